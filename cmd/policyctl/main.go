@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+)
+
+const (
+	tokenEnvName = "AUTH_ACCESS_TOKEN"
+
+	requestTimeout = 30 * time.Second
+
+	usage = `usage: policyctl [flags] <command>
+
+commands:
+  export              print current policy bundle
+  apply <file>        apply YAML or JSON policy bundle from file, - reads stdin
+  versions            list applied policy versions
+  rollback <version>  apply policy bundle of stored version
+
+flags:
+`
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "auth service gRPC address")
+	token := flag.String("token", os.Getenv(tokenEnvName),
+		"admin access token, defaults to "+tokenEnvName)
+	caFile := flag.String("ca", "", "TLS certificate of auth service, plaintext if empty")
+	format := flag.String("format", "yaml", "export format: yaml or json")
+	dryRun := flag.Bool("dry-run", false, "only print the diff of apply and rollback")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *token == "" {
+		log.Fatalf("access token is required, use -token or %s", tokenEnvName)
+	}
+
+	conn, err := dial(*addr, *caFile)
+	if err != nil {
+		log.Fatalf("failed to connect to auth service: %v", err)
+	}
+	defer conn.Close() // nolint:errcheck
+
+	client := access_admin_v1.NewAccessAdminV1Client(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+
+	args := flag.Args()
+	switch {
+	case args[0] == "export" && len(args) == 1:
+		err = exportPolicy(ctx, client, *format)
+	case args[0] == "apply" && len(args) == 2:
+		err = applyPolicy(ctx, client, args[1], *dryRun)
+	case args[0] == "versions" && len(args) == 1:
+		err = listVersions(ctx, client)
+	case args[0] == "rollback" && len(args) == 2:
+		err = rollbackPolicy(ctx, client, args[1], *dryRun)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func dial(addr, caFile string) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if caFile != "" {
+		tlsCreds, err := credentials.NewClientTLSFromFile(caFile, "")
+		if err != nil {
+			return nil, err
+		}
+		creds = tlsCreds
+	}
+
+	return grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+}
+
+func exportPolicy(ctx context.Context, client access_admin_v1.AccessAdminV1Client,
+	format string,
+) error {
+	req := &access_admin_v1.ExportPolicyRequest{}
+	switch format {
+	case "yaml":
+		req.Format = access_admin_v1.PolicyFormat_YAML
+	case "json":
+		req.Format = access_admin_v1.PolicyFormat_JSON
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	res, err := client.ExportPolicy(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(res.GetDocument())
+	return err
+}
+
+func applyPolicy(ctx context.Context, client access_admin_v1.AccessAdminV1Client,
+	file string, dryRun bool,
+) error {
+	var (
+		document []byte
+		err      error
+	)
+	if file == "-" {
+		document, err = io.ReadAll(os.Stdin)
+	} else {
+		document, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ApplyPolicy(ctx, &access_admin_v1.ApplyPolicyRequest{
+		Document: document,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	printResult(res, dryRun)
+	return nil
+}
+
+func listVersions(ctx context.Context, client access_admin_v1.AccessAdminV1Client) error {
+	res, err := client.ListPolicyVersions(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	for _, version := range res.GetVersions() {
+		fmt.Printf("%d\t%s\t%s\t%s\n", version.GetVersion(),
+			version.GetCreatedAt().AsTime().Format(time.RFC3339), version.GetAuthor(),
+			version.GetAction())
+		printDiff(version.GetDiff())
+	}
+
+	return nil
+}
+
+func rollbackPolicy(ctx context.Context, client access_admin_v1.AccessAdminV1Client,
+	arg string, dryRun bool,
+) error {
+	version, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid version %q", arg)
+	}
+
+	res, err := client.RollbackPolicy(ctx, &access_admin_v1.RollbackPolicyRequest{
+		Version: version,
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	printResult(res, dryRun)
+	return nil
+}
+
+func printResult(res *access_admin_v1.ApplyPolicyResponse, dryRun bool) {
+	printDiff(res.GetDiff())
+
+	switch {
+	case dryRun:
+		fmt.Println("dry run, nothing is changed")
+	case res.GetVersion() == 0:
+		fmt.Println("policy is up to date")
+	default:
+		fmt.Printf("applied policy version %d\n", res.GetVersion())
+	}
+}
+
+func printDiff(diff *access_admin_v1.PolicyDiff) {
+	for _, role := range diff.GetAddedRoles() {
+		fmt.Printf("  + role %s\n", role)
+	}
+	for _, role := range diff.GetRemovedRoles() {
+		fmt.Printf("  - role %s\n", role)
+	}
+	for _, permission := range diff.GetAddedPermissions() {
+		fmt.Printf("  + permission %s\n", permission)
+	}
+	for _, permission := range diff.GetRemovedPermissions() {
+		fmt.Printf("  - permission %s\n", permission)
+	}
+	for _, parent := range diff.GetAddedRoleParents() {
+		fmt.Printf("  + role %s inherits %s\n", parent.GetRole(), parent.GetParent())
+	}
+	for _, parent := range diff.GetRemovedRoleParents() {
+		fmt.Printf("  - role %s inherits %s\n", parent.GetRole(), parent.GetParent())
+	}
+	for _, grant := range diff.GetGrantedPermissions() {
+		fmt.Printf("  + grant %s to %s\n", grant.GetPermission(), grant.GetRole())
+	}
+	for _, grant := range diff.GetRevokedPermissions() {
+		fmt.Printf("  - grant %s to %s\n", grant.GetPermission(), grant.GetRole())
+	}
+	for _, rule := range diff.GetAddedRules() {
+		fmt.Printf("  + rule %s\n", formatRule(rule))
+	}
+	for _, rule := range diff.GetRemovedRules() {
+		fmt.Printf("  - rule %s\n", formatRule(rule))
+	}
+}
+
+func formatRule(rule *access_admin_v1.Rule) string {
+	subject := "permission " + rule.GetPermission()
+	switch {
+	case rule.GetRole() != "":
+		subject = "role " + rule.GetRole()
+	case rule.GetUserId() != 0:
+		subject = "user " + strconv.FormatInt(rule.GetUserId(), 10)
+	}
+
+	res := fmt.Sprintf("%s %s %s", rule.GetEffect(), subject, rule.GetEndpoint())
+	if rule.GetCondition() != "" {
+		res += " if " + rule.GetCondition()
+	}
+
+	return res
+}
@@ -0,0 +1,4409 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: access_admin.proto
+
+package access_admin_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Rule with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Rule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Rule with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RuleMultiError, or nil if none found.
+func (m *Rule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Rule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Permission
+
+	// no validation rules for Endpoint
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Condition
+
+	// no validation rules for Effect
+
+	// no validation rules for Role
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return RuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuleMultiError is an error wrapping multiple validation errors returned by
+// Rule.ValidateAll() if the designated constraints aren't met.
+type RuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuleMultiError) AllErrors() []error { return m }
+
+// RuleValidationError is the validation error returned by Rule.Validate if the
+// designated constraints aren't met.
+type RuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleValidationError) ErrorName() string { return "RuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleValidationError{}
+
+// Validate checks the field values on CreateRuleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRuleRequestMultiError, or nil if none found.
+func (m *CreateRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPermission()) > 100 {
+		err := CreateRuleRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRuleRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := CreateRuleRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^([0-9a-z_.-]+(:[0-9a-z_.-]+)+)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+		err := CreateRuleRequestValidationError{
+			field:  "Endpoint",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCondition()) > 1024 {
+		err := CreateRuleRequestValidationError{
+			field:  "Condition",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Effect_name[int32(m.GetEffect())]; !ok {
+		err := CreateRuleRequestValidationError{
+			field:  "Effect",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) > 100 {
+		err := CreateRuleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRuleRequest_Role_Pattern.MatchString(m.GetRole()) {
+		err := CreateRuleRequestValidationError{
+			field:  "Role",
+			reason: "value does not match regex pattern \"^[0-9A-Z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 0 {
+		err := CreateRuleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRuleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRuleRequestMultiError) AllErrors() []error { return m }
+
+// CreateRuleRequestValidationError is the validation error returned by
+// CreateRuleRequest.Validate if the designated constraints aren't met.
+type CreateRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRuleRequestValidationError) ErrorName() string {
+	return "CreateRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRuleRequestValidationError{}
+
+var _CreateRuleRequest_Permission_Pattern = regexp.MustCompile("^([0-9a-z_.-]+(:[0-9a-z_.-]+)+)?$")
+
+var _CreateRuleRequest_Role_Pattern = regexp.MustCompile("^[0-9A-Z_]*$")
+
+// Validate checks the field values on CreateRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRuleResponseMultiError, or nil if none found.
+func (m *CreateRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRuleResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateRuleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRuleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRuleResponseMultiError) AllErrors() []error { return m }
+
+// CreateRuleResponseValidationError is the validation error returned by
+// CreateRuleResponse.Validate if the designated constraints aren't met.
+type CreateRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRuleResponseValidationError) ErrorName() string {
+	return "CreateRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRuleResponseValidationError{}
+
+// Validate checks the field values on ListRulesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRulesRequestMultiError, or nil if none found.
+func (m *ListRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Permission
+
+	// no validation rules for Endpoint
+
+	// no validation rules for Effect
+
+	// no validation rules for Role
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ListRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRulesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRulesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListRulesRequestValidationError is the validation error returned by
+// ListRulesRequest.Validate if the designated constraints aren't met.
+type ListRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRulesRequestValidationError) ErrorName() string { return "ListRulesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRulesRequestValidationError{}
+
+// Validate checks the field values on ListRulesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRulesResponseMultiError, or nil if none found.
+func (m *ListRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRulesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRulesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRulesResponseMultiError) AllErrors() []error { return m }
+
+// ListRulesResponseValidationError is the validation error returned by
+// ListRulesResponse.Validate if the designated constraints aren't met.
+type ListRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRulesResponseValidationError) ErrorName() string {
+	return "ListRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRulesResponseValidationError{}
+
+// Validate checks the field values on UpdateRuleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRuleRequestMultiError, or nil if none found.
+func (m *UpdateRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if wrapper := m.GetPermission(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 3 || l > 100 {
+			err := UpdateRuleRequestValidationError{
+				field:  "Permission",
+				reason: "value length must be between 3 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateRuleRequest_Permission_Pattern.MatchString(wrapper.GetValue()) {
+			err := UpdateRuleRequestValidationError{
+				field:  "Permission",
+				reason: "value does not match regex pattern \"^[0-9a-z_.-]+(:[0-9a-z_.-]+)+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetEndpoint(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 255 {
+			err := UpdateRuleRequestValidationError{
+				field:  "Endpoint",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetCondition(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 1024 {
+			err := UpdateRuleRequestValidationError{
+				field:  "Condition",
+				reason: "value length must be at most 1024 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRuleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRuleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRuleRequestValidationError is the validation error returned by
+// UpdateRuleRequest.Validate if the designated constraints aren't met.
+type UpdateRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRuleRequestValidationError) ErrorName() string {
+	return "UpdateRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRuleRequestValidationError{}
+
+var _UpdateRuleRequest_Permission_Pattern = regexp.MustCompile("^[0-9a-z_.-]+(:[0-9a-z_.-]+)+$")
+
+// Validate checks the field values on DeleteRuleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRuleRequestMultiError, or nil if none found.
+func (m *DeleteRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRuleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRuleRequestValidationError is the validation error returned by
+// DeleteRuleRequest.Validate if the designated constraints aren't met.
+type DeleteRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRuleRequestValidationError) ErrorName() string {
+	return "DeleteRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRuleRequestValidationError{}
+
+// Validate checks the field values on Permission with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Permission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Permission with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PermissionMultiError, or
+// nil if none found.
+func (m *Permission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Permission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionMultiError is an error wrapping multiple validation errors
+// returned by Permission.ValidateAll() if the designated constraints aren't met.
+type PermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionMultiError) AllErrors() []error { return m }
+
+// PermissionValidationError is the validation error returned by
+// Permission.Validate if the designated constraints aren't met.
+type PermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionValidationError) ErrorName() string { return "PermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionValidationError{}
+
+// Validate checks the field values on CreatePermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePermissionRequestMultiError, or nil if none found.
+func (m *CreatePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := CreatePermissionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreatePermissionRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreatePermissionRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[0-9a-z_.-]+(:[0-9a-z_.-]+)+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return CreatePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePermissionRequestMultiError) AllErrors() []error { return m }
+
+// CreatePermissionRequestValidationError is the validation error returned by
+// CreatePermissionRequest.Validate if the designated constraints aren't met.
+type CreatePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePermissionRequestValidationError) ErrorName() string {
+	return "CreatePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePermissionRequestValidationError{}
+
+var _CreatePermissionRequest_Name_Pattern = regexp.MustCompile("^[0-9a-z_.-]+(:[0-9a-z_.-]+)+$")
+
+// Validate checks the field values on CreatePermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePermissionResponseMultiError, or nil if none found.
+func (m *CreatePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreatePermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePermissionResponseMultiError) AllErrors() []error { return m }
+
+// CreatePermissionResponseValidationError is the validation error returned by
+// CreatePermissionResponse.Validate if the designated constraints aren't met.
+type CreatePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePermissionResponseValidationError) ErrorName() string {
+	return "CreatePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePermissionResponseValidationError{}
+
+// Validate checks the field values on ListPermissionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPermissionsResponseMultiError, or nil if none found.
+func (m *ListPermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPermissionsResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPermissionsResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPermissionsResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPermissionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPermissionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPermissionsResponseMultiError) AllErrors() []error { return m }
+
+// ListPermissionsResponseValidationError is the validation error returned by
+// ListPermissionsResponse.Validate if the designated constraints aren't met.
+type ListPermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPermissionsResponseValidationError) ErrorName() string {
+	return "ListPermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPermissionsResponseValidationError{}
+
+// Validate checks the field values on DeletePermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePermissionRequestMultiError, or nil if none found.
+func (m *DeletePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeletePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePermissionRequestMultiError) AllErrors() []error { return m }
+
+// DeletePermissionRequestValidationError is the validation error returned by
+// DeletePermissionRequest.Validate if the designated constraints aren't met.
+type DeletePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePermissionRequestValidationError) ErrorName() string {
+	return "DeletePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePermissionRequestValidationError{}
+
+// Validate checks the field values on GrantPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantPermissionRequestMultiError, or nil if none found.
+func (m *GrantPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 100 {
+		err := GrantPermissionRequestValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GrantPermissionRequest_Role_Pattern.MatchString(m.GetRole()) {
+		err := GrantPermissionRequestValidationError{
+			field:  "Role",
+			reason: "value does not match regex pattern \"^[0-9A-Z_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPermission()) < 1 {
+		err := GrantPermissionRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GrantPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantPermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by GrantPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GrantPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantPermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantPermissionRequestMultiError) AllErrors() []error { return m }
+
+// GrantPermissionRequestValidationError is the validation error returned by
+// GrantPermissionRequest.Validate if the designated constraints aren't met.
+type GrantPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantPermissionRequestValidationError) ErrorName() string {
+	return "GrantPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantPermissionRequestValidationError{}
+
+var _GrantPermissionRequest_Role_Pattern = regexp.MustCompile("^[0-9A-Z_]+$")
+
+// Validate checks the field values on RevokePermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokePermissionRequestMultiError, or nil if none found.
+func (m *RevokePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Permission
+
+	if len(errors) > 0 {
+		return RevokePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokePermissionRequestMultiError) AllErrors() []error { return m }
+
+// RevokePermissionRequestValidationError is the validation error returned by
+// RevokePermissionRequest.Validate if the designated constraints aren't met.
+type RevokePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokePermissionRequestValidationError) ErrorName() string {
+	return "RevokePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokePermissionRequestValidationError{}
+
+// Validate checks the field values on AddRoleParentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddRoleParentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddRoleParentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddRoleParentRequestMultiError, or nil if none found.
+func (m *AddRoleParentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddRoleParentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 100 {
+		err := AddRoleParentRequestValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddRoleParentRequest_Role_Pattern.MatchString(m.GetRole()) {
+		err := AddRoleParentRequestValidationError{
+			field:  "Role",
+			reason: "value does not match regex pattern \"^[0-9A-Z_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetParent()); l < 1 || l > 100 {
+		err := AddRoleParentRequestValidationError{
+			field:  "Parent",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddRoleParentRequest_Parent_Pattern.MatchString(m.GetParent()) {
+		err := AddRoleParentRequestValidationError{
+			field:  "Parent",
+			reason: "value does not match regex pattern \"^[0-9A-Z_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddRoleParentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddRoleParentRequestMultiError is an error wrapping multiple validation
+// errors returned by AddRoleParentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddRoleParentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddRoleParentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddRoleParentRequestMultiError) AllErrors() []error { return m }
+
+// AddRoleParentRequestValidationError is the validation error returned by
+// AddRoleParentRequest.Validate if the designated constraints aren't met.
+type AddRoleParentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddRoleParentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddRoleParentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddRoleParentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddRoleParentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddRoleParentRequestValidationError) ErrorName() string {
+	return "AddRoleParentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddRoleParentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddRoleParentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddRoleParentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddRoleParentRequestValidationError{}
+
+var _AddRoleParentRequest_Role_Pattern = regexp.MustCompile("^[0-9A-Z_]+$")
+
+var _AddRoleParentRequest_Parent_Pattern = regexp.MustCompile("^[0-9A-Z_]+$")
+
+// Validate checks the field values on DeleteRoleParentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleParentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleParentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleParentRequestMultiError, or nil if none found.
+func (m *DeleteRoleParentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleParentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Parent
+
+	if len(errors) > 0 {
+		return DeleteRoleParentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleParentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRoleParentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRoleParentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleParentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleParentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleParentRequestValidationError is the validation error returned by
+// DeleteRoleParentRequest.Validate if the designated constraints aren't met.
+type DeleteRoleParentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleParentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleParentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleParentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleParentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleParentRequestValidationError) ErrorName() string {
+	return "DeleteRoleParentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleParentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleParentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleParentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleParentRequestValidationError{}
+
+// Validate checks the field values on GetRolePermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRolePermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRolePermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRolePermissionsRequestMultiError, or nil if none found.
+func (m *GetRolePermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRolePermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return GetRolePermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRolePermissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRolePermissionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetRolePermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRolePermissionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRolePermissionsRequestMultiError) AllErrors() []error { return m }
+
+// GetRolePermissionsRequestValidationError is the validation error returned by
+// GetRolePermissionsRequest.Validate if the designated constraints aren't met.
+type GetRolePermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRolePermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRolePermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRolePermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRolePermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRolePermissionsRequestValidationError) ErrorName() string {
+	return "GetRolePermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRolePermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRolePermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRolePermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRolePermissionsRequestValidationError{}
+
+// Validate checks the field values on GetRolePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRolePermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRolePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRolePermissionsResponseMultiError, or nil if none found.
+func (m *GetRolePermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRolePermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return GetRolePermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRolePermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetRolePermissionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetRolePermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRolePermissionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRolePermissionsResponseMultiError) AllErrors() []error { return m }
+
+// GetRolePermissionsResponseValidationError is the validation error returned
+// by GetRolePermissionsResponse.Validate if the designated constraints aren't met.
+type GetRolePermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRolePermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRolePermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRolePermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRolePermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRolePermissionsResponseValidationError) ErrorName() string {
+	return "GetRolePermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRolePermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRolePermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRolePermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRolePermissionsResponseValidationError{}
+
+// Validate checks the field values on SimulateCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SimulateCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulateCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulateCheckRequestMultiError, or nil if none found.
+func (m *SimulateCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulateCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEndpointAddress()); l < 1 || l > 255 {
+		err := SimulateCheckRequestValidationError{
+			field:  "EndpointAddress",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Attributes
+
+	if len(m.GetAddRules()) > 100 {
+		err := SimulateCheckRequestValidationError{
+			field:  "AddRules",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAddRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SimulateCheckRequestValidationError{
+						field:  fmt.Sprintf("AddRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SimulateCheckRequestValidationError{
+						field:  fmt.Sprintf("AddRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SimulateCheckRequestValidationError{
+					field:  fmt.Sprintf("AddRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetRemoveRuleIds()) > 100 {
+		err := SimulateCheckRequestValidationError{
+			field:  "RemoveRuleIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofSubjectPresent := false
+	switch v := m.Subject.(type) {
+	case *SimulateCheckRequest_UserId:
+		if v == nil {
+			err := SimulateCheckRequestValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSubjectPresent = true
+
+		if m.GetUserId() <= 0 {
+			err := SimulateCheckRequestValidationError{
+				field:  "UserId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SimulateCheckRequest_Role:
+		if v == nil {
+			err := SimulateCheckRequestValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSubjectPresent = true
+
+		if utf8.RuneCountInString(m.GetRole()) > 100 {
+			err := SimulateCheckRequestValidationError{
+				field:  "Role",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SimulateCheckRequest_Role_Pattern.MatchString(m.GetRole()) {
+			err := SimulateCheckRequestValidationError{
+				field:  "Role",
+				reason: "value does not match regex pattern \"^[0-9A-Z_]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSubjectPresent {
+		err := SimulateCheckRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SimulateCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimulateCheckRequestMultiError is an error wrapping multiple validation
+// errors returned by SimulateCheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SimulateCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulateCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulateCheckRequestMultiError) AllErrors() []error { return m }
+
+// SimulateCheckRequestValidationError is the validation error returned by
+// SimulateCheckRequest.Validate if the designated constraints aren't met.
+type SimulateCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateCheckRequestValidationError) ErrorName() string {
+	return "SimulateCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulateCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateCheckRequestValidationError{}
+
+var _SimulateCheckRequest_Role_Pattern = regexp.MustCompile("^[0-9A-Z_]+$")
+
+// Validate checks the field values on SimulateCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SimulateCheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulateCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulateCheckResponseMultiError, or nil if none found.
+func (m *SimulateCheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulateCheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SimulateCheckResponseValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SimulateCheckResponseValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SimulateCheckResponseValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetExplanation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SimulateCheckResponseValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SimulateCheckResponseValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExplanation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SimulateCheckResponseValidationError{
+				field:  "Explanation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SimulateCheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimulateCheckResponseMultiError is an error wrapping multiple validation
+// errors returned by SimulateCheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SimulateCheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulateCheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulateCheckResponseMultiError) AllErrors() []error { return m }
+
+// SimulateCheckResponseValidationError is the validation error returned by
+// SimulateCheckResponse.Validate if the designated constraints aren't met.
+type SimulateCheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateCheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateCheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateCheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateCheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateCheckResponseValidationError) ErrorName() string {
+	return "SimulateCheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulateCheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateCheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateCheckResponseValidationError{}
+
+// Validate checks the field values on Explanation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Explanation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Explanation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExplanationMultiError, or
+// nil if none found.
+func (m *Explanation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Explanation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Role
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplanationValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplanationValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplanationValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExplanationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplanationMultiError is an error wrapping multiple validation errors
+// returned by Explanation.ValidateAll() if the designated constraints aren't met.
+type ExplanationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplanationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplanationMultiError) AllErrors() []error { return m }
+
+// ExplanationValidationError is the validation error returned by
+// Explanation.Validate if the designated constraints aren't met.
+type ExplanationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplanationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplanationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplanationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplanationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplanationValidationError) ErrorName() string { return "ExplanationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExplanationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplanation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplanationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplanationValidationError{}
+
+// Validate checks the field values on RuleEvaluation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuleEvaluation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuleEvaluation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuleEvaluationMultiError,
+// or nil if none found.
+func (m *RuleEvaluation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuleEvaluation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleEvaluationValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleEvaluationValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleEvaluationValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SubjectMatched
+
+	// no validation rules for ConditionMatched
+
+	// no validation rules for ConditionError
+
+	if len(errors) > 0 {
+		return RuleEvaluationMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuleEvaluationMultiError is an error wrapping multiple validation errors
+// returned by RuleEvaluation.ValidateAll() if the designated constraints
+// aren't met.
+type RuleEvaluationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuleEvaluationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuleEvaluationMultiError) AllErrors() []error { return m }
+
+// RuleEvaluationValidationError is the validation error returned by
+// RuleEvaluation.Validate if the designated constraints aren't met.
+type RuleEvaluationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleEvaluationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleEvaluationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleEvaluationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleEvaluationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleEvaluationValidationError) ErrorName() string { return "RuleEvaluationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleEvaluationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleEvaluation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleEvaluationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleEvaluationValidationError{}
+
+// Validate checks the field values on ApplyPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyPolicyRequestMultiError, or nil if none found.
+func (m *ApplyPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetDocument()); l < 1 || l > 1048576 {
+		err := ApplyPolicyRequestValidationError{
+			field:  "Document",
+			reason: "value length must be between 1 and 1048576 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ApplyPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyPolicyRequestMultiError is an error wrapping multiple validation errors
+// returned by ApplyPolicyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApplyPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ApplyPolicyRequestValidationError is the validation error returned by
+// ApplyPolicyRequest.Validate if the designated constraints aren't met.
+type ApplyPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPolicyRequestValidationError) ErrorName() string {
+	return "ApplyPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPolicyRequestValidationError{}
+
+// Validate checks the field values on ApplyPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyPolicyResponseMultiError, or nil if none found.
+func (m *ApplyPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetDiff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyPolicyResponseValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplyPolicyResponseValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPolicyResponseValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApplyPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by ApplyPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyPolicyResponseMultiError) AllErrors() []error { return m }
+
+// ApplyPolicyResponseValidationError is the validation error returned by
+// ApplyPolicyResponse.Validate if the designated constraints aren't met.
+type ApplyPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPolicyResponseValidationError) ErrorName() string {
+	return "ApplyPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPolicyResponseValidationError{}
+
+// Validate checks the field values on PolicyDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyDiffMultiError, or
+// nil if none found.
+func (m *PolicyDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAddedRoleParents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("AddedRoleParents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("AddedRoleParents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyDiffValidationError{
+					field:  fmt.Sprintf("AddedRoleParents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemovedRoleParents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("RemovedRoleParents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("RemovedRoleParents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyDiffValidationError{
+					field:  fmt.Sprintf("RemovedRoleParents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetGrantedPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("GrantedPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("GrantedPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyDiffValidationError{
+					field:  fmt.Sprintf("GrantedPermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRevokedPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("RevokedPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("RevokedPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyDiffValidationError{
+					field:  fmt.Sprintf("RevokedPermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAddedRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("AddedRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("AddedRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyDiffValidationError{
+					field:  fmt.Sprintf("AddedRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemovedRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("RemovedRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyDiffValidationError{
+						field:  fmt.Sprintf("RemovedRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyDiffValidationError{
+					field:  fmt.Sprintf("RemovedRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyDiffMultiError is an error wrapping multiple validation errors
+// returned by PolicyDiff.ValidateAll() if the designated constraints aren't met.
+type PolicyDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyDiffMultiError) AllErrors() []error { return m }
+
+// PolicyDiffValidationError is the validation error returned by
+// PolicyDiff.Validate if the designated constraints aren't met.
+type PolicyDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyDiffValidationError) ErrorName() string { return "PolicyDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyDiffValidationError{}
+
+// Validate checks the field values on RoleParent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleParent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleParent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleParentMultiError, or
+// nil if none found.
+func (m *RoleParent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleParent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Parent
+
+	if len(errors) > 0 {
+		return RoleParentMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleParentMultiError is an error wrapping multiple validation errors
+// returned by RoleParent.ValidateAll() if the designated constraints aren't met.
+type RoleParentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleParentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleParentMultiError) AllErrors() []error { return m }
+
+// RoleParentValidationError is the validation error returned by
+// RoleParent.Validate if the designated constraints aren't met.
+type RoleParentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleParentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleParentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleParentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleParentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleParentValidationError) ErrorName() string { return "RoleParentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleParentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleParent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleParentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleParentValidationError{}
+
+// Validate checks the field values on RolePermission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolePermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolePermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolePermissionMultiError,
+// or nil if none found.
+func (m *RolePermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolePermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Permission
+
+	if len(errors) > 0 {
+		return RolePermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolePermissionMultiError is an error wrapping multiple validation errors
+// returned by RolePermission.ValidateAll() if the designated constraints
+// aren't met.
+type RolePermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolePermissionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolePermissionMultiError) AllErrors() []error { return m }
+
+// RolePermissionValidationError is the validation error returned by
+// RolePermission.Validate if the designated constraints aren't met.
+type RolePermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolePermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolePermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolePermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolePermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolePermissionValidationError) ErrorName() string { return "RolePermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolePermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolePermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolePermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolePermissionValidationError{}
+
+// Validate checks the field values on ExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPolicyRequestMultiError, or nil if none found.
+func (m *ExportPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := PolicyFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportPolicyRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ExportPolicyRequestValidationError is the validation error returned by
+// ExportPolicyRequest.Validate if the designated constraints aren't met.
+type ExportPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicyRequestValidationError) ErrorName() string {
+	return "ExportPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicyRequestValidationError{}
+
+// Validate checks the field values on ExportPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPolicyResponseMultiError, or nil if none found.
+func (m *ExportPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Document
+
+	if len(errors) > 0 {
+		return ExportPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicyResponseMultiError) AllErrors() []error { return m }
+
+// ExportPolicyResponseValidationError is the validation error returned by
+// ExportPolicyResponse.Validate if the designated constraints aren't met.
+type ExportPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicyResponseValidationError) ErrorName() string {
+	return "ExportPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicyResponseValidationError{}
+
+// Validate checks the field values on PolicyVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyVersionMultiError, or
+// nil if none found.
+func (m *PolicyVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Author
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetDiff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyVersionValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PolicyVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyVersionMultiError is an error wrapping multiple validation errors
+// returned by PolicyVersion.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyVersionMultiError) AllErrors() []error { return m }
+
+// PolicyVersionValidationError is the validation error returned by
+// PolicyVersion.Validate if the designated constraints aren't met.
+type PolicyVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyVersionValidationError) ErrorName() string { return "PolicyVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyVersionValidationError{}
+
+// Validate checks the field values on ListPolicyVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPolicyVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPolicyVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPolicyVersionsResponseMultiError, or nil if none found.
+func (m *ListPolicyVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPolicyVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPolicyVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPolicyVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPolicyVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPolicyVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPolicyVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPolicyVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPolicyVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPolicyVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPolicyVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListPolicyVersionsResponseValidationError is the validation error returned
+// by ListPolicyVersionsResponse.Validate if the designated constraints aren't met.
+type ListPolicyVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPolicyVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPolicyVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPolicyVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPolicyVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPolicyVersionsResponseValidationError) ErrorName() string {
+	return "ListPolicyVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPolicyVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPolicyVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPolicyVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPolicyVersionsResponseValidationError{}
+
+// Validate checks the field values on RollbackPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackPolicyRequestMultiError, or nil if none found.
+func (m *RollbackPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetVersion() < 0 {
+		err := RollbackPolicyRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return RollbackPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackPolicyRequestMultiError) AllErrors() []error { return m }
+
+// RollbackPolicyRequestValidationError is the validation error returned by
+// RollbackPolicyRequest.Validate if the designated constraints aren't met.
+type RollbackPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackPolicyRequestValidationError) ErrorName() string {
+	return "RollbackPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackPolicyRequestValidationError{}
@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.0--rc1
+// source: access_admin.proto
+
+package access_admin_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AccessAdminV1Client is the client API for AccessAdminV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccessAdminV1Client interface {
+	// CreateRule requires permission for endpoint
+	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*CreateRuleResponse, error)
+	// ListRules returns access rules of organization
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	// UpdateRule updates access rule
+	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteRule deletes access rule
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreatePermission creates new permission
+	CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error)
+	// ListPermissions returns permissions of organization
+	ListPermissions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	// DeletePermission deletes permission with its grants and access rules
+	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GrantPermission grants permission to role
+	GrantPermission(ctx context.Context, in *GrantPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RevokePermission revokes permission granted to role directly
+	RevokePermission(ctx context.Context, in *RevokePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AddRoleParent makes role inherit all permissions of parent role
+	AddRoleParent(ctx context.Context, in *AddRoleParentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteRoleParent removes inheritance of parent role
+	DeleteRoleParent(ctx context.Context, in *DeleteRoleParentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetRolePermissions returns parents, direct and effective permissions of role
+	GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error)
+	// SimulateCheck explains access of user or role to endpoint without its access token,
+	// optionally with proposed change of rules instead of current rules, nothing is changed
+	SimulateCheck(ctx context.Context, in *SimulateCheckRequest, opts ...grpc.CallOption) (*SimulateCheckResponse, error)
+	// ApplyPolicy replaces roles, permissions and access rules of organization with
+	// YAML or JSON policy bundle in one transaction and stores it as new policy version
+	ApplyPolicy(ctx context.Context, in *ApplyPolicyRequest, opts ...grpc.CallOption) (*ApplyPolicyResponse, error)
+	// ExportPolicy returns current policy of organization as policy bundle
+	ExportPolicy(ctx context.Context, in *ExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicyResponse, error)
+	// ListPolicyVersions returns applied policy versions of organization, newest first
+	ListPolicyVersions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPolicyVersionsResponse, error)
+	// RollbackPolicy applies policy bundle of stored version as new policy version
+	RollbackPolicy(ctx context.Context, in *RollbackPolicyRequest, opts ...grpc.CallOption) (*ApplyPolicyResponse, error)
+}
+
+type accessAdminV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessAdminV1Client(cc grpc.ClientConnInterface) AccessAdminV1Client {
+	return &accessAdminV1Client{cc}
+}
+
+func (c *accessAdminV1Client) CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*CreateRuleResponse, error) {
+	out := new(CreateRuleResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/CreateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/ListRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/UpdateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/DeleteRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error) {
+	out := new(CreatePermissionResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/CreatePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) ListPermissions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/DeletePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) GrantPermission(ctx context.Context, in *GrantPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/GrantPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) RevokePermission(ctx context.Context, in *RevokePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/RevokePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) AddRoleParent(ctx context.Context, in *AddRoleParentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/AddRoleParent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) DeleteRoleParent(ctx context.Context, in *DeleteRoleParentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/DeleteRoleParent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error) {
+	out := new(GetRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/GetRolePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) SimulateCheck(ctx context.Context, in *SimulateCheckRequest, opts ...grpc.CallOption) (*SimulateCheckResponse, error) {
+	out := new(SimulateCheckResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/SimulateCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) ApplyPolicy(ctx context.Context, in *ApplyPolicyRequest, opts ...grpc.CallOption) (*ApplyPolicyResponse, error) {
+	out := new(ApplyPolicyResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/ApplyPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) ExportPolicy(ctx context.Context, in *ExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicyResponse, error) {
+	out := new(ExportPolicyResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/ExportPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) ListPolicyVersions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPolicyVersionsResponse, error) {
+	out := new(ListPolicyVersionsResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/ListPolicyVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) RollbackPolicy(ctx context.Context, in *RollbackPolicyRequest, opts ...grpc.CallOption) (*ApplyPolicyResponse, error) {
+	out := new(ApplyPolicyResponse)
+	err := c.cc.Invoke(ctx, "/access_admin_v1.AccessAdminV1/RollbackPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessAdminV1Server is the server API for AccessAdminV1 service.
+// All implementations must embed UnimplementedAccessAdminV1Server
+// for forward compatibility
+type AccessAdminV1Server interface {
+	// CreateRule requires permission for endpoint
+	CreateRule(context.Context, *CreateRuleRequest) (*CreateRuleResponse, error)
+	// ListRules returns access rules of organization
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	// UpdateRule updates access rule
+	UpdateRule(context.Context, *UpdateRuleRequest) (*emptypb.Empty, error)
+	// DeleteRule deletes access rule
+	DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error)
+	// CreatePermission creates new permission
+	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
+	// ListPermissions returns permissions of organization
+	ListPermissions(context.Context, *emptypb.Empty) (*ListPermissionsResponse, error)
+	// DeletePermission deletes permission with its grants and access rules
+	DeletePermission(context.Context, *DeletePermissionRequest) (*emptypb.Empty, error)
+	// GrantPermission grants permission to role
+	GrantPermission(context.Context, *GrantPermissionRequest) (*emptypb.Empty, error)
+	// RevokePermission revokes permission granted to role directly
+	RevokePermission(context.Context, *RevokePermissionRequest) (*emptypb.Empty, error)
+	// AddRoleParent makes role inherit all permissions of parent role
+	AddRoleParent(context.Context, *AddRoleParentRequest) (*emptypb.Empty, error)
+	// DeleteRoleParent removes inheritance of parent role
+	DeleteRoleParent(context.Context, *DeleteRoleParentRequest) (*emptypb.Empty, error)
+	// GetRolePermissions returns parents, direct and effective permissions of role
+	GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error)
+	// SimulateCheck explains access of user or role to endpoint without its access token,
+	// optionally with proposed change of rules instead of current rules, nothing is changed
+	SimulateCheck(context.Context, *SimulateCheckRequest) (*SimulateCheckResponse, error)
+	// ApplyPolicy replaces roles, permissions and access rules of organization with
+	// YAML or JSON policy bundle in one transaction and stores it as new policy version
+	ApplyPolicy(context.Context, *ApplyPolicyRequest) (*ApplyPolicyResponse, error)
+	// ExportPolicy returns current policy of organization as policy bundle
+	ExportPolicy(context.Context, *ExportPolicyRequest) (*ExportPolicyResponse, error)
+	// ListPolicyVersions returns applied policy versions of organization, newest first
+	ListPolicyVersions(context.Context, *emptypb.Empty) (*ListPolicyVersionsResponse, error)
+	// RollbackPolicy applies policy bundle of stored version as new policy version
+	RollbackPolicy(context.Context, *RollbackPolicyRequest) (*ApplyPolicyResponse, error)
+	mustEmbedUnimplementedAccessAdminV1Server()
+}
+
+// UnimplementedAccessAdminV1Server must be embedded to have forward compatible implementations.
+type UnimplementedAccessAdminV1Server struct {
+}
+
+func (UnimplementedAccessAdminV1Server) CreateRule(context.Context, *CreateRuleRequest) (*CreateRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedAccessAdminV1Server) UpdateRule(context.Context, *UpdateRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+}
+func (UnimplementedAccessAdminV1Server) ListPermissions(context.Context, *emptypb.Empty) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedAccessAdminV1Server) DeletePermission(context.Context, *DeletePermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
+}
+func (UnimplementedAccessAdminV1Server) GrantPermission(context.Context, *GrantPermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPermission not implemented")
+}
+func (UnimplementedAccessAdminV1Server) RevokePermission(context.Context, *RevokePermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePermission not implemented")
+}
+func (UnimplementedAccessAdminV1Server) AddRoleParent(context.Context, *AddRoleParentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoleParent not implemented")
+}
+func (UnimplementedAccessAdminV1Server) DeleteRoleParent(context.Context, *DeleteRoleParentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleParent not implemented")
+}
+func (UnimplementedAccessAdminV1Server) GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermissions not implemented")
+}
+func (UnimplementedAccessAdminV1Server) SimulateCheck(context.Context, *SimulateCheckRequest) (*SimulateCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCheck not implemented")
+}
+func (UnimplementedAccessAdminV1Server) ApplyPolicy(context.Context, *ApplyPolicyRequest) (*ApplyPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPolicy not implemented")
+}
+func (UnimplementedAccessAdminV1Server) ExportPolicy(context.Context, *ExportPolicyRequest) (*ExportPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPolicy not implemented")
+}
+func (UnimplementedAccessAdminV1Server) ListPolicyVersions(context.Context, *emptypb.Empty) (*ListPolicyVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyVersions not implemented")
+}
+func (UnimplementedAccessAdminV1Server) RollbackPolicy(context.Context, *RollbackPolicyRequest) (*ApplyPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPolicy not implemented")
+}
+func (UnimplementedAccessAdminV1Server) mustEmbedUnimplementedAccessAdminV1Server() {}
+
+// UnsafeAccessAdminV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessAdminV1Server will
+// result in compilation errors.
+type UnsafeAccessAdminV1Server interface {
+	mustEmbedUnimplementedAccessAdminV1Server()
+}
+
+func RegisterAccessAdminV1Server(s grpc.ServiceRegistrar, srv AccessAdminV1Server) {
+	s.RegisterService(&AccessAdminV1_ServiceDesc, srv)
+}
+
+func _AccessAdminV1_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/CreateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/UpdateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/DeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).CreatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/CreatePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).CreatePermission(ctx, req.(*CreatePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ListPermissions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).DeletePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/DeletePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).DeletePermission(ctx, req.(*DeletePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_GrantPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).GrantPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/GrantPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).GrantPermission(ctx, req.(*GrantPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).RevokePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/RevokePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).RevokePermission(ctx, req.(*RevokePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_AddRoleParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).AddRoleParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/AddRoleParent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).AddRoleParent(ctx, req.(*AddRoleParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_DeleteRoleParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).DeleteRoleParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/DeleteRoleParent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).DeleteRoleParent(ctx, req.(*DeleteRoleParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_GetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).GetRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/GetRolePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).GetRolePermissions(ctx, req.(*GetRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_SimulateCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).SimulateCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/SimulateCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).SimulateCheck(ctx, req.(*SimulateCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_ApplyPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ApplyPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/ApplyPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ApplyPolicy(ctx, req.(*ApplyPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_ExportPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ExportPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/ExportPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ExportPolicy(ctx, req.(*ExportPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_ListPolicyVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ListPolicyVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/ListPolicyVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ListPolicyVersions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_RollbackPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).RollbackPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/access_admin_v1.AccessAdminV1/RollbackPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).RollbackPolicy(ctx, req.(*RollbackPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccessAdminV1_ServiceDesc is the grpc.ServiceDesc for AccessAdminV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccessAdminV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "access_admin_v1.AccessAdminV1",
+	HandlerType: (*AccessAdminV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRule",
+			Handler:    _AccessAdminV1_CreateRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _AccessAdminV1_ListRules_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _AccessAdminV1_UpdateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _AccessAdminV1_DeleteRule_Handler,
+		},
+		{
+			MethodName: "CreatePermission",
+			Handler:    _AccessAdminV1_CreatePermission_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _AccessAdminV1_ListPermissions_Handler,
+		},
+		{
+			MethodName: "DeletePermission",
+			Handler:    _AccessAdminV1_DeletePermission_Handler,
+		},
+		{
+			MethodName: "GrantPermission",
+			Handler:    _AccessAdminV1_GrantPermission_Handler,
+		},
+		{
+			MethodName: "RevokePermission",
+			Handler:    _AccessAdminV1_RevokePermission_Handler,
+		},
+		{
+			MethodName: "AddRoleParent",
+			Handler:    _AccessAdminV1_AddRoleParent_Handler,
+		},
+		{
+			MethodName: "DeleteRoleParent",
+			Handler:    _AccessAdminV1_DeleteRoleParent_Handler,
+		},
+		{
+			MethodName: "GetRolePermissions",
+			Handler:    _AccessAdminV1_GetRolePermissions_Handler,
+		},
+		{
+			MethodName: "SimulateCheck",
+			Handler:    _AccessAdminV1_SimulateCheck_Handler,
+		},
+		{
+			MethodName: "ApplyPolicy",
+			Handler:    _AccessAdminV1_ApplyPolicy_Handler,
+		},
+		{
+			MethodName: "ExportPolicy",
+			Handler:    _AccessAdminV1_ExportPolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicyVersions",
+			Handler:    _AccessAdminV1_ListPolicyVersions_Handler,
+		},
+		{
+			MethodName: "RollbackPolicy",
+			Handler:    _AccessAdminV1_RollbackPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "access_admin.proto",
+}
@@ -0,0 +1,915 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: elevation.proto
+
+package elevation_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RoleGrant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleGrantMultiError, or nil
+// if none found.
+func (m *RoleGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for Reason
+
+	// no validation rules for Status
+
+	// no validation rules for ReviewedBy
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleGrantValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleGrantValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleGrantValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleGrantValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleGrantMultiError is an error wrapping multiple validation errors returned
+// by RoleGrant.ValidateAll() if the designated constraints aren't met.
+type RoleGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleGrantMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleGrantMultiError) AllErrors() []error { return m }
+
+// RoleGrantValidationError is the validation error returned by
+// RoleGrant.Validate if the designated constraints aren't met.
+type RoleGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleGrantValidationError) ErrorName() string { return "RoleGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleGrantValidationError{}
+
+// Validate checks the field values on RequestElevationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestElevationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestElevationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestElevationRequestMultiError, or nil if none found.
+func (m *RequestElevationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestElevationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 255 {
+		err := RequestElevationRequestValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1024 {
+		err := RequestElevationRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestElevationRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestElevationRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestElevationRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetDuration() == nil {
+		err := RequestElevationRequestValidationError{
+			field:  "Duration",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RequestElevationRequestValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(28800*time.Second + 0*time.Nanosecond)
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt || dur > lte {
+				err := RequestElevationRequestValidationError{
+					field:  "Duration",
+					reason: "value must be inside range (0s, 8h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestElevationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestElevationRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestElevationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestElevationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestElevationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestElevationRequestMultiError) AllErrors() []error { return m }
+
+// RequestElevationRequestValidationError is the validation error returned by
+// RequestElevationRequest.Validate if the designated constraints aren't met.
+type RequestElevationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestElevationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestElevationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestElevationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestElevationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestElevationRequestValidationError) ErrorName() string {
+	return "RequestElevationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestElevationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestElevationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestElevationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestElevationRequestValidationError{}
+
+// Validate checks the field values on ListElevationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListElevationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListElevationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListElevationsRequestMultiError, or nil if none found.
+func (m *ListElevationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListElevationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := RoleGrantStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListElevationsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListElevationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListElevationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListElevationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListElevationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListElevationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListElevationsRequestMultiError) AllErrors() []error { return m }
+
+// ListElevationsRequestValidationError is the validation error returned by
+// ListElevationsRequest.Validate if the designated constraints aren't met.
+type ListElevationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListElevationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListElevationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListElevationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListElevationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListElevationsRequestValidationError) ErrorName() string {
+	return "ListElevationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListElevationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListElevationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListElevationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListElevationsRequestValidationError{}
+
+// Validate checks the field values on ListElevationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListElevationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListElevationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListElevationsResponseMultiError, or nil if none found.
+func (m *ListElevationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListElevationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGrants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListElevationsResponseValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListElevationsResponseValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListElevationsResponseValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListElevationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListElevationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListElevationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListElevationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListElevationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListElevationsResponseMultiError) AllErrors() []error { return m }
+
+// ListElevationsResponseValidationError is the validation error returned by
+// ListElevationsResponse.Validate if the designated constraints aren't met.
+type ListElevationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListElevationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListElevationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListElevationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListElevationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListElevationsResponseValidationError) ErrorName() string {
+	return "ListElevationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListElevationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListElevationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListElevationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListElevationsResponseValidationError{}
+
+// Validate checks the field values on ApproveElevationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveElevationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveElevationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveElevationRequestMultiError, or nil if none found.
+func (m *ApproveElevationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveElevationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ApproveElevationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveElevationRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveElevationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveElevationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveElevationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveElevationRequestMultiError) AllErrors() []error { return m }
+
+// ApproveElevationRequestValidationError is the validation error returned by
+// ApproveElevationRequest.Validate if the designated constraints aren't met.
+type ApproveElevationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveElevationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveElevationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveElevationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveElevationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveElevationRequestValidationError) ErrorName() string {
+	return "ApproveElevationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveElevationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveElevationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveElevationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveElevationRequestValidationError{}
+
+// Validate checks the field values on DenyElevationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DenyElevationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DenyElevationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DenyElevationRequestMultiError, or nil if none found.
+func (m *DenyElevationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DenyElevationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DenyElevationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DenyElevationRequestMultiError is an error wrapping multiple validation
+// errors returned by DenyElevationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DenyElevationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DenyElevationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DenyElevationRequestMultiError) AllErrors() []error { return m }
+
+// DenyElevationRequestValidationError is the validation error returned by
+// DenyElevationRequest.Validate if the designated constraints aren't met.
+type DenyElevationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DenyElevationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DenyElevationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DenyElevationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DenyElevationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DenyElevationRequestValidationError) ErrorName() string {
+	return "DenyElevationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DenyElevationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDenyElevationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DenyElevationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DenyElevationRequestValidationError{}
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.0--rc1
+// source: elevation.proto
+
+package elevation_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ElevationV1Client is the client API for ElevationV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ElevationV1Client interface {
+	// RequestElevation creates request of caller for time-bound grant of role
+	RequestElevation(ctx context.Context, in *RequestElevationRequest, opts ...grpc.CallOption) (*RoleGrant, error)
+	// ListElevations returns role grants, approvers see grants of all users
+	ListElevations(ctx context.Context, in *ListElevationsRequest, opts ...grpc.CallOption) (*ListElevationsResponse, error)
+	// ApproveElevation approves elevation request, it requires approver
+	ApproveElevation(ctx context.Context, in *ApproveElevationRequest, opts ...grpc.CallOption) (*RoleGrant, error)
+	// DenyElevation denies elevation request, it requires approver
+	DenyElevation(ctx context.Context, in *DenyElevationRequest, opts ...grpc.CallOption) (*RoleGrant, error)
+}
+
+type elevationV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewElevationV1Client(cc grpc.ClientConnInterface) ElevationV1Client {
+	return &elevationV1Client{cc}
+}
+
+func (c *elevationV1Client) RequestElevation(ctx context.Context, in *RequestElevationRequest, opts ...grpc.CallOption) (*RoleGrant, error) {
+	out := new(RoleGrant)
+	err := c.cc.Invoke(ctx, "/elevation_v1.ElevationV1/RequestElevation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationV1Client) ListElevations(ctx context.Context, in *ListElevationsRequest, opts ...grpc.CallOption) (*ListElevationsResponse, error) {
+	out := new(ListElevationsResponse)
+	err := c.cc.Invoke(ctx, "/elevation_v1.ElevationV1/ListElevations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationV1Client) ApproveElevation(ctx context.Context, in *ApproveElevationRequest, opts ...grpc.CallOption) (*RoleGrant, error) {
+	out := new(RoleGrant)
+	err := c.cc.Invoke(ctx, "/elevation_v1.ElevationV1/ApproveElevation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationV1Client) DenyElevation(ctx context.Context, in *DenyElevationRequest, opts ...grpc.CallOption) (*RoleGrant, error) {
+	out := new(RoleGrant)
+	err := c.cc.Invoke(ctx, "/elevation_v1.ElevationV1/DenyElevation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ElevationV1Server is the server API for ElevationV1 service.
+// All implementations must embed UnimplementedElevationV1Server
+// for forward compatibility
+type ElevationV1Server interface {
+	// RequestElevation creates request of caller for time-bound grant of role
+	RequestElevation(context.Context, *RequestElevationRequest) (*RoleGrant, error)
+	// ListElevations returns role grants, approvers see grants of all users
+	ListElevations(context.Context, *ListElevationsRequest) (*ListElevationsResponse, error)
+	// ApproveElevation approves elevation request, it requires approver
+	ApproveElevation(context.Context, *ApproveElevationRequest) (*RoleGrant, error)
+	// DenyElevation denies elevation request, it requires approver
+	DenyElevation(context.Context, *DenyElevationRequest) (*RoleGrant, error)
+	mustEmbedUnimplementedElevationV1Server()
+}
+
+// UnimplementedElevationV1Server must be embedded to have forward compatible implementations.
+type UnimplementedElevationV1Server struct {
+}
+
+func (UnimplementedElevationV1Server) RequestElevation(context.Context, *RequestElevationRequest) (*RoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestElevation not implemented")
+}
+func (UnimplementedElevationV1Server) ListElevations(context.Context, *ListElevationsRequest) (*ListElevationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListElevations not implemented")
+}
+func (UnimplementedElevationV1Server) ApproveElevation(context.Context, *ApproveElevationRequest) (*RoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveElevation not implemented")
+}
+func (UnimplementedElevationV1Server) DenyElevation(context.Context, *DenyElevationRequest) (*RoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyElevation not implemented")
+}
+func (UnimplementedElevationV1Server) mustEmbedUnimplementedElevationV1Server() {}
+
+// UnsafeElevationV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ElevationV1Server will
+// result in compilation errors.
+type UnsafeElevationV1Server interface {
+	mustEmbedUnimplementedElevationV1Server()
+}
+
+func RegisterElevationV1Server(s grpc.ServiceRegistrar, srv ElevationV1Server) {
+	s.RegisterService(&ElevationV1_ServiceDesc, srv)
+}
+
+func _ElevationV1_RequestElevation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationV1Server).RequestElevation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elevation_v1.ElevationV1/RequestElevation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationV1Server).RequestElevation(ctx, req.(*RequestElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationV1_ListElevations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListElevationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationV1Server).ListElevations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elevation_v1.ElevationV1/ListElevations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationV1Server).ListElevations(ctx, req.(*ListElevationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationV1_ApproveElevation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationV1Server).ApproveElevation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elevation_v1.ElevationV1/ApproveElevation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationV1Server).ApproveElevation(ctx, req.(*ApproveElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationV1_DenyElevation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationV1Server).DenyElevation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elevation_v1.ElevationV1/DenyElevation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationV1Server).DenyElevation(ctx, req.(*DenyElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ElevationV1_ServiceDesc is the grpc.ServiceDesc for ElevationV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ElevationV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elevation_v1.ElevationV1",
+	HandlerType: (*ElevationV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestElevation",
+			Handler:    _ElevationV1_RequestElevation_Handler,
+		},
+		{
+			MethodName: "ListElevations",
+			Handler:    _ElevationV1_ListElevations_Handler,
+		},
+		{
+			MethodName: "ApproveElevation",
+			Handler:    _ElevationV1_ApproveElevation_Handler,
+		},
+		{
+			MethodName: "DenyElevation",
+			Handler:    _ElevationV1_DenyElevation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "elevation.proto",
+}
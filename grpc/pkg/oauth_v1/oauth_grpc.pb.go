@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.0--rc1
+// source: oauth.proto
+
+package oauth_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OAuthV1Client is the client API for OAuthV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OAuthV1Client interface {
+	// CreateClient registers new public OAuth client
+	CreateClient(ctx context.Context, in *CreateClientRequest, opts ...grpc.CallOption) (*Client, error)
+	// ListClients returns OAuth clients of organization
+	ListClients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListClientsResponse, error)
+	// DeleteClient deletes OAuth client with its issued authorization codes
+	DeleteClient(ctx context.Context, in *DeleteClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateServiceAccount creates service account authenticating with client credentials grant,
+	// client secret is returned only once
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountCredentials, error)
+	// ListServiceAccounts returns service accounts of organization
+	ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// RotateServiceAccountSecret replaces client secret of service account,
+	// previous secret stops working immediately
+	RotateServiceAccountSecret(ctx context.Context, in *RotateServiceAccountSecretRequest, opts ...grpc.CallOption) (*ServiceAccountCredentials, error)
+	// DisableServiceAccount disables service account and revokes its access tokens
+	DisableServiceAccount(ctx context.Context, in *DisableServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type oAuthV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOAuthV1Client(cc grpc.ClientConnInterface) OAuthV1Client {
+	return &oAuthV1Client{cc}
+}
+
+func (c *oAuthV1Client) CreateClient(ctx context.Context, in *CreateClientRequest, opts ...grpc.CallOption) (*Client, error) {
+	out := new(Client)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/CreateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) ListClients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/ListClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) DeleteClient(ctx context.Context, in *DeleteClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/DeleteClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountCredentials, error) {
+	out := new(ServiceAccountCredentials)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/CreateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/ListServiceAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) RotateServiceAccountSecret(ctx context.Context, in *RotateServiceAccountSecretRequest, opts ...grpc.CallOption) (*ServiceAccountCredentials, error) {
+	out := new(ServiceAccountCredentials)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/RotateServiceAccountSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) DisableServiceAccount(ctx context.Context, in *DisableServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/oauth_v1.OAuthV1/DisableServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuthV1Server is the server API for OAuthV1 service.
+// All implementations must embed UnimplementedOAuthV1Server
+// for forward compatibility
+type OAuthV1Server interface {
+	// CreateClient registers new public OAuth client
+	CreateClient(context.Context, *CreateClientRequest) (*Client, error)
+	// ListClients returns OAuth clients of organization
+	ListClients(context.Context, *emptypb.Empty) (*ListClientsResponse, error)
+	// DeleteClient deletes OAuth client with its issued authorization codes
+	DeleteClient(context.Context, *DeleteClientRequest) (*emptypb.Empty, error)
+	// CreateServiceAccount creates service account authenticating with client credentials grant,
+	// client secret is returned only once
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountCredentials, error)
+	// ListServiceAccounts returns service accounts of organization
+	ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error)
+	// RotateServiceAccountSecret replaces client secret of service account,
+	// previous secret stops working immediately
+	RotateServiceAccountSecret(context.Context, *RotateServiceAccountSecretRequest) (*ServiceAccountCredentials, error)
+	// DisableServiceAccount disables service account and revokes its access tokens
+	DisableServiceAccount(context.Context, *DisableServiceAccountRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedOAuthV1Server()
+}
+
+// UnimplementedOAuthV1Server must be embedded to have forward compatible implementations.
+type UnimplementedOAuthV1Server struct {
+}
+
+func (UnimplementedOAuthV1Server) CreateClient(context.Context, *CreateClientRequest) (*Client, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClient not implemented")
+}
+func (UnimplementedOAuthV1Server) ListClients(context.Context, *emptypb.Empty) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedOAuthV1Server) DeleteClient(context.Context, *DeleteClientRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClient not implemented")
+}
+func (UnimplementedOAuthV1Server) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedOAuthV1Server) ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedOAuthV1Server) RotateServiceAccountSecret(context.Context, *RotateServiceAccountSecretRequest) (*ServiceAccountCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceAccountSecret not implemented")
+}
+func (UnimplementedOAuthV1Server) DisableServiceAccount(context.Context, *DisableServiceAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableServiceAccount not implemented")
+}
+func (UnimplementedOAuthV1Server) mustEmbedUnimplementedOAuthV1Server() {}
+
+// UnsafeOAuthV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OAuthV1Server will
+// result in compilation errors.
+type UnsafeOAuthV1Server interface {
+	mustEmbedUnimplementedOAuthV1Server()
+}
+
+func RegisterOAuthV1Server(s grpc.ServiceRegistrar, srv OAuthV1Server) {
+	s.RegisterService(&OAuthV1_ServiceDesc, srv)
+}
+
+func _OAuthV1_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/CreateClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).CreateClient(ctx, req.(*CreateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/ListClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).ListClients(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_DeleteClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).DeleteClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/DeleteClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).DeleteClient(ctx, req.(*DeleteClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/CreateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/ListServiceAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).ListServiceAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_RotateServiceAccountSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceAccountSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).RotateServiceAccountSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/RotateServiceAccountSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).RotateServiceAccountSecret(ctx, req.(*RotateServiceAccountSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_DisableServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).DisableServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oauth_v1.OAuthV1/DisableServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).DisableServiceAccount(ctx, req.(*DisableServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OAuthV1_ServiceDesc is the grpc.ServiceDesc for OAuthV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OAuthV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "oauth_v1.OAuthV1",
+	HandlerType: (*OAuthV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClient",
+			Handler:    _OAuthV1_CreateClient_Handler,
+		},
+		{
+			MethodName: "ListClients",
+			Handler:    _OAuthV1_ListClients_Handler,
+		},
+		{
+			MethodName: "DeleteClient",
+			Handler:    _OAuthV1_DeleteClient_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _OAuthV1_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _OAuthV1_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "RotateServiceAccountSecret",
+			Handler:    _OAuthV1_RotateServiceAccountSecret_Handler,
+		},
+		{
+			MethodName: "DisableServiceAccount",
+			Handler:    _OAuthV1_DisableServiceAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oauth.proto",
+}
@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.0--rc1
+// source: privacy.proto
+
+package privacy_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PrivacyV1Client is the client API for PrivacyV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PrivacyV1Client interface {
+	// ExportMyData returns all data stored about caller as JSON document
+	ExportMyData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportMyDataResponse, error)
+	// EraseUser creates erasure request, user is erased after admin approval
+	EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureRequest, error)
+	// GetErasureRequest returns erasure request
+	GetErasureRequest(ctx context.Context, in *GetErasureRequestRequest, opts ...grpc.CallOption) (*ErasureRequest, error)
+	// ApproveErasure approves erasure request and erases user
+	ApproveErasure(ctx context.Context, in *ApproveErasureRequest, opts ...grpc.CallOption) (*ErasureRequest, error)
+	// RejectErasure rejects erasure request
+	RejectErasure(ctx context.Context, in *RejectErasureRequest, opts ...grpc.CallOption) (*ErasureRequest, error)
+}
+
+type privacyV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrivacyV1Client(cc grpc.ClientConnInterface) PrivacyV1Client {
+	return &privacyV1Client{cc}
+}
+
+func (c *privacyV1Client) ExportMyData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportMyDataResponse, error) {
+	out := new(ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, "/privacy_v1.PrivacyV1/ExportMyData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyV1Client) EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureRequest, error) {
+	out := new(ErasureRequest)
+	err := c.cc.Invoke(ctx, "/privacy_v1.PrivacyV1/EraseUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyV1Client) GetErasureRequest(ctx context.Context, in *GetErasureRequestRequest, opts ...grpc.CallOption) (*ErasureRequest, error) {
+	out := new(ErasureRequest)
+	err := c.cc.Invoke(ctx, "/privacy_v1.PrivacyV1/GetErasureRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyV1Client) ApproveErasure(ctx context.Context, in *ApproveErasureRequest, opts ...grpc.CallOption) (*ErasureRequest, error) {
+	out := new(ErasureRequest)
+	err := c.cc.Invoke(ctx, "/privacy_v1.PrivacyV1/ApproveErasure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyV1Client) RejectErasure(ctx context.Context, in *RejectErasureRequest, opts ...grpc.CallOption) (*ErasureRequest, error) {
+	out := new(ErasureRequest)
+	err := c.cc.Invoke(ctx, "/privacy_v1.PrivacyV1/RejectErasure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivacyV1Server is the server API for PrivacyV1 service.
+// All implementations must embed UnimplementedPrivacyV1Server
+// for forward compatibility
+type PrivacyV1Server interface {
+	// ExportMyData returns all data stored about caller as JSON document
+	ExportMyData(context.Context, *emptypb.Empty) (*ExportMyDataResponse, error)
+	// EraseUser creates erasure request, user is erased after admin approval
+	EraseUser(context.Context, *EraseUserRequest) (*ErasureRequest, error)
+	// GetErasureRequest returns erasure request
+	GetErasureRequest(context.Context, *GetErasureRequestRequest) (*ErasureRequest, error)
+	// ApproveErasure approves erasure request and erases user
+	ApproveErasure(context.Context, *ApproveErasureRequest) (*ErasureRequest, error)
+	// RejectErasure rejects erasure request
+	RejectErasure(context.Context, *RejectErasureRequest) (*ErasureRequest, error)
+	mustEmbedUnimplementedPrivacyV1Server()
+}
+
+// UnimplementedPrivacyV1Server must be embedded to have forward compatible implementations.
+type UnimplementedPrivacyV1Server struct {
+}
+
+func (UnimplementedPrivacyV1Server) ExportMyData(context.Context, *emptypb.Empty) (*ExportMyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedPrivacyV1Server) EraseUser(context.Context, *EraseUserRequest) (*ErasureRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (UnimplementedPrivacyV1Server) GetErasureRequest(context.Context, *GetErasureRequestRequest) (*ErasureRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErasureRequest not implemented")
+}
+func (UnimplementedPrivacyV1Server) ApproveErasure(context.Context, *ApproveErasureRequest) (*ErasureRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveErasure not implemented")
+}
+func (UnimplementedPrivacyV1Server) RejectErasure(context.Context, *RejectErasureRequest) (*ErasureRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectErasure not implemented")
+}
+func (UnimplementedPrivacyV1Server) mustEmbedUnimplementedPrivacyV1Server() {}
+
+// UnsafePrivacyV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrivacyV1Server will
+// result in compilation errors.
+type UnsafePrivacyV1Server interface {
+	mustEmbedUnimplementedPrivacyV1Server()
+}
+
+func RegisterPrivacyV1Server(s grpc.ServiceRegistrar, srv PrivacyV1Server) {
+	s.RegisterService(&PrivacyV1_ServiceDesc, srv)
+}
+
+func _PrivacyV1_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyV1Server).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/privacy_v1.PrivacyV1/ExportMyData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyV1Server).ExportMyData(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyV1_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyV1Server).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/privacy_v1.PrivacyV1/EraseUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyV1Server).EraseUser(ctx, req.(*EraseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyV1_GetErasureRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetErasureRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyV1Server).GetErasureRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/privacy_v1.PrivacyV1/GetErasureRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyV1Server).GetErasureRequest(ctx, req.(*GetErasureRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyV1_ApproveErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveErasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyV1Server).ApproveErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/privacy_v1.PrivacyV1/ApproveErasure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyV1Server).ApproveErasure(ctx, req.(*ApproveErasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyV1_RejectErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectErasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyV1Server).RejectErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/privacy_v1.PrivacyV1/RejectErasure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyV1Server).RejectErasure(ctx, req.(*RejectErasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrivacyV1_ServiceDesc is the grpc.ServiceDesc for PrivacyV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrivacyV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "privacy_v1.PrivacyV1",
+	HandlerType: (*PrivacyV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportMyData",
+			Handler:    _PrivacyV1_ExportMyData_Handler,
+		},
+		{
+			MethodName: "EraseUser",
+			Handler:    _PrivacyV1_EraseUser_Handler,
+		},
+		{
+			MethodName: "GetErasureRequest",
+			Handler:    _PrivacyV1_GetErasureRequest_Handler,
+		},
+		{
+			MethodName: "ApproveErasure",
+			Handler:    _PrivacyV1_ApproveErasure_Handler,
+		},
+		{
+			MethodName: "RejectErasure",
+			Handler:    _PrivacyV1_RejectErasure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "privacy.proto",
+}
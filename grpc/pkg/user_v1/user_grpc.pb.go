@@ -27,10 +27,23 @@ type UserV1Client interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	// GetUser returns user
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// GetUserByName returns user by name
+	GetUserByName(ctx context.Context, in *GetUserByNameRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// GetUserByEmail returns user by e-mail
+	GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// BatchGetUsers returns users in order of requested IDs and reports missing IDs
+	BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersResponse, error)
 	// UpdateUser updates user
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteUser deletes existing user
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImportUsers uploads users file and starts import operation,
+	// first message must contain options, next messages contain file chunks
+	ImportUsers(ctx context.Context, opts ...grpc.CallOption) (UserV1_ImportUsersClient, error)
+	// GetOperation returns state of import operation
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// ExportUsers streams all users of organization
+	ExportUsers(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (UserV1_ExportUsersClient, error)
 }
 
 type userV1Client struct {
@@ -59,6 +72,33 @@ func (c *userV1Client) GetUser(ctx context.Context, in *GetUserRequest, opts ...
 	return out, nil
 }
 
+func (c *userV1Client) GetUserByName(ctx context.Context, in *GetUserByNameRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserV1/GetUserByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserV1/GetUserByEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersResponse, error) {
+	out := new(BatchGetUsersResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserV1/BatchGetUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userV1Client) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/user_v1.UserV1/UpdateUser", in, out, opts...)
@@ -77,6 +117,81 @@ func (c *userV1Client) DeleteUser(ctx context.Context, in *DeleteUserRequest, op
 	return out, nil
 }
 
+func (c *userV1Client) ImportUsers(ctx context.Context, opts ...grpc.CallOption) (UserV1_ImportUsersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserV1_ServiceDesc.Streams[0], "/user_v1.UserV1/ImportUsers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userV1ImportUsersClient{stream}
+	return x, nil
+}
+
+type UserV1_ImportUsersClient interface {
+	Send(*ImportUsersRequest) error
+	CloseAndRecv() (*Operation, error)
+	grpc.ClientStream
+}
+
+type userV1ImportUsersClient struct {
+	grpc.ClientStream
+}
+
+func (x *userV1ImportUsersClient) Send(m *ImportUsersRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *userV1ImportUsersClient) CloseAndRecv() (*Operation, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Operation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userV1Client) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/user_v1.UserV1/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) ExportUsers(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (UserV1_ExportUsersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserV1_ServiceDesc.Streams[1], "/user_v1.UserV1/ExportUsers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userV1ExportUsersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserV1_ExportUsersClient interface {
+	Recv() (*ExportUsersResponse, error)
+	grpc.ClientStream
+}
+
+type userV1ExportUsersClient struct {
+	grpc.ClientStream
+}
+
+func (x *userV1ExportUsersClient) Recv() (*ExportUsersResponse, error) {
+	m := new(ExportUsersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UserV1Server is the server API for UserV1 service.
 // All implementations must embed UnimplementedUserV1Server
 // for forward compatibility
@@ -85,10 +200,23 @@ type UserV1Server interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	// GetUser returns user
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// GetUserByName returns user by name
+	GetUserByName(context.Context, *GetUserByNameRequest) (*GetUserResponse, error)
+	// GetUserByEmail returns user by e-mail
+	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*GetUserResponse, error)
+	// BatchGetUsers returns users in order of requested IDs and reports missing IDs
+	BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchGetUsersResponse, error)
 	// UpdateUser updates user
 	UpdateUser(context.Context, *UpdateUserRequest) (*emptypb.Empty, error)
 	// DeleteUser deletes existing user
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// ImportUsers uploads users file and starts import operation,
+	// first message must contain options, next messages contain file chunks
+	ImportUsers(UserV1_ImportUsersServer) error
+	// GetOperation returns state of import operation
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	// ExportUsers streams all users of organization
+	ExportUsers(*ExportUsersRequest, UserV1_ExportUsersServer) error
 	mustEmbedUnimplementedUserV1Server()
 }
 
@@ -102,12 +230,30 @@ func (UnimplementedUserV1Server) CreateUser(context.Context, *CreateUserRequest)
 func (UnimplementedUserV1Server) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
+func (UnimplementedUserV1Server) GetUserByName(context.Context, *GetUserByNameRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByName not implemented")
+}
+func (UnimplementedUserV1Server) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedUserV1Server) BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchGetUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUsers not implemented")
+}
 func (UnimplementedUserV1Server) UpdateUser(context.Context, *UpdateUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
 func (UnimplementedUserV1Server) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserV1Server) ImportUsers(UserV1_ImportUsersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportUsers not implemented")
+}
+func (UnimplementedUserV1Server) GetOperation(context.Context, *GetOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedUserV1Server) ExportUsers(*ExportUsersRequest, UserV1_ExportUsersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUsers not implemented")
+}
 func (UnimplementedUserV1Server) mustEmbedUnimplementedUserV1Server() {}
 
 // UnsafeUserV1Server may be embedded to opt out of forward compatibility for this service.
@@ -157,6 +303,60 @@ func _UserV1_GetUser_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserV1_GetUserByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).GetUserByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserV1/GetUserByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).GetUserByName(ctx, req.(*GetUserByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserV1/GetUserByEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_BatchGetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).BatchGetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserV1/BatchGetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).BatchGetUsers(ctx, req.(*BatchGetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserV1_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
@@ -193,6 +393,71 @@ func _UserV1_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserV1_ImportUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserV1Server).ImportUsers(&userV1ImportUsersServer{stream})
+}
+
+type UserV1_ImportUsersServer interface {
+	SendAndClose(*Operation) error
+	Recv() (*ImportUsersRequest, error)
+	grpc.ServerStream
+}
+
+type userV1ImportUsersServer struct {
+	grpc.ServerStream
+}
+
+func (x *userV1ImportUsersServer) SendAndClose(m *Operation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *userV1ImportUsersServer) Recv() (*ImportUsersRequest, error) {
+	m := new(ImportUsersRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UserV1_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserV1/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_ExportUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserV1Server).ExportUsers(m, &userV1ExportUsersServer{stream})
+}
+
+type UserV1_ExportUsersServer interface {
+	Send(*ExportUsersResponse) error
+	grpc.ServerStream
+}
+
+type userV1ExportUsersServer struct {
+	grpc.ServerStream
+}
+
+func (x *userV1ExportUsersServer) Send(m *ExportUsersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // UserV1_ServiceDesc is the grpc.ServiceDesc for UserV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +473,18 @@ var UserV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUser",
 			Handler:    _UserV1_GetUser_Handler,
 		},
+		{
+			MethodName: "GetUserByName",
+			Handler:    _UserV1_GetUserByName_Handler,
+		},
+		{
+			MethodName: "GetUserByEmail",
+			Handler:    _UserV1_GetUserByEmail_Handler,
+		},
+		{
+			MethodName: "BatchGetUsers",
+			Handler:    _UserV1_BatchGetUsers_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _UserV1_UpdateUser_Handler,
@@ -216,7 +493,22 @@ var UserV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserV1_DeleteUser_Handler,
 		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _UserV1_GetOperation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportUsers",
+			Handler:       _UserV1_ImportUsers_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportUsers",
+			Handler:       _UserV1_ExportUsers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
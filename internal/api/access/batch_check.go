@@ -0,0 +1,39 @@
+package access
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// BatchCheck checks user access to several endpoints, denied access is returned
+// as result of item
+func (i *Implementation) BatchCheck(ctx context.Context, req *access_v1.BatchCheckRequest) (
+	*access_v1.BatchCheckResponse, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "batch check access api")
+	defer span.Finish()
+
+	err := validateBatchCheck(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	checks := converter.ToAccessChecksFromV1(req)
+	decisions, err := i.accessService.BatchCheck(ctx, token, checks)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	return converter.ToBatchCheckResponseV1FromService(checks, decisions), nil
+}
@@ -8,9 +8,10 @@ import (
 
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/valek177/auth/grpc/pkg/access_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
 )
 
 const (
@@ -18,9 +19,10 @@ const (
 	authPrefix     = "Bearer "
 )
 
-// Check checks user access to resource
+// Check checks user access to resource, denied access is returned as error
+// unless explanation is requested
 func (i *Implementation) Check(ctx context.Context, req *access_v1.CheckRequest) (
-	*emptypb.Empty, error,
+	*access_v1.CheckResponse, error,
 ) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "check access api")
 	defer span.Finish()
@@ -46,13 +48,17 @@ func (i *Implementation) Check(ctx context.Context, req *access_v1.CheckRequest)
 
 	accessToken := strings.TrimPrefix(authHeader[0], authPrefix)
 
-	hasAccess, err := i.accessService.Check(ctx, accessToken, req.GetEndpointAddress())
+	decision, err := i.accessService.Check(ctx, accessToken, req.GetEndpointAddress(),
+		req.GetAttributes(), req.GetExplain())
+	if errors.Is(err, model.ErrorUnauthenticated) {
+		return nil, serviceError(err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("check access error: %v", err.Error())
 	}
-	if !hasAccess {
-		return nil, errors.New("access denied")
+	if !decision.Allowed && !req.GetExplain() {
+		return nil, fmt.Errorf("access denied: %s", decision.Reason)
 	}
 
-	return &emptypb.Empty{}, nil
+	return converter.ToCheckResponseV1FromService(decision), nil
 }
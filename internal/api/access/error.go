@@ -0,0 +1,21 @@
+package access
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// serviceError returns error of access service, invalid or revoked token
+// is returned as Unauthenticated status so clients can tell it from other errors,
+// e.g. RemoteVerifier of pkg/authclient rejects such caller instead of failing
+// with internal error
+func serviceError(err error) error {
+	if errors.Is(err, model.ErrorUnauthenticated) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return errors.WithStack(err)
+}
@@ -0,0 +1,37 @@
+package access
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListPermissions returns roles, permissions and endpoint patterns allowed to user
+func (i *Implementation) ListPermissions(ctx context.Context,
+	req *access_v1.ListPermissionsRequest,
+) (*access_v1.ListPermissionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list permissions api")
+	defer span.Finish()
+
+	err := validateListPermissions(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	permissions, err := i.accessService.ListPermissions(ctx, token, req.GetAttributes())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	return converter.ToListPermissionsResponseV1FromService(permissions), nil
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/valek177/auth/grpc/pkg/access_v1"
+	"github.com/valek177/auth/internal/api/access"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service"
+)
+
+// accessService returns decisions or error of batch check
+type accessService struct {
+	service.AccessService
+	decisions []*model.AccessDecision
+	err       error
+}
+
+func (s accessService) BatchCheck(_ context.Context, _ string, _ []*model.AccessCheck) (
+	[]*model.AccessDecision, error,
+) {
+	return s.decisions, s.err
+}
+
+func TestBatchCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("authorization", "Bearer token"))
+
+		req = &access_v1.BatchCheckRequest{
+			Checks: []*access_v1.BatchCheckItem{{EndpointAddress: "/user_v1.UserV1/GetUser"}},
+		}
+
+		serviceErr = fmt.Errorf("service error")
+	)
+
+	tests := []struct {
+		name    string
+		service accessService
+		allowed bool
+		code    codes.Code
+	}{
+		{
+			name: "success case: allowed",
+			service: accessService{
+				decisions: []*model.AccessDecision{{Allowed: true}},
+			},
+			allowed: true,
+			code:    codes.OK,
+		},
+		{
+			name: "success case: denied access is result",
+			service: accessService{
+				decisions: []*model.AccessDecision{{Reason: "no rule allows access"}},
+			},
+			code: codes.OK,
+		},
+		{
+			name: "error: invalid token is unauthenticated",
+			service: accessService{
+				err: errors.Wrap(model.ErrorUnauthenticated, "token is expired"),
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:    "error: service error",
+			service: accessService{err: serviceErr},
+			code:    codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := access.NewImplementation(tt.service).BatchCheck(ctx, req)
+
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code != codes.OK {
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.GetResults(), 1)
+			assert.Equal(t, tt.allowed, res.GetResults()[0].GetAllowed())
+		})
+	}
+}
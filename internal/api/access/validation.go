@@ -13,3 +13,19 @@ func validateCheck(req *access_v1.CheckRequest) error {
 
 	return nil
 }
+
+func validateBatchCheck(req *access_v1.BatchCheckRequest) error {
+	if req == nil {
+		return errors.New("unable to check access: empty request")
+	}
+
+	return nil
+}
+
+func validateListPermissions(req *access_v1.ListPermissionsRequest) error {
+	if req == nil {
+		return errors.New("unable to list permissions: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/policybundle"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ApplyPolicy replaces policy of organization with policy bundle
+func (i *Implementation) ApplyPolicy(ctx context.Context, req *access_admin_v1.ApplyPolicyRequest) (
+	*access_admin_v1.ApplyPolicyResponse, error,
+) {
+	err := validateApplyPolicy(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	bundle, err := policybundle.Parse(req.GetDocument())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res, err := i.policyService.ApplyPolicy(ctx, token, bundle, req.GetDryRun())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToApplyPolicyResponseV1FromService(res), nil
+}
@@ -0,0 +1,36 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// CreatePermission creates new permission
+func (i *Implementation) CreatePermission(ctx context.Context,
+	req *access_admin_v1.CreatePermissionRequest,
+) (*access_admin_v1.CreatePermissionResponse, error) {
+	err := validateCreatePermission(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := i.policyService.CreatePermission(ctx, token,
+		converter.ToPermissionFromCreatePermissionV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &access_admin_v1.CreatePermissionResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// CreateRule requires permission for endpoint
+func (i *Implementation) CreateRule(ctx context.Context, req *access_admin_v1.CreateRuleRequest) (
+	*access_admin_v1.CreateRuleResponse, error,
+) {
+	err := validateCreateRule(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := i.policyService.CreateRule(ctx, token, converter.ToAccessRuleFromCreateRuleV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &access_admin_v1.CreateRuleResponse{
+		Id: id,
+	}, nil
+}
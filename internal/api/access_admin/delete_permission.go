@@ -0,0 +1,33 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// DeletePermission deletes permission with its grants and access rules
+func (i *Implementation) DeletePermission(ctx context.Context,
+	req *access_admin_v1.DeletePermissionRequest,
+) (*emptypb.Empty, error) {
+	err := validateDeletePermission(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.policyService.DeletePermission(ctx, token, req.GetName())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
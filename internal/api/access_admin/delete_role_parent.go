@@ -0,0 +1,37 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// DeleteRoleParent removes inheritance of parent role
+func (i *Implementation) DeleteRoleParent(ctx context.Context,
+	req *access_admin_v1.DeleteRoleParentRequest,
+) (*emptypb.Empty, error) {
+	err := validateDeleteRoleParent(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.policyService.DeleteRoleParent(ctx, token, &model.RoleParent{
+		Role:   req.GetRole(),
+		Parent: req.GetParent(),
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
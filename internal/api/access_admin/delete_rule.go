@@ -0,0 +1,33 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// DeleteRule deletes access rule
+func (i *Implementation) DeleteRule(ctx context.Context, req *access_admin_v1.DeleteRuleRequest) (
+	*emptypb.Empty, error,
+) {
+	err := validateDeleteRule(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.policyService.DeleteRule(ctx, token, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,43 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/policybundle"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ExportPolicy returns current policy of organization as policy bundle document
+func (i *Implementation) ExportPolicy(ctx context.Context,
+	req *access_admin_v1.ExportPolicyRequest,
+) (*access_admin_v1.ExportPolicyResponse, error) {
+	err := validateExportPolicy(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	bundle, err := i.policyService.ExportPolicy(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	format := converter.ToPolicyBundleFormatFromV1(req.GetFormat())
+	document, err := policybundle.Marshal(bundle, format)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &access_admin_v1.ExportPolicyResponse{
+		ContentType: policybundle.ContentType(format),
+		Document:    document,
+	}, nil
+}
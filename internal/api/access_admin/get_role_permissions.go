@@ -0,0 +1,33 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// GetRolePermissions returns parents, direct and effective permissions of role
+func (i *Implementation) GetRolePermissions(ctx context.Context,
+	req *access_admin_v1.GetRolePermissionsRequest,
+) (*access_admin_v1.GetRolePermissionsResponse, error) {
+	err := validateGetRolePermissions(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	permissions, err := i.policyService.GetRolePermissions(ctx, token, req.GetRole())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToGetRolePermissionsResponseV1FromService(permissions), nil
+}
@@ -0,0 +1,37 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// GrantPermission grants permission to role
+func (i *Implementation) GrantPermission(ctx context.Context,
+	req *access_admin_v1.GrantPermissionRequest,
+) (*emptypb.Empty, error) {
+	err := validateGrantPermission(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.policyService.GrantPermission(ctx, token, &model.RolePermission{
+		Role:       req.GetRole(),
+		Permission: req.GetPermission(),
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
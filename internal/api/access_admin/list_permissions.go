@@ -0,0 +1,31 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListPermissions returns permissions of organization
+func (i *Implementation) ListPermissions(ctx context.Context, _ *emptypb.Empty) (
+	*access_admin_v1.ListPermissionsResponse, error,
+) {
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	permissions, err := i.policyService.ListPermissions(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &access_admin_v1.ListPermissionsResponse{
+		Permissions: converter.ToPermissionsV1FromService(permissions),
+	}, nil
+}
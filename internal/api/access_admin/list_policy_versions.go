@@ -0,0 +1,31 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListPolicyVersions returns applied policy versions of organization
+func (i *Implementation) ListPolicyVersions(ctx context.Context, _ *emptypb.Empty) (
+	*access_admin_v1.ListPolicyVersionsResponse, error,
+) {
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	versions, err := i.policyService.ListPolicyVersions(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &access_admin_v1.ListPolicyVersionsResponse{
+		Versions: converter.ToPolicyVersionsV1FromService(versions),
+	}, nil
+}
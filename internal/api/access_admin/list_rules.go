@@ -0,0 +1,36 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListRules returns access rules of organization
+func (i *Implementation) ListRules(ctx context.Context, req *access_admin_v1.ListRulesRequest) (
+	*access_admin_v1.ListRulesResponse, error,
+) {
+	err := validateListRules(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rules, err := i.policyService.ListRules(ctx, token,
+		converter.ToAccessRuleFilterFromListRulesV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &access_admin_v1.ListRulesResponse{
+		Rules: converter.ToRulesV1FromService(rules),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// RollbackPolicy applies policy bundle of stored version as new policy version
+func (i *Implementation) RollbackPolicy(ctx context.Context,
+	req *access_admin_v1.RollbackPolicyRequest,
+) (*access_admin_v1.ApplyPolicyResponse, error) {
+	err := validateRollbackPolicy(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res, err := i.policyService.RollbackPolicy(ctx, token, req.GetVersion(), req.GetDryRun())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToApplyPolicyResponseV1FromService(res), nil
+}
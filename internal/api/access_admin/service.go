@@ -0,0 +1,19 @@
+package access_admin
+
+import (
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	access_admin_v1.UnimplementedAccessAdminV1Server
+	policyService service.PolicyService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(policyService service.PolicyService) *Implementation {
+	return &Implementation{
+		policyService: policyService,
+	}
+}
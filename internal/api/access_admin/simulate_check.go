@@ -0,0 +1,34 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// SimulateCheck explains access of user or role to endpoint without its access token
+func (i *Implementation) SimulateCheck(ctx context.Context,
+	req *access_admin_v1.SimulateCheckRequest,
+) (*access_admin_v1.SimulateCheckResponse, error) {
+	err := validateSimulateCheck(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	decision, err := i.policyService.SimulateCheck(ctx, token,
+		converter.ToAccessSimulationFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToSimulateCheckResponseV1FromService(decision), nil
+}
@@ -0,0 +1,34 @@
+package access_admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// UpdateRule updates access rule
+func (i *Implementation) UpdateRule(ctx context.Context, req *access_admin_v1.UpdateRuleRequest) (
+	*emptypb.Empty, error,
+) {
+	err := validateUpdateRule(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.policyService.UpdateRule(ctx, token, converter.ToUpdateAccessRuleFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
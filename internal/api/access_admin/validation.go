@@ -0,0 +1,127 @@
+package access_admin
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+)
+
+func validateCreateRule(req *access_admin_v1.CreateRuleRequest) error {
+	if req == nil {
+		return errors.New("unable to create access rule: empty request")
+	}
+
+	return nil
+}
+
+func validateListRules(req *access_admin_v1.ListRulesRequest) error {
+	if req == nil {
+		return errors.New("unable to list access rules: empty request")
+	}
+
+	return nil
+}
+
+func validateUpdateRule(req *access_admin_v1.UpdateRuleRequest) error {
+	if req == nil {
+		return errors.New("unable to update access rule: empty request")
+	}
+
+	return nil
+}
+
+func validateDeleteRule(req *access_admin_v1.DeleteRuleRequest) error {
+	if req == nil {
+		return errors.New("unable to delete access rule: empty request")
+	}
+
+	return nil
+}
+
+func validateCreatePermission(req *access_admin_v1.CreatePermissionRequest) error {
+	if req == nil {
+		return errors.New("unable to create permission: empty request")
+	}
+
+	return nil
+}
+
+func validateDeletePermission(req *access_admin_v1.DeletePermissionRequest) error {
+	if req == nil {
+		return errors.New("unable to delete permission: empty request")
+	}
+
+	return nil
+}
+
+func validateGrantPermission(req *access_admin_v1.GrantPermissionRequest) error {
+	if req == nil {
+		return errors.New("unable to grant permission: empty request")
+	}
+
+	return nil
+}
+
+func validateRevokePermission(req *access_admin_v1.RevokePermissionRequest) error {
+	if req == nil {
+		return errors.New("unable to revoke permission: empty request")
+	}
+
+	return nil
+}
+
+func validateAddRoleParent(req *access_admin_v1.AddRoleParentRequest) error {
+	if req == nil {
+		return errors.New("unable to add role parent: empty request")
+	}
+
+	return nil
+}
+
+func validateDeleteRoleParent(req *access_admin_v1.DeleteRoleParentRequest) error {
+	if req == nil {
+		return errors.New("unable to delete role parent: empty request")
+	}
+
+	return nil
+}
+
+func validateGetRolePermissions(req *access_admin_v1.GetRolePermissionsRequest) error {
+	if req == nil {
+		return errors.New("unable to get role permissions: empty request")
+	}
+
+	return nil
+}
+
+func validateSimulateCheck(req *access_admin_v1.SimulateCheckRequest) error {
+	if req == nil {
+		return errors.New("unable to simulate access check: empty request")
+	}
+
+	return nil
+}
+
+func validateApplyPolicy(req *access_admin_v1.ApplyPolicyRequest) error {
+	if req == nil {
+		return errors.New("unable to apply policy: empty request")
+	}
+
+	return nil
+}
+
+func validateExportPolicy(req *access_admin_v1.ExportPolicyRequest) error {
+	if req == nil {
+		return errors.New("unable to export policy: empty request")
+	}
+
+	return nil
+}
+
+func validateRollbackPolicy(req *access_admin_v1.RollbackPolicyRequest) error {
+	if req == nil {
+		return errors.New("unable to rollback policy: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package elevation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// DenyElevation denies elevation request, it requires approver
+func (i *Implementation) DenyElevation(ctx context.Context,
+	req *elevation_v1.DenyElevationRequest,
+) (*elevation_v1.RoleGrant, error) {
+	err := validateDenyElevation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	grant, err := i.elevationService.DenyElevation(ctx, token, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToRoleGrantV1FromService(grant), nil
+}
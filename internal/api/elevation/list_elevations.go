@@ -0,0 +1,36 @@
+package elevation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListElevations returns role grants, approvers see grants of all users
+func (i *Implementation) ListElevations(ctx context.Context,
+	req *elevation_v1.ListElevationsRequest,
+) (*elevation_v1.ListElevationsResponse, error) {
+	err := validateListElevations(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	grants, err := i.elevationService.ListElevations(ctx, token,
+		converter.ToRoleGrantStatusFromV1(req.GetStatus()))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &elevation_v1.ListElevationsResponse{
+		Grants: converter.ToRoleGrantsV1FromService(grants),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package elevation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// RequestElevation creates request of caller for time-bound grant of role
+func (i *Implementation) RequestElevation(ctx context.Context,
+	req *elevation_v1.RequestElevationRequest,
+) (*elevation_v1.RoleGrant, error) {
+	err := validateRequestElevation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	grant, err := i.elevationService.RequestElevation(ctx, token,
+		converter.ToNewElevationFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToRoleGrantV1FromService(grant), nil
+}
@@ -0,0 +1,19 @@
+package elevation
+
+import (
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	elevation_v1.UnimplementedElevationV1Server
+	elevationService service.ElevationService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(elevationService service.ElevationService) *Implementation {
+	return &Implementation{
+		elevationService: elevationService,
+	}
+}
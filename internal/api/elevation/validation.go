@@ -0,0 +1,39 @@
+package elevation
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+)
+
+func validateRequestElevation(req *elevation_v1.RequestElevationRequest) error {
+	if req == nil {
+		return errors.New("unable to request elevation: empty request")
+	}
+
+	return nil
+}
+
+func validateListElevations(req *elevation_v1.ListElevationsRequest) error {
+	if req == nil {
+		return errors.New("unable to list elevations: empty request")
+	}
+
+	return nil
+}
+
+func validateApproveElevation(req *elevation_v1.ApproveElevationRequest) error {
+	if req == nil {
+		return errors.New("unable to approve elevation: empty request")
+	}
+
+	return nil
+}
+
+func validateDenyElevation(req *elevation_v1.DenyElevationRequest) error {
+	if req == nil {
+		return errors.New("unable to deny elevation: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ext_authz
+
+import (
+	"context"
+	"strings"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	authHeaderName = "authorization"
+	authPrefix     = "Bearer "
+)
+
+// Check authorizes HTTP request proxied by Envoy, context extensions of route
+// are attributes of request for conditions of access rules.
+// Request without valid access token is denied as unauthenticated,
+// request with malformed path is denied as bad request.
+func (i *Implementation) Check(ctx context.Context, req *authv3.CheckRequest) (
+	*authv3.CheckResponse, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ext authz check api")
+	defer span.Finish()
+
+	err := validateCheck(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	httpReq := req.GetAttributes().GetRequest().GetHttp()
+
+	authHeader := httpReq.GetHeaders()[authHeaderName]
+	if !strings.HasPrefix(authHeader, authPrefix) {
+		return converter.ToExtAuthzDeniedResponse(codes.Unauthenticated,
+			typev3.StatusCode_Unauthorized, "access token is not provided"), nil
+	}
+
+	endpoint, err := converter.ToAccessEndpointFromExtAuthz(httpReq)
+	if err != nil {
+		return converter.ToExtAuthzDeniedResponse(codes.InvalidArgument,
+			typev3.StatusCode_BadRequest, err.Error()), nil
+	}
+
+	authorization, err := i.accessService.Authorize(ctx, strings.TrimPrefix(authHeader, authPrefix),
+		endpoint, req.GetAttributes().GetContextExtensions())
+	if errors.Is(err, model.ErrorUnauthenticated) {
+		return converter.ToExtAuthzDeniedResponse(codes.Unauthenticated,
+			typev3.StatusCode_Unauthorized, "invalid access token"), nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToExtAuthzCheckResponseFromService(authorization), nil
+}
@@ -0,0 +1,20 @@
+package ext_authz
+
+import (
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains Envoy external authorization server
+type Implementation struct {
+	authv3.UnimplementedAuthorizationServer
+	accessService service.AccessService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(accessService service.AccessService) *Implementation {
+	return &Implementation{
+		accessService: accessService,
+	}
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/valek177/auth/internal/api/ext_authz"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service"
+)
+
+// accessService allows endpoint given to it only
+type accessService struct {
+	service.AccessService
+	endpoint string
+}
+
+func (s accessService) Authorize(_ context.Context, _ string, endpoint string,
+	_ map[string]string,
+) (*model.AccessAuthorization, error) {
+	decision := &model.AccessDecision{Allowed: endpoint == s.endpoint, Reason: endpoint}
+
+	return &model.AccessAuthorization{Claims: &model.UserClaims{}, Decision: decision}, nil
+}
+
+func checkRequest(method, path string) *authv3.CheckRequest {
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Method:  method,
+					Path:    path,
+					Headers: map[string]string{"authorization": "Bearer token"},
+				},
+			},
+		},
+	}
+}
+
+func TestCheckPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		endpoint string
+		path     string
+		code     codes.Code
+	}{
+		{
+			name:     "success case: canonical path",
+			endpoint: "GET /admin",
+			path:     "/admin?page=2",
+			code:     codes.OK,
+		},
+		{
+			name:     "success case: dot segments resolved",
+			endpoint: "GET /admin",
+			path:     "/a/../admin",
+			code:     codes.OK,
+		},
+		{
+			name:     "success case: repeated slashes collapsed",
+			endpoint: "GET /admin",
+			path:     "//admin",
+			code:     codes.OK,
+		},
+		{
+			name:     "success case: encoded slash decoded",
+			endpoint: "GET /admin",
+			path:     "/%2Fadmin",
+			code:     codes.OK,
+		},
+		{
+			name:     "success case: encoded dot segments resolved",
+			endpoint: "GET /admin",
+			path:     "/public/%2E%2E/admin",
+			code:     codes.OK,
+		},
+		{
+			name:     "success case: path under public prefix is not public",
+			endpoint: "GET /public/admin",
+			path:     "/public/%2E%2E/admin",
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "error: invalid percent encoding",
+			endpoint: "GET /admin",
+			path:     "/%zzadmin",
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "error: encoded backslash",
+			endpoint: "GET /admin",
+			path:     "/public/..%5Cadmin",
+			code:     codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := ext_authz.NewImplementation(accessService{endpoint: tt.endpoint})
+
+			res, err := api.Check(ctx, checkRequest("GET", tt.path))
+
+			require.NoError(t, err)
+			assert.Equal(t, int32(tt.code), res.GetStatus().GetCode(), res.GetStatus().GetMessage())
+		})
+	}
+}
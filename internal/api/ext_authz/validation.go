@@ -0,0 +1,14 @@
+package ext_authz
+
+import (
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/pkg/errors"
+)
+
+func validateCheck(req *authv3.CheckRequest) error {
+	if req.GetAttributes().GetRequest().GetHttp() == nil {
+		return errors.New("unable to authorize request: HTTP request is not provided")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// AcceptInvitation sets user password, activates user and returns tokens
+func (i *Implementation) AcceptInvitation(ctx context.Context,
+	req *invitation_v1.AcceptInvitationRequest,
+) (*invitation_v1.AcceptInvitationResponse, error) {
+	err := validateAcceptInvitation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	refreshToken, accessToken, err := i.invitationService.AcceptInvitation(ctx,
+		converter.ToAcceptInvitationFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &invitation_v1.AcceptInvitationResponse{
+		RefreshToken: refreshToken,
+		AccessToken:  accessToken,
+	}, nil
+}
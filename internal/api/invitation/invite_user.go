@@ -0,0 +1,28 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// InviteUser creates pending user and sends invitation link
+func (i *Implementation) InviteUser(ctx context.Context, req *invitation_v1.InviteUserRequest) (
+	*invitation_v1.InviteUserResponse, error,
+) {
+	err := validateInviteUser(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	invitation, err := i.invitationService.InviteUser(ctx,
+		converter.ToNewInvitationFromInviteUserV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToInviteUserResponseV1FromService(invitation), nil
+}
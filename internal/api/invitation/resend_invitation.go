@@ -0,0 +1,27 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+)
+
+// ResendInvitation sends new invitation link
+func (i *Implementation) ResendInvitation(ctx context.Context,
+	req *invitation_v1.ResendInvitationRequest,
+) (*emptypb.Empty, error) {
+	err := validateResendInvitation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.invitationService.ResendInvitation(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
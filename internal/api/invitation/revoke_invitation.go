@@ -0,0 +1,27 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+)
+
+// RevokeInvitation revokes invitation and removes pending user
+func (i *Implementation) RevokeInvitation(ctx context.Context,
+	req *invitation_v1.RevokeInvitationRequest,
+) (*emptypb.Empty, error) {
+	err := validateRevokeInvitation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.invitationService.RevokeInvitation(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
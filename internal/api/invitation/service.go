@@ -0,0 +1,19 @@
+package invitation
+
+import (
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	invitation_v1.UnimplementedInvitationV1Server
+	invitationService service.InvitationService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(invitationService service.InvitationService) *Implementation {
+	return &Implementation{
+		invitationService: invitationService,
+	}
+}
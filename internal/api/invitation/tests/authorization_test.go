@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+	"github.com/valek177/auth/internal/api/invitation"
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/interceptor"
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	accessService "github.com/valek177/auth/internal/service/access"
+	serviceMocks "github.com/valek177/auth/internal/service/mocks"
+	"github.com/valek177/auth/internal/utils"
+)
+
+const invitationServicePrefix = "/invitation_v1.InvitationV1/"
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+// newAccessService returns access service deciding by default access rules of organization
+func newAccessService(t *testing.T, mc *minimock.Controller, tokenAccess utils.Token,
+) service.AccessService {
+	rules := make([]*model.AccessRule, 0, len(model.DefaultAccessRules))
+	for i, rule := range model.DefaultAccessRules {
+		rule := *rule
+		rule.ID = int64(i + 1)
+		rules = append(rules, &rule)
+	}
+	m, err := matcher.New(rules)
+	require.NoError(t, err)
+
+	conditions, err := condition.NewEvaluator(1000)
+	require.NoError(t, err)
+
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Set(func(_ context.Context,
+		endpoint string,
+	) (*model.EndpointAccessRule, error) {
+		return m.Match(endpoint), nil
+	})
+
+	revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+	revocationMock.GetTokensRevokedAtMock.Optional().Return(time.Time{}, nil)
+
+	ancestors := map[string][]string{model.RoleAdmin: {model.RoleUser}}
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Set(func(_ context.Context, role string) (
+		[]string, error,
+	) {
+		return ancestors[role], nil
+	})
+
+	return accessService.NewService(accessMock, revocationMock, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, conditions))
+}
+
+func TestInvitationAuthorization(t *testing.T) {
+	t.Parallel()
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		ctx = context.Background()
+
+		tenantID = int64(4)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       7,
+				TenantID: tenantID,
+				Name:     "alice",
+				Role:     role,
+			})
+			require.NoError(t, err)
+			return str
+		}
+
+		inviteReq = &invitation_v1.InviteUserRequest{
+			Name:  "bob",
+			Email: "bob@example.com",
+			Role:  invitation_v1.Role_ADMIN,
+		}
+	)
+
+	type call struct {
+		method  string
+		req     interface{}
+		handler func(impl *invitation.Implementation) grpc.UnaryHandler
+		mock    func(mock *serviceMocks.InvitationServiceMock)
+	}
+	inviteUser := call{
+		method: invitationServicePrefix + "InviteUser",
+		req:    inviteReq,
+		handler: func(impl *invitation.Implementation) grpc.UnaryHandler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				return impl.InviteUser(ctx, req.(*invitation_v1.InviteUserRequest))
+			}
+		},
+		mock: func(mock *serviceMocks.InvitationServiceMock) {
+			mock.InviteUserMock.Return(&model.Invitation{ID: 3}, nil)
+		},
+	}
+	resendInvitation := call{
+		method: invitationServicePrefix + "ResendInvitation",
+		req:    &invitation_v1.ResendInvitationRequest{Id: 3},
+		handler: func(impl *invitation.Implementation) grpc.UnaryHandler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				return impl.ResendInvitation(ctx, req.(*invitation_v1.ResendInvitationRequest))
+			}
+		},
+		mock: func(mock *serviceMocks.InvitationServiceMock) {
+			mock.ResendInvitationMock.Return(nil)
+		},
+	}
+	revokeInvitation := call{
+		method: invitationServicePrefix + "RevokeInvitation",
+		req:    &invitation_v1.RevokeInvitationRequest{Id: 3},
+		handler: func(impl *invitation.Implementation) grpc.UnaryHandler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				return impl.RevokeInvitation(ctx, req.(*invitation_v1.RevokeInvitationRequest))
+			}
+		},
+		mock: func(mock *serviceMocks.InvitationServiceMock) {
+			mock.RevokeInvitationMock.Return(nil)
+		},
+	}
+
+	tests := []struct {
+		name  string
+		call  call
+		token string
+		code  codes.Code
+	}{
+		{
+			name:  "success case: admin invites user",
+			call:  inviteUser,
+			token: token(model.RoleAdmin),
+			code:  codes.OK,
+		},
+		{
+			name:  "success case: admin resends invitation",
+			call:  resendInvitation,
+			token: token(model.RoleAdmin),
+			code:  codes.OK,
+		},
+		{
+			name:  "success case: admin revokes invitation",
+			call:  revokeInvitation,
+			token: token(model.RoleAdmin),
+			code:  codes.OK,
+		},
+		{
+			name: "error: anonymous caller invites user",
+			call: inviteUser,
+			code: codes.Unauthenticated,
+		},
+		{
+			name:  "error: caller with invalid token invites user",
+			call:  inviteUser,
+			token: "invalid",
+			code:  codes.Unauthenticated,
+		},
+		{
+			name:  "error: user invites user",
+			call:  inviteUser,
+			token: token(model.RoleUser),
+			code:  codes.PermissionDenied,
+		},
+		{
+			name: "error: anonymous caller resends invitation",
+			call: resendInvitation,
+			code: codes.Unauthenticated,
+		},
+		{
+			name:  "error: user resends invitation",
+			call:  resendInvitation,
+			token: token(model.RoleUser),
+			code:  codes.PermissionDenied,
+		},
+		{
+			name: "error: anonymous caller revokes invitation",
+			call: revokeInvitation,
+			code: codes.Unauthenticated,
+		},
+		{
+			name:  "error: user revokes invitation",
+			call:  revokeInvitation,
+			token: token(model.RoleUser),
+			code:  codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := minimock.NewController(t)
+
+			invitationMock := serviceMocks.NewInvitationServiceMock(mc)
+			if tt.code == codes.OK {
+				tt.call.mock(invitationMock)
+			}
+			impl := invitation.NewImplementation(invitationMock)
+
+			authInterceptor := interceptor.NewAuthInterceptor(
+				newAccessService(t, mc, tokenAccess), []string{invitationServicePrefix},
+				[]string{invitationServicePrefix + "AcceptInvitation"})
+			chain := grpcMiddleware.ChainUnaryServer(
+				interceptor.NewTenantInterceptor(tokenAccess).Unary,
+				authInterceptor.Unary,
+			)
+
+			md := metadata.Pairs("x-tenant-id", strconv.FormatInt(tenantID, 10))
+			if tt.token != "" {
+				md.Append("authorization", "Bearer "+tt.token)
+			}
+			res, err := chain(metadata.NewIncomingContext(ctx, md), tt.call.req,
+				&grpc.UnaryServerInfo{FullMethod: tt.call.method}, tt.call.handler(impl))
+
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err), err)
+				assert.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, res)
+			if _, ok := res.(*emptypb.Empty); !ok {
+				assert.Equal(t, int64(3), res.(*invitation_v1.InviteUserResponse).GetId())
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package invitation
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+)
+
+func validateInviteUser(req *invitation_v1.InviteUserRequest) error {
+	if req == nil {
+		return errors.New("unable to invite user: empty request")
+	}
+	if req.GetRole() == invitation_v1.Role_UNKNOWN {
+		return errors.New("unable to invite user: role is required")
+	}
+
+	return nil
+}
+
+func validateAcceptInvitation(req *invitation_v1.AcceptInvitationRequest) error {
+	if req == nil {
+		return errors.New("unable to accept invitation: empty request")
+	}
+
+	return nil
+}
+
+func validateResendInvitation(req *invitation_v1.ResendInvitationRequest) error {
+	if req == nil {
+		return errors.New("unable to resend invitation: empty request")
+	}
+
+	return nil
+}
+
+func validateRevokeInvitation(req *invitation_v1.RevokeInvitationRequest) error {
+	if req == nil {
+		return errors.New("unable to revoke invitation: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// CreateClient registers new OAuth client
+func (i *Implementation) CreateClient(ctx context.Context, req *oauth_v1.CreateClientRequest) (
+	*oauth_v1.Client, error,
+) {
+	err := validateCreateClient(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	client, err := i.oauthClientService.CreateClient(ctx, token,
+		converter.ToOAuthClientFromCreateClientV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToOAuthClientV1FromService(client), nil
+}
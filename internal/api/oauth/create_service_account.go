@@ -0,0 +1,34 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// CreateServiceAccount creates service account
+func (i *Implementation) CreateServiceAccount(ctx context.Context,
+	req *oauth_v1.CreateServiceAccountRequest,
+) (*oauth_v1.ServiceAccountCredentials, error) {
+	err := validateCreateServiceAccount(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	credentials, err := i.serviceAccountService.CreateServiceAccount(ctx, token,
+		converter.ToServiceAccountFromCreateServiceAccountV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToServiceAccountCredentialsV1FromService(credentials), nil
+}
@@ -0,0 +1,33 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// DisableServiceAccount disables service account
+func (i *Implementation) DisableServiceAccount(ctx context.Context,
+	req *oauth_v1.DisableServiceAccountRequest,
+) (*emptypb.Empty, error) {
+	err := validateDisableServiceAccount(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.serviceAccountService.DisableServiceAccount(ctx, token, req.GetClientId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,31 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListClients returns OAuth clients of organization
+func (i *Implementation) ListClients(ctx context.Context, _ *emptypb.Empty) (
+	*oauth_v1.ListClientsResponse, error,
+) {
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	clients, err := i.oauthClientService.ListClients(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &oauth_v1.ListClientsResponse{
+		Clients: converter.ToOAuthClientsV1FromService(clients),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListServiceAccounts returns service accounts of organization
+func (i *Implementation) ListServiceAccounts(ctx context.Context, _ *emptypb.Empty) (
+	*oauth_v1.ListServiceAccountsResponse, error,
+) {
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	accounts, err := i.serviceAccountService.ListServiceAccounts(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &oauth_v1.ListServiceAccountsResponse{
+		ServiceAccounts: converter.ToServiceAccountsV1FromService(accounts),
+	}, nil
+}
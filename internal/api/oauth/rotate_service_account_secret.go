@@ -0,0 +1,34 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// RotateServiceAccountSecret replaces client secret of service account
+func (i *Implementation) RotateServiceAccountSecret(ctx context.Context,
+	req *oauth_v1.RotateServiceAccountSecretRequest,
+) (*oauth_v1.ServiceAccountCredentials, error) {
+	err := validateRotateServiceAccountSecret(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	credentials, err := i.serviceAccountService.RotateServiceAccountSecret(ctx, token,
+		req.GetClientId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToServiceAccountCredentialsV1FromService(credentials), nil
+}
@@ -0,0 +1,24 @@
+package oauth
+
+import (
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	oauth_v1.UnimplementedOAuthV1Server
+	oauthClientService    service.OAuthClientService
+	serviceAccountService service.ServiceAccountService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(
+	oauthClientService service.OAuthClientService,
+	serviceAccountService service.ServiceAccountService,
+) *Implementation {
+	return &Implementation{
+		oauthClientService:    oauthClientService,
+		serviceAccountService: serviceAccountService,
+	}
+}
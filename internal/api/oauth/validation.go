@@ -0,0 +1,47 @@
+package oauth
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+)
+
+func validateCreateClient(req *oauth_v1.CreateClientRequest) error {
+	if req == nil {
+		return errors.New("unable to create oauth client: empty request")
+	}
+
+	return nil
+}
+
+func validateDeleteClient(req *oauth_v1.DeleteClientRequest) error {
+	if req == nil {
+		return errors.New("unable to delete oauth client: empty request")
+	}
+
+	return nil
+}
+
+func validateCreateServiceAccount(req *oauth_v1.CreateServiceAccountRequest) error {
+	if req == nil {
+		return errors.New("unable to create service account: empty request")
+	}
+
+	return nil
+}
+
+func validateRotateServiceAccountSecret(req *oauth_v1.RotateServiceAccountSecretRequest) error {
+	if req == nil {
+		return errors.New("unable to rotate service account secret: empty request")
+	}
+
+	return nil
+}
+
+func validateDisableServiceAccount(req *oauth_v1.DisableServiceAccountRequest) error {
+	if req == nil {
+		return errors.New("unable to disable service account: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package oauthserver
+
+import (
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+const actionDeny = "deny"
+
+// Authorize serves authorization endpoint: GET shows login and consent page,
+// POST authenticates user and redirects back to client with authorization code
+func (s *Server) Authorize(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	setNoStore(w)
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	req := &model.OAuthAuthorizationRequest{
+		ResponseType:        r.Form.Get("response_type"),
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		State:               r.Form.Get("state"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+		Nonce:               r.Form.Get("nonce"),
+	}
+
+	// user agent is never redirected to unregistered redirect URI, RFC 6749 section 4.1.2.1
+	client, err := s.authService.GetOAuthClient(r.Context(), req.ClientID, req.RedirectURI)
+	if err != nil {
+		logger.Warn("invalid oauth authorization request", zap.String("client_id", req.ClientID),
+			zap.Error(err))
+		http.Error(w, "invalid client or redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	if code, description := validateAuthorizationRequest(req); code != "" {
+		redirectError(w, r, req, code, description)
+		return
+	}
+
+	page := &authorizePageData{
+		ClientName: client.Name,
+		Scope:      req.Scope,
+		Request:    req,
+		Providers:  s.pageProviders(r, req),
+	}
+
+	if r.Method != http.MethodPost {
+		renderAuthorizePage(w, http.StatusOK, page)
+		return
+	}
+
+	if r.PostForm.Get("action") == actionDeny {
+		redirectError(w, r, req, errorAccessDenied, "user denied access")
+		return
+	}
+
+	code, err := s.authService.AuthorizeOAuth(r.Context(), req, r.PostForm.Get("username"),
+		r.PostForm.Get("password"))
+	if err != nil {
+		logger.Warn("oauth authorization failed", zap.String("client_id", req.ClientID),
+			zap.Error(err))
+		page.Error = "Invalid username or password"
+		renderAuthorizePage(w, http.StatusUnauthorized, page)
+		return
+	}
+
+	redirect(w, r, req.RedirectURI, url.Values{
+		"code":  {code},
+		"state": {req.State},
+	})
+}
+
+// validateAuthorizationRequest returns OAuth error code and description of invalid
+// authorization request, PKCE with S256 method is required for all clients
+func validateAuthorizationRequest(req *model.OAuthAuthorizationRequest) (string, string) {
+	switch {
+	case req.ResponseType != model.OAuthResponseTypeCode:
+		return errorUnsupportedResponseType, "response_type must be code"
+	case req.CodeChallenge == "":
+		return errorInvalidRequest, "code_challenge is required"
+	case req.CodeChallengeMethod != model.OAuthCodeChallengeMethodS256:
+		return errorInvalidRequest, "code_challenge_method must be S256"
+	}
+
+	return "", ""
+}
+
+func redirectError(w http.ResponseWriter, r *http.Request, req *model.OAuthAuthorizationRequest,
+	code, description string,
+) {
+	redirect(w, r, req.RedirectURI, url.Values{
+		"error":             {code},
+		"error_description": {description},
+		"state":             {req.State},
+	})
+}
@@ -0,0 +1,71 @@
+package oauthserver
+
+import (
+	"net/http"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// Paths of provider endpoints, discovery document advertises them under issuer URL
+const (
+	AuthorizePath = "/oauth/authorize"
+	TokenPath     = "/oauth/token"
+	UserInfoPath  = "/userinfo"
+	DiscoveryPath = "/.well-known/openid-configuration"
+	JWKSPath      = "/.well-known/jwks.json"
+)
+
+type discoveryResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// Discovery serves OpenID Provider metadata, OpenID Connect Discovery 1.0 section 4
+func (s *Server) Discovery(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	issuer := s.idToken.Issuer()
+
+	writeJSON(w, http.StatusOK, &discoveryResponse{
+		Issuer:                issuer,
+		AuthorizationEndpoint: issuer + AuthorizePath,
+		TokenEndpoint:         issuer + TokenPath,
+		UserInfoEndpoint:      issuer + UserInfoPath,
+		JWKSURI:               issuer + JWKSPath,
+		ScopesSupported: []string{
+			model.OIDCScopeOpenID, model.OIDCScopeProfile, model.OIDCScopeEmail,
+		},
+		ResponseTypesSupported: []string{model.OAuthResponseTypeCode},
+		GrantTypesSupported: []string{
+			model.OAuthGrantTypeAuthorizationCode,
+			model.OAuthGrantTypeRefreshToken,
+			model.OAuthGrantTypeClientCredentials,
+		},
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: []string{jwt.SigningMethodRS256.Alg()},
+		TokenEndpointAuthMethodsSupported: []string{
+			"none", "client_secret_basic", "client_secret_post",
+		},
+		CodeChallengeMethodsSupported: []string{model.OAuthCodeChallengeMethodS256},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "amr",
+			"name", "preferred_username", "email", "email_verified",
+		},
+	})
+}
+
+// JWKS serves public keys verifying ID tokens and access tokens
+func (s *Server) JWKS(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, s.idToken.JWKS())
+}
@@ -0,0 +1,190 @@
+package oauthserver
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+// Paths of federated login endpoints, callback URL is registered at upstream provider
+const (
+	FederatedLoginPath    = "/federation/{provider}/login"
+	FederatedCallbackPath = "/federation/{provider}/callback"
+)
+
+const (
+	providerParam = "provider"
+	// stateCookieName is cookie binding federated login to user agent which started it
+	stateCookieName = "federation_state"
+	stateCookiePath = "/federation/"
+)
+
+// FederatedCallbackURL returns callback URL of upstream provider under issuer URL
+func FederatedCallbackURL(issuer, provider string) string {
+	return issuer + providerPath(FederatedCallbackPath, provider)
+}
+
+// providerPath returns federated login endpoint path of provider
+func providerPath(path, provider string) string {
+	return strings.Replace(path, "{"+providerParam+"}", url.PathEscape(provider), 1)
+}
+
+// FederatedLogin redirects user agent to upstream provider, parameters of
+// authorization request are the ones of authorization endpoint
+func (s *Server) FederatedLogin(w http.ResponseWriter, r *http.Request,
+	pathParams map[string]string,
+) {
+	setNoStore(w)
+
+	query := r.URL.Query()
+	req := &model.OAuthAuthorizationRequest{
+		ResponseType:        query.Get("response_type"),
+		ClientID:            query.Get("client_id"),
+		RedirectURI:         query.Get("redirect_uri"),
+		Scope:               query.Get("scope"),
+		State:               query.Get("state"),
+		CodeChallenge:       query.Get("code_challenge"),
+		CodeChallengeMethod: query.Get("code_challenge_method"),
+		Nonce:               query.Get("nonce"),
+	}
+
+	if _, err := s.authService.GetOAuthClient(r.Context(), req.ClientID,
+		req.RedirectURI); err != nil {
+		logger.Warn("invalid federated login request", zap.String("client_id", req.ClientID),
+			zap.Error(err))
+		http.Error(w, "invalid client or redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	if code, description := validateAuthorizationRequest(req); code != "" {
+		redirectError(w, r, req, code, description)
+		return
+	}
+
+	redirectTo, err := s.federationService.BeginLogin(r.Context(), pathParams[providerParam], req)
+	switch {
+	case errors.Is(err, model.ErrorFederationProviderNotFound):
+		http.Error(w, "unknown identity provider", http.StatusNotFound)
+		return
+	case err != nil:
+		logger.ErrorWithMsg("unable to begin federated login", err,
+			zap.String("provider", pathParams[providerParam]))
+		redirectError(w, r, req, errorServerError, "identity provider is unavailable")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookieName,
+		Value:    redirectTo.State,
+		Path:     stateCookiePath,
+		MaxAge:   int(model.FederationLoginStateTTL.Seconds()),
+		Secure:   strings.HasPrefix(s.idToken.Issuer(), "https://"),
+		HttpOnly: true,
+		// callback is top-level navigation from provider site
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, redirectTo.URL, http.StatusFound)
+}
+
+// FederatedCallback completes login at upstream provider and redirects user agent
+// back to client with authorization code
+func (s *Server) FederatedCallback(w http.ResponseWriter, r *http.Request,
+	pathParams map[string]string,
+) {
+	setNoStore(w)
+
+	query := r.URL.Query()
+	callback := &model.FederationCallback{
+		State:            query.Get("state"),
+		Code:             query.Get("code"),
+		Error:            query.Get("error"),
+		ErrorDescription: query.Get("error_description"),
+	}
+
+	// login CSRF protection, state must be the one issued to this user agent
+	cookie, err := r.Cookie(stateCookieName)
+	if err != nil || callback.State == "" || cookie.Value != callback.State {
+		http.Error(w, "invalid login state", http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   stateCookieName,
+		Path:   stateCookiePath,
+		MaxAge: -1,
+	})
+
+	provider := pathParams[providerParam]
+	login, err := s.federationService.CompleteLogin(r.Context(), provider, callback)
+	switch {
+	case errors.Is(err, model.ErrorFederationProviderNotFound),
+		errors.Is(err, model.ErrorFederationInvalidState):
+		http.Error(w, "invalid login state", http.StatusBadRequest)
+		return
+	case err != nil:
+		logger.ErrorWithMsg("unable to complete federated login", err,
+			zap.String("provider", provider))
+		http.Error(w, "login at identity provider failed", http.StatusBadGateway)
+		return
+	}
+
+	if login.DeniedReason != "" {
+		logger.Warn("federated login denied", zap.String("provider", provider),
+			zap.String("reason", login.DeniedReason),
+			zap.String("error_description", callback.ErrorDescription))
+		redirectError(w, r, login.Request, errorAccessDenied, login.DeniedReason)
+		return
+	}
+
+	code, err := s.authService.AuthorizeOAuthUser(r.Context(), login.Request, login.UserID,
+		model.OIDCAuthMethodFederated)
+	if err != nil {
+		logger.ErrorWithMsg("unable to authorize federated user", err,
+			zap.String("provider", provider), zap.Int64("user_id", login.UserID))
+		redirectError(w, r, login.Request, errorServerError, "")
+		return
+	}
+
+	redirect(w, r, login.Request.RedirectURI, url.Values{
+		"code":  {code},
+		"state": {login.Request.State},
+	})
+}
+
+// pageProviders returns upstream providers of client tenant for login page,
+// links carry authorization request
+func (s *Server) pageProviders(r *http.Request, req *model.OAuthAuthorizationRequest,
+) []*pageProvider {
+	providers, err := s.federationService.ListProviders(r.Context(), req.ClientID)
+	if err != nil {
+		logger.ErrorWithMsg("unable to list federation providers", err,
+			zap.String("client_id", req.ClientID))
+		return nil
+	}
+
+	query := url.Values{
+		"response_type":         {req.ResponseType},
+		"client_id":             {req.ClientID},
+		"redirect_uri":          {req.RedirectURI},
+		"scope":                 {req.Scope},
+		"state":                 {req.State},
+		"code_challenge":        {req.CodeChallenge},
+		"code_challenge_method": {req.CodeChallengeMethod},
+		"nonce":                 {req.Nonce},
+	}.Encode()
+
+	res := make([]*pageProvider, 0, len(providers))
+	for _, provider := range providers {
+		res = append(res, &pageProvider{
+			DisplayName: provider.DisplayName,
+			URL:         providerPath(FederatedLoginPath, provider.Name) + "?" + query,
+		})
+	}
+
+	return res
+}
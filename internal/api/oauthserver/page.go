@@ -0,0 +1,64 @@
+package oauthserver
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+var authorizePage = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Sign in to {{.ClientName}}</title>
+</head>
+<body>
+<h1>Sign in to {{.ClientName}}</h1>
+{{if .Scope}}<p>{{.ClientName}} requests access: {{.Scope}}</p>{{end}}
+{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+<form method="post" action="/oauth/authorize">
+<input type="hidden" name="response_type" value="{{.Request.ResponseType}}">
+<input type="hidden" name="client_id" value="{{.Request.ClientID}}">
+<input type="hidden" name="redirect_uri" value="{{.Request.RedirectURI}}">
+<input type="hidden" name="scope" value="{{.Request.Scope}}">
+<input type="hidden" name="state" value="{{.Request.State}}">
+<input type="hidden" name="code_challenge" value="{{.Request.CodeChallenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Request.CodeChallengeMethod}}">
+<input type="hidden" name="nonce" value="{{.Request.Nonce}}">
+<p><label>Username <input name="username" autocomplete="username" required></label></p>
+<p><label>Password <input name="password" type="password" autocomplete="current-password" required></label></p>
+<p>
+<button type="submit" name="action" value="allow">Allow</button>
+<button type="submit" name="action" value="deny" formnovalidate>Deny</button>
+</p>
+</form>
+{{range .Providers}}<p><a href="{{.URL}}">Sign in with {{.DisplayName}}</a></p>
+{{end}}</body>
+</html>
+`))
+
+type authorizePageData struct {
+	ClientName string
+	Scope      string
+	Error      string
+	Request    *model.OAuthAuthorizationRequest
+	Providers  []*pageProvider
+}
+
+// pageProvider is upstream provider user may sign in with instead of password
+type pageProvider struct {
+	DisplayName string
+	URL         string
+}
+
+// renderAuthorizePage writes login and consent page of authorization request
+func renderAuthorizePage(w http.ResponseWriter, status int, data *authorizePageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := authorizePage.Execute(w, data); err != nil {
+		logger.ErrorWithMsg("unable to render authorize page", err)
+	}
+}
@@ -0,0 +1,98 @@
+package oauthserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// OAuth 2.0 error codes, RFC 6749 sections 4.1.2.1 and 5.2
+const (
+	errorInvalidRequest          = "invalid_request"
+	errorInvalidClient           = "invalid_client"
+	errorInvalidGrant            = "invalid_grant"
+	errorInvalidScope            = "invalid_scope"
+	errorUnsupportedGrantType    = "unsupported_grant_type"
+	errorUnsupportedResponseType = "unsupported_response_type"
+	errorAccessDenied            = "access_denied"
+	errorServerError             = "server_error"
+)
+
+// Server serves OAuth 2.0 authorization and token endpoints, OpenID Connect
+// discovery, keys and userinfo endpoints and federated login endpoints
+type Server struct {
+	authService       service.AuthService
+	federationService service.FederationService
+	idToken           utils.IDToken
+}
+
+// NewServer returns OAuth 2.0 authorization server and OpenID Connect provider
+func NewServer(authService service.AuthService, federationService service.FederationService,
+	idToken utils.IDToken,
+) *Server {
+	return &Server{
+		authService:       authService,
+		federationService: federationService,
+		idToken:           idToken,
+	}
+}
+
+// setNoStore disables caching of responses carrying codes and tokens and framing of pages
+func setNoStore(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+}
+
+// writeJSON writes JSON response of token endpoint
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.ErrorWithMsg("unable to write oauth response", err)
+	}
+}
+
+// writeError writes error response of token endpoint
+func writeError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, &errorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
+
+// redirect sends user agent back to client with response parameters,
+// query of registered redirect URI is kept
+func redirect(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) {
+	uri, err := url.Parse(redirectURI)
+	if err != nil {
+		logger.ErrorWithMsg("unable to parse redirect uri", err,
+			zap.String("redirect_uri", redirectURI))
+		http.Error(w, "invalid redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	query := uri.Query()
+	for key, values := range params {
+		for _, value := range values {
+			if value != "" {
+				query.Add(key, value)
+			}
+		}
+	}
+	uri.RawQuery = query.Encode()
+
+	http.Redirect(w, r, uri.String(), http.StatusFound)
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/client/ldap/ldaptest"
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	directoryName     = "corp"
+	directoryBindDN   = "cn=search,dc=example,dc=org"
+	directoryBindPass = "search secret"
+	directoryAdmins   = "cn=admins,ou=groups,dc=example,dc=org"
+)
+
+func directoryConfig(url string) *model.LDAPDirectory {
+	return &model.LDAPDirectory{
+		Name:              directoryName,
+		TenantID:          tenantID,
+		URL:               url,
+		BindDN:            directoryBindDN,
+		BindPassword:      directoryBindPass,
+		BaseDN:            "ou=people,dc=example,dc=org",
+		UserFilter:        "(objectClass=person)",
+		UsernameAttribute: "uid",
+		EmailAttribute:    "mail",
+		GroupAttribute:    "memberOf",
+		Sync:              true,
+		DefaultRole:       model.RoleUser,
+		RoleMappings: []model.LDAPRoleMapping{
+			{GroupDN: directoryAdmins, Role: model.RoleAdmin},
+		},
+	}
+}
+
+// unreachableDirectoryConfig returns directory whose server is down,
+// it is tried before directory of test server
+func unreachableDirectoryConfig() *model.LDAPDirectory {
+	directory := directoryConfig("ldap://127.0.0.1:1")
+	directory.Name = "legacy"
+
+	return directory
+}
+
+// newDirectory starts LDAP server with dave, member of admins, and alice, whose name
+// is taken by local user
+func newDirectory(t *testing.T) *ldaptest.Server {
+	server, err := ldaptest.NewServer(directoryBindDN, directoryBindPass,
+		directoryPerson("dave", "dave password", directoryAdmins),
+		directoryPerson(username, "directory password"))
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func directoryPerson(uid, password string, groups ...string) *ldaptest.Entry {
+	return &ldaptest.Entry{
+		DN:       "uid=" + uid + ",ou=people,dc=example,dc=org",
+		Password: password,
+		Attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {uid},
+			"mail":        {uid + "@example.org"},
+			"memberOf":    groups,
+		},
+	}
+}
+
+// authorizeAs logs in on authorization endpoint as user
+func (c *testClient) authorizeAs(user, userPassword string) *http.Response {
+	params := authorizeParams()
+	params.Set("username", user)
+	params.Set("password", userPassword)
+	params.Set("action", "allow")
+
+	return c.post("/oauth/authorize", params)
+}
+
+func TestDirectoryLogin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directory user is provisioned on first login", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		query := c.redirectQuery(c.authorizeAs("dave", "dave password"))
+		status, token := c.token(exchangeParams(query.Get("code")))
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+
+		identities := c.identities.all()
+		require.Len(t, identities, 1)
+		assert.Equal(t, model.LDAPIdentityPrefix+directoryName, identities[0].Provider)
+		assert.Equal(t, "uid=dave,ou=people,dc=example,dc=org", identities[0].Subject)
+
+		user, ok := c.users.get(identities[0].UserID)
+		require.True(t, ok)
+		assert.Equal(t, "dave", user.Name)
+		assert.Equal(t, "dave@example.org", user.Email)
+		assert.Equal(t, model.RoleAdmin, user.Role)
+		assert.Empty(t, user.Password)
+
+		claims, err := c.tokens.VerifyToken(context.Background(), token.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, "dave", claims.Username)
+		assert.Equal(t, model.RoleAdmin, claims.Role)
+
+		// second login signs in as linked user
+		users := c.users.count()
+		c.redirectQuery(c.authorizeAs("dave", "dave password"))
+		assert.Equal(t, users, c.users.count())
+		assert.Len(t, c.identities.all(), 1)
+	})
+
+	t.Run("local password is checked before directory", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		c.redirectQuery(c.authorizeAs(username, password))
+		assert.Empty(t, c.identities.all())
+	})
+
+	t.Run("directory user does not take over local user", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+		users := c.users.count()
+
+		res := c.authorizeAs(username, "directory password")
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		assert.Equal(t, users, c.users.count())
+		assert.Empty(t, c.identities.all())
+	})
+
+	t.Run("wrong password is rejected by every authenticator", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		for _, user := range []string{"dave", "nobody"} {
+			res := c.authorizeAs(user, "wrong password")
+			assert.Equal(t, http.StatusUnauthorized, res.StatusCode, user)
+		}
+		assert.Empty(t, c.identities.all())
+	})
+
+	t.Run("unreachable directory does not break login of next directory", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		c.redirectQuery(c.authorizeAs("dave", "dave password"))
+
+		identities := c.identities.all()
+		require.Len(t, identities, 1)
+		assert.Equal(t, model.LDAPIdentityPrefix+directoryName, identities[0].Provider)
+	})
+
+	t.Run("unreachable directory does not break local login", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+		c.directory.Close()
+
+		c.redirectQuery(c.authorizeAs(username, password))
+
+		res := c.authorizeAs("dave", "dave password")
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
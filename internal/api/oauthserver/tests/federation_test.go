@@ -0,0 +1,556 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/api/oauthserver"
+	"github.com/valek177/auth/internal/client/oidc"
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	providerName         = "corporate"
+	upstreamClientID     = "auth-service"
+	upstreamClientSecret = "upstream/secret"
+	providerKeyID        = "provider-key"
+)
+
+// providerKey is RSA key of mock upstream provider
+var providerKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// mockProvider is in-process upstream OpenID Connect provider which signs in
+// configured account without user interaction
+type mockProvider struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu sync.Mutex
+	// account is claims of account signing in at provider
+	account map[string]interface{}
+	// denied makes provider refuse login
+	denied bool
+	// tamper modifies claims of issued ID token
+	tamper func(claims jwt.MapClaims)
+	codes  map[string]*providerCode
+}
+
+type providerCode struct {
+	nonce         string
+	codeChallenge string
+	redirectURI   string
+	account       map[string]interface{}
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	p := &mockProvider{
+		t:     t,
+		codes: make(map[string]*providerCode),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("GET /keys", p.keys)
+	mux.HandleFunc("GET /authorize", p.authorize)
+	mux.HandleFunc("POST /token", p.token)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+// clients returns clients of mock provider and provider of other tenant,
+// callbacks are served by authorization server at serverURL
+func (p *mockProvider) clients(serverURL string) []oidc.Client {
+	providers := []*model.FederationProvider{
+		{
+			Name:         providerName,
+			DisplayName:  "Corporate SSO",
+			TenantID:     tenantID,
+			Issuer:       p.server.URL,
+			ClientID:     upstreamClientID,
+			ClientSecret: upstreamClientSecret,
+			Scopes: []string{
+				model.OIDCScopeOpenID, model.OIDCScopeProfile, model.OIDCScopeEmail,
+			},
+			Provisioning: true,
+			RoleMappings: []model.FederationRoleMapping{
+				{Claim: "groups", Value: "admins", Role: model.RoleAdmin},
+				{Claim: "groups", Value: "staff", Role: model.RoleUser},
+			},
+		},
+		{
+			Name:     "partner",
+			TenantID: tenantID + 1,
+			Issuer:   "https://partner.example.com",
+			ClientID: upstreamClientID,
+			Scopes:   []string{model.OIDCScopeOpenID},
+		},
+	}
+
+	clients := make([]oidc.Client, 0, len(providers))
+	for _, provider := range providers {
+		clients = append(clients, oidc.NewClient(provider,
+			oauthserver.FederatedCallbackURL(serverURL, provider.Name), http.DefaultClient))
+	}
+
+	return clients
+}
+
+func (p *mockProvider) signIn(account map[string]interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.account = account
+}
+
+func (p *mockProvider) deny() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.denied = true
+}
+
+func (p *mockProvider) tamperIDToken(tamper func(claims jwt.MapClaims)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tamper = tamper
+}
+
+func (p *mockProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, map[string]interface{}{
+		"issuer":                 p.server.URL,
+		"authorization_endpoint": p.server.URL + "/authorize",
+		"token_endpoint":         p.server.URL + "/token",
+		"jwks_uri":               p.server.URL + "/keys",
+	})
+}
+
+func (p *mockProvider) keys(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, &model.JSONWebKeySet{
+		Keys: []model.JSONWebKey{
+			{
+				KeyType:   "RSA",
+				Use:       "sig",
+				Algorithm: jwt.SigningMethodRS256.Alg(),
+				KeyID:     providerKeyID,
+				N:         base64.RawURLEncoding.EncodeToString(providerKey.N.Bytes()),
+				E: base64.RawURLEncoding.EncodeToString(
+					big.NewInt(int64(providerKey.E)).Bytes()),
+			},
+		},
+	})
+}
+
+func (p *mockProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	assert.Equal(p.t, model.OAuthResponseTypeCode, query.Get("response_type"))
+	assert.Equal(p.t, upstreamClientID, query.Get("client_id"))
+	assert.Equal(p.t, "openid profile email", query.Get("scope"))
+	assert.Equal(p.t, model.OAuthCodeChallengeMethodS256, query.Get("code_challenge_method"))
+	assert.NotEmpty(p.t, query.Get("nonce"))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	params := url.Values{"state": {query.Get("state")}}
+	if p.denied {
+		params.Set("error", "access_denied")
+	} else {
+		code := randomString(p.t)
+		p.codes[code] = &providerCode{
+			nonce:         query.Get("nonce"),
+			codeChallenge: query.Get("code_challenge"),
+			redirectURI:   query.Get("redirect_uri"),
+			account:       p.account,
+		}
+		params.Set("code", code)
+	}
+
+	http.Redirect(w, r, query.Get("redirect_uri")+"?"+params.Encode(), http.StatusFound)
+}
+
+func (p *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	require.NoError(p.t, r.ParseForm())
+
+	id, secret, ok := r.BasicAuth()
+	id, _ = url.QueryUnescape(id)
+	secret, _ = url.QueryUnescape(secret)
+	if !ok || id != upstreamClientID || secret != upstreamClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	code, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || code.redirectURI != r.PostForm.Get("redirect_uri") ||
+		code.codeChallenge != base64.RawURLEncoding.EncodeToString(sum[:]) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   p.server.URL,
+		"aud":   upstreamClientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": code.nonce,
+	}
+	for name, value := range code.account {
+		claims[name] = value
+	}
+	if p.tamper != nil {
+		p.tamper(claims)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = providerKeyID
+	idToken, err := token.SignedString(providerKey)
+	require.NoError(p.t, err)
+
+	p.writeJSON(w, map[string]interface{}{
+		"access_token": "upstream-access-token",
+		"token_type":   model.OAuthTokenTypeBearer,
+		"id_token":     idToken,
+	})
+}
+
+func (p *mockProvider) writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(p.t, json.NewEncoder(w).Encode(body))
+}
+
+func randomString(t *testing.T) string {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// browser returns user agent with cookies following redirects until client
+// redirect URI
+func (c *testClient) browser() *http.Client {
+	jar, err := cookiejar.New(nil)
+	require.NoError(c.t, err)
+
+	clientURL, err := url.Parse(redirectURI)
+	require.NoError(c.t, err)
+
+	return &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
+			if req.URL.Host == clientURL.Host {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+}
+
+// federatedLogin signs in through mock provider and returns final response of
+// user agent
+func (c *testClient) federatedLogin(provider string, params url.Values) *http.Response {
+	res, err := c.browser().Get(c.serverURL + "/federation/" + provider + "/login?" +
+		params.Encode())
+	require.NoError(c.t, err)
+	c.t.Cleanup(func() { _ = res.Body.Close() })
+
+	return res
+}
+
+// federatedCode signs in through mock provider and returns authorization code
+func (c *testClient) federatedCode(params url.Values) string {
+	query := c.redirectQuery(c.federatedLogin(providerName, params))
+	require.Equal(c.t, "xyz", query.Get("state"), query.Get("error_description"))
+	require.NotEmpty(c.t, query.Get("code"))
+
+	return query.Get("code")
+}
+
+func openIDParams() url.Values {
+	params := authorizeParams()
+	params.Set("scope", "openid profile email")
+	params.Set("nonce", "client-nonce")
+
+	return params
+}
+
+func staffAccount(subject, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":                subject,
+		"preferred_username": name,
+		"name":               "Bob Builder",
+		"email":              name + "@corp.example.com",
+		"email_verified":     true,
+		"groups":             []interface{}{"staff"},
+	}
+}
+
+func TestFederatedLogin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("login page offers providers of client tenant", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		res := c.get(oauthserver.AuthorizePath, authorizeParams())
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(body), "Sign in with Corporate SSO")
+		assert.Contains(t, string(body), `href="/federation/corporate/login?`)
+		assert.NotContains(t, string(body), "partner")
+	})
+
+	t.Run("first login provisions user with mapped role", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		account := staffAccount("u-1", "bob")
+		account["groups"] = []interface{}{"staff", "admins"}
+		c.provider.signIn(account)
+
+		status, token := c.token(exchangeParams(c.federatedCode(openIDParams())))
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+
+		identities := c.identities.all()
+		require.Len(t, identities, 1)
+		assert.Equal(t, providerName, identities[0].Provider)
+		assert.Equal(t, "u-1", identities[0].Subject)
+		assert.Equal(t, tenantID, identities[0].TenantID)
+
+		user, ok := c.users.get(identities[0].UserID)
+		require.True(t, ok)
+		assert.Equal(t, "bob", user.Name)
+		assert.Equal(t, "bob@corp.example.com", user.Email)
+		assert.True(t, user.EmailVerifiedAt.Valid)
+		assert.Equal(t, model.RoleAdmin, user.Role)
+		assert.Equal(t, model.UserStatusActive, user.Status)
+		assert.Equal(t, tenantID, user.TenantID)
+
+		claims := &model.IDTokenClaims{}
+		_, err := jwt.ParseWithClaims(token.IDToken, claims, c.jwksKey)
+		require.NoError(t, err)
+		assert.Equal(t, model.SubjectOf(user.ID), claims.Subject)
+		assert.Equal(t, "client-nonce", claims.Nonce)
+		assert.Equal(t, []string{model.OIDCAuthMethodFederated}, claims.AMR)
+	})
+
+	t.Run("linked account signs in as linked user", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+		c.provider.signIn(staffAccount("u-2", "carol"))
+
+		c.federatedCode(authorizeParams())
+		users := c.users.count()
+
+		// account renamed at provider still signs in as linked user
+		c.provider.signIn(staffAccount("u-2", "carol.smith"))
+		status, token := c.token(exchangeParams(c.federatedCode(authorizeParams())))
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+
+		assert.Equal(t, users, c.users.count())
+		require.Len(t, c.identities.all(), 1)
+
+		claims, err := c.tokens.VerifyToken(context.Background(), token.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, "carol", claims.Username)
+		assert.Equal(t, model.RoleUser, claims.Role)
+	})
+
+	t.Run("account is denied", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			prepare func(p *mockProvider)
+		}{
+			{
+				name: "no role mapping matches",
+				prepare: func(p *mockProvider) {
+					account := staffAccount("u-3", "dave")
+					account["groups"] = []interface{}{"contractors"}
+					p.signIn(account)
+				},
+			},
+			{
+				name: "user name belongs to local user",
+				prepare: func(p *mockProvider) {
+					p.signIn(staffAccount("u-4", username))
+				},
+			},
+			{
+				name: "user name is reserved for service accounts",
+				prepare: func(p *mockProvider) {
+					p.signIn(staffAccount("u-5", model.ServiceAccountPrincipal("ci")))
+				},
+			},
+			{
+				name: "provider refuses login",
+				prepare: func(p *mockProvider) {
+					p.deny()
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				c := newTestClient(t)
+				tt.prepare(c.provider)
+				users := c.users.count()
+
+				query := c.redirectQuery(c.federatedLogin(providerName, authorizeParams()))
+				assert.Equal(t, "access_denied", query.Get("error"))
+				assert.Equal(t, "xyz", query.Get("state"))
+				assert.Empty(t, query.Get("code"))
+
+				assert.Equal(t, users, c.users.count())
+				assert.Empty(t, c.identities.all())
+			})
+		}
+	})
+
+	t.Run("invalid id token is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			tamper func(claims jwt.MapClaims)
+		}{
+			{
+				name:   "nonce does not match",
+				tamper: func(claims jwt.MapClaims) { claims["nonce"] = "replayed" },
+			},
+			{
+				name:   "audience is other client",
+				tamper: func(claims jwt.MapClaims) { claims["aud"] = "other-client" },
+			},
+			{
+				name:   "issuer is other provider",
+				tamper: func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" },
+			},
+			{
+				name: "token is expired",
+				tamper: func(claims jwt.MapClaims) {
+					claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				c := newTestClient(t)
+				c.provider.signIn(staffAccount("u-6", "erin"))
+				c.provider.tamperIDToken(tt.tamper)
+
+				res := c.federatedLogin(providerName, authorizeParams())
+				assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+				assert.Empty(t, c.identities.all())
+			})
+		}
+	})
+
+	t.Run("callback requires state of user agent", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+		c.provider.signIn(staffAccount("u-7", "frank"))
+
+		browser := c.browser()
+		browser.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+		res, err := browser.Get(c.serverURL + "/federation/corporate/login?" +
+			authorizeParams().Encode())
+		require.NoError(t, err)
+		_ = res.Body.Close()
+		require.Equal(t, http.StatusFound, res.StatusCode)
+
+		res, err = browser.Get(res.Header.Get("Location"))
+		require.NoError(t, err)
+		_ = res.Body.Close()
+		callback, err := url.Parse(res.Header.Get("Location"))
+		require.NoError(t, err)
+		cookies := browser.Jar.Cookies(callback)
+		require.Len(t, cookies, 1)
+
+		callbackWith := func(cookies ...*http.Cookie) int {
+			req, err := http.NewRequest(http.MethodGet, callback.String(), nil)
+			require.NoError(t, err)
+			for _, cookie := range cookies {
+				req.AddCookie(cookie)
+			}
+			res, err := c.httpClient.Do(req)
+			require.NoError(t, err)
+			_ = res.Body.Close()
+			return res.StatusCode
+		}
+
+		// callback replayed in other user agent is rejected
+		assert.Equal(t, http.StatusBadRequest, callbackWith())
+		assert.Equal(t, http.StatusBadRequest, callbackWith(&http.Cookie{
+			Name:  cookies[0].Name,
+			Value: "forged",
+		}))
+
+		// state is consumed once
+		assert.Equal(t, http.StatusFound, callbackWith(cookies[0]))
+		assert.Equal(t, http.StatusBadRequest, callbackWith(cookies[0]))
+	})
+
+	t.Run("provider of other tenant is not found", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		res := c.federatedLogin("partner", authorizeParams())
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+		res = c.federatedLogin("unknown", authorizeParams())
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("login requires valid authorization request", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		params := authorizeParams()
+		params.Set("redirect_uri", "https://evil.example.com/callback")
+		res := c.federatedLogin(providerName, params)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		params = authorizeParams()
+		params.Del("code_challenge")
+		query := c.redirectQuery(c.federatedLogin(providerName, params))
+		assert.Equal(t, "invalid_request", query.Get("error"))
+	})
+}
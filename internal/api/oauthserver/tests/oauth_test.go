@@ -0,0 +1,935 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gojuno/minimock/v3"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/valek177/auth/internal/api/oauthserver"
+	ldapClient "github.com/valek177/auth/internal/client/ldap"
+	"github.com/valek177/auth/internal/client/ldap/ldaptest"
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+	passwordLib "github.com/valek177/auth/internal/password"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/service/auth"
+	"github.com/valek177/auth/internal/service/authenticator"
+	"github.com/valek177/auth/internal/service/federation"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+)
+
+const (
+	tenantID     = int64(3)
+	clientID     = "web-client"
+	redirectURI  = "https://app.example.com/callback"
+	username     = "alice"
+	password     = "correct horse"
+	codeVerifier = "dBjftJeZ4CVP-mJ0tIjkTNm3HW6-3wYgrBNrtmYbEQ4"
+
+	serviceAccountID     = "ci-pipeline"
+	serviceAccountSecret = "s3cret/with+special=chars"
+)
+
+var codeChallenge = func() string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}()
+
+const issuer = "https://auth.example.com"
+
+// signingKey is RSA key of test provider, generated once for all tests
+var signingKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type oidcConfig struct{}
+
+func (oidcConfig) Issuer() string                { return issuer }
+func (oidcConfig) SigningKey() *rsa.PrivateKey   { return signingKey }
+func (oidcConfig) IDTokenExpTime() time.Duration { return 10 * time.Minute }
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	Scope            string `json:"scope"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// testClient is local OAuth client driving authorization server over HTTP
+type testClient struct {
+	t          *testing.T
+	serverURL  string
+	httpClient *http.Client
+	tokens     utils.Token
+	auth       service.AuthService
+	provider   *mockProvider
+	users      *userStore
+	identities *identityStore
+	directory  *ldaptest.Server
+}
+
+// newTestClient starts authorization server backed by in-memory repositories
+func newTestClient(t *testing.T) *testClient {
+	logger.Init(zapcore.NewNopCore())
+	mc := minimock.NewController(t)
+
+	hash, err := passwordLib.HashPassword(password)
+	require.NoError(t, err)
+	user := &model.User{
+		ID:       7,
+		TenantID: tenantID,
+		Name:     username,
+		Email:    "alice@example.com",
+		Password: hash,
+		Role:     model.RoleUser,
+		Status:   model.UserStatusActive,
+		EmailVerifiedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	users := newUserStore(user)
+	userMock := users.mock(mc)
+
+	logMock := repoMocks.NewLogRepositoryMock(mc)
+	logMock.CreateRecordMock.Optional().Set(func(_ context.Context, _ *model.Record) (
+		int64, error,
+	) {
+		return 1, nil
+	})
+
+	revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+	revocationMock.GetTokensRevokedAtMock.Optional().Return(time.Time{}, nil)
+
+	roleGrantMock := repoMocks.NewRoleGrantRepositoryMock(mc)
+	roleGrantMock.GetActiveRoleGrantMock.Optional().Return(nil, model.ErrorRoleGrantNotFound)
+
+	clientMock := repoMocks.NewOAuthClientRepositoryMock(mc)
+	clientMock.GetOAuthClientMock.Optional().Set(func(_ context.Context, id string) (
+		*model.OAuthClient, error,
+	) {
+		if id != clientID {
+			return nil, model.ErrorOAuthClientNotFound
+		}
+		return &model.OAuthClient{
+			TenantID:     tenantID,
+			ClientID:     clientID,
+			Name:         "Example App",
+			RedirectURIs: []string{redirectURI},
+		}, nil
+	})
+
+	var (
+		mu    sync.Mutex
+		codes = make(map[string]*model.OAuthAuthorizationCode)
+	)
+	codeMock := repoMocks.NewOAuthAuthorizationCodeRepositoryMock(mc)
+	codeMock.CreateAuthorizationCodeMock.Optional().Set(func(_ context.Context,
+		code *model.OAuthAuthorizationCode,
+	) error {
+		mu.Lock()
+		defer mu.Unlock()
+		codes[code.CodeHash] = code
+		return nil
+	})
+	codeMock.ConsumeAuthorizationCodeMock.Optional().Set(func(_ context.Context,
+		codeHash string,
+	) (*model.OAuthAuthorizationCode, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		code, ok := codes[codeHash]
+		if !ok {
+			return nil, model.ErrorOAuthInvalidGrant
+		}
+		delete(codes, codeHash)
+		return code, nil
+	})
+	codeMock.DeleteExpiredAuthorizationCodesMock.Optional().Return(nil)
+
+	secretHash, err := passwordLib.HashPassword(serviceAccountSecret)
+	require.NoError(t, err)
+	serviceAccountMock := repoMocks.NewServiceAccountRepositoryMock(mc)
+	serviceAccountMock.GetServiceAccountMock.Optional().Set(func(_ context.Context,
+		id string,
+	) (*model.ServiceAccount, error) {
+		account := &model.ServiceAccount{
+			TenantID:   tenantID,
+			ClientID:   id,
+			Role:       model.RoleUser,
+			Scopes:     []string{"user:read", "user:update"},
+			SecretHash: secretHash,
+		}
+		switch id {
+		case serviceAccountID:
+			return account, nil
+		case "disabled":
+			account.Disabled = true
+			return account, nil
+		default:
+			return nil, model.ErrorServiceAccountNotFound
+		}
+	})
+
+	mux := runtime.NewServeMux()
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+
+	provider := newMockProvider(t)
+	identities := newIdentityStore()
+	identityMock := identities.mock(mc)
+	federationService := federation.NewService(clientMock, userMock, logMock,
+		identityMock, newStateStore().mock(mc), txManagerMock(mc),
+		provider.clients(httpServer.URL))
+
+	directory := newDirectory(t)
+	redisMock := repoMocks.NewUserRedisRepositoryMock(mc)
+	redisMock.DeleteUserMock.Optional().Return(nil)
+	authenticators := []service.Authenticator{
+		authenticator.NewLocalAuthenticator(userMock),
+		authenticator.NewLDAPAuthenticator(
+			ldapClient.NewClient(unreachableDirectoryConfig(), time.Second),
+			userMock, redisMock, logMock, identityMock, txManagerMock(mc)),
+		authenticator.NewLDAPAuthenticator(
+			ldapClient.NewClient(directoryConfig(directory.URL), time.Second),
+			userMock, redisMock, logMock, identityMock, txManagerMock(mc)),
+	}
+
+	tokens := utils.NewToken(tokenConfig{})
+	authService := auth.NewService(userMock, logMock, revocationMock, roleGrantMock, clientMock,
+		codeMock, serviceAccountMock, tokens, tokens, utils.NewIDToken(oidcConfig{}),
+		authenticators)
+	server := oauthserver.NewServer(authService, federationService, utils.NewIDToken(oidcConfig{}))
+
+	require.NoError(t, mux.HandlePath(http.MethodGet, oauthserver.AuthorizePath, server.Authorize))
+	require.NoError(t, mux.HandlePath(http.MethodPost, oauthserver.AuthorizePath, server.Authorize))
+	require.NoError(t, mux.HandlePath(http.MethodPost, oauthserver.TokenPath, server.Token))
+	require.NoError(t, mux.HandlePath(http.MethodGet, oauthserver.UserInfoPath, server.UserInfo))
+	require.NoError(t, mux.HandlePath(http.MethodGet, oauthserver.DiscoveryPath, server.Discovery))
+	require.NoError(t, mux.HandlePath(http.MethodGet, oauthserver.JWKSPath, server.JWKS))
+	require.NoError(t, mux.HandlePath(http.MethodGet, oauthserver.FederatedLoginPath,
+		server.FederatedLogin))
+	require.NoError(t, mux.HandlePath(http.MethodGet, oauthserver.FederatedCallbackPath,
+		server.FederatedCallback))
+
+	return &testClient{
+		t:         t,
+		serverURL: httpServer.URL,
+		httpClient: &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		tokens:     tokens,
+		auth:       authService,
+		provider:   provider,
+		users:      users,
+		identities: identities,
+		directory:  directory,
+	}
+}
+
+func authorizeParams() url.Values {
+	return url.Values{
+		"response_type":         {model.OAuthResponseTypeCode},
+		"client_id":             {clientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {"profile"},
+		"state":                 {"xyz"},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {model.OAuthCodeChallengeMethodS256},
+	}
+}
+
+func (c *testClient) get(path string, params url.Values) *http.Response {
+	res, err := c.httpClient.Get(c.serverURL + path + "?" + params.Encode())
+	require.NoError(c.t, err)
+	c.t.Cleanup(func() { _ = res.Body.Close() })
+
+	return res
+}
+
+func (c *testClient) post(path string, params url.Values) *http.Response {
+	res, err := c.httpClient.PostForm(c.serverURL+path, params)
+	require.NoError(c.t, err)
+	c.t.Cleanup(func() { _ = res.Body.Close() })
+
+	return res
+}
+
+// redirectQuery returns query of redirect to client redirect URI
+func (c *testClient) redirectQuery(res *http.Response) url.Values {
+	require.Equal(c.t, http.StatusFound, res.StatusCode)
+
+	location, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(c.t, err)
+	require.Equal(c.t, redirectURI, location.Scheme+"://"+location.Host+location.Path)
+
+	return location.Query()
+}
+
+// authorize logs in on authorization endpoint and returns authorization code
+func (c *testClient) authorize() string {
+	return c.authorizeWith(authorizeParams())
+}
+
+// authorizeWith logs in on authorization endpoint with authorization request parameters
+func (c *testClient) authorizeWith(params url.Values) string {
+	params.Set("username", username)
+	params.Set("password", password)
+	params.Set("action", "allow")
+
+	query := c.redirectQuery(c.post("/oauth/authorize", params))
+	require.Equal(c.t, "xyz", query.Get("state"))
+	require.NotEmpty(c.t, query.Get("code"))
+
+	return query.Get("code")
+}
+
+func (c *testClient) token(params url.Values) (int, *tokenResponse) {
+	return c.decodeToken(c.post("/oauth/token", params))
+}
+
+// tokenBasicAuth requests token authenticating client with HTTP Basic authentication
+func (c *testClient) tokenBasicAuth(clientID, clientSecret string, params url.Values) (
+	*http.Response, *tokenResponse,
+) {
+	req, err := http.NewRequest(http.MethodPost, c.serverURL+"/oauth/token",
+		strings.NewReader(params.Encode()))
+	require.NoError(c.t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+
+	res, err := c.httpClient.Do(req)
+	require.NoError(c.t, err)
+	c.t.Cleanup(func() { _ = res.Body.Close() })
+
+	_, body := c.decodeToken(res)
+
+	return res, body
+}
+
+func (c *testClient) decodeToken(res *http.Response) (int, *tokenResponse) {
+	assert.Equal(c.t, "no-store", res.Header.Get("Cache-Control"))
+
+	var body tokenResponse
+	require.NoError(c.t, json.NewDecoder(res.Body).Decode(&body))
+
+	return res.StatusCode, &body
+}
+
+func exchangeParams(code string) url.Values {
+	return url.Values{
+		"grant_type":    {model.OAuthGrantTypeAuthorizationCode},
+		"client_id":     {clientID},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {codeVerifier},
+	}
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	page := c.get("/oauth/authorize", authorizeParams())
+	require.Equal(t, http.StatusOK, page.StatusCode)
+	assert.Equal(t, "DENY", page.Header.Get("X-Frame-Options"))
+	assert.Equal(t, "frame-ancestors 'none'", page.Header.Get("Content-Security-Policy"))
+
+	status, token := c.token(exchangeParams(c.authorize()))
+	require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+	assert.Equal(t, model.OAuthTokenTypeBearer, token.TokenType)
+	assert.Equal(t, "profile", token.Scope)
+	assert.InDelta(t, time.Hour.Seconds(), token.ExpiresIn, 5)
+	assert.NotEmpty(t, token.RefreshToken)
+	assert.Empty(t, token.IDToken)
+
+	claims, err := c.tokens.VerifyToken(context.Background(), token.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, username, claims.Username)
+	assert.Equal(t, tenantID, claims.TenantID)
+
+	refreshClaims, err := c.tokens.VerifyToken(context.Background(), token.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, clientID, refreshClaims.ClientID)
+	assert.Equal(t, "profile", refreshClaims.Scope)
+
+	c.users.update(7, func(user *model.User) {
+		user.Role = model.RoleAdmin
+	})
+	status, refreshed := c.token(url.Values{
+		"grant_type":    {model.OAuthGrantTypeRefreshToken},
+		"client_id":     {clientID},
+		"refresh_token": {token.RefreshToken},
+	})
+	require.Equal(t, http.StatusOK, status, refreshed.ErrorDescription)
+	assert.NotEmpty(t, refreshed.AccessToken)
+	assert.NotEmpty(t, refreshed.RefreshToken)
+	assert.Equal(t, "profile", refreshed.Scope)
+	claims, err = c.tokens.VerifyToken(context.Background(), refreshed.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, model.RoleAdmin, claims.Role)
+
+	// refresh token of oauth client is not accepted by AuthV1
+	_, err = c.auth.GetRefreshToken(context.Background(), token.RefreshToken)
+	assert.Error(t, err)
+	_, err = c.auth.GetAccessToken(context.Background(), token.RefreshToken)
+	assert.Error(t, err)
+}
+
+func TestRefreshOfDisabledUser(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	refreshToken, _, err := c.auth.Login(tenant.NewContext(context.Background(), tenantID),
+		username, password)
+	require.NoError(t, err)
+
+	refreshed, err := c.auth.GetRefreshToken(context.Background(), refreshToken)
+	require.NoError(t, err)
+	_, err = c.auth.GetAccessToken(context.Background(), refreshed)
+	require.NoError(t, err)
+
+	c.users.update(7, func(user *model.User) {
+		user.Status = model.UserStatusDisabled
+	})
+
+	_, err = c.auth.GetRefreshToken(context.Background(), refreshed)
+	assert.ErrorContains(t, err, "user is not active")
+	_, err = c.auth.GetAccessToken(context.Background(), refreshed)
+	assert.ErrorContains(t, err, "user is not active")
+}
+
+func TestAuthorizeErrors(t *testing.T) {
+	t.Parallel()
+
+	// errors which must not redirect user agent to client
+	testsNoRedirect := []struct {
+		name   string
+		params func(url.Values)
+	}{
+		{
+			name:   "unknown client",
+			params: func(p url.Values) { p.Set("client_id", "other") },
+		},
+		{
+			name:   "unregistered redirect uri",
+			params: func(p url.Values) { p.Set("redirect_uri", "https://evil.example.com/cb") },
+		},
+		{
+			name:   "missing redirect uri",
+			params: func(p url.Values) { p.Del("redirect_uri") },
+		},
+	}
+	testsRedirect := []struct {
+		name   string
+		params func(url.Values)
+		err    string
+	}{
+		{
+			name:   "missing code challenge",
+			params: func(p url.Values) { p.Del("code_challenge") },
+			err:    "invalid_request",
+		},
+		{
+			name:   "plain code challenge method",
+			params: func(p url.Values) { p.Set("code_challenge_method", "plain") },
+			err:    "invalid_request",
+		},
+		{
+			name:   "implicit grant",
+			params: func(p url.Values) { p.Set("response_type", "token") },
+			err:    "unsupported_response_type",
+		},
+		{
+			name:   "user denies access",
+			params: func(p url.Values) { p.Set("action", "deny") },
+			err:    "access_denied",
+		},
+	}
+
+	for _, tt := range testsNoRedirect {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			params := authorizeParams()
+			tt.params(params)
+			res := c.get("/oauth/authorize", params)
+
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+			assert.Empty(t, res.Header.Get("Location"))
+		})
+	}
+
+	for _, tt := range testsRedirect {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			params := authorizeParams()
+			tt.params(params)
+			query := c.redirectQuery(c.post("/oauth/authorize", params))
+
+			assert.Equal(t, tt.err, query.Get("error"))
+			assert.Equal(t, "xyz", query.Get("state"))
+			assert.Empty(t, query.Get("code"))
+		})
+	}
+
+	t.Run("wrong password", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		params := authorizeParams()
+		params.Set("username", username)
+		params.Set("password", "wrong")
+		res := c.post("/oauth/authorize", params)
+
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		assert.Empty(t, res.Header.Get("Location"))
+	})
+}
+
+func TestTokenErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params func(code string) url.Values
+		status int
+		err    string
+	}{
+		{
+			name: "wrong code verifier",
+			params: func(code string) url.Values {
+				p := exchangeParams(code)
+				p.Set("code_verifier", strings.Repeat("a", 43))
+				return p
+			},
+			status: http.StatusBadRequest,
+			err:    "invalid_grant",
+		},
+		{
+			name: "missing code verifier",
+			params: func(code string) url.Values {
+				p := exchangeParams(code)
+				p.Del("code_verifier")
+				return p
+			},
+			status: http.StatusBadRequest,
+			err:    "invalid_request",
+		},
+		{
+			name: "redirect uri differs from authorization request",
+			params: func(code string) url.Values {
+				p := exchangeParams(code)
+				p.Set("redirect_uri", redirectURI+"/other")
+				return p
+			},
+			status: http.StatusBadRequest,
+			err:    "invalid_grant",
+		},
+		{
+			name: "unknown code",
+			params: func(string) url.Values {
+				return exchangeParams("unknown")
+			},
+			status: http.StatusBadRequest,
+			err:    "invalid_grant",
+		},
+		{
+			name: "unknown client",
+			params: func(code string) url.Values {
+				p := exchangeParams(code)
+				p.Set("client_id", "other")
+				return p
+			},
+			status: http.StatusUnauthorized,
+			err:    "invalid_client",
+		},
+		{
+			name: "unsupported grant type",
+			params: func(code string) url.Values {
+				p := exchangeParams(code)
+				p.Set("grant_type", "password")
+				return p
+			},
+			status: http.StatusBadRequest,
+			err:    "unsupported_grant_type",
+		},
+		{
+			name: "invalid refresh token",
+			params: func(string) url.Values {
+				return url.Values{
+					"grant_type":    {model.OAuthGrantTypeRefreshToken},
+					"client_id":     {clientID},
+					"refresh_token": {"invalid"},
+				}
+			},
+			status: http.StatusBadRequest,
+			err:    "invalid_grant",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			status, res := c.token(tt.params(c.authorize()))
+
+			assert.Equal(t, tt.status, status)
+			assert.Equal(t, tt.err, res.Error)
+			assert.Empty(t, res.AccessToken)
+		})
+	}
+
+	t.Run("code is exchanged once", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		code := c.authorize()
+		status, _ := c.token(exchangeParams(code))
+		require.Equal(t, http.StatusOK, status)
+
+		status, res := c.token(exchangeParams(code))
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalid_grant", res.Error)
+	})
+
+	t.Run("refresh token of disabled user", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		status, token := c.token(exchangeParams(c.authorize()))
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+		c.users.update(7, func(user *model.User) {
+			user.Status = model.UserStatusDisabled
+		})
+
+		status, res := c.token(url.Values{
+			"grant_type":    {model.OAuthGrantTypeRefreshToken},
+			"client_id":     {clientID},
+			"refresh_token": {token.RefreshToken},
+		})
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalid_grant", res.Error)
+		assert.Empty(t, res.AccessToken)
+	})
+
+	t.Run("refresh token is not issued to client", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		user, ok := c.users.get(7)
+		require.True(t, ok)
+		otherClientToken, err := c.tokens.GenerateClientToken(context.Background(), user, "other",
+			"profile")
+		require.NoError(t, err)
+		noClientToken, err := c.tokens.GenerateToken(context.Background(), user)
+		require.NoError(t, err)
+
+		for _, refreshToken := range []string{otherClientToken, noClientToken} {
+			status, res := c.token(url.Values{
+				"grant_type":    {model.OAuthGrantTypeRefreshToken},
+				"client_id":     {clientID},
+				"refresh_token": {refreshToken},
+			})
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, "invalid_grant", res.Error)
+			assert.Empty(t, res.AccessToken)
+		}
+	})
+}
+
+func TestClientCredentialsGrant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic authentication", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		res, token := c.tokenBasicAuth(serviceAccountID, serviceAccountSecret, url.Values{
+			"grant_type": {model.OAuthGrantTypeClientCredentials},
+			"scope":      {"user:read"},
+		})
+		require.Equal(t, http.StatusOK, res.StatusCode, token.ErrorDescription)
+		assert.Equal(t, model.OAuthTokenTypeBearer, token.TokenType)
+		assert.Equal(t, "user:read", token.Scope)
+		assert.Empty(t, token.RefreshToken)
+
+		claims, err := c.tokens.VerifyToken(context.Background(), token.AccessToken)
+		require.NoError(t, err)
+		assert.True(t, claims.IsServiceAccount())
+		assert.Equal(t, serviceAccountID, claims.ClientID)
+		assert.Equal(t, tenantID, claims.TenantID)
+		assert.Equal(t, []string{"user:read"}, claims.Scopes())
+	})
+
+	t.Run("request body authentication grants every scope", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		status, token := c.token(url.Values{
+			"grant_type":    {model.OAuthGrantTypeClientCredentials},
+			"client_id":     {serviceAccountID},
+			"client_secret": {serviceAccountSecret},
+		})
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+		assert.Equal(t, "user:read user:update", token.Scope)
+	})
+
+	tests := []struct {
+		name         string
+		clientID     string
+		clientSecret string
+		scope        string
+		status       int
+		err          string
+	}{
+		{
+			name:         "wrong secret",
+			clientID:     serviceAccountID,
+			clientSecret: "wrong",
+			status:       http.StatusUnauthorized,
+			err:          "invalid_client",
+		},
+		{
+			name:         "unknown service account",
+			clientID:     "unknown",
+			clientSecret: serviceAccountSecret,
+			status:       http.StatusUnauthorized,
+			err:          "invalid_client",
+		},
+		{
+			name:         "disabled service account",
+			clientID:     "disabled",
+			clientSecret: serviceAccountSecret,
+			status:       http.StatusUnauthorized,
+			err:          "invalid_client",
+		},
+		{
+			name:         "scope is not granted",
+			clientID:     serviceAccountID,
+			clientSecret: serviceAccountSecret,
+			scope:        "user:read user:admin",
+			status:       http.StatusBadRequest,
+			err:          "invalid_scope",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			res, token := c.tokenBasicAuth(tt.clientID, tt.clientSecret, url.Values{
+				"grant_type": {model.OAuthGrantTypeClientCredentials},
+				"scope":      {tt.scope},
+			})
+
+			assert.Equal(t, tt.status, res.StatusCode)
+			assert.Equal(t, tt.err, token.Error)
+			assert.Empty(t, token.AccessToken)
+			if tt.status == http.StatusUnauthorized {
+				assert.NotEmpty(t, res.Header.Get("WWW-Authenticate"))
+			}
+		})
+	}
+
+	t.Run("missing secret", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		status, token := c.token(url.Values{
+			"grant_type": {model.OAuthGrantTypeClientCredentials},
+			"client_id":  {serviceAccountID},
+		})
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "invalid_client", token.Error)
+	})
+}
+
+func TestOpenIDConnect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("discovery", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		var metadata map[string]interface{}
+		res := c.get(oauthserver.DiscoveryPath, nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&metadata))
+
+		assert.Equal(t, issuer, metadata["issuer"])
+		assert.Equal(t, issuer+"/oauth/authorize", metadata["authorization_endpoint"])
+		assert.Equal(t, issuer+"/oauth/token", metadata["token_endpoint"])
+		assert.Equal(t, issuer+"/userinfo", metadata["userinfo_endpoint"])
+		assert.Equal(t, issuer+"/.well-known/jwks.json", metadata["jwks_uri"])
+		assert.Equal(t, []interface{}{"RS256"}, metadata["id_token_signing_alg_values_supported"])
+		assert.Contains(t, metadata["scopes_supported"], "openid")
+	})
+
+	t.Run("id token and userinfo", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		params := authorizeParams()
+		params.Set("scope", "openid profile email")
+		params.Set("nonce", "n-0S6_WzA2Mj")
+		authorizedAt := time.Now().Add(-time.Second)
+
+		status, token := c.token(exchangeParams(c.authorizeWith(params)))
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+		require.NotEmpty(t, token.IDToken)
+
+		claims := &model.IDTokenClaims{}
+		_, err := jwt.ParseWithClaims(token.IDToken, claims, c.jwksKey)
+		require.NoError(t, err)
+		assert.Equal(t, issuer, claims.Issuer)
+		assert.Equal(t, clientID, claims.Audience)
+		assert.Equal(t, "7", claims.Subject)
+		assert.Equal(t, "n-0S6_WzA2Mj", claims.Nonce)
+		assert.Equal(t, []string{"pwd"}, claims.AMR)
+		assert.GreaterOrEqual(t, claims.AuthTime, authorizedAt.Unix())
+		assert.Equal(t, username, claims.Name)
+		assert.Equal(t, "alice@example.com", claims.Email)
+		require.NotNil(t, claims.EmailVerified)
+		assert.True(t, *claims.EmailVerified)
+
+		var info map[string]interface{}
+		res := c.userInfo(token.AccessToken)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&info))
+		assert.Equal(t, map[string]interface{}{
+			"sub":                "7",
+			"name":               username,
+			"preferred_username": username,
+			"email":              "alice@example.com",
+			"email_verified":     true,
+		}, info)
+	})
+
+	t.Run("id token releases claims of requested scopes", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		params := authorizeParams()
+		params.Set("scope", "openid")
+
+		status, token := c.token(exchangeParams(c.authorizeWith(params)))
+		require.Equal(t, http.StatusOK, status, token.ErrorDescription)
+
+		claims := &model.IDTokenClaims{}
+		_, err := jwt.ParseWithClaims(token.IDToken, claims, c.jwksKey)
+		require.NoError(t, err)
+		assert.Equal(t, "7", claims.Subject)
+		assert.Empty(t, claims.Nonce)
+		assert.Empty(t, claims.Name)
+		assert.Empty(t, claims.Email)
+		assert.Nil(t, claims.EmailVerified)
+	})
+
+	t.Run("userinfo rejects invalid token", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		res := c.userInfo("invalid")
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		assert.Contains(t, res.Header.Get("WWW-Authenticate"), "invalid_token")
+
+		res = c.userInfo("")
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("userinfo rejects service account token", func(t *testing.T) {
+		t.Parallel()
+		c := newTestClient(t)
+
+		res, token := c.tokenBasicAuth(serviceAccountID, serviceAccountSecret, url.Values{
+			"grant_type": {model.OAuthGrantTypeClientCredentials},
+		})
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		res = c.userInfo(token.AccessToken)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
+
+// jwksKey returns key of published key set which signed ID token
+func (c *testClient) jwksKey(token *jwt.Token) (interface{}, error) {
+	require.Equal(c.t, jwt.SigningMethodRS256, token.Method)
+
+	var keys model.JSONWebKeySet
+	res := c.get(oauthserver.JWKSPath, nil)
+	require.NoError(c.t, json.NewDecoder(res.Body).Decode(&keys))
+	require.Len(c.t, keys.Keys, 1)
+
+	key := keys.Keys[0]
+	require.Equal(c.t, key.KeyID, token.Header["kid"])
+
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	require.NoError(c.t, err)
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	require.NoError(c.t, err)
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+func (c *testClient) userInfo(accessToken string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, c.serverURL+oauthserver.UserInfoPath, nil)
+	require.NoError(c.t, err)
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	res, err := c.httpClient.Do(req)
+	require.NoError(c.t, err)
+	c.t.Cleanup(func() { _ = res.Body.Close() })
+
+	return res
+}
@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+// userStore is in-memory users of tenant
+type userStore struct {
+	mu     sync.Mutex
+	users  map[int64]*model.User
+	nextID int64
+}
+
+func newUserStore(users ...*model.User) *userStore {
+	store := &userStore{
+		users:  make(map[int64]*model.User),
+		nextID: 100,
+	}
+	for _, user := range users {
+		store.users[user.ID] = user
+	}
+
+	return store
+}
+
+func (s *userStore) get(id int64) (*model.User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+
+	return user, ok
+}
+
+func (s *userStore) update(id int64, f func(user *model.User)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f(s.users[id])
+}
+
+func (s *userStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.users)
+}
+
+func (s *userStore) byName(ctx context.Context, name string) (*model.User, bool) {
+	if ctxTenantID, _ := tenant.FromContext(ctx); ctxTenantID != tenantID {
+		return nil, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, user := range s.users {
+		if user.Name == name {
+			return user, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *userStore) mock(mc *minimock.Controller) repository.UserRepository {
+	mock := repoMocks.NewUserRepositoryMock(mc)
+	mock.GetUserByNameMock.Optional().Set(func(ctx context.Context, name string) (
+		*model.User, error,
+	) {
+		user, ok := s.byName(ctx, name)
+		if !ok {
+			return nil, model.ErrorUserNotFound
+		}
+		return user, nil
+	})
+	mock.GetUserMock.Optional().Set(func(_ context.Context, id int64) (*model.User, error) {
+		user, ok := s.get(id)
+		if !ok {
+			return nil, model.ErrorUserNotFound
+		}
+		return user, nil
+	})
+	mock.FindUserNamesMock.Optional().Set(func(ctx context.Context, names []string) (
+		[]string, error,
+	) {
+		var found []string
+		for _, name := range names {
+			if _, ok := s.byName(ctx, name); ok {
+				found = append(found, name)
+			}
+		}
+		return found, nil
+	})
+	mock.CreateUserMock.Optional().Set(func(ctx context.Context, newUser *model.NewUser) (
+		int64, error,
+	) {
+		ctxTenantID, err := tenant.FromContext(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.nextID++
+		user := &model.User{
+			ID:       s.nextID,
+			TenantID: ctxTenantID,
+			Name:     newUser.Name,
+			Email:    newUser.Email,
+			Role:     newUser.Role,
+			Password: newUser.Password,
+			Status:   newUser.Status,
+		}
+		if newUser.EmailVerified {
+			user.EmailVerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+		s.users[user.ID] = user
+
+		return user.ID, nil
+	})
+	mock.UpdateUserMock.Optional().Set(func(_ context.Context, info *model.UpdateUserInfo) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user, ok := s.users[info.ID]
+		if !ok {
+			return model.ErrorUserNotFound
+		}
+		if info.Role != nil {
+			user.Role = *info.Role
+		}
+
+		return nil
+	})
+
+	return mock
+}
+
+// identityStore is in-memory links of upstream accounts to users
+type identityStore struct {
+	mu         sync.Mutex
+	identities []*model.FederatedIdentity
+}
+
+func newIdentityStore() *identityStore {
+	return &identityStore{}
+}
+
+func (s *identityStore) all() []*model.FederatedIdentity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*model.FederatedIdentity(nil), s.identities...)
+}
+
+func (s *identityStore) mock(mc *minimock.Controller) repository.FederatedIdentityRepository {
+	mock := repoMocks.NewFederatedIdentityRepositoryMock(mc)
+	mock.GetFederatedIdentityMock.Optional().Set(func(ctx context.Context, provider,
+		subject string,
+	) (*model.FederatedIdentity, error) {
+		ctxTenantID, err := tenant.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, identity := range s.identities {
+			if identity.TenantID == ctxTenantID && identity.Provider == provider &&
+				identity.Subject == subject {
+				return identity, nil
+			}
+		}
+
+		return nil, model.ErrorFederatedIdentityNotFound
+	})
+	mock.CreateFederatedIdentityMock.Optional().Set(func(ctx context.Context,
+		identity *model.FederatedIdentity,
+	) (int64, error) {
+		ctxTenantID, err := tenant.FromContext(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		created := *identity
+		created.ID = int64(len(s.identities) + 1)
+		created.TenantID = ctxTenantID
+		s.identities = append(s.identities, &created)
+
+		return created.ID, nil
+	})
+
+	return mock
+}
+
+// stateStore is in-memory federated logins in progress
+type stateStore struct {
+	mu     sync.Mutex
+	states map[string]*model.FederationLoginState
+}
+
+func newStateStore() *stateStore {
+	return &stateStore{
+		states: make(map[string]*model.FederationLoginState),
+	}
+}
+
+func (s *stateStore) mock(mc *minimock.Controller) repository.FederationStateRepository {
+	mock := repoMocks.NewFederationStateRepositoryMock(mc)
+	mock.CreateLoginStateMock.Optional().Set(func(ctx context.Context,
+		state *model.FederationLoginState,
+	) error {
+		ctxTenantID, err := tenant.FromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		created := *state
+		created.TenantID = ctxTenantID
+		s.states[state.StateHash] = &created
+
+		return nil
+	})
+	mock.ConsumeLoginStateMock.Optional().Set(func(ctx context.Context, stateHash string) (
+		*model.FederationLoginState, error,
+	) {
+		ctxTenantID, err := tenant.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		state, ok := s.states[stateHash]
+		if !ok || state.TenantID != ctxTenantID {
+			return nil, model.ErrorFederationInvalidState
+		}
+		delete(s.states, stateHash)
+
+		return state, nil
+	})
+	mock.DeleteExpiredLoginStatesMock.Optional().Return(nil)
+
+	return mock
+}
+
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().
+		Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+
+	return mock
+}
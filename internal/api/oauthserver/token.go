@@ -0,0 +1,153 @@
+package oauthserver
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+}
+
+// Token serves token endpoint with authorization_code, refresh_token and
+// client_credentials grants
+func (s *Server) Token(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	setNoStore(w)
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, errorInvalidRequest, "invalid form body")
+		return
+	}
+
+	form := r.PostForm
+	clientID, clientSecret, basicAuth, err := clientCredentials(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorInvalidRequest, err.Error())
+		return
+	}
+	if clientID == "" {
+		writeError(w, http.StatusUnauthorized, errorInvalidClient, "client_id is required")
+		return
+	}
+
+	var token *model.OAuthToken
+	switch form.Get("grant_type") {
+	case model.OAuthGrantTypeAuthorizationCode:
+		if form.Get("code") == "" || form.Get("redirect_uri") == "" ||
+			form.Get("code_verifier") == "" {
+			writeError(w, http.StatusBadRequest, errorInvalidRequest,
+				"code, redirect_uri and code_verifier are required")
+			return
+		}
+		token, err = s.authService.ExchangeOAuthCode(r.Context(), &model.OAuthCodeExchange{
+			ClientID:     clientID,
+			Code:         form.Get("code"),
+			RedirectURI:  form.Get("redirect_uri"),
+			CodeVerifier: form.Get("code_verifier"),
+		})
+	case model.OAuthGrantTypeRefreshToken:
+		if form.Get("refresh_token") == "" {
+			writeError(w, http.StatusBadRequest, errorInvalidRequest, "refresh_token is required")
+			return
+		}
+		token, err = s.authService.RefreshOAuthToken(r.Context(), clientID,
+			form.Get("refresh_token"))
+	case model.OAuthGrantTypeClientCredentials:
+		if clientSecret == "" {
+			writeInvalidClient(w, basicAuth, "client_secret is required")
+			return
+		}
+		token, err = s.authService.IssueClientCredentialsToken(r.Context(),
+			&model.OAuthClientCredentials{
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				Scope:        form.Get("scope"),
+			})
+	case "":
+		writeError(w, http.StatusBadRequest, errorInvalidRequest, "grant_type is required")
+		return
+	default:
+		writeError(w, http.StatusBadRequest, errorUnsupportedGrantType,
+			"grant_type must be authorization_code, refresh_token or client_credentials")
+		return
+	}
+
+	switch {
+	case errors.Is(err, model.ErrorOAuthClientNotFound):
+		writeError(w, http.StatusUnauthorized, errorInvalidClient, "unknown client")
+		return
+	case errors.Is(err, model.ErrorOAuthInvalidClient):
+		logger.Warn("oauth client authentication failed", zap.String("client_id", clientID),
+			zap.Error(err))
+		writeInvalidClient(w, basicAuth, "client authentication failed")
+		return
+	case errors.Is(err, model.ErrorOAuthInvalidGrant):
+		logger.Warn("oauth grant rejected", zap.String("client_id", clientID), zap.Error(err))
+		writeError(w, http.StatusBadRequest, errorInvalidGrant, err.Error())
+		return
+	case errors.Is(err, model.ErrorOAuthInvalidScope):
+		writeError(w, http.StatusBadRequest, errorInvalidScope, err.Error())
+		return
+	case err != nil:
+		logger.ErrorWithMsg("unable to issue oauth token", err, zap.String("client_id", clientID))
+		writeError(w, http.StatusInternalServerError, errorServerError, "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &tokenResponse{
+		AccessToken:  token.AccessToken,
+		TokenType:    model.OAuthTokenTypeBearer,
+		ExpiresIn:    int64(time.Until(token.ExpiresAt).Seconds()),
+		RefreshToken: token.RefreshToken,
+		Scope:        token.Scope,
+		IDToken:      token.IDToken,
+	})
+}
+
+// clientCredentials returns client ID and secret of HTTP Basic authentication or
+// of request body, RFC 6749 section 2.3.1, client must use only one of them
+func clientCredentials(r *http.Request) (string, string, bool, error) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret"), false, nil
+	}
+
+	if r.PostForm.Has("client_secret") {
+		return "", "", true, errors.New("client must use only one authentication method")
+	}
+
+	clientID, err := url.QueryUnescape(username)
+	if err != nil {
+		return "", "", true, errors.New("invalid client_id encoding")
+	}
+	clientSecret, err := url.QueryUnescape(password)
+	if err != nil {
+		return "", "", true, errors.New("invalid client_secret encoding")
+	}
+	if formClientID := r.PostForm.Get("client_id"); formClientID != "" && formClientID != clientID {
+		return "", "", true, errors.New("client_id does not match authorization header")
+	}
+
+	return clientID, clientSecret, true, nil
+}
+
+// writeInvalidClient writes invalid_client error, client using HTTP Basic authentication
+// is challenged to authenticate, RFC 6749 section 5.2
+func writeInvalidClient(w http.ResponseWriter, basicAuth bool, description string) {
+	if basicAuth {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+	writeError(w, http.StatusUnauthorized, errorInvalidClient, description)
+}
@@ -0,0 +1,53 @@
+package oauthserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	bearerPrefix = "Bearer "
+	// userInfoScope releases every standard claim of user
+	userInfoScope = model.OIDCScopeProfile + " " + model.OIDCScopeEmail
+)
+
+type userInfoResponse struct {
+	Subject string `json:"sub"`
+	model.UserInfo
+}
+
+// UserInfo serves claims of user of access token, OpenID Connect Core 1.0 section 5.3.
+// Access tokens of users are not scoped, so every standard claim of user is returned.
+func (s *Server) UserInfo(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="userinfo"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	user, err := s.authService.GetUserInfo(r.Context(), strings.TrimPrefix(header, bearerPrefix))
+	switch {
+	case errors.Is(err, model.ErrorUnauthenticated):
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeError(w, http.StatusUnauthorized, "invalid_token", err.Error())
+		return
+	case err != nil:
+		logger.ErrorWithMsg("unable to get user info", err, zap.String("path", r.URL.Path))
+		writeError(w, http.StatusInternalServerError, errorServerError, "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &userInfoResponse{
+		Subject:  model.SubjectOf(user.ID),
+		UserInfo: model.NewUserInfo(user, userInfoScope),
+	})
+}
@@ -0,0 +1,28 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+)
+
+// CreateOrganization creates new organization
+func (i *Implementation) CreateOrganization(ctx context.Context,
+	req *organization_v1.CreateOrganizationRequest,
+) (*organization_v1.CreateOrganizationResponse, error) {
+	err := validateCreateOrganization(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := i.organizationService.CreateOrganization(ctx, req.GetName())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &organization_v1.CreateOrganizationResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// CreateRole creates new role in organization
+func (i *Implementation) CreateRole(ctx context.Context, req *organization_v1.CreateRoleRequest) (
+	*organization_v1.CreateRoleResponse, error,
+) {
+	err := validateCreateRole(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := i.organizationService.CreateRole(ctx, converter.ToRoleFromCreateRoleV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &organization_v1.CreateRoleResponse{
+		Id: id,
+	}, nil
+}
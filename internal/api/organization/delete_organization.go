@@ -0,0 +1,27 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+)
+
+// DeleteOrganization removes organization
+func (i *Implementation) DeleteOrganization(ctx context.Context,
+	req *organization_v1.DeleteOrganizationRequest,
+) (*emptypb.Empty, error) {
+	err := validateDeleteOrganization(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.organizationService.DeleteOrganization(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
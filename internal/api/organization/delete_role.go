@@ -0,0 +1,27 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+)
+
+// DeleteRole removes role from organization
+func (i *Implementation) DeleteRole(ctx context.Context, req *organization_v1.DeleteRoleRequest) (
+	*emptypb.Empty, error,
+) {
+	err := validateDeleteRole(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.organizationService.DeleteRole(ctx, req.GetOrganizationId(), req.GetName())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,29 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// GetOrganization returns info about organization
+func (i *Implementation) GetOrganization(ctx context.Context,
+	req *organization_v1.GetOrganizationRequest,
+) (*organization_v1.GetOrganizationResponse, error) {
+	err := validateGetOrganization(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	org, err := i.organizationService.GetOrganization(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &organization_v1.GetOrganizationResponse{
+		Organization: converter.ToOrganizationV1FromService(org),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// ListOrganizations returns all organizations
+func (i *Implementation) ListOrganizations(ctx context.Context, _ *emptypb.Empty) (
+	*organization_v1.ListOrganizationsResponse, error,
+) {
+	orgs, err := i.organizationService.ListOrganizations(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &organization_v1.ListOrganizationsResponse{
+		Organizations: converter.ToOrganizationsV1FromService(orgs),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// ListRoles returns roles of organization
+func (i *Implementation) ListRoles(ctx context.Context, req *organization_v1.ListRolesRequest) (
+	*organization_v1.ListRolesResponse, error,
+) {
+	err := validateListRoles(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	roles, err := i.organizationService.ListRoles(ctx, req.GetOrganizationId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &organization_v1.ListRolesResponse{
+		Roles: converter.ToRolesV1FromService(roles),
+	}, nil
+}
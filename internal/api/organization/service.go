@@ -0,0 +1,19 @@
+package organization
+
+import (
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	organization_v1.UnimplementedOrganizationV1Server
+	organizationService service.OrganizationService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(organizationService service.OrganizationService) *Implementation {
+	return &Implementation{
+		organizationService: organizationService,
+	}
+}
@@ -0,0 +1,55 @@
+package organization
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+)
+
+func validateCreateOrganization(req *organization_v1.CreateOrganizationRequest) error {
+	if req == nil {
+		return errors.New("unable to create organization: empty request")
+	}
+
+	return nil
+}
+
+func validateGetOrganization(req *organization_v1.GetOrganizationRequest) error {
+	if req == nil {
+		return errors.New("unable to get organization: empty request")
+	}
+
+	return nil
+}
+
+func validateDeleteOrganization(req *organization_v1.DeleteOrganizationRequest) error {
+	if req == nil {
+		return errors.New("unable to delete organization: empty request")
+	}
+
+	return nil
+}
+
+func validateCreateRole(req *organization_v1.CreateRoleRequest) error {
+	if req == nil {
+		return errors.New("unable to create role: empty request")
+	}
+
+	return nil
+}
+
+func validateListRoles(req *organization_v1.ListRolesRequest) error {
+	if req == nil {
+		return errors.New("unable to list roles: empty request")
+	}
+
+	return nil
+}
+
+func validateDeleteRole(req *organization_v1.DeleteRoleRequest) error {
+	if req == nil {
+		return errors.New("unable to delete role: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// EraseUser creates erasure request, user is erased after admin approval
+func (i *Implementation) EraseUser(ctx context.Context,
+	req *privacy_v1.EraseUserRequest,
+) (*privacy_v1.ErasureRequest, error) {
+	err := validateEraseUser(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	request, err := i.privacyService.EraseUser(ctx, token, req.GetUserId(), req.GetReason())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToErasureRequestV1FromService(request), nil
+}
@@ -0,0 +1,39 @@
+package privacy
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+	"github.com/valek177/auth/internal/utils"
+)
+
+const bundleContentType = "application/json"
+
+// ExportMyData returns all data stored about caller
+func (i *Implementation) ExportMyData(ctx context.Context, _ *emptypb.Empty) (
+	*privacy_v1.ExportMyDataResponse, error,
+) {
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	bundle, err := i.privacyService.ExportMyData(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &privacy_v1.ExportMyDataResponse{
+		ContentType: bundleContentType,
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// GetErasureRequest returns erasure request
+func (i *Implementation) GetErasureRequest(ctx context.Context,
+	req *privacy_v1.GetErasureRequestRequest,
+) (*privacy_v1.ErasureRequest, error) {
+	err := validateGetErasureRequest(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	request, err := i.privacyService.GetErasureRequest(ctx, token, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToErasureRequestV1FromService(request), nil
+}
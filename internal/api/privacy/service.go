@@ -0,0 +1,19 @@
+package privacy
+
+import (
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	privacy_v1.UnimplementedPrivacyV1Server
+	privacyService service.PrivacyService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(privacyService service.PrivacyService) *Implementation {
+	return &Implementation{
+		privacyService: privacyService,
+	}
+}
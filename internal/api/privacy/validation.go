@@ -0,0 +1,42 @@
+package privacy
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+)
+
+func validateEraseUser(req *privacy_v1.EraseUserRequest) error {
+	if req == nil {
+		return errors.New("unable to erase user: empty request")
+	}
+	if req.GetUserId() == 0 {
+		return errors.New("unable to erase user: user id is required")
+	}
+
+	return nil
+}
+
+func validateGetErasureRequest(req *privacy_v1.GetErasureRequestRequest) error {
+	if req == nil {
+		return errors.New("unable to get erasure request: empty request")
+	}
+
+	return nil
+}
+
+func validateApproveErasure(req *privacy_v1.ApproveErasureRequest) error {
+	if req == nil {
+		return errors.New("unable to approve erasure: empty request")
+	}
+
+	return nil
+}
+
+func validateRejectErasure(req *privacy_v1.RejectErasureRequest) error {
+	if req == nil {
+		return errors.New("unable to reject erasure: empty request")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// CheckRelation checks whether subject has relation to object
+func (i *Implementation) CheckRelation(ctx context.Context,
+	req *relation_v1.CheckRelationRequest,
+) (*relation_v1.CheckRelationResponse, error) {
+	err := validateCheckRelation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res, err := i.relationService.CheckRelation(ctx, token, converter.ToRelationCheckFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &relation_v1.CheckRelationResponse{
+		Allowed: res.Allowed,
+		Zookie:  res.Zookie,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// Expand returns userset tree of object relation
+func (i *Implementation) Expand(ctx context.Context, req *relation_v1.ExpandRequest) (
+	*relation_v1.ExpandResponse, error,
+) {
+	err := validateExpand(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res, err := i.relationService.Expand(ctx, token, converter.ToRelationExpandFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &relation_v1.ExpandResponse{
+		Tree:   converter.ToUsersetTreeFromService(res.Tree),
+		Zookie: res.Zookie,
+	}, nil
+}
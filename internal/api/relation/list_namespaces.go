@@ -0,0 +1,31 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListNamespaces returns namespace configurations of organization
+func (i *Implementation) ListNamespaces(ctx context.Context, _ *emptypb.Empty) (
+	*relation_v1.ListNamespacesResponse, error,
+) {
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	namespaces, err := i.relationService.ListNamespaces(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &relation_v1.ListNamespacesResponse{
+		Namespaces: converter.ToNamespacesFromService(namespaces),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// ListObjects returns objects of namespace subject has relation to
+func (i *Implementation) ListObjects(ctx context.Context, req *relation_v1.ListObjectsRequest) (
+	*relation_v1.ListObjectsResponse, error,
+) {
+	err := validateListObjects(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res, err := i.relationService.ListObjects(ctx, token,
+		converter.ToRelationListObjectsFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &relation_v1.ListObjectsResponse{
+		ObjectIds: res.ObjectIDs,
+		Zookie:    res.Zookie,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package relation
+
+import (
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/service"
+)
+
+// Implementation struct contains server
+type Implementation struct {
+	relation_v1.UnimplementedRelationV1Server
+	relationService service.RelationService
+}
+
+// NewImplementation returns implementation object
+func NewImplementation(relationService service.RelationService) *Implementation {
+	return &Implementation{
+		relationService: relationService,
+	}
+}
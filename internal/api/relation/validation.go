@@ -0,0 +1,47 @@
+package relation
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+)
+
+func validateWriteNamespace(req *relation_v1.WriteNamespaceRequest) error {
+	if req == nil || req.GetNamespace() == nil {
+		return errors.New("unable to write namespace: empty request")
+	}
+
+	return nil
+}
+
+func validateWriteTuples(req *relation_v1.WriteTuplesRequest) error {
+	if req == nil {
+		return errors.New("unable to write tuples: empty request")
+	}
+
+	return nil
+}
+
+func validateCheckRelation(req *relation_v1.CheckRelationRequest) error {
+	if req == nil {
+		return errors.New("unable to check relation: empty request")
+	}
+
+	return nil
+}
+
+func validateExpand(req *relation_v1.ExpandRequest) error {
+	if req == nil {
+		return errors.New("unable to expand relation: empty request")
+	}
+
+	return nil
+}
+
+func validateListObjects(req *relation_v1.ListObjectsRequest) error {
+	if req == nil {
+		return errors.New("unable to list objects: empty request")
+	}
+
+	return nil
+}
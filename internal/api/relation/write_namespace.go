@@ -0,0 +1,35 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// WriteNamespace creates or replaces namespace configuration
+func (i *Implementation) WriteNamespace(ctx context.Context,
+	req *relation_v1.WriteNamespaceRequest,
+) (*emptypb.Empty, error) {
+	err := validateWriteNamespace(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = i.relationService.WriteNamespace(ctx, token,
+		converter.ToRelationNamespaceFromV1(req.GetNamespace()))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,36 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// WriteTuples atomically deletes and creates tuples
+func (i *Implementation) WriteTuples(ctx context.Context, req *relation_v1.WriteTuplesRequest) (
+	*relation_v1.WriteTuplesResponse, error,
+) {
+	err := validateWriteTuples(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	zookie, err := i.relationService.WriteTuples(ctx, token,
+		converter.ToRelationTupleWriteFromV1(req))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &relation_v1.WriteTuplesResponse{
+		Zookie: zookie,
+	}, nil
+}
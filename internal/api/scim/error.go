@@ -0,0 +1,83 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+// SCIM error types, RFC 7644 section 3.12
+const (
+	errorInvalidFilter = "invalidFilter"
+	errorUniqueness    = "uniqueness"
+	errorMutability    = "mutability"
+	errorInvalidSyntax = "invalidSyntax"
+	errorInvalidPath   = "invalidPath"
+	errorNoTarget      = "noTarget"
+	errorInvalidValue  = "invalidValue"
+)
+
+// scimError is error written to client as SCIM error response
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, scimType, detail string) *scimError {
+	return &scimError{
+		status:   status,
+		scimType: scimType,
+		detail:   detail,
+	}
+}
+
+func badRequest(scimType, format string, args ...interface{}) *scimError {
+	return newError(http.StatusBadRequest, scimType, fmt.Sprintf(format, args...))
+}
+
+func notFound(format string, args ...interface{}) *scimError {
+	return newError(http.StatusNotFound, "", fmt.Sprintf(format, args...))
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// writeError writes SCIM error response
+func writeError(w http.ResponseWriter, err *scimError) {
+	writeJSON(w, err.status, &errorResponse{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(err.status),
+		ScimType: err.scimType,
+		Detail:   err.detail,
+	})
+}
+
+// writeFailure writes error of request, unexpected errors are logged and hidden from client
+func (s *Server) writeFailure(w http.ResponseWriter, r *http.Request, err error) {
+	var scimErr *scimError
+	switch {
+	case errors.As(err, &scimErr):
+		writeError(w, scimErr)
+	case errors.Is(err, model.ErrorUserNotFound):
+		writeError(w, notFound("user not found"))
+	default:
+		logger.ErrorWithMsg("unable to serve scim request", err,
+			zap.String("method", r.Method), zap.String("path", r.URL.Path))
+		writeError(w, newError(http.StatusInternalServerError, "", "internal error"))
+	}
+}
@@ -0,0 +1,514 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Filters of list requests, RFC 7644 section 3.4.2.2:
+//
+//	userName eq "alice"
+//	emails[type eq "work" and value ew "@example.com"]
+//	active eq true and not (groups.display eq "ADMIN")
+//	meta.lastModified gt "2026-01-01T00:00:00Z" or title pr
+//
+// Filters are evaluated over resources with lowercased attribute names, strings are
+// compared case-insensitively except case-exact attributes.
+
+// caseExactAttributes are compared case-sensitively, user names are unique
+// case-sensitively and are looked up as is
+var caseExactAttributes = map[string]bool{"id": true, "username": true}
+
+// filter is parsed filter expression
+type filter interface {
+	match(attributes map[string]interface{}) bool
+}
+
+// attrPath is attribute and optional sub-attribute, names are lowercased
+type attrPath struct {
+	attr string
+	sub  string
+}
+
+type logicalFilter struct {
+	and         bool
+	left, right filter
+}
+
+func (f *logicalFilter) match(attributes map[string]interface{}) bool {
+	if f.and {
+		return f.left.match(attributes) && f.right.match(attributes)
+	}
+
+	return f.left.match(attributes) || f.right.match(attributes)
+}
+
+type notFilter struct {
+	filter filter
+}
+
+func (f *notFilter) match(attributes map[string]interface{}) bool {
+	return !f.filter.match(attributes)
+}
+
+// valuePathFilter matches resource having value of multi-valued attribute matching filter
+type valuePathFilter struct {
+	attr   string
+	filter filter
+}
+
+func (f *valuePathFilter) match(attributes map[string]interface{}) bool {
+	for _, element := range elements(attributes[f.attr]) {
+		object, ok := element.(map[string]interface{})
+		if ok && f.filter.match(object) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type compareFilter struct {
+	path  attrPath
+	op    string
+	value interface{}
+}
+
+func (f *compareFilter) match(attributes map[string]interface{}) bool {
+	values := attributeValues(attributes, f.path)
+	switch {
+	case f.op == "pr":
+		for _, value := range values {
+			if value != "" {
+				return true
+			}
+		}
+		return false
+	case f.value == nil:
+		return (f.op == "eq") == (len(values) == 0)
+	case f.op == "ne":
+		return !(&compareFilter{path: f.path, op: "eq", value: f.value}).match(attributes)
+	}
+
+	caseExact := caseExactAttributes[f.path.attr] && f.path.sub == ""
+	for _, value := range values {
+		if compare(value, f.op, f.value, caseExact) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compare compares attribute value with filter value of the same type
+func compare(actual interface{}, op string, expected interface{}, caseExact bool) bool {
+	switch expected := expected.(type) {
+	case string:
+		value, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		if !caseExact {
+			value, expected = strings.ToLower(value), strings.ToLower(expected)
+		}
+		switch op {
+		case "eq":
+			return value == expected
+		case "co":
+			return strings.Contains(value, expected)
+		case "sw":
+			return strings.HasPrefix(value, expected)
+		case "ew":
+			return strings.HasSuffix(value, expected)
+		case "gt":
+			return value > expected
+		case "ge":
+			return value >= expected
+		case "lt":
+			return value < expected
+		case "le":
+			return value <= expected
+		}
+	case bool:
+		value, ok := actual.(bool)
+		return ok && op == "eq" && value == expected
+	case float64:
+		value, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return value == expected
+		case "gt":
+			return value > expected
+		case "ge":
+			return value >= expected
+		case "lt":
+			return value < expected
+		case "le":
+			return value <= expected
+		}
+	}
+
+	return false
+}
+
+// elements returns values of multi-valued attribute or single value
+func elements(value interface{}) []interface{} {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return value
+	default:
+		return []interface{}{value}
+	}
+}
+
+// attributeValues returns values of attribute path, value of multi-valued complex
+// attribute without sub-attribute is its "value" sub-attribute
+func attributeValues(attributes map[string]interface{}, path attrPath) []interface{} {
+	var values []interface{}
+	for _, element := range elements(attributes[path.attr]) {
+		object, isObject := element.(map[string]interface{})
+		switch {
+		case isObject && path.sub != "":
+			element = object[path.sub]
+		case isObject:
+			element = object["value"]
+		case path.sub != "":
+			continue
+		}
+		if element != nil {
+			values = append(values, element)
+		}
+	}
+
+	return values
+}
+
+// toAttributes returns resource as JSON object with lowercased attribute names
+func toAttributes(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes map[string]interface{}
+	if err = json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+
+	return lowerKeys(attributes).(map[string]interface{}), nil
+}
+
+func lowerKeys(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		lowered := make(map[string]interface{}, len(value))
+		for key, element := range value {
+			lowered[strings.ToLower(key)] = lowerKeys(element)
+		}
+		return lowered
+	case []interface{}:
+		for i, element := range value {
+			value[i] = lowerKeys(element)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+var attrNameRegexp = regexp.MustCompile(`^[A-Za-z$][A-Za-z0-9_$-]*$`)
+
+// parseAttrPath parses attribute path, schema URN prefix of attribute is dropped
+func parseAttrPath(raw string) (attrPath, bool) {
+	if strings.HasPrefix(strings.ToLower(raw), "urn:") {
+		raw = raw[strings.LastIndex(raw, ":")+1:]
+	}
+
+	attr, sub, hasSub := strings.Cut(raw, ".")
+	if !attrNameRegexp.MatchString(attr) || (hasSub && !attrNameRegexp.MatchString(sub)) {
+		return attrPath{}, false
+	}
+
+	return attrPath{attr: strings.ToLower(attr), sub: strings.ToLower(sub)}, true
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize splits filter into words, JSON strings, parentheses and brackets
+func tokenize(raw string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(raw); {
+		switch c := raw[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenLBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenRBracket, text: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(raw) && raw[end] != '"'; end++ {
+				if raw[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(raw) {
+				return nil, badRequest(errorInvalidFilter, "unterminated string in filter")
+			}
+			var value string
+			if err := json.Unmarshal([]byte(raw[i:end+1]), &value); err != nil {
+				return nil, badRequest(errorInvalidFilter, "invalid string %s in filter",
+					raw[i:end+1])
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value})
+			i = end + 1
+		default:
+			size := strings.IndexAny(raw[i:], " \t\n\r()[]\"")
+			if size < 0 {
+				size = len(raw) - i
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: raw[i : i+size]})
+			i += size
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parseFilter parses filter expression, "not" binds tighter than "and", "and" than "or"
+func parseFilter(raw string) (filter, error) {
+	tokens, err := tokenize(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.next(); tok.kind != tokenEOF {
+		return nil, badRequest(errorInvalidFilter, "unexpected %q in filter", tok.text)
+	}
+
+	return f, nil
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	tok := p.tokens[p.pos]
+
+	return tok.kind == tokenWord && strings.EqualFold(tok.text, keyword)
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	if tok := p.next(); tok.kind != kind {
+		return badRequest(errorInvalidFilter, "expected %q in filter", text)
+	}
+
+	return nil
+}
+
+func (p *parser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (filter, error) {
+	if p.peekKeyword("not") {
+		p.next()
+		if err := p.expect(tokenLParen, "("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		return &notFilter{filter: f}, nil
+	}
+
+	tok := p.next()
+	switch tok.kind {
+	case tokenLParen:
+		return p.parseGroup()
+	case tokenWord:
+		return p.parseAttrExpression(tok.text)
+	case tokenEOF:
+		return nil, badRequest(errorInvalidFilter, "unexpected end of filter")
+	default:
+		return nil, badRequest(errorInvalidFilter, "unexpected %q in filter", tok.text)
+	}
+}
+
+// parseGroup parses expression in parentheses after opening one
+func (p *parser) parseGroup() (filter, error) {
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(tokenRParen, ")"); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (p *parser) parseAttrExpression(raw string) (filter, error) {
+	path, ok := parseAttrPath(raw)
+	if !ok {
+		return nil, badRequest(errorInvalidFilter, "invalid attribute path %q", raw)
+	}
+
+	if p.tokens[p.pos].kind == tokenLBracket {
+		p.next()
+		if path.sub != "" {
+			return nil, badRequest(errorInvalidFilter, "invalid value path %q", raw)
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(tokenRBracket, "]"); err != nil {
+			return nil, err
+		}
+		return &valuePathFilter{attr: path.attr, filter: f}, nil
+	}
+
+	tok := p.next()
+	if tok.kind != tokenWord {
+		return nil, badRequest(errorInvalidFilter, "expected operator after %q", raw)
+	}
+	op := strings.ToLower(tok.text)
+	if op == "pr" {
+		return &compareFilter{path: path, op: op}, nil
+	}
+
+	value, err := parseValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	if err = validateOperator(op, value); err != nil {
+		return nil, err
+	}
+
+	return &compareFilter{path: path, op: op, value: value}, nil
+}
+
+// parseValue parses comparison value: string, boolean, null or number
+func parseValue(tok token) (interface{}, error) {
+	switch {
+	case tok.kind == tokenString:
+		return tok.text, nil
+	case tok.kind != tokenWord:
+		return nil, badRequest(errorInvalidFilter, "expected comparison value")
+	}
+
+	switch strings.ToLower(tok.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	number, err := strconv.ParseFloat(tok.text, 64)
+	if err != nil {
+		return nil, badRequest(errorInvalidFilter, "invalid comparison value %q", tok.text)
+	}
+
+	return number, nil
+}
+
+// validateOperator checks that operator can compare value
+func validateOperator(op string, value interface{}) error {
+	switch op {
+	case "eq", "ne":
+		return nil
+	case "co", "sw", "ew":
+		if _, ok := value.(string); ok {
+			return nil
+		}
+	case "gt", "ge", "lt", "le":
+		switch value.(type) {
+		case string, float64:
+			return nil
+		}
+	default:
+		return badRequest(errorInvalidFilter, "unknown operator %q", op)
+	}
+
+	return badRequest(errorInvalidFilter, "operator %q can't compare %v", op, value)
+}
@@ -0,0 +1,547 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// Groups are roles of tenant, members of group are users having its role. User has
+// the only role, so user added to group leaves its previous group and user removed
+// from group gets role USER.
+
+type groupResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	Members     []*reference `json:"members,omitempty"`
+	Meta        *meta        `json:"meta"`
+}
+
+type groupRequest struct {
+	DisplayName string       `json:"displayName"`
+	Members     []*reference `json:"members"`
+}
+
+// CreateGroup creates role with members
+func (s *Server) CreateGroup(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	role, err := s.createGroup(ctx, w, r)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resource, err := s.groupResource(ctx, role, false)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", resource.Meta.Location)
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *Server) createGroup(ctx context.Context, w http.ResponseWriter, r *http.Request) (
+	*model.Role, error,
+) {
+	var req groupRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		return nil, err
+	}
+	if req.DisplayName == "" {
+		return nil, badRequest(errorInvalidValue, "displayName is required")
+	}
+
+	roles, err := s.listRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.Name == req.DisplayName {
+			return nil, newError(http.StatusConflict, errorUniqueness,
+				"group "+req.DisplayName+" already exists")
+		}
+	}
+
+	members, err := s.memberIDs(ctx, req.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role := &model.Role{TenantID: tenantID, Name: req.DisplayName}
+	role.ID, err = s.organizationService.CreateRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range members {
+		if err = s.setRole(ctx, id, role.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.findGroup(ctx, strconv.FormatInt(role.ID, 10))
+}
+
+// GetGroup returns group with its members
+func (s *Server) GetGroup(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	role, err := s.findGroup(ctx, pathParams["id"])
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resource, err := s.groupResource(ctx, role, excludesMembers(r))
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+// ListGroups returns page of groups matching filter
+func (s *Server) ListGroups(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	params, err := parseListParams(r)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resources, err := s.listGroups(ctx, params)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, params.page(resources))
+}
+
+func (s *Server) listGroups(ctx context.Context, params *listParams) ([]interface{}, error) {
+	roles, err := s.listRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.roleMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		resource := s.toGroupResource(role, members[role.Name])
+		matched, err := matches(params.filter, resource)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+		if params.excludeMembers {
+			resource.Members = nil
+		}
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+// PatchGroup adds, replaces and removes members of group, group can't be renamed
+func (s *Server) PatchGroup(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	role, err := s.patchGroup(ctx, w, r, pathParams["id"])
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resource, err := s.groupResource(ctx, role, excludesMembers(r))
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+// patchGroup applies operations to members of group, users are updated after every
+// operation is validated
+func (s *Server) patchGroup(ctx context.Context, w http.ResponseWriter, r *http.Request,
+	id string,
+) (*model.Role, error) {
+	operations, err := decodePatchRequest(w, r)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.findGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.members(ctx, role.Name)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[int64]*model.User, len(current))
+	for _, user := range current {
+		members[user.ID] = user
+	}
+
+	patch := &groupPatch{server: s, role: role, members: members}
+	for _, operation := range operations {
+		if err = patch.apply(ctx, operation.Op, operation.path, operation.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, user := range current {
+		if _, ok := patch.members[user.ID]; !ok {
+			if role.Name == model.RoleUser {
+				return nil, badRequest(errorMutability,
+					"members can't be removed from group %s", model.RoleUser)
+			}
+			if err = s.setRole(ctx, user.ID, model.RoleUser); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for userID, user := range patch.members {
+		if user.Role != role.Name {
+			if err = s.setRole(ctx, userID, role.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return role, nil
+}
+
+// DeleteGroup deletes role, its members get role USER, default roles can't be deleted
+func (s *Server) DeleteGroup(w http.ResponseWriter, r *http.Request,
+	pathParams map[string]string,
+) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.deleteGroup(ctx, pathParams["id"]); err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteGroup(ctx context.Context, id string) error {
+	role, err := s.findGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, name := range model.DefaultRoles {
+		if role.Name == name {
+			return badRequest(errorMutability, "default group %s can't be deleted", name)
+		}
+	}
+
+	members, err := s.members(ctx, role.Name)
+	if err != nil {
+		return err
+	}
+	for _, user := range members {
+		if err = s.setRole(ctx, user.ID, model.RoleUser); err != nil {
+			return err
+		}
+	}
+
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.organizationService.DeleteRole(ctx, tenantID, role.Name)
+}
+
+// findGroup returns role of tenant by SCIM id
+func (s *Server) findGroup(ctx context.Context, id string) (*model.Role, error) {
+	roleID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, notFound("group %s not found", id)
+	}
+
+	roles, err := s.listRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.ID == roleID {
+			return role, nil
+		}
+	}
+
+	return nil, notFound("group %s not found", id)
+}
+
+func (s *Server) listRoles(ctx context.Context) ([]*model.Role, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.organizationService.ListRoles(ctx, tenantID)
+}
+
+// roleIDs returns ids of roles of tenant by name
+func (s *Server) roleIDs(ctx context.Context) (map[string]int64, error) {
+	roles, err := s.listRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int64, len(roles))
+	for _, role := range roles {
+		ids[role.Name] = role.ID
+	}
+
+	return ids, nil
+}
+
+// roleMembers returns users of tenant by role
+func (s *Server) roleMembers(ctx context.Context) (map[string][]*model.User, error) {
+	users, err := s.allUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string][]*model.User)
+	for _, user := range users {
+		members[user.Role] = append(members[user.Role], user)
+	}
+
+	return members, nil
+}
+
+func (s *Server) members(ctx context.Context, role string) ([]*model.User, error) {
+	members, err := s.roleMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return members[role], nil
+}
+
+// memberIDs returns ids of existing users referenced as members
+func (s *Server) memberIDs(ctx context.Context, members []*reference) ([]int64, error) {
+	users, err := s.memberUsers(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+
+	return ids, nil
+}
+
+func (s *Server) memberUsers(ctx context.Context, members []*reference) ([]*model.User, error) {
+	users := make([]*model.User, 0, len(members))
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		user, err := s.findUser(ctx, member.Value)
+		if err != nil {
+			return nil, badRequest(errorInvalidValue, "member %s not found", member.Value)
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+func (s *Server) setRole(ctx context.Context, userID int64, role string) error {
+	return s.userService.UpdateUser(ctx, &model.UpdateUserInfo{ID: userID, Role: &role})
+}
+
+func (s *Server) groupResource(ctx context.Context, role *model.Role, excludeMembers bool) (
+	*groupResource, error,
+) {
+	var members []*model.User
+	if !excludeMembers {
+		var err error
+		members, err = s.members(ctx, role.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.toGroupResource(role, members), nil
+}
+
+func (s *Server) toGroupResource(role *model.Role, members []*model.User) *groupResource {
+	id := strconv.FormatInt(role.ID, 10)
+	resource := &groupResource{
+		Schemas:     []string{schemaGroup},
+		ID:          id,
+		DisplayName: role.Name,
+		Meta:        s.meta("Group", GroupsPath, id, role.CreatedAt, role.CreatedAt),
+	}
+	for _, user := range members {
+		userID := strconv.FormatInt(user.ID, 10)
+		resource.Members = append(resource.Members, &reference{
+			Value:   userID,
+			Ref:     s.location(UsersPath, userID),
+			Display: user.Name,
+		})
+	}
+
+	return resource
+}
+
+// excludesMembers reports whether client asked to omit members of groups,
+// which is used by clients not interested in large groups
+func excludesMembers(r *http.Request) bool {
+	for _, attribute := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attribute), "members") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// groupPatch is membership of group changed by operations of PATCH request
+type groupPatch struct {
+	server  *Server
+	role    *model.Role
+	members map[int64]*model.User
+}
+
+func (p *groupPatch) apply(ctx context.Context, op string, path *patchPath,
+	value json.RawMessage,
+) error {
+	if path == nil {
+		attributes, err := valueObject(value)
+		if err != nil {
+			return err
+		}
+		for key, attributeValue := range attributes {
+			attributePath, err := parsePatchPath(key)
+			if err != nil {
+				return err
+			}
+			if err = p.apply(ctx, op, attributePath, attributeValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch path.attr {
+	case "displayname":
+		if op == patchRemove {
+			return badRequest(errorMutability, "displayName is required")
+		}
+		name, err := decodeString(value, "displayName")
+		if err != nil {
+			return err
+		}
+		if name != p.role.Name {
+			return badRequest(errorMutability, "group %s can't be renamed", p.role.Name)
+		}
+	case "members":
+		return p.applyMembers(ctx, op, path, value)
+	case "id", "meta":
+		return badRequest(errorMutability, "%s can't be changed", path.attr)
+	}
+
+	return nil
+}
+
+// applyMembers changes members, members are removed by filter of path,
+// by references of value or all at once
+func (p *groupPatch) applyMembers(ctx context.Context, op string, path *patchPath,
+	value json.RawMessage,
+) error {
+	if op != patchRemove && (path.filter != nil || path.sub != "") {
+		return badRequest(errorInvalidPath, "members can be added only as a whole")
+	}
+
+	var references []*reference
+	if !isNull(value) {
+		if err := json.Unmarshal(value, &references); err != nil {
+			var single reference
+			if err = json.Unmarshal(value, &single); err != nil {
+				return badRequest(errorInvalidValue, "members must be array of members")
+			}
+			references = []*reference{&single}
+		}
+	}
+
+	switch {
+	case op == patchRemove && path.filter != nil:
+		for id, user := range p.members {
+			if path.filter.match(map[string]interface{}{
+				"value":   strconv.FormatInt(id, 10),
+				"display": user.Name,
+			}) {
+				delete(p.members, id)
+			}
+		}
+	case op == patchRemove && len(references) > 0:
+		for _, member := range references {
+			if member == nil {
+				continue
+			}
+			if id, err := strconv.ParseInt(member.Value, 10, 64); err == nil {
+				delete(p.members, id)
+			}
+		}
+	case op == patchRemove:
+		p.members = make(map[int64]*model.User)
+	default:
+		users, err := p.server.memberUsers(ctx, references)
+		if err != nil {
+			return err
+		}
+		if op == patchReplace {
+			p.members = make(map[int64]*model.User, len(users))
+		}
+		for _, user := range users {
+			p.members[user.ID] = user
+		}
+	}
+
+	return nil
+}
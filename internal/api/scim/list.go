@@ -0,0 +1,94 @@
+package scim
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const (
+	// defaultCount is size of page returned when client does not specify it
+	defaultCount = 100
+	// maxResults is the largest page returned
+	maxResults = 200
+)
+
+// listParams are parameters of list request, RFC 7644 section 3.4.2
+type listParams struct {
+	filter filter
+	// startIndex is 1-based index of the first result
+	startIndex int
+	count      int
+	// excludeMembers omits members of groups
+	excludeMembers bool
+}
+
+type listResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+func parseListParams(r *http.Request) (*listParams, error) {
+	query := r.URL.Query()
+	params := &listParams{
+		startIndex:     1,
+		count:          defaultCount,
+		excludeMembers: excludesMembers(r),
+	}
+
+	if raw := query.Get("filter"); raw != "" {
+		parsed, err := parseFilter(raw)
+		if err != nil {
+			return nil, err
+		}
+		params.filter = parsed
+	}
+
+	if raw := query.Get("startIndex"); raw != "" {
+		startIndex, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, badRequest(errorInvalidValue, "startIndex must be integer")
+		}
+		params.startIndex = max(startIndex, 1)
+	}
+
+	if raw := query.Get("count"); raw != "" {
+		count, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, badRequest(errorInvalidValue, "count must be integer")
+		}
+		params.count = min(max(count, 0), maxResults)
+	}
+
+	return params, nil
+}
+
+// page returns requested page of resources
+func (p *listParams) page(resources []interface{}) *listResponse {
+	start := min(p.startIndex-1, len(resources))
+	end := min(start+p.count, len(resources))
+
+	return &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   p.startIndex,
+		ItemsPerPage: end - start,
+		Resources:    resources[start:end],
+	}
+}
+
+// matches reports whether resource matches filter, empty filter matches every resource
+func matches(resourceFilter filter, resource interface{}) (bool, error) {
+	if resourceFilter == nil {
+		return true, nil
+	}
+
+	attributes, err := toAttributes(resource)
+	if err != nil {
+		return false, err
+	}
+
+	return resourceFilter.match(attributes), nil
+}
@@ -0,0 +1,170 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Operations of PATCH request, RFC 7644 section 3.5.2
+const (
+	patchAdd     = "add"
+	patchReplace = "replace"
+	patchRemove  = "remove"
+)
+
+type patchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+
+	path *patchPath
+}
+
+// patchPath is target of operation: attribute, filter of values of multi-valued
+// attribute and sub-attribute, e.g. emails[type eq "work"].value
+type patchPath struct {
+	attr   string
+	filter filter
+	sub    string
+}
+
+// decodePatchRequest returns validated operations of PATCH request,
+// operation names are lowercased and paths are parsed
+func decodePatchRequest(w http.ResponseWriter, r *http.Request) ([]*patchOperation, error) {
+	var req patchRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		return nil, err
+	}
+	if !hasSchema(req.Schemas, schemaPatchOp) {
+		return nil, badRequest(errorInvalidSyntax, "schema %s is required", schemaPatchOp)
+	}
+	if len(req.Operations) == 0 {
+		return nil, badRequest(errorInvalidValue, "operations are required")
+	}
+
+	for _, operation := range req.Operations {
+		if operation == nil {
+			return nil, badRequest(errorInvalidSyntax, "empty operation")
+		}
+		operation.Op = strings.ToLower(operation.Op)
+		switch operation.Op {
+		case patchAdd, patchReplace:
+			if isNull(operation.Value) {
+				return nil, badRequest(errorInvalidValue, "value of %s operation is required",
+					operation.Op)
+			}
+		case patchRemove:
+			if operation.Path == "" {
+				return nil, badRequest(errorNoTarget, "path of remove operation is required")
+			}
+		default:
+			return nil, badRequest(errorInvalidSyntax, "unknown operation %q", operation.Op)
+		}
+
+		path, err := parsePatchPath(operation.Path)
+		if err != nil {
+			return nil, err
+		}
+		operation.path = path
+	}
+
+	return req.Operations, nil
+}
+
+// parsePatchPath parses path of operation, empty path targets whole resource
+func parsePatchPath(raw string) (*patchPath, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	open := strings.Index(raw, "[")
+	if open < 0 {
+		path, ok := parseAttrPath(raw)
+		if !ok {
+			return nil, badRequest(errorInvalidPath, "invalid path %q", raw)
+		}
+		return &patchPath{attr: path.attr, sub: path.sub}, nil
+	}
+
+	closing := strings.LastIndex(raw, "]")
+	path, ok := parseAttrPath(raw[:open])
+	if closing < open || !ok || path.sub != "" {
+		return nil, badRequest(errorInvalidPath, "invalid path %q", raw)
+	}
+
+	valueFilter, err := parseFilter(raw[open+1 : closing])
+	if err != nil {
+		return nil, err
+	}
+
+	var sub string
+	if rest := raw[closing+1:]; rest != "" {
+		if !strings.HasPrefix(rest, ".") || !attrNameRegexp.MatchString(rest[1:]) {
+			return nil, badRequest(errorInvalidPath, "invalid path %q", raw)
+		}
+		sub = strings.ToLower(rest[1:])
+	}
+
+	return &patchPath{attr: path.attr, filter: valueFilter, sub: sub}, nil
+}
+
+// valueObject decodes value of operation without path, keys are paths of attributes
+func valueObject(value json.RawMessage) (map[string]json.RawMessage, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(value, &object); err != nil {
+		return nil, badRequest(errorInvalidValue, "value of operation without path must be object")
+	}
+
+	return object, nil
+}
+
+func decodeString(value json.RawMessage, attr string) (string, error) {
+	var decoded string
+	if err := json.Unmarshal(value, &decoded); err != nil {
+		return "", badRequest(errorInvalidValue, "%s must be string", attr)
+	}
+
+	return decoded, nil
+}
+
+// decodeBool decodes boolean, some clients send booleans as strings "True" and "False"
+func decodeBool(value json.RawMessage, attr string) (bool, error) {
+	var decoded bool
+	if err := json.Unmarshal(value, &decoded); err == nil {
+		return decoded, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(value, &str); err == nil {
+		switch strings.ToLower(str) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+
+	return false, badRequest(errorInvalidValue, "%s must be boolean", attr)
+}
+
+func isNull(value json.RawMessage) bool {
+	trimmed := strings.TrimSpace(string(value))
+
+	return trimmed == "" || trimmed == "null"
+}
+
+func hasSchema(schemas []string, schema string) bool {
+	for _, s := range schemas {
+		if strings.EqualFold(s, schema) {
+			return true
+		}
+	}
+
+	return false
+}
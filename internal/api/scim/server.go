@@ -0,0 +1,120 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// Endpoints of SCIM 2.0 service provider, RFC 7644 section 3.2
+const (
+	UsersPath                 = "/scim/v2/Users"
+	UserPath                  = "/scim/v2/Users/{id}"
+	GroupsPath                = "/scim/v2/Groups"
+	GroupPath                 = "/scim/v2/Groups/{id}"
+	ServiceProviderConfigPath = "/scim/v2/ServiceProviderConfig"
+)
+
+// SCIM schemas, RFC 7643 and RFC 7644
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+const (
+	contentType  = "application/scim+json"
+	bearerPrefix = "Bearer "
+	// maxBodySize limits size of request body
+	maxBodySize = 1 << 20
+)
+
+// Server serves SCIM 2.0 provisioning endpoints of users and groups of tenant of
+// provisioning client. Client is authorized by access rules of its bearer token,
+// endpoint checked is "<HTTP method> <path>", e.g. "PATCH /scim/v2/Users/5".
+type Server struct {
+	accessService       service.AccessService
+	userService         service.UserService
+	organizationService service.OrganizationService
+	// baseURL is external URL of service used in locations of resources
+	baseURL string
+}
+
+// NewServer returns SCIM 2.0 service provider
+func NewServer(accessService service.AccessService, userService service.UserService,
+	organizationService service.OrganizationService, baseURL string,
+) *Server {
+	return &Server{
+		accessService:       accessService,
+		userService:         userService,
+		organizationService: organizationService,
+		baseURL:             strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// authorize returns context with tenant and identity of provisioning client allowed
+// to call endpoint, otherwise error response is written
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(w, newError(http.StatusUnauthorized, "", "bearer token is required"))
+		return nil, false
+	}
+
+	authorization, err := s.accessService.Authorize(r.Context(),
+		strings.TrimPrefix(header, bearerPrefix), r.Method+" "+r.URL.Path, nil)
+	switch {
+	case errors.Is(err, model.ErrorUnauthenticated):
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim", error="invalid_token"`)
+		writeError(w, newError(http.StatusUnauthorized, "", "invalid access token"))
+		return nil, false
+	case err != nil:
+		s.writeFailure(w, r, err)
+		return nil, false
+	case !authorization.Decision.Allowed:
+		writeError(w, newError(http.StatusForbidden, "",
+			"access denied: "+authorization.Decision.Reason))
+		return nil, false
+	}
+
+	ctx := tenant.NewContext(r.Context(), authorization.Claims.TenantID)
+
+	return identity.NewContext(ctx, authorization.Claims), true
+}
+
+// location returns URL of resource
+func (s *Server) location(path, id string) string {
+	return s.baseURL + path + "/" + id
+}
+
+// decodeBody decodes JSON request body, attribute names are case-insensitive
+func decodeBody(w http.ResponseWriter, r *http.Request, body interface{}) error {
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(body)
+	if err != nil {
+		return newError(http.StatusBadRequest, errorInvalidSyntax,
+			"unable to parse request body: "+err.Error())
+	}
+
+	return nil
+}
+
+// writeJSON writes SCIM response
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.ErrorWithMsg("unable to write scim response", err)
+	}
+}
@@ -0,0 +1,60 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type filterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type bulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type serviceProviderConfigResponse struct {
+	Schemas               []string                `json:"schemas"`
+	Patch                 supported               `json:"patch"`
+	Bulk                  bulkSupported           `json:"bulk"`
+	Filter                filterSupported         `json:"filter"`
+	ChangePassword        supported               `json:"changePassword"`
+	Sort                  supported               `json:"sort"`
+	ETag                  supported               `json:"etag"`
+	AuthenticationSchemes []*authenticationScheme `json:"authenticationSchemes"`
+}
+
+// ServiceProviderConfig serves features of service provider, RFC 7643 section 5
+func (s *Server) ServiceProviderConfig(w http.ResponseWriter, r *http.Request,
+	_ map[string]string,
+) {
+	if _, ok := s.authorize(w, r); !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &serviceProviderConfigResponse{
+		Schemas: []string{schemaServiceProviderConfig},
+		Patch:   supported{Supported: true},
+		Filter:  filterSupported{Supported: true, MaxResults: maxResults},
+		AuthenticationSchemes: []*authenticationScheme{{
+			Type: "oauthbearertoken",
+			Name: "OAuth Bearer Token",
+			Description: "access token of user or service account with permission " +
+				model.PermissionSCIMProvision,
+		}},
+	})
+}
@@ -0,0 +1,300 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/api/scim"
+	"github.com/valek177/auth/internal/model"
+)
+
+func members(group *groupResource) []string {
+	values := make([]string, 0, len(group.Members))
+	for _, member := range group.Members {
+		values = append(values, member.Value)
+	}
+	sort.Strings(values)
+
+	return values
+}
+
+func (c *testClient) createGroup(name string, memberIDs ...string) *groupResource {
+	memberRefs := make([]map[string]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		memberRefs = append(memberRefs, map[string]string{"value": id})
+	}
+
+	var group groupResource
+	res := c.do(http.MethodPost, scim.GroupsPath, map[string]interface{}{
+		"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+		"displayName": name,
+		"members":     memberRefs,
+	}, &group)
+	require.Equal(c.t, http.StatusCreated, res.StatusCode)
+	require.Equal(c.t, baseURL+scim.GroupsPath+"/"+group.ID, res.Header.Get("Location"))
+
+	return &group
+}
+
+func TestCreateGroup(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	group := c.createGroup("engineering", "2", "3")
+	assert.Equal(t, "engineering", group.DisplayName)
+	assert.Equal(t, []string{"2", "3"}, members(group))
+	assert.Equal(t, "engineering", c.directory.user(2).Role)
+	assert.Equal(t, "engineering", c.directory.user(3).Role)
+
+	var user userResource
+	c.do(http.MethodGet, scim.UsersPath+"/2", "", &user)
+	require.Len(t, user.Groups, 1)
+	assert.Equal(t, group.ID, user.Groups[0].Value)
+
+	tests := []struct {
+		name     string
+		body     map[string]interface{}
+		status   int
+		scimType string
+	}{
+		{
+			name:     "error: group exists",
+			body:     map[string]interface{}{"displayName": model.RoleAdmin},
+			status:   http.StatusConflict,
+			scimType: "uniqueness",
+		},
+		{
+			name:     "error: display name is missing",
+			body:     map[string]interface{}{"members": []map[string]string{{"value": "1"}}},
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+		},
+		{
+			name: "error: unknown member",
+			body: map[string]interface{}{
+				"displayName": "sales",
+				"members":     []map[string]string{{"value": "404"}},
+			},
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+		},
+	}
+	for _, tt := range tests {
+		var scimErr errorResponse
+		res := c.do(http.MethodPost, scim.GroupsPath, tt.body, &scimErr)
+		c.expectError(res, &scimErr, tt.status, tt.scimType)
+	}
+	assert.False(t, c.directory.hasRole("sales"))
+}
+
+func TestGetGroup(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	var group groupResource
+	res := c.do(http.MethodGet, scim.GroupsPath+"/"+strconv.FormatInt(userRoleID, 10), "", &group)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, model.RoleUser, group.DisplayName)
+	assert.Equal(t, []string{"2", "3"}, members(&group))
+
+	group = groupResource{}
+	c.do(http.MethodGet, scim.GroupsPath+"/1?excludedAttributes=members", "", &group)
+	assert.Equal(t, model.RoleAdmin, group.DisplayName)
+	assert.Empty(t, group.Members)
+
+	var scimErr errorResponse
+	res = c.do(http.MethodGet, scim.GroupsPath+"/404", "", &scimErr)
+	c.expectError(res, &scimErr, http.StatusNotFound, "")
+}
+
+func TestListGroups(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params url.Values
+		want   []string
+	}{
+		{
+			name:   "success case: all groups",
+			params: url.Values{},
+			want:   []string{model.RoleAdmin, model.RoleUser},
+		},
+		{
+			name:   "success case: group by name",
+			params: url.Values{"filter": {`displayName eq "admin"`}},
+			want:   []string{model.RoleAdmin},
+		},
+		{
+			name:   "success case: groups by member",
+			params: url.Values{"filter": {`members[value eq "3"]`}},
+			want:   []string{model.RoleUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			var list listResponse
+			res := c.do(http.MethodGet, scim.GroupsPath+"?"+tt.params.Encode(), "", &list)
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			assert.Equal(t, len(tt.want), list.TotalResults)
+
+			names := make([]string, 0, len(list.Resources))
+			for _, resource := range list.Resources {
+				var group groupResource
+				require.NoError(t, json.Unmarshal(resource, &group))
+				names = append(names, group.DisplayName)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestPatchGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     func(group *groupResource) interface{}
+		want     []string
+		roles    map[int64]string
+		status   int
+		scimType string
+	}{
+		{
+			name: "success case: member added",
+			body: func(_ *groupResource) interface{} {
+				return patchOp(operation("Add", "members", []map[string]string{{"value": "1"}}))
+			},
+			want:  []string{"1", "2"},
+			roles: map[int64]string{1: "engineering", 2: "engineering", 3: model.RoleUser},
+		},
+		{
+			name: "success case: member removed by filter",
+			body: func(_ *groupResource) interface{} {
+				return patchOp(operation("remove", `members[value eq "2"]`, nil))
+			},
+			want:  []string{},
+			roles: map[int64]string{1: model.RoleAdmin, 2: model.RoleUser},
+		},
+		{
+			name: "success case: members replaced without path",
+			body: func(group *groupResource) interface{} {
+				return patchOp(operation("replace", "", map[string]interface{}{
+					"displayName": group.DisplayName,
+					"members":     []map[string]string{{"value": "3"}},
+				}))
+			},
+			want:  []string{"3"},
+			roles: map[int64]string{2: model.RoleUser, 3: "engineering"},
+		},
+		{
+			name: "success case: members removed by value and added in one request",
+			body: func(_ *groupResource) interface{} {
+				return patchOp(
+					operation("remove", "members", []map[string]string{{"value": "2"}}),
+					operation("add", "members", []map[string]string{{"value": "3"}}),
+				)
+			},
+			want:  []string{"3"},
+			roles: map[int64]string{2: model.RoleUser, 3: "engineering"},
+		},
+		{
+			name: "error: group renamed",
+			body: func(_ *groupResource) interface{} {
+				return patchOp(operation("replace", "displayName", "platform"))
+			},
+			status:   http.StatusBadRequest,
+			scimType: "mutability",
+			roles:    map[int64]string{2: "engineering"},
+		},
+		{
+			name: "error: unknown member is not added",
+			body: func(_ *groupResource) interface{} {
+				return patchOp(
+					operation("add", "members", []map[string]string{{"value": "3"}}),
+					operation("add", "members", []map[string]string{{"value": "404"}}),
+				)
+			},
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+			roles:    map[int64]string{2: "engineering", 3: model.RoleUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+			group := c.createGroup("engineering", "2")
+
+			var raw json.RawMessage
+			res := c.do(http.MethodPatch, scim.GroupsPath+"/"+group.ID, tt.body(group), &raw)
+
+			if tt.scimType != "" {
+				var scimErr errorResponse
+				require.NoError(t, json.Unmarshal(raw, &scimErr))
+				c.expectError(res, &scimErr, tt.status, tt.scimType)
+			} else {
+				require.Equal(t, http.StatusOK, res.StatusCode)
+				var patched groupResource
+				require.NoError(t, json.Unmarshal(raw, &patched))
+				assert.Equal(t, tt.want, members(&patched))
+			}
+
+			for id, role := range tt.roles {
+				assert.Equal(t, role, c.directory.user(id).Role, "role of user %d", id)
+			}
+		})
+	}
+}
+
+func TestPatchDefaultGroup(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	var group groupResource
+	res := c.do(http.MethodPatch, scim.GroupsPath+"/"+strconv.FormatInt(userRoleID, 10),
+		patchOp(operation("add", "members", []map[string]string{{"value": "1"}})), &group)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, []string{"1", "2", "3"}, members(&group))
+	assert.Equal(t, model.RoleUser, c.directory.user(1).Role)
+
+	var scimErr errorResponse
+	res = c.do(http.MethodPatch, scim.GroupsPath+"/"+strconv.FormatInt(userRoleID, 10),
+		patchOp(operation("remove", "members", nil)), &scimErr)
+	c.expectError(res, &scimErr, http.StatusBadRequest, "mutability")
+}
+
+func TestDeleteGroup(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+	group := c.createGroup("engineering", "1", "2")
+
+	res := c.do(http.MethodDelete, scim.GroupsPath+"/"+group.ID, "", nil)
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.False(t, c.directory.hasRole("engineering"))
+	assert.Equal(t, model.RoleUser, c.directory.user(1).Role)
+	assert.Equal(t, model.RoleUser, c.directory.user(2).Role)
+
+	var scimErr errorResponse
+	res = c.do(http.MethodGet, scim.GroupsPath+"/"+group.ID, "", &scimErr)
+	c.expectError(res, &scimErr, http.StatusNotFound, "")
+
+	res = c.do(http.MethodDelete, scim.GroupsPath+"/"+strconv.FormatInt(adminRoleID, 10), "",
+		&scimErr)
+	c.expectError(res, &scimErr, http.StatusBadRequest, "mutability")
+	assert.True(t, c.directory.hasRole(model.RoleAdmin))
+}
@@ -0,0 +1,493 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/valek177/auth/internal/api/scim"
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+	passwordLib "github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/service"
+	serviceMocks "github.com/valek177/auth/internal/service/mocks"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+const (
+	tenantID = int64(3)
+	baseURL  = "https://auth.example.com"
+
+	// provisionerToken is allowed to call SCIM endpoints, readerToken is denied
+	provisionerToken = "provisioner-token"
+	readerToken      = "reader-token"
+
+	adminRoleID = int64(1)
+	userRoleID  = int64(2)
+)
+
+// accessService authorizes provisioning client by its token
+type accessService struct {
+	service.AccessService
+}
+
+func (accessService) Authorize(_ context.Context, accessToken string, endpoint string,
+	_ map[string]string,
+) (*model.AccessAuthorization, error) {
+	claims := &model.UserClaims{Username: "sa:provisioner", TenantID: tenantID}
+	switch accessToken {
+	case provisionerToken:
+		return &model.AccessAuthorization{
+			Decision: &model.AccessDecision{Allowed: true},
+			Claims:   claims,
+		}, nil
+	case readerToken:
+		return &model.AccessAuthorization{
+			Decision: &model.AccessDecision{Reason: "no rule allows " + endpoint},
+			Claims:   claims,
+		}, nil
+	default:
+		return nil, model.ErrorUnauthenticated
+	}
+}
+
+// directory is in-memory users and roles of tenant
+type directory struct {
+	mu     sync.Mutex
+	users  map[int64]*model.User
+	roles  []*model.Role
+	nextID int64
+}
+
+func newDirectory() *directory {
+	created := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	d := &directory{
+		users: make(map[int64]*model.User),
+		roles: []*model.Role{
+			{ID: adminRoleID, TenantID: tenantID, Name: model.RoleAdmin, CreatedAt: created},
+			{ID: userRoleID, TenantID: tenantID, Name: model.RoleUser, CreatedAt: created},
+		},
+		nextID: 100,
+	}
+	for _, user := range []*model.User{
+		{ID: 1, Name: "alice", Email: "alice@example.com", Role: model.RoleAdmin,
+			Status: model.UserStatusActive, CreatedAt: created},
+		{ID: 2, Name: "bob", Email: "bob@example.org", Role: model.RoleUser,
+			Status: model.UserStatusActive, CreatedAt: created.AddDate(0, 1, 0)},
+		{ID: 3, Name: "carol", Email: "carol@example.com", Role: model.RoleUser,
+			Status: model.UserStatusDisabled, CreatedAt: created.AddDate(0, 2, 0)},
+	} {
+		user.TenantID = tenantID
+		d.users[user.ID] = user
+	}
+
+	return d
+}
+
+func (d *directory) user(id int64) *model.User {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	user, ok := d.users[id]
+	if !ok {
+		return nil
+	}
+	copied := *user
+
+	return &copied
+}
+
+func (d *directory) hasRole(name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, role := range d.roles {
+		if role.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkTenant(ctx context.Context) error {
+	if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+		return fmt.Errorf("request of wrong tenant")
+	}
+
+	return nil
+}
+
+func (d *directory) userService(mc *minimock.Controller) service.UserService {
+	mock := serviceMocks.NewUserServiceMock(mc)
+	mock.CreateUserMock.Optional().Set(func(ctx context.Context, newUser *model.NewUser) (
+		int64, error,
+	) {
+		if err := checkTenant(ctx); err != nil {
+			return 0, err
+		}
+		if !passwordLib.CheckPasswordHash(newUser.PasswordConfirm, newUser.Password) {
+			return 0, fmt.Errorf("unable to create user: the passwords do not match")
+		}
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.nextID++
+		d.users[d.nextID] = &model.User{
+			ID:        d.nextID,
+			TenantID:  tenantID,
+			Name:      newUser.Name,
+			Email:     newUser.Email,
+			Password:  newUser.Password,
+			Role:      newUser.Role,
+			Status:    newUser.Status,
+			CreatedAt: time.Now(),
+		}
+		return d.nextID, nil
+	})
+	mock.GetUserMock.Optional().Set(func(ctx context.Context, id int64) (*model.User, error) {
+		if err := checkTenant(ctx); err != nil {
+			return nil, err
+		}
+		user := d.user(id)
+		if user == nil {
+			return nil, model.ErrorUserNotFound
+		}
+		return user, nil
+	})
+	mock.GetUserByNameMock.Optional().Set(func(ctx context.Context, name string) (
+		*model.User, error,
+	) {
+		if err := checkTenant(ctx); err != nil {
+			return nil, err
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		for _, user := range d.users {
+			if user.Name == name {
+				copied := *user
+				return &copied, nil
+			}
+		}
+		return nil, model.ErrorUserNotFound
+	})
+	mock.ListUsersMock.Optional().Set(func(ctx context.Context, afterID int64, limit uint64) (
+		[]*model.User, error,
+	) {
+		if err := checkTenant(ctx); err != nil {
+			return nil, err
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		users := make([]*model.User, 0, len(d.users))
+		for _, user := range d.users {
+			if user.ID > afterID {
+				copied := *user
+				users = append(users, &copied)
+			}
+		}
+		sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+		if uint64(len(users)) > limit {
+			users = users[:limit]
+		}
+		return users, nil
+	})
+	mock.UpdateUserMock.Optional().Set(func(ctx context.Context,
+		update *model.UpdateUserInfo,
+	) error {
+		if err := checkTenant(ctx); err != nil {
+			return err
+		}
+		if update.Role != nil && !d.hasRole(*update.Role) {
+			return fmt.Errorf("unknown role %s", *update.Role)
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		user, ok := d.users[update.ID]
+		if !ok {
+			return model.ErrorUserNotFound
+		}
+		if update.Name != nil {
+			user.Name = *update.Name
+		}
+		if update.Email != nil {
+			user.Email = *update.Email
+		}
+		if update.Role != nil {
+			user.Role = *update.Role
+		}
+		if update.Status != nil {
+			user.Status = *update.Status
+		}
+		return nil
+	})
+	mock.DeleteUserMock.Optional().Set(func(ctx context.Context, id int64) error {
+		if err := checkTenant(ctx); err != nil {
+			return err
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		delete(d.users, id)
+		return nil
+	})
+
+	return mock
+}
+
+func (d *directory) organizationService(mc *minimock.Controller) service.OrganizationService {
+	mock := serviceMocks.NewOrganizationServiceMock(mc)
+	mock.ListRolesMock.Optional().Set(func(_ context.Context, organizationID int64) (
+		[]*model.Role, error,
+	) {
+		if organizationID != tenantID {
+			return nil, model.ErrorOrganizationNotFound
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		roles := make([]*model.Role, 0, len(d.roles))
+		for _, role := range d.roles {
+			copied := *role
+			roles = append(roles, &copied)
+		}
+		return roles, nil
+	})
+	mock.CreateRoleMock.Optional().Set(func(_ context.Context, role *model.Role) (int64, error) {
+		if role.TenantID != tenantID {
+			return 0, model.ErrorOrganizationNotFound
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.nextID++
+		d.roles = append(d.roles, &model.Role{
+			ID:        d.nextID,
+			TenantID:  tenantID,
+			Name:      role.Name,
+			CreatedAt: time.Now(),
+		})
+		return d.nextID, nil
+	})
+	mock.DeleteRoleMock.Optional().Set(func(_ context.Context, organizationID int64,
+		name string,
+	) error {
+		if organizationID != tenantID {
+			return model.ErrorOrganizationNotFound
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		for _, user := range d.users {
+			if user.Role == name {
+				return fmt.Errorf("role %s is assigned to users", name)
+			}
+		}
+		for i, role := range d.roles {
+			if role.Name == name {
+				d.roles = append(d.roles[:i], d.roles[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("role %s not found", name)
+	})
+
+	return mock
+}
+
+// testClient is provisioning client calling SCIM server over HTTP
+type testClient struct {
+	t         *testing.T
+	serverURL string
+	directory *directory
+}
+
+// newTestClient starts SCIM server backed by in-memory directory
+func newTestClient(t *testing.T) *testClient {
+	logger.Init(zapcore.NewNopCore())
+	mc := minimock.NewController(t)
+
+	dir := newDirectory()
+	server := scim.NewServer(accessService{}, dir.userService(mc), dir.organizationService(mc),
+		baseURL)
+
+	mux := runtime.NewServeMux()
+	routes := []struct {
+		method  string
+		path    string
+		handler runtime.HandlerFunc
+	}{
+		{http.MethodPost, scim.UsersPath, server.CreateUser},
+		{http.MethodGet, scim.UsersPath, server.ListUsers},
+		{http.MethodGet, scim.UserPath, server.GetUser},
+		{http.MethodPatch, scim.UserPath, server.PatchUser},
+		{http.MethodDelete, scim.UserPath, server.DeleteUser},
+		{http.MethodPost, scim.GroupsPath, server.CreateGroup},
+		{http.MethodGet, scim.GroupsPath, server.ListGroups},
+		{http.MethodGet, scim.GroupPath, server.GetGroup},
+		{http.MethodPatch, scim.GroupPath, server.PatchGroup},
+		{http.MethodDelete, scim.GroupPath, server.DeleteGroup},
+		{http.MethodGet, scim.ServiceProviderConfigPath, server.ServiceProviderConfig},
+	}
+	for _, route := range routes {
+		require.NoError(t, mux.HandlePath(route.method, route.path, route.handler))
+	}
+
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+
+	return &testClient{
+		t:         t,
+		serverURL: httpServer.URL,
+		directory: dir,
+	}
+}
+
+// doAs sends request with token, body is encoded as JSON and response is decoded into out
+func (c *testClient) doAs(token, method, path string, body, out interface{}) *http.Response {
+	var reader *bytes.Reader
+	if raw, ok := body.(string); ok {
+		reader = bytes.NewReader([]byte(raw))
+	} else {
+		data, err := json.Marshal(body)
+		require.NoError(c.t, err)
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.serverURL+path, reader)
+	require.NoError(c.t, err)
+	req.Header.Set("Content-Type", "application/scim+json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(c.t, err)
+	c.t.Cleanup(func() { _ = res.Body.Close() })
+
+	if out != nil && res.StatusCode != http.StatusNoContent {
+		require.NoError(c.t, json.NewDecoder(res.Body).Decode(out))
+	}
+
+	return res
+}
+
+func (c *testClient) do(method, path string, body, out interface{}) *http.Response {
+	return c.doAs(provisionerToken, method, path, body, out)
+}
+
+// expectError checks SCIM error response
+func (c *testClient) expectError(res *http.Response, scimErr *errorResponse, status int,
+	scimType string,
+) {
+	assert.Equal(c.t, status, res.StatusCode)
+	assert.Equal(c.t, "application/scim+json", res.Header.Get("Content-Type"))
+	assert.Equal(c.t, []string{"urn:ietf:params:scim:api:messages:2.0:Error"}, scimErr.Schemas)
+	assert.Equal(c.t, fmt.Sprint(status), scimErr.Status)
+	assert.Equal(c.t, scimType, scimErr.ScimType)
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType"`
+	Detail   string   `json:"detail"`
+}
+
+type reference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref"`
+	Display string `json:"display"`
+}
+
+type userResource struct {
+	ID       string `json:"id"`
+	UserName string `json:"userName"`
+	Active   bool   `json:"active"`
+	Emails   []struct {
+		Value   string `json:"value"`
+		Primary bool   `json:"primary"`
+	} `json:"emails"`
+	Groups []*reference `json:"groups"`
+	Meta   struct {
+		ResourceType string `json:"resourceType"`
+		Location     string `json:"location"`
+	} `json:"meta"`
+}
+
+type groupResource struct {
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	Members     []*reference `json:"members"`
+}
+
+type listResponse struct {
+	TotalResults int               `json:"totalResults"`
+	StartIndex   int               `json:"startIndex"`
+	ItemsPerPage int               `json:"itemsPerPage"`
+	Resources    []json.RawMessage `json:"Resources"`
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		token         string
+		status        int
+		authenticate  string
+		expectedError bool
+	}{
+		{
+			name:          "error: token is missing",
+			token:         "",
+			status:        http.StatusUnauthorized,
+			authenticate:  `Bearer realm="scim"`,
+			expectedError: true,
+		},
+		{
+			name:          "error: invalid token",
+			token:         "forged",
+			status:        http.StatusUnauthorized,
+			authenticate:  `Bearer realm="scim", error="invalid_token"`,
+			expectedError: true,
+		},
+		{
+			name:          "error: client is not allowed to provision",
+			token:         readerToken,
+			status:        http.StatusForbidden,
+			expectedError: true,
+		},
+		{
+			name:   "success case: provisioning client",
+			token:  provisionerToken,
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			var scimErr errorResponse
+			res := c.doAs(tt.token, http.MethodGet, scim.ServiceProviderConfigPath, "", &scimErr)
+
+			assert.Equal(t, tt.status, res.StatusCode)
+			assert.Equal(t, tt.authenticate, res.Header.Get("WWW-Authenticate"))
+			if tt.expectedError {
+				c.expectError(res, &scimErr, tt.status, "")
+			}
+		})
+	}
+}
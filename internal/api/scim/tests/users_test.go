@@ -0,0 +1,463 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/api/scim"
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	userSchema    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	patchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+func patchOp(operations ...map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"schemas":    []string{patchOpSchema},
+		"Operations": operations,
+	}
+}
+
+func operation(op, path string, value interface{}) map[string]interface{} {
+	operation := map[string]interface{}{"op": op}
+	if path != "" {
+		operation["path"] = path
+	}
+	if value != nil {
+		operation["value"] = value
+	}
+
+	return operation
+}
+
+func TestCreateUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     interface{}
+		status   int
+		scimType string
+		check    func(t *testing.T, c *testClient, user *userResource)
+	}{
+		{
+			name: "success case: inactive user with email",
+			body: map[string]interface{}{
+				"schemas":  []string{userSchema},
+				"userName": "dave",
+				"active":   false,
+				"emails": []map[string]interface{}{
+					{"value": "dave@home.example", "type": "home"},
+					{"value": "dave@example.com", "type": "work", "primary": true},
+				},
+			},
+			status: http.StatusCreated,
+			check: func(t *testing.T, c *testClient, user *userResource) {
+				assert.Equal(t, "dave", user.UserName)
+				assert.False(t, user.Active)
+				require.Len(t, user.Emails, 1)
+				assert.Equal(t, "dave@example.com", user.Emails[0].Value)
+				require.Len(t, user.Groups, 1)
+				assert.Equal(t, model.RoleUser, user.Groups[0].Display)
+				assert.Equal(t, baseURL+scim.UsersPath+"/"+user.ID, user.Meta.Location)
+
+				stored := c.directory.user(101)
+				require.NotNil(t, stored)
+				assert.Equal(t, model.UserStatusDisabled, stored.Status)
+				assert.Equal(t, model.RoleUser, stored.Role)
+				assert.NotEmpty(t, stored.Password)
+			},
+		},
+		{
+			name: "success case: user with password",
+			body: map[string]interface{}{
+				"userName": "erin",
+				"password": "Secret-42",
+			},
+			status: http.StatusCreated,
+			check: func(t *testing.T, c *testClient, user *userResource) {
+				assert.True(t, user.Active)
+				assert.Empty(t, user.Emails)
+				assert.Equal(t, model.UserStatusActive, c.directory.user(101).Status)
+			},
+		},
+		{
+			name:     "error: user name is taken",
+			body:     map[string]interface{}{"userName": "alice"},
+			status:   http.StatusConflict,
+			scimType: "uniqueness",
+		},
+		{
+			name:     "error: user name is missing",
+			body:     map[string]interface{}{"emails": []map[string]string{{"value": "x@example.com"}}},
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+		},
+		{
+			name: "error: invalid email",
+			body: map[string]interface{}{
+				"userName": "frank",
+				"emails":   []map[string]string{{"value": "not an email"}},
+			},
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+		},
+		{
+			name:     "error: name of service account",
+			body:     map[string]interface{}{"userName": model.ServiceAccountPrincipalPrefix + "x"},
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+		},
+		{
+			name:     "error: malformed body",
+			body:     `{"userName": `,
+			status:   http.StatusBadRequest,
+			scimType: "invalidSyntax",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			var raw json.RawMessage
+			res := c.do(http.MethodPost, scim.UsersPath, tt.body, &raw)
+
+			if tt.check == nil {
+				var scimErr errorResponse
+				require.NoError(t, json.Unmarshal(raw, &scimErr))
+				c.expectError(res, &scimErr, tt.status, tt.scimType)
+				return
+			}
+
+			require.Equal(t, tt.status, res.StatusCode)
+			var user userResource
+			require.NoError(t, json.Unmarshal(raw, &user))
+			assert.Equal(t, user.Meta.Location, res.Header.Get("Location"))
+			assert.Equal(t, "User", user.Meta.ResourceType)
+			tt.check(t, c, &user)
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	var user userResource
+	res := c.do(http.MethodGet, scim.UsersPath+"/1", "", &user)
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "1", user.ID)
+	assert.Equal(t, "alice", user.UserName)
+	assert.True(t, user.Active)
+	require.Len(t, user.Emails, 1)
+	assert.True(t, user.Emails[0].Primary)
+	require.Len(t, user.Groups, 1)
+	assert.Equal(t, "1", user.Groups[0].Value)
+	assert.Equal(t, model.RoleAdmin, user.Groups[0].Display)
+
+	for _, id := range []string{"404", "abc"} {
+		var scimErr errorResponse
+		res = c.do(http.MethodGet, scim.UsersPath+"/"+id, "", &scimErr)
+		c.expectError(res, &scimErr, http.StatusNotFound, "")
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		params     url.Values
+		want       []string
+		total      int
+		startIndex int
+		scimType   string
+	}{
+		{
+			name:       "success case: all users",
+			params:     url.Values{},
+			want:       []string{"alice", "bob", "carol"},
+			total:      3,
+			startIndex: 1,
+		},
+		{
+			name:       "success case: lookup by name",
+			params:     url.Values{"filter": {`userName eq "bob"`}},
+			want:       []string{"bob"},
+			total:      1,
+			startIndex: 1,
+		},
+		{
+			name:       "success case: user names are case-exact",
+			params:     url.Values{"filter": {`userName eq "Bob" or userName sw "A"`}},
+			want:       []string{},
+			total:      0,
+			startIndex: 1,
+		},
+		{
+			name:       "success case: unknown name",
+			params:     url.Values{"filter": {`userName eq "nobody"`}},
+			want:       []string{},
+			total:      0,
+			startIndex: 1,
+		},
+		{
+			name: "success case: logical operators and value path",
+			params: url.Values{"filter": {
+				`emails[value ew "@example.com"] and not (active eq false)`,
+			}},
+			want:       []string{"alice"},
+			total:      1,
+			startIndex: 1,
+		},
+		{
+			name: "success case: or, presence and comparison",
+			params: url.Values{"filter": {
+				`(groups.display eq "ADMIN" or meta.created gt "2026-03-01") and emails pr`,
+			}},
+			want:       []string{"alice", "carol"},
+			total:      2,
+			startIndex: 1,
+		},
+		{
+			name: "success case: schema prefix and case-insensitive operators",
+			params: url.Values{"filter": {
+				`urn:ietf:params:scim:schemas:core:2.0:User:userName SW "c" AND active Eq False`,
+			}},
+			want:       []string{"carol"},
+			total:      1,
+			startIndex: 1,
+		},
+		{
+			name:       "success case: page",
+			params:     url.Values{"startIndex": {"2"}, "count": {"1"}},
+			want:       []string{"bob"},
+			total:      3,
+			startIndex: 2,
+		},
+		{
+			name:       "success case: page after last user",
+			params:     url.Values{"startIndex": {"10"}},
+			want:       []string{},
+			total:      3,
+			startIndex: 10,
+		},
+		{
+			name:     "error: unknown operator",
+			params:   url.Values{"filter": {`userName like "a"`}},
+			scimType: "invalidFilter",
+		},
+		{
+			name:     "error: unbalanced parentheses",
+			params:   url.Values{"filter": {`(userName eq "a"`}},
+			scimType: "invalidFilter",
+		},
+		{
+			name:     "error: boolean compared by order",
+			params:   url.Values{"filter": {`active gt true`}},
+			scimType: "invalidFilter",
+		},
+		{
+			name:     "error: invalid count",
+			params:   url.Values{"count": {"many"}},
+			scimType: "invalidValue",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			var raw json.RawMessage
+			res := c.do(http.MethodGet, scim.UsersPath+"?"+tt.params.Encode(), "", &raw)
+
+			if tt.scimType != "" {
+				var scimErr errorResponse
+				require.NoError(t, json.Unmarshal(raw, &scimErr))
+				c.expectError(res, &scimErr, http.StatusBadRequest, tt.scimType)
+				return
+			}
+
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			var list listResponse
+			require.NoError(t, json.Unmarshal(raw, &list))
+			assert.Equal(t, tt.total, list.TotalResults)
+			assert.Equal(t, tt.startIndex, list.StartIndex)
+			assert.Equal(t, len(tt.want), list.ItemsPerPage)
+
+			names := make([]string, 0, len(list.Resources))
+			for _, resource := range list.Resources {
+				var user userResource
+				require.NoError(t, json.Unmarshal(resource, &user))
+				names = append(names, user.UserName)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		body     interface{}
+		want     *model.User
+		status   int
+		scimType string
+	}{
+		{
+			name: "success case: deactivation with string boolean",
+			id:   "2",
+			body: patchOp(operation("Replace", "active", "False")),
+			want: &model.User{Name: "bob", Email: "bob@example.org",
+				Status: model.UserStatusDisabled},
+		},
+		{
+			name: "success case: activation, rename and email without path",
+			id:   "3",
+			body: patchOp(operation("replace", "", map[string]interface{}{
+				"active":   true,
+				"userName": "caroline",
+				"emails":   []map[string]interface{}{{"value": "caroline@example.com"}},
+				"name":     map[string]string{"givenName": "Caroline"},
+			})),
+			want: &model.User{Name: "caroline", Email: "caroline@example.com",
+				Status: model.UserStatusActive},
+		},
+		{
+			name: "success case: email by value path",
+			id:   "1",
+			body: patchOp(operation("replace", `emails[type eq "work"].value`,
+				"alice@corp.example")),
+			want: &model.User{Name: "alice", Email: "alice@corp.example",
+				Status: model.UserStatusActive},
+		},
+		{
+			name: "success case: email removed",
+			id:   "1",
+			body: patchOp(operation("remove", "emails", nil)),
+			want: &model.User{Name: "alice", Status: model.UserStatusActive},
+		},
+		{
+			name:     "error: user name is taken",
+			id:       "2",
+			body:     patchOp(operation("replace", "userName", "alice")),
+			status:   http.StatusConflict,
+			scimType: "uniqueness",
+		},
+		{
+			name:     "error: groups are read-only",
+			id:       "2",
+			body:     patchOp(operation("add", "groups", []map[string]string{{"value": "1"}})),
+			status:   http.StatusBadRequest,
+			scimType: "mutability",
+		},
+		{
+			name:     "error: remove without path",
+			id:       "2",
+			body:     patchOp(operation("remove", "", nil)),
+			status:   http.StatusBadRequest,
+			scimType: "noTarget",
+		},
+		{
+			name:     "error: unknown operation",
+			id:       "2",
+			body:     patchOp(operation("move", "active", false)),
+			status:   http.StatusBadRequest,
+			scimType: "invalidSyntax",
+		},
+		{
+			name: "error: PatchOp schema is missing",
+			id:   "2",
+			body: map[string]interface{}{
+				"Operations": []map[string]interface{}{operation("replace", "active", false)},
+			},
+			status:   http.StatusBadRequest,
+			scimType: "invalidSyntax",
+		},
+		{
+			name:     "error: invalid path",
+			id:       "2",
+			body:     patchOp(operation("replace", "emails[type eq].value", "x@example.com")),
+			status:   http.StatusBadRequest,
+			scimType: "invalidFilter",
+		},
+		{
+			name:     "error: active is not boolean",
+			id:       "2",
+			body:     patchOp(operation("replace", "active", "maybe")),
+			status:   http.StatusBadRequest,
+			scimType: "invalidValue",
+		},
+		{
+			name:   "error: user not found",
+			id:     "404",
+			body:   patchOp(operation("replace", "active", false)),
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t)
+
+			var raw json.RawMessage
+			res := c.do(http.MethodPatch, scim.UsersPath+"/"+tt.id, tt.body, &raw)
+
+			if tt.want == nil {
+				var scimErr errorResponse
+				require.NoError(t, json.Unmarshal(raw, &scimErr))
+				c.expectError(res, &scimErr, tt.status, tt.scimType)
+				return
+			}
+
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			var user userResource
+			require.NoError(t, json.Unmarshal(raw, &user))
+			assert.Equal(t, tt.want.Name, user.UserName)
+			assert.Equal(t, tt.want.Status == model.UserStatusActive, user.Active)
+
+			id, err := strconv.ParseInt(tt.id, 10, 64)
+			require.NoError(t, err)
+			stored := c.directory.user(id)
+			require.NotNil(t, stored)
+			assert.Equal(t, tt.want.Name, stored.Name)
+			assert.Equal(t, tt.want.Email, stored.Email)
+			assert.Equal(t, tt.want.Status, stored.Status)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t)
+
+	res := c.do(http.MethodDelete, scim.UsersPath+"/2", "", nil)
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.Nil(t, c.directory.user(2))
+
+	var scimErr errorResponse
+	res = c.do(http.MethodGet, scim.UsersPath+"/2", "", &scimErr)
+	c.expectError(res, &scimErr, http.StatusNotFound, "")
+
+	res = c.do(http.MethodDelete, scim.UsersPath+"/2", "", &scimErr)
+	c.expectError(res, &scimErr, http.StatusNotFound, "")
+}
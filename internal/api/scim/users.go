@@ -0,0 +1,538 @@
+package scim
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"time"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/password"
+)
+
+const (
+	// emailType is type of the only email of user
+	emailType = "work"
+	// listPageSize is size of pages users are read in
+	listPageSize = 500
+	// generatedPasswordSize is size of random password of user created without one
+	generatedPasswordSize = 32
+)
+
+type userResource struct {
+	Schemas  []string     `json:"schemas"`
+	ID       string       `json:"id"`
+	UserName string       `json:"userName"`
+	Emails   []*email     `json:"emails,omitempty"`
+	Active   bool         `json:"active"`
+	Groups   []*reference `json:"groups,omitempty"`
+	Meta     *meta        `json:"meta"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// reference is member of group or group of user
+type reference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+type userRequest struct {
+	UserName string   `json:"userName"`
+	Password string   `json:"password"`
+	Emails   []*email `json:"emails"`
+	Active   *bool    `json:"active"`
+}
+
+// CreateUser creates user with role USER, user created without password
+// can log in only through federated login or after reset of password
+func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := s.createUser(ctx, w, r)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resource, err := s.userResource(ctx, user)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", resource.Meta.Location)
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *Server) createUser(ctx context.Context, w http.ResponseWriter, r *http.Request) (
+	*model.User, error,
+) {
+	var req userRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateUserName(ctx, req.UserName, 0); err != nil {
+		return nil, err
+	}
+	address := primaryEmail(req.Emails)
+	if err := validateEmail(address); err != nil {
+		return nil, err
+	}
+
+	plain := req.Password
+	if plain == "" {
+		secret := make([]byte, generatedPasswordSize)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		plain = base64.RawURLEncoding.EncodeToString(secret)
+	}
+	hash, err := password.HashPassword(plain)
+	if err != nil {
+		return nil, err
+	}
+
+	status := model.UserStatusActive
+	if req.Active != nil && !*req.Active {
+		status = model.UserStatusDisabled
+	}
+
+	id, err := s.userService.CreateUser(ctx, &model.NewUser{
+		Name:            req.UserName,
+		Email:           address,
+		Password:        hash,
+		PasswordConfirm: plain,
+		Role:            model.RoleUser,
+		Status:          status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userService.GetUser(ctx, id)
+}
+
+// GetUser returns user
+func (s *Server) GetUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := s.findUser(ctx, pathParams["id"])
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resource, err := s.userResource(ctx, user)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+// ListUsers returns page of users matching filter
+func (s *Server) ListUsers(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	params, err := parseListParams(r)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resources, err := s.listUsers(ctx, params.filter)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, params.page(resources))
+}
+
+// listUsers returns users matching filter, lookup by name is served without listing
+func (s *Server) listUsers(ctx context.Context, userFilter filter) ([]interface{}, error) {
+	roles, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*model.User
+	if name, ok := userNameLookup(userFilter); ok {
+		user, err := s.userService.GetUserByName(ctx, name)
+		switch {
+		case errors.Is(err, model.ErrorUserNotFound):
+		case err != nil:
+			return nil, err
+		default:
+			users = []*model.User{user}
+		}
+	} else {
+		users, err = s.allUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resources := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		resource := s.toUserResource(user, roles)
+		matched, err := matches(userFilter, resource)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources, nil
+}
+
+// PatchUser changes user name, email and activity, groups of user are changed
+// through groups and other attributes are ignored
+func (s *Server) PatchUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := s.patchUser(ctx, w, r, pathParams["id"])
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	resource, err := s.userResource(ctx, user)
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) patchUser(ctx context.Context, w http.ResponseWriter, r *http.Request,
+	id string,
+) (*model.User, error) {
+	operations, err := decodePatchRequest(w, r)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.findUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	patched := *user
+	for _, operation := range operations {
+		err = applyUserOperation(&patched, operation.Op, operation.path, operation.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	update := &model.UpdateUserInfo{ID: user.ID}
+	changed := false
+	if patched.Name != user.Name {
+		if err = s.validateUserName(ctx, patched.Name, user.ID); err != nil {
+			return nil, err
+		}
+		update.Name = &patched.Name
+		changed = true
+	}
+	if patched.Email != user.Email {
+		if err = validateEmail(patched.Email); err != nil {
+			return nil, err
+		}
+		update.Email = &patched.Email
+		changed = true
+	}
+	if patched.Status != user.Status {
+		update.Status = &patched.Status
+		changed = true
+	}
+	if !changed {
+		return user, nil
+	}
+
+	if err = s.userService.UpdateUser(ctx, update); err != nil {
+		return nil, err
+	}
+
+	return s.userService.GetUser(ctx, user.ID)
+}
+
+// applyUserOperation applies operation to user, operation without path sets
+// attributes of its value
+func applyUserOperation(user *model.User, op string, path *patchPath,
+	value json.RawMessage,
+) error {
+	if path == nil {
+		attributes, err := valueObject(value)
+		if err != nil {
+			return err
+		}
+		for key, attributeValue := range attributes {
+			attributePath, err := parsePatchPath(key)
+			if err != nil {
+				return err
+			}
+			if err = applyUserOperation(user, op, attributePath, attributeValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch path.attr {
+	case "username":
+		if op == patchRemove {
+			return badRequest(errorMutability, "userName is required")
+		}
+		name, err := decodeString(value, "userName")
+		if err != nil {
+			return err
+		}
+		user.Name = name
+	case "active":
+		if op == patchRemove {
+			return badRequest(errorMutability, "active can't be removed")
+		}
+		active, err := decodeBool(value, "active")
+		if err != nil {
+			return err
+		}
+		// invited user stays pending until invitation is accepted
+		switch {
+		case !active:
+			user.Status = model.UserStatusDisabled
+		case user.Status == model.UserStatusDisabled:
+			user.Status = model.UserStatusActive
+		}
+	case "emails":
+		return applyEmailOperation(user, op, path, value)
+	case "id", "meta", "groups", "password":
+		return badRequest(errorMutability, "%s can't be changed", path.attr)
+	}
+
+	return nil
+}
+
+// applyEmailOperation changes email of user, user has the only email
+// which any filter of emails selects
+func applyEmailOperation(user *model.User, op string, path *patchPath,
+	value json.RawMessage,
+) error {
+	switch {
+	case op == patchRemove:
+		user.Email = ""
+	case path.sub == "value":
+		address, err := decodeString(value, "emails.value")
+		if err != nil {
+			return err
+		}
+		user.Email = address
+	case path.sub != "":
+	case path.filter != nil:
+		var address email
+		if err := json.Unmarshal(value, &address); err != nil {
+			return badRequest(errorInvalidValue, "value of emails must be email")
+		}
+		user.Email = address.Value
+	default:
+		var addresses []*email
+		if err := json.Unmarshal(value, &addresses); err != nil {
+			return badRequest(errorInvalidValue, "emails must be array of emails")
+		}
+		user.Email = primaryEmail(addresses)
+	}
+
+	return nil
+}
+
+// DeleteUser deletes user
+func (s *Server) DeleteUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := s.findUser(ctx, pathParams["id"])
+	if err == nil {
+		err = s.userService.DeleteUser(ctx, user.ID)
+	}
+	if err != nil {
+		s.writeFailure(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findUser returns user of tenant by SCIM id
+func (s *Server) findUser(ctx context.Context, id string) (*model.User, error) {
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, notFound("user %s not found", id)
+	}
+
+	user, err := s.userService.GetUser(ctx, userID)
+	if errors.Is(err, model.ErrorUserNotFound) {
+		return nil, notFound("user %s not found", id)
+	}
+
+	return user, err
+}
+
+// allUsers returns every user of tenant
+func (s *Server) allUsers(ctx context.Context) ([]*model.User, error) {
+	var users []*model.User
+	var afterID int64
+	for {
+		page, err := s.userService.ListUsers(ctx, afterID, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, page...)
+		if len(page) < listPageSize {
+			return users, nil
+		}
+		afterID = page[len(page)-1].ID
+	}
+}
+
+// validateUserName checks that name can be given to user with id
+func (s *Server) validateUserName(ctx context.Context, name string, id int64) error {
+	if name == "" {
+		return badRequest(errorInvalidValue, "userName is required")
+	}
+	if model.IsServiceAccountPrincipal(name) {
+		return badRequest(errorInvalidValue, "userName %s is reserved for service accounts", name)
+	}
+
+	user, err := s.userService.GetUserByName(ctx, name)
+	switch {
+	case errors.Is(err, model.ErrorUserNotFound):
+		return nil
+	case err != nil:
+		return err
+	case user.ID != id:
+		return newError(http.StatusConflict, errorUniqueness, "userName "+name+" is already taken")
+	}
+
+	return nil
+}
+
+func validateEmail(address string) error {
+	if address == "" {
+		return nil
+	}
+	if _, err := mail.ParseAddress(address); err != nil {
+		return badRequest(errorInvalidValue, "invalid email %q", address)
+	}
+
+	return nil
+}
+
+// primaryEmail returns primary email or the first one
+func primaryEmail(emails []*email) string {
+	for _, address := range emails {
+		if address != nil && address.Primary {
+			return address.Value
+		}
+	}
+	for _, address := range emails {
+		if address != nil {
+			return address.Value
+		}
+	}
+
+	return ""
+}
+
+// userNameLookup returns name of filter selecting user by name
+func userNameLookup(userFilter filter) (string, bool) {
+	compared, ok := userFilter.(*compareFilter)
+	if !ok || compared.op != "eq" || compared.path != (attrPath{attr: "username"}) {
+		return "", false
+	}
+	name, ok := compared.value.(string)
+
+	return name, ok
+}
+
+func (s *Server) userResource(ctx context.Context, user *model.User) (*userResource, error) {
+	roles, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toUserResource(user, roles), nil
+}
+
+// toUserResource returns SCIM user, role of user is its only group
+func (s *Server) toUserResource(user *model.User, roles map[string]int64) *userResource {
+	id := strconv.FormatInt(user.ID, 10)
+	resource := &userResource{
+		Schemas:  []string{schemaUser},
+		ID:       id,
+		UserName: user.Name,
+		Active:   user.Status == model.UserStatusActive,
+		Meta:     s.meta("User", UsersPath, id, user.CreatedAt, user.UpdatedAt.Time),
+	}
+	if user.Email != "" {
+		resource.Emails = []*email{{Value: user.Email, Type: emailType, Primary: true}}
+	}
+	if roleID, ok := roles[user.Role]; ok {
+		groupID := strconv.FormatInt(roleID, 10)
+		resource.Groups = []*reference{{
+			Value:   groupID,
+			Ref:     s.location(GroupsPath, groupID),
+			Display: user.Role,
+		}}
+	}
+
+	return resource
+}
+
+func (s *Server) meta(resourceType, path, id string, created, modified time.Time) *meta {
+	if modified.IsZero() {
+		modified = created
+	}
+
+	return &meta{
+		ResourceType: resourceType,
+		Created:      created.UTC().Format(time.RFC3339),
+		LastModified: modified.UTC().Format(time.RFC3339),
+		Location:     s.location(path, id),
+	}
+}
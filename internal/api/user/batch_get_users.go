@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// BatchGetUsers returns info about users in order of requested ids
+func (i *Implementation) BatchGetUsers(ctx context.Context, req *user_v1.BatchGetUsersRequest) (
+	*user_v1.BatchGetUsersResponse, error,
+) {
+	err := validateBatchGetUsers(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	users, missingIDs, err := i.userService.BatchGetUsers(ctx, req.GetIds())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &user_v1.BatchGetUsersResponse{
+		Users:      converter.ToUsersV1FromService(users),
+		MissingIds: missingIDs,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/valek177/auth/grpc/pkg/user_v1"
 	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/identity"
 )
 
 // CreateUser creates new user with specified parameters
@@ -18,6 +19,11 @@ func (i *Implementation) CreateUser(ctx context.Context, req *user_v1.CreateUser
 		return nil, errors.WithStack(err)
 	}
 
+	// self-registered user can not choose privileged role
+	if _, ok := identity.FromContext(ctx); !ok && req.GetRole() != user_v1.Role_USER {
+		return nil, errors.New("only USER role can be chosen at self registration")
+	}
+
 	id, err := i.userService.CreateUser(ctx, converter.ToNewUserFromNewUserV1(req))
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// ExportUsers streams users of organization
+func (i *Implementation) ExportUsers(req *user_v1.ExportUsersRequest,
+	stream user_v1.UserV1_ExportUsersServer,
+) error {
+	err := validateExportUsers(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = i.userService.ExportUsers(stream.Context(), converter.ToDataFormatFromV1(req.GetFormat()),
+		&chunkWriter{stream: stream})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// chunkWriter sends every written buffer as one chunk of export stream
+type chunkWriter struct {
+	stream user_v1.UserV1_ExportUsersServer
+}
+
+// Write implements io.Writer
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	err := w.stream.Send(&user_v1.ExportUsersResponse{Chunk: chunk})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// GetOperation returns state of import operation
+func (i *Implementation) GetOperation(ctx context.Context, req *user_v1.GetOperationRequest) (
+	*user_v1.Operation, error,
+) {
+	err := validateGetOperation(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	operation, err := i.userService.GetOperation(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return converter.ToOperationV1FromService(operation), nil
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// GetUserByEmail returns info about user by e-mail
+func (i *Implementation) GetUserByEmail(ctx context.Context, req *user_v1.GetUserByEmailRequest) (
+	*user_v1.GetUserResponse, error,
+) {
+	err := validateGetUserByEmail(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	userObj, err := i.userService.GetUserByEmail(ctx, req.GetEmail())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &user_v1.GetUserResponse{
+		User: converter.ToUserV1FromService(userObj),
+	}, nil
+}
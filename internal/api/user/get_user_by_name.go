@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// GetUserByName returns info about user by name
+func (i *Implementation) GetUserByName(ctx context.Context, req *user_v1.GetUserByNameRequest) (
+	*user_v1.GetUserResponse, error,
+) {
+	err := validateGetUserByName(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	userObj, err := i.userService.GetUserByName(ctx, req.GetName())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &user_v1.GetUserResponse{
+		User: converter.ToUserV1FromService(userObj),
+	}, nil
+}
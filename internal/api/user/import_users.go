@@ -0,0 +1,54 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/converter"
+)
+
+// ImportUsers receives users file and starts import operation
+func (i *Implementation) ImportUsers(stream user_v1.UserV1_ImportUsersServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = validateImportUsersOptions(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	operation, err := i.userService.ImportUsers(stream.Context(),
+		converter.ToImportUsersOptionsFromV1(req.GetOptions()), &chunkReader{stream: stream})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return stream.SendAndClose(converter.ToOperationV1FromService(operation))
+}
+
+// chunkReader reads users file from chunks of upload stream
+type chunkReader struct {
+	stream user_v1.UserV1_ImportUsersServer
+	chunk  []byte
+}
+
+// Read implements io.Reader
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetOptions() != nil {
+			return 0, errors.New("options must be sent only in first message")
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/valek177/auth/grpc/pkg/user_v1"
 	descUser "github.com/valek177/auth/internal/api/user"
+	"github.com/valek177/auth/internal/identity"
 	"github.com/valek177/auth/internal/model"
 	"github.com/valek177/auth/internal/service"
 	serviceMocks "github.com/valek177/auth/internal/service/mocks"
@@ -42,6 +43,15 @@ func TestCreateUser(t *testing.T) {
 			PasswordConfirm: password,
 			Role:            user_v1.Role_USER,
 		}
+		adminReq = &user_v1.CreateUserRequest{
+			Name:            name,
+			Email:           email,
+			Password:        password,
+			PasswordConfirm: password,
+			Role:            user_v1.Role_ADMIN,
+		}
+		adminCtx = identity.NewContext(ctx, &model.UserClaims{UserID: 1, Role: model.RoleAdmin})
+
 		res = &user_v1.CreateUserResponse{
 			Id: id,
 		}
@@ -71,6 +81,20 @@ func TestCreateUser(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "success case: admin creates admin",
+			args: args{
+				ctx: adminCtx,
+				req: adminReq,
+			},
+			want: res,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Return(id, nil)
+				return mock
+			},
+		},
 	}
 	testsErrors := []struct {
 		name            string
@@ -108,6 +132,18 @@ func TestCreateUser(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "error: self registration with admin role",
+			args: args{
+				ctx: ctx,
+				req: adminReq,
+			},
+			want: nil,
+			err:  fmt.Errorf("only USER role can be chosen at self registration"),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+		},
 	}
 
 	for _, tt := range testsSuccessful {
@@ -56,9 +56,10 @@ func TestGetUser(t *testing.T) {
 			User: &user_v1.User{
 				Id: id,
 				UserInfo: &user_v1.UserInfo{
-					Name:  wrapperspb.String(name),
-					Email: wrapperspb.String(email),
-					Role:  user_v1.Role_USER,
+					Name:     wrapperspb.String(name),
+					Email:    wrapperspb.String(email),
+					Role:     user_v1.Role_USER,
+					RoleName: role,
 				},
 				CreatedAt: timestamppb.New(time),
 			},
@@ -37,3 +37,51 @@ func validateGetUser(req *user_v1.GetUserRequest) error {
 
 	return nil
 }
+
+func validateGetOperation(req *user_v1.GetOperationRequest) error {
+	if req == nil {
+		return errors.New("unable to get operation: empty request")
+	}
+
+	return nil
+}
+
+func validateImportUsersOptions(req *user_v1.ImportUsersRequest) error {
+	if req.GetOptions() == nil {
+		return errors.New("unable to import users: first message must contain options")
+	}
+
+	return req.GetOptions().Validate()
+}
+
+func validateExportUsers(req *user_v1.ExportUsersRequest) error {
+	if req == nil {
+		return errors.New("unable to export users: empty request")
+	}
+
+	return req.Validate()
+}
+
+func validateGetUserByName(req *user_v1.GetUserByNameRequest) error {
+	if req == nil {
+		return errors.New("unable to get user by name: empty request")
+	}
+
+	return nil
+}
+
+func validateGetUserByEmail(req *user_v1.GetUserByEmailRequest) error {
+	if req == nil {
+		return errors.New("unable to get user by email: empty request")
+	}
+
+	return nil
+}
+
+func validateBatchGetUsers(req *user_v1.BatchGetUsersRequest) error {
+	if req == nil {
+		return errors.New("unable to get users: empty request")
+	}
+
+	return nil
+}
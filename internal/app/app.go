@@ -7,9 +7,11 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
@@ -25,9 +27,18 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
 	"github.com/valek177/auth/grpc/pkg/access_v1"
 	"github.com/valek177/auth/grpc/pkg/auth_v1"
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
 	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/api/oauthserver"
+	"github.com/valek177/auth/internal/api/scim"
 	"github.com/valek177/auth/internal/config"
 	"github.com/valek177/auth/internal/interceptor"
 	"github.com/valek177/auth/internal/logger"
@@ -40,18 +51,41 @@ import (
 var (
 	configPath                string
 	corsAllowedOriginsDefault = []string{"*"}
-	corsAllowedMethodsDefault = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	corsAllowedHeadersDefault = []string{"Accept", "Content-Type", "Content-Length", "Authorization"}
+	corsAllowedMethodsDefault = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	corsAllowedHeadersDefault = []string{"Accept", "Content-Type", "Content-Length", "Authorization", "X-Tenant-Id"}
 
 	prometheusReadHeaderTimeout = time.Second * 3
 
+	policySnapshotResyncInterval = time.Second * 30
+
+	roleGrantExpiryInterval = time.Minute
+
+	federationRequestTimeout = time.Second * 10
+
+	ldapRequestTimeout = time.Second * 10
+
+	accessConditionCostLimit uint64 = 1000
+
 	loggerFilename        = "logs/app.log"
 	loggerMaxSizeMb       = 10
 	loggerMaxBackupsCount = 3
 	loggerMaxAgeDays      = 7
 )
 
-const corsAllowCredentialsDefault = true
+const (
+	corsAllowCredentialsDefault = true
+
+	tenantHeaderName = "x-tenant-id"
+
+	// methods of these services are authorized by access rules
+	userServicePrefix         = "/user_v1.UserV1/"
+	invitationServicePrefix   = "/invitation_v1.InvitationV1/"
+	organizationServicePrefix = "/organization_v1.OrganizationV1/"
+	accessAdminServicePrefix  = "/access_admin_v1.AccessAdminV1/"
+	oauthServicePrefix        = "/oauth_v1.OAuthV1/"
+	createUserMethod          = "/user_v1.UserV1/CreateUser"
+	acceptInvitationMethod    = "/invitation_v1.InvitationV1/AcceptInvitation"
+)
 
 func init() {
 	flag.StringVar(&configPath, "config-path", ".env", "path to config file")
@@ -79,14 +113,14 @@ func NewApp(ctx context.Context) (*App, error) {
 }
 
 // Run runs application
-func (a *App) Run(_ context.Context) error {
+func (a *App) Run(ctx context.Context) error {
 	defer func() {
 		closer.CloseAll()
 		closer.Wait()
 	}()
 
 	wg := sync.WaitGroup{}
-	wg.Add(4)
+	wg.Add(6)
 
 	go func() {
 		defer wg.Done()
@@ -130,6 +164,22 @@ func (a *App) Run(_ context.Context) error {
 	// 	}
 	// }()
 
+	go func() {
+		defer wg.Done()
+
+		store, err := a.serviceProvider.PolicySnapshotStore(ctx)
+		if err != nil {
+			logger.FatalWithMsg("failed to create policy snapshot store: ", err)
+		}
+		store.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		a.runRoleGrantExpiry(ctx)
+	}()
+
 	go func() {
 		defer wg.Done()
 
@@ -193,10 +243,30 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	}
 
 	logger.Init(getCore(getAtomicLevel(grpcCfg.LogLevel())))
+
+	tokenAccess, err := a.serviceProvider.TokenAccess()
+	if err != nil {
+		return err
+	}
 	// cb := circuitbreaker.NewCircuitBreaker()
 
 	// rateLimiter := rate_limiter.NewTokenBucketLimiter(ctx, 10, time.Second)
 
+	tenantInterceptor := interceptor.NewTenantInterceptor(tokenAccess)
+
+	accessService, err := a.serviceProvider.AccessService(ctx)
+	if err != nil {
+		return err
+	}
+	publicMethods := []string{acceptInvitationMethod}
+	if grpcCfg.SelfRegistration() {
+		publicMethods = append(publicMethods, createUserMethod)
+	}
+	authInterceptor := interceptor.NewAuthInterceptor(accessService, []string{
+		userServicePrefix, invitationServicePrefix, organizationServicePrefix,
+		accessAdminServicePrefix, oauthServicePrefix,
+	}, publicMethods)
+
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(creds),
 		grpc.UnaryInterceptor(
@@ -207,6 +277,15 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 				interceptor.MetricsInterceptor,
 				otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
 				interceptor.ValidateInterceptor,
+				tenantInterceptor.Unary,
+				authInterceptor.Unary,
+			),
+		),
+		grpc.StreamInterceptor(
+			grpcMiddleware.ChainStreamServer(
+				otgrpc.OpenTracingStreamServerInterceptor(opentracing.GlobalTracer()),
+				tenantInterceptor.Stream,
+				authInterceptor.Stream,
 			),
 		),
 	)
@@ -228,15 +307,63 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		return err
 	}
 
+	organizationImpl, err := a.serviceProvider.OrganizationImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitationImpl, err := a.serviceProvider.InvitationImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	privacyImpl, err := a.serviceProvider.PrivacyImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	accessAdminImpl, err := a.serviceProvider.AccessAdminImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	relationImpl, err := a.serviceProvider.RelationImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	extAuthzImpl, err := a.serviceProvider.ExtAuthzImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	elevationImpl, err := a.serviceProvider.ElevationImpl(ctx)
+	if err != nil {
+		return err
+	}
+
+	oauthImpl, err := a.serviceProvider.OAuthImpl(ctx)
+	if err != nil {
+		return err
+	}
+
 	user_v1.RegisterUserV1Server(a.grpcServer, userImpl)
 	auth_v1.RegisterAuthV1Server(a.grpcServer, authImpl)
 	access_v1.RegisterAccessV1Server(a.grpcServer, accessImpl)
+	organization_v1.RegisterOrganizationV1Server(a.grpcServer, organizationImpl)
+	invitation_v1.RegisterInvitationV1Server(a.grpcServer, invitationImpl)
+	privacy_v1.RegisterPrivacyV1Server(a.grpcServer, privacyImpl)
+	access_admin_v1.RegisterAccessAdminV1Server(a.grpcServer, accessAdminImpl)
+	relation_v1.RegisterRelationV1Server(a.grpcServer, relationImpl)
+	authv3.RegisterAuthorizationServer(a.grpcServer, extAuthzImpl)
+	elevation_v1.RegisterElevationV1Server(a.grpcServer, elevationImpl)
+	oauth_v1.RegisterOAuthV1Server(a.grpcServer, oauthImpl)
 
 	return nil
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -252,6 +379,45 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return err
 	}
 
+	err = invitation_v1.RegisterInvitationV1HandlerFromEndpoint(ctx, mux, grpcCfg.Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = privacy_v1.RegisterPrivacyV1HandlerFromEndpoint(ctx, mux, grpcCfg.Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = access_admin_v1.RegisterAccessAdminV1HandlerFromEndpoint(ctx, mux, grpcCfg.Address(),
+		opts)
+	if err != nil {
+		return err
+	}
+
+	err = relation_v1.RegisterRelationV1HandlerFromEndpoint(ctx, mux, grpcCfg.Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = elevation_v1.RegisterElevationV1HandlerFromEndpoint(ctx, mux, grpcCfg.Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = oauth_v1.RegisterOAuthV1HandlerFromEndpoint(ctx, mux, grpcCfg.Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	if err = a.registerOAuthEndpoints(ctx, mux); err != nil {
+		return err
+	}
+
+	if err = a.registerSCIMEndpoints(ctx, mux); err != nil {
+		return err
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   corsAllowedOriginsDefault,
 		AllowedMethods:   corsAllowedMethodsDefault,
@@ -272,6 +438,71 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	return nil
 }
 
+// registerOAuthEndpoints serves OAuth 2.0 and OpenID Connect endpoints
+// next to gateway routes
+func (a *App) registerOAuthEndpoints(ctx context.Context, mux *runtime.ServeMux) error {
+	oauthServer, err := a.serviceProvider.OAuthServer(ctx)
+	if err != nil {
+		return err
+	}
+
+	routes := []struct {
+		method  string
+		path    string
+		handler runtime.HandlerFunc
+	}{
+		{http.MethodGet, oauthserver.AuthorizePath, oauthServer.Authorize},
+		{http.MethodPost, oauthserver.AuthorizePath, oauthServer.Authorize},
+		{http.MethodPost, oauthserver.TokenPath, oauthServer.Token},
+		{http.MethodGet, oauthserver.UserInfoPath, oauthServer.UserInfo},
+		{http.MethodPost, oauthserver.UserInfoPath, oauthServer.UserInfo},
+		{http.MethodGet, oauthserver.DiscoveryPath, oauthServer.Discovery},
+		{http.MethodGet, oauthserver.JWKSPath, oauthServer.JWKS},
+		{http.MethodGet, oauthserver.FederatedLoginPath, oauthServer.FederatedLogin},
+		{http.MethodGet, oauthserver.FederatedCallbackPath, oauthServer.FederatedCallback},
+	}
+	for _, route := range routes {
+		if err = mux.HandlePath(route.method, route.path, route.handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerSCIMEndpoints serves SCIM 2.0 provisioning endpoints next to gateway routes
+func (a *App) registerSCIMEndpoints(ctx context.Context, mux *runtime.ServeMux) error {
+	scimServer, err := a.serviceProvider.SCIMServer(ctx)
+	if err != nil {
+		return err
+	}
+
+	routes := []struct {
+		method  string
+		path    string
+		handler runtime.HandlerFunc
+	}{
+		{http.MethodPost, scim.UsersPath, scimServer.CreateUser},
+		{http.MethodGet, scim.UsersPath, scimServer.ListUsers},
+		{http.MethodGet, scim.UserPath, scimServer.GetUser},
+		{http.MethodPatch, scim.UserPath, scimServer.PatchUser},
+		{http.MethodDelete, scim.UserPath, scimServer.DeleteUser},
+		{http.MethodPost, scim.GroupsPath, scimServer.CreateGroup},
+		{http.MethodGet, scim.GroupsPath, scimServer.ListGroups},
+		{http.MethodGet, scim.GroupPath, scimServer.GetGroup},
+		{http.MethodPatch, scim.GroupPath, scimServer.PatchGroup},
+		{http.MethodDelete, scim.GroupPath, scimServer.DeleteGroup},
+		{http.MethodGet, scim.ServiceProviderConfigPath, scimServer.ServiceProviderConfig},
+	}
+	for _, route := range routes {
+		if err = mux.HandlePath(route.method, route.path, route.handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (a *App) initSwaggerServer(_ context.Context) error {
 	statikFs, err := fs.New()
 	if err != nil {
@@ -296,7 +527,17 @@ func (a *App) initSwaggerServer(_ context.Context) error {
 }
 
 func (a *App) initMetrics(ctx context.Context) error {
-	return metric.Init(ctx)
+	if err := metric.Init(ctx); err != nil {
+		return err
+	}
+
+	store, err := a.serviceProvider.PolicySnapshotStore(ctx)
+	if err != nil {
+		return err
+	}
+	metric.RegisterPolicySnapshot(store)
+
+	return nil
 }
 
 // func (a *App) initCircuitBreaker(ctx context.Context) error {
@@ -423,6 +664,15 @@ func serveSwaggerFile(path string) http.HandlerFunc {
 	}
 }
 
+// incomingHeaderMatcher passes tenant header to GRPC server in addition to default headers
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, tenantHeaderName) {
+		return tenantHeaderName, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (a *App) runPrometheusServer() error {
 	cfg, err := a.serviceProvider.PrometheusConfig()
 	if err != nil {
@@ -438,6 +688,33 @@ func (a *App) runPrometheusServer() error {
 	return nil
 }
 
+// runRoleGrantExpiry periodically marks role grants past their expiry as expired
+// until context is done, tokens are capped by grant expiry so it only keeps grants tidy
+func (a *App) runRoleGrantExpiry(ctx context.Context) {
+	elevationService, err := a.serviceProvider.ElevationService(ctx)
+	if err != nil {
+		logger.FatalWithMsg("failed to create elevation service: ", err)
+	}
+
+	ticker := time.NewTicker(roleGrantExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := elevationService.ExpireElevations(ctx)
+		if err != nil {
+			logger.ErrorWithMsg("failed to expire role grants: ", err)
+		} else if expired > 0 {
+			logger.Info("role grants expired", zap.Int64("count", expired))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func getCore(level zap.AtomicLevel) zapcore.Core {
 	stdout := zapcore.AddSync(os.Stdout)
 
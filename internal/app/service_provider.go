@@ -2,27 +2,71 @@ package app
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/IBM/sarama"
 	redigo "github.com/gomodule/redigo/redis"
 	"github.com/pkg/errors"
 
 	accessImpl "github.com/valek177/auth/internal/api/access"
+	accessAdminImpl "github.com/valek177/auth/internal/api/access_admin"
 	authImpl "github.com/valek177/auth/internal/api/auth"
+	elevationImpl "github.com/valek177/auth/internal/api/elevation"
+	extAuthzImpl "github.com/valek177/auth/internal/api/ext_authz"
+	invitationImpl "github.com/valek177/auth/internal/api/invitation"
+	oauthImpl "github.com/valek177/auth/internal/api/oauth"
+	"github.com/valek177/auth/internal/api/oauthserver"
+	organizationImpl "github.com/valek177/auth/internal/api/organization"
+	privacyImpl "github.com/valek177/auth/internal/api/privacy"
+	relationImpl "github.com/valek177/auth/internal/api/relation"
+	"github.com/valek177/auth/internal/api/scim"
 	userImpl "github.com/valek177/auth/internal/api/user"
 	"github.com/valek177/auth/internal/client/kafka"
 	kafkaConsumer "github.com/valek177/auth/internal/client/kafka/consumer"
+	kafkaProducer "github.com/valek177/auth/internal/client/kafka/producer"
+	ldapClient "github.com/valek177/auth/internal/client/ldap"
+	"github.com/valek177/auth/internal/client/notifier"
+	"github.com/valek177/auth/internal/client/oidc"
+	"github.com/valek177/auth/internal/condition"
 	"github.com/valek177/auth/internal/config"
 	"github.com/valek177/auth/internal/config/env"
+	"github.com/valek177/auth/internal/decision"
 	"github.com/valek177/auth/internal/repository"
 	accessRepository "github.com/valek177/auth/internal/repository/access"
+	policyBundleRepository "github.com/valek177/auth/internal/repository/bundle"
+	erasureRepository "github.com/valek177/auth/internal/repository/erasure"
+	federatedIdentityRepository "github.com/valek177/auth/internal/repository/federatedidentity"
+	federationStateRepository "github.com/valek177/auth/internal/repository/federationstate"
+	invitationRepository "github.com/valek177/auth/internal/repository/invitation"
 	logRepo "github.com/valek177/auth/internal/repository/log"
+	namespaceRepository "github.com/valek177/auth/internal/repository/namespace"
+	oauthClientRepository "github.com/valek177/auth/internal/repository/oauthclient"
+	oauthCodeRepository "github.com/valek177/auth/internal/repository/oauthcode"
+	operationRepository "github.com/valek177/auth/internal/repository/operation"
+	organizationRepository "github.com/valek177/auth/internal/repository/organization"
+	permissionRepository "github.com/valek177/auth/internal/repository/permission"
+	policyRepository "github.com/valek177/auth/internal/repository/policy"
 	redisRepo "github.com/valek177/auth/internal/repository/redis"
+	relationRepository "github.com/valek177/auth/internal/repository/relation"
+	roleRepository "github.com/valek177/auth/internal/repository/role"
+	roleGrantRepository "github.com/valek177/auth/internal/repository/rolegrant"
+	serviceAccountRepository "github.com/valek177/auth/internal/repository/serviceaccount"
+	"github.com/valek177/auth/internal/repository/snapshot"
 	userRepository "github.com/valek177/auth/internal/repository/user"
 	"github.com/valek177/auth/internal/service"
 	accessService "github.com/valek177/auth/internal/service/access"
 	authService "github.com/valek177/auth/internal/service/auth"
+	"github.com/valek177/auth/internal/service/authenticator"
 	userSaverConsumer "github.com/valek177/auth/internal/service/consumer/user_saver"
+	elevationService "github.com/valek177/auth/internal/service/elevation"
+	federationService "github.com/valek177/auth/internal/service/federation"
+	invitationService "github.com/valek177/auth/internal/service/invitation"
+	oauthClientService "github.com/valek177/auth/internal/service/oauthclient"
+	organizationService "github.com/valek177/auth/internal/service/organization"
+	policyService "github.com/valek177/auth/internal/service/policy"
+	privacyService "github.com/valek177/auth/internal/service/privacy"
+	relationService "github.com/valek177/auth/internal/service/relation"
+	serviceAccountService "github.com/valek177/auth/internal/service/serviceaccount"
 	userService "github.com/valek177/auth/internal/service/user"
 	"github.com/valek177/auth/internal/utils"
 	cache "github.com/valek177/platform-common/pkg/client/cache"
@@ -44,12 +88,19 @@ type serviceProvider struct {
 	tokenAccessConfig  config.TokenConfig
 	prometheusConfig   config.PrometheusConfig
 	jaegerConfig       config.JaegerConfig
+	invitationConfig   config.InvitationConfig
+	oidcConfig         config.OIDCConfig
+	federationConfig   config.FederationConfig
+	ldapConfig         config.LDAPConfig
 
 	kafkaConsumerConfig  config.KafkaConsumerConfig
 	consumer             kafka.Consumer
 	consumerGroup        sarama.ConsumerGroup
 	consumerGroupHandler *kafkaConsumer.GroupHandler
 
+	kafkaProducerConfig config.KafkaProducerConfig
+	producer            kafka.Producer
+
 	userSaverConsumer service.ConsumerService
 
 	dbClient  db.Client
@@ -60,19 +111,65 @@ type serviceProvider struct {
 
 	tokenAccess  utils.Token
 	tokenRefresh utils.Token
-
-	userRepository   repository.UserRepository
-	accessRepository repository.AccessRepository
-	logRepository    repository.LogRepository
-	redisRepository  repository.UserRedisRepository
-
-	userService   service.UserService
-	authService   service.AuthService
-	accessService service.AccessService
-
-	userImpl   *userImpl.Implementation
-	authImpl   *authImpl.Implementation
-	accessImpl *accessImpl.Implementation
+	idToken      utils.IDToken
+
+	conditionEvaluator  *condition.Evaluator
+	decider             *decision.Decider
+	policySnapshotStore *snapshot.Store
+
+	invitationToken   utils.InvitationToken
+	notifier          notifier.Notifier
+	federationClients []oidc.Client
+	authenticators    []service.Authenticator
+
+	userRepository              repository.UserRepository
+	accessRepository            repository.AccessRepository
+	logRepository               repository.LogRepository
+	redisRepository             repository.UserRedisRepository
+	organizationRepository      repository.OrganizationRepository
+	roleRepository              repository.RoleRepository
+	invitationRepository        repository.InvitationRepository
+	operationRepository         repository.OperationRepository
+	erasureRepository           repository.ErasureRepository
+	roleGrantRepository         repository.RoleGrantRepository
+	policyBundleRepository      repository.PolicyBundleRepository
+	oauthClientRepository       repository.OAuthClientRepository
+	oauthCodeRepository         repository.OAuthAuthorizationCodeRepository
+	serviceAccountRepository    repository.ServiceAccountRepository
+	federatedIdentityRepository repository.FederatedIdentityRepository
+	federationStateRepository   repository.FederationStateRepository
+	revocationRepository        repository.TokenRevocationRepository
+	policyChangeRepository      repository.PolicyChangeRepository
+	permissionRepository        repository.PermissionRepository
+	tupleRepository             repository.RelationTupleRepository
+	namespaceRepository         repository.RelationNamespaceRepository
+
+	userService           service.UserService
+	authService           service.AuthService
+	accessService         service.AccessService
+	organizationService   service.OrganizationService
+	invitationService     service.InvitationService
+	privacyService        service.PrivacyService
+	elevationService      service.ElevationService
+	oauthClientService    service.OAuthClientService
+	serviceAccountService service.ServiceAccountService
+	federationService     service.FederationService
+	policyService         service.PolicyService
+	relationService       service.RelationService
+
+	userImpl         *userImpl.Implementation
+	authImpl         *authImpl.Implementation
+	accessImpl       *accessImpl.Implementation
+	organizationImpl *organizationImpl.Implementation
+	invitationImpl   *invitationImpl.Implementation
+	privacyImpl      *privacyImpl.Implementation
+	elevationImpl    *elevationImpl.Implementation
+	accessAdminImpl  *accessAdminImpl.Implementation
+	relationImpl     *relationImpl.Implementation
+	extAuthzImpl     *extAuthzImpl.Implementation
+	oauthImpl        *oauthImpl.Implementation
+	oauthServer      *oauthserver.Server
+	scimServer       *scim.Server
 }
 
 func newServiceProvider() *serviceProvider {
@@ -191,6 +288,20 @@ func (s *serviceProvider) KafkaConsumerConfig() (config.KafkaConsumerConfig, err
 	return s.kafkaConsumerConfig, nil
 }
 
+// KafkaProducerConfig returns config for kafka producer
+func (s *serviceProvider) KafkaProducerConfig() (config.KafkaProducerConfig, error) {
+	if s.kafkaProducerConfig == nil {
+		cfg, err := env.NewKafkaProducerConfig()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		s.kafkaProducerConfig = cfg
+	}
+
+	return s.kafkaProducerConfig, nil
+}
+
 // PrometheusConfig returns prometheus config
 func (s *serviceProvider) PrometheusConfig() (config.PrometheusConfig, error) {
 	if s.prometheusConfig == nil {
@@ -219,6 +330,62 @@ func (s *serviceProvider) JaegerConfig() (config.JaegerConfig, error) {
 	return s.jaegerConfig, nil
 }
 
+// InvitationConfig returns invitation config
+func (s *serviceProvider) InvitationConfig() (config.InvitationConfig, error) {
+	if s.invitationConfig == nil {
+		cfg, err := env.NewInvitationConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		s.invitationConfig = cfg
+	}
+
+	return s.invitationConfig, nil
+}
+
+// OIDCConfig returns OpenID Connect provider config
+func (s *serviceProvider) OIDCConfig() (config.OIDCConfig, error) {
+	if s.oidcConfig == nil {
+		cfg, err := env.NewOIDCConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		s.oidcConfig = cfg
+	}
+
+	return s.oidcConfig, nil
+}
+
+// FederationConfig returns upstream OpenID Connect providers config
+func (s *serviceProvider) FederationConfig() (config.FederationConfig, error) {
+	if s.federationConfig == nil {
+		cfg, err := env.NewFederationConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		s.federationConfig = cfg
+	}
+
+	return s.federationConfig, nil
+}
+
+// LDAPConfig returns LDAP directories config
+func (s *serviceProvider) LDAPConfig() (config.LDAPConfig, error) {
+	if s.ldapConfig == nil {
+		cfg, err := env.NewLDAPConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		s.ldapConfig = cfg
+	}
+
+	return s.ldapConfig, nil
+}
+
 // DBClient returns new db client
 func (s *serviceProvider) DBClient(ctx context.Context) (db.Client, error) {
 	if s.dbClient == nil {
@@ -312,12 +479,116 @@ func (s *serviceProvider) TokenAccess() (utils.Token, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.tokenAccess = utils.NewToken(cfg)
+		oidcCfg, err := s.OIDCConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.tokenAccess = utils.NewAccessToken(cfg, oidcCfg.SigningKey())
 	}
 
 	return s.tokenAccess, nil
 }
 
+// IDToken returns OpenID Connect ID token
+func (s *serviceProvider) IDToken() (utils.IDToken, error) {
+	if s.idToken == nil {
+		cfg, err := s.OIDCConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.idToken = utils.NewIDToken(cfg)
+	}
+
+	return s.idToken, nil
+}
+
+// FederationClients returns clients of upstream OpenID Connect providers
+func (s *serviceProvider) FederationClients() ([]oidc.Client, error) {
+	if s.federationClients == nil {
+		federationCfg, err := s.FederationConfig()
+		if err != nil {
+			return nil, err
+		}
+		oidcCfg, err := s.OIDCConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient := &http.Client{Timeout: federationRequestTimeout}
+		clients := make([]oidc.Client, 0, len(federationCfg.Providers()))
+		for _, provider := range federationCfg.Providers() {
+			clients = append(clients, oidc.NewClient(provider,
+				oauthserver.FederatedCallbackURL(oidcCfg.Issuer(), provider.Name), httpClient))
+		}
+		s.federationClients = clients
+	}
+
+	return s.federationClients, nil
+}
+
+// Authenticators returns password authenticators of login, local passwords are checked
+// before LDAP directories
+func (s *serviceProvider) Authenticators(ctx context.Context) ([]service.Authenticator, error) {
+	if s.authenticators == nil {
+		ldapCfg, err := s.LDAPConfig()
+		if err != nil {
+			return nil, err
+		}
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		redisRepo, err := s.UserRedisRepository()
+		if err != nil {
+			return nil, err
+		}
+		logRepo, err := s.LogRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		identityRepo, err := s.FederatedIdentityRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authenticators := []service.Authenticator{authenticator.NewLocalAuthenticator(userRepo)}
+		for _, directory := range ldapCfg.Directories() {
+			authenticators = append(authenticators, authenticator.NewLDAPAuthenticator(
+				ldapClient.NewClient(directory, ldapRequestTimeout),
+				userRepo, redisRepo, logRepo, identityRepo, txManager))
+		}
+		s.authenticators = authenticators
+	}
+
+	return s.authenticators, nil
+}
+
+// InvitationToken returns invitation token
+func (s *serviceProvider) InvitationToken() (utils.InvitationToken, error) {
+	if s.invitationToken == nil {
+		cfg, err := s.InvitationConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.invitationToken = utils.NewInvitationToken(cfg)
+	}
+
+	return s.invitationToken, nil
+}
+
+// Notifier returns notifier for user notifications
+func (s *serviceProvider) Notifier() notifier.Notifier {
+	if s.notifier == nil {
+		s.notifier = notifier.NewLogNotifier()
+	}
+
+	return s.notifier
+}
+
 // UserRedisRepository returns redis repository
 func (s *serviceProvider) UserRedisRepository() (
 	repository.UserRedisRepository, error,
@@ -328,16 +599,42 @@ func (s *serviceProvider) UserRedisRepository() (
 			return nil, errors.WithStack(err)
 		}
 
+		pool, err := s.RedisPool()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
 		config, err := s.RedisConfig()
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		s.redisRepository = redisRepo.NewUserRedisRepository(client, config)
+		s.redisRepository = redisRepo.NewUserRedisRepository(client, pool, config)
 	}
 
 	return s.redisRepository, nil
 }
 
+// TokenRevocationRepository returns revoked tokens repository, revocation is kept
+// while revoked refresh tokens may still be valid
+func (s *serviceProvider) TokenRevocationRepository() (
+	repository.TokenRevocationRepository, error,
+) {
+	if s.revocationRepository == nil {
+		client, err := s.RedisClient()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		cfg, err := s.TokenRefreshConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.revocationRepository = redisRepo.NewTokenRevocationRepository(client, cfg.ExpTime())
+	}
+
+	return s.revocationRepository, nil
+}
+
 // UserRepository returns new UserRepository
 func (s *serviceProvider) UserRepository(ctx context.Context) (repository.UserRepository, error) {
 	if s.userRepository == nil {
@@ -373,161 +670,1080 @@ func (s *serviceProvider) AccessRepository(ctx context.Context) (
 		if err != nil {
 			return nil, err
 		}
-		s.accessRepository = accessRepository.NewRepository(dbClient)
+		store, err := s.PolicySnapshotStore(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.accessRepository = snapshot.NewAccessRepository(
+			accessRepository.NewRepository(dbClient), store)
 	}
 
 	return s.accessRepository, nil
 }
 
-// UserService returns new UserService
-func (s *serviceProvider) UserService(ctx context.Context) (service.UserService, error) {
-	if s.userService == nil {
-		userRepo, err := s.UserRepository(ctx)
+// OrganizationRepository returns organization repository
+func (s *serviceProvider) OrganizationRepository(ctx context.Context) (
+	repository.OrganizationRepository, error,
+) {
+	if s.organizationRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		logRepo, err := s.LogRepository(ctx)
+		s.organizationRepository = organizationRepository.NewRepository(dbClient)
+	}
+
+	return s.organizationRepository, nil
+}
+
+// RoleRepository returns role repository
+func (s *serviceProvider) RoleRepository(ctx context.Context) (repository.RoleRepository, error) {
+	if s.roleRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		redisRepo, err := s.UserRedisRepository()
+		s.roleRepository = roleRepository.NewRepository(dbClient)
+	}
+
+	return s.roleRepository, nil
+}
+
+// PermissionRepository returns permission repository
+func (s *serviceProvider) PermissionRepository(ctx context.Context) (
+	repository.PermissionRepository, error,
+) {
+	if s.permissionRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		txManager, err := s.TxManager(ctx)
+		s.permissionRepository = permissionRepository.NewRepository(dbClient)
+	}
+
+	return s.permissionRepository, nil
+}
+
+// RelationTupleRepository returns relation tuples repository
+func (s *serviceProvider) RelationTupleRepository(ctx context.Context) (
+	repository.RelationTupleRepository, error,
+) {
+	if s.tupleRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.userService = userService.NewService(
-			userRepo, logRepo, redisRepo, txManager,
-		)
+		s.tupleRepository = relationRepository.NewRepository(dbClient)
 	}
 
-	return s.userService, nil
+	return s.tupleRepository, nil
 }
 
-// AuthService returns new AuthService
-func (s *serviceProvider) AuthService(ctx context.Context) (service.AuthService, error) {
-	if s.authService == nil {
-		userRepo, err := s.UserRepository(ctx)
+// RelationNamespaceRepository returns relation namespaces repository
+func (s *serviceProvider) RelationNamespaceRepository(ctx context.Context) (
+	repository.RelationNamespaceRepository, error,
+) {
+	if s.namespaceRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		tokenAccess, err := s.TokenAccess()
+		s.namespaceRepository = namespaceRepository.NewRepository(dbClient)
+	}
+
+	return s.namespaceRepository, nil
+}
+
+// InvitationRepository returns invitation repository
+func (s *serviceProvider) InvitationRepository(ctx context.Context) (
+	repository.InvitationRepository, error,
+) {
+	if s.invitationRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		tokenRefresh, err := s.TokenRefresh()
+		s.invitationRepository = invitationRepository.NewRepository(dbClient)
+	}
+
+	return s.invitationRepository, nil
+}
+
+// OperationRepository returns operation repository
+func (s *serviceProvider) OperationRepository(ctx context.Context) (
+	repository.OperationRepository, error,
+) {
+	if s.operationRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.authService = authService.NewService(
-			userRepo,
-			tokenRefresh,
-			tokenAccess,
-		)
+		s.operationRepository = operationRepository.NewRepository(dbClient)
 	}
 
-	return s.authService, nil
+	return s.operationRepository, nil
 }
 
-// AccessService returns new AccessService
-func (s *serviceProvider) AccessService(ctx context.Context) (service.AccessService, error) {
-	if s.accessService == nil {
-		accessRepo, err := s.AccessRepository(ctx)
+// ErasureRepository returns erasure requests repository
+func (s *serviceProvider) ErasureRepository(ctx context.Context) (
+	repository.ErasureRepository, error,
+) {
+	if s.erasureRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		tokenAccess, err := s.TokenAccess()
+		s.erasureRepository = erasureRepository.NewRepository(dbClient)
+	}
+
+	return s.erasureRepository, nil
+}
+
+// RoleGrantRepository returns time-bound role grants repository
+func (s *serviceProvider) RoleGrantRepository(ctx context.Context) (
+	repository.RoleGrantRepository, error,
+) {
+	if s.roleGrantRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.accessService = accessService.NewService(accessRepo, tokenAccess)
+		s.roleGrantRepository = roleGrantRepository.NewRepository(dbClient)
 	}
 
-	return s.accessService, nil
+	return s.roleGrantRepository, nil
 }
 
-// UserImpl returns new User Service implementation
-func (s *serviceProvider) UserImpl(ctx context.Context) (*userImpl.Implementation, error) {
-	if s.userImpl == nil {
-		userServ, err := s.UserService(ctx)
+// PolicyBundleRepository returns applied policy bundles repository
+func (s *serviceProvider) PolicyBundleRepository(ctx context.Context) (
+	repository.PolicyBundleRepository, error,
+) {
+	if s.policyBundleRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.userImpl = userImpl.NewImplementation(userServ)
+		s.policyBundleRepository = policyBundleRepository.NewRepository(dbClient)
 	}
 
-	return s.userImpl, nil
+	return s.policyBundleRepository, nil
 }
 
-// AuthImpl returns new Auth Service implementation
-func (s *serviceProvider) AuthImpl(ctx context.Context) (*authImpl.Implementation, error) {
-	if s.authImpl == nil {
-		authServ, err := s.AuthService(ctx)
+// OAuthClientRepository returns OAuth clients repository
+func (s *serviceProvider) OAuthClientRepository(ctx context.Context) (
+	repository.OAuthClientRepository, error,
+) {
+	if s.oauthClientRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.authImpl = authImpl.NewImplementation(authServ)
+		s.oauthClientRepository = oauthClientRepository.NewRepository(dbClient)
 	}
 
-	return s.authImpl, nil
+	return s.oauthClientRepository, nil
 }
 
-// AccessImpl returns new Access Service implementation
-func (s *serviceProvider) AccessImpl(ctx context.Context) (*accessImpl.Implementation, error) {
-	if s.accessImpl == nil {
-		accessServ, err := s.AccessService(ctx)
+// OAuthCodeRepository returns OAuth authorization codes repository
+func (s *serviceProvider) OAuthCodeRepository(ctx context.Context) (
+	repository.OAuthAuthorizationCodeRepository, error,
+) {
+	if s.oauthCodeRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.accessImpl = accessImpl.NewImplementation(accessServ)
+		s.oauthCodeRepository = oauthCodeRepository.NewRepository(dbClient)
 	}
 
-	return s.accessImpl, nil
+	return s.oauthCodeRepository, nil
 }
 
-// UserSaverConsumer returns user consumer service
-func (s *serviceProvider) UserSaverConsumer(ctx context.Context) (service.ConsumerService, error) {
-	if s.userSaverConsumer == nil {
-		userRepo, err := s.UserRepository(ctx)
+// ServiceAccountRepository returns service accounts repository
+func (s *serviceProvider) ServiceAccountRepository(ctx context.Context) (
+	repository.ServiceAccountRepository, error,
+) {
+	if s.serviceAccountRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		consumer, err := s.Consumer()
+		s.serviceAccountRepository = serviceAccountRepository.NewRepository(dbClient)
+	}
+
+	return s.serviceAccountRepository, nil
+}
+
+// FederatedIdentityRepository returns links of upstream provider accounts repository
+func (s *serviceProvider) FederatedIdentityRepository(ctx context.Context) (
+	repository.FederatedIdentityRepository, error,
+) {
+	if s.federatedIdentityRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.userSaverConsumer = userSaverConsumer.NewService(
-			userRepo,
-			consumer,
-		)
+		s.federatedIdentityRepository = federatedIdentityRepository.NewRepository(dbClient)
 	}
 
-	return s.userSaverConsumer, nil
+	return s.federatedIdentityRepository, nil
 }
 
-// Consumer returns kafka consumer
-func (s *serviceProvider) Consumer() (kafka.Consumer, error) {
-	if s.consumer == nil {
-		group, err := s.ConsumerGroup()
+// FederationStateRepository returns federated logins in progress repository
+func (s *serviceProvider) FederationStateRepository(ctx context.Context) (
+	repository.FederationStateRepository, error,
+) {
+	if s.federationStateRepository == nil {
+		dbClient, err := s.DBClient(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.consumer = kafkaConsumer.NewConsumer(
-			group,
-			s.ConsumerGroupHandler(),
-		)
-		closer.Add(s.consumer.Close)
+		s.federationStateRepository = federationStateRepository.NewRepository(dbClient)
 	}
 
-	return s.consumer, nil
+	return s.federationStateRepository, nil
 }
 
-// ConsumerGroup returns consumer group
-func (s *serviceProvider) ConsumerGroup() (sarama.ConsumerGroup, error) {
-	if s.consumerGroup == nil {
-		cfg, err := s.KafkaConsumerConfig()
+// PolicyChangeRepository returns policy changes audit repository
+func (s *serviceProvider) PolicyChangeRepository(ctx context.Context) (
+	repository.PolicyChangeRepository, error,
+) {
+	if s.policyChangeRepository == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.policyChangeRepository = policyRepository.NewRepository(dbClient)
+	}
+
+	return s.policyChangeRepository, nil
+}
+
+// UserService returns new UserService
+func (s *serviceProvider) UserService(ctx context.Context) (service.UserService, error) {
+	if s.userService == nil {
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logRepo, err := s.LogRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		redisRepo, err := s.UserRedisRepository()
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		operationRepo, err := s.OperationRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		revocationRepo, err := s.TokenRevocationRepository()
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.userService = userService.NewService(
+			userRepo, logRepo, redisRepo, revocationRepo, roleRepo, operationRepo, txManager,
+		)
+	}
+
+	return s.userService, nil
+}
+
+// AuthService returns new AuthService
+func (s *serviceProvider) AuthService(ctx context.Context) (service.AuthService, error) {
+	if s.authService == nil {
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logRepo, err := s.LogRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		revocationRepo, err := s.TokenRevocationRepository()
+		if err != nil {
+			return nil, err
+		}
+		roleGrantRepo, err := s.RoleGrantRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oauthClientRepo, err := s.OAuthClientRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oauthCodeRepo, err := s.OAuthCodeRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountRepo, err := s.ServiceAccountRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tokenAccess, err := s.TokenAccess()
+		if err != nil {
+			return nil, err
+		}
+		tokenRefresh, err := s.TokenRefresh()
+		if err != nil {
+			return nil, err
+		}
+		idToken, err := s.IDToken()
+		if err != nil {
+			return nil, err
+		}
+		authenticators, err := s.Authenticators(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.authService = authService.NewService(
+			userRepo,
+			logRepo,
+			revocationRepo,
+			roleGrantRepo,
+			oauthClientRepo,
+			oauthCodeRepo,
+			serviceAccountRepo,
+			tokenRefresh,
+			tokenAccess,
+			idToken,
+			authenticators,
+		)
+	}
+
+	return s.authService, nil
+}
+
+// ConditionEvaluator returns evaluator of access rule conditions
+func (s *serviceProvider) ConditionEvaluator() (*condition.Evaluator, error) {
+	if s.conditionEvaluator == nil {
+		evaluator, err := condition.NewEvaluator(accessConditionCostLimit)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		s.conditionEvaluator = evaluator
+	}
+
+	return s.conditionEvaluator, nil
+}
+
+// PolicySnapshotStore returns in-memory snapshot of access policies of all tenants
+func (s *serviceProvider) PolicySnapshotStore(ctx context.Context) (*snapshot.Store, error) {
+	if s.policySnapshotStore == nil {
+		dbClient, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pgConfig, err := s.PGConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.policySnapshotStore = snapshot.NewStore(snapshot.NewRepository(dbClient),
+			pgConfig.DSN(), policySnapshotResyncInterval)
+	}
+
+	return s.policySnapshotStore, nil
+}
+
+// Decider returns decider of access by access rules,
+// roles and permissions of subjects are resolved by policy snapshot
+func (s *serviceProvider) Decider(ctx context.Context) (*decision.Decider, error) {
+	if s.decider == nil {
+		permissionRepo, err := s.PermissionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		store, err := s.PolicySnapshotStore(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conditions, err := s.ConditionEvaluator()
+		if err != nil {
+			return nil, err
+		}
+		s.decider = decision.New(snapshot.NewPermissionRepository(permissionRepo, store),
+			snapshot.NewRoleRepository(roleRepo, store), conditions)
+	}
+
+	return s.decider, nil
+}
+
+// AccessService returns new AccessService
+func (s *serviceProvider) AccessService(ctx context.Context) (service.AccessService, error) {
+	if s.accessService == nil {
+		accessRepo, err := s.AccessRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		revocationRepo, err := s.TokenRevocationRepository()
+		if err != nil {
+			return nil, err
+		}
+		tokenAccess, err := s.TokenAccess()
+		if err != nil {
+			return nil, err
+		}
+		decider, err := s.Decider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.accessService = accessService.NewService(accessRepo, revocationRepo, tokenAccess,
+			decider)
+	}
+
+	return s.accessService, nil
+}
+
+// OrganizationService returns new OrganizationService
+func (s *serviceProvider) OrganizationService(ctx context.Context) (
+	service.OrganizationService, error,
+) {
+	if s.organizationService == nil {
+		organizationRepo, err := s.OrganizationRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessRepo, err := s.AccessRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		permissionRepo, err := s.PermissionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.organizationService = organizationService.NewService(
+			organizationRepo, roleRepo, accessRepo, permissionRepo, txManager,
+		)
+	}
+
+	return s.organizationService, nil
+}
+
+// InvitationService returns new InvitationService
+func (s *serviceProvider) InvitationService(ctx context.Context) (
+	service.InvitationService, error,
+) {
+	if s.invitationService == nil {
+		invitationRepo, err := s.InvitationRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logRepo, err := s.LogRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		invitationToken, err := s.InvitationToken()
+		if err != nil {
+			return nil, err
+		}
+		tokenRefresh, err := s.TokenRefresh()
+		if err != nil {
+			return nil, err
+		}
+		tokenAccess, err := s.TokenAccess()
+		if err != nil {
+			return nil, err
+		}
+		invitationCfg, err := s.InvitationConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.invitationService = invitationService.NewService(
+			invitationRepo,
+			userRepo,
+			roleRepo,
+			logRepo,
+			txManager,
+			invitationToken,
+			tokenRefresh,
+			tokenAccess,
+			s.Notifier(),
+			invitationCfg.URL(),
+		)
+	}
+
+	return s.invitationService, nil
+}
+
+// PrivacyService returns new PrivacyService
+func (s *serviceProvider) PrivacyService(ctx context.Context) (service.PrivacyService, error) {
+	if s.privacyService == nil {
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logRepo, err := s.LogRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		invitationRepo, err := s.InvitationRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		erasureRepo, err := s.ErasureRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleGrantRepo, err := s.RoleGrantRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policyChangeRepo, err := s.PolicyChangeRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oauthCodeRepo, err := s.OAuthCodeRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		federatedIdentityRepo, err := s.FederatedIdentityRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		redisRepo, err := s.UserRedisRepository()
+		if err != nil {
+			return nil, err
+		}
+		revocationRepo, err := s.TokenRevocationRepository()
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		producer, err := s.Producer()
+		if err != nil {
+			return nil, err
+		}
+		s.privacyService = privacyService.NewService(
+			userRepo,
+			logRepo,
+			invitationRepo,
+			erasureRepo,
+			roleGrantRepo,
+			policyChangeRepo,
+			oauthCodeRepo,
+			federatedIdentityRepo,
+			redisRepo,
+			revocationRepo,
+			txManager,
+			accessServ,
+			producer,
+		)
+	}
+
+	return s.privacyService, nil
+}
+
+// ElevationService returns new ElevationService
+func (s *serviceProvider) ElevationService(ctx context.Context) (
+	service.ElevationService, error,
+) {
+	if s.elevationService == nil {
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleGrantRepo, err := s.RoleGrantRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		permissionRepo, err := s.PermissionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.elevationService = elevationService.NewService(
+			userRepo,
+			roleGrantRepo,
+			permissionRepo,
+			roleRepo,
+			accessServ,
+		)
+	}
+
+	return s.elevationService, nil
+}
+
+// PolicyService returns new PolicyService
+func (s *serviceProvider) PolicyService(ctx context.Context) (service.PolicyService, error) {
+	if s.policyService == nil {
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessRepo, err := s.AccessRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		permissionRepo, err := s.PermissionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policyChangeRepo, err := s.PolicyChangeRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policyBundleRepo, err := s.PolicyBundleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		decider, err := s.Decider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.policyService = policyService.NewService(
+			userRepo,
+			accessRepo,
+			roleRepo,
+			permissionRepo,
+			policyChangeRepo,
+			policyBundleRepo,
+			txManager,
+			accessServ,
+			decider,
+		)
+	}
+
+	return s.policyService, nil
+}
+
+// UserImpl returns new User Service implementation
+func (s *serviceProvider) UserImpl(ctx context.Context) (*userImpl.Implementation, error) {
+	if s.userImpl == nil {
+		userServ, err := s.UserService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.userImpl = userImpl.NewImplementation(userServ)
+	}
+
+	return s.userImpl, nil
+}
+
+// AuthImpl returns new Auth Service implementation
+func (s *serviceProvider) AuthImpl(ctx context.Context) (*authImpl.Implementation, error) {
+	if s.authImpl == nil {
+		authServ, err := s.AuthService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.authImpl = authImpl.NewImplementation(authServ)
+	}
+
+	return s.authImpl, nil
+}
+
+// AccessImpl returns new Access Service implementation
+func (s *serviceProvider) AccessImpl(ctx context.Context) (*accessImpl.Implementation, error) {
+	if s.accessImpl == nil {
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.accessImpl = accessImpl.NewImplementation(accessServ)
+	}
+
+	return s.accessImpl, nil
+}
+
+// OrganizationImpl returns new Organization Service implementation
+func (s *serviceProvider) OrganizationImpl(ctx context.Context) (
+	*organizationImpl.Implementation, error,
+) {
+	if s.organizationImpl == nil {
+		organizationServ, err := s.OrganizationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.organizationImpl = organizationImpl.NewImplementation(organizationServ)
+	}
+
+	return s.organizationImpl, nil
+}
+
+// InvitationImpl returns new Invitation Service implementation
+func (s *serviceProvider) InvitationImpl(ctx context.Context) (
+	*invitationImpl.Implementation, error,
+) {
+	if s.invitationImpl == nil {
+		invitationServ, err := s.InvitationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.invitationImpl = invitationImpl.NewImplementation(invitationServ)
+	}
+
+	return s.invitationImpl, nil
+}
+
+// PrivacyImpl returns new Privacy Service implementation
+func (s *serviceProvider) PrivacyImpl(ctx context.Context) (*privacyImpl.Implementation, error) {
+	if s.privacyImpl == nil {
+		privacyServ, err := s.PrivacyService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.privacyImpl = privacyImpl.NewImplementation(privacyServ)
+	}
+
+	return s.privacyImpl, nil
+}
+
+// OAuthClientService returns service of OAuth clients registry
+func (s *serviceProvider) OAuthClientService(ctx context.Context) (
+	service.OAuthClientService, error,
+) {
+	if s.oauthClientService == nil {
+		oauthClientRepo, err := s.OAuthClientRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.oauthClientService = oauthClientService.NewService(
+			oauthClientRepo,
+			accessServ,
+		)
+	}
+
+	return s.oauthClientService, nil
+}
+
+// ServiceAccountService returns service of service accounts
+func (s *serviceProvider) ServiceAccountService(ctx context.Context) (
+	service.ServiceAccountService, error,
+) {
+	if s.serviceAccountService == nil {
+		serviceAccountRepo, err := s.ServiceAccountRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleRepo, err := s.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		permissionRepo, err := s.PermissionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		revocationRepo, err := s.TokenRevocationRepository()
+		if err != nil {
+			return nil, err
+		}
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.serviceAccountService = serviceAccountService.NewService(
+			serviceAccountRepo,
+			roleRepo,
+			permissionRepo,
+			revocationRepo,
+			accessServ,
+		)
+	}
+
+	return s.serviceAccountService, nil
+}
+
+// OAuthImpl returns new OAuth Service implementation
+func (s *serviceProvider) OAuthImpl(ctx context.Context) (*oauthImpl.Implementation, error) {
+	if s.oauthImpl == nil {
+		oauthClientServ, err := s.OAuthClientService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountServ, err := s.ServiceAccountService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.oauthImpl = oauthImpl.NewImplementation(oauthClientServ, serviceAccountServ)
+	}
+
+	return s.oauthImpl, nil
+}
+
+// FederationService returns federated login service
+func (s *serviceProvider) FederationService(ctx context.Context) (
+	service.FederationService, error,
+) {
+	if s.federationService == nil {
+		oauthClientRepo, err := s.OAuthClientRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logRepo, err := s.LogRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		identityRepo, err := s.FederatedIdentityRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		stateRepo, err := s.FederationStateRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clients, err := s.FederationClients()
+		if err != nil {
+			return nil, err
+		}
+		s.federationService = federationService.NewService(
+			oauthClientRepo,
+			userRepo,
+			logRepo,
+			identityRepo,
+			stateRepo,
+			txManager,
+			clients,
+		)
+	}
+
+	return s.federationService, nil
+}
+
+// OAuthServer returns OAuth 2.0 authorization server
+func (s *serviceProvider) OAuthServer(ctx context.Context) (*oauthserver.Server, error) {
+	if s.oauthServer == nil {
+		authServ, err := s.AuthService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		federationServ, err := s.FederationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idToken, err := s.IDToken()
+		if err != nil {
+			return nil, err
+		}
+		s.oauthServer = oauthserver.NewServer(authServ, federationServ, idToken)
+	}
+
+	return s.oauthServer, nil
+}
+
+// SCIMServer returns SCIM 2.0 provisioning server
+func (s *serviceProvider) SCIMServer(ctx context.Context) (*scim.Server, error) {
+	if s.scimServer == nil {
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userServ, err := s.UserService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		organizationServ, err := s.OrganizationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oidcCfg, err := s.OIDCConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.scimServer = scim.NewServer(accessServ, userServ, organizationServ, oidcCfg.Issuer())
+	}
+
+	return s.scimServer, nil
+}
+
+// ElevationImpl returns new Elevation Service implementation
+func (s *serviceProvider) ElevationImpl(ctx context.Context) (
+	*elevationImpl.Implementation, error,
+) {
+	if s.elevationImpl == nil {
+		elevationServ, err := s.ElevationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.elevationImpl = elevationImpl.NewImplementation(elevationServ)
+	}
+
+	return s.elevationImpl, nil
+}
+
+// RelationService returns new RelationService
+func (s *serviceProvider) RelationService(ctx context.Context) (service.RelationService, error) {
+	if s.relationService == nil {
+		tupleRepo, err := s.RelationTupleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		namespaceRepo, err := s.RelationNamespaceRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.relationService = relationService.NewService(
+			tupleRepo,
+			namespaceRepo,
+			txManager,
+			accessServ,
+		)
+	}
+
+	return s.relationService, nil
+}
+
+// AccessAdminImpl returns new Access Admin Service implementation
+func (s *serviceProvider) AccessAdminImpl(ctx context.Context) (
+	*accessAdminImpl.Implementation, error,
+) {
+	if s.accessAdminImpl == nil {
+		policyServ, err := s.PolicyService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.accessAdminImpl = accessAdminImpl.NewImplementation(policyServ)
+	}
+
+	return s.accessAdminImpl, nil
+}
+
+// ExtAuthzImpl returns new Envoy external authorization implementation
+func (s *serviceProvider) ExtAuthzImpl(ctx context.Context) (*extAuthzImpl.Implementation, error) {
+	if s.extAuthzImpl == nil {
+		accessServ, err := s.AccessService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.extAuthzImpl = extAuthzImpl.NewImplementation(accessServ)
+	}
+
+	return s.extAuthzImpl, nil
+}
+
+// RelationImpl returns new Relation Service implementation
+func (s *serviceProvider) RelationImpl(ctx context.Context) (*relationImpl.Implementation, error) {
+	if s.relationImpl == nil {
+		relationServ, err := s.RelationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.relationImpl = relationImpl.NewImplementation(relationServ)
+	}
+
+	return s.relationImpl, nil
+}
+
+// UserSaverConsumer returns user consumer service
+func (s *serviceProvider) UserSaverConsumer(ctx context.Context) (service.ConsumerService, error) {
+	if s.userSaverConsumer == nil {
+		userRepo, err := s.UserRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+		consumer, err := s.Consumer()
+		if err != nil {
+			return nil, err
+		}
+		s.userSaverConsumer = userSaverConsumer.NewService(
+			userRepo,
+			consumer,
+		)
+	}
+
+	return s.userSaverConsumer, nil
+}
+
+// Consumer returns kafka consumer
+func (s *serviceProvider) Consumer() (kafka.Consumer, error) {
+	if s.consumer == nil {
+		group, err := s.ConsumerGroup()
+		if err != nil {
+			return nil, err
+		}
+		s.consumer = kafkaConsumer.NewConsumer(
+			group,
+			s.ConsumerGroupHandler(),
+		)
+		closer.Add(s.consumer.Close)
+	}
+
+	return s.consumer, nil
+}
+
+// ConsumerGroup returns consumer group
+func (s *serviceProvider) ConsumerGroup() (sarama.ConsumerGroup, error) {
+	if s.consumerGroup == nil {
+		cfg, err := s.KafkaConsumerConfig()
 		if err != nil {
 			return nil, err
 		}
@@ -554,3 +1770,22 @@ func (s *serviceProvider) ConsumerGroupHandler() *kafkaConsumer.GroupHandler {
 
 	return s.consumerGroupHandler
 }
+
+// Producer returns kafka producer
+func (s *serviceProvider) Producer() (kafka.Producer, error) {
+	if s.producer == nil {
+		cfg, err := s.KafkaProducerConfig()
+		if err != nil {
+			return nil, err
+		}
+		syncProducer, err := sarama.NewSyncProducer(cfg.Brokers(), cfg.Config())
+		if err != nil {
+			return nil, err
+		}
+
+		s.producer = kafkaProducer.NewProducer(syncProducer)
+		closer.Add(s.producer.Close)
+	}
+
+	return s.producer, nil
+}
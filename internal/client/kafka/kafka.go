@@ -11,3 +11,9 @@ type Consumer interface {
 	Consume(ctx context.Context, topicName string, handler consumer.Handler) (err error)
 	Close() error
 }
+
+// Producer is interface for kafka producer
+type Producer interface {
+	Produce(ctx context.Context, topicName string, key string, value []byte) error
+	Close() error
+}
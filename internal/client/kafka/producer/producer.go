@@ -0,0 +1,38 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+type producer struct {
+	syncProducer sarama.SyncProducer
+}
+
+// NewProducer returns new kafka producer
+func NewProducer(syncProducer sarama.SyncProducer) *producer {
+	return &producer{
+		syncProducer: syncProducer,
+	}
+}
+
+// Produce sends message to topic and waits for acknowledgement
+func (p *producer) Produce(_ context.Context, topicName string, key string, value []byte) error {
+	_, _, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: topicName,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(value),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Close closes sync producer
+func (p *producer) Close() error {
+	return p.syncProducer.Close()
+}
@@ -0,0 +1,4 @@
+package ldap
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i Client -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,144 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// Client is interface for LDAP or Active Directory server users authenticate against
+type Client interface {
+	Directory() *model.LDAPDirectory
+	Authenticate(ctx context.Context, username, password string) (*model.LDAPEntry, error)
+}
+
+type client struct {
+	directory *model.LDAPDirectory
+	timeout   time.Duration
+}
+
+// NewClient returns client of directory, every authentication uses new connection
+// closed after timeout at the latest
+func NewClient(directory *model.LDAPDirectory, timeout time.Duration) Client {
+	return &client{
+		directory: directory,
+		timeout:   timeout,
+	}
+}
+
+// Directory returns config of directory
+func (c *client) Directory() *model.LDAPDirectory {
+	return c.directory
+}
+
+// Authenticate searches entry of user and binds as it with password, returns
+// model.ErrorInvalidCredentials when directory has no such user or password is wrong
+func (c *client) Authenticate(ctx context.Context, username, password string) (
+	*model.LDAPEntry, error,
+) {
+	// simple bind with empty password is unauthenticated bind which always succeeds,
+	// RFC 4513 section 5.1.2
+	if username == "" || password == "" {
+		return nil, model.ErrorInvalidCredentials
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if c.directory.BindDN != "" {
+		if err = conn.Bind(c.directory.BindDN, c.directory.BindPassword); err != nil {
+			return nil, errors.Wrap(err, "unable to bind search account")
+		}
+	}
+
+	entry, err := c.search(conn, username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn.Bind(entry.DN, password)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return nil, model.ErrorInvalidCredentials
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to bind user")
+	}
+
+	return &model.LDAPEntry{
+		DN:       entry.DN,
+		Username: entry.GetEqualFoldAttributeValue(c.directory.UsernameAttribute),
+		Email:    entry.GetEqualFoldAttributeValue(c.directory.EmailAttribute),
+		Groups:   entry.GetEqualFoldAttributeValues(c.directory.GroupAttribute),
+	}, nil
+}
+
+// search returns the only entry of user under base DN
+func (c *client) search(conn *ldap.Conn, username string) (*ldap.Entry, error) {
+	filter := fmt.Sprintf("(&%s(%s=%s))", c.directory.UserFilter,
+		c.directory.UsernameAttribute, ldap.EscapeFilter(username))
+
+	// size limit of two is enough to detect ambiguous user
+	res, err := conn.Search(ldap.NewSearchRequest(c.directory.BaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false, filter,
+		[]string{
+			c.directory.UsernameAttribute,
+			c.directory.EmailAttribute,
+			c.directory.GroupAttribute,
+		}, nil))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, errors.Wrap(err, "unable to search user")
+	}
+
+	switch {
+	case res == nil || len(res.Entries) == 0:
+		return nil, model.ErrorInvalidCredentials
+	case len(res.Entries) > 1:
+		return nil, errors.Errorf("directory has several users %q", username)
+	default:
+		return res.Entries[0], nil
+	}
+}
+
+func (c *client) dial(ctx context.Context) (*ldap.Conn, error) {
+	serverURL, err := url.Parse(c.directory.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid directory url")
+	}
+
+	timeout := c.timeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: serverURL.Hostname(),
+		MinVersion: tls.VersionTLS12,
+	}
+	conn, err := ldap.DialURL(c.directory.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to connect to directory")
+	}
+	conn.SetTimeout(timeout)
+
+	if c.directory.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, errors.Wrap(err, "unable to start tls")
+		}
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,335 @@
+// Package ldaptest provides in-process LDAP server for tests, it supports simple bind
+// and search with equality, presence, and, or and not filters
+package ldaptest
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// LDAP operations and result codes, RFC 4511 section 4
+const (
+	opBindRequest     ber.Tag = 0
+	opBindResponse    ber.Tag = 1
+	opUnbindRequest   ber.Tag = 2
+	opSearchRequest   ber.Tag = 3
+	opSearchEntry     ber.Tag = 4
+	opSearchDone      ber.Tag = 5
+	opExtendedRequest ber.Tag = 23
+	opExtendedResult  ber.Tag = 24
+
+	resultSuccess                 = 0
+	resultProtocolError           = 2
+	resultInvalidCredentials      = 49
+	resultInsufficientAccessRight = 50
+)
+
+// Entry is directory entry, Password is password user binds with
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server is LDAP server listening on loopback interface
+type Server struct {
+	// URL is ldap:// URL of server
+	URL string
+
+	bindDN       string
+	bindPassword string
+	listener     net.Listener
+
+	mu      sync.Mutex
+	entries map[string]*Entry
+	conns   map[net.Conn]struct{}
+	wg      sync.WaitGroup
+}
+
+// NewServer starts server whose search account binds as bindDN with bindPassword,
+// anonymous search is allowed when bindDN is empty
+func NewServer(bindDN, bindPassword string, entries ...*Entry) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		URL:          "ldap://" + listener.Addr().String(),
+		bindDN:       bindDN,
+		bindPassword: bindPassword,
+		listener:     listener,
+		entries:      make(map[string]*Entry),
+		conns:        make(map[net.Conn]struct{}),
+	}
+	for _, entry := range entries {
+		s.SetEntry(entry)
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// SetEntry adds or replaces entry
+func (s *Server) SetEntry(entry *Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[strings.ToLower(entry.DN)] = entry
+}
+
+// Close stops server and closes its connections
+func (s *Server) Close() {
+	_ = s.listener.Close()
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	boundDN := ""
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		var responses []*ber.Packet
+		switch op.Tag {
+		case opBindRequest:
+			code := resultInvalidCredentials
+			if dn, ok := s.bind(op); ok {
+				boundDN, code = dn, resultSuccess
+			}
+			responses = append(responses, result(messageID, opBindResponse, code))
+		case opSearchRequest:
+			if s.bindDN != "" && !strings.EqualFold(boundDN, s.bindDN) {
+				responses = append(responses, result(messageID, opSearchDone,
+					resultInsufficientAccessRight))
+				break
+			}
+			for _, entry := range s.search(op) {
+				responses = append(responses, entry.packet(messageID, op))
+			}
+			responses = append(responses, result(messageID, opSearchDone, resultSuccess))
+		case opExtendedRequest:
+			responses = append(responses, result(messageID, opExtendedResult,
+				resultProtocolError))
+		case opUnbindRequest:
+			return
+		default:
+			return
+		}
+
+		for _, response := range responses {
+			if _, err = conn.Write(response.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind returns DN bound by simple bind request, bind with empty password is
+// unauthenticated bind which succeeds as anonymous, RFC 4513 section 5.1.2
+func (s *Server) bind(op *ber.Packet) (string, bool) {
+	if len(op.Children) < 3 || op.Children[2].Tag != 0 {
+		return "", false
+	}
+	dn := value(op.Children[1])
+	password := value(op.Children[2])
+
+	switch {
+	case password == "":
+		return "", true
+	case s.bindDN != "" && strings.EqualFold(dn, s.bindDN):
+		return dn, password == s.bindPassword
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[strings.ToLower(dn)]
+
+	return dn, ok && entry.Password != "" && entry.Password == password
+}
+
+func (s *Server) search(op *ber.Packet) []*Entry {
+	if len(op.Children) < 8 {
+		return nil
+	}
+	baseDN := strings.ToLower(value(op.Children[0]))
+	filter := op.Children[6]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var found []*Entry
+	for dn, entry := range s.entries {
+		if dn != baseDN && !strings.HasSuffix(dn, ","+baseDN) {
+			continue
+		}
+		if entry.matches(filter) {
+			found = append(found, entry)
+		}
+	}
+
+	return found
+}
+
+// matches evaluates search filter, RFC 4511 section 4.5.1.7
+func (e *Entry) matches(filter *ber.Packet) bool {
+	switch filter.Tag {
+	case 0:
+		for _, child := range filter.Children {
+			if !e.matches(child) {
+				return false
+			}
+		}
+		return true
+	case 1:
+		for _, child := range filter.Children {
+			if e.matches(child) {
+				return true
+			}
+		}
+		return false
+	case 2:
+		return len(filter.Children) == 1 && !e.matches(filter.Children[0])
+	case 3:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		for _, v := range e.values(value(filter.Children[0])) {
+			if strings.EqualFold(v, value(filter.Children[1])) {
+				return true
+			}
+		}
+		return false
+	case 7:
+		return len(e.values(value(filter))) > 0
+	default:
+		return false
+	}
+}
+
+func (e *Entry) values(attribute string) []string {
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+
+	return nil
+}
+
+// packet returns search result entry with requested attributes, all attributes
+// when none is requested
+func (e *Entry) packet(messageID int64, op *ber.Packet) *ber.Packet {
+	var requested []string
+	for _, attribute := range op.Children[7].Children {
+		requested = append(requested, value(attribute))
+	}
+
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	for name, values := range e.Attributes {
+		if len(requested) > 0 && !containsFold(requested, name) {
+			continue
+		}
+
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive,
+			ber.TagOctetString, name, ""))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive,
+				ber.TagOctetString, v, ""))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, opSearchEntry, nil, "")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString,
+		e.DN, ""))
+	entry.AppendChild(attributes)
+
+	return message(messageID, entry)
+}
+
+func result(messageID int64, tag ber.Tag, code int) *ber.Packet {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated,
+		code, ""))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString,
+		"", ""))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString,
+		"", ""))
+
+	return message(messageID, res)
+}
+
+func message(messageID int64, op *ber.Packet) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger,
+		messageID, ""))
+	packet.AppendChild(op)
+
+	return packet
+}
+
+// value returns content of primitive packet
+func value(packet *ber.Packet) string {
+	if packet.Data == nil {
+		return ""
+	}
+
+	return packet.Data.String()
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,647 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/client/ldap.Client -o client_minimock.go -n ClientMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ClientMock implements mm_ldap.Client
+type ClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, username string, password string) (lp1 *model.LDAPEntry, err error)
+	funcAuthenticateOrigin    string
+	inspectFuncAuthenticate   func(ctx context.Context, username string, password string)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mClientMockAuthenticate
+
+	funcDirectory          func() (lp1 *model.LDAPDirectory)
+	funcDirectoryOrigin    string
+	inspectFuncDirectory   func()
+	afterDirectoryCounter  uint64
+	beforeDirectoryCounter uint64
+	DirectoryMock          mClientMockDirectory
+}
+
+// NewClientMock returns a mock for mm_ldap.Client
+func NewClientMock(t minimock.Tester) *ClientMock {
+	m := &ClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mClientMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*ClientMockAuthenticateParams{}
+
+	m.DirectoryMock = mClientMockDirectory{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mClientMockAuthenticate struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockAuthenticateExpectation
+	expectations       []*ClientMockAuthenticateExpectation
+
+	callArgs []*ClientMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockAuthenticateExpectation specifies expectation struct of the Client.Authenticate
+type ClientMockAuthenticateExpectation struct {
+	mock               *ClientMock
+	params             *ClientMockAuthenticateParams
+	paramPtrs          *ClientMockAuthenticateParamPtrs
+	expectationOrigins ClientMockAuthenticateExpectationOrigins
+	results            *ClientMockAuthenticateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientMockAuthenticateParams contains parameters of the Client.Authenticate
+type ClientMockAuthenticateParams struct {
+	ctx      context.Context
+	username string
+	password string
+}
+
+// ClientMockAuthenticateParamPtrs contains pointers to parameters of the Client.Authenticate
+type ClientMockAuthenticateParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	password *string
+}
+
+// ClientMockAuthenticateResults contains results of the Client.Authenticate
+type ClientMockAuthenticateResults struct {
+	lp1 *model.LDAPEntry
+	err error
+}
+
+// ClientMockAuthenticateOrigins contains origins of expectations of the Client.Authenticate
+type ClientMockAuthenticateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mClientMockAuthenticate) Optional() *mClientMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for Client.Authenticate
+func (mmAuthenticate *mClientMockAuthenticate) Expect(ctx context.Context, username string, password string) *mClientMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &ClientMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &ClientMockAuthenticateParams{ctx, username, password}
+	mmAuthenticate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.Authenticate
+func (mmAuthenticate *mClientMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mClientMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &ClientMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &ClientMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectUsernameParam2 sets up expected param username for Client.Authenticate
+func (mmAuthenticate *mClientMockAuthenticate) ExpectUsernameParam2(username string) *mClientMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &ClientMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &ClientMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.username = &username
+	mmAuthenticate.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectPasswordParam3 sets up expected param password for Client.Authenticate
+func (mmAuthenticate *mClientMockAuthenticate) ExpectPasswordParam3(password string) *mClientMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &ClientMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &ClientMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.password = &password
+	mmAuthenticate.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Authenticate
+func (mmAuthenticate *mClientMockAuthenticate) Inspect(f func(ctx context.Context, username string, password string)) *mClientMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for ClientMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by Client.Authenticate
+func (mmAuthenticate *mClientMockAuthenticate) Return(lp1 *model.LDAPEntry, err error) *ClientMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &ClientMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &ClientMockAuthenticateResults{lp1, err}
+	mmAuthenticate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the Client.Authenticate method
+func (mmAuthenticate *mClientMockAuthenticate) Set(f func(ctx context.Context, username string, password string) (lp1 *model.LDAPEntry, err error)) *ClientMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the Client.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the Client.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	mmAuthenticate.mock.funcAuthenticateOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the Client.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mClientMockAuthenticate) When(ctx context.Context, username string, password string) *ClientMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("ClientMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &ClientMockAuthenticateExpectation{
+		mock:               mmAuthenticate.mock,
+		params:             &ClientMockAuthenticateParams{ctx, username, password},
+		expectationOrigins: ClientMockAuthenticateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.Authenticate return parameters for the expectation previously defined by the When method
+func (e *ClientMockAuthenticateExpectation) Then(lp1 *model.LDAPEntry, err error) *ClientMock {
+	e.results = &ClientMockAuthenticateResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.Authenticate should be invoked
+func (mmAuthenticate *mClientMockAuthenticate) Times(n uint64) *mClientMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of ClientMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	mmAuthenticate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mClientMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements mm_ldap.Client
+func (mmAuthenticate *ClientMock) Authenticate(ctx context.Context, username string, password string) (lp1 *model.LDAPEntry, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	mmAuthenticate.t.Helper()
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, username, password)
+	}
+
+	mm_params := ClientMockAuthenticateParams{ctx, username, password}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockAuthenticateParams{ctx, username, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("ClientMock.Authenticate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAuthenticate.t.Errorf("ClientMock.Authenticate got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmAuthenticate.t.Errorf("ClientMock.Authenticate got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("ClientMock.Authenticate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the ClientMock.Authenticate")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, username, password)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to ClientMock.Authenticate. %v %v %v", ctx, username, password)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished ClientMock.Authenticate invocations
+func (mmAuthenticate *ClientMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of ClientMock.Authenticate invocations
+func (mmAuthenticate *ClientMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mClientMockAuthenticate) Calls() []*ClientMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*ClientMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *ClientMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.Authenticate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientMock.Authenticate at\n%s", m.AuthenticateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientMock.Authenticate at\n%s with params: %#v", m.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Authenticate at\n%s", m.funcAuthenticateOrigin)
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Authenticate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), m.AuthenticateMock.expectedInvocationsOrigin, afterAuthenticateCounter)
+	}
+}
+
+type mClientMockDirectory struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockDirectoryExpectation
+	expectations       []*ClientMockDirectoryExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockDirectoryExpectation specifies expectation struct of the Client.Directory
+type ClientMockDirectoryExpectation struct {
+	mock *ClientMock
+
+	results      *ClientMockDirectoryResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ClientMockDirectoryResults contains results of the Client.Directory
+type ClientMockDirectoryResults struct {
+	lp1 *model.LDAPDirectory
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDirectory *mClientMockDirectory) Optional() *mClientMockDirectory {
+	mmDirectory.optional = true
+	return mmDirectory
+}
+
+// Expect sets up expected params for Client.Directory
+func (mmDirectory *mClientMockDirectory) Expect() *mClientMockDirectory {
+	if mmDirectory.mock.funcDirectory != nil {
+		mmDirectory.mock.t.Fatalf("ClientMock.Directory mock is already set by Set")
+	}
+
+	if mmDirectory.defaultExpectation == nil {
+		mmDirectory.defaultExpectation = &ClientMockDirectoryExpectation{}
+	}
+
+	return mmDirectory
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Directory
+func (mmDirectory *mClientMockDirectory) Inspect(f func()) *mClientMockDirectory {
+	if mmDirectory.mock.inspectFuncDirectory != nil {
+		mmDirectory.mock.t.Fatalf("Inspect function is already set for ClientMock.Directory")
+	}
+
+	mmDirectory.mock.inspectFuncDirectory = f
+
+	return mmDirectory
+}
+
+// Return sets up results that will be returned by Client.Directory
+func (mmDirectory *mClientMockDirectory) Return(lp1 *model.LDAPDirectory) *ClientMock {
+	if mmDirectory.mock.funcDirectory != nil {
+		mmDirectory.mock.t.Fatalf("ClientMock.Directory mock is already set by Set")
+	}
+
+	if mmDirectory.defaultExpectation == nil {
+		mmDirectory.defaultExpectation = &ClientMockDirectoryExpectation{mock: mmDirectory.mock}
+	}
+	mmDirectory.defaultExpectation.results = &ClientMockDirectoryResults{lp1}
+	mmDirectory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDirectory.mock
+}
+
+// Set uses given function f to mock the Client.Directory method
+func (mmDirectory *mClientMockDirectory) Set(f func() (lp1 *model.LDAPDirectory)) *ClientMock {
+	if mmDirectory.defaultExpectation != nil {
+		mmDirectory.mock.t.Fatalf("Default expectation is already set for the Client.Directory method")
+	}
+
+	if len(mmDirectory.expectations) > 0 {
+		mmDirectory.mock.t.Fatalf("Some expectations are already set for the Client.Directory method")
+	}
+
+	mmDirectory.mock.funcDirectory = f
+	mmDirectory.mock.funcDirectoryOrigin = minimock.CallerInfo(1)
+	return mmDirectory.mock
+}
+
+// Times sets number of times Client.Directory should be invoked
+func (mmDirectory *mClientMockDirectory) Times(n uint64) *mClientMockDirectory {
+	if n == 0 {
+		mmDirectory.mock.t.Fatalf("Times of ClientMock.Directory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDirectory.expectedInvocations, n)
+	mmDirectory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDirectory
+}
+
+func (mmDirectory *mClientMockDirectory) invocationsDone() bool {
+	if len(mmDirectory.expectations) == 0 && mmDirectory.defaultExpectation == nil && mmDirectory.mock.funcDirectory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDirectory.mock.afterDirectoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDirectory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Directory implements mm_ldap.Client
+func (mmDirectory *ClientMock) Directory() (lp1 *model.LDAPDirectory) {
+	mm_atomic.AddUint64(&mmDirectory.beforeDirectoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmDirectory.afterDirectoryCounter, 1)
+
+	mmDirectory.t.Helper()
+
+	if mmDirectory.inspectFuncDirectory != nil {
+		mmDirectory.inspectFuncDirectory()
+	}
+
+	if mmDirectory.DirectoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDirectory.DirectoryMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmDirectory.DirectoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDirectory.t.Fatal("No results are set for the ClientMock.Directory")
+		}
+		return (*mm_results).lp1
+	}
+	if mmDirectory.funcDirectory != nil {
+		return mmDirectory.funcDirectory()
+	}
+	mmDirectory.t.Fatalf("Unexpected call to ClientMock.Directory.")
+	return
+}
+
+// DirectoryAfterCounter returns a count of finished ClientMock.Directory invocations
+func (mmDirectory *ClientMock) DirectoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDirectory.afterDirectoryCounter)
+}
+
+// DirectoryBeforeCounter returns a count of ClientMock.Directory invocations
+func (mmDirectory *ClientMock) DirectoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDirectory.beforeDirectoryCounter)
+}
+
+// MinimockDirectoryDone returns true if the count of the Directory invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockDirectoryDone() bool {
+	if m.DirectoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DirectoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DirectoryMock.invocationsDone()
+}
+
+// MinimockDirectoryInspect logs each unmet expectation
+func (m *ClientMock) MinimockDirectoryInspect() {
+	for _, e := range m.DirectoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.Directory")
+		}
+	}
+
+	afterDirectoryCounter := mm_atomic.LoadUint64(&m.afterDirectoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DirectoryMock.defaultExpectation != nil && afterDirectoryCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Directory at\n%s", m.DirectoryMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDirectory != nil && afterDirectoryCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Directory at\n%s", m.funcDirectoryOrigin)
+	}
+
+	if !m.DirectoryMock.invocationsDone() && afterDirectoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Directory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DirectoryMock.expectedInvocations), m.DirectoryMock.expectedInvocationsOrigin, afterDirectoryCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+
+			m.MinimockDirectoryInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone() &&
+		m.MinimockDirectoryDone()
+}
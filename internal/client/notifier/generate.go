@@ -0,0 +1,4 @@
+package notifier
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i Notifier -o ./mocks/ -s "_minimock.go"
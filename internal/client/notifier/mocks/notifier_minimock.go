@@ -0,0 +1,447 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/client/notifier.Notifier -o notifier_minimock.go -n NotifierMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// NotifierMock implements mm_notifier.Notifier
+type NotifierMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSendInvitation          func(ctx context.Context, email string, link string) (err error)
+	funcSendInvitationOrigin    string
+	inspectFuncSendInvitation   func(ctx context.Context, email string, link string)
+	afterSendInvitationCounter  uint64
+	beforeSendInvitationCounter uint64
+	SendInvitationMock          mNotifierMockSendInvitation
+}
+
+// NewNotifierMock returns a mock for mm_notifier.Notifier
+func NewNotifierMock(t minimock.Tester) *NotifierMock {
+	m := &NotifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SendInvitationMock = mNotifierMockSendInvitation{mock: m}
+	m.SendInvitationMock.callArgs = []*NotifierMockSendInvitationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mNotifierMockSendInvitation struct {
+	optional           bool
+	mock               *NotifierMock
+	defaultExpectation *NotifierMockSendInvitationExpectation
+	expectations       []*NotifierMockSendInvitationExpectation
+
+	callArgs []*NotifierMockSendInvitationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotifierMockSendInvitationExpectation specifies expectation struct of the Notifier.SendInvitation
+type NotifierMockSendInvitationExpectation struct {
+	mock               *NotifierMock
+	params             *NotifierMockSendInvitationParams
+	paramPtrs          *NotifierMockSendInvitationParamPtrs
+	expectationOrigins NotifierMockSendInvitationExpectationOrigins
+	results            *NotifierMockSendInvitationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotifierMockSendInvitationParams contains parameters of the Notifier.SendInvitation
+type NotifierMockSendInvitationParams struct {
+	ctx   context.Context
+	email string
+	link  string
+}
+
+// NotifierMockSendInvitationParamPtrs contains pointers to parameters of the Notifier.SendInvitation
+type NotifierMockSendInvitationParamPtrs struct {
+	ctx   *context.Context
+	email *string
+	link  *string
+}
+
+// NotifierMockSendInvitationResults contains results of the Notifier.SendInvitation
+type NotifierMockSendInvitationResults struct {
+	err error
+}
+
+// NotifierMockSendInvitationOrigins contains origins of expectations of the Notifier.SendInvitation
+type NotifierMockSendInvitationExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+	originLink  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendInvitation *mNotifierMockSendInvitation) Optional() *mNotifierMockSendInvitation {
+	mmSendInvitation.optional = true
+	return mmSendInvitation
+}
+
+// Expect sets up expected params for Notifier.SendInvitation
+func (mmSendInvitation *mNotifierMockSendInvitation) Expect(ctx context.Context, email string, link string) *mNotifierMockSendInvitation {
+	if mmSendInvitation.mock.funcSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Set")
+	}
+
+	if mmSendInvitation.defaultExpectation == nil {
+		mmSendInvitation.defaultExpectation = &NotifierMockSendInvitationExpectation{}
+	}
+
+	if mmSendInvitation.defaultExpectation.paramPtrs != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by ExpectParams functions")
+	}
+
+	mmSendInvitation.defaultExpectation.params = &NotifierMockSendInvitationParams{ctx, email, link}
+	mmSendInvitation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendInvitation.expectations {
+		if minimock.Equal(e.params, mmSendInvitation.defaultExpectation.params) {
+			mmSendInvitation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendInvitation.defaultExpectation.params)
+		}
+	}
+
+	return mmSendInvitation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Notifier.SendInvitation
+func (mmSendInvitation *mNotifierMockSendInvitation) ExpectCtxParam1(ctx context.Context) *mNotifierMockSendInvitation {
+	if mmSendInvitation.mock.funcSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Set")
+	}
+
+	if mmSendInvitation.defaultExpectation == nil {
+		mmSendInvitation.defaultExpectation = &NotifierMockSendInvitationExpectation{}
+	}
+
+	if mmSendInvitation.defaultExpectation.params != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Expect")
+	}
+
+	if mmSendInvitation.defaultExpectation.paramPtrs == nil {
+		mmSendInvitation.defaultExpectation.paramPtrs = &NotifierMockSendInvitationParamPtrs{}
+	}
+	mmSendInvitation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendInvitation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendInvitation
+}
+
+// ExpectEmailParam2 sets up expected param email for Notifier.SendInvitation
+func (mmSendInvitation *mNotifierMockSendInvitation) ExpectEmailParam2(email string) *mNotifierMockSendInvitation {
+	if mmSendInvitation.mock.funcSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Set")
+	}
+
+	if mmSendInvitation.defaultExpectation == nil {
+		mmSendInvitation.defaultExpectation = &NotifierMockSendInvitationExpectation{}
+	}
+
+	if mmSendInvitation.defaultExpectation.params != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Expect")
+	}
+
+	if mmSendInvitation.defaultExpectation.paramPtrs == nil {
+		mmSendInvitation.defaultExpectation.paramPtrs = &NotifierMockSendInvitationParamPtrs{}
+	}
+	mmSendInvitation.defaultExpectation.paramPtrs.email = &email
+	mmSendInvitation.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmSendInvitation
+}
+
+// ExpectLinkParam3 sets up expected param link for Notifier.SendInvitation
+func (mmSendInvitation *mNotifierMockSendInvitation) ExpectLinkParam3(link string) *mNotifierMockSendInvitation {
+	if mmSendInvitation.mock.funcSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Set")
+	}
+
+	if mmSendInvitation.defaultExpectation == nil {
+		mmSendInvitation.defaultExpectation = &NotifierMockSendInvitationExpectation{}
+	}
+
+	if mmSendInvitation.defaultExpectation.params != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Expect")
+	}
+
+	if mmSendInvitation.defaultExpectation.paramPtrs == nil {
+		mmSendInvitation.defaultExpectation.paramPtrs = &NotifierMockSendInvitationParamPtrs{}
+	}
+	mmSendInvitation.defaultExpectation.paramPtrs.link = &link
+	mmSendInvitation.defaultExpectation.expectationOrigins.originLink = minimock.CallerInfo(1)
+
+	return mmSendInvitation
+}
+
+// Inspect accepts an inspector function that has same arguments as the Notifier.SendInvitation
+func (mmSendInvitation *mNotifierMockSendInvitation) Inspect(f func(ctx context.Context, email string, link string)) *mNotifierMockSendInvitation {
+	if mmSendInvitation.mock.inspectFuncSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("Inspect function is already set for NotifierMock.SendInvitation")
+	}
+
+	mmSendInvitation.mock.inspectFuncSendInvitation = f
+
+	return mmSendInvitation
+}
+
+// Return sets up results that will be returned by Notifier.SendInvitation
+func (mmSendInvitation *mNotifierMockSendInvitation) Return(err error) *NotifierMock {
+	if mmSendInvitation.mock.funcSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Set")
+	}
+
+	if mmSendInvitation.defaultExpectation == nil {
+		mmSendInvitation.defaultExpectation = &NotifierMockSendInvitationExpectation{mock: mmSendInvitation.mock}
+	}
+	mmSendInvitation.defaultExpectation.results = &NotifierMockSendInvitationResults{err}
+	mmSendInvitation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendInvitation.mock
+}
+
+// Set uses given function f to mock the Notifier.SendInvitation method
+func (mmSendInvitation *mNotifierMockSendInvitation) Set(f func(ctx context.Context, email string, link string) (err error)) *NotifierMock {
+	if mmSendInvitation.defaultExpectation != nil {
+		mmSendInvitation.mock.t.Fatalf("Default expectation is already set for the Notifier.SendInvitation method")
+	}
+
+	if len(mmSendInvitation.expectations) > 0 {
+		mmSendInvitation.mock.t.Fatalf("Some expectations are already set for the Notifier.SendInvitation method")
+	}
+
+	mmSendInvitation.mock.funcSendInvitation = f
+	mmSendInvitation.mock.funcSendInvitationOrigin = minimock.CallerInfo(1)
+	return mmSendInvitation.mock
+}
+
+// When sets expectation for the Notifier.SendInvitation which will trigger the result defined by the following
+// Then helper
+func (mmSendInvitation *mNotifierMockSendInvitation) When(ctx context.Context, email string, link string) *NotifierMockSendInvitationExpectation {
+	if mmSendInvitation.mock.funcSendInvitation != nil {
+		mmSendInvitation.mock.t.Fatalf("NotifierMock.SendInvitation mock is already set by Set")
+	}
+
+	expectation := &NotifierMockSendInvitationExpectation{
+		mock:               mmSendInvitation.mock,
+		params:             &NotifierMockSendInvitationParams{ctx, email, link},
+		expectationOrigins: NotifierMockSendInvitationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendInvitation.expectations = append(mmSendInvitation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Notifier.SendInvitation return parameters for the expectation previously defined by the When method
+func (e *NotifierMockSendInvitationExpectation) Then(err error) *NotifierMock {
+	e.results = &NotifierMockSendInvitationResults{err}
+	return e.mock
+}
+
+// Times sets number of times Notifier.SendInvitation should be invoked
+func (mmSendInvitation *mNotifierMockSendInvitation) Times(n uint64) *mNotifierMockSendInvitation {
+	if n == 0 {
+		mmSendInvitation.mock.t.Fatalf("Times of NotifierMock.SendInvitation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendInvitation.expectedInvocations, n)
+	mmSendInvitation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendInvitation
+}
+
+func (mmSendInvitation *mNotifierMockSendInvitation) invocationsDone() bool {
+	if len(mmSendInvitation.expectations) == 0 && mmSendInvitation.defaultExpectation == nil && mmSendInvitation.mock.funcSendInvitation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendInvitation.mock.afterSendInvitationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendInvitation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendInvitation implements mm_notifier.Notifier
+func (mmSendInvitation *NotifierMock) SendInvitation(ctx context.Context, email string, link string) (err error) {
+	mm_atomic.AddUint64(&mmSendInvitation.beforeSendInvitationCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendInvitation.afterSendInvitationCounter, 1)
+
+	mmSendInvitation.t.Helper()
+
+	if mmSendInvitation.inspectFuncSendInvitation != nil {
+		mmSendInvitation.inspectFuncSendInvitation(ctx, email, link)
+	}
+
+	mm_params := NotifierMockSendInvitationParams{ctx, email, link}
+
+	// Record call args
+	mmSendInvitation.SendInvitationMock.mutex.Lock()
+	mmSendInvitation.SendInvitationMock.callArgs = append(mmSendInvitation.SendInvitationMock.callArgs, &mm_params)
+	mmSendInvitation.SendInvitationMock.mutex.Unlock()
+
+	for _, e := range mmSendInvitation.SendInvitationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendInvitation.SendInvitationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendInvitation.SendInvitationMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendInvitation.SendInvitationMock.defaultExpectation.params
+		mm_want_ptrs := mmSendInvitation.SendInvitationMock.defaultExpectation.paramPtrs
+
+		mm_got := NotifierMockSendInvitationParams{ctx, email, link}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendInvitation.t.Errorf("NotifierMock.SendInvitation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendInvitation.SendInvitationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmSendInvitation.t.Errorf("NotifierMock.SendInvitation got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendInvitation.SendInvitationMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.link != nil && !minimock.Equal(*mm_want_ptrs.link, mm_got.link) {
+				mmSendInvitation.t.Errorf("NotifierMock.SendInvitation got unexpected parameter link, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendInvitation.SendInvitationMock.defaultExpectation.expectationOrigins.originLink, *mm_want_ptrs.link, mm_got.link, minimock.Diff(*mm_want_ptrs.link, mm_got.link))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendInvitation.t.Errorf("NotifierMock.SendInvitation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendInvitation.SendInvitationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendInvitation.SendInvitationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendInvitation.t.Fatal("No results are set for the NotifierMock.SendInvitation")
+		}
+		return (*mm_results).err
+	}
+	if mmSendInvitation.funcSendInvitation != nil {
+		return mmSendInvitation.funcSendInvitation(ctx, email, link)
+	}
+	mmSendInvitation.t.Fatalf("Unexpected call to NotifierMock.SendInvitation. %v %v %v", ctx, email, link)
+	return
+}
+
+// SendInvitationAfterCounter returns a count of finished NotifierMock.SendInvitation invocations
+func (mmSendInvitation *NotifierMock) SendInvitationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendInvitation.afterSendInvitationCounter)
+}
+
+// SendInvitationBeforeCounter returns a count of NotifierMock.SendInvitation invocations
+func (mmSendInvitation *NotifierMock) SendInvitationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendInvitation.beforeSendInvitationCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotifierMock.SendInvitation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendInvitation *mNotifierMockSendInvitation) Calls() []*NotifierMockSendInvitationParams {
+	mmSendInvitation.mutex.RLock()
+
+	argCopy := make([]*NotifierMockSendInvitationParams, len(mmSendInvitation.callArgs))
+	copy(argCopy, mmSendInvitation.callArgs)
+
+	mmSendInvitation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendInvitationDone returns true if the count of the SendInvitation invocations corresponds
+// the number of defined expectations
+func (m *NotifierMock) MinimockSendInvitationDone() bool {
+	if m.SendInvitationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendInvitationMock.invocationsDone()
+}
+
+// MinimockSendInvitationInspect logs each unmet expectation
+func (m *NotifierMock) MinimockSendInvitationInspect() {
+	for _, e := range m.SendInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotifierMock.SendInvitation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendInvitationCounter := mm_atomic.LoadUint64(&m.afterSendInvitationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendInvitationMock.defaultExpectation != nil && afterSendInvitationCounter < 1 {
+		if m.SendInvitationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotifierMock.SendInvitation at\n%s", m.SendInvitationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotifierMock.SendInvitation at\n%s with params: %#v", m.SendInvitationMock.defaultExpectation.expectationOrigins.origin, *m.SendInvitationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendInvitation != nil && afterSendInvitationCounter < 1 {
+		m.t.Errorf("Expected call to NotifierMock.SendInvitation at\n%s", m.funcSendInvitationOrigin)
+	}
+
+	if !m.SendInvitationMock.invocationsDone() && afterSendInvitationCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotifierMock.SendInvitation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendInvitationMock.expectedInvocations), m.SendInvitationMock.expectedInvocationsOrigin, afterSendInvitationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *NotifierMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSendInvitationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *NotifierMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *NotifierMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSendInvitationDone()
+}
@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+)
+
+// Notifier is interface for delivering notifications to users
+type Notifier interface {
+	SendInvitation(ctx context.Context, email, link string) error
+}
+
+type logNotifier struct{}
+
+// NewLogNotifier returns notifier which writes notifications to log,
+// it is used until real delivery (e-mail etc.) is configured
+func NewLogNotifier() Notifier {
+	return &logNotifier{}
+}
+
+// SendInvitation writes invitation link to log
+func (n *logNotifier) SendInvitation(_ context.Context, email, link string) error {
+	logger.Info("invitation sent", zap.String("email", email), zap.String("link", link))
+
+	return nil
+}
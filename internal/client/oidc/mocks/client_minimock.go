@@ -0,0 +1,1096 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/client/oidc.Client -o client_minimock.go -n ClientMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ClientMock implements mm_oidc.Client
+type ClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthCodeURL          func(ctx context.Context, state string, nonce string, codeChallenge string) (s1 string, err error)
+	funcAuthCodeURLOrigin    string
+	inspectFuncAuthCodeURL   func(ctx context.Context, state string, nonce string, codeChallenge string)
+	afterAuthCodeURLCounter  uint64
+	beforeAuthCodeURLCounter uint64
+	AuthCodeURLMock          mClientMockAuthCodeURL
+
+	funcExchange          func(ctx context.Context, code string, codeVerifier string, nonce string) (fp1 *model.FederatedClaims, err error)
+	funcExchangeOrigin    string
+	inspectFuncExchange   func(ctx context.Context, code string, codeVerifier string, nonce string)
+	afterExchangeCounter  uint64
+	beforeExchangeCounter uint64
+	ExchangeMock          mClientMockExchange
+
+	funcProvider          func() (fp1 *model.FederationProvider)
+	funcProviderOrigin    string
+	inspectFuncProvider   func()
+	afterProviderCounter  uint64
+	beforeProviderCounter uint64
+	ProviderMock          mClientMockProvider
+}
+
+// NewClientMock returns a mock for mm_oidc.Client
+func NewClientMock(t minimock.Tester) *ClientMock {
+	m := &ClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthCodeURLMock = mClientMockAuthCodeURL{mock: m}
+	m.AuthCodeURLMock.callArgs = []*ClientMockAuthCodeURLParams{}
+
+	m.ExchangeMock = mClientMockExchange{mock: m}
+	m.ExchangeMock.callArgs = []*ClientMockExchangeParams{}
+
+	m.ProviderMock = mClientMockProvider{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mClientMockAuthCodeURL struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockAuthCodeURLExpectation
+	expectations       []*ClientMockAuthCodeURLExpectation
+
+	callArgs []*ClientMockAuthCodeURLParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockAuthCodeURLExpectation specifies expectation struct of the Client.AuthCodeURL
+type ClientMockAuthCodeURLExpectation struct {
+	mock               *ClientMock
+	params             *ClientMockAuthCodeURLParams
+	paramPtrs          *ClientMockAuthCodeURLParamPtrs
+	expectationOrigins ClientMockAuthCodeURLExpectationOrigins
+	results            *ClientMockAuthCodeURLResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientMockAuthCodeURLParams contains parameters of the Client.AuthCodeURL
+type ClientMockAuthCodeURLParams struct {
+	ctx           context.Context
+	state         string
+	nonce         string
+	codeChallenge string
+}
+
+// ClientMockAuthCodeURLParamPtrs contains pointers to parameters of the Client.AuthCodeURL
+type ClientMockAuthCodeURLParamPtrs struct {
+	ctx           *context.Context
+	state         *string
+	nonce         *string
+	codeChallenge *string
+}
+
+// ClientMockAuthCodeURLResults contains results of the Client.AuthCodeURL
+type ClientMockAuthCodeURLResults struct {
+	s1  string
+	err error
+}
+
+// ClientMockAuthCodeURLOrigins contains origins of expectations of the Client.AuthCodeURL
+type ClientMockAuthCodeURLExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originState         string
+	originNonce         string
+	originCodeChallenge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Optional() *mClientMockAuthCodeURL {
+	mmAuthCodeURL.optional = true
+	return mmAuthCodeURL
+}
+
+// Expect sets up expected params for Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Expect(ctx context.Context, state string, nonce string, codeChallenge string) *mClientMockAuthCodeURL {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	if mmAuthCodeURL.defaultExpectation == nil {
+		mmAuthCodeURL.defaultExpectation = &ClientMockAuthCodeURLExpectation{}
+	}
+
+	if mmAuthCodeURL.defaultExpectation.paramPtrs != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by ExpectParams functions")
+	}
+
+	mmAuthCodeURL.defaultExpectation.params = &ClientMockAuthCodeURLParams{ctx, state, nonce, codeChallenge}
+	mmAuthCodeURL.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthCodeURL.expectations {
+		if minimock.Equal(e.params, mmAuthCodeURL.defaultExpectation.params) {
+			mmAuthCodeURL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthCodeURL.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthCodeURL
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) ExpectCtxParam1(ctx context.Context) *mClientMockAuthCodeURL {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	if mmAuthCodeURL.defaultExpectation == nil {
+		mmAuthCodeURL.defaultExpectation = &ClientMockAuthCodeURLExpectation{}
+	}
+
+	if mmAuthCodeURL.defaultExpectation.params != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Expect")
+	}
+
+	if mmAuthCodeURL.defaultExpectation.paramPtrs == nil {
+		mmAuthCodeURL.defaultExpectation.paramPtrs = &ClientMockAuthCodeURLParamPtrs{}
+	}
+	mmAuthCodeURL.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthCodeURL.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthCodeURL
+}
+
+// ExpectStateParam2 sets up expected param state for Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) ExpectStateParam2(state string) *mClientMockAuthCodeURL {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	if mmAuthCodeURL.defaultExpectation == nil {
+		mmAuthCodeURL.defaultExpectation = &ClientMockAuthCodeURLExpectation{}
+	}
+
+	if mmAuthCodeURL.defaultExpectation.params != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Expect")
+	}
+
+	if mmAuthCodeURL.defaultExpectation.paramPtrs == nil {
+		mmAuthCodeURL.defaultExpectation.paramPtrs = &ClientMockAuthCodeURLParamPtrs{}
+	}
+	mmAuthCodeURL.defaultExpectation.paramPtrs.state = &state
+	mmAuthCodeURL.defaultExpectation.expectationOrigins.originState = minimock.CallerInfo(1)
+
+	return mmAuthCodeURL
+}
+
+// ExpectNonceParam3 sets up expected param nonce for Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) ExpectNonceParam3(nonce string) *mClientMockAuthCodeURL {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	if mmAuthCodeURL.defaultExpectation == nil {
+		mmAuthCodeURL.defaultExpectation = &ClientMockAuthCodeURLExpectation{}
+	}
+
+	if mmAuthCodeURL.defaultExpectation.params != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Expect")
+	}
+
+	if mmAuthCodeURL.defaultExpectation.paramPtrs == nil {
+		mmAuthCodeURL.defaultExpectation.paramPtrs = &ClientMockAuthCodeURLParamPtrs{}
+	}
+	mmAuthCodeURL.defaultExpectation.paramPtrs.nonce = &nonce
+	mmAuthCodeURL.defaultExpectation.expectationOrigins.originNonce = minimock.CallerInfo(1)
+
+	return mmAuthCodeURL
+}
+
+// ExpectCodeChallengeParam4 sets up expected param codeChallenge for Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) ExpectCodeChallengeParam4(codeChallenge string) *mClientMockAuthCodeURL {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	if mmAuthCodeURL.defaultExpectation == nil {
+		mmAuthCodeURL.defaultExpectation = &ClientMockAuthCodeURLExpectation{}
+	}
+
+	if mmAuthCodeURL.defaultExpectation.params != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Expect")
+	}
+
+	if mmAuthCodeURL.defaultExpectation.paramPtrs == nil {
+		mmAuthCodeURL.defaultExpectation.paramPtrs = &ClientMockAuthCodeURLParamPtrs{}
+	}
+	mmAuthCodeURL.defaultExpectation.paramPtrs.codeChallenge = &codeChallenge
+	mmAuthCodeURL.defaultExpectation.expectationOrigins.originCodeChallenge = minimock.CallerInfo(1)
+
+	return mmAuthCodeURL
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Inspect(f func(ctx context.Context, state string, nonce string, codeChallenge string)) *mClientMockAuthCodeURL {
+	if mmAuthCodeURL.mock.inspectFuncAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("Inspect function is already set for ClientMock.AuthCodeURL")
+	}
+
+	mmAuthCodeURL.mock.inspectFuncAuthCodeURL = f
+
+	return mmAuthCodeURL
+}
+
+// Return sets up results that will be returned by Client.AuthCodeURL
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Return(s1 string, err error) *ClientMock {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	if mmAuthCodeURL.defaultExpectation == nil {
+		mmAuthCodeURL.defaultExpectation = &ClientMockAuthCodeURLExpectation{mock: mmAuthCodeURL.mock}
+	}
+	mmAuthCodeURL.defaultExpectation.results = &ClientMockAuthCodeURLResults{s1, err}
+	mmAuthCodeURL.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthCodeURL.mock
+}
+
+// Set uses given function f to mock the Client.AuthCodeURL method
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Set(f func(ctx context.Context, state string, nonce string, codeChallenge string) (s1 string, err error)) *ClientMock {
+	if mmAuthCodeURL.defaultExpectation != nil {
+		mmAuthCodeURL.mock.t.Fatalf("Default expectation is already set for the Client.AuthCodeURL method")
+	}
+
+	if len(mmAuthCodeURL.expectations) > 0 {
+		mmAuthCodeURL.mock.t.Fatalf("Some expectations are already set for the Client.AuthCodeURL method")
+	}
+
+	mmAuthCodeURL.mock.funcAuthCodeURL = f
+	mmAuthCodeURL.mock.funcAuthCodeURLOrigin = minimock.CallerInfo(1)
+	return mmAuthCodeURL.mock
+}
+
+// When sets expectation for the Client.AuthCodeURL which will trigger the result defined by the following
+// Then helper
+func (mmAuthCodeURL *mClientMockAuthCodeURL) When(ctx context.Context, state string, nonce string, codeChallenge string) *ClientMockAuthCodeURLExpectation {
+	if mmAuthCodeURL.mock.funcAuthCodeURL != nil {
+		mmAuthCodeURL.mock.t.Fatalf("ClientMock.AuthCodeURL mock is already set by Set")
+	}
+
+	expectation := &ClientMockAuthCodeURLExpectation{
+		mock:               mmAuthCodeURL.mock,
+		params:             &ClientMockAuthCodeURLParams{ctx, state, nonce, codeChallenge},
+		expectationOrigins: ClientMockAuthCodeURLExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthCodeURL.expectations = append(mmAuthCodeURL.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.AuthCodeURL return parameters for the expectation previously defined by the When method
+func (e *ClientMockAuthCodeURLExpectation) Then(s1 string, err error) *ClientMock {
+	e.results = &ClientMockAuthCodeURLResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.AuthCodeURL should be invoked
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Times(n uint64) *mClientMockAuthCodeURL {
+	if n == 0 {
+		mmAuthCodeURL.mock.t.Fatalf("Times of ClientMock.AuthCodeURL mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthCodeURL.expectedInvocations, n)
+	mmAuthCodeURL.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthCodeURL
+}
+
+func (mmAuthCodeURL *mClientMockAuthCodeURL) invocationsDone() bool {
+	if len(mmAuthCodeURL.expectations) == 0 && mmAuthCodeURL.defaultExpectation == nil && mmAuthCodeURL.mock.funcAuthCodeURL == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthCodeURL.mock.afterAuthCodeURLCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthCodeURL.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AuthCodeURL implements mm_oidc.Client
+func (mmAuthCodeURL *ClientMock) AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmAuthCodeURL.beforeAuthCodeURLCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthCodeURL.afterAuthCodeURLCounter, 1)
+
+	mmAuthCodeURL.t.Helper()
+
+	if mmAuthCodeURL.inspectFuncAuthCodeURL != nil {
+		mmAuthCodeURL.inspectFuncAuthCodeURL(ctx, state, nonce, codeChallenge)
+	}
+
+	mm_params := ClientMockAuthCodeURLParams{ctx, state, nonce, codeChallenge}
+
+	// Record call args
+	mmAuthCodeURL.AuthCodeURLMock.mutex.Lock()
+	mmAuthCodeURL.AuthCodeURLMock.callArgs = append(mmAuthCodeURL.AuthCodeURLMock.callArgs, &mm_params)
+	mmAuthCodeURL.AuthCodeURLMock.mutex.Unlock()
+
+	for _, e := range mmAuthCodeURL.AuthCodeURLMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmAuthCodeURL.AuthCodeURLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockAuthCodeURLParams{ctx, state, nonce, codeChallenge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthCodeURL.t.Errorf("ClientMock.AuthCodeURL got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.state != nil && !minimock.Equal(*mm_want_ptrs.state, mm_got.state) {
+				mmAuthCodeURL.t.Errorf("ClientMock.AuthCodeURL got unexpected parameter state, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.expectationOrigins.originState, *mm_want_ptrs.state, mm_got.state, minimock.Diff(*mm_want_ptrs.state, mm_got.state))
+			}
+
+			if mm_want_ptrs.nonce != nil && !minimock.Equal(*mm_want_ptrs.nonce, mm_got.nonce) {
+				mmAuthCodeURL.t.Errorf("ClientMock.AuthCodeURL got unexpected parameter nonce, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.expectationOrigins.originNonce, *mm_want_ptrs.nonce, mm_got.nonce, minimock.Diff(*mm_want_ptrs.nonce, mm_got.nonce))
+			}
+
+			if mm_want_ptrs.codeChallenge != nil && !minimock.Equal(*mm_want_ptrs.codeChallenge, mm_got.codeChallenge) {
+				mmAuthCodeURL.t.Errorf("ClientMock.AuthCodeURL got unexpected parameter codeChallenge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.expectationOrigins.originCodeChallenge, *mm_want_ptrs.codeChallenge, mm_got.codeChallenge, minimock.Diff(*mm_want_ptrs.codeChallenge, mm_got.codeChallenge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthCodeURL.t.Errorf("ClientMock.AuthCodeURL got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthCodeURL.AuthCodeURLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthCodeURL.t.Fatal("No results are set for the ClientMock.AuthCodeURL")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmAuthCodeURL.funcAuthCodeURL != nil {
+		return mmAuthCodeURL.funcAuthCodeURL(ctx, state, nonce, codeChallenge)
+	}
+	mmAuthCodeURL.t.Fatalf("Unexpected call to ClientMock.AuthCodeURL. %v %v %v %v", ctx, state, nonce, codeChallenge)
+	return
+}
+
+// AuthCodeURLAfterCounter returns a count of finished ClientMock.AuthCodeURL invocations
+func (mmAuthCodeURL *ClientMock) AuthCodeURLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthCodeURL.afterAuthCodeURLCounter)
+}
+
+// AuthCodeURLBeforeCounter returns a count of ClientMock.AuthCodeURL invocations
+func (mmAuthCodeURL *ClientMock) AuthCodeURLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthCodeURL.beforeAuthCodeURLCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.AuthCodeURL.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthCodeURL *mClientMockAuthCodeURL) Calls() []*ClientMockAuthCodeURLParams {
+	mmAuthCodeURL.mutex.RLock()
+
+	argCopy := make([]*ClientMockAuthCodeURLParams, len(mmAuthCodeURL.callArgs))
+	copy(argCopy, mmAuthCodeURL.callArgs)
+
+	mmAuthCodeURL.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthCodeURLDone returns true if the count of the AuthCodeURL invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockAuthCodeURLDone() bool {
+	if m.AuthCodeURLMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthCodeURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthCodeURLMock.invocationsDone()
+}
+
+// MinimockAuthCodeURLInspect logs each unmet expectation
+func (m *ClientMock) MinimockAuthCodeURLInspect() {
+	for _, e := range m.AuthCodeURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.AuthCodeURL at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthCodeURLCounter := mm_atomic.LoadUint64(&m.afterAuthCodeURLCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthCodeURLMock.defaultExpectation != nil && afterAuthCodeURLCounter < 1 {
+		if m.AuthCodeURLMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientMock.AuthCodeURL at\n%s", m.AuthCodeURLMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientMock.AuthCodeURL at\n%s with params: %#v", m.AuthCodeURLMock.defaultExpectation.expectationOrigins.origin, *m.AuthCodeURLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthCodeURL != nil && afterAuthCodeURLCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.AuthCodeURL at\n%s", m.funcAuthCodeURLOrigin)
+	}
+
+	if !m.AuthCodeURLMock.invocationsDone() && afterAuthCodeURLCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.AuthCodeURL at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthCodeURLMock.expectedInvocations), m.AuthCodeURLMock.expectedInvocationsOrigin, afterAuthCodeURLCounter)
+	}
+}
+
+type mClientMockExchange struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockExchangeExpectation
+	expectations       []*ClientMockExchangeExpectation
+
+	callArgs []*ClientMockExchangeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockExchangeExpectation specifies expectation struct of the Client.Exchange
+type ClientMockExchangeExpectation struct {
+	mock               *ClientMock
+	params             *ClientMockExchangeParams
+	paramPtrs          *ClientMockExchangeParamPtrs
+	expectationOrigins ClientMockExchangeExpectationOrigins
+	results            *ClientMockExchangeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientMockExchangeParams contains parameters of the Client.Exchange
+type ClientMockExchangeParams struct {
+	ctx          context.Context
+	code         string
+	codeVerifier string
+	nonce        string
+}
+
+// ClientMockExchangeParamPtrs contains pointers to parameters of the Client.Exchange
+type ClientMockExchangeParamPtrs struct {
+	ctx          *context.Context
+	code         *string
+	codeVerifier *string
+	nonce        *string
+}
+
+// ClientMockExchangeResults contains results of the Client.Exchange
+type ClientMockExchangeResults struct {
+	fp1 *model.FederatedClaims
+	err error
+}
+
+// ClientMockExchangeOrigins contains origins of expectations of the Client.Exchange
+type ClientMockExchangeExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originCode         string
+	originCodeVerifier string
+	originNonce        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExchange *mClientMockExchange) Optional() *mClientMockExchange {
+	mmExchange.optional = true
+	return mmExchange
+}
+
+// Expect sets up expected params for Client.Exchange
+func (mmExchange *mClientMockExchange) Expect(ctx context.Context, code string, codeVerifier string, nonce string) *mClientMockExchange {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	if mmExchange.defaultExpectation == nil {
+		mmExchange.defaultExpectation = &ClientMockExchangeExpectation{}
+	}
+
+	if mmExchange.defaultExpectation.paramPtrs != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by ExpectParams functions")
+	}
+
+	mmExchange.defaultExpectation.params = &ClientMockExchangeParams{ctx, code, codeVerifier, nonce}
+	mmExchange.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExchange.expectations {
+		if minimock.Equal(e.params, mmExchange.defaultExpectation.params) {
+			mmExchange.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExchange.defaultExpectation.params)
+		}
+	}
+
+	return mmExchange
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.Exchange
+func (mmExchange *mClientMockExchange) ExpectCtxParam1(ctx context.Context) *mClientMockExchange {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	if mmExchange.defaultExpectation == nil {
+		mmExchange.defaultExpectation = &ClientMockExchangeExpectation{}
+	}
+
+	if mmExchange.defaultExpectation.params != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Expect")
+	}
+
+	if mmExchange.defaultExpectation.paramPtrs == nil {
+		mmExchange.defaultExpectation.paramPtrs = &ClientMockExchangeParamPtrs{}
+	}
+	mmExchange.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExchange.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExchange
+}
+
+// ExpectCodeParam2 sets up expected param code for Client.Exchange
+func (mmExchange *mClientMockExchange) ExpectCodeParam2(code string) *mClientMockExchange {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	if mmExchange.defaultExpectation == nil {
+		mmExchange.defaultExpectation = &ClientMockExchangeExpectation{}
+	}
+
+	if mmExchange.defaultExpectation.params != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Expect")
+	}
+
+	if mmExchange.defaultExpectation.paramPtrs == nil {
+		mmExchange.defaultExpectation.paramPtrs = &ClientMockExchangeParamPtrs{}
+	}
+	mmExchange.defaultExpectation.paramPtrs.code = &code
+	mmExchange.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmExchange
+}
+
+// ExpectCodeVerifierParam3 sets up expected param codeVerifier for Client.Exchange
+func (mmExchange *mClientMockExchange) ExpectCodeVerifierParam3(codeVerifier string) *mClientMockExchange {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	if mmExchange.defaultExpectation == nil {
+		mmExchange.defaultExpectation = &ClientMockExchangeExpectation{}
+	}
+
+	if mmExchange.defaultExpectation.params != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Expect")
+	}
+
+	if mmExchange.defaultExpectation.paramPtrs == nil {
+		mmExchange.defaultExpectation.paramPtrs = &ClientMockExchangeParamPtrs{}
+	}
+	mmExchange.defaultExpectation.paramPtrs.codeVerifier = &codeVerifier
+	mmExchange.defaultExpectation.expectationOrigins.originCodeVerifier = minimock.CallerInfo(1)
+
+	return mmExchange
+}
+
+// ExpectNonceParam4 sets up expected param nonce for Client.Exchange
+func (mmExchange *mClientMockExchange) ExpectNonceParam4(nonce string) *mClientMockExchange {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	if mmExchange.defaultExpectation == nil {
+		mmExchange.defaultExpectation = &ClientMockExchangeExpectation{}
+	}
+
+	if mmExchange.defaultExpectation.params != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Expect")
+	}
+
+	if mmExchange.defaultExpectation.paramPtrs == nil {
+		mmExchange.defaultExpectation.paramPtrs = &ClientMockExchangeParamPtrs{}
+	}
+	mmExchange.defaultExpectation.paramPtrs.nonce = &nonce
+	mmExchange.defaultExpectation.expectationOrigins.originNonce = minimock.CallerInfo(1)
+
+	return mmExchange
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Exchange
+func (mmExchange *mClientMockExchange) Inspect(f func(ctx context.Context, code string, codeVerifier string, nonce string)) *mClientMockExchange {
+	if mmExchange.mock.inspectFuncExchange != nil {
+		mmExchange.mock.t.Fatalf("Inspect function is already set for ClientMock.Exchange")
+	}
+
+	mmExchange.mock.inspectFuncExchange = f
+
+	return mmExchange
+}
+
+// Return sets up results that will be returned by Client.Exchange
+func (mmExchange *mClientMockExchange) Return(fp1 *model.FederatedClaims, err error) *ClientMock {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	if mmExchange.defaultExpectation == nil {
+		mmExchange.defaultExpectation = &ClientMockExchangeExpectation{mock: mmExchange.mock}
+	}
+	mmExchange.defaultExpectation.results = &ClientMockExchangeResults{fp1, err}
+	mmExchange.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExchange.mock
+}
+
+// Set uses given function f to mock the Client.Exchange method
+func (mmExchange *mClientMockExchange) Set(f func(ctx context.Context, code string, codeVerifier string, nonce string) (fp1 *model.FederatedClaims, err error)) *ClientMock {
+	if mmExchange.defaultExpectation != nil {
+		mmExchange.mock.t.Fatalf("Default expectation is already set for the Client.Exchange method")
+	}
+
+	if len(mmExchange.expectations) > 0 {
+		mmExchange.mock.t.Fatalf("Some expectations are already set for the Client.Exchange method")
+	}
+
+	mmExchange.mock.funcExchange = f
+	mmExchange.mock.funcExchangeOrigin = minimock.CallerInfo(1)
+	return mmExchange.mock
+}
+
+// When sets expectation for the Client.Exchange which will trigger the result defined by the following
+// Then helper
+func (mmExchange *mClientMockExchange) When(ctx context.Context, code string, codeVerifier string, nonce string) *ClientMockExchangeExpectation {
+	if mmExchange.mock.funcExchange != nil {
+		mmExchange.mock.t.Fatalf("ClientMock.Exchange mock is already set by Set")
+	}
+
+	expectation := &ClientMockExchangeExpectation{
+		mock:               mmExchange.mock,
+		params:             &ClientMockExchangeParams{ctx, code, codeVerifier, nonce},
+		expectationOrigins: ClientMockExchangeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExchange.expectations = append(mmExchange.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.Exchange return parameters for the expectation previously defined by the When method
+func (e *ClientMockExchangeExpectation) Then(fp1 *model.FederatedClaims, err error) *ClientMock {
+	e.results = &ClientMockExchangeResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.Exchange should be invoked
+func (mmExchange *mClientMockExchange) Times(n uint64) *mClientMockExchange {
+	if n == 0 {
+		mmExchange.mock.t.Fatalf("Times of ClientMock.Exchange mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExchange.expectedInvocations, n)
+	mmExchange.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExchange
+}
+
+func (mmExchange *mClientMockExchange) invocationsDone() bool {
+	if len(mmExchange.expectations) == 0 && mmExchange.defaultExpectation == nil && mmExchange.mock.funcExchange == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExchange.mock.afterExchangeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExchange.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Exchange implements mm_oidc.Client
+func (mmExchange *ClientMock) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (fp1 *model.FederatedClaims, err error) {
+	mm_atomic.AddUint64(&mmExchange.beforeExchangeCounter, 1)
+	defer mm_atomic.AddUint64(&mmExchange.afterExchangeCounter, 1)
+
+	mmExchange.t.Helper()
+
+	if mmExchange.inspectFuncExchange != nil {
+		mmExchange.inspectFuncExchange(ctx, code, codeVerifier, nonce)
+	}
+
+	mm_params := ClientMockExchangeParams{ctx, code, codeVerifier, nonce}
+
+	// Record call args
+	mmExchange.ExchangeMock.mutex.Lock()
+	mmExchange.ExchangeMock.callArgs = append(mmExchange.ExchangeMock.callArgs, &mm_params)
+	mmExchange.ExchangeMock.mutex.Unlock()
+
+	for _, e := range mmExchange.ExchangeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmExchange.ExchangeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExchange.ExchangeMock.defaultExpectation.Counter, 1)
+		mm_want := mmExchange.ExchangeMock.defaultExpectation.params
+		mm_want_ptrs := mmExchange.ExchangeMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockExchangeParams{ctx, code, codeVerifier, nonce}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExchange.t.Errorf("ClientMock.Exchange got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchange.ExchangeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmExchange.t.Errorf("ClientMock.Exchange got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchange.ExchangeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+			if mm_want_ptrs.codeVerifier != nil && !minimock.Equal(*mm_want_ptrs.codeVerifier, mm_got.codeVerifier) {
+				mmExchange.t.Errorf("ClientMock.Exchange got unexpected parameter codeVerifier, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchange.ExchangeMock.defaultExpectation.expectationOrigins.originCodeVerifier, *mm_want_ptrs.codeVerifier, mm_got.codeVerifier, minimock.Diff(*mm_want_ptrs.codeVerifier, mm_got.codeVerifier))
+			}
+
+			if mm_want_ptrs.nonce != nil && !minimock.Equal(*mm_want_ptrs.nonce, mm_got.nonce) {
+				mmExchange.t.Errorf("ClientMock.Exchange got unexpected parameter nonce, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchange.ExchangeMock.defaultExpectation.expectationOrigins.originNonce, *mm_want_ptrs.nonce, mm_got.nonce, minimock.Diff(*mm_want_ptrs.nonce, mm_got.nonce))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExchange.t.Errorf("ClientMock.Exchange got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExchange.ExchangeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExchange.ExchangeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExchange.t.Fatal("No results are set for the ClientMock.Exchange")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmExchange.funcExchange != nil {
+		return mmExchange.funcExchange(ctx, code, codeVerifier, nonce)
+	}
+	mmExchange.t.Fatalf("Unexpected call to ClientMock.Exchange. %v %v %v %v", ctx, code, codeVerifier, nonce)
+	return
+}
+
+// ExchangeAfterCounter returns a count of finished ClientMock.Exchange invocations
+func (mmExchange *ClientMock) ExchangeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExchange.afterExchangeCounter)
+}
+
+// ExchangeBeforeCounter returns a count of ClientMock.Exchange invocations
+func (mmExchange *ClientMock) ExchangeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExchange.beforeExchangeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.Exchange.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExchange *mClientMockExchange) Calls() []*ClientMockExchangeParams {
+	mmExchange.mutex.RLock()
+
+	argCopy := make([]*ClientMockExchangeParams, len(mmExchange.callArgs))
+	copy(argCopy, mmExchange.callArgs)
+
+	mmExchange.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExchangeDone returns true if the count of the Exchange invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockExchangeDone() bool {
+	if m.ExchangeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExchangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExchangeMock.invocationsDone()
+}
+
+// MinimockExchangeInspect logs each unmet expectation
+func (m *ClientMock) MinimockExchangeInspect() {
+	for _, e := range m.ExchangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.Exchange at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExchangeCounter := mm_atomic.LoadUint64(&m.afterExchangeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExchangeMock.defaultExpectation != nil && afterExchangeCounter < 1 {
+		if m.ExchangeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientMock.Exchange at\n%s", m.ExchangeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientMock.Exchange at\n%s with params: %#v", m.ExchangeMock.defaultExpectation.expectationOrigins.origin, *m.ExchangeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExchange != nil && afterExchangeCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Exchange at\n%s", m.funcExchangeOrigin)
+	}
+
+	if !m.ExchangeMock.invocationsDone() && afterExchangeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Exchange at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExchangeMock.expectedInvocations), m.ExchangeMock.expectedInvocationsOrigin, afterExchangeCounter)
+	}
+}
+
+type mClientMockProvider struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockProviderExpectation
+	expectations       []*ClientMockProviderExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockProviderExpectation specifies expectation struct of the Client.Provider
+type ClientMockProviderExpectation struct {
+	mock *ClientMock
+
+	results      *ClientMockProviderResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ClientMockProviderResults contains results of the Client.Provider
+type ClientMockProviderResults struct {
+	fp1 *model.FederationProvider
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmProvider *mClientMockProvider) Optional() *mClientMockProvider {
+	mmProvider.optional = true
+	return mmProvider
+}
+
+// Expect sets up expected params for Client.Provider
+func (mmProvider *mClientMockProvider) Expect() *mClientMockProvider {
+	if mmProvider.mock.funcProvider != nil {
+		mmProvider.mock.t.Fatalf("ClientMock.Provider mock is already set by Set")
+	}
+
+	if mmProvider.defaultExpectation == nil {
+		mmProvider.defaultExpectation = &ClientMockProviderExpectation{}
+	}
+
+	return mmProvider
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Provider
+func (mmProvider *mClientMockProvider) Inspect(f func()) *mClientMockProvider {
+	if mmProvider.mock.inspectFuncProvider != nil {
+		mmProvider.mock.t.Fatalf("Inspect function is already set for ClientMock.Provider")
+	}
+
+	mmProvider.mock.inspectFuncProvider = f
+
+	return mmProvider
+}
+
+// Return sets up results that will be returned by Client.Provider
+func (mmProvider *mClientMockProvider) Return(fp1 *model.FederationProvider) *ClientMock {
+	if mmProvider.mock.funcProvider != nil {
+		mmProvider.mock.t.Fatalf("ClientMock.Provider mock is already set by Set")
+	}
+
+	if mmProvider.defaultExpectation == nil {
+		mmProvider.defaultExpectation = &ClientMockProviderExpectation{mock: mmProvider.mock}
+	}
+	mmProvider.defaultExpectation.results = &ClientMockProviderResults{fp1}
+	mmProvider.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmProvider.mock
+}
+
+// Set uses given function f to mock the Client.Provider method
+func (mmProvider *mClientMockProvider) Set(f func() (fp1 *model.FederationProvider)) *ClientMock {
+	if mmProvider.defaultExpectation != nil {
+		mmProvider.mock.t.Fatalf("Default expectation is already set for the Client.Provider method")
+	}
+
+	if len(mmProvider.expectations) > 0 {
+		mmProvider.mock.t.Fatalf("Some expectations are already set for the Client.Provider method")
+	}
+
+	mmProvider.mock.funcProvider = f
+	mmProvider.mock.funcProviderOrigin = minimock.CallerInfo(1)
+	return mmProvider.mock
+}
+
+// Times sets number of times Client.Provider should be invoked
+func (mmProvider *mClientMockProvider) Times(n uint64) *mClientMockProvider {
+	if n == 0 {
+		mmProvider.mock.t.Fatalf("Times of ClientMock.Provider mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmProvider.expectedInvocations, n)
+	mmProvider.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmProvider
+}
+
+func (mmProvider *mClientMockProvider) invocationsDone() bool {
+	if len(mmProvider.expectations) == 0 && mmProvider.defaultExpectation == nil && mmProvider.mock.funcProvider == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmProvider.mock.afterProviderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmProvider.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Provider implements mm_oidc.Client
+func (mmProvider *ClientMock) Provider() (fp1 *model.FederationProvider) {
+	mm_atomic.AddUint64(&mmProvider.beforeProviderCounter, 1)
+	defer mm_atomic.AddUint64(&mmProvider.afterProviderCounter, 1)
+
+	mmProvider.t.Helper()
+
+	if mmProvider.inspectFuncProvider != nil {
+		mmProvider.inspectFuncProvider()
+	}
+
+	if mmProvider.ProviderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProvider.ProviderMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmProvider.ProviderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmProvider.t.Fatal("No results are set for the ClientMock.Provider")
+		}
+		return (*mm_results).fp1
+	}
+	if mmProvider.funcProvider != nil {
+		return mmProvider.funcProvider()
+	}
+	mmProvider.t.Fatalf("Unexpected call to ClientMock.Provider.")
+	return
+}
+
+// ProviderAfterCounter returns a count of finished ClientMock.Provider invocations
+func (mmProvider *ClientMock) ProviderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProvider.afterProviderCounter)
+}
+
+// ProviderBeforeCounter returns a count of ClientMock.Provider invocations
+func (mmProvider *ClientMock) ProviderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProvider.beforeProviderCounter)
+}
+
+// MinimockProviderDone returns true if the count of the Provider invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockProviderDone() bool {
+	if m.ProviderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ProviderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ProviderMock.invocationsDone()
+}
+
+// MinimockProviderInspect logs each unmet expectation
+func (m *ClientMock) MinimockProviderInspect() {
+	for _, e := range m.ProviderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.Provider")
+		}
+	}
+
+	afterProviderCounter := mm_atomic.LoadUint64(&m.afterProviderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProviderMock.defaultExpectation != nil && afterProviderCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Provider at\n%s", m.ProviderMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProvider != nil && afterProviderCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Provider at\n%s", m.funcProviderOrigin)
+	}
+
+	if !m.ProviderMock.invocationsDone() && afterProviderCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Provider at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ProviderMock.expectedInvocations), m.ProviderMock.expectedInvocationsOrigin, afterProviderCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthCodeURLInspect()
+
+			m.MinimockExchangeInspect()
+
+			m.MinimockProviderInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthCodeURLDone() &&
+		m.MinimockExchangeDone() &&
+		m.MinimockProviderDone()
+}
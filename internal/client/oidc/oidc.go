@@ -0,0 +1,328 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// keysMinRefresh limits fetching of provider keys for tokens signed by unknown key
+	keysMinRefresh = time.Minute
+	// maxResponseSize limits size of provider responses
+	maxResponseSize = 1 << 20
+)
+
+// Client is interface for upstream OpenID Connect provider of federated login
+type Client interface {
+	Provider() *model.FederationProvider
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*model.FederatedClaims, error)
+}
+
+type client struct {
+	provider    *model.FederationProvider
+	redirectURI string
+	httpClient  *http.Client
+
+	mu            sync.Mutex
+	metadata      *metadata
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+// metadata is OpenID Provider metadata, OpenID Connect Discovery 1.0 section 3
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewClient returns client of upstream provider redirecting back to redirectURI,
+// provider metadata is discovered on first use
+func NewClient(provider *model.FederationProvider, redirectURI string,
+	httpClient *http.Client,
+) Client {
+	return &client{
+		provider:    provider,
+		redirectURI: redirectURI,
+		httpClient:  httpClient,
+	}
+}
+
+// Provider returns config of upstream provider
+func (c *client) Provider() *model.FederationProvider {
+	return c.provider
+}
+
+// AuthCodeURL returns URL of provider authorization endpoint user agent is redirected to,
+// authorization code flow with PKCE is used
+func (c *client) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (
+	string, error,
+) {
+	md, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	uri, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid authorization endpoint")
+	}
+
+	query := uri.Query()
+	query.Set("response_type", model.OAuthResponseTypeCode)
+	query.Set("client_id", c.provider.ClientID)
+	query.Set("redirect_uri", c.redirectURI)
+	query.Set("scope", strings.Join(c.provider.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", model.OAuthCodeChallengeMethodS256)
+	uri.RawQuery = query.Encode()
+
+	return uri.String(), nil
+}
+
+// Exchange exchanges authorization code at provider token endpoint and returns claims of
+// verified ID token issued with nonce
+func (c *client) Exchange(ctx context.Context, code, codeVerifier, nonce string) (
+	*model.FederatedClaims, error,
+) {
+	md, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {model.OAuthGrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {c.redirectURI},
+		"code_verifier": {codeVerifier},
+	}
+	if c.provider.ClientSecret == "" {
+		form.Set("client_id", c.provider.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if c.provider.ClientSecret != "" {
+		// client_secret_basic, RFC 6749 section 2.3.1
+		req.SetBasicAuth(url.QueryEscape(c.provider.ClientID),
+			url.QueryEscape(c.provider.ClientSecret))
+	}
+
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err = c.do(req, &token); err != nil {
+		return nil, errors.Wrap(err, "unable to exchange code")
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token response has no id token")
+	}
+
+	return c.verify(ctx, md, token.IDToken, nonce)
+}
+
+// verify verifies ID token, OpenID Connect Core 1.0 section 3.1.3.7
+func (c *client) verify(ctx context.Context, md *metadata, idToken, nonce string) (
+	*model.FederatedClaims, error,
+) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodRS256 {
+			return nil, errors.New("unexpected id token signing method")
+		}
+		kid, _ := t.Header["kid"].(string)
+
+		return c.key(ctx, md, kid)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid id token")
+	}
+
+	if !claims.VerifyIssuer(md.Issuer, true) {
+		return nil, errors.New("invalid id token: unexpected issuer")
+	}
+	audience := audienceOf(claims)
+	if !slices.Contains(audience, c.provider.ClientID) {
+		return nil, errors.New("invalid id token: unexpected audience")
+	}
+	azp, hasAZP := claims["azp"].(string)
+	if (hasAZP || len(audience) > 1) && azp != c.provider.ClientID {
+		return nil, errors.New("invalid id token: unexpected authorized party")
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("invalid id token: expiration time is required")
+	}
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, errors.New("invalid id token: nonce does not match")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("invalid id token: subject is required")
+	}
+
+	federated := &model.FederatedClaims{
+		Subject: subject,
+		Claims:  claims,
+	}
+	federated.Email, _ = claims["email"].(string)
+	federated.EmailVerified, _ = claims["email_verified"].(bool)
+	federated.Name, _ = claims["name"].(string)
+	federated.PreferredUsername, _ = claims["preferred_username"].(string)
+
+	return federated, nil
+}
+
+// discover returns provider metadata, failed discovery is retried on next use
+func (c *client) discover(ctx context.Context) (*metadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.metadata != nil {
+		return c.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		c.provider.Issuer+discoveryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var md metadata
+	if err = c.do(req, &md); err != nil {
+		return nil, errors.Wrap(err, "unable to discover provider")
+	}
+	// issuer must be identical to configured one, OpenID Connect Discovery 1.0 section 4.3
+	if md.Issuer != c.provider.Issuer {
+		return nil, errors.Errorf("provider issuer %q does not match %q", md.Issuer,
+			c.provider.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, errors.New("provider metadata has no required endpoints")
+	}
+	c.metadata = &md
+
+	return c.metadata, nil
+}
+
+// key returns provider key of ID token, keys are fetched again when token is signed
+// by unknown key but not more often than keysMinRefresh
+func (c *client) key(ctx context.Context, md *metadata, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(c.keysFetchedAt) < keysMinRefresh {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	keys, err := c.fetchKeys(ctx, md.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	c.keys = keys
+	c.keysFetchedAt = time.Now()
+
+	key, ok := c.keys[kid]
+	if !ok {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	return key, nil
+}
+
+func (c *client) fetchKeys(ctx context.Context, jwksURI string) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var set model.JSONWebKeySet
+	if err = c.do(req, &set); err != nil {
+		return nil, errors.Wrap(err, "unable to fetch provider keys")
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.KeyType != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid key modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid key exponent")
+		}
+
+		keys[key.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+// do sends request to provider and decodes JSON response
+func (c *client) do(req *http.Request, body interface{}) error {
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s", res.Status)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(body)
+}
+
+// audienceOf returns audiences of ID token, aud is string or array of strings
+func audienceOf(claims jwt.MapClaims) []string {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return []string{aud}
+	case []interface{}:
+		audience := make([]string, 0, len(aud))
+		for _, item := range aud {
+			if value, ok := item.(string); ok {
+				audience = append(audience, value)
+			}
+		}
+		return audience
+	default:
+		return nil
+	}
+}
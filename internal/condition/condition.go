@@ -0,0 +1,170 @@
+package condition
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// Conditions of access rules are CEL expressions returning bool, e.g.
+//
+//	request.user_id == string(claims.user_id)
+//	now.getHours("Europe/Moscow") >= 9 && now.getHours("Europe/Moscow") < 18
+//
+// Variables:
+//
+//	claims  map with user_id, username, role and tenant_id of access token
+//	request map(string, string) of attributes supplied by caller of check
+//	now     timestamp of check
+
+const (
+	varClaims  = "claims"
+	varRequest = "request"
+	varNow     = "now"
+
+	maxExpressionLength = 1024
+)
+
+// Attributes are values of condition variables
+type Attributes struct {
+	Claims  *model.UserClaims
+	Request map[string]string
+	Now     time.Time
+}
+
+// Evaluator evaluates conditions, compiled programs are cached by expression
+type Evaluator struct {
+	env       *cel.Env
+	costLimit uint64
+	programs  sync.Map
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(varClaims, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(varRequest, cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable(varNow, cel.TimestampType),
+	)
+}
+
+// NewEvaluator creates evaluator which aborts evaluation of expression exceeding cost limit
+func NewEvaluator(costLimit uint64) (*Evaluator, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Evaluator{
+		env:       env,
+		costLimit: costLimit,
+	}, nil
+}
+
+// Validate returns error if expression is not valid bool condition
+func Validate(expr string) error {
+	env, err := newEnv()
+	if err != nil {
+		return err
+	}
+
+	_, err = (&Evaluator{env: env}).compile(expr)
+
+	return err
+}
+
+// Eval reports whether condition holds, empty condition always holds
+func (e *Evaluator) Eval(expr string, attrs *Attributes) (bool, error) {
+	if expr == "" {
+		return true, nil
+	}
+
+	prg, err := e.program(expr)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := prg.Eval(activation(attrs))
+	if err != nil {
+		return false, errors.Wrap(err, "unable to evaluate condition")
+	}
+
+	res, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.New("condition result is not bool")
+	}
+
+	return res, nil
+}
+
+func (e *Evaluator) program(expr string) (cel.Program, error) {
+	if prg, ok := e.programs.Load(expr); ok {
+		return prg.(cel.Program), nil
+	}
+
+	ast, err := e.compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	prg, err := e.env.Program(ast, cel.CostLimit(e.costLimit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid condition")
+	}
+	e.programs.Store(expr, prg)
+
+	return prg, nil
+}
+
+func (e *Evaluator) compile(expr string) (*cel.Ast, error) {
+	if len(expr) > maxExpressionLength {
+		return nil, errors.Errorf("invalid condition: longer than %d characters",
+			maxExpressionLength)
+	}
+
+	ast, issues := e.env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.Wrap(issues.Err(), "invalid condition")
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, errors.Errorf("invalid condition: result type is %s, expected bool",
+			ast.OutputType())
+	}
+
+	return ast, nil
+}
+
+func activation(attrs *Attributes) map[string]interface{} {
+	if attrs == nil {
+		attrs = &Attributes{}
+	}
+
+	claims := map[string]interface{}{}
+	if attrs.Claims != nil {
+		claims = map[string]interface{}{
+			"user_id":   attrs.Claims.UserID,
+			"username":  attrs.Claims.Username,
+			"role":      attrs.Claims.Role,
+			"tenant_id": attrs.Claims.TenantID,
+		}
+	}
+
+	request := attrs.Request
+	if request == nil {
+		request = map[string]string{}
+	}
+
+	now := attrs.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	return map[string]interface{}{
+		varClaims:  claims,
+		varRequest: request,
+		varNow:     now,
+	}
+}
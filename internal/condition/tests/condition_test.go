@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/model"
+)
+
+func TestEval(t *testing.T) {
+	t.Parallel()
+
+	evaluator, err := condition.NewEvaluator(100)
+	require.NoError(t, err)
+
+	monday10 := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	attrs := func(request map[string]string, now time.Time) *condition.Attributes {
+		return &condition.Attributes{
+			Claims: &model.UserClaims{
+				UserID:   7,
+				Username: "alice",
+				Role:     "SUPPORT",
+				TenantID: 3,
+			},
+			Request: request,
+			Now:     now,
+		}
+	}
+	businessHours := `now.getDayOfWeek("UTC") >= 1 && now.getDayOfWeek("UTC") <= 5 && ` +
+		`now.getHours("UTC") >= 9 && now.getHours("UTC") < 18 && ` +
+		`request.tenant_id == string(claims.tenant_id)`
+
+	tests := []struct {
+		name  string
+		expr  string
+		attrs *condition.Attributes
+		want  bool
+		err   bool
+	}{
+		{
+			name:  "empty condition",
+			attrs: attrs(nil, monday10),
+			want:  true,
+		},
+		{
+			name:  "own profile",
+			expr:  `request.user_id == string(claims.user_id)`,
+			attrs: attrs(map[string]string{"user_id": "7"}, monday10),
+			want:  true,
+		},
+		{
+			name:  "other profile",
+			expr:  `request.user_id == string(claims.user_id)`,
+			attrs: attrs(map[string]string{"user_id": "8"}, monday10),
+			want:  false,
+		},
+		{
+			name:  "business hours in tenant",
+			expr:  businessHours,
+			attrs: attrs(map[string]string{"tenant_id": "3"}, monday10),
+			want:  true,
+		},
+		{
+			name:  "outside business hours",
+			expr:  businessHours,
+			attrs: attrs(map[string]string{"tenant_id": "3"}, monday10.Add(10*time.Hour)),
+			want:  false,
+		},
+		{
+			name:  "missing attribute",
+			expr:  `request.user_id == "7"`,
+			attrs: attrs(nil, monday10),
+			err:   true,
+		},
+		{
+			name:  "cost limit exceeded",
+			expr:  `[1,2,3,4,5,6,7,8,9,10].all(x, [1,2,3,4,5,6,7,8,9,10].all(y, x * y > 0))`,
+			attrs: attrs(nil, monday10),
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := evaluator.Eval(tt.expr, tt.attrs)
+
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, condition.Validate(`claims.role == "SUPPORT" && has(request.user_id)`))
+
+	for _, expr := range []string{
+		`claims.role ==`,
+		`request.user_id`,
+		`unknown_var == 1`,
+		`request.user_id == 7`,
+	} {
+		assert.Error(t, condition.Validate(expr), expr)
+	}
+}
@@ -1,10 +1,13 @@
 package config
 
 import (
+	"crypto/rsa"
 	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/joho/godotenv"
+
+	"github.com/valek177/auth/internal/model"
 )
 
 // Load loads environment
@@ -23,6 +26,7 @@ type GRPCConfig interface {
 	TLSCertFile() string
 	TLSKeyFile() string
 	LogLevel() string
+	SelfRegistration() bool
 }
 
 // PGConfig interface for PGConfig
@@ -47,12 +51,42 @@ type KafkaConsumerConfig interface {
 	Config() *sarama.Config
 }
 
+// KafkaProducerConfig interface for KafkaProducerConfig
+type KafkaProducerConfig interface {
+	Brokers() []string
+	Config() *sarama.Config
+}
+
 // TokenConfig interface for TokenConfig
 type TokenConfig interface {
 	ExpTime() time.Duration
 	Secret() []byte
 }
 
+// InvitationConfig interface for InvitationConfig
+type InvitationConfig interface {
+	ExpTime() time.Duration
+	Secret() []byte
+	URL() string
+}
+
+// OIDCConfig interface for OIDCConfig
+type OIDCConfig interface {
+	Issuer() string
+	SigningKey() *rsa.PrivateKey
+	IDTokenExpTime() time.Duration
+}
+
+// FederationConfig interface for FederationConfig
+type FederationConfig interface {
+	Providers() []*model.FederationProvider
+}
+
+// LDAPConfig interface for LDAPConfig
+type LDAPConfig interface {
+	Directories() []*model.LDAPDirectory
+}
+
 // PrometheusConfig interface for PrometheusConfig
 type PrometheusConfig interface {
 	Address() string
@@ -0,0 +1,157 @@
+package env
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/valek177/auth/internal/config"
+	"github.com/valek177/auth/internal/model"
+)
+
+const federationProvidersFileName = "FEDERATION_PROVIDERS_FILE"
+
+var _ config.FederationConfig = (*federationConfig)(nil)
+
+var providerNameRegexp = regexp.MustCompile(`^[a-z0-9-]{1,50}$`)
+
+type federationConfig struct {
+	providers []*model.FederationProvider
+}
+
+type federationFile struct {
+	Providers []struct {
+		Name          string   `yaml:"name"`
+		DisplayName   string   `yaml:"display_name"`
+		TenantID      int64    `yaml:"tenant_id"`
+		Issuer        string   `yaml:"issuer"`
+		ClientID      string   `yaml:"client_id"`
+		ClientSecret  string   `yaml:"client_secret"`
+		Scopes        []string `yaml:"scopes"`
+		UsernameClaim string   `yaml:"username_claim"`
+		Provisioning  bool     `yaml:"provisioning"`
+		DefaultRole   string   `yaml:"default_role"`
+		RoleMappings  []struct {
+			Claim string `yaml:"claim"`
+			Value string `yaml:"value"`
+			Role  string `yaml:"role"`
+		} `yaml:"role_mappings"`
+	} `yaml:"providers"`
+}
+
+// NewFederationConfig returns upstream OpenID Connect providers of YAML file,
+// federated login is disabled when file is not set. Client secrets may reference
+// environment variables as ${NAME}.
+func NewFederationConfig() (*federationConfig, error) {
+	path := os.Getenv(federationProvidersFileName)
+	if path == "" {
+		return &federationConfig{}, nil
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read federation providers file")
+	}
+
+	providers, err := parseFederationProviders(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &federationConfig{
+		providers: providers,
+	}, nil
+}
+
+// Providers returns configured upstream providers
+func (cfg *federationConfig) Providers() []*model.FederationProvider {
+	return cfg.providers
+}
+
+func parseFederationProviders(data []byte) ([]*model.FederationProvider, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var file federationFile
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "invalid federation providers file")
+	}
+
+	providers := make([]*model.FederationProvider, 0, len(file.Providers))
+	names := make([]string, 0, len(file.Providers))
+	for _, p := range file.Providers {
+		provider := &model.FederationProvider{
+			Name:          p.Name,
+			DisplayName:   p.DisplayName,
+			TenantID:      p.TenantID,
+			Issuer:        strings.TrimSuffix(p.Issuer, "/"),
+			ClientID:      p.ClientID,
+			ClientSecret:  os.ExpandEnv(p.ClientSecret),
+			Scopes:        p.Scopes,
+			UsernameClaim: p.UsernameClaim,
+			Provisioning:  p.Provisioning,
+			DefaultRole:   p.DefaultRole,
+		}
+		for _, mapping := range p.RoleMappings {
+			provider.RoleMappings = append(provider.RoleMappings, model.FederationRoleMapping{
+				Claim: mapping.Claim,
+				Value: mapping.Value,
+				Role:  mapping.Role,
+			})
+		}
+		if provider.DisplayName == "" {
+			provider.DisplayName = provider.Name
+		}
+		if len(provider.Scopes) == 0 {
+			provider.Scopes = []string{
+				model.OIDCScopeOpenID, model.OIDCScopeProfile, model.OIDCScopeEmail,
+			}
+		}
+
+		if err := validateFederationProvider(provider); err != nil {
+			return nil, errors.Wrapf(err, "invalid federation provider %q", provider.Name)
+		}
+		if slices.Contains(names, provider.Name) {
+			return nil, errors.Errorf("duplicate federation provider %q", provider.Name)
+		}
+
+		names = append(names, provider.Name)
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+func validateFederationProvider(provider *model.FederationProvider) error {
+	if !providerNameRegexp.MatchString(provider.Name) {
+		return errors.New("name must be lowercase letters, digits and dashes")
+	}
+	if provider.TenantID <= 0 {
+		return errors.New("tenant_id is required")
+	}
+	issuerURL, err := url.Parse(provider.Issuer)
+	if err != nil || issuerURL.Host == "" || (issuerURL.Scheme != "https" &&
+		issuerURL.Scheme != "http") {
+		return errors.New("issuer must be http(s) URL")
+	}
+	if provider.ClientID == "" {
+		return errors.New("client_id is required")
+	}
+	if !slices.Contains(provider.Scopes, model.OIDCScopeOpenID) {
+		return errors.New("scopes must contain openid")
+	}
+	for _, mapping := range provider.RoleMappings {
+		if mapping.Claim == "" || mapping.Value == "" || mapping.Role == "" {
+			return errors.New("role mapping requires claim, value and role")
+		}
+	}
+
+	return nil
+}
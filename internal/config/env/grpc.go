@@ -3,6 +3,7 @@ package env
 import (
 	"net"
 	"os"
+	"strconv"
 
 	"github.com/pkg/errors"
 
@@ -17,6 +18,7 @@ const (
 	serviceTLSCertFile = "GRPC_TLS_CERT_FILE"
 	serviceTLSKeyFile  = "GRPC_TLS_KEY_FILE"
 	logLevel           = "LOG_LEVEL"
+	selfRegistration   = "SELF_REGISTRATION_ENABLED"
 )
 
 type grpcConfig struct {
@@ -25,6 +27,8 @@ type grpcConfig struct {
 	tlsCertFile string
 	tlsKeyFile  string
 	logLevel    string
+	// selfRegistration allows anonymous callers to create users
+	selfRegistration bool
 }
 
 // NewGRPCConfig creates new grpcConfig
@@ -54,12 +58,22 @@ func NewGRPCConfig() (*grpcConfig, error) {
 		return nil, errors.New("log level not found")
 	}
 
+	var selfRegistrationEnabled bool
+	if value := os.Getenv(selfRegistration); value != "" {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("unable to get self registration flag")
+		}
+		selfRegistrationEnabled = enabled
+	}
+
 	return &grpcConfig{
-		host:        host,
-		port:        port,
-		tlsCertFile: tlsServiceCertFile,
-		tlsKeyFile:  tlsServiceKeyFile,
-		logLevel:    logLevel,
+		host:             host,
+		port:             port,
+		tlsCertFile:      tlsServiceCertFile,
+		tlsKeyFile:       tlsServiceKeyFile,
+		logLevel:         logLevel,
+		selfRegistration: selfRegistrationEnabled,
 	}, nil
 }
 
@@ -82,3 +96,8 @@ func (cfg *grpcConfig) TLSKeyFile() string {
 func (cfg *grpcConfig) LogLevel() string {
 	return cfg.logLevel
 }
+
+// SelfRegistration returns whether anonymous callers may create users
+func (cfg *grpcConfig) SelfRegistration() bool {
+	return cfg.selfRegistration
+}
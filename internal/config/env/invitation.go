@@ -0,0 +1,72 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	invitationExpTimeName   = "INVITATION_EXPIRATION_TIME"
+	invitationSecretKeyName = "INVITATION_SECRET_KEY" //nolint:gosec
+	invitationURLName       = "INVITATION_URL"
+)
+
+// InvitationConfig is interface for invitation config
+type InvitationConfig interface {
+	ExpTime() time.Duration
+	Secret() []byte
+	URL() string
+}
+
+type invitationConfig struct {
+	expTime time.Duration
+	secret  []byte
+	url     string
+}
+
+// NewInvitationConfig returns config for invitation links
+func NewInvitationConfig() (InvitationConfig, error) {
+	expTimeStr := os.Getenv(invitationExpTimeName)
+	if expTimeStr == "" {
+		return nil, errors.New("invitation expiration time not found")
+	}
+
+	expTime, err := strconv.Atoi(expTimeStr)
+	if err != nil {
+		return nil, errors.New("unable to get invitation expiration time")
+	}
+
+	secret := os.Getenv(invitationSecretKeyName)
+	if secret == "" {
+		return nil, errors.New("invitation secret not found")
+	}
+
+	url := os.Getenv(invitationURLName)
+	if url == "" {
+		return nil, errors.New("invitation url not found")
+	}
+
+	return &invitationConfig{
+		expTime: time.Hour * time.Duration(expTime),
+		secret:  []byte(secret),
+		url:     url,
+	}, nil
+}
+
+// ExpTime returns lifetime of invitation link
+func (cfg *invitationConfig) ExpTime() time.Duration {
+	return cfg.expTime
+}
+
+// Secret returns secret for invitation token
+func (cfg *invitationConfig) Secret() []byte {
+	return cfg.secret
+}
+
+// URL returns base URL of invitation link
+func (cfg *invitationConfig) URL() string {
+	return cfg.url
+}
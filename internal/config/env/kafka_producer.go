@@ -0,0 +1,45 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/IBM/sarama"
+)
+
+type kafkaProducerConfig struct {
+	brokers []string
+}
+
+// NewKafkaProducerConfig returns new kafka producer config
+func NewKafkaProducerConfig() (*kafkaProducerConfig, error) {
+	brokersStr := os.Getenv(brokersEnvName)
+	if len(brokersStr) == 0 {
+		return nil, errors.New("kafka brokers address not found")
+	}
+
+	brokers := strings.Split(brokersStr, ",")
+	for i := range brokers {
+		brokers[i] = strings.TrimSpace(brokers[i])
+	}
+
+	return &kafkaProducerConfig{
+		brokers: brokers,
+	}, nil
+}
+
+func (cfg *kafkaProducerConfig) Brokers() []string {
+	return cfg.brokers
+}
+
+// Config returns config for sarama sync producer
+func (cfg *kafkaProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_6_0_0
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 5
+	config.Producer.Return.Successes = true
+
+	return config
+}
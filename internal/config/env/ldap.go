@@ -0,0 +1,161 @@
+package env
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/valek177/auth/internal/config"
+	"github.com/valek177/auth/internal/model"
+)
+
+const ldapDirectoriesFileName = "LDAP_DIRECTORIES_FILE"
+
+var _ config.LDAPConfig = (*ldapConfig)(nil)
+
+type ldapConfig struct {
+	directories []*model.LDAPDirectory
+}
+
+type ldapFile struct {
+	Directories []struct {
+		Name              string `yaml:"name"`
+		TenantID          int64  `yaml:"tenant_id"`
+		URL               string `yaml:"url"`
+		StartTLS          bool   `yaml:"start_tls"`
+		BindDN            string `yaml:"bind_dn"`
+		BindPassword      string `yaml:"bind_password"`
+		BaseDN            string `yaml:"base_dn"`
+		UserFilter        string `yaml:"user_filter"`
+		UsernameAttribute string `yaml:"username_attribute"`
+		EmailAttribute    string `yaml:"email_attribute"`
+		GroupAttribute    string `yaml:"group_attribute"`
+		Sync              bool   `yaml:"sync"`
+		DefaultRole       string `yaml:"default_role"`
+		RoleMappings      []struct {
+			GroupDN string `yaml:"group_dn"`
+			Role    string `yaml:"role"`
+		} `yaml:"role_mappings"`
+	} `yaml:"directories"`
+}
+
+// NewLDAPConfig returns LDAP directories of YAML file, users authenticate with local
+// passwords only when file is not set. Bind passwords may reference environment
+// variables as ${NAME}.
+func NewLDAPConfig() (*ldapConfig, error) {
+	path := os.Getenv(ldapDirectoriesFileName)
+	if path == "" {
+		return &ldapConfig{}, nil
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read ldap directories file")
+	}
+
+	directories, err := parseLDAPDirectories(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ldapConfig{
+		directories: directories,
+	}, nil
+}
+
+// Directories returns configured LDAP directories
+func (cfg *ldapConfig) Directories() []*model.LDAPDirectory {
+	return cfg.directories
+}
+
+func parseLDAPDirectories(data []byte) ([]*model.LDAPDirectory, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var file ldapFile
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "invalid ldap directories file")
+	}
+
+	directories := make([]*model.LDAPDirectory, 0, len(file.Directories))
+	names := make([]string, 0, len(file.Directories))
+	for _, d := range file.Directories {
+		directory := &model.LDAPDirectory{
+			Name:              d.Name,
+			TenantID:          d.TenantID,
+			URL:               d.URL,
+			StartTLS:          d.StartTLS,
+			BindDN:            d.BindDN,
+			BindPassword:      os.ExpandEnv(d.BindPassword),
+			BaseDN:            d.BaseDN,
+			UserFilter:        d.UserFilter,
+			UsernameAttribute: d.UsernameAttribute,
+			EmailAttribute:    d.EmailAttribute,
+			GroupAttribute:    d.GroupAttribute,
+			Sync:              d.Sync,
+			DefaultRole:       d.DefaultRole,
+		}
+		for _, mapping := range d.RoleMappings {
+			directory.RoleMappings = append(directory.RoleMappings, model.LDAPRoleMapping{
+				GroupDN: mapping.GroupDN,
+				Role:    mapping.Role,
+			})
+		}
+		if directory.UserFilter == "" {
+			directory.UserFilter = "(objectClass=person)"
+		}
+		if directory.UsernameAttribute == "" {
+			directory.UsernameAttribute = "uid"
+		}
+		if directory.EmailAttribute == "" {
+			directory.EmailAttribute = "mail"
+		}
+		if directory.GroupAttribute == "" {
+			directory.GroupAttribute = "memberOf"
+		}
+
+		if err := validateLDAPDirectory(directory); err != nil {
+			return nil, errors.Wrapf(err, "invalid ldap directory %q", directory.Name)
+		}
+		if slices.Contains(names, directory.Name) {
+			return nil, errors.Errorf("duplicate ldap directory %q", directory.Name)
+		}
+
+		names = append(names, directory.Name)
+		directories = append(directories, directory)
+	}
+
+	return directories, nil
+}
+
+func validateLDAPDirectory(directory *model.LDAPDirectory) error {
+	if !providerNameRegexp.MatchString(directory.Name) {
+		return errors.New("name must be lowercase letters, digits and dashes")
+	}
+	if directory.TenantID <= 0 {
+		return errors.New("tenant_id is required")
+	}
+	serverURL, err := url.Parse(directory.URL)
+	if err != nil || serverURL.Host == "" || (serverURL.Scheme != "ldap" &&
+		serverURL.Scheme != "ldaps") {
+		return errors.New("url must be ldap(s) URL")
+	}
+	if directory.StartTLS && serverURL.Scheme == "ldaps" {
+		return errors.New("start_tls is not used with ldaps")
+	}
+	if directory.BaseDN == "" {
+		return errors.New("base_dn is required")
+	}
+	for _, mapping := range directory.RoleMappings {
+		if mapping.GroupDN == "" || mapping.Role == "" {
+			return errors.New("role mapping requires group_dn and role")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package env
+
+import (
+	"crypto/rsa"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	oidcIssuerName            = "OIDC_ISSUER"
+	oidcSigningKeyFileName    = "OIDC_SIGNING_KEY_FILE"
+	oidcIDTokenExpirationName = "OIDC_ID_TOKEN_EXPIRATION_TIME" //nolint:gosec
+)
+
+// OIDCConfig is interface for OpenID Connect provider config
+type OIDCConfig interface {
+	Issuer() string
+	SigningKey() *rsa.PrivateKey
+	IDTokenExpTime() time.Duration
+}
+
+type oidcConfig struct {
+	issuer         string
+	signingKey     *rsa.PrivateKey
+	idTokenExpTime time.Duration
+}
+
+// NewOIDCConfig returns config of OpenID Connect provider
+func NewOIDCConfig() (OIDCConfig, error) {
+	issuer := strings.TrimSuffix(os.Getenv(oidcIssuerName), "/")
+	if issuer == "" {
+		return nil, errors.New("oidc issuer not found")
+	}
+	issuerURL, err := url.Parse(issuer)
+	if err != nil || issuerURL.Host == "" || issuerURL.RawQuery != "" || issuerURL.Fragment != "" {
+		return nil, errors.New("oidc issuer must be URL without query and fragment")
+	}
+
+	keyFile := os.Getenv(oidcSigningKeyFileName)
+	if keyFile == "" {
+		return nil, errors.New("oidc signing key file not found")
+	}
+
+	keyPEM, err := os.ReadFile(keyFile) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read oidc signing key")
+	}
+
+	signingKey, err := jwt.ParseRSAPrivateKeyFromPEM(keyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse oidc signing key")
+	}
+
+	expTimeStr := os.Getenv(oidcIDTokenExpirationName)
+	if expTimeStr == "" {
+		return nil, errors.New("oidc id token expiration time not found")
+	}
+
+	expTime, err := strconv.Atoi(expTimeStr)
+	if err != nil {
+		return nil, errors.New("unable to get oidc id token expiration time")
+	}
+
+	return &oidcConfig{
+		issuer:         issuer,
+		signingKey:     signingKey,
+		idTokenExpTime: time.Minute * time.Duration(expTime),
+	}, nil
+}
+
+// Issuer returns issuer identifier, base URL of OAuth and OpenID Connect endpoints
+func (cfg *oidcConfig) Issuer() string {
+	return cfg.issuer
+}
+
+// SigningKey returns RSA key signing ID tokens
+func (cfg *oidcConfig) SigningKey() *rsa.PrivateKey {
+	return cfg.signingKey
+}
+
+// IDTokenExpTime returns lifetime of ID token
+func (cfg *oidcConfig) IDTokenExpTime() time.Duration {
+	return cfg.idTokenExpTime
+}
@@ -0,0 +1,110 @@
+package converter
+
+import (
+	"github.com/valek177/auth/grpc/pkg/access_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToCheckResponseV1FromService converts access decision model to protobuf object
+func ToCheckResponseV1FromService(decision *model.AccessDecision) *access_v1.CheckResponse {
+	res := &access_v1.CheckResponse{
+		Allowed: decision.Allowed,
+		Code:    access_v1.ReasonCode(toReasonCodeV1FromService(decision.Code)),
+		Reason:  decision.Reason,
+	}
+	if decision.Rule != nil {
+		res.RuleId = decision.Rule.ID
+		res.RuleEndpoint = decision.Rule.Endpoint
+	}
+	if explanation := decision.Explanation; explanation != nil {
+		res.Explanation = &access_v1.Explanation{
+			Role:        explanation.Role,
+			Roles:       explanation.Roles,
+			Permissions: explanation.Permissions,
+		}
+		for _, evaluation := range explanation.Rules {
+			res.Explanation.Rules = append(res.Explanation.Rules, &access_v1.RuleEvaluation{
+				RuleId:           evaluation.Rule.ID,
+				Effect:           evaluation.Rule.Effect,
+				Endpoint:         evaluation.Rule.Endpoint,
+				SubjectMatched:   evaluation.SubjectMatched,
+				ConditionMatched: evaluation.ConditionMatched,
+				ConditionError:   evaluation.ConditionError,
+			})
+		}
+	}
+
+	return res
+}
+
+// toReasonCodeV1FromService converts reason code of decision to enum value,
+// values of enums of access and access admin APIs are the same
+func toReasonCodeV1FromService(code string) int32 {
+	switch code {
+	case model.AccessReasonAllowed:
+		return int32(access_v1.ReasonCode_ALLOWED)
+	case model.AccessReasonNoMatchingRule:
+		return int32(access_v1.ReasonCode_NO_MATCHING_RULE)
+	case model.AccessReasonExplicitDeny:
+		return int32(access_v1.ReasonCode_EXPLICIT_DENY)
+	case model.AccessReasonNoAllowingRule:
+		return int32(access_v1.ReasonCode_NO_ALLOWING_RULE)
+	default:
+		return int32(access_v1.ReasonCode_REASON_CODE_UNSPECIFIED)
+	}
+}
+
+// ToAccessChecksFromV1 converts batch check protobuf object to models
+func ToAccessChecksFromV1(req *access_v1.BatchCheckRequest) []*model.AccessCheck {
+	res := make([]*model.AccessCheck, 0, len(req.GetChecks()))
+	for _, check := range req.GetChecks() {
+		res = append(res, &model.AccessCheck{
+			Endpoint:   check.GetEndpointAddress(),
+			Attributes: check.GetAttributes(),
+		})
+	}
+
+	return res
+}
+
+// ToBatchCheckResponseV1FromService converts access decision models to protobuf object,
+// decisions are in order of checks
+func ToBatchCheckResponseV1FromService(checks []*model.AccessCheck,
+	decisions []*model.AccessDecision,
+) *access_v1.BatchCheckResponse {
+	res := &access_v1.BatchCheckResponse{
+		Results: make([]*access_v1.BatchCheckResult, 0, len(decisions)),
+	}
+	for i, decision := range decisions {
+		result := &access_v1.BatchCheckResult{
+			EndpointAddress: checks[i].Endpoint,
+			Allowed:         decision.Allowed,
+			Code:            access_v1.ReasonCode(toReasonCodeV1FromService(decision.Code)),
+			Reason:          decision.Reason,
+		}
+		if decision.Rule != nil {
+			result.RuleId = decision.Rule.ID
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	return res
+}
+
+// ToListPermissionsResponseV1FromService converts permissions model to protobuf object
+func ToListPermissionsResponseV1FromService(permissions *model.AccessPermissions,
+) *access_v1.ListPermissionsResponse {
+	res := &access_v1.ListPermissionsResponse{
+		Roles:       permissions.Roles,
+		Permissions: permissions.Permissions,
+	}
+	for _, endpoint := range permissions.Endpoints {
+		res.Endpoints = append(res.Endpoints, &access_v1.AllowedEndpoint{
+			Endpoint:    endpoint.Endpoint,
+			RuleId:      endpoint.RuleID,
+			Conditional: endpoint.Conditional,
+		})
+	}
+
+	return res
+}
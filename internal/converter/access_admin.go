@@ -0,0 +1,223 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToAccessRuleFromCreateRuleV1 converts access rule protobuf object to model
+func ToAccessRuleFromCreateRuleV1(req *access_admin_v1.CreateRuleRequest) *model.AccessRule {
+	if req == nil {
+		return &model.AccessRule{}
+	}
+
+	return &model.AccessRule{
+		Effect:     toAccessEffectFromV1(req.GetEffect()),
+		Permission: req.GetPermission(),
+		Role:       req.GetRole(),
+		UserID:     req.GetUserId(),
+		Endpoint:   req.GetEndpoint(),
+		Condition:  req.GetCondition(),
+	}
+}
+
+// toAccessEffectFromV1 converts effect enum to model, unspecified effect is allow
+func toAccessEffectFromV1(effect access_admin_v1.Effect) string {
+	if effect == access_admin_v1.Effect_DENY {
+		return model.AccessEffectDeny
+	}
+
+	return model.AccessEffectAllow
+}
+
+func toEffectV1FromService(effect string) access_admin_v1.Effect {
+	if effect == model.AccessEffectDeny {
+		return access_admin_v1.Effect_DENY
+	}
+
+	return access_admin_v1.Effect_ALLOW
+}
+
+// ToAccessRuleFilterFromListRulesV1 converts access rules filter protobuf object to model
+func ToAccessRuleFilterFromListRulesV1(req *access_admin_v1.ListRulesRequest,
+) *model.AccessRuleFilter {
+	if req == nil {
+		return &model.AccessRuleFilter{}
+	}
+
+	filter := &model.AccessRuleFilter{
+		Permission: req.GetPermission(),
+		Role:       req.GetRole(),
+		UserID:     req.GetUserId(),
+		Endpoint:   req.GetEndpoint(),
+	}
+	if req.GetEffect() != access_admin_v1.Effect_EFFECT_UNSPECIFIED {
+		filter.Effect = toAccessEffectFromV1(req.GetEffect())
+	}
+
+	return filter
+}
+
+// ToUpdateAccessRuleFromV1 converts access rule update protobuf object to model
+func ToUpdateAccessRuleFromV1(req *access_admin_v1.UpdateRuleRequest) *model.UpdateAccessRule {
+	if req == nil {
+		return &model.UpdateAccessRule{}
+	}
+
+	var ptrPermission, ptrEndpoint, ptrCondition *string
+
+	if req.GetPermission() != nil {
+		str := req.GetPermission().GetValue()
+		ptrPermission = &str
+	}
+
+	if req.GetEndpoint() != nil {
+		str := req.GetEndpoint().GetValue()
+		ptrEndpoint = &str
+	}
+
+	if req.GetCondition() != nil {
+		str := req.GetCondition().GetValue()
+		ptrCondition = &str
+	}
+
+	return &model.UpdateAccessRule{
+		ID:         req.GetId(),
+		Permission: ptrPermission,
+		Endpoint:   ptrEndpoint,
+		Condition:  ptrCondition,
+	}
+}
+
+// ToRulesV1FromService converts access rule models to protobuf objects
+func ToRulesV1FromService(rules []*model.AccessRule) []*access_admin_v1.Rule {
+	res := make([]*access_admin_v1.Rule, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, toRuleV1FromService(rule))
+	}
+
+	return res
+}
+
+// toRuleV1FromService converts access rule model to protobuf object,
+// proposed rule which is not saved has no timestamps
+func toRuleV1FromService(rule *model.AccessRule) *access_admin_v1.Rule {
+	var createdAt, updatedAt *timestamppb.Timestamp
+	if !rule.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(rule.CreatedAt)
+	}
+	if rule.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(rule.UpdatedAt.Time)
+	}
+
+	return &access_admin_v1.Rule{
+		Id:         rule.ID,
+		Effect:     toEffectV1FromService(rule.Effect),
+		Permission: rule.Permission,
+		Role:       rule.Role,
+		UserId:     rule.UserID,
+		Endpoint:   rule.Endpoint,
+		Condition:  rule.Condition,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// ToPermissionFromCreatePermissionV1 converts permission protobuf object to model
+func ToPermissionFromCreatePermissionV1(req *access_admin_v1.CreatePermissionRequest,
+) *model.Permission {
+	if req == nil {
+		return &model.Permission{}
+	}
+
+	return &model.Permission{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+}
+
+// ToPermissionsV1FromService converts permission models to protobuf objects
+func ToPermissionsV1FromService(permissions []*model.Permission) []*access_admin_v1.Permission {
+	res := make([]*access_admin_v1.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		res = append(res, &access_admin_v1.Permission{
+			Id:          permission.ID,
+			Name:        permission.Name,
+			Description: permission.Description,
+			CreatedAt:   timestamppb.New(permission.CreatedAt),
+		})
+	}
+
+	return res
+}
+
+// ToGetRolePermissionsResponseV1FromService converts role permissions model to protobuf object
+func ToGetRolePermissionsResponseV1FromService(permissions *model.RolePermissions,
+) *access_admin_v1.GetRolePermissionsResponse {
+	if permissions == nil {
+		return &access_admin_v1.GetRolePermissionsResponse{}
+	}
+
+	return &access_admin_v1.GetRolePermissionsResponse{
+		Role:                 permissions.Role,
+		Parents:              permissions.Parents,
+		Permissions:          permissions.Permissions,
+		EffectivePermissions: permissions.EffectivePermissions,
+	}
+}
+
+// ToAccessSimulationFromV1 converts simulated access check protobuf object to model
+func ToAccessSimulationFromV1(req *access_admin_v1.SimulateCheckRequest,
+) *model.AccessSimulation {
+	if req == nil {
+		return &model.AccessSimulation{}
+	}
+
+	res := &model.AccessSimulation{
+		UserID:        req.GetUserId(),
+		Role:          req.GetRole(),
+		Endpoint:      req.GetEndpointAddress(),
+		Attributes:    req.GetAttributes(),
+		RemoveRuleIDs: req.GetRemoveRuleIds(),
+	}
+	for _, rule := range req.GetAddRules() {
+		res.AddRules = append(res.AddRules, ToAccessRuleFromCreateRuleV1(rule))
+	}
+
+	return res
+}
+
+// ToSimulateCheckResponseV1FromService converts access decision model to protobuf object
+func ToSimulateCheckResponseV1FromService(decision *model.AccessDecision,
+) *access_admin_v1.SimulateCheckResponse {
+	res := &access_admin_v1.SimulateCheckResponse{
+		Allowed: decision.Allowed,
+		Code:    access_admin_v1.ReasonCode(toReasonCodeV1FromService(decision.Code)),
+		Reason:  decision.Reason,
+	}
+	if decision.Rule != nil {
+		res.Rule = toRuleV1FromService(decision.Rule)
+	}
+	if explanation := decision.Explanation; explanation != nil {
+		res.Explanation = &access_admin_v1.Explanation{
+			UserId:      explanation.UserID,
+			Username:    explanation.Username,
+			Role:        explanation.Role,
+			Roles:       explanation.Roles,
+			Permissions: explanation.Permissions,
+		}
+		for _, evaluation := range explanation.Rules {
+			res.Explanation.Rules = append(res.Explanation.Rules,
+				&access_admin_v1.RuleEvaluation{
+					Rule:             toRuleV1FromService(evaluation.Rule),
+					SubjectMatched:   evaluation.SubjectMatched,
+					ConditionMatched: evaluation.ConditionMatched,
+					ConditionError:   evaluation.ConditionError,
+				})
+		}
+	}
+
+	return res
+}
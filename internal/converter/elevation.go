@@ -0,0 +1,96 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/elevation_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToNewElevationFromV1 converts protobuf elevation request to service model
+func ToNewElevationFromV1(req *elevation_v1.RequestElevationRequest) *model.NewElevation {
+	if req == nil {
+		return &model.NewElevation{}
+	}
+
+	var startsAt time.Time
+	if req.GetStartsAt() != nil {
+		startsAt = req.GetStartsAt().AsTime()
+	}
+
+	return &model.NewElevation{
+		Role:     req.GetRole(),
+		Reason:   req.GetReason(),
+		StartsAt: startsAt,
+		Duration: req.GetDuration().AsDuration(),
+	}
+}
+
+// ToRoleGrantStatusFromV1 converts protobuf role grant status to service model,
+// unspecified status is empty
+func ToRoleGrantStatusFromV1(status elevation_v1.RoleGrantStatus) string {
+	switch status {
+	case elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_PENDING:
+		return model.RoleGrantStatusPending
+	case elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_APPROVED:
+		return model.RoleGrantStatusApproved
+	case elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_DENIED:
+		return model.RoleGrantStatusDenied
+	case elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_EXPIRED:
+		return model.RoleGrantStatusExpired
+	default:
+		return ""
+	}
+}
+
+// ToRoleGrantV1FromService converts role grant model to protobuf object
+func ToRoleGrantV1FromService(grant *model.RoleGrant) *elevation_v1.RoleGrant {
+	if grant == nil {
+		return &elevation_v1.RoleGrant{}
+	}
+
+	var updatedAt *timestamppb.Timestamp
+	if grant.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(grant.UpdatedAt.Time)
+	}
+
+	return &elevation_v1.RoleGrant{
+		Id:         grant.ID,
+		UserId:     grant.UserID,
+		Role:       grant.Role,
+		Reason:     grant.Reason,
+		Status:     toRoleGrantStatusV1(grant.Status),
+		ReviewedBy: grant.ReviewedBy.String,
+		StartsAt:   timestamppb.New(grant.StartsAt),
+		ExpiresAt:  timestamppb.New(grant.ExpiresAt),
+		CreatedAt:  timestamppb.New(grant.CreatedAt),
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// ToRoleGrantsV1FromService converts role grants to protobuf objects
+func ToRoleGrantsV1FromService(grants []*model.RoleGrant) []*elevation_v1.RoleGrant {
+	res := make([]*elevation_v1.RoleGrant, 0, len(grants))
+	for _, grant := range grants {
+		res = append(res, ToRoleGrantV1FromService(grant))
+	}
+
+	return res
+}
+
+func toRoleGrantStatusV1(status string) elevation_v1.RoleGrantStatus {
+	switch status {
+	case model.RoleGrantStatusPending:
+		return elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_PENDING
+	case model.RoleGrantStatusApproved:
+		return elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_APPROVED
+	case model.RoleGrantStatusDenied:
+		return elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_DENIED
+	case model.RoleGrantStatusExpired:
+		return elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_EXPIRED
+	default:
+		return elevation_v1.RoleGrantStatus_ROLE_GRANT_STATUS_UNSPECIFIED
+	}
+}
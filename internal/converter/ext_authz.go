@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/pkg/errors"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// Headers injected into requests allowed by external authorization
+const (
+	ExtAuthzUserIDHeader   = "x-user-id"
+	ExtAuthzUserRoleHeader = "x-user-role"
+	ExtAuthzTenantHeader   = "x-tenant-id"
+)
+
+// ToAccessEndpointFromExtAuthz converts HTTP request checked by Envoy to endpoint
+// of access rules: gRPC request is its path, other request is its method and path.
+// Path is percent-decoded and cleaned of dot segments and repeated slashes,
+// so /a/../admin, //admin and /%2Fadmin are matched as /admin.
+func ToAccessEndpointFromExtAuthz(req *authv3.AttributeContext_HttpRequest) (string, error) {
+	rawPath := req.GetPath()
+	if i := strings.IndexAny(rawPath, "?#"); i >= 0 {
+		rawPath = rawPath[:i]
+	}
+
+	decoded, err := url.PathUnescape(rawPath)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid request path")
+	}
+	if strings.ContainsAny(decoded, "\\\x00") {
+		return "", errors.New("invalid request path: backslash or NUL byte")
+	}
+	endpointPath := path.Clean("/" + decoded)
+
+	if strings.HasPrefix(req.GetHeaders()["content-type"], "application/grpc") {
+		return endpointPath, nil
+	}
+
+	return req.GetMethod() + " " + endpointPath, nil
+}
+
+// ToExtAuthzCheckResponseFromService converts access authorization model to Envoy response,
+// allowed request is forwarded with identity of its user in headers
+func ToExtAuthzCheckResponseFromService(authorization *model.AccessAuthorization,
+) *authv3.CheckResponse {
+	decision := authorization.Decision
+	if !decision.Allowed {
+		return ToExtAuthzDeniedResponse(codes.PermissionDenied, typev3.StatusCode_Forbidden,
+			"access denied: "+decision.Reason)
+	}
+
+	claims := authorization.Claims
+
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{
+			OkResponse: &authv3.OkHttpResponse{
+				Headers: []*corev3.HeaderValueOption{
+					toExtAuthzHeader(ExtAuthzUserIDHeader, strconv.FormatInt(claims.UserID, 10)),
+					toExtAuthzHeader(ExtAuthzUserRoleHeader, claims.Role),
+					toExtAuthzHeader(ExtAuthzTenantHeader, strconv.FormatInt(claims.TenantID, 10)),
+				},
+			},
+		},
+	}
+}
+
+// ToExtAuthzDeniedResponse returns Envoy response denying request with status and reason
+func ToExtAuthzDeniedResponse(code codes.Code, httpCode typev3.StatusCode, reason string,
+) *authv3.CheckResponse {
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(code), Message: reason},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{
+				Status: &typev3.HttpStatus{Code: httpCode},
+				Body:   reason,
+			},
+		},
+	}
+}
+
+// toExtAuthzHeader returns header replacing header of the same name sent by client
+func toExtAuthzHeader(key, value string) *corev3.HeaderValueOption {
+	return &corev3.HeaderValueOption{
+		Header:       &corev3.HeaderValue{Key: key, Value: value},
+		AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	}
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/invitation_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToNewInvitationFromInviteUserV1 converts invitation protobuf object to model
+func ToNewInvitationFromInviteUserV1(req *invitation_v1.InviteUserRequest) *model.NewInvitation {
+	if req == nil {
+		return &model.NewInvitation{}
+	}
+
+	role := req.Role.String()
+	if req.GetRoleName() != "" {
+		role = req.GetRoleName()
+	}
+
+	return &model.NewInvitation{
+		Name:  req.Name,
+		Email: req.Email,
+		Role:  role,
+	}
+}
+
+// ToInviteUserResponseV1FromService converts invitation model to protobuf object
+func ToInviteUserResponseV1FromService(
+	invitation *model.Invitation,
+) *invitation_v1.InviteUserResponse {
+	if invitation == nil {
+		return &invitation_v1.InviteUserResponse{}
+	}
+
+	return &invitation_v1.InviteUserResponse{
+		Id:        invitation.ID,
+		UserId:    invitation.UserID.Int64,
+		ExpiresAt: timestamppb.New(invitation.ExpiresAt),
+	}
+}
+
+// ToAcceptInvitationFromV1 converts invitation acceptance protobuf object to model
+func ToAcceptInvitationFromV1(req *invitation_v1.AcceptInvitationRequest) *model.AcceptInvitation {
+	if req == nil {
+		return &model.AcceptInvitation{}
+	}
+
+	return &model.AcceptInvitation{
+		Token:           req.Token,
+		Password:        req.Password,
+		PasswordConfirm: req.PasswordConfirm,
+	}
+}
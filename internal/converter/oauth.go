@@ -0,0 +1,44 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToOAuthClientFromCreateClientV1 converts protobuf client registration to service model
+func ToOAuthClientFromCreateClientV1(req *oauth_v1.CreateClientRequest) *model.OAuthClient {
+	if req == nil {
+		return &model.OAuthClient{}
+	}
+
+	return &model.OAuthClient{
+		Name:         req.GetName(),
+		RedirectURIs: req.GetRedirectUris(),
+	}
+}
+
+// ToOAuthClientV1FromService converts OAuth client to protobuf object
+func ToOAuthClientV1FromService(client *model.OAuthClient) *oauth_v1.Client {
+	if client == nil {
+		return &oauth_v1.Client{}
+	}
+
+	return &oauth_v1.Client{
+		ClientId:     client.ClientID,
+		Name:         client.Name,
+		RedirectUris: client.RedirectURIs,
+		CreatedAt:    timestamppb.New(client.CreatedAt),
+	}
+}
+
+// ToOAuthClientsV1FromService converts OAuth clients to protobuf objects
+func ToOAuthClientsV1FromService(clients []*model.OAuthClient) []*oauth_v1.Client {
+	res := make([]*oauth_v1.Client, 0, len(clients))
+	for _, client := range clients {
+		res = append(res, ToOAuthClientV1FromService(client))
+	}
+
+	return res
+}
@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/user_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToDataFormatFromV1 converts data format protobuf enum to model
+func ToDataFormatFromV1(format user_v1.DataFormat) string {
+	switch format {
+	case user_v1.DataFormat_DATA_FORMAT_CSV:
+		return model.DataFormatCSV
+	case user_v1.DataFormat_DATA_FORMAT_JSONL:
+		return model.DataFormatJSONL
+	default:
+		return ""
+	}
+}
+
+// ToImportUsersOptionsFromV1 converts import options protobuf object to model
+func ToImportUsersOptionsFromV1(options *user_v1.ImportUsersOptions) *model.ImportUsersOptions {
+	if options == nil {
+		return &model.ImportUsersOptions{}
+	}
+
+	return &model.ImportUsersOptions{
+		Format: ToDataFormatFromV1(options.GetFormat()),
+		DryRun: options.GetDryRun(),
+	}
+}
+
+// ToOperationV1FromService converts operation model to protobuf object
+func ToOperationV1FromService(operation *model.Operation) *user_v1.Operation {
+	if operation == nil {
+		return &user_v1.Operation{}
+	}
+
+	var updatedAt *timestamppb.Timestamp
+	if operation.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(operation.UpdatedAt.Time)
+	}
+
+	res := &user_v1.Operation{
+		Id:   operation.ID,
+		Done: operation.Done,
+		Metadata: &user_v1.ImportUsersMetadata{
+			TotalRows:     operation.Metadata.TotalRows,
+			ProcessedRows: operation.Metadata.ProcessedRows,
+			DryRun:        operation.Metadata.DryRun,
+			CreatedAt:     timestamppb.New(operation.CreatedAt),
+			UpdatedAt:     updatedAt,
+		},
+	}
+
+	switch {
+	case operation.Error != "":
+		res.Result = &user_v1.Operation_Error{Error: operation.Error}
+	case operation.Result != nil:
+		rowErrors := make([]*user_v1.RowError, 0, len(operation.Result.RowErrors))
+		for _, rowError := range operation.Result.RowErrors {
+			rowErrors = append(rowErrors, &user_v1.RowError{
+				Row:   rowError.Row,
+				Error: rowError.Error,
+			})
+		}
+		res.Result = &user_v1.Operation_Response{Response: &user_v1.ImportUsersResult{
+			ImportedRows: operation.Result.ImportedRows,
+			FailedRows:   operation.Result.FailedRows,
+			RowErrors:    rowErrors,
+		}}
+	}
+
+	return res
+}
@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/organization_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToOrganizationV1FromService converts organization model to protobuf object
+func ToOrganizationV1FromService(org *model.Organization) *organization_v1.Organization {
+	if org == nil {
+		return &organization_v1.Organization{}
+	}
+
+	var updatedAt *timestamppb.Timestamp
+	if org.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(org.UpdatedAt.Time)
+	}
+
+	return &organization_v1.Organization{
+		Id:        org.ID,
+		Name:      org.Name,
+		CreatedAt: timestamppb.New(org.CreatedAt),
+		UpdatedAt: updatedAt,
+	}
+}
+
+// ToOrganizationsV1FromService converts organization models to protobuf objects
+func ToOrganizationsV1FromService(orgs []*model.Organization) []*organization_v1.Organization {
+	res := make([]*organization_v1.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		res = append(res, ToOrganizationV1FromService(org))
+	}
+
+	return res
+}
+
+// ToRoleFromCreateRoleV1 converts create role protobuf object to model
+func ToRoleFromCreateRoleV1(req *organization_v1.CreateRoleRequest) *model.Role {
+	if req == nil {
+		return &model.Role{}
+	}
+
+	return &model.Role{
+		TenantID:    req.GetOrganizationId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+}
+
+// ToRolesV1FromService converts role models to protobuf objects
+func ToRolesV1FromService(roles []*model.Role) []*organization_v1.Role {
+	res := make([]*organization_v1.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, &organization_v1.Role{
+			Id:             role.ID,
+			OrganizationId: role.TenantID,
+			Name:           role.Name,
+			Description:    role.Description,
+			CreatedAt:      timestamppb.New(role.CreatedAt),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/access_admin_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToPolicyBundleFormatFromV1 converts policy format protobuf enum to model,
+// unspecified format is YAML
+func ToPolicyBundleFormatFromV1(format access_admin_v1.PolicyFormat) string {
+	if format == access_admin_v1.PolicyFormat_JSON {
+		return model.PolicyBundleFormatJSON
+	}
+
+	return model.PolicyBundleFormatYAML
+}
+
+// ToApplyPolicyResponseV1FromService converts policy apply result to protobuf object
+func ToApplyPolicyResponseV1FromService(res *model.PolicyApplyResult,
+) *access_admin_v1.ApplyPolicyResponse {
+	if res == nil {
+		return &access_admin_v1.ApplyPolicyResponse{}
+	}
+
+	return &access_admin_v1.ApplyPolicyResponse{
+		Version: res.Version,
+		Diff:    toPolicyDiffV1FromService(res.Diff),
+	}
+}
+
+// ToPolicyVersionsV1FromService converts policy versions to protobuf objects
+func ToPolicyVersionsV1FromService(versions []*model.PolicyBundleVersion,
+) []*access_admin_v1.PolicyVersion {
+	res := make([]*access_admin_v1.PolicyVersion, 0, len(versions))
+	for _, version := range versions {
+		res = append(res, &access_admin_v1.PolicyVersion{
+			Version:   version.Version,
+			Author:    version.Author,
+			Action:    version.Action,
+			Diff:      toPolicyDiffV1FromService(version.Diff),
+			CreatedAt: timestamppb.New(version.CreatedAt),
+		})
+	}
+
+	return res
+}
+
+func toPolicyDiffV1FromService(diff *model.PolicyDiff) *access_admin_v1.PolicyDiff {
+	if diff == nil {
+		return &access_admin_v1.PolicyDiff{}
+	}
+
+	res := &access_admin_v1.PolicyDiff{
+		RemovedRoles:       diff.RemovedRoles,
+		RemovedPermissions: diff.RemovedPermissions,
+		AddedRoleParents:   toRoleParentsV1FromService(diff.AddedRoleParents),
+		RemovedRoleParents: toRoleParentsV1FromService(diff.RemovedRoleParents),
+		GrantedPermissions: toRolePermissionsV1FromService(diff.GrantedPermissions),
+		RevokedPermissions: toRolePermissionsV1FromService(diff.RevokedPermissions),
+		AddedRules:         ToRulesV1FromService(diff.AddedRules),
+		RemovedRules:       ToRulesV1FromService(diff.RemovedRules),
+	}
+	for _, role := range diff.AddedRoles {
+		res.AddedRoles = append(res.AddedRoles, role.Name)
+	}
+	for _, permission := range diff.AddedPermissions {
+		res.AddedPermissions = append(res.AddedPermissions, permission.Name)
+	}
+
+	return res
+}
+
+func toRoleParentsV1FromService(parents []*model.RoleParent) []*access_admin_v1.RoleParent {
+	res := make([]*access_admin_v1.RoleParent, 0, len(parents))
+	for _, parent := range parents {
+		res = append(res, &access_admin_v1.RoleParent{
+			Role:   parent.Role,
+			Parent: parent.Parent,
+		})
+	}
+
+	return res
+}
+
+func toRolePermissionsV1FromService(grants []*model.RolePermission,
+) []*access_admin_v1.RolePermission {
+	res := make([]*access_admin_v1.RolePermission, 0, len(grants))
+	for _, grant := range grants {
+		res = append(res, &access_admin_v1.RolePermission{
+			Role:       grant.Role,
+			Permission: grant.Permission,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,126 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/privacy_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToDataBundle collects all data stored about user into data bundle, login records are
+// also listed separately as login history
+func ToDataBundle(generatedAt time.Time, user *model.User, records []*model.Record,
+	invitations []*model.Invitation, grants []*model.RoleGrant,
+	codes []*model.OAuthAuthorizationCode, identities []*model.FederatedIdentity,
+) *model.DataBundle {
+	bundle := &model.DataBundle{
+		GeneratedAt: generatedAt,
+		Profile: model.DataProfile{
+			ID:             user.ID,
+			OrganizationID: user.TenantID,
+			Name:           user.Name,
+			Email:          user.Email,
+			Role:           user.Role,
+			Status:         user.Status,
+			CreatedAt:      user.CreatedAt,
+		},
+		AuditLog:           make([]model.DataRecord, 0, len(records)),
+		LoginHistory:       make([]model.DataRecord, 0),
+		Invitations:        make([]model.DataInvitation, 0, len(invitations)),
+		RoleGrants:         make([]model.DataRoleGrant, 0, len(grants)),
+		AuthorizationCodes: make([]model.DataAuthorizationCode, 0, len(codes)),
+		LinkedIdentities:   make([]model.DataLinkedIdentity, 0, len(identities)),
+	}
+	if user.UpdatedAt.Valid {
+		bundle.Profile.UpdatedAt = &user.UpdatedAt.Time
+	}
+
+	for _, record := range records {
+		dataRecord := model.DataRecord{
+			Action:    record.Action,
+			CreatedAt: record.CreatedAt,
+		}
+		bundle.AuditLog = append(bundle.AuditLog, dataRecord)
+		if record.Action == model.ActionLogin {
+			bundle.LoginHistory = append(bundle.LoginHistory, dataRecord)
+		}
+	}
+
+	for _, invitation := range invitations {
+		bundle.Invitations = append(bundle.Invitations, model.DataInvitation{
+			Email:     invitation.Email,
+			Status:    invitation.Status,
+			ExpiresAt: invitation.ExpiresAt,
+			CreatedAt: invitation.CreatedAt,
+		})
+	}
+
+	for _, grant := range grants {
+		bundle.RoleGrants = append(bundle.RoleGrants, model.DataRoleGrant{
+			Role:      grant.Role,
+			Reason:    grant.Reason,
+			Status:    grant.Status,
+			StartsAt:  grant.StartsAt,
+			ExpiresAt: grant.ExpiresAt,
+			CreatedAt: grant.CreatedAt,
+		})
+	}
+
+	for _, code := range codes {
+		bundle.AuthorizationCodes = append(bundle.AuthorizationCodes, model.DataAuthorizationCode{
+			ClientID:    code.ClientID,
+			Scope:       code.Scope,
+			RedirectURI: code.RedirectURI,
+			ExpiresAt:   code.ExpiresAt,
+			CreatedAt:   code.CreatedAt,
+		})
+	}
+
+	for _, identity := range identities {
+		bundle.LinkedIdentities = append(bundle.LinkedIdentities, model.DataLinkedIdentity{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	return bundle
+}
+
+// ToErasureRequestV1FromService converts erasure request model to protobuf object
+func ToErasureRequestV1FromService(request *model.ErasureRequest) *privacy_v1.ErasureRequest {
+	if request == nil {
+		return &privacy_v1.ErasureRequest{}
+	}
+
+	var updatedAt *timestamppb.Timestamp
+	if request.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(request.UpdatedAt.Time)
+	}
+
+	return &privacy_v1.ErasureRequest{
+		Id:          request.ID,
+		UserId:      request.UserID,
+		RequestedBy: request.RequestedBy,
+		ReviewedBy:  request.ReviewedBy.String,
+		Reason:      request.Reason,
+		Status:      toErasureStatusV1(request.Status),
+		CreatedAt:   timestamppb.New(request.CreatedAt),
+		UpdatedAt:   updatedAt,
+	}
+}
+
+func toErasureStatusV1(status string) privacy_v1.ErasureStatus {
+	switch status {
+	case model.ErasureStatusPending:
+		return privacy_v1.ErasureStatus_ERASURE_STATUS_PENDING
+	case model.ErasureStatusRejected:
+		return privacy_v1.ErasureStatus_ERASURE_STATUS_REJECTED
+	case model.ErasureStatusCompleted:
+		return privacy_v1.ErasureStatus_ERASURE_STATUS_COMPLETED
+	default:
+		return privacy_v1.ErasureStatus_ERASURE_STATUS_UNSPECIFIED
+	}
+}
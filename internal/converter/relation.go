@@ -0,0 +1,187 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/valek177/auth/grpc/pkg/relation_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToRelationNamespaceFromV1 converts namespace protobuf object to model
+func ToRelationNamespaceFromV1(namespace *relation_v1.Namespace) *model.RelationNamespace {
+	res := &model.RelationNamespace{
+		Name:      namespace.GetName(),
+		Relations: make(map[string]*model.RelationRewrite, len(namespace.GetRelations())),
+	}
+
+	for name, relation := range namespace.GetRelations() {
+		rewrite := &model.RelationRewrite{}
+		for _, rule := range relation.GetUnion() {
+			rewrite.Union = append(rewrite.Union, toRelationRewriteRuleFromV1(rule))
+		}
+		res.Relations[name] = rewrite
+	}
+
+	return res
+}
+
+func toRelationRewriteRuleFromV1(rule *relation_v1.RewriteRule) *model.RelationRewriteRule {
+	res := &model.RelationRewriteRule{
+		This:            rule.GetThis() != nil,
+		ComputedUserset: rule.GetComputedUserset(),
+	}
+	if ttu := rule.GetTupleToUserset(); ttu != nil {
+		res.TupleToUserset = &model.TupleToUserset{
+			Tupleset:        ttu.GetTupleset(),
+			ComputedUserset: ttu.GetComputedUserset(),
+		}
+	}
+
+	return res
+}
+
+// ToNamespacesFromService converts namespace models to protobuf objects
+func ToNamespacesFromService(namespaces []*model.RelationNamespace) []*relation_v1.Namespace {
+	res := make([]*relation_v1.Namespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		ns := &relation_v1.Namespace{
+			Name:      namespace.Name,
+			Relations: make(map[string]*relation_v1.Relation, len(namespace.Relations)),
+		}
+		for name, rewrite := range namespace.Relations {
+			relation := &relation_v1.Relation{}
+			if rewrite != nil {
+				for _, rule := range rewrite.Union {
+					relation.Union = append(relation.Union, toRewriteRuleFromService(rule))
+				}
+			}
+			ns.Relations[name] = relation
+		}
+		res = append(res, ns)
+	}
+
+	return res
+}
+
+func toRewriteRuleFromService(rule *model.RelationRewriteRule) *relation_v1.RewriteRule {
+	switch {
+	case rule.ComputedUserset != "":
+		return &relation_v1.RewriteRule{
+			Rule: &relation_v1.RewriteRule_ComputedUserset{ComputedUserset: rule.ComputedUserset},
+		}
+	case rule.TupleToUserset != nil:
+		return &relation_v1.RewriteRule{
+			Rule: &relation_v1.RewriteRule_TupleToUserset{
+				TupleToUserset: &relation_v1.TupleToUserset{
+					Tupleset:        rule.TupleToUserset.Tupleset,
+					ComputedUserset: rule.TupleToUserset.ComputedUserset,
+				},
+			},
+		}
+	default:
+		return &relation_v1.RewriteRule{
+			Rule: &relation_v1.RewriteRule_This{This: &emptypb.Empty{}},
+		}
+	}
+}
+
+func toRelationObjectFromV1(object *relation_v1.Object) model.RelationObject {
+	return model.RelationObject{
+		Namespace: object.GetNamespace(),
+		ID:        object.GetId(),
+	}
+}
+
+func toRelationSubjectFromV1(subject *relation_v1.Subject) model.RelationSubject {
+	return model.RelationSubject{
+		Namespace: subject.GetNamespace(),
+		ID:        subject.GetId(),
+		Relation:  subject.GetRelation(),
+	}
+}
+
+func toRelationSnapshotFromV1(consistency *relation_v1.Consistency) model.RelationSnapshot {
+	return model.RelationSnapshot{
+		Consistency: model.RelationConsistency(consistency.GetMode()),
+		Zookie:      consistency.GetZookie(),
+	}
+}
+
+func toRelationTuplesFromV1(tuples []*relation_v1.Tuple) []*model.RelationTuple {
+	res := make([]*model.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		res = append(res, &model.RelationTuple{
+			Object:   toRelationObjectFromV1(tuple.GetObject()),
+			Relation: tuple.GetRelation(),
+			Subject:  toRelationSubjectFromV1(tuple.GetSubject()),
+		})
+	}
+
+	return res
+}
+
+// ToRelationTupleWriteFromV1 converts write tuples protobuf object to model
+func ToRelationTupleWriteFromV1(req *relation_v1.WriteTuplesRequest) *model.RelationTupleWrite {
+	return &model.RelationTupleWrite{
+		Writes:  toRelationTuplesFromV1(req.GetWrites()),
+		Deletes: toRelationTuplesFromV1(req.GetDeletes()),
+	}
+}
+
+// ToRelationCheckFromV1 converts relation check protobuf object to model
+func ToRelationCheckFromV1(req *relation_v1.CheckRelationRequest) *model.RelationCheck {
+	return &model.RelationCheck{
+		Object:   toRelationObjectFromV1(req.GetObject()),
+		Relation: req.GetRelation(),
+		Subject:  toRelationSubjectFromV1(req.GetSubject()),
+		Snapshot: toRelationSnapshotFromV1(req.GetConsistency()),
+	}
+}
+
+// ToRelationExpandFromV1 converts relation expansion protobuf object to model
+func ToRelationExpandFromV1(req *relation_v1.ExpandRequest) *model.RelationExpand {
+	return &model.RelationExpand{
+		Object:   toRelationObjectFromV1(req.GetObject()),
+		Relation: req.GetRelation(),
+		Snapshot: toRelationSnapshotFromV1(req.GetConsistency()),
+	}
+}
+
+// ToRelationListObjectsFromV1 converts objects lookup protobuf object to model
+func ToRelationListObjectsFromV1(req *relation_v1.ListObjectsRequest,
+) *model.RelationListObjects {
+	return &model.RelationListObjects{
+		Namespace: req.GetNamespace(),
+		Relation:  req.GetRelation(),
+		Subject:   toRelationSubjectFromV1(req.GetSubject()),
+		Snapshot:  toRelationSnapshotFromV1(req.GetConsistency()),
+	}
+}
+
+// ToUsersetTreeFromService converts userset tree model to protobuf object
+func ToUsersetTreeFromService(tree *model.UsersetTree) *relation_v1.UsersetTree {
+	if tree == nil {
+		return nil
+	}
+
+	res := &relation_v1.UsersetTree{
+		Object: &relation_v1.Object{
+			Namespace: tree.Object.Namespace,
+			Id:        tree.Object.ID,
+		},
+		Relation:  tree.Relation,
+		Operation: tree.Operation,
+	}
+	for _, subject := range tree.Subjects {
+		res.Subjects = append(res.Subjects, &relation_v1.Subject{
+			Namespace: subject.Namespace,
+			Id:        subject.ID,
+			Relation:  subject.Relation,
+		})
+	}
+	for _, child := range tree.Children {
+		res.Children = append(res.Children, ToUsersetTreeFromService(child))
+	}
+
+	return res
+}
@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/valek177/auth/grpc/pkg/oauth_v1"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ToServiceAccountFromCreateServiceAccountV1 converts protobuf service account creation
+// to service model
+func ToServiceAccountFromCreateServiceAccountV1(
+	req *oauth_v1.CreateServiceAccountRequest,
+) *model.ServiceAccount {
+	if req == nil {
+		return &model.ServiceAccount{}
+	}
+
+	return &model.ServiceAccount{
+		Name:   req.GetName(),
+		Role:   req.GetRole(),
+		Scopes: req.GetScopes(),
+	}
+}
+
+// ToServiceAccountV1FromService converts service account to protobuf object
+func ToServiceAccountV1FromService(account *model.ServiceAccount) *oauth_v1.ServiceAccount {
+	if account == nil {
+		return &oauth_v1.ServiceAccount{}
+	}
+
+	return &oauth_v1.ServiceAccount{
+		ClientId:        account.ClientID,
+		Name:            account.Name,
+		Role:            account.Role,
+		Scopes:          account.Scopes,
+		Disabled:        account.Disabled,
+		SecretRotatedAt: timestamppb.New(account.SecretRotatedAt),
+		CreatedAt:       timestamppb.New(account.CreatedAt),
+	}
+}
+
+// ToServiceAccountsV1FromService converts service accounts to protobuf objects
+func ToServiceAccountsV1FromService(accounts []*model.ServiceAccount) []*oauth_v1.ServiceAccount {
+	res := make([]*oauth_v1.ServiceAccount, 0, len(accounts))
+	for _, account := range accounts {
+		res = append(res, ToServiceAccountV1FromService(account))
+	}
+
+	return res
+}
+
+// ToServiceAccountCredentialsV1FromService converts service account with client secret
+// to protobuf object
+func ToServiceAccountCredentialsV1FromService(
+	credentials *model.ServiceAccountCredentials,
+) *oauth_v1.ServiceAccountCredentials {
+	if credentials == nil {
+		return &oauth_v1.ServiceAccountCredentials{}
+	}
+
+	return &oauth_v1.ServiceAccountCredentials{
+		ServiceAccount: ToServiceAccountV1FromService(credentials.Account),
+		ClientSecret:   credentials.ClientSecret,
+	}
+}
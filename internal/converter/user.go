@@ -28,6 +28,16 @@ func ToUserV1FromService(user *model.User) *user_v1.User {
 	}
 }
 
+// ToUsersV1FromService converts user models to protobuf objects
+func ToUsersV1FromService(users []*model.User) []*user_v1.User {
+	res := make([]*user_v1.User, 0, len(users))
+	for _, user := range users {
+		res = append(res, ToUserV1FromService(user))
+	}
+
+	return res
+}
+
 // ToUserInfoFromService converts user info model to protobuf object
 func ToUserInfoFromService(user *model.User) *user_v1.UserInfo {
 	if user == nil {
@@ -35,9 +45,10 @@ func ToUserInfoFromService(user *model.User) *user_v1.UserInfo {
 	}
 
 	return &user_v1.UserInfo{
-		Name:  wrapperspb.String(user.Name),
-		Email: wrapperspb.String(user.Email),
-		Role:  user_v1.Role(user_v1.Role_value[user.Role]),
+		Name:     wrapperspb.String(user.Name),
+		Email:    wrapperspb.String(user.Email),
+		Role:     user_v1.Role(user_v1.Role_value[user.Role]),
+		RoleName: user.Role,
 	}
 }
 
@@ -49,12 +60,17 @@ func ToNewUserFromNewUserV1(req *user_v1.CreateUserRequest) *model.NewUser {
 
 	passHash, _ := password.HashPassword(req.Password)
 
+	role := req.Role.String()
+	if req.GetRoleName() != "" {
+		role = req.GetRoleName()
+	}
+
 	return &model.NewUser{
 		Name:            req.Name,
 		Email:           req.Email,
 		Password:        passHash,
 		PasswordConfirm: req.PasswordConfirm,
-		Role:            req.Role.String(),
+		Role:            role,
 	}
 }
 
@@ -71,7 +87,10 @@ func ToUpdateUserInfoFromV1(req *user_v1.UpdateUserRequest) *model.UpdateUserInf
 		ptrName = &str
 	}
 
-	if user_v1.Role_value[req.GetRole().String()] != 0 {
+	if req.GetRoleName() != "" {
+		str := req.GetRoleName()
+		ptrRole = &str
+	} else if user_v1.Role_value[req.GetRole().String()] != 0 {
 		str := req.GetRole().String()
 		ptrRole = &str
 	}
@@ -0,0 +1,281 @@
+package decision
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+)
+
+// Decider decides access of subject to endpoint by access rules matching it:
+// explicit deny of any matching rule takes precedence over allow and endpoint
+// without applicable allowing rule is denied
+type Decider struct {
+	permissionRepository repository.PermissionRepository
+	roleRepository       repository.RoleRepository
+	conditions           *condition.Evaluator
+}
+
+// New creates decider resolving roles and permissions of subjects in repositories
+func New(
+	permissionRepository repository.PermissionRepository,
+	roleRepository repository.RoleRepository,
+	conditions *condition.Evaluator,
+) *Decider {
+	return &Decider{
+		permissionRepository: permissionRepository,
+		roleRepository:       roleRepository,
+		conditions:           conditions,
+	}
+}
+
+// Subject is owner of claims, its permissions and roles are loaded once
+// and only if matching rules refer to them, so subject may be reused by several decisions
+type Subject struct {
+	claims      *model.UserClaims
+	permissions []string
+	roles       []string
+	// scoped reports whether scopes of service account token exclude some permission of its role
+	scoped bool
+}
+
+// NewSubject returns subject of claims
+func NewSubject(claims *model.UserClaims) *Subject {
+	return &Subject{claims: claims}
+}
+
+// Roles returns role of subject and roles it inherits, they must be loaded
+func (s *Subject) Roles() []string {
+	return s.roles
+}
+
+// Permissions returns effective permissions of subject, they must be loaded
+func (s *Subject) Permissions() []string {
+	return s.permissions
+}
+
+// Decide decides access of subject to endpoint with rules matching it,
+// context must be of subject tenant, attributes are used by conditions of rules.
+// Explained decision evaluates every rule and loads roles and permissions of subject.
+func (d *Decider) Decide(ctx context.Context, subj *Subject, rules *model.EndpointAccessRule,
+	attributes map[string]string, explain bool,
+) (*model.AccessDecision, error) {
+	claims := subj.claims
+
+	var explanation *model.AccessExplanation
+	if explain {
+		if err := d.LoadSubject(ctx, subj); err != nil {
+			return nil, err
+		}
+		explanation = &model.AccessExplanation{
+			UserID:      claims.UserID,
+			Username:    claims.Username,
+			Role:        claims.Role,
+			Roles:       subj.roles,
+			Permissions: subj.permissions,
+		}
+	}
+
+	if rules == nil {
+		return &model.AccessDecision{
+			Code:        model.AccessReasonNoMatchingRule,
+			Reason:      "no access rule matches endpoint",
+			Explanation: explanation,
+		}, nil
+	}
+
+	attrs := &condition.Attributes{
+		Claims:  claims,
+		Request: attributes,
+		Now:     time.Now(),
+	}
+
+	var decision *model.AccessDecision
+	for _, rule := range rules.Deny {
+		evaluation, err := d.evaluate(ctx, subj, rule, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if explanation != nil {
+			explanation.Rules = append(explanation.Rules, evaluation)
+		}
+
+		// condition of deny rule which can not be evaluated denies access
+		denies := evaluation.SubjectMatched &&
+			(evaluation.ConditionMatched || evaluation.ConditionError != "")
+		if decision == nil && denies {
+			decision = &model.AccessDecision{
+				Rule:   rule,
+				Code:   model.AccessReasonExplicitDeny,
+				Reason: fmt.Sprintf("explicit deny by rule %d", rule.ID),
+			}
+		}
+		if decision != nil && !explain {
+			return decision, nil
+		}
+	}
+
+	// allowing rules of the most specific pattern having rule of subject decide,
+	// rules of broader patterns apply to subject without such rule
+	subjectMatched := false
+	for _, allow := range append([][]*model.AccessRule{rules.Allow}, rules.BroaderAllow...) {
+		if (decision != nil || subjectMatched) && !explain {
+			break
+		}
+
+		patternMatched := false
+		for _, rule := range allow {
+			if decision != nil && !explain {
+				break
+			}
+
+			evaluation, err := d.evaluate(ctx, subj, rule, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if explanation != nil {
+				explanation.Rules = append(explanation.Rules, evaluation)
+			}
+			patternMatched = patternMatched || evaluation.SubjectMatched
+
+			// condition of allow rule which can not be evaluated, e.g. because of missing
+			// attribute, does not grant access
+			if decision == nil && !subjectMatched &&
+				evaluation.SubjectMatched && evaluation.ConditionMatched {
+				decision = &model.AccessDecision{
+					Allowed: true,
+					Rule:    rule,
+					Code:    model.AccessReasonAllowed,
+					Reason:  fmt.Sprintf("allowed by rule %d", rule.ID),
+				}
+			}
+		}
+		subjectMatched = subjectMatched || patternMatched
+	}
+
+	if decision == nil {
+		decision = &model.AccessDecision{
+			Code:   model.AccessReasonNoAllowingRule,
+			Reason: "no rule allows access",
+		}
+	}
+	decision.Explanation = explanation
+
+	return decision, nil
+}
+
+func (d *Decider) evaluate(ctx context.Context, subj *Subject, rule *model.AccessRule,
+	attrs *condition.Attributes,
+) (*model.AccessRuleEvaluation, error) {
+	applies, err := d.matchSubject(ctx, subj, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation := &model.AccessRuleEvaluation{
+		Rule:           rule,
+		SubjectMatched: applies,
+	}
+	if !applies {
+		return evaluation, nil
+	}
+
+	ok, err := d.conditions.Eval(rule.Condition, attrs)
+	if err != nil {
+		evaluation.ConditionError = err.Error()
+		return evaluation, nil
+	}
+	evaluation.ConditionMatched = ok
+
+	return evaluation, nil
+}
+
+// matchSubject reports whether rule applies to subject: rule of user applies to that user,
+// rule of role applies to users of role and of roles inheriting it and to service accounts
+// whose token scopes cover all permissions of role,
+// rule of permission applies to users whose role has permission and to service accounts
+// whose role has permission granted to token as scope
+func (d *Decider) matchSubject(ctx context.Context, subj *Subject, rule *model.AccessRule) (
+	bool, error,
+) {
+	switch {
+	case rule.UserID != 0:
+		return subj.claims.UserID == rule.UserID, nil
+	case rule.Role != "":
+		if err := d.loadRoles(ctx, subj); err != nil {
+			return false, err
+		}
+		if !slices.Contains(subj.roles, rule.Role) {
+			return false, nil
+		}
+
+		// rule of role grants access of the whole role, token with narrowed scopes
+		// gets access of its scopes only
+		if subj.claims.IsServiceAccount() {
+			if err := d.loadPermissions(ctx, subj); err != nil {
+				return false, err
+			}
+
+			return !subj.scoped, nil
+		}
+
+		return true, nil
+	default:
+		if err := d.loadPermissions(ctx, subj); err != nil {
+			return false, err
+		}
+
+		return slices.Contains(subj.permissions, rule.Permission), nil
+	}
+}
+
+// LoadSubject loads roles and permissions of subject
+func (d *Decider) LoadSubject(ctx context.Context, subj *Subject) error {
+	if err := d.loadRoles(ctx, subj); err != nil {
+		return err
+	}
+
+	return d.loadPermissions(ctx, subj)
+}
+
+func (d *Decider) loadRoles(ctx context.Context, subj *Subject) error {
+	if subj.roles != nil {
+		return nil
+	}
+
+	ancestors, err := d.roleRepository.ListAncestorRoles(ctx, subj.claims.Role)
+	if err != nil {
+		return err
+	}
+	subj.roles = append([]string{subj.claims.Role}, ancestors...)
+
+	return nil
+}
+
+func (d *Decider) loadPermissions(ctx context.Context, subj *Subject) error {
+	if subj.permissions != nil {
+		return nil
+	}
+
+	permissions, err := d.permissionRepository.ListEffectivePermissions(ctx, subj.claims.Role)
+	if err != nil {
+		return err
+	}
+
+	// scopes of service account token limit permissions of its role
+	if subj.claims.IsServiceAccount() {
+		scopes := subj.claims.Scopes()
+		granted := slices.DeleteFunc(slices.Clone(permissions), func(permission string) bool {
+			return !slices.Contains(scopes, permission)
+		})
+		subj.scoped = len(granted) < len(permissions)
+		permissions = granted
+	}
+	subj.permissions = append(make([]string, 0, len(permissions)), permissions...)
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+)
+
+func TestDecideDefaultAccessRules(t *testing.T) {
+	t.Parallel()
+
+	rules := make([]*model.AccessRule, 0, len(model.DefaultAccessRules))
+	for i, rule := range model.DefaultAccessRules {
+		rule := *rule
+		rule.ID = int64(i + 1)
+		rules = append(rules, &rule)
+	}
+	m, err := matcher.New(rules)
+	require.NoError(t, err)
+
+	conditions, err := condition.NewEvaluator(1000)
+	require.NoError(t, err)
+
+	ancestors := map[string][]string{
+		model.RoleAdmin: {model.RoleUser},
+		model.RoleUser:  {},
+	}
+
+	tests := []struct {
+		name     string
+		role     string
+		endpoint string
+		id       string
+		want     bool
+	}{
+		{
+			name:     "success case: admin gets another user",
+			role:     model.RoleAdmin,
+			endpoint: "/user_v1.UserV1/GetUser",
+			id:       "8",
+			want:     true,
+		},
+		{
+			name:     "success case: admin gets own profile",
+			role:     model.RoleAdmin,
+			endpoint: "/user_v1.UserV1/GetUser",
+			id:       "7",
+			want:     true,
+		},
+		{
+			name:     "success case: admin deletes user",
+			role:     model.RoleAdmin,
+			endpoint: "/user_v1.UserV1/DeleteUser",
+			id:       "8",
+			want:     true,
+		},
+		{
+			name:     "success case: user gets own profile",
+			role:     model.RoleUser,
+			endpoint: "/user_v1.UserV1/GetUser",
+			id:       "7",
+			want:     true,
+		},
+		{
+			name:     "success case: user can't get another user",
+			role:     model.RoleUser,
+			endpoint: "/user_v1.UserV1/GetUser",
+			id:       "8",
+			want:     false,
+		},
+		{
+			name:     "success case: user can't delete user",
+			role:     model.RoleUser,
+			endpoint: "/user_v1.UserV1/DeleteUser",
+			id:       "8",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := minimock.NewController(t)
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListAncestorRolesMock.Return(ancestors[tt.role], nil)
+			decider := decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock,
+				conditions)
+
+			subj := decision.NewSubject(&model.UserClaims{
+				UserID:   7,
+				Username: "alice",
+				Role:     tt.role,
+				TenantID: 4,
+			})
+			decided, err := decider.Decide(context.Background(), subj, m.Match(tt.endpoint),
+				map[string]string{"id": tt.id}, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decided.Allowed, decided.Reason)
+		})
+	}
+}
+
+func TestDecideBroaderPatterns(t *testing.T) {
+	t.Parallel()
+
+	m, err := matcher.New([]*model.AccessRule{
+		{ID: 1, Effect: model.AccessEffectAllow, Role: model.RoleAdmin, Endpoint: "/svc"},
+		{ID: 2, Effect: model.AccessEffectAllow, Role: model.RoleUser, Endpoint: "/svc/Method"},
+		{ID: 3, Effect: model.AccessEffectAllow, Role: model.RoleUser, Endpoint: "/other"},
+		{ID: 4, Effect: model.AccessEffectAllow, Role: model.RoleUser, Endpoint: "/other/Method",
+			Condition: "request.id == string(claims.user_id)"},
+	})
+	require.NoError(t, err)
+
+	conditions, err := condition.NewEvaluator(1000)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		role     string
+		endpoint string
+		id       string
+		rule     int64
+		want     bool
+	}{
+		{
+			name:     "success case: admin calls method by service rule",
+			role:     model.RoleAdmin,
+			endpoint: "/svc/Method",
+			rule:     1,
+			want:     true,
+		},
+		{
+			name:     "success case: user calls method by method rule",
+			role:     model.RoleUser,
+			endpoint: "/svc/Method",
+			rule:     2,
+			want:     true,
+		},
+		{
+			name:     "success case: user can't call another method of service",
+			role:     model.RoleUser,
+			endpoint: "/svc/Other",
+		},
+		{
+			name:     "success case: condition of method rule is not bypassed by service rule",
+			role:     model.RoleUser,
+			endpoint: "/other/Method",
+			id:       "8",
+		},
+		{
+			name:     "success case: method rule with condition",
+			role:     model.RoleUser,
+			endpoint: "/other/Method",
+			id:       "7",
+			rule:     4,
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := minimock.NewController(t)
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListAncestorRolesMock.Return(nil, nil)
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			permissionMock.ListEffectivePermissionsMock.Optional().Return(nil, nil)
+			decider := decision.New(permissionMock, roleMock, conditions)
+
+			subj := decision.NewSubject(&model.UserClaims{
+				UserID:   7,
+				Username: "alice",
+				Role:     tt.role,
+				TenantID: 4,
+			})
+			for _, explain := range []bool{false, true} {
+				decided, err := decider.Decide(context.Background(), subj, m.Match(tt.endpoint),
+					map[string]string{"id": tt.id}, explain)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, decided.Allowed, decided.Reason)
+				if tt.want {
+					assert.Equal(t, tt.rule, decided.Rule.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestDecideServiceAccountScopes(t *testing.T) {
+	t.Parallel()
+
+	rules := make([]*model.AccessRule, 0, len(model.DefaultAccessRules))
+	for i, rule := range model.DefaultAccessRules {
+		rule := *rule
+		rule.ID = int64(i + 1)
+		rules = append(rules, &rule)
+	}
+	m, err := matcher.New(rules)
+	require.NoError(t, err)
+
+	conditions, err := condition.NewEvaluator(1000)
+	require.NoError(t, err)
+
+	permissions := []string{model.PermissionElevationApprove, model.PermissionSCIMProvision}
+
+	tests := []struct {
+		name     string
+		scope    string
+		endpoint string
+		want     bool
+	}{
+		{
+			name:     "success case: token with all scopes of role uses rule of role",
+			scope:    model.PermissionElevationApprove + " " + model.PermissionSCIMProvision,
+			endpoint: "/user_v1.UserV1/DeleteUser",
+			want:     true,
+		},
+		{
+			name:     "success case: token with narrowed scopes can't use rule of role",
+			scope:    model.PermissionSCIMProvision,
+			endpoint: "/user_v1.UserV1/DeleteUser",
+			want:     false,
+		},
+		{
+			name:     "success case: token without scopes can't use rule of role",
+			endpoint: "/access_admin_v1.AccessAdminV1/CreateRule",
+			want:     false,
+		},
+		{
+			name:     "success case: token with narrowed scopes uses rule of its scope",
+			scope:    model.PermissionSCIMProvision,
+			endpoint: "POST /scim/v2/Users",
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := minimock.NewController(t)
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListAncestorRolesMock.Optional().Return([]string{model.RoleUser}, nil)
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			permissionMock.ListEffectivePermissionsMock.Return(permissions, nil)
+			decider := decision.New(permissionMock, roleMock, conditions)
+
+			subj := decision.NewSubject(&model.UserClaims{
+				Username: model.ServiceAccountPrincipal("robot"),
+				Role:     model.RoleAdmin,
+				TenantID: 4,
+				Scope:    tt.scope,
+			})
+			decided, err := decider.Decide(context.Background(), subj, m.Match(tt.endpoint),
+				map[string]string{}, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decided.Allowed, decided.Reason)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+type key string
+
+const identityKey key = "identity"
+
+// NewContext returns context with claims of authenticated caller
+func NewContext(ctx context.Context, claims *model.UserClaims) context.Context {
+	return context.WithValue(ctx, identityKey, claims)
+}
+
+// FromContext returns claims of authenticated caller, ok is false for anonymous caller
+func FromContext(ctx context.Context) (*model.UserClaims, bool) {
+	claims, ok := ctx.Value(identityKey).(*model.UserClaims)
+
+	return claims, ok && claims != nil
+}
@@ -0,0 +1,128 @@
+package interceptor
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/utils"
+)
+
+// idAttribute is request attribute of access rule conditions with ID of requested object
+const idAttribute = "id"
+
+// AuthInterceptor is a struct for authentication and authorization interceptor
+type AuthInterceptor struct {
+	accessService service.AccessService
+	protected     []string
+	public        map[string]bool
+}
+
+// NewAuthInterceptor creates interceptor enforcing access rules on methods of protected
+// services, e.g. /user_v1.UserV1/, except public methods
+func NewAuthInterceptor(accessService service.AccessService, protected []string,
+	public []string,
+) *AuthInterceptor {
+	publicMethods := make(map[string]bool, len(public))
+	for _, method := range public {
+		publicMethods[method] = true
+	}
+
+	return &AuthInterceptor{
+		accessService: accessService,
+		protected:     protected,
+		public:        publicMethods,
+	}
+}
+
+// Unary authorizes caller by bearer token and puts its identity into context,
+// ID of requested object is available to conditions of access rules as request.id
+func (a *AuthInterceptor) Unary(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod, requestAttributes(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// Stream authorizes caller of streaming method, see Unary
+func (a *AuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+
+	wrapped := grpcMiddleware.WrapServerStream(ss)
+	wrapped.WrappedContext = ctx
+
+	return handler(srv, wrapped)
+}
+
+// authorize returns context with identity of caller allowed to call method,
+// caller of public method is anonymous unless it is allowed to call method by token
+func (a *AuthInterceptor) authorize(ctx context.Context, method string,
+	attributes map[string]string,
+) (context.Context, error) {
+	if !a.isProtected(method) {
+		return ctx, nil
+	}
+	public := a.public[method]
+
+	token, err := utils.BearerToken(ctx)
+	if err != nil {
+		if public {
+			return ctx, nil
+		}
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	authorization, err := a.accessService.Authorize(ctx, token, method, attributes)
+	switch {
+	case public && (err != nil || !authorization.Decision.Allowed):
+		return ctx, nil
+	case errors.Is(err, model.ErrorUnauthenticated):
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	case err != nil:
+		return nil, err
+	case !authorization.Decision.Allowed:
+		return nil, status.Error(codes.PermissionDenied,
+			"access denied: "+authorization.Decision.Reason)
+	}
+
+	return identity.NewContext(ctx, authorization.Claims), nil
+}
+
+func (a *AuthInterceptor) isProtected(method string) bool {
+	for _, prefix := range a.protected {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestAttributes returns attributes of request for conditions of access rules
+func requestAttributes(req interface{}) map[string]string {
+	withID, ok := req.(interface{ GetId() int64 })
+	if !ok {
+		return nil
+	}
+
+	return map[string]string{idAttribute: strconv.FormatInt(withID.GetId(), 10)}
+}
@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+)
+
+const (
+	tenantHeaderName = "x-tenant-id"
+	authHeaderName   = "authorization"
+	authPrefix       = "Bearer "
+)
+
+// TenantInterceptor is a struct for tenant interceptor
+type TenantInterceptor struct {
+	tokenAccess utils.Token
+}
+
+// NewTenantInterceptor creates new tenant interceptor
+func NewTenantInterceptor(tokenAccess utils.Token) *TenantInterceptor {
+	return &TenantInterceptor{tokenAccess: tokenAccess}
+}
+
+// Unary puts tenant of request into context. Tenant is taken from access token
+// claims, x-tenant-id header or default tenant, in that order.
+func (t *TenantInterceptor) Unary(ctx context.Context, req interface{},
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	tenantID, err := t.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(tenant.NewContext(ctx, tenantID), req)
+}
+
+// Stream puts tenant of streaming request into context, see Unary
+func (t *TenantInterceptor) Stream(srv interface{}, ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	tenantID, err := t.resolveTenant(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	wrapped := grpcMiddleware.WrapServerStream(ss)
+	wrapped.WrappedContext = tenant.NewContext(ss.Context(), tenantID)
+
+	return handler(srv, wrapped)
+}
+
+func (t *TenantInterceptor) resolveTenant(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return model.DefaultTenantID, nil
+	}
+
+	var headerTenantID int64
+	if values := md.Get(tenantHeaderName); len(values) > 0 {
+		id, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil || id <= 0 {
+			return 0, status.Error(codes.InvalidArgument, "invalid tenant id")
+		}
+		headerTenantID = id
+	}
+
+	if values := md.Get(authHeaderName); len(values) > 0 && strings.HasPrefix(values[0], authPrefix) {
+		claims, err := t.tokenAccess.VerifyToken(ctx, strings.TrimPrefix(values[0], authPrefix))
+		if err == nil && claims.TenantID != 0 {
+			if headerTenantID != 0 && headerTenantID != claims.TenantID {
+				return 0, status.Error(codes.PermissionDenied, "token was issued for another tenant")
+			}
+
+			return claims.TenantID, nil
+		}
+	}
+
+	if headerTenantID != 0 {
+		return headerTenantID, nil
+	}
+
+	return model.DefaultTenantID, nil
+}
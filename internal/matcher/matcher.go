@@ -0,0 +1,347 @@
+package matcher
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// Endpoint patterns of access rules:
+//
+//	/user_v1.UserV1/Get        gRPC method
+//	/chat_v1.ChatV1            every method of gRPC service
+//	/chat_v1.ChatV1/*          glob, * matches any characters of one segment
+//	GET /user/v1/{id}          HTTP method and path template, {id} matches one segment
+//	* /files/**                any HTTP method, ** matches any number of segments
+//
+// Endpoints which are checked have the same form without wildcards,
+// e.g. /user_v1.UserV1/Get or GET /user/v1/5.
+
+const (
+	anyMethod   = "*"
+	anySegments = "**"
+)
+
+type segmentKind int
+
+const (
+	segmentLiteral segmentKind = iota
+	segmentGlob
+	segmentParam
+	segmentAny
+)
+
+type segment struct {
+	kind  segmentKind
+	value string
+}
+
+// pattern is compiled endpoint pattern with all rules using it
+type pattern struct {
+	// key is normalized pattern, e.g. /chat_v1.ChatV1 and /chat_v1.ChatV1/* have same key
+	key      string
+	method   string
+	segments []segment
+	allow    []*model.AccessRule
+	deny     []*model.AccessRule
+
+	exact        bool
+	literals     int
+	globLiterals int
+	wildcards    int
+	anySegments  int
+}
+
+// Matcher finds access rules of endpoint: allowing rules of every pattern grouped by
+// pattern from the most specific and denying rules of every pattern,
+// so broad deny overrides specific allow
+type Matcher struct {
+	// byFirst contains patterns starting with literal segment,
+	// others are in generic, both lists are sorted from most specific
+	byFirst  map[string][]*pattern
+	generic  []*pattern
+	rank     map[*pattern]int
+	patterns []*pattern
+}
+
+// ValidatePattern returns error if endpoint pattern can not be compiled
+func ValidatePattern(raw string) error {
+	_, err := compile(raw)
+
+	return err
+}
+
+// New compiles access rules into matcher
+func New(rules []*model.AccessRule) (*Matcher, error) {
+	patterns := make(map[string]*pattern, len(rules))
+	for _, rule := range rules {
+		compiled, err := compile(rule.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		p, ok := patterns[compiled.key]
+		if !ok {
+			p = compiled
+			patterns[p.key] = p
+		}
+		if rule.Effect == model.AccessEffectDeny {
+			p.deny = append(p.deny, rule)
+			continue
+		}
+		p.allow = append(p.allow, rule)
+	}
+
+	sorted := make([]*pattern, 0, len(patterns))
+	for _, p := range patterns {
+		sortRules(p.allow)
+		sortRules(p.deny)
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return moreSpecific(sorted[i], sorted[j])
+	})
+
+	m := &Matcher{
+		byFirst:  make(map[string][]*pattern),
+		rank:     make(map[*pattern]int, len(sorted)),
+		patterns: sorted,
+	}
+	for i, p := range sorted {
+		m.rank[p] = i
+		if len(p.segments) > 0 && p.segments[0].kind == segmentLiteral {
+			m.byFirst[p.segments[0].value] = append(m.byFirst[p.segments[0].value], p)
+			continue
+		}
+		m.generic = append(m.generic, p)
+	}
+
+	return m, nil
+}
+
+// Match returns access rules of endpoint, it returns nil if no pattern matches
+func (m *Matcher) Match(endpoint string) *model.EndpointAccessRule {
+	method, segments, err := split(endpoint)
+	if err != nil {
+		return nil
+	}
+
+	var matched []*pattern
+	if len(segments) > 0 {
+		matched = appendMatching(matched, m.byFirst[segments[0]], method, segments)
+	}
+	matched = appendMatching(matched, m.generic, method, segments)
+	if len(matched) == 0 {
+		return nil
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return m.rank[matched[i]] < m.rank[matched[j]]
+	})
+
+	res := &model.EndpointAccessRule{Endpoint: endpoint}
+	for _, p := range matched {
+		switch {
+		case len(p.allow) == 0:
+		case res.Allow == nil:
+			res.Allow = p.allow
+		default:
+			res.BroaderAllow = append(res.BroaderAllow, p.allow)
+		}
+		res.Deny = append(res.Deny, p.deny...)
+	}
+	sortRules(res.Deny)
+
+	return res
+}
+
+// Patterns returns normalized endpoint patterns of rules from most specific,
+// every pattern matches itself as endpoint, e.g. /chat_v1.ChatV1/* matches /chat_v1.ChatV1/*
+func (m *Matcher) Patterns() []string {
+	res := make([]string, 0, len(m.patterns))
+	for _, p := range m.patterns {
+		res = append(res, p.key)
+	}
+
+	return res
+}
+
+func appendMatching(matched, patterns []*pattern, method string, segments []string) []*pattern {
+	for _, p := range patterns {
+		if p.match(method, segments) {
+			matched = append(matched, p)
+		}
+	}
+
+	return matched
+}
+
+func sortRules(rules []*model.AccessRule) {
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+}
+
+// moreSpecific orders patterns: exact, more literal segments, more literal characters
+// in globs, fewer ** segments, fewer wildcards, explicit HTTP method, then by text
+func moreSpecific(a, b *pattern) bool {
+	if a.exact != b.exact {
+		return a.exact
+	}
+	if a.literals != b.literals {
+		return a.literals > b.literals
+	}
+	if a.globLiterals != b.globLiterals {
+		return a.globLiterals > b.globLiterals
+	}
+	if a.anySegments != b.anySegments {
+		return a.anySegments < b.anySegments
+	}
+	if a.wildcards != b.wildcards {
+		return a.wildcards < b.wildcards
+	}
+	if (a.method == anyMethod) != (b.method == anyMethod) {
+		return b.method == anyMethod
+	}
+
+	return a.key < b.key
+}
+
+// split splits endpoint into HTTP method (empty for gRPC) and path segments
+func split(endpoint string) (string, []string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+
+	method := ""
+	if idx := strings.IndexByte(endpoint, ' '); idx >= 0 {
+		method = strings.ToUpper(endpoint[:idx])
+		endpoint = strings.TrimSpace(endpoint[idx+1:])
+		if idx = strings.IndexAny(endpoint, "?#"); idx >= 0 {
+			endpoint = endpoint[:idx]
+		}
+	}
+
+	if !strings.HasPrefix(endpoint, "/") {
+		return "", nil, errors.Errorf("endpoint %q must start with /", endpoint)
+	}
+
+	endpoint = strings.Trim(endpoint, "/")
+	if endpoint == "" {
+		return method, nil, nil
+	}
+
+	return method, strings.Split(endpoint, "/"), nil
+}
+
+func compile(raw string) (*pattern, error) {
+	method, parts, err := split(raw)
+	if err != nil {
+		return nil, err
+	}
+	if method == "" && len(parts) == 1 {
+		// rule of gRPC service covers all its methods
+		parts = append(parts, "*")
+	}
+
+	p := &pattern{
+		key:      strings.TrimSpace(method + " /" + strings.Join(parts, "/")),
+		method:   method,
+		segments: make([]segment, 0, len(parts)),
+		exact:    true,
+	}
+	for _, part := range parts {
+		s, errSegment := compileSegment(part)
+		if errSegment != nil {
+			return nil, errors.Wrapf(errSegment, "invalid endpoint pattern %q", raw)
+		}
+		p.segments = append(p.segments, s)
+
+		switch s.kind {
+		case segmentLiteral:
+			p.literals++
+		case segmentGlob:
+			p.globLiterals += len(strings.ReplaceAll(part, "*", ""))
+			p.wildcards++
+			p.exact = false
+		case segmentParam:
+			p.wildcards++
+			p.exact = false
+		case segmentAny:
+			p.anySegments++
+			p.exact = false
+		}
+	}
+	if method == anyMethod {
+		p.exact = false
+	}
+
+	return p, nil
+}
+
+func compileSegment(part string) (segment, error) {
+	switch {
+	case part == "":
+		return segment{}, errors.New("empty path segment")
+	case part == anySegments:
+		return segment{kind: segmentAny}, nil
+	case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+		if len(part) == 2 {
+			return segment{}, errors.New("empty path parameter name")
+		}
+		return segment{kind: segmentParam, value: part[1 : len(part)-1]}, nil
+	case strings.ContainsAny(part, "{}"):
+		return segment{}, errors.Errorf("path parameter %q must be whole segment", part)
+	case strings.Contains(part, anySegments):
+		return segment{}, errors.New("** must be whole segment")
+	case strings.ContainsAny(part, "*?["):
+		if _, err := path.Match(part, ""); err != nil {
+			return segment{}, errors.Errorf("bad glob %q", part)
+		}
+		return segment{kind: segmentGlob, value: part}, nil
+	default:
+		return segment{kind: segmentLiteral, value: part}, nil
+	}
+}
+
+func (p *pattern) match(method string, segments []string) bool {
+	// gRPC patterns match gRPC endpoints only, HTTP patterns match HTTP endpoints only
+	if (p.method == "") != (method == "") {
+		return false
+	}
+	if p.method != "" && p.method != anyMethod && p.method != method {
+		return false
+	}
+
+	return matchSegments(p.segments, segments)
+}
+
+func matchSegments(pattern []segment, segments []string) bool {
+	for i, s := range pattern {
+		if s.kind == segmentAny {
+			for j := i; j <= len(segments); j++ {
+				if matchSegments(pattern[i+1:], segments[j:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if i >= len(segments) {
+			return false
+		}
+
+		switch s.kind {
+		case segmentLiteral:
+			if s.value != segments[i] {
+				return false
+			}
+		case segmentGlob:
+			if ok, _ := path.Match(s.value, segments[i]); !ok {
+				return false
+			}
+		}
+	}
+
+	return len(pattern) == len(segments)
+}
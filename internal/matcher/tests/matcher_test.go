@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	rules := []*model.AccessRule{
+		{ID: 1, Endpoint: "/user_v1.UserV1/Get", Permission: "user:read"},
+		{ID: 2, Endpoint: "/user_v1.UserV1/*", Permission: "user:admin"},
+		{ID: 3, Endpoint: "/user_v1.UserV1/Batch*", Permission: "user:batch"},
+		{ID: 4, Endpoint: "/chat_v1.ChatV1", Permission: "chat:use"},
+		{ID: 5, Endpoint: "/chat_v1.ChatV1/*", Permission: "chat:all"},
+		{ID: 6, Endpoint: "GET /user/v1/{id}", Permission: "user:read"},
+		{ID: 7, Endpoint: "GET /user/v1/by_name/{name}", Permission: "user:lookup"},
+		{ID: 8, Endpoint: "* /user/v1/{id}", Permission: "user:write"},
+		{ID: 9, Endpoint: "GET /files/**", Permission: "files:read"},
+		{ID: 10, Endpoint: "GET /files/public/**", Permission: "files:public"},
+		{ID: 11, Endpoint: "/user_v1.UserV1/*", Role: "SUSPENDED",
+			Effect: model.AccessEffectDeny},
+		{ID: 12, Endpoint: "/user_v1.UserV1/Get", UserID: 9, Effect: model.AccessEffectDeny},
+		{ID: 13, Endpoint: "/audit_v1.AuditV1/*", UserID: 3, Effect: model.AccessEffectDeny},
+	}
+
+	m, err := matcher.New(rules)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		permissions []string
+		deny        []int64
+	}{
+		{
+			name:        "exact gRPC method wins over glob, deny rules of both apply",
+			endpoint:    "/user_v1.UserV1/Get",
+			permissions: []string{"user:read"},
+			deny:        []int64{11, 12},
+		},
+		{
+			name:        "glob with literal prefix wins over *",
+			endpoint:    "/user_v1.UserV1/BatchGetUsers",
+			permissions: []string{"user:batch"},
+			deny:        []int64{11},
+		},
+		{
+			name:        "service glob",
+			endpoint:    "/user_v1.UserV1/Delete",
+			permissions: []string{"user:admin"},
+			deny:        []int64{11},
+		},
+		{
+			name:        "service rule and service glob are same pattern",
+			endpoint:    "/chat_v1.ChatV1/Send",
+			permissions: []string{"chat:use", "chat:all"},
+		},
+		{
+			name:     "pattern with deny rules only",
+			endpoint: "/audit_v1.AuditV1/List",
+			deny:     []int64{13},
+		},
+		{
+			name:        "HTTP path parameter",
+			endpoint:    "GET /user/v1/5?fields=name",
+			permissions: []string{"user:read"},
+		},
+		{
+			name:        "HTTP literal segment wins over parameter",
+			endpoint:    "GET /user/v1/by_name/alice",
+			permissions: []string{"user:lookup"},
+		},
+		{
+			name:        "HTTP any method",
+			endpoint:    "delete /user/v1/5",
+			permissions: []string{"user:write"},
+		},
+		{
+			name:        "longer ** prefix wins",
+			endpoint:    "GET /files/public/a/b.png",
+			permissions: []string{"files:public"},
+		},
+		{
+			name:        "** matches nested path",
+			endpoint:    "GET /files/private/a/b.png",
+			permissions: []string{"files:read"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := m.Match(tt.endpoint)
+
+			require.NotNil(t, rule)
+			var permissions []string
+			for _, allow := range rule.Allow {
+				permissions = append(permissions, allow.Permission)
+			}
+			var deny []int64
+			for _, d := range rule.Deny {
+				deny = append(deny, d.ID)
+			}
+			assert.Equal(t, tt.permissions, permissions)
+			assert.Equal(t, tt.deny, deny)
+		})
+	}
+
+	for _, endpoint := range []string{
+		"/user_v1.UserV1",
+		"/auth_v1.AuthV1/Login",
+		"POST /files/a",
+		"GET /user/v1/5/extra",
+		"user_v1.UserV1/Get",
+	} {
+		assert.Nil(t, m.Match(endpoint), endpoint)
+	}
+}
+
+func TestMatchBroaderAllow(t *testing.T) {
+	t.Parallel()
+
+	m, err := matcher.New([]*model.AccessRule{
+		{ID: 1, Endpoint: "/svc", Role: model.RoleAdmin},
+		{ID: 2, Endpoint: "/svc/Method", Role: model.RoleUser},
+		{ID: 3, Endpoint: "/svc/Meth*", Permission: "svc:use"},
+		{ID: 4, Endpoint: "/svc/*", Role: "SUSPENDED", Effect: model.AccessEffectDeny},
+	})
+	require.NoError(t, err)
+
+	rule := m.Match("/svc/Method")
+	require.NotNil(t, rule)
+	assert.Equal(t, []*model.AccessRule{{ID: 2, Endpoint: "/svc/Method", Role: model.RoleUser}},
+		rule.Allow)
+	require.Len(t, rule.BroaderAllow, 2)
+	assert.Equal(t, int64(3), rule.BroaderAllow[0][0].ID)
+	assert.Equal(t, int64(1), rule.BroaderAllow[1][0].ID)
+	require.Len(t, rule.Deny, 1)
+}
+
+func TestValidatePattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{
+		"/user_v1.UserV1/Get",
+		"/chat_v1.ChatV1",
+		"GET /user/v1/{id}",
+		"* /files/**",
+	} {
+		assert.NoError(t, matcher.ValidatePattern(pattern), pattern)
+	}
+
+	for _, pattern := range []string{
+		"user_v1.UserV1/Get",
+		"GET /user/v1/{}",
+		"GET /user/v1/id-{id}",
+		"/user_v1.UserV1/a**",
+		"/user_v1.UserV1/[",
+		"GET /user//v1",
+	} {
+		assert.Error(t, matcher.ValidatePattern(pattern), pattern)
+	}
+}
+
+func TestPatterns(t *testing.T) {
+	t.Parallel()
+
+	m, err := matcher.New([]*model.AccessRule{
+		{ID: 1, Endpoint: "/chat_v1.ChatV1", Permission: "chat:use"},
+		{ID: 2, Endpoint: "/user_v1.UserV1/Get", Permission: "user:read"},
+		{ID: 3, Endpoint: "* /files/**", Permission: "files:read"},
+		{ID: 4, Endpoint: "GET /user/v1/{id}", Permission: "user:read"},
+		{ID: 5, Endpoint: "/chat_v1.ChatV1/*", Role: "SUSPENDED",
+			Effect: model.AccessEffectDeny},
+	})
+	require.NoError(t, err)
+
+	patterns := m.Patterns()
+	assert.Equal(t, []string{
+		"/user_v1.UserV1/Get",
+		"GET /user/v1/{id}",
+		"/chat_v1.ChatV1/*",
+		"* /files/**",
+	}, patterns)
+
+	// every pattern matches itself as endpoint
+	for _, pattern := range patterns {
+		rule := m.Match(pattern)
+		require.NotNil(t, rule, pattern)
+		assert.NotEmpty(t, rule.Allow, pattern)
+	}
+}
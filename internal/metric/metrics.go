@@ -2,6 +2,7 @@ package metric
 
 import (
 	"context"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -70,3 +71,39 @@ func IncResponseCounter(status string, method string) {
 func HistogramResponseTimeObserve(status string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(status).Observe(time)
 }
+
+// PolicySnapshot is source of in-memory snapshot of access policies
+type PolicySnapshot interface {
+	Version() int64
+	SyncedAt() time.Time
+}
+
+// RegisterPolicySnapshot registers metrics of version and age of policy snapshot
+func RegisterPolicySnapshot(snapshot PolicySnapshot) {
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "policy",
+			Name:      appName + "_snapshot_version",
+			Help:      "Версия загруженного снимка политик доступа",
+		},
+		func() float64 {
+			return float64(snapshot.Version())
+		},
+	)
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "policy",
+			Name:      appName + "_snapshot_age_seconds",
+			Help:      "Время с последней синхронизации снимка политик доступа",
+		},
+		func() float64 {
+			syncedAt := snapshot.SyncedAt()
+			if syncedAt.IsZero() {
+				return -1
+			}
+			return time.Since(syncedAt).Seconds()
+		},
+	)
+}
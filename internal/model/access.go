@@ -1,7 +1,197 @@
 package model
 
-// EndpointAccessRule is a model for access rule of endpoint
+import (
+	"database/sql"
+	"time"
+)
+
+// Effects of access rules, explicit deny takes precedence over allow
+// and endpoint without allowing rule is denied
+const (
+	AccessEffectAllow = "allow"
+	AccessEffectDeny  = "deny"
+)
+
+// EndpointAccessRule is a model for access rules matching endpoint
 type EndpointAccessRule struct {
 	Endpoint string
-	Roles    []string
+	// Allow are allowing rules of the most specific pattern having them
+	Allow []*AccessRule
+	// BroaderAllow are allowing rules of less specific patterns grouped by pattern
+	// from the most specific, they apply to subject to whom no rule of Allow applies
+	BroaderAllow [][]*AccessRule
+	// Deny are denying rules of every pattern matching endpoint
+	Deny []*AccessRule
+}
+
+// AccessRule is a model for rule allowing or denying endpoint to subject,
+// subject is exactly one of permission, role and user
+type AccessRule struct {
+	ID         int64        `json:"id"`
+	TenantID   int64        `json:"-"`
+	Effect     string       `json:"effect"`
+	Permission string       `json:"permission,omitempty"`
+	Role       string       `json:"role,omitempty"`
+	UserID     int64        `json:"user_id,omitempty"`
+	Endpoint   string       `json:"endpoint"`
+	Condition  string       `json:"condition,omitempty"`
+	CreatedAt  time.Time    `json:"-"`
+	UpdatedAt  sql.NullTime `json:"-"`
+}
+
+// Reason codes of access decisions
+const (
+	AccessReasonAllowed        = "allowed"
+	AccessReasonNoMatchingRule = "no_matching_rule"
+	AccessReasonExplicitDeny   = "explicit_deny"
+	AccessReasonNoAllowingRule = "no_allowing_rule"
+)
+
+// AccessDecision is a model for result of access check with rule which decided it
+type AccessDecision struct {
+	Allowed bool
+	// Rule is nil when no rule applies and access is denied by default
+	Rule   *AccessRule
+	Code   string
+	Reason string
+	// Explanation is set only when it is requested
+	Explanation *AccessExplanation
+}
+
+// AccessAuthorization is a model for access decision of proxied request
+// with identity of its user
+type AccessAuthorization struct {
+	Decision *AccessDecision
+	Claims   *UserClaims
+}
+
+// AccessExplanation is a model for evaluation details of access check
+type AccessExplanation struct {
+	UserID   int64
+	Username string
+	Role     string
+	// Roles are role of subject and roles it inherits
+	Roles []string
+	// Permissions are effective permissions of role of subject
+	Permissions []string
+	// Rules are evaluations of rules matching endpoint, deny rules first
+	Rules []*AccessRuleEvaluation
+}
+
+// AccessRuleEvaluation is a model for evaluation of single access rule
+type AccessRuleEvaluation struct {
+	Rule *AccessRule
+	// SubjectMatched reports whether rule applies to subject,
+	// condition is evaluated only for applying rules
+	SubjectMatched   bool
+	ConditionMatched bool
+	ConditionError   string
+}
+
+// AccessCheck is a model for single check of batch,
+// attributes are used by conditions of access rules
+type AccessCheck struct {
+	Endpoint   string
+	Attributes map[string]string
+}
+
+// AccessPermissions is a model for everything subject is allowed to do
+type AccessPermissions struct {
+	// Roles are role of subject and roles it inherits
+	Roles []string
+	// Permissions are effective permissions of role of subject
+	Permissions []string
+	// Endpoints are allowed endpoint patterns from most specific
+	Endpoints []*AccessEndpoint
+}
+
+// AccessEndpoint is a model for endpoint pattern allowed to subject,
+// more specific endpoints matching pattern may still be denied
+type AccessEndpoint struct {
+	Endpoint string
+	RuleID   int64
+	// Conditional is true when allowing rule has condition
+	Conditional bool
+}
+
+// AccessSimulation is a model for check of hypothetical subject, exactly one of user
+// and role is set, proposed change of rules is evaluated instead of current rules
+type AccessSimulation struct {
+	UserID        int64
+	Role          string
+	Endpoint      string
+	Attributes    map[string]string
+	AddRules      []*AccessRule
+	RemoveRuleIDs []int64
+}
+
+// AccessRuleFilter is a model for access rules list filter, empty fields are not applied
+type AccessRuleFilter struct {
+	Effect     string
+	Permission string
+	Role       string
+	UserID     int64
+	Endpoint   string
+}
+
+// UpdateAccessRule is a model for updated params of access rule
+type UpdateAccessRule struct {
+	ID         int64
+	Permission *string
+	Endpoint   *string
+	Condition  *string
+}
+
+// Permission is a model for permission defined in organization, e.g. user:read
+type Permission struct {
+	ID          int64     `json:"id"`
+	TenantID    int64     `json:"-"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"-"`
+}
+
+// RolePermission is a model for permission granted to role
+type RolePermission struct {
+	TenantID   int64  `json:"-"`
+	Role       string `json:"role"`
+	Permission string `json:"permission"`
+}
+
+// RoleParent is a model for role inheriting all permissions of parent role
+type RoleParent struct {
+	TenantID int64  `json:"-"`
+	Role     string `json:"role"`
+	Parent   string `json:"parent"`
+}
+
+// RolePermissions is a model for permissions of role
+type RolePermissions struct {
+	Role string
+	// Parents are roles inherited directly
+	Parents []string
+	// Permissions are permissions granted to role directly
+	Permissions []string
+	// EffectivePermissions are permissions of role and all inherited roles
+	EffectivePermissions []string
+}
+
+// PolicyChange is a model for audit record of access policy change
+type PolicyChange struct {
+	ID        int64
+	TenantID  int64
+	Actor     string
+	Action    string
+	Before    interface{}
+	After     interface{}
+	CreatedAt time.Time
+}
+
+// PolicySnapshot is a model for access rules and role grants of all tenants at version,
+// version is increased by every change of them
+type PolicySnapshot struct {
+	Version         int64
+	Rules           []*AccessRule
+	RoleParents     []*RoleParent
+	RolePermissions []*RolePermission
 }
@@ -1,10 +1,61 @@
 package model
 
-import "github.com/dgrijalva/jwt-go"
+import (
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
 
 // UserClaims is a model for user claims
 type UserClaims struct {
 	jwt.StandardClaims
+	UserID   int64  `json:"user_id,omitempty"`
 	Username string `json:"username"`
 	Role     string `json:"role"`
+	TenantID int64  `json:"tenant_id"`
+	// ClientID and Scope are set in tokens of service accounts and refresh tokens
+	// of oauth clients
+	ClientID string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	// IssuedAtNano is issue time in nanoseconds, iat has whole seconds only
+	IssuedAtNano int64 `json:"iat_ns,omitempty"`
+}
+
+// IsServiceAccount reports whether token was issued to service account
+func (c *UserClaims) IsServiceAccount() bool {
+	return IsServiceAccountPrincipal(c.Username)
+}
+
+// TokenSubject returns subject whose tokens are revoked together: principal name of
+// service account or ID of user, so name of erased user is not kept with revocation
+func (c *UserClaims) TokenSubject() string {
+	if c.IsServiceAccount() {
+		return c.Username
+	}
+
+	return UserTokenSubject(c.UserID)
+}
+
+// UserTokenSubject returns subject of tokens of user
+func UserTokenSubject(userID int64) string {
+	return SubjectOf(userID)
+}
+
+// Scopes returns scopes of token
+func (c *UserClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// IsRevoked reports whether token was issued not later than tokens revocation time,
+// so token issued right after revocation in the same second is valid
+func (c *UserClaims) IsRevoked(revokedAt time.Time) bool {
+	if revokedAt.IsZero() {
+		return false
+	}
+	if c.IssuedAtNano == 0 {
+		return c.IssuedAt <= revokedAt.Unix()
+	}
+
+	return c.IssuedAtNano <= revokedAt.UnixNano()
 }
@@ -2,5 +2,54 @@ package model
 
 import "github.com/pkg/errors"
 
-// ErrorUserNotFound is error for not existing user
-var ErrorUserNotFound = errors.New("user not found")
+var (
+	// ErrorUserNotFound is error for not existing user
+	ErrorUserNotFound = errors.New("user not found")
+	// ErrorUnauthenticated is error for invalid, expired or revoked access token
+	ErrorUnauthenticated = errors.New("unauthenticated")
+	// ErrorPermissionDenied is error for caller not allowed to access resource
+	ErrorPermissionDenied = errors.New("permission denied")
+	// ErrorTenantNotSpecified is error for request without tenant
+	ErrorTenantNotSpecified = errors.New("tenant is not specified")
+	// ErrorOrganizationNotFound is error for not existing organization
+	ErrorOrganizationNotFound = errors.New("organization not found")
+	// ErrorInvitationNotFound is error for not existing invitation
+	ErrorInvitationNotFound = errors.New("invitation not found")
+	// ErrorOperationNotFound is error for not existing operation
+	ErrorOperationNotFound = errors.New("operation not found")
+	// ErrorAccessRuleNotFound is error for not existing access rule
+	ErrorAccessRuleNotFound = errors.New("access rule not found")
+	// ErrorPermissionNotFound is error for not existing permission or permission grant
+	ErrorPermissionNotFound = errors.New("permission not found")
+	// ErrorRoleParentNotFound is error for not existing role inheritance
+	ErrorRoleParentNotFound = errors.New("role parent not found")
+	// ErrorRelationNamespaceNotFound is error for not configured relation namespace
+	ErrorRelationNamespaceNotFound = errors.New("relation namespace not found")
+	// ErrorErasureRequestNotFound is error for not existing or already reviewed erasure request
+	ErrorErasureRequestNotFound = errors.New("erasure request not found")
+	// ErrorRoleGrantNotFound is error for not existing or already reviewed role grant
+	ErrorRoleGrantNotFound = errors.New("role grant not found")
+	// ErrorPolicyVersionNotFound is error for not existing version of tenant policy
+	ErrorPolicyVersionNotFound = errors.New("policy version not found")
+	// ErrorOAuthClientNotFound is error for not registered OAuth client
+	ErrorOAuthClientNotFound = errors.New("oauth client not found")
+	// ErrorOAuthInvalidGrant is error for invalid, expired or used authorization code
+	// or refresh token
+	ErrorOAuthInvalidGrant = errors.New("invalid grant")
+	// ErrorOAuthInvalidClient is error for unknown or disabled service account or wrong secret
+	ErrorOAuthInvalidClient = errors.New("invalid client")
+	// ErrorOAuthInvalidScope is error for scope not granted to client
+	ErrorOAuthInvalidScope = errors.New("invalid scope")
+	// ErrorServiceAccountNotFound is error for not existing service account
+	ErrorServiceAccountNotFound = errors.New("service account not found")
+	// ErrorFederationProviderNotFound is error for not configured upstream provider
+	ErrorFederationProviderNotFound = errors.New("federation provider not found")
+	// ErrorFederatedIdentityNotFound is error for upstream account linked to no user
+	ErrorFederatedIdentityNotFound = errors.New("federated identity not found")
+	// ErrorFederationInvalidState is error for unknown, expired or used state of
+	// federated login
+	ErrorFederationInvalidState = errors.New("invalid federation state")
+	// ErrorInvalidCredentials is error for unknown user or wrong password,
+	// next authenticator is tried
+	ErrorInvalidCredentials = errors.New("invalid credentials")
+)
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// FederationLoginStateTTL is lifetime of federated login started at upstream provider
+const FederationLoginStateTTL = 10 * time.Minute
+
+// FederationProvider is a model for upstream OpenID Connect provider users of tenant
+// sign in with
+type FederationProvider struct {
+	// Name identifies provider in login and callback URLs
+	Name         string
+	DisplayName  string
+	TenantID     int64
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// UsernameClaim is claim which becomes name of provisioned user,
+	// preferred_username or email when empty
+	UsernameClaim string
+	// Provisioning enables creation of users on their first login
+	Provisioning bool
+	// DefaultRole is role of provisioned user matching no role mapping,
+	// user matching no mapping is not provisioned when empty
+	DefaultRole  string
+	RoleMappings []FederationRoleMapping
+}
+
+// FederationRoleMapping is a model for rule giving role to provisioned user whose
+// claim equals or, for array claim, contains value
+type FederationRoleMapping struct {
+	Claim string
+	Value string
+	Role  string
+}
+
+// FederatedIdentity is a model for link of upstream provider account to user
+type FederatedIdentity struct {
+	ID        int64
+	TenantID  int64
+	Provider  string
+	Subject   string
+	UserID    int64
+	CreatedAt time.Time
+}
+
+// FederatedClaims is a model for claims of verified ID token of upstream provider
+type FederatedClaims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	// Claims contains every claim of ID token for role mappings
+	Claims map[string]interface{}
+}
+
+// FederationLoginState is a model for federated login in progress, authorization
+// request of client is resumed when upstream provider redirects back,
+// only hash of state is stored
+type FederationLoginState struct {
+	StateHash     string
+	TenantID      int64
+	Provider      string
+	Request       *OAuthAuthorizationRequest
+	UpstreamNonce string
+	CodeVerifier  string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+// FederationRedirect is a model for redirect of user agent to upstream provider,
+// State binds callback to user agent which started login
+type FederationRedirect struct {
+	URL   string
+	State string
+}
+
+// FederationCallback is a model for parameters upstream provider redirects back with
+type FederationCallback struct {
+	State            string
+	Code             string
+	Error            string
+	ErrorDescription string
+}
+
+// FederatedLogin is a model for result of federated login, authorization request
+// of client is denied for DeniedReason when user may not sign in
+type FederatedLogin struct {
+	Request      *OAuthAuthorizationRequest
+	UserID       int64
+	DeniedReason string
+}
+
+// MapRole returns role of first mapping matching claims, default role otherwise
+func (p *FederationProvider) MapRole(claims map[string]interface{}) string {
+	for _, mapping := range p.RoleMappings {
+		if claimContains(claims[mapping.Claim], mapping.Value) {
+			return mapping.Role
+		}
+	}
+
+	return p.DefaultRole
+}
+
+// Username returns name of user provisioned for claims
+func (p *FederationProvider) Username(claims *FederatedClaims) string {
+	if p.UsernameClaim != "" {
+		value, _ := claims.Claims[p.UsernameClaim].(string)
+		return value
+	}
+	if claims.PreferredUsername != "" {
+		return claims.PreferredUsername
+	}
+
+	return claims.Email
+}
+
+func claimContains(claim interface{}, value string) bool {
+	switch claim := claim.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		for _, item := range claim {
+			if claimContains(item, value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(claim) == value
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	// InvitationStatusPending is status of invitation waiting for user
+	InvitationStatusPending = "pending"
+	// InvitationStatusAccepted is status of accepted invitation
+	InvitationStatusAccepted = "accepted"
+	// InvitationStatusRevoked is status of revoked invitation
+	InvitationStatusRevoked = "revoked"
+)
+
+// NewInvitation is a model for invited user
+type NewInvitation struct {
+	Name  string
+	Email string
+	Role  string
+}
+
+// Invitation is a model for invitation
+type Invitation struct {
+	ID        int64
+	TenantID  int64
+	UserID    sql.NullInt64
+	Email     string
+	TokenID   string
+	Status    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+}
+
+// AcceptInvitation is a model for invitation acceptance
+type AcceptInvitation struct {
+	Token           string
+	Password        string
+	PasswordConfirm string
+}
+
+// InvitationClaims is a model for invitation token claims, token ID is kept in jti
+type InvitationClaims struct {
+	jwt.StandardClaims
+	TenantID int64 `json:"tenant_id"`
+}
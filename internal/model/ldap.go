@@ -0,0 +1,85 @@
+package model
+
+import "strings"
+
+// LDAPIdentityPrefix prefixes name of directory in links of directory accounts to users,
+// colon is not allowed in names of federation providers
+const LDAPIdentityPrefix = "ldap:"
+
+// LDAPDirectory is a model for LDAP or Active Directory server users of tenant
+// authenticate against by binding with their password
+type LDAPDirectory struct {
+	// Name identifies directory in links of directory accounts to users
+	Name     string
+	TenantID int64
+	// URL is ldap:// or ldaps:// URL of server
+	URL      string
+	StartTLS bool
+	// BindDN and BindPassword are credentials of account searching users,
+	// search is anonymous when BindDN is empty
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	// UserFilter limits entries searched for users, e.g. (objectClass=person)
+	UserFilter string
+	// UsernameAttribute is attribute user signs in with, e.g. uid or sAMAccountName
+	UsernameAttribute string
+	EmailAttribute    string
+	// GroupAttribute is attribute listing DNs of groups of user, e.g. memberOf
+	GroupAttribute string
+	// Sync creates users on their first login and keeps their role mapped from groups,
+	// directory only checks passwords of existing users with same name otherwise
+	Sync bool
+	// DefaultRole is role of synced user in no mapped group, user in no mapped group
+	// may not sign in when empty
+	DefaultRole  string
+	RoleMappings []LDAPRoleMapping
+}
+
+// LDAPRoleMapping is a model for rule giving role to members of group
+type LDAPRoleMapping struct {
+	GroupDN string
+	Role    string
+}
+
+// LDAPEntry is a model for directory user who bound with correct password
+type LDAPEntry struct {
+	DN       string
+	Username string
+	Email    string
+	Groups   []string
+}
+
+// IdentityProvider returns provider of links of directory accounts to users
+func (d *LDAPDirectory) IdentityProvider() string {
+	return LDAPIdentityPrefix + d.Name
+}
+
+// MapRole returns role of first mapping whose group user is member of,
+// default role otherwise
+func (d *LDAPDirectory) MapRole(groups []string) string {
+	for _, mapping := range d.RoleMappings {
+		for _, group := range groups {
+			if equalDN(group, mapping.GroupDN) {
+				return mapping.Role
+			}
+		}
+	}
+
+	return d.DefaultRole
+}
+
+// equalDN compares DNs ignoring case and spaces around separators
+func equalDN(a, b string) bool {
+	return strings.EqualFold(normalizeDN(a), normalizeDN(b))
+}
+
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, part := range parts {
+		name, value, _ := strings.Cut(part, "=")
+		parts[i] = strings.TrimSpace(name) + "=" + strings.TrimSpace(value)
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -2,10 +2,14 @@ package model
 
 import "time"
 
+// ActionLogin is action of log record written on successful login
+const ActionLogin = "login"
+
 // Record is a model for record in log table
 type Record struct {
 	ID        int64
 	UserID    int64
 	Action    string
+	Pseudonym string
 	CreatedAt time.Time
 }
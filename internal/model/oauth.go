@@ -0,0 +1,79 @@
+package model
+
+import "time"
+
+// OAuth 2.0 protocol values
+const (
+	OAuthResponseTypeCode           = "code"
+	OAuthCodeChallengeMethodS256    = "S256"
+	OAuthGrantTypeAuthorizationCode = "authorization_code"
+	OAuthGrantTypeRefreshToken      = "refresh_token"
+	OAuthGrantTypeClientCredentials = "client_credentials"
+	OAuthTokenTypeBearer            = "Bearer"
+)
+
+// AccessTokenType is typ header of access tokens, RFC 9068, ID tokens don't carry it
+const AccessTokenType = "at+jwt"
+
+// OAuthAuthorizationCodeTTL is lifetime of authorization code
+const OAuthAuthorizationCodeTTL = 5 * time.Minute
+
+// OAuthClient is a model for client application registered in tenant,
+// clients are public and authenticate authorization code exchange with PKCE
+type OAuthClient struct {
+	ID           int64
+	TenantID     int64
+	ClientID     string
+	Name         string
+	RedirectURIs []string
+	CreatedAt    time.Time
+}
+
+// OAuthAuthorizationRequest is a model for parameters of authorization endpoint
+type OAuthAuthorizationRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+}
+
+// OAuthAuthorizationCode is a model for issued authorization code, only hash of code is stored
+type OAuthAuthorizationCode struct {
+	CodeHash      string
+	TenantID      int64
+	ClientID      string
+	UserID        int64
+	RedirectURI   string
+	Scope         string
+	CodeChallenge string
+	Nonce         string
+	// AuthTime is time when user authenticated
+	AuthTime time.Time
+	// AuthMethod is authentication method reference of user login
+	AuthMethod string
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+// OAuthCodeExchange is a model for authorization code grant of token endpoint
+type OAuthCodeExchange struct {
+	ClientID     string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+}
+
+// OAuthToken is a model for response of token endpoint
+type OAuthToken struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresAt is expiration time of access token
+	ExpiresAt time.Time
+	Scope     string
+	// IDToken is issued for openid scope
+	IDToken string
+}
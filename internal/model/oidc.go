@@ -0,0 +1,94 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// OpenID Connect scopes, OpenID Connect Core 1.0 section 5.4
+const (
+	OIDCScopeOpenID  = "openid"
+	OIDCScopeProfile = "profile"
+	OIDCScopeEmail   = "email"
+)
+
+// Authentication method references, RFC 8176, federated login is not registered
+// there and is referenced as "fed"
+const (
+	OIDCAuthMethodPassword  = "pwd"
+	OIDCAuthMethodFederated = "fed"
+)
+
+// IDTokenParams is a model for authentication ID token is issued for
+type IDTokenParams struct {
+	ClientID string
+	Nonce    string
+	AuthTime time.Time
+	// AuthMethod is authentication method reference of user login
+	AuthMethod string
+	Scope      string
+}
+
+// IDTokenClaims is a model for claims of ID token, claims of user are
+// included by requested scopes
+type IDTokenClaims struct {
+	jwt.StandardClaims
+	Nonce    string   `json:"nonce,omitempty"`
+	AuthTime int64    `json:"auth_time"`
+	AMR      []string `json:"amr"`
+	UserInfo
+}
+
+// UserInfo is a model for standard claims of user except subject,
+// OpenID Connect Core 1.0 section 5.1
+type UserInfo struct {
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     *bool  `json:"email_verified,omitempty"`
+}
+
+// JSONWebKey is a model for public key verifying ID tokens, RFC 7517
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+// JSONWebKeySet is a model for published keys of provider
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// HasScope reports whether space-delimited scope contains scope value
+func HasScope(scope, value string) bool {
+	return slices.Contains(strings.Fields(scope), value)
+}
+
+// NewUserInfo returns claims of user released for scope
+func NewUserInfo(user *User, scope string) UserInfo {
+	var info UserInfo
+	if HasScope(scope, OIDCScopeProfile) {
+		info.Name = user.Name
+		info.PreferredUsername = user.Name
+	}
+	if HasScope(scope, OIDCScopeEmail) && user.Email != "" {
+		verified := user.EmailVerifiedAt.Valid
+		info.Email = user.Email
+		info.EmailVerified = &verified
+	}
+
+	return info
+}
+
+// SubjectOf returns subject identifier of user, user IDs are unique across tenants
+func SubjectOf(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
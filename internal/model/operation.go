@@ -0,0 +1,75 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	// OperationKindImportUsers is kind of users import operation
+	OperationKindImportUsers = "import_users"
+
+	// DataFormatCSV is CSV format of users file
+	DataFormatCSV = "csv"
+	// DataFormatJSONL is JSON Lines format of users file
+	DataFormatJSONL = "jsonl"
+)
+
+// ImportUsersOptions is a model for users import settings
+type ImportUsersOptions struct {
+	Format string
+	DryRun bool
+}
+
+// ImportUser is a model for user row of users file
+type ImportUser struct {
+	Row          int64  `json:"-"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	Role         string `json:"role"`
+	Password     string `json:"password,omitempty"`
+	PasswordHash string `json:"password_hash,omitempty"`
+}
+
+// RowError is a model for error in row of users file
+type RowError struct {
+	Row   int64  `json:"row"`
+	Error string `json:"error"`
+}
+
+// ImportUsersMetadata is a model for import operation progress
+type ImportUsersMetadata struct {
+	TotalRows     int64 `json:"total_rows"`
+	ProcessedRows int64 `json:"processed_rows"`
+	DryRun        bool  `json:"dry_run"`
+}
+
+// ImportUsersResult is a model for finished import operation
+type ImportUsersResult struct {
+	ImportedRows int64      `json:"imported_rows"`
+	FailedRows   int64      `json:"failed_rows"`
+	RowErrors    []RowError `json:"row_errors"`
+}
+
+// Operation is a model for long-running operation
+type Operation struct {
+	ID        int64
+	TenantID  int64
+	Kind      string
+	Done      bool
+	Metadata  ImportUsersMetadata
+	Result    *ImportUsersResult
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+}
+
+// ExportUser is a model for user row of exported file
+type ExportUser struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
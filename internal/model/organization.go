@@ -0,0 +1,61 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// DefaultTenantID is ID of organization which owns data created before multi-tenancy
+const DefaultTenantID int64 = 1
+
+// RoleAdmin is name of administrator role
+const RoleAdmin = "ADMIN"
+
+// RoleUser is name of regular user role
+const RoleUser = "USER"
+
+// DefaultRoles are roles created for every new organization,
+// ADMIN inherits all permissions of USER
+var DefaultRoles = []string{RoleAdmin, RoleUser}
+
+// DefaultAccessRules are access rules created for every new organization:
+// ADMIN manages users, invitations, roles of organization, access policy, OAuth
+// clients, relations and erasures of users, USER reads own profile and SCIM clients
+// provision users. Allowing rules of the most specific pattern applying to caller decide,
+// ADMIN inherits conditional rule of USER on GetUser, so it has own rule next to it.
+var DefaultAccessRules = []*AccessRule{
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/user_v1.UserV1"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/user_v1.UserV1/GetUser"},
+	{Effect: AccessEffectAllow, Role: RoleUser, Endpoint: "/user_v1.UserV1/GetUser",
+		Condition: "request.id == string(claims.user_id)"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/invitation_v1.InvitationV1"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/organization_v1.OrganizationV1"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/access_admin_v1.AccessAdminV1"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/oauth_v1.OAuthV1"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/relation_v1.RelationV1"},
+	{Effect: AccessEffectAllow, Role: RoleAdmin, Endpoint: "/privacy_v1.PrivacyV1"},
+	{Effect: AccessEffectAllow, Permission: PermissionSCIMProvision, Endpoint: "* /scim/v2/**"},
+}
+
+// DefaultPermissions are permissions created for every new organization and granted to ADMIN
+var DefaultPermissions = []*Permission{
+	{Name: PermissionElevationApprove, Description: "approval of elevation requests"},
+	{Name: PermissionSCIMProvision, Description: "provisioning of users and groups over SCIM"},
+}
+
+// Organization is a model for organization (tenant)
+type Organization struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+}
+
+// Role is a model for role defined in organization
+type Role struct {
+	ID          int64
+	TenantID    int64
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,95 @@
+package model
+
+import "time"
+
+// Formats of policy bundle documents
+const (
+	PolicyBundleFormatYAML = "yaml"
+	PolicyBundleFormatJSON = "json"
+)
+
+// PolicyBundle is a model for declarative access policy of tenant, applied bundle
+// replaces roles, permissions and access rules of tenant
+type PolicyBundle struct {
+	Roles       []*PolicyBundleRole       `json:"roles" yaml:"roles"`
+	Permissions []*PolicyBundlePermission `json:"permissions" yaml:"permissions"`
+	Rules       []*PolicyBundleRule       `json:"rules" yaml:"rules"`
+}
+
+// PolicyBundleRole is a model for role of policy bundle with roles it inherits
+// and permissions granted to it directly, description is set only for new roles
+type PolicyBundleRole struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Parents     []string `json:"parents,omitempty" yaml:"parents,omitempty"`
+	Permissions []string `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+}
+
+// PolicyBundlePermission is a model for permission of policy bundle,
+// description is set only for new permissions
+type PolicyBundlePermission struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// PolicyBundleRule is a model for access rule of policy bundle,
+// subject is exactly one of permission, role and user
+type PolicyBundleRule struct {
+	Effect     string `json:"effect,omitempty" yaml:"effect,omitempty"`
+	Permission string `json:"permission,omitempty" yaml:"permission,omitempty"`
+	Role       string `json:"role,omitempty" yaml:"role,omitempty"`
+	UserID     int64  `json:"user_id,omitempty" yaml:"user_id,omitempty"`
+	Endpoint   string `json:"endpoint" yaml:"endpoint"`
+	Condition  string `json:"condition,omitempty" yaml:"condition,omitempty"`
+}
+
+// PolicyDiff is a model for changes turning current policy of tenant into policy bundle
+type PolicyDiff struct {
+	AddedRoles         []*PolicyBundleRole       `json:"added_roles,omitempty"`
+	RemovedRoles       []string                  `json:"removed_roles,omitempty"`
+	AddedPermissions   []*PolicyBundlePermission `json:"added_permissions,omitempty"`
+	RemovedPermissions []string                  `json:"removed_permissions,omitempty"`
+	AddedRoleParents   []*RoleParent             `json:"added_role_parents,omitempty"`
+	RemovedRoleParents []*RoleParent             `json:"removed_role_parents,omitempty"`
+	GrantedPermissions []*RolePermission         `json:"granted_permissions,omitempty"`
+	RevokedPermissions []*RolePermission         `json:"revoked_permissions,omitempty"`
+	AddedRules         []*AccessRule             `json:"added_rules,omitempty"`
+	RemovedRules       []*AccessRule             `json:"removed_rules,omitempty"`
+}
+
+// IsEmpty reports whether diff has no changes
+func (d *PolicyDiff) IsEmpty() bool {
+	return len(d.AddedRoles) == 0 && len(d.RemovedRoles) == 0 &&
+		len(d.AddedPermissions) == 0 && len(d.RemovedPermissions) == 0 &&
+		len(d.AddedRoleParents) == 0 && len(d.RemovedRoleParents) == 0 &&
+		len(d.GrantedPermissions) == 0 && len(d.RevokedPermissions) == 0 &&
+		len(d.AddedRules) == 0 && len(d.RemovedRules) == 0
+}
+
+// PolicyBundleVersion is a model for applied policy bundle stored as version of tenant policy
+type PolicyBundleVersion struct {
+	ID       int64
+	TenantID int64
+	Version  int64
+	Bundle   *PolicyBundle
+	Diff     *PolicyDiff
+	// Author is name of admin who applied bundle
+	Author string
+	// Action is apply, rollback or initial
+	Action    string
+	CreatedAt time.Time
+}
+
+// Actions of policy bundle versions, initial version 0 keeps policy before the first apply
+const (
+	PolicyBundleActionApply    = "apply"
+	PolicyBundleActionRollback = "rollback"
+	PolicyBundleActionInitial  = "initial"
+)
+
+// PolicyApplyResult is a model for result of policy bundle application,
+// version is zero if nothing was applied
+type PolicyApplyResult struct {
+	Version int64
+	Diff    *PolicyDiff
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	// ErasureStatusPending is status of erasure request waiting for approval
+	ErasureStatusPending = "pending"
+	// ErasureStatusRejected is status of rejected erasure request
+	ErasureStatusRejected = "rejected"
+	// ErasureStatusCompleted is status of approved erasure request, user is erased
+	ErasureStatusCompleted = "completed"
+)
+
+// ErasureRequest is a model for user erasure request
+type ErasureRequest struct {
+	ID          int64
+	TenantID    int64
+	UserID      int64
+	RequestedBy string
+	ReviewedBy  sql.NullString
+	Reason      string
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   sql.NullTime
+}
+
+// ErasureEvent is a model for event sent after user erasure
+type ErasureEvent struct {
+	TenantID  int64     `json:"tenant_id"`
+	UserID    int64     `json:"user_id"`
+	Pseudonym string    `json:"pseudonym"`
+	ErasedAt  time.Time `json:"erased_at"`
+}
+
+// DataBundle is a model for all data stored about user, login history is login records
+// of audit log. Service stores no sessions and consents: tokens are not stored and
+// authorization of OAuth client lives only as pending authorization code.
+type DataBundle struct {
+	GeneratedAt        time.Time               `json:"generated_at"`
+	Profile            DataProfile             `json:"profile"`
+	AuditLog           []DataRecord            `json:"audit_log"`
+	LoginHistory       []DataRecord            `json:"login_history"`
+	Invitations        []DataInvitation        `json:"invitations"`
+	RoleGrants         []DataRoleGrant         `json:"role_grants"`
+	AuthorizationCodes []DataAuthorizationCode `json:"authorization_codes"`
+	LinkedIdentities   []DataLinkedIdentity    `json:"linked_identities"`
+}
+
+// DataProfile is a model for user profile in data bundle
+type DataProfile struct {
+	ID             int64      `json:"id"`
+	OrganizationID int64      `json:"organization_id"`
+	Name           string     `json:"name"`
+	Email          string     `json:"email"`
+	Role           string     `json:"role"`
+	Status         string     `json:"status"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// DataRecord is a model for audit log entry in data bundle
+type DataRecord struct {
+	Action    string    `json:"action"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DataInvitation is a model for invitation in data bundle
+type DataInvitation struct {
+	Email     string    `json:"email"`
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DataRoleGrant is a model for time-bound role grant of user in data bundle
+type DataRoleGrant struct {
+	Role      string    `json:"role"`
+	Reason    string    `json:"reason"`
+	Status    string    `json:"status"`
+	StartsAt  time.Time `json:"starts_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DataAuthorizationCode is a model for authorization code issued to OAuth client
+// on behalf of user in data bundle
+type DataAuthorizationCode struct {
+	ClientID    string    `json:"client_id"`
+	Scope       string    `json:"scope"`
+	RedirectURI string    `json:"redirect_uri"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// DataLinkedIdentity is a model for upstream provider account of user in data bundle
+type DataLinkedIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
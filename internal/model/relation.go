@@ -0,0 +1,155 @@
+package model
+
+// RelationObject is a model for object of relation tuple, e.g. document:readme
+type RelationObject struct {
+	Namespace string
+	ID        string
+}
+
+// String returns object in namespace:id form
+func (o RelationObject) String() string {
+	return o.Namespace + ":" + o.ID
+}
+
+// RelationSubject is a model for subject of relation tuple: either object itself
+// (user:alice) or userset of objects related to it by relation (group:eng#member)
+type RelationSubject struct {
+	Namespace string
+	ID        string
+	// Relation is empty for subject which is not a userset
+	Relation string
+}
+
+// Object returns object of subject
+func (s RelationSubject) Object() RelationObject {
+	return RelationObject{Namespace: s.Namespace, ID: s.ID}
+}
+
+// String returns subject in namespace:id or namespace:id#relation form
+func (s RelationSubject) String() string {
+	if s.Relation == "" {
+		return s.Object().String()
+	}
+
+	return s.Object().String() + "#" + s.Relation
+}
+
+// RelationTuple is a model for relation tuple object#relation@subject
+type RelationTuple struct {
+	Object   RelationObject
+	Relation string
+	Subject  RelationSubject
+}
+
+// String returns tuple in object#relation@subject form
+func (t *RelationTuple) String() string {
+	return t.Object.String() + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+// RelationNamespace is a model for namespace configuration,
+// relations map relation name to its userset rewrite
+type RelationNamespace struct {
+	Name      string                      `json:"name"`
+	Relations map[string]*RelationRewrite `json:"relations"`
+}
+
+// RelationRewrite is a model for userset rewrite of relation,
+// relation is a union of usersets of its rules, no rules means direct tuples only
+type RelationRewrite struct {
+	Union []*RelationRewriteRule `json:"union,omitempty"`
+}
+
+// RelationRewriteRule is a model for single userset rewrite rule, exactly one field is set
+type RelationRewriteRule struct {
+	// This is userset of subjects of tuples with the relation itself
+	This bool `json:"this,omitempty"`
+	// ComputedUserset is userset of another relation of the same object
+	ComputedUserset string `json:"computed_userset,omitempty"`
+	// TupleToUserset is userset of relation of objects referenced by tupleset relation
+	TupleToUserset *TupleToUserset `json:"tuple_to_userset,omitempty"`
+}
+
+// TupleToUserset is a model for tuple-to-userset rewrite,
+// e.g. viewers of folder referenced by document#parent are viewers of document
+type TupleToUserset struct {
+	Tupleset        string `json:"tupleset"`
+	ComputedUserset string `json:"computed_userset"`
+}
+
+// RelationConsistency is a model for required freshness of relation snapshot
+type RelationConsistency int32
+
+const (
+	// ConsistencyMinimizeLatency evaluates request at the latest snapshot
+	ConsistencyMinimizeLatency RelationConsistency = iota
+	// ConsistencyAtLeastAsFresh evaluates request at snapshot not older than zookie
+	ConsistencyAtLeastAsFresh
+	// ConsistencyAtExactSnapshot evaluates request at snapshot of zookie
+	ConsistencyAtExactSnapshot
+)
+
+// RelationSnapshot is a model for snapshot requested by client
+type RelationSnapshot struct {
+	Consistency RelationConsistency
+	Zookie      string
+}
+
+// RelationTupleWrite is a model for atomic change of relation tuples
+type RelationTupleWrite struct {
+	Writes  []*RelationTuple
+	Deletes []*RelationTuple
+}
+
+// RelationCheck is a model for check of subject relation to object
+type RelationCheck struct {
+	Object   RelationObject
+	Relation string
+	Subject  RelationSubject
+	Snapshot RelationSnapshot
+}
+
+// RelationCheckResult is a model for result of relation check
+type RelationCheckResult struct {
+	Allowed bool
+	Zookie  string
+}
+
+// RelationExpand is a model for expansion of object relation to userset tree
+type RelationExpand struct {
+	Object   RelationObject
+	Relation string
+	Snapshot RelationSnapshot
+}
+
+// UsersetTree is a model for node of expanded userset, userset of node is union of its
+// children and subjects
+type UsersetTree struct {
+	Object   RelationObject
+	Relation string
+	// Operation is how node is derived from its parent: this, computed_userset or
+	// tuple_to_userset, root node is union
+	Operation string
+	// Subjects are direct subjects of leaf node of operation this
+	Subjects []RelationSubject
+	Children []*UsersetTree
+}
+
+// RelationExpandResult is a model for result of relation expansion
+type RelationExpandResult struct {
+	Tree   *UsersetTree
+	Zookie string
+}
+
+// RelationListObjects is a model for lookup of objects subject is related to
+type RelationListObjects struct {
+	Namespace string
+	Relation  string
+	Subject   RelationSubject
+	Snapshot  RelationSnapshot
+}
+
+// RelationListObjectsResult is a model for result of objects lookup
+type RelationListObjectsResult struct {
+	ObjectIDs []string
+	Zookie    string
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	// RoleGrantStatusPending is status of elevation request waiting for approval
+	RoleGrantStatusPending = "pending"
+	// RoleGrantStatusApproved is status of approved role grant, role is granted between
+	// start and expiry time
+	RoleGrantStatusApproved = "approved"
+	// RoleGrantStatusDenied is status of denied elevation request
+	RoleGrantStatusDenied = "denied"
+	// RoleGrantStatusExpired is status of role grant or request after its expiry time
+	RoleGrantStatusExpired = "expired"
+)
+
+const (
+	// PermissionElevationApprove is permission of approvers of elevation requests,
+	// it is granted to ADMIN in every new organization
+	PermissionElevationApprove = "elevation:approve"
+
+	// MaxElevationDuration is the longest time role may be granted for
+	MaxElevationDuration = 8 * time.Hour
+)
+
+// NewElevation is a model for elevation request
+type NewElevation struct {
+	Role   string
+	Reason string
+	// StartsAt is start of grant, zero time means now
+	StartsAt time.Time
+	Duration time.Duration
+}
+
+// RoleGrant is a model for time-bound grant of role to user
+type RoleGrant struct {
+	ID         int64
+	TenantID   int64
+	UserID     int64
+	Role       string
+	Reason     string
+	Status     string
+	ReviewedBy sql.NullString
+	StartsAt   time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	UpdatedAt  sql.NullTime
+}
+
+// RoleGrantFilter is a model for role grants filter, empty fields match any grant
+type RoleGrantFilter struct {
+	UserID int64
+	Status string
+}
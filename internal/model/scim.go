@@ -0,0 +1,5 @@
+package model
+
+// PermissionSCIMProvision is permission of SCIM clients provisioning users and groups,
+// it is granted to ADMIN in every new organization
+const PermissionSCIMProvision = "scim:provision"
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// ServiceAccountPrincipalPrefix is prefix of principal names of service accounts,
+// names of users must not start with it
+const ServiceAccountPrincipalPrefix = "service-account/"
+
+// ServiceAccount is a model for non-human principal of tenant authenticating with
+// client ID and secret, only hash of secret is stored.
+// Scopes are permissions its tokens may carry.
+type ServiceAccount struct {
+	ID              int64
+	TenantID        int64
+	ClientID        string
+	Name            string
+	Role            string
+	Scopes          []string
+	SecretHash      string
+	Disabled        bool
+	SecretRotatedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       sql.NullTime
+}
+
+// ServiceAccountCredentials is a model for service account with its client secret,
+// secret is returned only when it is generated
+type ServiceAccountCredentials struct {
+	Account      *ServiceAccount
+	ClientSecret string
+}
+
+// OAuthClientCredentials is a model for client_credentials grant of token endpoint
+type OAuthClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+	// Scope is space-delimited requested scopes, empty scope requests every scope of account
+	Scope string
+}
+
+// ServiceAccountPrincipal returns principal name of service account tokens
+func ServiceAccountPrincipal(clientID string) string {
+	return ServiceAccountPrincipalPrefix + clientID
+}
+
+// IsServiceAccountPrincipal reports whether name is principal name of service account
+func IsServiceAccountPrincipal(name string) bool {
+	return strings.HasPrefix(name, ServiceAccountPrincipalPrefix)
+}
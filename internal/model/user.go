@@ -5,6 +5,16 @@ import (
 	"time"
 )
 
+const (
+	// UserStatusActive is status of user who can log in
+	UserStatusActive = "active"
+	// UserStatusPending is status of invited user who has not set password yet
+	UserStatusPending = "pending"
+	// UserStatusDisabled is status of user deactivated by administrator or provisioning
+	// client, user can't log in
+	UserStatusDisabled = "disabled"
+)
+
 // NewUser is a model for created user
 type NewUser struct {
 	Name            string
@@ -12,22 +22,31 @@ type NewUser struct {
 	Password        string
 	PasswordConfirm string
 	Role            string
+	Status          string
+	// EmailVerified marks email verified, e.g. by upstream identity provider
+	EmailVerified bool
 }
 
 // UpdateUserInfo is a model for updated params of user
 type UpdateUserInfo struct {
-	ID   int64
-	Name *string
-	Role *string
+	ID     int64
+	Name   *string
+	Role   *string
+	Email  *string
+	Status *string
 }
 
 // User contains user settings
 type User struct {
 	ID        int64
+	TenantID  int64
 	Name      string
 	Email     string
 	Role      string
 	Password  string
+	Status    string
 	CreatedAt time.Time
 	UpdatedAt sql.NullTime
+	// EmailVerifiedAt is set when user proves email ownership by accepting invitation
+	EmailVerifiedAt sql.NullTime
 }
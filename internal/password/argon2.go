@@ -0,0 +1,82 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+// Bounds of argon2id parameters of imported hashes: zero time or threads panics
+// and unbounded memory or time exhausts server on login
+const (
+	argon2MaxMemory  = 1 << 20 // KiB, 1 GiB
+	argon2MaxTime    = 16
+	argon2MaxKeySize = 1024
+)
+
+type argon2Params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// parseArgon2Hash parses hash in PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func parseArgon2Hash(hash string) (*argon2Params, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, fmt.Errorf("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, fmt.Errorf("invalid argon2id hash version: %w", err)
+	}
+	if version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	params := &argon2Params{}
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads)
+	if err != nil {
+		return nil, fmt.Errorf("invalid argon2id hash params: %w", err)
+	}
+	if params.threads < 1 || params.time < 1 || params.time > argon2MaxTime {
+		return nil, fmt.Errorf("argon2id hash params are out of range")
+	}
+	// memory must be at least 8 KiB per thread
+	if params.memory < 8*uint32(params.threads) || params.memory > argon2MaxMemory {
+		return nil, fmt.Errorf("argon2id hash memory is out of range")
+	}
+
+	params.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, fmt.Errorf("invalid argon2id hash salt: %w", err)
+	}
+
+	params.key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(params.key) == 0 || len(params.key) > argon2MaxKeySize {
+		return nil, fmt.Errorf("invalid argon2id hash key")
+	}
+
+	return params, nil
+}
+
+func checkArgon2Hash(password, hash string) bool {
+	params, err := parseArgon2Hash(hash)
+	if err != nil {
+		return false
+	}
+
+	key := argon2.IDKey([]byte(password), params.salt, params.time, params.memory,
+		params.threads, uint32(len(params.key)))
+
+	return subtle.ConstantTimeCompare(key, params.key) == 1
+}
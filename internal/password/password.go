@@ -1,6 +1,10 @@
 package password
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 // HashPassword return hash of password
 func HashPassword(password string) (string, error) {
@@ -8,8 +12,23 @@ func HashPassword(password string) (string, error) {
 	return string(bytes), err
 }
 
-// CheckPasswordHash compares password and hash
+// CheckPasswordHash compares password and hash, bcrypt and argon2id hashes are supported
 func CheckPasswordHash(password, hash string) bool {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		return checkArgon2Hash(password, hash)
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// IsSupportedHash reports whether hash is valid bcrypt or argon2id hash
+func IsSupportedHash(hash string) bool {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		_, err := parseArgon2Hash(hash)
+		return err == nil
+	}
+
+	_, err := bcrypt.Cost([]byte(hash))
+	return err == nil
+}
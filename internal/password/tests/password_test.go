@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+
+	"github.com/valek177/auth/internal/password"
+)
+
+const testPassword = "password"
+
+func argon2Hash(memory, time uint32, threads uint8) string {
+	salt := []byte("saltsaltsaltsalt")
+	key := argon2.IDKey([]byte(testPassword), salt, time, memory, threads, 32)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, memory, time,
+		threads, base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))
+}
+
+// argon2Params returns hash with key of valid hash and given params
+func argon2Params(params string) string {
+	return "$argon2id$v=19$" + params + "$c2FsdHNhbHRzYWx0c2FsdA$" +
+		"a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+}
+
+func TestArgon2Hash(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		hash      string
+		supported bool
+		matches   bool
+	}{
+		{
+			name:      "success case: valid hash",
+			hash:      argon2Hash(64, 1, 1),
+			supported: true,
+			matches:   true,
+		},
+		{
+			name:      "success case: several threads",
+			hash:      argon2Hash(64, 2, 4),
+			supported: true,
+			matches:   true,
+		},
+		{
+			name: "error: zero time",
+			hash: argon2Params("m=64,t=0,p=1"),
+		},
+		{
+			name: "error: zero threads",
+			hash: argon2Params("m=64,t=1,p=0"),
+		},
+		{
+			name: "error: too many threads",
+			hash: argon2Params("m=64,t=1,p=256"),
+		},
+		{
+			name: "error: time is too long",
+			hash: argon2Params("m=64,t=100000,p=1"),
+		},
+		{
+			name: "error: zero memory",
+			hash: argon2Params("m=0,t=1,p=1"),
+		},
+		{
+			name: "error: memory is less than 8 KiB per thread",
+			hash: argon2Params("m=16,t=1,p=4"),
+		},
+		{
+			name: "error: memory is more than 1 GiB",
+			hash: argon2Params("m=4194304,t=1,p=1"),
+		},
+		{
+			name: "error: memory overflows",
+			hash: argon2Params("m=4294967296,t=1,p=1"),
+		},
+		{
+			name: "error: empty key",
+			hash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$",
+		},
+		{
+			name: "error: unsupported version",
+			hash: "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.supported, password.IsSupportedHash(tt.hash))
+			assert.Equal(t, tt.matches, password.CheckPasswordHash(testPassword, tt.hash))
+			assert.False(t, password.CheckPasswordHash("wrong", tt.hash))
+		})
+	}
+}
+
+func TestBcryptHash(t *testing.T) {
+	t.Parallel()
+
+	hash, err := password.HashPassword(testPassword)
+	assert.NoError(t, err)
+
+	assert.True(t, password.IsSupportedHash(hash))
+	assert.True(t, password.CheckPasswordHash(testPassword, hash))
+	assert.False(t, password.CheckPasswordHash("wrong", hash))
+	assert.False(t, password.IsSupportedHash("plain"))
+}
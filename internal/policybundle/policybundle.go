@@ -0,0 +1,222 @@
+package policybundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// State is current policy of tenant, rules keep their IDs
+type State struct {
+	Roles       []*model.PolicyBundleRole
+	Permissions []*model.PolicyBundlePermission
+	Rules       []*model.AccessRule
+}
+
+// Bundle returns policy bundle describing state
+func (s *State) Bundle() *model.PolicyBundle {
+	bundle := &model.PolicyBundle{
+		Roles:       s.Roles,
+		Permissions: s.Permissions,
+		Rules:       make([]*model.PolicyBundleRule, 0, len(s.Rules)),
+	}
+	for _, rule := range s.Rules {
+		bundle.Rules = append(bundle.Rules, &model.PolicyBundleRule{
+			Effect:     rule.Effect,
+			Permission: rule.Permission,
+			Role:       rule.Role,
+			UserID:     rule.UserID,
+			Endpoint:   rule.Endpoint,
+			Condition:  rule.Condition,
+		})
+	}
+
+	return bundle
+}
+
+// Parse decodes policy bundle from YAML or JSON document, unknown fields are rejected
+func Parse(data []byte) (*model.PolicyBundle, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var bundle model.PolicyBundle
+	if err := decoder.Decode(&bundle); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("invalid policy bundle: empty document")
+		}
+		return nil, errors.Wrap(err, "invalid policy bundle")
+	}
+
+	return &bundle, nil
+}
+
+// Marshal encodes policy bundle to document of format
+func Marshal(bundle *model.PolicyBundle, format string) ([]byte, error) {
+	switch format {
+	case model.PolicyBundleFormatYAML:
+		return yaml.Marshal(bundle)
+	case model.PolicyBundleFormatJSON:
+		return json.MarshalIndent(bundle, "", "  ")
+	default:
+		return nil, errors.Errorf("unknown policy bundle format %q", format)
+	}
+}
+
+// ToAccessRule converts rule of policy bundle to access rule, rule without effect allows access
+func ToAccessRule(rule *model.PolicyBundleRule) *model.AccessRule {
+	effect := rule.Effect
+	if effect == "" {
+		effect = model.AccessEffectAllow
+	}
+
+	return &model.AccessRule{
+		Effect:     effect,
+		Permission: rule.Permission,
+		Role:       rule.Role,
+		UserID:     rule.UserID,
+		Endpoint:   rule.Endpoint,
+		Condition:  rule.Condition,
+	}
+}
+
+// Diff returns changes turning current state into desired bundle, rules are
+// compared by all their fields so changed rule is removed and added again
+func Diff(current *State, desired *model.PolicyBundle) *model.PolicyDiff {
+	diff := &model.PolicyDiff{}
+
+	currentRoles := make(map[string]bool, len(current.Roles))
+	for _, role := range current.Roles {
+		currentRoles[role.Name] = true
+	}
+	desiredRoles := make(map[string]bool, len(desired.Roles))
+	for _, role := range desired.Roles {
+		desiredRoles[role.Name] = true
+		if !currentRoles[role.Name] {
+			diff.AddedRoles = append(diff.AddedRoles, &model.PolicyBundleRole{
+				Name:        role.Name,
+				Description: role.Description,
+			})
+		}
+	}
+	for _, role := range current.Roles {
+		if !desiredRoles[role.Name] {
+			diff.RemovedRoles = append(diff.RemovedRoles, role.Name)
+		}
+	}
+
+	currentPermissions := make(map[string]bool, len(current.Permissions))
+	for _, permission := range current.Permissions {
+		currentPermissions[permission.Name] = true
+	}
+	desiredPermissions := make(map[string]bool, len(desired.Permissions))
+	for _, permission := range desired.Permissions {
+		desiredPermissions[permission.Name] = true
+		if !currentPermissions[permission.Name] {
+			diff.AddedPermissions = append(diff.AddedPermissions, &model.PolicyBundlePermission{
+				Name:        permission.Name,
+				Description: permission.Description,
+			})
+		}
+	}
+	for _, permission := range current.Permissions {
+		if !desiredPermissions[permission.Name] {
+			diff.RemovedPermissions = append(diff.RemovedPermissions, permission.Name)
+		}
+	}
+
+	currentParents, currentGrants := roleEdges(current.Roles)
+	desiredParents, desiredGrants := roleEdges(desired.Roles)
+	for _, role := range desired.Roles {
+		for _, parent := range role.Parents {
+			if !currentParents[edge{role.Name, parent}] {
+				diff.AddedRoleParents = append(diff.AddedRoleParents,
+					&model.RoleParent{Role: role.Name, Parent: parent})
+			}
+		}
+		for _, permission := range role.Permissions {
+			if !currentGrants[edge{role.Name, permission}] {
+				diff.GrantedPermissions = append(diff.GrantedPermissions,
+					&model.RolePermission{Role: role.Name, Permission: permission})
+			}
+		}
+	}
+	for _, role := range current.Roles {
+		for _, parent := range role.Parents {
+			if !desiredParents[edge{role.Name, parent}] {
+				diff.RemovedRoleParents = append(diff.RemovedRoleParents,
+					&model.RoleParent{Role: role.Name, Parent: parent})
+			}
+		}
+		for _, permission := range role.Permissions {
+			if !desiredGrants[edge{role.Name, permission}] {
+				diff.RevokedPermissions = append(diff.RevokedPermissions,
+					&model.RolePermission{Role: role.Name, Permission: permission})
+			}
+		}
+	}
+
+	// every desired rule keeps at most one equal current rule
+	desiredRules := make(map[string]int, len(desired.Rules))
+	for _, rule := range desired.Rules {
+		desiredRules[RuleKey(ToAccessRule(rule))]++
+	}
+	for _, rule := range current.Rules {
+		key := RuleKey(rule)
+		if desiredRules[key] > 0 {
+			desiredRules[key]--
+			continue
+		}
+		diff.RemovedRules = append(diff.RemovedRules, rule)
+	}
+	for _, bundleRule := range desired.Rules {
+		rule := ToAccessRule(bundleRule)
+		key := RuleKey(rule)
+		if desiredRules[key] > 0 {
+			desiredRules[key]--
+			diff.AddedRules = append(diff.AddedRules, rule)
+		}
+	}
+
+	return diff
+}
+
+// RuleKey returns key of access rule equal for rules differing only by ID and timestamps
+func RuleKey(rule *model.AccessRule) string {
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%d\x00%s\x00%s", rule.Effect, rule.Permission,
+		rule.Role, rule.UserID, rule.Endpoint, rule.Condition)
+}
+
+type edge struct {
+	role, target string
+}
+
+// roleEdges returns role parents and permission grants of roles
+func roleEdges(roles []*model.PolicyBundleRole) (map[edge]bool, map[edge]bool) {
+	parents := make(map[edge]bool)
+	grants := make(map[edge]bool)
+	for _, role := range roles {
+		for _, parent := range role.Parents {
+			parents[edge{role.Name, parent}] = true
+		}
+		for _, permission := range role.Permissions {
+			grants[edge{role.Name, permission}] = true
+		}
+	}
+
+	return parents, grants
+}
+
+// ContentType returns MIME type of document of format
+func ContentType(format string) string {
+	if format == model.PolicyBundleFormatJSON {
+		return "application/json"
+	}
+
+	return "application/yaml"
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/policybundle"
+)
+
+const bundleYAML = `
+roles:
+  - name: ADMIN
+    parents: [USER]
+    permissions: [user:write]
+  - name: USER
+    permissions: [user:read]
+permissions:
+  - name: user:read
+  - name: user:write
+    description: Edit users
+rules:
+  - permission: user:read
+    endpoint: /user_v1.UserV1/GetUser
+  - effect: deny
+    user_id: 7
+    endpoint: /user_v1.UserV1/*
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		document string
+		err      string
+	}{
+		{
+			name:     "YAML document",
+			document: bundleYAML,
+		},
+		{
+			name: "JSON document",
+			document: `{"roles": [{"name": "ADMIN"}, {"name": "USER"}], ` +
+				`"rules": [{"role": "ADMIN", "endpoint": "/user_v1.UserV1"}]}`,
+		},
+		{
+			name:     "unknown field",
+			document: "roles:\n  - name: ADMIN\n    inherits: [USER]\n",
+			err:      "field inherits not found",
+		},
+		{
+			name:     "empty document",
+			document: "",
+			err:      "empty document",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			bundle, err := policybundle.Parse([]byte(tt.document))
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, bundle.Roles)
+			assert.NotEmpty(t, bundle.Rules)
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := policybundle.Parse([]byte(bundleYAML))
+	require.NoError(t, err)
+
+	for _, format := range []string{model.PolicyBundleFormatYAML, model.PolicyBundleFormatJSON} {
+		document, err := policybundle.Marshal(bundle, format)
+		require.NoError(t, err)
+
+		parsed, err := policybundle.Parse(document)
+		require.NoError(t, err)
+		assert.Equal(t, bundle, parsed, format)
+	}
+
+	_, err = policybundle.Marshal(bundle, "xml")
+	assert.ErrorContains(t, err, "unknown policy bundle format")
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	desired, err := policybundle.Parse([]byte(bundleYAML))
+	require.NoError(t, err)
+
+	current := &policybundle.State{
+		Roles: []*model.PolicyBundleRole{
+			{Name: model.RoleAdmin, Parents: []string{model.RoleUser}},
+			{Name: model.RoleUser, Permissions: []string{"user:read", "user:list"}},
+			{Name: "SUPPORT", Parents: []string{model.RoleUser}},
+		},
+		Permissions: []*model.PolicyBundlePermission{
+			{Name: "user:read"}, {Name: "user:list"},
+		},
+		Rules: []*model.AccessRule{
+			{ID: 1, Effect: model.AccessEffectAllow, Permission: "user:read",
+				Endpoint: "/user_v1.UserV1/GetUser"},
+			{ID: 2, Effect: model.AccessEffectAllow, Permission: "user:read",
+				Endpoint: "/user_v1.UserV1/GetUser"},
+			{ID: 3, Effect: model.AccessEffectDeny, UserID: 7, Endpoint: "/user_v1.UserV1/Get*"},
+		},
+	}
+
+	diff := policybundle.Diff(current, desired)
+
+	assert.Empty(t, diff.AddedRoles)
+	assert.Equal(t, []string{"SUPPORT"}, diff.RemovedRoles)
+	assert.Equal(t, []*model.PolicyBundlePermission{
+		{Name: "user:write", Description: "Edit users"},
+	}, diff.AddedPermissions)
+	assert.Equal(t, []string{"user:list"}, diff.RemovedPermissions)
+	assert.Empty(t, diff.AddedRoleParents)
+	assert.Equal(t, []*model.RoleParent{{Role: "SUPPORT", Parent: model.RoleUser}},
+		diff.RemovedRoleParents)
+	assert.Equal(t, []*model.RolePermission{{Role: model.RoleAdmin, Permission: "user:write"}},
+		diff.GrantedPermissions)
+	assert.Equal(t, []*model.RolePermission{{Role: model.RoleUser, Permission: "user:list"}},
+		diff.RevokedPermissions)
+	assert.Equal(t, []*model.AccessRule{
+		{Effect: model.AccessEffectDeny, UserID: 7, Endpoint: "/user_v1.UserV1/*"},
+	}, diff.AddedRules)
+	assert.Equal(t, []int64{2, 3}, []int64{diff.RemovedRules[0].ID, diff.RemovedRules[1].ID})
+
+	assert.True(t, policybundle.Diff(&policybundle.State{
+		Roles:       desired.Roles,
+		Permissions: desired.Permissions,
+		Rules: []*model.AccessRule{
+			policybundle.ToAccessRule(desired.Rules[0]),
+			policybundle.ToAccessRule(desired.Rules[1]),
+		},
+	}, desired).IsEmpty())
+}
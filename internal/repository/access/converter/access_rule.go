@@ -1,31 +1,48 @@
 package converter
 
 import (
+	"database/sql"
+
 	"github.com/valek177/auth/internal/model"
 	repoModel "github.com/valek177/auth/internal/repository/access/model"
 )
 
-// ToEndpointAccessRuleFromRepo converts rules from repoModel to EndpointAccessRule for endpoint
-func ToEndpointAccessRuleFromRepo(endpoint string, rules []*repoModel.AccessRule,
-) *model.EndpointAccessRule {
-	if endpoint == "" {
-		return nil
+// ToAccessRuleFromRepo converts access rule from repository model to service model
+func ToAccessRuleFromRepo(rule *repoModel.Rule) *model.AccessRule {
+	if rule == nil {
+		return &model.AccessRule{}
 	}
 
-	if len(rules) == 0 {
-		return nil
+	return &model.AccessRule{
+		ID:         rule.ID,
+		TenantID:   rule.TenantID,
+		Effect:     rule.Effect,
+		Permission: rule.Permission.String,
+		Role:       rule.Role.String,
+		UserID:     rule.UserID.Int64,
+		Endpoint:   rule.Endpoint,
+		Condition:  rule.Condition,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
 	}
+}
 
-	endpointRoles := make([]string, len(rules))
-
-	for i, rule := range rules {
-		endpointRoles[i] = rule.Role
+// ToAccessRulesFromRepo converts access rules from repository model to service model
+func ToAccessRulesFromRepo(rules []*repoModel.Rule) []*model.AccessRule {
+	res := make([]*model.AccessRule, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, ToAccessRuleFromRepo(rule))
 	}
 
-	resRule := &model.EndpointAccessRule{
-		Endpoint: endpoint,
-		Roles:    endpointRoles,
-	}
+	return res
+}
+
+// ToNullStringFromService converts optional subject of access rule to nullable column value
+func ToNullStringFromService(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
 
-	return resRule
+// ToNullInt64FromService converts optional user ID of access rule to nullable column value
+func ToNullInt64FromService(value int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: value, Valid: value != 0}
 }
@@ -1,7 +1,21 @@
 package model
 
-// AccessRule is a struct for access rule record
-type AccessRule struct {
-	Role     string `json:"role"`
-	Endpoint string `json:"endpoint"`
+import (
+	"database/sql"
+	"time"
+)
+
+// Rule is a struct for access rule record with its metadata,
+// exactly one of permission, role and user ID is set
+type Rule struct {
+	ID         int64          `db:"id"`
+	TenantID   int64          `db:"tenant_id"`
+	Effect     string         `db:"effect"`
+	Permission sql.NullString `db:"permission"`
+	Role       sql.NullString `db:"role"`
+	UserID     sql.NullInt64  `db:"user_id"`
+	Endpoint   string         `db:"endpoint"`
+	Condition  string         `db:"condition"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  sql.NullTime   `db:"updated_at"`
 }
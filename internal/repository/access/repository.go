@@ -7,18 +7,28 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v4"
 
+	"github.com/valek177/auth/internal/matcher"
 	"github.com/valek177/auth/internal/model"
 	"github.com/valek177/auth/internal/repository"
 	"github.com/valek177/auth/internal/repository/access/converter"
 	repoModel "github.com/valek177/auth/internal/repository/access/model"
+	"github.com/valek177/auth/internal/tenant"
 	"github.com/valek177/platform-common/pkg/client/db"
 )
 
 const (
 	tableName = "access_list"
 
-	roleColumn     = "role"
-	endpointColumn = "endpoint"
+	idColumn         = "id"
+	tenantIDColumn   = "tenant_id"
+	effectColumn     = "effect"
+	permissionColumn = "permission"
+	roleColumn       = "role"
+	userIDColumn     = "user_id"
+	endpointColumn   = "endpoint"
+	conditionColumn  = "condition"
+	createdAtColumn  = "created_at"
+	updatedAtColumn  = "updated_at"
 )
 
 type repo struct {
@@ -30,34 +40,221 @@ func NewRepository(db db.Client) repository.AccessRepository {
 	return &repo{db: db}
 }
 
-// GetAccessRuleByEndpoint returns EndpointAccessRule by endpoint
+// GetAccessRuleByEndpoint returns access rules of endpoint patterns matching endpoint
 func (r *repo) GetAccessRuleByEndpoint(ctx context.Context, endpoint string) (
 	*model.EndpointAccessRule, error,
 ) {
-	builderSelect := sq.Select(roleColumn, endpointColumn).
+	m, err := r.GetAccessMatcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Match(endpoint), nil
+}
+
+// GetAccessMatcher compiles all access rules of tenant into matcher
+func (r *repo) GetAccessMatcher(ctx context.Context) (*matcher.Matcher, error) {
+	rules, err := r.ListRules(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return matcher.New(rules)
+}
+
+// CreateRule creates new access rule in tenant
+func (r *repo) CreateRule(ctx context.Context, rule *model.AccessRule) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, effectColumn, permissionColumn, roleColumn, userIDColumn,
+			endpointColumn, conditionColumn).
+		Values(tenantID, rule.Effect, converter.ToNullStringFromService(rule.Permission),
+			converter.ToNullStringFromService(rule.Role),
+			converter.ToNullInt64FromService(rule.UserID), rule.Endpoint, rule.Condition).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.CreateRule",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetRule returns access rule by id
+func (r *repo) GetRule(ctx context.Context, id int64) (*model.AccessRule, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, effectColumn, permissionColumn,
+		roleColumn, userIDColumn, endpointColumn, conditionColumn, createdAtColumn,
+		updatedAtColumn).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{endpointColumn: endpoint})
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID}).
+		Limit(1)
 
-	query, args, err := builderSelect.ToSql()
+	query, args, err := builderSelectOne.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
 	q := db.Query{
-		Name:     "access_repository.GetAccessRuleByEndpoint",
+		Name:     "access_repository.GetRule",
 		QueryRaw: query,
 	}
 
-	var rules []*repoModel.AccessRule
-
-	err = r.db.DB().ScanAllContext(ctx, &rules, q, args...)
+	var rule repoModel.Rule
+	err = r.db.DB().ScanOneContext(ctx, &rule, q, args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("access rules not found")
+			return nil, model.ErrorAccessRuleNotFound
 		}
 		return nil, err
 	}
 
-	return converter.ToEndpointAccessRuleFromRepo(endpoint, rules), nil
+	return converter.ToAccessRuleFromRepo(&rule), nil
+}
+
+// ListRules returns access rules of tenant matching filter
+func (r *repo) ListRules(ctx context.Context, filter *model.AccessRuleFilter) (
+	[]*model.AccessRule, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := sq.Eq{tenantIDColumn: tenantID}
+	if filter != nil && filter.Effect != "" {
+		cond[effectColumn] = filter.Effect
+	}
+	if filter != nil && filter.Permission != "" {
+		cond[permissionColumn] = filter.Permission
+	}
+	if filter != nil && filter.Role != "" {
+		cond[roleColumn] = filter.Role
+	}
+	if filter != nil && filter.UserID != 0 {
+		cond[userIDColumn] = filter.UserID
+	}
+	if filter != nil && filter.Endpoint != "" {
+		cond[endpointColumn] = filter.Endpoint
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, effectColumn, permissionColumn,
+		roleColumn, userIDColumn, endpointColumn, conditionColumn, createdAtColumn,
+		updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(cond).
+		OrderBy(idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.ListRules",
+		QueryRaw: query,
+	}
+
+	var rules []*repoModel.Rule
+	err = r.db.DB().ScanAllContext(ctx, &rules, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAccessRulesFromRepo(rules), nil
+}
+
+// UpdateRule updates permission, endpoint or condition of access rule
+func (r *repo) UpdateRule(ctx context.Context, update *model.UpdateAccessRule) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: update.ID, tenantIDColumn: tenantID})
+	if update.Permission != nil {
+		builderUpdate = builderUpdate.Set(permissionColumn, *update.Permission)
+	}
+	if update.Endpoint != nil {
+		builderUpdate = builderUpdate.Set(endpointColumn, *update.Endpoint)
+	}
+	if update.Condition != nil {
+		builderUpdate = builderUpdate.Set(conditionColumn, *update.Condition)
+	}
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.UpdateRule",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorAccessRuleNotFound
+	}
+
+	return nil
+}
+
+// DeleteRule deletes access rule
+func (r *repo) DeleteRule(ctx context.Context, id int64) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.DeleteRule",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorAccessRuleNotFound
+	}
+
+	return nil
 }
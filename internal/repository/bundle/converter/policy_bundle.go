@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/bundle/model"
+)
+
+// ToPolicyBundleVersionFromRepo converts policy bundle version from repository model
+// to service model
+func ToPolicyBundleVersionFromRepo(version *modelRepo.PolicyBundleVersion) (
+	*model.PolicyBundleVersion, error,
+) {
+	if version == nil {
+		return &model.PolicyBundleVersion{}, nil
+	}
+
+	var bundle model.PolicyBundle
+	if err := json.Unmarshal(version.Bundle, &bundle); err != nil {
+		return nil, err
+	}
+	var diff model.PolicyDiff
+	if err := json.Unmarshal(version.Diff, &diff); err != nil {
+		return nil, err
+	}
+
+	return &model.PolicyBundleVersion{
+		ID:        version.ID,
+		TenantID:  version.TenantID,
+		Version:   version.Version,
+		Bundle:    &bundle,
+		Diff:      &diff,
+		Author:    version.Author,
+		Action:    version.Action,
+		CreatedAt: version.CreatedAt,
+	}, nil
+}
+
+// ToPolicyBundleVersionsFromRepo converts policy bundle versions from repository model
+// to service model
+func ToPolicyBundleVersionsFromRepo(versions []*modelRepo.PolicyBundleVersion) (
+	[]*model.PolicyBundleVersion, error,
+) {
+	res := make([]*model.PolicyBundleVersion, 0, len(versions))
+	for _, version := range versions {
+		converted, err := ToPolicyBundleVersionFromRepo(version)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, converted)
+	}
+
+	return res, nil
+}
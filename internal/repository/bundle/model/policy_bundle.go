@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// PolicyBundleVersion contains applied policy bundle info, bundle and diff are JSON
+type PolicyBundleVersion struct {
+	ID        int64     `db:"id"`
+	TenantID  int64     `db:"tenant_id"`
+	Version   int64     `db:"version"`
+	Bundle    []byte    `db:"bundle"`
+	Diff      []byte    `db:"diff"`
+	Author    string    `db:"author"`
+	Action    string    `db:"action"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,191 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/bundle/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/bundle/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "policy_bundles"
+
+	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
+	versionColumn   = "version"
+	bundleColumn    = "bundle"
+	diffColumn      = "diff"
+	authorColumn    = "author"
+	actionColumn    = "action"
+	createdAtColumn = "created_at"
+)
+
+// queryCreatePolicyBundle stores bundle as next version of tenant policy,
+// concurrent applies of the same version violate unique constraint
+const queryCreatePolicyBundle = `
+insert into policy_bundles (tenant_id, version, bundle, diff, author, action)
+select $1, coalesce(max(version), 0) + 1, $2, $3, $4, $5
+from policy_bundles
+where tenant_id = $1
+returning version`
+
+// queryCreateInitialPolicyBundle stores bundle as version 0 of tenant policy
+// unless policy of tenant has versions
+const queryCreateInitialPolicyBundle = `
+insert into policy_bundles (tenant_id, version, bundle, diff, author, action)
+select $1, 0, $2, $3, $4, $5
+where not exists (select 1 from policy_bundles where tenant_id = $1)
+on conflict (tenant_id, version) do nothing`
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new policy bundles repository
+func NewRepository(db db.Client) repository.PolicyBundleRepository {
+	return &repo{db: db}
+}
+
+// CreatePolicyBundle stores applied bundle as next version of tenant policy
+// and returns the version
+func (r *repo) CreatePolicyBundle(ctx context.Context, version *model.PolicyBundleVersion) (
+	int64, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	bundle, err := json.Marshal(version.Bundle)
+	if err != nil {
+		return 0, err
+	}
+	diff, err := json.Marshal(version.Diff)
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "bundle_repository.CreatePolicyBundle",
+		QueryRaw: queryCreatePolicyBundle,
+	}
+
+	var created int64
+	err = r.db.DB().QueryRowContext(ctx, q, tenantID, bundle, diff, version.Author,
+		version.Action).Scan(&created)
+	if err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
+
+// CreateInitialPolicyBundle stores bundle as version 0 of tenant policy
+// if no version was stored yet
+func (r *repo) CreateInitialPolicyBundle(ctx context.Context,
+	version *model.PolicyBundleVersion,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := json.Marshal(version.Bundle)
+	if err != nil {
+		return err
+	}
+	diff, err := json.Marshal(version.Diff)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "bundle_repository.CreateInitialPolicyBundle",
+		QueryRaw: queryCreateInitialPolicyBundle,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, tenantID, bundle, diff, version.Author,
+		version.Action)
+
+	return err
+}
+
+// GetPolicyBundle returns version of tenant policy
+func (r *repo) GetPolicyBundle(ctx context.Context, version int64) (
+	*model.PolicyBundleVersion, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, versionColumn, bundleColumn,
+		diffColumn, authorColumn, actionColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, versionColumn: version}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "bundle_repository.GetPolicyBundle",
+		QueryRaw: query,
+	}
+
+	var res modelRepo.PolicyBundleVersion
+	err = r.db.DB().ScanOneContext(ctx, &res, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorPolicyVersionNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToPolicyBundleVersionFromRepo(&res)
+}
+
+// ListPolicyBundles returns versions of tenant policy, newest first
+func (r *repo) ListPolicyBundles(ctx context.Context) ([]*model.PolicyBundleVersion, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, versionColumn, bundleColumn,
+		diffColumn, authorColumn, actionColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(versionColumn + " DESC")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "bundle_repository.ListPolicyBundles",
+		QueryRaw: query,
+	}
+
+	var versions []*modelRepo.PolicyBundleVersion
+	err = r.db.DB().ScanAllContext(ctx, &versions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPolicyBundleVersionsFromRepo(versions)
+}
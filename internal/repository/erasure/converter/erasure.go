@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/erasure/model"
+)
+
+// ToErasureRequestFromRepo converts erasure request from repository model to service model
+func ToErasureRequestFromRepo(request *modelRepo.ErasureRequest) *model.ErasureRequest {
+	if request == nil {
+		return &model.ErasureRequest{}
+	}
+
+	return &model.ErasureRequest{
+		ID:          request.ID,
+		TenantID:    request.TenantID,
+		UserID:      request.UserID,
+		RequestedBy: request.RequestedBy,
+		ReviewedBy:  request.ReviewedBy,
+		Reason:      request.Reason,
+		Status:      request.Status,
+		CreatedAt:   request.CreatedAt,
+		UpdatedAt:   request.UpdatedAt,
+	}
+}
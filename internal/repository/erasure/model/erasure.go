@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ErasureRequest contains user erasure request info
+type ErasureRequest struct {
+	ID          int64          `db:"id"`
+	TenantID    int64          `db:"tenant_id"`
+	UserID      int64          `db:"user_id"`
+	RequestedBy string         `db:"requested_by"`
+	ReviewedBy  sql.NullString `db:"reviewed_by"`
+	Reason      string         `db:"reason"`
+	Status      string         `db:"status"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at"`
+}
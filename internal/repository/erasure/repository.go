@@ -0,0 +1,184 @@
+package erasure
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/erasure/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/erasure/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "erasure_requests"
+
+	idColumn          = "id"
+	tenantIDColumn    = "tenant_id"
+	userIDColumn      = "user_id"
+	requestedByColumn = "requested_by"
+	reviewedByColumn  = "reviewed_by"
+	reasonColumn      = "reason"
+	statusColumn      = "status"
+	createdAtColumn   = "created_at"
+	updatedAtColumn   = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new erasure requests repository
+func NewRepository(db db.Client) repository.ErasureRepository {
+	return &repo{db: db}
+}
+
+// CreateErasureRequest creates new erasure request in tenant
+func (r *repo) CreateErasureRequest(ctx context.Context, request *model.ErasureRequest) (
+	int64, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, userIDColumn, requestedByColumn, reasonColumn, statusColumn).
+		Values(tenantID, request.UserID, request.RequestedBy, request.Reason, request.Status).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "erasure_repository.CreateErasureRequest",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetErasureRequest returns erasure request by id
+func (r *repo) GetErasureRequest(ctx context.Context, id int64) (*model.ErasureRequest, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, userIDColumn, requestedByColumn,
+		reviewedByColumn, reasonColumn, statusColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "erasure_repository.GetErasureRequest",
+		QueryRaw: query,
+	}
+
+	var request modelRepo.ErasureRequest
+	err = r.db.DB().ScanOneContext(ctx, &request, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorErasureRequestNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToErasureRequestFromRepo(&request), nil
+}
+
+// UpdateErasureRequestStatus sets status of pending erasure request and its reviewer
+func (r *repo) UpdateErasureRequestStatus(ctx context.Context, id int64,
+	status, reviewedBy string,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, status).
+		Set(reviewedByColumn, reviewedBy).
+		Where(sq.Eq{
+			idColumn:       id,
+			tenantIDColumn: tenantID,
+			statusColumn:   model.ErasureStatusPending,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "erasure_repository.UpdateErasureRequestStatus",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorErasureRequestNotFound
+	}
+
+	return nil
+}
+
+// PseudonymizeErasureRequests replaces name of user in requesters and reviewers
+// of erasure requests of tenant with pseudonym
+func (r *repo) PseudonymizeErasureRequests(ctx context.Context, username, pseudonym string,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(requestedByColumn, replaceExpr(requestedByColumn, username, pseudonym)).
+		Set(reviewedByColumn, replaceExpr(reviewedByColumn, username, pseudonym)).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		Where(sq.Or{sq.Eq{requestedByColumn: username}, sq.Eq{reviewedByColumn: username}})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "erasure_repository.PseudonymizeErasureRequests",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// replaceExpr returns value of column with old value replaced by new one
+func replaceExpr(column, oldValue, newValue string) sq.Sqlizer {
+	return sq.Expr("CASE WHEN "+column+" = ? THEN ? ELSE "+column+" END", oldValue, newValue)
+}
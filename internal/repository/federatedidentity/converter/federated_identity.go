@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/federatedidentity/model"
+)
+
+// ToFederatedIdentityFromRepo converts federated identity from repository model
+// to service model
+func ToFederatedIdentityFromRepo(identity *modelRepo.FederatedIdentity,
+) *model.FederatedIdentity {
+	if identity == nil {
+		return &model.FederatedIdentity{}
+	}
+
+	return &model.FederatedIdentity{
+		ID:        identity.ID,
+		TenantID:  identity.TenantID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		UserID:    identity.UserID,
+		CreatedAt: identity.CreatedAt,
+	}
+}
+
+// ToFederatedIdentitiesFromRepo converts federated identities from repository model
+// to service model
+func ToFederatedIdentitiesFromRepo(identities []*modelRepo.FederatedIdentity,
+) []*model.FederatedIdentity {
+	res := make([]*model.FederatedIdentity, 0, len(identities))
+	for _, identity := range identities {
+		res = append(res, ToFederatedIdentityFromRepo(identity))
+	}
+
+	return res
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// FederatedIdentity contains link of upstream provider account to user
+type FederatedIdentity struct {
+	ID        int64     `db:"id"`
+	TenantID  int64     `db:"tenant_id"`
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	UserID    int64     `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,170 @@
+package federatedidentity
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/federatedidentity/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/federatedidentity/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "federated_identities"
+
+	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
+	providerColumn  = "provider"
+	subjectColumn   = "subject"
+	userIDColumn    = "user_id"
+	createdAtColumn = "created_at"
+)
+
+var selectColumns = []string{
+	idColumn, tenantIDColumn, providerColumn, subjectColumn, userIDColumn, createdAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new federated identities repository
+func NewRepository(db db.Client) repository.FederatedIdentityRepository {
+	return &repo{db: db}
+}
+
+// CreateFederatedIdentity links upstream provider account to user of tenant
+func (r *repo) CreateFederatedIdentity(ctx context.Context,
+	identity *model.FederatedIdentity,
+) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, providerColumn, subjectColumn, userIDColumn).
+		Values(tenantID, identity.Provider, identity.Subject, identity.UserID).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "federated_identity_repository.CreateFederatedIdentity",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetFederatedIdentity returns link of upstream provider account in tenant
+func (r *repo) GetFederatedIdentity(ctx context.Context, provider, subject string) (
+	*model.FederatedIdentity, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, providerColumn: provider, subjectColumn: subject}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "federated_identity_repository.GetFederatedIdentity",
+		QueryRaw: query,
+	}
+
+	var identity modelRepo.FederatedIdentity
+	err = r.db.DB().ScanOneContext(ctx, &identity, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorFederatedIdentityNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToFederatedIdentityFromRepo(&identity), nil
+}
+
+// ListFederatedIdentitiesByUserID returns upstream provider accounts linked to user
+func (r *repo) ListFederatedIdentitiesByUserID(ctx context.Context, userID int64) (
+	[]*model.FederatedIdentity, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID}).
+		OrderBy(idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "federated_identity_repository.ListFederatedIdentitiesByUserID",
+		QueryRaw: query,
+	}
+
+	var identities []*modelRepo.FederatedIdentity
+	err = r.db.DB().ScanAllContext(ctx, &identities, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToFederatedIdentitiesFromRepo(identities), nil
+}
+
+// DeleteFederatedIdentitiesByUserID unlinks all upstream provider accounts from user
+func (r *repo) DeleteFederatedIdentitiesByUserID(ctx context.Context, userID int64) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "federated_identity_repository.DeleteFederatedIdentitiesByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
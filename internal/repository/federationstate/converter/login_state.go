@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/federationstate/model"
+)
+
+// ToLoginStateFromRepo converts federated login state from repository model
+// to service model
+func ToLoginStateFromRepo(state *modelRepo.LoginState) *model.FederationLoginState {
+	if state == nil {
+		return &model.FederationLoginState{}
+	}
+
+	return &model.FederationLoginState{
+		StateHash: state.StateHash,
+		TenantID:  state.TenantID,
+		Provider:  state.Provider,
+		Request: &model.OAuthAuthorizationRequest{
+			ResponseType:        model.OAuthResponseTypeCode,
+			ClientID:            state.ClientID,
+			RedirectURI:         state.RedirectURI,
+			Scope:               state.Scope,
+			State:               state.State,
+			CodeChallenge:       state.CodeChallenge,
+			CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+			Nonce:               state.Nonce,
+		},
+		UpstreamNonce: state.UpstreamNonce,
+		CodeVerifier:  state.CodeVerifier,
+		ExpiresAt:     state.ExpiresAt,
+		CreatedAt:     state.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// LoginState contains federated login in progress
+type LoginState struct {
+	StateHash     string    `db:"state_hash"`
+	TenantID      int64     `db:"tenant_id"`
+	Provider      string    `db:"provider"`
+	ClientID      string    `db:"client_id"`
+	RedirectURI   string    `db:"redirect_uri"`
+	Scope         string    `db:"scope"`
+	State         string    `db:"state"`
+	CodeChallenge string    `db:"code_challenge"`
+	Nonce         string    `db:"nonce"`
+	UpstreamNonce string    `db:"upstream_nonce"`
+	CodeVerifier  string    `db:"code_verifier"`
+	ExpiresAt     time.Time `db:"expires_at"`
+	CreatedAt     time.Time `db:"created_at"`
+}
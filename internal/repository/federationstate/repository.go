@@ -0,0 +1,148 @@
+package federationstate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/federationstate/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/federationstate/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "federation_login_states"
+
+	stateHashColumn     = "state_hash"
+	tenantIDColumn      = "tenant_id"
+	providerColumn      = "provider"
+	clientIDColumn      = "client_id"
+	redirectURIColumn   = "redirect_uri"
+	scopeColumn         = "scope"
+	stateColumn         = "state"
+	codeChallengeColumn = "code_challenge"
+	nonceColumn         = "nonce"
+	upstreamNonceColumn = "upstream_nonce"
+	codeVerifierColumn  = "code_verifier"
+	expiresAtColumn     = "expires_at"
+	createdAtColumn     = "created_at"
+)
+
+var selectColumns = []string{
+	stateHashColumn, tenantIDColumn, providerColumn, clientIDColumn, redirectURIColumn,
+	scopeColumn, stateColumn, codeChallengeColumn, nonceColumn, upstreamNonceColumn,
+	codeVerifierColumn, expiresAtColumn, createdAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new federated login states repository
+func NewRepository(db db.Client) repository.FederationStateRepository {
+	return &repo{db: db}
+}
+
+// CreateLoginState saves federated login started in tenant
+func (r *repo) CreateLoginState(ctx context.Context, state *model.FederationLoginState) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(stateHashColumn, tenantIDColumn, providerColumn, clientIDColumn,
+			redirectURIColumn, scopeColumn, stateColumn, codeChallengeColumn, nonceColumn,
+			upstreamNonceColumn, codeVerifierColumn, expiresAtColumn).
+		Values(state.StateHash, tenantID, state.Provider, state.Request.ClientID,
+			state.Request.RedirectURI, state.Request.Scope, state.Request.State,
+			state.Request.CodeChallenge, state.Request.Nonce, state.UpstreamNonce,
+			state.CodeVerifier, state.ExpiresAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "federation_state_repository.CreateLoginState",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// ConsumeLoginState deletes federated login of tenant and returns it,
+// so every login is completed at most once
+func (r *repo) ConsumeLoginState(ctx context.Context, stateHash string) (
+	*model.FederationLoginState, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, stateHashColumn: stateHash}).
+		Suffix("RETURNING " + strings.Join(selectColumns, ", "))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "federation_state_repository.ConsumeLoginState",
+		QueryRaw: query,
+	}
+
+	var state modelRepo.LoginState
+	err = r.db.DB().ScanOneContext(ctx, &state, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorFederationInvalidState
+		}
+		return nil, err
+	}
+
+	return converter.ToLoginStateFromRepo(&state), nil
+}
+
+// DeleteExpiredLoginStates deletes federated logins of tenant which expired
+// not later than given time
+func (r *repo) DeleteExpiredLoginStates(ctx context.Context, at time.Time) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		Where(sq.LtOrEq{expiresAtColumn: at})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "federation_state_repository.DeleteExpiredLoginStates",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -4,3 +4,22 @@ package repository
 //go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i LogRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i UserRedisRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OrganizationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RoleRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i InvitationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OperationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ErasureRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RoleGrantRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i TokenRevocationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PolicySnapshotRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PolicyChangeRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PolicyBundleRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OAuthClientRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OAuthAuthorizationCodeRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ServiceAccountRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i FederatedIdentityRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i FederationStateRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PermissionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RelationTupleRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RelationNamespaceRepository -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/invitation/model"
+)
+
+// ToInvitationFromRepo converts invitation from repository model to service model
+func ToInvitationFromRepo(invitation *modelRepo.Invitation) *model.Invitation {
+	if invitation == nil {
+		return &model.Invitation{}
+	}
+
+	return &model.Invitation{
+		ID:        invitation.ID,
+		TenantID:  invitation.TenantID,
+		UserID:    invitation.UserID,
+		Email:     invitation.Email,
+		TokenID:   invitation.TokenID,
+		Status:    invitation.Status,
+		ExpiresAt: invitation.ExpiresAt,
+		CreatedAt: invitation.CreatedAt,
+		UpdatedAt: invitation.UpdatedAt,
+	}
+}
+
+// ToInvitationsFromRepo converts invitations from repository model to service model
+func ToInvitationsFromRepo(invitations []*modelRepo.Invitation) []*model.Invitation {
+	res := make([]*model.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		res = append(res, ToInvitationFromRepo(invitation))
+	}
+
+	return res
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Invitation contains invitation info
+type Invitation struct {
+	ID        int64         `db:"id"`
+	TenantID  int64         `db:"tenant_id"`
+	UserID    sql.NullInt64 `db:"user_id"`
+	Email     string        `db:"email"`
+	TokenID   string        `db:"token_id"`
+	Status    string        `db:"status"`
+	ExpiresAt time.Time     `db:"expires_at"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt sql.NullTime  `db:"updated_at"`
+}
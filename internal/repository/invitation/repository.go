@@ -0,0 +1,240 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/invitation/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/invitation/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "invitations"
+
+	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
+	userIDColumn    = "user_id"
+	emailColumn     = "email"
+	tokenIDColumn   = "token_id"
+	statusColumn    = "status"
+	expiresAtColumn = "expires_at"
+	createdAtColumn = "created_at"
+	updatedAtColumn = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new invitation repository
+func NewRepository(db db.Client) repository.InvitationRepository {
+	return &repo{db: db}
+}
+
+// CreateInvitation creates new invitation in tenant
+func (r *repo) CreateInvitation(ctx context.Context, invitation *model.Invitation) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, userIDColumn, emailColumn, tokenIDColumn, statusColumn,
+			expiresAtColumn).
+		Values(tenantID, invitation.UserID, invitation.Email, invitation.TokenID,
+			invitation.Status, invitation.ExpiresAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "invitation_repository.CreateInvitation",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetInvitation returns invitation by id
+func (r *repo) GetInvitation(ctx context.Context, id int64) (*model.Invitation, error) {
+	return r.getInvitation(ctx, "invitation_repository.GetInvitation", sq.Eq{idColumn: id})
+}
+
+// GetInvitationByTokenID returns invitation by id of its current token
+func (r *repo) GetInvitationByTokenID(ctx context.Context, tokenID string) (
+	*model.Invitation, error,
+) {
+	return r.getInvitation(ctx, "invitation_repository.GetInvitationByTokenID",
+		sq.Eq{tokenIDColumn: tokenID})
+}
+
+func (r *repo) getInvitation(ctx context.Context, name string, cond sq.Eq) (
+	*model.Invitation, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, userIDColumn, emailColumn,
+		tokenIDColumn, statusColumn, expiresAtColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(cond).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	var invitation modelRepo.Invitation
+	err = r.db.DB().ScanOneContext(ctx, &invitation, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorInvitationNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToInvitationFromRepo(&invitation), nil
+}
+
+// UpdateInvitationToken replaces invitation token, previous token becomes invalid
+func (r *repo) UpdateInvitationToken(ctx context.Context, id int64, tokenID string,
+	expiresAt time.Time,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(tokenIDColumn, tokenID).
+		Set(expiresAtColumn, expiresAt).
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID})
+
+	return r.exec(ctx, "invitation_repository.UpdateInvitationToken", builderUpdate)
+}
+
+// UpdateInvitationStatus sets invitation status
+func (r *repo) UpdateInvitationStatus(ctx context.Context, id int64, status string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, status).
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID})
+
+	return r.exec(ctx, "invitation_repository.UpdateInvitationStatus", builderUpdate)
+}
+
+// ListInvitationsByUserID returns invitations of user
+func (r *repo) ListInvitationsByUserID(ctx context.Context, userID int64) (
+	[]*model.Invitation, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, userIDColumn, emailColumn,
+		tokenIDColumn, statusColumn, expiresAtColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID, tenantIDColumn: tenantID}).
+		OrderBy(idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "invitation_repository.ListInvitationsByUserID",
+		QueryRaw: query,
+	}
+
+	var invitations []*modelRepo.Invitation
+	err = r.db.DB().ScanAllContext(ctx, &invitations, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToInvitationsFromRepo(invitations), nil
+}
+
+// DeleteInvitationsByUserID deletes all invitations of user
+func (r *repo) DeleteInvitationsByUserID(ctx context.Context, userID int64) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID, tenantIDColumn: tenantID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "invitation_repository.DeleteInvitationsByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+func (r *repo) exec(ctx context.Context, name string, builder sq.UpdateBuilder) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorInvitationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/log/model"
+)
+
+// ToRecordsFromRepo converts log records from repository model to service model
+func ToRecordsFromRepo(records []*modelRepo.Record) []*model.Record {
+	res := make([]*model.Record, 0, len(records))
+	for _, record := range records {
+		res = append(res, &model.Record{
+			ID:        record.ID,
+			UserID:    record.UserID,
+			Action:    record.Action,
+			Pseudonym: record.Pseudonym.String,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+
+	return res
+}
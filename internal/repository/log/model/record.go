@@ -0,0 +1,15 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Record contains users log record
+type Record struct {
+	ID        int64          `db:"id"`
+	UserID    int64          `db:"user_id"`
+	Action    string         `db:"action"`
+	Pseudonym sql.NullString `db:"pseudonym"`
+	CreatedAt time.Time      `db:"created_at"`
+}
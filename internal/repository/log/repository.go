@@ -2,12 +2,16 @@ package log
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/valek177/auth/internal/model"
 	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/log/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/log/model"
+	"github.com/valek177/auth/internal/tenant"
 	"github.com/valek177/platform-common/pkg/client/db"
 )
 
@@ -15,8 +19,10 @@ const (
 	tableName = "users_log"
 
 	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
 	userIDColumn    = "user_id"
 	actionColumn    = "action"
+	pseudonymColumn = "pseudonym"
 	createdAtColumn = "created_at"
 )
 
@@ -31,9 +37,15 @@ func NewRepository(db db.Client) repository.LogRepository {
 
 // CreateRecord creates new record in users log table
 func (r *repo) CreateRecord(ctx context.Context, record *model.Record) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
 	builderInsert := sq.Insert(tableName).
-		Columns(userIDColumn, actionColumn, createdAtColumn).
-		Values(record.UserID, record.Action, time.Now()).
+		Columns(tenantIDColumn, userIDColumn, actionColumn, pseudonymColumn, createdAtColumn).
+		Values(tenantID, record.UserID, record.Action,
+			sql.NullString{String: record.Pseudonym, Valid: record.Pseudonym != ""}, time.Now()).
 		PlaceholderFormat(sq.Dollar).
 		Suffix("RETURNING id")
 
@@ -54,3 +66,64 @@ func (r *repo) CreateRecord(ctx context.Context, record *model.Record) (int64, e
 
 	return recordID, nil
 }
+
+// ListRecords returns log records of user ordered by creation time
+func (r *repo) ListRecords(ctx context.Context, userID int64) ([]*model.Record, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, userIDColumn, actionColumn, pseudonymColumn,
+		createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID}).
+		OrderBy(createdAtColumn, idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "log_repository.ListRecords",
+		QueryRaw: query,
+	}
+
+	var records []*modelRepo.Record
+	err = r.db.DB().ScanAllContext(ctx, &records, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRecordsFromRepo(records), nil
+}
+
+// PseudonymizeRecords unlinks log records from user, records keep pseudonym instead
+func (r *repo) PseudonymizeRecords(ctx context.Context, userID int64, pseudonym string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(userIDColumn, 0).
+		Set(pseudonymColumn, pseudonym).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "log_repository.PseudonymizeRecords",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -0,0 +1,2522 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.AccessRepository -o access_repository_minimock.go -n AccessRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+)
+
+// AccessRepositoryMock implements mm_repository.AccessRepository
+type AccessRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateRule          func(ctx context.Context, rule *model.AccessRule) (i1 int64, err error)
+	funcCreateRuleOrigin    string
+	inspectFuncCreateRule   func(ctx context.Context, rule *model.AccessRule)
+	afterCreateRuleCounter  uint64
+	beforeCreateRuleCounter uint64
+	CreateRuleMock          mAccessRepositoryMockCreateRule
+
+	funcDeleteRule          func(ctx context.Context, id int64) (err error)
+	funcDeleteRuleOrigin    string
+	inspectFuncDeleteRule   func(ctx context.Context, id int64)
+	afterDeleteRuleCounter  uint64
+	beforeDeleteRuleCounter uint64
+	DeleteRuleMock          mAccessRepositoryMockDeleteRule
+
+	funcGetAccessMatcher          func(ctx context.Context) (mp1 *matcher.Matcher, err error)
+	funcGetAccessMatcherOrigin    string
+	inspectFuncGetAccessMatcher   func(ctx context.Context)
+	afterGetAccessMatcherCounter  uint64
+	beforeGetAccessMatcherCounter uint64
+	GetAccessMatcherMock          mAccessRepositoryMockGetAccessMatcher
+
+	funcGetAccessRuleByEndpoint          func(ctx context.Context, endpoint string) (ep1 *model.EndpointAccessRule, err error)
+	funcGetAccessRuleByEndpointOrigin    string
+	inspectFuncGetAccessRuleByEndpoint   func(ctx context.Context, endpoint string)
+	afterGetAccessRuleByEndpointCounter  uint64
+	beforeGetAccessRuleByEndpointCounter uint64
+	GetAccessRuleByEndpointMock          mAccessRepositoryMockGetAccessRuleByEndpoint
+
+	funcGetRule          func(ctx context.Context, id int64) (ap1 *model.AccessRule, err error)
+	funcGetRuleOrigin    string
+	inspectFuncGetRule   func(ctx context.Context, id int64)
+	afterGetRuleCounter  uint64
+	beforeGetRuleCounter uint64
+	GetRuleMock          mAccessRepositoryMockGetRule
+
+	funcListRules          func(ctx context.Context, filter *model.AccessRuleFilter) (apa1 []*model.AccessRule, err error)
+	funcListRulesOrigin    string
+	inspectFuncListRules   func(ctx context.Context, filter *model.AccessRuleFilter)
+	afterListRulesCounter  uint64
+	beforeListRulesCounter uint64
+	ListRulesMock          mAccessRepositoryMockListRules
+
+	funcUpdateRule          func(ctx context.Context, update *model.UpdateAccessRule) (err error)
+	funcUpdateRuleOrigin    string
+	inspectFuncUpdateRule   func(ctx context.Context, update *model.UpdateAccessRule)
+	afterUpdateRuleCounter  uint64
+	beforeUpdateRuleCounter uint64
+	UpdateRuleMock          mAccessRepositoryMockUpdateRule
+}
+
+// NewAccessRepositoryMock returns a mock for mm_repository.AccessRepository
+func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
+	m := &AccessRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateRuleMock = mAccessRepositoryMockCreateRule{mock: m}
+	m.CreateRuleMock.callArgs = []*AccessRepositoryMockCreateRuleParams{}
+
+	m.DeleteRuleMock = mAccessRepositoryMockDeleteRule{mock: m}
+	m.DeleteRuleMock.callArgs = []*AccessRepositoryMockDeleteRuleParams{}
+
+	m.GetAccessMatcherMock = mAccessRepositoryMockGetAccessMatcher{mock: m}
+	m.GetAccessMatcherMock.callArgs = []*AccessRepositoryMockGetAccessMatcherParams{}
+
+	m.GetAccessRuleByEndpointMock = mAccessRepositoryMockGetAccessRuleByEndpoint{mock: m}
+	m.GetAccessRuleByEndpointMock.callArgs = []*AccessRepositoryMockGetAccessRuleByEndpointParams{}
+
+	m.GetRuleMock = mAccessRepositoryMockGetRule{mock: m}
+	m.GetRuleMock.callArgs = []*AccessRepositoryMockGetRuleParams{}
+
+	m.ListRulesMock = mAccessRepositoryMockListRules{mock: m}
+	m.ListRulesMock.callArgs = []*AccessRepositoryMockListRulesParams{}
+
+	m.UpdateRuleMock = mAccessRepositoryMockUpdateRule{mock: m}
+	m.UpdateRuleMock.callArgs = []*AccessRepositoryMockUpdateRuleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessRepositoryMockCreateRule struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockCreateRuleExpectation
+	expectations       []*AccessRepositoryMockCreateRuleExpectation
+
+	callArgs []*AccessRepositoryMockCreateRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockCreateRuleExpectation specifies expectation struct of the AccessRepository.CreateRule
+type AccessRepositoryMockCreateRuleExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockCreateRuleParams
+	paramPtrs          *AccessRepositoryMockCreateRuleParamPtrs
+	expectationOrigins AccessRepositoryMockCreateRuleExpectationOrigins
+	results            *AccessRepositoryMockCreateRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockCreateRuleParams contains parameters of the AccessRepository.CreateRule
+type AccessRepositoryMockCreateRuleParams struct {
+	ctx  context.Context
+	rule *model.AccessRule
+}
+
+// AccessRepositoryMockCreateRuleParamPtrs contains pointers to parameters of the AccessRepository.CreateRule
+type AccessRepositoryMockCreateRuleParamPtrs struct {
+	ctx  *context.Context
+	rule **model.AccessRule
+}
+
+// AccessRepositoryMockCreateRuleResults contains results of the AccessRepository.CreateRule
+type AccessRepositoryMockCreateRuleResults struct {
+	i1  int64
+	err error
+}
+
+// AccessRepositoryMockCreateRuleOrigins contains origins of expectations of the AccessRepository.CreateRule
+type AccessRepositoryMockCreateRuleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRule string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Optional() *mAccessRepositoryMockCreateRule {
+	mmCreateRule.optional = true
+	return mmCreateRule
+}
+
+// Expect sets up expected params for AccessRepository.CreateRule
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Expect(ctx context.Context, rule *model.AccessRule) *mAccessRepositoryMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &AccessRepositoryMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRule.defaultExpectation.params = &AccessRepositoryMockCreateRuleParams{ctx, rule}
+	mmCreateRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRule.expectations {
+		if minimock.Equal(e.params, mmCreateRule.defaultExpectation.params) {
+			mmCreateRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRule.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.CreateRule
+func (mmCreateRule *mAccessRepositoryMockCreateRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &AccessRepositoryMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.params != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Expect")
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRule.defaultExpectation.paramPtrs = &AccessRepositoryMockCreateRuleParamPtrs{}
+	}
+	mmCreateRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRule
+}
+
+// ExpectRuleParam2 sets up expected param rule for AccessRepository.CreateRule
+func (mmCreateRule *mAccessRepositoryMockCreateRule) ExpectRuleParam2(rule *model.AccessRule) *mAccessRepositoryMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &AccessRepositoryMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.params != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Expect")
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRule.defaultExpectation.paramPtrs = &AccessRepositoryMockCreateRuleParamPtrs{}
+	}
+	mmCreateRule.defaultExpectation.paramPtrs.rule = &rule
+	mmCreateRule.defaultExpectation.expectationOrigins.originRule = minimock.CallerInfo(1)
+
+	return mmCreateRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.CreateRule
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Inspect(f func(ctx context.Context, rule *model.AccessRule)) *mAccessRepositoryMockCreateRule {
+	if mmCreateRule.mock.inspectFuncCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.CreateRule")
+	}
+
+	mmCreateRule.mock.inspectFuncCreateRule = f
+
+	return mmCreateRule
+}
+
+// Return sets up results that will be returned by AccessRepository.CreateRule
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Return(i1 int64, err error) *AccessRepositoryMock {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &AccessRepositoryMockCreateRuleExpectation{mock: mmCreateRule.mock}
+	}
+	mmCreateRule.defaultExpectation.results = &AccessRepositoryMockCreateRuleResults{i1, err}
+	mmCreateRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.CreateRule method
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Set(f func(ctx context.Context, rule *model.AccessRule) (i1 int64, err error)) *AccessRepositoryMock {
+	if mmCreateRule.defaultExpectation != nil {
+		mmCreateRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.CreateRule method")
+	}
+
+	if len(mmCreateRule.expectations) > 0 {
+		mmCreateRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.CreateRule method")
+	}
+
+	mmCreateRule.mock.funcCreateRule = f
+	mmCreateRule.mock.funcCreateRuleOrigin = minimock.CallerInfo(1)
+	return mmCreateRule.mock
+}
+
+// When sets expectation for the AccessRepository.CreateRule which will trigger the result defined by the following
+// Then helper
+func (mmCreateRule *mAccessRepositoryMockCreateRule) When(ctx context.Context, rule *model.AccessRule) *AccessRepositoryMockCreateRuleExpectation {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("AccessRepositoryMock.CreateRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockCreateRuleExpectation{
+		mock:               mmCreateRule.mock,
+		params:             &AccessRepositoryMockCreateRuleParams{ctx, rule},
+		expectationOrigins: AccessRepositoryMockCreateRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRule.expectations = append(mmCreateRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.CreateRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockCreateRuleExpectation) Then(i1 int64, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockCreateRuleResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.CreateRule should be invoked
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Times(n uint64) *mAccessRepositoryMockCreateRule {
+	if n == 0 {
+		mmCreateRule.mock.t.Fatalf("Times of AccessRepositoryMock.CreateRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRule.expectedInvocations, n)
+	mmCreateRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRule
+}
+
+func (mmCreateRule *mAccessRepositoryMockCreateRule) invocationsDone() bool {
+	if len(mmCreateRule.expectations) == 0 && mmCreateRule.defaultExpectation == nil && mmCreateRule.mock.funcCreateRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRule.mock.afterCreateRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRule implements mm_repository.AccessRepository
+func (mmCreateRule *AccessRepositoryMock) CreateRule(ctx context.Context, rule *model.AccessRule) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRule.beforeCreateRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRule.afterCreateRuleCounter, 1)
+
+	mmCreateRule.t.Helper()
+
+	if mmCreateRule.inspectFuncCreateRule != nil {
+		mmCreateRule.inspectFuncCreateRule(ctx, rule)
+	}
+
+	mm_params := AccessRepositoryMockCreateRuleParams{ctx, rule}
+
+	// Record call args
+	mmCreateRule.CreateRuleMock.mutex.Lock()
+	mmCreateRule.CreateRuleMock.callArgs = append(mmCreateRule.CreateRuleMock.callArgs, &mm_params)
+	mmCreateRule.CreateRuleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRule.CreateRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRule.CreateRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRule.CreateRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRule.CreateRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRule.CreateRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockCreateRuleParams{ctx, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRule.t.Errorf("AccessRepositoryMock.CreateRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmCreateRule.t.Errorf("AccessRepositoryMock.CreateRule got unexpected parameter rule, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.originRule, *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRule.t.Errorf("AccessRepositoryMock.CreateRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRule.CreateRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRule.t.Fatal("No results are set for the AccessRepositoryMock.CreateRule")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRule.funcCreateRule != nil {
+		return mmCreateRule.funcCreateRule(ctx, rule)
+	}
+	mmCreateRule.t.Fatalf("Unexpected call to AccessRepositoryMock.CreateRule. %v %v", ctx, rule)
+	return
+}
+
+// CreateRuleAfterCounter returns a count of finished AccessRepositoryMock.CreateRule invocations
+func (mmCreateRule *AccessRepositoryMock) CreateRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRule.afterCreateRuleCounter)
+}
+
+// CreateRuleBeforeCounter returns a count of AccessRepositoryMock.CreateRule invocations
+func (mmCreateRule *AccessRepositoryMock) CreateRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRule.beforeCreateRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.CreateRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRule *mAccessRepositoryMockCreateRule) Calls() []*AccessRepositoryMockCreateRuleParams {
+	mmCreateRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockCreateRuleParams, len(mmCreateRule.callArgs))
+	copy(argCopy, mmCreateRule.callArgs)
+
+	mmCreateRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRuleDone returns true if the count of the CreateRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockCreateRuleDone() bool {
+	if m.CreateRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRuleMock.invocationsDone()
+}
+
+// MinimockCreateRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockCreateRuleInspect() {
+	for _, e := range m.CreateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CreateRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRuleCounter := mm_atomic.LoadUint64(&m.afterCreateRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRuleMock.defaultExpectation != nil && afterCreateRuleCounter < 1 {
+		if m.CreateRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CreateRule at\n%s", m.CreateRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CreateRule at\n%s with params: %#v", m.CreateRuleMock.defaultExpectation.expectationOrigins.origin, *m.CreateRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRule != nil && afterCreateRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.CreateRule at\n%s", m.funcCreateRuleOrigin)
+	}
+
+	if !m.CreateRuleMock.invocationsDone() && afterCreateRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.CreateRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRuleMock.expectedInvocations), m.CreateRuleMock.expectedInvocationsOrigin, afterCreateRuleCounter)
+	}
+}
+
+type mAccessRepositoryMockDeleteRule struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockDeleteRuleExpectation
+	expectations       []*AccessRepositoryMockDeleteRuleExpectation
+
+	callArgs []*AccessRepositoryMockDeleteRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockDeleteRuleExpectation specifies expectation struct of the AccessRepository.DeleteRule
+type AccessRepositoryMockDeleteRuleExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockDeleteRuleParams
+	paramPtrs          *AccessRepositoryMockDeleteRuleParamPtrs
+	expectationOrigins AccessRepositoryMockDeleteRuleExpectationOrigins
+	results            *AccessRepositoryMockDeleteRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockDeleteRuleParams contains parameters of the AccessRepository.DeleteRule
+type AccessRepositoryMockDeleteRuleParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessRepositoryMockDeleteRuleParamPtrs contains pointers to parameters of the AccessRepository.DeleteRule
+type AccessRepositoryMockDeleteRuleParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessRepositoryMockDeleteRuleResults contains results of the AccessRepository.DeleteRule
+type AccessRepositoryMockDeleteRuleResults struct {
+	err error
+}
+
+// AccessRepositoryMockDeleteRuleOrigins contains origins of expectations of the AccessRepository.DeleteRule
+type AccessRepositoryMockDeleteRuleExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Optional() *mAccessRepositoryMockDeleteRule {
+	mmDeleteRule.optional = true
+	return mmDeleteRule
+}
+
+// Expect sets up expected params for AccessRepository.DeleteRule
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Expect(ctx context.Context, id int64) *mAccessRepositoryMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &AccessRepositoryMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRule.defaultExpectation.params = &AccessRepositoryMockDeleteRuleParams{ctx, id}
+	mmDeleteRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRule.expectations {
+		if minimock.Equal(e.params, mmDeleteRule.defaultExpectation.params) {
+			mmDeleteRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRule.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.DeleteRule
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &AccessRepositoryMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.params != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockDeleteRuleParamPtrs{}
+	}
+	mmDeleteRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRule
+}
+
+// ExpectIdParam2 sets up expected param id for AccessRepository.DeleteRule
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) ExpectIdParam2(id int64) *mAccessRepositoryMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &AccessRepositoryMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.params != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockDeleteRuleParamPtrs{}
+	}
+	mmDeleteRule.defaultExpectation.paramPtrs.id = &id
+	mmDeleteRule.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.DeleteRule
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Inspect(f func(ctx context.Context, id int64)) *mAccessRepositoryMockDeleteRule {
+	if mmDeleteRule.mock.inspectFuncDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.DeleteRule")
+	}
+
+	mmDeleteRule.mock.inspectFuncDeleteRule = f
+
+	return mmDeleteRule
+}
+
+// Return sets up results that will be returned by AccessRepository.DeleteRule
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Return(err error) *AccessRepositoryMock {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &AccessRepositoryMockDeleteRuleExpectation{mock: mmDeleteRule.mock}
+	}
+	mmDeleteRule.defaultExpectation.results = &AccessRepositoryMockDeleteRuleResults{err}
+	mmDeleteRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.DeleteRule method
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Set(f func(ctx context.Context, id int64) (err error)) *AccessRepositoryMock {
+	if mmDeleteRule.defaultExpectation != nil {
+		mmDeleteRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.DeleteRule method")
+	}
+
+	if len(mmDeleteRule.expectations) > 0 {
+		mmDeleteRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.DeleteRule method")
+	}
+
+	mmDeleteRule.mock.funcDeleteRule = f
+	mmDeleteRule.mock.funcDeleteRuleOrigin = minimock.CallerInfo(1)
+	return mmDeleteRule.mock
+}
+
+// When sets expectation for the AccessRepository.DeleteRule which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) When(ctx context.Context, id int64) *AccessRepositoryMockDeleteRuleExpectation {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockDeleteRuleExpectation{
+		mock:               mmDeleteRule.mock,
+		params:             &AccessRepositoryMockDeleteRuleParams{ctx, id},
+		expectationOrigins: AccessRepositoryMockDeleteRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRule.expectations = append(mmDeleteRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.DeleteRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockDeleteRuleExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockDeleteRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.DeleteRule should be invoked
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Times(n uint64) *mAccessRepositoryMockDeleteRule {
+	if n == 0 {
+		mmDeleteRule.mock.t.Fatalf("Times of AccessRepositoryMock.DeleteRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRule.expectedInvocations, n)
+	mmDeleteRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRule
+}
+
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) invocationsDone() bool {
+	if len(mmDeleteRule.expectations) == 0 && mmDeleteRule.defaultExpectation == nil && mmDeleteRule.mock.funcDeleteRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRule.mock.afterDeleteRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRule implements mm_repository.AccessRepository
+func (mmDeleteRule *AccessRepositoryMock) DeleteRule(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRule.beforeDeleteRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRule.afterDeleteRuleCounter, 1)
+
+	mmDeleteRule.t.Helper()
+
+	if mmDeleteRule.inspectFuncDeleteRule != nil {
+		mmDeleteRule.inspectFuncDeleteRule(ctx, id)
+	}
+
+	mm_params := AccessRepositoryMockDeleteRuleParams{ctx, id}
+
+	// Record call args
+	mmDeleteRule.DeleteRuleMock.mutex.Lock()
+	mmDeleteRule.DeleteRuleMock.callArgs = append(mmDeleteRule.DeleteRuleMock.callArgs, &mm_params)
+	mmDeleteRule.DeleteRuleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRule.DeleteRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRule.DeleteRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRule.DeleteRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRule.DeleteRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRule.DeleteRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockDeleteRuleParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRule.t.Errorf("AccessRepositoryMock.DeleteRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteRule.t.Errorf("AccessRepositoryMock.DeleteRule got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRule.t.Errorf("AccessRepositoryMock.DeleteRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRule.DeleteRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRule.t.Fatal("No results are set for the AccessRepositoryMock.DeleteRule")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRule.funcDeleteRule != nil {
+		return mmDeleteRule.funcDeleteRule(ctx, id)
+	}
+	mmDeleteRule.t.Fatalf("Unexpected call to AccessRepositoryMock.DeleteRule. %v %v", ctx, id)
+	return
+}
+
+// DeleteRuleAfterCounter returns a count of finished AccessRepositoryMock.DeleteRule invocations
+func (mmDeleteRule *AccessRepositoryMock) DeleteRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRule.afterDeleteRuleCounter)
+}
+
+// DeleteRuleBeforeCounter returns a count of AccessRepositoryMock.DeleteRule invocations
+func (mmDeleteRule *AccessRepositoryMock) DeleteRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRule.beforeDeleteRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.DeleteRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRule *mAccessRepositoryMockDeleteRule) Calls() []*AccessRepositoryMockDeleteRuleParams {
+	mmDeleteRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockDeleteRuleParams, len(mmDeleteRule.callArgs))
+	copy(argCopy, mmDeleteRule.callArgs)
+
+	mmDeleteRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRuleDone returns true if the count of the DeleteRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockDeleteRuleDone() bool {
+	if m.DeleteRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRuleMock.invocationsDone()
+}
+
+// MinimockDeleteRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockDeleteRuleInspect() {
+	for _, e := range m.DeleteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRuleCounter := mm_atomic.LoadUint64(&m.afterDeleteRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRuleMock.defaultExpectation != nil && afterDeleteRuleCounter < 1 {
+		if m.DeleteRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteRule at\n%s", m.DeleteRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteRule at\n%s with params: %#v", m.DeleteRuleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRule != nil && afterDeleteRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.DeleteRule at\n%s", m.funcDeleteRuleOrigin)
+	}
+
+	if !m.DeleteRuleMock.invocationsDone() && afterDeleteRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.DeleteRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRuleMock.expectedInvocations), m.DeleteRuleMock.expectedInvocationsOrigin, afterDeleteRuleCounter)
+	}
+}
+
+type mAccessRepositoryMockGetAccessMatcher struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetAccessMatcherExpectation
+	expectations       []*AccessRepositoryMockGetAccessMatcherExpectation
+
+	callArgs []*AccessRepositoryMockGetAccessMatcherParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetAccessMatcherExpectation specifies expectation struct of the AccessRepository.GetAccessMatcher
+type AccessRepositoryMockGetAccessMatcherExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetAccessMatcherParams
+	paramPtrs          *AccessRepositoryMockGetAccessMatcherParamPtrs
+	expectationOrigins AccessRepositoryMockGetAccessMatcherExpectationOrigins
+	results            *AccessRepositoryMockGetAccessMatcherResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetAccessMatcherParams contains parameters of the AccessRepository.GetAccessMatcher
+type AccessRepositoryMockGetAccessMatcherParams struct {
+	ctx context.Context
+}
+
+// AccessRepositoryMockGetAccessMatcherParamPtrs contains pointers to parameters of the AccessRepository.GetAccessMatcher
+type AccessRepositoryMockGetAccessMatcherParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessRepositoryMockGetAccessMatcherResults contains results of the AccessRepository.GetAccessMatcher
+type AccessRepositoryMockGetAccessMatcherResults struct {
+	mp1 *matcher.Matcher
+	err error
+}
+
+// AccessRepositoryMockGetAccessMatcherOrigins contains origins of expectations of the AccessRepository.GetAccessMatcher
+type AccessRepositoryMockGetAccessMatcherExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Optional() *mAccessRepositoryMockGetAccessMatcher {
+	mmGetAccessMatcher.optional = true
+	return mmGetAccessMatcher
+}
+
+// Expect sets up expected params for AccessRepository.GetAccessMatcher
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Expect(ctx context.Context) *mAccessRepositoryMockGetAccessMatcher {
+	if mmGetAccessMatcher.mock.funcGetAccessMatcher != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("AccessRepositoryMock.GetAccessMatcher mock is already set by Set")
+	}
+
+	if mmGetAccessMatcher.defaultExpectation == nil {
+		mmGetAccessMatcher.defaultExpectation = &AccessRepositoryMockGetAccessMatcherExpectation{}
+	}
+
+	if mmGetAccessMatcher.defaultExpectation.paramPtrs != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("AccessRepositoryMock.GetAccessMatcher mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessMatcher.defaultExpectation.params = &AccessRepositoryMockGetAccessMatcherParams{ctx}
+	mmGetAccessMatcher.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessMatcher.expectations {
+		if minimock.Equal(e.params, mmGetAccessMatcher.defaultExpectation.params) {
+			mmGetAccessMatcher.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessMatcher.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessMatcher
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetAccessMatcher
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetAccessMatcher {
+	if mmGetAccessMatcher.mock.funcGetAccessMatcher != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("AccessRepositoryMock.GetAccessMatcher mock is already set by Set")
+	}
+
+	if mmGetAccessMatcher.defaultExpectation == nil {
+		mmGetAccessMatcher.defaultExpectation = &AccessRepositoryMockGetAccessMatcherExpectation{}
+	}
+
+	if mmGetAccessMatcher.defaultExpectation.params != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("AccessRepositoryMock.GetAccessMatcher mock is already set by Expect")
+	}
+
+	if mmGetAccessMatcher.defaultExpectation.paramPtrs == nil {
+		mmGetAccessMatcher.defaultExpectation.paramPtrs = &AccessRepositoryMockGetAccessMatcherParamPtrs{}
+	}
+	mmGetAccessMatcher.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessMatcher.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessMatcher
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetAccessMatcher
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Inspect(f func(ctx context.Context)) *mAccessRepositoryMockGetAccessMatcher {
+	if mmGetAccessMatcher.mock.inspectFuncGetAccessMatcher != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetAccessMatcher")
+	}
+
+	mmGetAccessMatcher.mock.inspectFuncGetAccessMatcher = f
+
+	return mmGetAccessMatcher
+}
+
+// Return sets up results that will be returned by AccessRepository.GetAccessMatcher
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Return(mp1 *matcher.Matcher, err error) *AccessRepositoryMock {
+	if mmGetAccessMatcher.mock.funcGetAccessMatcher != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("AccessRepositoryMock.GetAccessMatcher mock is already set by Set")
+	}
+
+	if mmGetAccessMatcher.defaultExpectation == nil {
+		mmGetAccessMatcher.defaultExpectation = &AccessRepositoryMockGetAccessMatcherExpectation{mock: mmGetAccessMatcher.mock}
+	}
+	mmGetAccessMatcher.defaultExpectation.results = &AccessRepositoryMockGetAccessMatcherResults{mp1, err}
+	mmGetAccessMatcher.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessMatcher.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetAccessMatcher method
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Set(f func(ctx context.Context) (mp1 *matcher.Matcher, err error)) *AccessRepositoryMock {
+	if mmGetAccessMatcher.defaultExpectation != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetAccessMatcher method")
+	}
+
+	if len(mmGetAccessMatcher.expectations) > 0 {
+		mmGetAccessMatcher.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetAccessMatcher method")
+	}
+
+	mmGetAccessMatcher.mock.funcGetAccessMatcher = f
+	mmGetAccessMatcher.mock.funcGetAccessMatcherOrigin = minimock.CallerInfo(1)
+	return mmGetAccessMatcher.mock
+}
+
+// When sets expectation for the AccessRepository.GetAccessMatcher which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) When(ctx context.Context) *AccessRepositoryMockGetAccessMatcherExpectation {
+	if mmGetAccessMatcher.mock.funcGetAccessMatcher != nil {
+		mmGetAccessMatcher.mock.t.Fatalf("AccessRepositoryMock.GetAccessMatcher mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetAccessMatcherExpectation{
+		mock:               mmGetAccessMatcher.mock,
+		params:             &AccessRepositoryMockGetAccessMatcherParams{ctx},
+		expectationOrigins: AccessRepositoryMockGetAccessMatcherExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessMatcher.expectations = append(mmGetAccessMatcher.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetAccessMatcher return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetAccessMatcherExpectation) Then(mp1 *matcher.Matcher, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetAccessMatcherResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetAccessMatcher should be invoked
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Times(n uint64) *mAccessRepositoryMockGetAccessMatcher {
+	if n == 0 {
+		mmGetAccessMatcher.mock.t.Fatalf("Times of AccessRepositoryMock.GetAccessMatcher mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessMatcher.expectedInvocations, n)
+	mmGetAccessMatcher.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessMatcher
+}
+
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) invocationsDone() bool {
+	if len(mmGetAccessMatcher.expectations) == 0 && mmGetAccessMatcher.defaultExpectation == nil && mmGetAccessMatcher.mock.funcGetAccessMatcher == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessMatcher.mock.afterGetAccessMatcherCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessMatcher.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessMatcher implements mm_repository.AccessRepository
+func (mmGetAccessMatcher *AccessRepositoryMock) GetAccessMatcher(ctx context.Context) (mp1 *matcher.Matcher, err error) {
+	mm_atomic.AddUint64(&mmGetAccessMatcher.beforeGetAccessMatcherCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessMatcher.afterGetAccessMatcherCounter, 1)
+
+	mmGetAccessMatcher.t.Helper()
+
+	if mmGetAccessMatcher.inspectFuncGetAccessMatcher != nil {
+		mmGetAccessMatcher.inspectFuncGetAccessMatcher(ctx)
+	}
+
+	mm_params := AccessRepositoryMockGetAccessMatcherParams{ctx}
+
+	// Record call args
+	mmGetAccessMatcher.GetAccessMatcherMock.mutex.Lock()
+	mmGetAccessMatcher.GetAccessMatcherMock.callArgs = append(mmGetAccessMatcher.GetAccessMatcherMock.callArgs, &mm_params)
+	mmGetAccessMatcher.GetAccessMatcherMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessMatcher.GetAccessMatcherMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetAccessMatcherParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessMatcher.t.Errorf("AccessRepositoryMock.GetAccessMatcher got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessMatcher.t.Errorf("AccessRepositoryMock.GetAccessMatcher got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessMatcher.GetAccessMatcherMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessMatcher.t.Fatal("No results are set for the AccessRepositoryMock.GetAccessMatcher")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetAccessMatcher.funcGetAccessMatcher != nil {
+		return mmGetAccessMatcher.funcGetAccessMatcher(ctx)
+	}
+	mmGetAccessMatcher.t.Fatalf("Unexpected call to AccessRepositoryMock.GetAccessMatcher. %v", ctx)
+	return
+}
+
+// GetAccessMatcherAfterCounter returns a count of finished AccessRepositoryMock.GetAccessMatcher invocations
+func (mmGetAccessMatcher *AccessRepositoryMock) GetAccessMatcherAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessMatcher.afterGetAccessMatcherCounter)
+}
+
+// GetAccessMatcherBeforeCounter returns a count of AccessRepositoryMock.GetAccessMatcher invocations
+func (mmGetAccessMatcher *AccessRepositoryMock) GetAccessMatcherBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessMatcher.beforeGetAccessMatcherCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetAccessMatcher.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessMatcher *mAccessRepositoryMockGetAccessMatcher) Calls() []*AccessRepositoryMockGetAccessMatcherParams {
+	mmGetAccessMatcher.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetAccessMatcherParams, len(mmGetAccessMatcher.callArgs))
+	copy(argCopy, mmGetAccessMatcher.callArgs)
+
+	mmGetAccessMatcher.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessMatcherDone returns true if the count of the GetAccessMatcher invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetAccessMatcherDone() bool {
+	if m.GetAccessMatcherMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessMatcherMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessMatcherMock.invocationsDone()
+}
+
+// MinimockGetAccessMatcherInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetAccessMatcherInspect() {
+	for _, e := range m.GetAccessMatcherMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessMatcher at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessMatcherCounter := mm_atomic.LoadUint64(&m.afterGetAccessMatcherCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessMatcherMock.defaultExpectation != nil && afterGetAccessMatcherCounter < 1 {
+		if m.GetAccessMatcherMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessMatcher at\n%s", m.GetAccessMatcherMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessMatcher at\n%s with params: %#v", m.GetAccessMatcherMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessMatcherMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessMatcher != nil && afterGetAccessMatcherCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessMatcher at\n%s", m.funcGetAccessMatcherOrigin)
+	}
+
+	if !m.GetAccessMatcherMock.invocationsDone() && afterGetAccessMatcherCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetAccessMatcher at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessMatcherMock.expectedInvocations), m.GetAccessMatcherMock.expectedInvocationsOrigin, afterGetAccessMatcherCounter)
+	}
+}
+
+type mAccessRepositoryMockGetAccessRuleByEndpoint struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetAccessRuleByEndpointExpectation
+	expectations       []*AccessRepositoryMockGetAccessRuleByEndpointExpectation
+
+	callArgs []*AccessRepositoryMockGetAccessRuleByEndpointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetAccessRuleByEndpointExpectation specifies expectation struct of the AccessRepository.GetAccessRuleByEndpoint
+type AccessRepositoryMockGetAccessRuleByEndpointExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetAccessRuleByEndpointParams
+	paramPtrs          *AccessRepositoryMockGetAccessRuleByEndpointParamPtrs
+	expectationOrigins AccessRepositoryMockGetAccessRuleByEndpointExpectationOrigins
+	results            *AccessRepositoryMockGetAccessRuleByEndpointResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetAccessRuleByEndpointParams contains parameters of the AccessRepository.GetAccessRuleByEndpoint
+type AccessRepositoryMockGetAccessRuleByEndpointParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// AccessRepositoryMockGetAccessRuleByEndpointParamPtrs contains pointers to parameters of the AccessRepository.GetAccessRuleByEndpoint
+type AccessRepositoryMockGetAccessRuleByEndpointParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// AccessRepositoryMockGetAccessRuleByEndpointResults contains results of the AccessRepository.GetAccessRuleByEndpoint
+type AccessRepositoryMockGetAccessRuleByEndpointResults struct {
+	ep1 *model.EndpointAccessRule
+	err error
+}
+
+// AccessRepositoryMockGetAccessRuleByEndpointOrigins contains origins of expectations of the AccessRepository.GetAccessRuleByEndpoint
+type AccessRepositoryMockGetAccessRuleByEndpointExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEndpoint string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Optional() *mAccessRepositoryMockGetAccessRuleByEndpoint {
+	mmGetAccessRuleByEndpoint.optional = true
+	return mmGetAccessRuleByEndpoint
+}
+
+// Expect sets up expected params for AccessRepository.GetAccessRuleByEndpoint
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Expect(ctx context.Context, endpoint string) *mAccessRepositoryMockGetAccessRuleByEndpoint {
+	if mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Set")
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation == nil {
+		mmGetAccessRuleByEndpoint.defaultExpectation = &AccessRepositoryMockGetAccessRuleByEndpointExpectation{}
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessRuleByEndpoint.defaultExpectation.params = &AccessRepositoryMockGetAccessRuleByEndpointParams{ctx, endpoint}
+	mmGetAccessRuleByEndpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessRuleByEndpoint.expectations {
+		if minimock.Equal(e.params, mmGetAccessRuleByEndpoint.defaultExpectation.params) {
+			mmGetAccessRuleByEndpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessRuleByEndpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessRuleByEndpoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetAccessRuleByEndpoint
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetAccessRuleByEndpoint {
+	if mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Set")
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation == nil {
+		mmGetAccessRuleByEndpoint.defaultExpectation = &AccessRepositoryMockGetAccessRuleByEndpointExpectation{}
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation.params != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Expect")
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs == nil {
+		mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs = &AccessRepositoryMockGetAccessRuleByEndpointParamPtrs{}
+	}
+	mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessRuleByEndpoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessRuleByEndpoint
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AccessRepository.GetAccessRuleByEndpoint
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) ExpectEndpointParam2(endpoint string) *mAccessRepositoryMockGetAccessRuleByEndpoint {
+	if mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Set")
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation == nil {
+		mmGetAccessRuleByEndpoint.defaultExpectation = &AccessRepositoryMockGetAccessRuleByEndpointExpectation{}
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation.params != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Expect")
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs == nil {
+		mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs = &AccessRepositoryMockGetAccessRuleByEndpointParamPtrs{}
+	}
+	mmGetAccessRuleByEndpoint.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmGetAccessRuleByEndpoint.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmGetAccessRuleByEndpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetAccessRuleByEndpoint
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Inspect(f func(ctx context.Context, endpoint string)) *mAccessRepositoryMockGetAccessRuleByEndpoint {
+	if mmGetAccessRuleByEndpoint.mock.inspectFuncGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetAccessRuleByEndpoint")
+	}
+
+	mmGetAccessRuleByEndpoint.mock.inspectFuncGetAccessRuleByEndpoint = f
+
+	return mmGetAccessRuleByEndpoint
+}
+
+// Return sets up results that will be returned by AccessRepository.GetAccessRuleByEndpoint
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Return(ep1 *model.EndpointAccessRule, err error) *AccessRepositoryMock {
+	if mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Set")
+	}
+
+	if mmGetAccessRuleByEndpoint.defaultExpectation == nil {
+		mmGetAccessRuleByEndpoint.defaultExpectation = &AccessRepositoryMockGetAccessRuleByEndpointExpectation{mock: mmGetAccessRuleByEndpoint.mock}
+	}
+	mmGetAccessRuleByEndpoint.defaultExpectation.results = &AccessRepositoryMockGetAccessRuleByEndpointResults{ep1, err}
+	mmGetAccessRuleByEndpoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessRuleByEndpoint.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetAccessRuleByEndpoint method
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Set(f func(ctx context.Context, endpoint string) (ep1 *model.EndpointAccessRule, err error)) *AccessRepositoryMock {
+	if mmGetAccessRuleByEndpoint.defaultExpectation != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetAccessRuleByEndpoint method")
+	}
+
+	if len(mmGetAccessRuleByEndpoint.expectations) > 0 {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetAccessRuleByEndpoint method")
+	}
+
+	mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint = f
+	mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpointOrigin = minimock.CallerInfo(1)
+	return mmGetAccessRuleByEndpoint.mock
+}
+
+// When sets expectation for the AccessRepository.GetAccessRuleByEndpoint which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) When(ctx context.Context, endpoint string) *AccessRepositoryMockGetAccessRuleByEndpointExpectation {
+	if mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("AccessRepositoryMock.GetAccessRuleByEndpoint mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetAccessRuleByEndpointExpectation{
+		mock:               mmGetAccessRuleByEndpoint.mock,
+		params:             &AccessRepositoryMockGetAccessRuleByEndpointParams{ctx, endpoint},
+		expectationOrigins: AccessRepositoryMockGetAccessRuleByEndpointExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessRuleByEndpoint.expectations = append(mmGetAccessRuleByEndpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetAccessRuleByEndpoint return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetAccessRuleByEndpointExpectation) Then(ep1 *model.EndpointAccessRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetAccessRuleByEndpointResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetAccessRuleByEndpoint should be invoked
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Times(n uint64) *mAccessRepositoryMockGetAccessRuleByEndpoint {
+	if n == 0 {
+		mmGetAccessRuleByEndpoint.mock.t.Fatalf("Times of AccessRepositoryMock.GetAccessRuleByEndpoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessRuleByEndpoint.expectedInvocations, n)
+	mmGetAccessRuleByEndpoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessRuleByEndpoint
+}
+
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) invocationsDone() bool {
+	if len(mmGetAccessRuleByEndpoint.expectations) == 0 && mmGetAccessRuleByEndpoint.defaultExpectation == nil && mmGetAccessRuleByEndpoint.mock.funcGetAccessRuleByEndpoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessRuleByEndpoint.mock.afterGetAccessRuleByEndpointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessRuleByEndpoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessRuleByEndpoint implements mm_repository.AccessRepository
+func (mmGetAccessRuleByEndpoint *AccessRepositoryMock) GetAccessRuleByEndpoint(ctx context.Context, endpoint string) (ep1 *model.EndpointAccessRule, err error) {
+	mm_atomic.AddUint64(&mmGetAccessRuleByEndpoint.beforeGetAccessRuleByEndpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessRuleByEndpoint.afterGetAccessRuleByEndpointCounter, 1)
+
+	mmGetAccessRuleByEndpoint.t.Helper()
+
+	if mmGetAccessRuleByEndpoint.inspectFuncGetAccessRuleByEndpoint != nil {
+		mmGetAccessRuleByEndpoint.inspectFuncGetAccessRuleByEndpoint(ctx, endpoint)
+	}
+
+	mm_params := AccessRepositoryMockGetAccessRuleByEndpointParams{ctx, endpoint}
+
+	// Record call args
+	mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.mutex.Lock()
+	mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.callArgs = append(mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.callArgs, &mm_params)
+	mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetAccessRuleByEndpointParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessRuleByEndpoint.t.Errorf("AccessRepositoryMock.GetAccessRuleByEndpoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmGetAccessRuleByEndpoint.t.Errorf("AccessRepositoryMock.GetAccessRuleByEndpoint got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessRuleByEndpoint.t.Errorf("AccessRepositoryMock.GetAccessRuleByEndpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessRuleByEndpoint.GetAccessRuleByEndpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessRuleByEndpoint.t.Fatal("No results are set for the AccessRepositoryMock.GetAccessRuleByEndpoint")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmGetAccessRuleByEndpoint.funcGetAccessRuleByEndpoint != nil {
+		return mmGetAccessRuleByEndpoint.funcGetAccessRuleByEndpoint(ctx, endpoint)
+	}
+	mmGetAccessRuleByEndpoint.t.Fatalf("Unexpected call to AccessRepositoryMock.GetAccessRuleByEndpoint. %v %v", ctx, endpoint)
+	return
+}
+
+// GetAccessRuleByEndpointAfterCounter returns a count of finished AccessRepositoryMock.GetAccessRuleByEndpoint invocations
+func (mmGetAccessRuleByEndpoint *AccessRepositoryMock) GetAccessRuleByEndpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessRuleByEndpoint.afterGetAccessRuleByEndpointCounter)
+}
+
+// GetAccessRuleByEndpointBeforeCounter returns a count of AccessRepositoryMock.GetAccessRuleByEndpoint invocations
+func (mmGetAccessRuleByEndpoint *AccessRepositoryMock) GetAccessRuleByEndpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessRuleByEndpoint.beforeGetAccessRuleByEndpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetAccessRuleByEndpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessRuleByEndpoint *mAccessRepositoryMockGetAccessRuleByEndpoint) Calls() []*AccessRepositoryMockGetAccessRuleByEndpointParams {
+	mmGetAccessRuleByEndpoint.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetAccessRuleByEndpointParams, len(mmGetAccessRuleByEndpoint.callArgs))
+	copy(argCopy, mmGetAccessRuleByEndpoint.callArgs)
+
+	mmGetAccessRuleByEndpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessRuleByEndpointDone returns true if the count of the GetAccessRuleByEndpoint invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetAccessRuleByEndpointDone() bool {
+	if m.GetAccessRuleByEndpointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessRuleByEndpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessRuleByEndpointMock.invocationsDone()
+}
+
+// MinimockGetAccessRuleByEndpointInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetAccessRuleByEndpointInspect() {
+	for _, e := range m.GetAccessRuleByEndpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessRuleByEndpoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessRuleByEndpointCounter := mm_atomic.LoadUint64(&m.afterGetAccessRuleByEndpointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessRuleByEndpointMock.defaultExpectation != nil && afterGetAccessRuleByEndpointCounter < 1 {
+		if m.GetAccessRuleByEndpointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessRuleByEndpoint at\n%s", m.GetAccessRuleByEndpointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessRuleByEndpoint at\n%s with params: %#v", m.GetAccessRuleByEndpointMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessRuleByEndpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessRuleByEndpoint != nil && afterGetAccessRuleByEndpointCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessRuleByEndpoint at\n%s", m.funcGetAccessRuleByEndpointOrigin)
+	}
+
+	if !m.GetAccessRuleByEndpointMock.invocationsDone() && afterGetAccessRuleByEndpointCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetAccessRuleByEndpoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessRuleByEndpointMock.expectedInvocations), m.GetAccessRuleByEndpointMock.expectedInvocationsOrigin, afterGetAccessRuleByEndpointCounter)
+	}
+}
+
+type mAccessRepositoryMockGetRule struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetRuleExpectation
+	expectations       []*AccessRepositoryMockGetRuleExpectation
+
+	callArgs []*AccessRepositoryMockGetRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetRuleExpectation specifies expectation struct of the AccessRepository.GetRule
+type AccessRepositoryMockGetRuleExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetRuleParams
+	paramPtrs          *AccessRepositoryMockGetRuleParamPtrs
+	expectationOrigins AccessRepositoryMockGetRuleExpectationOrigins
+	results            *AccessRepositoryMockGetRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetRuleParams contains parameters of the AccessRepository.GetRule
+type AccessRepositoryMockGetRuleParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessRepositoryMockGetRuleParamPtrs contains pointers to parameters of the AccessRepository.GetRule
+type AccessRepositoryMockGetRuleParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessRepositoryMockGetRuleResults contains results of the AccessRepository.GetRule
+type AccessRepositoryMockGetRuleResults struct {
+	ap1 *model.AccessRule
+	err error
+}
+
+// AccessRepositoryMockGetRuleOrigins contains origins of expectations of the AccessRepository.GetRule
+type AccessRepositoryMockGetRuleExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRule *mAccessRepositoryMockGetRule) Optional() *mAccessRepositoryMockGetRule {
+	mmGetRule.optional = true
+	return mmGetRule
+}
+
+// Expect sets up expected params for AccessRepository.GetRule
+func (mmGetRule *mAccessRepositoryMockGetRule) Expect(ctx context.Context, id int64) *mAccessRepositoryMockGetRule {
+	if mmGetRule.mock.funcGetRule != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Set")
+	}
+
+	if mmGetRule.defaultExpectation == nil {
+		mmGetRule.defaultExpectation = &AccessRepositoryMockGetRuleExpectation{}
+	}
+
+	if mmGetRule.defaultExpectation.paramPtrs != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by ExpectParams functions")
+	}
+
+	mmGetRule.defaultExpectation.params = &AccessRepositoryMockGetRuleParams{ctx, id}
+	mmGetRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRule.expectations {
+		if minimock.Equal(e.params, mmGetRule.defaultExpectation.params) {
+			mmGetRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRule.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetRule
+func (mmGetRule *mAccessRepositoryMockGetRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetRule {
+	if mmGetRule.mock.funcGetRule != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Set")
+	}
+
+	if mmGetRule.defaultExpectation == nil {
+		mmGetRule.defaultExpectation = &AccessRepositoryMockGetRuleExpectation{}
+	}
+
+	if mmGetRule.defaultExpectation.params != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Expect")
+	}
+
+	if mmGetRule.defaultExpectation.paramPtrs == nil {
+		mmGetRule.defaultExpectation.paramPtrs = &AccessRepositoryMockGetRuleParamPtrs{}
+	}
+	mmGetRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRule
+}
+
+// ExpectIdParam2 sets up expected param id for AccessRepository.GetRule
+func (mmGetRule *mAccessRepositoryMockGetRule) ExpectIdParam2(id int64) *mAccessRepositoryMockGetRule {
+	if mmGetRule.mock.funcGetRule != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Set")
+	}
+
+	if mmGetRule.defaultExpectation == nil {
+		mmGetRule.defaultExpectation = &AccessRepositoryMockGetRuleExpectation{}
+	}
+
+	if mmGetRule.defaultExpectation.params != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Expect")
+	}
+
+	if mmGetRule.defaultExpectation.paramPtrs == nil {
+		mmGetRule.defaultExpectation.paramPtrs = &AccessRepositoryMockGetRuleParamPtrs{}
+	}
+	mmGetRule.defaultExpectation.paramPtrs.id = &id
+	mmGetRule.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetRule
+func (mmGetRule *mAccessRepositoryMockGetRule) Inspect(f func(ctx context.Context, id int64)) *mAccessRepositoryMockGetRule {
+	if mmGetRule.mock.inspectFuncGetRule != nil {
+		mmGetRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetRule")
+	}
+
+	mmGetRule.mock.inspectFuncGetRule = f
+
+	return mmGetRule
+}
+
+// Return sets up results that will be returned by AccessRepository.GetRule
+func (mmGetRule *mAccessRepositoryMockGetRule) Return(ap1 *model.AccessRule, err error) *AccessRepositoryMock {
+	if mmGetRule.mock.funcGetRule != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Set")
+	}
+
+	if mmGetRule.defaultExpectation == nil {
+		mmGetRule.defaultExpectation = &AccessRepositoryMockGetRuleExpectation{mock: mmGetRule.mock}
+	}
+	mmGetRule.defaultExpectation.results = &AccessRepositoryMockGetRuleResults{ap1, err}
+	mmGetRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetRule method
+func (mmGetRule *mAccessRepositoryMockGetRule) Set(f func(ctx context.Context, id int64) (ap1 *model.AccessRule, err error)) *AccessRepositoryMock {
+	if mmGetRule.defaultExpectation != nil {
+		mmGetRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetRule method")
+	}
+
+	if len(mmGetRule.expectations) > 0 {
+		mmGetRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetRule method")
+	}
+
+	mmGetRule.mock.funcGetRule = f
+	mmGetRule.mock.funcGetRuleOrigin = minimock.CallerInfo(1)
+	return mmGetRule.mock
+}
+
+// When sets expectation for the AccessRepository.GetRule which will trigger the result defined by the following
+// Then helper
+func (mmGetRule *mAccessRepositoryMockGetRule) When(ctx context.Context, id int64) *AccessRepositoryMockGetRuleExpectation {
+	if mmGetRule.mock.funcGetRule != nil {
+		mmGetRule.mock.t.Fatalf("AccessRepositoryMock.GetRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetRuleExpectation{
+		mock:               mmGetRule.mock,
+		params:             &AccessRepositoryMockGetRuleParams{ctx, id},
+		expectationOrigins: AccessRepositoryMockGetRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRule.expectations = append(mmGetRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetRuleExpectation) Then(ap1 *model.AccessRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetRuleResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetRule should be invoked
+func (mmGetRule *mAccessRepositoryMockGetRule) Times(n uint64) *mAccessRepositoryMockGetRule {
+	if n == 0 {
+		mmGetRule.mock.t.Fatalf("Times of AccessRepositoryMock.GetRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRule.expectedInvocations, n)
+	mmGetRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRule
+}
+
+func (mmGetRule *mAccessRepositoryMockGetRule) invocationsDone() bool {
+	if len(mmGetRule.expectations) == 0 && mmGetRule.defaultExpectation == nil && mmGetRule.mock.funcGetRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRule.mock.afterGetRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRule implements mm_repository.AccessRepository
+func (mmGetRule *AccessRepositoryMock) GetRule(ctx context.Context, id int64) (ap1 *model.AccessRule, err error) {
+	mm_atomic.AddUint64(&mmGetRule.beforeGetRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRule.afterGetRuleCounter, 1)
+
+	mmGetRule.t.Helper()
+
+	if mmGetRule.inspectFuncGetRule != nil {
+		mmGetRule.inspectFuncGetRule(ctx, id)
+	}
+
+	mm_params := AccessRepositoryMockGetRuleParams{ctx, id}
+
+	// Record call args
+	mmGetRule.GetRuleMock.mutex.Lock()
+	mmGetRule.GetRuleMock.callArgs = append(mmGetRule.GetRuleMock.callArgs, &mm_params)
+	mmGetRule.GetRuleMock.mutex.Unlock()
+
+	for _, e := range mmGetRule.GetRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetRule.GetRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRule.GetRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRule.GetRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRule.GetRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetRuleParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRule.t.Errorf("AccessRepositoryMock.GetRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRule.GetRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetRule.t.Errorf("AccessRepositoryMock.GetRule got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRule.GetRuleMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRule.t.Errorf("AccessRepositoryMock.GetRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRule.GetRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRule.GetRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRule.t.Fatal("No results are set for the AccessRepositoryMock.GetRule")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetRule.funcGetRule != nil {
+		return mmGetRule.funcGetRule(ctx, id)
+	}
+	mmGetRule.t.Fatalf("Unexpected call to AccessRepositoryMock.GetRule. %v %v", ctx, id)
+	return
+}
+
+// GetRuleAfterCounter returns a count of finished AccessRepositoryMock.GetRule invocations
+func (mmGetRule *AccessRepositoryMock) GetRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRule.afterGetRuleCounter)
+}
+
+// GetRuleBeforeCounter returns a count of AccessRepositoryMock.GetRule invocations
+func (mmGetRule *AccessRepositoryMock) GetRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRule.beforeGetRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRule *mAccessRepositoryMockGetRule) Calls() []*AccessRepositoryMockGetRuleParams {
+	mmGetRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetRuleParams, len(mmGetRule.callArgs))
+	copy(argCopy, mmGetRule.callArgs)
+
+	mmGetRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRuleDone returns true if the count of the GetRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetRuleDone() bool {
+	if m.GetRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRuleMock.invocationsDone()
+}
+
+// MinimockGetRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetRuleInspect() {
+	for _, e := range m.GetRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRuleCounter := mm_atomic.LoadUint64(&m.afterGetRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRuleMock.defaultExpectation != nil && afterGetRuleCounter < 1 {
+		if m.GetRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRule at\n%s", m.GetRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRule at\n%s with params: %#v", m.GetRuleMock.defaultExpectation.expectationOrigins.origin, *m.GetRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRule != nil && afterGetRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetRule at\n%s", m.funcGetRuleOrigin)
+	}
+
+	if !m.GetRuleMock.invocationsDone() && afterGetRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRuleMock.expectedInvocations), m.GetRuleMock.expectedInvocationsOrigin, afterGetRuleCounter)
+	}
+}
+
+type mAccessRepositoryMockListRules struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockListRulesExpectation
+	expectations       []*AccessRepositoryMockListRulesExpectation
+
+	callArgs []*AccessRepositoryMockListRulesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockListRulesExpectation specifies expectation struct of the AccessRepository.ListRules
+type AccessRepositoryMockListRulesExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockListRulesParams
+	paramPtrs          *AccessRepositoryMockListRulesParamPtrs
+	expectationOrigins AccessRepositoryMockListRulesExpectationOrigins
+	results            *AccessRepositoryMockListRulesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockListRulesParams contains parameters of the AccessRepository.ListRules
+type AccessRepositoryMockListRulesParams struct {
+	ctx    context.Context
+	filter *model.AccessRuleFilter
+}
+
+// AccessRepositoryMockListRulesParamPtrs contains pointers to parameters of the AccessRepository.ListRules
+type AccessRepositoryMockListRulesParamPtrs struct {
+	ctx    *context.Context
+	filter **model.AccessRuleFilter
+}
+
+// AccessRepositoryMockListRulesResults contains results of the AccessRepository.ListRules
+type AccessRepositoryMockListRulesResults struct {
+	apa1 []*model.AccessRule
+	err  error
+}
+
+// AccessRepositoryMockListRulesOrigins contains origins of expectations of the AccessRepository.ListRules
+type AccessRepositoryMockListRulesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRules *mAccessRepositoryMockListRules) Optional() *mAccessRepositoryMockListRules {
+	mmListRules.optional = true
+	return mmListRules
+}
+
+// Expect sets up expected params for AccessRepository.ListRules
+func (mmListRules *mAccessRepositoryMockListRules) Expect(ctx context.Context, filter *model.AccessRuleFilter) *mAccessRepositoryMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &AccessRepositoryMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by ExpectParams functions")
+	}
+
+	mmListRules.defaultExpectation.params = &AccessRepositoryMockListRulesParams{ctx, filter}
+	mmListRules.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRules.expectations {
+		if minimock.Equal(e.params, mmListRules.defaultExpectation.params) {
+			mmListRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRules.defaultExpectation.params)
+		}
+	}
+
+	return mmListRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.ListRules
+func (mmListRules *mAccessRepositoryMockListRules) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &AccessRepositoryMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.params != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Expect")
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs == nil {
+		mmListRules.defaultExpectation.paramPtrs = &AccessRepositoryMockListRulesParamPtrs{}
+	}
+	mmListRules.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRules.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRules
+}
+
+// ExpectFilterParam2 sets up expected param filter for AccessRepository.ListRules
+func (mmListRules *mAccessRepositoryMockListRules) ExpectFilterParam2(filter *model.AccessRuleFilter) *mAccessRepositoryMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &AccessRepositoryMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.params != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Expect")
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs == nil {
+		mmListRules.defaultExpectation.paramPtrs = &AccessRepositoryMockListRulesParamPtrs{}
+	}
+	mmListRules.defaultExpectation.paramPtrs.filter = &filter
+	mmListRules.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.ListRules
+func (mmListRules *mAccessRepositoryMockListRules) Inspect(f func(ctx context.Context, filter *model.AccessRuleFilter)) *mAccessRepositoryMockListRules {
+	if mmListRules.mock.inspectFuncListRules != nil {
+		mmListRules.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.ListRules")
+	}
+
+	mmListRules.mock.inspectFuncListRules = f
+
+	return mmListRules
+}
+
+// Return sets up results that will be returned by AccessRepository.ListRules
+func (mmListRules *mAccessRepositoryMockListRules) Return(apa1 []*model.AccessRule, err error) *AccessRepositoryMock {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &AccessRepositoryMockListRulesExpectation{mock: mmListRules.mock}
+	}
+	mmListRules.defaultExpectation.results = &AccessRepositoryMockListRulesResults{apa1, err}
+	mmListRules.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRules.mock
+}
+
+// Set uses given function f to mock the AccessRepository.ListRules method
+func (mmListRules *mAccessRepositoryMockListRules) Set(f func(ctx context.Context, filter *model.AccessRuleFilter) (apa1 []*model.AccessRule, err error)) *AccessRepositoryMock {
+	if mmListRules.defaultExpectation != nil {
+		mmListRules.mock.t.Fatalf("Default expectation is already set for the AccessRepository.ListRules method")
+	}
+
+	if len(mmListRules.expectations) > 0 {
+		mmListRules.mock.t.Fatalf("Some expectations are already set for the AccessRepository.ListRules method")
+	}
+
+	mmListRules.mock.funcListRules = f
+	mmListRules.mock.funcListRulesOrigin = minimock.CallerInfo(1)
+	return mmListRules.mock
+}
+
+// When sets expectation for the AccessRepository.ListRules which will trigger the result defined by the following
+// Then helper
+func (mmListRules *mAccessRepositoryMockListRules) When(ctx context.Context, filter *model.AccessRuleFilter) *AccessRepositoryMockListRulesExpectation {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("AccessRepositoryMock.ListRules mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockListRulesExpectation{
+		mock:               mmListRules.mock,
+		params:             &AccessRepositoryMockListRulesParams{ctx, filter},
+		expectationOrigins: AccessRepositoryMockListRulesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRules.expectations = append(mmListRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.ListRules return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockListRulesExpectation) Then(apa1 []*model.AccessRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockListRulesResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.ListRules should be invoked
+func (mmListRules *mAccessRepositoryMockListRules) Times(n uint64) *mAccessRepositoryMockListRules {
+	if n == 0 {
+		mmListRules.mock.t.Fatalf("Times of AccessRepositoryMock.ListRules mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRules.expectedInvocations, n)
+	mmListRules.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRules
+}
+
+func (mmListRules *mAccessRepositoryMockListRules) invocationsDone() bool {
+	if len(mmListRules.expectations) == 0 && mmListRules.defaultExpectation == nil && mmListRules.mock.funcListRules == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRules.mock.afterListRulesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRules.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRules implements mm_repository.AccessRepository
+func (mmListRules *AccessRepositoryMock) ListRules(ctx context.Context, filter *model.AccessRuleFilter) (apa1 []*model.AccessRule, err error) {
+	mm_atomic.AddUint64(&mmListRules.beforeListRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRules.afterListRulesCounter, 1)
+
+	mmListRules.t.Helper()
+
+	if mmListRules.inspectFuncListRules != nil {
+		mmListRules.inspectFuncListRules(ctx, filter)
+	}
+
+	mm_params := AccessRepositoryMockListRulesParams{ctx, filter}
+
+	// Record call args
+	mmListRules.ListRulesMock.mutex.Lock()
+	mmListRules.ListRulesMock.callArgs = append(mmListRules.ListRulesMock.callArgs, &mm_params)
+	mmListRules.ListRulesMock.mutex.Unlock()
+
+	for _, e := range mmListRules.ListRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmListRules.ListRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRules.ListRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRules.ListRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRules.ListRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockListRulesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRules.t.Errorf("AccessRepositoryMock.ListRules got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListRules.t.Errorf("AccessRepositoryMock.ListRules got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRules.t.Errorf("AccessRepositoryMock.ListRules got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRules.ListRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRules.t.Fatal("No results are set for the AccessRepositoryMock.ListRules")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmListRules.funcListRules != nil {
+		return mmListRules.funcListRules(ctx, filter)
+	}
+	mmListRules.t.Fatalf("Unexpected call to AccessRepositoryMock.ListRules. %v %v", ctx, filter)
+	return
+}
+
+// ListRulesAfterCounter returns a count of finished AccessRepositoryMock.ListRules invocations
+func (mmListRules *AccessRepositoryMock) ListRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRules.afterListRulesCounter)
+}
+
+// ListRulesBeforeCounter returns a count of AccessRepositoryMock.ListRules invocations
+func (mmListRules *AccessRepositoryMock) ListRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRules.beforeListRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.ListRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRules *mAccessRepositoryMockListRules) Calls() []*AccessRepositoryMockListRulesParams {
+	mmListRules.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockListRulesParams, len(mmListRules.callArgs))
+	copy(argCopy, mmListRules.callArgs)
+
+	mmListRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRulesDone returns true if the count of the ListRules invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockListRulesDone() bool {
+	if m.ListRulesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRulesMock.invocationsDone()
+}
+
+// MinimockListRulesInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockListRulesInspect() {
+	for _, e := range m.ListRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.ListRules at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRulesCounter := mm_atomic.LoadUint64(&m.afterListRulesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRulesMock.defaultExpectation != nil && afterListRulesCounter < 1 {
+		if m.ListRulesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.ListRules at\n%s", m.ListRulesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.ListRules at\n%s with params: %#v", m.ListRulesMock.defaultExpectation.expectationOrigins.origin, *m.ListRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRules != nil && afterListRulesCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.ListRules at\n%s", m.funcListRulesOrigin)
+	}
+
+	if !m.ListRulesMock.invocationsDone() && afterListRulesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.ListRules at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRulesMock.expectedInvocations), m.ListRulesMock.expectedInvocationsOrigin, afterListRulesCounter)
+	}
+}
+
+type mAccessRepositoryMockUpdateRule struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockUpdateRuleExpectation
+	expectations       []*AccessRepositoryMockUpdateRuleExpectation
+
+	callArgs []*AccessRepositoryMockUpdateRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockUpdateRuleExpectation specifies expectation struct of the AccessRepository.UpdateRule
+type AccessRepositoryMockUpdateRuleExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockUpdateRuleParams
+	paramPtrs          *AccessRepositoryMockUpdateRuleParamPtrs
+	expectationOrigins AccessRepositoryMockUpdateRuleExpectationOrigins
+	results            *AccessRepositoryMockUpdateRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockUpdateRuleParams contains parameters of the AccessRepository.UpdateRule
+type AccessRepositoryMockUpdateRuleParams struct {
+	ctx    context.Context
+	update *model.UpdateAccessRule
+}
+
+// AccessRepositoryMockUpdateRuleParamPtrs contains pointers to parameters of the AccessRepository.UpdateRule
+type AccessRepositoryMockUpdateRuleParamPtrs struct {
+	ctx    *context.Context
+	update **model.UpdateAccessRule
+}
+
+// AccessRepositoryMockUpdateRuleResults contains results of the AccessRepository.UpdateRule
+type AccessRepositoryMockUpdateRuleResults struct {
+	err error
+}
+
+// AccessRepositoryMockUpdateRuleOrigins contains origins of expectations of the AccessRepository.UpdateRule
+type AccessRepositoryMockUpdateRuleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Optional() *mAccessRepositoryMockUpdateRule {
+	mmUpdateRule.optional = true
+	return mmUpdateRule
+}
+
+// Expect sets up expected params for AccessRepository.UpdateRule
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Expect(ctx context.Context, update *model.UpdateAccessRule) *mAccessRepositoryMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &AccessRepositoryMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRule.defaultExpectation.params = &AccessRepositoryMockUpdateRuleParams{ctx, update}
+	mmUpdateRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateRule.expectations {
+		if minimock.Equal(e.params, mmUpdateRule.defaultExpectation.params) {
+			mmUpdateRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRule.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.UpdateRule
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &AccessRepositoryMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.params != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Expect")
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRule.defaultExpectation.paramPtrs = &AccessRepositoryMockUpdateRuleParamPtrs{}
+	}
+	mmUpdateRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateRule
+}
+
+// ExpectUpdateParam2 sets up expected param update for AccessRepository.UpdateRule
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) ExpectUpdateParam2(update *model.UpdateAccessRule) *mAccessRepositoryMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &AccessRepositoryMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.params != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Expect")
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRule.defaultExpectation.paramPtrs = &AccessRepositoryMockUpdateRuleParamPtrs{}
+	}
+	mmUpdateRule.defaultExpectation.paramPtrs.update = &update
+	mmUpdateRule.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.UpdateRule
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Inspect(f func(ctx context.Context, update *model.UpdateAccessRule)) *mAccessRepositoryMockUpdateRule {
+	if mmUpdateRule.mock.inspectFuncUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.UpdateRule")
+	}
+
+	mmUpdateRule.mock.inspectFuncUpdateRule = f
+
+	return mmUpdateRule
+}
+
+// Return sets up results that will be returned by AccessRepository.UpdateRule
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Return(err error) *AccessRepositoryMock {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &AccessRepositoryMockUpdateRuleExpectation{mock: mmUpdateRule.mock}
+	}
+	mmUpdateRule.defaultExpectation.results = &AccessRepositoryMockUpdateRuleResults{err}
+	mmUpdateRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.UpdateRule method
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Set(f func(ctx context.Context, update *model.UpdateAccessRule) (err error)) *AccessRepositoryMock {
+	if mmUpdateRule.defaultExpectation != nil {
+		mmUpdateRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.UpdateRule method")
+	}
+
+	if len(mmUpdateRule.expectations) > 0 {
+		mmUpdateRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.UpdateRule method")
+	}
+
+	mmUpdateRule.mock.funcUpdateRule = f
+	mmUpdateRule.mock.funcUpdateRuleOrigin = minimock.CallerInfo(1)
+	return mmUpdateRule.mock
+}
+
+// When sets expectation for the AccessRepository.UpdateRule which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) When(ctx context.Context, update *model.UpdateAccessRule) *AccessRepositoryMockUpdateRuleExpectation {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockUpdateRuleExpectation{
+		mock:               mmUpdateRule.mock,
+		params:             &AccessRepositoryMockUpdateRuleParams{ctx, update},
+		expectationOrigins: AccessRepositoryMockUpdateRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateRule.expectations = append(mmUpdateRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.UpdateRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockUpdateRuleExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockUpdateRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.UpdateRule should be invoked
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Times(n uint64) *mAccessRepositoryMockUpdateRule {
+	if n == 0 {
+		mmUpdateRule.mock.t.Fatalf("Times of AccessRepositoryMock.UpdateRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRule.expectedInvocations, n)
+	mmUpdateRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateRule
+}
+
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) invocationsDone() bool {
+	if len(mmUpdateRule.expectations) == 0 && mmUpdateRule.defaultExpectation == nil && mmUpdateRule.mock.funcUpdateRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRule.mock.afterUpdateRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRule implements mm_repository.AccessRepository
+func (mmUpdateRule *AccessRepositoryMock) UpdateRule(ctx context.Context, update *model.UpdateAccessRule) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRule.beforeUpdateRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRule.afterUpdateRuleCounter, 1)
+
+	mmUpdateRule.t.Helper()
+
+	if mmUpdateRule.inspectFuncUpdateRule != nil {
+		mmUpdateRule.inspectFuncUpdateRule(ctx, update)
+	}
+
+	mm_params := AccessRepositoryMockUpdateRuleParams{ctx, update}
+
+	// Record call args
+	mmUpdateRule.UpdateRuleMock.mutex.Lock()
+	mmUpdateRule.UpdateRuleMock.callArgs = append(mmUpdateRule.UpdateRuleMock.callArgs, &mm_params)
+	mmUpdateRule.UpdateRuleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRule.UpdateRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRule.UpdateRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRule.UpdateRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRule.UpdateRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRule.UpdateRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockUpdateRuleParams{ctx, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRule.t.Errorf("AccessRepositoryMock.UpdateRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateRule.t.Errorf("AccessRepositoryMock.UpdateRule got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRule.t.Errorf("AccessRepositoryMock.UpdateRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRule.UpdateRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRule.t.Fatal("No results are set for the AccessRepositoryMock.UpdateRule")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRule.funcUpdateRule != nil {
+		return mmUpdateRule.funcUpdateRule(ctx, update)
+	}
+	mmUpdateRule.t.Fatalf("Unexpected call to AccessRepositoryMock.UpdateRule. %v %v", ctx, update)
+	return
+}
+
+// UpdateRuleAfterCounter returns a count of finished AccessRepositoryMock.UpdateRule invocations
+func (mmUpdateRule *AccessRepositoryMock) UpdateRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRule.afterUpdateRuleCounter)
+}
+
+// UpdateRuleBeforeCounter returns a count of AccessRepositoryMock.UpdateRule invocations
+func (mmUpdateRule *AccessRepositoryMock) UpdateRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRule.beforeUpdateRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.UpdateRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRule *mAccessRepositoryMockUpdateRule) Calls() []*AccessRepositoryMockUpdateRuleParams {
+	mmUpdateRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockUpdateRuleParams, len(mmUpdateRule.callArgs))
+	copy(argCopy, mmUpdateRule.callArgs)
+
+	mmUpdateRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRuleDone returns true if the count of the UpdateRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockUpdateRuleDone() bool {
+	if m.UpdateRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRuleMock.invocationsDone()
+}
+
+// MinimockUpdateRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockUpdateRuleInspect() {
+	for _, e := range m.UpdateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.UpdateRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateRuleCounter := mm_atomic.LoadUint64(&m.afterUpdateRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRuleMock.defaultExpectation != nil && afterUpdateRuleCounter < 1 {
+		if m.UpdateRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.UpdateRule at\n%s", m.UpdateRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.UpdateRule at\n%s with params: %#v", m.UpdateRuleMock.defaultExpectation.expectationOrigins.origin, *m.UpdateRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRule != nil && afterUpdateRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.UpdateRule at\n%s", m.funcUpdateRuleOrigin)
+	}
+
+	if !m.UpdateRuleMock.invocationsDone() && afterUpdateRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.UpdateRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRuleMock.expectedInvocations), m.UpdateRuleMock.expectedInvocationsOrigin, afterUpdateRuleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateRuleInspect()
+
+			m.MinimockDeleteRuleInspect()
+
+			m.MinimockGetAccessMatcherInspect()
+
+			m.MinimockGetAccessRuleByEndpointInspect()
+
+			m.MinimockGetRuleInspect()
+
+			m.MinimockListRulesInspect()
+
+			m.MinimockUpdateRuleInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateRuleDone() &&
+		m.MinimockDeleteRuleDone() &&
+		m.MinimockGetAccessMatcherDone() &&
+		m.MinimockGetAccessRuleByEndpointDone() &&
+		m.MinimockGetRuleDone() &&
+		m.MinimockListRulesDone() &&
+		m.MinimockUpdateRuleDone()
+}
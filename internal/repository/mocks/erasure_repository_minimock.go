@@ -0,0 +1,1577 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.ErasureRepository -o erasure_repository_minimock.go -n ErasureRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ErasureRepositoryMock implements mm_repository.ErasureRepository
+type ErasureRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateErasureRequest          func(ctx context.Context, request *model.ErasureRequest) (i1 int64, err error)
+	funcCreateErasureRequestOrigin    string
+	inspectFuncCreateErasureRequest   func(ctx context.Context, request *model.ErasureRequest)
+	afterCreateErasureRequestCounter  uint64
+	beforeCreateErasureRequestCounter uint64
+	CreateErasureRequestMock          mErasureRepositoryMockCreateErasureRequest
+
+	funcGetErasureRequest          func(ctx context.Context, id int64) (ep1 *model.ErasureRequest, err error)
+	funcGetErasureRequestOrigin    string
+	inspectFuncGetErasureRequest   func(ctx context.Context, id int64)
+	afterGetErasureRequestCounter  uint64
+	beforeGetErasureRequestCounter uint64
+	GetErasureRequestMock          mErasureRepositoryMockGetErasureRequest
+
+	funcPseudonymizeErasureRequests          func(ctx context.Context, username string, pseudonym string) (err error)
+	funcPseudonymizeErasureRequestsOrigin    string
+	inspectFuncPseudonymizeErasureRequests   func(ctx context.Context, username string, pseudonym string)
+	afterPseudonymizeErasureRequestsCounter  uint64
+	beforePseudonymizeErasureRequestsCounter uint64
+	PseudonymizeErasureRequestsMock          mErasureRepositoryMockPseudonymizeErasureRequests
+
+	funcUpdateErasureRequestStatus          func(ctx context.Context, id int64, status string, reviewedBy string) (err error)
+	funcUpdateErasureRequestStatusOrigin    string
+	inspectFuncUpdateErasureRequestStatus   func(ctx context.Context, id int64, status string, reviewedBy string)
+	afterUpdateErasureRequestStatusCounter  uint64
+	beforeUpdateErasureRequestStatusCounter uint64
+	UpdateErasureRequestStatusMock          mErasureRepositoryMockUpdateErasureRequestStatus
+}
+
+// NewErasureRepositoryMock returns a mock for mm_repository.ErasureRepository
+func NewErasureRepositoryMock(t minimock.Tester) *ErasureRepositoryMock {
+	m := &ErasureRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateErasureRequestMock = mErasureRepositoryMockCreateErasureRequest{mock: m}
+	m.CreateErasureRequestMock.callArgs = []*ErasureRepositoryMockCreateErasureRequestParams{}
+
+	m.GetErasureRequestMock = mErasureRepositoryMockGetErasureRequest{mock: m}
+	m.GetErasureRequestMock.callArgs = []*ErasureRepositoryMockGetErasureRequestParams{}
+
+	m.PseudonymizeErasureRequestsMock = mErasureRepositoryMockPseudonymizeErasureRequests{mock: m}
+	m.PseudonymizeErasureRequestsMock.callArgs = []*ErasureRepositoryMockPseudonymizeErasureRequestsParams{}
+
+	m.UpdateErasureRequestStatusMock = mErasureRepositoryMockUpdateErasureRequestStatus{mock: m}
+	m.UpdateErasureRequestStatusMock.callArgs = []*ErasureRepositoryMockUpdateErasureRequestStatusParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mErasureRepositoryMockCreateErasureRequest struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockCreateErasureRequestExpectation
+	expectations       []*ErasureRepositoryMockCreateErasureRequestExpectation
+
+	callArgs []*ErasureRepositoryMockCreateErasureRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockCreateErasureRequestExpectation specifies expectation struct of the ErasureRepository.CreateErasureRequest
+type ErasureRepositoryMockCreateErasureRequestExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockCreateErasureRequestParams
+	paramPtrs          *ErasureRepositoryMockCreateErasureRequestParamPtrs
+	expectationOrigins ErasureRepositoryMockCreateErasureRequestExpectationOrigins
+	results            *ErasureRepositoryMockCreateErasureRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockCreateErasureRequestParams contains parameters of the ErasureRepository.CreateErasureRequest
+type ErasureRepositoryMockCreateErasureRequestParams struct {
+	ctx     context.Context
+	request *model.ErasureRequest
+}
+
+// ErasureRepositoryMockCreateErasureRequestParamPtrs contains pointers to parameters of the ErasureRepository.CreateErasureRequest
+type ErasureRepositoryMockCreateErasureRequestParamPtrs struct {
+	ctx     *context.Context
+	request **model.ErasureRequest
+}
+
+// ErasureRepositoryMockCreateErasureRequestResults contains results of the ErasureRepository.CreateErasureRequest
+type ErasureRepositoryMockCreateErasureRequestResults struct {
+	i1  int64
+	err error
+}
+
+// ErasureRepositoryMockCreateErasureRequestOrigins contains origins of expectations of the ErasureRepository.CreateErasureRequest
+type ErasureRepositoryMockCreateErasureRequestExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRequest string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Optional() *mErasureRepositoryMockCreateErasureRequest {
+	mmCreateErasureRequest.optional = true
+	return mmCreateErasureRequest
+}
+
+// Expect sets up expected params for ErasureRepository.CreateErasureRequest
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Expect(ctx context.Context, request *model.ErasureRequest) *mErasureRepositoryMockCreateErasureRequest {
+	if mmCreateErasureRequest.mock.funcCreateErasureRequest != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Set")
+	}
+
+	if mmCreateErasureRequest.defaultExpectation == nil {
+		mmCreateErasureRequest.defaultExpectation = &ErasureRepositoryMockCreateErasureRequestExpectation{}
+	}
+
+	if mmCreateErasureRequest.defaultExpectation.paramPtrs != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by ExpectParams functions")
+	}
+
+	mmCreateErasureRequest.defaultExpectation.params = &ErasureRepositoryMockCreateErasureRequestParams{ctx, request}
+	mmCreateErasureRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateErasureRequest.expectations {
+		if minimock.Equal(e.params, mmCreateErasureRequest.defaultExpectation.params) {
+			mmCreateErasureRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateErasureRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateErasureRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.CreateErasureRequest
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockCreateErasureRequest {
+	if mmCreateErasureRequest.mock.funcCreateErasureRequest != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Set")
+	}
+
+	if mmCreateErasureRequest.defaultExpectation == nil {
+		mmCreateErasureRequest.defaultExpectation = &ErasureRepositoryMockCreateErasureRequestExpectation{}
+	}
+
+	if mmCreateErasureRequest.defaultExpectation.params != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Expect")
+	}
+
+	if mmCreateErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmCreateErasureRequest.defaultExpectation.paramPtrs = &ErasureRepositoryMockCreateErasureRequestParamPtrs{}
+	}
+	mmCreateErasureRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateErasureRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateErasureRequest
+}
+
+// ExpectRequestParam2 sets up expected param request for ErasureRepository.CreateErasureRequest
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) ExpectRequestParam2(request *model.ErasureRequest) *mErasureRepositoryMockCreateErasureRequest {
+	if mmCreateErasureRequest.mock.funcCreateErasureRequest != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Set")
+	}
+
+	if mmCreateErasureRequest.defaultExpectation == nil {
+		mmCreateErasureRequest.defaultExpectation = &ErasureRepositoryMockCreateErasureRequestExpectation{}
+	}
+
+	if mmCreateErasureRequest.defaultExpectation.params != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Expect")
+	}
+
+	if mmCreateErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmCreateErasureRequest.defaultExpectation.paramPtrs = &ErasureRepositoryMockCreateErasureRequestParamPtrs{}
+	}
+	mmCreateErasureRequest.defaultExpectation.paramPtrs.request = &request
+	mmCreateErasureRequest.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmCreateErasureRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.CreateErasureRequest
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Inspect(f func(ctx context.Context, request *model.ErasureRequest)) *mErasureRepositoryMockCreateErasureRequest {
+	if mmCreateErasureRequest.mock.inspectFuncCreateErasureRequest != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.CreateErasureRequest")
+	}
+
+	mmCreateErasureRequest.mock.inspectFuncCreateErasureRequest = f
+
+	return mmCreateErasureRequest
+}
+
+// Return sets up results that will be returned by ErasureRepository.CreateErasureRequest
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Return(i1 int64, err error) *ErasureRepositoryMock {
+	if mmCreateErasureRequest.mock.funcCreateErasureRequest != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Set")
+	}
+
+	if mmCreateErasureRequest.defaultExpectation == nil {
+		mmCreateErasureRequest.defaultExpectation = &ErasureRepositoryMockCreateErasureRequestExpectation{mock: mmCreateErasureRequest.mock}
+	}
+	mmCreateErasureRequest.defaultExpectation.results = &ErasureRepositoryMockCreateErasureRequestResults{i1, err}
+	mmCreateErasureRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateErasureRequest.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.CreateErasureRequest method
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Set(f func(ctx context.Context, request *model.ErasureRequest) (i1 int64, err error)) *ErasureRepositoryMock {
+	if mmCreateErasureRequest.defaultExpectation != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.CreateErasureRequest method")
+	}
+
+	if len(mmCreateErasureRequest.expectations) > 0 {
+		mmCreateErasureRequest.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.CreateErasureRequest method")
+	}
+
+	mmCreateErasureRequest.mock.funcCreateErasureRequest = f
+	mmCreateErasureRequest.mock.funcCreateErasureRequestOrigin = minimock.CallerInfo(1)
+	return mmCreateErasureRequest.mock
+}
+
+// When sets expectation for the ErasureRepository.CreateErasureRequest which will trigger the result defined by the following
+// Then helper
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) When(ctx context.Context, request *model.ErasureRequest) *ErasureRepositoryMockCreateErasureRequestExpectation {
+	if mmCreateErasureRequest.mock.funcCreateErasureRequest != nil {
+		mmCreateErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.CreateErasureRequest mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockCreateErasureRequestExpectation{
+		mock:               mmCreateErasureRequest.mock,
+		params:             &ErasureRepositoryMockCreateErasureRequestParams{ctx, request},
+		expectationOrigins: ErasureRepositoryMockCreateErasureRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateErasureRequest.expectations = append(mmCreateErasureRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.CreateErasureRequest return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockCreateErasureRequestExpectation) Then(i1 int64, err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockCreateErasureRequestResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.CreateErasureRequest should be invoked
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Times(n uint64) *mErasureRepositoryMockCreateErasureRequest {
+	if n == 0 {
+		mmCreateErasureRequest.mock.t.Fatalf("Times of ErasureRepositoryMock.CreateErasureRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateErasureRequest.expectedInvocations, n)
+	mmCreateErasureRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateErasureRequest
+}
+
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) invocationsDone() bool {
+	if len(mmCreateErasureRequest.expectations) == 0 && mmCreateErasureRequest.defaultExpectation == nil && mmCreateErasureRequest.mock.funcCreateErasureRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateErasureRequest.mock.afterCreateErasureRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateErasureRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateErasureRequest implements mm_repository.ErasureRepository
+func (mmCreateErasureRequest *ErasureRepositoryMock) CreateErasureRequest(ctx context.Context, request *model.ErasureRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateErasureRequest.beforeCreateErasureRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateErasureRequest.afterCreateErasureRequestCounter, 1)
+
+	mmCreateErasureRequest.t.Helper()
+
+	if mmCreateErasureRequest.inspectFuncCreateErasureRequest != nil {
+		mmCreateErasureRequest.inspectFuncCreateErasureRequest(ctx, request)
+	}
+
+	mm_params := ErasureRepositoryMockCreateErasureRequestParams{ctx, request}
+
+	// Record call args
+	mmCreateErasureRequest.CreateErasureRequestMock.mutex.Lock()
+	mmCreateErasureRequest.CreateErasureRequestMock.callArgs = append(mmCreateErasureRequest.CreateErasureRequestMock.callArgs, &mm_params)
+	mmCreateErasureRequest.CreateErasureRequestMock.mutex.Unlock()
+
+	for _, e := range mmCreateErasureRequest.CreateErasureRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockCreateErasureRequestParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateErasureRequest.t.Errorf("ErasureRepositoryMock.CreateErasureRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmCreateErasureRequest.t.Errorf("ErasureRepositoryMock.CreateErasureRequest got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateErasureRequest.t.Errorf("ErasureRepositoryMock.CreateErasureRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateErasureRequest.CreateErasureRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateErasureRequest.t.Fatal("No results are set for the ErasureRepositoryMock.CreateErasureRequest")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateErasureRequest.funcCreateErasureRequest != nil {
+		return mmCreateErasureRequest.funcCreateErasureRequest(ctx, request)
+	}
+	mmCreateErasureRequest.t.Fatalf("Unexpected call to ErasureRepositoryMock.CreateErasureRequest. %v %v", ctx, request)
+	return
+}
+
+// CreateErasureRequestAfterCounter returns a count of finished ErasureRepositoryMock.CreateErasureRequest invocations
+func (mmCreateErasureRequest *ErasureRepositoryMock) CreateErasureRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateErasureRequest.afterCreateErasureRequestCounter)
+}
+
+// CreateErasureRequestBeforeCounter returns a count of ErasureRepositoryMock.CreateErasureRequest invocations
+func (mmCreateErasureRequest *ErasureRepositoryMock) CreateErasureRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateErasureRequest.beforeCreateErasureRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.CreateErasureRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateErasureRequest *mErasureRepositoryMockCreateErasureRequest) Calls() []*ErasureRepositoryMockCreateErasureRequestParams {
+	mmCreateErasureRequest.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockCreateErasureRequestParams, len(mmCreateErasureRequest.callArgs))
+	copy(argCopy, mmCreateErasureRequest.callArgs)
+
+	mmCreateErasureRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateErasureRequestDone returns true if the count of the CreateErasureRequest invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockCreateErasureRequestDone() bool {
+	if m.CreateErasureRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateErasureRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateErasureRequestMock.invocationsDone()
+}
+
+// MinimockCreateErasureRequestInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockCreateErasureRequestInspect() {
+	for _, e := range m.CreateErasureRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.CreateErasureRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateErasureRequestCounter := mm_atomic.LoadUint64(&m.afterCreateErasureRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateErasureRequestMock.defaultExpectation != nil && afterCreateErasureRequestCounter < 1 {
+		if m.CreateErasureRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.CreateErasureRequest at\n%s", m.CreateErasureRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.CreateErasureRequest at\n%s with params: %#v", m.CreateErasureRequestMock.defaultExpectation.expectationOrigins.origin, *m.CreateErasureRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateErasureRequest != nil && afterCreateErasureRequestCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.CreateErasureRequest at\n%s", m.funcCreateErasureRequestOrigin)
+	}
+
+	if !m.CreateErasureRequestMock.invocationsDone() && afterCreateErasureRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.CreateErasureRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateErasureRequestMock.expectedInvocations), m.CreateErasureRequestMock.expectedInvocationsOrigin, afterCreateErasureRequestCounter)
+	}
+}
+
+type mErasureRepositoryMockGetErasureRequest struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockGetErasureRequestExpectation
+	expectations       []*ErasureRepositoryMockGetErasureRequestExpectation
+
+	callArgs []*ErasureRepositoryMockGetErasureRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockGetErasureRequestExpectation specifies expectation struct of the ErasureRepository.GetErasureRequest
+type ErasureRepositoryMockGetErasureRequestExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockGetErasureRequestParams
+	paramPtrs          *ErasureRepositoryMockGetErasureRequestParamPtrs
+	expectationOrigins ErasureRepositoryMockGetErasureRequestExpectationOrigins
+	results            *ErasureRepositoryMockGetErasureRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockGetErasureRequestParams contains parameters of the ErasureRepository.GetErasureRequest
+type ErasureRepositoryMockGetErasureRequestParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ErasureRepositoryMockGetErasureRequestParamPtrs contains pointers to parameters of the ErasureRepository.GetErasureRequest
+type ErasureRepositoryMockGetErasureRequestParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ErasureRepositoryMockGetErasureRequestResults contains results of the ErasureRepository.GetErasureRequest
+type ErasureRepositoryMockGetErasureRequestResults struct {
+	ep1 *model.ErasureRequest
+	err error
+}
+
+// ErasureRepositoryMockGetErasureRequestOrigins contains origins of expectations of the ErasureRepository.GetErasureRequest
+type ErasureRepositoryMockGetErasureRequestExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Optional() *mErasureRepositoryMockGetErasureRequest {
+	mmGetErasureRequest.optional = true
+	return mmGetErasureRequest
+}
+
+// Expect sets up expected params for ErasureRepository.GetErasureRequest
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Expect(ctx context.Context, id int64) *mErasureRepositoryMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &ErasureRepositoryMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by ExpectParams functions")
+	}
+
+	mmGetErasureRequest.defaultExpectation.params = &ErasureRepositoryMockGetErasureRequestParams{ctx, id}
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetErasureRequest.expectations {
+		if minimock.Equal(e.params, mmGetErasureRequest.defaultExpectation.params) {
+			mmGetErasureRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetErasureRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmGetErasureRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.GetErasureRequest
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &ErasureRepositoryMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.params != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Expect")
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmGetErasureRequest.defaultExpectation.paramPtrs = &ErasureRepositoryMockGetErasureRequestParamPtrs{}
+	}
+	mmGetErasureRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetErasureRequest
+}
+
+// ExpectIdParam2 sets up expected param id for ErasureRepository.GetErasureRequest
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) ExpectIdParam2(id int64) *mErasureRepositoryMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &ErasureRepositoryMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.params != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Expect")
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmGetErasureRequest.defaultExpectation.paramPtrs = &ErasureRepositoryMockGetErasureRequestParamPtrs{}
+	}
+	mmGetErasureRequest.defaultExpectation.paramPtrs.id = &id
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetErasureRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.GetErasureRequest
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Inspect(f func(ctx context.Context, id int64)) *mErasureRepositoryMockGetErasureRequest {
+	if mmGetErasureRequest.mock.inspectFuncGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.GetErasureRequest")
+	}
+
+	mmGetErasureRequest.mock.inspectFuncGetErasureRequest = f
+
+	return mmGetErasureRequest
+}
+
+// Return sets up results that will be returned by ErasureRepository.GetErasureRequest
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Return(ep1 *model.ErasureRequest, err error) *ErasureRepositoryMock {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &ErasureRepositoryMockGetErasureRequestExpectation{mock: mmGetErasureRequest.mock}
+	}
+	mmGetErasureRequest.defaultExpectation.results = &ErasureRepositoryMockGetErasureRequestResults{ep1, err}
+	mmGetErasureRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetErasureRequest.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.GetErasureRequest method
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Set(f func(ctx context.Context, id int64) (ep1 *model.ErasureRequest, err error)) *ErasureRepositoryMock {
+	if mmGetErasureRequest.defaultExpectation != nil {
+		mmGetErasureRequest.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.GetErasureRequest method")
+	}
+
+	if len(mmGetErasureRequest.expectations) > 0 {
+		mmGetErasureRequest.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.GetErasureRequest method")
+	}
+
+	mmGetErasureRequest.mock.funcGetErasureRequest = f
+	mmGetErasureRequest.mock.funcGetErasureRequestOrigin = minimock.CallerInfo(1)
+	return mmGetErasureRequest.mock
+}
+
+// When sets expectation for the ErasureRepository.GetErasureRequest which will trigger the result defined by the following
+// Then helper
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) When(ctx context.Context, id int64) *ErasureRepositoryMockGetErasureRequestExpectation {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("ErasureRepositoryMock.GetErasureRequest mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockGetErasureRequestExpectation{
+		mock:               mmGetErasureRequest.mock,
+		params:             &ErasureRepositoryMockGetErasureRequestParams{ctx, id},
+		expectationOrigins: ErasureRepositoryMockGetErasureRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetErasureRequest.expectations = append(mmGetErasureRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.GetErasureRequest return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockGetErasureRequestExpectation) Then(ep1 *model.ErasureRequest, err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockGetErasureRequestResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.GetErasureRequest should be invoked
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Times(n uint64) *mErasureRepositoryMockGetErasureRequest {
+	if n == 0 {
+		mmGetErasureRequest.mock.t.Fatalf("Times of ErasureRepositoryMock.GetErasureRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetErasureRequest.expectedInvocations, n)
+	mmGetErasureRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetErasureRequest
+}
+
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) invocationsDone() bool {
+	if len(mmGetErasureRequest.expectations) == 0 && mmGetErasureRequest.defaultExpectation == nil && mmGetErasureRequest.mock.funcGetErasureRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetErasureRequest.mock.afterGetErasureRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetErasureRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetErasureRequest implements mm_repository.ErasureRepository
+func (mmGetErasureRequest *ErasureRepositoryMock) GetErasureRequest(ctx context.Context, id int64) (ep1 *model.ErasureRequest, err error) {
+	mm_atomic.AddUint64(&mmGetErasureRequest.beforeGetErasureRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetErasureRequest.afterGetErasureRequestCounter, 1)
+
+	mmGetErasureRequest.t.Helper()
+
+	if mmGetErasureRequest.inspectFuncGetErasureRequest != nil {
+		mmGetErasureRequest.inspectFuncGetErasureRequest(ctx, id)
+	}
+
+	mm_params := ErasureRepositoryMockGetErasureRequestParams{ctx, id}
+
+	// Record call args
+	mmGetErasureRequest.GetErasureRequestMock.mutex.Lock()
+	mmGetErasureRequest.GetErasureRequestMock.callArgs = append(mmGetErasureRequest.GetErasureRequestMock.callArgs, &mm_params)
+	mmGetErasureRequest.GetErasureRequestMock.mutex.Unlock()
+
+	for _, e := range mmGetErasureRequest.GetErasureRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmGetErasureRequest.GetErasureRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockGetErasureRequestParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetErasureRequest.t.Errorf("ErasureRepositoryMock.GetErasureRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetErasureRequest.t.Errorf("ErasureRepositoryMock.GetErasureRequest got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetErasureRequest.t.Errorf("ErasureRepositoryMock.GetErasureRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetErasureRequest.t.Fatal("No results are set for the ErasureRepositoryMock.GetErasureRequest")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmGetErasureRequest.funcGetErasureRequest != nil {
+		return mmGetErasureRequest.funcGetErasureRequest(ctx, id)
+	}
+	mmGetErasureRequest.t.Fatalf("Unexpected call to ErasureRepositoryMock.GetErasureRequest. %v %v", ctx, id)
+	return
+}
+
+// GetErasureRequestAfterCounter returns a count of finished ErasureRepositoryMock.GetErasureRequest invocations
+func (mmGetErasureRequest *ErasureRepositoryMock) GetErasureRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetErasureRequest.afterGetErasureRequestCounter)
+}
+
+// GetErasureRequestBeforeCounter returns a count of ErasureRepositoryMock.GetErasureRequest invocations
+func (mmGetErasureRequest *ErasureRepositoryMock) GetErasureRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetErasureRequest.beforeGetErasureRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.GetErasureRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetErasureRequest *mErasureRepositoryMockGetErasureRequest) Calls() []*ErasureRepositoryMockGetErasureRequestParams {
+	mmGetErasureRequest.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockGetErasureRequestParams, len(mmGetErasureRequest.callArgs))
+	copy(argCopy, mmGetErasureRequest.callArgs)
+
+	mmGetErasureRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetErasureRequestDone returns true if the count of the GetErasureRequest invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockGetErasureRequestDone() bool {
+	if m.GetErasureRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetErasureRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetErasureRequestMock.invocationsDone()
+}
+
+// MinimockGetErasureRequestInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockGetErasureRequestInspect() {
+	for _, e := range m.GetErasureRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.GetErasureRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetErasureRequestCounter := mm_atomic.LoadUint64(&m.afterGetErasureRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetErasureRequestMock.defaultExpectation != nil && afterGetErasureRequestCounter < 1 {
+		if m.GetErasureRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.GetErasureRequest at\n%s", m.GetErasureRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.GetErasureRequest at\n%s with params: %#v", m.GetErasureRequestMock.defaultExpectation.expectationOrigins.origin, *m.GetErasureRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetErasureRequest != nil && afterGetErasureRequestCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.GetErasureRequest at\n%s", m.funcGetErasureRequestOrigin)
+	}
+
+	if !m.GetErasureRequestMock.invocationsDone() && afterGetErasureRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.GetErasureRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetErasureRequestMock.expectedInvocations), m.GetErasureRequestMock.expectedInvocationsOrigin, afterGetErasureRequestCounter)
+	}
+}
+
+type mErasureRepositoryMockPseudonymizeErasureRequests struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockPseudonymizeErasureRequestsExpectation
+	expectations       []*ErasureRepositoryMockPseudonymizeErasureRequestsExpectation
+
+	callArgs []*ErasureRepositoryMockPseudonymizeErasureRequestsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockPseudonymizeErasureRequestsExpectation specifies expectation struct of the ErasureRepository.PseudonymizeErasureRequests
+type ErasureRepositoryMockPseudonymizeErasureRequestsExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockPseudonymizeErasureRequestsParams
+	paramPtrs          *ErasureRepositoryMockPseudonymizeErasureRequestsParamPtrs
+	expectationOrigins ErasureRepositoryMockPseudonymizeErasureRequestsExpectationOrigins
+	results            *ErasureRepositoryMockPseudonymizeErasureRequestsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockPseudonymizeErasureRequestsParams contains parameters of the ErasureRepository.PseudonymizeErasureRequests
+type ErasureRepositoryMockPseudonymizeErasureRequestsParams struct {
+	ctx       context.Context
+	username  string
+	pseudonym string
+}
+
+// ErasureRepositoryMockPseudonymizeErasureRequestsParamPtrs contains pointers to parameters of the ErasureRepository.PseudonymizeErasureRequests
+type ErasureRepositoryMockPseudonymizeErasureRequestsParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	pseudonym *string
+}
+
+// ErasureRepositoryMockPseudonymizeErasureRequestsResults contains results of the ErasureRepository.PseudonymizeErasureRequests
+type ErasureRepositoryMockPseudonymizeErasureRequestsResults struct {
+	err error
+}
+
+// ErasureRepositoryMockPseudonymizeErasureRequestsOrigins contains origins of expectations of the ErasureRepository.PseudonymizeErasureRequests
+type ErasureRepositoryMockPseudonymizeErasureRequestsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originPseudonym string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Optional() *mErasureRepositoryMockPseudonymizeErasureRequests {
+	mmPseudonymizeErasureRequests.optional = true
+	return mmPseudonymizeErasureRequests
+}
+
+// Expect sets up expected params for ErasureRepository.PseudonymizeErasureRequests
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Expect(ctx context.Context, username string, pseudonym string) *mErasureRepositoryMockPseudonymizeErasureRequests {
+	if mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Set")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation = &ErasureRepositoryMockPseudonymizeErasureRequestsExpectation{}
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by ExpectParams functions")
+	}
+
+	mmPseudonymizeErasureRequests.defaultExpectation.params = &ErasureRepositoryMockPseudonymizeErasureRequestsParams{ctx, username, pseudonym}
+	mmPseudonymizeErasureRequests.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPseudonymizeErasureRequests.expectations {
+		if minimock.Equal(e.params, mmPseudonymizeErasureRequests.defaultExpectation.params) {
+			mmPseudonymizeErasureRequests.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPseudonymizeErasureRequests.defaultExpectation.params)
+		}
+	}
+
+	return mmPseudonymizeErasureRequests
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.PseudonymizeErasureRequests
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockPseudonymizeErasureRequests {
+	if mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Set")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation = &ErasureRepositoryMockPseudonymizeErasureRequestsExpectation{}
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.params != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Expect")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs = &ErasureRepositoryMockPseudonymizeErasureRequestsParamPtrs{}
+	}
+	mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPseudonymizeErasureRequests.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPseudonymizeErasureRequests
+}
+
+// ExpectUsernameParam2 sets up expected param username for ErasureRepository.PseudonymizeErasureRequests
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) ExpectUsernameParam2(username string) *mErasureRepositoryMockPseudonymizeErasureRequests {
+	if mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Set")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation = &ErasureRepositoryMockPseudonymizeErasureRequestsExpectation{}
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.params != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Expect")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs = &ErasureRepositoryMockPseudonymizeErasureRequestsParamPtrs{}
+	}
+	mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs.username = &username
+	mmPseudonymizeErasureRequests.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmPseudonymizeErasureRequests
+}
+
+// ExpectPseudonymParam3 sets up expected param pseudonym for ErasureRepository.PseudonymizeErasureRequests
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) ExpectPseudonymParam3(pseudonym string) *mErasureRepositoryMockPseudonymizeErasureRequests {
+	if mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Set")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation = &ErasureRepositoryMockPseudonymizeErasureRequestsExpectation{}
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.params != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Expect")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs = &ErasureRepositoryMockPseudonymizeErasureRequestsParamPtrs{}
+	}
+	mmPseudonymizeErasureRequests.defaultExpectation.paramPtrs.pseudonym = &pseudonym
+	mmPseudonymizeErasureRequests.defaultExpectation.expectationOrigins.originPseudonym = minimock.CallerInfo(1)
+
+	return mmPseudonymizeErasureRequests
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.PseudonymizeErasureRequests
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Inspect(f func(ctx context.Context, username string, pseudonym string)) *mErasureRepositoryMockPseudonymizeErasureRequests {
+	if mmPseudonymizeErasureRequests.mock.inspectFuncPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.PseudonymizeErasureRequests")
+	}
+
+	mmPseudonymizeErasureRequests.mock.inspectFuncPseudonymizeErasureRequests = f
+
+	return mmPseudonymizeErasureRequests
+}
+
+// Return sets up results that will be returned by ErasureRepository.PseudonymizeErasureRequests
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Return(err error) *ErasureRepositoryMock {
+	if mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Set")
+	}
+
+	if mmPseudonymizeErasureRequests.defaultExpectation == nil {
+		mmPseudonymizeErasureRequests.defaultExpectation = &ErasureRepositoryMockPseudonymizeErasureRequestsExpectation{mock: mmPseudonymizeErasureRequests.mock}
+	}
+	mmPseudonymizeErasureRequests.defaultExpectation.results = &ErasureRepositoryMockPseudonymizeErasureRequestsResults{err}
+	mmPseudonymizeErasureRequests.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeErasureRequests.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.PseudonymizeErasureRequests method
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Set(f func(ctx context.Context, username string, pseudonym string) (err error)) *ErasureRepositoryMock {
+	if mmPseudonymizeErasureRequests.defaultExpectation != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.PseudonymizeErasureRequests method")
+	}
+
+	if len(mmPseudonymizeErasureRequests.expectations) > 0 {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.PseudonymizeErasureRequests method")
+	}
+
+	mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests = f
+	mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequestsOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeErasureRequests.mock
+}
+
+// When sets expectation for the ErasureRepository.PseudonymizeErasureRequests which will trigger the result defined by the following
+// Then helper
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) When(ctx context.Context, username string, pseudonym string) *ErasureRepositoryMockPseudonymizeErasureRequestsExpectation {
+	if mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("ErasureRepositoryMock.PseudonymizeErasureRequests mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockPseudonymizeErasureRequestsExpectation{
+		mock:               mmPseudonymizeErasureRequests.mock,
+		params:             &ErasureRepositoryMockPseudonymizeErasureRequestsParams{ctx, username, pseudonym},
+		expectationOrigins: ErasureRepositoryMockPseudonymizeErasureRequestsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPseudonymizeErasureRequests.expectations = append(mmPseudonymizeErasureRequests.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.PseudonymizeErasureRequests return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockPseudonymizeErasureRequestsExpectation) Then(err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockPseudonymizeErasureRequestsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.PseudonymizeErasureRequests should be invoked
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Times(n uint64) *mErasureRepositoryMockPseudonymizeErasureRequests {
+	if n == 0 {
+		mmPseudonymizeErasureRequests.mock.t.Fatalf("Times of ErasureRepositoryMock.PseudonymizeErasureRequests mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPseudonymizeErasureRequests.expectedInvocations, n)
+	mmPseudonymizeErasureRequests.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeErasureRequests
+}
+
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) invocationsDone() bool {
+	if len(mmPseudonymizeErasureRequests.expectations) == 0 && mmPseudonymizeErasureRequests.defaultExpectation == nil && mmPseudonymizeErasureRequests.mock.funcPseudonymizeErasureRequests == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPseudonymizeErasureRequests.mock.afterPseudonymizeErasureRequestsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPseudonymizeErasureRequests.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PseudonymizeErasureRequests implements mm_repository.ErasureRepository
+func (mmPseudonymizeErasureRequests *ErasureRepositoryMock) PseudonymizeErasureRequests(ctx context.Context, username string, pseudonym string) (err error) {
+	mm_atomic.AddUint64(&mmPseudonymizeErasureRequests.beforePseudonymizeErasureRequestsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPseudonymizeErasureRequests.afterPseudonymizeErasureRequestsCounter, 1)
+
+	mmPseudonymizeErasureRequests.t.Helper()
+
+	if mmPseudonymizeErasureRequests.inspectFuncPseudonymizeErasureRequests != nil {
+		mmPseudonymizeErasureRequests.inspectFuncPseudonymizeErasureRequests(ctx, username, pseudonym)
+	}
+
+	mm_params := ErasureRepositoryMockPseudonymizeErasureRequestsParams{ctx, username, pseudonym}
+
+	// Record call args
+	mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.mutex.Lock()
+	mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.callArgs = append(mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.callArgs, &mm_params)
+	mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.mutex.Unlock()
+
+	for _, e := range mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.params
+		mm_want_ptrs := mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockPseudonymizeErasureRequestsParams{ctx, username, pseudonym}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPseudonymizeErasureRequests.t.Errorf("ErasureRepositoryMock.PseudonymizeErasureRequests got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmPseudonymizeErasureRequests.t.Errorf("ErasureRepositoryMock.PseudonymizeErasureRequests got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.pseudonym != nil && !minimock.Equal(*mm_want_ptrs.pseudonym, mm_got.pseudonym) {
+				mmPseudonymizeErasureRequests.t.Errorf("ErasureRepositoryMock.PseudonymizeErasureRequests got unexpected parameter pseudonym, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.expectationOrigins.originPseudonym, *mm_want_ptrs.pseudonym, mm_got.pseudonym, minimock.Diff(*mm_want_ptrs.pseudonym, mm_got.pseudonym))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPseudonymizeErasureRequests.t.Errorf("ErasureRepositoryMock.PseudonymizeErasureRequests got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPseudonymizeErasureRequests.PseudonymizeErasureRequestsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPseudonymizeErasureRequests.t.Fatal("No results are set for the ErasureRepositoryMock.PseudonymizeErasureRequests")
+		}
+		return (*mm_results).err
+	}
+	if mmPseudonymizeErasureRequests.funcPseudonymizeErasureRequests != nil {
+		return mmPseudonymizeErasureRequests.funcPseudonymizeErasureRequests(ctx, username, pseudonym)
+	}
+	mmPseudonymizeErasureRequests.t.Fatalf("Unexpected call to ErasureRepositoryMock.PseudonymizeErasureRequests. %v %v %v", ctx, username, pseudonym)
+	return
+}
+
+// PseudonymizeErasureRequestsAfterCounter returns a count of finished ErasureRepositoryMock.PseudonymizeErasureRequests invocations
+func (mmPseudonymizeErasureRequests *ErasureRepositoryMock) PseudonymizeErasureRequestsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeErasureRequests.afterPseudonymizeErasureRequestsCounter)
+}
+
+// PseudonymizeErasureRequestsBeforeCounter returns a count of ErasureRepositoryMock.PseudonymizeErasureRequests invocations
+func (mmPseudonymizeErasureRequests *ErasureRepositoryMock) PseudonymizeErasureRequestsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeErasureRequests.beforePseudonymizeErasureRequestsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.PseudonymizeErasureRequests.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPseudonymizeErasureRequests *mErasureRepositoryMockPseudonymizeErasureRequests) Calls() []*ErasureRepositoryMockPseudonymizeErasureRequestsParams {
+	mmPseudonymizeErasureRequests.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockPseudonymizeErasureRequestsParams, len(mmPseudonymizeErasureRequests.callArgs))
+	copy(argCopy, mmPseudonymizeErasureRequests.callArgs)
+
+	mmPseudonymizeErasureRequests.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPseudonymizeErasureRequestsDone returns true if the count of the PseudonymizeErasureRequests invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockPseudonymizeErasureRequestsDone() bool {
+	if m.PseudonymizeErasureRequestsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PseudonymizeErasureRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PseudonymizeErasureRequestsMock.invocationsDone()
+}
+
+// MinimockPseudonymizeErasureRequestsInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockPseudonymizeErasureRequestsInspect() {
+	for _, e := range m.PseudonymizeErasureRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.PseudonymizeErasureRequests at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPseudonymizeErasureRequestsCounter := mm_atomic.LoadUint64(&m.afterPseudonymizeErasureRequestsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PseudonymizeErasureRequestsMock.defaultExpectation != nil && afterPseudonymizeErasureRequestsCounter < 1 {
+		if m.PseudonymizeErasureRequestsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.PseudonymizeErasureRequests at\n%s", m.PseudonymizeErasureRequestsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.PseudonymizeErasureRequests at\n%s with params: %#v", m.PseudonymizeErasureRequestsMock.defaultExpectation.expectationOrigins.origin, *m.PseudonymizeErasureRequestsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPseudonymizeErasureRequests != nil && afterPseudonymizeErasureRequestsCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.PseudonymizeErasureRequests at\n%s", m.funcPseudonymizeErasureRequestsOrigin)
+	}
+
+	if !m.PseudonymizeErasureRequestsMock.invocationsDone() && afterPseudonymizeErasureRequestsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.PseudonymizeErasureRequests at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PseudonymizeErasureRequestsMock.expectedInvocations), m.PseudonymizeErasureRequestsMock.expectedInvocationsOrigin, afterPseudonymizeErasureRequestsCounter)
+	}
+}
+
+type mErasureRepositoryMockUpdateErasureRequestStatus struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockUpdateErasureRequestStatusExpectation
+	expectations       []*ErasureRepositoryMockUpdateErasureRequestStatusExpectation
+
+	callArgs []*ErasureRepositoryMockUpdateErasureRequestStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockUpdateErasureRequestStatusExpectation specifies expectation struct of the ErasureRepository.UpdateErasureRequestStatus
+type ErasureRepositoryMockUpdateErasureRequestStatusExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockUpdateErasureRequestStatusParams
+	paramPtrs          *ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs
+	expectationOrigins ErasureRepositoryMockUpdateErasureRequestStatusExpectationOrigins
+	results            *ErasureRepositoryMockUpdateErasureRequestStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockUpdateErasureRequestStatusParams contains parameters of the ErasureRepository.UpdateErasureRequestStatus
+type ErasureRepositoryMockUpdateErasureRequestStatusParams struct {
+	ctx        context.Context
+	id         int64
+	status     string
+	reviewedBy string
+}
+
+// ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs contains pointers to parameters of the ErasureRepository.UpdateErasureRequestStatus
+type ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs struct {
+	ctx        *context.Context
+	id         *int64
+	status     *string
+	reviewedBy *string
+}
+
+// ErasureRepositoryMockUpdateErasureRequestStatusResults contains results of the ErasureRepository.UpdateErasureRequestStatus
+type ErasureRepositoryMockUpdateErasureRequestStatusResults struct {
+	err error
+}
+
+// ErasureRepositoryMockUpdateErasureRequestStatusOrigins contains origins of expectations of the ErasureRepository.UpdateErasureRequestStatus
+type ErasureRepositoryMockUpdateErasureRequestStatusExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originId         string
+	originStatus     string
+	originReviewedBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Optional() *mErasureRepositoryMockUpdateErasureRequestStatus {
+	mmUpdateErasureRequestStatus.optional = true
+	return mmUpdateErasureRequestStatus
+}
+
+// Expect sets up expected params for ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Expect(ctx context.Context, id int64, status string, reviewedBy string) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation = &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{}
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateErasureRequestStatus.defaultExpectation.params = &ErasureRepositoryMockUpdateErasureRequestStatusParams{ctx, id, status, reviewedBy}
+	mmUpdateErasureRequestStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateErasureRequestStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateErasureRequestStatus.defaultExpectation.params) {
+			mmUpdateErasureRequestStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateErasureRequestStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateErasureRequestStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation = &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{}
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.params != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Expect")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs = &ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs{}
+	}
+	mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateErasureRequestStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateErasureRequestStatus
+}
+
+// ExpectIdParam2 sets up expected param id for ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) ExpectIdParam2(id int64) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation = &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{}
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.params != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Expect")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs = &ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs{}
+	}
+	mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs.id = &id
+	mmUpdateErasureRequestStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateErasureRequestStatus
+}
+
+// ExpectStatusParam3 sets up expected param status for ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) ExpectStatusParam3(status string) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation = &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{}
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.params != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Expect")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs = &ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs{}
+	}
+	mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs.status = &status
+	mmUpdateErasureRequestStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmUpdateErasureRequestStatus
+}
+
+// ExpectReviewedByParam4 sets up expected param reviewedBy for ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) ExpectReviewedByParam4(reviewedBy string) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation = &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{}
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.params != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Expect")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs = &ErasureRepositoryMockUpdateErasureRequestStatusParamPtrs{}
+	}
+	mmUpdateErasureRequestStatus.defaultExpectation.paramPtrs.reviewedBy = &reviewedBy
+	mmUpdateErasureRequestStatus.defaultExpectation.expectationOrigins.originReviewedBy = minimock.CallerInfo(1)
+
+	return mmUpdateErasureRequestStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Inspect(f func(ctx context.Context, id int64, status string, reviewedBy string)) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if mmUpdateErasureRequestStatus.mock.inspectFuncUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.UpdateErasureRequestStatus")
+	}
+
+	mmUpdateErasureRequestStatus.mock.inspectFuncUpdateErasureRequestStatus = f
+
+	return mmUpdateErasureRequestStatus
+}
+
+// Return sets up results that will be returned by ErasureRepository.UpdateErasureRequestStatus
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Return(err error) *ErasureRepositoryMock {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	if mmUpdateErasureRequestStatus.defaultExpectation == nil {
+		mmUpdateErasureRequestStatus.defaultExpectation = &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{mock: mmUpdateErasureRequestStatus.mock}
+	}
+	mmUpdateErasureRequestStatus.defaultExpectation.results = &ErasureRepositoryMockUpdateErasureRequestStatusResults{err}
+	mmUpdateErasureRequestStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateErasureRequestStatus.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.UpdateErasureRequestStatus method
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Set(f func(ctx context.Context, id int64, status string, reviewedBy string) (err error)) *ErasureRepositoryMock {
+	if mmUpdateErasureRequestStatus.defaultExpectation != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.UpdateErasureRequestStatus method")
+	}
+
+	if len(mmUpdateErasureRequestStatus.expectations) > 0 {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.UpdateErasureRequestStatus method")
+	}
+
+	mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus = f
+	mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatusOrigin = minimock.CallerInfo(1)
+	return mmUpdateErasureRequestStatus.mock
+}
+
+// When sets expectation for the ErasureRepository.UpdateErasureRequestStatus which will trigger the result defined by the following
+// Then helper
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) When(ctx context.Context, id int64, status string, reviewedBy string) *ErasureRepositoryMockUpdateErasureRequestStatusExpectation {
+	if mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("ErasureRepositoryMock.UpdateErasureRequestStatus mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockUpdateErasureRequestStatusExpectation{
+		mock:               mmUpdateErasureRequestStatus.mock,
+		params:             &ErasureRepositoryMockUpdateErasureRequestStatusParams{ctx, id, status, reviewedBy},
+		expectationOrigins: ErasureRepositoryMockUpdateErasureRequestStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateErasureRequestStatus.expectations = append(mmUpdateErasureRequestStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.UpdateErasureRequestStatus return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockUpdateErasureRequestStatusExpectation) Then(err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockUpdateErasureRequestStatusResults{err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.UpdateErasureRequestStatus should be invoked
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Times(n uint64) *mErasureRepositoryMockUpdateErasureRequestStatus {
+	if n == 0 {
+		mmUpdateErasureRequestStatus.mock.t.Fatalf("Times of ErasureRepositoryMock.UpdateErasureRequestStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateErasureRequestStatus.expectedInvocations, n)
+	mmUpdateErasureRequestStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateErasureRequestStatus
+}
+
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) invocationsDone() bool {
+	if len(mmUpdateErasureRequestStatus.expectations) == 0 && mmUpdateErasureRequestStatus.defaultExpectation == nil && mmUpdateErasureRequestStatus.mock.funcUpdateErasureRequestStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateErasureRequestStatus.mock.afterUpdateErasureRequestStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateErasureRequestStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateErasureRequestStatus implements mm_repository.ErasureRepository
+func (mmUpdateErasureRequestStatus *ErasureRepositoryMock) UpdateErasureRequestStatus(ctx context.Context, id int64, status string, reviewedBy string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateErasureRequestStatus.beforeUpdateErasureRequestStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateErasureRequestStatus.afterUpdateErasureRequestStatusCounter, 1)
+
+	mmUpdateErasureRequestStatus.t.Helper()
+
+	if mmUpdateErasureRequestStatus.inspectFuncUpdateErasureRequestStatus != nil {
+		mmUpdateErasureRequestStatus.inspectFuncUpdateErasureRequestStatus(ctx, id, status, reviewedBy)
+	}
+
+	mm_params := ErasureRepositoryMockUpdateErasureRequestStatusParams{ctx, id, status, reviewedBy}
+
+	// Record call args
+	mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.mutex.Lock()
+	mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.callArgs = append(mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.callArgs, &mm_params)
+	mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.mutex.Unlock()
+
+	for _, e := range mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockUpdateErasureRequestStatusParams{ctx, id, status, reviewedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateErasureRequestStatus.t.Errorf("ErasureRepositoryMock.UpdateErasureRequestStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateErasureRequestStatus.t.Errorf("ErasureRepositoryMock.UpdateErasureRequestStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmUpdateErasureRequestStatus.t.Errorf("ErasureRepositoryMock.UpdateErasureRequestStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.reviewedBy != nil && !minimock.Equal(*mm_want_ptrs.reviewedBy, mm_got.reviewedBy) {
+				mmUpdateErasureRequestStatus.t.Errorf("ErasureRepositoryMock.UpdateErasureRequestStatus got unexpected parameter reviewedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.expectationOrigins.originReviewedBy, *mm_want_ptrs.reviewedBy, mm_got.reviewedBy, minimock.Diff(*mm_want_ptrs.reviewedBy, mm_got.reviewedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateErasureRequestStatus.t.Errorf("ErasureRepositoryMock.UpdateErasureRequestStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateErasureRequestStatus.UpdateErasureRequestStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateErasureRequestStatus.t.Fatal("No results are set for the ErasureRepositoryMock.UpdateErasureRequestStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateErasureRequestStatus.funcUpdateErasureRequestStatus != nil {
+		return mmUpdateErasureRequestStatus.funcUpdateErasureRequestStatus(ctx, id, status, reviewedBy)
+	}
+	mmUpdateErasureRequestStatus.t.Fatalf("Unexpected call to ErasureRepositoryMock.UpdateErasureRequestStatus. %v %v %v %v", ctx, id, status, reviewedBy)
+	return
+}
+
+// UpdateErasureRequestStatusAfterCounter returns a count of finished ErasureRepositoryMock.UpdateErasureRequestStatus invocations
+func (mmUpdateErasureRequestStatus *ErasureRepositoryMock) UpdateErasureRequestStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateErasureRequestStatus.afterUpdateErasureRequestStatusCounter)
+}
+
+// UpdateErasureRequestStatusBeforeCounter returns a count of ErasureRepositoryMock.UpdateErasureRequestStatus invocations
+func (mmUpdateErasureRequestStatus *ErasureRepositoryMock) UpdateErasureRequestStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateErasureRequestStatus.beforeUpdateErasureRequestStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.UpdateErasureRequestStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateErasureRequestStatus *mErasureRepositoryMockUpdateErasureRequestStatus) Calls() []*ErasureRepositoryMockUpdateErasureRequestStatusParams {
+	mmUpdateErasureRequestStatus.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockUpdateErasureRequestStatusParams, len(mmUpdateErasureRequestStatus.callArgs))
+	copy(argCopy, mmUpdateErasureRequestStatus.callArgs)
+
+	mmUpdateErasureRequestStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateErasureRequestStatusDone returns true if the count of the UpdateErasureRequestStatus invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockUpdateErasureRequestStatusDone() bool {
+	if m.UpdateErasureRequestStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateErasureRequestStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateErasureRequestStatusMock.invocationsDone()
+}
+
+// MinimockUpdateErasureRequestStatusInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockUpdateErasureRequestStatusInspect() {
+	for _, e := range m.UpdateErasureRequestStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.UpdateErasureRequestStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateErasureRequestStatusCounter := mm_atomic.LoadUint64(&m.afterUpdateErasureRequestStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateErasureRequestStatusMock.defaultExpectation != nil && afterUpdateErasureRequestStatusCounter < 1 {
+		if m.UpdateErasureRequestStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.UpdateErasureRequestStatus at\n%s", m.UpdateErasureRequestStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.UpdateErasureRequestStatus at\n%s with params: %#v", m.UpdateErasureRequestStatusMock.defaultExpectation.expectationOrigins.origin, *m.UpdateErasureRequestStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateErasureRequestStatus != nil && afterUpdateErasureRequestStatusCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.UpdateErasureRequestStatus at\n%s", m.funcUpdateErasureRequestStatusOrigin)
+	}
+
+	if !m.UpdateErasureRequestStatusMock.invocationsDone() && afterUpdateErasureRequestStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.UpdateErasureRequestStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateErasureRequestStatusMock.expectedInvocations), m.UpdateErasureRequestStatusMock.expectedInvocationsOrigin, afterUpdateErasureRequestStatusCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ErasureRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateErasureRequestInspect()
+
+			m.MinimockGetErasureRequestInspect()
+
+			m.MinimockPseudonymizeErasureRequestsInspect()
+
+			m.MinimockUpdateErasureRequestStatusInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ErasureRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ErasureRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateErasureRequestDone() &&
+		m.MinimockGetErasureRequestDone() &&
+		m.MinimockPseudonymizeErasureRequestsDone() &&
+		m.MinimockUpdateErasureRequestStatusDone()
+}
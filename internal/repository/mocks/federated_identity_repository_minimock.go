@@ -0,0 +1,1516 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.FederatedIdentityRepository -o federated_identity_repository_minimock.go -n FederatedIdentityRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// FederatedIdentityRepositoryMock implements mm_repository.FederatedIdentityRepository
+type FederatedIdentityRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateFederatedIdentity          func(ctx context.Context, identity *model.FederatedIdentity) (i1 int64, err error)
+	funcCreateFederatedIdentityOrigin    string
+	inspectFuncCreateFederatedIdentity   func(ctx context.Context, identity *model.FederatedIdentity)
+	afterCreateFederatedIdentityCounter  uint64
+	beforeCreateFederatedIdentityCounter uint64
+	CreateFederatedIdentityMock          mFederatedIdentityRepositoryMockCreateFederatedIdentity
+
+	funcDeleteFederatedIdentitiesByUserID          func(ctx context.Context, userID int64) (err error)
+	funcDeleteFederatedIdentitiesByUserIDOrigin    string
+	inspectFuncDeleteFederatedIdentitiesByUserID   func(ctx context.Context, userID int64)
+	afterDeleteFederatedIdentitiesByUserIDCounter  uint64
+	beforeDeleteFederatedIdentitiesByUserIDCounter uint64
+	DeleteFederatedIdentitiesByUserIDMock          mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID
+
+	funcGetFederatedIdentity          func(ctx context.Context, provider string, subject string) (fp1 *model.FederatedIdentity, err error)
+	funcGetFederatedIdentityOrigin    string
+	inspectFuncGetFederatedIdentity   func(ctx context.Context, provider string, subject string)
+	afterGetFederatedIdentityCounter  uint64
+	beforeGetFederatedIdentityCounter uint64
+	GetFederatedIdentityMock          mFederatedIdentityRepositoryMockGetFederatedIdentity
+
+	funcListFederatedIdentitiesByUserID          func(ctx context.Context, userID int64) (fpa1 []*model.FederatedIdentity, err error)
+	funcListFederatedIdentitiesByUserIDOrigin    string
+	inspectFuncListFederatedIdentitiesByUserID   func(ctx context.Context, userID int64)
+	afterListFederatedIdentitiesByUserIDCounter  uint64
+	beforeListFederatedIdentitiesByUserIDCounter uint64
+	ListFederatedIdentitiesByUserIDMock          mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID
+}
+
+// NewFederatedIdentityRepositoryMock returns a mock for mm_repository.FederatedIdentityRepository
+func NewFederatedIdentityRepositoryMock(t minimock.Tester) *FederatedIdentityRepositoryMock {
+	m := &FederatedIdentityRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateFederatedIdentityMock = mFederatedIdentityRepositoryMockCreateFederatedIdentity{mock: m}
+	m.CreateFederatedIdentityMock.callArgs = []*FederatedIdentityRepositoryMockCreateFederatedIdentityParams{}
+
+	m.DeleteFederatedIdentitiesByUserIDMock = mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID{mock: m}
+	m.DeleteFederatedIdentitiesByUserIDMock.callArgs = []*FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams{}
+
+	m.GetFederatedIdentityMock = mFederatedIdentityRepositoryMockGetFederatedIdentity{mock: m}
+	m.GetFederatedIdentityMock.callArgs = []*FederatedIdentityRepositoryMockGetFederatedIdentityParams{}
+
+	m.ListFederatedIdentitiesByUserIDMock = mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID{mock: m}
+	m.ListFederatedIdentitiesByUserIDMock.callArgs = []*FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFederatedIdentityRepositoryMockCreateFederatedIdentity struct {
+	optional           bool
+	mock               *FederatedIdentityRepositoryMock
+	defaultExpectation *FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation
+	expectations       []*FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation
+
+	callArgs []*FederatedIdentityRepositoryMockCreateFederatedIdentityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation specifies expectation struct of the FederatedIdentityRepository.CreateFederatedIdentity
+type FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation struct {
+	mock               *FederatedIdentityRepositoryMock
+	params             *FederatedIdentityRepositoryMockCreateFederatedIdentityParams
+	paramPtrs          *FederatedIdentityRepositoryMockCreateFederatedIdentityParamPtrs
+	expectationOrigins FederatedIdentityRepositoryMockCreateFederatedIdentityExpectationOrigins
+	results            *FederatedIdentityRepositoryMockCreateFederatedIdentityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederatedIdentityRepositoryMockCreateFederatedIdentityParams contains parameters of the FederatedIdentityRepository.CreateFederatedIdentity
+type FederatedIdentityRepositoryMockCreateFederatedIdentityParams struct {
+	ctx      context.Context
+	identity *model.FederatedIdentity
+}
+
+// FederatedIdentityRepositoryMockCreateFederatedIdentityParamPtrs contains pointers to parameters of the FederatedIdentityRepository.CreateFederatedIdentity
+type FederatedIdentityRepositoryMockCreateFederatedIdentityParamPtrs struct {
+	ctx      *context.Context
+	identity **model.FederatedIdentity
+}
+
+// FederatedIdentityRepositoryMockCreateFederatedIdentityResults contains results of the FederatedIdentityRepository.CreateFederatedIdentity
+type FederatedIdentityRepositoryMockCreateFederatedIdentityResults struct {
+	i1  int64
+	err error
+}
+
+// FederatedIdentityRepositoryMockCreateFederatedIdentityOrigins contains origins of expectations of the FederatedIdentityRepository.CreateFederatedIdentity
+type FederatedIdentityRepositoryMockCreateFederatedIdentityExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originIdentity string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Optional() *mFederatedIdentityRepositoryMockCreateFederatedIdentity {
+	mmCreateFederatedIdentity.optional = true
+	return mmCreateFederatedIdentity
+}
+
+// Expect sets up expected params for FederatedIdentityRepository.CreateFederatedIdentity
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Expect(ctx context.Context, identity *model.FederatedIdentity) *mFederatedIdentityRepositoryMockCreateFederatedIdentity {
+	if mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Set")
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation == nil {
+		mmCreateFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation{}
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation.paramPtrs != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by ExpectParams functions")
+	}
+
+	mmCreateFederatedIdentity.defaultExpectation.params = &FederatedIdentityRepositoryMockCreateFederatedIdentityParams{ctx, identity}
+	mmCreateFederatedIdentity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateFederatedIdentity.expectations {
+		if minimock.Equal(e.params, mmCreateFederatedIdentity.defaultExpectation.params) {
+			mmCreateFederatedIdentity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateFederatedIdentity.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateFederatedIdentity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedIdentityRepository.CreateFederatedIdentity
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) ExpectCtxParam1(ctx context.Context) *mFederatedIdentityRepositoryMockCreateFederatedIdentity {
+	if mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Set")
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation == nil {
+		mmCreateFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation{}
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation.params != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Expect")
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation.paramPtrs == nil {
+		mmCreateFederatedIdentity.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockCreateFederatedIdentityParamPtrs{}
+	}
+	mmCreateFederatedIdentity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateFederatedIdentity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateFederatedIdentity
+}
+
+// ExpectIdentityParam2 sets up expected param identity for FederatedIdentityRepository.CreateFederatedIdentity
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) ExpectIdentityParam2(identity *model.FederatedIdentity) *mFederatedIdentityRepositoryMockCreateFederatedIdentity {
+	if mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Set")
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation == nil {
+		mmCreateFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation{}
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation.params != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Expect")
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation.paramPtrs == nil {
+		mmCreateFederatedIdentity.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockCreateFederatedIdentityParamPtrs{}
+	}
+	mmCreateFederatedIdentity.defaultExpectation.paramPtrs.identity = &identity
+	mmCreateFederatedIdentity.defaultExpectation.expectationOrigins.originIdentity = minimock.CallerInfo(1)
+
+	return mmCreateFederatedIdentity
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedIdentityRepository.CreateFederatedIdentity
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Inspect(f func(ctx context.Context, identity *model.FederatedIdentity)) *mFederatedIdentityRepositoryMockCreateFederatedIdentity {
+	if mmCreateFederatedIdentity.mock.inspectFuncCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("Inspect function is already set for FederatedIdentityRepositoryMock.CreateFederatedIdentity")
+	}
+
+	mmCreateFederatedIdentity.mock.inspectFuncCreateFederatedIdentity = f
+
+	return mmCreateFederatedIdentity
+}
+
+// Return sets up results that will be returned by FederatedIdentityRepository.CreateFederatedIdentity
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Return(i1 int64, err error) *FederatedIdentityRepositoryMock {
+	if mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Set")
+	}
+
+	if mmCreateFederatedIdentity.defaultExpectation == nil {
+		mmCreateFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation{mock: mmCreateFederatedIdentity.mock}
+	}
+	mmCreateFederatedIdentity.defaultExpectation.results = &FederatedIdentityRepositoryMockCreateFederatedIdentityResults{i1, err}
+	mmCreateFederatedIdentity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateFederatedIdentity.mock
+}
+
+// Set uses given function f to mock the FederatedIdentityRepository.CreateFederatedIdentity method
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Set(f func(ctx context.Context, identity *model.FederatedIdentity) (i1 int64, err error)) *FederatedIdentityRepositoryMock {
+	if mmCreateFederatedIdentity.defaultExpectation != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("Default expectation is already set for the FederatedIdentityRepository.CreateFederatedIdentity method")
+	}
+
+	if len(mmCreateFederatedIdentity.expectations) > 0 {
+		mmCreateFederatedIdentity.mock.t.Fatalf("Some expectations are already set for the FederatedIdentityRepository.CreateFederatedIdentity method")
+	}
+
+	mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity = f
+	mmCreateFederatedIdentity.mock.funcCreateFederatedIdentityOrigin = minimock.CallerInfo(1)
+	return mmCreateFederatedIdentity.mock
+}
+
+// When sets expectation for the FederatedIdentityRepository.CreateFederatedIdentity which will trigger the result defined by the following
+// Then helper
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) When(ctx context.Context, identity *model.FederatedIdentity) *FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation {
+	if mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.CreateFederatedIdentity mock is already set by Set")
+	}
+
+	expectation := &FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation{
+		mock:               mmCreateFederatedIdentity.mock,
+		params:             &FederatedIdentityRepositoryMockCreateFederatedIdentityParams{ctx, identity},
+		expectationOrigins: FederatedIdentityRepositoryMockCreateFederatedIdentityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateFederatedIdentity.expectations = append(mmCreateFederatedIdentity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedIdentityRepository.CreateFederatedIdentity return parameters for the expectation previously defined by the When method
+func (e *FederatedIdentityRepositoryMockCreateFederatedIdentityExpectation) Then(i1 int64, err error) *FederatedIdentityRepositoryMock {
+	e.results = &FederatedIdentityRepositoryMockCreateFederatedIdentityResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times FederatedIdentityRepository.CreateFederatedIdentity should be invoked
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Times(n uint64) *mFederatedIdentityRepositoryMockCreateFederatedIdentity {
+	if n == 0 {
+		mmCreateFederatedIdentity.mock.t.Fatalf("Times of FederatedIdentityRepositoryMock.CreateFederatedIdentity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateFederatedIdentity.expectedInvocations, n)
+	mmCreateFederatedIdentity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateFederatedIdentity
+}
+
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) invocationsDone() bool {
+	if len(mmCreateFederatedIdentity.expectations) == 0 && mmCreateFederatedIdentity.defaultExpectation == nil && mmCreateFederatedIdentity.mock.funcCreateFederatedIdentity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateFederatedIdentity.mock.afterCreateFederatedIdentityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateFederatedIdentity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateFederatedIdentity implements mm_repository.FederatedIdentityRepository
+func (mmCreateFederatedIdentity *FederatedIdentityRepositoryMock) CreateFederatedIdentity(ctx context.Context, identity *model.FederatedIdentity) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateFederatedIdentity.beforeCreateFederatedIdentityCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateFederatedIdentity.afterCreateFederatedIdentityCounter, 1)
+
+	mmCreateFederatedIdentity.t.Helper()
+
+	if mmCreateFederatedIdentity.inspectFuncCreateFederatedIdentity != nil {
+		mmCreateFederatedIdentity.inspectFuncCreateFederatedIdentity(ctx, identity)
+	}
+
+	mm_params := FederatedIdentityRepositoryMockCreateFederatedIdentityParams{ctx, identity}
+
+	// Record call args
+	mmCreateFederatedIdentity.CreateFederatedIdentityMock.mutex.Lock()
+	mmCreateFederatedIdentity.CreateFederatedIdentityMock.callArgs = append(mmCreateFederatedIdentity.CreateFederatedIdentityMock.callArgs, &mm_params)
+	mmCreateFederatedIdentity.CreateFederatedIdentityMock.mutex.Unlock()
+
+	for _, e := range mmCreateFederatedIdentity.CreateFederatedIdentityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedIdentityRepositoryMockCreateFederatedIdentityParams{ctx, identity}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.CreateFederatedIdentity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.identity != nil && !minimock.Equal(*mm_want_ptrs.identity, mm_got.identity) {
+				mmCreateFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.CreateFederatedIdentity got unexpected parameter identity, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.expectationOrigins.originIdentity, *mm_want_ptrs.identity, mm_got.identity, minimock.Diff(*mm_want_ptrs.identity, mm_got.identity))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.CreateFederatedIdentity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateFederatedIdentity.CreateFederatedIdentityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateFederatedIdentity.t.Fatal("No results are set for the FederatedIdentityRepositoryMock.CreateFederatedIdentity")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateFederatedIdentity.funcCreateFederatedIdentity != nil {
+		return mmCreateFederatedIdentity.funcCreateFederatedIdentity(ctx, identity)
+	}
+	mmCreateFederatedIdentity.t.Fatalf("Unexpected call to FederatedIdentityRepositoryMock.CreateFederatedIdentity. %v %v", ctx, identity)
+	return
+}
+
+// CreateFederatedIdentityAfterCounter returns a count of finished FederatedIdentityRepositoryMock.CreateFederatedIdentity invocations
+func (mmCreateFederatedIdentity *FederatedIdentityRepositoryMock) CreateFederatedIdentityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFederatedIdentity.afterCreateFederatedIdentityCounter)
+}
+
+// CreateFederatedIdentityBeforeCounter returns a count of FederatedIdentityRepositoryMock.CreateFederatedIdentity invocations
+func (mmCreateFederatedIdentity *FederatedIdentityRepositoryMock) CreateFederatedIdentityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFederatedIdentity.beforeCreateFederatedIdentityCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedIdentityRepositoryMock.CreateFederatedIdentity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateFederatedIdentity *mFederatedIdentityRepositoryMockCreateFederatedIdentity) Calls() []*FederatedIdentityRepositoryMockCreateFederatedIdentityParams {
+	mmCreateFederatedIdentity.mutex.RLock()
+
+	argCopy := make([]*FederatedIdentityRepositoryMockCreateFederatedIdentityParams, len(mmCreateFederatedIdentity.callArgs))
+	copy(argCopy, mmCreateFederatedIdentity.callArgs)
+
+	mmCreateFederatedIdentity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateFederatedIdentityDone returns true if the count of the CreateFederatedIdentity invocations corresponds
+// the number of defined expectations
+func (m *FederatedIdentityRepositoryMock) MinimockCreateFederatedIdentityDone() bool {
+	if m.CreateFederatedIdentityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateFederatedIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateFederatedIdentityMock.invocationsDone()
+}
+
+// MinimockCreateFederatedIdentityInspect logs each unmet expectation
+func (m *FederatedIdentityRepositoryMock) MinimockCreateFederatedIdentityInspect() {
+	for _, e := range m.CreateFederatedIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.CreateFederatedIdentity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateFederatedIdentityCounter := mm_atomic.LoadUint64(&m.afterCreateFederatedIdentityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateFederatedIdentityMock.defaultExpectation != nil && afterCreateFederatedIdentityCounter < 1 {
+		if m.CreateFederatedIdentityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.CreateFederatedIdentity at\n%s", m.CreateFederatedIdentityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.CreateFederatedIdentity at\n%s with params: %#v", m.CreateFederatedIdentityMock.defaultExpectation.expectationOrigins.origin, *m.CreateFederatedIdentityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateFederatedIdentity != nil && afterCreateFederatedIdentityCounter < 1 {
+		m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.CreateFederatedIdentity at\n%s", m.funcCreateFederatedIdentityOrigin)
+	}
+
+	if !m.CreateFederatedIdentityMock.invocationsDone() && afterCreateFederatedIdentityCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederatedIdentityRepositoryMock.CreateFederatedIdentity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateFederatedIdentityMock.expectedInvocations), m.CreateFederatedIdentityMock.expectedInvocationsOrigin, afterCreateFederatedIdentityCounter)
+	}
+}
+
+type mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID struct {
+	optional           bool
+	mock               *FederatedIdentityRepositoryMock
+	defaultExpectation *FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation
+	expectations       []*FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation
+
+	callArgs []*FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation specifies expectation struct of the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation struct {
+	mock               *FederatedIdentityRepositoryMock
+	params             *FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams
+	paramPtrs          *FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParamPtrs
+	expectationOrigins FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectationOrigins
+	results            *FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams contains parameters of the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParamPtrs contains pointers to parameters of the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDResults contains results of the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDResults struct {
+	err error
+}
+
+// FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDOrigins contains origins of expectations of the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Optional() *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID {
+	mmDeleteFederatedIdentitiesByUserID.optional = true
+	return mmDeleteFederatedIdentitiesByUserID
+}
+
+// Expect sets up expected params for FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Expect(ctx context.Context, userID int64) *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID {
+	if mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmDeleteFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation{}
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.params = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams{ctx, userID}
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteFederatedIdentitiesByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteFederatedIdentitiesByUserID.defaultExpectation.params) {
+			mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFederatedIdentitiesByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFederatedIdentitiesByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) ExpectCtxParam1(ctx context.Context) *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID {
+	if mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmDeleteFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation{}
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation.params != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParamPtrs{}
+	}
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteFederatedIdentitiesByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) ExpectUserIDParam2(userID int64) *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID {
+	if mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmDeleteFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation{}
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation.params != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParamPtrs{}
+	}
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteFederatedIdentitiesByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Inspect(f func(ctx context.Context, userID int64)) *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID {
+	if mmDeleteFederatedIdentitiesByUserID.mock.inspectFuncDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("Inspect function is already set for FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID")
+	}
+
+	mmDeleteFederatedIdentitiesByUserID.mock.inspectFuncDeleteFederatedIdentitiesByUserID = f
+
+	return mmDeleteFederatedIdentitiesByUserID
+}
+
+// Return sets up results that will be returned by FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Return(err error) *FederatedIdentityRepositoryMock {
+	if mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmDeleteFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation{mock: mmDeleteFederatedIdentitiesByUserID.mock}
+	}
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.results = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDResults{err}
+	mmDeleteFederatedIdentitiesByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteFederatedIdentitiesByUserID.mock
+}
+
+// Set uses given function f to mock the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID method
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Set(f func(ctx context.Context, userID int64) (err error)) *FederatedIdentityRepositoryMock {
+	if mmDeleteFederatedIdentitiesByUserID.defaultExpectation != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("Default expectation is already set for the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID method")
+	}
+
+	if len(mmDeleteFederatedIdentitiesByUserID.expectations) > 0 {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("Some expectations are already set for the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID method")
+	}
+
+	mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID = f
+	mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteFederatedIdentitiesByUserID.mock
+}
+
+// When sets expectation for the FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) When(ctx context.Context, userID int64) *FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation {
+	if mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	expectation := &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation{
+		mock:               mmDeleteFederatedIdentitiesByUserID.mock,
+		params:             &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams{ctx, userID},
+		expectationOrigins: FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteFederatedIdentitiesByUserID.expectations = append(mmDeleteFederatedIdentitiesByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID return parameters for the expectation previously defined by the When method
+func (e *FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDExpectation) Then(err error) *FederatedIdentityRepositoryMock {
+	e.results = &FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times FederatedIdentityRepository.DeleteFederatedIdentitiesByUserID should be invoked
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Times(n uint64) *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID {
+	if n == 0 {
+		mmDeleteFederatedIdentitiesByUserID.mock.t.Fatalf("Times of FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFederatedIdentitiesByUserID.expectedInvocations, n)
+	mmDeleteFederatedIdentitiesByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteFederatedIdentitiesByUserID
+}
+
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) invocationsDone() bool {
+	if len(mmDeleteFederatedIdentitiesByUserID.expectations) == 0 && mmDeleteFederatedIdentitiesByUserID.defaultExpectation == nil && mmDeleteFederatedIdentitiesByUserID.mock.funcDeleteFederatedIdentitiesByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFederatedIdentitiesByUserID.mock.afterDeleteFederatedIdentitiesByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFederatedIdentitiesByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFederatedIdentitiesByUserID implements mm_repository.FederatedIdentityRepository
+func (mmDeleteFederatedIdentitiesByUserID *FederatedIdentityRepositoryMock) DeleteFederatedIdentitiesByUserID(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteFederatedIdentitiesByUserID.beforeDeleteFederatedIdentitiesByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFederatedIdentitiesByUserID.afterDeleteFederatedIdentitiesByUserIDCounter, 1)
+
+	mmDeleteFederatedIdentitiesByUserID.t.Helper()
+
+	if mmDeleteFederatedIdentitiesByUserID.inspectFuncDeleteFederatedIdentitiesByUserID != nil {
+		mmDeleteFederatedIdentitiesByUserID.inspectFuncDeleteFederatedIdentitiesByUserID(ctx, userID)
+	}
+
+	mm_params := FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.mutex.Lock()
+	mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.callArgs = append(mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.callArgs, &mm_params)
+	mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFederatedIdentitiesByUserID.t.Errorf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteFederatedIdentitiesByUserID.t.Errorf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFederatedIdentitiesByUserID.t.Errorf("FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFederatedIdentitiesByUserID.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFederatedIdentitiesByUserID.t.Fatal("No results are set for the FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteFederatedIdentitiesByUserID.funcDeleteFederatedIdentitiesByUserID != nil {
+		return mmDeleteFederatedIdentitiesByUserID.funcDeleteFederatedIdentitiesByUserID(ctx, userID)
+	}
+	mmDeleteFederatedIdentitiesByUserID.t.Fatalf("Unexpected call to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteFederatedIdentitiesByUserIDAfterCounter returns a count of finished FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID invocations
+func (mmDeleteFederatedIdentitiesByUserID *FederatedIdentityRepositoryMock) DeleteFederatedIdentitiesByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFederatedIdentitiesByUserID.afterDeleteFederatedIdentitiesByUserIDCounter)
+}
+
+// DeleteFederatedIdentitiesByUserIDBeforeCounter returns a count of FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID invocations
+func (mmDeleteFederatedIdentitiesByUserID *FederatedIdentityRepositoryMock) DeleteFederatedIdentitiesByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFederatedIdentitiesByUserID.beforeDeleteFederatedIdentitiesByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserID) Calls() []*FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams {
+	mmDeleteFederatedIdentitiesByUserID.mutex.RLock()
+
+	argCopy := make([]*FederatedIdentityRepositoryMockDeleteFederatedIdentitiesByUserIDParams, len(mmDeleteFederatedIdentitiesByUserID.callArgs))
+	copy(argCopy, mmDeleteFederatedIdentitiesByUserID.callArgs)
+
+	mmDeleteFederatedIdentitiesByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFederatedIdentitiesByUserIDDone returns true if the count of the DeleteFederatedIdentitiesByUserID invocations corresponds
+// the number of defined expectations
+func (m *FederatedIdentityRepositoryMock) MinimockDeleteFederatedIdentitiesByUserIDDone() bool {
+	if m.DeleteFederatedIdentitiesByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFederatedIdentitiesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFederatedIdentitiesByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteFederatedIdentitiesByUserIDInspect logs each unmet expectation
+func (m *FederatedIdentityRepositoryMock) MinimockDeleteFederatedIdentitiesByUserIDInspect() {
+	for _, e := range m.DeleteFederatedIdentitiesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteFederatedIdentitiesByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteFederatedIdentitiesByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation != nil && afterDeleteFederatedIdentitiesByUserIDCounter < 1 {
+		if m.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID at\n%s", m.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID at\n%s with params: %#v", m.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteFederatedIdentitiesByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFederatedIdentitiesByUserID != nil && afterDeleteFederatedIdentitiesByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID at\n%s", m.funcDeleteFederatedIdentitiesByUserIDOrigin)
+	}
+
+	if !m.DeleteFederatedIdentitiesByUserIDMock.invocationsDone() && afterDeleteFederatedIdentitiesByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederatedIdentityRepositoryMock.DeleteFederatedIdentitiesByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFederatedIdentitiesByUserIDMock.expectedInvocations), m.DeleteFederatedIdentitiesByUserIDMock.expectedInvocationsOrigin, afterDeleteFederatedIdentitiesByUserIDCounter)
+	}
+}
+
+type mFederatedIdentityRepositoryMockGetFederatedIdentity struct {
+	optional           bool
+	mock               *FederatedIdentityRepositoryMock
+	defaultExpectation *FederatedIdentityRepositoryMockGetFederatedIdentityExpectation
+	expectations       []*FederatedIdentityRepositoryMockGetFederatedIdentityExpectation
+
+	callArgs []*FederatedIdentityRepositoryMockGetFederatedIdentityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederatedIdentityRepositoryMockGetFederatedIdentityExpectation specifies expectation struct of the FederatedIdentityRepository.GetFederatedIdentity
+type FederatedIdentityRepositoryMockGetFederatedIdentityExpectation struct {
+	mock               *FederatedIdentityRepositoryMock
+	params             *FederatedIdentityRepositoryMockGetFederatedIdentityParams
+	paramPtrs          *FederatedIdentityRepositoryMockGetFederatedIdentityParamPtrs
+	expectationOrigins FederatedIdentityRepositoryMockGetFederatedIdentityExpectationOrigins
+	results            *FederatedIdentityRepositoryMockGetFederatedIdentityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederatedIdentityRepositoryMockGetFederatedIdentityParams contains parameters of the FederatedIdentityRepository.GetFederatedIdentity
+type FederatedIdentityRepositoryMockGetFederatedIdentityParams struct {
+	ctx      context.Context
+	provider string
+	subject  string
+}
+
+// FederatedIdentityRepositoryMockGetFederatedIdentityParamPtrs contains pointers to parameters of the FederatedIdentityRepository.GetFederatedIdentity
+type FederatedIdentityRepositoryMockGetFederatedIdentityParamPtrs struct {
+	ctx      *context.Context
+	provider *string
+	subject  *string
+}
+
+// FederatedIdentityRepositoryMockGetFederatedIdentityResults contains results of the FederatedIdentityRepository.GetFederatedIdentity
+type FederatedIdentityRepositoryMockGetFederatedIdentityResults struct {
+	fp1 *model.FederatedIdentity
+	err error
+}
+
+// FederatedIdentityRepositoryMockGetFederatedIdentityOrigins contains origins of expectations of the FederatedIdentityRepository.GetFederatedIdentity
+type FederatedIdentityRepositoryMockGetFederatedIdentityExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originProvider string
+	originSubject  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Optional() *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	mmGetFederatedIdentity.optional = true
+	return mmGetFederatedIdentity
+}
+
+// Expect sets up expected params for FederatedIdentityRepository.GetFederatedIdentity
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Expect(ctx context.Context, provider string, subject string) *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	if mmGetFederatedIdentity.mock.funcGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Set")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation == nil {
+		mmGetFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockGetFederatedIdentityExpectation{}
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.paramPtrs != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by ExpectParams functions")
+	}
+
+	mmGetFederatedIdentity.defaultExpectation.params = &FederatedIdentityRepositoryMockGetFederatedIdentityParams{ctx, provider, subject}
+	mmGetFederatedIdentity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetFederatedIdentity.expectations {
+		if minimock.Equal(e.params, mmGetFederatedIdentity.defaultExpectation.params) {
+			mmGetFederatedIdentity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFederatedIdentity.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFederatedIdentity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedIdentityRepository.GetFederatedIdentity
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) ExpectCtxParam1(ctx context.Context) *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	if mmGetFederatedIdentity.mock.funcGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Set")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation == nil {
+		mmGetFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockGetFederatedIdentityExpectation{}
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.params != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Expect")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetFederatedIdentity.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockGetFederatedIdentityParamPtrs{}
+	}
+	mmGetFederatedIdentity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetFederatedIdentity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetFederatedIdentity
+}
+
+// ExpectProviderParam2 sets up expected param provider for FederatedIdentityRepository.GetFederatedIdentity
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) ExpectProviderParam2(provider string) *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	if mmGetFederatedIdentity.mock.funcGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Set")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation == nil {
+		mmGetFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockGetFederatedIdentityExpectation{}
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.params != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Expect")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetFederatedIdentity.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockGetFederatedIdentityParamPtrs{}
+	}
+	mmGetFederatedIdentity.defaultExpectation.paramPtrs.provider = &provider
+	mmGetFederatedIdentity.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmGetFederatedIdentity
+}
+
+// ExpectSubjectParam3 sets up expected param subject for FederatedIdentityRepository.GetFederatedIdentity
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) ExpectSubjectParam3(subject string) *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	if mmGetFederatedIdentity.mock.funcGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Set")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation == nil {
+		mmGetFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockGetFederatedIdentityExpectation{}
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.params != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Expect")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetFederatedIdentity.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockGetFederatedIdentityParamPtrs{}
+	}
+	mmGetFederatedIdentity.defaultExpectation.paramPtrs.subject = &subject
+	mmGetFederatedIdentity.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmGetFederatedIdentity
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedIdentityRepository.GetFederatedIdentity
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Inspect(f func(ctx context.Context, provider string, subject string)) *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	if mmGetFederatedIdentity.mock.inspectFuncGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("Inspect function is already set for FederatedIdentityRepositoryMock.GetFederatedIdentity")
+	}
+
+	mmGetFederatedIdentity.mock.inspectFuncGetFederatedIdentity = f
+
+	return mmGetFederatedIdentity
+}
+
+// Return sets up results that will be returned by FederatedIdentityRepository.GetFederatedIdentity
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Return(fp1 *model.FederatedIdentity, err error) *FederatedIdentityRepositoryMock {
+	if mmGetFederatedIdentity.mock.funcGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Set")
+	}
+
+	if mmGetFederatedIdentity.defaultExpectation == nil {
+		mmGetFederatedIdentity.defaultExpectation = &FederatedIdentityRepositoryMockGetFederatedIdentityExpectation{mock: mmGetFederatedIdentity.mock}
+	}
+	mmGetFederatedIdentity.defaultExpectation.results = &FederatedIdentityRepositoryMockGetFederatedIdentityResults{fp1, err}
+	mmGetFederatedIdentity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetFederatedIdentity.mock
+}
+
+// Set uses given function f to mock the FederatedIdentityRepository.GetFederatedIdentity method
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Set(f func(ctx context.Context, provider string, subject string) (fp1 *model.FederatedIdentity, err error)) *FederatedIdentityRepositoryMock {
+	if mmGetFederatedIdentity.defaultExpectation != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("Default expectation is already set for the FederatedIdentityRepository.GetFederatedIdentity method")
+	}
+
+	if len(mmGetFederatedIdentity.expectations) > 0 {
+		mmGetFederatedIdentity.mock.t.Fatalf("Some expectations are already set for the FederatedIdentityRepository.GetFederatedIdentity method")
+	}
+
+	mmGetFederatedIdentity.mock.funcGetFederatedIdentity = f
+	mmGetFederatedIdentity.mock.funcGetFederatedIdentityOrigin = minimock.CallerInfo(1)
+	return mmGetFederatedIdentity.mock
+}
+
+// When sets expectation for the FederatedIdentityRepository.GetFederatedIdentity which will trigger the result defined by the following
+// Then helper
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) When(ctx context.Context, provider string, subject string) *FederatedIdentityRepositoryMockGetFederatedIdentityExpectation {
+	if mmGetFederatedIdentity.mock.funcGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.mock.t.Fatalf("FederatedIdentityRepositoryMock.GetFederatedIdentity mock is already set by Set")
+	}
+
+	expectation := &FederatedIdentityRepositoryMockGetFederatedIdentityExpectation{
+		mock:               mmGetFederatedIdentity.mock,
+		params:             &FederatedIdentityRepositoryMockGetFederatedIdentityParams{ctx, provider, subject},
+		expectationOrigins: FederatedIdentityRepositoryMockGetFederatedIdentityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetFederatedIdentity.expectations = append(mmGetFederatedIdentity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedIdentityRepository.GetFederatedIdentity return parameters for the expectation previously defined by the When method
+func (e *FederatedIdentityRepositoryMockGetFederatedIdentityExpectation) Then(fp1 *model.FederatedIdentity, err error) *FederatedIdentityRepositoryMock {
+	e.results = &FederatedIdentityRepositoryMockGetFederatedIdentityResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times FederatedIdentityRepository.GetFederatedIdentity should be invoked
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Times(n uint64) *mFederatedIdentityRepositoryMockGetFederatedIdentity {
+	if n == 0 {
+		mmGetFederatedIdentity.mock.t.Fatalf("Times of FederatedIdentityRepositoryMock.GetFederatedIdentity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFederatedIdentity.expectedInvocations, n)
+	mmGetFederatedIdentity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetFederatedIdentity
+}
+
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) invocationsDone() bool {
+	if len(mmGetFederatedIdentity.expectations) == 0 && mmGetFederatedIdentity.defaultExpectation == nil && mmGetFederatedIdentity.mock.funcGetFederatedIdentity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFederatedIdentity.mock.afterGetFederatedIdentityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFederatedIdentity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFederatedIdentity implements mm_repository.FederatedIdentityRepository
+func (mmGetFederatedIdentity *FederatedIdentityRepositoryMock) GetFederatedIdentity(ctx context.Context, provider string, subject string) (fp1 *model.FederatedIdentity, err error) {
+	mm_atomic.AddUint64(&mmGetFederatedIdentity.beforeGetFederatedIdentityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFederatedIdentity.afterGetFederatedIdentityCounter, 1)
+
+	mmGetFederatedIdentity.t.Helper()
+
+	if mmGetFederatedIdentity.inspectFuncGetFederatedIdentity != nil {
+		mmGetFederatedIdentity.inspectFuncGetFederatedIdentity(ctx, provider, subject)
+	}
+
+	mm_params := FederatedIdentityRepositoryMockGetFederatedIdentityParams{ctx, provider, subject}
+
+	// Record call args
+	mmGetFederatedIdentity.GetFederatedIdentityMock.mutex.Lock()
+	mmGetFederatedIdentity.GetFederatedIdentityMock.callArgs = append(mmGetFederatedIdentity.GetFederatedIdentityMock.callArgs, &mm_params)
+	mmGetFederatedIdentity.GetFederatedIdentityMock.mutex.Unlock()
+
+	for _, e := range mmGetFederatedIdentity.GetFederatedIdentityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedIdentityRepositoryMockGetFederatedIdentityParams{ctx, provider, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.GetFederatedIdentity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmGetFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.GetFederatedIdentity got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGetFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.GetFederatedIdentity got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFederatedIdentity.t.Errorf("FederatedIdentityRepositoryMock.GetFederatedIdentity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFederatedIdentity.GetFederatedIdentityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFederatedIdentity.t.Fatal("No results are set for the FederatedIdentityRepositoryMock.GetFederatedIdentity")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmGetFederatedIdentity.funcGetFederatedIdentity != nil {
+		return mmGetFederatedIdentity.funcGetFederatedIdentity(ctx, provider, subject)
+	}
+	mmGetFederatedIdentity.t.Fatalf("Unexpected call to FederatedIdentityRepositoryMock.GetFederatedIdentity. %v %v %v", ctx, provider, subject)
+	return
+}
+
+// GetFederatedIdentityAfterCounter returns a count of finished FederatedIdentityRepositoryMock.GetFederatedIdentity invocations
+func (mmGetFederatedIdentity *FederatedIdentityRepositoryMock) GetFederatedIdentityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFederatedIdentity.afterGetFederatedIdentityCounter)
+}
+
+// GetFederatedIdentityBeforeCounter returns a count of FederatedIdentityRepositoryMock.GetFederatedIdentity invocations
+func (mmGetFederatedIdentity *FederatedIdentityRepositoryMock) GetFederatedIdentityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFederatedIdentity.beforeGetFederatedIdentityCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedIdentityRepositoryMock.GetFederatedIdentity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFederatedIdentity *mFederatedIdentityRepositoryMockGetFederatedIdentity) Calls() []*FederatedIdentityRepositoryMockGetFederatedIdentityParams {
+	mmGetFederatedIdentity.mutex.RLock()
+
+	argCopy := make([]*FederatedIdentityRepositoryMockGetFederatedIdentityParams, len(mmGetFederatedIdentity.callArgs))
+	copy(argCopy, mmGetFederatedIdentity.callArgs)
+
+	mmGetFederatedIdentity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFederatedIdentityDone returns true if the count of the GetFederatedIdentity invocations corresponds
+// the number of defined expectations
+func (m *FederatedIdentityRepositoryMock) MinimockGetFederatedIdentityDone() bool {
+	if m.GetFederatedIdentityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFederatedIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFederatedIdentityMock.invocationsDone()
+}
+
+// MinimockGetFederatedIdentityInspect logs each unmet expectation
+func (m *FederatedIdentityRepositoryMock) MinimockGetFederatedIdentityInspect() {
+	for _, e := range m.GetFederatedIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.GetFederatedIdentity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetFederatedIdentityCounter := mm_atomic.LoadUint64(&m.afterGetFederatedIdentityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFederatedIdentityMock.defaultExpectation != nil && afterGetFederatedIdentityCounter < 1 {
+		if m.GetFederatedIdentityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.GetFederatedIdentity at\n%s", m.GetFederatedIdentityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.GetFederatedIdentity at\n%s with params: %#v", m.GetFederatedIdentityMock.defaultExpectation.expectationOrigins.origin, *m.GetFederatedIdentityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFederatedIdentity != nil && afterGetFederatedIdentityCounter < 1 {
+		m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.GetFederatedIdentity at\n%s", m.funcGetFederatedIdentityOrigin)
+	}
+
+	if !m.GetFederatedIdentityMock.invocationsDone() && afterGetFederatedIdentityCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederatedIdentityRepositoryMock.GetFederatedIdentity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFederatedIdentityMock.expectedInvocations), m.GetFederatedIdentityMock.expectedInvocationsOrigin, afterGetFederatedIdentityCounter)
+	}
+}
+
+type mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID struct {
+	optional           bool
+	mock               *FederatedIdentityRepositoryMock
+	defaultExpectation *FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation
+	expectations       []*FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation
+
+	callArgs []*FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation specifies expectation struct of the FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation struct {
+	mock               *FederatedIdentityRepositoryMock
+	params             *FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams
+	paramPtrs          *FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParamPtrs
+	expectationOrigins FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectationOrigins
+	results            *FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams contains parameters of the FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParamPtrs contains pointers to parameters of the FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDResults contains results of the FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDResults struct {
+	fpa1 []*model.FederatedIdentity
+	err  error
+}
+
+// FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDOrigins contains origins of expectations of the FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+type FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Optional() *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID {
+	mmListFederatedIdentitiesByUserID.optional = true
+	return mmListFederatedIdentitiesByUserID
+}
+
+// Expect sets up expected params for FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Expect(ctx context.Context, userID int64) *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID {
+	if mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmListFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation{}
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmListFederatedIdentitiesByUserID.defaultExpectation.params = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams{ctx, userID}
+	mmListFederatedIdentitiesByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListFederatedIdentitiesByUserID.expectations {
+		if minimock.Equal(e.params, mmListFederatedIdentitiesByUserID.defaultExpectation.params) {
+			mmListFederatedIdentitiesByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListFederatedIdentitiesByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmListFederatedIdentitiesByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) ExpectCtxParam1(ctx context.Context) *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID {
+	if mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmListFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation{}
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation.params != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Expect")
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs == nil {
+		mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParamPtrs{}
+	}
+	mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListFederatedIdentitiesByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListFederatedIdentitiesByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) ExpectUserIDParam2(userID int64) *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID {
+	if mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmListFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation{}
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation.params != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Expect")
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs == nil {
+		mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParamPtrs{}
+	}
+	mmListFederatedIdentitiesByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmListFederatedIdentitiesByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListFederatedIdentitiesByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Inspect(f func(ctx context.Context, userID int64)) *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID {
+	if mmListFederatedIdentitiesByUserID.mock.inspectFuncListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("Inspect function is already set for FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID")
+	}
+
+	mmListFederatedIdentitiesByUserID.mock.inspectFuncListFederatedIdentitiesByUserID = f
+
+	return mmListFederatedIdentitiesByUserID
+}
+
+// Return sets up results that will be returned by FederatedIdentityRepository.ListFederatedIdentitiesByUserID
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Return(fpa1 []*model.FederatedIdentity, err error) *FederatedIdentityRepositoryMock {
+	if mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	if mmListFederatedIdentitiesByUserID.defaultExpectation == nil {
+		mmListFederatedIdentitiesByUserID.defaultExpectation = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation{mock: mmListFederatedIdentitiesByUserID.mock}
+	}
+	mmListFederatedIdentitiesByUserID.defaultExpectation.results = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDResults{fpa1, err}
+	mmListFederatedIdentitiesByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListFederatedIdentitiesByUserID.mock
+}
+
+// Set uses given function f to mock the FederatedIdentityRepository.ListFederatedIdentitiesByUserID method
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Set(f func(ctx context.Context, userID int64) (fpa1 []*model.FederatedIdentity, err error)) *FederatedIdentityRepositoryMock {
+	if mmListFederatedIdentitiesByUserID.defaultExpectation != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("Default expectation is already set for the FederatedIdentityRepository.ListFederatedIdentitiesByUserID method")
+	}
+
+	if len(mmListFederatedIdentitiesByUserID.expectations) > 0 {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("Some expectations are already set for the FederatedIdentityRepository.ListFederatedIdentitiesByUserID method")
+	}
+
+	mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID = f
+	mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserIDOrigin = minimock.CallerInfo(1)
+	return mmListFederatedIdentitiesByUserID.mock
+}
+
+// When sets expectation for the FederatedIdentityRepository.ListFederatedIdentitiesByUserID which will trigger the result defined by the following
+// Then helper
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) When(ctx context.Context, userID int64) *FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation {
+	if mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock is already set by Set")
+	}
+
+	expectation := &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation{
+		mock:               mmListFederatedIdentitiesByUserID.mock,
+		params:             &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams{ctx, userID},
+		expectationOrigins: FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListFederatedIdentitiesByUserID.expectations = append(mmListFederatedIdentitiesByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedIdentityRepository.ListFederatedIdentitiesByUserID return parameters for the expectation previously defined by the When method
+func (e *FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDExpectation) Then(fpa1 []*model.FederatedIdentity, err error) *FederatedIdentityRepositoryMock {
+	e.results = &FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDResults{fpa1, err}
+	return e.mock
+}
+
+// Times sets number of times FederatedIdentityRepository.ListFederatedIdentitiesByUserID should be invoked
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Times(n uint64) *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID {
+	if n == 0 {
+		mmListFederatedIdentitiesByUserID.mock.t.Fatalf("Times of FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListFederatedIdentitiesByUserID.expectedInvocations, n)
+	mmListFederatedIdentitiesByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListFederatedIdentitiesByUserID
+}
+
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) invocationsDone() bool {
+	if len(mmListFederatedIdentitiesByUserID.expectations) == 0 && mmListFederatedIdentitiesByUserID.defaultExpectation == nil && mmListFederatedIdentitiesByUserID.mock.funcListFederatedIdentitiesByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListFederatedIdentitiesByUserID.mock.afterListFederatedIdentitiesByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListFederatedIdentitiesByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListFederatedIdentitiesByUserID implements mm_repository.FederatedIdentityRepository
+func (mmListFederatedIdentitiesByUserID *FederatedIdentityRepositoryMock) ListFederatedIdentitiesByUserID(ctx context.Context, userID int64) (fpa1 []*model.FederatedIdentity, err error) {
+	mm_atomic.AddUint64(&mmListFederatedIdentitiesByUserID.beforeListFederatedIdentitiesByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmListFederatedIdentitiesByUserID.afterListFederatedIdentitiesByUserIDCounter, 1)
+
+	mmListFederatedIdentitiesByUserID.t.Helper()
+
+	if mmListFederatedIdentitiesByUserID.inspectFuncListFederatedIdentitiesByUserID != nil {
+		mmListFederatedIdentitiesByUserID.inspectFuncListFederatedIdentitiesByUserID(ctx, userID)
+	}
+
+	mm_params := FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.mutex.Lock()
+	mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.callArgs = append(mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.callArgs, &mm_params)
+	mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fpa1, e.results.err
+		}
+	}
+
+	if mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListFederatedIdentitiesByUserID.t.Errorf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListFederatedIdentitiesByUserID.t.Errorf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListFederatedIdentitiesByUserID.t.Errorf("FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListFederatedIdentitiesByUserID.ListFederatedIdentitiesByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListFederatedIdentitiesByUserID.t.Fatal("No results are set for the FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID")
+		}
+		return (*mm_results).fpa1, (*mm_results).err
+	}
+	if mmListFederatedIdentitiesByUserID.funcListFederatedIdentitiesByUserID != nil {
+		return mmListFederatedIdentitiesByUserID.funcListFederatedIdentitiesByUserID(ctx, userID)
+	}
+	mmListFederatedIdentitiesByUserID.t.Fatalf("Unexpected call to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID. %v %v", ctx, userID)
+	return
+}
+
+// ListFederatedIdentitiesByUserIDAfterCounter returns a count of finished FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID invocations
+func (mmListFederatedIdentitiesByUserID *FederatedIdentityRepositoryMock) ListFederatedIdentitiesByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFederatedIdentitiesByUserID.afterListFederatedIdentitiesByUserIDCounter)
+}
+
+// ListFederatedIdentitiesByUserIDBeforeCounter returns a count of FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID invocations
+func (mmListFederatedIdentitiesByUserID *FederatedIdentityRepositoryMock) ListFederatedIdentitiesByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFederatedIdentitiesByUserID.beforeListFederatedIdentitiesByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListFederatedIdentitiesByUserID *mFederatedIdentityRepositoryMockListFederatedIdentitiesByUserID) Calls() []*FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams {
+	mmListFederatedIdentitiesByUserID.mutex.RLock()
+
+	argCopy := make([]*FederatedIdentityRepositoryMockListFederatedIdentitiesByUserIDParams, len(mmListFederatedIdentitiesByUserID.callArgs))
+	copy(argCopy, mmListFederatedIdentitiesByUserID.callArgs)
+
+	mmListFederatedIdentitiesByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListFederatedIdentitiesByUserIDDone returns true if the count of the ListFederatedIdentitiesByUserID invocations corresponds
+// the number of defined expectations
+func (m *FederatedIdentityRepositoryMock) MinimockListFederatedIdentitiesByUserIDDone() bool {
+	if m.ListFederatedIdentitiesByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListFederatedIdentitiesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListFederatedIdentitiesByUserIDMock.invocationsDone()
+}
+
+// MinimockListFederatedIdentitiesByUserIDInspect logs each unmet expectation
+func (m *FederatedIdentityRepositoryMock) MinimockListFederatedIdentitiesByUserIDInspect() {
+	for _, e := range m.ListFederatedIdentitiesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListFederatedIdentitiesByUserIDCounter := mm_atomic.LoadUint64(&m.afterListFederatedIdentitiesByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListFederatedIdentitiesByUserIDMock.defaultExpectation != nil && afterListFederatedIdentitiesByUserIDCounter < 1 {
+		if m.ListFederatedIdentitiesByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID at\n%s", m.ListFederatedIdentitiesByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID at\n%s with params: %#v", m.ListFederatedIdentitiesByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.ListFederatedIdentitiesByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListFederatedIdentitiesByUserID != nil && afterListFederatedIdentitiesByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID at\n%s", m.funcListFederatedIdentitiesByUserIDOrigin)
+	}
+
+	if !m.ListFederatedIdentitiesByUserIDMock.invocationsDone() && afterListFederatedIdentitiesByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederatedIdentityRepositoryMock.ListFederatedIdentitiesByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListFederatedIdentitiesByUserIDMock.expectedInvocations), m.ListFederatedIdentitiesByUserIDMock.expectedInvocationsOrigin, afterListFederatedIdentitiesByUserIDCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FederatedIdentityRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateFederatedIdentityInspect()
+
+			m.MinimockDeleteFederatedIdentitiesByUserIDInspect()
+
+			m.MinimockGetFederatedIdentityInspect()
+
+			m.MinimockListFederatedIdentitiesByUserIDInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FederatedIdentityRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FederatedIdentityRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateFederatedIdentityDone() &&
+		m.MinimockDeleteFederatedIdentitiesByUserIDDone() &&
+		m.MinimockGetFederatedIdentityDone() &&
+		m.MinimockListFederatedIdentitiesByUserIDDone()
+}
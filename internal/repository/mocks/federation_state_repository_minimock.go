@@ -0,0 +1,1129 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.FederationStateRepository -o federation_state_repository_minimock.go -n FederationStateRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// FederationStateRepositoryMock implements mm_repository.FederationStateRepository
+type FederationStateRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsumeLoginState          func(ctx context.Context, stateHash string) (fp1 *model.FederationLoginState, err error)
+	funcConsumeLoginStateOrigin    string
+	inspectFuncConsumeLoginState   func(ctx context.Context, stateHash string)
+	afterConsumeLoginStateCounter  uint64
+	beforeConsumeLoginStateCounter uint64
+	ConsumeLoginStateMock          mFederationStateRepositoryMockConsumeLoginState
+
+	funcCreateLoginState          func(ctx context.Context, state *model.FederationLoginState) (err error)
+	funcCreateLoginStateOrigin    string
+	inspectFuncCreateLoginState   func(ctx context.Context, state *model.FederationLoginState)
+	afterCreateLoginStateCounter  uint64
+	beforeCreateLoginStateCounter uint64
+	CreateLoginStateMock          mFederationStateRepositoryMockCreateLoginState
+
+	funcDeleteExpiredLoginStates          func(ctx context.Context, at time.Time) (err error)
+	funcDeleteExpiredLoginStatesOrigin    string
+	inspectFuncDeleteExpiredLoginStates   func(ctx context.Context, at time.Time)
+	afterDeleteExpiredLoginStatesCounter  uint64
+	beforeDeleteExpiredLoginStatesCounter uint64
+	DeleteExpiredLoginStatesMock          mFederationStateRepositoryMockDeleteExpiredLoginStates
+}
+
+// NewFederationStateRepositoryMock returns a mock for mm_repository.FederationStateRepository
+func NewFederationStateRepositoryMock(t minimock.Tester) *FederationStateRepositoryMock {
+	m := &FederationStateRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeLoginStateMock = mFederationStateRepositoryMockConsumeLoginState{mock: m}
+	m.ConsumeLoginStateMock.callArgs = []*FederationStateRepositoryMockConsumeLoginStateParams{}
+
+	m.CreateLoginStateMock = mFederationStateRepositoryMockCreateLoginState{mock: m}
+	m.CreateLoginStateMock.callArgs = []*FederationStateRepositoryMockCreateLoginStateParams{}
+
+	m.DeleteExpiredLoginStatesMock = mFederationStateRepositoryMockDeleteExpiredLoginStates{mock: m}
+	m.DeleteExpiredLoginStatesMock.callArgs = []*FederationStateRepositoryMockDeleteExpiredLoginStatesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFederationStateRepositoryMockConsumeLoginState struct {
+	optional           bool
+	mock               *FederationStateRepositoryMock
+	defaultExpectation *FederationStateRepositoryMockConsumeLoginStateExpectation
+	expectations       []*FederationStateRepositoryMockConsumeLoginStateExpectation
+
+	callArgs []*FederationStateRepositoryMockConsumeLoginStateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederationStateRepositoryMockConsumeLoginStateExpectation specifies expectation struct of the FederationStateRepository.ConsumeLoginState
+type FederationStateRepositoryMockConsumeLoginStateExpectation struct {
+	mock               *FederationStateRepositoryMock
+	params             *FederationStateRepositoryMockConsumeLoginStateParams
+	paramPtrs          *FederationStateRepositoryMockConsumeLoginStateParamPtrs
+	expectationOrigins FederationStateRepositoryMockConsumeLoginStateExpectationOrigins
+	results            *FederationStateRepositoryMockConsumeLoginStateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederationStateRepositoryMockConsumeLoginStateParams contains parameters of the FederationStateRepository.ConsumeLoginState
+type FederationStateRepositoryMockConsumeLoginStateParams struct {
+	ctx       context.Context
+	stateHash string
+}
+
+// FederationStateRepositoryMockConsumeLoginStateParamPtrs contains pointers to parameters of the FederationStateRepository.ConsumeLoginState
+type FederationStateRepositoryMockConsumeLoginStateParamPtrs struct {
+	ctx       *context.Context
+	stateHash *string
+}
+
+// FederationStateRepositoryMockConsumeLoginStateResults contains results of the FederationStateRepository.ConsumeLoginState
+type FederationStateRepositoryMockConsumeLoginStateResults struct {
+	fp1 *model.FederationLoginState
+	err error
+}
+
+// FederationStateRepositoryMockConsumeLoginStateOrigins contains origins of expectations of the FederationStateRepository.ConsumeLoginState
+type FederationStateRepositoryMockConsumeLoginStateExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originStateHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Optional() *mFederationStateRepositoryMockConsumeLoginState {
+	mmConsumeLoginState.optional = true
+	return mmConsumeLoginState
+}
+
+// Expect sets up expected params for FederationStateRepository.ConsumeLoginState
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Expect(ctx context.Context, stateHash string) *mFederationStateRepositoryMockConsumeLoginState {
+	if mmConsumeLoginState.mock.funcConsumeLoginState != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Set")
+	}
+
+	if mmConsumeLoginState.defaultExpectation == nil {
+		mmConsumeLoginState.defaultExpectation = &FederationStateRepositoryMockConsumeLoginStateExpectation{}
+	}
+
+	if mmConsumeLoginState.defaultExpectation.paramPtrs != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by ExpectParams functions")
+	}
+
+	mmConsumeLoginState.defaultExpectation.params = &FederationStateRepositoryMockConsumeLoginStateParams{ctx, stateHash}
+	mmConsumeLoginState.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConsumeLoginState.expectations {
+		if minimock.Equal(e.params, mmConsumeLoginState.defaultExpectation.params) {
+			mmConsumeLoginState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsumeLoginState.defaultExpectation.params)
+		}
+	}
+
+	return mmConsumeLoginState
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederationStateRepository.ConsumeLoginState
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) ExpectCtxParam1(ctx context.Context) *mFederationStateRepositoryMockConsumeLoginState {
+	if mmConsumeLoginState.mock.funcConsumeLoginState != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Set")
+	}
+
+	if mmConsumeLoginState.defaultExpectation == nil {
+		mmConsumeLoginState.defaultExpectation = &FederationStateRepositoryMockConsumeLoginStateExpectation{}
+	}
+
+	if mmConsumeLoginState.defaultExpectation.params != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Expect")
+	}
+
+	if mmConsumeLoginState.defaultExpectation.paramPtrs == nil {
+		mmConsumeLoginState.defaultExpectation.paramPtrs = &FederationStateRepositoryMockConsumeLoginStateParamPtrs{}
+	}
+	mmConsumeLoginState.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConsumeLoginState.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConsumeLoginState
+}
+
+// ExpectStateHashParam2 sets up expected param stateHash for FederationStateRepository.ConsumeLoginState
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) ExpectStateHashParam2(stateHash string) *mFederationStateRepositoryMockConsumeLoginState {
+	if mmConsumeLoginState.mock.funcConsumeLoginState != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Set")
+	}
+
+	if mmConsumeLoginState.defaultExpectation == nil {
+		mmConsumeLoginState.defaultExpectation = &FederationStateRepositoryMockConsumeLoginStateExpectation{}
+	}
+
+	if mmConsumeLoginState.defaultExpectation.params != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Expect")
+	}
+
+	if mmConsumeLoginState.defaultExpectation.paramPtrs == nil {
+		mmConsumeLoginState.defaultExpectation.paramPtrs = &FederationStateRepositoryMockConsumeLoginStateParamPtrs{}
+	}
+	mmConsumeLoginState.defaultExpectation.paramPtrs.stateHash = &stateHash
+	mmConsumeLoginState.defaultExpectation.expectationOrigins.originStateHash = minimock.CallerInfo(1)
+
+	return mmConsumeLoginState
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederationStateRepository.ConsumeLoginState
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Inspect(f func(ctx context.Context, stateHash string)) *mFederationStateRepositoryMockConsumeLoginState {
+	if mmConsumeLoginState.mock.inspectFuncConsumeLoginState != nil {
+		mmConsumeLoginState.mock.t.Fatalf("Inspect function is already set for FederationStateRepositoryMock.ConsumeLoginState")
+	}
+
+	mmConsumeLoginState.mock.inspectFuncConsumeLoginState = f
+
+	return mmConsumeLoginState
+}
+
+// Return sets up results that will be returned by FederationStateRepository.ConsumeLoginState
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Return(fp1 *model.FederationLoginState, err error) *FederationStateRepositoryMock {
+	if mmConsumeLoginState.mock.funcConsumeLoginState != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Set")
+	}
+
+	if mmConsumeLoginState.defaultExpectation == nil {
+		mmConsumeLoginState.defaultExpectation = &FederationStateRepositoryMockConsumeLoginStateExpectation{mock: mmConsumeLoginState.mock}
+	}
+	mmConsumeLoginState.defaultExpectation.results = &FederationStateRepositoryMockConsumeLoginStateResults{fp1, err}
+	mmConsumeLoginState.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConsumeLoginState.mock
+}
+
+// Set uses given function f to mock the FederationStateRepository.ConsumeLoginState method
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Set(f func(ctx context.Context, stateHash string) (fp1 *model.FederationLoginState, err error)) *FederationStateRepositoryMock {
+	if mmConsumeLoginState.defaultExpectation != nil {
+		mmConsumeLoginState.mock.t.Fatalf("Default expectation is already set for the FederationStateRepository.ConsumeLoginState method")
+	}
+
+	if len(mmConsumeLoginState.expectations) > 0 {
+		mmConsumeLoginState.mock.t.Fatalf("Some expectations are already set for the FederationStateRepository.ConsumeLoginState method")
+	}
+
+	mmConsumeLoginState.mock.funcConsumeLoginState = f
+	mmConsumeLoginState.mock.funcConsumeLoginStateOrigin = minimock.CallerInfo(1)
+	return mmConsumeLoginState.mock
+}
+
+// When sets expectation for the FederationStateRepository.ConsumeLoginState which will trigger the result defined by the following
+// Then helper
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) When(ctx context.Context, stateHash string) *FederationStateRepositoryMockConsumeLoginStateExpectation {
+	if mmConsumeLoginState.mock.funcConsumeLoginState != nil {
+		mmConsumeLoginState.mock.t.Fatalf("FederationStateRepositoryMock.ConsumeLoginState mock is already set by Set")
+	}
+
+	expectation := &FederationStateRepositoryMockConsumeLoginStateExpectation{
+		mock:               mmConsumeLoginState.mock,
+		params:             &FederationStateRepositoryMockConsumeLoginStateParams{ctx, stateHash},
+		expectationOrigins: FederationStateRepositoryMockConsumeLoginStateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConsumeLoginState.expectations = append(mmConsumeLoginState.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederationStateRepository.ConsumeLoginState return parameters for the expectation previously defined by the When method
+func (e *FederationStateRepositoryMockConsumeLoginStateExpectation) Then(fp1 *model.FederationLoginState, err error) *FederationStateRepositoryMock {
+	e.results = &FederationStateRepositoryMockConsumeLoginStateResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times FederationStateRepository.ConsumeLoginState should be invoked
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Times(n uint64) *mFederationStateRepositoryMockConsumeLoginState {
+	if n == 0 {
+		mmConsumeLoginState.mock.t.Fatalf("Times of FederationStateRepositoryMock.ConsumeLoginState mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConsumeLoginState.expectedInvocations, n)
+	mmConsumeLoginState.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConsumeLoginState
+}
+
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) invocationsDone() bool {
+	if len(mmConsumeLoginState.expectations) == 0 && mmConsumeLoginState.defaultExpectation == nil && mmConsumeLoginState.mock.funcConsumeLoginState == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConsumeLoginState.mock.afterConsumeLoginStateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConsumeLoginState.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConsumeLoginState implements mm_repository.FederationStateRepository
+func (mmConsumeLoginState *FederationStateRepositoryMock) ConsumeLoginState(ctx context.Context, stateHash string) (fp1 *model.FederationLoginState, err error) {
+	mm_atomic.AddUint64(&mmConsumeLoginState.beforeConsumeLoginStateCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsumeLoginState.afterConsumeLoginStateCounter, 1)
+
+	mmConsumeLoginState.t.Helper()
+
+	if mmConsumeLoginState.inspectFuncConsumeLoginState != nil {
+		mmConsumeLoginState.inspectFuncConsumeLoginState(ctx, stateHash)
+	}
+
+	mm_params := FederationStateRepositoryMockConsumeLoginStateParams{ctx, stateHash}
+
+	// Record call args
+	mmConsumeLoginState.ConsumeLoginStateMock.mutex.Lock()
+	mmConsumeLoginState.ConsumeLoginStateMock.callArgs = append(mmConsumeLoginState.ConsumeLoginStateMock.callArgs, &mm_params)
+	mmConsumeLoginState.ConsumeLoginStateMock.mutex.Unlock()
+
+	for _, e := range mmConsumeLoginState.ConsumeLoginStateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.params
+		mm_want_ptrs := mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.paramPtrs
+
+		mm_got := FederationStateRepositoryMockConsumeLoginStateParams{ctx, stateHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsumeLoginState.t.Errorf("FederationStateRepositoryMock.ConsumeLoginState got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.stateHash != nil && !minimock.Equal(*mm_want_ptrs.stateHash, mm_got.stateHash) {
+				mmConsumeLoginState.t.Errorf("FederationStateRepositoryMock.ConsumeLoginState got unexpected parameter stateHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.expectationOrigins.originStateHash, *mm_want_ptrs.stateHash, mm_got.stateHash, minimock.Diff(*mm_want_ptrs.stateHash, mm_got.stateHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsumeLoginState.t.Errorf("FederationStateRepositoryMock.ConsumeLoginState got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsumeLoginState.ConsumeLoginStateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsumeLoginState.t.Fatal("No results are set for the FederationStateRepositoryMock.ConsumeLoginState")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmConsumeLoginState.funcConsumeLoginState != nil {
+		return mmConsumeLoginState.funcConsumeLoginState(ctx, stateHash)
+	}
+	mmConsumeLoginState.t.Fatalf("Unexpected call to FederationStateRepositoryMock.ConsumeLoginState. %v %v", ctx, stateHash)
+	return
+}
+
+// ConsumeLoginStateAfterCounter returns a count of finished FederationStateRepositoryMock.ConsumeLoginState invocations
+func (mmConsumeLoginState *FederationStateRepositoryMock) ConsumeLoginStateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeLoginState.afterConsumeLoginStateCounter)
+}
+
+// ConsumeLoginStateBeforeCounter returns a count of FederationStateRepositoryMock.ConsumeLoginState invocations
+func (mmConsumeLoginState *FederationStateRepositoryMock) ConsumeLoginStateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeLoginState.beforeConsumeLoginStateCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederationStateRepositoryMock.ConsumeLoginState.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsumeLoginState *mFederationStateRepositoryMockConsumeLoginState) Calls() []*FederationStateRepositoryMockConsumeLoginStateParams {
+	mmConsumeLoginState.mutex.RLock()
+
+	argCopy := make([]*FederationStateRepositoryMockConsumeLoginStateParams, len(mmConsumeLoginState.callArgs))
+	copy(argCopy, mmConsumeLoginState.callArgs)
+
+	mmConsumeLoginState.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeLoginStateDone returns true if the count of the ConsumeLoginState invocations corresponds
+// the number of defined expectations
+func (m *FederationStateRepositoryMock) MinimockConsumeLoginStateDone() bool {
+	if m.ConsumeLoginStateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConsumeLoginStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConsumeLoginStateMock.invocationsDone()
+}
+
+// MinimockConsumeLoginStateInspect logs each unmet expectation
+func (m *FederationStateRepositoryMock) MinimockConsumeLoginStateInspect() {
+	for _, e := range m.ConsumeLoginStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.ConsumeLoginState at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConsumeLoginStateCounter := mm_atomic.LoadUint64(&m.afterConsumeLoginStateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeLoginStateMock.defaultExpectation != nil && afterConsumeLoginStateCounter < 1 {
+		if m.ConsumeLoginStateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.ConsumeLoginState at\n%s", m.ConsumeLoginStateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.ConsumeLoginState at\n%s with params: %#v", m.ConsumeLoginStateMock.defaultExpectation.expectationOrigins.origin, *m.ConsumeLoginStateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsumeLoginState != nil && afterConsumeLoginStateCounter < 1 {
+		m.t.Errorf("Expected call to FederationStateRepositoryMock.ConsumeLoginState at\n%s", m.funcConsumeLoginStateOrigin)
+	}
+
+	if !m.ConsumeLoginStateMock.invocationsDone() && afterConsumeLoginStateCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederationStateRepositoryMock.ConsumeLoginState at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConsumeLoginStateMock.expectedInvocations), m.ConsumeLoginStateMock.expectedInvocationsOrigin, afterConsumeLoginStateCounter)
+	}
+}
+
+type mFederationStateRepositoryMockCreateLoginState struct {
+	optional           bool
+	mock               *FederationStateRepositoryMock
+	defaultExpectation *FederationStateRepositoryMockCreateLoginStateExpectation
+	expectations       []*FederationStateRepositoryMockCreateLoginStateExpectation
+
+	callArgs []*FederationStateRepositoryMockCreateLoginStateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederationStateRepositoryMockCreateLoginStateExpectation specifies expectation struct of the FederationStateRepository.CreateLoginState
+type FederationStateRepositoryMockCreateLoginStateExpectation struct {
+	mock               *FederationStateRepositoryMock
+	params             *FederationStateRepositoryMockCreateLoginStateParams
+	paramPtrs          *FederationStateRepositoryMockCreateLoginStateParamPtrs
+	expectationOrigins FederationStateRepositoryMockCreateLoginStateExpectationOrigins
+	results            *FederationStateRepositoryMockCreateLoginStateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederationStateRepositoryMockCreateLoginStateParams contains parameters of the FederationStateRepository.CreateLoginState
+type FederationStateRepositoryMockCreateLoginStateParams struct {
+	ctx   context.Context
+	state *model.FederationLoginState
+}
+
+// FederationStateRepositoryMockCreateLoginStateParamPtrs contains pointers to parameters of the FederationStateRepository.CreateLoginState
+type FederationStateRepositoryMockCreateLoginStateParamPtrs struct {
+	ctx   *context.Context
+	state **model.FederationLoginState
+}
+
+// FederationStateRepositoryMockCreateLoginStateResults contains results of the FederationStateRepository.CreateLoginState
+type FederationStateRepositoryMockCreateLoginStateResults struct {
+	err error
+}
+
+// FederationStateRepositoryMockCreateLoginStateOrigins contains origins of expectations of the FederationStateRepository.CreateLoginState
+type FederationStateRepositoryMockCreateLoginStateExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originState string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Optional() *mFederationStateRepositoryMockCreateLoginState {
+	mmCreateLoginState.optional = true
+	return mmCreateLoginState
+}
+
+// Expect sets up expected params for FederationStateRepository.CreateLoginState
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Expect(ctx context.Context, state *model.FederationLoginState) *mFederationStateRepositoryMockCreateLoginState {
+	if mmCreateLoginState.mock.funcCreateLoginState != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Set")
+	}
+
+	if mmCreateLoginState.defaultExpectation == nil {
+		mmCreateLoginState.defaultExpectation = &FederationStateRepositoryMockCreateLoginStateExpectation{}
+	}
+
+	if mmCreateLoginState.defaultExpectation.paramPtrs != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by ExpectParams functions")
+	}
+
+	mmCreateLoginState.defaultExpectation.params = &FederationStateRepositoryMockCreateLoginStateParams{ctx, state}
+	mmCreateLoginState.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateLoginState.expectations {
+		if minimock.Equal(e.params, mmCreateLoginState.defaultExpectation.params) {
+			mmCreateLoginState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateLoginState.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateLoginState
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederationStateRepository.CreateLoginState
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) ExpectCtxParam1(ctx context.Context) *mFederationStateRepositoryMockCreateLoginState {
+	if mmCreateLoginState.mock.funcCreateLoginState != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Set")
+	}
+
+	if mmCreateLoginState.defaultExpectation == nil {
+		mmCreateLoginState.defaultExpectation = &FederationStateRepositoryMockCreateLoginStateExpectation{}
+	}
+
+	if mmCreateLoginState.defaultExpectation.params != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Expect")
+	}
+
+	if mmCreateLoginState.defaultExpectation.paramPtrs == nil {
+		mmCreateLoginState.defaultExpectation.paramPtrs = &FederationStateRepositoryMockCreateLoginStateParamPtrs{}
+	}
+	mmCreateLoginState.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateLoginState.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateLoginState
+}
+
+// ExpectStateParam2 sets up expected param state for FederationStateRepository.CreateLoginState
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) ExpectStateParam2(state *model.FederationLoginState) *mFederationStateRepositoryMockCreateLoginState {
+	if mmCreateLoginState.mock.funcCreateLoginState != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Set")
+	}
+
+	if mmCreateLoginState.defaultExpectation == nil {
+		mmCreateLoginState.defaultExpectation = &FederationStateRepositoryMockCreateLoginStateExpectation{}
+	}
+
+	if mmCreateLoginState.defaultExpectation.params != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Expect")
+	}
+
+	if mmCreateLoginState.defaultExpectation.paramPtrs == nil {
+		mmCreateLoginState.defaultExpectation.paramPtrs = &FederationStateRepositoryMockCreateLoginStateParamPtrs{}
+	}
+	mmCreateLoginState.defaultExpectation.paramPtrs.state = &state
+	mmCreateLoginState.defaultExpectation.expectationOrigins.originState = minimock.CallerInfo(1)
+
+	return mmCreateLoginState
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederationStateRepository.CreateLoginState
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Inspect(f func(ctx context.Context, state *model.FederationLoginState)) *mFederationStateRepositoryMockCreateLoginState {
+	if mmCreateLoginState.mock.inspectFuncCreateLoginState != nil {
+		mmCreateLoginState.mock.t.Fatalf("Inspect function is already set for FederationStateRepositoryMock.CreateLoginState")
+	}
+
+	mmCreateLoginState.mock.inspectFuncCreateLoginState = f
+
+	return mmCreateLoginState
+}
+
+// Return sets up results that will be returned by FederationStateRepository.CreateLoginState
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Return(err error) *FederationStateRepositoryMock {
+	if mmCreateLoginState.mock.funcCreateLoginState != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Set")
+	}
+
+	if mmCreateLoginState.defaultExpectation == nil {
+		mmCreateLoginState.defaultExpectation = &FederationStateRepositoryMockCreateLoginStateExpectation{mock: mmCreateLoginState.mock}
+	}
+	mmCreateLoginState.defaultExpectation.results = &FederationStateRepositoryMockCreateLoginStateResults{err}
+	mmCreateLoginState.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateLoginState.mock
+}
+
+// Set uses given function f to mock the FederationStateRepository.CreateLoginState method
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Set(f func(ctx context.Context, state *model.FederationLoginState) (err error)) *FederationStateRepositoryMock {
+	if mmCreateLoginState.defaultExpectation != nil {
+		mmCreateLoginState.mock.t.Fatalf("Default expectation is already set for the FederationStateRepository.CreateLoginState method")
+	}
+
+	if len(mmCreateLoginState.expectations) > 0 {
+		mmCreateLoginState.mock.t.Fatalf("Some expectations are already set for the FederationStateRepository.CreateLoginState method")
+	}
+
+	mmCreateLoginState.mock.funcCreateLoginState = f
+	mmCreateLoginState.mock.funcCreateLoginStateOrigin = minimock.CallerInfo(1)
+	return mmCreateLoginState.mock
+}
+
+// When sets expectation for the FederationStateRepository.CreateLoginState which will trigger the result defined by the following
+// Then helper
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) When(ctx context.Context, state *model.FederationLoginState) *FederationStateRepositoryMockCreateLoginStateExpectation {
+	if mmCreateLoginState.mock.funcCreateLoginState != nil {
+		mmCreateLoginState.mock.t.Fatalf("FederationStateRepositoryMock.CreateLoginState mock is already set by Set")
+	}
+
+	expectation := &FederationStateRepositoryMockCreateLoginStateExpectation{
+		mock:               mmCreateLoginState.mock,
+		params:             &FederationStateRepositoryMockCreateLoginStateParams{ctx, state},
+		expectationOrigins: FederationStateRepositoryMockCreateLoginStateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateLoginState.expectations = append(mmCreateLoginState.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederationStateRepository.CreateLoginState return parameters for the expectation previously defined by the When method
+func (e *FederationStateRepositoryMockCreateLoginStateExpectation) Then(err error) *FederationStateRepositoryMock {
+	e.results = &FederationStateRepositoryMockCreateLoginStateResults{err}
+	return e.mock
+}
+
+// Times sets number of times FederationStateRepository.CreateLoginState should be invoked
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Times(n uint64) *mFederationStateRepositoryMockCreateLoginState {
+	if n == 0 {
+		mmCreateLoginState.mock.t.Fatalf("Times of FederationStateRepositoryMock.CreateLoginState mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateLoginState.expectedInvocations, n)
+	mmCreateLoginState.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateLoginState
+}
+
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) invocationsDone() bool {
+	if len(mmCreateLoginState.expectations) == 0 && mmCreateLoginState.defaultExpectation == nil && mmCreateLoginState.mock.funcCreateLoginState == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateLoginState.mock.afterCreateLoginStateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateLoginState.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateLoginState implements mm_repository.FederationStateRepository
+func (mmCreateLoginState *FederationStateRepositoryMock) CreateLoginState(ctx context.Context, state *model.FederationLoginState) (err error) {
+	mm_atomic.AddUint64(&mmCreateLoginState.beforeCreateLoginStateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateLoginState.afterCreateLoginStateCounter, 1)
+
+	mmCreateLoginState.t.Helper()
+
+	if mmCreateLoginState.inspectFuncCreateLoginState != nil {
+		mmCreateLoginState.inspectFuncCreateLoginState(ctx, state)
+	}
+
+	mm_params := FederationStateRepositoryMockCreateLoginStateParams{ctx, state}
+
+	// Record call args
+	mmCreateLoginState.CreateLoginStateMock.mutex.Lock()
+	mmCreateLoginState.CreateLoginStateMock.callArgs = append(mmCreateLoginState.CreateLoginStateMock.callArgs, &mm_params)
+	mmCreateLoginState.CreateLoginStateMock.mutex.Unlock()
+
+	for _, e := range mmCreateLoginState.CreateLoginStateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateLoginState.CreateLoginStateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateLoginState.CreateLoginStateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateLoginState.CreateLoginStateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateLoginState.CreateLoginStateMock.defaultExpectation.paramPtrs
+
+		mm_got := FederationStateRepositoryMockCreateLoginStateParams{ctx, state}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateLoginState.t.Errorf("FederationStateRepositoryMock.CreateLoginState got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateLoginState.CreateLoginStateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.state != nil && !minimock.Equal(*mm_want_ptrs.state, mm_got.state) {
+				mmCreateLoginState.t.Errorf("FederationStateRepositoryMock.CreateLoginState got unexpected parameter state, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateLoginState.CreateLoginStateMock.defaultExpectation.expectationOrigins.originState, *mm_want_ptrs.state, mm_got.state, minimock.Diff(*mm_want_ptrs.state, mm_got.state))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateLoginState.t.Errorf("FederationStateRepositoryMock.CreateLoginState got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateLoginState.CreateLoginStateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateLoginState.CreateLoginStateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateLoginState.t.Fatal("No results are set for the FederationStateRepositoryMock.CreateLoginState")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateLoginState.funcCreateLoginState != nil {
+		return mmCreateLoginState.funcCreateLoginState(ctx, state)
+	}
+	mmCreateLoginState.t.Fatalf("Unexpected call to FederationStateRepositoryMock.CreateLoginState. %v %v", ctx, state)
+	return
+}
+
+// CreateLoginStateAfterCounter returns a count of finished FederationStateRepositoryMock.CreateLoginState invocations
+func (mmCreateLoginState *FederationStateRepositoryMock) CreateLoginStateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLoginState.afterCreateLoginStateCounter)
+}
+
+// CreateLoginStateBeforeCounter returns a count of FederationStateRepositoryMock.CreateLoginState invocations
+func (mmCreateLoginState *FederationStateRepositoryMock) CreateLoginStateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLoginState.beforeCreateLoginStateCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederationStateRepositoryMock.CreateLoginState.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateLoginState *mFederationStateRepositoryMockCreateLoginState) Calls() []*FederationStateRepositoryMockCreateLoginStateParams {
+	mmCreateLoginState.mutex.RLock()
+
+	argCopy := make([]*FederationStateRepositoryMockCreateLoginStateParams, len(mmCreateLoginState.callArgs))
+	copy(argCopy, mmCreateLoginState.callArgs)
+
+	mmCreateLoginState.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateLoginStateDone returns true if the count of the CreateLoginState invocations corresponds
+// the number of defined expectations
+func (m *FederationStateRepositoryMock) MinimockCreateLoginStateDone() bool {
+	if m.CreateLoginStateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateLoginStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateLoginStateMock.invocationsDone()
+}
+
+// MinimockCreateLoginStateInspect logs each unmet expectation
+func (m *FederationStateRepositoryMock) MinimockCreateLoginStateInspect() {
+	for _, e := range m.CreateLoginStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.CreateLoginState at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateLoginStateCounter := mm_atomic.LoadUint64(&m.afterCreateLoginStateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateLoginStateMock.defaultExpectation != nil && afterCreateLoginStateCounter < 1 {
+		if m.CreateLoginStateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.CreateLoginState at\n%s", m.CreateLoginStateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.CreateLoginState at\n%s with params: %#v", m.CreateLoginStateMock.defaultExpectation.expectationOrigins.origin, *m.CreateLoginStateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateLoginState != nil && afterCreateLoginStateCounter < 1 {
+		m.t.Errorf("Expected call to FederationStateRepositoryMock.CreateLoginState at\n%s", m.funcCreateLoginStateOrigin)
+	}
+
+	if !m.CreateLoginStateMock.invocationsDone() && afterCreateLoginStateCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederationStateRepositoryMock.CreateLoginState at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateLoginStateMock.expectedInvocations), m.CreateLoginStateMock.expectedInvocationsOrigin, afterCreateLoginStateCounter)
+	}
+}
+
+type mFederationStateRepositoryMockDeleteExpiredLoginStates struct {
+	optional           bool
+	mock               *FederationStateRepositoryMock
+	defaultExpectation *FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation
+	expectations       []*FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation
+
+	callArgs []*FederationStateRepositoryMockDeleteExpiredLoginStatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation specifies expectation struct of the FederationStateRepository.DeleteExpiredLoginStates
+type FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation struct {
+	mock               *FederationStateRepositoryMock
+	params             *FederationStateRepositoryMockDeleteExpiredLoginStatesParams
+	paramPtrs          *FederationStateRepositoryMockDeleteExpiredLoginStatesParamPtrs
+	expectationOrigins FederationStateRepositoryMockDeleteExpiredLoginStatesExpectationOrigins
+	results            *FederationStateRepositoryMockDeleteExpiredLoginStatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederationStateRepositoryMockDeleteExpiredLoginStatesParams contains parameters of the FederationStateRepository.DeleteExpiredLoginStates
+type FederationStateRepositoryMockDeleteExpiredLoginStatesParams struct {
+	ctx context.Context
+	at  time.Time
+}
+
+// FederationStateRepositoryMockDeleteExpiredLoginStatesParamPtrs contains pointers to parameters of the FederationStateRepository.DeleteExpiredLoginStates
+type FederationStateRepositoryMockDeleteExpiredLoginStatesParamPtrs struct {
+	ctx *context.Context
+	at  *time.Time
+}
+
+// FederationStateRepositoryMockDeleteExpiredLoginStatesResults contains results of the FederationStateRepository.DeleteExpiredLoginStates
+type FederationStateRepositoryMockDeleteExpiredLoginStatesResults struct {
+	err error
+}
+
+// FederationStateRepositoryMockDeleteExpiredLoginStatesOrigins contains origins of expectations of the FederationStateRepository.DeleteExpiredLoginStates
+type FederationStateRepositoryMockDeleteExpiredLoginStatesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originAt  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Optional() *mFederationStateRepositoryMockDeleteExpiredLoginStates {
+	mmDeleteExpiredLoginStates.optional = true
+	return mmDeleteExpiredLoginStates
+}
+
+// Expect sets up expected params for FederationStateRepository.DeleteExpiredLoginStates
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Expect(ctx context.Context, at time.Time) *mFederationStateRepositoryMockDeleteExpiredLoginStates {
+	if mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Set")
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation == nil {
+		mmDeleteExpiredLoginStates.defaultExpectation = &FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation{}
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredLoginStates.defaultExpectation.params = &FederationStateRepositoryMockDeleteExpiredLoginStatesParams{ctx, at}
+	mmDeleteExpiredLoginStates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredLoginStates.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredLoginStates.defaultExpectation.params) {
+			mmDeleteExpiredLoginStates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredLoginStates.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredLoginStates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederationStateRepository.DeleteExpiredLoginStates
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) ExpectCtxParam1(ctx context.Context) *mFederationStateRepositoryMockDeleteExpiredLoginStates {
+	if mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Set")
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation == nil {
+		mmDeleteExpiredLoginStates.defaultExpectation = &FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation{}
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation.params != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs = &FederationStateRepositoryMockDeleteExpiredLoginStatesParamPtrs{}
+	}
+	mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredLoginStates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredLoginStates
+}
+
+// ExpectAtParam2 sets up expected param at for FederationStateRepository.DeleteExpiredLoginStates
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) ExpectAtParam2(at time.Time) *mFederationStateRepositoryMockDeleteExpiredLoginStates {
+	if mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Set")
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation == nil {
+		mmDeleteExpiredLoginStates.defaultExpectation = &FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation{}
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation.params != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs = &FederationStateRepositoryMockDeleteExpiredLoginStatesParamPtrs{}
+	}
+	mmDeleteExpiredLoginStates.defaultExpectation.paramPtrs.at = &at
+	mmDeleteExpiredLoginStates.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredLoginStates
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederationStateRepository.DeleteExpiredLoginStates
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Inspect(f func(ctx context.Context, at time.Time)) *mFederationStateRepositoryMockDeleteExpiredLoginStates {
+	if mmDeleteExpiredLoginStates.mock.inspectFuncDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("Inspect function is already set for FederationStateRepositoryMock.DeleteExpiredLoginStates")
+	}
+
+	mmDeleteExpiredLoginStates.mock.inspectFuncDeleteExpiredLoginStates = f
+
+	return mmDeleteExpiredLoginStates
+}
+
+// Return sets up results that will be returned by FederationStateRepository.DeleteExpiredLoginStates
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Return(err error) *FederationStateRepositoryMock {
+	if mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Set")
+	}
+
+	if mmDeleteExpiredLoginStates.defaultExpectation == nil {
+		mmDeleteExpiredLoginStates.defaultExpectation = &FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation{mock: mmDeleteExpiredLoginStates.mock}
+	}
+	mmDeleteExpiredLoginStates.defaultExpectation.results = &FederationStateRepositoryMockDeleteExpiredLoginStatesResults{err}
+	mmDeleteExpiredLoginStates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredLoginStates.mock
+}
+
+// Set uses given function f to mock the FederationStateRepository.DeleteExpiredLoginStates method
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Set(f func(ctx context.Context, at time.Time) (err error)) *FederationStateRepositoryMock {
+	if mmDeleteExpiredLoginStates.defaultExpectation != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("Default expectation is already set for the FederationStateRepository.DeleteExpiredLoginStates method")
+	}
+
+	if len(mmDeleteExpiredLoginStates.expectations) > 0 {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("Some expectations are already set for the FederationStateRepository.DeleteExpiredLoginStates method")
+	}
+
+	mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates = f
+	mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStatesOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredLoginStates.mock
+}
+
+// When sets expectation for the FederationStateRepository.DeleteExpiredLoginStates which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) When(ctx context.Context, at time.Time) *FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation {
+	if mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("FederationStateRepositoryMock.DeleteExpiredLoginStates mock is already set by Set")
+	}
+
+	expectation := &FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation{
+		mock:               mmDeleteExpiredLoginStates.mock,
+		params:             &FederationStateRepositoryMockDeleteExpiredLoginStatesParams{ctx, at},
+		expectationOrigins: FederationStateRepositoryMockDeleteExpiredLoginStatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredLoginStates.expectations = append(mmDeleteExpiredLoginStates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederationStateRepository.DeleteExpiredLoginStates return parameters for the expectation previously defined by the When method
+func (e *FederationStateRepositoryMockDeleteExpiredLoginStatesExpectation) Then(err error) *FederationStateRepositoryMock {
+	e.results = &FederationStateRepositoryMockDeleteExpiredLoginStatesResults{err}
+	return e.mock
+}
+
+// Times sets number of times FederationStateRepository.DeleteExpiredLoginStates should be invoked
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Times(n uint64) *mFederationStateRepositoryMockDeleteExpiredLoginStates {
+	if n == 0 {
+		mmDeleteExpiredLoginStates.mock.t.Fatalf("Times of FederationStateRepositoryMock.DeleteExpiredLoginStates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredLoginStates.expectedInvocations, n)
+	mmDeleteExpiredLoginStates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredLoginStates
+}
+
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) invocationsDone() bool {
+	if len(mmDeleteExpiredLoginStates.expectations) == 0 && mmDeleteExpiredLoginStates.defaultExpectation == nil && mmDeleteExpiredLoginStates.mock.funcDeleteExpiredLoginStates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredLoginStates.mock.afterDeleteExpiredLoginStatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredLoginStates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredLoginStates implements mm_repository.FederationStateRepository
+func (mmDeleteExpiredLoginStates *FederationStateRepositoryMock) DeleteExpiredLoginStates(ctx context.Context, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredLoginStates.beforeDeleteExpiredLoginStatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredLoginStates.afterDeleteExpiredLoginStatesCounter, 1)
+
+	mmDeleteExpiredLoginStates.t.Helper()
+
+	if mmDeleteExpiredLoginStates.inspectFuncDeleteExpiredLoginStates != nil {
+		mmDeleteExpiredLoginStates.inspectFuncDeleteExpiredLoginStates(ctx, at)
+	}
+
+	mm_params := FederationStateRepositoryMockDeleteExpiredLoginStatesParams{ctx, at}
+
+	// Record call args
+	mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.mutex.Lock()
+	mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.callArgs = append(mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.callArgs, &mm_params)
+	mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.paramPtrs
+
+		mm_got := FederationStateRepositoryMockDeleteExpiredLoginStatesParams{ctx, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredLoginStates.t.Errorf("FederationStateRepositoryMock.DeleteExpiredLoginStates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmDeleteExpiredLoginStates.t.Errorf("FederationStateRepositoryMock.DeleteExpiredLoginStates got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredLoginStates.t.Errorf("FederationStateRepositoryMock.DeleteExpiredLoginStates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredLoginStates.DeleteExpiredLoginStatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredLoginStates.t.Fatal("No results are set for the FederationStateRepositoryMock.DeleteExpiredLoginStates")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteExpiredLoginStates.funcDeleteExpiredLoginStates != nil {
+		return mmDeleteExpiredLoginStates.funcDeleteExpiredLoginStates(ctx, at)
+	}
+	mmDeleteExpiredLoginStates.t.Fatalf("Unexpected call to FederationStateRepositoryMock.DeleteExpiredLoginStates. %v %v", ctx, at)
+	return
+}
+
+// DeleteExpiredLoginStatesAfterCounter returns a count of finished FederationStateRepositoryMock.DeleteExpiredLoginStates invocations
+func (mmDeleteExpiredLoginStates *FederationStateRepositoryMock) DeleteExpiredLoginStatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredLoginStates.afterDeleteExpiredLoginStatesCounter)
+}
+
+// DeleteExpiredLoginStatesBeforeCounter returns a count of FederationStateRepositoryMock.DeleteExpiredLoginStates invocations
+func (mmDeleteExpiredLoginStates *FederationStateRepositoryMock) DeleteExpiredLoginStatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredLoginStates.beforeDeleteExpiredLoginStatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederationStateRepositoryMock.DeleteExpiredLoginStates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredLoginStates *mFederationStateRepositoryMockDeleteExpiredLoginStates) Calls() []*FederationStateRepositoryMockDeleteExpiredLoginStatesParams {
+	mmDeleteExpiredLoginStates.mutex.RLock()
+
+	argCopy := make([]*FederationStateRepositoryMockDeleteExpiredLoginStatesParams, len(mmDeleteExpiredLoginStates.callArgs))
+	copy(argCopy, mmDeleteExpiredLoginStates.callArgs)
+
+	mmDeleteExpiredLoginStates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredLoginStatesDone returns true if the count of the DeleteExpiredLoginStates invocations corresponds
+// the number of defined expectations
+func (m *FederationStateRepositoryMock) MinimockDeleteExpiredLoginStatesDone() bool {
+	if m.DeleteExpiredLoginStatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredLoginStatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredLoginStatesMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredLoginStatesInspect logs each unmet expectation
+func (m *FederationStateRepositoryMock) MinimockDeleteExpiredLoginStatesInspect() {
+	for _, e := range m.DeleteExpiredLoginStatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.DeleteExpiredLoginStates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredLoginStatesCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredLoginStatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredLoginStatesMock.defaultExpectation != nil && afterDeleteExpiredLoginStatesCounter < 1 {
+		if m.DeleteExpiredLoginStatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.DeleteExpiredLoginStates at\n%s", m.DeleteExpiredLoginStatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederationStateRepositoryMock.DeleteExpiredLoginStates at\n%s with params: %#v", m.DeleteExpiredLoginStatesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredLoginStatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredLoginStates != nil && afterDeleteExpiredLoginStatesCounter < 1 {
+		m.t.Errorf("Expected call to FederationStateRepositoryMock.DeleteExpiredLoginStates at\n%s", m.funcDeleteExpiredLoginStatesOrigin)
+	}
+
+	if !m.DeleteExpiredLoginStatesMock.invocationsDone() && afterDeleteExpiredLoginStatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederationStateRepositoryMock.DeleteExpiredLoginStates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredLoginStatesMock.expectedInvocations), m.DeleteExpiredLoginStatesMock.expectedInvocationsOrigin, afterDeleteExpiredLoginStatesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FederationStateRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeLoginStateInspect()
+
+			m.MinimockCreateLoginStateInspect()
+
+			m.MinimockDeleteExpiredLoginStatesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FederationStateRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FederationStateRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeLoginStateDone() &&
+		m.MinimockCreateLoginStateDone() &&
+		m.MinimockDeleteExpiredLoginStatesDone()
+}
@@ -0,0 +1,2645 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.InvitationRepository -o invitation_repository_minimock.go -n InvitationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// InvitationRepositoryMock implements mm_repository.InvitationRepository
+type InvitationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateInvitation          func(ctx context.Context, invitation *model.Invitation) (i1 int64, err error)
+	funcCreateInvitationOrigin    string
+	inspectFuncCreateInvitation   func(ctx context.Context, invitation *model.Invitation)
+	afterCreateInvitationCounter  uint64
+	beforeCreateInvitationCounter uint64
+	CreateInvitationMock          mInvitationRepositoryMockCreateInvitation
+
+	funcDeleteInvitationsByUserID          func(ctx context.Context, userID int64) (err error)
+	funcDeleteInvitationsByUserIDOrigin    string
+	inspectFuncDeleteInvitationsByUserID   func(ctx context.Context, userID int64)
+	afterDeleteInvitationsByUserIDCounter  uint64
+	beforeDeleteInvitationsByUserIDCounter uint64
+	DeleteInvitationsByUserIDMock          mInvitationRepositoryMockDeleteInvitationsByUserID
+
+	funcGetInvitation          func(ctx context.Context, id int64) (ip1 *model.Invitation, err error)
+	funcGetInvitationOrigin    string
+	inspectFuncGetInvitation   func(ctx context.Context, id int64)
+	afterGetInvitationCounter  uint64
+	beforeGetInvitationCounter uint64
+	GetInvitationMock          mInvitationRepositoryMockGetInvitation
+
+	funcGetInvitationByTokenID          func(ctx context.Context, tokenID string) (ip1 *model.Invitation, err error)
+	funcGetInvitationByTokenIDOrigin    string
+	inspectFuncGetInvitationByTokenID   func(ctx context.Context, tokenID string)
+	afterGetInvitationByTokenIDCounter  uint64
+	beforeGetInvitationByTokenIDCounter uint64
+	GetInvitationByTokenIDMock          mInvitationRepositoryMockGetInvitationByTokenID
+
+	funcListInvitationsByUserID          func(ctx context.Context, userID int64) (ipa1 []*model.Invitation, err error)
+	funcListInvitationsByUserIDOrigin    string
+	inspectFuncListInvitationsByUserID   func(ctx context.Context, userID int64)
+	afterListInvitationsByUserIDCounter  uint64
+	beforeListInvitationsByUserIDCounter uint64
+	ListInvitationsByUserIDMock          mInvitationRepositoryMockListInvitationsByUserID
+
+	funcUpdateInvitationStatus          func(ctx context.Context, id int64, status string) (err error)
+	funcUpdateInvitationStatusOrigin    string
+	inspectFuncUpdateInvitationStatus   func(ctx context.Context, id int64, status string)
+	afterUpdateInvitationStatusCounter  uint64
+	beforeUpdateInvitationStatusCounter uint64
+	UpdateInvitationStatusMock          mInvitationRepositoryMockUpdateInvitationStatus
+
+	funcUpdateInvitationToken          func(ctx context.Context, id int64, tokenID string, expiresAt time.Time) (err error)
+	funcUpdateInvitationTokenOrigin    string
+	inspectFuncUpdateInvitationToken   func(ctx context.Context, id int64, tokenID string, expiresAt time.Time)
+	afterUpdateInvitationTokenCounter  uint64
+	beforeUpdateInvitationTokenCounter uint64
+	UpdateInvitationTokenMock          mInvitationRepositoryMockUpdateInvitationToken
+}
+
+// NewInvitationRepositoryMock returns a mock for mm_repository.InvitationRepository
+func NewInvitationRepositoryMock(t minimock.Tester) *InvitationRepositoryMock {
+	m := &InvitationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateInvitationMock = mInvitationRepositoryMockCreateInvitation{mock: m}
+	m.CreateInvitationMock.callArgs = []*InvitationRepositoryMockCreateInvitationParams{}
+
+	m.DeleteInvitationsByUserIDMock = mInvitationRepositoryMockDeleteInvitationsByUserID{mock: m}
+	m.DeleteInvitationsByUserIDMock.callArgs = []*InvitationRepositoryMockDeleteInvitationsByUserIDParams{}
+
+	m.GetInvitationMock = mInvitationRepositoryMockGetInvitation{mock: m}
+	m.GetInvitationMock.callArgs = []*InvitationRepositoryMockGetInvitationParams{}
+
+	m.GetInvitationByTokenIDMock = mInvitationRepositoryMockGetInvitationByTokenID{mock: m}
+	m.GetInvitationByTokenIDMock.callArgs = []*InvitationRepositoryMockGetInvitationByTokenIDParams{}
+
+	m.ListInvitationsByUserIDMock = mInvitationRepositoryMockListInvitationsByUserID{mock: m}
+	m.ListInvitationsByUserIDMock.callArgs = []*InvitationRepositoryMockListInvitationsByUserIDParams{}
+
+	m.UpdateInvitationStatusMock = mInvitationRepositoryMockUpdateInvitationStatus{mock: m}
+	m.UpdateInvitationStatusMock.callArgs = []*InvitationRepositoryMockUpdateInvitationStatusParams{}
+
+	m.UpdateInvitationTokenMock = mInvitationRepositoryMockUpdateInvitationToken{mock: m}
+	m.UpdateInvitationTokenMock.callArgs = []*InvitationRepositoryMockUpdateInvitationTokenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mInvitationRepositoryMockCreateInvitation struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockCreateInvitationExpectation
+	expectations       []*InvitationRepositoryMockCreateInvitationExpectation
+
+	callArgs []*InvitationRepositoryMockCreateInvitationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockCreateInvitationExpectation specifies expectation struct of the InvitationRepository.CreateInvitation
+type InvitationRepositoryMockCreateInvitationExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockCreateInvitationParams
+	paramPtrs          *InvitationRepositoryMockCreateInvitationParamPtrs
+	expectationOrigins InvitationRepositoryMockCreateInvitationExpectationOrigins
+	results            *InvitationRepositoryMockCreateInvitationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockCreateInvitationParams contains parameters of the InvitationRepository.CreateInvitation
+type InvitationRepositoryMockCreateInvitationParams struct {
+	ctx        context.Context
+	invitation *model.Invitation
+}
+
+// InvitationRepositoryMockCreateInvitationParamPtrs contains pointers to parameters of the InvitationRepository.CreateInvitation
+type InvitationRepositoryMockCreateInvitationParamPtrs struct {
+	ctx        *context.Context
+	invitation **model.Invitation
+}
+
+// InvitationRepositoryMockCreateInvitationResults contains results of the InvitationRepository.CreateInvitation
+type InvitationRepositoryMockCreateInvitationResults struct {
+	i1  int64
+	err error
+}
+
+// InvitationRepositoryMockCreateInvitationOrigins contains origins of expectations of the InvitationRepository.CreateInvitation
+type InvitationRepositoryMockCreateInvitationExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originInvitation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Optional() *mInvitationRepositoryMockCreateInvitation {
+	mmCreateInvitation.optional = true
+	return mmCreateInvitation
+}
+
+// Expect sets up expected params for InvitationRepository.CreateInvitation
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Expect(ctx context.Context, invitation *model.Invitation) *mInvitationRepositoryMockCreateInvitation {
+	if mmCreateInvitation.mock.funcCreateInvitation != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Set")
+	}
+
+	if mmCreateInvitation.defaultExpectation == nil {
+		mmCreateInvitation.defaultExpectation = &InvitationRepositoryMockCreateInvitationExpectation{}
+	}
+
+	if mmCreateInvitation.defaultExpectation.paramPtrs != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by ExpectParams functions")
+	}
+
+	mmCreateInvitation.defaultExpectation.params = &InvitationRepositoryMockCreateInvitationParams{ctx, invitation}
+	mmCreateInvitation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateInvitation.expectations {
+		if minimock.Equal(e.params, mmCreateInvitation.defaultExpectation.params) {
+			mmCreateInvitation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateInvitation.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateInvitation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.CreateInvitation
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockCreateInvitation {
+	if mmCreateInvitation.mock.funcCreateInvitation != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Set")
+	}
+
+	if mmCreateInvitation.defaultExpectation == nil {
+		mmCreateInvitation.defaultExpectation = &InvitationRepositoryMockCreateInvitationExpectation{}
+	}
+
+	if mmCreateInvitation.defaultExpectation.params != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Expect")
+	}
+
+	if mmCreateInvitation.defaultExpectation.paramPtrs == nil {
+		mmCreateInvitation.defaultExpectation.paramPtrs = &InvitationRepositoryMockCreateInvitationParamPtrs{}
+	}
+	mmCreateInvitation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateInvitation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateInvitation
+}
+
+// ExpectInvitationParam2 sets up expected param invitation for InvitationRepository.CreateInvitation
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) ExpectInvitationParam2(invitation *model.Invitation) *mInvitationRepositoryMockCreateInvitation {
+	if mmCreateInvitation.mock.funcCreateInvitation != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Set")
+	}
+
+	if mmCreateInvitation.defaultExpectation == nil {
+		mmCreateInvitation.defaultExpectation = &InvitationRepositoryMockCreateInvitationExpectation{}
+	}
+
+	if mmCreateInvitation.defaultExpectation.params != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Expect")
+	}
+
+	if mmCreateInvitation.defaultExpectation.paramPtrs == nil {
+		mmCreateInvitation.defaultExpectation.paramPtrs = &InvitationRepositoryMockCreateInvitationParamPtrs{}
+	}
+	mmCreateInvitation.defaultExpectation.paramPtrs.invitation = &invitation
+	mmCreateInvitation.defaultExpectation.expectationOrigins.originInvitation = minimock.CallerInfo(1)
+
+	return mmCreateInvitation
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.CreateInvitation
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Inspect(f func(ctx context.Context, invitation *model.Invitation)) *mInvitationRepositoryMockCreateInvitation {
+	if mmCreateInvitation.mock.inspectFuncCreateInvitation != nil {
+		mmCreateInvitation.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.CreateInvitation")
+	}
+
+	mmCreateInvitation.mock.inspectFuncCreateInvitation = f
+
+	return mmCreateInvitation
+}
+
+// Return sets up results that will be returned by InvitationRepository.CreateInvitation
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Return(i1 int64, err error) *InvitationRepositoryMock {
+	if mmCreateInvitation.mock.funcCreateInvitation != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Set")
+	}
+
+	if mmCreateInvitation.defaultExpectation == nil {
+		mmCreateInvitation.defaultExpectation = &InvitationRepositoryMockCreateInvitationExpectation{mock: mmCreateInvitation.mock}
+	}
+	mmCreateInvitation.defaultExpectation.results = &InvitationRepositoryMockCreateInvitationResults{i1, err}
+	mmCreateInvitation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateInvitation.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.CreateInvitation method
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Set(f func(ctx context.Context, invitation *model.Invitation) (i1 int64, err error)) *InvitationRepositoryMock {
+	if mmCreateInvitation.defaultExpectation != nil {
+		mmCreateInvitation.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.CreateInvitation method")
+	}
+
+	if len(mmCreateInvitation.expectations) > 0 {
+		mmCreateInvitation.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.CreateInvitation method")
+	}
+
+	mmCreateInvitation.mock.funcCreateInvitation = f
+	mmCreateInvitation.mock.funcCreateInvitationOrigin = minimock.CallerInfo(1)
+	return mmCreateInvitation.mock
+}
+
+// When sets expectation for the InvitationRepository.CreateInvitation which will trigger the result defined by the following
+// Then helper
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) When(ctx context.Context, invitation *model.Invitation) *InvitationRepositoryMockCreateInvitationExpectation {
+	if mmCreateInvitation.mock.funcCreateInvitation != nil {
+		mmCreateInvitation.mock.t.Fatalf("InvitationRepositoryMock.CreateInvitation mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockCreateInvitationExpectation{
+		mock:               mmCreateInvitation.mock,
+		params:             &InvitationRepositoryMockCreateInvitationParams{ctx, invitation},
+		expectationOrigins: InvitationRepositoryMockCreateInvitationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateInvitation.expectations = append(mmCreateInvitation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.CreateInvitation return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockCreateInvitationExpectation) Then(i1 int64, err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockCreateInvitationResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.CreateInvitation should be invoked
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Times(n uint64) *mInvitationRepositoryMockCreateInvitation {
+	if n == 0 {
+		mmCreateInvitation.mock.t.Fatalf("Times of InvitationRepositoryMock.CreateInvitation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateInvitation.expectedInvocations, n)
+	mmCreateInvitation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateInvitation
+}
+
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) invocationsDone() bool {
+	if len(mmCreateInvitation.expectations) == 0 && mmCreateInvitation.defaultExpectation == nil && mmCreateInvitation.mock.funcCreateInvitation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateInvitation.mock.afterCreateInvitationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateInvitation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateInvitation implements mm_repository.InvitationRepository
+func (mmCreateInvitation *InvitationRepositoryMock) CreateInvitation(ctx context.Context, invitation *model.Invitation) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateInvitation.beforeCreateInvitationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateInvitation.afterCreateInvitationCounter, 1)
+
+	mmCreateInvitation.t.Helper()
+
+	if mmCreateInvitation.inspectFuncCreateInvitation != nil {
+		mmCreateInvitation.inspectFuncCreateInvitation(ctx, invitation)
+	}
+
+	mm_params := InvitationRepositoryMockCreateInvitationParams{ctx, invitation}
+
+	// Record call args
+	mmCreateInvitation.CreateInvitationMock.mutex.Lock()
+	mmCreateInvitation.CreateInvitationMock.callArgs = append(mmCreateInvitation.CreateInvitationMock.callArgs, &mm_params)
+	mmCreateInvitation.CreateInvitationMock.mutex.Unlock()
+
+	for _, e := range mmCreateInvitation.CreateInvitationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateInvitation.CreateInvitationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateInvitation.CreateInvitationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateInvitation.CreateInvitationMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateInvitation.CreateInvitationMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockCreateInvitationParams{ctx, invitation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateInvitation.t.Errorf("InvitationRepositoryMock.CreateInvitation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateInvitation.CreateInvitationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.invitation != nil && !minimock.Equal(*mm_want_ptrs.invitation, mm_got.invitation) {
+				mmCreateInvitation.t.Errorf("InvitationRepositoryMock.CreateInvitation got unexpected parameter invitation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateInvitation.CreateInvitationMock.defaultExpectation.expectationOrigins.originInvitation, *mm_want_ptrs.invitation, mm_got.invitation, minimock.Diff(*mm_want_ptrs.invitation, mm_got.invitation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateInvitation.t.Errorf("InvitationRepositoryMock.CreateInvitation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateInvitation.CreateInvitationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateInvitation.CreateInvitationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateInvitation.t.Fatal("No results are set for the InvitationRepositoryMock.CreateInvitation")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateInvitation.funcCreateInvitation != nil {
+		return mmCreateInvitation.funcCreateInvitation(ctx, invitation)
+	}
+	mmCreateInvitation.t.Fatalf("Unexpected call to InvitationRepositoryMock.CreateInvitation. %v %v", ctx, invitation)
+	return
+}
+
+// CreateInvitationAfterCounter returns a count of finished InvitationRepositoryMock.CreateInvitation invocations
+func (mmCreateInvitation *InvitationRepositoryMock) CreateInvitationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateInvitation.afterCreateInvitationCounter)
+}
+
+// CreateInvitationBeforeCounter returns a count of InvitationRepositoryMock.CreateInvitation invocations
+func (mmCreateInvitation *InvitationRepositoryMock) CreateInvitationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateInvitation.beforeCreateInvitationCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.CreateInvitation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateInvitation *mInvitationRepositoryMockCreateInvitation) Calls() []*InvitationRepositoryMockCreateInvitationParams {
+	mmCreateInvitation.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockCreateInvitationParams, len(mmCreateInvitation.callArgs))
+	copy(argCopy, mmCreateInvitation.callArgs)
+
+	mmCreateInvitation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateInvitationDone returns true if the count of the CreateInvitation invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockCreateInvitationDone() bool {
+	if m.CreateInvitationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateInvitationMock.invocationsDone()
+}
+
+// MinimockCreateInvitationInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockCreateInvitationInspect() {
+	for _, e := range m.CreateInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.CreateInvitation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateInvitationCounter := mm_atomic.LoadUint64(&m.afterCreateInvitationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateInvitationMock.defaultExpectation != nil && afterCreateInvitationCounter < 1 {
+		if m.CreateInvitationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.CreateInvitation at\n%s", m.CreateInvitationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.CreateInvitation at\n%s with params: %#v", m.CreateInvitationMock.defaultExpectation.expectationOrigins.origin, *m.CreateInvitationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateInvitation != nil && afterCreateInvitationCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.CreateInvitation at\n%s", m.funcCreateInvitationOrigin)
+	}
+
+	if !m.CreateInvitationMock.invocationsDone() && afterCreateInvitationCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.CreateInvitation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateInvitationMock.expectedInvocations), m.CreateInvitationMock.expectedInvocationsOrigin, afterCreateInvitationCounter)
+	}
+}
+
+type mInvitationRepositoryMockDeleteInvitationsByUserID struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockDeleteInvitationsByUserIDExpectation
+	expectations       []*InvitationRepositoryMockDeleteInvitationsByUserIDExpectation
+
+	callArgs []*InvitationRepositoryMockDeleteInvitationsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockDeleteInvitationsByUserIDExpectation specifies expectation struct of the InvitationRepository.DeleteInvitationsByUserID
+type InvitationRepositoryMockDeleteInvitationsByUserIDExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockDeleteInvitationsByUserIDParams
+	paramPtrs          *InvitationRepositoryMockDeleteInvitationsByUserIDParamPtrs
+	expectationOrigins InvitationRepositoryMockDeleteInvitationsByUserIDExpectationOrigins
+	results            *InvitationRepositoryMockDeleteInvitationsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockDeleteInvitationsByUserIDParams contains parameters of the InvitationRepository.DeleteInvitationsByUserID
+type InvitationRepositoryMockDeleteInvitationsByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// InvitationRepositoryMockDeleteInvitationsByUserIDParamPtrs contains pointers to parameters of the InvitationRepository.DeleteInvitationsByUserID
+type InvitationRepositoryMockDeleteInvitationsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// InvitationRepositoryMockDeleteInvitationsByUserIDResults contains results of the InvitationRepository.DeleteInvitationsByUserID
+type InvitationRepositoryMockDeleteInvitationsByUserIDResults struct {
+	err error
+}
+
+// InvitationRepositoryMockDeleteInvitationsByUserIDOrigins contains origins of expectations of the InvitationRepository.DeleteInvitationsByUserID
+type InvitationRepositoryMockDeleteInvitationsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Optional() *mInvitationRepositoryMockDeleteInvitationsByUserID {
+	mmDeleteInvitationsByUserID.optional = true
+	return mmDeleteInvitationsByUserID
+}
+
+// Expect sets up expected params for InvitationRepository.DeleteInvitationsByUserID
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Expect(ctx context.Context, userID int64) *mInvitationRepositoryMockDeleteInvitationsByUserID {
+	if mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation == nil {
+		mmDeleteInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockDeleteInvitationsByUserIDExpectation{}
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteInvitationsByUserID.defaultExpectation.params = &InvitationRepositoryMockDeleteInvitationsByUserIDParams{ctx, userID}
+	mmDeleteInvitationsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteInvitationsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteInvitationsByUserID.defaultExpectation.params) {
+			mmDeleteInvitationsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteInvitationsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteInvitationsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.DeleteInvitationsByUserID
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockDeleteInvitationsByUserID {
+	if mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation == nil {
+		mmDeleteInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockDeleteInvitationsByUserIDExpectation{}
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation.params != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs = &InvitationRepositoryMockDeleteInvitationsByUserIDParamPtrs{}
+	}
+	mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteInvitationsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteInvitationsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for InvitationRepository.DeleteInvitationsByUserID
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) ExpectUserIDParam2(userID int64) *mInvitationRepositoryMockDeleteInvitationsByUserID {
+	if mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation == nil {
+		mmDeleteInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockDeleteInvitationsByUserIDExpectation{}
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation.params != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs = &InvitationRepositoryMockDeleteInvitationsByUserIDParamPtrs{}
+	}
+	mmDeleteInvitationsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteInvitationsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteInvitationsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.DeleteInvitationsByUserID
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Inspect(f func(ctx context.Context, userID int64)) *mInvitationRepositoryMockDeleteInvitationsByUserID {
+	if mmDeleteInvitationsByUserID.mock.inspectFuncDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.DeleteInvitationsByUserID")
+	}
+
+	mmDeleteInvitationsByUserID.mock.inspectFuncDeleteInvitationsByUserID = f
+
+	return mmDeleteInvitationsByUserID
+}
+
+// Return sets up results that will be returned by InvitationRepository.DeleteInvitationsByUserID
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Return(err error) *InvitationRepositoryMock {
+	if mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteInvitationsByUserID.defaultExpectation == nil {
+		mmDeleteInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockDeleteInvitationsByUserIDExpectation{mock: mmDeleteInvitationsByUserID.mock}
+	}
+	mmDeleteInvitationsByUserID.defaultExpectation.results = &InvitationRepositoryMockDeleteInvitationsByUserIDResults{err}
+	mmDeleteInvitationsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteInvitationsByUserID.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.DeleteInvitationsByUserID method
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Set(f func(ctx context.Context, userID int64) (err error)) *InvitationRepositoryMock {
+	if mmDeleteInvitationsByUserID.defaultExpectation != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.DeleteInvitationsByUserID method")
+	}
+
+	if len(mmDeleteInvitationsByUserID.expectations) > 0 {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.DeleteInvitationsByUserID method")
+	}
+
+	mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID = f
+	mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteInvitationsByUserID.mock
+}
+
+// When sets expectation for the InvitationRepository.DeleteInvitationsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) When(ctx context.Context, userID int64) *InvitationRepositoryMockDeleteInvitationsByUserIDExpectation {
+	if mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.DeleteInvitationsByUserID mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockDeleteInvitationsByUserIDExpectation{
+		mock:               mmDeleteInvitationsByUserID.mock,
+		params:             &InvitationRepositoryMockDeleteInvitationsByUserIDParams{ctx, userID},
+		expectationOrigins: InvitationRepositoryMockDeleteInvitationsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteInvitationsByUserID.expectations = append(mmDeleteInvitationsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.DeleteInvitationsByUserID return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockDeleteInvitationsByUserIDExpectation) Then(err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockDeleteInvitationsByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.DeleteInvitationsByUserID should be invoked
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Times(n uint64) *mInvitationRepositoryMockDeleteInvitationsByUserID {
+	if n == 0 {
+		mmDeleteInvitationsByUserID.mock.t.Fatalf("Times of InvitationRepositoryMock.DeleteInvitationsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteInvitationsByUserID.expectedInvocations, n)
+	mmDeleteInvitationsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteInvitationsByUserID
+}
+
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) invocationsDone() bool {
+	if len(mmDeleteInvitationsByUserID.expectations) == 0 && mmDeleteInvitationsByUserID.defaultExpectation == nil && mmDeleteInvitationsByUserID.mock.funcDeleteInvitationsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteInvitationsByUserID.mock.afterDeleteInvitationsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteInvitationsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteInvitationsByUserID implements mm_repository.InvitationRepository
+func (mmDeleteInvitationsByUserID *InvitationRepositoryMock) DeleteInvitationsByUserID(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteInvitationsByUserID.beforeDeleteInvitationsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteInvitationsByUserID.afterDeleteInvitationsByUserIDCounter, 1)
+
+	mmDeleteInvitationsByUserID.t.Helper()
+
+	if mmDeleteInvitationsByUserID.inspectFuncDeleteInvitationsByUserID != nil {
+		mmDeleteInvitationsByUserID.inspectFuncDeleteInvitationsByUserID(ctx, userID)
+	}
+
+	mm_params := InvitationRepositoryMockDeleteInvitationsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.mutex.Lock()
+	mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.callArgs = append(mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.callArgs, &mm_params)
+	mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockDeleteInvitationsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteInvitationsByUserID.t.Errorf("InvitationRepositoryMock.DeleteInvitationsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteInvitationsByUserID.t.Errorf("InvitationRepositoryMock.DeleteInvitationsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteInvitationsByUserID.t.Errorf("InvitationRepositoryMock.DeleteInvitationsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteInvitationsByUserID.DeleteInvitationsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteInvitationsByUserID.t.Fatal("No results are set for the InvitationRepositoryMock.DeleteInvitationsByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteInvitationsByUserID.funcDeleteInvitationsByUserID != nil {
+		return mmDeleteInvitationsByUserID.funcDeleteInvitationsByUserID(ctx, userID)
+	}
+	mmDeleteInvitationsByUserID.t.Fatalf("Unexpected call to InvitationRepositoryMock.DeleteInvitationsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteInvitationsByUserIDAfterCounter returns a count of finished InvitationRepositoryMock.DeleteInvitationsByUserID invocations
+func (mmDeleteInvitationsByUserID *InvitationRepositoryMock) DeleteInvitationsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteInvitationsByUserID.afterDeleteInvitationsByUserIDCounter)
+}
+
+// DeleteInvitationsByUserIDBeforeCounter returns a count of InvitationRepositoryMock.DeleteInvitationsByUserID invocations
+func (mmDeleteInvitationsByUserID *InvitationRepositoryMock) DeleteInvitationsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteInvitationsByUserID.beforeDeleteInvitationsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.DeleteInvitationsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteInvitationsByUserID *mInvitationRepositoryMockDeleteInvitationsByUserID) Calls() []*InvitationRepositoryMockDeleteInvitationsByUserIDParams {
+	mmDeleteInvitationsByUserID.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockDeleteInvitationsByUserIDParams, len(mmDeleteInvitationsByUserID.callArgs))
+	copy(argCopy, mmDeleteInvitationsByUserID.callArgs)
+
+	mmDeleteInvitationsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteInvitationsByUserIDDone returns true if the count of the DeleteInvitationsByUserID invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockDeleteInvitationsByUserIDDone() bool {
+	if m.DeleteInvitationsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteInvitationsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteInvitationsByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteInvitationsByUserIDInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockDeleteInvitationsByUserIDInspect() {
+	for _, e := range m.DeleteInvitationsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.DeleteInvitationsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteInvitationsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteInvitationsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteInvitationsByUserIDMock.defaultExpectation != nil && afterDeleteInvitationsByUserIDCounter < 1 {
+		if m.DeleteInvitationsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.DeleteInvitationsByUserID at\n%s", m.DeleteInvitationsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.DeleteInvitationsByUserID at\n%s with params: %#v", m.DeleteInvitationsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteInvitationsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteInvitationsByUserID != nil && afterDeleteInvitationsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.DeleteInvitationsByUserID at\n%s", m.funcDeleteInvitationsByUserIDOrigin)
+	}
+
+	if !m.DeleteInvitationsByUserIDMock.invocationsDone() && afterDeleteInvitationsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.DeleteInvitationsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteInvitationsByUserIDMock.expectedInvocations), m.DeleteInvitationsByUserIDMock.expectedInvocationsOrigin, afterDeleteInvitationsByUserIDCounter)
+	}
+}
+
+type mInvitationRepositoryMockGetInvitation struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockGetInvitationExpectation
+	expectations       []*InvitationRepositoryMockGetInvitationExpectation
+
+	callArgs []*InvitationRepositoryMockGetInvitationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockGetInvitationExpectation specifies expectation struct of the InvitationRepository.GetInvitation
+type InvitationRepositoryMockGetInvitationExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockGetInvitationParams
+	paramPtrs          *InvitationRepositoryMockGetInvitationParamPtrs
+	expectationOrigins InvitationRepositoryMockGetInvitationExpectationOrigins
+	results            *InvitationRepositoryMockGetInvitationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockGetInvitationParams contains parameters of the InvitationRepository.GetInvitation
+type InvitationRepositoryMockGetInvitationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// InvitationRepositoryMockGetInvitationParamPtrs contains pointers to parameters of the InvitationRepository.GetInvitation
+type InvitationRepositoryMockGetInvitationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// InvitationRepositoryMockGetInvitationResults contains results of the InvitationRepository.GetInvitation
+type InvitationRepositoryMockGetInvitationResults struct {
+	ip1 *model.Invitation
+	err error
+}
+
+// InvitationRepositoryMockGetInvitationOrigins contains origins of expectations of the InvitationRepository.GetInvitation
+type InvitationRepositoryMockGetInvitationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Optional() *mInvitationRepositoryMockGetInvitation {
+	mmGetInvitation.optional = true
+	return mmGetInvitation
+}
+
+// Expect sets up expected params for InvitationRepository.GetInvitation
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Expect(ctx context.Context, id int64) *mInvitationRepositoryMockGetInvitation {
+	if mmGetInvitation.mock.funcGetInvitation != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Set")
+	}
+
+	if mmGetInvitation.defaultExpectation == nil {
+		mmGetInvitation.defaultExpectation = &InvitationRepositoryMockGetInvitationExpectation{}
+	}
+
+	if mmGetInvitation.defaultExpectation.paramPtrs != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by ExpectParams functions")
+	}
+
+	mmGetInvitation.defaultExpectation.params = &InvitationRepositoryMockGetInvitationParams{ctx, id}
+	mmGetInvitation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetInvitation.expectations {
+		if minimock.Equal(e.params, mmGetInvitation.defaultExpectation.params) {
+			mmGetInvitation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetInvitation.defaultExpectation.params)
+		}
+	}
+
+	return mmGetInvitation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.GetInvitation
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockGetInvitation {
+	if mmGetInvitation.mock.funcGetInvitation != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Set")
+	}
+
+	if mmGetInvitation.defaultExpectation == nil {
+		mmGetInvitation.defaultExpectation = &InvitationRepositoryMockGetInvitationExpectation{}
+	}
+
+	if mmGetInvitation.defaultExpectation.params != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Expect")
+	}
+
+	if mmGetInvitation.defaultExpectation.paramPtrs == nil {
+		mmGetInvitation.defaultExpectation.paramPtrs = &InvitationRepositoryMockGetInvitationParamPtrs{}
+	}
+	mmGetInvitation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetInvitation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetInvitation
+}
+
+// ExpectIdParam2 sets up expected param id for InvitationRepository.GetInvitation
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) ExpectIdParam2(id int64) *mInvitationRepositoryMockGetInvitation {
+	if mmGetInvitation.mock.funcGetInvitation != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Set")
+	}
+
+	if mmGetInvitation.defaultExpectation == nil {
+		mmGetInvitation.defaultExpectation = &InvitationRepositoryMockGetInvitationExpectation{}
+	}
+
+	if mmGetInvitation.defaultExpectation.params != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Expect")
+	}
+
+	if mmGetInvitation.defaultExpectation.paramPtrs == nil {
+		mmGetInvitation.defaultExpectation.paramPtrs = &InvitationRepositoryMockGetInvitationParamPtrs{}
+	}
+	mmGetInvitation.defaultExpectation.paramPtrs.id = &id
+	mmGetInvitation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetInvitation
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.GetInvitation
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Inspect(f func(ctx context.Context, id int64)) *mInvitationRepositoryMockGetInvitation {
+	if mmGetInvitation.mock.inspectFuncGetInvitation != nil {
+		mmGetInvitation.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.GetInvitation")
+	}
+
+	mmGetInvitation.mock.inspectFuncGetInvitation = f
+
+	return mmGetInvitation
+}
+
+// Return sets up results that will be returned by InvitationRepository.GetInvitation
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Return(ip1 *model.Invitation, err error) *InvitationRepositoryMock {
+	if mmGetInvitation.mock.funcGetInvitation != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Set")
+	}
+
+	if mmGetInvitation.defaultExpectation == nil {
+		mmGetInvitation.defaultExpectation = &InvitationRepositoryMockGetInvitationExpectation{mock: mmGetInvitation.mock}
+	}
+	mmGetInvitation.defaultExpectation.results = &InvitationRepositoryMockGetInvitationResults{ip1, err}
+	mmGetInvitation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetInvitation.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.GetInvitation method
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Set(f func(ctx context.Context, id int64) (ip1 *model.Invitation, err error)) *InvitationRepositoryMock {
+	if mmGetInvitation.defaultExpectation != nil {
+		mmGetInvitation.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.GetInvitation method")
+	}
+
+	if len(mmGetInvitation.expectations) > 0 {
+		mmGetInvitation.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.GetInvitation method")
+	}
+
+	mmGetInvitation.mock.funcGetInvitation = f
+	mmGetInvitation.mock.funcGetInvitationOrigin = minimock.CallerInfo(1)
+	return mmGetInvitation.mock
+}
+
+// When sets expectation for the InvitationRepository.GetInvitation which will trigger the result defined by the following
+// Then helper
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) When(ctx context.Context, id int64) *InvitationRepositoryMockGetInvitationExpectation {
+	if mmGetInvitation.mock.funcGetInvitation != nil {
+		mmGetInvitation.mock.t.Fatalf("InvitationRepositoryMock.GetInvitation mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockGetInvitationExpectation{
+		mock:               mmGetInvitation.mock,
+		params:             &InvitationRepositoryMockGetInvitationParams{ctx, id},
+		expectationOrigins: InvitationRepositoryMockGetInvitationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetInvitation.expectations = append(mmGetInvitation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.GetInvitation return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockGetInvitationExpectation) Then(ip1 *model.Invitation, err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockGetInvitationResults{ip1, err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.GetInvitation should be invoked
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Times(n uint64) *mInvitationRepositoryMockGetInvitation {
+	if n == 0 {
+		mmGetInvitation.mock.t.Fatalf("Times of InvitationRepositoryMock.GetInvitation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetInvitation.expectedInvocations, n)
+	mmGetInvitation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetInvitation
+}
+
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) invocationsDone() bool {
+	if len(mmGetInvitation.expectations) == 0 && mmGetInvitation.defaultExpectation == nil && mmGetInvitation.mock.funcGetInvitation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetInvitation.mock.afterGetInvitationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetInvitation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetInvitation implements mm_repository.InvitationRepository
+func (mmGetInvitation *InvitationRepositoryMock) GetInvitation(ctx context.Context, id int64) (ip1 *model.Invitation, err error) {
+	mm_atomic.AddUint64(&mmGetInvitation.beforeGetInvitationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetInvitation.afterGetInvitationCounter, 1)
+
+	mmGetInvitation.t.Helper()
+
+	if mmGetInvitation.inspectFuncGetInvitation != nil {
+		mmGetInvitation.inspectFuncGetInvitation(ctx, id)
+	}
+
+	mm_params := InvitationRepositoryMockGetInvitationParams{ctx, id}
+
+	// Record call args
+	mmGetInvitation.GetInvitationMock.mutex.Lock()
+	mmGetInvitation.GetInvitationMock.callArgs = append(mmGetInvitation.GetInvitationMock.callArgs, &mm_params)
+	mmGetInvitation.GetInvitationMock.mutex.Unlock()
+
+	for _, e := range mmGetInvitation.GetInvitationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.err
+		}
+	}
+
+	if mmGetInvitation.GetInvitationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetInvitation.GetInvitationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetInvitation.GetInvitationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetInvitation.GetInvitationMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockGetInvitationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetInvitation.t.Errorf("InvitationRepositoryMock.GetInvitation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInvitation.GetInvitationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetInvitation.t.Errorf("InvitationRepositoryMock.GetInvitation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInvitation.GetInvitationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetInvitation.t.Errorf("InvitationRepositoryMock.GetInvitation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetInvitation.GetInvitationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetInvitation.GetInvitationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetInvitation.t.Fatal("No results are set for the InvitationRepositoryMock.GetInvitation")
+		}
+		return (*mm_results).ip1, (*mm_results).err
+	}
+	if mmGetInvitation.funcGetInvitation != nil {
+		return mmGetInvitation.funcGetInvitation(ctx, id)
+	}
+	mmGetInvitation.t.Fatalf("Unexpected call to InvitationRepositoryMock.GetInvitation. %v %v", ctx, id)
+	return
+}
+
+// GetInvitationAfterCounter returns a count of finished InvitationRepositoryMock.GetInvitation invocations
+func (mmGetInvitation *InvitationRepositoryMock) GetInvitationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetInvitation.afterGetInvitationCounter)
+}
+
+// GetInvitationBeforeCounter returns a count of InvitationRepositoryMock.GetInvitation invocations
+func (mmGetInvitation *InvitationRepositoryMock) GetInvitationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetInvitation.beforeGetInvitationCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.GetInvitation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetInvitation *mInvitationRepositoryMockGetInvitation) Calls() []*InvitationRepositoryMockGetInvitationParams {
+	mmGetInvitation.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockGetInvitationParams, len(mmGetInvitation.callArgs))
+	copy(argCopy, mmGetInvitation.callArgs)
+
+	mmGetInvitation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetInvitationDone returns true if the count of the GetInvitation invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockGetInvitationDone() bool {
+	if m.GetInvitationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetInvitationMock.invocationsDone()
+}
+
+// MinimockGetInvitationInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockGetInvitationInspect() {
+	for _, e := range m.GetInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetInvitationCounter := mm_atomic.LoadUint64(&m.afterGetInvitationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetInvitationMock.defaultExpectation != nil && afterGetInvitationCounter < 1 {
+		if m.GetInvitationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitation at\n%s", m.GetInvitationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitation at\n%s with params: %#v", m.GetInvitationMock.defaultExpectation.expectationOrigins.origin, *m.GetInvitationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetInvitation != nil && afterGetInvitationCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitation at\n%s", m.funcGetInvitationOrigin)
+	}
+
+	if !m.GetInvitationMock.invocationsDone() && afterGetInvitationCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.GetInvitation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetInvitationMock.expectedInvocations), m.GetInvitationMock.expectedInvocationsOrigin, afterGetInvitationCounter)
+	}
+}
+
+type mInvitationRepositoryMockGetInvitationByTokenID struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockGetInvitationByTokenIDExpectation
+	expectations       []*InvitationRepositoryMockGetInvitationByTokenIDExpectation
+
+	callArgs []*InvitationRepositoryMockGetInvitationByTokenIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockGetInvitationByTokenIDExpectation specifies expectation struct of the InvitationRepository.GetInvitationByTokenID
+type InvitationRepositoryMockGetInvitationByTokenIDExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockGetInvitationByTokenIDParams
+	paramPtrs          *InvitationRepositoryMockGetInvitationByTokenIDParamPtrs
+	expectationOrigins InvitationRepositoryMockGetInvitationByTokenIDExpectationOrigins
+	results            *InvitationRepositoryMockGetInvitationByTokenIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockGetInvitationByTokenIDParams contains parameters of the InvitationRepository.GetInvitationByTokenID
+type InvitationRepositoryMockGetInvitationByTokenIDParams struct {
+	ctx     context.Context
+	tokenID string
+}
+
+// InvitationRepositoryMockGetInvitationByTokenIDParamPtrs contains pointers to parameters of the InvitationRepository.GetInvitationByTokenID
+type InvitationRepositoryMockGetInvitationByTokenIDParamPtrs struct {
+	ctx     *context.Context
+	tokenID *string
+}
+
+// InvitationRepositoryMockGetInvitationByTokenIDResults contains results of the InvitationRepository.GetInvitationByTokenID
+type InvitationRepositoryMockGetInvitationByTokenIDResults struct {
+	ip1 *model.Invitation
+	err error
+}
+
+// InvitationRepositoryMockGetInvitationByTokenIDOrigins contains origins of expectations of the InvitationRepository.GetInvitationByTokenID
+type InvitationRepositoryMockGetInvitationByTokenIDExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originTokenID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Optional() *mInvitationRepositoryMockGetInvitationByTokenID {
+	mmGetInvitationByTokenID.optional = true
+	return mmGetInvitationByTokenID
+}
+
+// Expect sets up expected params for InvitationRepository.GetInvitationByTokenID
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Expect(ctx context.Context, tokenID string) *mInvitationRepositoryMockGetInvitationByTokenID {
+	if mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Set")
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation == nil {
+		mmGetInvitationByTokenID.defaultExpectation = &InvitationRepositoryMockGetInvitationByTokenIDExpectation{}
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation.paramPtrs != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by ExpectParams functions")
+	}
+
+	mmGetInvitationByTokenID.defaultExpectation.params = &InvitationRepositoryMockGetInvitationByTokenIDParams{ctx, tokenID}
+	mmGetInvitationByTokenID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetInvitationByTokenID.expectations {
+		if minimock.Equal(e.params, mmGetInvitationByTokenID.defaultExpectation.params) {
+			mmGetInvitationByTokenID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetInvitationByTokenID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetInvitationByTokenID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.GetInvitationByTokenID
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockGetInvitationByTokenID {
+	if mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Set")
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation == nil {
+		mmGetInvitationByTokenID.defaultExpectation = &InvitationRepositoryMockGetInvitationByTokenIDExpectation{}
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation.params != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Expect")
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation.paramPtrs == nil {
+		mmGetInvitationByTokenID.defaultExpectation.paramPtrs = &InvitationRepositoryMockGetInvitationByTokenIDParamPtrs{}
+	}
+	mmGetInvitationByTokenID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetInvitationByTokenID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetInvitationByTokenID
+}
+
+// ExpectTokenIDParam2 sets up expected param tokenID for InvitationRepository.GetInvitationByTokenID
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) ExpectTokenIDParam2(tokenID string) *mInvitationRepositoryMockGetInvitationByTokenID {
+	if mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Set")
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation == nil {
+		mmGetInvitationByTokenID.defaultExpectation = &InvitationRepositoryMockGetInvitationByTokenIDExpectation{}
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation.params != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Expect")
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation.paramPtrs == nil {
+		mmGetInvitationByTokenID.defaultExpectation.paramPtrs = &InvitationRepositoryMockGetInvitationByTokenIDParamPtrs{}
+	}
+	mmGetInvitationByTokenID.defaultExpectation.paramPtrs.tokenID = &tokenID
+	mmGetInvitationByTokenID.defaultExpectation.expectationOrigins.originTokenID = minimock.CallerInfo(1)
+
+	return mmGetInvitationByTokenID
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.GetInvitationByTokenID
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Inspect(f func(ctx context.Context, tokenID string)) *mInvitationRepositoryMockGetInvitationByTokenID {
+	if mmGetInvitationByTokenID.mock.inspectFuncGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.GetInvitationByTokenID")
+	}
+
+	mmGetInvitationByTokenID.mock.inspectFuncGetInvitationByTokenID = f
+
+	return mmGetInvitationByTokenID
+}
+
+// Return sets up results that will be returned by InvitationRepository.GetInvitationByTokenID
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Return(ip1 *model.Invitation, err error) *InvitationRepositoryMock {
+	if mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Set")
+	}
+
+	if mmGetInvitationByTokenID.defaultExpectation == nil {
+		mmGetInvitationByTokenID.defaultExpectation = &InvitationRepositoryMockGetInvitationByTokenIDExpectation{mock: mmGetInvitationByTokenID.mock}
+	}
+	mmGetInvitationByTokenID.defaultExpectation.results = &InvitationRepositoryMockGetInvitationByTokenIDResults{ip1, err}
+	mmGetInvitationByTokenID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetInvitationByTokenID.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.GetInvitationByTokenID method
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Set(f func(ctx context.Context, tokenID string) (ip1 *model.Invitation, err error)) *InvitationRepositoryMock {
+	if mmGetInvitationByTokenID.defaultExpectation != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.GetInvitationByTokenID method")
+	}
+
+	if len(mmGetInvitationByTokenID.expectations) > 0 {
+		mmGetInvitationByTokenID.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.GetInvitationByTokenID method")
+	}
+
+	mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID = f
+	mmGetInvitationByTokenID.mock.funcGetInvitationByTokenIDOrigin = minimock.CallerInfo(1)
+	return mmGetInvitationByTokenID.mock
+}
+
+// When sets expectation for the InvitationRepository.GetInvitationByTokenID which will trigger the result defined by the following
+// Then helper
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) When(ctx context.Context, tokenID string) *InvitationRepositoryMockGetInvitationByTokenIDExpectation {
+	if mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.mock.t.Fatalf("InvitationRepositoryMock.GetInvitationByTokenID mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockGetInvitationByTokenIDExpectation{
+		mock:               mmGetInvitationByTokenID.mock,
+		params:             &InvitationRepositoryMockGetInvitationByTokenIDParams{ctx, tokenID},
+		expectationOrigins: InvitationRepositoryMockGetInvitationByTokenIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetInvitationByTokenID.expectations = append(mmGetInvitationByTokenID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.GetInvitationByTokenID return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockGetInvitationByTokenIDExpectation) Then(ip1 *model.Invitation, err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockGetInvitationByTokenIDResults{ip1, err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.GetInvitationByTokenID should be invoked
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Times(n uint64) *mInvitationRepositoryMockGetInvitationByTokenID {
+	if n == 0 {
+		mmGetInvitationByTokenID.mock.t.Fatalf("Times of InvitationRepositoryMock.GetInvitationByTokenID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetInvitationByTokenID.expectedInvocations, n)
+	mmGetInvitationByTokenID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetInvitationByTokenID
+}
+
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) invocationsDone() bool {
+	if len(mmGetInvitationByTokenID.expectations) == 0 && mmGetInvitationByTokenID.defaultExpectation == nil && mmGetInvitationByTokenID.mock.funcGetInvitationByTokenID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetInvitationByTokenID.mock.afterGetInvitationByTokenIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetInvitationByTokenID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetInvitationByTokenID implements mm_repository.InvitationRepository
+func (mmGetInvitationByTokenID *InvitationRepositoryMock) GetInvitationByTokenID(ctx context.Context, tokenID string) (ip1 *model.Invitation, err error) {
+	mm_atomic.AddUint64(&mmGetInvitationByTokenID.beforeGetInvitationByTokenIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetInvitationByTokenID.afterGetInvitationByTokenIDCounter, 1)
+
+	mmGetInvitationByTokenID.t.Helper()
+
+	if mmGetInvitationByTokenID.inspectFuncGetInvitationByTokenID != nil {
+		mmGetInvitationByTokenID.inspectFuncGetInvitationByTokenID(ctx, tokenID)
+	}
+
+	mm_params := InvitationRepositoryMockGetInvitationByTokenIDParams{ctx, tokenID}
+
+	// Record call args
+	mmGetInvitationByTokenID.GetInvitationByTokenIDMock.mutex.Lock()
+	mmGetInvitationByTokenID.GetInvitationByTokenIDMock.callArgs = append(mmGetInvitationByTokenID.GetInvitationByTokenIDMock.callArgs, &mm_params)
+	mmGetInvitationByTokenID.GetInvitationByTokenIDMock.mutex.Unlock()
+
+	for _, e := range mmGetInvitationByTokenID.GetInvitationByTokenIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.err
+		}
+	}
+
+	if mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockGetInvitationByTokenIDParams{ctx, tokenID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetInvitationByTokenID.t.Errorf("InvitationRepositoryMock.GetInvitationByTokenID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmGetInvitationByTokenID.t.Errorf("InvitationRepositoryMock.GetInvitationByTokenID got unexpected parameter tokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.expectationOrigins.originTokenID, *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetInvitationByTokenID.t.Errorf("InvitationRepositoryMock.GetInvitationByTokenID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetInvitationByTokenID.GetInvitationByTokenIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetInvitationByTokenID.t.Fatal("No results are set for the InvitationRepositoryMock.GetInvitationByTokenID")
+		}
+		return (*mm_results).ip1, (*mm_results).err
+	}
+	if mmGetInvitationByTokenID.funcGetInvitationByTokenID != nil {
+		return mmGetInvitationByTokenID.funcGetInvitationByTokenID(ctx, tokenID)
+	}
+	mmGetInvitationByTokenID.t.Fatalf("Unexpected call to InvitationRepositoryMock.GetInvitationByTokenID. %v %v", ctx, tokenID)
+	return
+}
+
+// GetInvitationByTokenIDAfterCounter returns a count of finished InvitationRepositoryMock.GetInvitationByTokenID invocations
+func (mmGetInvitationByTokenID *InvitationRepositoryMock) GetInvitationByTokenIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetInvitationByTokenID.afterGetInvitationByTokenIDCounter)
+}
+
+// GetInvitationByTokenIDBeforeCounter returns a count of InvitationRepositoryMock.GetInvitationByTokenID invocations
+func (mmGetInvitationByTokenID *InvitationRepositoryMock) GetInvitationByTokenIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetInvitationByTokenID.beforeGetInvitationByTokenIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.GetInvitationByTokenID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetInvitationByTokenID *mInvitationRepositoryMockGetInvitationByTokenID) Calls() []*InvitationRepositoryMockGetInvitationByTokenIDParams {
+	mmGetInvitationByTokenID.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockGetInvitationByTokenIDParams, len(mmGetInvitationByTokenID.callArgs))
+	copy(argCopy, mmGetInvitationByTokenID.callArgs)
+
+	mmGetInvitationByTokenID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetInvitationByTokenIDDone returns true if the count of the GetInvitationByTokenID invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockGetInvitationByTokenIDDone() bool {
+	if m.GetInvitationByTokenIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetInvitationByTokenIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetInvitationByTokenIDMock.invocationsDone()
+}
+
+// MinimockGetInvitationByTokenIDInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockGetInvitationByTokenIDInspect() {
+	for _, e := range m.GetInvitationByTokenIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitationByTokenID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetInvitationByTokenIDCounter := mm_atomic.LoadUint64(&m.afterGetInvitationByTokenIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetInvitationByTokenIDMock.defaultExpectation != nil && afterGetInvitationByTokenIDCounter < 1 {
+		if m.GetInvitationByTokenIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitationByTokenID at\n%s", m.GetInvitationByTokenIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitationByTokenID at\n%s with params: %#v", m.GetInvitationByTokenIDMock.defaultExpectation.expectationOrigins.origin, *m.GetInvitationByTokenIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetInvitationByTokenID != nil && afterGetInvitationByTokenIDCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.GetInvitationByTokenID at\n%s", m.funcGetInvitationByTokenIDOrigin)
+	}
+
+	if !m.GetInvitationByTokenIDMock.invocationsDone() && afterGetInvitationByTokenIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.GetInvitationByTokenID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetInvitationByTokenIDMock.expectedInvocations), m.GetInvitationByTokenIDMock.expectedInvocationsOrigin, afterGetInvitationByTokenIDCounter)
+	}
+}
+
+type mInvitationRepositoryMockListInvitationsByUserID struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockListInvitationsByUserIDExpectation
+	expectations       []*InvitationRepositoryMockListInvitationsByUserIDExpectation
+
+	callArgs []*InvitationRepositoryMockListInvitationsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockListInvitationsByUserIDExpectation specifies expectation struct of the InvitationRepository.ListInvitationsByUserID
+type InvitationRepositoryMockListInvitationsByUserIDExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockListInvitationsByUserIDParams
+	paramPtrs          *InvitationRepositoryMockListInvitationsByUserIDParamPtrs
+	expectationOrigins InvitationRepositoryMockListInvitationsByUserIDExpectationOrigins
+	results            *InvitationRepositoryMockListInvitationsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockListInvitationsByUserIDParams contains parameters of the InvitationRepository.ListInvitationsByUserID
+type InvitationRepositoryMockListInvitationsByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// InvitationRepositoryMockListInvitationsByUserIDParamPtrs contains pointers to parameters of the InvitationRepository.ListInvitationsByUserID
+type InvitationRepositoryMockListInvitationsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// InvitationRepositoryMockListInvitationsByUserIDResults contains results of the InvitationRepository.ListInvitationsByUserID
+type InvitationRepositoryMockListInvitationsByUserIDResults struct {
+	ipa1 []*model.Invitation
+	err  error
+}
+
+// InvitationRepositoryMockListInvitationsByUserIDOrigins contains origins of expectations of the InvitationRepository.ListInvitationsByUserID
+type InvitationRepositoryMockListInvitationsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Optional() *mInvitationRepositoryMockListInvitationsByUserID {
+	mmListInvitationsByUserID.optional = true
+	return mmListInvitationsByUserID
+}
+
+// Expect sets up expected params for InvitationRepository.ListInvitationsByUserID
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Expect(ctx context.Context, userID int64) *mInvitationRepositoryMockListInvitationsByUserID {
+	if mmListInvitationsByUserID.mock.funcListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation == nil {
+		mmListInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockListInvitationsByUserIDExpectation{}
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation.paramPtrs != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmListInvitationsByUserID.defaultExpectation.params = &InvitationRepositoryMockListInvitationsByUserIDParams{ctx, userID}
+	mmListInvitationsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListInvitationsByUserID.expectations {
+		if minimock.Equal(e.params, mmListInvitationsByUserID.defaultExpectation.params) {
+			mmListInvitationsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListInvitationsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmListInvitationsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.ListInvitationsByUserID
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockListInvitationsByUserID {
+	if mmListInvitationsByUserID.mock.funcListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation == nil {
+		mmListInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockListInvitationsByUserIDExpectation{}
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation.params != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Expect")
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation.paramPtrs == nil {
+		mmListInvitationsByUserID.defaultExpectation.paramPtrs = &InvitationRepositoryMockListInvitationsByUserIDParamPtrs{}
+	}
+	mmListInvitationsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListInvitationsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListInvitationsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for InvitationRepository.ListInvitationsByUserID
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) ExpectUserIDParam2(userID int64) *mInvitationRepositoryMockListInvitationsByUserID {
+	if mmListInvitationsByUserID.mock.funcListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation == nil {
+		mmListInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockListInvitationsByUserIDExpectation{}
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation.params != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Expect")
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation.paramPtrs == nil {
+		mmListInvitationsByUserID.defaultExpectation.paramPtrs = &InvitationRepositoryMockListInvitationsByUserIDParamPtrs{}
+	}
+	mmListInvitationsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmListInvitationsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListInvitationsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.ListInvitationsByUserID
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Inspect(f func(ctx context.Context, userID int64)) *mInvitationRepositoryMockListInvitationsByUserID {
+	if mmListInvitationsByUserID.mock.inspectFuncListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.ListInvitationsByUserID")
+	}
+
+	mmListInvitationsByUserID.mock.inspectFuncListInvitationsByUserID = f
+
+	return mmListInvitationsByUserID
+}
+
+// Return sets up results that will be returned by InvitationRepository.ListInvitationsByUserID
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Return(ipa1 []*model.Invitation, err error) *InvitationRepositoryMock {
+	if mmListInvitationsByUserID.mock.funcListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Set")
+	}
+
+	if mmListInvitationsByUserID.defaultExpectation == nil {
+		mmListInvitationsByUserID.defaultExpectation = &InvitationRepositoryMockListInvitationsByUserIDExpectation{mock: mmListInvitationsByUserID.mock}
+	}
+	mmListInvitationsByUserID.defaultExpectation.results = &InvitationRepositoryMockListInvitationsByUserIDResults{ipa1, err}
+	mmListInvitationsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListInvitationsByUserID.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.ListInvitationsByUserID method
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Set(f func(ctx context.Context, userID int64) (ipa1 []*model.Invitation, err error)) *InvitationRepositoryMock {
+	if mmListInvitationsByUserID.defaultExpectation != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.ListInvitationsByUserID method")
+	}
+
+	if len(mmListInvitationsByUserID.expectations) > 0 {
+		mmListInvitationsByUserID.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.ListInvitationsByUserID method")
+	}
+
+	mmListInvitationsByUserID.mock.funcListInvitationsByUserID = f
+	mmListInvitationsByUserID.mock.funcListInvitationsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmListInvitationsByUserID.mock
+}
+
+// When sets expectation for the InvitationRepository.ListInvitationsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) When(ctx context.Context, userID int64) *InvitationRepositoryMockListInvitationsByUserIDExpectation {
+	if mmListInvitationsByUserID.mock.funcListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.mock.t.Fatalf("InvitationRepositoryMock.ListInvitationsByUserID mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockListInvitationsByUserIDExpectation{
+		mock:               mmListInvitationsByUserID.mock,
+		params:             &InvitationRepositoryMockListInvitationsByUserIDParams{ctx, userID},
+		expectationOrigins: InvitationRepositoryMockListInvitationsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListInvitationsByUserID.expectations = append(mmListInvitationsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.ListInvitationsByUserID return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockListInvitationsByUserIDExpectation) Then(ipa1 []*model.Invitation, err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockListInvitationsByUserIDResults{ipa1, err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.ListInvitationsByUserID should be invoked
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Times(n uint64) *mInvitationRepositoryMockListInvitationsByUserID {
+	if n == 0 {
+		mmListInvitationsByUserID.mock.t.Fatalf("Times of InvitationRepositoryMock.ListInvitationsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListInvitationsByUserID.expectedInvocations, n)
+	mmListInvitationsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListInvitationsByUserID
+}
+
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) invocationsDone() bool {
+	if len(mmListInvitationsByUserID.expectations) == 0 && mmListInvitationsByUserID.defaultExpectation == nil && mmListInvitationsByUserID.mock.funcListInvitationsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListInvitationsByUserID.mock.afterListInvitationsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListInvitationsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListInvitationsByUserID implements mm_repository.InvitationRepository
+func (mmListInvitationsByUserID *InvitationRepositoryMock) ListInvitationsByUserID(ctx context.Context, userID int64) (ipa1 []*model.Invitation, err error) {
+	mm_atomic.AddUint64(&mmListInvitationsByUserID.beforeListInvitationsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmListInvitationsByUserID.afterListInvitationsByUserIDCounter, 1)
+
+	mmListInvitationsByUserID.t.Helper()
+
+	if mmListInvitationsByUserID.inspectFuncListInvitationsByUserID != nil {
+		mmListInvitationsByUserID.inspectFuncListInvitationsByUserID(ctx, userID)
+	}
+
+	mm_params := InvitationRepositoryMockListInvitationsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmListInvitationsByUserID.ListInvitationsByUserIDMock.mutex.Lock()
+	mmListInvitationsByUserID.ListInvitationsByUserIDMock.callArgs = append(mmListInvitationsByUserID.ListInvitationsByUserIDMock.callArgs, &mm_params)
+	mmListInvitationsByUserID.ListInvitationsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmListInvitationsByUserID.ListInvitationsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ipa1, e.results.err
+		}
+	}
+
+	if mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockListInvitationsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListInvitationsByUserID.t.Errorf("InvitationRepositoryMock.ListInvitationsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListInvitationsByUserID.t.Errorf("InvitationRepositoryMock.ListInvitationsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListInvitationsByUserID.t.Errorf("InvitationRepositoryMock.ListInvitationsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListInvitationsByUserID.ListInvitationsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListInvitationsByUserID.t.Fatal("No results are set for the InvitationRepositoryMock.ListInvitationsByUserID")
+		}
+		return (*mm_results).ipa1, (*mm_results).err
+	}
+	if mmListInvitationsByUserID.funcListInvitationsByUserID != nil {
+		return mmListInvitationsByUserID.funcListInvitationsByUserID(ctx, userID)
+	}
+	mmListInvitationsByUserID.t.Fatalf("Unexpected call to InvitationRepositoryMock.ListInvitationsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// ListInvitationsByUserIDAfterCounter returns a count of finished InvitationRepositoryMock.ListInvitationsByUserID invocations
+func (mmListInvitationsByUserID *InvitationRepositoryMock) ListInvitationsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListInvitationsByUserID.afterListInvitationsByUserIDCounter)
+}
+
+// ListInvitationsByUserIDBeforeCounter returns a count of InvitationRepositoryMock.ListInvitationsByUserID invocations
+func (mmListInvitationsByUserID *InvitationRepositoryMock) ListInvitationsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListInvitationsByUserID.beforeListInvitationsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.ListInvitationsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListInvitationsByUserID *mInvitationRepositoryMockListInvitationsByUserID) Calls() []*InvitationRepositoryMockListInvitationsByUserIDParams {
+	mmListInvitationsByUserID.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockListInvitationsByUserIDParams, len(mmListInvitationsByUserID.callArgs))
+	copy(argCopy, mmListInvitationsByUserID.callArgs)
+
+	mmListInvitationsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListInvitationsByUserIDDone returns true if the count of the ListInvitationsByUserID invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockListInvitationsByUserIDDone() bool {
+	if m.ListInvitationsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListInvitationsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListInvitationsByUserIDMock.invocationsDone()
+}
+
+// MinimockListInvitationsByUserIDInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockListInvitationsByUserIDInspect() {
+	for _, e := range m.ListInvitationsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.ListInvitationsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListInvitationsByUserIDCounter := mm_atomic.LoadUint64(&m.afterListInvitationsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListInvitationsByUserIDMock.defaultExpectation != nil && afterListInvitationsByUserIDCounter < 1 {
+		if m.ListInvitationsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.ListInvitationsByUserID at\n%s", m.ListInvitationsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.ListInvitationsByUserID at\n%s with params: %#v", m.ListInvitationsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.ListInvitationsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListInvitationsByUserID != nil && afterListInvitationsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.ListInvitationsByUserID at\n%s", m.funcListInvitationsByUserIDOrigin)
+	}
+
+	if !m.ListInvitationsByUserIDMock.invocationsDone() && afterListInvitationsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.ListInvitationsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListInvitationsByUserIDMock.expectedInvocations), m.ListInvitationsByUserIDMock.expectedInvocationsOrigin, afterListInvitationsByUserIDCounter)
+	}
+}
+
+type mInvitationRepositoryMockUpdateInvitationStatus struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockUpdateInvitationStatusExpectation
+	expectations       []*InvitationRepositoryMockUpdateInvitationStatusExpectation
+
+	callArgs []*InvitationRepositoryMockUpdateInvitationStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockUpdateInvitationStatusExpectation specifies expectation struct of the InvitationRepository.UpdateInvitationStatus
+type InvitationRepositoryMockUpdateInvitationStatusExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockUpdateInvitationStatusParams
+	paramPtrs          *InvitationRepositoryMockUpdateInvitationStatusParamPtrs
+	expectationOrigins InvitationRepositoryMockUpdateInvitationStatusExpectationOrigins
+	results            *InvitationRepositoryMockUpdateInvitationStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockUpdateInvitationStatusParams contains parameters of the InvitationRepository.UpdateInvitationStatus
+type InvitationRepositoryMockUpdateInvitationStatusParams struct {
+	ctx    context.Context
+	id     int64
+	status string
+}
+
+// InvitationRepositoryMockUpdateInvitationStatusParamPtrs contains pointers to parameters of the InvitationRepository.UpdateInvitationStatus
+type InvitationRepositoryMockUpdateInvitationStatusParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	status *string
+}
+
+// InvitationRepositoryMockUpdateInvitationStatusResults contains results of the InvitationRepository.UpdateInvitationStatus
+type InvitationRepositoryMockUpdateInvitationStatusResults struct {
+	err error
+}
+
+// InvitationRepositoryMockUpdateInvitationStatusOrigins contains origins of expectations of the InvitationRepository.UpdateInvitationStatus
+type InvitationRepositoryMockUpdateInvitationStatusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originStatus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Optional() *mInvitationRepositoryMockUpdateInvitationStatus {
+	mmUpdateInvitationStatus.optional = true
+	return mmUpdateInvitationStatus
+}
+
+// Expect sets up expected params for InvitationRepository.UpdateInvitationStatus
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Expect(ctx context.Context, id int64, status string) *mInvitationRepositoryMockUpdateInvitationStatus {
+	if mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Set")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation == nil {
+		mmUpdateInvitationStatus.defaultExpectation = &InvitationRepositoryMockUpdateInvitationStatusExpectation{}
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.paramPtrs != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateInvitationStatus.defaultExpectation.params = &InvitationRepositoryMockUpdateInvitationStatusParams{ctx, id, status}
+	mmUpdateInvitationStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateInvitationStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateInvitationStatus.defaultExpectation.params) {
+			mmUpdateInvitationStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateInvitationStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateInvitationStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.UpdateInvitationStatus
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockUpdateInvitationStatus {
+	if mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Set")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation == nil {
+		mmUpdateInvitationStatus.defaultExpectation = &InvitationRepositoryMockUpdateInvitationStatusExpectation{}
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.params != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationStatus.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationStatusParamPtrs{}
+	}
+	mmUpdateInvitationStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateInvitationStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationStatus
+}
+
+// ExpectIdParam2 sets up expected param id for InvitationRepository.UpdateInvitationStatus
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) ExpectIdParam2(id int64) *mInvitationRepositoryMockUpdateInvitationStatus {
+	if mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Set")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation == nil {
+		mmUpdateInvitationStatus.defaultExpectation = &InvitationRepositoryMockUpdateInvitationStatusExpectation{}
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.params != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationStatus.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationStatusParamPtrs{}
+	}
+	mmUpdateInvitationStatus.defaultExpectation.paramPtrs.id = &id
+	mmUpdateInvitationStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationStatus
+}
+
+// ExpectStatusParam3 sets up expected param status for InvitationRepository.UpdateInvitationStatus
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) ExpectStatusParam3(status string) *mInvitationRepositoryMockUpdateInvitationStatus {
+	if mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Set")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation == nil {
+		mmUpdateInvitationStatus.defaultExpectation = &InvitationRepositoryMockUpdateInvitationStatusExpectation{}
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.params != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationStatus.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationStatusParamPtrs{}
+	}
+	mmUpdateInvitationStatus.defaultExpectation.paramPtrs.status = &status
+	mmUpdateInvitationStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.UpdateInvitationStatus
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Inspect(f func(ctx context.Context, id int64, status string)) *mInvitationRepositoryMockUpdateInvitationStatus {
+	if mmUpdateInvitationStatus.mock.inspectFuncUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.UpdateInvitationStatus")
+	}
+
+	mmUpdateInvitationStatus.mock.inspectFuncUpdateInvitationStatus = f
+
+	return mmUpdateInvitationStatus
+}
+
+// Return sets up results that will be returned by InvitationRepository.UpdateInvitationStatus
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Return(err error) *InvitationRepositoryMock {
+	if mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Set")
+	}
+
+	if mmUpdateInvitationStatus.defaultExpectation == nil {
+		mmUpdateInvitationStatus.defaultExpectation = &InvitationRepositoryMockUpdateInvitationStatusExpectation{mock: mmUpdateInvitationStatus.mock}
+	}
+	mmUpdateInvitationStatus.defaultExpectation.results = &InvitationRepositoryMockUpdateInvitationStatusResults{err}
+	mmUpdateInvitationStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateInvitationStatus.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.UpdateInvitationStatus method
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Set(f func(ctx context.Context, id int64, status string) (err error)) *InvitationRepositoryMock {
+	if mmUpdateInvitationStatus.defaultExpectation != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.UpdateInvitationStatus method")
+	}
+
+	if len(mmUpdateInvitationStatus.expectations) > 0 {
+		mmUpdateInvitationStatus.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.UpdateInvitationStatus method")
+	}
+
+	mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus = f
+	mmUpdateInvitationStatus.mock.funcUpdateInvitationStatusOrigin = minimock.CallerInfo(1)
+	return mmUpdateInvitationStatus.mock
+}
+
+// When sets expectation for the InvitationRepository.UpdateInvitationStatus which will trigger the result defined by the following
+// Then helper
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) When(ctx context.Context, id int64, status string) *InvitationRepositoryMockUpdateInvitationStatusExpectation {
+	if mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationStatus mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockUpdateInvitationStatusExpectation{
+		mock:               mmUpdateInvitationStatus.mock,
+		params:             &InvitationRepositoryMockUpdateInvitationStatusParams{ctx, id, status},
+		expectationOrigins: InvitationRepositoryMockUpdateInvitationStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateInvitationStatus.expectations = append(mmUpdateInvitationStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.UpdateInvitationStatus return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockUpdateInvitationStatusExpectation) Then(err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockUpdateInvitationStatusResults{err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.UpdateInvitationStatus should be invoked
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Times(n uint64) *mInvitationRepositoryMockUpdateInvitationStatus {
+	if n == 0 {
+		mmUpdateInvitationStatus.mock.t.Fatalf("Times of InvitationRepositoryMock.UpdateInvitationStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateInvitationStatus.expectedInvocations, n)
+	mmUpdateInvitationStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateInvitationStatus
+}
+
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) invocationsDone() bool {
+	if len(mmUpdateInvitationStatus.expectations) == 0 && mmUpdateInvitationStatus.defaultExpectation == nil && mmUpdateInvitationStatus.mock.funcUpdateInvitationStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateInvitationStatus.mock.afterUpdateInvitationStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateInvitationStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateInvitationStatus implements mm_repository.InvitationRepository
+func (mmUpdateInvitationStatus *InvitationRepositoryMock) UpdateInvitationStatus(ctx context.Context, id int64, status string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateInvitationStatus.beforeUpdateInvitationStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateInvitationStatus.afterUpdateInvitationStatusCounter, 1)
+
+	mmUpdateInvitationStatus.t.Helper()
+
+	if mmUpdateInvitationStatus.inspectFuncUpdateInvitationStatus != nil {
+		mmUpdateInvitationStatus.inspectFuncUpdateInvitationStatus(ctx, id, status)
+	}
+
+	mm_params := InvitationRepositoryMockUpdateInvitationStatusParams{ctx, id, status}
+
+	// Record call args
+	mmUpdateInvitationStatus.UpdateInvitationStatusMock.mutex.Lock()
+	mmUpdateInvitationStatus.UpdateInvitationStatusMock.callArgs = append(mmUpdateInvitationStatus.UpdateInvitationStatusMock.callArgs, &mm_params)
+	mmUpdateInvitationStatus.UpdateInvitationStatusMock.mutex.Unlock()
+
+	for _, e := range mmUpdateInvitationStatus.UpdateInvitationStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockUpdateInvitationStatusParams{ctx, id, status}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateInvitationStatus.t.Errorf("InvitationRepositoryMock.UpdateInvitationStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateInvitationStatus.t.Errorf("InvitationRepositoryMock.UpdateInvitationStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmUpdateInvitationStatus.t.Errorf("InvitationRepositoryMock.UpdateInvitationStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateInvitationStatus.t.Errorf("InvitationRepositoryMock.UpdateInvitationStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateInvitationStatus.UpdateInvitationStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateInvitationStatus.t.Fatal("No results are set for the InvitationRepositoryMock.UpdateInvitationStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateInvitationStatus.funcUpdateInvitationStatus != nil {
+		return mmUpdateInvitationStatus.funcUpdateInvitationStatus(ctx, id, status)
+	}
+	mmUpdateInvitationStatus.t.Fatalf("Unexpected call to InvitationRepositoryMock.UpdateInvitationStatus. %v %v %v", ctx, id, status)
+	return
+}
+
+// UpdateInvitationStatusAfterCounter returns a count of finished InvitationRepositoryMock.UpdateInvitationStatus invocations
+func (mmUpdateInvitationStatus *InvitationRepositoryMock) UpdateInvitationStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateInvitationStatus.afterUpdateInvitationStatusCounter)
+}
+
+// UpdateInvitationStatusBeforeCounter returns a count of InvitationRepositoryMock.UpdateInvitationStatus invocations
+func (mmUpdateInvitationStatus *InvitationRepositoryMock) UpdateInvitationStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateInvitationStatus.beforeUpdateInvitationStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.UpdateInvitationStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateInvitationStatus *mInvitationRepositoryMockUpdateInvitationStatus) Calls() []*InvitationRepositoryMockUpdateInvitationStatusParams {
+	mmUpdateInvitationStatus.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockUpdateInvitationStatusParams, len(mmUpdateInvitationStatus.callArgs))
+	copy(argCopy, mmUpdateInvitationStatus.callArgs)
+
+	mmUpdateInvitationStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateInvitationStatusDone returns true if the count of the UpdateInvitationStatus invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockUpdateInvitationStatusDone() bool {
+	if m.UpdateInvitationStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateInvitationStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateInvitationStatusMock.invocationsDone()
+}
+
+// MinimockUpdateInvitationStatusInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockUpdateInvitationStatusInspect() {
+	for _, e := range m.UpdateInvitationStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateInvitationStatusCounter := mm_atomic.LoadUint64(&m.afterUpdateInvitationStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateInvitationStatusMock.defaultExpectation != nil && afterUpdateInvitationStatusCounter < 1 {
+		if m.UpdateInvitationStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationStatus at\n%s", m.UpdateInvitationStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationStatus at\n%s with params: %#v", m.UpdateInvitationStatusMock.defaultExpectation.expectationOrigins.origin, *m.UpdateInvitationStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateInvitationStatus != nil && afterUpdateInvitationStatusCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationStatus at\n%s", m.funcUpdateInvitationStatusOrigin)
+	}
+
+	if !m.UpdateInvitationStatusMock.invocationsDone() && afterUpdateInvitationStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.UpdateInvitationStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateInvitationStatusMock.expectedInvocations), m.UpdateInvitationStatusMock.expectedInvocationsOrigin, afterUpdateInvitationStatusCounter)
+	}
+}
+
+type mInvitationRepositoryMockUpdateInvitationToken struct {
+	optional           bool
+	mock               *InvitationRepositoryMock
+	defaultExpectation *InvitationRepositoryMockUpdateInvitationTokenExpectation
+	expectations       []*InvitationRepositoryMockUpdateInvitationTokenExpectation
+
+	callArgs []*InvitationRepositoryMockUpdateInvitationTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationRepositoryMockUpdateInvitationTokenExpectation specifies expectation struct of the InvitationRepository.UpdateInvitationToken
+type InvitationRepositoryMockUpdateInvitationTokenExpectation struct {
+	mock               *InvitationRepositoryMock
+	params             *InvitationRepositoryMockUpdateInvitationTokenParams
+	paramPtrs          *InvitationRepositoryMockUpdateInvitationTokenParamPtrs
+	expectationOrigins InvitationRepositoryMockUpdateInvitationTokenExpectationOrigins
+	results            *InvitationRepositoryMockUpdateInvitationTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationRepositoryMockUpdateInvitationTokenParams contains parameters of the InvitationRepository.UpdateInvitationToken
+type InvitationRepositoryMockUpdateInvitationTokenParams struct {
+	ctx       context.Context
+	id        int64
+	tokenID   string
+	expiresAt time.Time
+}
+
+// InvitationRepositoryMockUpdateInvitationTokenParamPtrs contains pointers to parameters of the InvitationRepository.UpdateInvitationToken
+type InvitationRepositoryMockUpdateInvitationTokenParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	tokenID   *string
+	expiresAt *time.Time
+}
+
+// InvitationRepositoryMockUpdateInvitationTokenResults contains results of the InvitationRepository.UpdateInvitationToken
+type InvitationRepositoryMockUpdateInvitationTokenResults struct {
+	err error
+}
+
+// InvitationRepositoryMockUpdateInvitationTokenOrigins contains origins of expectations of the InvitationRepository.UpdateInvitationToken
+type InvitationRepositoryMockUpdateInvitationTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originTokenID   string
+	originExpiresAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Optional() *mInvitationRepositoryMockUpdateInvitationToken {
+	mmUpdateInvitationToken.optional = true
+	return mmUpdateInvitationToken
+}
+
+// Expect sets up expected params for InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Expect(ctx context.Context, id int64, tokenID string, expiresAt time.Time) *mInvitationRepositoryMockUpdateInvitationToken {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation == nil {
+		mmUpdateInvitationToken.defaultExpectation = &InvitationRepositoryMockUpdateInvitationTokenExpectation{}
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.paramPtrs != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateInvitationToken.defaultExpectation.params = &InvitationRepositoryMockUpdateInvitationTokenParams{ctx, id, tokenID, expiresAt}
+	mmUpdateInvitationToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateInvitationToken.expectations {
+		if minimock.Equal(e.params, mmUpdateInvitationToken.defaultExpectation.params) {
+			mmUpdateInvitationToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateInvitationToken.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateInvitationToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) ExpectCtxParam1(ctx context.Context) *mInvitationRepositoryMockUpdateInvitationToken {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation == nil {
+		mmUpdateInvitationToken.defaultExpectation = &InvitationRepositoryMockUpdateInvitationTokenExpectation{}
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.params != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationToken.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationTokenParamPtrs{}
+	}
+	mmUpdateInvitationToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateInvitationToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationToken
+}
+
+// ExpectIdParam2 sets up expected param id for InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) ExpectIdParam2(id int64) *mInvitationRepositoryMockUpdateInvitationToken {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation == nil {
+		mmUpdateInvitationToken.defaultExpectation = &InvitationRepositoryMockUpdateInvitationTokenExpectation{}
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.params != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationToken.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationTokenParamPtrs{}
+	}
+	mmUpdateInvitationToken.defaultExpectation.paramPtrs.id = &id
+	mmUpdateInvitationToken.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationToken
+}
+
+// ExpectTokenIDParam3 sets up expected param tokenID for InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) ExpectTokenIDParam3(tokenID string) *mInvitationRepositoryMockUpdateInvitationToken {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation == nil {
+		mmUpdateInvitationToken.defaultExpectation = &InvitationRepositoryMockUpdateInvitationTokenExpectation{}
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.params != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationToken.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationTokenParamPtrs{}
+	}
+	mmUpdateInvitationToken.defaultExpectation.paramPtrs.tokenID = &tokenID
+	mmUpdateInvitationToken.defaultExpectation.expectationOrigins.originTokenID = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationToken
+}
+
+// ExpectExpiresAtParam4 sets up expected param expiresAt for InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) ExpectExpiresAtParam4(expiresAt time.Time) *mInvitationRepositoryMockUpdateInvitationToken {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation == nil {
+		mmUpdateInvitationToken.defaultExpectation = &InvitationRepositoryMockUpdateInvitationTokenExpectation{}
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.params != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Expect")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation.paramPtrs == nil {
+		mmUpdateInvitationToken.defaultExpectation.paramPtrs = &InvitationRepositoryMockUpdateInvitationTokenParamPtrs{}
+	}
+	mmUpdateInvitationToken.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmUpdateInvitationToken.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmUpdateInvitationToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Inspect(f func(ctx context.Context, id int64, tokenID string, expiresAt time.Time)) *mInvitationRepositoryMockUpdateInvitationToken {
+	if mmUpdateInvitationToken.mock.inspectFuncUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("Inspect function is already set for InvitationRepositoryMock.UpdateInvitationToken")
+	}
+
+	mmUpdateInvitationToken.mock.inspectFuncUpdateInvitationToken = f
+
+	return mmUpdateInvitationToken
+}
+
+// Return sets up results that will be returned by InvitationRepository.UpdateInvitationToken
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Return(err error) *InvitationRepositoryMock {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	if mmUpdateInvitationToken.defaultExpectation == nil {
+		mmUpdateInvitationToken.defaultExpectation = &InvitationRepositoryMockUpdateInvitationTokenExpectation{mock: mmUpdateInvitationToken.mock}
+	}
+	mmUpdateInvitationToken.defaultExpectation.results = &InvitationRepositoryMockUpdateInvitationTokenResults{err}
+	mmUpdateInvitationToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateInvitationToken.mock
+}
+
+// Set uses given function f to mock the InvitationRepository.UpdateInvitationToken method
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Set(f func(ctx context.Context, id int64, tokenID string, expiresAt time.Time) (err error)) *InvitationRepositoryMock {
+	if mmUpdateInvitationToken.defaultExpectation != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("Default expectation is already set for the InvitationRepository.UpdateInvitationToken method")
+	}
+
+	if len(mmUpdateInvitationToken.expectations) > 0 {
+		mmUpdateInvitationToken.mock.t.Fatalf("Some expectations are already set for the InvitationRepository.UpdateInvitationToken method")
+	}
+
+	mmUpdateInvitationToken.mock.funcUpdateInvitationToken = f
+	mmUpdateInvitationToken.mock.funcUpdateInvitationTokenOrigin = minimock.CallerInfo(1)
+	return mmUpdateInvitationToken.mock
+}
+
+// When sets expectation for the InvitationRepository.UpdateInvitationToken which will trigger the result defined by the following
+// Then helper
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) When(ctx context.Context, id int64, tokenID string, expiresAt time.Time) *InvitationRepositoryMockUpdateInvitationTokenExpectation {
+	if mmUpdateInvitationToken.mock.funcUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.mock.t.Fatalf("InvitationRepositoryMock.UpdateInvitationToken mock is already set by Set")
+	}
+
+	expectation := &InvitationRepositoryMockUpdateInvitationTokenExpectation{
+		mock:               mmUpdateInvitationToken.mock,
+		params:             &InvitationRepositoryMockUpdateInvitationTokenParams{ctx, id, tokenID, expiresAt},
+		expectationOrigins: InvitationRepositoryMockUpdateInvitationTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateInvitationToken.expectations = append(mmUpdateInvitationToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationRepository.UpdateInvitationToken return parameters for the expectation previously defined by the When method
+func (e *InvitationRepositoryMockUpdateInvitationTokenExpectation) Then(err error) *InvitationRepositoryMock {
+	e.results = &InvitationRepositoryMockUpdateInvitationTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times InvitationRepository.UpdateInvitationToken should be invoked
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Times(n uint64) *mInvitationRepositoryMockUpdateInvitationToken {
+	if n == 0 {
+		mmUpdateInvitationToken.mock.t.Fatalf("Times of InvitationRepositoryMock.UpdateInvitationToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateInvitationToken.expectedInvocations, n)
+	mmUpdateInvitationToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateInvitationToken
+}
+
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) invocationsDone() bool {
+	if len(mmUpdateInvitationToken.expectations) == 0 && mmUpdateInvitationToken.defaultExpectation == nil && mmUpdateInvitationToken.mock.funcUpdateInvitationToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateInvitationToken.mock.afterUpdateInvitationTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateInvitationToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateInvitationToken implements mm_repository.InvitationRepository
+func (mmUpdateInvitationToken *InvitationRepositoryMock) UpdateInvitationToken(ctx context.Context, id int64, tokenID string, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmUpdateInvitationToken.beforeUpdateInvitationTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateInvitationToken.afterUpdateInvitationTokenCounter, 1)
+
+	mmUpdateInvitationToken.t.Helper()
+
+	if mmUpdateInvitationToken.inspectFuncUpdateInvitationToken != nil {
+		mmUpdateInvitationToken.inspectFuncUpdateInvitationToken(ctx, id, tokenID, expiresAt)
+	}
+
+	mm_params := InvitationRepositoryMockUpdateInvitationTokenParams{ctx, id, tokenID, expiresAt}
+
+	// Record call args
+	mmUpdateInvitationToken.UpdateInvitationTokenMock.mutex.Lock()
+	mmUpdateInvitationToken.UpdateInvitationTokenMock.callArgs = append(mmUpdateInvitationToken.UpdateInvitationTokenMock.callArgs, &mm_params)
+	mmUpdateInvitationToken.UpdateInvitationTokenMock.mutex.Unlock()
+
+	for _, e := range mmUpdateInvitationToken.UpdateInvitationTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationRepositoryMockUpdateInvitationTokenParams{ctx, id, tokenID, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateInvitationToken.t.Errorf("InvitationRepositoryMock.UpdateInvitationToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateInvitationToken.t.Errorf("InvitationRepositoryMock.UpdateInvitationToken got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmUpdateInvitationToken.t.Errorf("InvitationRepositoryMock.UpdateInvitationToken got unexpected parameter tokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.expectationOrigins.originTokenID, *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmUpdateInvitationToken.t.Errorf("InvitationRepositoryMock.UpdateInvitationToken got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateInvitationToken.t.Errorf("InvitationRepositoryMock.UpdateInvitationToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateInvitationToken.UpdateInvitationTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateInvitationToken.t.Fatal("No results are set for the InvitationRepositoryMock.UpdateInvitationToken")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateInvitationToken.funcUpdateInvitationToken != nil {
+		return mmUpdateInvitationToken.funcUpdateInvitationToken(ctx, id, tokenID, expiresAt)
+	}
+	mmUpdateInvitationToken.t.Fatalf("Unexpected call to InvitationRepositoryMock.UpdateInvitationToken. %v %v %v %v", ctx, id, tokenID, expiresAt)
+	return
+}
+
+// UpdateInvitationTokenAfterCounter returns a count of finished InvitationRepositoryMock.UpdateInvitationToken invocations
+func (mmUpdateInvitationToken *InvitationRepositoryMock) UpdateInvitationTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateInvitationToken.afterUpdateInvitationTokenCounter)
+}
+
+// UpdateInvitationTokenBeforeCounter returns a count of InvitationRepositoryMock.UpdateInvitationToken invocations
+func (mmUpdateInvitationToken *InvitationRepositoryMock) UpdateInvitationTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateInvitationToken.beforeUpdateInvitationTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationRepositoryMock.UpdateInvitationToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateInvitationToken *mInvitationRepositoryMockUpdateInvitationToken) Calls() []*InvitationRepositoryMockUpdateInvitationTokenParams {
+	mmUpdateInvitationToken.mutex.RLock()
+
+	argCopy := make([]*InvitationRepositoryMockUpdateInvitationTokenParams, len(mmUpdateInvitationToken.callArgs))
+	copy(argCopy, mmUpdateInvitationToken.callArgs)
+
+	mmUpdateInvitationToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateInvitationTokenDone returns true if the count of the UpdateInvitationToken invocations corresponds
+// the number of defined expectations
+func (m *InvitationRepositoryMock) MinimockUpdateInvitationTokenDone() bool {
+	if m.UpdateInvitationTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateInvitationTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateInvitationTokenMock.invocationsDone()
+}
+
+// MinimockUpdateInvitationTokenInspect logs each unmet expectation
+func (m *InvitationRepositoryMock) MinimockUpdateInvitationTokenInspect() {
+	for _, e := range m.UpdateInvitationTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateInvitationTokenCounter := mm_atomic.LoadUint64(&m.afterUpdateInvitationTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateInvitationTokenMock.defaultExpectation != nil && afterUpdateInvitationTokenCounter < 1 {
+		if m.UpdateInvitationTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationToken at\n%s", m.UpdateInvitationTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationToken at\n%s with params: %#v", m.UpdateInvitationTokenMock.defaultExpectation.expectationOrigins.origin, *m.UpdateInvitationTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateInvitationToken != nil && afterUpdateInvitationTokenCounter < 1 {
+		m.t.Errorf("Expected call to InvitationRepositoryMock.UpdateInvitationToken at\n%s", m.funcUpdateInvitationTokenOrigin)
+	}
+
+	if !m.UpdateInvitationTokenMock.invocationsDone() && afterUpdateInvitationTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationRepositoryMock.UpdateInvitationToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateInvitationTokenMock.expectedInvocations), m.UpdateInvitationTokenMock.expectedInvocationsOrigin, afterUpdateInvitationTokenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *InvitationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInvitationInspect()
+
+			m.MinimockDeleteInvitationsByUserIDInspect()
+
+			m.MinimockGetInvitationInspect()
+
+			m.MinimockGetInvitationByTokenIDInspect()
+
+			m.MinimockListInvitationsByUserIDInspect()
+
+			m.MinimockUpdateInvitationStatusInspect()
+
+			m.MinimockUpdateInvitationTokenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *InvitationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *InvitationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateInvitationDone() &&
+		m.MinimockDeleteInvitationsByUserIDDone() &&
+		m.MinimockGetInvitationDone() &&
+		m.MinimockGetInvitationByTokenIDDone() &&
+		m.MinimockListInvitationsByUserIDDone() &&
+		m.MinimockUpdateInvitationStatusDone() &&
+		m.MinimockUpdateInvitationTokenDone()
+}
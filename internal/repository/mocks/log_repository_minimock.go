@@ -25,6 +25,20 @@ type LogRepositoryMock struct {
 	afterCreateRecordCounter  uint64
 	beforeCreateRecordCounter uint64
 	CreateRecordMock          mLogRepositoryMockCreateRecord
+
+	funcListRecords          func(ctx context.Context, userID int64) (rpa1 []*model.Record, err error)
+	funcListRecordsOrigin    string
+	inspectFuncListRecords   func(ctx context.Context, userID int64)
+	afterListRecordsCounter  uint64
+	beforeListRecordsCounter uint64
+	ListRecordsMock          mLogRepositoryMockListRecords
+
+	funcPseudonymizeRecords          func(ctx context.Context, userID int64, pseudonym string) (err error)
+	funcPseudonymizeRecordsOrigin    string
+	inspectFuncPseudonymizeRecords   func(ctx context.Context, userID int64, pseudonym string)
+	afterPseudonymizeRecordsCounter  uint64
+	beforePseudonymizeRecordsCounter uint64
+	PseudonymizeRecordsMock          mLogRepositoryMockPseudonymizeRecords
 }
 
 // NewLogRepositoryMock returns a mock for mm_repository.LogRepository
@@ -38,6 +52,12 @@ func NewLogRepositoryMock(t minimock.Tester) *LogRepositoryMock {
 	m.CreateRecordMock = mLogRepositoryMockCreateRecord{mock: m}
 	m.CreateRecordMock.callArgs = []*LogRepositoryMockCreateRecordParams{}
 
+	m.ListRecordsMock = mLogRepositoryMockListRecords{mock: m}
+	m.ListRecordsMock.callArgs = []*LogRepositoryMockListRecordsParams{}
+
+	m.PseudonymizeRecordsMock = mLogRepositoryMockPseudonymizeRecords{mock: m}
+	m.PseudonymizeRecordsMock.callArgs = []*LogRepositoryMockPseudonymizeRecordsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -386,11 +406,731 @@ func (m *LogRepositoryMock) MinimockCreateRecordInspect() {
 	}
 }
 
+type mLogRepositoryMockListRecords struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListRecordsExpectation
+	expectations       []*LogRepositoryMockListRecordsExpectation
+
+	callArgs []*LogRepositoryMockListRecordsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockListRecordsExpectation specifies expectation struct of the LogRepository.ListRecords
+type LogRepositoryMockListRecordsExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockListRecordsParams
+	paramPtrs          *LogRepositoryMockListRecordsParamPtrs
+	expectationOrigins LogRepositoryMockListRecordsExpectationOrigins
+	results            *LogRepositoryMockListRecordsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockListRecordsParams contains parameters of the LogRepository.ListRecords
+type LogRepositoryMockListRecordsParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// LogRepositoryMockListRecordsParamPtrs contains pointers to parameters of the LogRepository.ListRecords
+type LogRepositoryMockListRecordsParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// LogRepositoryMockListRecordsResults contains results of the LogRepository.ListRecords
+type LogRepositoryMockListRecordsResults struct {
+	rpa1 []*model.Record
+	err  error
+}
+
+// LogRepositoryMockListRecordsOrigins contains origins of expectations of the LogRepository.ListRecords
+type LogRepositoryMockListRecordsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRecords *mLogRepositoryMockListRecords) Optional() *mLogRepositoryMockListRecords {
+	mmListRecords.optional = true
+	return mmListRecords
+}
+
+// Expect sets up expected params for LogRepository.ListRecords
+func (mmListRecords *mLogRepositoryMockListRecords) Expect(ctx context.Context, userID int64) *mLogRepositoryMockListRecords {
+	if mmListRecords.mock.funcListRecords != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Set")
+	}
+
+	if mmListRecords.defaultExpectation == nil {
+		mmListRecords.defaultExpectation = &LogRepositoryMockListRecordsExpectation{}
+	}
+
+	if mmListRecords.defaultExpectation.paramPtrs != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by ExpectParams functions")
+	}
+
+	mmListRecords.defaultExpectation.params = &LogRepositoryMockListRecordsParams{ctx, userID}
+	mmListRecords.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRecords.expectations {
+		if minimock.Equal(e.params, mmListRecords.defaultExpectation.params) {
+			mmListRecords.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRecords.defaultExpectation.params)
+		}
+	}
+
+	return mmListRecords
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListRecords
+func (mmListRecords *mLogRepositoryMockListRecords) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListRecords {
+	if mmListRecords.mock.funcListRecords != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Set")
+	}
+
+	if mmListRecords.defaultExpectation == nil {
+		mmListRecords.defaultExpectation = &LogRepositoryMockListRecordsExpectation{}
+	}
+
+	if mmListRecords.defaultExpectation.params != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Expect")
+	}
+
+	if mmListRecords.defaultExpectation.paramPtrs == nil {
+		mmListRecords.defaultExpectation.paramPtrs = &LogRepositoryMockListRecordsParamPtrs{}
+	}
+	mmListRecords.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRecords.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRecords
+}
+
+// ExpectUserIDParam2 sets up expected param userID for LogRepository.ListRecords
+func (mmListRecords *mLogRepositoryMockListRecords) ExpectUserIDParam2(userID int64) *mLogRepositoryMockListRecords {
+	if mmListRecords.mock.funcListRecords != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Set")
+	}
+
+	if mmListRecords.defaultExpectation == nil {
+		mmListRecords.defaultExpectation = &LogRepositoryMockListRecordsExpectation{}
+	}
+
+	if mmListRecords.defaultExpectation.params != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Expect")
+	}
+
+	if mmListRecords.defaultExpectation.paramPtrs == nil {
+		mmListRecords.defaultExpectation.paramPtrs = &LogRepositoryMockListRecordsParamPtrs{}
+	}
+	mmListRecords.defaultExpectation.paramPtrs.userID = &userID
+	mmListRecords.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListRecords
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListRecords
+func (mmListRecords *mLogRepositoryMockListRecords) Inspect(f func(ctx context.Context, userID int64)) *mLogRepositoryMockListRecords {
+	if mmListRecords.mock.inspectFuncListRecords != nil {
+		mmListRecords.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListRecords")
+	}
+
+	mmListRecords.mock.inspectFuncListRecords = f
+
+	return mmListRecords
+}
+
+// Return sets up results that will be returned by LogRepository.ListRecords
+func (mmListRecords *mLogRepositoryMockListRecords) Return(rpa1 []*model.Record, err error) *LogRepositoryMock {
+	if mmListRecords.mock.funcListRecords != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Set")
+	}
+
+	if mmListRecords.defaultExpectation == nil {
+		mmListRecords.defaultExpectation = &LogRepositoryMockListRecordsExpectation{mock: mmListRecords.mock}
+	}
+	mmListRecords.defaultExpectation.results = &LogRepositoryMockListRecordsResults{rpa1, err}
+	mmListRecords.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRecords.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListRecords method
+func (mmListRecords *mLogRepositoryMockListRecords) Set(f func(ctx context.Context, userID int64) (rpa1 []*model.Record, err error)) *LogRepositoryMock {
+	if mmListRecords.defaultExpectation != nil {
+		mmListRecords.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListRecords method")
+	}
+
+	if len(mmListRecords.expectations) > 0 {
+		mmListRecords.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListRecords method")
+	}
+
+	mmListRecords.mock.funcListRecords = f
+	mmListRecords.mock.funcListRecordsOrigin = minimock.CallerInfo(1)
+	return mmListRecords.mock
+}
+
+// When sets expectation for the LogRepository.ListRecords which will trigger the result defined by the following
+// Then helper
+func (mmListRecords *mLogRepositoryMockListRecords) When(ctx context.Context, userID int64) *LogRepositoryMockListRecordsExpectation {
+	if mmListRecords.mock.funcListRecords != nil {
+		mmListRecords.mock.t.Fatalf("LogRepositoryMock.ListRecords mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListRecordsExpectation{
+		mock:               mmListRecords.mock,
+		params:             &LogRepositoryMockListRecordsParams{ctx, userID},
+		expectationOrigins: LogRepositoryMockListRecordsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRecords.expectations = append(mmListRecords.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListRecords return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListRecordsExpectation) Then(rpa1 []*model.Record, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListRecordsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListRecords should be invoked
+func (mmListRecords *mLogRepositoryMockListRecords) Times(n uint64) *mLogRepositoryMockListRecords {
+	if n == 0 {
+		mmListRecords.mock.t.Fatalf("Times of LogRepositoryMock.ListRecords mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRecords.expectedInvocations, n)
+	mmListRecords.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRecords
+}
+
+func (mmListRecords *mLogRepositoryMockListRecords) invocationsDone() bool {
+	if len(mmListRecords.expectations) == 0 && mmListRecords.defaultExpectation == nil && mmListRecords.mock.funcListRecords == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRecords.mock.afterListRecordsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRecords.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRecords implements mm_repository.LogRepository
+func (mmListRecords *LogRepositoryMock) ListRecords(ctx context.Context, userID int64) (rpa1 []*model.Record, err error) {
+	mm_atomic.AddUint64(&mmListRecords.beforeListRecordsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRecords.afterListRecordsCounter, 1)
+
+	mmListRecords.t.Helper()
+
+	if mmListRecords.inspectFuncListRecords != nil {
+		mmListRecords.inspectFuncListRecords(ctx, userID)
+	}
+
+	mm_params := LogRepositoryMockListRecordsParams{ctx, userID}
+
+	// Record call args
+	mmListRecords.ListRecordsMock.mutex.Lock()
+	mmListRecords.ListRecordsMock.callArgs = append(mmListRecords.ListRecordsMock.callArgs, &mm_params)
+	mmListRecords.ListRecordsMock.mutex.Unlock()
+
+	for _, e := range mmListRecords.ListRecordsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListRecords.ListRecordsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRecords.ListRecordsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRecords.ListRecordsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRecords.ListRecordsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListRecordsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRecords.t.Errorf("LogRepositoryMock.ListRecords got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRecords.ListRecordsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListRecords.t.Errorf("LogRepositoryMock.ListRecords got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRecords.ListRecordsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRecords.t.Errorf("LogRepositoryMock.ListRecords got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRecords.ListRecordsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRecords.ListRecordsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRecords.t.Fatal("No results are set for the LogRepositoryMock.ListRecords")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListRecords.funcListRecords != nil {
+		return mmListRecords.funcListRecords(ctx, userID)
+	}
+	mmListRecords.t.Fatalf("Unexpected call to LogRepositoryMock.ListRecords. %v %v", ctx, userID)
+	return
+}
+
+// ListRecordsAfterCounter returns a count of finished LogRepositoryMock.ListRecords invocations
+func (mmListRecords *LogRepositoryMock) ListRecordsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRecords.afterListRecordsCounter)
+}
+
+// ListRecordsBeforeCounter returns a count of LogRepositoryMock.ListRecords invocations
+func (mmListRecords *LogRepositoryMock) ListRecordsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRecords.beforeListRecordsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListRecords.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRecords *mLogRepositoryMockListRecords) Calls() []*LogRepositoryMockListRecordsParams {
+	mmListRecords.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListRecordsParams, len(mmListRecords.callArgs))
+	copy(argCopy, mmListRecords.callArgs)
+
+	mmListRecords.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRecordsDone returns true if the count of the ListRecords invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListRecordsDone() bool {
+	if m.ListRecordsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRecordsMock.invocationsDone()
+}
+
+// MinimockListRecordsInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListRecordsInspect() {
+	for _, e := range m.ListRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListRecords at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRecordsCounter := mm_atomic.LoadUint64(&m.afterListRecordsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRecordsMock.defaultExpectation != nil && afterListRecordsCounter < 1 {
+		if m.ListRecordsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListRecords at\n%s", m.ListRecordsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListRecords at\n%s with params: %#v", m.ListRecordsMock.defaultExpectation.expectationOrigins.origin, *m.ListRecordsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRecords != nil && afterListRecordsCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.ListRecords at\n%s", m.funcListRecordsOrigin)
+	}
+
+	if !m.ListRecordsMock.invocationsDone() && afterListRecordsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListRecords at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRecordsMock.expectedInvocations), m.ListRecordsMock.expectedInvocationsOrigin, afterListRecordsCounter)
+	}
+}
+
+type mLogRepositoryMockPseudonymizeRecords struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockPseudonymizeRecordsExpectation
+	expectations       []*LogRepositoryMockPseudonymizeRecordsExpectation
+
+	callArgs []*LogRepositoryMockPseudonymizeRecordsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockPseudonymizeRecordsExpectation specifies expectation struct of the LogRepository.PseudonymizeRecords
+type LogRepositoryMockPseudonymizeRecordsExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockPseudonymizeRecordsParams
+	paramPtrs          *LogRepositoryMockPseudonymizeRecordsParamPtrs
+	expectationOrigins LogRepositoryMockPseudonymizeRecordsExpectationOrigins
+	results            *LogRepositoryMockPseudonymizeRecordsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockPseudonymizeRecordsParams contains parameters of the LogRepository.PseudonymizeRecords
+type LogRepositoryMockPseudonymizeRecordsParams struct {
+	ctx       context.Context
+	userID    int64
+	pseudonym string
+}
+
+// LogRepositoryMockPseudonymizeRecordsParamPtrs contains pointers to parameters of the LogRepository.PseudonymizeRecords
+type LogRepositoryMockPseudonymizeRecordsParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	pseudonym *string
+}
+
+// LogRepositoryMockPseudonymizeRecordsResults contains results of the LogRepository.PseudonymizeRecords
+type LogRepositoryMockPseudonymizeRecordsResults struct {
+	err error
+}
+
+// LogRepositoryMockPseudonymizeRecordsOrigins contains origins of expectations of the LogRepository.PseudonymizeRecords
+type LogRepositoryMockPseudonymizeRecordsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originPseudonym string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Optional() *mLogRepositoryMockPseudonymizeRecords {
+	mmPseudonymizeRecords.optional = true
+	return mmPseudonymizeRecords
+}
+
+// Expect sets up expected params for LogRepository.PseudonymizeRecords
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Expect(ctx context.Context, userID int64, pseudonym string) *mLogRepositoryMockPseudonymizeRecords {
+	if mmPseudonymizeRecords.mock.funcPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Set")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation == nil {
+		mmPseudonymizeRecords.defaultExpectation = &LogRepositoryMockPseudonymizeRecordsExpectation{}
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.paramPtrs != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by ExpectParams functions")
+	}
+
+	mmPseudonymizeRecords.defaultExpectation.params = &LogRepositoryMockPseudonymizeRecordsParams{ctx, userID, pseudonym}
+	mmPseudonymizeRecords.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPseudonymizeRecords.expectations {
+		if minimock.Equal(e.params, mmPseudonymizeRecords.defaultExpectation.params) {
+			mmPseudonymizeRecords.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPseudonymizeRecords.defaultExpectation.params)
+		}
+	}
+
+	return mmPseudonymizeRecords
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.PseudonymizeRecords
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockPseudonymizeRecords {
+	if mmPseudonymizeRecords.mock.funcPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Set")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation == nil {
+		mmPseudonymizeRecords.defaultExpectation = &LogRepositoryMockPseudonymizeRecordsExpectation{}
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.params != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Expect")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeRecords.defaultExpectation.paramPtrs = &LogRepositoryMockPseudonymizeRecordsParamPtrs{}
+	}
+	mmPseudonymizeRecords.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPseudonymizeRecords.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPseudonymizeRecords
+}
+
+// ExpectUserIDParam2 sets up expected param userID for LogRepository.PseudonymizeRecords
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) ExpectUserIDParam2(userID int64) *mLogRepositoryMockPseudonymizeRecords {
+	if mmPseudonymizeRecords.mock.funcPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Set")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation == nil {
+		mmPseudonymizeRecords.defaultExpectation = &LogRepositoryMockPseudonymizeRecordsExpectation{}
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.params != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Expect")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeRecords.defaultExpectation.paramPtrs = &LogRepositoryMockPseudonymizeRecordsParamPtrs{}
+	}
+	mmPseudonymizeRecords.defaultExpectation.paramPtrs.userID = &userID
+	mmPseudonymizeRecords.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmPseudonymizeRecords
+}
+
+// ExpectPseudonymParam3 sets up expected param pseudonym for LogRepository.PseudonymizeRecords
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) ExpectPseudonymParam3(pseudonym string) *mLogRepositoryMockPseudonymizeRecords {
+	if mmPseudonymizeRecords.mock.funcPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Set")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation == nil {
+		mmPseudonymizeRecords.defaultExpectation = &LogRepositoryMockPseudonymizeRecordsExpectation{}
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.params != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Expect")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeRecords.defaultExpectation.paramPtrs = &LogRepositoryMockPseudonymizeRecordsParamPtrs{}
+	}
+	mmPseudonymizeRecords.defaultExpectation.paramPtrs.pseudonym = &pseudonym
+	mmPseudonymizeRecords.defaultExpectation.expectationOrigins.originPseudonym = minimock.CallerInfo(1)
+
+	return mmPseudonymizeRecords
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.PseudonymizeRecords
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Inspect(f func(ctx context.Context, userID int64, pseudonym string)) *mLogRepositoryMockPseudonymizeRecords {
+	if mmPseudonymizeRecords.mock.inspectFuncPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.PseudonymizeRecords")
+	}
+
+	mmPseudonymizeRecords.mock.inspectFuncPseudonymizeRecords = f
+
+	return mmPseudonymizeRecords
+}
+
+// Return sets up results that will be returned by LogRepository.PseudonymizeRecords
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Return(err error) *LogRepositoryMock {
+	if mmPseudonymizeRecords.mock.funcPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Set")
+	}
+
+	if mmPseudonymizeRecords.defaultExpectation == nil {
+		mmPseudonymizeRecords.defaultExpectation = &LogRepositoryMockPseudonymizeRecordsExpectation{mock: mmPseudonymizeRecords.mock}
+	}
+	mmPseudonymizeRecords.defaultExpectation.results = &LogRepositoryMockPseudonymizeRecordsResults{err}
+	mmPseudonymizeRecords.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeRecords.mock
+}
+
+// Set uses given function f to mock the LogRepository.PseudonymizeRecords method
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Set(f func(ctx context.Context, userID int64, pseudonym string) (err error)) *LogRepositoryMock {
+	if mmPseudonymizeRecords.defaultExpectation != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("Default expectation is already set for the LogRepository.PseudonymizeRecords method")
+	}
+
+	if len(mmPseudonymizeRecords.expectations) > 0 {
+		mmPseudonymizeRecords.mock.t.Fatalf("Some expectations are already set for the LogRepository.PseudonymizeRecords method")
+	}
+
+	mmPseudonymizeRecords.mock.funcPseudonymizeRecords = f
+	mmPseudonymizeRecords.mock.funcPseudonymizeRecordsOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeRecords.mock
+}
+
+// When sets expectation for the LogRepository.PseudonymizeRecords which will trigger the result defined by the following
+// Then helper
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) When(ctx context.Context, userID int64, pseudonym string) *LogRepositoryMockPseudonymizeRecordsExpectation {
+	if mmPseudonymizeRecords.mock.funcPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.mock.t.Fatalf("LogRepositoryMock.PseudonymizeRecords mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockPseudonymizeRecordsExpectation{
+		mock:               mmPseudonymizeRecords.mock,
+		params:             &LogRepositoryMockPseudonymizeRecordsParams{ctx, userID, pseudonym},
+		expectationOrigins: LogRepositoryMockPseudonymizeRecordsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPseudonymizeRecords.expectations = append(mmPseudonymizeRecords.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.PseudonymizeRecords return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockPseudonymizeRecordsExpectation) Then(err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockPseudonymizeRecordsResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.PseudonymizeRecords should be invoked
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Times(n uint64) *mLogRepositoryMockPseudonymizeRecords {
+	if n == 0 {
+		mmPseudonymizeRecords.mock.t.Fatalf("Times of LogRepositoryMock.PseudonymizeRecords mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPseudonymizeRecords.expectedInvocations, n)
+	mmPseudonymizeRecords.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeRecords
+}
+
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) invocationsDone() bool {
+	if len(mmPseudonymizeRecords.expectations) == 0 && mmPseudonymizeRecords.defaultExpectation == nil && mmPseudonymizeRecords.mock.funcPseudonymizeRecords == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPseudonymizeRecords.mock.afterPseudonymizeRecordsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPseudonymizeRecords.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PseudonymizeRecords implements mm_repository.LogRepository
+func (mmPseudonymizeRecords *LogRepositoryMock) PseudonymizeRecords(ctx context.Context, userID int64, pseudonym string) (err error) {
+	mm_atomic.AddUint64(&mmPseudonymizeRecords.beforePseudonymizeRecordsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPseudonymizeRecords.afterPseudonymizeRecordsCounter, 1)
+
+	mmPseudonymizeRecords.t.Helper()
+
+	if mmPseudonymizeRecords.inspectFuncPseudonymizeRecords != nil {
+		mmPseudonymizeRecords.inspectFuncPseudonymizeRecords(ctx, userID, pseudonym)
+	}
+
+	mm_params := LogRepositoryMockPseudonymizeRecordsParams{ctx, userID, pseudonym}
+
+	// Record call args
+	mmPseudonymizeRecords.PseudonymizeRecordsMock.mutex.Lock()
+	mmPseudonymizeRecords.PseudonymizeRecordsMock.callArgs = append(mmPseudonymizeRecords.PseudonymizeRecordsMock.callArgs, &mm_params)
+	mmPseudonymizeRecords.PseudonymizeRecordsMock.mutex.Unlock()
+
+	for _, e := range mmPseudonymizeRecords.PseudonymizeRecordsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.params
+		mm_want_ptrs := mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockPseudonymizeRecordsParams{ctx, userID, pseudonym}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPseudonymizeRecords.t.Errorf("LogRepositoryMock.PseudonymizeRecords got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmPseudonymizeRecords.t.Errorf("LogRepositoryMock.PseudonymizeRecords got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.pseudonym != nil && !minimock.Equal(*mm_want_ptrs.pseudonym, mm_got.pseudonym) {
+				mmPseudonymizeRecords.t.Errorf("LogRepositoryMock.PseudonymizeRecords got unexpected parameter pseudonym, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.expectationOrigins.originPseudonym, *mm_want_ptrs.pseudonym, mm_got.pseudonym, minimock.Diff(*mm_want_ptrs.pseudonym, mm_got.pseudonym))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPseudonymizeRecords.t.Errorf("LogRepositoryMock.PseudonymizeRecords got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPseudonymizeRecords.PseudonymizeRecordsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPseudonymizeRecords.t.Fatal("No results are set for the LogRepositoryMock.PseudonymizeRecords")
+		}
+		return (*mm_results).err
+	}
+	if mmPseudonymizeRecords.funcPseudonymizeRecords != nil {
+		return mmPseudonymizeRecords.funcPseudonymizeRecords(ctx, userID, pseudonym)
+	}
+	mmPseudonymizeRecords.t.Fatalf("Unexpected call to LogRepositoryMock.PseudonymizeRecords. %v %v %v", ctx, userID, pseudonym)
+	return
+}
+
+// PseudonymizeRecordsAfterCounter returns a count of finished LogRepositoryMock.PseudonymizeRecords invocations
+func (mmPseudonymizeRecords *LogRepositoryMock) PseudonymizeRecordsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeRecords.afterPseudonymizeRecordsCounter)
+}
+
+// PseudonymizeRecordsBeforeCounter returns a count of LogRepositoryMock.PseudonymizeRecords invocations
+func (mmPseudonymizeRecords *LogRepositoryMock) PseudonymizeRecordsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeRecords.beforePseudonymizeRecordsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.PseudonymizeRecords.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPseudonymizeRecords *mLogRepositoryMockPseudonymizeRecords) Calls() []*LogRepositoryMockPseudonymizeRecordsParams {
+	mmPseudonymizeRecords.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockPseudonymizeRecordsParams, len(mmPseudonymizeRecords.callArgs))
+	copy(argCopy, mmPseudonymizeRecords.callArgs)
+
+	mmPseudonymizeRecords.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPseudonymizeRecordsDone returns true if the count of the PseudonymizeRecords invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockPseudonymizeRecordsDone() bool {
+	if m.PseudonymizeRecordsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PseudonymizeRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PseudonymizeRecordsMock.invocationsDone()
+}
+
+// MinimockPseudonymizeRecordsInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockPseudonymizeRecordsInspect() {
+	for _, e := range m.PseudonymizeRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.PseudonymizeRecords at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPseudonymizeRecordsCounter := mm_atomic.LoadUint64(&m.afterPseudonymizeRecordsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PseudonymizeRecordsMock.defaultExpectation != nil && afterPseudonymizeRecordsCounter < 1 {
+		if m.PseudonymizeRecordsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.PseudonymizeRecords at\n%s", m.PseudonymizeRecordsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.PseudonymizeRecords at\n%s with params: %#v", m.PseudonymizeRecordsMock.defaultExpectation.expectationOrigins.origin, *m.PseudonymizeRecordsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPseudonymizeRecords != nil && afterPseudonymizeRecordsCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.PseudonymizeRecords at\n%s", m.funcPseudonymizeRecordsOrigin)
+	}
+
+	if !m.PseudonymizeRecordsMock.invocationsDone() && afterPseudonymizeRecordsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.PseudonymizeRecords at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PseudonymizeRecordsMock.expectedInvocations), m.PseudonymizeRecordsMock.expectedInvocationsOrigin, afterPseudonymizeRecordsCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *LogRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockCreateRecordInspect()
+
+			m.MinimockListRecordsInspect()
+
+			m.MinimockPseudonymizeRecordsInspect()
 		}
 	})
 }
@@ -414,5 +1154,7 @@ func (m *LogRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LogRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCreateRecordDone()
+		m.MinimockCreateRecordDone() &&
+		m.MinimockListRecordsDone() &&
+		m.MinimockPseudonymizeRecordsDone()
 }
@@ -0,0 +1,1840 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.OAuthAuthorizationCodeRepository -o o_auth_authorization_code_repository_minimock.go -n OAuthAuthorizationCodeRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// OAuthAuthorizationCodeRepositoryMock implements mm_repository.OAuthAuthorizationCodeRepository
+type OAuthAuthorizationCodeRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsumeAuthorizationCode          func(ctx context.Context, codeHash string) (op1 *model.OAuthAuthorizationCode, err error)
+	funcConsumeAuthorizationCodeOrigin    string
+	inspectFuncConsumeAuthorizationCode   func(ctx context.Context, codeHash string)
+	afterConsumeAuthorizationCodeCounter  uint64
+	beforeConsumeAuthorizationCodeCounter uint64
+	ConsumeAuthorizationCodeMock          mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode
+
+	funcCreateAuthorizationCode          func(ctx context.Context, code *model.OAuthAuthorizationCode) (err error)
+	funcCreateAuthorizationCodeOrigin    string
+	inspectFuncCreateAuthorizationCode   func(ctx context.Context, code *model.OAuthAuthorizationCode)
+	afterCreateAuthorizationCodeCounter  uint64
+	beforeCreateAuthorizationCodeCounter uint64
+	CreateAuthorizationCodeMock          mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode
+
+	funcDeleteAuthorizationCodesByUserID          func(ctx context.Context, userID int64) (err error)
+	funcDeleteAuthorizationCodesByUserIDOrigin    string
+	inspectFuncDeleteAuthorizationCodesByUserID   func(ctx context.Context, userID int64)
+	afterDeleteAuthorizationCodesByUserIDCounter  uint64
+	beforeDeleteAuthorizationCodesByUserIDCounter uint64
+	DeleteAuthorizationCodesByUserIDMock          mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID
+
+	funcDeleteExpiredAuthorizationCodes          func(ctx context.Context, at time.Time) (err error)
+	funcDeleteExpiredAuthorizationCodesOrigin    string
+	inspectFuncDeleteExpiredAuthorizationCodes   func(ctx context.Context, at time.Time)
+	afterDeleteExpiredAuthorizationCodesCounter  uint64
+	beforeDeleteExpiredAuthorizationCodesCounter uint64
+	DeleteExpiredAuthorizationCodesMock          mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes
+
+	funcListAuthorizationCodesByUserID          func(ctx context.Context, userID int64) (opa1 []*model.OAuthAuthorizationCode, err error)
+	funcListAuthorizationCodesByUserIDOrigin    string
+	inspectFuncListAuthorizationCodesByUserID   func(ctx context.Context, userID int64)
+	afterListAuthorizationCodesByUserIDCounter  uint64
+	beforeListAuthorizationCodesByUserIDCounter uint64
+	ListAuthorizationCodesByUserIDMock          mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID
+}
+
+// NewOAuthAuthorizationCodeRepositoryMock returns a mock for mm_repository.OAuthAuthorizationCodeRepository
+func NewOAuthAuthorizationCodeRepositoryMock(t minimock.Tester) *OAuthAuthorizationCodeRepositoryMock {
+	m := &OAuthAuthorizationCodeRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeAuthorizationCodeMock = mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode{mock: m}
+	m.ConsumeAuthorizationCodeMock.callArgs = []*OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams{}
+
+	m.CreateAuthorizationCodeMock = mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode{mock: m}
+	m.CreateAuthorizationCodeMock.callArgs = []*OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams{}
+
+	m.DeleteAuthorizationCodesByUserIDMock = mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID{mock: m}
+	m.DeleteAuthorizationCodesByUserIDMock.callArgs = []*OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams{}
+
+	m.DeleteExpiredAuthorizationCodesMock = mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes{mock: m}
+	m.DeleteExpiredAuthorizationCodesMock.callArgs = []*OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams{}
+
+	m.ListAuthorizationCodesByUserIDMock = mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID{mock: m}
+	m.ListAuthorizationCodesByUserIDMock.callArgs = []*OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode struct {
+	optional           bool
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	defaultExpectation *OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation
+	expectations       []*OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation
+
+	callArgs []*OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation specifies expectation struct of the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation struct {
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	params             *OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams
+	paramPtrs          *OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParamPtrs
+	expectationOrigins OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectationOrigins
+	results            *OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams contains parameters of the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams struct {
+	ctx      context.Context
+	codeHash string
+}
+
+// OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParamPtrs contains pointers to parameters of the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParamPtrs struct {
+	ctx      *context.Context
+	codeHash *string
+}
+
+// OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeResults contains results of the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeResults struct {
+	op1 *model.OAuthAuthorizationCode
+	err error
+}
+
+// OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeOrigins contains origins of expectations of the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originCodeHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Optional() *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode {
+	mmConsumeAuthorizationCode.optional = true
+	return mmConsumeAuthorizationCode
+}
+
+// Expect sets up expected params for OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Expect(ctx context.Context, codeHash string) *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation{}
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.paramPtrs != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by ExpectParams functions")
+	}
+
+	mmConsumeAuthorizationCode.defaultExpectation.params = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash}
+	mmConsumeAuthorizationCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConsumeAuthorizationCode.expectations {
+		if minimock.Equal(e.params, mmConsumeAuthorizationCode.defaultExpectation.params) {
+			mmConsumeAuthorizationCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsumeAuthorizationCode.defaultExpectation.params)
+		}
+	}
+
+	return mmConsumeAuthorizationCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) ExpectCtxParam1(ctx context.Context) *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation{}
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.params != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmConsumeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParamPtrs{}
+	}
+	mmConsumeAuthorizationCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConsumeAuthorizationCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConsumeAuthorizationCode
+}
+
+// ExpectCodeHashParam2 sets up expected param codeHash for OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) ExpectCodeHashParam2(codeHash string) *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation{}
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.params != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmConsumeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParamPtrs{}
+	}
+	mmConsumeAuthorizationCode.defaultExpectation.paramPtrs.codeHash = &codeHash
+	mmConsumeAuthorizationCode.defaultExpectation.expectationOrigins.originCodeHash = minimock.CallerInfo(1)
+
+	return mmConsumeAuthorizationCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Inspect(f func(ctx context.Context, codeHash string)) *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.inspectFuncConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Inspect function is already set for OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode")
+	}
+
+	mmConsumeAuthorizationCode.mock.inspectFuncConsumeAuthorizationCode = f
+
+	return mmConsumeAuthorizationCode
+}
+
+// Return sets up results that will be returned by OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Return(op1 *model.OAuthAuthorizationCode, err error) *OAuthAuthorizationCodeRepositoryMock {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation{mock: mmConsumeAuthorizationCode.mock}
+	}
+	mmConsumeAuthorizationCode.defaultExpectation.results = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeResults{op1, err}
+	mmConsumeAuthorizationCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConsumeAuthorizationCode.mock
+}
+
+// Set uses given function f to mock the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode method
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Set(f func(ctx context.Context, codeHash string) (op1 *model.OAuthAuthorizationCode, err error)) *OAuthAuthorizationCodeRepositoryMock {
+	if mmConsumeAuthorizationCode.defaultExpectation != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Default expectation is already set for the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode method")
+	}
+
+	if len(mmConsumeAuthorizationCode.expectations) > 0 {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Some expectations are already set for the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode method")
+	}
+
+	mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode = f
+	mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCodeOrigin = minimock.CallerInfo(1)
+	return mmConsumeAuthorizationCode.mock
+}
+
+// When sets expectation for the OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode which will trigger the result defined by the following
+// Then helper
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) When(ctx context.Context, codeHash string) *OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	expectation := &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation{
+		mock:               mmConsumeAuthorizationCode.mock,
+		params:             &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash},
+		expectationOrigins: OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConsumeAuthorizationCode.expectations = append(mmConsumeAuthorizationCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode return parameters for the expectation previously defined by the When method
+func (e *OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeExpectation) Then(op1 *model.OAuthAuthorizationCode, err error) *OAuthAuthorizationCodeRepositoryMock {
+	e.results = &OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthAuthorizationCodeRepository.ConsumeAuthorizationCode should be invoked
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Times(n uint64) *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode {
+	if n == 0 {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Times of OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConsumeAuthorizationCode.expectedInvocations, n)
+	mmConsumeAuthorizationCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConsumeAuthorizationCode
+}
+
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) invocationsDone() bool {
+	if len(mmConsumeAuthorizationCode.expectations) == 0 && mmConsumeAuthorizationCode.defaultExpectation == nil && mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.mock.afterConsumeAuthorizationCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConsumeAuthorizationCode implements mm_repository.OAuthAuthorizationCodeRepository
+func (mmConsumeAuthorizationCode *OAuthAuthorizationCodeRepositoryMock) ConsumeAuthorizationCode(ctx context.Context, codeHash string) (op1 *model.OAuthAuthorizationCode, err error) {
+	mm_atomic.AddUint64(&mmConsumeAuthorizationCode.beforeConsumeAuthorizationCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsumeAuthorizationCode.afterConsumeAuthorizationCodeCounter, 1)
+
+	mmConsumeAuthorizationCode.t.Helper()
+
+	if mmConsumeAuthorizationCode.inspectFuncConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.inspectFuncConsumeAuthorizationCode(ctx, codeHash)
+	}
+
+	mm_params := OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash}
+
+	// Record call args
+	mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.mutex.Lock()
+	mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.callArgs = append(mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.callArgs, &mm_params)
+	mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.mutex.Unlock()
+
+	for _, e := range mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsumeAuthorizationCode.t.Errorf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmConsumeAuthorizationCode.t.Errorf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode got unexpected parameter codeHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCodeHash, *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsumeAuthorizationCode.t.Errorf("OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsumeAuthorizationCode.t.Fatal("No results are set for the OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmConsumeAuthorizationCode.funcConsumeAuthorizationCode != nil {
+		return mmConsumeAuthorizationCode.funcConsumeAuthorizationCode(ctx, codeHash)
+	}
+	mmConsumeAuthorizationCode.t.Fatalf("Unexpected call to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode. %v %v", ctx, codeHash)
+	return
+}
+
+// ConsumeAuthorizationCodeAfterCounter returns a count of finished OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode invocations
+func (mmConsumeAuthorizationCode *OAuthAuthorizationCodeRepositoryMock) ConsumeAuthorizationCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.afterConsumeAuthorizationCodeCounter)
+}
+
+// ConsumeAuthorizationCodeBeforeCounter returns a count of OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode invocations
+func (mmConsumeAuthorizationCode *OAuthAuthorizationCodeRepositoryMock) ConsumeAuthorizationCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.beforeConsumeAuthorizationCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsumeAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCode) Calls() []*OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams {
+	mmConsumeAuthorizationCode.mutex.RLock()
+
+	argCopy := make([]*OAuthAuthorizationCodeRepositoryMockConsumeAuthorizationCodeParams, len(mmConsumeAuthorizationCode.callArgs))
+	copy(argCopy, mmConsumeAuthorizationCode.callArgs)
+
+	mmConsumeAuthorizationCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeAuthorizationCodeDone returns true if the count of the ConsumeAuthorizationCode invocations corresponds
+// the number of defined expectations
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockConsumeAuthorizationCodeDone() bool {
+	if m.ConsumeAuthorizationCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConsumeAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConsumeAuthorizationCodeMock.invocationsDone()
+}
+
+// MinimockConsumeAuthorizationCodeInspect logs each unmet expectation
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockConsumeAuthorizationCodeInspect() {
+	for _, e := range m.ConsumeAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConsumeAuthorizationCodeCounter := mm_atomic.LoadUint64(&m.afterConsumeAuthorizationCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeAuthorizationCodeMock.defaultExpectation != nil && afterConsumeAuthorizationCodeCounter < 1 {
+		if m.ConsumeAuthorizationCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode at\n%s", m.ConsumeAuthorizationCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode at\n%s with params: %#v", m.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *m.ConsumeAuthorizationCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsumeAuthorizationCode != nil && afterConsumeAuthorizationCodeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode at\n%s", m.funcConsumeAuthorizationCodeOrigin)
+	}
+
+	if !m.ConsumeAuthorizationCodeMock.invocationsDone() && afterConsumeAuthorizationCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthAuthorizationCodeRepositoryMock.ConsumeAuthorizationCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConsumeAuthorizationCodeMock.expectedInvocations), m.ConsumeAuthorizationCodeMock.expectedInvocationsOrigin, afterConsumeAuthorizationCodeCounter)
+	}
+}
+
+type mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode struct {
+	optional           bool
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	defaultExpectation *OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation
+	expectations       []*OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation
+
+	callArgs []*OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation specifies expectation struct of the OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation struct {
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	params             *OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams
+	paramPtrs          *OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParamPtrs
+	expectationOrigins OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectationOrigins
+	results            *OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams contains parameters of the OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams struct {
+	ctx  context.Context
+	code *model.OAuthAuthorizationCode
+}
+
+// OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParamPtrs contains pointers to parameters of the OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParamPtrs struct {
+	ctx  *context.Context
+	code **model.OAuthAuthorizationCode
+}
+
+// OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeResults contains results of the OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeResults struct {
+	err error
+}
+
+// OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeOrigins contains origins of expectations of the OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+type OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCode string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Optional() *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode {
+	mmCreateAuthorizationCode.optional = true
+	return mmCreateAuthorizationCode
+}
+
+// Expect sets up expected params for OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Expect(ctx context.Context, code *model.OAuthAuthorizationCode) *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation{}
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.paramPtrs != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuthorizationCode.defaultExpectation.params = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams{ctx, code}
+	mmCreateAuthorizationCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAuthorizationCode.expectations {
+		if minimock.Equal(e.params, mmCreateAuthorizationCode.defaultExpectation.params) {
+			mmCreateAuthorizationCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuthorizationCode.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuthorizationCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) ExpectCtxParam1(ctx context.Context) *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation{}
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.params != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmCreateAuthorizationCode.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParamPtrs{}
+	}
+	mmCreateAuthorizationCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAuthorizationCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAuthorizationCode
+}
+
+// ExpectCodeParam2 sets up expected param code for OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) ExpectCodeParam2(code *model.OAuthAuthorizationCode) *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation{}
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.params != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmCreateAuthorizationCode.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParamPtrs{}
+	}
+	mmCreateAuthorizationCode.defaultExpectation.paramPtrs.code = &code
+	mmCreateAuthorizationCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmCreateAuthorizationCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Inspect(f func(ctx context.Context, code *model.OAuthAuthorizationCode)) *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.inspectFuncCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Inspect function is already set for OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode")
+	}
+
+	mmCreateAuthorizationCode.mock.inspectFuncCreateAuthorizationCode = f
+
+	return mmCreateAuthorizationCode
+}
+
+// Return sets up results that will be returned by OAuthAuthorizationCodeRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Return(err error) *OAuthAuthorizationCodeRepositoryMock {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation{mock: mmCreateAuthorizationCode.mock}
+	}
+	mmCreateAuthorizationCode.defaultExpectation.results = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeResults{err}
+	mmCreateAuthorizationCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAuthorizationCode.mock
+}
+
+// Set uses given function f to mock the OAuthAuthorizationCodeRepository.CreateAuthorizationCode method
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Set(f func(ctx context.Context, code *model.OAuthAuthorizationCode) (err error)) *OAuthAuthorizationCodeRepositoryMock {
+	if mmCreateAuthorizationCode.defaultExpectation != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Default expectation is already set for the OAuthAuthorizationCodeRepository.CreateAuthorizationCode method")
+	}
+
+	if len(mmCreateAuthorizationCode.expectations) > 0 {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Some expectations are already set for the OAuthAuthorizationCodeRepository.CreateAuthorizationCode method")
+	}
+
+	mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode = f
+	mmCreateAuthorizationCode.mock.funcCreateAuthorizationCodeOrigin = minimock.CallerInfo(1)
+	return mmCreateAuthorizationCode.mock
+}
+
+// When sets expectation for the OAuthAuthorizationCodeRepository.CreateAuthorizationCode which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) When(ctx context.Context, code *model.OAuthAuthorizationCode) *OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	expectation := &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation{
+		mock:               mmCreateAuthorizationCode.mock,
+		params:             &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams{ctx, code},
+		expectationOrigins: OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAuthorizationCode.expectations = append(mmCreateAuthorizationCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthAuthorizationCodeRepository.CreateAuthorizationCode return parameters for the expectation previously defined by the When method
+func (e *OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeExpectation) Then(err error) *OAuthAuthorizationCodeRepositoryMock {
+	e.results = &OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthAuthorizationCodeRepository.CreateAuthorizationCode should be invoked
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Times(n uint64) *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode {
+	if n == 0 {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Times of OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuthorizationCode.expectedInvocations, n)
+	mmCreateAuthorizationCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAuthorizationCode
+}
+
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) invocationsDone() bool {
+	if len(mmCreateAuthorizationCode.expectations) == 0 && mmCreateAuthorizationCode.defaultExpectation == nil && mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuthorizationCode.mock.afterCreateAuthorizationCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuthorizationCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuthorizationCode implements mm_repository.OAuthAuthorizationCodeRepository
+func (mmCreateAuthorizationCode *OAuthAuthorizationCodeRepositoryMock) CreateAuthorizationCode(ctx context.Context, code *model.OAuthAuthorizationCode) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuthorizationCode.beforeCreateAuthorizationCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuthorizationCode.afterCreateAuthorizationCodeCounter, 1)
+
+	mmCreateAuthorizationCode.t.Helper()
+
+	if mmCreateAuthorizationCode.inspectFuncCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.inspectFuncCreateAuthorizationCode(ctx, code)
+	}
+
+	mm_params := OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams{ctx, code}
+
+	// Record call args
+	mmCreateAuthorizationCode.CreateAuthorizationCodeMock.mutex.Lock()
+	mmCreateAuthorizationCode.CreateAuthorizationCodeMock.callArgs = append(mmCreateAuthorizationCode.CreateAuthorizationCodeMock.callArgs, &mm_params)
+	mmCreateAuthorizationCode.CreateAuthorizationCodeMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuthorizationCode.CreateAuthorizationCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams{ctx, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuthorizationCode.t.Errorf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmCreateAuthorizationCode.t.Errorf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuthorizationCode.t.Errorf("OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuthorizationCode.t.Fatal("No results are set for the OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAuthorizationCode.funcCreateAuthorizationCode != nil {
+		return mmCreateAuthorizationCode.funcCreateAuthorizationCode(ctx, code)
+	}
+	mmCreateAuthorizationCode.t.Fatalf("Unexpected call to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode. %v %v", ctx, code)
+	return
+}
+
+// CreateAuthorizationCodeAfterCounter returns a count of finished OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode invocations
+func (mmCreateAuthorizationCode *OAuthAuthorizationCodeRepositoryMock) CreateAuthorizationCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuthorizationCode.afterCreateAuthorizationCodeCounter)
+}
+
+// CreateAuthorizationCodeBeforeCounter returns a count of OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode invocations
+func (mmCreateAuthorizationCode *OAuthAuthorizationCodeRepositoryMock) CreateAuthorizationCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuthorizationCode.beforeCreateAuthorizationCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuthorizationCode *mOAuthAuthorizationCodeRepositoryMockCreateAuthorizationCode) Calls() []*OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams {
+	mmCreateAuthorizationCode.mutex.RLock()
+
+	argCopy := make([]*OAuthAuthorizationCodeRepositoryMockCreateAuthorizationCodeParams, len(mmCreateAuthorizationCode.callArgs))
+	copy(argCopy, mmCreateAuthorizationCode.callArgs)
+
+	mmCreateAuthorizationCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuthorizationCodeDone returns true if the count of the CreateAuthorizationCode invocations corresponds
+// the number of defined expectations
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockCreateAuthorizationCodeDone() bool {
+	if m.CreateAuthorizationCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuthorizationCodeMock.invocationsDone()
+}
+
+// MinimockCreateAuthorizationCodeInspect logs each unmet expectation
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockCreateAuthorizationCodeInspect() {
+	for _, e := range m.CreateAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAuthorizationCodeCounter := mm_atomic.LoadUint64(&m.afterCreateAuthorizationCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuthorizationCodeMock.defaultExpectation != nil && afterCreateAuthorizationCodeCounter < 1 {
+		if m.CreateAuthorizationCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode at\n%s", m.CreateAuthorizationCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode at\n%s with params: %#v", m.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *m.CreateAuthorizationCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuthorizationCode != nil && afterCreateAuthorizationCodeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode at\n%s", m.funcCreateAuthorizationCodeOrigin)
+	}
+
+	if !m.CreateAuthorizationCodeMock.invocationsDone() && afterCreateAuthorizationCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthAuthorizationCodeRepositoryMock.CreateAuthorizationCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuthorizationCodeMock.expectedInvocations), m.CreateAuthorizationCodeMock.expectedInvocationsOrigin, afterCreateAuthorizationCodeCounter)
+	}
+}
+
+type mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID struct {
+	optional           bool
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	defaultExpectation *OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation
+	expectations       []*OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation
+
+	callArgs []*OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation specifies expectation struct of the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation struct {
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	params             *OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams
+	paramPtrs          *OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParamPtrs
+	expectationOrigins OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectationOrigins
+	results            *OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams contains parameters of the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParamPtrs contains pointers to parameters of the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDResults contains results of the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDResults struct {
+	err error
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDOrigins contains origins of expectations of the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Optional() *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID {
+	mmDeleteAuthorizationCodesByUserID.optional = true
+	return mmDeleteAuthorizationCodesByUserID
+}
+
+// Expect sets up expected params for OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Expect(ctx context.Context, userID int64) *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID {
+	if mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmDeleteAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation{}
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.params = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams{ctx, userID}
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAuthorizationCodesByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteAuthorizationCodesByUserID.defaultExpectation.params) {
+			mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAuthorizationCodesByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAuthorizationCodesByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) ExpectCtxParam1(ctx context.Context) *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID {
+	if mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmDeleteAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation{}
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation.params != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParamPtrs{}
+	}
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAuthorizationCodesByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) ExpectUserIDParam2(userID int64) *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID {
+	if mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmDeleteAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation{}
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation.params != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParamPtrs{}
+	}
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteAuthorizationCodesByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Inspect(f func(ctx context.Context, userID int64)) *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID {
+	if mmDeleteAuthorizationCodesByUserID.mock.inspectFuncDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("Inspect function is already set for OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID")
+	}
+
+	mmDeleteAuthorizationCodesByUserID.mock.inspectFuncDeleteAuthorizationCodesByUserID = f
+
+	return mmDeleteAuthorizationCodesByUserID
+}
+
+// Return sets up results that will be returned by OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Return(err error) *OAuthAuthorizationCodeRepositoryMock {
+	if mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmDeleteAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation{mock: mmDeleteAuthorizationCodesByUserID.mock}
+	}
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.results = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDResults{err}
+	mmDeleteAuthorizationCodesByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAuthorizationCodesByUserID.mock
+}
+
+// Set uses given function f to mock the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID method
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Set(f func(ctx context.Context, userID int64) (err error)) *OAuthAuthorizationCodeRepositoryMock {
+	if mmDeleteAuthorizationCodesByUserID.defaultExpectation != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("Default expectation is already set for the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID method")
+	}
+
+	if len(mmDeleteAuthorizationCodesByUserID.expectations) > 0 {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("Some expectations are already set for the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID method")
+	}
+
+	mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID = f
+	mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteAuthorizationCodesByUserID.mock
+}
+
+// When sets expectation for the OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) When(ctx context.Context, userID int64) *OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation {
+	if mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	expectation := &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation{
+		mock:               mmDeleteAuthorizationCodesByUserID.mock,
+		params:             &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams{ctx, userID},
+		expectationOrigins: OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAuthorizationCodesByUserID.expectations = append(mmDeleteAuthorizationCodesByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID return parameters for the expectation previously defined by the When method
+func (e *OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDExpectation) Then(err error) *OAuthAuthorizationCodeRepositoryMock {
+	e.results = &OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthAuthorizationCodeRepository.DeleteAuthorizationCodesByUserID should be invoked
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Times(n uint64) *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID {
+	if n == 0 {
+		mmDeleteAuthorizationCodesByUserID.mock.t.Fatalf("Times of OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAuthorizationCodesByUserID.expectedInvocations, n)
+	mmDeleteAuthorizationCodesByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAuthorizationCodesByUserID
+}
+
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) invocationsDone() bool {
+	if len(mmDeleteAuthorizationCodesByUserID.expectations) == 0 && mmDeleteAuthorizationCodesByUserID.defaultExpectation == nil && mmDeleteAuthorizationCodesByUserID.mock.funcDeleteAuthorizationCodesByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAuthorizationCodesByUserID.mock.afterDeleteAuthorizationCodesByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAuthorizationCodesByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAuthorizationCodesByUserID implements mm_repository.OAuthAuthorizationCodeRepository
+func (mmDeleteAuthorizationCodesByUserID *OAuthAuthorizationCodeRepositoryMock) DeleteAuthorizationCodesByUserID(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAuthorizationCodesByUserID.beforeDeleteAuthorizationCodesByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAuthorizationCodesByUserID.afterDeleteAuthorizationCodesByUserIDCounter, 1)
+
+	mmDeleteAuthorizationCodesByUserID.t.Helper()
+
+	if mmDeleteAuthorizationCodesByUserID.inspectFuncDeleteAuthorizationCodesByUserID != nil {
+		mmDeleteAuthorizationCodesByUserID.inspectFuncDeleteAuthorizationCodesByUserID(ctx, userID)
+	}
+
+	mm_params := OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.mutex.Lock()
+	mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.callArgs = append(mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.callArgs, &mm_params)
+	mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAuthorizationCodesByUserID.t.Errorf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteAuthorizationCodesByUserID.t.Errorf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAuthorizationCodesByUserID.t.Errorf("OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAuthorizationCodesByUserID.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAuthorizationCodesByUserID.t.Fatal("No results are set for the OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAuthorizationCodesByUserID.funcDeleteAuthorizationCodesByUserID != nil {
+		return mmDeleteAuthorizationCodesByUserID.funcDeleteAuthorizationCodesByUserID(ctx, userID)
+	}
+	mmDeleteAuthorizationCodesByUserID.t.Fatalf("Unexpected call to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteAuthorizationCodesByUserIDAfterCounter returns a count of finished OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID invocations
+func (mmDeleteAuthorizationCodesByUserID *OAuthAuthorizationCodeRepositoryMock) DeleteAuthorizationCodesByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAuthorizationCodesByUserID.afterDeleteAuthorizationCodesByUserIDCounter)
+}
+
+// DeleteAuthorizationCodesByUserIDBeforeCounter returns a count of OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID invocations
+func (mmDeleteAuthorizationCodesByUserID *OAuthAuthorizationCodeRepositoryMock) DeleteAuthorizationCodesByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAuthorizationCodesByUserID.beforeDeleteAuthorizationCodesByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserID) Calls() []*OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams {
+	mmDeleteAuthorizationCodesByUserID.mutex.RLock()
+
+	argCopy := make([]*OAuthAuthorizationCodeRepositoryMockDeleteAuthorizationCodesByUserIDParams, len(mmDeleteAuthorizationCodesByUserID.callArgs))
+	copy(argCopy, mmDeleteAuthorizationCodesByUserID.callArgs)
+
+	mmDeleteAuthorizationCodesByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAuthorizationCodesByUserIDDone returns true if the count of the DeleteAuthorizationCodesByUserID invocations corresponds
+// the number of defined expectations
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockDeleteAuthorizationCodesByUserIDDone() bool {
+	if m.DeleteAuthorizationCodesByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAuthorizationCodesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAuthorizationCodesByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteAuthorizationCodesByUserIDInspect logs each unmet expectation
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockDeleteAuthorizationCodesByUserIDInspect() {
+	for _, e := range m.DeleteAuthorizationCodesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAuthorizationCodesByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteAuthorizationCodesByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAuthorizationCodesByUserIDMock.defaultExpectation != nil && afterDeleteAuthorizationCodesByUserIDCounter < 1 {
+		if m.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID at\n%s", m.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID at\n%s with params: %#v", m.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAuthorizationCodesByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAuthorizationCodesByUserID != nil && afterDeleteAuthorizationCodesByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID at\n%s", m.funcDeleteAuthorizationCodesByUserIDOrigin)
+	}
+
+	if !m.DeleteAuthorizationCodesByUserIDMock.invocationsDone() && afterDeleteAuthorizationCodesByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthAuthorizationCodeRepositoryMock.DeleteAuthorizationCodesByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAuthorizationCodesByUserIDMock.expectedInvocations), m.DeleteAuthorizationCodesByUserIDMock.expectedInvocationsOrigin, afterDeleteAuthorizationCodesByUserIDCounter)
+	}
+}
+
+type mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes struct {
+	optional           bool
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	defaultExpectation *OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation
+	expectations       []*OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation
+
+	callArgs []*OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation specifies expectation struct of the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+type OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation struct {
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	params             *OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams
+	paramPtrs          *OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParamPtrs
+	expectationOrigins OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectationOrigins
+	results            *OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams contains parameters of the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+type OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams struct {
+	ctx context.Context
+	at  time.Time
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParamPtrs contains pointers to parameters of the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+type OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParamPtrs struct {
+	ctx *context.Context
+	at  *time.Time
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesResults contains results of the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+type OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesResults struct {
+	err error
+}
+
+// OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesOrigins contains origins of expectations of the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+type OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originAt  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Optional() *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes {
+	mmDeleteExpiredAuthorizationCodes.optional = true
+	return mmDeleteExpiredAuthorizationCodes
+}
+
+// Expect sets up expected params for OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Expect(ctx context.Context, at time.Time) *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes {
+	if mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation == nil {
+		mmDeleteExpiredAuthorizationCodes.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation{}
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.params = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams{ctx, at}
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredAuthorizationCodes.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredAuthorizationCodes.defaultExpectation.params) {
+			mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredAuthorizationCodes.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredAuthorizationCodes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) ExpectCtxParam1(ctx context.Context) *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes {
+	if mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation == nil {
+		mmDeleteExpiredAuthorizationCodes.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation{}
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation.params != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParamPtrs{}
+	}
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredAuthorizationCodes
+}
+
+// ExpectAtParam2 sets up expected param at for OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) ExpectAtParam2(at time.Time) *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes {
+	if mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation == nil {
+		mmDeleteExpiredAuthorizationCodes.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation{}
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation.params != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParamPtrs{}
+	}
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.paramPtrs.at = &at
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredAuthorizationCodes
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Inspect(f func(ctx context.Context, at time.Time)) *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes {
+	if mmDeleteExpiredAuthorizationCodes.mock.inspectFuncDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("Inspect function is already set for OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes")
+	}
+
+	mmDeleteExpiredAuthorizationCodes.mock.inspectFuncDeleteExpiredAuthorizationCodes = f
+
+	return mmDeleteExpiredAuthorizationCodes
+}
+
+// Return sets up results that will be returned by OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Return(err error) *OAuthAuthorizationCodeRepositoryMock {
+	if mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation == nil {
+		mmDeleteExpiredAuthorizationCodes.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation{mock: mmDeleteExpiredAuthorizationCodes.mock}
+	}
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.results = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesResults{err}
+	mmDeleteExpiredAuthorizationCodes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredAuthorizationCodes.mock
+}
+
+// Set uses given function f to mock the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes method
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Set(f func(ctx context.Context, at time.Time) (err error)) *OAuthAuthorizationCodeRepositoryMock {
+	if mmDeleteExpiredAuthorizationCodes.defaultExpectation != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("Default expectation is already set for the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes method")
+	}
+
+	if len(mmDeleteExpiredAuthorizationCodes.expectations) > 0 {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("Some expectations are already set for the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes method")
+	}
+
+	mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes = f
+	mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodesOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredAuthorizationCodes.mock
+}
+
+// When sets expectation for the OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) When(ctx context.Context, at time.Time) *OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation {
+	if mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock is already set by Set")
+	}
+
+	expectation := &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation{
+		mock:               mmDeleteExpiredAuthorizationCodes.mock,
+		params:             &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams{ctx, at},
+		expectationOrigins: OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredAuthorizationCodes.expectations = append(mmDeleteExpiredAuthorizationCodes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes return parameters for the expectation previously defined by the When method
+func (e *OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesExpectation) Then(err error) *OAuthAuthorizationCodeRepositoryMock {
+	e.results = &OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthAuthorizationCodeRepository.DeleteExpiredAuthorizationCodes should be invoked
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Times(n uint64) *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes {
+	if n == 0 {
+		mmDeleteExpiredAuthorizationCodes.mock.t.Fatalf("Times of OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredAuthorizationCodes.expectedInvocations, n)
+	mmDeleteExpiredAuthorizationCodes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredAuthorizationCodes
+}
+
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) invocationsDone() bool {
+	if len(mmDeleteExpiredAuthorizationCodes.expectations) == 0 && mmDeleteExpiredAuthorizationCodes.defaultExpectation == nil && mmDeleteExpiredAuthorizationCodes.mock.funcDeleteExpiredAuthorizationCodes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredAuthorizationCodes.mock.afterDeleteExpiredAuthorizationCodesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredAuthorizationCodes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredAuthorizationCodes implements mm_repository.OAuthAuthorizationCodeRepository
+func (mmDeleteExpiredAuthorizationCodes *OAuthAuthorizationCodeRepositoryMock) DeleteExpiredAuthorizationCodes(ctx context.Context, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredAuthorizationCodes.beforeDeleteExpiredAuthorizationCodesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredAuthorizationCodes.afterDeleteExpiredAuthorizationCodesCounter, 1)
+
+	mmDeleteExpiredAuthorizationCodes.t.Helper()
+
+	if mmDeleteExpiredAuthorizationCodes.inspectFuncDeleteExpiredAuthorizationCodes != nil {
+		mmDeleteExpiredAuthorizationCodes.inspectFuncDeleteExpiredAuthorizationCodes(ctx, at)
+	}
+
+	mm_params := OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams{ctx, at}
+
+	// Record call args
+	mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.mutex.Lock()
+	mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.callArgs = append(mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.callArgs, &mm_params)
+	mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams{ctx, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredAuthorizationCodes.t.Errorf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmDeleteExpiredAuthorizationCodes.t.Errorf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredAuthorizationCodes.t.Errorf("OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredAuthorizationCodes.DeleteExpiredAuthorizationCodesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredAuthorizationCodes.t.Fatal("No results are set for the OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteExpiredAuthorizationCodes.funcDeleteExpiredAuthorizationCodes != nil {
+		return mmDeleteExpiredAuthorizationCodes.funcDeleteExpiredAuthorizationCodes(ctx, at)
+	}
+	mmDeleteExpiredAuthorizationCodes.t.Fatalf("Unexpected call to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes. %v %v", ctx, at)
+	return
+}
+
+// DeleteExpiredAuthorizationCodesAfterCounter returns a count of finished OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes invocations
+func (mmDeleteExpiredAuthorizationCodes *OAuthAuthorizationCodeRepositoryMock) DeleteExpiredAuthorizationCodesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredAuthorizationCodes.afterDeleteExpiredAuthorizationCodesCounter)
+}
+
+// DeleteExpiredAuthorizationCodesBeforeCounter returns a count of OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes invocations
+func (mmDeleteExpiredAuthorizationCodes *OAuthAuthorizationCodeRepositoryMock) DeleteExpiredAuthorizationCodesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredAuthorizationCodes.beforeDeleteExpiredAuthorizationCodesCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredAuthorizationCodes *mOAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodes) Calls() []*OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams {
+	mmDeleteExpiredAuthorizationCodes.mutex.RLock()
+
+	argCopy := make([]*OAuthAuthorizationCodeRepositoryMockDeleteExpiredAuthorizationCodesParams, len(mmDeleteExpiredAuthorizationCodes.callArgs))
+	copy(argCopy, mmDeleteExpiredAuthorizationCodes.callArgs)
+
+	mmDeleteExpiredAuthorizationCodes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredAuthorizationCodesDone returns true if the count of the DeleteExpiredAuthorizationCodes invocations corresponds
+// the number of defined expectations
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockDeleteExpiredAuthorizationCodesDone() bool {
+	if m.DeleteExpiredAuthorizationCodesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredAuthorizationCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredAuthorizationCodesMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredAuthorizationCodesInspect logs each unmet expectation
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockDeleteExpiredAuthorizationCodesInspect() {
+	for _, e := range m.DeleteExpiredAuthorizationCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredAuthorizationCodesCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredAuthorizationCodesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredAuthorizationCodesMock.defaultExpectation != nil && afterDeleteExpiredAuthorizationCodesCounter < 1 {
+		if m.DeleteExpiredAuthorizationCodesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes at\n%s", m.DeleteExpiredAuthorizationCodesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes at\n%s with params: %#v", m.DeleteExpiredAuthorizationCodesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredAuthorizationCodesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredAuthorizationCodes != nil && afterDeleteExpiredAuthorizationCodesCounter < 1 {
+		m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes at\n%s", m.funcDeleteExpiredAuthorizationCodesOrigin)
+	}
+
+	if !m.DeleteExpiredAuthorizationCodesMock.invocationsDone() && afterDeleteExpiredAuthorizationCodesCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthAuthorizationCodeRepositoryMock.DeleteExpiredAuthorizationCodes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredAuthorizationCodesMock.expectedInvocations), m.DeleteExpiredAuthorizationCodesMock.expectedInvocationsOrigin, afterDeleteExpiredAuthorizationCodesCounter)
+	}
+}
+
+type mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID struct {
+	optional           bool
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	defaultExpectation *OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation
+	expectations       []*OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation
+
+	callArgs []*OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation specifies expectation struct of the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation struct {
+	mock               *OAuthAuthorizationCodeRepositoryMock
+	params             *OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams
+	paramPtrs          *OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParamPtrs
+	expectationOrigins OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectationOrigins
+	results            *OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams contains parameters of the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParamPtrs contains pointers to parameters of the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDResults contains results of the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDResults struct {
+	opa1 []*model.OAuthAuthorizationCode
+	err  error
+}
+
+// OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDOrigins contains origins of expectations of the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+type OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Optional() *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID {
+	mmListAuthorizationCodesByUserID.optional = true
+	return mmListAuthorizationCodesByUserID
+}
+
+// Expect sets up expected params for OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Expect(ctx context.Context, userID int64) *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID {
+	if mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmListAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation{}
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmListAuthorizationCodesByUserID.defaultExpectation.params = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams{ctx, userID}
+	mmListAuthorizationCodesByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAuthorizationCodesByUserID.expectations {
+		if minimock.Equal(e.params, mmListAuthorizationCodesByUserID.defaultExpectation.params) {
+			mmListAuthorizationCodesByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuthorizationCodesByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuthorizationCodesByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) ExpectCtxParam1(ctx context.Context) *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID {
+	if mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmListAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation{}
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation.params != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Expect")
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs == nil {
+		mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParamPtrs{}
+	}
+	mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAuthorizationCodesByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAuthorizationCodesByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) ExpectUserIDParam2(userID int64) *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID {
+	if mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmListAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation{}
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation.params != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Expect")
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs == nil {
+		mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParamPtrs{}
+	}
+	mmListAuthorizationCodesByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmListAuthorizationCodesByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListAuthorizationCodesByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Inspect(f func(ctx context.Context, userID int64)) *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID {
+	if mmListAuthorizationCodesByUserID.mock.inspectFuncListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("Inspect function is already set for OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID")
+	}
+
+	mmListAuthorizationCodesByUserID.mock.inspectFuncListAuthorizationCodesByUserID = f
+
+	return mmListAuthorizationCodesByUserID
+}
+
+// Return sets up results that will be returned by OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Return(opa1 []*model.OAuthAuthorizationCode, err error) *OAuthAuthorizationCodeRepositoryMock {
+	if mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	if mmListAuthorizationCodesByUserID.defaultExpectation == nil {
+		mmListAuthorizationCodesByUserID.defaultExpectation = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation{mock: mmListAuthorizationCodesByUserID.mock}
+	}
+	mmListAuthorizationCodesByUserID.defaultExpectation.results = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDResults{opa1, err}
+	mmListAuthorizationCodesByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAuthorizationCodesByUserID.mock
+}
+
+// Set uses given function f to mock the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID method
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Set(f func(ctx context.Context, userID int64) (opa1 []*model.OAuthAuthorizationCode, err error)) *OAuthAuthorizationCodeRepositoryMock {
+	if mmListAuthorizationCodesByUserID.defaultExpectation != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("Default expectation is already set for the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID method")
+	}
+
+	if len(mmListAuthorizationCodesByUserID.expectations) > 0 {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("Some expectations are already set for the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID method")
+	}
+
+	mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID = f
+	mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserIDOrigin = minimock.CallerInfo(1)
+	return mmListAuthorizationCodesByUserID.mock
+}
+
+// When sets expectation for the OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID which will trigger the result defined by the following
+// Then helper
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) When(ctx context.Context, userID int64) *OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation {
+	if mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock is already set by Set")
+	}
+
+	expectation := &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation{
+		mock:               mmListAuthorizationCodesByUserID.mock,
+		params:             &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams{ctx, userID},
+		expectationOrigins: OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAuthorizationCodesByUserID.expectations = append(mmListAuthorizationCodesByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID return parameters for the expectation previously defined by the When method
+func (e *OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDExpectation) Then(opa1 []*model.OAuthAuthorizationCode, err error) *OAuthAuthorizationCodeRepositoryMock {
+	e.results = &OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthAuthorizationCodeRepository.ListAuthorizationCodesByUserID should be invoked
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Times(n uint64) *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID {
+	if n == 0 {
+		mmListAuthorizationCodesByUserID.mock.t.Fatalf("Times of OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuthorizationCodesByUserID.expectedInvocations, n)
+	mmListAuthorizationCodesByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAuthorizationCodesByUserID
+}
+
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) invocationsDone() bool {
+	if len(mmListAuthorizationCodesByUserID.expectations) == 0 && mmListAuthorizationCodesByUserID.defaultExpectation == nil && mmListAuthorizationCodesByUserID.mock.funcListAuthorizationCodesByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuthorizationCodesByUserID.mock.afterListAuthorizationCodesByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuthorizationCodesByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuthorizationCodesByUserID implements mm_repository.OAuthAuthorizationCodeRepository
+func (mmListAuthorizationCodesByUserID *OAuthAuthorizationCodeRepositoryMock) ListAuthorizationCodesByUserID(ctx context.Context, userID int64) (opa1 []*model.OAuthAuthorizationCode, err error) {
+	mm_atomic.AddUint64(&mmListAuthorizationCodesByUserID.beforeListAuthorizationCodesByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuthorizationCodesByUserID.afterListAuthorizationCodesByUserIDCounter, 1)
+
+	mmListAuthorizationCodesByUserID.t.Helper()
+
+	if mmListAuthorizationCodesByUserID.inspectFuncListAuthorizationCodesByUserID != nil {
+		mmListAuthorizationCodesByUserID.inspectFuncListAuthorizationCodesByUserID(ctx, userID)
+	}
+
+	mm_params := OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.mutex.Lock()
+	mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.callArgs = append(mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.callArgs, &mm_params)
+	mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuthorizationCodesByUserID.t.Errorf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListAuthorizationCodesByUserID.t.Errorf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuthorizationCodesByUserID.t.Errorf("OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuthorizationCodesByUserID.ListAuthorizationCodesByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuthorizationCodesByUserID.t.Fatal("No results are set for the OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmListAuthorizationCodesByUserID.funcListAuthorizationCodesByUserID != nil {
+		return mmListAuthorizationCodesByUserID.funcListAuthorizationCodesByUserID(ctx, userID)
+	}
+	mmListAuthorizationCodesByUserID.t.Fatalf("Unexpected call to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID. %v %v", ctx, userID)
+	return
+}
+
+// ListAuthorizationCodesByUserIDAfterCounter returns a count of finished OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID invocations
+func (mmListAuthorizationCodesByUserID *OAuthAuthorizationCodeRepositoryMock) ListAuthorizationCodesByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuthorizationCodesByUserID.afterListAuthorizationCodesByUserIDCounter)
+}
+
+// ListAuthorizationCodesByUserIDBeforeCounter returns a count of OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID invocations
+func (mmListAuthorizationCodesByUserID *OAuthAuthorizationCodeRepositoryMock) ListAuthorizationCodesByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuthorizationCodesByUserID.beforeListAuthorizationCodesByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuthorizationCodesByUserID *mOAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserID) Calls() []*OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams {
+	mmListAuthorizationCodesByUserID.mutex.RLock()
+
+	argCopy := make([]*OAuthAuthorizationCodeRepositoryMockListAuthorizationCodesByUserIDParams, len(mmListAuthorizationCodesByUserID.callArgs))
+	copy(argCopy, mmListAuthorizationCodesByUserID.callArgs)
+
+	mmListAuthorizationCodesByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuthorizationCodesByUserIDDone returns true if the count of the ListAuthorizationCodesByUserID invocations corresponds
+// the number of defined expectations
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockListAuthorizationCodesByUserIDDone() bool {
+	if m.ListAuthorizationCodesByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuthorizationCodesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuthorizationCodesByUserIDMock.invocationsDone()
+}
+
+// MinimockListAuthorizationCodesByUserIDInspect logs each unmet expectation
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockListAuthorizationCodesByUserIDInspect() {
+	for _, e := range m.ListAuthorizationCodesByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAuthorizationCodesByUserIDCounter := mm_atomic.LoadUint64(&m.afterListAuthorizationCodesByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuthorizationCodesByUserIDMock.defaultExpectation != nil && afterListAuthorizationCodesByUserIDCounter < 1 {
+		if m.ListAuthorizationCodesByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID at\n%s", m.ListAuthorizationCodesByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID at\n%s with params: %#v", m.ListAuthorizationCodesByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.ListAuthorizationCodesByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuthorizationCodesByUserID != nil && afterListAuthorizationCodesByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID at\n%s", m.funcListAuthorizationCodesByUserIDOrigin)
+	}
+
+	if !m.ListAuthorizationCodesByUserIDMock.invocationsDone() && afterListAuthorizationCodesByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthAuthorizationCodeRepositoryMock.ListAuthorizationCodesByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuthorizationCodesByUserIDMock.expectedInvocations), m.ListAuthorizationCodesByUserIDMock.expectedInvocationsOrigin, afterListAuthorizationCodesByUserIDCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeAuthorizationCodeInspect()
+
+			m.MinimockCreateAuthorizationCodeInspect()
+
+			m.MinimockDeleteAuthorizationCodesByUserIDInspect()
+
+			m.MinimockDeleteExpiredAuthorizationCodesInspect()
+
+			m.MinimockListAuthorizationCodesByUserIDInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OAuthAuthorizationCodeRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OAuthAuthorizationCodeRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeAuthorizationCodeDone() &&
+		m.MinimockCreateAuthorizationCodeDone() &&
+		m.MinimockDeleteAuthorizationCodesByUserIDDone() &&
+		m.MinimockDeleteExpiredAuthorizationCodesDone() &&
+		m.MinimockListAuthorizationCodesByUserIDDone()
+}
@@ -0,0 +1,1454 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.OAuthClientRepository -o o_auth_client_repository_minimock.go -n OAuthClientRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// OAuthClientRepositoryMock implements mm_repository.OAuthClientRepository
+type OAuthClientRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateOAuthClient          func(ctx context.Context, client *model.OAuthClient) (i1 int64, err error)
+	funcCreateOAuthClientOrigin    string
+	inspectFuncCreateOAuthClient   func(ctx context.Context, client *model.OAuthClient)
+	afterCreateOAuthClientCounter  uint64
+	beforeCreateOAuthClientCounter uint64
+	CreateOAuthClientMock          mOAuthClientRepositoryMockCreateOAuthClient
+
+	funcDeleteOAuthClient          func(ctx context.Context, clientID string) (err error)
+	funcDeleteOAuthClientOrigin    string
+	inspectFuncDeleteOAuthClient   func(ctx context.Context, clientID string)
+	afterDeleteOAuthClientCounter  uint64
+	beforeDeleteOAuthClientCounter uint64
+	DeleteOAuthClientMock          mOAuthClientRepositoryMockDeleteOAuthClient
+
+	funcGetOAuthClient          func(ctx context.Context, clientID string) (op1 *model.OAuthClient, err error)
+	funcGetOAuthClientOrigin    string
+	inspectFuncGetOAuthClient   func(ctx context.Context, clientID string)
+	afterGetOAuthClientCounter  uint64
+	beforeGetOAuthClientCounter uint64
+	GetOAuthClientMock          mOAuthClientRepositoryMockGetOAuthClient
+
+	funcListOAuthClients          func(ctx context.Context) (opa1 []*model.OAuthClient, err error)
+	funcListOAuthClientsOrigin    string
+	inspectFuncListOAuthClients   func(ctx context.Context)
+	afterListOAuthClientsCounter  uint64
+	beforeListOAuthClientsCounter uint64
+	ListOAuthClientsMock          mOAuthClientRepositoryMockListOAuthClients
+}
+
+// NewOAuthClientRepositoryMock returns a mock for mm_repository.OAuthClientRepository
+func NewOAuthClientRepositoryMock(t minimock.Tester) *OAuthClientRepositoryMock {
+	m := &OAuthClientRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateOAuthClientMock = mOAuthClientRepositoryMockCreateOAuthClient{mock: m}
+	m.CreateOAuthClientMock.callArgs = []*OAuthClientRepositoryMockCreateOAuthClientParams{}
+
+	m.DeleteOAuthClientMock = mOAuthClientRepositoryMockDeleteOAuthClient{mock: m}
+	m.DeleteOAuthClientMock.callArgs = []*OAuthClientRepositoryMockDeleteOAuthClientParams{}
+
+	m.GetOAuthClientMock = mOAuthClientRepositoryMockGetOAuthClient{mock: m}
+	m.GetOAuthClientMock.callArgs = []*OAuthClientRepositoryMockGetOAuthClientParams{}
+
+	m.ListOAuthClientsMock = mOAuthClientRepositoryMockListOAuthClients{mock: m}
+	m.ListOAuthClientsMock.callArgs = []*OAuthClientRepositoryMockListOAuthClientsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOAuthClientRepositoryMockCreateOAuthClient struct {
+	optional           bool
+	mock               *OAuthClientRepositoryMock
+	defaultExpectation *OAuthClientRepositoryMockCreateOAuthClientExpectation
+	expectations       []*OAuthClientRepositoryMockCreateOAuthClientExpectation
+
+	callArgs []*OAuthClientRepositoryMockCreateOAuthClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientRepositoryMockCreateOAuthClientExpectation specifies expectation struct of the OAuthClientRepository.CreateOAuthClient
+type OAuthClientRepositoryMockCreateOAuthClientExpectation struct {
+	mock               *OAuthClientRepositoryMock
+	params             *OAuthClientRepositoryMockCreateOAuthClientParams
+	paramPtrs          *OAuthClientRepositoryMockCreateOAuthClientParamPtrs
+	expectationOrigins OAuthClientRepositoryMockCreateOAuthClientExpectationOrigins
+	results            *OAuthClientRepositoryMockCreateOAuthClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientRepositoryMockCreateOAuthClientParams contains parameters of the OAuthClientRepository.CreateOAuthClient
+type OAuthClientRepositoryMockCreateOAuthClientParams struct {
+	ctx    context.Context
+	client *model.OAuthClient
+}
+
+// OAuthClientRepositoryMockCreateOAuthClientParamPtrs contains pointers to parameters of the OAuthClientRepository.CreateOAuthClient
+type OAuthClientRepositoryMockCreateOAuthClientParamPtrs struct {
+	ctx    *context.Context
+	client **model.OAuthClient
+}
+
+// OAuthClientRepositoryMockCreateOAuthClientResults contains results of the OAuthClientRepository.CreateOAuthClient
+type OAuthClientRepositoryMockCreateOAuthClientResults struct {
+	i1  int64
+	err error
+}
+
+// OAuthClientRepositoryMockCreateOAuthClientOrigins contains origins of expectations of the OAuthClientRepository.CreateOAuthClient
+type OAuthClientRepositoryMockCreateOAuthClientExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClient string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Optional() *mOAuthClientRepositoryMockCreateOAuthClient {
+	mmCreateOAuthClient.optional = true
+	return mmCreateOAuthClient
+}
+
+// Expect sets up expected params for OAuthClientRepository.CreateOAuthClient
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Expect(ctx context.Context, client *model.OAuthClient) *mOAuthClientRepositoryMockCreateOAuthClient {
+	if mmCreateOAuthClient.mock.funcCreateOAuthClient != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Set")
+	}
+
+	if mmCreateOAuthClient.defaultExpectation == nil {
+		mmCreateOAuthClient.defaultExpectation = &OAuthClientRepositoryMockCreateOAuthClientExpectation{}
+	}
+
+	if mmCreateOAuthClient.defaultExpectation.paramPtrs != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOAuthClient.defaultExpectation.params = &OAuthClientRepositoryMockCreateOAuthClientParams{ctx, client}
+	mmCreateOAuthClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOAuthClient.expectations {
+		if minimock.Equal(e.params, mmCreateOAuthClient.defaultExpectation.params) {
+			mmCreateOAuthClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOAuthClient.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOAuthClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientRepository.CreateOAuthClient
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) ExpectCtxParam1(ctx context.Context) *mOAuthClientRepositoryMockCreateOAuthClient {
+	if mmCreateOAuthClient.mock.funcCreateOAuthClient != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Set")
+	}
+
+	if mmCreateOAuthClient.defaultExpectation == nil {
+		mmCreateOAuthClient.defaultExpectation = &OAuthClientRepositoryMockCreateOAuthClientExpectation{}
+	}
+
+	if mmCreateOAuthClient.defaultExpectation.params != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Expect")
+	}
+
+	if mmCreateOAuthClient.defaultExpectation.paramPtrs == nil {
+		mmCreateOAuthClient.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockCreateOAuthClientParamPtrs{}
+	}
+	mmCreateOAuthClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOAuthClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOAuthClient
+}
+
+// ExpectClientParam2 sets up expected param client for OAuthClientRepository.CreateOAuthClient
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) ExpectClientParam2(client *model.OAuthClient) *mOAuthClientRepositoryMockCreateOAuthClient {
+	if mmCreateOAuthClient.mock.funcCreateOAuthClient != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Set")
+	}
+
+	if mmCreateOAuthClient.defaultExpectation == nil {
+		mmCreateOAuthClient.defaultExpectation = &OAuthClientRepositoryMockCreateOAuthClientExpectation{}
+	}
+
+	if mmCreateOAuthClient.defaultExpectation.params != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Expect")
+	}
+
+	if mmCreateOAuthClient.defaultExpectation.paramPtrs == nil {
+		mmCreateOAuthClient.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockCreateOAuthClientParamPtrs{}
+	}
+	mmCreateOAuthClient.defaultExpectation.paramPtrs.client = &client
+	mmCreateOAuthClient.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmCreateOAuthClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientRepository.CreateOAuthClient
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Inspect(f func(ctx context.Context, client *model.OAuthClient)) *mOAuthClientRepositoryMockCreateOAuthClient {
+	if mmCreateOAuthClient.mock.inspectFuncCreateOAuthClient != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("Inspect function is already set for OAuthClientRepositoryMock.CreateOAuthClient")
+	}
+
+	mmCreateOAuthClient.mock.inspectFuncCreateOAuthClient = f
+
+	return mmCreateOAuthClient
+}
+
+// Return sets up results that will be returned by OAuthClientRepository.CreateOAuthClient
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Return(i1 int64, err error) *OAuthClientRepositoryMock {
+	if mmCreateOAuthClient.mock.funcCreateOAuthClient != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Set")
+	}
+
+	if mmCreateOAuthClient.defaultExpectation == nil {
+		mmCreateOAuthClient.defaultExpectation = &OAuthClientRepositoryMockCreateOAuthClientExpectation{mock: mmCreateOAuthClient.mock}
+	}
+	mmCreateOAuthClient.defaultExpectation.results = &OAuthClientRepositoryMockCreateOAuthClientResults{i1, err}
+	mmCreateOAuthClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOAuthClient.mock
+}
+
+// Set uses given function f to mock the OAuthClientRepository.CreateOAuthClient method
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Set(f func(ctx context.Context, client *model.OAuthClient) (i1 int64, err error)) *OAuthClientRepositoryMock {
+	if mmCreateOAuthClient.defaultExpectation != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("Default expectation is already set for the OAuthClientRepository.CreateOAuthClient method")
+	}
+
+	if len(mmCreateOAuthClient.expectations) > 0 {
+		mmCreateOAuthClient.mock.t.Fatalf("Some expectations are already set for the OAuthClientRepository.CreateOAuthClient method")
+	}
+
+	mmCreateOAuthClient.mock.funcCreateOAuthClient = f
+	mmCreateOAuthClient.mock.funcCreateOAuthClientOrigin = minimock.CallerInfo(1)
+	return mmCreateOAuthClient.mock
+}
+
+// When sets expectation for the OAuthClientRepository.CreateOAuthClient which will trigger the result defined by the following
+// Then helper
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) When(ctx context.Context, client *model.OAuthClient) *OAuthClientRepositoryMockCreateOAuthClientExpectation {
+	if mmCreateOAuthClient.mock.funcCreateOAuthClient != nil {
+		mmCreateOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.CreateOAuthClient mock is already set by Set")
+	}
+
+	expectation := &OAuthClientRepositoryMockCreateOAuthClientExpectation{
+		mock:               mmCreateOAuthClient.mock,
+		params:             &OAuthClientRepositoryMockCreateOAuthClientParams{ctx, client},
+		expectationOrigins: OAuthClientRepositoryMockCreateOAuthClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOAuthClient.expectations = append(mmCreateOAuthClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientRepository.CreateOAuthClient return parameters for the expectation previously defined by the When method
+func (e *OAuthClientRepositoryMockCreateOAuthClientExpectation) Then(i1 int64, err error) *OAuthClientRepositoryMock {
+	e.results = &OAuthClientRepositoryMockCreateOAuthClientResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientRepository.CreateOAuthClient should be invoked
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Times(n uint64) *mOAuthClientRepositoryMockCreateOAuthClient {
+	if n == 0 {
+		mmCreateOAuthClient.mock.t.Fatalf("Times of OAuthClientRepositoryMock.CreateOAuthClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOAuthClient.expectedInvocations, n)
+	mmCreateOAuthClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOAuthClient
+}
+
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) invocationsDone() bool {
+	if len(mmCreateOAuthClient.expectations) == 0 && mmCreateOAuthClient.defaultExpectation == nil && mmCreateOAuthClient.mock.funcCreateOAuthClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOAuthClient.mock.afterCreateOAuthClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOAuthClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOAuthClient implements mm_repository.OAuthClientRepository
+func (mmCreateOAuthClient *OAuthClientRepositoryMock) CreateOAuthClient(ctx context.Context, client *model.OAuthClient) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateOAuthClient.beforeCreateOAuthClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOAuthClient.afterCreateOAuthClientCounter, 1)
+
+	mmCreateOAuthClient.t.Helper()
+
+	if mmCreateOAuthClient.inspectFuncCreateOAuthClient != nil {
+		mmCreateOAuthClient.inspectFuncCreateOAuthClient(ctx, client)
+	}
+
+	mm_params := OAuthClientRepositoryMockCreateOAuthClientParams{ctx, client}
+
+	// Record call args
+	mmCreateOAuthClient.CreateOAuthClientMock.mutex.Lock()
+	mmCreateOAuthClient.CreateOAuthClientMock.callArgs = append(mmCreateOAuthClient.CreateOAuthClientMock.callArgs, &mm_params)
+	mmCreateOAuthClient.CreateOAuthClientMock.mutex.Unlock()
+
+	for _, e := range mmCreateOAuthClient.CreateOAuthClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientRepositoryMockCreateOAuthClientParams{ctx, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOAuthClient.t.Errorf("OAuthClientRepositoryMock.CreateOAuthClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmCreateOAuthClient.t.Errorf("OAuthClientRepositoryMock.CreateOAuthClient got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOAuthClient.t.Errorf("OAuthClientRepositoryMock.CreateOAuthClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOAuthClient.CreateOAuthClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOAuthClient.t.Fatal("No results are set for the OAuthClientRepositoryMock.CreateOAuthClient")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateOAuthClient.funcCreateOAuthClient != nil {
+		return mmCreateOAuthClient.funcCreateOAuthClient(ctx, client)
+	}
+	mmCreateOAuthClient.t.Fatalf("Unexpected call to OAuthClientRepositoryMock.CreateOAuthClient. %v %v", ctx, client)
+	return
+}
+
+// CreateOAuthClientAfterCounter returns a count of finished OAuthClientRepositoryMock.CreateOAuthClient invocations
+func (mmCreateOAuthClient *OAuthClientRepositoryMock) CreateOAuthClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOAuthClient.afterCreateOAuthClientCounter)
+}
+
+// CreateOAuthClientBeforeCounter returns a count of OAuthClientRepositoryMock.CreateOAuthClient invocations
+func (mmCreateOAuthClient *OAuthClientRepositoryMock) CreateOAuthClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOAuthClient.beforeCreateOAuthClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientRepositoryMock.CreateOAuthClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOAuthClient *mOAuthClientRepositoryMockCreateOAuthClient) Calls() []*OAuthClientRepositoryMockCreateOAuthClientParams {
+	mmCreateOAuthClient.mutex.RLock()
+
+	argCopy := make([]*OAuthClientRepositoryMockCreateOAuthClientParams, len(mmCreateOAuthClient.callArgs))
+	copy(argCopy, mmCreateOAuthClient.callArgs)
+
+	mmCreateOAuthClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOAuthClientDone returns true if the count of the CreateOAuthClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientRepositoryMock) MinimockCreateOAuthClientDone() bool {
+	if m.CreateOAuthClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOAuthClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOAuthClientMock.invocationsDone()
+}
+
+// MinimockCreateOAuthClientInspect logs each unmet expectation
+func (m *OAuthClientRepositoryMock) MinimockCreateOAuthClientInspect() {
+	for _, e := range m.CreateOAuthClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.CreateOAuthClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOAuthClientCounter := mm_atomic.LoadUint64(&m.afterCreateOAuthClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOAuthClientMock.defaultExpectation != nil && afterCreateOAuthClientCounter < 1 {
+		if m.CreateOAuthClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.CreateOAuthClient at\n%s", m.CreateOAuthClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.CreateOAuthClient at\n%s with params: %#v", m.CreateOAuthClientMock.defaultExpectation.expectationOrigins.origin, *m.CreateOAuthClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOAuthClient != nil && afterCreateOAuthClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientRepositoryMock.CreateOAuthClient at\n%s", m.funcCreateOAuthClientOrigin)
+	}
+
+	if !m.CreateOAuthClientMock.invocationsDone() && afterCreateOAuthClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientRepositoryMock.CreateOAuthClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOAuthClientMock.expectedInvocations), m.CreateOAuthClientMock.expectedInvocationsOrigin, afterCreateOAuthClientCounter)
+	}
+}
+
+type mOAuthClientRepositoryMockDeleteOAuthClient struct {
+	optional           bool
+	mock               *OAuthClientRepositoryMock
+	defaultExpectation *OAuthClientRepositoryMockDeleteOAuthClientExpectation
+	expectations       []*OAuthClientRepositoryMockDeleteOAuthClientExpectation
+
+	callArgs []*OAuthClientRepositoryMockDeleteOAuthClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientRepositoryMockDeleteOAuthClientExpectation specifies expectation struct of the OAuthClientRepository.DeleteOAuthClient
+type OAuthClientRepositoryMockDeleteOAuthClientExpectation struct {
+	mock               *OAuthClientRepositoryMock
+	params             *OAuthClientRepositoryMockDeleteOAuthClientParams
+	paramPtrs          *OAuthClientRepositoryMockDeleteOAuthClientParamPtrs
+	expectationOrigins OAuthClientRepositoryMockDeleteOAuthClientExpectationOrigins
+	results            *OAuthClientRepositoryMockDeleteOAuthClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientRepositoryMockDeleteOAuthClientParams contains parameters of the OAuthClientRepository.DeleteOAuthClient
+type OAuthClientRepositoryMockDeleteOAuthClientParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// OAuthClientRepositoryMockDeleteOAuthClientParamPtrs contains pointers to parameters of the OAuthClientRepository.DeleteOAuthClient
+type OAuthClientRepositoryMockDeleteOAuthClientParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// OAuthClientRepositoryMockDeleteOAuthClientResults contains results of the OAuthClientRepository.DeleteOAuthClient
+type OAuthClientRepositoryMockDeleteOAuthClientResults struct {
+	err error
+}
+
+// OAuthClientRepositoryMockDeleteOAuthClientOrigins contains origins of expectations of the OAuthClientRepository.DeleteOAuthClient
+type OAuthClientRepositoryMockDeleteOAuthClientExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Optional() *mOAuthClientRepositoryMockDeleteOAuthClient {
+	mmDeleteOAuthClient.optional = true
+	return mmDeleteOAuthClient
+}
+
+// Expect sets up expected params for OAuthClientRepository.DeleteOAuthClient
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Expect(ctx context.Context, clientID string) *mOAuthClientRepositoryMockDeleteOAuthClient {
+	if mmDeleteOAuthClient.mock.funcDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Set")
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation == nil {
+		mmDeleteOAuthClient.defaultExpectation = &OAuthClientRepositoryMockDeleteOAuthClientExpectation{}
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation.paramPtrs != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOAuthClient.defaultExpectation.params = &OAuthClientRepositoryMockDeleteOAuthClientParams{ctx, clientID}
+	mmDeleteOAuthClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOAuthClient.expectations {
+		if minimock.Equal(e.params, mmDeleteOAuthClient.defaultExpectation.params) {
+			mmDeleteOAuthClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOAuthClient.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOAuthClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientRepository.DeleteOAuthClient
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) ExpectCtxParam1(ctx context.Context) *mOAuthClientRepositoryMockDeleteOAuthClient {
+	if mmDeleteOAuthClient.mock.funcDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Set")
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation == nil {
+		mmDeleteOAuthClient.defaultExpectation = &OAuthClientRepositoryMockDeleteOAuthClientExpectation{}
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation.params != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Expect")
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation.paramPtrs == nil {
+		mmDeleteOAuthClient.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockDeleteOAuthClientParamPtrs{}
+	}
+	mmDeleteOAuthClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOAuthClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOAuthClient
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for OAuthClientRepository.DeleteOAuthClient
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) ExpectClientIDParam2(clientID string) *mOAuthClientRepositoryMockDeleteOAuthClient {
+	if mmDeleteOAuthClient.mock.funcDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Set")
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation == nil {
+		mmDeleteOAuthClient.defaultExpectation = &OAuthClientRepositoryMockDeleteOAuthClientExpectation{}
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation.params != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Expect")
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation.paramPtrs == nil {
+		mmDeleteOAuthClient.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockDeleteOAuthClientParamPtrs{}
+	}
+	mmDeleteOAuthClient.defaultExpectation.paramPtrs.clientID = &clientID
+	mmDeleteOAuthClient.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmDeleteOAuthClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientRepository.DeleteOAuthClient
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Inspect(f func(ctx context.Context, clientID string)) *mOAuthClientRepositoryMockDeleteOAuthClient {
+	if mmDeleteOAuthClient.mock.inspectFuncDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("Inspect function is already set for OAuthClientRepositoryMock.DeleteOAuthClient")
+	}
+
+	mmDeleteOAuthClient.mock.inspectFuncDeleteOAuthClient = f
+
+	return mmDeleteOAuthClient
+}
+
+// Return sets up results that will be returned by OAuthClientRepository.DeleteOAuthClient
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Return(err error) *OAuthClientRepositoryMock {
+	if mmDeleteOAuthClient.mock.funcDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Set")
+	}
+
+	if mmDeleteOAuthClient.defaultExpectation == nil {
+		mmDeleteOAuthClient.defaultExpectation = &OAuthClientRepositoryMockDeleteOAuthClientExpectation{mock: mmDeleteOAuthClient.mock}
+	}
+	mmDeleteOAuthClient.defaultExpectation.results = &OAuthClientRepositoryMockDeleteOAuthClientResults{err}
+	mmDeleteOAuthClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOAuthClient.mock
+}
+
+// Set uses given function f to mock the OAuthClientRepository.DeleteOAuthClient method
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Set(f func(ctx context.Context, clientID string) (err error)) *OAuthClientRepositoryMock {
+	if mmDeleteOAuthClient.defaultExpectation != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("Default expectation is already set for the OAuthClientRepository.DeleteOAuthClient method")
+	}
+
+	if len(mmDeleteOAuthClient.expectations) > 0 {
+		mmDeleteOAuthClient.mock.t.Fatalf("Some expectations are already set for the OAuthClientRepository.DeleteOAuthClient method")
+	}
+
+	mmDeleteOAuthClient.mock.funcDeleteOAuthClient = f
+	mmDeleteOAuthClient.mock.funcDeleteOAuthClientOrigin = minimock.CallerInfo(1)
+	return mmDeleteOAuthClient.mock
+}
+
+// When sets expectation for the OAuthClientRepository.DeleteOAuthClient which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) When(ctx context.Context, clientID string) *OAuthClientRepositoryMockDeleteOAuthClientExpectation {
+	if mmDeleteOAuthClient.mock.funcDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.DeleteOAuthClient mock is already set by Set")
+	}
+
+	expectation := &OAuthClientRepositoryMockDeleteOAuthClientExpectation{
+		mock:               mmDeleteOAuthClient.mock,
+		params:             &OAuthClientRepositoryMockDeleteOAuthClientParams{ctx, clientID},
+		expectationOrigins: OAuthClientRepositoryMockDeleteOAuthClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOAuthClient.expectations = append(mmDeleteOAuthClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientRepository.DeleteOAuthClient return parameters for the expectation previously defined by the When method
+func (e *OAuthClientRepositoryMockDeleteOAuthClientExpectation) Then(err error) *OAuthClientRepositoryMock {
+	e.results = &OAuthClientRepositoryMockDeleteOAuthClientResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientRepository.DeleteOAuthClient should be invoked
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Times(n uint64) *mOAuthClientRepositoryMockDeleteOAuthClient {
+	if n == 0 {
+		mmDeleteOAuthClient.mock.t.Fatalf("Times of OAuthClientRepositoryMock.DeleteOAuthClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOAuthClient.expectedInvocations, n)
+	mmDeleteOAuthClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOAuthClient
+}
+
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) invocationsDone() bool {
+	if len(mmDeleteOAuthClient.expectations) == 0 && mmDeleteOAuthClient.defaultExpectation == nil && mmDeleteOAuthClient.mock.funcDeleteOAuthClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOAuthClient.mock.afterDeleteOAuthClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOAuthClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOAuthClient implements mm_repository.OAuthClientRepository
+func (mmDeleteOAuthClient *OAuthClientRepositoryMock) DeleteOAuthClient(ctx context.Context, clientID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOAuthClient.beforeDeleteOAuthClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOAuthClient.afterDeleteOAuthClientCounter, 1)
+
+	mmDeleteOAuthClient.t.Helper()
+
+	if mmDeleteOAuthClient.inspectFuncDeleteOAuthClient != nil {
+		mmDeleteOAuthClient.inspectFuncDeleteOAuthClient(ctx, clientID)
+	}
+
+	mm_params := OAuthClientRepositoryMockDeleteOAuthClientParams{ctx, clientID}
+
+	// Record call args
+	mmDeleteOAuthClient.DeleteOAuthClientMock.mutex.Lock()
+	mmDeleteOAuthClient.DeleteOAuthClientMock.callArgs = append(mmDeleteOAuthClient.DeleteOAuthClientMock.callArgs, &mm_params)
+	mmDeleteOAuthClient.DeleteOAuthClientMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOAuthClient.DeleteOAuthClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientRepositoryMockDeleteOAuthClientParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOAuthClient.t.Errorf("OAuthClientRepositoryMock.DeleteOAuthClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmDeleteOAuthClient.t.Errorf("OAuthClientRepositoryMock.DeleteOAuthClient got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOAuthClient.t.Errorf("OAuthClientRepositoryMock.DeleteOAuthClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOAuthClient.DeleteOAuthClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOAuthClient.t.Fatal("No results are set for the OAuthClientRepositoryMock.DeleteOAuthClient")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOAuthClient.funcDeleteOAuthClient != nil {
+		return mmDeleteOAuthClient.funcDeleteOAuthClient(ctx, clientID)
+	}
+	mmDeleteOAuthClient.t.Fatalf("Unexpected call to OAuthClientRepositoryMock.DeleteOAuthClient. %v %v", ctx, clientID)
+	return
+}
+
+// DeleteOAuthClientAfterCounter returns a count of finished OAuthClientRepositoryMock.DeleteOAuthClient invocations
+func (mmDeleteOAuthClient *OAuthClientRepositoryMock) DeleteOAuthClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOAuthClient.afterDeleteOAuthClientCounter)
+}
+
+// DeleteOAuthClientBeforeCounter returns a count of OAuthClientRepositoryMock.DeleteOAuthClient invocations
+func (mmDeleteOAuthClient *OAuthClientRepositoryMock) DeleteOAuthClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOAuthClient.beforeDeleteOAuthClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientRepositoryMock.DeleteOAuthClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOAuthClient *mOAuthClientRepositoryMockDeleteOAuthClient) Calls() []*OAuthClientRepositoryMockDeleteOAuthClientParams {
+	mmDeleteOAuthClient.mutex.RLock()
+
+	argCopy := make([]*OAuthClientRepositoryMockDeleteOAuthClientParams, len(mmDeleteOAuthClient.callArgs))
+	copy(argCopy, mmDeleteOAuthClient.callArgs)
+
+	mmDeleteOAuthClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOAuthClientDone returns true if the count of the DeleteOAuthClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientRepositoryMock) MinimockDeleteOAuthClientDone() bool {
+	if m.DeleteOAuthClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOAuthClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOAuthClientMock.invocationsDone()
+}
+
+// MinimockDeleteOAuthClientInspect logs each unmet expectation
+func (m *OAuthClientRepositoryMock) MinimockDeleteOAuthClientInspect() {
+	for _, e := range m.DeleteOAuthClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.DeleteOAuthClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOAuthClientCounter := mm_atomic.LoadUint64(&m.afterDeleteOAuthClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOAuthClientMock.defaultExpectation != nil && afterDeleteOAuthClientCounter < 1 {
+		if m.DeleteOAuthClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.DeleteOAuthClient at\n%s", m.DeleteOAuthClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.DeleteOAuthClient at\n%s with params: %#v", m.DeleteOAuthClientMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOAuthClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOAuthClient != nil && afterDeleteOAuthClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientRepositoryMock.DeleteOAuthClient at\n%s", m.funcDeleteOAuthClientOrigin)
+	}
+
+	if !m.DeleteOAuthClientMock.invocationsDone() && afterDeleteOAuthClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientRepositoryMock.DeleteOAuthClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOAuthClientMock.expectedInvocations), m.DeleteOAuthClientMock.expectedInvocationsOrigin, afterDeleteOAuthClientCounter)
+	}
+}
+
+type mOAuthClientRepositoryMockGetOAuthClient struct {
+	optional           bool
+	mock               *OAuthClientRepositoryMock
+	defaultExpectation *OAuthClientRepositoryMockGetOAuthClientExpectation
+	expectations       []*OAuthClientRepositoryMockGetOAuthClientExpectation
+
+	callArgs []*OAuthClientRepositoryMockGetOAuthClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientRepositoryMockGetOAuthClientExpectation specifies expectation struct of the OAuthClientRepository.GetOAuthClient
+type OAuthClientRepositoryMockGetOAuthClientExpectation struct {
+	mock               *OAuthClientRepositoryMock
+	params             *OAuthClientRepositoryMockGetOAuthClientParams
+	paramPtrs          *OAuthClientRepositoryMockGetOAuthClientParamPtrs
+	expectationOrigins OAuthClientRepositoryMockGetOAuthClientExpectationOrigins
+	results            *OAuthClientRepositoryMockGetOAuthClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientRepositoryMockGetOAuthClientParams contains parameters of the OAuthClientRepository.GetOAuthClient
+type OAuthClientRepositoryMockGetOAuthClientParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// OAuthClientRepositoryMockGetOAuthClientParamPtrs contains pointers to parameters of the OAuthClientRepository.GetOAuthClient
+type OAuthClientRepositoryMockGetOAuthClientParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// OAuthClientRepositoryMockGetOAuthClientResults contains results of the OAuthClientRepository.GetOAuthClient
+type OAuthClientRepositoryMockGetOAuthClientResults struct {
+	op1 *model.OAuthClient
+	err error
+}
+
+// OAuthClientRepositoryMockGetOAuthClientOrigins contains origins of expectations of the OAuthClientRepository.GetOAuthClient
+type OAuthClientRepositoryMockGetOAuthClientExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Optional() *mOAuthClientRepositoryMockGetOAuthClient {
+	mmGetOAuthClient.optional = true
+	return mmGetOAuthClient
+}
+
+// Expect sets up expected params for OAuthClientRepository.GetOAuthClient
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Expect(ctx context.Context, clientID string) *mOAuthClientRepositoryMockGetOAuthClient {
+	if mmGetOAuthClient.mock.funcGetOAuthClient != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Set")
+	}
+
+	if mmGetOAuthClient.defaultExpectation == nil {
+		mmGetOAuthClient.defaultExpectation = &OAuthClientRepositoryMockGetOAuthClientExpectation{}
+	}
+
+	if mmGetOAuthClient.defaultExpectation.paramPtrs != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by ExpectParams functions")
+	}
+
+	mmGetOAuthClient.defaultExpectation.params = &OAuthClientRepositoryMockGetOAuthClientParams{ctx, clientID}
+	mmGetOAuthClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOAuthClient.expectations {
+		if minimock.Equal(e.params, mmGetOAuthClient.defaultExpectation.params) {
+			mmGetOAuthClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOAuthClient.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOAuthClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientRepository.GetOAuthClient
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) ExpectCtxParam1(ctx context.Context) *mOAuthClientRepositoryMockGetOAuthClient {
+	if mmGetOAuthClient.mock.funcGetOAuthClient != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Set")
+	}
+
+	if mmGetOAuthClient.defaultExpectation == nil {
+		mmGetOAuthClient.defaultExpectation = &OAuthClientRepositoryMockGetOAuthClientExpectation{}
+	}
+
+	if mmGetOAuthClient.defaultExpectation.params != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Expect")
+	}
+
+	if mmGetOAuthClient.defaultExpectation.paramPtrs == nil {
+		mmGetOAuthClient.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockGetOAuthClientParamPtrs{}
+	}
+	mmGetOAuthClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOAuthClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOAuthClient
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for OAuthClientRepository.GetOAuthClient
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) ExpectClientIDParam2(clientID string) *mOAuthClientRepositoryMockGetOAuthClient {
+	if mmGetOAuthClient.mock.funcGetOAuthClient != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Set")
+	}
+
+	if mmGetOAuthClient.defaultExpectation == nil {
+		mmGetOAuthClient.defaultExpectation = &OAuthClientRepositoryMockGetOAuthClientExpectation{}
+	}
+
+	if mmGetOAuthClient.defaultExpectation.params != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Expect")
+	}
+
+	if mmGetOAuthClient.defaultExpectation.paramPtrs == nil {
+		mmGetOAuthClient.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockGetOAuthClientParamPtrs{}
+	}
+	mmGetOAuthClient.defaultExpectation.paramPtrs.clientID = &clientID
+	mmGetOAuthClient.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmGetOAuthClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientRepository.GetOAuthClient
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Inspect(f func(ctx context.Context, clientID string)) *mOAuthClientRepositoryMockGetOAuthClient {
+	if mmGetOAuthClient.mock.inspectFuncGetOAuthClient != nil {
+		mmGetOAuthClient.mock.t.Fatalf("Inspect function is already set for OAuthClientRepositoryMock.GetOAuthClient")
+	}
+
+	mmGetOAuthClient.mock.inspectFuncGetOAuthClient = f
+
+	return mmGetOAuthClient
+}
+
+// Return sets up results that will be returned by OAuthClientRepository.GetOAuthClient
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Return(op1 *model.OAuthClient, err error) *OAuthClientRepositoryMock {
+	if mmGetOAuthClient.mock.funcGetOAuthClient != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Set")
+	}
+
+	if mmGetOAuthClient.defaultExpectation == nil {
+		mmGetOAuthClient.defaultExpectation = &OAuthClientRepositoryMockGetOAuthClientExpectation{mock: mmGetOAuthClient.mock}
+	}
+	mmGetOAuthClient.defaultExpectation.results = &OAuthClientRepositoryMockGetOAuthClientResults{op1, err}
+	mmGetOAuthClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOAuthClient.mock
+}
+
+// Set uses given function f to mock the OAuthClientRepository.GetOAuthClient method
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Set(f func(ctx context.Context, clientID string) (op1 *model.OAuthClient, err error)) *OAuthClientRepositoryMock {
+	if mmGetOAuthClient.defaultExpectation != nil {
+		mmGetOAuthClient.mock.t.Fatalf("Default expectation is already set for the OAuthClientRepository.GetOAuthClient method")
+	}
+
+	if len(mmGetOAuthClient.expectations) > 0 {
+		mmGetOAuthClient.mock.t.Fatalf("Some expectations are already set for the OAuthClientRepository.GetOAuthClient method")
+	}
+
+	mmGetOAuthClient.mock.funcGetOAuthClient = f
+	mmGetOAuthClient.mock.funcGetOAuthClientOrigin = minimock.CallerInfo(1)
+	return mmGetOAuthClient.mock
+}
+
+// When sets expectation for the OAuthClientRepository.GetOAuthClient which will trigger the result defined by the following
+// Then helper
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) When(ctx context.Context, clientID string) *OAuthClientRepositoryMockGetOAuthClientExpectation {
+	if mmGetOAuthClient.mock.funcGetOAuthClient != nil {
+		mmGetOAuthClient.mock.t.Fatalf("OAuthClientRepositoryMock.GetOAuthClient mock is already set by Set")
+	}
+
+	expectation := &OAuthClientRepositoryMockGetOAuthClientExpectation{
+		mock:               mmGetOAuthClient.mock,
+		params:             &OAuthClientRepositoryMockGetOAuthClientParams{ctx, clientID},
+		expectationOrigins: OAuthClientRepositoryMockGetOAuthClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOAuthClient.expectations = append(mmGetOAuthClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientRepository.GetOAuthClient return parameters for the expectation previously defined by the When method
+func (e *OAuthClientRepositoryMockGetOAuthClientExpectation) Then(op1 *model.OAuthClient, err error) *OAuthClientRepositoryMock {
+	e.results = &OAuthClientRepositoryMockGetOAuthClientResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientRepository.GetOAuthClient should be invoked
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Times(n uint64) *mOAuthClientRepositoryMockGetOAuthClient {
+	if n == 0 {
+		mmGetOAuthClient.mock.t.Fatalf("Times of OAuthClientRepositoryMock.GetOAuthClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOAuthClient.expectedInvocations, n)
+	mmGetOAuthClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOAuthClient
+}
+
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) invocationsDone() bool {
+	if len(mmGetOAuthClient.expectations) == 0 && mmGetOAuthClient.defaultExpectation == nil && mmGetOAuthClient.mock.funcGetOAuthClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOAuthClient.mock.afterGetOAuthClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOAuthClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOAuthClient implements mm_repository.OAuthClientRepository
+func (mmGetOAuthClient *OAuthClientRepositoryMock) GetOAuthClient(ctx context.Context, clientID string) (op1 *model.OAuthClient, err error) {
+	mm_atomic.AddUint64(&mmGetOAuthClient.beforeGetOAuthClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOAuthClient.afterGetOAuthClientCounter, 1)
+
+	mmGetOAuthClient.t.Helper()
+
+	if mmGetOAuthClient.inspectFuncGetOAuthClient != nil {
+		mmGetOAuthClient.inspectFuncGetOAuthClient(ctx, clientID)
+	}
+
+	mm_params := OAuthClientRepositoryMockGetOAuthClientParams{ctx, clientID}
+
+	// Record call args
+	mmGetOAuthClient.GetOAuthClientMock.mutex.Lock()
+	mmGetOAuthClient.GetOAuthClientMock.callArgs = append(mmGetOAuthClient.GetOAuthClientMock.callArgs, &mm_params)
+	mmGetOAuthClient.GetOAuthClientMock.mutex.Unlock()
+
+	for _, e := range mmGetOAuthClient.GetOAuthClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOAuthClient.GetOAuthClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientRepositoryMockGetOAuthClientParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOAuthClient.t.Errorf("OAuthClientRepositoryMock.GetOAuthClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmGetOAuthClient.t.Errorf("OAuthClientRepositoryMock.GetOAuthClient got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOAuthClient.t.Errorf("OAuthClientRepositoryMock.GetOAuthClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOAuthClient.GetOAuthClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOAuthClient.t.Fatal("No results are set for the OAuthClientRepositoryMock.GetOAuthClient")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOAuthClient.funcGetOAuthClient != nil {
+		return mmGetOAuthClient.funcGetOAuthClient(ctx, clientID)
+	}
+	mmGetOAuthClient.t.Fatalf("Unexpected call to OAuthClientRepositoryMock.GetOAuthClient. %v %v", ctx, clientID)
+	return
+}
+
+// GetOAuthClientAfterCounter returns a count of finished OAuthClientRepositoryMock.GetOAuthClient invocations
+func (mmGetOAuthClient *OAuthClientRepositoryMock) GetOAuthClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOAuthClient.afterGetOAuthClientCounter)
+}
+
+// GetOAuthClientBeforeCounter returns a count of OAuthClientRepositoryMock.GetOAuthClient invocations
+func (mmGetOAuthClient *OAuthClientRepositoryMock) GetOAuthClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOAuthClient.beforeGetOAuthClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientRepositoryMock.GetOAuthClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOAuthClient *mOAuthClientRepositoryMockGetOAuthClient) Calls() []*OAuthClientRepositoryMockGetOAuthClientParams {
+	mmGetOAuthClient.mutex.RLock()
+
+	argCopy := make([]*OAuthClientRepositoryMockGetOAuthClientParams, len(mmGetOAuthClient.callArgs))
+	copy(argCopy, mmGetOAuthClient.callArgs)
+
+	mmGetOAuthClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOAuthClientDone returns true if the count of the GetOAuthClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientRepositoryMock) MinimockGetOAuthClientDone() bool {
+	if m.GetOAuthClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOAuthClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOAuthClientMock.invocationsDone()
+}
+
+// MinimockGetOAuthClientInspect logs each unmet expectation
+func (m *OAuthClientRepositoryMock) MinimockGetOAuthClientInspect() {
+	for _, e := range m.GetOAuthClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.GetOAuthClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOAuthClientCounter := mm_atomic.LoadUint64(&m.afterGetOAuthClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOAuthClientMock.defaultExpectation != nil && afterGetOAuthClientCounter < 1 {
+		if m.GetOAuthClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.GetOAuthClient at\n%s", m.GetOAuthClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.GetOAuthClient at\n%s with params: %#v", m.GetOAuthClientMock.defaultExpectation.expectationOrigins.origin, *m.GetOAuthClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOAuthClient != nil && afterGetOAuthClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientRepositoryMock.GetOAuthClient at\n%s", m.funcGetOAuthClientOrigin)
+	}
+
+	if !m.GetOAuthClientMock.invocationsDone() && afterGetOAuthClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientRepositoryMock.GetOAuthClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOAuthClientMock.expectedInvocations), m.GetOAuthClientMock.expectedInvocationsOrigin, afterGetOAuthClientCounter)
+	}
+}
+
+type mOAuthClientRepositoryMockListOAuthClients struct {
+	optional           bool
+	mock               *OAuthClientRepositoryMock
+	defaultExpectation *OAuthClientRepositoryMockListOAuthClientsExpectation
+	expectations       []*OAuthClientRepositoryMockListOAuthClientsExpectation
+
+	callArgs []*OAuthClientRepositoryMockListOAuthClientsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientRepositoryMockListOAuthClientsExpectation specifies expectation struct of the OAuthClientRepository.ListOAuthClients
+type OAuthClientRepositoryMockListOAuthClientsExpectation struct {
+	mock               *OAuthClientRepositoryMock
+	params             *OAuthClientRepositoryMockListOAuthClientsParams
+	paramPtrs          *OAuthClientRepositoryMockListOAuthClientsParamPtrs
+	expectationOrigins OAuthClientRepositoryMockListOAuthClientsExpectationOrigins
+	results            *OAuthClientRepositoryMockListOAuthClientsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientRepositoryMockListOAuthClientsParams contains parameters of the OAuthClientRepository.ListOAuthClients
+type OAuthClientRepositoryMockListOAuthClientsParams struct {
+	ctx context.Context
+}
+
+// OAuthClientRepositoryMockListOAuthClientsParamPtrs contains pointers to parameters of the OAuthClientRepository.ListOAuthClients
+type OAuthClientRepositoryMockListOAuthClientsParamPtrs struct {
+	ctx *context.Context
+}
+
+// OAuthClientRepositoryMockListOAuthClientsResults contains results of the OAuthClientRepository.ListOAuthClients
+type OAuthClientRepositoryMockListOAuthClientsResults struct {
+	opa1 []*model.OAuthClient
+	err  error
+}
+
+// OAuthClientRepositoryMockListOAuthClientsOrigins contains origins of expectations of the OAuthClientRepository.ListOAuthClients
+type OAuthClientRepositoryMockListOAuthClientsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Optional() *mOAuthClientRepositoryMockListOAuthClients {
+	mmListOAuthClients.optional = true
+	return mmListOAuthClients
+}
+
+// Expect sets up expected params for OAuthClientRepository.ListOAuthClients
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Expect(ctx context.Context) *mOAuthClientRepositoryMockListOAuthClients {
+	if mmListOAuthClients.mock.funcListOAuthClients != nil {
+		mmListOAuthClients.mock.t.Fatalf("OAuthClientRepositoryMock.ListOAuthClients mock is already set by Set")
+	}
+
+	if mmListOAuthClients.defaultExpectation == nil {
+		mmListOAuthClients.defaultExpectation = &OAuthClientRepositoryMockListOAuthClientsExpectation{}
+	}
+
+	if mmListOAuthClients.defaultExpectation.paramPtrs != nil {
+		mmListOAuthClients.mock.t.Fatalf("OAuthClientRepositoryMock.ListOAuthClients mock is already set by ExpectParams functions")
+	}
+
+	mmListOAuthClients.defaultExpectation.params = &OAuthClientRepositoryMockListOAuthClientsParams{ctx}
+	mmListOAuthClients.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOAuthClients.expectations {
+		if minimock.Equal(e.params, mmListOAuthClients.defaultExpectation.params) {
+			mmListOAuthClients.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOAuthClients.defaultExpectation.params)
+		}
+	}
+
+	return mmListOAuthClients
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientRepository.ListOAuthClients
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) ExpectCtxParam1(ctx context.Context) *mOAuthClientRepositoryMockListOAuthClients {
+	if mmListOAuthClients.mock.funcListOAuthClients != nil {
+		mmListOAuthClients.mock.t.Fatalf("OAuthClientRepositoryMock.ListOAuthClients mock is already set by Set")
+	}
+
+	if mmListOAuthClients.defaultExpectation == nil {
+		mmListOAuthClients.defaultExpectation = &OAuthClientRepositoryMockListOAuthClientsExpectation{}
+	}
+
+	if mmListOAuthClients.defaultExpectation.params != nil {
+		mmListOAuthClients.mock.t.Fatalf("OAuthClientRepositoryMock.ListOAuthClients mock is already set by Expect")
+	}
+
+	if mmListOAuthClients.defaultExpectation.paramPtrs == nil {
+		mmListOAuthClients.defaultExpectation.paramPtrs = &OAuthClientRepositoryMockListOAuthClientsParamPtrs{}
+	}
+	mmListOAuthClients.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOAuthClients.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOAuthClients
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientRepository.ListOAuthClients
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Inspect(f func(ctx context.Context)) *mOAuthClientRepositoryMockListOAuthClients {
+	if mmListOAuthClients.mock.inspectFuncListOAuthClients != nil {
+		mmListOAuthClients.mock.t.Fatalf("Inspect function is already set for OAuthClientRepositoryMock.ListOAuthClients")
+	}
+
+	mmListOAuthClients.mock.inspectFuncListOAuthClients = f
+
+	return mmListOAuthClients
+}
+
+// Return sets up results that will be returned by OAuthClientRepository.ListOAuthClients
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Return(opa1 []*model.OAuthClient, err error) *OAuthClientRepositoryMock {
+	if mmListOAuthClients.mock.funcListOAuthClients != nil {
+		mmListOAuthClients.mock.t.Fatalf("OAuthClientRepositoryMock.ListOAuthClients mock is already set by Set")
+	}
+
+	if mmListOAuthClients.defaultExpectation == nil {
+		mmListOAuthClients.defaultExpectation = &OAuthClientRepositoryMockListOAuthClientsExpectation{mock: mmListOAuthClients.mock}
+	}
+	mmListOAuthClients.defaultExpectation.results = &OAuthClientRepositoryMockListOAuthClientsResults{opa1, err}
+	mmListOAuthClients.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOAuthClients.mock
+}
+
+// Set uses given function f to mock the OAuthClientRepository.ListOAuthClients method
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Set(f func(ctx context.Context) (opa1 []*model.OAuthClient, err error)) *OAuthClientRepositoryMock {
+	if mmListOAuthClients.defaultExpectation != nil {
+		mmListOAuthClients.mock.t.Fatalf("Default expectation is already set for the OAuthClientRepository.ListOAuthClients method")
+	}
+
+	if len(mmListOAuthClients.expectations) > 0 {
+		mmListOAuthClients.mock.t.Fatalf("Some expectations are already set for the OAuthClientRepository.ListOAuthClients method")
+	}
+
+	mmListOAuthClients.mock.funcListOAuthClients = f
+	mmListOAuthClients.mock.funcListOAuthClientsOrigin = minimock.CallerInfo(1)
+	return mmListOAuthClients.mock
+}
+
+// When sets expectation for the OAuthClientRepository.ListOAuthClients which will trigger the result defined by the following
+// Then helper
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) When(ctx context.Context) *OAuthClientRepositoryMockListOAuthClientsExpectation {
+	if mmListOAuthClients.mock.funcListOAuthClients != nil {
+		mmListOAuthClients.mock.t.Fatalf("OAuthClientRepositoryMock.ListOAuthClients mock is already set by Set")
+	}
+
+	expectation := &OAuthClientRepositoryMockListOAuthClientsExpectation{
+		mock:               mmListOAuthClients.mock,
+		params:             &OAuthClientRepositoryMockListOAuthClientsParams{ctx},
+		expectationOrigins: OAuthClientRepositoryMockListOAuthClientsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOAuthClients.expectations = append(mmListOAuthClients.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientRepository.ListOAuthClients return parameters for the expectation previously defined by the When method
+func (e *OAuthClientRepositoryMockListOAuthClientsExpectation) Then(opa1 []*model.OAuthClient, err error) *OAuthClientRepositoryMock {
+	e.results = &OAuthClientRepositoryMockListOAuthClientsResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientRepository.ListOAuthClients should be invoked
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Times(n uint64) *mOAuthClientRepositoryMockListOAuthClients {
+	if n == 0 {
+		mmListOAuthClients.mock.t.Fatalf("Times of OAuthClientRepositoryMock.ListOAuthClients mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOAuthClients.expectedInvocations, n)
+	mmListOAuthClients.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOAuthClients
+}
+
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) invocationsDone() bool {
+	if len(mmListOAuthClients.expectations) == 0 && mmListOAuthClients.defaultExpectation == nil && mmListOAuthClients.mock.funcListOAuthClients == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOAuthClients.mock.afterListOAuthClientsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOAuthClients.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOAuthClients implements mm_repository.OAuthClientRepository
+func (mmListOAuthClients *OAuthClientRepositoryMock) ListOAuthClients(ctx context.Context) (opa1 []*model.OAuthClient, err error) {
+	mm_atomic.AddUint64(&mmListOAuthClients.beforeListOAuthClientsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOAuthClients.afterListOAuthClientsCounter, 1)
+
+	mmListOAuthClients.t.Helper()
+
+	if mmListOAuthClients.inspectFuncListOAuthClients != nil {
+		mmListOAuthClients.inspectFuncListOAuthClients(ctx)
+	}
+
+	mm_params := OAuthClientRepositoryMockListOAuthClientsParams{ctx}
+
+	// Record call args
+	mmListOAuthClients.ListOAuthClientsMock.mutex.Lock()
+	mmListOAuthClients.ListOAuthClientsMock.callArgs = append(mmListOAuthClients.ListOAuthClientsMock.callArgs, &mm_params)
+	mmListOAuthClients.ListOAuthClientsMock.mutex.Unlock()
+
+	for _, e := range mmListOAuthClients.ListOAuthClientsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmListOAuthClients.ListOAuthClientsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOAuthClients.ListOAuthClientsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOAuthClients.ListOAuthClientsMock.defaultExpectation.params
+		mm_want_ptrs := mmListOAuthClients.ListOAuthClientsMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientRepositoryMockListOAuthClientsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOAuthClients.t.Errorf("OAuthClientRepositoryMock.ListOAuthClients got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOAuthClients.ListOAuthClientsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOAuthClients.t.Errorf("OAuthClientRepositoryMock.ListOAuthClients got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOAuthClients.ListOAuthClientsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOAuthClients.ListOAuthClientsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOAuthClients.t.Fatal("No results are set for the OAuthClientRepositoryMock.ListOAuthClients")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmListOAuthClients.funcListOAuthClients != nil {
+		return mmListOAuthClients.funcListOAuthClients(ctx)
+	}
+	mmListOAuthClients.t.Fatalf("Unexpected call to OAuthClientRepositoryMock.ListOAuthClients. %v", ctx)
+	return
+}
+
+// ListOAuthClientsAfterCounter returns a count of finished OAuthClientRepositoryMock.ListOAuthClients invocations
+func (mmListOAuthClients *OAuthClientRepositoryMock) ListOAuthClientsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOAuthClients.afterListOAuthClientsCounter)
+}
+
+// ListOAuthClientsBeforeCounter returns a count of OAuthClientRepositoryMock.ListOAuthClients invocations
+func (mmListOAuthClients *OAuthClientRepositoryMock) ListOAuthClientsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOAuthClients.beforeListOAuthClientsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientRepositoryMock.ListOAuthClients.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOAuthClients *mOAuthClientRepositoryMockListOAuthClients) Calls() []*OAuthClientRepositoryMockListOAuthClientsParams {
+	mmListOAuthClients.mutex.RLock()
+
+	argCopy := make([]*OAuthClientRepositoryMockListOAuthClientsParams, len(mmListOAuthClients.callArgs))
+	copy(argCopy, mmListOAuthClients.callArgs)
+
+	mmListOAuthClients.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOAuthClientsDone returns true if the count of the ListOAuthClients invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientRepositoryMock) MinimockListOAuthClientsDone() bool {
+	if m.ListOAuthClientsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOAuthClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOAuthClientsMock.invocationsDone()
+}
+
+// MinimockListOAuthClientsInspect logs each unmet expectation
+func (m *OAuthClientRepositoryMock) MinimockListOAuthClientsInspect() {
+	for _, e := range m.ListOAuthClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.ListOAuthClients at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOAuthClientsCounter := mm_atomic.LoadUint64(&m.afterListOAuthClientsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOAuthClientsMock.defaultExpectation != nil && afterListOAuthClientsCounter < 1 {
+		if m.ListOAuthClientsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.ListOAuthClients at\n%s", m.ListOAuthClientsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientRepositoryMock.ListOAuthClients at\n%s with params: %#v", m.ListOAuthClientsMock.defaultExpectation.expectationOrigins.origin, *m.ListOAuthClientsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOAuthClients != nil && afterListOAuthClientsCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientRepositoryMock.ListOAuthClients at\n%s", m.funcListOAuthClientsOrigin)
+	}
+
+	if !m.ListOAuthClientsMock.invocationsDone() && afterListOAuthClientsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientRepositoryMock.ListOAuthClients at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOAuthClientsMock.expectedInvocations), m.ListOAuthClientsMock.expectedInvocationsOrigin, afterListOAuthClientsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OAuthClientRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateOAuthClientInspect()
+
+			m.MinimockDeleteOAuthClientInspect()
+
+			m.MinimockGetOAuthClientInspect()
+
+			m.MinimockListOAuthClientsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OAuthClientRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OAuthClientRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateOAuthClientDone() &&
+		m.MinimockDeleteOAuthClientDone() &&
+		m.MinimockGetOAuthClientDone() &&
+		m.MinimockListOAuthClientsDone()
+}
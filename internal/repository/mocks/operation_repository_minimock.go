@@ -0,0 +1,1129 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.OperationRepository -o operation_repository_minimock.go -n OperationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// OperationRepositoryMock implements mm_repository.OperationRepository
+type OperationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateOperation          func(ctx context.Context, operation *model.Operation) (i1 int64, err error)
+	funcCreateOperationOrigin    string
+	inspectFuncCreateOperation   func(ctx context.Context, operation *model.Operation)
+	afterCreateOperationCounter  uint64
+	beforeCreateOperationCounter uint64
+	CreateOperationMock          mOperationRepositoryMockCreateOperation
+
+	funcGetOperation          func(ctx context.Context, id int64) (op1 *model.Operation, err error)
+	funcGetOperationOrigin    string
+	inspectFuncGetOperation   func(ctx context.Context, id int64)
+	afterGetOperationCounter  uint64
+	beforeGetOperationCounter uint64
+	GetOperationMock          mOperationRepositoryMockGetOperation
+
+	funcUpdateOperation          func(ctx context.Context, operation *model.Operation) (err error)
+	funcUpdateOperationOrigin    string
+	inspectFuncUpdateOperation   func(ctx context.Context, operation *model.Operation)
+	afterUpdateOperationCounter  uint64
+	beforeUpdateOperationCounter uint64
+	UpdateOperationMock          mOperationRepositoryMockUpdateOperation
+}
+
+// NewOperationRepositoryMock returns a mock for mm_repository.OperationRepository
+func NewOperationRepositoryMock(t minimock.Tester) *OperationRepositoryMock {
+	m := &OperationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateOperationMock = mOperationRepositoryMockCreateOperation{mock: m}
+	m.CreateOperationMock.callArgs = []*OperationRepositoryMockCreateOperationParams{}
+
+	m.GetOperationMock = mOperationRepositoryMockGetOperation{mock: m}
+	m.GetOperationMock.callArgs = []*OperationRepositoryMockGetOperationParams{}
+
+	m.UpdateOperationMock = mOperationRepositoryMockUpdateOperation{mock: m}
+	m.UpdateOperationMock.callArgs = []*OperationRepositoryMockUpdateOperationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOperationRepositoryMockCreateOperation struct {
+	optional           bool
+	mock               *OperationRepositoryMock
+	defaultExpectation *OperationRepositoryMockCreateOperationExpectation
+	expectations       []*OperationRepositoryMockCreateOperationExpectation
+
+	callArgs []*OperationRepositoryMockCreateOperationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OperationRepositoryMockCreateOperationExpectation specifies expectation struct of the OperationRepository.CreateOperation
+type OperationRepositoryMockCreateOperationExpectation struct {
+	mock               *OperationRepositoryMock
+	params             *OperationRepositoryMockCreateOperationParams
+	paramPtrs          *OperationRepositoryMockCreateOperationParamPtrs
+	expectationOrigins OperationRepositoryMockCreateOperationExpectationOrigins
+	results            *OperationRepositoryMockCreateOperationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OperationRepositoryMockCreateOperationParams contains parameters of the OperationRepository.CreateOperation
+type OperationRepositoryMockCreateOperationParams struct {
+	ctx       context.Context
+	operation *model.Operation
+}
+
+// OperationRepositoryMockCreateOperationParamPtrs contains pointers to parameters of the OperationRepository.CreateOperation
+type OperationRepositoryMockCreateOperationParamPtrs struct {
+	ctx       *context.Context
+	operation **model.Operation
+}
+
+// OperationRepositoryMockCreateOperationResults contains results of the OperationRepository.CreateOperation
+type OperationRepositoryMockCreateOperationResults struct {
+	i1  int64
+	err error
+}
+
+// OperationRepositoryMockCreateOperationOrigins contains origins of expectations of the OperationRepository.CreateOperation
+type OperationRepositoryMockCreateOperationExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originOperation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Optional() *mOperationRepositoryMockCreateOperation {
+	mmCreateOperation.optional = true
+	return mmCreateOperation
+}
+
+// Expect sets up expected params for OperationRepository.CreateOperation
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Expect(ctx context.Context, operation *model.Operation) *mOperationRepositoryMockCreateOperation {
+	if mmCreateOperation.mock.funcCreateOperation != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Set")
+	}
+
+	if mmCreateOperation.defaultExpectation == nil {
+		mmCreateOperation.defaultExpectation = &OperationRepositoryMockCreateOperationExpectation{}
+	}
+
+	if mmCreateOperation.defaultExpectation.paramPtrs != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOperation.defaultExpectation.params = &OperationRepositoryMockCreateOperationParams{ctx, operation}
+	mmCreateOperation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOperation.expectations {
+		if minimock.Equal(e.params, mmCreateOperation.defaultExpectation.params) {
+			mmCreateOperation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOperation.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOperation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OperationRepository.CreateOperation
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) ExpectCtxParam1(ctx context.Context) *mOperationRepositoryMockCreateOperation {
+	if mmCreateOperation.mock.funcCreateOperation != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Set")
+	}
+
+	if mmCreateOperation.defaultExpectation == nil {
+		mmCreateOperation.defaultExpectation = &OperationRepositoryMockCreateOperationExpectation{}
+	}
+
+	if mmCreateOperation.defaultExpectation.params != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Expect")
+	}
+
+	if mmCreateOperation.defaultExpectation.paramPtrs == nil {
+		mmCreateOperation.defaultExpectation.paramPtrs = &OperationRepositoryMockCreateOperationParamPtrs{}
+	}
+	mmCreateOperation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOperation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOperation
+}
+
+// ExpectOperationParam2 sets up expected param operation for OperationRepository.CreateOperation
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) ExpectOperationParam2(operation *model.Operation) *mOperationRepositoryMockCreateOperation {
+	if mmCreateOperation.mock.funcCreateOperation != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Set")
+	}
+
+	if mmCreateOperation.defaultExpectation == nil {
+		mmCreateOperation.defaultExpectation = &OperationRepositoryMockCreateOperationExpectation{}
+	}
+
+	if mmCreateOperation.defaultExpectation.params != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Expect")
+	}
+
+	if mmCreateOperation.defaultExpectation.paramPtrs == nil {
+		mmCreateOperation.defaultExpectation.paramPtrs = &OperationRepositoryMockCreateOperationParamPtrs{}
+	}
+	mmCreateOperation.defaultExpectation.paramPtrs.operation = &operation
+	mmCreateOperation.defaultExpectation.expectationOrigins.originOperation = minimock.CallerInfo(1)
+
+	return mmCreateOperation
+}
+
+// Inspect accepts an inspector function that has same arguments as the OperationRepository.CreateOperation
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Inspect(f func(ctx context.Context, operation *model.Operation)) *mOperationRepositoryMockCreateOperation {
+	if mmCreateOperation.mock.inspectFuncCreateOperation != nil {
+		mmCreateOperation.mock.t.Fatalf("Inspect function is already set for OperationRepositoryMock.CreateOperation")
+	}
+
+	mmCreateOperation.mock.inspectFuncCreateOperation = f
+
+	return mmCreateOperation
+}
+
+// Return sets up results that will be returned by OperationRepository.CreateOperation
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Return(i1 int64, err error) *OperationRepositoryMock {
+	if mmCreateOperation.mock.funcCreateOperation != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Set")
+	}
+
+	if mmCreateOperation.defaultExpectation == nil {
+		mmCreateOperation.defaultExpectation = &OperationRepositoryMockCreateOperationExpectation{mock: mmCreateOperation.mock}
+	}
+	mmCreateOperation.defaultExpectation.results = &OperationRepositoryMockCreateOperationResults{i1, err}
+	mmCreateOperation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOperation.mock
+}
+
+// Set uses given function f to mock the OperationRepository.CreateOperation method
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Set(f func(ctx context.Context, operation *model.Operation) (i1 int64, err error)) *OperationRepositoryMock {
+	if mmCreateOperation.defaultExpectation != nil {
+		mmCreateOperation.mock.t.Fatalf("Default expectation is already set for the OperationRepository.CreateOperation method")
+	}
+
+	if len(mmCreateOperation.expectations) > 0 {
+		mmCreateOperation.mock.t.Fatalf("Some expectations are already set for the OperationRepository.CreateOperation method")
+	}
+
+	mmCreateOperation.mock.funcCreateOperation = f
+	mmCreateOperation.mock.funcCreateOperationOrigin = minimock.CallerInfo(1)
+	return mmCreateOperation.mock
+}
+
+// When sets expectation for the OperationRepository.CreateOperation which will trigger the result defined by the following
+// Then helper
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) When(ctx context.Context, operation *model.Operation) *OperationRepositoryMockCreateOperationExpectation {
+	if mmCreateOperation.mock.funcCreateOperation != nil {
+		mmCreateOperation.mock.t.Fatalf("OperationRepositoryMock.CreateOperation mock is already set by Set")
+	}
+
+	expectation := &OperationRepositoryMockCreateOperationExpectation{
+		mock:               mmCreateOperation.mock,
+		params:             &OperationRepositoryMockCreateOperationParams{ctx, operation},
+		expectationOrigins: OperationRepositoryMockCreateOperationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOperation.expectations = append(mmCreateOperation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OperationRepository.CreateOperation return parameters for the expectation previously defined by the When method
+func (e *OperationRepositoryMockCreateOperationExpectation) Then(i1 int64, err error) *OperationRepositoryMock {
+	e.results = &OperationRepositoryMockCreateOperationResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OperationRepository.CreateOperation should be invoked
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Times(n uint64) *mOperationRepositoryMockCreateOperation {
+	if n == 0 {
+		mmCreateOperation.mock.t.Fatalf("Times of OperationRepositoryMock.CreateOperation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOperation.expectedInvocations, n)
+	mmCreateOperation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOperation
+}
+
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) invocationsDone() bool {
+	if len(mmCreateOperation.expectations) == 0 && mmCreateOperation.defaultExpectation == nil && mmCreateOperation.mock.funcCreateOperation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOperation.mock.afterCreateOperationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOperation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOperation implements mm_repository.OperationRepository
+func (mmCreateOperation *OperationRepositoryMock) CreateOperation(ctx context.Context, operation *model.Operation) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateOperation.beforeCreateOperationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOperation.afterCreateOperationCounter, 1)
+
+	mmCreateOperation.t.Helper()
+
+	if mmCreateOperation.inspectFuncCreateOperation != nil {
+		mmCreateOperation.inspectFuncCreateOperation(ctx, operation)
+	}
+
+	mm_params := OperationRepositoryMockCreateOperationParams{ctx, operation}
+
+	// Record call args
+	mmCreateOperation.CreateOperationMock.mutex.Lock()
+	mmCreateOperation.CreateOperationMock.callArgs = append(mmCreateOperation.CreateOperationMock.callArgs, &mm_params)
+	mmCreateOperation.CreateOperationMock.mutex.Unlock()
+
+	for _, e := range mmCreateOperation.CreateOperationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateOperation.CreateOperationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOperation.CreateOperationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOperation.CreateOperationMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOperation.CreateOperationMock.defaultExpectation.paramPtrs
+
+		mm_got := OperationRepositoryMockCreateOperationParams{ctx, operation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOperation.t.Errorf("OperationRepositoryMock.CreateOperation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOperation.CreateOperationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.operation != nil && !minimock.Equal(*mm_want_ptrs.operation, mm_got.operation) {
+				mmCreateOperation.t.Errorf("OperationRepositoryMock.CreateOperation got unexpected parameter operation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOperation.CreateOperationMock.defaultExpectation.expectationOrigins.originOperation, *mm_want_ptrs.operation, mm_got.operation, minimock.Diff(*mm_want_ptrs.operation, mm_got.operation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOperation.t.Errorf("OperationRepositoryMock.CreateOperation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOperation.CreateOperationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOperation.CreateOperationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOperation.t.Fatal("No results are set for the OperationRepositoryMock.CreateOperation")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateOperation.funcCreateOperation != nil {
+		return mmCreateOperation.funcCreateOperation(ctx, operation)
+	}
+	mmCreateOperation.t.Fatalf("Unexpected call to OperationRepositoryMock.CreateOperation. %v %v", ctx, operation)
+	return
+}
+
+// CreateOperationAfterCounter returns a count of finished OperationRepositoryMock.CreateOperation invocations
+func (mmCreateOperation *OperationRepositoryMock) CreateOperationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOperation.afterCreateOperationCounter)
+}
+
+// CreateOperationBeforeCounter returns a count of OperationRepositoryMock.CreateOperation invocations
+func (mmCreateOperation *OperationRepositoryMock) CreateOperationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOperation.beforeCreateOperationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OperationRepositoryMock.CreateOperation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOperation *mOperationRepositoryMockCreateOperation) Calls() []*OperationRepositoryMockCreateOperationParams {
+	mmCreateOperation.mutex.RLock()
+
+	argCopy := make([]*OperationRepositoryMockCreateOperationParams, len(mmCreateOperation.callArgs))
+	copy(argCopy, mmCreateOperation.callArgs)
+
+	mmCreateOperation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOperationDone returns true if the count of the CreateOperation invocations corresponds
+// the number of defined expectations
+func (m *OperationRepositoryMock) MinimockCreateOperationDone() bool {
+	if m.CreateOperationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOperationMock.invocationsDone()
+}
+
+// MinimockCreateOperationInspect logs each unmet expectation
+func (m *OperationRepositoryMock) MinimockCreateOperationInspect() {
+	for _, e := range m.CreateOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OperationRepositoryMock.CreateOperation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOperationCounter := mm_atomic.LoadUint64(&m.afterCreateOperationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOperationMock.defaultExpectation != nil && afterCreateOperationCounter < 1 {
+		if m.CreateOperationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OperationRepositoryMock.CreateOperation at\n%s", m.CreateOperationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OperationRepositoryMock.CreateOperation at\n%s with params: %#v", m.CreateOperationMock.defaultExpectation.expectationOrigins.origin, *m.CreateOperationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOperation != nil && afterCreateOperationCounter < 1 {
+		m.t.Errorf("Expected call to OperationRepositoryMock.CreateOperation at\n%s", m.funcCreateOperationOrigin)
+	}
+
+	if !m.CreateOperationMock.invocationsDone() && afterCreateOperationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OperationRepositoryMock.CreateOperation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOperationMock.expectedInvocations), m.CreateOperationMock.expectedInvocationsOrigin, afterCreateOperationCounter)
+	}
+}
+
+type mOperationRepositoryMockGetOperation struct {
+	optional           bool
+	mock               *OperationRepositoryMock
+	defaultExpectation *OperationRepositoryMockGetOperationExpectation
+	expectations       []*OperationRepositoryMockGetOperationExpectation
+
+	callArgs []*OperationRepositoryMockGetOperationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OperationRepositoryMockGetOperationExpectation specifies expectation struct of the OperationRepository.GetOperation
+type OperationRepositoryMockGetOperationExpectation struct {
+	mock               *OperationRepositoryMock
+	params             *OperationRepositoryMockGetOperationParams
+	paramPtrs          *OperationRepositoryMockGetOperationParamPtrs
+	expectationOrigins OperationRepositoryMockGetOperationExpectationOrigins
+	results            *OperationRepositoryMockGetOperationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OperationRepositoryMockGetOperationParams contains parameters of the OperationRepository.GetOperation
+type OperationRepositoryMockGetOperationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OperationRepositoryMockGetOperationParamPtrs contains pointers to parameters of the OperationRepository.GetOperation
+type OperationRepositoryMockGetOperationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// OperationRepositoryMockGetOperationResults contains results of the OperationRepository.GetOperation
+type OperationRepositoryMockGetOperationResults struct {
+	op1 *model.Operation
+	err error
+}
+
+// OperationRepositoryMockGetOperationOrigins contains origins of expectations of the OperationRepository.GetOperation
+type OperationRepositoryMockGetOperationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Optional() *mOperationRepositoryMockGetOperation {
+	mmGetOperation.optional = true
+	return mmGetOperation
+}
+
+// Expect sets up expected params for OperationRepository.GetOperation
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Expect(ctx context.Context, id int64) *mOperationRepositoryMockGetOperation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &OperationRepositoryMockGetOperationExpectation{}
+	}
+
+	if mmGetOperation.defaultExpectation.paramPtrs != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by ExpectParams functions")
+	}
+
+	mmGetOperation.defaultExpectation.params = &OperationRepositoryMockGetOperationParams{ctx, id}
+	mmGetOperation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOperation.expectations {
+		if minimock.Equal(e.params, mmGetOperation.defaultExpectation.params) {
+			mmGetOperation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOperation.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOperation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OperationRepository.GetOperation
+func (mmGetOperation *mOperationRepositoryMockGetOperation) ExpectCtxParam1(ctx context.Context) *mOperationRepositoryMockGetOperation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &OperationRepositoryMockGetOperationExpectation{}
+	}
+
+	if mmGetOperation.defaultExpectation.params != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Expect")
+	}
+
+	if mmGetOperation.defaultExpectation.paramPtrs == nil {
+		mmGetOperation.defaultExpectation.paramPtrs = &OperationRepositoryMockGetOperationParamPtrs{}
+	}
+	mmGetOperation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOperation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOperation
+}
+
+// ExpectIdParam2 sets up expected param id for OperationRepository.GetOperation
+func (mmGetOperation *mOperationRepositoryMockGetOperation) ExpectIdParam2(id int64) *mOperationRepositoryMockGetOperation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &OperationRepositoryMockGetOperationExpectation{}
+	}
+
+	if mmGetOperation.defaultExpectation.params != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Expect")
+	}
+
+	if mmGetOperation.defaultExpectation.paramPtrs == nil {
+		mmGetOperation.defaultExpectation.paramPtrs = &OperationRepositoryMockGetOperationParamPtrs{}
+	}
+	mmGetOperation.defaultExpectation.paramPtrs.id = &id
+	mmGetOperation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetOperation
+}
+
+// Inspect accepts an inspector function that has same arguments as the OperationRepository.GetOperation
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Inspect(f func(ctx context.Context, id int64)) *mOperationRepositoryMockGetOperation {
+	if mmGetOperation.mock.inspectFuncGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("Inspect function is already set for OperationRepositoryMock.GetOperation")
+	}
+
+	mmGetOperation.mock.inspectFuncGetOperation = f
+
+	return mmGetOperation
+}
+
+// Return sets up results that will be returned by OperationRepository.GetOperation
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Return(op1 *model.Operation, err error) *OperationRepositoryMock {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &OperationRepositoryMockGetOperationExpectation{mock: mmGetOperation.mock}
+	}
+	mmGetOperation.defaultExpectation.results = &OperationRepositoryMockGetOperationResults{op1, err}
+	mmGetOperation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOperation.mock
+}
+
+// Set uses given function f to mock the OperationRepository.GetOperation method
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Set(f func(ctx context.Context, id int64) (op1 *model.Operation, err error)) *OperationRepositoryMock {
+	if mmGetOperation.defaultExpectation != nil {
+		mmGetOperation.mock.t.Fatalf("Default expectation is already set for the OperationRepository.GetOperation method")
+	}
+
+	if len(mmGetOperation.expectations) > 0 {
+		mmGetOperation.mock.t.Fatalf("Some expectations are already set for the OperationRepository.GetOperation method")
+	}
+
+	mmGetOperation.mock.funcGetOperation = f
+	mmGetOperation.mock.funcGetOperationOrigin = minimock.CallerInfo(1)
+	return mmGetOperation.mock
+}
+
+// When sets expectation for the OperationRepository.GetOperation which will trigger the result defined by the following
+// Then helper
+func (mmGetOperation *mOperationRepositoryMockGetOperation) When(ctx context.Context, id int64) *OperationRepositoryMockGetOperationExpectation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("OperationRepositoryMock.GetOperation mock is already set by Set")
+	}
+
+	expectation := &OperationRepositoryMockGetOperationExpectation{
+		mock:               mmGetOperation.mock,
+		params:             &OperationRepositoryMockGetOperationParams{ctx, id},
+		expectationOrigins: OperationRepositoryMockGetOperationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOperation.expectations = append(mmGetOperation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OperationRepository.GetOperation return parameters for the expectation previously defined by the When method
+func (e *OperationRepositoryMockGetOperationExpectation) Then(op1 *model.Operation, err error) *OperationRepositoryMock {
+	e.results = &OperationRepositoryMockGetOperationResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OperationRepository.GetOperation should be invoked
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Times(n uint64) *mOperationRepositoryMockGetOperation {
+	if n == 0 {
+		mmGetOperation.mock.t.Fatalf("Times of OperationRepositoryMock.GetOperation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOperation.expectedInvocations, n)
+	mmGetOperation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOperation
+}
+
+func (mmGetOperation *mOperationRepositoryMockGetOperation) invocationsDone() bool {
+	if len(mmGetOperation.expectations) == 0 && mmGetOperation.defaultExpectation == nil && mmGetOperation.mock.funcGetOperation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOperation.mock.afterGetOperationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOperation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOperation implements mm_repository.OperationRepository
+func (mmGetOperation *OperationRepositoryMock) GetOperation(ctx context.Context, id int64) (op1 *model.Operation, err error) {
+	mm_atomic.AddUint64(&mmGetOperation.beforeGetOperationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOperation.afterGetOperationCounter, 1)
+
+	mmGetOperation.t.Helper()
+
+	if mmGetOperation.inspectFuncGetOperation != nil {
+		mmGetOperation.inspectFuncGetOperation(ctx, id)
+	}
+
+	mm_params := OperationRepositoryMockGetOperationParams{ctx, id}
+
+	// Record call args
+	mmGetOperation.GetOperationMock.mutex.Lock()
+	mmGetOperation.GetOperationMock.callArgs = append(mmGetOperation.GetOperationMock.callArgs, &mm_params)
+	mmGetOperation.GetOperationMock.mutex.Unlock()
+
+	for _, e := range mmGetOperation.GetOperationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOperation.GetOperationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOperation.GetOperationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOperation.GetOperationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOperation.GetOperationMock.defaultExpectation.paramPtrs
+
+		mm_got := OperationRepositoryMockGetOperationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOperation.t.Errorf("OperationRepositoryMock.GetOperation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOperation.GetOperationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetOperation.t.Errorf("OperationRepositoryMock.GetOperation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOperation.GetOperationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOperation.t.Errorf("OperationRepositoryMock.GetOperation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOperation.GetOperationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOperation.GetOperationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOperation.t.Fatal("No results are set for the OperationRepositoryMock.GetOperation")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOperation.funcGetOperation != nil {
+		return mmGetOperation.funcGetOperation(ctx, id)
+	}
+	mmGetOperation.t.Fatalf("Unexpected call to OperationRepositoryMock.GetOperation. %v %v", ctx, id)
+	return
+}
+
+// GetOperationAfterCounter returns a count of finished OperationRepositoryMock.GetOperation invocations
+func (mmGetOperation *OperationRepositoryMock) GetOperationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOperation.afterGetOperationCounter)
+}
+
+// GetOperationBeforeCounter returns a count of OperationRepositoryMock.GetOperation invocations
+func (mmGetOperation *OperationRepositoryMock) GetOperationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOperation.beforeGetOperationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OperationRepositoryMock.GetOperation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOperation *mOperationRepositoryMockGetOperation) Calls() []*OperationRepositoryMockGetOperationParams {
+	mmGetOperation.mutex.RLock()
+
+	argCopy := make([]*OperationRepositoryMockGetOperationParams, len(mmGetOperation.callArgs))
+	copy(argCopy, mmGetOperation.callArgs)
+
+	mmGetOperation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOperationDone returns true if the count of the GetOperation invocations corresponds
+// the number of defined expectations
+func (m *OperationRepositoryMock) MinimockGetOperationDone() bool {
+	if m.GetOperationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOperationMock.invocationsDone()
+}
+
+// MinimockGetOperationInspect logs each unmet expectation
+func (m *OperationRepositoryMock) MinimockGetOperationInspect() {
+	for _, e := range m.GetOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OperationRepositoryMock.GetOperation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOperationCounter := mm_atomic.LoadUint64(&m.afterGetOperationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOperationMock.defaultExpectation != nil && afterGetOperationCounter < 1 {
+		if m.GetOperationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OperationRepositoryMock.GetOperation at\n%s", m.GetOperationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OperationRepositoryMock.GetOperation at\n%s with params: %#v", m.GetOperationMock.defaultExpectation.expectationOrigins.origin, *m.GetOperationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOperation != nil && afterGetOperationCounter < 1 {
+		m.t.Errorf("Expected call to OperationRepositoryMock.GetOperation at\n%s", m.funcGetOperationOrigin)
+	}
+
+	if !m.GetOperationMock.invocationsDone() && afterGetOperationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OperationRepositoryMock.GetOperation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOperationMock.expectedInvocations), m.GetOperationMock.expectedInvocationsOrigin, afterGetOperationCounter)
+	}
+}
+
+type mOperationRepositoryMockUpdateOperation struct {
+	optional           bool
+	mock               *OperationRepositoryMock
+	defaultExpectation *OperationRepositoryMockUpdateOperationExpectation
+	expectations       []*OperationRepositoryMockUpdateOperationExpectation
+
+	callArgs []*OperationRepositoryMockUpdateOperationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OperationRepositoryMockUpdateOperationExpectation specifies expectation struct of the OperationRepository.UpdateOperation
+type OperationRepositoryMockUpdateOperationExpectation struct {
+	mock               *OperationRepositoryMock
+	params             *OperationRepositoryMockUpdateOperationParams
+	paramPtrs          *OperationRepositoryMockUpdateOperationParamPtrs
+	expectationOrigins OperationRepositoryMockUpdateOperationExpectationOrigins
+	results            *OperationRepositoryMockUpdateOperationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OperationRepositoryMockUpdateOperationParams contains parameters of the OperationRepository.UpdateOperation
+type OperationRepositoryMockUpdateOperationParams struct {
+	ctx       context.Context
+	operation *model.Operation
+}
+
+// OperationRepositoryMockUpdateOperationParamPtrs contains pointers to parameters of the OperationRepository.UpdateOperation
+type OperationRepositoryMockUpdateOperationParamPtrs struct {
+	ctx       *context.Context
+	operation **model.Operation
+}
+
+// OperationRepositoryMockUpdateOperationResults contains results of the OperationRepository.UpdateOperation
+type OperationRepositoryMockUpdateOperationResults struct {
+	err error
+}
+
+// OperationRepositoryMockUpdateOperationOrigins contains origins of expectations of the OperationRepository.UpdateOperation
+type OperationRepositoryMockUpdateOperationExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originOperation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Optional() *mOperationRepositoryMockUpdateOperation {
+	mmUpdateOperation.optional = true
+	return mmUpdateOperation
+}
+
+// Expect sets up expected params for OperationRepository.UpdateOperation
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Expect(ctx context.Context, operation *model.Operation) *mOperationRepositoryMockUpdateOperation {
+	if mmUpdateOperation.mock.funcUpdateOperation != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Set")
+	}
+
+	if mmUpdateOperation.defaultExpectation == nil {
+		mmUpdateOperation.defaultExpectation = &OperationRepositoryMockUpdateOperationExpectation{}
+	}
+
+	if mmUpdateOperation.defaultExpectation.paramPtrs != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateOperation.defaultExpectation.params = &OperationRepositoryMockUpdateOperationParams{ctx, operation}
+	mmUpdateOperation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateOperation.expectations {
+		if minimock.Equal(e.params, mmUpdateOperation.defaultExpectation.params) {
+			mmUpdateOperation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOperation.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateOperation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OperationRepository.UpdateOperation
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) ExpectCtxParam1(ctx context.Context) *mOperationRepositoryMockUpdateOperation {
+	if mmUpdateOperation.mock.funcUpdateOperation != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Set")
+	}
+
+	if mmUpdateOperation.defaultExpectation == nil {
+		mmUpdateOperation.defaultExpectation = &OperationRepositoryMockUpdateOperationExpectation{}
+	}
+
+	if mmUpdateOperation.defaultExpectation.params != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Expect")
+	}
+
+	if mmUpdateOperation.defaultExpectation.paramPtrs == nil {
+		mmUpdateOperation.defaultExpectation.paramPtrs = &OperationRepositoryMockUpdateOperationParamPtrs{}
+	}
+	mmUpdateOperation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateOperation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateOperation
+}
+
+// ExpectOperationParam2 sets up expected param operation for OperationRepository.UpdateOperation
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) ExpectOperationParam2(operation *model.Operation) *mOperationRepositoryMockUpdateOperation {
+	if mmUpdateOperation.mock.funcUpdateOperation != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Set")
+	}
+
+	if mmUpdateOperation.defaultExpectation == nil {
+		mmUpdateOperation.defaultExpectation = &OperationRepositoryMockUpdateOperationExpectation{}
+	}
+
+	if mmUpdateOperation.defaultExpectation.params != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Expect")
+	}
+
+	if mmUpdateOperation.defaultExpectation.paramPtrs == nil {
+		mmUpdateOperation.defaultExpectation.paramPtrs = &OperationRepositoryMockUpdateOperationParamPtrs{}
+	}
+	mmUpdateOperation.defaultExpectation.paramPtrs.operation = &operation
+	mmUpdateOperation.defaultExpectation.expectationOrigins.originOperation = minimock.CallerInfo(1)
+
+	return mmUpdateOperation
+}
+
+// Inspect accepts an inspector function that has same arguments as the OperationRepository.UpdateOperation
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Inspect(f func(ctx context.Context, operation *model.Operation)) *mOperationRepositoryMockUpdateOperation {
+	if mmUpdateOperation.mock.inspectFuncUpdateOperation != nil {
+		mmUpdateOperation.mock.t.Fatalf("Inspect function is already set for OperationRepositoryMock.UpdateOperation")
+	}
+
+	mmUpdateOperation.mock.inspectFuncUpdateOperation = f
+
+	return mmUpdateOperation
+}
+
+// Return sets up results that will be returned by OperationRepository.UpdateOperation
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Return(err error) *OperationRepositoryMock {
+	if mmUpdateOperation.mock.funcUpdateOperation != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Set")
+	}
+
+	if mmUpdateOperation.defaultExpectation == nil {
+		mmUpdateOperation.defaultExpectation = &OperationRepositoryMockUpdateOperationExpectation{mock: mmUpdateOperation.mock}
+	}
+	mmUpdateOperation.defaultExpectation.results = &OperationRepositoryMockUpdateOperationResults{err}
+	mmUpdateOperation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateOperation.mock
+}
+
+// Set uses given function f to mock the OperationRepository.UpdateOperation method
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Set(f func(ctx context.Context, operation *model.Operation) (err error)) *OperationRepositoryMock {
+	if mmUpdateOperation.defaultExpectation != nil {
+		mmUpdateOperation.mock.t.Fatalf("Default expectation is already set for the OperationRepository.UpdateOperation method")
+	}
+
+	if len(mmUpdateOperation.expectations) > 0 {
+		mmUpdateOperation.mock.t.Fatalf("Some expectations are already set for the OperationRepository.UpdateOperation method")
+	}
+
+	mmUpdateOperation.mock.funcUpdateOperation = f
+	mmUpdateOperation.mock.funcUpdateOperationOrigin = minimock.CallerInfo(1)
+	return mmUpdateOperation.mock
+}
+
+// When sets expectation for the OperationRepository.UpdateOperation which will trigger the result defined by the following
+// Then helper
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) When(ctx context.Context, operation *model.Operation) *OperationRepositoryMockUpdateOperationExpectation {
+	if mmUpdateOperation.mock.funcUpdateOperation != nil {
+		mmUpdateOperation.mock.t.Fatalf("OperationRepositoryMock.UpdateOperation mock is already set by Set")
+	}
+
+	expectation := &OperationRepositoryMockUpdateOperationExpectation{
+		mock:               mmUpdateOperation.mock,
+		params:             &OperationRepositoryMockUpdateOperationParams{ctx, operation},
+		expectationOrigins: OperationRepositoryMockUpdateOperationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateOperation.expectations = append(mmUpdateOperation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OperationRepository.UpdateOperation return parameters for the expectation previously defined by the When method
+func (e *OperationRepositoryMockUpdateOperationExpectation) Then(err error) *OperationRepositoryMock {
+	e.results = &OperationRepositoryMockUpdateOperationResults{err}
+	return e.mock
+}
+
+// Times sets number of times OperationRepository.UpdateOperation should be invoked
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Times(n uint64) *mOperationRepositoryMockUpdateOperation {
+	if n == 0 {
+		mmUpdateOperation.mock.t.Fatalf("Times of OperationRepositoryMock.UpdateOperation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateOperation.expectedInvocations, n)
+	mmUpdateOperation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateOperation
+}
+
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) invocationsDone() bool {
+	if len(mmUpdateOperation.expectations) == 0 && mmUpdateOperation.defaultExpectation == nil && mmUpdateOperation.mock.funcUpdateOperation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateOperation.mock.afterUpdateOperationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateOperation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateOperation implements mm_repository.OperationRepository
+func (mmUpdateOperation *OperationRepositoryMock) UpdateOperation(ctx context.Context, operation *model.Operation) (err error) {
+	mm_atomic.AddUint64(&mmUpdateOperation.beforeUpdateOperationCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOperation.afterUpdateOperationCounter, 1)
+
+	mmUpdateOperation.t.Helper()
+
+	if mmUpdateOperation.inspectFuncUpdateOperation != nil {
+		mmUpdateOperation.inspectFuncUpdateOperation(ctx, operation)
+	}
+
+	mm_params := OperationRepositoryMockUpdateOperationParams{ctx, operation}
+
+	// Record call args
+	mmUpdateOperation.UpdateOperationMock.mutex.Lock()
+	mmUpdateOperation.UpdateOperationMock.callArgs = append(mmUpdateOperation.UpdateOperationMock.callArgs, &mm_params)
+	mmUpdateOperation.UpdateOperationMock.mutex.Unlock()
+
+	for _, e := range mmUpdateOperation.UpdateOperationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateOperation.UpdateOperationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOperation.UpdateOperationMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOperation.UpdateOperationMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateOperation.UpdateOperationMock.defaultExpectation.paramPtrs
+
+		mm_got := OperationRepositoryMockUpdateOperationParams{ctx, operation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateOperation.t.Errorf("OperationRepositoryMock.UpdateOperation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOperation.UpdateOperationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.operation != nil && !minimock.Equal(*mm_want_ptrs.operation, mm_got.operation) {
+				mmUpdateOperation.t.Errorf("OperationRepositoryMock.UpdateOperation got unexpected parameter operation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOperation.UpdateOperationMock.defaultExpectation.expectationOrigins.originOperation, *mm_want_ptrs.operation, mm_got.operation, minimock.Diff(*mm_want_ptrs.operation, mm_got.operation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateOperation.t.Errorf("OperationRepositoryMock.UpdateOperation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateOperation.UpdateOperationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateOperation.UpdateOperationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateOperation.t.Fatal("No results are set for the OperationRepositoryMock.UpdateOperation")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateOperation.funcUpdateOperation != nil {
+		return mmUpdateOperation.funcUpdateOperation(ctx, operation)
+	}
+	mmUpdateOperation.t.Fatalf("Unexpected call to OperationRepositoryMock.UpdateOperation. %v %v", ctx, operation)
+	return
+}
+
+// UpdateOperationAfterCounter returns a count of finished OperationRepositoryMock.UpdateOperation invocations
+func (mmUpdateOperation *OperationRepositoryMock) UpdateOperationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOperation.afterUpdateOperationCounter)
+}
+
+// UpdateOperationBeforeCounter returns a count of OperationRepositoryMock.UpdateOperation invocations
+func (mmUpdateOperation *OperationRepositoryMock) UpdateOperationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOperation.beforeUpdateOperationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OperationRepositoryMock.UpdateOperation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateOperation *mOperationRepositoryMockUpdateOperation) Calls() []*OperationRepositoryMockUpdateOperationParams {
+	mmUpdateOperation.mutex.RLock()
+
+	argCopy := make([]*OperationRepositoryMockUpdateOperationParams, len(mmUpdateOperation.callArgs))
+	copy(argCopy, mmUpdateOperation.callArgs)
+
+	mmUpdateOperation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateOperationDone returns true if the count of the UpdateOperation invocations corresponds
+// the number of defined expectations
+func (m *OperationRepositoryMock) MinimockUpdateOperationDone() bool {
+	if m.UpdateOperationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateOperationMock.invocationsDone()
+}
+
+// MinimockUpdateOperationInspect logs each unmet expectation
+func (m *OperationRepositoryMock) MinimockUpdateOperationInspect() {
+	for _, e := range m.UpdateOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OperationRepositoryMock.UpdateOperation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateOperationCounter := mm_atomic.LoadUint64(&m.afterUpdateOperationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateOperationMock.defaultExpectation != nil && afterUpdateOperationCounter < 1 {
+		if m.UpdateOperationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OperationRepositoryMock.UpdateOperation at\n%s", m.UpdateOperationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OperationRepositoryMock.UpdateOperation at\n%s with params: %#v", m.UpdateOperationMock.defaultExpectation.expectationOrigins.origin, *m.UpdateOperationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateOperation != nil && afterUpdateOperationCounter < 1 {
+		m.t.Errorf("Expected call to OperationRepositoryMock.UpdateOperation at\n%s", m.funcUpdateOperationOrigin)
+	}
+
+	if !m.UpdateOperationMock.invocationsDone() && afterUpdateOperationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OperationRepositoryMock.UpdateOperation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateOperationMock.expectedInvocations), m.UpdateOperationMock.expectedInvocationsOrigin, afterUpdateOperationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OperationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateOperationInspect()
+
+			m.MinimockGetOperationInspect()
+
+			m.MinimockUpdateOperationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OperationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OperationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateOperationDone() &&
+		m.MinimockGetOperationDone() &&
+		m.MinimockUpdateOperationDone()
+}
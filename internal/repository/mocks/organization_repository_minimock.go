@@ -0,0 +1,1454 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.OrganizationRepository -o organization_repository_minimock.go -n OrganizationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// OrganizationRepositoryMock implements mm_repository.OrganizationRepository
+type OrganizationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateOrganization          func(ctx context.Context, name string) (i1 int64, err error)
+	funcCreateOrganizationOrigin    string
+	inspectFuncCreateOrganization   func(ctx context.Context, name string)
+	afterCreateOrganizationCounter  uint64
+	beforeCreateOrganizationCounter uint64
+	CreateOrganizationMock          mOrganizationRepositoryMockCreateOrganization
+
+	funcDeleteOrganization          func(ctx context.Context, id int64) (err error)
+	funcDeleteOrganizationOrigin    string
+	inspectFuncDeleteOrganization   func(ctx context.Context, id int64)
+	afterDeleteOrganizationCounter  uint64
+	beforeDeleteOrganizationCounter uint64
+	DeleteOrganizationMock          mOrganizationRepositoryMockDeleteOrganization
+
+	funcGetOrganization          func(ctx context.Context, id int64) (op1 *model.Organization, err error)
+	funcGetOrganizationOrigin    string
+	inspectFuncGetOrganization   func(ctx context.Context, id int64)
+	afterGetOrganizationCounter  uint64
+	beforeGetOrganizationCounter uint64
+	GetOrganizationMock          mOrganizationRepositoryMockGetOrganization
+
+	funcListOrganizations          func(ctx context.Context) (opa1 []*model.Organization, err error)
+	funcListOrganizationsOrigin    string
+	inspectFuncListOrganizations   func(ctx context.Context)
+	afterListOrganizationsCounter  uint64
+	beforeListOrganizationsCounter uint64
+	ListOrganizationsMock          mOrganizationRepositoryMockListOrganizations
+}
+
+// NewOrganizationRepositoryMock returns a mock for mm_repository.OrganizationRepository
+func NewOrganizationRepositoryMock(t minimock.Tester) *OrganizationRepositoryMock {
+	m := &OrganizationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateOrganizationMock = mOrganizationRepositoryMockCreateOrganization{mock: m}
+	m.CreateOrganizationMock.callArgs = []*OrganizationRepositoryMockCreateOrganizationParams{}
+
+	m.DeleteOrganizationMock = mOrganizationRepositoryMockDeleteOrganization{mock: m}
+	m.DeleteOrganizationMock.callArgs = []*OrganizationRepositoryMockDeleteOrganizationParams{}
+
+	m.GetOrganizationMock = mOrganizationRepositoryMockGetOrganization{mock: m}
+	m.GetOrganizationMock.callArgs = []*OrganizationRepositoryMockGetOrganizationParams{}
+
+	m.ListOrganizationsMock = mOrganizationRepositoryMockListOrganizations{mock: m}
+	m.ListOrganizationsMock.callArgs = []*OrganizationRepositoryMockListOrganizationsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOrganizationRepositoryMockCreateOrganization struct {
+	optional           bool
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockCreateOrganizationExpectation
+	expectations       []*OrganizationRepositoryMockCreateOrganizationExpectation
+
+	callArgs []*OrganizationRepositoryMockCreateOrganizationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationRepositoryMockCreateOrganizationExpectation specifies expectation struct of the OrganizationRepository.CreateOrganization
+type OrganizationRepositoryMockCreateOrganizationExpectation struct {
+	mock               *OrganizationRepositoryMock
+	params             *OrganizationRepositoryMockCreateOrganizationParams
+	paramPtrs          *OrganizationRepositoryMockCreateOrganizationParamPtrs
+	expectationOrigins OrganizationRepositoryMockCreateOrganizationExpectationOrigins
+	results            *OrganizationRepositoryMockCreateOrganizationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationRepositoryMockCreateOrganizationParams contains parameters of the OrganizationRepository.CreateOrganization
+type OrganizationRepositoryMockCreateOrganizationParams struct {
+	ctx  context.Context
+	name string
+}
+
+// OrganizationRepositoryMockCreateOrganizationParamPtrs contains pointers to parameters of the OrganizationRepository.CreateOrganization
+type OrganizationRepositoryMockCreateOrganizationParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// OrganizationRepositoryMockCreateOrganizationResults contains results of the OrganizationRepository.CreateOrganization
+type OrganizationRepositoryMockCreateOrganizationResults struct {
+	i1  int64
+	err error
+}
+
+// OrganizationRepositoryMockCreateOrganizationOrigins contains origins of expectations of the OrganizationRepository.CreateOrganization
+type OrganizationRepositoryMockCreateOrganizationExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Optional() *mOrganizationRepositoryMockCreateOrganization {
+	mmCreateOrganization.optional = true
+	return mmCreateOrganization
+}
+
+// Expect sets up expected params for OrganizationRepository.CreateOrganization
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Expect(ctx context.Context, name string) *mOrganizationRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationRepositoryMockCreateOrganizationExpectation{}
+	}
+
+	if mmCreateOrganization.defaultExpectation.paramPtrs != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOrganization.defaultExpectation.params = &OrganizationRepositoryMockCreateOrganizationParams{ctx, name}
+	mmCreateOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOrganization.expectations {
+		if minimock.Equal(e.params, mmCreateOrganization.defaultExpectation.params) {
+			mmCreateOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrganization.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrganization
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.CreateOrganization
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationRepositoryMockCreateOrganizationExpectation{}
+	}
+
+	if mmCreateOrganization.defaultExpectation.params != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Expect")
+	}
+
+	if mmCreateOrganization.defaultExpectation.paramPtrs == nil {
+		mmCreateOrganization.defaultExpectation.paramPtrs = &OrganizationRepositoryMockCreateOrganizationParamPtrs{}
+	}
+	mmCreateOrganization.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOrganization.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOrganization
+}
+
+// ExpectNameParam2 sets up expected param name for OrganizationRepository.CreateOrganization
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) ExpectNameParam2(name string) *mOrganizationRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationRepositoryMockCreateOrganizationExpectation{}
+	}
+
+	if mmCreateOrganization.defaultExpectation.params != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Expect")
+	}
+
+	if mmCreateOrganization.defaultExpectation.paramPtrs == nil {
+		mmCreateOrganization.defaultExpectation.paramPtrs = &OrganizationRepositoryMockCreateOrganizationParamPtrs{}
+	}
+	mmCreateOrganization.defaultExpectation.paramPtrs.name = &name
+	mmCreateOrganization.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreateOrganization
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.CreateOrganization
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Inspect(f func(ctx context.Context, name string)) *mOrganizationRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.inspectFuncCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.CreateOrganization")
+	}
+
+	mmCreateOrganization.mock.inspectFuncCreateOrganization = f
+
+	return mmCreateOrganization
+}
+
+// Return sets up results that will be returned by OrganizationRepository.CreateOrganization
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Return(i1 int64, err error) *OrganizationRepositoryMock {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationRepositoryMockCreateOrganizationExpectation{mock: mmCreateOrganization.mock}
+	}
+	mmCreateOrganization.defaultExpectation.results = &OrganizationRepositoryMockCreateOrganizationResults{i1, err}
+	mmCreateOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.CreateOrganization method
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Set(f func(ctx context.Context, name string) (i1 int64, err error)) *OrganizationRepositoryMock {
+	if mmCreateOrganization.defaultExpectation != nil {
+		mmCreateOrganization.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.CreateOrganization method")
+	}
+
+	if len(mmCreateOrganization.expectations) > 0 {
+		mmCreateOrganization.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.CreateOrganization method")
+	}
+
+	mmCreateOrganization.mock.funcCreateOrganization = f
+	mmCreateOrganization.mock.funcCreateOrganizationOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization.mock
+}
+
+// When sets expectation for the OrganizationRepository.CreateOrganization which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) When(ctx context.Context, name string) *OrganizationRepositoryMockCreateOrganizationExpectation {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationRepositoryMock.CreateOrganization mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockCreateOrganizationExpectation{
+		mock:               mmCreateOrganization.mock,
+		params:             &OrganizationRepositoryMockCreateOrganizationParams{ctx, name},
+		expectationOrigins: OrganizationRepositoryMockCreateOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOrganization.expectations = append(mmCreateOrganization.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.CreateOrganization return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockCreateOrganizationExpectation) Then(i1 int64, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockCreateOrganizationResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationRepository.CreateOrganization should be invoked
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Times(n uint64) *mOrganizationRepositoryMockCreateOrganization {
+	if n == 0 {
+		mmCreateOrganization.mock.t.Fatalf("Times of OrganizationRepositoryMock.CreateOrganization mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOrganization.expectedInvocations, n)
+	mmCreateOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization
+}
+
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) invocationsDone() bool {
+	if len(mmCreateOrganization.expectations) == 0 && mmCreateOrganization.defaultExpectation == nil && mmCreateOrganization.mock.funcCreateOrganization == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOrganization.mock.afterCreateOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOrganization.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOrganization implements mm_repository.OrganizationRepository
+func (mmCreateOrganization *OrganizationRepositoryMock) CreateOrganization(ctx context.Context, name string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateOrganization.beforeCreateOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrganization.afterCreateOrganizationCounter, 1)
+
+	mmCreateOrganization.t.Helper()
+
+	if mmCreateOrganization.inspectFuncCreateOrganization != nil {
+		mmCreateOrganization.inspectFuncCreateOrganization(ctx, name)
+	}
+
+	mm_params := OrganizationRepositoryMockCreateOrganizationParams{ctx, name}
+
+	// Record call args
+	mmCreateOrganization.CreateOrganizationMock.mutex.Lock()
+	mmCreateOrganization.CreateOrganizationMock.callArgs = append(mmCreateOrganization.CreateOrganizationMock.callArgs, &mm_params)
+	mmCreateOrganization.CreateOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrganization.CreateOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateOrganization.CreateOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrganization.CreateOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockCreateOrganizationParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOrganization.t.Errorf("OrganizationRepositoryMock.CreateOrganization got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreateOrganization.t.Errorf("OrganizationRepositoryMock.CreateOrganization got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrganization.t.Errorf("OrganizationRepositoryMock.CreateOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrganization.t.Fatal("No results are set for the OrganizationRepositoryMock.CreateOrganization")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateOrganization.funcCreateOrganization != nil {
+		return mmCreateOrganization.funcCreateOrganization(ctx, name)
+	}
+	mmCreateOrganization.t.Fatalf("Unexpected call to OrganizationRepositoryMock.CreateOrganization. %v %v", ctx, name)
+	return
+}
+
+// CreateOrganizationAfterCounter returns a count of finished OrganizationRepositoryMock.CreateOrganization invocations
+func (mmCreateOrganization *OrganizationRepositoryMock) CreateOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrganization.afterCreateOrganizationCounter)
+}
+
+// CreateOrganizationBeforeCounter returns a count of OrganizationRepositoryMock.CreateOrganization invocations
+func (mmCreateOrganization *OrganizationRepositoryMock) CreateOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrganization.beforeCreateOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.CreateOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrganization *mOrganizationRepositoryMockCreateOrganization) Calls() []*OrganizationRepositoryMockCreateOrganizationParams {
+	mmCreateOrganization.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockCreateOrganizationParams, len(mmCreateOrganization.callArgs))
+	copy(argCopy, mmCreateOrganization.callArgs)
+
+	mmCreateOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrganizationDone returns true if the count of the CreateOrganization invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockCreateOrganizationDone() bool {
+	if m.CreateOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOrganizationMock.invocationsDone()
+}
+
+// MinimockCreateOrganizationInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockCreateOrganizationInspect() {
+	for _, e := range m.CreateOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.CreateOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOrganizationCounter := mm_atomic.LoadUint64(&m.afterCreateOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrganizationMock.defaultExpectation != nil && afterCreateOrganizationCounter < 1 {
+		if m.CreateOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.CreateOrganization at\n%s", m.CreateOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.CreateOrganization at\n%s with params: %#v", m.CreateOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.CreateOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrganization != nil && afterCreateOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationRepositoryMock.CreateOrganization at\n%s", m.funcCreateOrganizationOrigin)
+	}
+
+	if !m.CreateOrganizationMock.invocationsDone() && afterCreateOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationRepositoryMock.CreateOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOrganizationMock.expectedInvocations), m.CreateOrganizationMock.expectedInvocationsOrigin, afterCreateOrganizationCounter)
+	}
+}
+
+type mOrganizationRepositoryMockDeleteOrganization struct {
+	optional           bool
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockDeleteOrganizationExpectation
+	expectations       []*OrganizationRepositoryMockDeleteOrganizationExpectation
+
+	callArgs []*OrganizationRepositoryMockDeleteOrganizationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationRepositoryMockDeleteOrganizationExpectation specifies expectation struct of the OrganizationRepository.DeleteOrganization
+type OrganizationRepositoryMockDeleteOrganizationExpectation struct {
+	mock               *OrganizationRepositoryMock
+	params             *OrganizationRepositoryMockDeleteOrganizationParams
+	paramPtrs          *OrganizationRepositoryMockDeleteOrganizationParamPtrs
+	expectationOrigins OrganizationRepositoryMockDeleteOrganizationExpectationOrigins
+	results            *OrganizationRepositoryMockDeleteOrganizationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationRepositoryMockDeleteOrganizationParams contains parameters of the OrganizationRepository.DeleteOrganization
+type OrganizationRepositoryMockDeleteOrganizationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OrganizationRepositoryMockDeleteOrganizationParamPtrs contains pointers to parameters of the OrganizationRepository.DeleteOrganization
+type OrganizationRepositoryMockDeleteOrganizationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// OrganizationRepositoryMockDeleteOrganizationResults contains results of the OrganizationRepository.DeleteOrganization
+type OrganizationRepositoryMockDeleteOrganizationResults struct {
+	err error
+}
+
+// OrganizationRepositoryMockDeleteOrganizationOrigins contains origins of expectations of the OrganizationRepository.DeleteOrganization
+type OrganizationRepositoryMockDeleteOrganizationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Optional() *mOrganizationRepositoryMockDeleteOrganization {
+	mmDeleteOrganization.optional = true
+	return mmDeleteOrganization
+}
+
+// Expect sets up expected params for OrganizationRepository.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Expect(ctx context.Context, id int64) *mOrganizationRepositoryMockDeleteOrganization {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationRepositoryMockDeleteOrganizationExpectation{}
+	}
+
+	if mmDeleteOrganization.defaultExpectation.paramPtrs != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOrganization.defaultExpectation.params = &OrganizationRepositoryMockDeleteOrganizationParams{ctx, id}
+	mmDeleteOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOrganization.expectations {
+		if minimock.Equal(e.params, mmDeleteOrganization.defaultExpectation.params) {
+			mmDeleteOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOrganization.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOrganization
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockDeleteOrganization {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationRepositoryMockDeleteOrganizationExpectation{}
+	}
+
+	if mmDeleteOrganization.defaultExpectation.params != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Expect")
+	}
+
+	if mmDeleteOrganization.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrganization.defaultExpectation.paramPtrs = &OrganizationRepositoryMockDeleteOrganizationParamPtrs{}
+	}
+	mmDeleteOrganization.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOrganization.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOrganization
+}
+
+// ExpectIdParam2 sets up expected param id for OrganizationRepository.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) ExpectIdParam2(id int64) *mOrganizationRepositoryMockDeleteOrganization {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationRepositoryMockDeleteOrganizationExpectation{}
+	}
+
+	if mmDeleteOrganization.defaultExpectation.params != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Expect")
+	}
+
+	if mmDeleteOrganization.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrganization.defaultExpectation.paramPtrs = &OrganizationRepositoryMockDeleteOrganizationParamPtrs{}
+	}
+	mmDeleteOrganization.defaultExpectation.paramPtrs.id = &id
+	mmDeleteOrganization.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteOrganization
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Inspect(f func(ctx context.Context, id int64)) *mOrganizationRepositoryMockDeleteOrganization {
+	if mmDeleteOrganization.mock.inspectFuncDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.DeleteOrganization")
+	}
+
+	mmDeleteOrganization.mock.inspectFuncDeleteOrganization = f
+
+	return mmDeleteOrganization
+}
+
+// Return sets up results that will be returned by OrganizationRepository.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Return(err error) *OrganizationRepositoryMock {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationRepositoryMockDeleteOrganizationExpectation{mock: mmDeleteOrganization.mock}
+	}
+	mmDeleteOrganization.defaultExpectation.results = &OrganizationRepositoryMockDeleteOrganizationResults{err}
+	mmDeleteOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganization.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.DeleteOrganization method
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Set(f func(ctx context.Context, id int64) (err error)) *OrganizationRepositoryMock {
+	if mmDeleteOrganization.defaultExpectation != nil {
+		mmDeleteOrganization.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.DeleteOrganization method")
+	}
+
+	if len(mmDeleteOrganization.expectations) > 0 {
+		mmDeleteOrganization.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.DeleteOrganization method")
+	}
+
+	mmDeleteOrganization.mock.funcDeleteOrganization = f
+	mmDeleteOrganization.mock.funcDeleteOrganizationOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganization.mock
+}
+
+// When sets expectation for the OrganizationRepository.DeleteOrganization which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) When(ctx context.Context, id int64) *OrganizationRepositoryMockDeleteOrganizationExpectation {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationRepositoryMock.DeleteOrganization mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockDeleteOrganizationExpectation{
+		mock:               mmDeleteOrganization.mock,
+		params:             &OrganizationRepositoryMockDeleteOrganizationParams{ctx, id},
+		expectationOrigins: OrganizationRepositoryMockDeleteOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOrganization.expectations = append(mmDeleteOrganization.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.DeleteOrganization return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockDeleteOrganizationExpectation) Then(err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockDeleteOrganizationResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationRepository.DeleteOrganization should be invoked
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Times(n uint64) *mOrganizationRepositoryMockDeleteOrganization {
+	if n == 0 {
+		mmDeleteOrganization.mock.t.Fatalf("Times of OrganizationRepositoryMock.DeleteOrganization mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOrganization.expectedInvocations, n)
+	mmDeleteOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganization
+}
+
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) invocationsDone() bool {
+	if len(mmDeleteOrganization.expectations) == 0 && mmDeleteOrganization.defaultExpectation == nil && mmDeleteOrganization.mock.funcDeleteOrganization == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOrganization.mock.afterDeleteOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOrganization.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOrganization implements mm_repository.OrganizationRepository
+func (mmDeleteOrganization *OrganizationRepositoryMock) DeleteOrganization(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOrganization.beforeDeleteOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOrganization.afterDeleteOrganizationCounter, 1)
+
+	mmDeleteOrganization.t.Helper()
+
+	if mmDeleteOrganization.inspectFuncDeleteOrganization != nil {
+		mmDeleteOrganization.inspectFuncDeleteOrganization(ctx, id)
+	}
+
+	mm_params := OrganizationRepositoryMockDeleteOrganizationParams{ctx, id}
+
+	// Record call args
+	mmDeleteOrganization.DeleteOrganizationMock.mutex.Lock()
+	mmDeleteOrganization.DeleteOrganizationMock.callArgs = append(mmDeleteOrganization.DeleteOrganizationMock.callArgs, &mm_params)
+	mmDeleteOrganization.DeleteOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOrganization.DeleteOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockDeleteOrganizationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOrganization.t.Errorf("OrganizationRepositoryMock.DeleteOrganization got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteOrganization.t.Errorf("OrganizationRepositoryMock.DeleteOrganization got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOrganization.t.Errorf("OrganizationRepositoryMock.DeleteOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOrganization.t.Fatal("No results are set for the OrganizationRepositoryMock.DeleteOrganization")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOrganization.funcDeleteOrganization != nil {
+		return mmDeleteOrganization.funcDeleteOrganization(ctx, id)
+	}
+	mmDeleteOrganization.t.Fatalf("Unexpected call to OrganizationRepositoryMock.DeleteOrganization. %v %v", ctx, id)
+	return
+}
+
+// DeleteOrganizationAfterCounter returns a count of finished OrganizationRepositoryMock.DeleteOrganization invocations
+func (mmDeleteOrganization *OrganizationRepositoryMock) DeleteOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrganization.afterDeleteOrganizationCounter)
+}
+
+// DeleteOrganizationBeforeCounter returns a count of OrganizationRepositoryMock.DeleteOrganization invocations
+func (mmDeleteOrganization *OrganizationRepositoryMock) DeleteOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrganization.beforeDeleteOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.DeleteOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOrganization *mOrganizationRepositoryMockDeleteOrganization) Calls() []*OrganizationRepositoryMockDeleteOrganizationParams {
+	mmDeleteOrganization.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockDeleteOrganizationParams, len(mmDeleteOrganization.callArgs))
+	copy(argCopy, mmDeleteOrganization.callArgs)
+
+	mmDeleteOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOrganizationDone returns true if the count of the DeleteOrganization invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockDeleteOrganizationDone() bool {
+	if m.DeleteOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOrganizationMock.invocationsDone()
+}
+
+// MinimockDeleteOrganizationInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockDeleteOrganizationInspect() {
+	for _, e := range m.DeleteOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.DeleteOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOrganizationCounter := mm_atomic.LoadUint64(&m.afterDeleteOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOrganizationMock.defaultExpectation != nil && afterDeleteOrganizationCounter < 1 {
+		if m.DeleteOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.DeleteOrganization at\n%s", m.DeleteOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.DeleteOrganization at\n%s with params: %#v", m.DeleteOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOrganization != nil && afterDeleteOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationRepositoryMock.DeleteOrganization at\n%s", m.funcDeleteOrganizationOrigin)
+	}
+
+	if !m.DeleteOrganizationMock.invocationsDone() && afterDeleteOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationRepositoryMock.DeleteOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOrganizationMock.expectedInvocations), m.DeleteOrganizationMock.expectedInvocationsOrigin, afterDeleteOrganizationCounter)
+	}
+}
+
+type mOrganizationRepositoryMockGetOrganization struct {
+	optional           bool
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockGetOrganizationExpectation
+	expectations       []*OrganizationRepositoryMockGetOrganizationExpectation
+
+	callArgs []*OrganizationRepositoryMockGetOrganizationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationRepositoryMockGetOrganizationExpectation specifies expectation struct of the OrganizationRepository.GetOrganization
+type OrganizationRepositoryMockGetOrganizationExpectation struct {
+	mock               *OrganizationRepositoryMock
+	params             *OrganizationRepositoryMockGetOrganizationParams
+	paramPtrs          *OrganizationRepositoryMockGetOrganizationParamPtrs
+	expectationOrigins OrganizationRepositoryMockGetOrganizationExpectationOrigins
+	results            *OrganizationRepositoryMockGetOrganizationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationRepositoryMockGetOrganizationParams contains parameters of the OrganizationRepository.GetOrganization
+type OrganizationRepositoryMockGetOrganizationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OrganizationRepositoryMockGetOrganizationParamPtrs contains pointers to parameters of the OrganizationRepository.GetOrganization
+type OrganizationRepositoryMockGetOrganizationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// OrganizationRepositoryMockGetOrganizationResults contains results of the OrganizationRepository.GetOrganization
+type OrganizationRepositoryMockGetOrganizationResults struct {
+	op1 *model.Organization
+	err error
+}
+
+// OrganizationRepositoryMockGetOrganizationOrigins contains origins of expectations of the OrganizationRepository.GetOrganization
+type OrganizationRepositoryMockGetOrganizationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Optional() *mOrganizationRepositoryMockGetOrganization {
+	mmGetOrganization.optional = true
+	return mmGetOrganization
+}
+
+// Expect sets up expected params for OrganizationRepository.GetOrganization
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Expect(ctx context.Context, id int64) *mOrganizationRepositoryMockGetOrganization {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationRepositoryMockGetOrganizationExpectation{}
+	}
+
+	if mmGetOrganization.defaultExpectation.paramPtrs != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrganization.defaultExpectation.params = &OrganizationRepositoryMockGetOrganizationParams{ctx, id}
+	mmGetOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrganization.expectations {
+		if minimock.Equal(e.params, mmGetOrganization.defaultExpectation.params) {
+			mmGetOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrganization.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrganization
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.GetOrganization
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockGetOrganization {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationRepositoryMockGetOrganizationExpectation{}
+	}
+
+	if mmGetOrganization.defaultExpectation.params != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Expect")
+	}
+
+	if mmGetOrganization.defaultExpectation.paramPtrs == nil {
+		mmGetOrganization.defaultExpectation.paramPtrs = &OrganizationRepositoryMockGetOrganizationParamPtrs{}
+	}
+	mmGetOrganization.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrganization.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrganization
+}
+
+// ExpectIdParam2 sets up expected param id for OrganizationRepository.GetOrganization
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) ExpectIdParam2(id int64) *mOrganizationRepositoryMockGetOrganization {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationRepositoryMockGetOrganizationExpectation{}
+	}
+
+	if mmGetOrganization.defaultExpectation.params != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Expect")
+	}
+
+	if mmGetOrganization.defaultExpectation.paramPtrs == nil {
+		mmGetOrganization.defaultExpectation.paramPtrs = &OrganizationRepositoryMockGetOrganizationParamPtrs{}
+	}
+	mmGetOrganization.defaultExpectation.paramPtrs.id = &id
+	mmGetOrganization.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetOrganization
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.GetOrganization
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Inspect(f func(ctx context.Context, id int64)) *mOrganizationRepositoryMockGetOrganization {
+	if mmGetOrganization.mock.inspectFuncGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.GetOrganization")
+	}
+
+	mmGetOrganization.mock.inspectFuncGetOrganization = f
+
+	return mmGetOrganization
+}
+
+// Return sets up results that will be returned by OrganizationRepository.GetOrganization
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Return(op1 *model.Organization, err error) *OrganizationRepositoryMock {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationRepositoryMockGetOrganizationExpectation{mock: mmGetOrganization.mock}
+	}
+	mmGetOrganization.defaultExpectation.results = &OrganizationRepositoryMockGetOrganizationResults{op1, err}
+	mmGetOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrganization.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.GetOrganization method
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Set(f func(ctx context.Context, id int64) (op1 *model.Organization, err error)) *OrganizationRepositoryMock {
+	if mmGetOrganization.defaultExpectation != nil {
+		mmGetOrganization.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.GetOrganization method")
+	}
+
+	if len(mmGetOrganization.expectations) > 0 {
+		mmGetOrganization.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.GetOrganization method")
+	}
+
+	mmGetOrganization.mock.funcGetOrganization = f
+	mmGetOrganization.mock.funcGetOrganizationOrigin = minimock.CallerInfo(1)
+	return mmGetOrganization.mock
+}
+
+// When sets expectation for the OrganizationRepository.GetOrganization which will trigger the result defined by the following
+// Then helper
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) When(ctx context.Context, id int64) *OrganizationRepositoryMockGetOrganizationExpectation {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationRepositoryMock.GetOrganization mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockGetOrganizationExpectation{
+		mock:               mmGetOrganization.mock,
+		params:             &OrganizationRepositoryMockGetOrganizationParams{ctx, id},
+		expectationOrigins: OrganizationRepositoryMockGetOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrganization.expectations = append(mmGetOrganization.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.GetOrganization return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockGetOrganizationExpectation) Then(op1 *model.Organization, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockGetOrganizationResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationRepository.GetOrganization should be invoked
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Times(n uint64) *mOrganizationRepositoryMockGetOrganization {
+	if n == 0 {
+		mmGetOrganization.mock.t.Fatalf("Times of OrganizationRepositoryMock.GetOrganization mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrganization.expectedInvocations, n)
+	mmGetOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrganization
+}
+
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) invocationsDone() bool {
+	if len(mmGetOrganization.expectations) == 0 && mmGetOrganization.defaultExpectation == nil && mmGetOrganization.mock.funcGetOrganization == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrganization.mock.afterGetOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrganization.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrganization implements mm_repository.OrganizationRepository
+func (mmGetOrganization *OrganizationRepositoryMock) GetOrganization(ctx context.Context, id int64) (op1 *model.Organization, err error) {
+	mm_atomic.AddUint64(&mmGetOrganization.beforeGetOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrganization.afterGetOrganizationCounter, 1)
+
+	mmGetOrganization.t.Helper()
+
+	if mmGetOrganization.inspectFuncGetOrganization != nil {
+		mmGetOrganization.inspectFuncGetOrganization(ctx, id)
+	}
+
+	mm_params := OrganizationRepositoryMockGetOrganizationParams{ctx, id}
+
+	// Record call args
+	mmGetOrganization.GetOrganizationMock.mutex.Lock()
+	mmGetOrganization.GetOrganizationMock.callArgs = append(mmGetOrganization.GetOrganizationMock.callArgs, &mm_params)
+	mmGetOrganization.GetOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmGetOrganization.GetOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOrganization.GetOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrganization.GetOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrganization.GetOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrganization.GetOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockGetOrganizationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrganization.t.Errorf("OrganizationRepositoryMock.GetOrganization got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrganization.GetOrganizationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetOrganization.t.Errorf("OrganizationRepositoryMock.GetOrganization got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrganization.GetOrganizationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrganization.t.Errorf("OrganizationRepositoryMock.GetOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrganization.GetOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrganization.GetOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrganization.t.Fatal("No results are set for the OrganizationRepositoryMock.GetOrganization")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOrganization.funcGetOrganization != nil {
+		return mmGetOrganization.funcGetOrganization(ctx, id)
+	}
+	mmGetOrganization.t.Fatalf("Unexpected call to OrganizationRepositoryMock.GetOrganization. %v %v", ctx, id)
+	return
+}
+
+// GetOrganizationAfterCounter returns a count of finished OrganizationRepositoryMock.GetOrganization invocations
+func (mmGetOrganization *OrganizationRepositoryMock) GetOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganization.afterGetOrganizationCounter)
+}
+
+// GetOrganizationBeforeCounter returns a count of OrganizationRepositoryMock.GetOrganization invocations
+func (mmGetOrganization *OrganizationRepositoryMock) GetOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganization.beforeGetOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.GetOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrganization *mOrganizationRepositoryMockGetOrganization) Calls() []*OrganizationRepositoryMockGetOrganizationParams {
+	mmGetOrganization.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockGetOrganizationParams, len(mmGetOrganization.callArgs))
+	copy(argCopy, mmGetOrganization.callArgs)
+
+	mmGetOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrganizationDone returns true if the count of the GetOrganization invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockGetOrganizationDone() bool {
+	if m.GetOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrganizationMock.invocationsDone()
+}
+
+// MinimockGetOrganizationInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockGetOrganizationInspect() {
+	for _, e := range m.GetOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.GetOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrganizationCounter := mm_atomic.LoadUint64(&m.afterGetOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrganizationMock.defaultExpectation != nil && afterGetOrganizationCounter < 1 {
+		if m.GetOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.GetOrganization at\n%s", m.GetOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.GetOrganization at\n%s with params: %#v", m.GetOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.GetOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrganization != nil && afterGetOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationRepositoryMock.GetOrganization at\n%s", m.funcGetOrganizationOrigin)
+	}
+
+	if !m.GetOrganizationMock.invocationsDone() && afterGetOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationRepositoryMock.GetOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrganizationMock.expectedInvocations), m.GetOrganizationMock.expectedInvocationsOrigin, afterGetOrganizationCounter)
+	}
+}
+
+type mOrganizationRepositoryMockListOrganizations struct {
+	optional           bool
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockListOrganizationsExpectation
+	expectations       []*OrganizationRepositoryMockListOrganizationsExpectation
+
+	callArgs []*OrganizationRepositoryMockListOrganizationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationRepositoryMockListOrganizationsExpectation specifies expectation struct of the OrganizationRepository.ListOrganizations
+type OrganizationRepositoryMockListOrganizationsExpectation struct {
+	mock               *OrganizationRepositoryMock
+	params             *OrganizationRepositoryMockListOrganizationsParams
+	paramPtrs          *OrganizationRepositoryMockListOrganizationsParamPtrs
+	expectationOrigins OrganizationRepositoryMockListOrganizationsExpectationOrigins
+	results            *OrganizationRepositoryMockListOrganizationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationRepositoryMockListOrganizationsParams contains parameters of the OrganizationRepository.ListOrganizations
+type OrganizationRepositoryMockListOrganizationsParams struct {
+	ctx context.Context
+}
+
+// OrganizationRepositoryMockListOrganizationsParamPtrs contains pointers to parameters of the OrganizationRepository.ListOrganizations
+type OrganizationRepositoryMockListOrganizationsParamPtrs struct {
+	ctx *context.Context
+}
+
+// OrganizationRepositoryMockListOrganizationsResults contains results of the OrganizationRepository.ListOrganizations
+type OrganizationRepositoryMockListOrganizationsResults struct {
+	opa1 []*model.Organization
+	err  error
+}
+
+// OrganizationRepositoryMockListOrganizationsOrigins contains origins of expectations of the OrganizationRepository.ListOrganizations
+type OrganizationRepositoryMockListOrganizationsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Optional() *mOrganizationRepositoryMockListOrganizations {
+	mmListOrganizations.optional = true
+	return mmListOrganizations
+}
+
+// Expect sets up expected params for OrganizationRepository.ListOrganizations
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Expect(ctx context.Context) *mOrganizationRepositoryMockListOrganizations {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &OrganizationRepositoryMockListOrganizationsExpectation{}
+	}
+
+	if mmListOrganizations.defaultExpectation.paramPtrs != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationRepositoryMock.ListOrganizations mock is already set by ExpectParams functions")
+	}
+
+	mmListOrganizations.defaultExpectation.params = &OrganizationRepositoryMockListOrganizationsParams{ctx}
+	mmListOrganizations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOrganizations.expectations {
+		if minimock.Equal(e.params, mmListOrganizations.defaultExpectation.params) {
+			mmListOrganizations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrganizations.defaultExpectation.params)
+		}
+	}
+
+	return mmListOrganizations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.ListOrganizations
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockListOrganizations {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &OrganizationRepositoryMockListOrganizationsExpectation{}
+	}
+
+	if mmListOrganizations.defaultExpectation.params != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationRepositoryMock.ListOrganizations mock is already set by Expect")
+	}
+
+	if mmListOrganizations.defaultExpectation.paramPtrs == nil {
+		mmListOrganizations.defaultExpectation.paramPtrs = &OrganizationRepositoryMockListOrganizationsParamPtrs{}
+	}
+	mmListOrganizations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOrganizations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOrganizations
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.ListOrganizations
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Inspect(f func(ctx context.Context)) *mOrganizationRepositoryMockListOrganizations {
+	if mmListOrganizations.mock.inspectFuncListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.ListOrganizations")
+	}
+
+	mmListOrganizations.mock.inspectFuncListOrganizations = f
+
+	return mmListOrganizations
+}
+
+// Return sets up results that will be returned by OrganizationRepository.ListOrganizations
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Return(opa1 []*model.Organization, err error) *OrganizationRepositoryMock {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &OrganizationRepositoryMockListOrganizationsExpectation{mock: mmListOrganizations.mock}
+	}
+	mmListOrganizations.defaultExpectation.results = &OrganizationRepositoryMockListOrganizationsResults{opa1, err}
+	mmListOrganizations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.ListOrganizations method
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Set(f func(ctx context.Context) (opa1 []*model.Organization, err error)) *OrganizationRepositoryMock {
+	if mmListOrganizations.defaultExpectation != nil {
+		mmListOrganizations.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.ListOrganizations method")
+	}
+
+	if len(mmListOrganizations.expectations) > 0 {
+		mmListOrganizations.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.ListOrganizations method")
+	}
+
+	mmListOrganizations.mock.funcListOrganizations = f
+	mmListOrganizations.mock.funcListOrganizationsOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations.mock
+}
+
+// When sets expectation for the OrganizationRepository.ListOrganizations which will trigger the result defined by the following
+// Then helper
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) When(ctx context.Context) *OrganizationRepositoryMockListOrganizationsExpectation {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockListOrganizationsExpectation{
+		mock:               mmListOrganizations.mock,
+		params:             &OrganizationRepositoryMockListOrganizationsParams{ctx},
+		expectationOrigins: OrganizationRepositoryMockListOrganizationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOrganizations.expectations = append(mmListOrganizations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.ListOrganizations return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockListOrganizationsExpectation) Then(opa1 []*model.Organization, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockListOrganizationsResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationRepository.ListOrganizations should be invoked
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Times(n uint64) *mOrganizationRepositoryMockListOrganizations {
+	if n == 0 {
+		mmListOrganizations.mock.t.Fatalf("Times of OrganizationRepositoryMock.ListOrganizations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOrganizations.expectedInvocations, n)
+	mmListOrganizations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations
+}
+
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) invocationsDone() bool {
+	if len(mmListOrganizations.expectations) == 0 && mmListOrganizations.defaultExpectation == nil && mmListOrganizations.mock.funcListOrganizations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOrganizations.mock.afterListOrganizationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOrganizations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOrganizations implements mm_repository.OrganizationRepository
+func (mmListOrganizations *OrganizationRepositoryMock) ListOrganizations(ctx context.Context) (opa1 []*model.Organization, err error) {
+	mm_atomic.AddUint64(&mmListOrganizations.beforeListOrganizationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOrganizations.afterListOrganizationsCounter, 1)
+
+	mmListOrganizations.t.Helper()
+
+	if mmListOrganizations.inspectFuncListOrganizations != nil {
+		mmListOrganizations.inspectFuncListOrganizations(ctx)
+	}
+
+	mm_params := OrganizationRepositoryMockListOrganizationsParams{ctx}
+
+	// Record call args
+	mmListOrganizations.ListOrganizationsMock.mutex.Lock()
+	mmListOrganizations.ListOrganizationsMock.callArgs = append(mmListOrganizations.ListOrganizationsMock.callArgs, &mm_params)
+	mmListOrganizations.ListOrganizationsMock.mutex.Unlock()
+
+	for _, e := range mmListOrganizations.ListOrganizationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmListOrganizations.ListOrganizationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOrganizations.ListOrganizationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOrganizations.ListOrganizationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListOrganizations.ListOrganizationsMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockListOrganizationsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOrganizations.t.Errorf("OrganizationRepositoryMock.ListOrganizations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrganizations.ListOrganizationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOrganizations.t.Errorf("OrganizationRepositoryMock.ListOrganizations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOrganizations.ListOrganizationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOrganizations.ListOrganizationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOrganizations.t.Fatal("No results are set for the OrganizationRepositoryMock.ListOrganizations")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmListOrganizations.funcListOrganizations != nil {
+		return mmListOrganizations.funcListOrganizations(ctx)
+	}
+	mmListOrganizations.t.Fatalf("Unexpected call to OrganizationRepositoryMock.ListOrganizations. %v", ctx)
+	return
+}
+
+// ListOrganizationsAfterCounter returns a count of finished OrganizationRepositoryMock.ListOrganizations invocations
+func (mmListOrganizations *OrganizationRepositoryMock) ListOrganizationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrganizations.afterListOrganizationsCounter)
+}
+
+// ListOrganizationsBeforeCounter returns a count of OrganizationRepositoryMock.ListOrganizations invocations
+func (mmListOrganizations *OrganizationRepositoryMock) ListOrganizationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrganizations.beforeListOrganizationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.ListOrganizations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOrganizations *mOrganizationRepositoryMockListOrganizations) Calls() []*OrganizationRepositoryMockListOrganizationsParams {
+	mmListOrganizations.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockListOrganizationsParams, len(mmListOrganizations.callArgs))
+	copy(argCopy, mmListOrganizations.callArgs)
+
+	mmListOrganizations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOrganizationsDone returns true if the count of the ListOrganizations invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockListOrganizationsDone() bool {
+	if m.ListOrganizationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOrganizationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOrganizationsMock.invocationsDone()
+}
+
+// MinimockListOrganizationsInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockListOrganizationsInspect() {
+	for _, e := range m.ListOrganizationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.ListOrganizations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOrganizationsCounter := mm_atomic.LoadUint64(&m.afterListOrganizationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOrganizationsMock.defaultExpectation != nil && afterListOrganizationsCounter < 1 {
+		if m.ListOrganizationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.ListOrganizations at\n%s", m.ListOrganizationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.ListOrganizations at\n%s with params: %#v", m.ListOrganizationsMock.defaultExpectation.expectationOrigins.origin, *m.ListOrganizationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOrganizations != nil && afterListOrganizationsCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationRepositoryMock.ListOrganizations at\n%s", m.funcListOrganizationsOrigin)
+	}
+
+	if !m.ListOrganizationsMock.invocationsDone() && afterListOrganizationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationRepositoryMock.ListOrganizations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOrganizationsMock.expectedInvocations), m.ListOrganizationsMock.expectedInvocationsOrigin, afterListOrganizationsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrganizationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateOrganizationInspect()
+
+			m.MinimockDeleteOrganizationInspect()
+
+			m.MinimockGetOrganizationInspect()
+
+			m.MinimockListOrganizationsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OrganizationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OrganizationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateOrganizationDone() &&
+		m.MinimockDeleteOrganizationDone() &&
+		m.MinimockGetOrganizationDone() &&
+		m.MinimockListOrganizationsDone()
+}
@@ -0,0 +1,2582 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.PermissionRepository -o permission_repository_minimock.go -n PermissionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// PermissionRepositoryMock implements mm_repository.PermissionRepository
+type PermissionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreatePermission          func(ctx context.Context, permission *model.Permission) (i1 int64, err error)
+	funcCreatePermissionOrigin    string
+	inspectFuncCreatePermission   func(ctx context.Context, permission *model.Permission)
+	afterCreatePermissionCounter  uint64
+	beforeCreatePermissionCounter uint64
+	CreatePermissionMock          mPermissionRepositoryMockCreatePermission
+
+	funcDeletePermission          func(ctx context.Context, name string) (err error)
+	funcDeletePermissionOrigin    string
+	inspectFuncDeletePermission   func(ctx context.Context, name string)
+	afterDeletePermissionCounter  uint64
+	beforeDeletePermissionCounter uint64
+	DeletePermissionMock          mPermissionRepositoryMockDeletePermission
+
+	funcGrantPermission          func(ctx context.Context, role string, permission string) (err error)
+	funcGrantPermissionOrigin    string
+	inspectFuncGrantPermission   func(ctx context.Context, role string, permission string)
+	afterGrantPermissionCounter  uint64
+	beforeGrantPermissionCounter uint64
+	GrantPermissionMock          mPermissionRepositoryMockGrantPermission
+
+	funcListEffectivePermissions          func(ctx context.Context, role string) (sa1 []string, err error)
+	funcListEffectivePermissionsOrigin    string
+	inspectFuncListEffectivePermissions   func(ctx context.Context, role string)
+	afterListEffectivePermissionsCounter  uint64
+	beforeListEffectivePermissionsCounter uint64
+	ListEffectivePermissionsMock          mPermissionRepositoryMockListEffectivePermissions
+
+	funcListPermissions          func(ctx context.Context) (ppa1 []*model.Permission, err error)
+	funcListPermissionsOrigin    string
+	inspectFuncListPermissions   func(ctx context.Context)
+	afterListPermissionsCounter  uint64
+	beforeListPermissionsCounter uint64
+	ListPermissionsMock          mPermissionRepositoryMockListPermissions
+
+	funcListRolePermissions          func(ctx context.Context, role string) (sa1 []string, err error)
+	funcListRolePermissionsOrigin    string
+	inspectFuncListRolePermissions   func(ctx context.Context, role string)
+	afterListRolePermissionsCounter  uint64
+	beforeListRolePermissionsCounter uint64
+	ListRolePermissionsMock          mPermissionRepositoryMockListRolePermissions
+
+	funcRevokePermission          func(ctx context.Context, role string, permission string) (err error)
+	funcRevokePermissionOrigin    string
+	inspectFuncRevokePermission   func(ctx context.Context, role string, permission string)
+	afterRevokePermissionCounter  uint64
+	beforeRevokePermissionCounter uint64
+	RevokePermissionMock          mPermissionRepositoryMockRevokePermission
+}
+
+// NewPermissionRepositoryMock returns a mock for mm_repository.PermissionRepository
+func NewPermissionRepositoryMock(t minimock.Tester) *PermissionRepositoryMock {
+	m := &PermissionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreatePermissionMock = mPermissionRepositoryMockCreatePermission{mock: m}
+	m.CreatePermissionMock.callArgs = []*PermissionRepositoryMockCreatePermissionParams{}
+
+	m.DeletePermissionMock = mPermissionRepositoryMockDeletePermission{mock: m}
+	m.DeletePermissionMock.callArgs = []*PermissionRepositoryMockDeletePermissionParams{}
+
+	m.GrantPermissionMock = mPermissionRepositoryMockGrantPermission{mock: m}
+	m.GrantPermissionMock.callArgs = []*PermissionRepositoryMockGrantPermissionParams{}
+
+	m.ListEffectivePermissionsMock = mPermissionRepositoryMockListEffectivePermissions{mock: m}
+	m.ListEffectivePermissionsMock.callArgs = []*PermissionRepositoryMockListEffectivePermissionsParams{}
+
+	m.ListPermissionsMock = mPermissionRepositoryMockListPermissions{mock: m}
+	m.ListPermissionsMock.callArgs = []*PermissionRepositoryMockListPermissionsParams{}
+
+	m.ListRolePermissionsMock = mPermissionRepositoryMockListRolePermissions{mock: m}
+	m.ListRolePermissionsMock.callArgs = []*PermissionRepositoryMockListRolePermissionsParams{}
+
+	m.RevokePermissionMock = mPermissionRepositoryMockRevokePermission{mock: m}
+	m.RevokePermissionMock.callArgs = []*PermissionRepositoryMockRevokePermissionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPermissionRepositoryMockCreatePermission struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockCreatePermissionExpectation
+	expectations       []*PermissionRepositoryMockCreatePermissionExpectation
+
+	callArgs []*PermissionRepositoryMockCreatePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockCreatePermissionExpectation specifies expectation struct of the PermissionRepository.CreatePermission
+type PermissionRepositoryMockCreatePermissionExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockCreatePermissionParams
+	paramPtrs          *PermissionRepositoryMockCreatePermissionParamPtrs
+	expectationOrigins PermissionRepositoryMockCreatePermissionExpectationOrigins
+	results            *PermissionRepositoryMockCreatePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockCreatePermissionParams contains parameters of the PermissionRepository.CreatePermission
+type PermissionRepositoryMockCreatePermissionParams struct {
+	ctx        context.Context
+	permission *model.Permission
+}
+
+// PermissionRepositoryMockCreatePermissionParamPtrs contains pointers to parameters of the PermissionRepository.CreatePermission
+type PermissionRepositoryMockCreatePermissionParamPtrs struct {
+	ctx        *context.Context
+	permission **model.Permission
+}
+
+// PermissionRepositoryMockCreatePermissionResults contains results of the PermissionRepository.CreatePermission
+type PermissionRepositoryMockCreatePermissionResults struct {
+	i1  int64
+	err error
+}
+
+// PermissionRepositoryMockCreatePermissionOrigins contains origins of expectations of the PermissionRepository.CreatePermission
+type PermissionRepositoryMockCreatePermissionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originPermission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Optional() *mPermissionRepositoryMockCreatePermission {
+	mmCreatePermission.optional = true
+	return mmCreatePermission
+}
+
+// Expect sets up expected params for PermissionRepository.CreatePermission
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Expect(ctx context.Context, permission *model.Permission) *mPermissionRepositoryMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PermissionRepositoryMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePermission.defaultExpectation.params = &PermissionRepositoryMockCreatePermissionParams{ctx, permission}
+	mmCreatePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePermission.expectations {
+		if minimock.Equal(e.params, mmCreatePermission.defaultExpectation.params) {
+			mmCreatePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.CreatePermission
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PermissionRepositoryMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePermission
+}
+
+// ExpectPermissionParam2 sets up expected param permission for PermissionRepository.CreatePermission
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) ExpectPermissionParam2(permission *model.Permission) *mPermissionRepositoryMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PermissionRepositoryMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.permission = &permission
+	mmCreatePermission.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmCreatePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.CreatePermission
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Inspect(f func(ctx context.Context, permission *model.Permission)) *mPermissionRepositoryMockCreatePermission {
+	if mmCreatePermission.mock.inspectFuncCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.CreatePermission")
+	}
+
+	mmCreatePermission.mock.inspectFuncCreatePermission = f
+
+	return mmCreatePermission
+}
+
+// Return sets up results that will be returned by PermissionRepository.CreatePermission
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Return(i1 int64, err error) *PermissionRepositoryMock {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PermissionRepositoryMockCreatePermissionExpectation{mock: mmCreatePermission.mock}
+	}
+	mmCreatePermission.defaultExpectation.results = &PermissionRepositoryMockCreatePermissionResults{i1, err}
+	mmCreatePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePermission.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.CreatePermission method
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Set(f func(ctx context.Context, permission *model.Permission) (i1 int64, err error)) *PermissionRepositoryMock {
+	if mmCreatePermission.defaultExpectation != nil {
+		mmCreatePermission.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.CreatePermission method")
+	}
+
+	if len(mmCreatePermission.expectations) > 0 {
+		mmCreatePermission.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.CreatePermission method")
+	}
+
+	mmCreatePermission.mock.funcCreatePermission = f
+	mmCreatePermission.mock.funcCreatePermissionOrigin = minimock.CallerInfo(1)
+	return mmCreatePermission.mock
+}
+
+// When sets expectation for the PermissionRepository.CreatePermission which will trigger the result defined by the following
+// Then helper
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) When(ctx context.Context, permission *model.Permission) *PermissionRepositoryMockCreatePermissionExpectation {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PermissionRepositoryMock.CreatePermission mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockCreatePermissionExpectation{
+		mock:               mmCreatePermission.mock,
+		params:             &PermissionRepositoryMockCreatePermissionParams{ctx, permission},
+		expectationOrigins: PermissionRepositoryMockCreatePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePermission.expectations = append(mmCreatePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.CreatePermission return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockCreatePermissionExpectation) Then(i1 int64, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockCreatePermissionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.CreatePermission should be invoked
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Times(n uint64) *mPermissionRepositoryMockCreatePermission {
+	if n == 0 {
+		mmCreatePermission.mock.t.Fatalf("Times of PermissionRepositoryMock.CreatePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePermission.expectedInvocations, n)
+	mmCreatePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePermission
+}
+
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) invocationsDone() bool {
+	if len(mmCreatePermission.expectations) == 0 && mmCreatePermission.defaultExpectation == nil && mmCreatePermission.mock.funcCreatePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePermission.mock.afterCreatePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePermission implements mm_repository.PermissionRepository
+func (mmCreatePermission *PermissionRepositoryMock) CreatePermission(ctx context.Context, permission *model.Permission) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreatePermission.beforeCreatePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePermission.afterCreatePermissionCounter, 1)
+
+	mmCreatePermission.t.Helper()
+
+	if mmCreatePermission.inspectFuncCreatePermission != nil {
+		mmCreatePermission.inspectFuncCreatePermission(ctx, permission)
+	}
+
+	mm_params := PermissionRepositoryMockCreatePermissionParams{ctx, permission}
+
+	// Record call args
+	mmCreatePermission.CreatePermissionMock.mutex.Lock()
+	mmCreatePermission.CreatePermissionMock.callArgs = append(mmCreatePermission.CreatePermissionMock.callArgs, &mm_params)
+	mmCreatePermission.CreatePermissionMock.mutex.Unlock()
+
+	for _, e := range mmCreatePermission.CreatePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreatePermission.CreatePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePermission.CreatePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePermission.CreatePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePermission.CreatePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockCreatePermissionParams{ctx, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePermission.t.Errorf("PermissionRepositoryMock.CreatePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCreatePermission.t.Errorf("PermissionRepositoryMock.CreatePermission got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePermission.t.Errorf("PermissionRepositoryMock.CreatePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePermission.CreatePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePermission.t.Fatal("No results are set for the PermissionRepositoryMock.CreatePermission")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreatePermission.funcCreatePermission != nil {
+		return mmCreatePermission.funcCreatePermission(ctx, permission)
+	}
+	mmCreatePermission.t.Fatalf("Unexpected call to PermissionRepositoryMock.CreatePermission. %v %v", ctx, permission)
+	return
+}
+
+// CreatePermissionAfterCounter returns a count of finished PermissionRepositoryMock.CreatePermission invocations
+func (mmCreatePermission *PermissionRepositoryMock) CreatePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePermission.afterCreatePermissionCounter)
+}
+
+// CreatePermissionBeforeCounter returns a count of PermissionRepositoryMock.CreatePermission invocations
+func (mmCreatePermission *PermissionRepositoryMock) CreatePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePermission.beforeCreatePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.CreatePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePermission *mPermissionRepositoryMockCreatePermission) Calls() []*PermissionRepositoryMockCreatePermissionParams {
+	mmCreatePermission.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockCreatePermissionParams, len(mmCreatePermission.callArgs))
+	copy(argCopy, mmCreatePermission.callArgs)
+
+	mmCreatePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePermissionDone returns true if the count of the CreatePermission invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockCreatePermissionDone() bool {
+	if m.CreatePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePermissionMock.invocationsDone()
+}
+
+// MinimockCreatePermissionInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockCreatePermissionInspect() {
+	for _, e := range m.CreatePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.CreatePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePermissionCounter := mm_atomic.LoadUint64(&m.afterCreatePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePermissionMock.defaultExpectation != nil && afterCreatePermissionCounter < 1 {
+		if m.CreatePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.CreatePermission at\n%s", m.CreatePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.CreatePermission at\n%s with params: %#v", m.CreatePermissionMock.defaultExpectation.expectationOrigins.origin, *m.CreatePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePermission != nil && afterCreatePermissionCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.CreatePermission at\n%s", m.funcCreatePermissionOrigin)
+	}
+
+	if !m.CreatePermissionMock.invocationsDone() && afterCreatePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.CreatePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePermissionMock.expectedInvocations), m.CreatePermissionMock.expectedInvocationsOrigin, afterCreatePermissionCounter)
+	}
+}
+
+type mPermissionRepositoryMockDeletePermission struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockDeletePermissionExpectation
+	expectations       []*PermissionRepositoryMockDeletePermissionExpectation
+
+	callArgs []*PermissionRepositoryMockDeletePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockDeletePermissionExpectation specifies expectation struct of the PermissionRepository.DeletePermission
+type PermissionRepositoryMockDeletePermissionExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockDeletePermissionParams
+	paramPtrs          *PermissionRepositoryMockDeletePermissionParamPtrs
+	expectationOrigins PermissionRepositoryMockDeletePermissionExpectationOrigins
+	results            *PermissionRepositoryMockDeletePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockDeletePermissionParams contains parameters of the PermissionRepository.DeletePermission
+type PermissionRepositoryMockDeletePermissionParams struct {
+	ctx  context.Context
+	name string
+}
+
+// PermissionRepositoryMockDeletePermissionParamPtrs contains pointers to parameters of the PermissionRepository.DeletePermission
+type PermissionRepositoryMockDeletePermissionParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// PermissionRepositoryMockDeletePermissionResults contains results of the PermissionRepository.DeletePermission
+type PermissionRepositoryMockDeletePermissionResults struct {
+	err error
+}
+
+// PermissionRepositoryMockDeletePermissionOrigins contains origins of expectations of the PermissionRepository.DeletePermission
+type PermissionRepositoryMockDeletePermissionExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Optional() *mPermissionRepositoryMockDeletePermission {
+	mmDeletePermission.optional = true
+	return mmDeletePermission
+}
+
+// Expect sets up expected params for PermissionRepository.DeletePermission
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Expect(ctx context.Context, name string) *mPermissionRepositoryMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PermissionRepositoryMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePermission.defaultExpectation.params = &PermissionRepositoryMockDeletePermissionParams{ctx, name}
+	mmDeletePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePermission.expectations {
+		if minimock.Equal(e.params, mmDeletePermission.defaultExpectation.params) {
+			mmDeletePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.DeletePermission
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PermissionRepositoryMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePermission
+}
+
+// ExpectNameParam2 sets up expected param name for PermissionRepository.DeletePermission
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) ExpectNameParam2(name string) *mPermissionRepositoryMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PermissionRepositoryMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.name = &name
+	mmDeletePermission.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeletePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.DeletePermission
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Inspect(f func(ctx context.Context, name string)) *mPermissionRepositoryMockDeletePermission {
+	if mmDeletePermission.mock.inspectFuncDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.DeletePermission")
+	}
+
+	mmDeletePermission.mock.inspectFuncDeletePermission = f
+
+	return mmDeletePermission
+}
+
+// Return sets up results that will be returned by PermissionRepository.DeletePermission
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Return(err error) *PermissionRepositoryMock {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PermissionRepositoryMockDeletePermissionExpectation{mock: mmDeletePermission.mock}
+	}
+	mmDeletePermission.defaultExpectation.results = &PermissionRepositoryMockDeletePermissionResults{err}
+	mmDeletePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePermission.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.DeletePermission method
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Set(f func(ctx context.Context, name string) (err error)) *PermissionRepositoryMock {
+	if mmDeletePermission.defaultExpectation != nil {
+		mmDeletePermission.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.DeletePermission method")
+	}
+
+	if len(mmDeletePermission.expectations) > 0 {
+		mmDeletePermission.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.DeletePermission method")
+	}
+
+	mmDeletePermission.mock.funcDeletePermission = f
+	mmDeletePermission.mock.funcDeletePermissionOrigin = minimock.CallerInfo(1)
+	return mmDeletePermission.mock
+}
+
+// When sets expectation for the PermissionRepository.DeletePermission which will trigger the result defined by the following
+// Then helper
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) When(ctx context.Context, name string) *PermissionRepositoryMockDeletePermissionExpectation {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PermissionRepositoryMock.DeletePermission mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockDeletePermissionExpectation{
+		mock:               mmDeletePermission.mock,
+		params:             &PermissionRepositoryMockDeletePermissionParams{ctx, name},
+		expectationOrigins: PermissionRepositoryMockDeletePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePermission.expectations = append(mmDeletePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.DeletePermission return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockDeletePermissionExpectation) Then(err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockDeletePermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.DeletePermission should be invoked
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Times(n uint64) *mPermissionRepositoryMockDeletePermission {
+	if n == 0 {
+		mmDeletePermission.mock.t.Fatalf("Times of PermissionRepositoryMock.DeletePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePermission.expectedInvocations, n)
+	mmDeletePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePermission
+}
+
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) invocationsDone() bool {
+	if len(mmDeletePermission.expectations) == 0 && mmDeletePermission.defaultExpectation == nil && mmDeletePermission.mock.funcDeletePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePermission.mock.afterDeletePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePermission implements mm_repository.PermissionRepository
+func (mmDeletePermission *PermissionRepositoryMock) DeletePermission(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeletePermission.beforeDeletePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePermission.afterDeletePermissionCounter, 1)
+
+	mmDeletePermission.t.Helper()
+
+	if mmDeletePermission.inspectFuncDeletePermission != nil {
+		mmDeletePermission.inspectFuncDeletePermission(ctx, name)
+	}
+
+	mm_params := PermissionRepositoryMockDeletePermissionParams{ctx, name}
+
+	// Record call args
+	mmDeletePermission.DeletePermissionMock.mutex.Lock()
+	mmDeletePermission.DeletePermissionMock.callArgs = append(mmDeletePermission.DeletePermissionMock.callArgs, &mm_params)
+	mmDeletePermission.DeletePermissionMock.mutex.Unlock()
+
+	for _, e := range mmDeletePermission.DeletePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePermission.DeletePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePermission.DeletePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePermission.DeletePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePermission.DeletePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockDeletePermissionParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePermission.t.Errorf("PermissionRepositoryMock.DeletePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeletePermission.t.Errorf("PermissionRepositoryMock.DeletePermission got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePermission.t.Errorf("PermissionRepositoryMock.DeletePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePermission.DeletePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePermission.t.Fatal("No results are set for the PermissionRepositoryMock.DeletePermission")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePermission.funcDeletePermission != nil {
+		return mmDeletePermission.funcDeletePermission(ctx, name)
+	}
+	mmDeletePermission.t.Fatalf("Unexpected call to PermissionRepositoryMock.DeletePermission. %v %v", ctx, name)
+	return
+}
+
+// DeletePermissionAfterCounter returns a count of finished PermissionRepositoryMock.DeletePermission invocations
+func (mmDeletePermission *PermissionRepositoryMock) DeletePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePermission.afterDeletePermissionCounter)
+}
+
+// DeletePermissionBeforeCounter returns a count of PermissionRepositoryMock.DeletePermission invocations
+func (mmDeletePermission *PermissionRepositoryMock) DeletePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePermission.beforeDeletePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.DeletePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePermission *mPermissionRepositoryMockDeletePermission) Calls() []*PermissionRepositoryMockDeletePermissionParams {
+	mmDeletePermission.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockDeletePermissionParams, len(mmDeletePermission.callArgs))
+	copy(argCopy, mmDeletePermission.callArgs)
+
+	mmDeletePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePermissionDone returns true if the count of the DeletePermission invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockDeletePermissionDone() bool {
+	if m.DeletePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePermissionMock.invocationsDone()
+}
+
+// MinimockDeletePermissionInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockDeletePermissionInspect() {
+	for _, e := range m.DeletePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.DeletePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePermissionCounter := mm_atomic.LoadUint64(&m.afterDeletePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePermissionMock.defaultExpectation != nil && afterDeletePermissionCounter < 1 {
+		if m.DeletePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.DeletePermission at\n%s", m.DeletePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.DeletePermission at\n%s with params: %#v", m.DeletePermissionMock.defaultExpectation.expectationOrigins.origin, *m.DeletePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePermission != nil && afterDeletePermissionCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.DeletePermission at\n%s", m.funcDeletePermissionOrigin)
+	}
+
+	if !m.DeletePermissionMock.invocationsDone() && afterDeletePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.DeletePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePermissionMock.expectedInvocations), m.DeletePermissionMock.expectedInvocationsOrigin, afterDeletePermissionCounter)
+	}
+}
+
+type mPermissionRepositoryMockGrantPermission struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockGrantPermissionExpectation
+	expectations       []*PermissionRepositoryMockGrantPermissionExpectation
+
+	callArgs []*PermissionRepositoryMockGrantPermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockGrantPermissionExpectation specifies expectation struct of the PermissionRepository.GrantPermission
+type PermissionRepositoryMockGrantPermissionExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockGrantPermissionParams
+	paramPtrs          *PermissionRepositoryMockGrantPermissionParamPtrs
+	expectationOrigins PermissionRepositoryMockGrantPermissionExpectationOrigins
+	results            *PermissionRepositoryMockGrantPermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockGrantPermissionParams contains parameters of the PermissionRepository.GrantPermission
+type PermissionRepositoryMockGrantPermissionParams struct {
+	ctx        context.Context
+	role       string
+	permission string
+}
+
+// PermissionRepositoryMockGrantPermissionParamPtrs contains pointers to parameters of the PermissionRepository.GrantPermission
+type PermissionRepositoryMockGrantPermissionParamPtrs struct {
+	ctx        *context.Context
+	role       *string
+	permission *string
+}
+
+// PermissionRepositoryMockGrantPermissionResults contains results of the PermissionRepository.GrantPermission
+type PermissionRepositoryMockGrantPermissionResults struct {
+	err error
+}
+
+// PermissionRepositoryMockGrantPermissionOrigins contains origins of expectations of the PermissionRepository.GrantPermission
+type PermissionRepositoryMockGrantPermissionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originRole       string
+	originPermission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Optional() *mPermissionRepositoryMockGrantPermission {
+	mmGrantPermission.optional = true
+	return mmGrantPermission
+}
+
+// Expect sets up expected params for PermissionRepository.GrantPermission
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Expect(ctx context.Context, role string, permission string) *mPermissionRepositoryMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PermissionRepositoryMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by ExpectParams functions")
+	}
+
+	mmGrantPermission.defaultExpectation.params = &PermissionRepositoryMockGrantPermissionParams{ctx, role, permission}
+	mmGrantPermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGrantPermission.expectations {
+		if minimock.Equal(e.params, mmGrantPermission.defaultExpectation.params) {
+			mmGrantPermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGrantPermission.defaultExpectation.params)
+		}
+	}
+
+	return mmGrantPermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.GrantPermission
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PermissionRepositoryMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.params != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Expect")
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs == nil {
+		mmGrantPermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockGrantPermissionParamPtrs{}
+	}
+	mmGrantPermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGrantPermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGrantPermission
+}
+
+// ExpectRoleParam2 sets up expected param role for PermissionRepository.GrantPermission
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) ExpectRoleParam2(role string) *mPermissionRepositoryMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PermissionRepositoryMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.params != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Expect")
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs == nil {
+		mmGrantPermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockGrantPermissionParamPtrs{}
+	}
+	mmGrantPermission.defaultExpectation.paramPtrs.role = &role
+	mmGrantPermission.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmGrantPermission
+}
+
+// ExpectPermissionParam3 sets up expected param permission for PermissionRepository.GrantPermission
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) ExpectPermissionParam3(permission string) *mPermissionRepositoryMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PermissionRepositoryMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.params != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Expect")
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs == nil {
+		mmGrantPermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockGrantPermissionParamPtrs{}
+	}
+	mmGrantPermission.defaultExpectation.paramPtrs.permission = &permission
+	mmGrantPermission.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmGrantPermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.GrantPermission
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Inspect(f func(ctx context.Context, role string, permission string)) *mPermissionRepositoryMockGrantPermission {
+	if mmGrantPermission.mock.inspectFuncGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.GrantPermission")
+	}
+
+	mmGrantPermission.mock.inspectFuncGrantPermission = f
+
+	return mmGrantPermission
+}
+
+// Return sets up results that will be returned by PermissionRepository.GrantPermission
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Return(err error) *PermissionRepositoryMock {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PermissionRepositoryMockGrantPermissionExpectation{mock: mmGrantPermission.mock}
+	}
+	mmGrantPermission.defaultExpectation.results = &PermissionRepositoryMockGrantPermissionResults{err}
+	mmGrantPermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGrantPermission.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.GrantPermission method
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Set(f func(ctx context.Context, role string, permission string) (err error)) *PermissionRepositoryMock {
+	if mmGrantPermission.defaultExpectation != nil {
+		mmGrantPermission.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.GrantPermission method")
+	}
+
+	if len(mmGrantPermission.expectations) > 0 {
+		mmGrantPermission.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.GrantPermission method")
+	}
+
+	mmGrantPermission.mock.funcGrantPermission = f
+	mmGrantPermission.mock.funcGrantPermissionOrigin = minimock.CallerInfo(1)
+	return mmGrantPermission.mock
+}
+
+// When sets expectation for the PermissionRepository.GrantPermission which will trigger the result defined by the following
+// Then helper
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) When(ctx context.Context, role string, permission string) *PermissionRepositoryMockGrantPermissionExpectation {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PermissionRepositoryMock.GrantPermission mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockGrantPermissionExpectation{
+		mock:               mmGrantPermission.mock,
+		params:             &PermissionRepositoryMockGrantPermissionParams{ctx, role, permission},
+		expectationOrigins: PermissionRepositoryMockGrantPermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGrantPermission.expectations = append(mmGrantPermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.GrantPermission return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockGrantPermissionExpectation) Then(err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockGrantPermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.GrantPermission should be invoked
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Times(n uint64) *mPermissionRepositoryMockGrantPermission {
+	if n == 0 {
+		mmGrantPermission.mock.t.Fatalf("Times of PermissionRepositoryMock.GrantPermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGrantPermission.expectedInvocations, n)
+	mmGrantPermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGrantPermission
+}
+
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) invocationsDone() bool {
+	if len(mmGrantPermission.expectations) == 0 && mmGrantPermission.defaultExpectation == nil && mmGrantPermission.mock.funcGrantPermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGrantPermission.mock.afterGrantPermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGrantPermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GrantPermission implements mm_repository.PermissionRepository
+func (mmGrantPermission *PermissionRepositoryMock) GrantPermission(ctx context.Context, role string, permission string) (err error) {
+	mm_atomic.AddUint64(&mmGrantPermission.beforeGrantPermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGrantPermission.afterGrantPermissionCounter, 1)
+
+	mmGrantPermission.t.Helper()
+
+	if mmGrantPermission.inspectFuncGrantPermission != nil {
+		mmGrantPermission.inspectFuncGrantPermission(ctx, role, permission)
+	}
+
+	mm_params := PermissionRepositoryMockGrantPermissionParams{ctx, role, permission}
+
+	// Record call args
+	mmGrantPermission.GrantPermissionMock.mutex.Lock()
+	mmGrantPermission.GrantPermissionMock.callArgs = append(mmGrantPermission.GrantPermissionMock.callArgs, &mm_params)
+	mmGrantPermission.GrantPermissionMock.mutex.Unlock()
+
+	for _, e := range mmGrantPermission.GrantPermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmGrantPermission.GrantPermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGrantPermission.GrantPermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGrantPermission.GrantPermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmGrantPermission.GrantPermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockGrantPermissionParams{ctx, role, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGrantPermission.t.Errorf("PermissionRepositoryMock.GrantPermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmGrantPermission.t.Errorf("PermissionRepositoryMock.GrantPermission got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmGrantPermission.t.Errorf("PermissionRepositoryMock.GrantPermission got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGrantPermission.t.Errorf("PermissionRepositoryMock.GrantPermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGrantPermission.GrantPermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGrantPermission.t.Fatal("No results are set for the PermissionRepositoryMock.GrantPermission")
+		}
+		return (*mm_results).err
+	}
+	if mmGrantPermission.funcGrantPermission != nil {
+		return mmGrantPermission.funcGrantPermission(ctx, role, permission)
+	}
+	mmGrantPermission.t.Fatalf("Unexpected call to PermissionRepositoryMock.GrantPermission. %v %v %v", ctx, role, permission)
+	return
+}
+
+// GrantPermissionAfterCounter returns a count of finished PermissionRepositoryMock.GrantPermission invocations
+func (mmGrantPermission *PermissionRepositoryMock) GrantPermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrantPermission.afterGrantPermissionCounter)
+}
+
+// GrantPermissionBeforeCounter returns a count of PermissionRepositoryMock.GrantPermission invocations
+func (mmGrantPermission *PermissionRepositoryMock) GrantPermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrantPermission.beforeGrantPermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.GrantPermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGrantPermission *mPermissionRepositoryMockGrantPermission) Calls() []*PermissionRepositoryMockGrantPermissionParams {
+	mmGrantPermission.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockGrantPermissionParams, len(mmGrantPermission.callArgs))
+	copy(argCopy, mmGrantPermission.callArgs)
+
+	mmGrantPermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGrantPermissionDone returns true if the count of the GrantPermission invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockGrantPermissionDone() bool {
+	if m.GrantPermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GrantPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GrantPermissionMock.invocationsDone()
+}
+
+// MinimockGrantPermissionInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockGrantPermissionInspect() {
+	for _, e := range m.GrantPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.GrantPermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGrantPermissionCounter := mm_atomic.LoadUint64(&m.afterGrantPermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GrantPermissionMock.defaultExpectation != nil && afterGrantPermissionCounter < 1 {
+		if m.GrantPermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.GrantPermission at\n%s", m.GrantPermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.GrantPermission at\n%s with params: %#v", m.GrantPermissionMock.defaultExpectation.expectationOrigins.origin, *m.GrantPermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGrantPermission != nil && afterGrantPermissionCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.GrantPermission at\n%s", m.funcGrantPermissionOrigin)
+	}
+
+	if !m.GrantPermissionMock.invocationsDone() && afterGrantPermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.GrantPermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GrantPermissionMock.expectedInvocations), m.GrantPermissionMock.expectedInvocationsOrigin, afterGrantPermissionCounter)
+	}
+}
+
+type mPermissionRepositoryMockListEffectivePermissions struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockListEffectivePermissionsExpectation
+	expectations       []*PermissionRepositoryMockListEffectivePermissionsExpectation
+
+	callArgs []*PermissionRepositoryMockListEffectivePermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockListEffectivePermissionsExpectation specifies expectation struct of the PermissionRepository.ListEffectivePermissions
+type PermissionRepositoryMockListEffectivePermissionsExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockListEffectivePermissionsParams
+	paramPtrs          *PermissionRepositoryMockListEffectivePermissionsParamPtrs
+	expectationOrigins PermissionRepositoryMockListEffectivePermissionsExpectationOrigins
+	results            *PermissionRepositoryMockListEffectivePermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockListEffectivePermissionsParams contains parameters of the PermissionRepository.ListEffectivePermissions
+type PermissionRepositoryMockListEffectivePermissionsParams struct {
+	ctx  context.Context
+	role string
+}
+
+// PermissionRepositoryMockListEffectivePermissionsParamPtrs contains pointers to parameters of the PermissionRepository.ListEffectivePermissions
+type PermissionRepositoryMockListEffectivePermissionsParamPtrs struct {
+	ctx  *context.Context
+	role *string
+}
+
+// PermissionRepositoryMockListEffectivePermissionsResults contains results of the PermissionRepository.ListEffectivePermissions
+type PermissionRepositoryMockListEffectivePermissionsResults struct {
+	sa1 []string
+	err error
+}
+
+// PermissionRepositoryMockListEffectivePermissionsOrigins contains origins of expectations of the PermissionRepository.ListEffectivePermissions
+type PermissionRepositoryMockListEffectivePermissionsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Optional() *mPermissionRepositoryMockListEffectivePermissions {
+	mmListEffectivePermissions.optional = true
+	return mmListEffectivePermissions
+}
+
+// Expect sets up expected params for PermissionRepository.ListEffectivePermissions
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Expect(ctx context.Context, role string) *mPermissionRepositoryMockListEffectivePermissions {
+	if mmListEffectivePermissions.mock.funcListEffectivePermissions != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Set")
+	}
+
+	if mmListEffectivePermissions.defaultExpectation == nil {
+		mmListEffectivePermissions.defaultExpectation = &PermissionRepositoryMockListEffectivePermissionsExpectation{}
+	}
+
+	if mmListEffectivePermissions.defaultExpectation.paramPtrs != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by ExpectParams functions")
+	}
+
+	mmListEffectivePermissions.defaultExpectation.params = &PermissionRepositoryMockListEffectivePermissionsParams{ctx, role}
+	mmListEffectivePermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListEffectivePermissions.expectations {
+		if minimock.Equal(e.params, mmListEffectivePermissions.defaultExpectation.params) {
+			mmListEffectivePermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListEffectivePermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmListEffectivePermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.ListEffectivePermissions
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockListEffectivePermissions {
+	if mmListEffectivePermissions.mock.funcListEffectivePermissions != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Set")
+	}
+
+	if mmListEffectivePermissions.defaultExpectation == nil {
+		mmListEffectivePermissions.defaultExpectation = &PermissionRepositoryMockListEffectivePermissionsExpectation{}
+	}
+
+	if mmListEffectivePermissions.defaultExpectation.params != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Expect")
+	}
+
+	if mmListEffectivePermissions.defaultExpectation.paramPtrs == nil {
+		mmListEffectivePermissions.defaultExpectation.paramPtrs = &PermissionRepositoryMockListEffectivePermissionsParamPtrs{}
+	}
+	mmListEffectivePermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListEffectivePermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListEffectivePermissions
+}
+
+// ExpectRoleParam2 sets up expected param role for PermissionRepository.ListEffectivePermissions
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) ExpectRoleParam2(role string) *mPermissionRepositoryMockListEffectivePermissions {
+	if mmListEffectivePermissions.mock.funcListEffectivePermissions != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Set")
+	}
+
+	if mmListEffectivePermissions.defaultExpectation == nil {
+		mmListEffectivePermissions.defaultExpectation = &PermissionRepositoryMockListEffectivePermissionsExpectation{}
+	}
+
+	if mmListEffectivePermissions.defaultExpectation.params != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Expect")
+	}
+
+	if mmListEffectivePermissions.defaultExpectation.paramPtrs == nil {
+		mmListEffectivePermissions.defaultExpectation.paramPtrs = &PermissionRepositoryMockListEffectivePermissionsParamPtrs{}
+	}
+	mmListEffectivePermissions.defaultExpectation.paramPtrs.role = &role
+	mmListEffectivePermissions.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmListEffectivePermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.ListEffectivePermissions
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Inspect(f func(ctx context.Context, role string)) *mPermissionRepositoryMockListEffectivePermissions {
+	if mmListEffectivePermissions.mock.inspectFuncListEffectivePermissions != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.ListEffectivePermissions")
+	}
+
+	mmListEffectivePermissions.mock.inspectFuncListEffectivePermissions = f
+
+	return mmListEffectivePermissions
+}
+
+// Return sets up results that will be returned by PermissionRepository.ListEffectivePermissions
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Return(sa1 []string, err error) *PermissionRepositoryMock {
+	if mmListEffectivePermissions.mock.funcListEffectivePermissions != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Set")
+	}
+
+	if mmListEffectivePermissions.defaultExpectation == nil {
+		mmListEffectivePermissions.defaultExpectation = &PermissionRepositoryMockListEffectivePermissionsExpectation{mock: mmListEffectivePermissions.mock}
+	}
+	mmListEffectivePermissions.defaultExpectation.results = &PermissionRepositoryMockListEffectivePermissionsResults{sa1, err}
+	mmListEffectivePermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListEffectivePermissions.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.ListEffectivePermissions method
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Set(f func(ctx context.Context, role string) (sa1 []string, err error)) *PermissionRepositoryMock {
+	if mmListEffectivePermissions.defaultExpectation != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.ListEffectivePermissions method")
+	}
+
+	if len(mmListEffectivePermissions.expectations) > 0 {
+		mmListEffectivePermissions.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.ListEffectivePermissions method")
+	}
+
+	mmListEffectivePermissions.mock.funcListEffectivePermissions = f
+	mmListEffectivePermissions.mock.funcListEffectivePermissionsOrigin = minimock.CallerInfo(1)
+	return mmListEffectivePermissions.mock
+}
+
+// When sets expectation for the PermissionRepository.ListEffectivePermissions which will trigger the result defined by the following
+// Then helper
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) When(ctx context.Context, role string) *PermissionRepositoryMockListEffectivePermissionsExpectation {
+	if mmListEffectivePermissions.mock.funcListEffectivePermissions != nil {
+		mmListEffectivePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListEffectivePermissions mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockListEffectivePermissionsExpectation{
+		mock:               mmListEffectivePermissions.mock,
+		params:             &PermissionRepositoryMockListEffectivePermissionsParams{ctx, role},
+		expectationOrigins: PermissionRepositoryMockListEffectivePermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListEffectivePermissions.expectations = append(mmListEffectivePermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.ListEffectivePermissions return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockListEffectivePermissionsExpectation) Then(sa1 []string, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockListEffectivePermissionsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.ListEffectivePermissions should be invoked
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Times(n uint64) *mPermissionRepositoryMockListEffectivePermissions {
+	if n == 0 {
+		mmListEffectivePermissions.mock.t.Fatalf("Times of PermissionRepositoryMock.ListEffectivePermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListEffectivePermissions.expectedInvocations, n)
+	mmListEffectivePermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListEffectivePermissions
+}
+
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) invocationsDone() bool {
+	if len(mmListEffectivePermissions.expectations) == 0 && mmListEffectivePermissions.defaultExpectation == nil && mmListEffectivePermissions.mock.funcListEffectivePermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListEffectivePermissions.mock.afterListEffectivePermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListEffectivePermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListEffectivePermissions implements mm_repository.PermissionRepository
+func (mmListEffectivePermissions *PermissionRepositoryMock) ListEffectivePermissions(ctx context.Context, role string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListEffectivePermissions.beforeListEffectivePermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListEffectivePermissions.afterListEffectivePermissionsCounter, 1)
+
+	mmListEffectivePermissions.t.Helper()
+
+	if mmListEffectivePermissions.inspectFuncListEffectivePermissions != nil {
+		mmListEffectivePermissions.inspectFuncListEffectivePermissions(ctx, role)
+	}
+
+	mm_params := PermissionRepositoryMockListEffectivePermissionsParams{ctx, role}
+
+	// Record call args
+	mmListEffectivePermissions.ListEffectivePermissionsMock.mutex.Lock()
+	mmListEffectivePermissions.ListEffectivePermissionsMock.callArgs = append(mmListEffectivePermissions.ListEffectivePermissionsMock.callArgs, &mm_params)
+	mmListEffectivePermissions.ListEffectivePermissionsMock.mutex.Unlock()
+
+	for _, e := range mmListEffectivePermissions.ListEffectivePermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockListEffectivePermissionsParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListEffectivePermissions.t.Errorf("PermissionRepositoryMock.ListEffectivePermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmListEffectivePermissions.t.Errorf("PermissionRepositoryMock.ListEffectivePermissions got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListEffectivePermissions.t.Errorf("PermissionRepositoryMock.ListEffectivePermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListEffectivePermissions.ListEffectivePermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListEffectivePermissions.t.Fatal("No results are set for the PermissionRepositoryMock.ListEffectivePermissions")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListEffectivePermissions.funcListEffectivePermissions != nil {
+		return mmListEffectivePermissions.funcListEffectivePermissions(ctx, role)
+	}
+	mmListEffectivePermissions.t.Fatalf("Unexpected call to PermissionRepositoryMock.ListEffectivePermissions. %v %v", ctx, role)
+	return
+}
+
+// ListEffectivePermissionsAfterCounter returns a count of finished PermissionRepositoryMock.ListEffectivePermissions invocations
+func (mmListEffectivePermissions *PermissionRepositoryMock) ListEffectivePermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEffectivePermissions.afterListEffectivePermissionsCounter)
+}
+
+// ListEffectivePermissionsBeforeCounter returns a count of PermissionRepositoryMock.ListEffectivePermissions invocations
+func (mmListEffectivePermissions *PermissionRepositoryMock) ListEffectivePermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEffectivePermissions.beforeListEffectivePermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.ListEffectivePermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListEffectivePermissions *mPermissionRepositoryMockListEffectivePermissions) Calls() []*PermissionRepositoryMockListEffectivePermissionsParams {
+	mmListEffectivePermissions.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockListEffectivePermissionsParams, len(mmListEffectivePermissions.callArgs))
+	copy(argCopy, mmListEffectivePermissions.callArgs)
+
+	mmListEffectivePermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListEffectivePermissionsDone returns true if the count of the ListEffectivePermissions invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockListEffectivePermissionsDone() bool {
+	if m.ListEffectivePermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListEffectivePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListEffectivePermissionsMock.invocationsDone()
+}
+
+// MinimockListEffectivePermissionsInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockListEffectivePermissionsInspect() {
+	for _, e := range m.ListEffectivePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListEffectivePermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListEffectivePermissionsCounter := mm_atomic.LoadUint64(&m.afterListEffectivePermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListEffectivePermissionsMock.defaultExpectation != nil && afterListEffectivePermissionsCounter < 1 {
+		if m.ListEffectivePermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListEffectivePermissions at\n%s", m.ListEffectivePermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListEffectivePermissions at\n%s with params: %#v", m.ListEffectivePermissionsMock.defaultExpectation.expectationOrigins.origin, *m.ListEffectivePermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListEffectivePermissions != nil && afterListEffectivePermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.ListEffectivePermissions at\n%s", m.funcListEffectivePermissionsOrigin)
+	}
+
+	if !m.ListEffectivePermissionsMock.invocationsDone() && afterListEffectivePermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.ListEffectivePermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListEffectivePermissionsMock.expectedInvocations), m.ListEffectivePermissionsMock.expectedInvocationsOrigin, afterListEffectivePermissionsCounter)
+	}
+}
+
+type mPermissionRepositoryMockListPermissions struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockListPermissionsExpectation
+	expectations       []*PermissionRepositoryMockListPermissionsExpectation
+
+	callArgs []*PermissionRepositoryMockListPermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockListPermissionsExpectation specifies expectation struct of the PermissionRepository.ListPermissions
+type PermissionRepositoryMockListPermissionsExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockListPermissionsParams
+	paramPtrs          *PermissionRepositoryMockListPermissionsParamPtrs
+	expectationOrigins PermissionRepositoryMockListPermissionsExpectationOrigins
+	results            *PermissionRepositoryMockListPermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockListPermissionsParams contains parameters of the PermissionRepository.ListPermissions
+type PermissionRepositoryMockListPermissionsParams struct {
+	ctx context.Context
+}
+
+// PermissionRepositoryMockListPermissionsParamPtrs contains pointers to parameters of the PermissionRepository.ListPermissions
+type PermissionRepositoryMockListPermissionsParamPtrs struct {
+	ctx *context.Context
+}
+
+// PermissionRepositoryMockListPermissionsResults contains results of the PermissionRepository.ListPermissions
+type PermissionRepositoryMockListPermissionsResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// PermissionRepositoryMockListPermissionsOrigins contains origins of expectations of the PermissionRepository.ListPermissions
+type PermissionRepositoryMockListPermissionsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Optional() *mPermissionRepositoryMockListPermissions {
+	mmListPermissions.optional = true
+	return mmListPermissions
+}
+
+// Expect sets up expected params for PermissionRepository.ListPermissions
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Expect(ctx context.Context) *mPermissionRepositoryMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PermissionRepositoryMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PermissionRepositoryMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs != nil {
+		mmListPermissions.mock.t.Fatalf("PermissionRepositoryMock.ListPermissions mock is already set by ExpectParams functions")
+	}
+
+	mmListPermissions.defaultExpectation.params = &PermissionRepositoryMockListPermissionsParams{ctx}
+	mmListPermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPermissions.expectations {
+		if minimock.Equal(e.params, mmListPermissions.defaultExpectation.params) {
+			mmListPermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmListPermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.ListPermissions
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PermissionRepositoryMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PermissionRepositoryMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.params != nil {
+		mmListPermissions.mock.t.Fatalf("PermissionRepositoryMock.ListPermissions mock is already set by Expect")
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs == nil {
+		mmListPermissions.defaultExpectation.paramPtrs = &PermissionRepositoryMockListPermissionsParamPtrs{}
+	}
+	mmListPermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.ListPermissions
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Inspect(f func(ctx context.Context)) *mPermissionRepositoryMockListPermissions {
+	if mmListPermissions.mock.inspectFuncListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.ListPermissions")
+	}
+
+	mmListPermissions.mock.inspectFuncListPermissions = f
+
+	return mmListPermissions
+}
+
+// Return sets up results that will be returned by PermissionRepository.ListPermissions
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Return(ppa1 []*model.Permission, err error) *PermissionRepositoryMock {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PermissionRepositoryMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PermissionRepositoryMockListPermissionsExpectation{mock: mmListPermissions.mock}
+	}
+	mmListPermissions.defaultExpectation.results = &PermissionRepositoryMockListPermissionsResults{ppa1, err}
+	mmListPermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPermissions.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.ListPermissions method
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Set(f func(ctx context.Context) (ppa1 []*model.Permission, err error)) *PermissionRepositoryMock {
+	if mmListPermissions.defaultExpectation != nil {
+		mmListPermissions.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.ListPermissions method")
+	}
+
+	if len(mmListPermissions.expectations) > 0 {
+		mmListPermissions.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.ListPermissions method")
+	}
+
+	mmListPermissions.mock.funcListPermissions = f
+	mmListPermissions.mock.funcListPermissionsOrigin = minimock.CallerInfo(1)
+	return mmListPermissions.mock
+}
+
+// When sets expectation for the PermissionRepository.ListPermissions which will trigger the result defined by the following
+// Then helper
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) When(ctx context.Context) *PermissionRepositoryMockListPermissionsExpectation {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PermissionRepositoryMock.ListPermissions mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockListPermissionsExpectation{
+		mock:               mmListPermissions.mock,
+		params:             &PermissionRepositoryMockListPermissionsParams{ctx},
+		expectationOrigins: PermissionRepositoryMockListPermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPermissions.expectations = append(mmListPermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.ListPermissions return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockListPermissionsExpectation) Then(ppa1 []*model.Permission, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockListPermissionsResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.ListPermissions should be invoked
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Times(n uint64) *mPermissionRepositoryMockListPermissions {
+	if n == 0 {
+		mmListPermissions.mock.t.Fatalf("Times of PermissionRepositoryMock.ListPermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPermissions.expectedInvocations, n)
+	mmListPermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPermissions
+}
+
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) invocationsDone() bool {
+	if len(mmListPermissions.expectations) == 0 && mmListPermissions.defaultExpectation == nil && mmListPermissions.mock.funcListPermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPermissions.mock.afterListPermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPermissions implements mm_repository.PermissionRepository
+func (mmListPermissions *PermissionRepositoryMock) ListPermissions(ctx context.Context) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmListPermissions.beforeListPermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPermissions.afterListPermissionsCounter, 1)
+
+	mmListPermissions.t.Helper()
+
+	if mmListPermissions.inspectFuncListPermissions != nil {
+		mmListPermissions.inspectFuncListPermissions(ctx)
+	}
+
+	mm_params := PermissionRepositoryMockListPermissionsParams{ctx}
+
+	// Record call args
+	mmListPermissions.ListPermissionsMock.mutex.Lock()
+	mmListPermissions.ListPermissionsMock.callArgs = append(mmListPermissions.ListPermissionsMock.callArgs, &mm_params)
+	mmListPermissions.ListPermissionsMock.mutex.Unlock()
+
+	for _, e := range mmListPermissions.ListPermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPermissions.ListPermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPermissions.ListPermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPermissions.ListPermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPermissions.ListPermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockListPermissionsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPermissions.t.Errorf("PermissionRepositoryMock.ListPermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPermissions.ListPermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPermissions.t.Errorf("PermissionRepositoryMock.ListPermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPermissions.ListPermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPermissions.ListPermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPermissions.t.Fatal("No results are set for the PermissionRepositoryMock.ListPermissions")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPermissions.funcListPermissions != nil {
+		return mmListPermissions.funcListPermissions(ctx)
+	}
+	mmListPermissions.t.Fatalf("Unexpected call to PermissionRepositoryMock.ListPermissions. %v", ctx)
+	return
+}
+
+// ListPermissionsAfterCounter returns a count of finished PermissionRepositoryMock.ListPermissions invocations
+func (mmListPermissions *PermissionRepositoryMock) ListPermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPermissions.afterListPermissionsCounter)
+}
+
+// ListPermissionsBeforeCounter returns a count of PermissionRepositoryMock.ListPermissions invocations
+func (mmListPermissions *PermissionRepositoryMock) ListPermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPermissions.beforeListPermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.ListPermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPermissions *mPermissionRepositoryMockListPermissions) Calls() []*PermissionRepositoryMockListPermissionsParams {
+	mmListPermissions.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockListPermissionsParams, len(mmListPermissions.callArgs))
+	copy(argCopy, mmListPermissions.callArgs)
+
+	mmListPermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPermissionsDone returns true if the count of the ListPermissions invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockListPermissionsDone() bool {
+	if m.ListPermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPermissionsMock.invocationsDone()
+}
+
+// MinimockListPermissionsInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockListPermissionsInspect() {
+	for _, e := range m.ListPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListPermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPermissionsCounter := mm_atomic.LoadUint64(&m.afterListPermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPermissionsMock.defaultExpectation != nil && afterListPermissionsCounter < 1 {
+		if m.ListPermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListPermissions at\n%s", m.ListPermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListPermissions at\n%s with params: %#v", m.ListPermissionsMock.defaultExpectation.expectationOrigins.origin, *m.ListPermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPermissions != nil && afterListPermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.ListPermissions at\n%s", m.funcListPermissionsOrigin)
+	}
+
+	if !m.ListPermissionsMock.invocationsDone() && afterListPermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.ListPermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPermissionsMock.expectedInvocations), m.ListPermissionsMock.expectedInvocationsOrigin, afterListPermissionsCounter)
+	}
+}
+
+type mPermissionRepositoryMockListRolePermissions struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockListRolePermissionsExpectation
+	expectations       []*PermissionRepositoryMockListRolePermissionsExpectation
+
+	callArgs []*PermissionRepositoryMockListRolePermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockListRolePermissionsExpectation specifies expectation struct of the PermissionRepository.ListRolePermissions
+type PermissionRepositoryMockListRolePermissionsExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockListRolePermissionsParams
+	paramPtrs          *PermissionRepositoryMockListRolePermissionsParamPtrs
+	expectationOrigins PermissionRepositoryMockListRolePermissionsExpectationOrigins
+	results            *PermissionRepositoryMockListRolePermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockListRolePermissionsParams contains parameters of the PermissionRepository.ListRolePermissions
+type PermissionRepositoryMockListRolePermissionsParams struct {
+	ctx  context.Context
+	role string
+}
+
+// PermissionRepositoryMockListRolePermissionsParamPtrs contains pointers to parameters of the PermissionRepository.ListRolePermissions
+type PermissionRepositoryMockListRolePermissionsParamPtrs struct {
+	ctx  *context.Context
+	role *string
+}
+
+// PermissionRepositoryMockListRolePermissionsResults contains results of the PermissionRepository.ListRolePermissions
+type PermissionRepositoryMockListRolePermissionsResults struct {
+	sa1 []string
+	err error
+}
+
+// PermissionRepositoryMockListRolePermissionsOrigins contains origins of expectations of the PermissionRepository.ListRolePermissions
+type PermissionRepositoryMockListRolePermissionsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Optional() *mPermissionRepositoryMockListRolePermissions {
+	mmListRolePermissions.optional = true
+	return mmListRolePermissions
+}
+
+// Expect sets up expected params for PermissionRepository.ListRolePermissions
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Expect(ctx context.Context, role string) *mPermissionRepositoryMockListRolePermissions {
+	if mmListRolePermissions.mock.funcListRolePermissions != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Set")
+	}
+
+	if mmListRolePermissions.defaultExpectation == nil {
+		mmListRolePermissions.defaultExpectation = &PermissionRepositoryMockListRolePermissionsExpectation{}
+	}
+
+	if mmListRolePermissions.defaultExpectation.paramPtrs != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by ExpectParams functions")
+	}
+
+	mmListRolePermissions.defaultExpectation.params = &PermissionRepositoryMockListRolePermissionsParams{ctx, role}
+	mmListRolePermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRolePermissions.expectations {
+		if minimock.Equal(e.params, mmListRolePermissions.defaultExpectation.params) {
+			mmListRolePermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRolePermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmListRolePermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.ListRolePermissions
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockListRolePermissions {
+	if mmListRolePermissions.mock.funcListRolePermissions != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Set")
+	}
+
+	if mmListRolePermissions.defaultExpectation == nil {
+		mmListRolePermissions.defaultExpectation = &PermissionRepositoryMockListRolePermissionsExpectation{}
+	}
+
+	if mmListRolePermissions.defaultExpectation.params != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Expect")
+	}
+
+	if mmListRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmListRolePermissions.defaultExpectation.paramPtrs = &PermissionRepositoryMockListRolePermissionsParamPtrs{}
+	}
+	mmListRolePermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRolePermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRolePermissions
+}
+
+// ExpectRoleParam2 sets up expected param role for PermissionRepository.ListRolePermissions
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) ExpectRoleParam2(role string) *mPermissionRepositoryMockListRolePermissions {
+	if mmListRolePermissions.mock.funcListRolePermissions != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Set")
+	}
+
+	if mmListRolePermissions.defaultExpectation == nil {
+		mmListRolePermissions.defaultExpectation = &PermissionRepositoryMockListRolePermissionsExpectation{}
+	}
+
+	if mmListRolePermissions.defaultExpectation.params != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Expect")
+	}
+
+	if mmListRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmListRolePermissions.defaultExpectation.paramPtrs = &PermissionRepositoryMockListRolePermissionsParamPtrs{}
+	}
+	mmListRolePermissions.defaultExpectation.paramPtrs.role = &role
+	mmListRolePermissions.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmListRolePermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.ListRolePermissions
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Inspect(f func(ctx context.Context, role string)) *mPermissionRepositoryMockListRolePermissions {
+	if mmListRolePermissions.mock.inspectFuncListRolePermissions != nil {
+		mmListRolePermissions.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.ListRolePermissions")
+	}
+
+	mmListRolePermissions.mock.inspectFuncListRolePermissions = f
+
+	return mmListRolePermissions
+}
+
+// Return sets up results that will be returned by PermissionRepository.ListRolePermissions
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Return(sa1 []string, err error) *PermissionRepositoryMock {
+	if mmListRolePermissions.mock.funcListRolePermissions != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Set")
+	}
+
+	if mmListRolePermissions.defaultExpectation == nil {
+		mmListRolePermissions.defaultExpectation = &PermissionRepositoryMockListRolePermissionsExpectation{mock: mmListRolePermissions.mock}
+	}
+	mmListRolePermissions.defaultExpectation.results = &PermissionRepositoryMockListRolePermissionsResults{sa1, err}
+	mmListRolePermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRolePermissions.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.ListRolePermissions method
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Set(f func(ctx context.Context, role string) (sa1 []string, err error)) *PermissionRepositoryMock {
+	if mmListRolePermissions.defaultExpectation != nil {
+		mmListRolePermissions.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.ListRolePermissions method")
+	}
+
+	if len(mmListRolePermissions.expectations) > 0 {
+		mmListRolePermissions.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.ListRolePermissions method")
+	}
+
+	mmListRolePermissions.mock.funcListRolePermissions = f
+	mmListRolePermissions.mock.funcListRolePermissionsOrigin = minimock.CallerInfo(1)
+	return mmListRolePermissions.mock
+}
+
+// When sets expectation for the PermissionRepository.ListRolePermissions which will trigger the result defined by the following
+// Then helper
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) When(ctx context.Context, role string) *PermissionRepositoryMockListRolePermissionsExpectation {
+	if mmListRolePermissions.mock.funcListRolePermissions != nil {
+		mmListRolePermissions.mock.t.Fatalf("PermissionRepositoryMock.ListRolePermissions mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockListRolePermissionsExpectation{
+		mock:               mmListRolePermissions.mock,
+		params:             &PermissionRepositoryMockListRolePermissionsParams{ctx, role},
+		expectationOrigins: PermissionRepositoryMockListRolePermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRolePermissions.expectations = append(mmListRolePermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.ListRolePermissions return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockListRolePermissionsExpectation) Then(sa1 []string, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockListRolePermissionsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.ListRolePermissions should be invoked
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Times(n uint64) *mPermissionRepositoryMockListRolePermissions {
+	if n == 0 {
+		mmListRolePermissions.mock.t.Fatalf("Times of PermissionRepositoryMock.ListRolePermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRolePermissions.expectedInvocations, n)
+	mmListRolePermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRolePermissions
+}
+
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) invocationsDone() bool {
+	if len(mmListRolePermissions.expectations) == 0 && mmListRolePermissions.defaultExpectation == nil && mmListRolePermissions.mock.funcListRolePermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRolePermissions.mock.afterListRolePermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRolePermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRolePermissions implements mm_repository.PermissionRepository
+func (mmListRolePermissions *PermissionRepositoryMock) ListRolePermissions(ctx context.Context, role string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListRolePermissions.beforeListRolePermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRolePermissions.afterListRolePermissionsCounter, 1)
+
+	mmListRolePermissions.t.Helper()
+
+	if mmListRolePermissions.inspectFuncListRolePermissions != nil {
+		mmListRolePermissions.inspectFuncListRolePermissions(ctx, role)
+	}
+
+	mm_params := PermissionRepositoryMockListRolePermissionsParams{ctx, role}
+
+	// Record call args
+	mmListRolePermissions.ListRolePermissionsMock.mutex.Lock()
+	mmListRolePermissions.ListRolePermissionsMock.callArgs = append(mmListRolePermissions.ListRolePermissionsMock.callArgs, &mm_params)
+	mmListRolePermissions.ListRolePermissionsMock.mutex.Unlock()
+
+	for _, e := range mmListRolePermissions.ListRolePermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListRolePermissions.ListRolePermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockListRolePermissionsParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRolePermissions.t.Errorf("PermissionRepositoryMock.ListRolePermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmListRolePermissions.t.Errorf("PermissionRepositoryMock.ListRolePermissions got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRolePermissions.t.Errorf("PermissionRepositoryMock.ListRolePermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRolePermissions.ListRolePermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRolePermissions.t.Fatal("No results are set for the PermissionRepositoryMock.ListRolePermissions")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListRolePermissions.funcListRolePermissions != nil {
+		return mmListRolePermissions.funcListRolePermissions(ctx, role)
+	}
+	mmListRolePermissions.t.Fatalf("Unexpected call to PermissionRepositoryMock.ListRolePermissions. %v %v", ctx, role)
+	return
+}
+
+// ListRolePermissionsAfterCounter returns a count of finished PermissionRepositoryMock.ListRolePermissions invocations
+func (mmListRolePermissions *PermissionRepositoryMock) ListRolePermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRolePermissions.afterListRolePermissionsCounter)
+}
+
+// ListRolePermissionsBeforeCounter returns a count of PermissionRepositoryMock.ListRolePermissions invocations
+func (mmListRolePermissions *PermissionRepositoryMock) ListRolePermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRolePermissions.beforeListRolePermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.ListRolePermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRolePermissions *mPermissionRepositoryMockListRolePermissions) Calls() []*PermissionRepositoryMockListRolePermissionsParams {
+	mmListRolePermissions.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockListRolePermissionsParams, len(mmListRolePermissions.callArgs))
+	copy(argCopy, mmListRolePermissions.callArgs)
+
+	mmListRolePermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRolePermissionsDone returns true if the count of the ListRolePermissions invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockListRolePermissionsDone() bool {
+	if m.ListRolePermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRolePermissionsMock.invocationsDone()
+}
+
+// MinimockListRolePermissionsInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockListRolePermissionsInspect() {
+	for _, e := range m.ListRolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListRolePermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRolePermissionsCounter := mm_atomic.LoadUint64(&m.afterListRolePermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRolePermissionsMock.defaultExpectation != nil && afterListRolePermissionsCounter < 1 {
+		if m.ListRolePermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListRolePermissions at\n%s", m.ListRolePermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListRolePermissions at\n%s with params: %#v", m.ListRolePermissionsMock.defaultExpectation.expectationOrigins.origin, *m.ListRolePermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRolePermissions != nil && afterListRolePermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.ListRolePermissions at\n%s", m.funcListRolePermissionsOrigin)
+	}
+
+	if !m.ListRolePermissionsMock.invocationsDone() && afterListRolePermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.ListRolePermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRolePermissionsMock.expectedInvocations), m.ListRolePermissionsMock.expectedInvocationsOrigin, afterListRolePermissionsCounter)
+	}
+}
+
+type mPermissionRepositoryMockRevokePermission struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockRevokePermissionExpectation
+	expectations       []*PermissionRepositoryMockRevokePermissionExpectation
+
+	callArgs []*PermissionRepositoryMockRevokePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionRepositoryMockRevokePermissionExpectation specifies expectation struct of the PermissionRepository.RevokePermission
+type PermissionRepositoryMockRevokePermissionExpectation struct {
+	mock               *PermissionRepositoryMock
+	params             *PermissionRepositoryMockRevokePermissionParams
+	paramPtrs          *PermissionRepositoryMockRevokePermissionParamPtrs
+	expectationOrigins PermissionRepositoryMockRevokePermissionExpectationOrigins
+	results            *PermissionRepositoryMockRevokePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionRepositoryMockRevokePermissionParams contains parameters of the PermissionRepository.RevokePermission
+type PermissionRepositoryMockRevokePermissionParams struct {
+	ctx        context.Context
+	role       string
+	permission string
+}
+
+// PermissionRepositoryMockRevokePermissionParamPtrs contains pointers to parameters of the PermissionRepository.RevokePermission
+type PermissionRepositoryMockRevokePermissionParamPtrs struct {
+	ctx        *context.Context
+	role       *string
+	permission *string
+}
+
+// PermissionRepositoryMockRevokePermissionResults contains results of the PermissionRepository.RevokePermission
+type PermissionRepositoryMockRevokePermissionResults struct {
+	err error
+}
+
+// PermissionRepositoryMockRevokePermissionOrigins contains origins of expectations of the PermissionRepository.RevokePermission
+type PermissionRepositoryMockRevokePermissionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originRole       string
+	originPermission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Optional() *mPermissionRepositoryMockRevokePermission {
+	mmRevokePermission.optional = true
+	return mmRevokePermission
+}
+
+// Expect sets up expected params for PermissionRepository.RevokePermission
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Expect(ctx context.Context, role string, permission string) *mPermissionRepositoryMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PermissionRepositoryMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by ExpectParams functions")
+	}
+
+	mmRevokePermission.defaultExpectation.params = &PermissionRepositoryMockRevokePermissionParams{ctx, role, permission}
+	mmRevokePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokePermission.expectations {
+		if minimock.Equal(e.params, mmRevokePermission.defaultExpectation.params) {
+			mmRevokePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.RevokePermission
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PermissionRepositoryMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.params != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Expect")
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs == nil {
+		mmRevokePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockRevokePermissionParamPtrs{}
+	}
+	mmRevokePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokePermission
+}
+
+// ExpectRoleParam2 sets up expected param role for PermissionRepository.RevokePermission
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) ExpectRoleParam2(role string) *mPermissionRepositoryMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PermissionRepositoryMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.params != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Expect")
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs == nil {
+		mmRevokePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockRevokePermissionParamPtrs{}
+	}
+	mmRevokePermission.defaultExpectation.paramPtrs.role = &role
+	mmRevokePermission.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmRevokePermission
+}
+
+// ExpectPermissionParam3 sets up expected param permission for PermissionRepository.RevokePermission
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) ExpectPermissionParam3(permission string) *mPermissionRepositoryMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PermissionRepositoryMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.params != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Expect")
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs == nil {
+		mmRevokePermission.defaultExpectation.paramPtrs = &PermissionRepositoryMockRevokePermissionParamPtrs{}
+	}
+	mmRevokePermission.defaultExpectation.paramPtrs.permission = &permission
+	mmRevokePermission.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmRevokePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.RevokePermission
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Inspect(f func(ctx context.Context, role string, permission string)) *mPermissionRepositoryMockRevokePermission {
+	if mmRevokePermission.mock.inspectFuncRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.RevokePermission")
+	}
+
+	mmRevokePermission.mock.inspectFuncRevokePermission = f
+
+	return mmRevokePermission
+}
+
+// Return sets up results that will be returned by PermissionRepository.RevokePermission
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Return(err error) *PermissionRepositoryMock {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PermissionRepositoryMockRevokePermissionExpectation{mock: mmRevokePermission.mock}
+	}
+	mmRevokePermission.defaultExpectation.results = &PermissionRepositoryMockRevokePermissionResults{err}
+	mmRevokePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokePermission.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.RevokePermission method
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Set(f func(ctx context.Context, role string, permission string) (err error)) *PermissionRepositoryMock {
+	if mmRevokePermission.defaultExpectation != nil {
+		mmRevokePermission.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.RevokePermission method")
+	}
+
+	if len(mmRevokePermission.expectations) > 0 {
+		mmRevokePermission.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.RevokePermission method")
+	}
+
+	mmRevokePermission.mock.funcRevokePermission = f
+	mmRevokePermission.mock.funcRevokePermissionOrigin = minimock.CallerInfo(1)
+	return mmRevokePermission.mock
+}
+
+// When sets expectation for the PermissionRepository.RevokePermission which will trigger the result defined by the following
+// Then helper
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) When(ctx context.Context, role string, permission string) *PermissionRepositoryMockRevokePermissionExpectation {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PermissionRepositoryMock.RevokePermission mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockRevokePermissionExpectation{
+		mock:               mmRevokePermission.mock,
+		params:             &PermissionRepositoryMockRevokePermissionParams{ctx, role, permission},
+		expectationOrigins: PermissionRepositoryMockRevokePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokePermission.expectations = append(mmRevokePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.RevokePermission return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockRevokePermissionExpectation) Then(err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockRevokePermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.RevokePermission should be invoked
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Times(n uint64) *mPermissionRepositoryMockRevokePermission {
+	if n == 0 {
+		mmRevokePermission.mock.t.Fatalf("Times of PermissionRepositoryMock.RevokePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokePermission.expectedInvocations, n)
+	mmRevokePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokePermission
+}
+
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) invocationsDone() bool {
+	if len(mmRevokePermission.expectations) == 0 && mmRevokePermission.defaultExpectation == nil && mmRevokePermission.mock.funcRevokePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokePermission.mock.afterRevokePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokePermission implements mm_repository.PermissionRepository
+func (mmRevokePermission *PermissionRepositoryMock) RevokePermission(ctx context.Context, role string, permission string) (err error) {
+	mm_atomic.AddUint64(&mmRevokePermission.beforeRevokePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokePermission.afterRevokePermissionCounter, 1)
+
+	mmRevokePermission.t.Helper()
+
+	if mmRevokePermission.inspectFuncRevokePermission != nil {
+		mmRevokePermission.inspectFuncRevokePermission(ctx, role, permission)
+	}
+
+	mm_params := PermissionRepositoryMockRevokePermissionParams{ctx, role, permission}
+
+	// Record call args
+	mmRevokePermission.RevokePermissionMock.mutex.Lock()
+	mmRevokePermission.RevokePermissionMock.callArgs = append(mmRevokePermission.RevokePermissionMock.callArgs, &mm_params)
+	mmRevokePermission.RevokePermissionMock.mutex.Unlock()
+
+	for _, e := range mmRevokePermission.RevokePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokePermission.RevokePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokePermission.RevokePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokePermission.RevokePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokePermission.RevokePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockRevokePermissionParams{ctx, role, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokePermission.t.Errorf("PermissionRepositoryMock.RevokePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmRevokePermission.t.Errorf("PermissionRepositoryMock.RevokePermission got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmRevokePermission.t.Errorf("PermissionRepositoryMock.RevokePermission got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokePermission.t.Errorf("PermissionRepositoryMock.RevokePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokePermission.RevokePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokePermission.t.Fatal("No results are set for the PermissionRepositoryMock.RevokePermission")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokePermission.funcRevokePermission != nil {
+		return mmRevokePermission.funcRevokePermission(ctx, role, permission)
+	}
+	mmRevokePermission.t.Fatalf("Unexpected call to PermissionRepositoryMock.RevokePermission. %v %v %v", ctx, role, permission)
+	return
+}
+
+// RevokePermissionAfterCounter returns a count of finished PermissionRepositoryMock.RevokePermission invocations
+func (mmRevokePermission *PermissionRepositoryMock) RevokePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokePermission.afterRevokePermissionCounter)
+}
+
+// RevokePermissionBeforeCounter returns a count of PermissionRepositoryMock.RevokePermission invocations
+func (mmRevokePermission *PermissionRepositoryMock) RevokePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokePermission.beforeRevokePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.RevokePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokePermission *mPermissionRepositoryMockRevokePermission) Calls() []*PermissionRepositoryMockRevokePermissionParams {
+	mmRevokePermission.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockRevokePermissionParams, len(mmRevokePermission.callArgs))
+	copy(argCopy, mmRevokePermission.callArgs)
+
+	mmRevokePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokePermissionDone returns true if the count of the RevokePermission invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockRevokePermissionDone() bool {
+	if m.RevokePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokePermissionMock.invocationsDone()
+}
+
+// MinimockRevokePermissionInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockRevokePermissionInspect() {
+	for _, e := range m.RevokePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.RevokePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokePermissionCounter := mm_atomic.LoadUint64(&m.afterRevokePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokePermissionMock.defaultExpectation != nil && afterRevokePermissionCounter < 1 {
+		if m.RevokePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.RevokePermission at\n%s", m.RevokePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.RevokePermission at\n%s with params: %#v", m.RevokePermissionMock.defaultExpectation.expectationOrigins.origin, *m.RevokePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokePermission != nil && afterRevokePermissionCounter < 1 {
+		m.t.Errorf("Expected call to PermissionRepositoryMock.RevokePermission at\n%s", m.funcRevokePermissionOrigin)
+	}
+
+	if !m.RevokePermissionMock.invocationsDone() && afterRevokePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.RevokePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokePermissionMock.expectedInvocations), m.RevokePermissionMock.expectedInvocationsOrigin, afterRevokePermissionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PermissionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreatePermissionInspect()
+
+			m.MinimockDeletePermissionInspect()
+
+			m.MinimockGrantPermissionInspect()
+
+			m.MinimockListEffectivePermissionsInspect()
+
+			m.MinimockListPermissionsInspect()
+
+			m.MinimockListRolePermissionsInspect()
+
+			m.MinimockRevokePermissionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PermissionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PermissionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreatePermissionDone() &&
+		m.MinimockDeletePermissionDone() &&
+		m.MinimockGrantPermissionDone() &&
+		m.MinimockListEffectivePermissionsDone() &&
+		m.MinimockListPermissionsDone() &&
+		m.MinimockListRolePermissionsDone() &&
+		m.MinimockRevokePermissionDone()
+}
@@ -0,0 +1,1454 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.PolicyBundleRepository -o policy_bundle_repository_minimock.go -n PolicyBundleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// PolicyBundleRepositoryMock implements mm_repository.PolicyBundleRepository
+type PolicyBundleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateInitialPolicyBundle          func(ctx context.Context, version *model.PolicyBundleVersion) (err error)
+	funcCreateInitialPolicyBundleOrigin    string
+	inspectFuncCreateInitialPolicyBundle   func(ctx context.Context, version *model.PolicyBundleVersion)
+	afterCreateInitialPolicyBundleCounter  uint64
+	beforeCreateInitialPolicyBundleCounter uint64
+	CreateInitialPolicyBundleMock          mPolicyBundleRepositoryMockCreateInitialPolicyBundle
+
+	funcCreatePolicyBundle          func(ctx context.Context, version *model.PolicyBundleVersion) (i1 int64, err error)
+	funcCreatePolicyBundleOrigin    string
+	inspectFuncCreatePolicyBundle   func(ctx context.Context, version *model.PolicyBundleVersion)
+	afterCreatePolicyBundleCounter  uint64
+	beforeCreatePolicyBundleCounter uint64
+	CreatePolicyBundleMock          mPolicyBundleRepositoryMockCreatePolicyBundle
+
+	funcGetPolicyBundle          func(ctx context.Context, version int64) (pp1 *model.PolicyBundleVersion, err error)
+	funcGetPolicyBundleOrigin    string
+	inspectFuncGetPolicyBundle   func(ctx context.Context, version int64)
+	afterGetPolicyBundleCounter  uint64
+	beforeGetPolicyBundleCounter uint64
+	GetPolicyBundleMock          mPolicyBundleRepositoryMockGetPolicyBundle
+
+	funcListPolicyBundles          func(ctx context.Context) (ppa1 []*model.PolicyBundleVersion, err error)
+	funcListPolicyBundlesOrigin    string
+	inspectFuncListPolicyBundles   func(ctx context.Context)
+	afterListPolicyBundlesCounter  uint64
+	beforeListPolicyBundlesCounter uint64
+	ListPolicyBundlesMock          mPolicyBundleRepositoryMockListPolicyBundles
+}
+
+// NewPolicyBundleRepositoryMock returns a mock for mm_repository.PolicyBundleRepository
+func NewPolicyBundleRepositoryMock(t minimock.Tester) *PolicyBundleRepositoryMock {
+	m := &PolicyBundleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateInitialPolicyBundleMock = mPolicyBundleRepositoryMockCreateInitialPolicyBundle{mock: m}
+	m.CreateInitialPolicyBundleMock.callArgs = []*PolicyBundleRepositoryMockCreateInitialPolicyBundleParams{}
+
+	m.CreatePolicyBundleMock = mPolicyBundleRepositoryMockCreatePolicyBundle{mock: m}
+	m.CreatePolicyBundleMock.callArgs = []*PolicyBundleRepositoryMockCreatePolicyBundleParams{}
+
+	m.GetPolicyBundleMock = mPolicyBundleRepositoryMockGetPolicyBundle{mock: m}
+	m.GetPolicyBundleMock.callArgs = []*PolicyBundleRepositoryMockGetPolicyBundleParams{}
+
+	m.ListPolicyBundlesMock = mPolicyBundleRepositoryMockListPolicyBundles{mock: m}
+	m.ListPolicyBundlesMock.callArgs = []*PolicyBundleRepositoryMockListPolicyBundlesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPolicyBundleRepositoryMockCreateInitialPolicyBundle struct {
+	optional           bool
+	mock               *PolicyBundleRepositoryMock
+	defaultExpectation *PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation
+	expectations       []*PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation
+
+	callArgs []*PolicyBundleRepositoryMockCreateInitialPolicyBundleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation specifies expectation struct of the PolicyBundleRepository.CreateInitialPolicyBundle
+type PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation struct {
+	mock               *PolicyBundleRepositoryMock
+	params             *PolicyBundleRepositoryMockCreateInitialPolicyBundleParams
+	paramPtrs          *PolicyBundleRepositoryMockCreateInitialPolicyBundleParamPtrs
+	expectationOrigins PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectationOrigins
+	results            *PolicyBundleRepositoryMockCreateInitialPolicyBundleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyBundleRepositoryMockCreateInitialPolicyBundleParams contains parameters of the PolicyBundleRepository.CreateInitialPolicyBundle
+type PolicyBundleRepositoryMockCreateInitialPolicyBundleParams struct {
+	ctx     context.Context
+	version *model.PolicyBundleVersion
+}
+
+// PolicyBundleRepositoryMockCreateInitialPolicyBundleParamPtrs contains pointers to parameters of the PolicyBundleRepository.CreateInitialPolicyBundle
+type PolicyBundleRepositoryMockCreateInitialPolicyBundleParamPtrs struct {
+	ctx     *context.Context
+	version **model.PolicyBundleVersion
+}
+
+// PolicyBundleRepositoryMockCreateInitialPolicyBundleResults contains results of the PolicyBundleRepository.CreateInitialPolicyBundle
+type PolicyBundleRepositoryMockCreateInitialPolicyBundleResults struct {
+	err error
+}
+
+// PolicyBundleRepositoryMockCreateInitialPolicyBundleOrigins contains origins of expectations of the PolicyBundleRepository.CreateInitialPolicyBundle
+type PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Optional() *mPolicyBundleRepositoryMockCreateInitialPolicyBundle {
+	mmCreateInitialPolicyBundle.optional = true
+	return mmCreateInitialPolicyBundle
+}
+
+// Expect sets up expected params for PolicyBundleRepository.CreateInitialPolicyBundle
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Expect(ctx context.Context, version *model.PolicyBundleVersion) *mPolicyBundleRepositoryMockCreateInitialPolicyBundle {
+	if mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Set")
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation == nil {
+		mmCreateInitialPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation{}
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by ExpectParams functions")
+	}
+
+	mmCreateInitialPolicyBundle.defaultExpectation.params = &PolicyBundleRepositoryMockCreateInitialPolicyBundleParams{ctx, version}
+	mmCreateInitialPolicyBundle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateInitialPolicyBundle.expectations {
+		if minimock.Equal(e.params, mmCreateInitialPolicyBundle.defaultExpectation.params) {
+			mmCreateInitialPolicyBundle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateInitialPolicyBundle.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateInitialPolicyBundle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyBundleRepository.CreateInitialPolicyBundle
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) ExpectCtxParam1(ctx context.Context) *mPolicyBundleRepositoryMockCreateInitialPolicyBundle {
+	if mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Set")
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation == nil {
+		mmCreateInitialPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation{}
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation.params != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Expect")
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs == nil {
+		mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockCreateInitialPolicyBundleParamPtrs{}
+	}
+	mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateInitialPolicyBundle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateInitialPolicyBundle
+}
+
+// ExpectVersionParam2 sets up expected param version for PolicyBundleRepository.CreateInitialPolicyBundle
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) ExpectVersionParam2(version *model.PolicyBundleVersion) *mPolicyBundleRepositoryMockCreateInitialPolicyBundle {
+	if mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Set")
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation == nil {
+		mmCreateInitialPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation{}
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation.params != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Expect")
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs == nil {
+		mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockCreateInitialPolicyBundleParamPtrs{}
+	}
+	mmCreateInitialPolicyBundle.defaultExpectation.paramPtrs.version = &version
+	mmCreateInitialPolicyBundle.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmCreateInitialPolicyBundle
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyBundleRepository.CreateInitialPolicyBundle
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Inspect(f func(ctx context.Context, version *model.PolicyBundleVersion)) *mPolicyBundleRepositoryMockCreateInitialPolicyBundle {
+	if mmCreateInitialPolicyBundle.mock.inspectFuncCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("Inspect function is already set for PolicyBundleRepositoryMock.CreateInitialPolicyBundle")
+	}
+
+	mmCreateInitialPolicyBundle.mock.inspectFuncCreateInitialPolicyBundle = f
+
+	return mmCreateInitialPolicyBundle
+}
+
+// Return sets up results that will be returned by PolicyBundleRepository.CreateInitialPolicyBundle
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Return(err error) *PolicyBundleRepositoryMock {
+	if mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Set")
+	}
+
+	if mmCreateInitialPolicyBundle.defaultExpectation == nil {
+		mmCreateInitialPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation{mock: mmCreateInitialPolicyBundle.mock}
+	}
+	mmCreateInitialPolicyBundle.defaultExpectation.results = &PolicyBundleRepositoryMockCreateInitialPolicyBundleResults{err}
+	mmCreateInitialPolicyBundle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateInitialPolicyBundle.mock
+}
+
+// Set uses given function f to mock the PolicyBundleRepository.CreateInitialPolicyBundle method
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Set(f func(ctx context.Context, version *model.PolicyBundleVersion) (err error)) *PolicyBundleRepositoryMock {
+	if mmCreateInitialPolicyBundle.defaultExpectation != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("Default expectation is already set for the PolicyBundleRepository.CreateInitialPolicyBundle method")
+	}
+
+	if len(mmCreateInitialPolicyBundle.expectations) > 0 {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("Some expectations are already set for the PolicyBundleRepository.CreateInitialPolicyBundle method")
+	}
+
+	mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle = f
+	mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundleOrigin = minimock.CallerInfo(1)
+	return mmCreateInitialPolicyBundle.mock
+}
+
+// When sets expectation for the PolicyBundleRepository.CreateInitialPolicyBundle which will trigger the result defined by the following
+// Then helper
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) When(ctx context.Context, version *model.PolicyBundleVersion) *PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation {
+	if mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock is already set by Set")
+	}
+
+	expectation := &PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation{
+		mock:               mmCreateInitialPolicyBundle.mock,
+		params:             &PolicyBundleRepositoryMockCreateInitialPolicyBundleParams{ctx, version},
+		expectationOrigins: PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateInitialPolicyBundle.expectations = append(mmCreateInitialPolicyBundle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyBundleRepository.CreateInitialPolicyBundle return parameters for the expectation previously defined by the When method
+func (e *PolicyBundleRepositoryMockCreateInitialPolicyBundleExpectation) Then(err error) *PolicyBundleRepositoryMock {
+	e.results = &PolicyBundleRepositoryMockCreateInitialPolicyBundleResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyBundleRepository.CreateInitialPolicyBundle should be invoked
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Times(n uint64) *mPolicyBundleRepositoryMockCreateInitialPolicyBundle {
+	if n == 0 {
+		mmCreateInitialPolicyBundle.mock.t.Fatalf("Times of PolicyBundleRepositoryMock.CreateInitialPolicyBundle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateInitialPolicyBundle.expectedInvocations, n)
+	mmCreateInitialPolicyBundle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateInitialPolicyBundle
+}
+
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) invocationsDone() bool {
+	if len(mmCreateInitialPolicyBundle.expectations) == 0 && mmCreateInitialPolicyBundle.defaultExpectation == nil && mmCreateInitialPolicyBundle.mock.funcCreateInitialPolicyBundle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateInitialPolicyBundle.mock.afterCreateInitialPolicyBundleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateInitialPolicyBundle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateInitialPolicyBundle implements mm_repository.PolicyBundleRepository
+func (mmCreateInitialPolicyBundle *PolicyBundleRepositoryMock) CreateInitialPolicyBundle(ctx context.Context, version *model.PolicyBundleVersion) (err error) {
+	mm_atomic.AddUint64(&mmCreateInitialPolicyBundle.beforeCreateInitialPolicyBundleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateInitialPolicyBundle.afterCreateInitialPolicyBundleCounter, 1)
+
+	mmCreateInitialPolicyBundle.t.Helper()
+
+	if mmCreateInitialPolicyBundle.inspectFuncCreateInitialPolicyBundle != nil {
+		mmCreateInitialPolicyBundle.inspectFuncCreateInitialPolicyBundle(ctx, version)
+	}
+
+	mm_params := PolicyBundleRepositoryMockCreateInitialPolicyBundleParams{ctx, version}
+
+	// Record call args
+	mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.mutex.Lock()
+	mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.callArgs = append(mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.callArgs, &mm_params)
+	mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.mutex.Unlock()
+
+	for _, e := range mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyBundleRepositoryMockCreateInitialPolicyBundleParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateInitialPolicyBundle.t.Errorf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmCreateInitialPolicyBundle.t.Errorf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateInitialPolicyBundle.t.Errorf("PolicyBundleRepositoryMock.CreateInitialPolicyBundle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateInitialPolicyBundle.CreateInitialPolicyBundleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateInitialPolicyBundle.t.Fatal("No results are set for the PolicyBundleRepositoryMock.CreateInitialPolicyBundle")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateInitialPolicyBundle.funcCreateInitialPolicyBundle != nil {
+		return mmCreateInitialPolicyBundle.funcCreateInitialPolicyBundle(ctx, version)
+	}
+	mmCreateInitialPolicyBundle.t.Fatalf("Unexpected call to PolicyBundleRepositoryMock.CreateInitialPolicyBundle. %v %v", ctx, version)
+	return
+}
+
+// CreateInitialPolicyBundleAfterCounter returns a count of finished PolicyBundleRepositoryMock.CreateInitialPolicyBundle invocations
+func (mmCreateInitialPolicyBundle *PolicyBundleRepositoryMock) CreateInitialPolicyBundleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateInitialPolicyBundle.afterCreateInitialPolicyBundleCounter)
+}
+
+// CreateInitialPolicyBundleBeforeCounter returns a count of PolicyBundleRepositoryMock.CreateInitialPolicyBundle invocations
+func (mmCreateInitialPolicyBundle *PolicyBundleRepositoryMock) CreateInitialPolicyBundleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateInitialPolicyBundle.beforeCreateInitialPolicyBundleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyBundleRepositoryMock.CreateInitialPolicyBundle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateInitialPolicyBundle *mPolicyBundleRepositoryMockCreateInitialPolicyBundle) Calls() []*PolicyBundleRepositoryMockCreateInitialPolicyBundleParams {
+	mmCreateInitialPolicyBundle.mutex.RLock()
+
+	argCopy := make([]*PolicyBundleRepositoryMockCreateInitialPolicyBundleParams, len(mmCreateInitialPolicyBundle.callArgs))
+	copy(argCopy, mmCreateInitialPolicyBundle.callArgs)
+
+	mmCreateInitialPolicyBundle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateInitialPolicyBundleDone returns true if the count of the CreateInitialPolicyBundle invocations corresponds
+// the number of defined expectations
+func (m *PolicyBundleRepositoryMock) MinimockCreateInitialPolicyBundleDone() bool {
+	if m.CreateInitialPolicyBundleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateInitialPolicyBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateInitialPolicyBundleMock.invocationsDone()
+}
+
+// MinimockCreateInitialPolicyBundleInspect logs each unmet expectation
+func (m *PolicyBundleRepositoryMock) MinimockCreateInitialPolicyBundleInspect() {
+	for _, e := range m.CreateInitialPolicyBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreateInitialPolicyBundle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateInitialPolicyBundleCounter := mm_atomic.LoadUint64(&m.afterCreateInitialPolicyBundleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateInitialPolicyBundleMock.defaultExpectation != nil && afterCreateInitialPolicyBundleCounter < 1 {
+		if m.CreateInitialPolicyBundleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreateInitialPolicyBundle at\n%s", m.CreateInitialPolicyBundleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreateInitialPolicyBundle at\n%s with params: %#v", m.CreateInitialPolicyBundleMock.defaultExpectation.expectationOrigins.origin, *m.CreateInitialPolicyBundleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateInitialPolicyBundle != nil && afterCreateInitialPolicyBundleCounter < 1 {
+		m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreateInitialPolicyBundle at\n%s", m.funcCreateInitialPolicyBundleOrigin)
+	}
+
+	if !m.CreateInitialPolicyBundleMock.invocationsDone() && afterCreateInitialPolicyBundleCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyBundleRepositoryMock.CreateInitialPolicyBundle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateInitialPolicyBundleMock.expectedInvocations), m.CreateInitialPolicyBundleMock.expectedInvocationsOrigin, afterCreateInitialPolicyBundleCounter)
+	}
+}
+
+type mPolicyBundleRepositoryMockCreatePolicyBundle struct {
+	optional           bool
+	mock               *PolicyBundleRepositoryMock
+	defaultExpectation *PolicyBundleRepositoryMockCreatePolicyBundleExpectation
+	expectations       []*PolicyBundleRepositoryMockCreatePolicyBundleExpectation
+
+	callArgs []*PolicyBundleRepositoryMockCreatePolicyBundleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyBundleRepositoryMockCreatePolicyBundleExpectation specifies expectation struct of the PolicyBundleRepository.CreatePolicyBundle
+type PolicyBundleRepositoryMockCreatePolicyBundleExpectation struct {
+	mock               *PolicyBundleRepositoryMock
+	params             *PolicyBundleRepositoryMockCreatePolicyBundleParams
+	paramPtrs          *PolicyBundleRepositoryMockCreatePolicyBundleParamPtrs
+	expectationOrigins PolicyBundleRepositoryMockCreatePolicyBundleExpectationOrigins
+	results            *PolicyBundleRepositoryMockCreatePolicyBundleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyBundleRepositoryMockCreatePolicyBundleParams contains parameters of the PolicyBundleRepository.CreatePolicyBundle
+type PolicyBundleRepositoryMockCreatePolicyBundleParams struct {
+	ctx     context.Context
+	version *model.PolicyBundleVersion
+}
+
+// PolicyBundleRepositoryMockCreatePolicyBundleParamPtrs contains pointers to parameters of the PolicyBundleRepository.CreatePolicyBundle
+type PolicyBundleRepositoryMockCreatePolicyBundleParamPtrs struct {
+	ctx     *context.Context
+	version **model.PolicyBundleVersion
+}
+
+// PolicyBundleRepositoryMockCreatePolicyBundleResults contains results of the PolicyBundleRepository.CreatePolicyBundle
+type PolicyBundleRepositoryMockCreatePolicyBundleResults struct {
+	i1  int64
+	err error
+}
+
+// PolicyBundleRepositoryMockCreatePolicyBundleOrigins contains origins of expectations of the PolicyBundleRepository.CreatePolicyBundle
+type PolicyBundleRepositoryMockCreatePolicyBundleExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Optional() *mPolicyBundleRepositoryMockCreatePolicyBundle {
+	mmCreatePolicyBundle.optional = true
+	return mmCreatePolicyBundle
+}
+
+// Expect sets up expected params for PolicyBundleRepository.CreatePolicyBundle
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Expect(ctx context.Context, version *model.PolicyBundleVersion) *mPolicyBundleRepositoryMockCreatePolicyBundle {
+	if mmCreatePolicyBundle.mock.funcCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Set")
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation == nil {
+		mmCreatePolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreatePolicyBundleExpectation{}
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation.paramPtrs != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePolicyBundle.defaultExpectation.params = &PolicyBundleRepositoryMockCreatePolicyBundleParams{ctx, version}
+	mmCreatePolicyBundle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePolicyBundle.expectations {
+		if minimock.Equal(e.params, mmCreatePolicyBundle.defaultExpectation.params) {
+			mmCreatePolicyBundle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePolicyBundle.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePolicyBundle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyBundleRepository.CreatePolicyBundle
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) ExpectCtxParam1(ctx context.Context) *mPolicyBundleRepositoryMockCreatePolicyBundle {
+	if mmCreatePolicyBundle.mock.funcCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Set")
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation == nil {
+		mmCreatePolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreatePolicyBundleExpectation{}
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation.params != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Expect")
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation.paramPtrs == nil {
+		mmCreatePolicyBundle.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockCreatePolicyBundleParamPtrs{}
+	}
+	mmCreatePolicyBundle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePolicyBundle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePolicyBundle
+}
+
+// ExpectVersionParam2 sets up expected param version for PolicyBundleRepository.CreatePolicyBundle
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) ExpectVersionParam2(version *model.PolicyBundleVersion) *mPolicyBundleRepositoryMockCreatePolicyBundle {
+	if mmCreatePolicyBundle.mock.funcCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Set")
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation == nil {
+		mmCreatePolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreatePolicyBundleExpectation{}
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation.params != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Expect")
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation.paramPtrs == nil {
+		mmCreatePolicyBundle.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockCreatePolicyBundleParamPtrs{}
+	}
+	mmCreatePolicyBundle.defaultExpectation.paramPtrs.version = &version
+	mmCreatePolicyBundle.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmCreatePolicyBundle
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyBundleRepository.CreatePolicyBundle
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Inspect(f func(ctx context.Context, version *model.PolicyBundleVersion)) *mPolicyBundleRepositoryMockCreatePolicyBundle {
+	if mmCreatePolicyBundle.mock.inspectFuncCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("Inspect function is already set for PolicyBundleRepositoryMock.CreatePolicyBundle")
+	}
+
+	mmCreatePolicyBundle.mock.inspectFuncCreatePolicyBundle = f
+
+	return mmCreatePolicyBundle
+}
+
+// Return sets up results that will be returned by PolicyBundleRepository.CreatePolicyBundle
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Return(i1 int64, err error) *PolicyBundleRepositoryMock {
+	if mmCreatePolicyBundle.mock.funcCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Set")
+	}
+
+	if mmCreatePolicyBundle.defaultExpectation == nil {
+		mmCreatePolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockCreatePolicyBundleExpectation{mock: mmCreatePolicyBundle.mock}
+	}
+	mmCreatePolicyBundle.defaultExpectation.results = &PolicyBundleRepositoryMockCreatePolicyBundleResults{i1, err}
+	mmCreatePolicyBundle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePolicyBundle.mock
+}
+
+// Set uses given function f to mock the PolicyBundleRepository.CreatePolicyBundle method
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Set(f func(ctx context.Context, version *model.PolicyBundleVersion) (i1 int64, err error)) *PolicyBundleRepositoryMock {
+	if mmCreatePolicyBundle.defaultExpectation != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("Default expectation is already set for the PolicyBundleRepository.CreatePolicyBundle method")
+	}
+
+	if len(mmCreatePolicyBundle.expectations) > 0 {
+		mmCreatePolicyBundle.mock.t.Fatalf("Some expectations are already set for the PolicyBundleRepository.CreatePolicyBundle method")
+	}
+
+	mmCreatePolicyBundle.mock.funcCreatePolicyBundle = f
+	mmCreatePolicyBundle.mock.funcCreatePolicyBundleOrigin = minimock.CallerInfo(1)
+	return mmCreatePolicyBundle.mock
+}
+
+// When sets expectation for the PolicyBundleRepository.CreatePolicyBundle which will trigger the result defined by the following
+// Then helper
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) When(ctx context.Context, version *model.PolicyBundleVersion) *PolicyBundleRepositoryMockCreatePolicyBundleExpectation {
+	if mmCreatePolicyBundle.mock.funcCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.CreatePolicyBundle mock is already set by Set")
+	}
+
+	expectation := &PolicyBundleRepositoryMockCreatePolicyBundleExpectation{
+		mock:               mmCreatePolicyBundle.mock,
+		params:             &PolicyBundleRepositoryMockCreatePolicyBundleParams{ctx, version},
+		expectationOrigins: PolicyBundleRepositoryMockCreatePolicyBundleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePolicyBundle.expectations = append(mmCreatePolicyBundle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyBundleRepository.CreatePolicyBundle return parameters for the expectation previously defined by the When method
+func (e *PolicyBundleRepositoryMockCreatePolicyBundleExpectation) Then(i1 int64, err error) *PolicyBundleRepositoryMock {
+	e.results = &PolicyBundleRepositoryMockCreatePolicyBundleResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyBundleRepository.CreatePolicyBundle should be invoked
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Times(n uint64) *mPolicyBundleRepositoryMockCreatePolicyBundle {
+	if n == 0 {
+		mmCreatePolicyBundle.mock.t.Fatalf("Times of PolicyBundleRepositoryMock.CreatePolicyBundle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePolicyBundle.expectedInvocations, n)
+	mmCreatePolicyBundle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePolicyBundle
+}
+
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) invocationsDone() bool {
+	if len(mmCreatePolicyBundle.expectations) == 0 && mmCreatePolicyBundle.defaultExpectation == nil && mmCreatePolicyBundle.mock.funcCreatePolicyBundle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePolicyBundle.mock.afterCreatePolicyBundleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePolicyBundle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePolicyBundle implements mm_repository.PolicyBundleRepository
+func (mmCreatePolicyBundle *PolicyBundleRepositoryMock) CreatePolicyBundle(ctx context.Context, version *model.PolicyBundleVersion) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreatePolicyBundle.beforeCreatePolicyBundleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePolicyBundle.afterCreatePolicyBundleCounter, 1)
+
+	mmCreatePolicyBundle.t.Helper()
+
+	if mmCreatePolicyBundle.inspectFuncCreatePolicyBundle != nil {
+		mmCreatePolicyBundle.inspectFuncCreatePolicyBundle(ctx, version)
+	}
+
+	mm_params := PolicyBundleRepositoryMockCreatePolicyBundleParams{ctx, version}
+
+	// Record call args
+	mmCreatePolicyBundle.CreatePolicyBundleMock.mutex.Lock()
+	mmCreatePolicyBundle.CreatePolicyBundleMock.callArgs = append(mmCreatePolicyBundle.CreatePolicyBundleMock.callArgs, &mm_params)
+	mmCreatePolicyBundle.CreatePolicyBundleMock.mutex.Unlock()
+
+	for _, e := range mmCreatePolicyBundle.CreatePolicyBundleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyBundleRepositoryMockCreatePolicyBundleParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePolicyBundle.t.Errorf("PolicyBundleRepositoryMock.CreatePolicyBundle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmCreatePolicyBundle.t.Errorf("PolicyBundleRepositoryMock.CreatePolicyBundle got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePolicyBundle.t.Errorf("PolicyBundleRepositoryMock.CreatePolicyBundle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePolicyBundle.CreatePolicyBundleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePolicyBundle.t.Fatal("No results are set for the PolicyBundleRepositoryMock.CreatePolicyBundle")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreatePolicyBundle.funcCreatePolicyBundle != nil {
+		return mmCreatePolicyBundle.funcCreatePolicyBundle(ctx, version)
+	}
+	mmCreatePolicyBundle.t.Fatalf("Unexpected call to PolicyBundleRepositoryMock.CreatePolicyBundle. %v %v", ctx, version)
+	return
+}
+
+// CreatePolicyBundleAfterCounter returns a count of finished PolicyBundleRepositoryMock.CreatePolicyBundle invocations
+func (mmCreatePolicyBundle *PolicyBundleRepositoryMock) CreatePolicyBundleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePolicyBundle.afterCreatePolicyBundleCounter)
+}
+
+// CreatePolicyBundleBeforeCounter returns a count of PolicyBundleRepositoryMock.CreatePolicyBundle invocations
+func (mmCreatePolicyBundle *PolicyBundleRepositoryMock) CreatePolicyBundleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePolicyBundle.beforeCreatePolicyBundleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyBundleRepositoryMock.CreatePolicyBundle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePolicyBundle *mPolicyBundleRepositoryMockCreatePolicyBundle) Calls() []*PolicyBundleRepositoryMockCreatePolicyBundleParams {
+	mmCreatePolicyBundle.mutex.RLock()
+
+	argCopy := make([]*PolicyBundleRepositoryMockCreatePolicyBundleParams, len(mmCreatePolicyBundle.callArgs))
+	copy(argCopy, mmCreatePolicyBundle.callArgs)
+
+	mmCreatePolicyBundle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePolicyBundleDone returns true if the count of the CreatePolicyBundle invocations corresponds
+// the number of defined expectations
+func (m *PolicyBundleRepositoryMock) MinimockCreatePolicyBundleDone() bool {
+	if m.CreatePolicyBundleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePolicyBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePolicyBundleMock.invocationsDone()
+}
+
+// MinimockCreatePolicyBundleInspect logs each unmet expectation
+func (m *PolicyBundleRepositoryMock) MinimockCreatePolicyBundleInspect() {
+	for _, e := range m.CreatePolicyBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreatePolicyBundle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePolicyBundleCounter := mm_atomic.LoadUint64(&m.afterCreatePolicyBundleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePolicyBundleMock.defaultExpectation != nil && afterCreatePolicyBundleCounter < 1 {
+		if m.CreatePolicyBundleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreatePolicyBundle at\n%s", m.CreatePolicyBundleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreatePolicyBundle at\n%s with params: %#v", m.CreatePolicyBundleMock.defaultExpectation.expectationOrigins.origin, *m.CreatePolicyBundleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePolicyBundle != nil && afterCreatePolicyBundleCounter < 1 {
+		m.t.Errorf("Expected call to PolicyBundleRepositoryMock.CreatePolicyBundle at\n%s", m.funcCreatePolicyBundleOrigin)
+	}
+
+	if !m.CreatePolicyBundleMock.invocationsDone() && afterCreatePolicyBundleCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyBundleRepositoryMock.CreatePolicyBundle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePolicyBundleMock.expectedInvocations), m.CreatePolicyBundleMock.expectedInvocationsOrigin, afterCreatePolicyBundleCounter)
+	}
+}
+
+type mPolicyBundleRepositoryMockGetPolicyBundle struct {
+	optional           bool
+	mock               *PolicyBundleRepositoryMock
+	defaultExpectation *PolicyBundleRepositoryMockGetPolicyBundleExpectation
+	expectations       []*PolicyBundleRepositoryMockGetPolicyBundleExpectation
+
+	callArgs []*PolicyBundleRepositoryMockGetPolicyBundleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyBundleRepositoryMockGetPolicyBundleExpectation specifies expectation struct of the PolicyBundleRepository.GetPolicyBundle
+type PolicyBundleRepositoryMockGetPolicyBundleExpectation struct {
+	mock               *PolicyBundleRepositoryMock
+	params             *PolicyBundleRepositoryMockGetPolicyBundleParams
+	paramPtrs          *PolicyBundleRepositoryMockGetPolicyBundleParamPtrs
+	expectationOrigins PolicyBundleRepositoryMockGetPolicyBundleExpectationOrigins
+	results            *PolicyBundleRepositoryMockGetPolicyBundleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyBundleRepositoryMockGetPolicyBundleParams contains parameters of the PolicyBundleRepository.GetPolicyBundle
+type PolicyBundleRepositoryMockGetPolicyBundleParams struct {
+	ctx     context.Context
+	version int64
+}
+
+// PolicyBundleRepositoryMockGetPolicyBundleParamPtrs contains pointers to parameters of the PolicyBundleRepository.GetPolicyBundle
+type PolicyBundleRepositoryMockGetPolicyBundleParamPtrs struct {
+	ctx     *context.Context
+	version *int64
+}
+
+// PolicyBundleRepositoryMockGetPolicyBundleResults contains results of the PolicyBundleRepository.GetPolicyBundle
+type PolicyBundleRepositoryMockGetPolicyBundleResults struct {
+	pp1 *model.PolicyBundleVersion
+	err error
+}
+
+// PolicyBundleRepositoryMockGetPolicyBundleOrigins contains origins of expectations of the PolicyBundleRepository.GetPolicyBundle
+type PolicyBundleRepositoryMockGetPolicyBundleExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Optional() *mPolicyBundleRepositoryMockGetPolicyBundle {
+	mmGetPolicyBundle.optional = true
+	return mmGetPolicyBundle
+}
+
+// Expect sets up expected params for PolicyBundleRepository.GetPolicyBundle
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Expect(ctx context.Context, version int64) *mPolicyBundleRepositoryMockGetPolicyBundle {
+	if mmGetPolicyBundle.mock.funcGetPolicyBundle != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Set")
+	}
+
+	if mmGetPolicyBundle.defaultExpectation == nil {
+		mmGetPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockGetPolicyBundleExpectation{}
+	}
+
+	if mmGetPolicyBundle.defaultExpectation.paramPtrs != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by ExpectParams functions")
+	}
+
+	mmGetPolicyBundle.defaultExpectation.params = &PolicyBundleRepositoryMockGetPolicyBundleParams{ctx, version}
+	mmGetPolicyBundle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPolicyBundle.expectations {
+		if minimock.Equal(e.params, mmGetPolicyBundle.defaultExpectation.params) {
+			mmGetPolicyBundle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPolicyBundle.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPolicyBundle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyBundleRepository.GetPolicyBundle
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) ExpectCtxParam1(ctx context.Context) *mPolicyBundleRepositoryMockGetPolicyBundle {
+	if mmGetPolicyBundle.mock.funcGetPolicyBundle != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Set")
+	}
+
+	if mmGetPolicyBundle.defaultExpectation == nil {
+		mmGetPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockGetPolicyBundleExpectation{}
+	}
+
+	if mmGetPolicyBundle.defaultExpectation.params != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Expect")
+	}
+
+	if mmGetPolicyBundle.defaultExpectation.paramPtrs == nil {
+		mmGetPolicyBundle.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockGetPolicyBundleParamPtrs{}
+	}
+	mmGetPolicyBundle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPolicyBundle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPolicyBundle
+}
+
+// ExpectVersionParam2 sets up expected param version for PolicyBundleRepository.GetPolicyBundle
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) ExpectVersionParam2(version int64) *mPolicyBundleRepositoryMockGetPolicyBundle {
+	if mmGetPolicyBundle.mock.funcGetPolicyBundle != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Set")
+	}
+
+	if mmGetPolicyBundle.defaultExpectation == nil {
+		mmGetPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockGetPolicyBundleExpectation{}
+	}
+
+	if mmGetPolicyBundle.defaultExpectation.params != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Expect")
+	}
+
+	if mmGetPolicyBundle.defaultExpectation.paramPtrs == nil {
+		mmGetPolicyBundle.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockGetPolicyBundleParamPtrs{}
+	}
+	mmGetPolicyBundle.defaultExpectation.paramPtrs.version = &version
+	mmGetPolicyBundle.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetPolicyBundle
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyBundleRepository.GetPolicyBundle
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Inspect(f func(ctx context.Context, version int64)) *mPolicyBundleRepositoryMockGetPolicyBundle {
+	if mmGetPolicyBundle.mock.inspectFuncGetPolicyBundle != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("Inspect function is already set for PolicyBundleRepositoryMock.GetPolicyBundle")
+	}
+
+	mmGetPolicyBundle.mock.inspectFuncGetPolicyBundle = f
+
+	return mmGetPolicyBundle
+}
+
+// Return sets up results that will be returned by PolicyBundleRepository.GetPolicyBundle
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Return(pp1 *model.PolicyBundleVersion, err error) *PolicyBundleRepositoryMock {
+	if mmGetPolicyBundle.mock.funcGetPolicyBundle != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Set")
+	}
+
+	if mmGetPolicyBundle.defaultExpectation == nil {
+		mmGetPolicyBundle.defaultExpectation = &PolicyBundleRepositoryMockGetPolicyBundleExpectation{mock: mmGetPolicyBundle.mock}
+	}
+	mmGetPolicyBundle.defaultExpectation.results = &PolicyBundleRepositoryMockGetPolicyBundleResults{pp1, err}
+	mmGetPolicyBundle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPolicyBundle.mock
+}
+
+// Set uses given function f to mock the PolicyBundleRepository.GetPolicyBundle method
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Set(f func(ctx context.Context, version int64) (pp1 *model.PolicyBundleVersion, err error)) *PolicyBundleRepositoryMock {
+	if mmGetPolicyBundle.defaultExpectation != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("Default expectation is already set for the PolicyBundleRepository.GetPolicyBundle method")
+	}
+
+	if len(mmGetPolicyBundle.expectations) > 0 {
+		mmGetPolicyBundle.mock.t.Fatalf("Some expectations are already set for the PolicyBundleRepository.GetPolicyBundle method")
+	}
+
+	mmGetPolicyBundle.mock.funcGetPolicyBundle = f
+	mmGetPolicyBundle.mock.funcGetPolicyBundleOrigin = minimock.CallerInfo(1)
+	return mmGetPolicyBundle.mock
+}
+
+// When sets expectation for the PolicyBundleRepository.GetPolicyBundle which will trigger the result defined by the following
+// Then helper
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) When(ctx context.Context, version int64) *PolicyBundleRepositoryMockGetPolicyBundleExpectation {
+	if mmGetPolicyBundle.mock.funcGetPolicyBundle != nil {
+		mmGetPolicyBundle.mock.t.Fatalf("PolicyBundleRepositoryMock.GetPolicyBundle mock is already set by Set")
+	}
+
+	expectation := &PolicyBundleRepositoryMockGetPolicyBundleExpectation{
+		mock:               mmGetPolicyBundle.mock,
+		params:             &PolicyBundleRepositoryMockGetPolicyBundleParams{ctx, version},
+		expectationOrigins: PolicyBundleRepositoryMockGetPolicyBundleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPolicyBundle.expectations = append(mmGetPolicyBundle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyBundleRepository.GetPolicyBundle return parameters for the expectation previously defined by the When method
+func (e *PolicyBundleRepositoryMockGetPolicyBundleExpectation) Then(pp1 *model.PolicyBundleVersion, err error) *PolicyBundleRepositoryMock {
+	e.results = &PolicyBundleRepositoryMockGetPolicyBundleResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyBundleRepository.GetPolicyBundle should be invoked
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Times(n uint64) *mPolicyBundleRepositoryMockGetPolicyBundle {
+	if n == 0 {
+		mmGetPolicyBundle.mock.t.Fatalf("Times of PolicyBundleRepositoryMock.GetPolicyBundle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPolicyBundle.expectedInvocations, n)
+	mmGetPolicyBundle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPolicyBundle
+}
+
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) invocationsDone() bool {
+	if len(mmGetPolicyBundle.expectations) == 0 && mmGetPolicyBundle.defaultExpectation == nil && mmGetPolicyBundle.mock.funcGetPolicyBundle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPolicyBundle.mock.afterGetPolicyBundleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPolicyBundle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPolicyBundle implements mm_repository.PolicyBundleRepository
+func (mmGetPolicyBundle *PolicyBundleRepositoryMock) GetPolicyBundle(ctx context.Context, version int64) (pp1 *model.PolicyBundleVersion, err error) {
+	mm_atomic.AddUint64(&mmGetPolicyBundle.beforeGetPolicyBundleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPolicyBundle.afterGetPolicyBundleCounter, 1)
+
+	mmGetPolicyBundle.t.Helper()
+
+	if mmGetPolicyBundle.inspectFuncGetPolicyBundle != nil {
+		mmGetPolicyBundle.inspectFuncGetPolicyBundle(ctx, version)
+	}
+
+	mm_params := PolicyBundleRepositoryMockGetPolicyBundleParams{ctx, version}
+
+	// Record call args
+	mmGetPolicyBundle.GetPolicyBundleMock.mutex.Lock()
+	mmGetPolicyBundle.GetPolicyBundleMock.callArgs = append(mmGetPolicyBundle.GetPolicyBundleMock.callArgs, &mm_params)
+	mmGetPolicyBundle.GetPolicyBundleMock.mutex.Unlock()
+
+	for _, e := range mmGetPolicyBundle.GetPolicyBundleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyBundleRepositoryMockGetPolicyBundleParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPolicyBundle.t.Errorf("PolicyBundleRepositoryMock.GetPolicyBundle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetPolicyBundle.t.Errorf("PolicyBundleRepositoryMock.GetPolicyBundle got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPolicyBundle.t.Errorf("PolicyBundleRepositoryMock.GetPolicyBundle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPolicyBundle.GetPolicyBundleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPolicyBundle.t.Fatal("No results are set for the PolicyBundleRepositoryMock.GetPolicyBundle")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetPolicyBundle.funcGetPolicyBundle != nil {
+		return mmGetPolicyBundle.funcGetPolicyBundle(ctx, version)
+	}
+	mmGetPolicyBundle.t.Fatalf("Unexpected call to PolicyBundleRepositoryMock.GetPolicyBundle. %v %v", ctx, version)
+	return
+}
+
+// GetPolicyBundleAfterCounter returns a count of finished PolicyBundleRepositoryMock.GetPolicyBundle invocations
+func (mmGetPolicyBundle *PolicyBundleRepositoryMock) GetPolicyBundleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicyBundle.afterGetPolicyBundleCounter)
+}
+
+// GetPolicyBundleBeforeCounter returns a count of PolicyBundleRepositoryMock.GetPolicyBundle invocations
+func (mmGetPolicyBundle *PolicyBundleRepositoryMock) GetPolicyBundleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicyBundle.beforeGetPolicyBundleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyBundleRepositoryMock.GetPolicyBundle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPolicyBundle *mPolicyBundleRepositoryMockGetPolicyBundle) Calls() []*PolicyBundleRepositoryMockGetPolicyBundleParams {
+	mmGetPolicyBundle.mutex.RLock()
+
+	argCopy := make([]*PolicyBundleRepositoryMockGetPolicyBundleParams, len(mmGetPolicyBundle.callArgs))
+	copy(argCopy, mmGetPolicyBundle.callArgs)
+
+	mmGetPolicyBundle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPolicyBundleDone returns true if the count of the GetPolicyBundle invocations corresponds
+// the number of defined expectations
+func (m *PolicyBundleRepositoryMock) MinimockGetPolicyBundleDone() bool {
+	if m.GetPolicyBundleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPolicyBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPolicyBundleMock.invocationsDone()
+}
+
+// MinimockGetPolicyBundleInspect logs each unmet expectation
+func (m *PolicyBundleRepositoryMock) MinimockGetPolicyBundleInspect() {
+	for _, e := range m.GetPolicyBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.GetPolicyBundle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPolicyBundleCounter := mm_atomic.LoadUint64(&m.afterGetPolicyBundleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicyBundleMock.defaultExpectation != nil && afterGetPolicyBundleCounter < 1 {
+		if m.GetPolicyBundleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.GetPolicyBundle at\n%s", m.GetPolicyBundleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.GetPolicyBundle at\n%s with params: %#v", m.GetPolicyBundleMock.defaultExpectation.expectationOrigins.origin, *m.GetPolicyBundleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicyBundle != nil && afterGetPolicyBundleCounter < 1 {
+		m.t.Errorf("Expected call to PolicyBundleRepositoryMock.GetPolicyBundle at\n%s", m.funcGetPolicyBundleOrigin)
+	}
+
+	if !m.GetPolicyBundleMock.invocationsDone() && afterGetPolicyBundleCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyBundleRepositoryMock.GetPolicyBundle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPolicyBundleMock.expectedInvocations), m.GetPolicyBundleMock.expectedInvocationsOrigin, afterGetPolicyBundleCounter)
+	}
+}
+
+type mPolicyBundleRepositoryMockListPolicyBundles struct {
+	optional           bool
+	mock               *PolicyBundleRepositoryMock
+	defaultExpectation *PolicyBundleRepositoryMockListPolicyBundlesExpectation
+	expectations       []*PolicyBundleRepositoryMockListPolicyBundlesExpectation
+
+	callArgs []*PolicyBundleRepositoryMockListPolicyBundlesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyBundleRepositoryMockListPolicyBundlesExpectation specifies expectation struct of the PolicyBundleRepository.ListPolicyBundles
+type PolicyBundleRepositoryMockListPolicyBundlesExpectation struct {
+	mock               *PolicyBundleRepositoryMock
+	params             *PolicyBundleRepositoryMockListPolicyBundlesParams
+	paramPtrs          *PolicyBundleRepositoryMockListPolicyBundlesParamPtrs
+	expectationOrigins PolicyBundleRepositoryMockListPolicyBundlesExpectationOrigins
+	results            *PolicyBundleRepositoryMockListPolicyBundlesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyBundleRepositoryMockListPolicyBundlesParams contains parameters of the PolicyBundleRepository.ListPolicyBundles
+type PolicyBundleRepositoryMockListPolicyBundlesParams struct {
+	ctx context.Context
+}
+
+// PolicyBundleRepositoryMockListPolicyBundlesParamPtrs contains pointers to parameters of the PolicyBundleRepository.ListPolicyBundles
+type PolicyBundleRepositoryMockListPolicyBundlesParamPtrs struct {
+	ctx *context.Context
+}
+
+// PolicyBundleRepositoryMockListPolicyBundlesResults contains results of the PolicyBundleRepository.ListPolicyBundles
+type PolicyBundleRepositoryMockListPolicyBundlesResults struct {
+	ppa1 []*model.PolicyBundleVersion
+	err  error
+}
+
+// PolicyBundleRepositoryMockListPolicyBundlesOrigins contains origins of expectations of the PolicyBundleRepository.ListPolicyBundles
+type PolicyBundleRepositoryMockListPolicyBundlesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Optional() *mPolicyBundleRepositoryMockListPolicyBundles {
+	mmListPolicyBundles.optional = true
+	return mmListPolicyBundles
+}
+
+// Expect sets up expected params for PolicyBundleRepository.ListPolicyBundles
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Expect(ctx context.Context) *mPolicyBundleRepositoryMockListPolicyBundles {
+	if mmListPolicyBundles.mock.funcListPolicyBundles != nil {
+		mmListPolicyBundles.mock.t.Fatalf("PolicyBundleRepositoryMock.ListPolicyBundles mock is already set by Set")
+	}
+
+	if mmListPolicyBundles.defaultExpectation == nil {
+		mmListPolicyBundles.defaultExpectation = &PolicyBundleRepositoryMockListPolicyBundlesExpectation{}
+	}
+
+	if mmListPolicyBundles.defaultExpectation.paramPtrs != nil {
+		mmListPolicyBundles.mock.t.Fatalf("PolicyBundleRepositoryMock.ListPolicyBundles mock is already set by ExpectParams functions")
+	}
+
+	mmListPolicyBundles.defaultExpectation.params = &PolicyBundleRepositoryMockListPolicyBundlesParams{ctx}
+	mmListPolicyBundles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPolicyBundles.expectations {
+		if minimock.Equal(e.params, mmListPolicyBundles.defaultExpectation.params) {
+			mmListPolicyBundles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPolicyBundles.defaultExpectation.params)
+		}
+	}
+
+	return mmListPolicyBundles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyBundleRepository.ListPolicyBundles
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) ExpectCtxParam1(ctx context.Context) *mPolicyBundleRepositoryMockListPolicyBundles {
+	if mmListPolicyBundles.mock.funcListPolicyBundles != nil {
+		mmListPolicyBundles.mock.t.Fatalf("PolicyBundleRepositoryMock.ListPolicyBundles mock is already set by Set")
+	}
+
+	if mmListPolicyBundles.defaultExpectation == nil {
+		mmListPolicyBundles.defaultExpectation = &PolicyBundleRepositoryMockListPolicyBundlesExpectation{}
+	}
+
+	if mmListPolicyBundles.defaultExpectation.params != nil {
+		mmListPolicyBundles.mock.t.Fatalf("PolicyBundleRepositoryMock.ListPolicyBundles mock is already set by Expect")
+	}
+
+	if mmListPolicyBundles.defaultExpectation.paramPtrs == nil {
+		mmListPolicyBundles.defaultExpectation.paramPtrs = &PolicyBundleRepositoryMockListPolicyBundlesParamPtrs{}
+	}
+	mmListPolicyBundles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPolicyBundles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPolicyBundles
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyBundleRepository.ListPolicyBundles
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Inspect(f func(ctx context.Context)) *mPolicyBundleRepositoryMockListPolicyBundles {
+	if mmListPolicyBundles.mock.inspectFuncListPolicyBundles != nil {
+		mmListPolicyBundles.mock.t.Fatalf("Inspect function is already set for PolicyBundleRepositoryMock.ListPolicyBundles")
+	}
+
+	mmListPolicyBundles.mock.inspectFuncListPolicyBundles = f
+
+	return mmListPolicyBundles
+}
+
+// Return sets up results that will be returned by PolicyBundleRepository.ListPolicyBundles
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Return(ppa1 []*model.PolicyBundleVersion, err error) *PolicyBundleRepositoryMock {
+	if mmListPolicyBundles.mock.funcListPolicyBundles != nil {
+		mmListPolicyBundles.mock.t.Fatalf("PolicyBundleRepositoryMock.ListPolicyBundles mock is already set by Set")
+	}
+
+	if mmListPolicyBundles.defaultExpectation == nil {
+		mmListPolicyBundles.defaultExpectation = &PolicyBundleRepositoryMockListPolicyBundlesExpectation{mock: mmListPolicyBundles.mock}
+	}
+	mmListPolicyBundles.defaultExpectation.results = &PolicyBundleRepositoryMockListPolicyBundlesResults{ppa1, err}
+	mmListPolicyBundles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPolicyBundles.mock
+}
+
+// Set uses given function f to mock the PolicyBundleRepository.ListPolicyBundles method
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Set(f func(ctx context.Context) (ppa1 []*model.PolicyBundleVersion, err error)) *PolicyBundleRepositoryMock {
+	if mmListPolicyBundles.defaultExpectation != nil {
+		mmListPolicyBundles.mock.t.Fatalf("Default expectation is already set for the PolicyBundleRepository.ListPolicyBundles method")
+	}
+
+	if len(mmListPolicyBundles.expectations) > 0 {
+		mmListPolicyBundles.mock.t.Fatalf("Some expectations are already set for the PolicyBundleRepository.ListPolicyBundles method")
+	}
+
+	mmListPolicyBundles.mock.funcListPolicyBundles = f
+	mmListPolicyBundles.mock.funcListPolicyBundlesOrigin = minimock.CallerInfo(1)
+	return mmListPolicyBundles.mock
+}
+
+// When sets expectation for the PolicyBundleRepository.ListPolicyBundles which will trigger the result defined by the following
+// Then helper
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) When(ctx context.Context) *PolicyBundleRepositoryMockListPolicyBundlesExpectation {
+	if mmListPolicyBundles.mock.funcListPolicyBundles != nil {
+		mmListPolicyBundles.mock.t.Fatalf("PolicyBundleRepositoryMock.ListPolicyBundles mock is already set by Set")
+	}
+
+	expectation := &PolicyBundleRepositoryMockListPolicyBundlesExpectation{
+		mock:               mmListPolicyBundles.mock,
+		params:             &PolicyBundleRepositoryMockListPolicyBundlesParams{ctx},
+		expectationOrigins: PolicyBundleRepositoryMockListPolicyBundlesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPolicyBundles.expectations = append(mmListPolicyBundles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyBundleRepository.ListPolicyBundles return parameters for the expectation previously defined by the When method
+func (e *PolicyBundleRepositoryMockListPolicyBundlesExpectation) Then(ppa1 []*model.PolicyBundleVersion, err error) *PolicyBundleRepositoryMock {
+	e.results = &PolicyBundleRepositoryMockListPolicyBundlesResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyBundleRepository.ListPolicyBundles should be invoked
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Times(n uint64) *mPolicyBundleRepositoryMockListPolicyBundles {
+	if n == 0 {
+		mmListPolicyBundles.mock.t.Fatalf("Times of PolicyBundleRepositoryMock.ListPolicyBundles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPolicyBundles.expectedInvocations, n)
+	mmListPolicyBundles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPolicyBundles
+}
+
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) invocationsDone() bool {
+	if len(mmListPolicyBundles.expectations) == 0 && mmListPolicyBundles.defaultExpectation == nil && mmListPolicyBundles.mock.funcListPolicyBundles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPolicyBundles.mock.afterListPolicyBundlesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPolicyBundles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPolicyBundles implements mm_repository.PolicyBundleRepository
+func (mmListPolicyBundles *PolicyBundleRepositoryMock) ListPolicyBundles(ctx context.Context) (ppa1 []*model.PolicyBundleVersion, err error) {
+	mm_atomic.AddUint64(&mmListPolicyBundles.beforeListPolicyBundlesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPolicyBundles.afterListPolicyBundlesCounter, 1)
+
+	mmListPolicyBundles.t.Helper()
+
+	if mmListPolicyBundles.inspectFuncListPolicyBundles != nil {
+		mmListPolicyBundles.inspectFuncListPolicyBundles(ctx)
+	}
+
+	mm_params := PolicyBundleRepositoryMockListPolicyBundlesParams{ctx}
+
+	// Record call args
+	mmListPolicyBundles.ListPolicyBundlesMock.mutex.Lock()
+	mmListPolicyBundles.ListPolicyBundlesMock.callArgs = append(mmListPolicyBundles.ListPolicyBundlesMock.callArgs, &mm_params)
+	mmListPolicyBundles.ListPolicyBundlesMock.mutex.Unlock()
+
+	for _, e := range mmListPolicyBundles.ListPolicyBundlesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyBundleRepositoryMockListPolicyBundlesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPolicyBundles.t.Errorf("PolicyBundleRepositoryMock.ListPolicyBundles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPolicyBundles.t.Errorf("PolicyBundleRepositoryMock.ListPolicyBundles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPolicyBundles.ListPolicyBundlesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPolicyBundles.t.Fatal("No results are set for the PolicyBundleRepositoryMock.ListPolicyBundles")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPolicyBundles.funcListPolicyBundles != nil {
+		return mmListPolicyBundles.funcListPolicyBundles(ctx)
+	}
+	mmListPolicyBundles.t.Fatalf("Unexpected call to PolicyBundleRepositoryMock.ListPolicyBundles. %v", ctx)
+	return
+}
+
+// ListPolicyBundlesAfterCounter returns a count of finished PolicyBundleRepositoryMock.ListPolicyBundles invocations
+func (mmListPolicyBundles *PolicyBundleRepositoryMock) ListPolicyBundlesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPolicyBundles.afterListPolicyBundlesCounter)
+}
+
+// ListPolicyBundlesBeforeCounter returns a count of PolicyBundleRepositoryMock.ListPolicyBundles invocations
+func (mmListPolicyBundles *PolicyBundleRepositoryMock) ListPolicyBundlesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPolicyBundles.beforeListPolicyBundlesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyBundleRepositoryMock.ListPolicyBundles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPolicyBundles *mPolicyBundleRepositoryMockListPolicyBundles) Calls() []*PolicyBundleRepositoryMockListPolicyBundlesParams {
+	mmListPolicyBundles.mutex.RLock()
+
+	argCopy := make([]*PolicyBundleRepositoryMockListPolicyBundlesParams, len(mmListPolicyBundles.callArgs))
+	copy(argCopy, mmListPolicyBundles.callArgs)
+
+	mmListPolicyBundles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPolicyBundlesDone returns true if the count of the ListPolicyBundles invocations corresponds
+// the number of defined expectations
+func (m *PolicyBundleRepositoryMock) MinimockListPolicyBundlesDone() bool {
+	if m.ListPolicyBundlesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPolicyBundlesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPolicyBundlesMock.invocationsDone()
+}
+
+// MinimockListPolicyBundlesInspect logs each unmet expectation
+func (m *PolicyBundleRepositoryMock) MinimockListPolicyBundlesInspect() {
+	for _, e := range m.ListPolicyBundlesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.ListPolicyBundles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPolicyBundlesCounter := mm_atomic.LoadUint64(&m.afterListPolicyBundlesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPolicyBundlesMock.defaultExpectation != nil && afterListPolicyBundlesCounter < 1 {
+		if m.ListPolicyBundlesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.ListPolicyBundles at\n%s", m.ListPolicyBundlesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyBundleRepositoryMock.ListPolicyBundles at\n%s with params: %#v", m.ListPolicyBundlesMock.defaultExpectation.expectationOrigins.origin, *m.ListPolicyBundlesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPolicyBundles != nil && afterListPolicyBundlesCounter < 1 {
+		m.t.Errorf("Expected call to PolicyBundleRepositoryMock.ListPolicyBundles at\n%s", m.funcListPolicyBundlesOrigin)
+	}
+
+	if !m.ListPolicyBundlesMock.invocationsDone() && afterListPolicyBundlesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyBundleRepositoryMock.ListPolicyBundles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPolicyBundlesMock.expectedInvocations), m.ListPolicyBundlesMock.expectedInvocationsOrigin, afterListPolicyBundlesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PolicyBundleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInitialPolicyBundleInspect()
+
+			m.MinimockCreatePolicyBundleInspect()
+
+			m.MinimockGetPolicyBundleInspect()
+
+			m.MinimockListPolicyBundlesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PolicyBundleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PolicyBundleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateInitialPolicyBundleDone() &&
+		m.MinimockCreatePolicyBundleDone() &&
+		m.MinimockGetPolicyBundleDone() &&
+		m.MinimockListPolicyBundlesDone()
+}
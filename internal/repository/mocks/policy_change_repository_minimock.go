@@ -0,0 +1,804 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.PolicyChangeRepository -o policy_change_repository_minimock.go -n PolicyChangeRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// PolicyChangeRepositoryMock implements mm_repository.PolicyChangeRepository
+type PolicyChangeRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreatePolicyChange          func(ctx context.Context, change *model.PolicyChange) (i1 int64, err error)
+	funcCreatePolicyChangeOrigin    string
+	inspectFuncCreatePolicyChange   func(ctx context.Context, change *model.PolicyChange)
+	afterCreatePolicyChangeCounter  uint64
+	beforeCreatePolicyChangeCounter uint64
+	CreatePolicyChangeMock          mPolicyChangeRepositoryMockCreatePolicyChange
+
+	funcPseudonymizeActor          func(ctx context.Context, username string, pseudonym string) (err error)
+	funcPseudonymizeActorOrigin    string
+	inspectFuncPseudonymizeActor   func(ctx context.Context, username string, pseudonym string)
+	afterPseudonymizeActorCounter  uint64
+	beforePseudonymizeActorCounter uint64
+	PseudonymizeActorMock          mPolicyChangeRepositoryMockPseudonymizeActor
+}
+
+// NewPolicyChangeRepositoryMock returns a mock for mm_repository.PolicyChangeRepository
+func NewPolicyChangeRepositoryMock(t minimock.Tester) *PolicyChangeRepositoryMock {
+	m := &PolicyChangeRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreatePolicyChangeMock = mPolicyChangeRepositoryMockCreatePolicyChange{mock: m}
+	m.CreatePolicyChangeMock.callArgs = []*PolicyChangeRepositoryMockCreatePolicyChangeParams{}
+
+	m.PseudonymizeActorMock = mPolicyChangeRepositoryMockPseudonymizeActor{mock: m}
+	m.PseudonymizeActorMock.callArgs = []*PolicyChangeRepositoryMockPseudonymizeActorParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPolicyChangeRepositoryMockCreatePolicyChange struct {
+	optional           bool
+	mock               *PolicyChangeRepositoryMock
+	defaultExpectation *PolicyChangeRepositoryMockCreatePolicyChangeExpectation
+	expectations       []*PolicyChangeRepositoryMockCreatePolicyChangeExpectation
+
+	callArgs []*PolicyChangeRepositoryMockCreatePolicyChangeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyChangeRepositoryMockCreatePolicyChangeExpectation specifies expectation struct of the PolicyChangeRepository.CreatePolicyChange
+type PolicyChangeRepositoryMockCreatePolicyChangeExpectation struct {
+	mock               *PolicyChangeRepositoryMock
+	params             *PolicyChangeRepositoryMockCreatePolicyChangeParams
+	paramPtrs          *PolicyChangeRepositoryMockCreatePolicyChangeParamPtrs
+	expectationOrigins PolicyChangeRepositoryMockCreatePolicyChangeExpectationOrigins
+	results            *PolicyChangeRepositoryMockCreatePolicyChangeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyChangeRepositoryMockCreatePolicyChangeParams contains parameters of the PolicyChangeRepository.CreatePolicyChange
+type PolicyChangeRepositoryMockCreatePolicyChangeParams struct {
+	ctx    context.Context
+	change *model.PolicyChange
+}
+
+// PolicyChangeRepositoryMockCreatePolicyChangeParamPtrs contains pointers to parameters of the PolicyChangeRepository.CreatePolicyChange
+type PolicyChangeRepositoryMockCreatePolicyChangeParamPtrs struct {
+	ctx    *context.Context
+	change **model.PolicyChange
+}
+
+// PolicyChangeRepositoryMockCreatePolicyChangeResults contains results of the PolicyChangeRepository.CreatePolicyChange
+type PolicyChangeRepositoryMockCreatePolicyChangeResults struct {
+	i1  int64
+	err error
+}
+
+// PolicyChangeRepositoryMockCreatePolicyChangeOrigins contains origins of expectations of the PolicyChangeRepository.CreatePolicyChange
+type PolicyChangeRepositoryMockCreatePolicyChangeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChange string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Optional() *mPolicyChangeRepositoryMockCreatePolicyChange {
+	mmCreatePolicyChange.optional = true
+	return mmCreatePolicyChange
+}
+
+// Expect sets up expected params for PolicyChangeRepository.CreatePolicyChange
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Expect(ctx context.Context, change *model.PolicyChange) *mPolicyChangeRepositoryMockCreatePolicyChange {
+	if mmCreatePolicyChange.mock.funcCreatePolicyChange != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Set")
+	}
+
+	if mmCreatePolicyChange.defaultExpectation == nil {
+		mmCreatePolicyChange.defaultExpectation = &PolicyChangeRepositoryMockCreatePolicyChangeExpectation{}
+	}
+
+	if mmCreatePolicyChange.defaultExpectation.paramPtrs != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePolicyChange.defaultExpectation.params = &PolicyChangeRepositoryMockCreatePolicyChangeParams{ctx, change}
+	mmCreatePolicyChange.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePolicyChange.expectations {
+		if minimock.Equal(e.params, mmCreatePolicyChange.defaultExpectation.params) {
+			mmCreatePolicyChange.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePolicyChange.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePolicyChange
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyChangeRepository.CreatePolicyChange
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) ExpectCtxParam1(ctx context.Context) *mPolicyChangeRepositoryMockCreatePolicyChange {
+	if mmCreatePolicyChange.mock.funcCreatePolicyChange != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Set")
+	}
+
+	if mmCreatePolicyChange.defaultExpectation == nil {
+		mmCreatePolicyChange.defaultExpectation = &PolicyChangeRepositoryMockCreatePolicyChangeExpectation{}
+	}
+
+	if mmCreatePolicyChange.defaultExpectation.params != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Expect")
+	}
+
+	if mmCreatePolicyChange.defaultExpectation.paramPtrs == nil {
+		mmCreatePolicyChange.defaultExpectation.paramPtrs = &PolicyChangeRepositoryMockCreatePolicyChangeParamPtrs{}
+	}
+	mmCreatePolicyChange.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePolicyChange.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePolicyChange
+}
+
+// ExpectChangeParam2 sets up expected param change for PolicyChangeRepository.CreatePolicyChange
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) ExpectChangeParam2(change *model.PolicyChange) *mPolicyChangeRepositoryMockCreatePolicyChange {
+	if mmCreatePolicyChange.mock.funcCreatePolicyChange != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Set")
+	}
+
+	if mmCreatePolicyChange.defaultExpectation == nil {
+		mmCreatePolicyChange.defaultExpectation = &PolicyChangeRepositoryMockCreatePolicyChangeExpectation{}
+	}
+
+	if mmCreatePolicyChange.defaultExpectation.params != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Expect")
+	}
+
+	if mmCreatePolicyChange.defaultExpectation.paramPtrs == nil {
+		mmCreatePolicyChange.defaultExpectation.paramPtrs = &PolicyChangeRepositoryMockCreatePolicyChangeParamPtrs{}
+	}
+	mmCreatePolicyChange.defaultExpectation.paramPtrs.change = &change
+	mmCreatePolicyChange.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
+
+	return mmCreatePolicyChange
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyChangeRepository.CreatePolicyChange
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Inspect(f func(ctx context.Context, change *model.PolicyChange)) *mPolicyChangeRepositoryMockCreatePolicyChange {
+	if mmCreatePolicyChange.mock.inspectFuncCreatePolicyChange != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("Inspect function is already set for PolicyChangeRepositoryMock.CreatePolicyChange")
+	}
+
+	mmCreatePolicyChange.mock.inspectFuncCreatePolicyChange = f
+
+	return mmCreatePolicyChange
+}
+
+// Return sets up results that will be returned by PolicyChangeRepository.CreatePolicyChange
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Return(i1 int64, err error) *PolicyChangeRepositoryMock {
+	if mmCreatePolicyChange.mock.funcCreatePolicyChange != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Set")
+	}
+
+	if mmCreatePolicyChange.defaultExpectation == nil {
+		mmCreatePolicyChange.defaultExpectation = &PolicyChangeRepositoryMockCreatePolicyChangeExpectation{mock: mmCreatePolicyChange.mock}
+	}
+	mmCreatePolicyChange.defaultExpectation.results = &PolicyChangeRepositoryMockCreatePolicyChangeResults{i1, err}
+	mmCreatePolicyChange.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePolicyChange.mock
+}
+
+// Set uses given function f to mock the PolicyChangeRepository.CreatePolicyChange method
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Set(f func(ctx context.Context, change *model.PolicyChange) (i1 int64, err error)) *PolicyChangeRepositoryMock {
+	if mmCreatePolicyChange.defaultExpectation != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("Default expectation is already set for the PolicyChangeRepository.CreatePolicyChange method")
+	}
+
+	if len(mmCreatePolicyChange.expectations) > 0 {
+		mmCreatePolicyChange.mock.t.Fatalf("Some expectations are already set for the PolicyChangeRepository.CreatePolicyChange method")
+	}
+
+	mmCreatePolicyChange.mock.funcCreatePolicyChange = f
+	mmCreatePolicyChange.mock.funcCreatePolicyChangeOrigin = minimock.CallerInfo(1)
+	return mmCreatePolicyChange.mock
+}
+
+// When sets expectation for the PolicyChangeRepository.CreatePolicyChange which will trigger the result defined by the following
+// Then helper
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) When(ctx context.Context, change *model.PolicyChange) *PolicyChangeRepositoryMockCreatePolicyChangeExpectation {
+	if mmCreatePolicyChange.mock.funcCreatePolicyChange != nil {
+		mmCreatePolicyChange.mock.t.Fatalf("PolicyChangeRepositoryMock.CreatePolicyChange mock is already set by Set")
+	}
+
+	expectation := &PolicyChangeRepositoryMockCreatePolicyChangeExpectation{
+		mock:               mmCreatePolicyChange.mock,
+		params:             &PolicyChangeRepositoryMockCreatePolicyChangeParams{ctx, change},
+		expectationOrigins: PolicyChangeRepositoryMockCreatePolicyChangeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePolicyChange.expectations = append(mmCreatePolicyChange.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyChangeRepository.CreatePolicyChange return parameters for the expectation previously defined by the When method
+func (e *PolicyChangeRepositoryMockCreatePolicyChangeExpectation) Then(i1 int64, err error) *PolicyChangeRepositoryMock {
+	e.results = &PolicyChangeRepositoryMockCreatePolicyChangeResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyChangeRepository.CreatePolicyChange should be invoked
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Times(n uint64) *mPolicyChangeRepositoryMockCreatePolicyChange {
+	if n == 0 {
+		mmCreatePolicyChange.mock.t.Fatalf("Times of PolicyChangeRepositoryMock.CreatePolicyChange mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePolicyChange.expectedInvocations, n)
+	mmCreatePolicyChange.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePolicyChange
+}
+
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) invocationsDone() bool {
+	if len(mmCreatePolicyChange.expectations) == 0 && mmCreatePolicyChange.defaultExpectation == nil && mmCreatePolicyChange.mock.funcCreatePolicyChange == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePolicyChange.mock.afterCreatePolicyChangeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePolicyChange.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePolicyChange implements mm_repository.PolicyChangeRepository
+func (mmCreatePolicyChange *PolicyChangeRepositoryMock) CreatePolicyChange(ctx context.Context, change *model.PolicyChange) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreatePolicyChange.beforeCreatePolicyChangeCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePolicyChange.afterCreatePolicyChangeCounter, 1)
+
+	mmCreatePolicyChange.t.Helper()
+
+	if mmCreatePolicyChange.inspectFuncCreatePolicyChange != nil {
+		mmCreatePolicyChange.inspectFuncCreatePolicyChange(ctx, change)
+	}
+
+	mm_params := PolicyChangeRepositoryMockCreatePolicyChangeParams{ctx, change}
+
+	// Record call args
+	mmCreatePolicyChange.CreatePolicyChangeMock.mutex.Lock()
+	mmCreatePolicyChange.CreatePolicyChangeMock.callArgs = append(mmCreatePolicyChange.CreatePolicyChangeMock.callArgs, &mm_params)
+	mmCreatePolicyChange.CreatePolicyChangeMock.mutex.Unlock()
+
+	for _, e := range mmCreatePolicyChange.CreatePolicyChangeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyChangeRepositoryMockCreatePolicyChangeParams{ctx, change}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePolicyChange.t.Errorf("PolicyChangeRepositoryMock.CreatePolicyChange got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmCreatePolicyChange.t.Errorf("PolicyChangeRepositoryMock.CreatePolicyChange got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePolicyChange.t.Errorf("PolicyChangeRepositoryMock.CreatePolicyChange got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePolicyChange.CreatePolicyChangeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePolicyChange.t.Fatal("No results are set for the PolicyChangeRepositoryMock.CreatePolicyChange")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreatePolicyChange.funcCreatePolicyChange != nil {
+		return mmCreatePolicyChange.funcCreatePolicyChange(ctx, change)
+	}
+	mmCreatePolicyChange.t.Fatalf("Unexpected call to PolicyChangeRepositoryMock.CreatePolicyChange. %v %v", ctx, change)
+	return
+}
+
+// CreatePolicyChangeAfterCounter returns a count of finished PolicyChangeRepositoryMock.CreatePolicyChange invocations
+func (mmCreatePolicyChange *PolicyChangeRepositoryMock) CreatePolicyChangeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePolicyChange.afterCreatePolicyChangeCounter)
+}
+
+// CreatePolicyChangeBeforeCounter returns a count of PolicyChangeRepositoryMock.CreatePolicyChange invocations
+func (mmCreatePolicyChange *PolicyChangeRepositoryMock) CreatePolicyChangeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePolicyChange.beforeCreatePolicyChangeCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyChangeRepositoryMock.CreatePolicyChange.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePolicyChange *mPolicyChangeRepositoryMockCreatePolicyChange) Calls() []*PolicyChangeRepositoryMockCreatePolicyChangeParams {
+	mmCreatePolicyChange.mutex.RLock()
+
+	argCopy := make([]*PolicyChangeRepositoryMockCreatePolicyChangeParams, len(mmCreatePolicyChange.callArgs))
+	copy(argCopy, mmCreatePolicyChange.callArgs)
+
+	mmCreatePolicyChange.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePolicyChangeDone returns true if the count of the CreatePolicyChange invocations corresponds
+// the number of defined expectations
+func (m *PolicyChangeRepositoryMock) MinimockCreatePolicyChangeDone() bool {
+	if m.CreatePolicyChangeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePolicyChangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePolicyChangeMock.invocationsDone()
+}
+
+// MinimockCreatePolicyChangeInspect logs each unmet expectation
+func (m *PolicyChangeRepositoryMock) MinimockCreatePolicyChangeInspect() {
+	for _, e := range m.CreatePolicyChangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyChangeRepositoryMock.CreatePolicyChange at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePolicyChangeCounter := mm_atomic.LoadUint64(&m.afterCreatePolicyChangeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePolicyChangeMock.defaultExpectation != nil && afterCreatePolicyChangeCounter < 1 {
+		if m.CreatePolicyChangeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyChangeRepositoryMock.CreatePolicyChange at\n%s", m.CreatePolicyChangeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyChangeRepositoryMock.CreatePolicyChange at\n%s with params: %#v", m.CreatePolicyChangeMock.defaultExpectation.expectationOrigins.origin, *m.CreatePolicyChangeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePolicyChange != nil && afterCreatePolicyChangeCounter < 1 {
+		m.t.Errorf("Expected call to PolicyChangeRepositoryMock.CreatePolicyChange at\n%s", m.funcCreatePolicyChangeOrigin)
+	}
+
+	if !m.CreatePolicyChangeMock.invocationsDone() && afterCreatePolicyChangeCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyChangeRepositoryMock.CreatePolicyChange at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePolicyChangeMock.expectedInvocations), m.CreatePolicyChangeMock.expectedInvocationsOrigin, afterCreatePolicyChangeCounter)
+	}
+}
+
+type mPolicyChangeRepositoryMockPseudonymizeActor struct {
+	optional           bool
+	mock               *PolicyChangeRepositoryMock
+	defaultExpectation *PolicyChangeRepositoryMockPseudonymizeActorExpectation
+	expectations       []*PolicyChangeRepositoryMockPseudonymizeActorExpectation
+
+	callArgs []*PolicyChangeRepositoryMockPseudonymizeActorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyChangeRepositoryMockPseudonymizeActorExpectation specifies expectation struct of the PolicyChangeRepository.PseudonymizeActor
+type PolicyChangeRepositoryMockPseudonymizeActorExpectation struct {
+	mock               *PolicyChangeRepositoryMock
+	params             *PolicyChangeRepositoryMockPseudonymizeActorParams
+	paramPtrs          *PolicyChangeRepositoryMockPseudonymizeActorParamPtrs
+	expectationOrigins PolicyChangeRepositoryMockPseudonymizeActorExpectationOrigins
+	results            *PolicyChangeRepositoryMockPseudonymizeActorResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyChangeRepositoryMockPseudonymizeActorParams contains parameters of the PolicyChangeRepository.PseudonymizeActor
+type PolicyChangeRepositoryMockPseudonymizeActorParams struct {
+	ctx       context.Context
+	username  string
+	pseudonym string
+}
+
+// PolicyChangeRepositoryMockPseudonymizeActorParamPtrs contains pointers to parameters of the PolicyChangeRepository.PseudonymizeActor
+type PolicyChangeRepositoryMockPseudonymizeActorParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	pseudonym *string
+}
+
+// PolicyChangeRepositoryMockPseudonymizeActorResults contains results of the PolicyChangeRepository.PseudonymizeActor
+type PolicyChangeRepositoryMockPseudonymizeActorResults struct {
+	err error
+}
+
+// PolicyChangeRepositoryMockPseudonymizeActorOrigins contains origins of expectations of the PolicyChangeRepository.PseudonymizeActor
+type PolicyChangeRepositoryMockPseudonymizeActorExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originPseudonym string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Optional() *mPolicyChangeRepositoryMockPseudonymizeActor {
+	mmPseudonymizeActor.optional = true
+	return mmPseudonymizeActor
+}
+
+// Expect sets up expected params for PolicyChangeRepository.PseudonymizeActor
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Expect(ctx context.Context, username string, pseudonym string) *mPolicyChangeRepositoryMockPseudonymizeActor {
+	if mmPseudonymizeActor.mock.funcPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Set")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation == nil {
+		mmPseudonymizeActor.defaultExpectation = &PolicyChangeRepositoryMockPseudonymizeActorExpectation{}
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.paramPtrs != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by ExpectParams functions")
+	}
+
+	mmPseudonymizeActor.defaultExpectation.params = &PolicyChangeRepositoryMockPseudonymizeActorParams{ctx, username, pseudonym}
+	mmPseudonymizeActor.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPseudonymizeActor.expectations {
+		if minimock.Equal(e.params, mmPseudonymizeActor.defaultExpectation.params) {
+			mmPseudonymizeActor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPseudonymizeActor.defaultExpectation.params)
+		}
+	}
+
+	return mmPseudonymizeActor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyChangeRepository.PseudonymizeActor
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) ExpectCtxParam1(ctx context.Context) *mPolicyChangeRepositoryMockPseudonymizeActor {
+	if mmPseudonymizeActor.mock.funcPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Set")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation == nil {
+		mmPseudonymizeActor.defaultExpectation = &PolicyChangeRepositoryMockPseudonymizeActorExpectation{}
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.params != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Expect")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeActor.defaultExpectation.paramPtrs = &PolicyChangeRepositoryMockPseudonymizeActorParamPtrs{}
+	}
+	mmPseudonymizeActor.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPseudonymizeActor.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPseudonymizeActor
+}
+
+// ExpectUsernameParam2 sets up expected param username for PolicyChangeRepository.PseudonymizeActor
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) ExpectUsernameParam2(username string) *mPolicyChangeRepositoryMockPseudonymizeActor {
+	if mmPseudonymizeActor.mock.funcPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Set")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation == nil {
+		mmPseudonymizeActor.defaultExpectation = &PolicyChangeRepositoryMockPseudonymizeActorExpectation{}
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.params != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Expect")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeActor.defaultExpectation.paramPtrs = &PolicyChangeRepositoryMockPseudonymizeActorParamPtrs{}
+	}
+	mmPseudonymizeActor.defaultExpectation.paramPtrs.username = &username
+	mmPseudonymizeActor.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmPseudonymizeActor
+}
+
+// ExpectPseudonymParam3 sets up expected param pseudonym for PolicyChangeRepository.PseudonymizeActor
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) ExpectPseudonymParam3(pseudonym string) *mPolicyChangeRepositoryMockPseudonymizeActor {
+	if mmPseudonymizeActor.mock.funcPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Set")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation == nil {
+		mmPseudonymizeActor.defaultExpectation = &PolicyChangeRepositoryMockPseudonymizeActorExpectation{}
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.params != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Expect")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeActor.defaultExpectation.paramPtrs = &PolicyChangeRepositoryMockPseudonymizeActorParamPtrs{}
+	}
+	mmPseudonymizeActor.defaultExpectation.paramPtrs.pseudonym = &pseudonym
+	mmPseudonymizeActor.defaultExpectation.expectationOrigins.originPseudonym = minimock.CallerInfo(1)
+
+	return mmPseudonymizeActor
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyChangeRepository.PseudonymizeActor
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Inspect(f func(ctx context.Context, username string, pseudonym string)) *mPolicyChangeRepositoryMockPseudonymizeActor {
+	if mmPseudonymizeActor.mock.inspectFuncPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("Inspect function is already set for PolicyChangeRepositoryMock.PseudonymizeActor")
+	}
+
+	mmPseudonymizeActor.mock.inspectFuncPseudonymizeActor = f
+
+	return mmPseudonymizeActor
+}
+
+// Return sets up results that will be returned by PolicyChangeRepository.PseudonymizeActor
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Return(err error) *PolicyChangeRepositoryMock {
+	if mmPseudonymizeActor.mock.funcPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Set")
+	}
+
+	if mmPseudonymizeActor.defaultExpectation == nil {
+		mmPseudonymizeActor.defaultExpectation = &PolicyChangeRepositoryMockPseudonymizeActorExpectation{mock: mmPseudonymizeActor.mock}
+	}
+	mmPseudonymizeActor.defaultExpectation.results = &PolicyChangeRepositoryMockPseudonymizeActorResults{err}
+	mmPseudonymizeActor.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeActor.mock
+}
+
+// Set uses given function f to mock the PolicyChangeRepository.PseudonymizeActor method
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Set(f func(ctx context.Context, username string, pseudonym string) (err error)) *PolicyChangeRepositoryMock {
+	if mmPseudonymizeActor.defaultExpectation != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("Default expectation is already set for the PolicyChangeRepository.PseudonymizeActor method")
+	}
+
+	if len(mmPseudonymizeActor.expectations) > 0 {
+		mmPseudonymizeActor.mock.t.Fatalf("Some expectations are already set for the PolicyChangeRepository.PseudonymizeActor method")
+	}
+
+	mmPseudonymizeActor.mock.funcPseudonymizeActor = f
+	mmPseudonymizeActor.mock.funcPseudonymizeActorOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeActor.mock
+}
+
+// When sets expectation for the PolicyChangeRepository.PseudonymizeActor which will trigger the result defined by the following
+// Then helper
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) When(ctx context.Context, username string, pseudonym string) *PolicyChangeRepositoryMockPseudonymizeActorExpectation {
+	if mmPseudonymizeActor.mock.funcPseudonymizeActor != nil {
+		mmPseudonymizeActor.mock.t.Fatalf("PolicyChangeRepositoryMock.PseudonymizeActor mock is already set by Set")
+	}
+
+	expectation := &PolicyChangeRepositoryMockPseudonymizeActorExpectation{
+		mock:               mmPseudonymizeActor.mock,
+		params:             &PolicyChangeRepositoryMockPseudonymizeActorParams{ctx, username, pseudonym},
+		expectationOrigins: PolicyChangeRepositoryMockPseudonymizeActorExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPseudonymizeActor.expectations = append(mmPseudonymizeActor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyChangeRepository.PseudonymizeActor return parameters for the expectation previously defined by the When method
+func (e *PolicyChangeRepositoryMockPseudonymizeActorExpectation) Then(err error) *PolicyChangeRepositoryMock {
+	e.results = &PolicyChangeRepositoryMockPseudonymizeActorResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyChangeRepository.PseudonymizeActor should be invoked
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Times(n uint64) *mPolicyChangeRepositoryMockPseudonymizeActor {
+	if n == 0 {
+		mmPseudonymizeActor.mock.t.Fatalf("Times of PolicyChangeRepositoryMock.PseudonymizeActor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPseudonymizeActor.expectedInvocations, n)
+	mmPseudonymizeActor.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeActor
+}
+
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) invocationsDone() bool {
+	if len(mmPseudonymizeActor.expectations) == 0 && mmPseudonymizeActor.defaultExpectation == nil && mmPseudonymizeActor.mock.funcPseudonymizeActor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPseudonymizeActor.mock.afterPseudonymizeActorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPseudonymizeActor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PseudonymizeActor implements mm_repository.PolicyChangeRepository
+func (mmPseudonymizeActor *PolicyChangeRepositoryMock) PseudonymizeActor(ctx context.Context, username string, pseudonym string) (err error) {
+	mm_atomic.AddUint64(&mmPseudonymizeActor.beforePseudonymizeActorCounter, 1)
+	defer mm_atomic.AddUint64(&mmPseudonymizeActor.afterPseudonymizeActorCounter, 1)
+
+	mmPseudonymizeActor.t.Helper()
+
+	if mmPseudonymizeActor.inspectFuncPseudonymizeActor != nil {
+		mmPseudonymizeActor.inspectFuncPseudonymizeActor(ctx, username, pseudonym)
+	}
+
+	mm_params := PolicyChangeRepositoryMockPseudonymizeActorParams{ctx, username, pseudonym}
+
+	// Record call args
+	mmPseudonymizeActor.PseudonymizeActorMock.mutex.Lock()
+	mmPseudonymizeActor.PseudonymizeActorMock.callArgs = append(mmPseudonymizeActor.PseudonymizeActorMock.callArgs, &mm_params)
+	mmPseudonymizeActor.PseudonymizeActorMock.mutex.Unlock()
+
+	for _, e := range mmPseudonymizeActor.PseudonymizeActorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.Counter, 1)
+		mm_want := mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.params
+		mm_want_ptrs := mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyChangeRepositoryMockPseudonymizeActorParams{ctx, username, pseudonym}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPseudonymizeActor.t.Errorf("PolicyChangeRepositoryMock.PseudonymizeActor got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmPseudonymizeActor.t.Errorf("PolicyChangeRepositoryMock.PseudonymizeActor got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.pseudonym != nil && !minimock.Equal(*mm_want_ptrs.pseudonym, mm_got.pseudonym) {
+				mmPseudonymizeActor.t.Errorf("PolicyChangeRepositoryMock.PseudonymizeActor got unexpected parameter pseudonym, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.expectationOrigins.originPseudonym, *mm_want_ptrs.pseudonym, mm_got.pseudonym, minimock.Diff(*mm_want_ptrs.pseudonym, mm_got.pseudonym))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPseudonymizeActor.t.Errorf("PolicyChangeRepositoryMock.PseudonymizeActor got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPseudonymizeActor.PseudonymizeActorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPseudonymizeActor.t.Fatal("No results are set for the PolicyChangeRepositoryMock.PseudonymizeActor")
+		}
+		return (*mm_results).err
+	}
+	if mmPseudonymizeActor.funcPseudonymizeActor != nil {
+		return mmPseudonymizeActor.funcPseudonymizeActor(ctx, username, pseudonym)
+	}
+	mmPseudonymizeActor.t.Fatalf("Unexpected call to PolicyChangeRepositoryMock.PseudonymizeActor. %v %v %v", ctx, username, pseudonym)
+	return
+}
+
+// PseudonymizeActorAfterCounter returns a count of finished PolicyChangeRepositoryMock.PseudonymizeActor invocations
+func (mmPseudonymizeActor *PolicyChangeRepositoryMock) PseudonymizeActorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeActor.afterPseudonymizeActorCounter)
+}
+
+// PseudonymizeActorBeforeCounter returns a count of PolicyChangeRepositoryMock.PseudonymizeActor invocations
+func (mmPseudonymizeActor *PolicyChangeRepositoryMock) PseudonymizeActorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeActor.beforePseudonymizeActorCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyChangeRepositoryMock.PseudonymizeActor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPseudonymizeActor *mPolicyChangeRepositoryMockPseudonymizeActor) Calls() []*PolicyChangeRepositoryMockPseudonymizeActorParams {
+	mmPseudonymizeActor.mutex.RLock()
+
+	argCopy := make([]*PolicyChangeRepositoryMockPseudonymizeActorParams, len(mmPseudonymizeActor.callArgs))
+	copy(argCopy, mmPseudonymizeActor.callArgs)
+
+	mmPseudonymizeActor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPseudonymizeActorDone returns true if the count of the PseudonymizeActor invocations corresponds
+// the number of defined expectations
+func (m *PolicyChangeRepositoryMock) MinimockPseudonymizeActorDone() bool {
+	if m.PseudonymizeActorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PseudonymizeActorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PseudonymizeActorMock.invocationsDone()
+}
+
+// MinimockPseudonymizeActorInspect logs each unmet expectation
+func (m *PolicyChangeRepositoryMock) MinimockPseudonymizeActorInspect() {
+	for _, e := range m.PseudonymizeActorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyChangeRepositoryMock.PseudonymizeActor at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPseudonymizeActorCounter := mm_atomic.LoadUint64(&m.afterPseudonymizeActorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PseudonymizeActorMock.defaultExpectation != nil && afterPseudonymizeActorCounter < 1 {
+		if m.PseudonymizeActorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyChangeRepositoryMock.PseudonymizeActor at\n%s", m.PseudonymizeActorMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyChangeRepositoryMock.PseudonymizeActor at\n%s with params: %#v", m.PseudonymizeActorMock.defaultExpectation.expectationOrigins.origin, *m.PseudonymizeActorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPseudonymizeActor != nil && afterPseudonymizeActorCounter < 1 {
+		m.t.Errorf("Expected call to PolicyChangeRepositoryMock.PseudonymizeActor at\n%s", m.funcPseudonymizeActorOrigin)
+	}
+
+	if !m.PseudonymizeActorMock.invocationsDone() && afterPseudonymizeActorCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyChangeRepositoryMock.PseudonymizeActor at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PseudonymizeActorMock.expectedInvocations), m.PseudonymizeActorMock.expectedInvocationsOrigin, afterPseudonymizeActorCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PolicyChangeRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreatePolicyChangeInspect()
+
+			m.MinimockPseudonymizeActorInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PolicyChangeRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PolicyChangeRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreatePolicyChangeDone() &&
+		m.MinimockPseudonymizeActorDone()
+}
@@ -0,0 +1,712 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.PolicySnapshotRepository -o policy_snapshot_repository_minimock.go -n PolicySnapshotRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// PolicySnapshotRepositoryMock implements mm_repository.PolicySnapshotRepository
+type PolicySnapshotRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPolicySnapshot          func(ctx context.Context) (pp1 *model.PolicySnapshot, err error)
+	funcGetPolicySnapshotOrigin    string
+	inspectFuncGetPolicySnapshot   func(ctx context.Context)
+	afterGetPolicySnapshotCounter  uint64
+	beforeGetPolicySnapshotCounter uint64
+	GetPolicySnapshotMock          mPolicySnapshotRepositoryMockGetPolicySnapshot
+
+	funcGetPolicyVersion          func(ctx context.Context) (i1 int64, err error)
+	funcGetPolicyVersionOrigin    string
+	inspectFuncGetPolicyVersion   func(ctx context.Context)
+	afterGetPolicyVersionCounter  uint64
+	beforeGetPolicyVersionCounter uint64
+	GetPolicyVersionMock          mPolicySnapshotRepositoryMockGetPolicyVersion
+}
+
+// NewPolicySnapshotRepositoryMock returns a mock for mm_repository.PolicySnapshotRepository
+func NewPolicySnapshotRepositoryMock(t minimock.Tester) *PolicySnapshotRepositoryMock {
+	m := &PolicySnapshotRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPolicySnapshotMock = mPolicySnapshotRepositoryMockGetPolicySnapshot{mock: m}
+	m.GetPolicySnapshotMock.callArgs = []*PolicySnapshotRepositoryMockGetPolicySnapshotParams{}
+
+	m.GetPolicyVersionMock = mPolicySnapshotRepositoryMockGetPolicyVersion{mock: m}
+	m.GetPolicyVersionMock.callArgs = []*PolicySnapshotRepositoryMockGetPolicyVersionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPolicySnapshotRepositoryMockGetPolicySnapshot struct {
+	optional           bool
+	mock               *PolicySnapshotRepositoryMock
+	defaultExpectation *PolicySnapshotRepositoryMockGetPolicySnapshotExpectation
+	expectations       []*PolicySnapshotRepositoryMockGetPolicySnapshotExpectation
+
+	callArgs []*PolicySnapshotRepositoryMockGetPolicySnapshotParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicySnapshotRepositoryMockGetPolicySnapshotExpectation specifies expectation struct of the PolicySnapshotRepository.GetPolicySnapshot
+type PolicySnapshotRepositoryMockGetPolicySnapshotExpectation struct {
+	mock               *PolicySnapshotRepositoryMock
+	params             *PolicySnapshotRepositoryMockGetPolicySnapshotParams
+	paramPtrs          *PolicySnapshotRepositoryMockGetPolicySnapshotParamPtrs
+	expectationOrigins PolicySnapshotRepositoryMockGetPolicySnapshotExpectationOrigins
+	results            *PolicySnapshotRepositoryMockGetPolicySnapshotResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicySnapshotRepositoryMockGetPolicySnapshotParams contains parameters of the PolicySnapshotRepository.GetPolicySnapshot
+type PolicySnapshotRepositoryMockGetPolicySnapshotParams struct {
+	ctx context.Context
+}
+
+// PolicySnapshotRepositoryMockGetPolicySnapshotParamPtrs contains pointers to parameters of the PolicySnapshotRepository.GetPolicySnapshot
+type PolicySnapshotRepositoryMockGetPolicySnapshotParamPtrs struct {
+	ctx *context.Context
+}
+
+// PolicySnapshotRepositoryMockGetPolicySnapshotResults contains results of the PolicySnapshotRepository.GetPolicySnapshot
+type PolicySnapshotRepositoryMockGetPolicySnapshotResults struct {
+	pp1 *model.PolicySnapshot
+	err error
+}
+
+// PolicySnapshotRepositoryMockGetPolicySnapshotOrigins contains origins of expectations of the PolicySnapshotRepository.GetPolicySnapshot
+type PolicySnapshotRepositoryMockGetPolicySnapshotExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Optional() *mPolicySnapshotRepositoryMockGetPolicySnapshot {
+	mmGetPolicySnapshot.optional = true
+	return mmGetPolicySnapshot
+}
+
+// Expect sets up expected params for PolicySnapshotRepository.GetPolicySnapshot
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Expect(ctx context.Context) *mPolicySnapshotRepositoryMockGetPolicySnapshot {
+	if mmGetPolicySnapshot.mock.funcGetPolicySnapshot != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicySnapshot mock is already set by Set")
+	}
+
+	if mmGetPolicySnapshot.defaultExpectation == nil {
+		mmGetPolicySnapshot.defaultExpectation = &PolicySnapshotRepositoryMockGetPolicySnapshotExpectation{}
+	}
+
+	if mmGetPolicySnapshot.defaultExpectation.paramPtrs != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicySnapshot mock is already set by ExpectParams functions")
+	}
+
+	mmGetPolicySnapshot.defaultExpectation.params = &PolicySnapshotRepositoryMockGetPolicySnapshotParams{ctx}
+	mmGetPolicySnapshot.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPolicySnapshot.expectations {
+		if minimock.Equal(e.params, mmGetPolicySnapshot.defaultExpectation.params) {
+			mmGetPolicySnapshot.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPolicySnapshot.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPolicySnapshot
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicySnapshotRepository.GetPolicySnapshot
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) ExpectCtxParam1(ctx context.Context) *mPolicySnapshotRepositoryMockGetPolicySnapshot {
+	if mmGetPolicySnapshot.mock.funcGetPolicySnapshot != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicySnapshot mock is already set by Set")
+	}
+
+	if mmGetPolicySnapshot.defaultExpectation == nil {
+		mmGetPolicySnapshot.defaultExpectation = &PolicySnapshotRepositoryMockGetPolicySnapshotExpectation{}
+	}
+
+	if mmGetPolicySnapshot.defaultExpectation.params != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicySnapshot mock is already set by Expect")
+	}
+
+	if mmGetPolicySnapshot.defaultExpectation.paramPtrs == nil {
+		mmGetPolicySnapshot.defaultExpectation.paramPtrs = &PolicySnapshotRepositoryMockGetPolicySnapshotParamPtrs{}
+	}
+	mmGetPolicySnapshot.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPolicySnapshot.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPolicySnapshot
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicySnapshotRepository.GetPolicySnapshot
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Inspect(f func(ctx context.Context)) *mPolicySnapshotRepositoryMockGetPolicySnapshot {
+	if mmGetPolicySnapshot.mock.inspectFuncGetPolicySnapshot != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("Inspect function is already set for PolicySnapshotRepositoryMock.GetPolicySnapshot")
+	}
+
+	mmGetPolicySnapshot.mock.inspectFuncGetPolicySnapshot = f
+
+	return mmGetPolicySnapshot
+}
+
+// Return sets up results that will be returned by PolicySnapshotRepository.GetPolicySnapshot
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Return(pp1 *model.PolicySnapshot, err error) *PolicySnapshotRepositoryMock {
+	if mmGetPolicySnapshot.mock.funcGetPolicySnapshot != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicySnapshot mock is already set by Set")
+	}
+
+	if mmGetPolicySnapshot.defaultExpectation == nil {
+		mmGetPolicySnapshot.defaultExpectation = &PolicySnapshotRepositoryMockGetPolicySnapshotExpectation{mock: mmGetPolicySnapshot.mock}
+	}
+	mmGetPolicySnapshot.defaultExpectation.results = &PolicySnapshotRepositoryMockGetPolicySnapshotResults{pp1, err}
+	mmGetPolicySnapshot.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPolicySnapshot.mock
+}
+
+// Set uses given function f to mock the PolicySnapshotRepository.GetPolicySnapshot method
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Set(f func(ctx context.Context) (pp1 *model.PolicySnapshot, err error)) *PolicySnapshotRepositoryMock {
+	if mmGetPolicySnapshot.defaultExpectation != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("Default expectation is already set for the PolicySnapshotRepository.GetPolicySnapshot method")
+	}
+
+	if len(mmGetPolicySnapshot.expectations) > 0 {
+		mmGetPolicySnapshot.mock.t.Fatalf("Some expectations are already set for the PolicySnapshotRepository.GetPolicySnapshot method")
+	}
+
+	mmGetPolicySnapshot.mock.funcGetPolicySnapshot = f
+	mmGetPolicySnapshot.mock.funcGetPolicySnapshotOrigin = minimock.CallerInfo(1)
+	return mmGetPolicySnapshot.mock
+}
+
+// When sets expectation for the PolicySnapshotRepository.GetPolicySnapshot which will trigger the result defined by the following
+// Then helper
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) When(ctx context.Context) *PolicySnapshotRepositoryMockGetPolicySnapshotExpectation {
+	if mmGetPolicySnapshot.mock.funcGetPolicySnapshot != nil {
+		mmGetPolicySnapshot.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicySnapshot mock is already set by Set")
+	}
+
+	expectation := &PolicySnapshotRepositoryMockGetPolicySnapshotExpectation{
+		mock:               mmGetPolicySnapshot.mock,
+		params:             &PolicySnapshotRepositoryMockGetPolicySnapshotParams{ctx},
+		expectationOrigins: PolicySnapshotRepositoryMockGetPolicySnapshotExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPolicySnapshot.expectations = append(mmGetPolicySnapshot.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicySnapshotRepository.GetPolicySnapshot return parameters for the expectation previously defined by the When method
+func (e *PolicySnapshotRepositoryMockGetPolicySnapshotExpectation) Then(pp1 *model.PolicySnapshot, err error) *PolicySnapshotRepositoryMock {
+	e.results = &PolicySnapshotRepositoryMockGetPolicySnapshotResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicySnapshotRepository.GetPolicySnapshot should be invoked
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Times(n uint64) *mPolicySnapshotRepositoryMockGetPolicySnapshot {
+	if n == 0 {
+		mmGetPolicySnapshot.mock.t.Fatalf("Times of PolicySnapshotRepositoryMock.GetPolicySnapshot mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPolicySnapshot.expectedInvocations, n)
+	mmGetPolicySnapshot.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPolicySnapshot
+}
+
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) invocationsDone() bool {
+	if len(mmGetPolicySnapshot.expectations) == 0 && mmGetPolicySnapshot.defaultExpectation == nil && mmGetPolicySnapshot.mock.funcGetPolicySnapshot == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPolicySnapshot.mock.afterGetPolicySnapshotCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPolicySnapshot.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPolicySnapshot implements mm_repository.PolicySnapshotRepository
+func (mmGetPolicySnapshot *PolicySnapshotRepositoryMock) GetPolicySnapshot(ctx context.Context) (pp1 *model.PolicySnapshot, err error) {
+	mm_atomic.AddUint64(&mmGetPolicySnapshot.beforeGetPolicySnapshotCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPolicySnapshot.afterGetPolicySnapshotCounter, 1)
+
+	mmGetPolicySnapshot.t.Helper()
+
+	if mmGetPolicySnapshot.inspectFuncGetPolicySnapshot != nil {
+		mmGetPolicySnapshot.inspectFuncGetPolicySnapshot(ctx)
+	}
+
+	mm_params := PolicySnapshotRepositoryMockGetPolicySnapshotParams{ctx}
+
+	// Record call args
+	mmGetPolicySnapshot.GetPolicySnapshotMock.mutex.Lock()
+	mmGetPolicySnapshot.GetPolicySnapshotMock.callArgs = append(mmGetPolicySnapshot.GetPolicySnapshotMock.callArgs, &mm_params)
+	mmGetPolicySnapshot.GetPolicySnapshotMock.mutex.Unlock()
+
+	for _, e := range mmGetPolicySnapshot.GetPolicySnapshotMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicySnapshotRepositoryMockGetPolicySnapshotParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPolicySnapshot.t.Errorf("PolicySnapshotRepositoryMock.GetPolicySnapshot got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPolicySnapshot.t.Errorf("PolicySnapshotRepositoryMock.GetPolicySnapshot got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPolicySnapshot.GetPolicySnapshotMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPolicySnapshot.t.Fatal("No results are set for the PolicySnapshotRepositoryMock.GetPolicySnapshot")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetPolicySnapshot.funcGetPolicySnapshot != nil {
+		return mmGetPolicySnapshot.funcGetPolicySnapshot(ctx)
+	}
+	mmGetPolicySnapshot.t.Fatalf("Unexpected call to PolicySnapshotRepositoryMock.GetPolicySnapshot. %v", ctx)
+	return
+}
+
+// GetPolicySnapshotAfterCounter returns a count of finished PolicySnapshotRepositoryMock.GetPolicySnapshot invocations
+func (mmGetPolicySnapshot *PolicySnapshotRepositoryMock) GetPolicySnapshotAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicySnapshot.afterGetPolicySnapshotCounter)
+}
+
+// GetPolicySnapshotBeforeCounter returns a count of PolicySnapshotRepositoryMock.GetPolicySnapshot invocations
+func (mmGetPolicySnapshot *PolicySnapshotRepositoryMock) GetPolicySnapshotBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicySnapshot.beforeGetPolicySnapshotCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicySnapshotRepositoryMock.GetPolicySnapshot.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPolicySnapshot *mPolicySnapshotRepositoryMockGetPolicySnapshot) Calls() []*PolicySnapshotRepositoryMockGetPolicySnapshotParams {
+	mmGetPolicySnapshot.mutex.RLock()
+
+	argCopy := make([]*PolicySnapshotRepositoryMockGetPolicySnapshotParams, len(mmGetPolicySnapshot.callArgs))
+	copy(argCopy, mmGetPolicySnapshot.callArgs)
+
+	mmGetPolicySnapshot.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPolicySnapshotDone returns true if the count of the GetPolicySnapshot invocations corresponds
+// the number of defined expectations
+func (m *PolicySnapshotRepositoryMock) MinimockGetPolicySnapshotDone() bool {
+	if m.GetPolicySnapshotMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPolicySnapshotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPolicySnapshotMock.invocationsDone()
+}
+
+// MinimockGetPolicySnapshotInspect logs each unmet expectation
+func (m *PolicySnapshotRepositoryMock) MinimockGetPolicySnapshotInspect() {
+	for _, e := range m.GetPolicySnapshotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicySnapshot at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPolicySnapshotCounter := mm_atomic.LoadUint64(&m.afterGetPolicySnapshotCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicySnapshotMock.defaultExpectation != nil && afterGetPolicySnapshotCounter < 1 {
+		if m.GetPolicySnapshotMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicySnapshot at\n%s", m.GetPolicySnapshotMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicySnapshot at\n%s with params: %#v", m.GetPolicySnapshotMock.defaultExpectation.expectationOrigins.origin, *m.GetPolicySnapshotMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicySnapshot != nil && afterGetPolicySnapshotCounter < 1 {
+		m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicySnapshot at\n%s", m.funcGetPolicySnapshotOrigin)
+	}
+
+	if !m.GetPolicySnapshotMock.invocationsDone() && afterGetPolicySnapshotCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicySnapshotRepositoryMock.GetPolicySnapshot at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPolicySnapshotMock.expectedInvocations), m.GetPolicySnapshotMock.expectedInvocationsOrigin, afterGetPolicySnapshotCounter)
+	}
+}
+
+type mPolicySnapshotRepositoryMockGetPolicyVersion struct {
+	optional           bool
+	mock               *PolicySnapshotRepositoryMock
+	defaultExpectation *PolicySnapshotRepositoryMockGetPolicyVersionExpectation
+	expectations       []*PolicySnapshotRepositoryMockGetPolicyVersionExpectation
+
+	callArgs []*PolicySnapshotRepositoryMockGetPolicyVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicySnapshotRepositoryMockGetPolicyVersionExpectation specifies expectation struct of the PolicySnapshotRepository.GetPolicyVersion
+type PolicySnapshotRepositoryMockGetPolicyVersionExpectation struct {
+	mock               *PolicySnapshotRepositoryMock
+	params             *PolicySnapshotRepositoryMockGetPolicyVersionParams
+	paramPtrs          *PolicySnapshotRepositoryMockGetPolicyVersionParamPtrs
+	expectationOrigins PolicySnapshotRepositoryMockGetPolicyVersionExpectationOrigins
+	results            *PolicySnapshotRepositoryMockGetPolicyVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicySnapshotRepositoryMockGetPolicyVersionParams contains parameters of the PolicySnapshotRepository.GetPolicyVersion
+type PolicySnapshotRepositoryMockGetPolicyVersionParams struct {
+	ctx context.Context
+}
+
+// PolicySnapshotRepositoryMockGetPolicyVersionParamPtrs contains pointers to parameters of the PolicySnapshotRepository.GetPolicyVersion
+type PolicySnapshotRepositoryMockGetPolicyVersionParamPtrs struct {
+	ctx *context.Context
+}
+
+// PolicySnapshotRepositoryMockGetPolicyVersionResults contains results of the PolicySnapshotRepository.GetPolicyVersion
+type PolicySnapshotRepositoryMockGetPolicyVersionResults struct {
+	i1  int64
+	err error
+}
+
+// PolicySnapshotRepositoryMockGetPolicyVersionOrigins contains origins of expectations of the PolicySnapshotRepository.GetPolicyVersion
+type PolicySnapshotRepositoryMockGetPolicyVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Optional() *mPolicySnapshotRepositoryMockGetPolicyVersion {
+	mmGetPolicyVersion.optional = true
+	return mmGetPolicyVersion
+}
+
+// Expect sets up expected params for PolicySnapshotRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Expect(ctx context.Context) *mPolicySnapshotRepositoryMockGetPolicyVersion {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation == nil {
+		mmGetPolicyVersion.defaultExpectation = &PolicySnapshotRepositoryMockGetPolicyVersionExpectation{}
+	}
+
+	if mmGetPolicyVersion.defaultExpectation.paramPtrs != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicyVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetPolicyVersion.defaultExpectation.params = &PolicySnapshotRepositoryMockGetPolicyVersionParams{ctx}
+	mmGetPolicyVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPolicyVersion.expectations {
+		if minimock.Equal(e.params, mmGetPolicyVersion.defaultExpectation.params) {
+			mmGetPolicyVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPolicyVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPolicyVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicySnapshotRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) ExpectCtxParam1(ctx context.Context) *mPolicySnapshotRepositoryMockGetPolicyVersion {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation == nil {
+		mmGetPolicyVersion.defaultExpectation = &PolicySnapshotRepositoryMockGetPolicyVersionExpectation{}
+	}
+
+	if mmGetPolicyVersion.defaultExpectation.params != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicyVersion mock is already set by Expect")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation.paramPtrs == nil {
+		mmGetPolicyVersion.defaultExpectation.paramPtrs = &PolicySnapshotRepositoryMockGetPolicyVersionParamPtrs{}
+	}
+	mmGetPolicyVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPolicyVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPolicyVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicySnapshotRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Inspect(f func(ctx context.Context)) *mPolicySnapshotRepositoryMockGetPolicyVersion {
+	if mmGetPolicyVersion.mock.inspectFuncGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("Inspect function is already set for PolicySnapshotRepositoryMock.GetPolicyVersion")
+	}
+
+	mmGetPolicyVersion.mock.inspectFuncGetPolicyVersion = f
+
+	return mmGetPolicyVersion
+}
+
+// Return sets up results that will be returned by PolicySnapshotRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Return(i1 int64, err error) *PolicySnapshotRepositoryMock {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation == nil {
+		mmGetPolicyVersion.defaultExpectation = &PolicySnapshotRepositoryMockGetPolicyVersionExpectation{mock: mmGetPolicyVersion.mock}
+	}
+	mmGetPolicyVersion.defaultExpectation.results = &PolicySnapshotRepositoryMockGetPolicyVersionResults{i1, err}
+	mmGetPolicyVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPolicyVersion.mock
+}
+
+// Set uses given function f to mock the PolicySnapshotRepository.GetPolicyVersion method
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Set(f func(ctx context.Context) (i1 int64, err error)) *PolicySnapshotRepositoryMock {
+	if mmGetPolicyVersion.defaultExpectation != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("Default expectation is already set for the PolicySnapshotRepository.GetPolicyVersion method")
+	}
+
+	if len(mmGetPolicyVersion.expectations) > 0 {
+		mmGetPolicyVersion.mock.t.Fatalf("Some expectations are already set for the PolicySnapshotRepository.GetPolicyVersion method")
+	}
+
+	mmGetPolicyVersion.mock.funcGetPolicyVersion = f
+	mmGetPolicyVersion.mock.funcGetPolicyVersionOrigin = minimock.CallerInfo(1)
+	return mmGetPolicyVersion.mock
+}
+
+// When sets expectation for the PolicySnapshotRepository.GetPolicyVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) When(ctx context.Context) *PolicySnapshotRepositoryMockGetPolicyVersionExpectation {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("PolicySnapshotRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	expectation := &PolicySnapshotRepositoryMockGetPolicyVersionExpectation{
+		mock:               mmGetPolicyVersion.mock,
+		params:             &PolicySnapshotRepositoryMockGetPolicyVersionParams{ctx},
+		expectationOrigins: PolicySnapshotRepositoryMockGetPolicyVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPolicyVersion.expectations = append(mmGetPolicyVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicySnapshotRepository.GetPolicyVersion return parameters for the expectation previously defined by the When method
+func (e *PolicySnapshotRepositoryMockGetPolicyVersionExpectation) Then(i1 int64, err error) *PolicySnapshotRepositoryMock {
+	e.results = &PolicySnapshotRepositoryMockGetPolicyVersionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicySnapshotRepository.GetPolicyVersion should be invoked
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Times(n uint64) *mPolicySnapshotRepositoryMockGetPolicyVersion {
+	if n == 0 {
+		mmGetPolicyVersion.mock.t.Fatalf("Times of PolicySnapshotRepositoryMock.GetPolicyVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPolicyVersion.expectedInvocations, n)
+	mmGetPolicyVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPolicyVersion
+}
+
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) invocationsDone() bool {
+	if len(mmGetPolicyVersion.expectations) == 0 && mmGetPolicyVersion.defaultExpectation == nil && mmGetPolicyVersion.mock.funcGetPolicyVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPolicyVersion.mock.afterGetPolicyVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPolicyVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPolicyVersion implements mm_repository.PolicySnapshotRepository
+func (mmGetPolicyVersion *PolicySnapshotRepositoryMock) GetPolicyVersion(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetPolicyVersion.beforeGetPolicyVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPolicyVersion.afterGetPolicyVersionCounter, 1)
+
+	mmGetPolicyVersion.t.Helper()
+
+	if mmGetPolicyVersion.inspectFuncGetPolicyVersion != nil {
+		mmGetPolicyVersion.inspectFuncGetPolicyVersion(ctx)
+	}
+
+	mm_params := PolicySnapshotRepositoryMockGetPolicyVersionParams{ctx}
+
+	// Record call args
+	mmGetPolicyVersion.GetPolicyVersionMock.mutex.Lock()
+	mmGetPolicyVersion.GetPolicyVersionMock.callArgs = append(mmGetPolicyVersion.GetPolicyVersionMock.callArgs, &mm_params)
+	mmGetPolicyVersion.GetPolicyVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetPolicyVersion.GetPolicyVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicySnapshotRepositoryMockGetPolicyVersionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPolicyVersion.t.Errorf("PolicySnapshotRepositoryMock.GetPolicyVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPolicyVersion.t.Errorf("PolicySnapshotRepositoryMock.GetPolicyVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPolicyVersion.t.Fatal("No results are set for the PolicySnapshotRepositoryMock.GetPolicyVersion")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetPolicyVersion.funcGetPolicyVersion != nil {
+		return mmGetPolicyVersion.funcGetPolicyVersion(ctx)
+	}
+	mmGetPolicyVersion.t.Fatalf("Unexpected call to PolicySnapshotRepositoryMock.GetPolicyVersion. %v", ctx)
+	return
+}
+
+// GetPolicyVersionAfterCounter returns a count of finished PolicySnapshotRepositoryMock.GetPolicyVersion invocations
+func (mmGetPolicyVersion *PolicySnapshotRepositoryMock) GetPolicyVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicyVersion.afterGetPolicyVersionCounter)
+}
+
+// GetPolicyVersionBeforeCounter returns a count of PolicySnapshotRepositoryMock.GetPolicyVersion invocations
+func (mmGetPolicyVersion *PolicySnapshotRepositoryMock) GetPolicyVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicyVersion.beforeGetPolicyVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicySnapshotRepositoryMock.GetPolicyVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPolicyVersion *mPolicySnapshotRepositoryMockGetPolicyVersion) Calls() []*PolicySnapshotRepositoryMockGetPolicyVersionParams {
+	mmGetPolicyVersion.mutex.RLock()
+
+	argCopy := make([]*PolicySnapshotRepositoryMockGetPolicyVersionParams, len(mmGetPolicyVersion.callArgs))
+	copy(argCopy, mmGetPolicyVersion.callArgs)
+
+	mmGetPolicyVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPolicyVersionDone returns true if the count of the GetPolicyVersion invocations corresponds
+// the number of defined expectations
+func (m *PolicySnapshotRepositoryMock) MinimockGetPolicyVersionDone() bool {
+	if m.GetPolicyVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPolicyVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPolicyVersionMock.invocationsDone()
+}
+
+// MinimockGetPolicyVersionInspect logs each unmet expectation
+func (m *PolicySnapshotRepositoryMock) MinimockGetPolicyVersionInspect() {
+	for _, e := range m.GetPolicyVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicyVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPolicyVersionCounter := mm_atomic.LoadUint64(&m.afterGetPolicyVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicyVersionMock.defaultExpectation != nil && afterGetPolicyVersionCounter < 1 {
+		if m.GetPolicyVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicyVersion at\n%s", m.GetPolicyVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicyVersion at\n%s with params: %#v", m.GetPolicyVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetPolicyVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicyVersion != nil && afterGetPolicyVersionCounter < 1 {
+		m.t.Errorf("Expected call to PolicySnapshotRepositoryMock.GetPolicyVersion at\n%s", m.funcGetPolicyVersionOrigin)
+	}
+
+	if !m.GetPolicyVersionMock.invocationsDone() && afterGetPolicyVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicySnapshotRepositoryMock.GetPolicyVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPolicyVersionMock.expectedInvocations), m.GetPolicyVersionMock.expectedInvocationsOrigin, afterGetPolicyVersionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PolicySnapshotRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPolicySnapshotInspect()
+
+			m.MinimockGetPolicyVersionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PolicySnapshotRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PolicySnapshotRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPolicySnapshotDone() &&
+		m.MinimockGetPolicyVersionDone()
+}
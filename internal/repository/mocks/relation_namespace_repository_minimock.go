@@ -0,0 +1,742 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.RelationNamespaceRepository -o relation_namespace_repository_minimock.go -n RelationNamespaceRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// RelationNamespaceRepositoryMock implements mm_repository.RelationNamespaceRepository
+type RelationNamespaceRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListNamespaces          func(ctx context.Context) (rpa1 []*model.RelationNamespace, err error)
+	funcListNamespacesOrigin    string
+	inspectFuncListNamespaces   func(ctx context.Context)
+	afterListNamespacesCounter  uint64
+	beforeListNamespacesCounter uint64
+	ListNamespacesMock          mRelationNamespaceRepositoryMockListNamespaces
+
+	funcSaveNamespace          func(ctx context.Context, namespace *model.RelationNamespace) (err error)
+	funcSaveNamespaceOrigin    string
+	inspectFuncSaveNamespace   func(ctx context.Context, namespace *model.RelationNamespace)
+	afterSaveNamespaceCounter  uint64
+	beforeSaveNamespaceCounter uint64
+	SaveNamespaceMock          mRelationNamespaceRepositoryMockSaveNamespace
+}
+
+// NewRelationNamespaceRepositoryMock returns a mock for mm_repository.RelationNamespaceRepository
+func NewRelationNamespaceRepositoryMock(t minimock.Tester) *RelationNamespaceRepositoryMock {
+	m := &RelationNamespaceRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListNamespacesMock = mRelationNamespaceRepositoryMockListNamespaces{mock: m}
+	m.ListNamespacesMock.callArgs = []*RelationNamespaceRepositoryMockListNamespacesParams{}
+
+	m.SaveNamespaceMock = mRelationNamespaceRepositoryMockSaveNamespace{mock: m}
+	m.SaveNamespaceMock.callArgs = []*RelationNamespaceRepositoryMockSaveNamespaceParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationNamespaceRepositoryMockListNamespaces struct {
+	optional           bool
+	mock               *RelationNamespaceRepositoryMock
+	defaultExpectation *RelationNamespaceRepositoryMockListNamespacesExpectation
+	expectations       []*RelationNamespaceRepositoryMockListNamespacesExpectation
+
+	callArgs []*RelationNamespaceRepositoryMockListNamespacesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationNamespaceRepositoryMockListNamespacesExpectation specifies expectation struct of the RelationNamespaceRepository.ListNamespaces
+type RelationNamespaceRepositoryMockListNamespacesExpectation struct {
+	mock               *RelationNamespaceRepositoryMock
+	params             *RelationNamespaceRepositoryMockListNamespacesParams
+	paramPtrs          *RelationNamespaceRepositoryMockListNamespacesParamPtrs
+	expectationOrigins RelationNamespaceRepositoryMockListNamespacesExpectationOrigins
+	results            *RelationNamespaceRepositoryMockListNamespacesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationNamespaceRepositoryMockListNamespacesParams contains parameters of the RelationNamespaceRepository.ListNamespaces
+type RelationNamespaceRepositoryMockListNamespacesParams struct {
+	ctx context.Context
+}
+
+// RelationNamespaceRepositoryMockListNamespacesParamPtrs contains pointers to parameters of the RelationNamespaceRepository.ListNamespaces
+type RelationNamespaceRepositoryMockListNamespacesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationNamespaceRepositoryMockListNamespacesResults contains results of the RelationNamespaceRepository.ListNamespaces
+type RelationNamespaceRepositoryMockListNamespacesResults struct {
+	rpa1 []*model.RelationNamespace
+	err  error
+}
+
+// RelationNamespaceRepositoryMockListNamespacesOrigins contains origins of expectations of the RelationNamespaceRepository.ListNamespaces
+type RelationNamespaceRepositoryMockListNamespacesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Optional() *mRelationNamespaceRepositoryMockListNamespaces {
+	mmListNamespaces.optional = true
+	return mmListNamespaces
+}
+
+// Expect sets up expected params for RelationNamespaceRepository.ListNamespaces
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Expect(ctx context.Context) *mRelationNamespaceRepositoryMockListNamespaces {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationNamespaceRepositoryMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationNamespaceRepositoryMockListNamespacesExpectation{}
+	}
+
+	if mmListNamespaces.defaultExpectation.paramPtrs != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationNamespaceRepositoryMock.ListNamespaces mock is already set by ExpectParams functions")
+	}
+
+	mmListNamespaces.defaultExpectation.params = &RelationNamespaceRepositoryMockListNamespacesParams{ctx}
+	mmListNamespaces.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListNamespaces.expectations {
+		if minimock.Equal(e.params, mmListNamespaces.defaultExpectation.params) {
+			mmListNamespaces.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespaces.defaultExpectation.params)
+		}
+	}
+
+	return mmListNamespaces
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationNamespaceRepository.ListNamespaces
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) ExpectCtxParam1(ctx context.Context) *mRelationNamespaceRepositoryMockListNamespaces {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationNamespaceRepositoryMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationNamespaceRepositoryMockListNamespacesExpectation{}
+	}
+
+	if mmListNamespaces.defaultExpectation.params != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationNamespaceRepositoryMock.ListNamespaces mock is already set by Expect")
+	}
+
+	if mmListNamespaces.defaultExpectation.paramPtrs == nil {
+		mmListNamespaces.defaultExpectation.paramPtrs = &RelationNamespaceRepositoryMockListNamespacesParamPtrs{}
+	}
+	mmListNamespaces.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNamespaces.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListNamespaces
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationNamespaceRepository.ListNamespaces
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Inspect(f func(ctx context.Context)) *mRelationNamespaceRepositoryMockListNamespaces {
+	if mmListNamespaces.mock.inspectFuncListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("Inspect function is already set for RelationNamespaceRepositoryMock.ListNamespaces")
+	}
+
+	mmListNamespaces.mock.inspectFuncListNamespaces = f
+
+	return mmListNamespaces
+}
+
+// Return sets up results that will be returned by RelationNamespaceRepository.ListNamespaces
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Return(rpa1 []*model.RelationNamespace, err error) *RelationNamespaceRepositoryMock {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationNamespaceRepositoryMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationNamespaceRepositoryMockListNamespacesExpectation{mock: mmListNamespaces.mock}
+	}
+	mmListNamespaces.defaultExpectation.results = &RelationNamespaceRepositoryMockListNamespacesResults{rpa1, err}
+	mmListNamespaces.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListNamespaces.mock
+}
+
+// Set uses given function f to mock the RelationNamespaceRepository.ListNamespaces method
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Set(f func(ctx context.Context) (rpa1 []*model.RelationNamespace, err error)) *RelationNamespaceRepositoryMock {
+	if mmListNamespaces.defaultExpectation != nil {
+		mmListNamespaces.mock.t.Fatalf("Default expectation is already set for the RelationNamespaceRepository.ListNamespaces method")
+	}
+
+	if len(mmListNamespaces.expectations) > 0 {
+		mmListNamespaces.mock.t.Fatalf("Some expectations are already set for the RelationNamespaceRepository.ListNamespaces method")
+	}
+
+	mmListNamespaces.mock.funcListNamespaces = f
+	mmListNamespaces.mock.funcListNamespacesOrigin = minimock.CallerInfo(1)
+	return mmListNamespaces.mock
+}
+
+// When sets expectation for the RelationNamespaceRepository.ListNamespaces which will trigger the result defined by the following
+// Then helper
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) When(ctx context.Context) *RelationNamespaceRepositoryMockListNamespacesExpectation {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationNamespaceRepositoryMock.ListNamespaces mock is already set by Set")
+	}
+
+	expectation := &RelationNamespaceRepositoryMockListNamespacesExpectation{
+		mock:               mmListNamespaces.mock,
+		params:             &RelationNamespaceRepositoryMockListNamespacesParams{ctx},
+		expectationOrigins: RelationNamespaceRepositoryMockListNamespacesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListNamespaces.expectations = append(mmListNamespaces.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationNamespaceRepository.ListNamespaces return parameters for the expectation previously defined by the When method
+func (e *RelationNamespaceRepositoryMockListNamespacesExpectation) Then(rpa1 []*model.RelationNamespace, err error) *RelationNamespaceRepositoryMock {
+	e.results = &RelationNamespaceRepositoryMockListNamespacesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationNamespaceRepository.ListNamespaces should be invoked
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Times(n uint64) *mRelationNamespaceRepositoryMockListNamespaces {
+	if n == 0 {
+		mmListNamespaces.mock.t.Fatalf("Times of RelationNamespaceRepositoryMock.ListNamespaces mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListNamespaces.expectedInvocations, n)
+	mmListNamespaces.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListNamespaces
+}
+
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) invocationsDone() bool {
+	if len(mmListNamespaces.expectations) == 0 && mmListNamespaces.defaultExpectation == nil && mmListNamespaces.mock.funcListNamespaces == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListNamespaces.mock.afterListNamespacesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespaces.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListNamespaces implements mm_repository.RelationNamespaceRepository
+func (mmListNamespaces *RelationNamespaceRepositoryMock) ListNamespaces(ctx context.Context) (rpa1 []*model.RelationNamespace, err error) {
+	mm_atomic.AddUint64(&mmListNamespaces.beforeListNamespacesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNamespaces.afterListNamespacesCounter, 1)
+
+	mmListNamespaces.t.Helper()
+
+	if mmListNamespaces.inspectFuncListNamespaces != nil {
+		mmListNamespaces.inspectFuncListNamespaces(ctx)
+	}
+
+	mm_params := RelationNamespaceRepositoryMockListNamespacesParams{ctx}
+
+	// Record call args
+	mmListNamespaces.ListNamespacesMock.mutex.Lock()
+	mmListNamespaces.ListNamespacesMock.callArgs = append(mmListNamespaces.ListNamespacesMock.callArgs, &mm_params)
+	mmListNamespaces.ListNamespacesMock.mutex.Unlock()
+
+	for _, e := range mmListNamespaces.ListNamespacesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListNamespaces.ListNamespacesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNamespaces.ListNamespacesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNamespaces.ListNamespacesMock.defaultExpectation.params
+		mm_want_ptrs := mmListNamespaces.ListNamespacesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationNamespaceRepositoryMockListNamespacesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListNamespaces.t.Errorf("RelationNamespaceRepositoryMock.ListNamespaces got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNamespaces.ListNamespacesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListNamespaces.t.Errorf("RelationNamespaceRepositoryMock.ListNamespaces got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListNamespaces.ListNamespacesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListNamespaces.ListNamespacesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListNamespaces.t.Fatal("No results are set for the RelationNamespaceRepositoryMock.ListNamespaces")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListNamespaces.funcListNamespaces != nil {
+		return mmListNamespaces.funcListNamespaces(ctx)
+	}
+	mmListNamespaces.t.Fatalf("Unexpected call to RelationNamespaceRepositoryMock.ListNamespaces. %v", ctx)
+	return
+}
+
+// ListNamespacesAfterCounter returns a count of finished RelationNamespaceRepositoryMock.ListNamespaces invocations
+func (mmListNamespaces *RelationNamespaceRepositoryMock) ListNamespacesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespaces.afterListNamespacesCounter)
+}
+
+// ListNamespacesBeforeCounter returns a count of RelationNamespaceRepositoryMock.ListNamespaces invocations
+func (mmListNamespaces *RelationNamespaceRepositoryMock) ListNamespacesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespaces.beforeListNamespacesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationNamespaceRepositoryMock.ListNamespaces.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListNamespaces *mRelationNamespaceRepositoryMockListNamespaces) Calls() []*RelationNamespaceRepositoryMockListNamespacesParams {
+	mmListNamespaces.mutex.RLock()
+
+	argCopy := make([]*RelationNamespaceRepositoryMockListNamespacesParams, len(mmListNamespaces.callArgs))
+	copy(argCopy, mmListNamespaces.callArgs)
+
+	mmListNamespaces.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListNamespacesDone returns true if the count of the ListNamespaces invocations corresponds
+// the number of defined expectations
+func (m *RelationNamespaceRepositoryMock) MinimockListNamespacesDone() bool {
+	if m.ListNamespacesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListNamespacesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListNamespacesMock.invocationsDone()
+}
+
+// MinimockListNamespacesInspect logs each unmet expectation
+func (m *RelationNamespaceRepositoryMock) MinimockListNamespacesInspect() {
+	for _, e := range m.ListNamespacesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.ListNamespaces at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListNamespacesCounter := mm_atomic.LoadUint64(&m.afterListNamespacesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListNamespacesMock.defaultExpectation != nil && afterListNamespacesCounter < 1 {
+		if m.ListNamespacesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.ListNamespaces at\n%s", m.ListNamespacesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.ListNamespaces at\n%s with params: %#v", m.ListNamespacesMock.defaultExpectation.expectationOrigins.origin, *m.ListNamespacesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListNamespaces != nil && afterListNamespacesCounter < 1 {
+		m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.ListNamespaces at\n%s", m.funcListNamespacesOrigin)
+	}
+
+	if !m.ListNamespacesMock.invocationsDone() && afterListNamespacesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationNamespaceRepositoryMock.ListNamespaces at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNamespacesMock.expectedInvocations), m.ListNamespacesMock.expectedInvocationsOrigin, afterListNamespacesCounter)
+	}
+}
+
+type mRelationNamespaceRepositoryMockSaveNamespace struct {
+	optional           bool
+	mock               *RelationNamespaceRepositoryMock
+	defaultExpectation *RelationNamespaceRepositoryMockSaveNamespaceExpectation
+	expectations       []*RelationNamespaceRepositoryMockSaveNamespaceExpectation
+
+	callArgs []*RelationNamespaceRepositoryMockSaveNamespaceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationNamespaceRepositoryMockSaveNamespaceExpectation specifies expectation struct of the RelationNamespaceRepository.SaveNamespace
+type RelationNamespaceRepositoryMockSaveNamespaceExpectation struct {
+	mock               *RelationNamespaceRepositoryMock
+	params             *RelationNamespaceRepositoryMockSaveNamespaceParams
+	paramPtrs          *RelationNamespaceRepositoryMockSaveNamespaceParamPtrs
+	expectationOrigins RelationNamespaceRepositoryMockSaveNamespaceExpectationOrigins
+	results            *RelationNamespaceRepositoryMockSaveNamespaceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationNamespaceRepositoryMockSaveNamespaceParams contains parameters of the RelationNamespaceRepository.SaveNamespace
+type RelationNamespaceRepositoryMockSaveNamespaceParams struct {
+	ctx       context.Context
+	namespace *model.RelationNamespace
+}
+
+// RelationNamespaceRepositoryMockSaveNamespaceParamPtrs contains pointers to parameters of the RelationNamespaceRepository.SaveNamespace
+type RelationNamespaceRepositoryMockSaveNamespaceParamPtrs struct {
+	ctx       *context.Context
+	namespace **model.RelationNamespace
+}
+
+// RelationNamespaceRepositoryMockSaveNamespaceResults contains results of the RelationNamespaceRepository.SaveNamespace
+type RelationNamespaceRepositoryMockSaveNamespaceResults struct {
+	err error
+}
+
+// RelationNamespaceRepositoryMockSaveNamespaceOrigins contains origins of expectations of the RelationNamespaceRepository.SaveNamespace
+type RelationNamespaceRepositoryMockSaveNamespaceExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originNamespace string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Optional() *mRelationNamespaceRepositoryMockSaveNamespace {
+	mmSaveNamespace.optional = true
+	return mmSaveNamespace
+}
+
+// Expect sets up expected params for RelationNamespaceRepository.SaveNamespace
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Expect(ctx context.Context, namespace *model.RelationNamespace) *mRelationNamespaceRepositoryMockSaveNamespace {
+	if mmSaveNamespace.mock.funcSaveNamespace != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Set")
+	}
+
+	if mmSaveNamespace.defaultExpectation == nil {
+		mmSaveNamespace.defaultExpectation = &RelationNamespaceRepositoryMockSaveNamespaceExpectation{}
+	}
+
+	if mmSaveNamespace.defaultExpectation.paramPtrs != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by ExpectParams functions")
+	}
+
+	mmSaveNamespace.defaultExpectation.params = &RelationNamespaceRepositoryMockSaveNamespaceParams{ctx, namespace}
+	mmSaveNamespace.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveNamespace.expectations {
+		if minimock.Equal(e.params, mmSaveNamespace.defaultExpectation.params) {
+			mmSaveNamespace.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveNamespace.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveNamespace
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationNamespaceRepository.SaveNamespace
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) ExpectCtxParam1(ctx context.Context) *mRelationNamespaceRepositoryMockSaveNamespace {
+	if mmSaveNamespace.mock.funcSaveNamespace != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Set")
+	}
+
+	if mmSaveNamespace.defaultExpectation == nil {
+		mmSaveNamespace.defaultExpectation = &RelationNamespaceRepositoryMockSaveNamespaceExpectation{}
+	}
+
+	if mmSaveNamespace.defaultExpectation.params != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Expect")
+	}
+
+	if mmSaveNamespace.defaultExpectation.paramPtrs == nil {
+		mmSaveNamespace.defaultExpectation.paramPtrs = &RelationNamespaceRepositoryMockSaveNamespaceParamPtrs{}
+	}
+	mmSaveNamespace.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveNamespace.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveNamespace
+}
+
+// ExpectNamespaceParam2 sets up expected param namespace for RelationNamespaceRepository.SaveNamespace
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) ExpectNamespaceParam2(namespace *model.RelationNamespace) *mRelationNamespaceRepositoryMockSaveNamespace {
+	if mmSaveNamespace.mock.funcSaveNamespace != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Set")
+	}
+
+	if mmSaveNamespace.defaultExpectation == nil {
+		mmSaveNamespace.defaultExpectation = &RelationNamespaceRepositoryMockSaveNamespaceExpectation{}
+	}
+
+	if mmSaveNamespace.defaultExpectation.params != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Expect")
+	}
+
+	if mmSaveNamespace.defaultExpectation.paramPtrs == nil {
+		mmSaveNamespace.defaultExpectation.paramPtrs = &RelationNamespaceRepositoryMockSaveNamespaceParamPtrs{}
+	}
+	mmSaveNamespace.defaultExpectation.paramPtrs.namespace = &namespace
+	mmSaveNamespace.defaultExpectation.expectationOrigins.originNamespace = minimock.CallerInfo(1)
+
+	return mmSaveNamespace
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationNamespaceRepository.SaveNamespace
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Inspect(f func(ctx context.Context, namespace *model.RelationNamespace)) *mRelationNamespaceRepositoryMockSaveNamespace {
+	if mmSaveNamespace.mock.inspectFuncSaveNamespace != nil {
+		mmSaveNamespace.mock.t.Fatalf("Inspect function is already set for RelationNamespaceRepositoryMock.SaveNamespace")
+	}
+
+	mmSaveNamespace.mock.inspectFuncSaveNamespace = f
+
+	return mmSaveNamespace
+}
+
+// Return sets up results that will be returned by RelationNamespaceRepository.SaveNamespace
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Return(err error) *RelationNamespaceRepositoryMock {
+	if mmSaveNamespace.mock.funcSaveNamespace != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Set")
+	}
+
+	if mmSaveNamespace.defaultExpectation == nil {
+		mmSaveNamespace.defaultExpectation = &RelationNamespaceRepositoryMockSaveNamespaceExpectation{mock: mmSaveNamespace.mock}
+	}
+	mmSaveNamespace.defaultExpectation.results = &RelationNamespaceRepositoryMockSaveNamespaceResults{err}
+	mmSaveNamespace.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveNamespace.mock
+}
+
+// Set uses given function f to mock the RelationNamespaceRepository.SaveNamespace method
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Set(f func(ctx context.Context, namespace *model.RelationNamespace) (err error)) *RelationNamespaceRepositoryMock {
+	if mmSaveNamespace.defaultExpectation != nil {
+		mmSaveNamespace.mock.t.Fatalf("Default expectation is already set for the RelationNamespaceRepository.SaveNamespace method")
+	}
+
+	if len(mmSaveNamespace.expectations) > 0 {
+		mmSaveNamespace.mock.t.Fatalf("Some expectations are already set for the RelationNamespaceRepository.SaveNamespace method")
+	}
+
+	mmSaveNamespace.mock.funcSaveNamespace = f
+	mmSaveNamespace.mock.funcSaveNamespaceOrigin = minimock.CallerInfo(1)
+	return mmSaveNamespace.mock
+}
+
+// When sets expectation for the RelationNamespaceRepository.SaveNamespace which will trigger the result defined by the following
+// Then helper
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) When(ctx context.Context, namespace *model.RelationNamespace) *RelationNamespaceRepositoryMockSaveNamespaceExpectation {
+	if mmSaveNamespace.mock.funcSaveNamespace != nil {
+		mmSaveNamespace.mock.t.Fatalf("RelationNamespaceRepositoryMock.SaveNamespace mock is already set by Set")
+	}
+
+	expectation := &RelationNamespaceRepositoryMockSaveNamespaceExpectation{
+		mock:               mmSaveNamespace.mock,
+		params:             &RelationNamespaceRepositoryMockSaveNamespaceParams{ctx, namespace},
+		expectationOrigins: RelationNamespaceRepositoryMockSaveNamespaceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveNamespace.expectations = append(mmSaveNamespace.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationNamespaceRepository.SaveNamespace return parameters for the expectation previously defined by the When method
+func (e *RelationNamespaceRepositoryMockSaveNamespaceExpectation) Then(err error) *RelationNamespaceRepositoryMock {
+	e.results = &RelationNamespaceRepositoryMockSaveNamespaceResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationNamespaceRepository.SaveNamespace should be invoked
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Times(n uint64) *mRelationNamespaceRepositoryMockSaveNamespace {
+	if n == 0 {
+		mmSaveNamespace.mock.t.Fatalf("Times of RelationNamespaceRepositoryMock.SaveNamespace mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveNamespace.expectedInvocations, n)
+	mmSaveNamespace.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveNamespace
+}
+
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) invocationsDone() bool {
+	if len(mmSaveNamespace.expectations) == 0 && mmSaveNamespace.defaultExpectation == nil && mmSaveNamespace.mock.funcSaveNamespace == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveNamespace.mock.afterSaveNamespaceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveNamespace.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveNamespace implements mm_repository.RelationNamespaceRepository
+func (mmSaveNamespace *RelationNamespaceRepositoryMock) SaveNamespace(ctx context.Context, namespace *model.RelationNamespace) (err error) {
+	mm_atomic.AddUint64(&mmSaveNamespace.beforeSaveNamespaceCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveNamespace.afterSaveNamespaceCounter, 1)
+
+	mmSaveNamespace.t.Helper()
+
+	if mmSaveNamespace.inspectFuncSaveNamespace != nil {
+		mmSaveNamespace.inspectFuncSaveNamespace(ctx, namespace)
+	}
+
+	mm_params := RelationNamespaceRepositoryMockSaveNamespaceParams{ctx, namespace}
+
+	// Record call args
+	mmSaveNamespace.SaveNamespaceMock.mutex.Lock()
+	mmSaveNamespace.SaveNamespaceMock.callArgs = append(mmSaveNamespace.SaveNamespaceMock.callArgs, &mm_params)
+	mmSaveNamespace.SaveNamespaceMock.mutex.Unlock()
+
+	for _, e := range mmSaveNamespace.SaveNamespaceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveNamespace.SaveNamespaceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveNamespace.SaveNamespaceMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveNamespace.SaveNamespaceMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveNamespace.SaveNamespaceMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationNamespaceRepositoryMockSaveNamespaceParams{ctx, namespace}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveNamespace.t.Errorf("RelationNamespaceRepositoryMock.SaveNamespace got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveNamespace.SaveNamespaceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.namespace != nil && !minimock.Equal(*mm_want_ptrs.namespace, mm_got.namespace) {
+				mmSaveNamespace.t.Errorf("RelationNamespaceRepositoryMock.SaveNamespace got unexpected parameter namespace, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveNamespace.SaveNamespaceMock.defaultExpectation.expectationOrigins.originNamespace, *mm_want_ptrs.namespace, mm_got.namespace, minimock.Diff(*mm_want_ptrs.namespace, mm_got.namespace))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveNamespace.t.Errorf("RelationNamespaceRepositoryMock.SaveNamespace got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveNamespace.SaveNamespaceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveNamespace.SaveNamespaceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveNamespace.t.Fatal("No results are set for the RelationNamespaceRepositoryMock.SaveNamespace")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveNamespace.funcSaveNamespace != nil {
+		return mmSaveNamespace.funcSaveNamespace(ctx, namespace)
+	}
+	mmSaveNamespace.t.Fatalf("Unexpected call to RelationNamespaceRepositoryMock.SaveNamespace. %v %v", ctx, namespace)
+	return
+}
+
+// SaveNamespaceAfterCounter returns a count of finished RelationNamespaceRepositoryMock.SaveNamespace invocations
+func (mmSaveNamespace *RelationNamespaceRepositoryMock) SaveNamespaceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveNamespace.afterSaveNamespaceCounter)
+}
+
+// SaveNamespaceBeforeCounter returns a count of RelationNamespaceRepositoryMock.SaveNamespace invocations
+func (mmSaveNamespace *RelationNamespaceRepositoryMock) SaveNamespaceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveNamespace.beforeSaveNamespaceCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationNamespaceRepositoryMock.SaveNamespace.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveNamespace *mRelationNamespaceRepositoryMockSaveNamespace) Calls() []*RelationNamespaceRepositoryMockSaveNamespaceParams {
+	mmSaveNamespace.mutex.RLock()
+
+	argCopy := make([]*RelationNamespaceRepositoryMockSaveNamespaceParams, len(mmSaveNamespace.callArgs))
+	copy(argCopy, mmSaveNamespace.callArgs)
+
+	mmSaveNamespace.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveNamespaceDone returns true if the count of the SaveNamespace invocations corresponds
+// the number of defined expectations
+func (m *RelationNamespaceRepositoryMock) MinimockSaveNamespaceDone() bool {
+	if m.SaveNamespaceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveNamespaceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveNamespaceMock.invocationsDone()
+}
+
+// MinimockSaveNamespaceInspect logs each unmet expectation
+func (m *RelationNamespaceRepositoryMock) MinimockSaveNamespaceInspect() {
+	for _, e := range m.SaveNamespaceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.SaveNamespace at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveNamespaceCounter := mm_atomic.LoadUint64(&m.afterSaveNamespaceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveNamespaceMock.defaultExpectation != nil && afterSaveNamespaceCounter < 1 {
+		if m.SaveNamespaceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.SaveNamespace at\n%s", m.SaveNamespaceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.SaveNamespace at\n%s with params: %#v", m.SaveNamespaceMock.defaultExpectation.expectationOrigins.origin, *m.SaveNamespaceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveNamespace != nil && afterSaveNamespaceCounter < 1 {
+		m.t.Errorf("Expected call to RelationNamespaceRepositoryMock.SaveNamespace at\n%s", m.funcSaveNamespaceOrigin)
+	}
+
+	if !m.SaveNamespaceMock.invocationsDone() && afterSaveNamespaceCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationNamespaceRepositoryMock.SaveNamespace at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveNamespaceMock.expectedInvocations), m.SaveNamespaceMock.expectedInvocationsOrigin, afterSaveNamespaceCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationNamespaceRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListNamespacesInspect()
+
+			m.MinimockSaveNamespaceInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationNamespaceRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationNamespaceRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListNamespacesDone() &&
+		m.MinimockSaveNamespaceDone()
+}
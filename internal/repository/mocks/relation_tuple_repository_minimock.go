@@ -0,0 +1,2289 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.RelationTupleRepository -o relation_tuple_repository_minimock.go -n RelationTupleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// RelationTupleRepositoryMock implements mm_repository.RelationTupleRepository
+type RelationTupleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateTuple          func(ctx context.Context, tuple *model.RelationTuple, revision int64) (err error)
+	funcCreateTupleOrigin    string
+	inspectFuncCreateTuple   func(ctx context.Context, tuple *model.RelationTuple, revision int64)
+	afterCreateTupleCounter  uint64
+	beforeCreateTupleCounter uint64
+	CreateTupleMock          mRelationTupleRepositoryMockCreateTuple
+
+	funcDeleteTuple          func(ctx context.Context, tuple *model.RelationTuple, revision int64) (err error)
+	funcDeleteTupleOrigin    string
+	inspectFuncDeleteTuple   func(ctx context.Context, tuple *model.RelationTuple, revision int64)
+	afterDeleteTupleCounter  uint64
+	beforeDeleteTupleCounter uint64
+	DeleteTupleMock          mRelationTupleRepositoryMockDeleteTuple
+
+	funcGetRevision          func(ctx context.Context) (i1 int64, err error)
+	funcGetRevisionOrigin    string
+	inspectFuncGetRevision   func(ctx context.Context)
+	afterGetRevisionCounter  uint64
+	beforeGetRevisionCounter uint64
+	GetRevisionMock          mRelationTupleRepositoryMockGetRevision
+
+	funcIncrementRevision          func(ctx context.Context) (i1 int64, err error)
+	funcIncrementRevisionOrigin    string
+	inspectFuncIncrementRevision   func(ctx context.Context)
+	afterIncrementRevisionCounter  uint64
+	beforeIncrementRevisionCounter uint64
+	IncrementRevisionMock          mRelationTupleRepositoryMockIncrementRevision
+
+	funcListObjectIDs          func(ctx context.Context, namespace string, revision int64) (sa1 []string, err error)
+	funcListObjectIDsOrigin    string
+	inspectFuncListObjectIDs   func(ctx context.Context, namespace string, revision int64)
+	afterListObjectIDsCounter  uint64
+	beforeListObjectIDsCounter uint64
+	ListObjectIDsMock          mRelationTupleRepositoryMockListObjectIDs
+
+	funcListTuples          func(ctx context.Context, object model.RelationObject, relation string, revision int64) (rpa1 []*model.RelationTuple, err error)
+	funcListTuplesOrigin    string
+	inspectFuncListTuples   func(ctx context.Context, object model.RelationObject, relation string, revision int64)
+	afterListTuplesCounter  uint64
+	beforeListTuplesCounter uint64
+	ListTuplesMock          mRelationTupleRepositoryMockListTuples
+}
+
+// NewRelationTupleRepositoryMock returns a mock for mm_repository.RelationTupleRepository
+func NewRelationTupleRepositoryMock(t minimock.Tester) *RelationTupleRepositoryMock {
+	m := &RelationTupleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateTupleMock = mRelationTupleRepositoryMockCreateTuple{mock: m}
+	m.CreateTupleMock.callArgs = []*RelationTupleRepositoryMockCreateTupleParams{}
+
+	m.DeleteTupleMock = mRelationTupleRepositoryMockDeleteTuple{mock: m}
+	m.DeleteTupleMock.callArgs = []*RelationTupleRepositoryMockDeleteTupleParams{}
+
+	m.GetRevisionMock = mRelationTupleRepositoryMockGetRevision{mock: m}
+	m.GetRevisionMock.callArgs = []*RelationTupleRepositoryMockGetRevisionParams{}
+
+	m.IncrementRevisionMock = mRelationTupleRepositoryMockIncrementRevision{mock: m}
+	m.IncrementRevisionMock.callArgs = []*RelationTupleRepositoryMockIncrementRevisionParams{}
+
+	m.ListObjectIDsMock = mRelationTupleRepositoryMockListObjectIDs{mock: m}
+	m.ListObjectIDsMock.callArgs = []*RelationTupleRepositoryMockListObjectIDsParams{}
+
+	m.ListTuplesMock = mRelationTupleRepositoryMockListTuples{mock: m}
+	m.ListTuplesMock.callArgs = []*RelationTupleRepositoryMockListTuplesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationTupleRepositoryMockCreateTuple struct {
+	optional           bool
+	mock               *RelationTupleRepositoryMock
+	defaultExpectation *RelationTupleRepositoryMockCreateTupleExpectation
+	expectations       []*RelationTupleRepositoryMockCreateTupleExpectation
+
+	callArgs []*RelationTupleRepositoryMockCreateTupleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationTupleRepositoryMockCreateTupleExpectation specifies expectation struct of the RelationTupleRepository.CreateTuple
+type RelationTupleRepositoryMockCreateTupleExpectation struct {
+	mock               *RelationTupleRepositoryMock
+	params             *RelationTupleRepositoryMockCreateTupleParams
+	paramPtrs          *RelationTupleRepositoryMockCreateTupleParamPtrs
+	expectationOrigins RelationTupleRepositoryMockCreateTupleExpectationOrigins
+	results            *RelationTupleRepositoryMockCreateTupleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationTupleRepositoryMockCreateTupleParams contains parameters of the RelationTupleRepository.CreateTuple
+type RelationTupleRepositoryMockCreateTupleParams struct {
+	ctx      context.Context
+	tuple    *model.RelationTuple
+	revision int64
+}
+
+// RelationTupleRepositoryMockCreateTupleParamPtrs contains pointers to parameters of the RelationTupleRepository.CreateTuple
+type RelationTupleRepositoryMockCreateTupleParamPtrs struct {
+	ctx      *context.Context
+	tuple    **model.RelationTuple
+	revision *int64
+}
+
+// RelationTupleRepositoryMockCreateTupleResults contains results of the RelationTupleRepository.CreateTuple
+type RelationTupleRepositoryMockCreateTupleResults struct {
+	err error
+}
+
+// RelationTupleRepositoryMockCreateTupleOrigins contains origins of expectations of the RelationTupleRepository.CreateTuple
+type RelationTupleRepositoryMockCreateTupleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTuple    string
+	originRevision string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Optional() *mRelationTupleRepositoryMockCreateTuple {
+	mmCreateTuple.optional = true
+	return mmCreateTuple
+}
+
+// Expect sets up expected params for RelationTupleRepository.CreateTuple
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Expect(ctx context.Context, tuple *model.RelationTuple, revision int64) *mRelationTupleRepositoryMockCreateTuple {
+	if mmCreateTuple.mock.funcCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Set")
+	}
+
+	if mmCreateTuple.defaultExpectation == nil {
+		mmCreateTuple.defaultExpectation = &RelationTupleRepositoryMockCreateTupleExpectation{}
+	}
+
+	if mmCreateTuple.defaultExpectation.paramPtrs != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by ExpectParams functions")
+	}
+
+	mmCreateTuple.defaultExpectation.params = &RelationTupleRepositoryMockCreateTupleParams{ctx, tuple, revision}
+	mmCreateTuple.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateTuple.expectations {
+		if minimock.Equal(e.params, mmCreateTuple.defaultExpectation.params) {
+			mmCreateTuple.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateTuple.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateTuple
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationTupleRepository.CreateTuple
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) ExpectCtxParam1(ctx context.Context) *mRelationTupleRepositoryMockCreateTuple {
+	if mmCreateTuple.mock.funcCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Set")
+	}
+
+	if mmCreateTuple.defaultExpectation == nil {
+		mmCreateTuple.defaultExpectation = &RelationTupleRepositoryMockCreateTupleExpectation{}
+	}
+
+	if mmCreateTuple.defaultExpectation.params != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Expect")
+	}
+
+	if mmCreateTuple.defaultExpectation.paramPtrs == nil {
+		mmCreateTuple.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockCreateTupleParamPtrs{}
+	}
+	mmCreateTuple.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateTuple.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateTuple
+}
+
+// ExpectTupleParam2 sets up expected param tuple for RelationTupleRepository.CreateTuple
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) ExpectTupleParam2(tuple *model.RelationTuple) *mRelationTupleRepositoryMockCreateTuple {
+	if mmCreateTuple.mock.funcCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Set")
+	}
+
+	if mmCreateTuple.defaultExpectation == nil {
+		mmCreateTuple.defaultExpectation = &RelationTupleRepositoryMockCreateTupleExpectation{}
+	}
+
+	if mmCreateTuple.defaultExpectation.params != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Expect")
+	}
+
+	if mmCreateTuple.defaultExpectation.paramPtrs == nil {
+		mmCreateTuple.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockCreateTupleParamPtrs{}
+	}
+	mmCreateTuple.defaultExpectation.paramPtrs.tuple = &tuple
+	mmCreateTuple.defaultExpectation.expectationOrigins.originTuple = minimock.CallerInfo(1)
+
+	return mmCreateTuple
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationTupleRepository.CreateTuple
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) ExpectRevisionParam3(revision int64) *mRelationTupleRepositoryMockCreateTuple {
+	if mmCreateTuple.mock.funcCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Set")
+	}
+
+	if mmCreateTuple.defaultExpectation == nil {
+		mmCreateTuple.defaultExpectation = &RelationTupleRepositoryMockCreateTupleExpectation{}
+	}
+
+	if mmCreateTuple.defaultExpectation.params != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Expect")
+	}
+
+	if mmCreateTuple.defaultExpectation.paramPtrs == nil {
+		mmCreateTuple.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockCreateTupleParamPtrs{}
+	}
+	mmCreateTuple.defaultExpectation.paramPtrs.revision = &revision
+	mmCreateTuple.defaultExpectation.expectationOrigins.originRevision = minimock.CallerInfo(1)
+
+	return mmCreateTuple
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationTupleRepository.CreateTuple
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Inspect(f func(ctx context.Context, tuple *model.RelationTuple, revision int64)) *mRelationTupleRepositoryMockCreateTuple {
+	if mmCreateTuple.mock.inspectFuncCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("Inspect function is already set for RelationTupleRepositoryMock.CreateTuple")
+	}
+
+	mmCreateTuple.mock.inspectFuncCreateTuple = f
+
+	return mmCreateTuple
+}
+
+// Return sets up results that will be returned by RelationTupleRepository.CreateTuple
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Return(err error) *RelationTupleRepositoryMock {
+	if mmCreateTuple.mock.funcCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Set")
+	}
+
+	if mmCreateTuple.defaultExpectation == nil {
+		mmCreateTuple.defaultExpectation = &RelationTupleRepositoryMockCreateTupleExpectation{mock: mmCreateTuple.mock}
+	}
+	mmCreateTuple.defaultExpectation.results = &RelationTupleRepositoryMockCreateTupleResults{err}
+	mmCreateTuple.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateTuple.mock
+}
+
+// Set uses given function f to mock the RelationTupleRepository.CreateTuple method
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Set(f func(ctx context.Context, tuple *model.RelationTuple, revision int64) (err error)) *RelationTupleRepositoryMock {
+	if mmCreateTuple.defaultExpectation != nil {
+		mmCreateTuple.mock.t.Fatalf("Default expectation is already set for the RelationTupleRepository.CreateTuple method")
+	}
+
+	if len(mmCreateTuple.expectations) > 0 {
+		mmCreateTuple.mock.t.Fatalf("Some expectations are already set for the RelationTupleRepository.CreateTuple method")
+	}
+
+	mmCreateTuple.mock.funcCreateTuple = f
+	mmCreateTuple.mock.funcCreateTupleOrigin = minimock.CallerInfo(1)
+	return mmCreateTuple.mock
+}
+
+// When sets expectation for the RelationTupleRepository.CreateTuple which will trigger the result defined by the following
+// Then helper
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) When(ctx context.Context, tuple *model.RelationTuple, revision int64) *RelationTupleRepositoryMockCreateTupleExpectation {
+	if mmCreateTuple.mock.funcCreateTuple != nil {
+		mmCreateTuple.mock.t.Fatalf("RelationTupleRepositoryMock.CreateTuple mock is already set by Set")
+	}
+
+	expectation := &RelationTupleRepositoryMockCreateTupleExpectation{
+		mock:               mmCreateTuple.mock,
+		params:             &RelationTupleRepositoryMockCreateTupleParams{ctx, tuple, revision},
+		expectationOrigins: RelationTupleRepositoryMockCreateTupleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateTuple.expectations = append(mmCreateTuple.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationTupleRepository.CreateTuple return parameters for the expectation previously defined by the When method
+func (e *RelationTupleRepositoryMockCreateTupleExpectation) Then(err error) *RelationTupleRepositoryMock {
+	e.results = &RelationTupleRepositoryMockCreateTupleResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationTupleRepository.CreateTuple should be invoked
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Times(n uint64) *mRelationTupleRepositoryMockCreateTuple {
+	if n == 0 {
+		mmCreateTuple.mock.t.Fatalf("Times of RelationTupleRepositoryMock.CreateTuple mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateTuple.expectedInvocations, n)
+	mmCreateTuple.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateTuple
+}
+
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) invocationsDone() bool {
+	if len(mmCreateTuple.expectations) == 0 && mmCreateTuple.defaultExpectation == nil && mmCreateTuple.mock.funcCreateTuple == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateTuple.mock.afterCreateTupleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateTuple.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateTuple implements mm_repository.RelationTupleRepository
+func (mmCreateTuple *RelationTupleRepositoryMock) CreateTuple(ctx context.Context, tuple *model.RelationTuple, revision int64) (err error) {
+	mm_atomic.AddUint64(&mmCreateTuple.beforeCreateTupleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateTuple.afterCreateTupleCounter, 1)
+
+	mmCreateTuple.t.Helper()
+
+	if mmCreateTuple.inspectFuncCreateTuple != nil {
+		mmCreateTuple.inspectFuncCreateTuple(ctx, tuple, revision)
+	}
+
+	mm_params := RelationTupleRepositoryMockCreateTupleParams{ctx, tuple, revision}
+
+	// Record call args
+	mmCreateTuple.CreateTupleMock.mutex.Lock()
+	mmCreateTuple.CreateTupleMock.callArgs = append(mmCreateTuple.CreateTupleMock.callArgs, &mm_params)
+	mmCreateTuple.CreateTupleMock.mutex.Unlock()
+
+	for _, e := range mmCreateTuple.CreateTupleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateTuple.CreateTupleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateTuple.CreateTupleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateTuple.CreateTupleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateTuple.CreateTupleMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationTupleRepositoryMockCreateTupleParams{ctx, tuple, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateTuple.t.Errorf("RelationTupleRepositoryMock.CreateTuple got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTuple.CreateTupleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmCreateTuple.t.Errorf("RelationTupleRepositoryMock.CreateTuple got unexpected parameter tuple, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTuple.CreateTupleMock.defaultExpectation.expectationOrigins.originTuple, *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmCreateTuple.t.Errorf("RelationTupleRepositoryMock.CreateTuple got unexpected parameter revision, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTuple.CreateTupleMock.defaultExpectation.expectationOrigins.originRevision, *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateTuple.t.Errorf("RelationTupleRepositoryMock.CreateTuple got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateTuple.CreateTupleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateTuple.CreateTupleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateTuple.t.Fatal("No results are set for the RelationTupleRepositoryMock.CreateTuple")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateTuple.funcCreateTuple != nil {
+		return mmCreateTuple.funcCreateTuple(ctx, tuple, revision)
+	}
+	mmCreateTuple.t.Fatalf("Unexpected call to RelationTupleRepositoryMock.CreateTuple. %v %v %v", ctx, tuple, revision)
+	return
+}
+
+// CreateTupleAfterCounter returns a count of finished RelationTupleRepositoryMock.CreateTuple invocations
+func (mmCreateTuple *RelationTupleRepositoryMock) CreateTupleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTuple.afterCreateTupleCounter)
+}
+
+// CreateTupleBeforeCounter returns a count of RelationTupleRepositoryMock.CreateTuple invocations
+func (mmCreateTuple *RelationTupleRepositoryMock) CreateTupleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTuple.beforeCreateTupleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationTupleRepositoryMock.CreateTuple.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateTuple *mRelationTupleRepositoryMockCreateTuple) Calls() []*RelationTupleRepositoryMockCreateTupleParams {
+	mmCreateTuple.mutex.RLock()
+
+	argCopy := make([]*RelationTupleRepositoryMockCreateTupleParams, len(mmCreateTuple.callArgs))
+	copy(argCopy, mmCreateTuple.callArgs)
+
+	mmCreateTuple.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateTupleDone returns true if the count of the CreateTuple invocations corresponds
+// the number of defined expectations
+func (m *RelationTupleRepositoryMock) MinimockCreateTupleDone() bool {
+	if m.CreateTupleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateTupleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateTupleMock.invocationsDone()
+}
+
+// MinimockCreateTupleInspect logs each unmet expectation
+func (m *RelationTupleRepositoryMock) MinimockCreateTupleInspect() {
+	for _, e := range m.CreateTupleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.CreateTuple at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateTupleCounter := mm_atomic.LoadUint64(&m.afterCreateTupleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateTupleMock.defaultExpectation != nil && afterCreateTupleCounter < 1 {
+		if m.CreateTupleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.CreateTuple at\n%s", m.CreateTupleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.CreateTuple at\n%s with params: %#v", m.CreateTupleMock.defaultExpectation.expectationOrigins.origin, *m.CreateTupleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateTuple != nil && afterCreateTupleCounter < 1 {
+		m.t.Errorf("Expected call to RelationTupleRepositoryMock.CreateTuple at\n%s", m.funcCreateTupleOrigin)
+	}
+
+	if !m.CreateTupleMock.invocationsDone() && afterCreateTupleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationTupleRepositoryMock.CreateTuple at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateTupleMock.expectedInvocations), m.CreateTupleMock.expectedInvocationsOrigin, afterCreateTupleCounter)
+	}
+}
+
+type mRelationTupleRepositoryMockDeleteTuple struct {
+	optional           bool
+	mock               *RelationTupleRepositoryMock
+	defaultExpectation *RelationTupleRepositoryMockDeleteTupleExpectation
+	expectations       []*RelationTupleRepositoryMockDeleteTupleExpectation
+
+	callArgs []*RelationTupleRepositoryMockDeleteTupleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationTupleRepositoryMockDeleteTupleExpectation specifies expectation struct of the RelationTupleRepository.DeleteTuple
+type RelationTupleRepositoryMockDeleteTupleExpectation struct {
+	mock               *RelationTupleRepositoryMock
+	params             *RelationTupleRepositoryMockDeleteTupleParams
+	paramPtrs          *RelationTupleRepositoryMockDeleteTupleParamPtrs
+	expectationOrigins RelationTupleRepositoryMockDeleteTupleExpectationOrigins
+	results            *RelationTupleRepositoryMockDeleteTupleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationTupleRepositoryMockDeleteTupleParams contains parameters of the RelationTupleRepository.DeleteTuple
+type RelationTupleRepositoryMockDeleteTupleParams struct {
+	ctx      context.Context
+	tuple    *model.RelationTuple
+	revision int64
+}
+
+// RelationTupleRepositoryMockDeleteTupleParamPtrs contains pointers to parameters of the RelationTupleRepository.DeleteTuple
+type RelationTupleRepositoryMockDeleteTupleParamPtrs struct {
+	ctx      *context.Context
+	tuple    **model.RelationTuple
+	revision *int64
+}
+
+// RelationTupleRepositoryMockDeleteTupleResults contains results of the RelationTupleRepository.DeleteTuple
+type RelationTupleRepositoryMockDeleteTupleResults struct {
+	err error
+}
+
+// RelationTupleRepositoryMockDeleteTupleOrigins contains origins of expectations of the RelationTupleRepository.DeleteTuple
+type RelationTupleRepositoryMockDeleteTupleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTuple    string
+	originRevision string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Optional() *mRelationTupleRepositoryMockDeleteTuple {
+	mmDeleteTuple.optional = true
+	return mmDeleteTuple
+}
+
+// Expect sets up expected params for RelationTupleRepository.DeleteTuple
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Expect(ctx context.Context, tuple *model.RelationTuple, revision int64) *mRelationTupleRepositoryMockDeleteTuple {
+	if mmDeleteTuple.mock.funcDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Set")
+	}
+
+	if mmDeleteTuple.defaultExpectation == nil {
+		mmDeleteTuple.defaultExpectation = &RelationTupleRepositoryMockDeleteTupleExpectation{}
+	}
+
+	if mmDeleteTuple.defaultExpectation.paramPtrs != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTuple.defaultExpectation.params = &RelationTupleRepositoryMockDeleteTupleParams{ctx, tuple, revision}
+	mmDeleteTuple.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTuple.expectations {
+		if minimock.Equal(e.params, mmDeleteTuple.defaultExpectation.params) {
+			mmDeleteTuple.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTuple.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTuple
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationTupleRepository.DeleteTuple
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) ExpectCtxParam1(ctx context.Context) *mRelationTupleRepositoryMockDeleteTuple {
+	if mmDeleteTuple.mock.funcDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Set")
+	}
+
+	if mmDeleteTuple.defaultExpectation == nil {
+		mmDeleteTuple.defaultExpectation = &RelationTupleRepositoryMockDeleteTupleExpectation{}
+	}
+
+	if mmDeleteTuple.defaultExpectation.params != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Expect")
+	}
+
+	if mmDeleteTuple.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuple.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockDeleteTupleParamPtrs{}
+	}
+	mmDeleteTuple.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTuple.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTuple
+}
+
+// ExpectTupleParam2 sets up expected param tuple for RelationTupleRepository.DeleteTuple
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) ExpectTupleParam2(tuple *model.RelationTuple) *mRelationTupleRepositoryMockDeleteTuple {
+	if mmDeleteTuple.mock.funcDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Set")
+	}
+
+	if mmDeleteTuple.defaultExpectation == nil {
+		mmDeleteTuple.defaultExpectation = &RelationTupleRepositoryMockDeleteTupleExpectation{}
+	}
+
+	if mmDeleteTuple.defaultExpectation.params != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Expect")
+	}
+
+	if mmDeleteTuple.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuple.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockDeleteTupleParamPtrs{}
+	}
+	mmDeleteTuple.defaultExpectation.paramPtrs.tuple = &tuple
+	mmDeleteTuple.defaultExpectation.expectationOrigins.originTuple = minimock.CallerInfo(1)
+
+	return mmDeleteTuple
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationTupleRepository.DeleteTuple
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) ExpectRevisionParam3(revision int64) *mRelationTupleRepositoryMockDeleteTuple {
+	if mmDeleteTuple.mock.funcDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Set")
+	}
+
+	if mmDeleteTuple.defaultExpectation == nil {
+		mmDeleteTuple.defaultExpectation = &RelationTupleRepositoryMockDeleteTupleExpectation{}
+	}
+
+	if mmDeleteTuple.defaultExpectation.params != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Expect")
+	}
+
+	if mmDeleteTuple.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuple.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockDeleteTupleParamPtrs{}
+	}
+	mmDeleteTuple.defaultExpectation.paramPtrs.revision = &revision
+	mmDeleteTuple.defaultExpectation.expectationOrigins.originRevision = minimock.CallerInfo(1)
+
+	return mmDeleteTuple
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationTupleRepository.DeleteTuple
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Inspect(f func(ctx context.Context, tuple *model.RelationTuple, revision int64)) *mRelationTupleRepositoryMockDeleteTuple {
+	if mmDeleteTuple.mock.inspectFuncDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("Inspect function is already set for RelationTupleRepositoryMock.DeleteTuple")
+	}
+
+	mmDeleteTuple.mock.inspectFuncDeleteTuple = f
+
+	return mmDeleteTuple
+}
+
+// Return sets up results that will be returned by RelationTupleRepository.DeleteTuple
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Return(err error) *RelationTupleRepositoryMock {
+	if mmDeleteTuple.mock.funcDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Set")
+	}
+
+	if mmDeleteTuple.defaultExpectation == nil {
+		mmDeleteTuple.defaultExpectation = &RelationTupleRepositoryMockDeleteTupleExpectation{mock: mmDeleteTuple.mock}
+	}
+	mmDeleteTuple.defaultExpectation.results = &RelationTupleRepositoryMockDeleteTupleResults{err}
+	mmDeleteTuple.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuple.mock
+}
+
+// Set uses given function f to mock the RelationTupleRepository.DeleteTuple method
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Set(f func(ctx context.Context, tuple *model.RelationTuple, revision int64) (err error)) *RelationTupleRepositoryMock {
+	if mmDeleteTuple.defaultExpectation != nil {
+		mmDeleteTuple.mock.t.Fatalf("Default expectation is already set for the RelationTupleRepository.DeleteTuple method")
+	}
+
+	if len(mmDeleteTuple.expectations) > 0 {
+		mmDeleteTuple.mock.t.Fatalf("Some expectations are already set for the RelationTupleRepository.DeleteTuple method")
+	}
+
+	mmDeleteTuple.mock.funcDeleteTuple = f
+	mmDeleteTuple.mock.funcDeleteTupleOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuple.mock
+}
+
+// When sets expectation for the RelationTupleRepository.DeleteTuple which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) When(ctx context.Context, tuple *model.RelationTuple, revision int64) *RelationTupleRepositoryMockDeleteTupleExpectation {
+	if mmDeleteTuple.mock.funcDeleteTuple != nil {
+		mmDeleteTuple.mock.t.Fatalf("RelationTupleRepositoryMock.DeleteTuple mock is already set by Set")
+	}
+
+	expectation := &RelationTupleRepositoryMockDeleteTupleExpectation{
+		mock:               mmDeleteTuple.mock,
+		params:             &RelationTupleRepositoryMockDeleteTupleParams{ctx, tuple, revision},
+		expectationOrigins: RelationTupleRepositoryMockDeleteTupleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTuple.expectations = append(mmDeleteTuple.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationTupleRepository.DeleteTuple return parameters for the expectation previously defined by the When method
+func (e *RelationTupleRepositoryMockDeleteTupleExpectation) Then(err error) *RelationTupleRepositoryMock {
+	e.results = &RelationTupleRepositoryMockDeleteTupleResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationTupleRepository.DeleteTuple should be invoked
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Times(n uint64) *mRelationTupleRepositoryMockDeleteTuple {
+	if n == 0 {
+		mmDeleteTuple.mock.t.Fatalf("Times of RelationTupleRepositoryMock.DeleteTuple mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTuple.expectedInvocations, n)
+	mmDeleteTuple.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuple
+}
+
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) invocationsDone() bool {
+	if len(mmDeleteTuple.expectations) == 0 && mmDeleteTuple.defaultExpectation == nil && mmDeleteTuple.mock.funcDeleteTuple == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTuple.mock.afterDeleteTupleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTuple.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTuple implements mm_repository.RelationTupleRepository
+func (mmDeleteTuple *RelationTupleRepositoryMock) DeleteTuple(ctx context.Context, tuple *model.RelationTuple, revision int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTuple.beforeDeleteTupleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTuple.afterDeleteTupleCounter, 1)
+
+	mmDeleteTuple.t.Helper()
+
+	if mmDeleteTuple.inspectFuncDeleteTuple != nil {
+		mmDeleteTuple.inspectFuncDeleteTuple(ctx, tuple, revision)
+	}
+
+	mm_params := RelationTupleRepositoryMockDeleteTupleParams{ctx, tuple, revision}
+
+	// Record call args
+	mmDeleteTuple.DeleteTupleMock.mutex.Lock()
+	mmDeleteTuple.DeleteTupleMock.callArgs = append(mmDeleteTuple.DeleteTupleMock.callArgs, &mm_params)
+	mmDeleteTuple.DeleteTupleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTuple.DeleteTupleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTuple.DeleteTupleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTuple.DeleteTupleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTuple.DeleteTupleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTuple.DeleteTupleMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationTupleRepositoryMockDeleteTupleParams{ctx, tuple, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTuple.t.Errorf("RelationTupleRepositoryMock.DeleteTuple got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuple.DeleteTupleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmDeleteTuple.t.Errorf("RelationTupleRepositoryMock.DeleteTuple got unexpected parameter tuple, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuple.DeleteTupleMock.defaultExpectation.expectationOrigins.originTuple, *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmDeleteTuple.t.Errorf("RelationTupleRepositoryMock.DeleteTuple got unexpected parameter revision, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuple.DeleteTupleMock.defaultExpectation.expectationOrigins.originRevision, *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTuple.t.Errorf("RelationTupleRepositoryMock.DeleteTuple got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTuple.DeleteTupleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTuple.DeleteTupleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTuple.t.Fatal("No results are set for the RelationTupleRepositoryMock.DeleteTuple")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTuple.funcDeleteTuple != nil {
+		return mmDeleteTuple.funcDeleteTuple(ctx, tuple, revision)
+	}
+	mmDeleteTuple.t.Fatalf("Unexpected call to RelationTupleRepositoryMock.DeleteTuple. %v %v %v", ctx, tuple, revision)
+	return
+}
+
+// DeleteTupleAfterCounter returns a count of finished RelationTupleRepositoryMock.DeleteTuple invocations
+func (mmDeleteTuple *RelationTupleRepositoryMock) DeleteTupleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuple.afterDeleteTupleCounter)
+}
+
+// DeleteTupleBeforeCounter returns a count of RelationTupleRepositoryMock.DeleteTuple invocations
+func (mmDeleteTuple *RelationTupleRepositoryMock) DeleteTupleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuple.beforeDeleteTupleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationTupleRepositoryMock.DeleteTuple.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTuple *mRelationTupleRepositoryMockDeleteTuple) Calls() []*RelationTupleRepositoryMockDeleteTupleParams {
+	mmDeleteTuple.mutex.RLock()
+
+	argCopy := make([]*RelationTupleRepositoryMockDeleteTupleParams, len(mmDeleteTuple.callArgs))
+	copy(argCopy, mmDeleteTuple.callArgs)
+
+	mmDeleteTuple.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTupleDone returns true if the count of the DeleteTuple invocations corresponds
+// the number of defined expectations
+func (m *RelationTupleRepositoryMock) MinimockDeleteTupleDone() bool {
+	if m.DeleteTupleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTupleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTupleMock.invocationsDone()
+}
+
+// MinimockDeleteTupleInspect logs each unmet expectation
+func (m *RelationTupleRepositoryMock) MinimockDeleteTupleInspect() {
+	for _, e := range m.DeleteTupleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.DeleteTuple at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTupleCounter := mm_atomic.LoadUint64(&m.afterDeleteTupleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTupleMock.defaultExpectation != nil && afterDeleteTupleCounter < 1 {
+		if m.DeleteTupleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.DeleteTuple at\n%s", m.DeleteTupleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.DeleteTuple at\n%s with params: %#v", m.DeleteTupleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTupleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTuple != nil && afterDeleteTupleCounter < 1 {
+		m.t.Errorf("Expected call to RelationTupleRepositoryMock.DeleteTuple at\n%s", m.funcDeleteTupleOrigin)
+	}
+
+	if !m.DeleteTupleMock.invocationsDone() && afterDeleteTupleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationTupleRepositoryMock.DeleteTuple at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTupleMock.expectedInvocations), m.DeleteTupleMock.expectedInvocationsOrigin, afterDeleteTupleCounter)
+	}
+}
+
+type mRelationTupleRepositoryMockGetRevision struct {
+	optional           bool
+	mock               *RelationTupleRepositoryMock
+	defaultExpectation *RelationTupleRepositoryMockGetRevisionExpectation
+	expectations       []*RelationTupleRepositoryMockGetRevisionExpectation
+
+	callArgs []*RelationTupleRepositoryMockGetRevisionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationTupleRepositoryMockGetRevisionExpectation specifies expectation struct of the RelationTupleRepository.GetRevision
+type RelationTupleRepositoryMockGetRevisionExpectation struct {
+	mock               *RelationTupleRepositoryMock
+	params             *RelationTupleRepositoryMockGetRevisionParams
+	paramPtrs          *RelationTupleRepositoryMockGetRevisionParamPtrs
+	expectationOrigins RelationTupleRepositoryMockGetRevisionExpectationOrigins
+	results            *RelationTupleRepositoryMockGetRevisionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationTupleRepositoryMockGetRevisionParams contains parameters of the RelationTupleRepository.GetRevision
+type RelationTupleRepositoryMockGetRevisionParams struct {
+	ctx context.Context
+}
+
+// RelationTupleRepositoryMockGetRevisionParamPtrs contains pointers to parameters of the RelationTupleRepository.GetRevision
+type RelationTupleRepositoryMockGetRevisionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationTupleRepositoryMockGetRevisionResults contains results of the RelationTupleRepository.GetRevision
+type RelationTupleRepositoryMockGetRevisionResults struct {
+	i1  int64
+	err error
+}
+
+// RelationTupleRepositoryMockGetRevisionOrigins contains origins of expectations of the RelationTupleRepository.GetRevision
+type RelationTupleRepositoryMockGetRevisionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Optional() *mRelationTupleRepositoryMockGetRevision {
+	mmGetRevision.optional = true
+	return mmGetRevision
+}
+
+// Expect sets up expected params for RelationTupleRepository.GetRevision
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Expect(ctx context.Context) *mRelationTupleRepositoryMockGetRevision {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationTupleRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	if mmGetRevision.defaultExpectation == nil {
+		mmGetRevision.defaultExpectation = &RelationTupleRepositoryMockGetRevisionExpectation{}
+	}
+
+	if mmGetRevision.defaultExpectation.paramPtrs != nil {
+		mmGetRevision.mock.t.Fatalf("RelationTupleRepositoryMock.GetRevision mock is already set by ExpectParams functions")
+	}
+
+	mmGetRevision.defaultExpectation.params = &RelationTupleRepositoryMockGetRevisionParams{ctx}
+	mmGetRevision.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRevision.expectations {
+		if minimock.Equal(e.params, mmGetRevision.defaultExpectation.params) {
+			mmGetRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationTupleRepository.GetRevision
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) ExpectCtxParam1(ctx context.Context) *mRelationTupleRepositoryMockGetRevision {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationTupleRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	if mmGetRevision.defaultExpectation == nil {
+		mmGetRevision.defaultExpectation = &RelationTupleRepositoryMockGetRevisionExpectation{}
+	}
+
+	if mmGetRevision.defaultExpectation.params != nil {
+		mmGetRevision.mock.t.Fatalf("RelationTupleRepositoryMock.GetRevision mock is already set by Expect")
+	}
+
+	if mmGetRevision.defaultExpectation.paramPtrs == nil {
+		mmGetRevision.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockGetRevisionParamPtrs{}
+	}
+	mmGetRevision.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRevision.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationTupleRepository.GetRevision
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Inspect(f func(ctx context.Context)) *mRelationTupleRepositoryMockGetRevision {
+	if mmGetRevision.mock.inspectFuncGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("Inspect function is already set for RelationTupleRepositoryMock.GetRevision")
+	}
+
+	mmGetRevision.mock.inspectFuncGetRevision = f
+
+	return mmGetRevision
+}
+
+// Return sets up results that will be returned by RelationTupleRepository.GetRevision
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Return(i1 int64, err error) *RelationTupleRepositoryMock {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationTupleRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	if mmGetRevision.defaultExpectation == nil {
+		mmGetRevision.defaultExpectation = &RelationTupleRepositoryMockGetRevisionExpectation{mock: mmGetRevision.mock}
+	}
+	mmGetRevision.defaultExpectation.results = &RelationTupleRepositoryMockGetRevisionResults{i1, err}
+	mmGetRevision.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRevision.mock
+}
+
+// Set uses given function f to mock the RelationTupleRepository.GetRevision method
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Set(f func(ctx context.Context) (i1 int64, err error)) *RelationTupleRepositoryMock {
+	if mmGetRevision.defaultExpectation != nil {
+		mmGetRevision.mock.t.Fatalf("Default expectation is already set for the RelationTupleRepository.GetRevision method")
+	}
+
+	if len(mmGetRevision.expectations) > 0 {
+		mmGetRevision.mock.t.Fatalf("Some expectations are already set for the RelationTupleRepository.GetRevision method")
+	}
+
+	mmGetRevision.mock.funcGetRevision = f
+	mmGetRevision.mock.funcGetRevisionOrigin = minimock.CallerInfo(1)
+	return mmGetRevision.mock
+}
+
+// When sets expectation for the RelationTupleRepository.GetRevision which will trigger the result defined by the following
+// Then helper
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) When(ctx context.Context) *RelationTupleRepositoryMockGetRevisionExpectation {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationTupleRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	expectation := &RelationTupleRepositoryMockGetRevisionExpectation{
+		mock:               mmGetRevision.mock,
+		params:             &RelationTupleRepositoryMockGetRevisionParams{ctx},
+		expectationOrigins: RelationTupleRepositoryMockGetRevisionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRevision.expectations = append(mmGetRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationTupleRepository.GetRevision return parameters for the expectation previously defined by the When method
+func (e *RelationTupleRepositoryMockGetRevisionExpectation) Then(i1 int64, err error) *RelationTupleRepositoryMock {
+	e.results = &RelationTupleRepositoryMockGetRevisionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationTupleRepository.GetRevision should be invoked
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Times(n uint64) *mRelationTupleRepositoryMockGetRevision {
+	if n == 0 {
+		mmGetRevision.mock.t.Fatalf("Times of RelationTupleRepositoryMock.GetRevision mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRevision.expectedInvocations, n)
+	mmGetRevision.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRevision
+}
+
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) invocationsDone() bool {
+	if len(mmGetRevision.expectations) == 0 && mmGetRevision.defaultExpectation == nil && mmGetRevision.mock.funcGetRevision == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRevision.mock.afterGetRevisionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRevision.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRevision implements mm_repository.RelationTupleRepository
+func (mmGetRevision *RelationTupleRepositoryMock) GetRevision(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetRevision.beforeGetRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRevision.afterGetRevisionCounter, 1)
+
+	mmGetRevision.t.Helper()
+
+	if mmGetRevision.inspectFuncGetRevision != nil {
+		mmGetRevision.inspectFuncGetRevision(ctx)
+	}
+
+	mm_params := RelationTupleRepositoryMockGetRevisionParams{ctx}
+
+	// Record call args
+	mmGetRevision.GetRevisionMock.mutex.Lock()
+	mmGetRevision.GetRevisionMock.callArgs = append(mmGetRevision.GetRevisionMock.callArgs, &mm_params)
+	mmGetRevision.GetRevisionMock.mutex.Unlock()
+
+	for _, e := range mmGetRevision.GetRevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetRevision.GetRevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRevision.GetRevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRevision.GetRevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRevision.GetRevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationTupleRepositoryMockGetRevisionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRevision.t.Errorf("RelationTupleRepositoryMock.GetRevision got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRevision.GetRevisionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRevision.t.Errorf("RelationTupleRepositoryMock.GetRevision got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRevision.GetRevisionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRevision.GetRevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRevision.t.Fatal("No results are set for the RelationTupleRepositoryMock.GetRevision")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetRevision.funcGetRevision != nil {
+		return mmGetRevision.funcGetRevision(ctx)
+	}
+	mmGetRevision.t.Fatalf("Unexpected call to RelationTupleRepositoryMock.GetRevision. %v", ctx)
+	return
+}
+
+// GetRevisionAfterCounter returns a count of finished RelationTupleRepositoryMock.GetRevision invocations
+func (mmGetRevision *RelationTupleRepositoryMock) GetRevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevision.afterGetRevisionCounter)
+}
+
+// GetRevisionBeforeCounter returns a count of RelationTupleRepositoryMock.GetRevision invocations
+func (mmGetRevision *RelationTupleRepositoryMock) GetRevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevision.beforeGetRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationTupleRepositoryMock.GetRevision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRevision *mRelationTupleRepositoryMockGetRevision) Calls() []*RelationTupleRepositoryMockGetRevisionParams {
+	mmGetRevision.mutex.RLock()
+
+	argCopy := make([]*RelationTupleRepositoryMockGetRevisionParams, len(mmGetRevision.callArgs))
+	copy(argCopy, mmGetRevision.callArgs)
+
+	mmGetRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRevisionDone returns true if the count of the GetRevision invocations corresponds
+// the number of defined expectations
+func (m *RelationTupleRepositoryMock) MinimockGetRevisionDone() bool {
+	if m.GetRevisionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRevisionMock.invocationsDone()
+}
+
+// MinimockGetRevisionInspect logs each unmet expectation
+func (m *RelationTupleRepositoryMock) MinimockGetRevisionInspect() {
+	for _, e := range m.GetRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.GetRevision at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRevisionCounter := mm_atomic.LoadUint64(&m.afterGetRevisionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRevisionMock.defaultExpectation != nil && afterGetRevisionCounter < 1 {
+		if m.GetRevisionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.GetRevision at\n%s", m.GetRevisionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.GetRevision at\n%s with params: %#v", m.GetRevisionMock.defaultExpectation.expectationOrigins.origin, *m.GetRevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRevision != nil && afterGetRevisionCounter < 1 {
+		m.t.Errorf("Expected call to RelationTupleRepositoryMock.GetRevision at\n%s", m.funcGetRevisionOrigin)
+	}
+
+	if !m.GetRevisionMock.invocationsDone() && afterGetRevisionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationTupleRepositoryMock.GetRevision at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRevisionMock.expectedInvocations), m.GetRevisionMock.expectedInvocationsOrigin, afterGetRevisionCounter)
+	}
+}
+
+type mRelationTupleRepositoryMockIncrementRevision struct {
+	optional           bool
+	mock               *RelationTupleRepositoryMock
+	defaultExpectation *RelationTupleRepositoryMockIncrementRevisionExpectation
+	expectations       []*RelationTupleRepositoryMockIncrementRevisionExpectation
+
+	callArgs []*RelationTupleRepositoryMockIncrementRevisionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationTupleRepositoryMockIncrementRevisionExpectation specifies expectation struct of the RelationTupleRepository.IncrementRevision
+type RelationTupleRepositoryMockIncrementRevisionExpectation struct {
+	mock               *RelationTupleRepositoryMock
+	params             *RelationTupleRepositoryMockIncrementRevisionParams
+	paramPtrs          *RelationTupleRepositoryMockIncrementRevisionParamPtrs
+	expectationOrigins RelationTupleRepositoryMockIncrementRevisionExpectationOrigins
+	results            *RelationTupleRepositoryMockIncrementRevisionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationTupleRepositoryMockIncrementRevisionParams contains parameters of the RelationTupleRepository.IncrementRevision
+type RelationTupleRepositoryMockIncrementRevisionParams struct {
+	ctx context.Context
+}
+
+// RelationTupleRepositoryMockIncrementRevisionParamPtrs contains pointers to parameters of the RelationTupleRepository.IncrementRevision
+type RelationTupleRepositoryMockIncrementRevisionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationTupleRepositoryMockIncrementRevisionResults contains results of the RelationTupleRepository.IncrementRevision
+type RelationTupleRepositoryMockIncrementRevisionResults struct {
+	i1  int64
+	err error
+}
+
+// RelationTupleRepositoryMockIncrementRevisionOrigins contains origins of expectations of the RelationTupleRepository.IncrementRevision
+type RelationTupleRepositoryMockIncrementRevisionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Optional() *mRelationTupleRepositoryMockIncrementRevision {
+	mmIncrementRevision.optional = true
+	return mmIncrementRevision
+}
+
+// Expect sets up expected params for RelationTupleRepository.IncrementRevision
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Expect(ctx context.Context) *mRelationTupleRepositoryMockIncrementRevision {
+	if mmIncrementRevision.mock.funcIncrementRevision != nil {
+		mmIncrementRevision.mock.t.Fatalf("RelationTupleRepositoryMock.IncrementRevision mock is already set by Set")
+	}
+
+	if mmIncrementRevision.defaultExpectation == nil {
+		mmIncrementRevision.defaultExpectation = &RelationTupleRepositoryMockIncrementRevisionExpectation{}
+	}
+
+	if mmIncrementRevision.defaultExpectation.paramPtrs != nil {
+		mmIncrementRevision.mock.t.Fatalf("RelationTupleRepositoryMock.IncrementRevision mock is already set by ExpectParams functions")
+	}
+
+	mmIncrementRevision.defaultExpectation.params = &RelationTupleRepositoryMockIncrementRevisionParams{ctx}
+	mmIncrementRevision.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncrementRevision.expectations {
+		if minimock.Equal(e.params, mmIncrementRevision.defaultExpectation.params) {
+			mmIncrementRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncrementRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmIncrementRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationTupleRepository.IncrementRevision
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) ExpectCtxParam1(ctx context.Context) *mRelationTupleRepositoryMockIncrementRevision {
+	if mmIncrementRevision.mock.funcIncrementRevision != nil {
+		mmIncrementRevision.mock.t.Fatalf("RelationTupleRepositoryMock.IncrementRevision mock is already set by Set")
+	}
+
+	if mmIncrementRevision.defaultExpectation == nil {
+		mmIncrementRevision.defaultExpectation = &RelationTupleRepositoryMockIncrementRevisionExpectation{}
+	}
+
+	if mmIncrementRevision.defaultExpectation.params != nil {
+		mmIncrementRevision.mock.t.Fatalf("RelationTupleRepositoryMock.IncrementRevision mock is already set by Expect")
+	}
+
+	if mmIncrementRevision.defaultExpectation.paramPtrs == nil {
+		mmIncrementRevision.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockIncrementRevisionParamPtrs{}
+	}
+	mmIncrementRevision.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncrementRevision.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIncrementRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationTupleRepository.IncrementRevision
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Inspect(f func(ctx context.Context)) *mRelationTupleRepositoryMockIncrementRevision {
+	if mmIncrementRevision.mock.inspectFuncIncrementRevision != nil {
+		mmIncrementRevision.mock.t.Fatalf("Inspect function is already set for RelationTupleRepositoryMock.IncrementRevision")
+	}
+
+	mmIncrementRevision.mock.inspectFuncIncrementRevision = f
+
+	return mmIncrementRevision
+}
+
+// Return sets up results that will be returned by RelationTupleRepository.IncrementRevision
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Return(i1 int64, err error) *RelationTupleRepositoryMock {
+	if mmIncrementRevision.mock.funcIncrementRevision != nil {
+		mmIncrementRevision.mock.t.Fatalf("RelationTupleRepositoryMock.IncrementRevision mock is already set by Set")
+	}
+
+	if mmIncrementRevision.defaultExpectation == nil {
+		mmIncrementRevision.defaultExpectation = &RelationTupleRepositoryMockIncrementRevisionExpectation{mock: mmIncrementRevision.mock}
+	}
+	mmIncrementRevision.defaultExpectation.results = &RelationTupleRepositoryMockIncrementRevisionResults{i1, err}
+	mmIncrementRevision.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncrementRevision.mock
+}
+
+// Set uses given function f to mock the RelationTupleRepository.IncrementRevision method
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Set(f func(ctx context.Context) (i1 int64, err error)) *RelationTupleRepositoryMock {
+	if mmIncrementRevision.defaultExpectation != nil {
+		mmIncrementRevision.mock.t.Fatalf("Default expectation is already set for the RelationTupleRepository.IncrementRevision method")
+	}
+
+	if len(mmIncrementRevision.expectations) > 0 {
+		mmIncrementRevision.mock.t.Fatalf("Some expectations are already set for the RelationTupleRepository.IncrementRevision method")
+	}
+
+	mmIncrementRevision.mock.funcIncrementRevision = f
+	mmIncrementRevision.mock.funcIncrementRevisionOrigin = minimock.CallerInfo(1)
+	return mmIncrementRevision.mock
+}
+
+// When sets expectation for the RelationTupleRepository.IncrementRevision which will trigger the result defined by the following
+// Then helper
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) When(ctx context.Context) *RelationTupleRepositoryMockIncrementRevisionExpectation {
+	if mmIncrementRevision.mock.funcIncrementRevision != nil {
+		mmIncrementRevision.mock.t.Fatalf("RelationTupleRepositoryMock.IncrementRevision mock is already set by Set")
+	}
+
+	expectation := &RelationTupleRepositoryMockIncrementRevisionExpectation{
+		mock:               mmIncrementRevision.mock,
+		params:             &RelationTupleRepositoryMockIncrementRevisionParams{ctx},
+		expectationOrigins: RelationTupleRepositoryMockIncrementRevisionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIncrementRevision.expectations = append(mmIncrementRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationTupleRepository.IncrementRevision return parameters for the expectation previously defined by the When method
+func (e *RelationTupleRepositoryMockIncrementRevisionExpectation) Then(i1 int64, err error) *RelationTupleRepositoryMock {
+	e.results = &RelationTupleRepositoryMockIncrementRevisionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationTupleRepository.IncrementRevision should be invoked
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Times(n uint64) *mRelationTupleRepositoryMockIncrementRevision {
+	if n == 0 {
+		mmIncrementRevision.mock.t.Fatalf("Times of RelationTupleRepositoryMock.IncrementRevision mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIncrementRevision.expectedInvocations, n)
+	mmIncrementRevision.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncrementRevision
+}
+
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) invocationsDone() bool {
+	if len(mmIncrementRevision.expectations) == 0 && mmIncrementRevision.defaultExpectation == nil && mmIncrementRevision.mock.funcIncrementRevision == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIncrementRevision.mock.afterIncrementRevisionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncrementRevision.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IncrementRevision implements mm_repository.RelationTupleRepository
+func (mmIncrementRevision *RelationTupleRepositoryMock) IncrementRevision(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmIncrementRevision.beforeIncrementRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncrementRevision.afterIncrementRevisionCounter, 1)
+
+	mmIncrementRevision.t.Helper()
+
+	if mmIncrementRevision.inspectFuncIncrementRevision != nil {
+		mmIncrementRevision.inspectFuncIncrementRevision(ctx)
+	}
+
+	mm_params := RelationTupleRepositoryMockIncrementRevisionParams{ctx}
+
+	// Record call args
+	mmIncrementRevision.IncrementRevisionMock.mutex.Lock()
+	mmIncrementRevision.IncrementRevisionMock.callArgs = append(mmIncrementRevision.IncrementRevisionMock.callArgs, &mm_params)
+	mmIncrementRevision.IncrementRevisionMock.mutex.Unlock()
+
+	for _, e := range mmIncrementRevision.IncrementRevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmIncrementRevision.IncrementRevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncrementRevision.IncrementRevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncrementRevision.IncrementRevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmIncrementRevision.IncrementRevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationTupleRepositoryMockIncrementRevisionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIncrementRevision.t.Errorf("RelationTupleRepositoryMock.IncrementRevision got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementRevision.IncrementRevisionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIncrementRevision.t.Errorf("RelationTupleRepositoryMock.IncrementRevision got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncrementRevision.IncrementRevisionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIncrementRevision.IncrementRevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIncrementRevision.t.Fatal("No results are set for the RelationTupleRepositoryMock.IncrementRevision")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmIncrementRevision.funcIncrementRevision != nil {
+		return mmIncrementRevision.funcIncrementRevision(ctx)
+	}
+	mmIncrementRevision.t.Fatalf("Unexpected call to RelationTupleRepositoryMock.IncrementRevision. %v", ctx)
+	return
+}
+
+// IncrementRevisionAfterCounter returns a count of finished RelationTupleRepositoryMock.IncrementRevision invocations
+func (mmIncrementRevision *RelationTupleRepositoryMock) IncrementRevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementRevision.afterIncrementRevisionCounter)
+}
+
+// IncrementRevisionBeforeCounter returns a count of RelationTupleRepositoryMock.IncrementRevision invocations
+func (mmIncrementRevision *RelationTupleRepositoryMock) IncrementRevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementRevision.beforeIncrementRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationTupleRepositoryMock.IncrementRevision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIncrementRevision *mRelationTupleRepositoryMockIncrementRevision) Calls() []*RelationTupleRepositoryMockIncrementRevisionParams {
+	mmIncrementRevision.mutex.RLock()
+
+	argCopy := make([]*RelationTupleRepositoryMockIncrementRevisionParams, len(mmIncrementRevision.callArgs))
+	copy(argCopy, mmIncrementRevision.callArgs)
+
+	mmIncrementRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIncrementRevisionDone returns true if the count of the IncrementRevision invocations corresponds
+// the number of defined expectations
+func (m *RelationTupleRepositoryMock) MinimockIncrementRevisionDone() bool {
+	if m.IncrementRevisionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IncrementRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IncrementRevisionMock.invocationsDone()
+}
+
+// MinimockIncrementRevisionInspect logs each unmet expectation
+func (m *RelationTupleRepositoryMock) MinimockIncrementRevisionInspect() {
+	for _, e := range m.IncrementRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.IncrementRevision at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIncrementRevisionCounter := mm_atomic.LoadUint64(&m.afterIncrementRevisionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IncrementRevisionMock.defaultExpectation != nil && afterIncrementRevisionCounter < 1 {
+		if m.IncrementRevisionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.IncrementRevision at\n%s", m.IncrementRevisionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.IncrementRevision at\n%s with params: %#v", m.IncrementRevisionMock.defaultExpectation.expectationOrigins.origin, *m.IncrementRevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIncrementRevision != nil && afterIncrementRevisionCounter < 1 {
+		m.t.Errorf("Expected call to RelationTupleRepositoryMock.IncrementRevision at\n%s", m.funcIncrementRevisionOrigin)
+	}
+
+	if !m.IncrementRevisionMock.invocationsDone() && afterIncrementRevisionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationTupleRepositoryMock.IncrementRevision at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncrementRevisionMock.expectedInvocations), m.IncrementRevisionMock.expectedInvocationsOrigin, afterIncrementRevisionCounter)
+	}
+}
+
+type mRelationTupleRepositoryMockListObjectIDs struct {
+	optional           bool
+	mock               *RelationTupleRepositoryMock
+	defaultExpectation *RelationTupleRepositoryMockListObjectIDsExpectation
+	expectations       []*RelationTupleRepositoryMockListObjectIDsExpectation
+
+	callArgs []*RelationTupleRepositoryMockListObjectIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationTupleRepositoryMockListObjectIDsExpectation specifies expectation struct of the RelationTupleRepository.ListObjectIDs
+type RelationTupleRepositoryMockListObjectIDsExpectation struct {
+	mock               *RelationTupleRepositoryMock
+	params             *RelationTupleRepositoryMockListObjectIDsParams
+	paramPtrs          *RelationTupleRepositoryMockListObjectIDsParamPtrs
+	expectationOrigins RelationTupleRepositoryMockListObjectIDsExpectationOrigins
+	results            *RelationTupleRepositoryMockListObjectIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationTupleRepositoryMockListObjectIDsParams contains parameters of the RelationTupleRepository.ListObjectIDs
+type RelationTupleRepositoryMockListObjectIDsParams struct {
+	ctx       context.Context
+	namespace string
+	revision  int64
+}
+
+// RelationTupleRepositoryMockListObjectIDsParamPtrs contains pointers to parameters of the RelationTupleRepository.ListObjectIDs
+type RelationTupleRepositoryMockListObjectIDsParamPtrs struct {
+	ctx       *context.Context
+	namespace *string
+	revision  *int64
+}
+
+// RelationTupleRepositoryMockListObjectIDsResults contains results of the RelationTupleRepository.ListObjectIDs
+type RelationTupleRepositoryMockListObjectIDsResults struct {
+	sa1 []string
+	err error
+}
+
+// RelationTupleRepositoryMockListObjectIDsOrigins contains origins of expectations of the RelationTupleRepository.ListObjectIDs
+type RelationTupleRepositoryMockListObjectIDsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originNamespace string
+	originRevision  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Optional() *mRelationTupleRepositoryMockListObjectIDs {
+	mmListObjectIDs.optional = true
+	return mmListObjectIDs
+}
+
+// Expect sets up expected params for RelationTupleRepository.ListObjectIDs
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Expect(ctx context.Context, namespace string, revision int64) *mRelationTupleRepositoryMockListObjectIDs {
+	if mmListObjectIDs.mock.funcListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Set")
+	}
+
+	if mmListObjectIDs.defaultExpectation == nil {
+		mmListObjectIDs.defaultExpectation = &RelationTupleRepositoryMockListObjectIDsExpectation{}
+	}
+
+	if mmListObjectIDs.defaultExpectation.paramPtrs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by ExpectParams functions")
+	}
+
+	mmListObjectIDs.defaultExpectation.params = &RelationTupleRepositoryMockListObjectIDsParams{ctx, namespace, revision}
+	mmListObjectIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListObjectIDs.expectations {
+		if minimock.Equal(e.params, mmListObjectIDs.defaultExpectation.params) {
+			mmListObjectIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListObjectIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmListObjectIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationTupleRepository.ListObjectIDs
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) ExpectCtxParam1(ctx context.Context) *mRelationTupleRepositoryMockListObjectIDs {
+	if mmListObjectIDs.mock.funcListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Set")
+	}
+
+	if mmListObjectIDs.defaultExpectation == nil {
+		mmListObjectIDs.defaultExpectation = &RelationTupleRepositoryMockListObjectIDsExpectation{}
+	}
+
+	if mmListObjectIDs.defaultExpectation.params != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Expect")
+	}
+
+	if mmListObjectIDs.defaultExpectation.paramPtrs == nil {
+		mmListObjectIDs.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListObjectIDsParamPtrs{}
+	}
+	mmListObjectIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListObjectIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListObjectIDs
+}
+
+// ExpectNamespaceParam2 sets up expected param namespace for RelationTupleRepository.ListObjectIDs
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) ExpectNamespaceParam2(namespace string) *mRelationTupleRepositoryMockListObjectIDs {
+	if mmListObjectIDs.mock.funcListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Set")
+	}
+
+	if mmListObjectIDs.defaultExpectation == nil {
+		mmListObjectIDs.defaultExpectation = &RelationTupleRepositoryMockListObjectIDsExpectation{}
+	}
+
+	if mmListObjectIDs.defaultExpectation.params != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Expect")
+	}
+
+	if mmListObjectIDs.defaultExpectation.paramPtrs == nil {
+		mmListObjectIDs.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListObjectIDsParamPtrs{}
+	}
+	mmListObjectIDs.defaultExpectation.paramPtrs.namespace = &namespace
+	mmListObjectIDs.defaultExpectation.expectationOrigins.originNamespace = minimock.CallerInfo(1)
+
+	return mmListObjectIDs
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationTupleRepository.ListObjectIDs
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) ExpectRevisionParam3(revision int64) *mRelationTupleRepositoryMockListObjectIDs {
+	if mmListObjectIDs.mock.funcListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Set")
+	}
+
+	if mmListObjectIDs.defaultExpectation == nil {
+		mmListObjectIDs.defaultExpectation = &RelationTupleRepositoryMockListObjectIDsExpectation{}
+	}
+
+	if mmListObjectIDs.defaultExpectation.params != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Expect")
+	}
+
+	if mmListObjectIDs.defaultExpectation.paramPtrs == nil {
+		mmListObjectIDs.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListObjectIDsParamPtrs{}
+	}
+	mmListObjectIDs.defaultExpectation.paramPtrs.revision = &revision
+	mmListObjectIDs.defaultExpectation.expectationOrigins.originRevision = minimock.CallerInfo(1)
+
+	return mmListObjectIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationTupleRepository.ListObjectIDs
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Inspect(f func(ctx context.Context, namespace string, revision int64)) *mRelationTupleRepositoryMockListObjectIDs {
+	if mmListObjectIDs.mock.inspectFuncListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("Inspect function is already set for RelationTupleRepositoryMock.ListObjectIDs")
+	}
+
+	mmListObjectIDs.mock.inspectFuncListObjectIDs = f
+
+	return mmListObjectIDs
+}
+
+// Return sets up results that will be returned by RelationTupleRepository.ListObjectIDs
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Return(sa1 []string, err error) *RelationTupleRepositoryMock {
+	if mmListObjectIDs.mock.funcListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Set")
+	}
+
+	if mmListObjectIDs.defaultExpectation == nil {
+		mmListObjectIDs.defaultExpectation = &RelationTupleRepositoryMockListObjectIDsExpectation{mock: mmListObjectIDs.mock}
+	}
+	mmListObjectIDs.defaultExpectation.results = &RelationTupleRepositoryMockListObjectIDsResults{sa1, err}
+	mmListObjectIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListObjectIDs.mock
+}
+
+// Set uses given function f to mock the RelationTupleRepository.ListObjectIDs method
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Set(f func(ctx context.Context, namespace string, revision int64) (sa1 []string, err error)) *RelationTupleRepositoryMock {
+	if mmListObjectIDs.defaultExpectation != nil {
+		mmListObjectIDs.mock.t.Fatalf("Default expectation is already set for the RelationTupleRepository.ListObjectIDs method")
+	}
+
+	if len(mmListObjectIDs.expectations) > 0 {
+		mmListObjectIDs.mock.t.Fatalf("Some expectations are already set for the RelationTupleRepository.ListObjectIDs method")
+	}
+
+	mmListObjectIDs.mock.funcListObjectIDs = f
+	mmListObjectIDs.mock.funcListObjectIDsOrigin = minimock.CallerInfo(1)
+	return mmListObjectIDs.mock
+}
+
+// When sets expectation for the RelationTupleRepository.ListObjectIDs which will trigger the result defined by the following
+// Then helper
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) When(ctx context.Context, namespace string, revision int64) *RelationTupleRepositoryMockListObjectIDsExpectation {
+	if mmListObjectIDs.mock.funcListObjectIDs != nil {
+		mmListObjectIDs.mock.t.Fatalf("RelationTupleRepositoryMock.ListObjectIDs mock is already set by Set")
+	}
+
+	expectation := &RelationTupleRepositoryMockListObjectIDsExpectation{
+		mock:               mmListObjectIDs.mock,
+		params:             &RelationTupleRepositoryMockListObjectIDsParams{ctx, namespace, revision},
+		expectationOrigins: RelationTupleRepositoryMockListObjectIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListObjectIDs.expectations = append(mmListObjectIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationTupleRepository.ListObjectIDs return parameters for the expectation previously defined by the When method
+func (e *RelationTupleRepositoryMockListObjectIDsExpectation) Then(sa1 []string, err error) *RelationTupleRepositoryMock {
+	e.results = &RelationTupleRepositoryMockListObjectIDsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationTupleRepository.ListObjectIDs should be invoked
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Times(n uint64) *mRelationTupleRepositoryMockListObjectIDs {
+	if n == 0 {
+		mmListObjectIDs.mock.t.Fatalf("Times of RelationTupleRepositoryMock.ListObjectIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListObjectIDs.expectedInvocations, n)
+	mmListObjectIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListObjectIDs
+}
+
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) invocationsDone() bool {
+	if len(mmListObjectIDs.expectations) == 0 && mmListObjectIDs.defaultExpectation == nil && mmListObjectIDs.mock.funcListObjectIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListObjectIDs.mock.afterListObjectIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListObjectIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListObjectIDs implements mm_repository.RelationTupleRepository
+func (mmListObjectIDs *RelationTupleRepositoryMock) ListObjectIDs(ctx context.Context, namespace string, revision int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListObjectIDs.beforeListObjectIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListObjectIDs.afterListObjectIDsCounter, 1)
+
+	mmListObjectIDs.t.Helper()
+
+	if mmListObjectIDs.inspectFuncListObjectIDs != nil {
+		mmListObjectIDs.inspectFuncListObjectIDs(ctx, namespace, revision)
+	}
+
+	mm_params := RelationTupleRepositoryMockListObjectIDsParams{ctx, namespace, revision}
+
+	// Record call args
+	mmListObjectIDs.ListObjectIDsMock.mutex.Lock()
+	mmListObjectIDs.ListObjectIDsMock.callArgs = append(mmListObjectIDs.ListObjectIDsMock.callArgs, &mm_params)
+	mmListObjectIDs.ListObjectIDsMock.mutex.Unlock()
+
+	for _, e := range mmListObjectIDs.ListObjectIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListObjectIDs.ListObjectIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListObjectIDs.ListObjectIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListObjectIDs.ListObjectIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmListObjectIDs.ListObjectIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationTupleRepositoryMockListObjectIDsParams{ctx, namespace, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListObjectIDs.t.Errorf("RelationTupleRepositoryMock.ListObjectIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjectIDs.ListObjectIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.namespace != nil && !minimock.Equal(*mm_want_ptrs.namespace, mm_got.namespace) {
+				mmListObjectIDs.t.Errorf("RelationTupleRepositoryMock.ListObjectIDs got unexpected parameter namespace, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjectIDs.ListObjectIDsMock.defaultExpectation.expectationOrigins.originNamespace, *mm_want_ptrs.namespace, mm_got.namespace, minimock.Diff(*mm_want_ptrs.namespace, mm_got.namespace))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmListObjectIDs.t.Errorf("RelationTupleRepositoryMock.ListObjectIDs got unexpected parameter revision, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjectIDs.ListObjectIDsMock.defaultExpectation.expectationOrigins.originRevision, *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListObjectIDs.t.Errorf("RelationTupleRepositoryMock.ListObjectIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListObjectIDs.ListObjectIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListObjectIDs.ListObjectIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListObjectIDs.t.Fatal("No results are set for the RelationTupleRepositoryMock.ListObjectIDs")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListObjectIDs.funcListObjectIDs != nil {
+		return mmListObjectIDs.funcListObjectIDs(ctx, namespace, revision)
+	}
+	mmListObjectIDs.t.Fatalf("Unexpected call to RelationTupleRepositoryMock.ListObjectIDs. %v %v %v", ctx, namespace, revision)
+	return
+}
+
+// ListObjectIDsAfterCounter returns a count of finished RelationTupleRepositoryMock.ListObjectIDs invocations
+func (mmListObjectIDs *RelationTupleRepositoryMock) ListObjectIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListObjectIDs.afterListObjectIDsCounter)
+}
+
+// ListObjectIDsBeforeCounter returns a count of RelationTupleRepositoryMock.ListObjectIDs invocations
+func (mmListObjectIDs *RelationTupleRepositoryMock) ListObjectIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListObjectIDs.beforeListObjectIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationTupleRepositoryMock.ListObjectIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListObjectIDs *mRelationTupleRepositoryMockListObjectIDs) Calls() []*RelationTupleRepositoryMockListObjectIDsParams {
+	mmListObjectIDs.mutex.RLock()
+
+	argCopy := make([]*RelationTupleRepositoryMockListObjectIDsParams, len(mmListObjectIDs.callArgs))
+	copy(argCopy, mmListObjectIDs.callArgs)
+
+	mmListObjectIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListObjectIDsDone returns true if the count of the ListObjectIDs invocations corresponds
+// the number of defined expectations
+func (m *RelationTupleRepositoryMock) MinimockListObjectIDsDone() bool {
+	if m.ListObjectIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListObjectIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListObjectIDsMock.invocationsDone()
+}
+
+// MinimockListObjectIDsInspect logs each unmet expectation
+func (m *RelationTupleRepositoryMock) MinimockListObjectIDsInspect() {
+	for _, e := range m.ListObjectIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListObjectIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListObjectIDsCounter := mm_atomic.LoadUint64(&m.afterListObjectIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListObjectIDsMock.defaultExpectation != nil && afterListObjectIDsCounter < 1 {
+		if m.ListObjectIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListObjectIDs at\n%s", m.ListObjectIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListObjectIDs at\n%s with params: %#v", m.ListObjectIDsMock.defaultExpectation.expectationOrigins.origin, *m.ListObjectIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListObjectIDs != nil && afterListObjectIDsCounter < 1 {
+		m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListObjectIDs at\n%s", m.funcListObjectIDsOrigin)
+	}
+
+	if !m.ListObjectIDsMock.invocationsDone() && afterListObjectIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationTupleRepositoryMock.ListObjectIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListObjectIDsMock.expectedInvocations), m.ListObjectIDsMock.expectedInvocationsOrigin, afterListObjectIDsCounter)
+	}
+}
+
+type mRelationTupleRepositoryMockListTuples struct {
+	optional           bool
+	mock               *RelationTupleRepositoryMock
+	defaultExpectation *RelationTupleRepositoryMockListTuplesExpectation
+	expectations       []*RelationTupleRepositoryMockListTuplesExpectation
+
+	callArgs []*RelationTupleRepositoryMockListTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationTupleRepositoryMockListTuplesExpectation specifies expectation struct of the RelationTupleRepository.ListTuples
+type RelationTupleRepositoryMockListTuplesExpectation struct {
+	mock               *RelationTupleRepositoryMock
+	params             *RelationTupleRepositoryMockListTuplesParams
+	paramPtrs          *RelationTupleRepositoryMockListTuplesParamPtrs
+	expectationOrigins RelationTupleRepositoryMockListTuplesExpectationOrigins
+	results            *RelationTupleRepositoryMockListTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationTupleRepositoryMockListTuplesParams contains parameters of the RelationTupleRepository.ListTuples
+type RelationTupleRepositoryMockListTuplesParams struct {
+	ctx      context.Context
+	object   model.RelationObject
+	relation string
+	revision int64
+}
+
+// RelationTupleRepositoryMockListTuplesParamPtrs contains pointers to parameters of the RelationTupleRepository.ListTuples
+type RelationTupleRepositoryMockListTuplesParamPtrs struct {
+	ctx      *context.Context
+	object   *model.RelationObject
+	relation *string
+	revision *int64
+}
+
+// RelationTupleRepositoryMockListTuplesResults contains results of the RelationTupleRepository.ListTuples
+type RelationTupleRepositoryMockListTuplesResults struct {
+	rpa1 []*model.RelationTuple
+	err  error
+}
+
+// RelationTupleRepositoryMockListTuplesOrigins contains origins of expectations of the RelationTupleRepository.ListTuples
+type RelationTupleRepositoryMockListTuplesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originObject   string
+	originRelation string
+	originRevision string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Optional() *mRelationTupleRepositoryMockListTuples {
+	mmListTuples.optional = true
+	return mmListTuples
+}
+
+// Expect sets up expected params for RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Expect(ctx context.Context, object model.RelationObject, relation string, revision int64) *mRelationTupleRepositoryMockListTuples {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	if mmListTuples.defaultExpectation == nil {
+		mmListTuples.defaultExpectation = &RelationTupleRepositoryMockListTuplesExpectation{}
+	}
+
+	if mmListTuples.defaultExpectation.paramPtrs != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by ExpectParams functions")
+	}
+
+	mmListTuples.defaultExpectation.params = &RelationTupleRepositoryMockListTuplesParams{ctx, object, relation, revision}
+	mmListTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListTuples.expectations {
+		if minimock.Equal(e.params, mmListTuples.defaultExpectation.params) {
+			mmListTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmListTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) ExpectCtxParam1(ctx context.Context) *mRelationTupleRepositoryMockListTuples {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	if mmListTuples.defaultExpectation == nil {
+		mmListTuples.defaultExpectation = &RelationTupleRepositoryMockListTuplesExpectation{}
+	}
+
+	if mmListTuples.defaultExpectation.params != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Expect")
+	}
+
+	if mmListTuples.defaultExpectation.paramPtrs == nil {
+		mmListTuples.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListTuplesParamPtrs{}
+	}
+	mmListTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListTuples
+}
+
+// ExpectObjectParam2 sets up expected param object for RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) ExpectObjectParam2(object model.RelationObject) *mRelationTupleRepositoryMockListTuples {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	if mmListTuples.defaultExpectation == nil {
+		mmListTuples.defaultExpectation = &RelationTupleRepositoryMockListTuplesExpectation{}
+	}
+
+	if mmListTuples.defaultExpectation.params != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Expect")
+	}
+
+	if mmListTuples.defaultExpectation.paramPtrs == nil {
+		mmListTuples.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListTuplesParamPtrs{}
+	}
+	mmListTuples.defaultExpectation.paramPtrs.object = &object
+	mmListTuples.defaultExpectation.expectationOrigins.originObject = minimock.CallerInfo(1)
+
+	return mmListTuples
+}
+
+// ExpectRelationParam3 sets up expected param relation for RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) ExpectRelationParam3(relation string) *mRelationTupleRepositoryMockListTuples {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	if mmListTuples.defaultExpectation == nil {
+		mmListTuples.defaultExpectation = &RelationTupleRepositoryMockListTuplesExpectation{}
+	}
+
+	if mmListTuples.defaultExpectation.params != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Expect")
+	}
+
+	if mmListTuples.defaultExpectation.paramPtrs == nil {
+		mmListTuples.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListTuplesParamPtrs{}
+	}
+	mmListTuples.defaultExpectation.paramPtrs.relation = &relation
+	mmListTuples.defaultExpectation.expectationOrigins.originRelation = minimock.CallerInfo(1)
+
+	return mmListTuples
+}
+
+// ExpectRevisionParam4 sets up expected param revision for RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) ExpectRevisionParam4(revision int64) *mRelationTupleRepositoryMockListTuples {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	if mmListTuples.defaultExpectation == nil {
+		mmListTuples.defaultExpectation = &RelationTupleRepositoryMockListTuplesExpectation{}
+	}
+
+	if mmListTuples.defaultExpectation.params != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Expect")
+	}
+
+	if mmListTuples.defaultExpectation.paramPtrs == nil {
+		mmListTuples.defaultExpectation.paramPtrs = &RelationTupleRepositoryMockListTuplesParamPtrs{}
+	}
+	mmListTuples.defaultExpectation.paramPtrs.revision = &revision
+	mmListTuples.defaultExpectation.expectationOrigins.originRevision = minimock.CallerInfo(1)
+
+	return mmListTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Inspect(f func(ctx context.Context, object model.RelationObject, relation string, revision int64)) *mRelationTupleRepositoryMockListTuples {
+	if mmListTuples.mock.inspectFuncListTuples != nil {
+		mmListTuples.mock.t.Fatalf("Inspect function is already set for RelationTupleRepositoryMock.ListTuples")
+	}
+
+	mmListTuples.mock.inspectFuncListTuples = f
+
+	return mmListTuples
+}
+
+// Return sets up results that will be returned by RelationTupleRepository.ListTuples
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Return(rpa1 []*model.RelationTuple, err error) *RelationTupleRepositoryMock {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	if mmListTuples.defaultExpectation == nil {
+		mmListTuples.defaultExpectation = &RelationTupleRepositoryMockListTuplesExpectation{mock: mmListTuples.mock}
+	}
+	mmListTuples.defaultExpectation.results = &RelationTupleRepositoryMockListTuplesResults{rpa1, err}
+	mmListTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListTuples.mock
+}
+
+// Set uses given function f to mock the RelationTupleRepository.ListTuples method
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Set(f func(ctx context.Context, object model.RelationObject, relation string, revision int64) (rpa1 []*model.RelationTuple, err error)) *RelationTupleRepositoryMock {
+	if mmListTuples.defaultExpectation != nil {
+		mmListTuples.mock.t.Fatalf("Default expectation is already set for the RelationTupleRepository.ListTuples method")
+	}
+
+	if len(mmListTuples.expectations) > 0 {
+		mmListTuples.mock.t.Fatalf("Some expectations are already set for the RelationTupleRepository.ListTuples method")
+	}
+
+	mmListTuples.mock.funcListTuples = f
+	mmListTuples.mock.funcListTuplesOrigin = minimock.CallerInfo(1)
+	return mmListTuples.mock
+}
+
+// When sets expectation for the RelationTupleRepository.ListTuples which will trigger the result defined by the following
+// Then helper
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) When(ctx context.Context, object model.RelationObject, relation string, revision int64) *RelationTupleRepositoryMockListTuplesExpectation {
+	if mmListTuples.mock.funcListTuples != nil {
+		mmListTuples.mock.t.Fatalf("RelationTupleRepositoryMock.ListTuples mock is already set by Set")
+	}
+
+	expectation := &RelationTupleRepositoryMockListTuplesExpectation{
+		mock:               mmListTuples.mock,
+		params:             &RelationTupleRepositoryMockListTuplesParams{ctx, object, relation, revision},
+		expectationOrigins: RelationTupleRepositoryMockListTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListTuples.expectations = append(mmListTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationTupleRepository.ListTuples return parameters for the expectation previously defined by the When method
+func (e *RelationTupleRepositoryMockListTuplesExpectation) Then(rpa1 []*model.RelationTuple, err error) *RelationTupleRepositoryMock {
+	e.results = &RelationTupleRepositoryMockListTuplesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationTupleRepository.ListTuples should be invoked
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Times(n uint64) *mRelationTupleRepositoryMockListTuples {
+	if n == 0 {
+		mmListTuples.mock.t.Fatalf("Times of RelationTupleRepositoryMock.ListTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListTuples.expectedInvocations, n)
+	mmListTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListTuples
+}
+
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) invocationsDone() bool {
+	if len(mmListTuples.expectations) == 0 && mmListTuples.defaultExpectation == nil && mmListTuples.mock.funcListTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListTuples.mock.afterListTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListTuples implements mm_repository.RelationTupleRepository
+func (mmListTuples *RelationTupleRepositoryMock) ListTuples(ctx context.Context, object model.RelationObject, relation string, revision int64) (rpa1 []*model.RelationTuple, err error) {
+	mm_atomic.AddUint64(&mmListTuples.beforeListTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListTuples.afterListTuplesCounter, 1)
+
+	mmListTuples.t.Helper()
+
+	if mmListTuples.inspectFuncListTuples != nil {
+		mmListTuples.inspectFuncListTuples(ctx, object, relation, revision)
+	}
+
+	mm_params := RelationTupleRepositoryMockListTuplesParams{ctx, object, relation, revision}
+
+	// Record call args
+	mmListTuples.ListTuplesMock.mutex.Lock()
+	mmListTuples.ListTuplesMock.callArgs = append(mmListTuples.ListTuplesMock.callArgs, &mm_params)
+	mmListTuples.ListTuplesMock.mutex.Unlock()
+
+	for _, e := range mmListTuples.ListTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListTuples.ListTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListTuples.ListTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListTuples.ListTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmListTuples.ListTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationTupleRepositoryMockListTuplesParams{ctx, object, relation, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListTuples.t.Errorf("RelationTupleRepositoryMock.ListTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTuples.ListTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.object != nil && !minimock.Equal(*mm_want_ptrs.object, mm_got.object) {
+				mmListTuples.t.Errorf("RelationTupleRepositoryMock.ListTuples got unexpected parameter object, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTuples.ListTuplesMock.defaultExpectation.expectationOrigins.originObject, *mm_want_ptrs.object, mm_got.object, minimock.Diff(*mm_want_ptrs.object, mm_got.object))
+			}
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmListTuples.t.Errorf("RelationTupleRepositoryMock.ListTuples got unexpected parameter relation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTuples.ListTuplesMock.defaultExpectation.expectationOrigins.originRelation, *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmListTuples.t.Errorf("RelationTupleRepositoryMock.ListTuples got unexpected parameter revision, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTuples.ListTuplesMock.defaultExpectation.expectationOrigins.originRevision, *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListTuples.t.Errorf("RelationTupleRepositoryMock.ListTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListTuples.ListTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListTuples.ListTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListTuples.t.Fatal("No results are set for the RelationTupleRepositoryMock.ListTuples")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListTuples.funcListTuples != nil {
+		return mmListTuples.funcListTuples(ctx, object, relation, revision)
+	}
+	mmListTuples.t.Fatalf("Unexpected call to RelationTupleRepositoryMock.ListTuples. %v %v %v %v", ctx, object, relation, revision)
+	return
+}
+
+// ListTuplesAfterCounter returns a count of finished RelationTupleRepositoryMock.ListTuples invocations
+func (mmListTuples *RelationTupleRepositoryMock) ListTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTuples.afterListTuplesCounter)
+}
+
+// ListTuplesBeforeCounter returns a count of RelationTupleRepositoryMock.ListTuples invocations
+func (mmListTuples *RelationTupleRepositoryMock) ListTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTuples.beforeListTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationTupleRepositoryMock.ListTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListTuples *mRelationTupleRepositoryMockListTuples) Calls() []*RelationTupleRepositoryMockListTuplesParams {
+	mmListTuples.mutex.RLock()
+
+	argCopy := make([]*RelationTupleRepositoryMockListTuplesParams, len(mmListTuples.callArgs))
+	copy(argCopy, mmListTuples.callArgs)
+
+	mmListTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListTuplesDone returns true if the count of the ListTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationTupleRepositoryMock) MinimockListTuplesDone() bool {
+	if m.ListTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListTuplesMock.invocationsDone()
+}
+
+// MinimockListTuplesInspect logs each unmet expectation
+func (m *RelationTupleRepositoryMock) MinimockListTuplesInspect() {
+	for _, e := range m.ListTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListTuplesCounter := mm_atomic.LoadUint64(&m.afterListTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTuplesMock.defaultExpectation != nil && afterListTuplesCounter < 1 {
+		if m.ListTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListTuples at\n%s", m.ListTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListTuples at\n%s with params: %#v", m.ListTuplesMock.defaultExpectation.expectationOrigins.origin, *m.ListTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTuples != nil && afterListTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationTupleRepositoryMock.ListTuples at\n%s", m.funcListTuplesOrigin)
+	}
+
+	if !m.ListTuplesMock.invocationsDone() && afterListTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationTupleRepositoryMock.ListTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListTuplesMock.expectedInvocations), m.ListTuplesMock.expectedInvocationsOrigin, afterListTuplesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationTupleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateTupleInspect()
+
+			m.MinimockDeleteTupleInspect()
+
+			m.MinimockGetRevisionInspect()
+
+			m.MinimockIncrementRevisionInspect()
+
+			m.MinimockListObjectIDsInspect()
+
+			m.MinimockListTuplesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationTupleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationTupleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateTupleDone() &&
+		m.MinimockDeleteTupleDone() &&
+		m.MinimockGetRevisionDone() &&
+		m.MinimockIncrementRevisionDone() &&
+		m.MinimockListObjectIDsDone() &&
+		m.MinimockListTuplesDone()
+}
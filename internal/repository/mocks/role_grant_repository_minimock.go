@@ -0,0 +1,3032 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.RoleGrantRepository -o role_grant_repository_minimock.go -n RoleGrantRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// RoleGrantRepositoryMock implements mm_repository.RoleGrantRepository
+type RoleGrantRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateRoleGrant          func(ctx context.Context, grant *model.RoleGrant) (i1 int64, err error)
+	funcCreateRoleGrantOrigin    string
+	inspectFuncCreateRoleGrant   func(ctx context.Context, grant *model.RoleGrant)
+	afterCreateRoleGrantCounter  uint64
+	beforeCreateRoleGrantCounter uint64
+	CreateRoleGrantMock          mRoleGrantRepositoryMockCreateRoleGrant
+
+	funcDeleteRoleGrantsByUserID          func(ctx context.Context, userID int64) (err error)
+	funcDeleteRoleGrantsByUserIDOrigin    string
+	inspectFuncDeleteRoleGrantsByUserID   func(ctx context.Context, userID int64)
+	afterDeleteRoleGrantsByUserIDCounter  uint64
+	beforeDeleteRoleGrantsByUserIDCounter uint64
+	DeleteRoleGrantsByUserIDMock          mRoleGrantRepositoryMockDeleteRoleGrantsByUserID
+
+	funcExpireRoleGrants          func(ctx context.Context, at time.Time) (i1 int64, err error)
+	funcExpireRoleGrantsOrigin    string
+	inspectFuncExpireRoleGrants   func(ctx context.Context, at time.Time)
+	afterExpireRoleGrantsCounter  uint64
+	beforeExpireRoleGrantsCounter uint64
+	ExpireRoleGrantsMock          mRoleGrantRepositoryMockExpireRoleGrants
+
+	funcGetActiveRoleGrant          func(ctx context.Context, userID int64, at time.Time) (rp1 *model.RoleGrant, err error)
+	funcGetActiveRoleGrantOrigin    string
+	inspectFuncGetActiveRoleGrant   func(ctx context.Context, userID int64, at time.Time)
+	afterGetActiveRoleGrantCounter  uint64
+	beforeGetActiveRoleGrantCounter uint64
+	GetActiveRoleGrantMock          mRoleGrantRepositoryMockGetActiveRoleGrant
+
+	funcGetRoleGrant          func(ctx context.Context, id int64) (rp1 *model.RoleGrant, err error)
+	funcGetRoleGrantOrigin    string
+	inspectFuncGetRoleGrant   func(ctx context.Context, id int64)
+	afterGetRoleGrantCounter  uint64
+	beforeGetRoleGrantCounter uint64
+	GetRoleGrantMock          mRoleGrantRepositoryMockGetRoleGrant
+
+	funcListRoleGrants          func(ctx context.Context, filter *model.RoleGrantFilter) (rpa1 []*model.RoleGrant, err error)
+	funcListRoleGrantsOrigin    string
+	inspectFuncListRoleGrants   func(ctx context.Context, filter *model.RoleGrantFilter)
+	afterListRoleGrantsCounter  uint64
+	beforeListRoleGrantsCounter uint64
+	ListRoleGrantsMock          mRoleGrantRepositoryMockListRoleGrants
+
+	funcPseudonymizeReviewer          func(ctx context.Context, username string, pseudonym string) (err error)
+	funcPseudonymizeReviewerOrigin    string
+	inspectFuncPseudonymizeReviewer   func(ctx context.Context, username string, pseudonym string)
+	afterPseudonymizeReviewerCounter  uint64
+	beforePseudonymizeReviewerCounter uint64
+	PseudonymizeReviewerMock          mRoleGrantRepositoryMockPseudonymizeReviewer
+
+	funcUpdateRoleGrantStatus          func(ctx context.Context, id int64, status string, reviewedBy string) (err error)
+	funcUpdateRoleGrantStatusOrigin    string
+	inspectFuncUpdateRoleGrantStatus   func(ctx context.Context, id int64, status string, reviewedBy string)
+	afterUpdateRoleGrantStatusCounter  uint64
+	beforeUpdateRoleGrantStatusCounter uint64
+	UpdateRoleGrantStatusMock          mRoleGrantRepositoryMockUpdateRoleGrantStatus
+}
+
+// NewRoleGrantRepositoryMock returns a mock for mm_repository.RoleGrantRepository
+func NewRoleGrantRepositoryMock(t minimock.Tester) *RoleGrantRepositoryMock {
+	m := &RoleGrantRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateRoleGrantMock = mRoleGrantRepositoryMockCreateRoleGrant{mock: m}
+	m.CreateRoleGrantMock.callArgs = []*RoleGrantRepositoryMockCreateRoleGrantParams{}
+
+	m.DeleteRoleGrantsByUserIDMock = mRoleGrantRepositoryMockDeleteRoleGrantsByUserID{mock: m}
+	m.DeleteRoleGrantsByUserIDMock.callArgs = []*RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams{}
+
+	m.ExpireRoleGrantsMock = mRoleGrantRepositoryMockExpireRoleGrants{mock: m}
+	m.ExpireRoleGrantsMock.callArgs = []*RoleGrantRepositoryMockExpireRoleGrantsParams{}
+
+	m.GetActiveRoleGrantMock = mRoleGrantRepositoryMockGetActiveRoleGrant{mock: m}
+	m.GetActiveRoleGrantMock.callArgs = []*RoleGrantRepositoryMockGetActiveRoleGrantParams{}
+
+	m.GetRoleGrantMock = mRoleGrantRepositoryMockGetRoleGrant{mock: m}
+	m.GetRoleGrantMock.callArgs = []*RoleGrantRepositoryMockGetRoleGrantParams{}
+
+	m.ListRoleGrantsMock = mRoleGrantRepositoryMockListRoleGrants{mock: m}
+	m.ListRoleGrantsMock.callArgs = []*RoleGrantRepositoryMockListRoleGrantsParams{}
+
+	m.PseudonymizeReviewerMock = mRoleGrantRepositoryMockPseudonymizeReviewer{mock: m}
+	m.PseudonymizeReviewerMock.callArgs = []*RoleGrantRepositoryMockPseudonymizeReviewerParams{}
+
+	m.UpdateRoleGrantStatusMock = mRoleGrantRepositoryMockUpdateRoleGrantStatus{mock: m}
+	m.UpdateRoleGrantStatusMock.callArgs = []*RoleGrantRepositoryMockUpdateRoleGrantStatusParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleGrantRepositoryMockCreateRoleGrant struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockCreateRoleGrantExpectation
+	expectations       []*RoleGrantRepositoryMockCreateRoleGrantExpectation
+
+	callArgs []*RoleGrantRepositoryMockCreateRoleGrantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockCreateRoleGrantExpectation specifies expectation struct of the RoleGrantRepository.CreateRoleGrant
+type RoleGrantRepositoryMockCreateRoleGrantExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockCreateRoleGrantParams
+	paramPtrs          *RoleGrantRepositoryMockCreateRoleGrantParamPtrs
+	expectationOrigins RoleGrantRepositoryMockCreateRoleGrantExpectationOrigins
+	results            *RoleGrantRepositoryMockCreateRoleGrantResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockCreateRoleGrantParams contains parameters of the RoleGrantRepository.CreateRoleGrant
+type RoleGrantRepositoryMockCreateRoleGrantParams struct {
+	ctx   context.Context
+	grant *model.RoleGrant
+}
+
+// RoleGrantRepositoryMockCreateRoleGrantParamPtrs contains pointers to parameters of the RoleGrantRepository.CreateRoleGrant
+type RoleGrantRepositoryMockCreateRoleGrantParamPtrs struct {
+	ctx   *context.Context
+	grant **model.RoleGrant
+}
+
+// RoleGrantRepositoryMockCreateRoleGrantResults contains results of the RoleGrantRepository.CreateRoleGrant
+type RoleGrantRepositoryMockCreateRoleGrantResults struct {
+	i1  int64
+	err error
+}
+
+// RoleGrantRepositoryMockCreateRoleGrantOrigins contains origins of expectations of the RoleGrantRepository.CreateRoleGrant
+type RoleGrantRepositoryMockCreateRoleGrantExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originGrant string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Optional() *mRoleGrantRepositoryMockCreateRoleGrant {
+	mmCreateRoleGrant.optional = true
+	return mmCreateRoleGrant
+}
+
+// Expect sets up expected params for RoleGrantRepository.CreateRoleGrant
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Expect(ctx context.Context, grant *model.RoleGrant) *mRoleGrantRepositoryMockCreateRoleGrant {
+	if mmCreateRoleGrant.mock.funcCreateRoleGrant != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Set")
+	}
+
+	if mmCreateRoleGrant.defaultExpectation == nil {
+		mmCreateRoleGrant.defaultExpectation = &RoleGrantRepositoryMockCreateRoleGrantExpectation{}
+	}
+
+	if mmCreateRoleGrant.defaultExpectation.paramPtrs != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRoleGrant.defaultExpectation.params = &RoleGrantRepositoryMockCreateRoleGrantParams{ctx, grant}
+	mmCreateRoleGrant.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRoleGrant.expectations {
+		if minimock.Equal(e.params, mmCreateRoleGrant.defaultExpectation.params) {
+			mmCreateRoleGrant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRoleGrant.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRoleGrant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.CreateRoleGrant
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockCreateRoleGrant {
+	if mmCreateRoleGrant.mock.funcCreateRoleGrant != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Set")
+	}
+
+	if mmCreateRoleGrant.defaultExpectation == nil {
+		mmCreateRoleGrant.defaultExpectation = &RoleGrantRepositoryMockCreateRoleGrantExpectation{}
+	}
+
+	if mmCreateRoleGrant.defaultExpectation.params != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Expect")
+	}
+
+	if mmCreateRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmCreateRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockCreateRoleGrantParamPtrs{}
+	}
+	mmCreateRoleGrant.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRoleGrant.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRoleGrant
+}
+
+// ExpectGrantParam2 sets up expected param grant for RoleGrantRepository.CreateRoleGrant
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) ExpectGrantParam2(grant *model.RoleGrant) *mRoleGrantRepositoryMockCreateRoleGrant {
+	if mmCreateRoleGrant.mock.funcCreateRoleGrant != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Set")
+	}
+
+	if mmCreateRoleGrant.defaultExpectation == nil {
+		mmCreateRoleGrant.defaultExpectation = &RoleGrantRepositoryMockCreateRoleGrantExpectation{}
+	}
+
+	if mmCreateRoleGrant.defaultExpectation.params != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Expect")
+	}
+
+	if mmCreateRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmCreateRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockCreateRoleGrantParamPtrs{}
+	}
+	mmCreateRoleGrant.defaultExpectation.paramPtrs.grant = &grant
+	mmCreateRoleGrant.defaultExpectation.expectationOrigins.originGrant = minimock.CallerInfo(1)
+
+	return mmCreateRoleGrant
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.CreateRoleGrant
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Inspect(f func(ctx context.Context, grant *model.RoleGrant)) *mRoleGrantRepositoryMockCreateRoleGrant {
+	if mmCreateRoleGrant.mock.inspectFuncCreateRoleGrant != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.CreateRoleGrant")
+	}
+
+	mmCreateRoleGrant.mock.inspectFuncCreateRoleGrant = f
+
+	return mmCreateRoleGrant
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.CreateRoleGrant
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Return(i1 int64, err error) *RoleGrantRepositoryMock {
+	if mmCreateRoleGrant.mock.funcCreateRoleGrant != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Set")
+	}
+
+	if mmCreateRoleGrant.defaultExpectation == nil {
+		mmCreateRoleGrant.defaultExpectation = &RoleGrantRepositoryMockCreateRoleGrantExpectation{mock: mmCreateRoleGrant.mock}
+	}
+	mmCreateRoleGrant.defaultExpectation.results = &RoleGrantRepositoryMockCreateRoleGrantResults{i1, err}
+	mmCreateRoleGrant.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRoleGrant.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.CreateRoleGrant method
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Set(f func(ctx context.Context, grant *model.RoleGrant) (i1 int64, err error)) *RoleGrantRepositoryMock {
+	if mmCreateRoleGrant.defaultExpectation != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.CreateRoleGrant method")
+	}
+
+	if len(mmCreateRoleGrant.expectations) > 0 {
+		mmCreateRoleGrant.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.CreateRoleGrant method")
+	}
+
+	mmCreateRoleGrant.mock.funcCreateRoleGrant = f
+	mmCreateRoleGrant.mock.funcCreateRoleGrantOrigin = minimock.CallerInfo(1)
+	return mmCreateRoleGrant.mock
+}
+
+// When sets expectation for the RoleGrantRepository.CreateRoleGrant which will trigger the result defined by the following
+// Then helper
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) When(ctx context.Context, grant *model.RoleGrant) *RoleGrantRepositoryMockCreateRoleGrantExpectation {
+	if mmCreateRoleGrant.mock.funcCreateRoleGrant != nil {
+		mmCreateRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.CreateRoleGrant mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockCreateRoleGrantExpectation{
+		mock:               mmCreateRoleGrant.mock,
+		params:             &RoleGrantRepositoryMockCreateRoleGrantParams{ctx, grant},
+		expectationOrigins: RoleGrantRepositoryMockCreateRoleGrantExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRoleGrant.expectations = append(mmCreateRoleGrant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.CreateRoleGrant return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockCreateRoleGrantExpectation) Then(i1 int64, err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockCreateRoleGrantResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.CreateRoleGrant should be invoked
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Times(n uint64) *mRoleGrantRepositoryMockCreateRoleGrant {
+	if n == 0 {
+		mmCreateRoleGrant.mock.t.Fatalf("Times of RoleGrantRepositoryMock.CreateRoleGrant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRoleGrant.expectedInvocations, n)
+	mmCreateRoleGrant.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRoleGrant
+}
+
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) invocationsDone() bool {
+	if len(mmCreateRoleGrant.expectations) == 0 && mmCreateRoleGrant.defaultExpectation == nil && mmCreateRoleGrant.mock.funcCreateRoleGrant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRoleGrant.mock.afterCreateRoleGrantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRoleGrant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRoleGrant implements mm_repository.RoleGrantRepository
+func (mmCreateRoleGrant *RoleGrantRepositoryMock) CreateRoleGrant(ctx context.Context, grant *model.RoleGrant) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRoleGrant.beforeCreateRoleGrantCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRoleGrant.afterCreateRoleGrantCounter, 1)
+
+	mmCreateRoleGrant.t.Helper()
+
+	if mmCreateRoleGrant.inspectFuncCreateRoleGrant != nil {
+		mmCreateRoleGrant.inspectFuncCreateRoleGrant(ctx, grant)
+	}
+
+	mm_params := RoleGrantRepositoryMockCreateRoleGrantParams{ctx, grant}
+
+	// Record call args
+	mmCreateRoleGrant.CreateRoleGrantMock.mutex.Lock()
+	mmCreateRoleGrant.CreateRoleGrantMock.callArgs = append(mmCreateRoleGrant.CreateRoleGrantMock.callArgs, &mm_params)
+	mmCreateRoleGrant.CreateRoleGrantMock.mutex.Unlock()
+
+	for _, e := range mmCreateRoleGrant.CreateRoleGrantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockCreateRoleGrantParams{ctx, grant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRoleGrant.t.Errorf("RoleGrantRepositoryMock.CreateRoleGrant got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grant != nil && !minimock.Equal(*mm_want_ptrs.grant, mm_got.grant) {
+				mmCreateRoleGrant.t.Errorf("RoleGrantRepositoryMock.CreateRoleGrant got unexpected parameter grant, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.expectationOrigins.originGrant, *mm_want_ptrs.grant, mm_got.grant, minimock.Diff(*mm_want_ptrs.grant, mm_got.grant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRoleGrant.t.Errorf("RoleGrantRepositoryMock.CreateRoleGrant got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRoleGrant.CreateRoleGrantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRoleGrant.t.Fatal("No results are set for the RoleGrantRepositoryMock.CreateRoleGrant")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRoleGrant.funcCreateRoleGrant != nil {
+		return mmCreateRoleGrant.funcCreateRoleGrant(ctx, grant)
+	}
+	mmCreateRoleGrant.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.CreateRoleGrant. %v %v", ctx, grant)
+	return
+}
+
+// CreateRoleGrantAfterCounter returns a count of finished RoleGrantRepositoryMock.CreateRoleGrant invocations
+func (mmCreateRoleGrant *RoleGrantRepositoryMock) CreateRoleGrantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRoleGrant.afterCreateRoleGrantCounter)
+}
+
+// CreateRoleGrantBeforeCounter returns a count of RoleGrantRepositoryMock.CreateRoleGrant invocations
+func (mmCreateRoleGrant *RoleGrantRepositoryMock) CreateRoleGrantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRoleGrant.beforeCreateRoleGrantCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.CreateRoleGrant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRoleGrant *mRoleGrantRepositoryMockCreateRoleGrant) Calls() []*RoleGrantRepositoryMockCreateRoleGrantParams {
+	mmCreateRoleGrant.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockCreateRoleGrantParams, len(mmCreateRoleGrant.callArgs))
+	copy(argCopy, mmCreateRoleGrant.callArgs)
+
+	mmCreateRoleGrant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRoleGrantDone returns true if the count of the CreateRoleGrant invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockCreateRoleGrantDone() bool {
+	if m.CreateRoleGrantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRoleGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRoleGrantMock.invocationsDone()
+}
+
+// MinimockCreateRoleGrantInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockCreateRoleGrantInspect() {
+	for _, e := range m.CreateRoleGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.CreateRoleGrant at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRoleGrantCounter := mm_atomic.LoadUint64(&m.afterCreateRoleGrantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRoleGrantMock.defaultExpectation != nil && afterCreateRoleGrantCounter < 1 {
+		if m.CreateRoleGrantMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.CreateRoleGrant at\n%s", m.CreateRoleGrantMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.CreateRoleGrant at\n%s with params: %#v", m.CreateRoleGrantMock.defaultExpectation.expectationOrigins.origin, *m.CreateRoleGrantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRoleGrant != nil && afterCreateRoleGrantCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.CreateRoleGrant at\n%s", m.funcCreateRoleGrantOrigin)
+	}
+
+	if !m.CreateRoleGrantMock.invocationsDone() && afterCreateRoleGrantCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.CreateRoleGrant at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRoleGrantMock.expectedInvocations), m.CreateRoleGrantMock.expectedInvocationsOrigin, afterCreateRoleGrantCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockDeleteRoleGrantsByUserID struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation
+	expectations       []*RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation
+
+	callArgs []*RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation specifies expectation struct of the RoleGrantRepository.DeleteRoleGrantsByUserID
+type RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams
+	paramPtrs          *RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParamPtrs
+	expectationOrigins RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectationOrigins
+	results            *RoleGrantRepositoryMockDeleteRoleGrantsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams contains parameters of the RoleGrantRepository.DeleteRoleGrantsByUserID
+type RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParamPtrs contains pointers to parameters of the RoleGrantRepository.DeleteRoleGrantsByUserID
+type RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// RoleGrantRepositoryMockDeleteRoleGrantsByUserIDResults contains results of the RoleGrantRepository.DeleteRoleGrantsByUserID
+type RoleGrantRepositoryMockDeleteRoleGrantsByUserIDResults struct {
+	err error
+}
+
+// RoleGrantRepositoryMockDeleteRoleGrantsByUserIDOrigins contains origins of expectations of the RoleGrantRepository.DeleteRoleGrantsByUserID
+type RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Optional() *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID {
+	mmDeleteRoleGrantsByUserID.optional = true
+	return mmDeleteRoleGrantsByUserID
+}
+
+// Expect sets up expected params for RoleGrantRepository.DeleteRoleGrantsByUserID
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Expect(ctx context.Context, userID int64) *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID {
+	if mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation == nil {
+		mmDeleteRoleGrantsByUserID.defaultExpectation = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation{}
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRoleGrantsByUserID.defaultExpectation.params = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams{ctx, userID}
+	mmDeleteRoleGrantsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRoleGrantsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteRoleGrantsByUserID.defaultExpectation.params) {
+			mmDeleteRoleGrantsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRoleGrantsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRoleGrantsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.DeleteRoleGrantsByUserID
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID {
+	if mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation == nil {
+		mmDeleteRoleGrantsByUserID.defaultExpectation = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation{}
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation.params != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParamPtrs{}
+	}
+	mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRoleGrantsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRoleGrantsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RoleGrantRepository.DeleteRoleGrantsByUserID
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) ExpectUserIDParam2(userID int64) *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID {
+	if mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation == nil {
+		mmDeleteRoleGrantsByUserID.defaultExpectation = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation{}
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation.params != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParamPtrs{}
+	}
+	mmDeleteRoleGrantsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteRoleGrantsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteRoleGrantsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.DeleteRoleGrantsByUserID
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Inspect(f func(ctx context.Context, userID int64)) *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID {
+	if mmDeleteRoleGrantsByUserID.mock.inspectFuncDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.DeleteRoleGrantsByUserID")
+	}
+
+	mmDeleteRoleGrantsByUserID.mock.inspectFuncDeleteRoleGrantsByUserID = f
+
+	return mmDeleteRoleGrantsByUserID
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.DeleteRoleGrantsByUserID
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Return(err error) *RoleGrantRepositoryMock {
+	if mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteRoleGrantsByUserID.defaultExpectation == nil {
+		mmDeleteRoleGrantsByUserID.defaultExpectation = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation{mock: mmDeleteRoleGrantsByUserID.mock}
+	}
+	mmDeleteRoleGrantsByUserID.defaultExpectation.results = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDResults{err}
+	mmDeleteRoleGrantsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleGrantsByUserID.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.DeleteRoleGrantsByUserID method
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Set(f func(ctx context.Context, userID int64) (err error)) *RoleGrantRepositoryMock {
+	if mmDeleteRoleGrantsByUserID.defaultExpectation != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.DeleteRoleGrantsByUserID method")
+	}
+
+	if len(mmDeleteRoleGrantsByUserID.expectations) > 0 {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.DeleteRoleGrantsByUserID method")
+	}
+
+	mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID = f
+	mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleGrantsByUserID.mock
+}
+
+// When sets expectation for the RoleGrantRepository.DeleteRoleGrantsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) When(ctx context.Context, userID int64) *RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation {
+	if mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation{
+		mock:               mmDeleteRoleGrantsByUserID.mock,
+		params:             &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams{ctx, userID},
+		expectationOrigins: RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRoleGrantsByUserID.expectations = append(mmDeleteRoleGrantsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.DeleteRoleGrantsByUserID return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockDeleteRoleGrantsByUserIDExpectation) Then(err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockDeleteRoleGrantsByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.DeleteRoleGrantsByUserID should be invoked
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Times(n uint64) *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID {
+	if n == 0 {
+		mmDeleteRoleGrantsByUserID.mock.t.Fatalf("Times of RoleGrantRepositoryMock.DeleteRoleGrantsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRoleGrantsByUserID.expectedInvocations, n)
+	mmDeleteRoleGrantsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleGrantsByUserID
+}
+
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) invocationsDone() bool {
+	if len(mmDeleteRoleGrantsByUserID.expectations) == 0 && mmDeleteRoleGrantsByUserID.defaultExpectation == nil && mmDeleteRoleGrantsByUserID.mock.funcDeleteRoleGrantsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRoleGrantsByUserID.mock.afterDeleteRoleGrantsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRoleGrantsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRoleGrantsByUserID implements mm_repository.RoleGrantRepository
+func (mmDeleteRoleGrantsByUserID *RoleGrantRepositoryMock) DeleteRoleGrantsByUserID(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRoleGrantsByUserID.beforeDeleteRoleGrantsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRoleGrantsByUserID.afterDeleteRoleGrantsByUserIDCounter, 1)
+
+	mmDeleteRoleGrantsByUserID.t.Helper()
+
+	if mmDeleteRoleGrantsByUserID.inspectFuncDeleteRoleGrantsByUserID != nil {
+		mmDeleteRoleGrantsByUserID.inspectFuncDeleteRoleGrantsByUserID(ctx, userID)
+	}
+
+	mm_params := RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.mutex.Lock()
+	mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.callArgs = append(mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.callArgs, &mm_params)
+	mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRoleGrantsByUserID.t.Errorf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteRoleGrantsByUserID.t.Errorf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRoleGrantsByUserID.t.Errorf("RoleGrantRepositoryMock.DeleteRoleGrantsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRoleGrantsByUserID.DeleteRoleGrantsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRoleGrantsByUserID.t.Fatal("No results are set for the RoleGrantRepositoryMock.DeleteRoleGrantsByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRoleGrantsByUserID.funcDeleteRoleGrantsByUserID != nil {
+		return mmDeleteRoleGrantsByUserID.funcDeleteRoleGrantsByUserID(ctx, userID)
+	}
+	mmDeleteRoleGrantsByUserID.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteRoleGrantsByUserIDAfterCounter returns a count of finished RoleGrantRepositoryMock.DeleteRoleGrantsByUserID invocations
+func (mmDeleteRoleGrantsByUserID *RoleGrantRepositoryMock) DeleteRoleGrantsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRoleGrantsByUserID.afterDeleteRoleGrantsByUserIDCounter)
+}
+
+// DeleteRoleGrantsByUserIDBeforeCounter returns a count of RoleGrantRepositoryMock.DeleteRoleGrantsByUserID invocations
+func (mmDeleteRoleGrantsByUserID *RoleGrantRepositoryMock) DeleteRoleGrantsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRoleGrantsByUserID.beforeDeleteRoleGrantsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRoleGrantsByUserID *mRoleGrantRepositoryMockDeleteRoleGrantsByUserID) Calls() []*RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams {
+	mmDeleteRoleGrantsByUserID.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockDeleteRoleGrantsByUserIDParams, len(mmDeleteRoleGrantsByUserID.callArgs))
+	copy(argCopy, mmDeleteRoleGrantsByUserID.callArgs)
+
+	mmDeleteRoleGrantsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleGrantsByUserIDDone returns true if the count of the DeleteRoleGrantsByUserID invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockDeleteRoleGrantsByUserIDDone() bool {
+	if m.DeleteRoleGrantsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleGrantsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleGrantsByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteRoleGrantsByUserIDInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockDeleteRoleGrantsByUserIDInspect() {
+	for _, e := range m.DeleteRoleGrantsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRoleGrantsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleGrantsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleGrantsByUserIDMock.defaultExpectation != nil && afterDeleteRoleGrantsByUserIDCounter < 1 {
+		if m.DeleteRoleGrantsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID at\n%s", m.DeleteRoleGrantsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID at\n%s with params: %#v", m.DeleteRoleGrantsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRoleGrantsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRoleGrantsByUserID != nil && afterDeleteRoleGrantsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID at\n%s", m.funcDeleteRoleGrantsByUserIDOrigin)
+	}
+
+	if !m.DeleteRoleGrantsByUserIDMock.invocationsDone() && afterDeleteRoleGrantsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.DeleteRoleGrantsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleGrantsByUserIDMock.expectedInvocations), m.DeleteRoleGrantsByUserIDMock.expectedInvocationsOrigin, afterDeleteRoleGrantsByUserIDCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockExpireRoleGrants struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockExpireRoleGrantsExpectation
+	expectations       []*RoleGrantRepositoryMockExpireRoleGrantsExpectation
+
+	callArgs []*RoleGrantRepositoryMockExpireRoleGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockExpireRoleGrantsExpectation specifies expectation struct of the RoleGrantRepository.ExpireRoleGrants
+type RoleGrantRepositoryMockExpireRoleGrantsExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockExpireRoleGrantsParams
+	paramPtrs          *RoleGrantRepositoryMockExpireRoleGrantsParamPtrs
+	expectationOrigins RoleGrantRepositoryMockExpireRoleGrantsExpectationOrigins
+	results            *RoleGrantRepositoryMockExpireRoleGrantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockExpireRoleGrantsParams contains parameters of the RoleGrantRepository.ExpireRoleGrants
+type RoleGrantRepositoryMockExpireRoleGrantsParams struct {
+	ctx context.Context
+	at  time.Time
+}
+
+// RoleGrantRepositoryMockExpireRoleGrantsParamPtrs contains pointers to parameters of the RoleGrantRepository.ExpireRoleGrants
+type RoleGrantRepositoryMockExpireRoleGrantsParamPtrs struct {
+	ctx *context.Context
+	at  *time.Time
+}
+
+// RoleGrantRepositoryMockExpireRoleGrantsResults contains results of the RoleGrantRepository.ExpireRoleGrants
+type RoleGrantRepositoryMockExpireRoleGrantsResults struct {
+	i1  int64
+	err error
+}
+
+// RoleGrantRepositoryMockExpireRoleGrantsOrigins contains origins of expectations of the RoleGrantRepository.ExpireRoleGrants
+type RoleGrantRepositoryMockExpireRoleGrantsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originAt  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Optional() *mRoleGrantRepositoryMockExpireRoleGrants {
+	mmExpireRoleGrants.optional = true
+	return mmExpireRoleGrants
+}
+
+// Expect sets up expected params for RoleGrantRepository.ExpireRoleGrants
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Expect(ctx context.Context, at time.Time) *mRoleGrantRepositoryMockExpireRoleGrants {
+	if mmExpireRoleGrants.mock.funcExpireRoleGrants != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Set")
+	}
+
+	if mmExpireRoleGrants.defaultExpectation == nil {
+		mmExpireRoleGrants.defaultExpectation = &RoleGrantRepositoryMockExpireRoleGrantsExpectation{}
+	}
+
+	if mmExpireRoleGrants.defaultExpectation.paramPtrs != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by ExpectParams functions")
+	}
+
+	mmExpireRoleGrants.defaultExpectation.params = &RoleGrantRepositoryMockExpireRoleGrantsParams{ctx, at}
+	mmExpireRoleGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireRoleGrants.expectations {
+		if minimock.Equal(e.params, mmExpireRoleGrants.defaultExpectation.params) {
+			mmExpireRoleGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireRoleGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireRoleGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.ExpireRoleGrants
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockExpireRoleGrants {
+	if mmExpireRoleGrants.mock.funcExpireRoleGrants != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Set")
+	}
+
+	if mmExpireRoleGrants.defaultExpectation == nil {
+		mmExpireRoleGrants.defaultExpectation = &RoleGrantRepositoryMockExpireRoleGrantsExpectation{}
+	}
+
+	if mmExpireRoleGrants.defaultExpectation.params != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Expect")
+	}
+
+	if mmExpireRoleGrants.defaultExpectation.paramPtrs == nil {
+		mmExpireRoleGrants.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockExpireRoleGrantsParamPtrs{}
+	}
+	mmExpireRoleGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireRoleGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpireRoleGrants
+}
+
+// ExpectAtParam2 sets up expected param at for RoleGrantRepository.ExpireRoleGrants
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) ExpectAtParam2(at time.Time) *mRoleGrantRepositoryMockExpireRoleGrants {
+	if mmExpireRoleGrants.mock.funcExpireRoleGrants != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Set")
+	}
+
+	if mmExpireRoleGrants.defaultExpectation == nil {
+		mmExpireRoleGrants.defaultExpectation = &RoleGrantRepositoryMockExpireRoleGrantsExpectation{}
+	}
+
+	if mmExpireRoleGrants.defaultExpectation.params != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Expect")
+	}
+
+	if mmExpireRoleGrants.defaultExpectation.paramPtrs == nil {
+		mmExpireRoleGrants.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockExpireRoleGrantsParamPtrs{}
+	}
+	mmExpireRoleGrants.defaultExpectation.paramPtrs.at = &at
+	mmExpireRoleGrants.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmExpireRoleGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.ExpireRoleGrants
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Inspect(f func(ctx context.Context, at time.Time)) *mRoleGrantRepositoryMockExpireRoleGrants {
+	if mmExpireRoleGrants.mock.inspectFuncExpireRoleGrants != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.ExpireRoleGrants")
+	}
+
+	mmExpireRoleGrants.mock.inspectFuncExpireRoleGrants = f
+
+	return mmExpireRoleGrants
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.ExpireRoleGrants
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Return(i1 int64, err error) *RoleGrantRepositoryMock {
+	if mmExpireRoleGrants.mock.funcExpireRoleGrants != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Set")
+	}
+
+	if mmExpireRoleGrants.defaultExpectation == nil {
+		mmExpireRoleGrants.defaultExpectation = &RoleGrantRepositoryMockExpireRoleGrantsExpectation{mock: mmExpireRoleGrants.mock}
+	}
+	mmExpireRoleGrants.defaultExpectation.results = &RoleGrantRepositoryMockExpireRoleGrantsResults{i1, err}
+	mmExpireRoleGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireRoleGrants.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.ExpireRoleGrants method
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Set(f func(ctx context.Context, at time.Time) (i1 int64, err error)) *RoleGrantRepositoryMock {
+	if mmExpireRoleGrants.defaultExpectation != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.ExpireRoleGrants method")
+	}
+
+	if len(mmExpireRoleGrants.expectations) > 0 {
+		mmExpireRoleGrants.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.ExpireRoleGrants method")
+	}
+
+	mmExpireRoleGrants.mock.funcExpireRoleGrants = f
+	mmExpireRoleGrants.mock.funcExpireRoleGrantsOrigin = minimock.CallerInfo(1)
+	return mmExpireRoleGrants.mock
+}
+
+// When sets expectation for the RoleGrantRepository.ExpireRoleGrants which will trigger the result defined by the following
+// Then helper
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) When(ctx context.Context, at time.Time) *RoleGrantRepositoryMockExpireRoleGrantsExpectation {
+	if mmExpireRoleGrants.mock.funcExpireRoleGrants != nil {
+		mmExpireRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ExpireRoleGrants mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockExpireRoleGrantsExpectation{
+		mock:               mmExpireRoleGrants.mock,
+		params:             &RoleGrantRepositoryMockExpireRoleGrantsParams{ctx, at},
+		expectationOrigins: RoleGrantRepositoryMockExpireRoleGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireRoleGrants.expectations = append(mmExpireRoleGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.ExpireRoleGrants return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockExpireRoleGrantsExpectation) Then(i1 int64, err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockExpireRoleGrantsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.ExpireRoleGrants should be invoked
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Times(n uint64) *mRoleGrantRepositoryMockExpireRoleGrants {
+	if n == 0 {
+		mmExpireRoleGrants.mock.t.Fatalf("Times of RoleGrantRepositoryMock.ExpireRoleGrants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireRoleGrants.expectedInvocations, n)
+	mmExpireRoleGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireRoleGrants
+}
+
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) invocationsDone() bool {
+	if len(mmExpireRoleGrants.expectations) == 0 && mmExpireRoleGrants.defaultExpectation == nil && mmExpireRoleGrants.mock.funcExpireRoleGrants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireRoleGrants.mock.afterExpireRoleGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireRoleGrants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireRoleGrants implements mm_repository.RoleGrantRepository
+func (mmExpireRoleGrants *RoleGrantRepositoryMock) ExpireRoleGrants(ctx context.Context, at time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmExpireRoleGrants.beforeExpireRoleGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireRoleGrants.afterExpireRoleGrantsCounter, 1)
+
+	mmExpireRoleGrants.t.Helper()
+
+	if mmExpireRoleGrants.inspectFuncExpireRoleGrants != nil {
+		mmExpireRoleGrants.inspectFuncExpireRoleGrants(ctx, at)
+	}
+
+	mm_params := RoleGrantRepositoryMockExpireRoleGrantsParams{ctx, at}
+
+	// Record call args
+	mmExpireRoleGrants.ExpireRoleGrantsMock.mutex.Lock()
+	mmExpireRoleGrants.ExpireRoleGrantsMock.callArgs = append(mmExpireRoleGrants.ExpireRoleGrantsMock.callArgs, &mm_params)
+	mmExpireRoleGrants.ExpireRoleGrantsMock.mutex.Unlock()
+
+	for _, e := range mmExpireRoleGrants.ExpireRoleGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockExpireRoleGrantsParams{ctx, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireRoleGrants.t.Errorf("RoleGrantRepositoryMock.ExpireRoleGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmExpireRoleGrants.t.Errorf("RoleGrantRepositoryMock.ExpireRoleGrants got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireRoleGrants.t.Errorf("RoleGrantRepositoryMock.ExpireRoleGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireRoleGrants.ExpireRoleGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireRoleGrants.t.Fatal("No results are set for the RoleGrantRepositoryMock.ExpireRoleGrants")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmExpireRoleGrants.funcExpireRoleGrants != nil {
+		return mmExpireRoleGrants.funcExpireRoleGrants(ctx, at)
+	}
+	mmExpireRoleGrants.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.ExpireRoleGrants. %v %v", ctx, at)
+	return
+}
+
+// ExpireRoleGrantsAfterCounter returns a count of finished RoleGrantRepositoryMock.ExpireRoleGrants invocations
+func (mmExpireRoleGrants *RoleGrantRepositoryMock) ExpireRoleGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireRoleGrants.afterExpireRoleGrantsCounter)
+}
+
+// ExpireRoleGrantsBeforeCounter returns a count of RoleGrantRepositoryMock.ExpireRoleGrants invocations
+func (mmExpireRoleGrants *RoleGrantRepositoryMock) ExpireRoleGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireRoleGrants.beforeExpireRoleGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.ExpireRoleGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireRoleGrants *mRoleGrantRepositoryMockExpireRoleGrants) Calls() []*RoleGrantRepositoryMockExpireRoleGrantsParams {
+	mmExpireRoleGrants.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockExpireRoleGrantsParams, len(mmExpireRoleGrants.callArgs))
+	copy(argCopy, mmExpireRoleGrants.callArgs)
+
+	mmExpireRoleGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireRoleGrantsDone returns true if the count of the ExpireRoleGrants invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockExpireRoleGrantsDone() bool {
+	if m.ExpireRoleGrantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireRoleGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireRoleGrantsMock.invocationsDone()
+}
+
+// MinimockExpireRoleGrantsInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockExpireRoleGrantsInspect() {
+	for _, e := range m.ExpireRoleGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.ExpireRoleGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireRoleGrantsCounter := mm_atomic.LoadUint64(&m.afterExpireRoleGrantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireRoleGrantsMock.defaultExpectation != nil && afterExpireRoleGrantsCounter < 1 {
+		if m.ExpireRoleGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.ExpireRoleGrants at\n%s", m.ExpireRoleGrantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.ExpireRoleGrants at\n%s with params: %#v", m.ExpireRoleGrantsMock.defaultExpectation.expectationOrigins.origin, *m.ExpireRoleGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireRoleGrants != nil && afterExpireRoleGrantsCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.ExpireRoleGrants at\n%s", m.funcExpireRoleGrantsOrigin)
+	}
+
+	if !m.ExpireRoleGrantsMock.invocationsDone() && afterExpireRoleGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.ExpireRoleGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireRoleGrantsMock.expectedInvocations), m.ExpireRoleGrantsMock.expectedInvocationsOrigin, afterExpireRoleGrantsCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockGetActiveRoleGrant struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockGetActiveRoleGrantExpectation
+	expectations       []*RoleGrantRepositoryMockGetActiveRoleGrantExpectation
+
+	callArgs []*RoleGrantRepositoryMockGetActiveRoleGrantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockGetActiveRoleGrantExpectation specifies expectation struct of the RoleGrantRepository.GetActiveRoleGrant
+type RoleGrantRepositoryMockGetActiveRoleGrantExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockGetActiveRoleGrantParams
+	paramPtrs          *RoleGrantRepositoryMockGetActiveRoleGrantParamPtrs
+	expectationOrigins RoleGrantRepositoryMockGetActiveRoleGrantExpectationOrigins
+	results            *RoleGrantRepositoryMockGetActiveRoleGrantResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockGetActiveRoleGrantParams contains parameters of the RoleGrantRepository.GetActiveRoleGrant
+type RoleGrantRepositoryMockGetActiveRoleGrantParams struct {
+	ctx    context.Context
+	userID int64
+	at     time.Time
+}
+
+// RoleGrantRepositoryMockGetActiveRoleGrantParamPtrs contains pointers to parameters of the RoleGrantRepository.GetActiveRoleGrant
+type RoleGrantRepositoryMockGetActiveRoleGrantParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	at     *time.Time
+}
+
+// RoleGrantRepositoryMockGetActiveRoleGrantResults contains results of the RoleGrantRepository.GetActiveRoleGrant
+type RoleGrantRepositoryMockGetActiveRoleGrantResults struct {
+	rp1 *model.RoleGrant
+	err error
+}
+
+// RoleGrantRepositoryMockGetActiveRoleGrantOrigins contains origins of expectations of the RoleGrantRepository.GetActiveRoleGrant
+type RoleGrantRepositoryMockGetActiveRoleGrantExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originAt     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Optional() *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	mmGetActiveRoleGrant.optional = true
+	return mmGetActiveRoleGrant
+}
+
+// Expect sets up expected params for RoleGrantRepository.GetActiveRoleGrant
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Expect(ctx context.Context, userID int64, at time.Time) *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	if mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Set")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation == nil {
+		mmGetActiveRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetActiveRoleGrantExpectation{}
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.paramPtrs != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by ExpectParams functions")
+	}
+
+	mmGetActiveRoleGrant.defaultExpectation.params = &RoleGrantRepositoryMockGetActiveRoleGrantParams{ctx, userID, at}
+	mmGetActiveRoleGrant.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetActiveRoleGrant.expectations {
+		if minimock.Equal(e.params, mmGetActiveRoleGrant.defaultExpectation.params) {
+			mmGetActiveRoleGrant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetActiveRoleGrant.defaultExpectation.params)
+		}
+	}
+
+	return mmGetActiveRoleGrant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.GetActiveRoleGrant
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	if mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Set")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation == nil {
+		mmGetActiveRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetActiveRoleGrantExpectation{}
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.params != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Expect")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmGetActiveRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockGetActiveRoleGrantParamPtrs{}
+	}
+	mmGetActiveRoleGrant.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetActiveRoleGrant.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetActiveRoleGrant
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RoleGrantRepository.GetActiveRoleGrant
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) ExpectUserIDParam2(userID int64) *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	if mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Set")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation == nil {
+		mmGetActiveRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetActiveRoleGrantExpectation{}
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.params != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Expect")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmGetActiveRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockGetActiveRoleGrantParamPtrs{}
+	}
+	mmGetActiveRoleGrant.defaultExpectation.paramPtrs.userID = &userID
+	mmGetActiveRoleGrant.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetActiveRoleGrant
+}
+
+// ExpectAtParam3 sets up expected param at for RoleGrantRepository.GetActiveRoleGrant
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) ExpectAtParam3(at time.Time) *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	if mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Set")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation == nil {
+		mmGetActiveRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetActiveRoleGrantExpectation{}
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.params != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Expect")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmGetActiveRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockGetActiveRoleGrantParamPtrs{}
+	}
+	mmGetActiveRoleGrant.defaultExpectation.paramPtrs.at = &at
+	mmGetActiveRoleGrant.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmGetActiveRoleGrant
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.GetActiveRoleGrant
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Inspect(f func(ctx context.Context, userID int64, at time.Time)) *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	if mmGetActiveRoleGrant.mock.inspectFuncGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.GetActiveRoleGrant")
+	}
+
+	mmGetActiveRoleGrant.mock.inspectFuncGetActiveRoleGrant = f
+
+	return mmGetActiveRoleGrant
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.GetActiveRoleGrant
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Return(rp1 *model.RoleGrant, err error) *RoleGrantRepositoryMock {
+	if mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Set")
+	}
+
+	if mmGetActiveRoleGrant.defaultExpectation == nil {
+		mmGetActiveRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetActiveRoleGrantExpectation{mock: mmGetActiveRoleGrant.mock}
+	}
+	mmGetActiveRoleGrant.defaultExpectation.results = &RoleGrantRepositoryMockGetActiveRoleGrantResults{rp1, err}
+	mmGetActiveRoleGrant.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetActiveRoleGrant.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.GetActiveRoleGrant method
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Set(f func(ctx context.Context, userID int64, at time.Time) (rp1 *model.RoleGrant, err error)) *RoleGrantRepositoryMock {
+	if mmGetActiveRoleGrant.defaultExpectation != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.GetActiveRoleGrant method")
+	}
+
+	if len(mmGetActiveRoleGrant.expectations) > 0 {
+		mmGetActiveRoleGrant.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.GetActiveRoleGrant method")
+	}
+
+	mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant = f
+	mmGetActiveRoleGrant.mock.funcGetActiveRoleGrantOrigin = minimock.CallerInfo(1)
+	return mmGetActiveRoleGrant.mock
+}
+
+// When sets expectation for the RoleGrantRepository.GetActiveRoleGrant which will trigger the result defined by the following
+// Then helper
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) When(ctx context.Context, userID int64, at time.Time) *RoleGrantRepositoryMockGetActiveRoleGrantExpectation {
+	if mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetActiveRoleGrant mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockGetActiveRoleGrantExpectation{
+		mock:               mmGetActiveRoleGrant.mock,
+		params:             &RoleGrantRepositoryMockGetActiveRoleGrantParams{ctx, userID, at},
+		expectationOrigins: RoleGrantRepositoryMockGetActiveRoleGrantExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetActiveRoleGrant.expectations = append(mmGetActiveRoleGrant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.GetActiveRoleGrant return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockGetActiveRoleGrantExpectation) Then(rp1 *model.RoleGrant, err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockGetActiveRoleGrantResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.GetActiveRoleGrant should be invoked
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Times(n uint64) *mRoleGrantRepositoryMockGetActiveRoleGrant {
+	if n == 0 {
+		mmGetActiveRoleGrant.mock.t.Fatalf("Times of RoleGrantRepositoryMock.GetActiveRoleGrant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetActiveRoleGrant.expectedInvocations, n)
+	mmGetActiveRoleGrant.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetActiveRoleGrant
+}
+
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) invocationsDone() bool {
+	if len(mmGetActiveRoleGrant.expectations) == 0 && mmGetActiveRoleGrant.defaultExpectation == nil && mmGetActiveRoleGrant.mock.funcGetActiveRoleGrant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetActiveRoleGrant.mock.afterGetActiveRoleGrantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetActiveRoleGrant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetActiveRoleGrant implements mm_repository.RoleGrantRepository
+func (mmGetActiveRoleGrant *RoleGrantRepositoryMock) GetActiveRoleGrant(ctx context.Context, userID int64, at time.Time) (rp1 *model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmGetActiveRoleGrant.beforeGetActiveRoleGrantCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetActiveRoleGrant.afterGetActiveRoleGrantCounter, 1)
+
+	mmGetActiveRoleGrant.t.Helper()
+
+	if mmGetActiveRoleGrant.inspectFuncGetActiveRoleGrant != nil {
+		mmGetActiveRoleGrant.inspectFuncGetActiveRoleGrant(ctx, userID, at)
+	}
+
+	mm_params := RoleGrantRepositoryMockGetActiveRoleGrantParams{ctx, userID, at}
+
+	// Record call args
+	mmGetActiveRoleGrant.GetActiveRoleGrantMock.mutex.Lock()
+	mmGetActiveRoleGrant.GetActiveRoleGrantMock.callArgs = append(mmGetActiveRoleGrant.GetActiveRoleGrantMock.callArgs, &mm_params)
+	mmGetActiveRoleGrant.GetActiveRoleGrantMock.mutex.Unlock()
+
+	for _, e := range mmGetActiveRoleGrant.GetActiveRoleGrantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.params
+		mm_want_ptrs := mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockGetActiveRoleGrantParams{ctx, userID, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetActiveRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetActiveRoleGrant got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetActiveRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetActiveRoleGrant got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmGetActiveRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetActiveRoleGrant got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetActiveRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetActiveRoleGrant got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetActiveRoleGrant.GetActiveRoleGrantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetActiveRoleGrant.t.Fatal("No results are set for the RoleGrantRepositoryMock.GetActiveRoleGrant")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetActiveRoleGrant.funcGetActiveRoleGrant != nil {
+		return mmGetActiveRoleGrant.funcGetActiveRoleGrant(ctx, userID, at)
+	}
+	mmGetActiveRoleGrant.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.GetActiveRoleGrant. %v %v %v", ctx, userID, at)
+	return
+}
+
+// GetActiveRoleGrantAfterCounter returns a count of finished RoleGrantRepositoryMock.GetActiveRoleGrant invocations
+func (mmGetActiveRoleGrant *RoleGrantRepositoryMock) GetActiveRoleGrantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActiveRoleGrant.afterGetActiveRoleGrantCounter)
+}
+
+// GetActiveRoleGrantBeforeCounter returns a count of RoleGrantRepositoryMock.GetActiveRoleGrant invocations
+func (mmGetActiveRoleGrant *RoleGrantRepositoryMock) GetActiveRoleGrantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActiveRoleGrant.beforeGetActiveRoleGrantCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.GetActiveRoleGrant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetActiveRoleGrant *mRoleGrantRepositoryMockGetActiveRoleGrant) Calls() []*RoleGrantRepositoryMockGetActiveRoleGrantParams {
+	mmGetActiveRoleGrant.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockGetActiveRoleGrantParams, len(mmGetActiveRoleGrant.callArgs))
+	copy(argCopy, mmGetActiveRoleGrant.callArgs)
+
+	mmGetActiveRoleGrant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetActiveRoleGrantDone returns true if the count of the GetActiveRoleGrant invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockGetActiveRoleGrantDone() bool {
+	if m.GetActiveRoleGrantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetActiveRoleGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetActiveRoleGrantMock.invocationsDone()
+}
+
+// MinimockGetActiveRoleGrantInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockGetActiveRoleGrantInspect() {
+	for _, e := range m.GetActiveRoleGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetActiveRoleGrant at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetActiveRoleGrantCounter := mm_atomic.LoadUint64(&m.afterGetActiveRoleGrantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetActiveRoleGrantMock.defaultExpectation != nil && afterGetActiveRoleGrantCounter < 1 {
+		if m.GetActiveRoleGrantMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetActiveRoleGrant at\n%s", m.GetActiveRoleGrantMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetActiveRoleGrant at\n%s with params: %#v", m.GetActiveRoleGrantMock.defaultExpectation.expectationOrigins.origin, *m.GetActiveRoleGrantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetActiveRoleGrant != nil && afterGetActiveRoleGrantCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetActiveRoleGrant at\n%s", m.funcGetActiveRoleGrantOrigin)
+	}
+
+	if !m.GetActiveRoleGrantMock.invocationsDone() && afterGetActiveRoleGrantCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.GetActiveRoleGrant at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetActiveRoleGrantMock.expectedInvocations), m.GetActiveRoleGrantMock.expectedInvocationsOrigin, afterGetActiveRoleGrantCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockGetRoleGrant struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockGetRoleGrantExpectation
+	expectations       []*RoleGrantRepositoryMockGetRoleGrantExpectation
+
+	callArgs []*RoleGrantRepositoryMockGetRoleGrantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockGetRoleGrantExpectation specifies expectation struct of the RoleGrantRepository.GetRoleGrant
+type RoleGrantRepositoryMockGetRoleGrantExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockGetRoleGrantParams
+	paramPtrs          *RoleGrantRepositoryMockGetRoleGrantParamPtrs
+	expectationOrigins RoleGrantRepositoryMockGetRoleGrantExpectationOrigins
+	results            *RoleGrantRepositoryMockGetRoleGrantResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockGetRoleGrantParams contains parameters of the RoleGrantRepository.GetRoleGrant
+type RoleGrantRepositoryMockGetRoleGrantParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// RoleGrantRepositoryMockGetRoleGrantParamPtrs contains pointers to parameters of the RoleGrantRepository.GetRoleGrant
+type RoleGrantRepositoryMockGetRoleGrantParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// RoleGrantRepositoryMockGetRoleGrantResults contains results of the RoleGrantRepository.GetRoleGrant
+type RoleGrantRepositoryMockGetRoleGrantResults struct {
+	rp1 *model.RoleGrant
+	err error
+}
+
+// RoleGrantRepositoryMockGetRoleGrantOrigins contains origins of expectations of the RoleGrantRepository.GetRoleGrant
+type RoleGrantRepositoryMockGetRoleGrantExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Optional() *mRoleGrantRepositoryMockGetRoleGrant {
+	mmGetRoleGrant.optional = true
+	return mmGetRoleGrant
+}
+
+// Expect sets up expected params for RoleGrantRepository.GetRoleGrant
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Expect(ctx context.Context, id int64) *mRoleGrantRepositoryMockGetRoleGrant {
+	if mmGetRoleGrant.mock.funcGetRoleGrant != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Set")
+	}
+
+	if mmGetRoleGrant.defaultExpectation == nil {
+		mmGetRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetRoleGrantExpectation{}
+	}
+
+	if mmGetRoleGrant.defaultExpectation.paramPtrs != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by ExpectParams functions")
+	}
+
+	mmGetRoleGrant.defaultExpectation.params = &RoleGrantRepositoryMockGetRoleGrantParams{ctx, id}
+	mmGetRoleGrant.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRoleGrant.expectations {
+		if minimock.Equal(e.params, mmGetRoleGrant.defaultExpectation.params) {
+			mmGetRoleGrant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRoleGrant.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRoleGrant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.GetRoleGrant
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockGetRoleGrant {
+	if mmGetRoleGrant.mock.funcGetRoleGrant != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Set")
+	}
+
+	if mmGetRoleGrant.defaultExpectation == nil {
+		mmGetRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetRoleGrantExpectation{}
+	}
+
+	if mmGetRoleGrant.defaultExpectation.params != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Expect")
+	}
+
+	if mmGetRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmGetRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockGetRoleGrantParamPtrs{}
+	}
+	mmGetRoleGrant.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRoleGrant.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRoleGrant
+}
+
+// ExpectIdParam2 sets up expected param id for RoleGrantRepository.GetRoleGrant
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) ExpectIdParam2(id int64) *mRoleGrantRepositoryMockGetRoleGrant {
+	if mmGetRoleGrant.mock.funcGetRoleGrant != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Set")
+	}
+
+	if mmGetRoleGrant.defaultExpectation == nil {
+		mmGetRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetRoleGrantExpectation{}
+	}
+
+	if mmGetRoleGrant.defaultExpectation.params != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Expect")
+	}
+
+	if mmGetRoleGrant.defaultExpectation.paramPtrs == nil {
+		mmGetRoleGrant.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockGetRoleGrantParamPtrs{}
+	}
+	mmGetRoleGrant.defaultExpectation.paramPtrs.id = &id
+	mmGetRoleGrant.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetRoleGrant
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.GetRoleGrant
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Inspect(f func(ctx context.Context, id int64)) *mRoleGrantRepositoryMockGetRoleGrant {
+	if mmGetRoleGrant.mock.inspectFuncGetRoleGrant != nil {
+		mmGetRoleGrant.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.GetRoleGrant")
+	}
+
+	mmGetRoleGrant.mock.inspectFuncGetRoleGrant = f
+
+	return mmGetRoleGrant
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.GetRoleGrant
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Return(rp1 *model.RoleGrant, err error) *RoleGrantRepositoryMock {
+	if mmGetRoleGrant.mock.funcGetRoleGrant != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Set")
+	}
+
+	if mmGetRoleGrant.defaultExpectation == nil {
+		mmGetRoleGrant.defaultExpectation = &RoleGrantRepositoryMockGetRoleGrantExpectation{mock: mmGetRoleGrant.mock}
+	}
+	mmGetRoleGrant.defaultExpectation.results = &RoleGrantRepositoryMockGetRoleGrantResults{rp1, err}
+	mmGetRoleGrant.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRoleGrant.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.GetRoleGrant method
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Set(f func(ctx context.Context, id int64) (rp1 *model.RoleGrant, err error)) *RoleGrantRepositoryMock {
+	if mmGetRoleGrant.defaultExpectation != nil {
+		mmGetRoleGrant.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.GetRoleGrant method")
+	}
+
+	if len(mmGetRoleGrant.expectations) > 0 {
+		mmGetRoleGrant.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.GetRoleGrant method")
+	}
+
+	mmGetRoleGrant.mock.funcGetRoleGrant = f
+	mmGetRoleGrant.mock.funcGetRoleGrantOrigin = minimock.CallerInfo(1)
+	return mmGetRoleGrant.mock
+}
+
+// When sets expectation for the RoleGrantRepository.GetRoleGrant which will trigger the result defined by the following
+// Then helper
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) When(ctx context.Context, id int64) *RoleGrantRepositoryMockGetRoleGrantExpectation {
+	if mmGetRoleGrant.mock.funcGetRoleGrant != nil {
+		mmGetRoleGrant.mock.t.Fatalf("RoleGrantRepositoryMock.GetRoleGrant mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockGetRoleGrantExpectation{
+		mock:               mmGetRoleGrant.mock,
+		params:             &RoleGrantRepositoryMockGetRoleGrantParams{ctx, id},
+		expectationOrigins: RoleGrantRepositoryMockGetRoleGrantExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRoleGrant.expectations = append(mmGetRoleGrant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.GetRoleGrant return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockGetRoleGrantExpectation) Then(rp1 *model.RoleGrant, err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockGetRoleGrantResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.GetRoleGrant should be invoked
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Times(n uint64) *mRoleGrantRepositoryMockGetRoleGrant {
+	if n == 0 {
+		mmGetRoleGrant.mock.t.Fatalf("Times of RoleGrantRepositoryMock.GetRoleGrant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRoleGrant.expectedInvocations, n)
+	mmGetRoleGrant.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRoleGrant
+}
+
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) invocationsDone() bool {
+	if len(mmGetRoleGrant.expectations) == 0 && mmGetRoleGrant.defaultExpectation == nil && mmGetRoleGrant.mock.funcGetRoleGrant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRoleGrant.mock.afterGetRoleGrantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRoleGrant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRoleGrant implements mm_repository.RoleGrantRepository
+func (mmGetRoleGrant *RoleGrantRepositoryMock) GetRoleGrant(ctx context.Context, id int64) (rp1 *model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmGetRoleGrant.beforeGetRoleGrantCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRoleGrant.afterGetRoleGrantCounter, 1)
+
+	mmGetRoleGrant.t.Helper()
+
+	if mmGetRoleGrant.inspectFuncGetRoleGrant != nil {
+		mmGetRoleGrant.inspectFuncGetRoleGrant(ctx, id)
+	}
+
+	mm_params := RoleGrantRepositoryMockGetRoleGrantParams{ctx, id}
+
+	// Record call args
+	mmGetRoleGrant.GetRoleGrantMock.mutex.Lock()
+	mmGetRoleGrant.GetRoleGrantMock.callArgs = append(mmGetRoleGrant.GetRoleGrantMock.callArgs, &mm_params)
+	mmGetRoleGrant.GetRoleGrantMock.mutex.Unlock()
+
+	for _, e := range mmGetRoleGrant.GetRoleGrantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetRoleGrant.GetRoleGrantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockGetRoleGrantParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetRoleGrant got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetRoleGrant got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRoleGrant.t.Errorf("RoleGrantRepositoryMock.GetRoleGrant got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRoleGrant.GetRoleGrantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRoleGrant.t.Fatal("No results are set for the RoleGrantRepositoryMock.GetRoleGrant")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetRoleGrant.funcGetRoleGrant != nil {
+		return mmGetRoleGrant.funcGetRoleGrant(ctx, id)
+	}
+	mmGetRoleGrant.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.GetRoleGrant. %v %v", ctx, id)
+	return
+}
+
+// GetRoleGrantAfterCounter returns a count of finished RoleGrantRepositoryMock.GetRoleGrant invocations
+func (mmGetRoleGrant *RoleGrantRepositoryMock) GetRoleGrantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRoleGrant.afterGetRoleGrantCounter)
+}
+
+// GetRoleGrantBeforeCounter returns a count of RoleGrantRepositoryMock.GetRoleGrant invocations
+func (mmGetRoleGrant *RoleGrantRepositoryMock) GetRoleGrantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRoleGrant.beforeGetRoleGrantCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.GetRoleGrant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRoleGrant *mRoleGrantRepositoryMockGetRoleGrant) Calls() []*RoleGrantRepositoryMockGetRoleGrantParams {
+	mmGetRoleGrant.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockGetRoleGrantParams, len(mmGetRoleGrant.callArgs))
+	copy(argCopy, mmGetRoleGrant.callArgs)
+
+	mmGetRoleGrant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRoleGrantDone returns true if the count of the GetRoleGrant invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockGetRoleGrantDone() bool {
+	if m.GetRoleGrantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRoleGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRoleGrantMock.invocationsDone()
+}
+
+// MinimockGetRoleGrantInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockGetRoleGrantInspect() {
+	for _, e := range m.GetRoleGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetRoleGrant at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRoleGrantCounter := mm_atomic.LoadUint64(&m.afterGetRoleGrantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRoleGrantMock.defaultExpectation != nil && afterGetRoleGrantCounter < 1 {
+		if m.GetRoleGrantMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetRoleGrant at\n%s", m.GetRoleGrantMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetRoleGrant at\n%s with params: %#v", m.GetRoleGrantMock.defaultExpectation.expectationOrigins.origin, *m.GetRoleGrantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRoleGrant != nil && afterGetRoleGrantCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.GetRoleGrant at\n%s", m.funcGetRoleGrantOrigin)
+	}
+
+	if !m.GetRoleGrantMock.invocationsDone() && afterGetRoleGrantCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.GetRoleGrant at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRoleGrantMock.expectedInvocations), m.GetRoleGrantMock.expectedInvocationsOrigin, afterGetRoleGrantCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockListRoleGrants struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockListRoleGrantsExpectation
+	expectations       []*RoleGrantRepositoryMockListRoleGrantsExpectation
+
+	callArgs []*RoleGrantRepositoryMockListRoleGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockListRoleGrantsExpectation specifies expectation struct of the RoleGrantRepository.ListRoleGrants
+type RoleGrantRepositoryMockListRoleGrantsExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockListRoleGrantsParams
+	paramPtrs          *RoleGrantRepositoryMockListRoleGrantsParamPtrs
+	expectationOrigins RoleGrantRepositoryMockListRoleGrantsExpectationOrigins
+	results            *RoleGrantRepositoryMockListRoleGrantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockListRoleGrantsParams contains parameters of the RoleGrantRepository.ListRoleGrants
+type RoleGrantRepositoryMockListRoleGrantsParams struct {
+	ctx    context.Context
+	filter *model.RoleGrantFilter
+}
+
+// RoleGrantRepositoryMockListRoleGrantsParamPtrs contains pointers to parameters of the RoleGrantRepository.ListRoleGrants
+type RoleGrantRepositoryMockListRoleGrantsParamPtrs struct {
+	ctx    *context.Context
+	filter **model.RoleGrantFilter
+}
+
+// RoleGrantRepositoryMockListRoleGrantsResults contains results of the RoleGrantRepository.ListRoleGrants
+type RoleGrantRepositoryMockListRoleGrantsResults struct {
+	rpa1 []*model.RoleGrant
+	err  error
+}
+
+// RoleGrantRepositoryMockListRoleGrantsOrigins contains origins of expectations of the RoleGrantRepository.ListRoleGrants
+type RoleGrantRepositoryMockListRoleGrantsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Optional() *mRoleGrantRepositoryMockListRoleGrants {
+	mmListRoleGrants.optional = true
+	return mmListRoleGrants
+}
+
+// Expect sets up expected params for RoleGrantRepository.ListRoleGrants
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Expect(ctx context.Context, filter *model.RoleGrantFilter) *mRoleGrantRepositoryMockListRoleGrants {
+	if mmListRoleGrants.mock.funcListRoleGrants != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Set")
+	}
+
+	if mmListRoleGrants.defaultExpectation == nil {
+		mmListRoleGrants.defaultExpectation = &RoleGrantRepositoryMockListRoleGrantsExpectation{}
+	}
+
+	if mmListRoleGrants.defaultExpectation.paramPtrs != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by ExpectParams functions")
+	}
+
+	mmListRoleGrants.defaultExpectation.params = &RoleGrantRepositoryMockListRoleGrantsParams{ctx, filter}
+	mmListRoleGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoleGrants.expectations {
+		if minimock.Equal(e.params, mmListRoleGrants.defaultExpectation.params) {
+			mmListRoleGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoleGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoleGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.ListRoleGrants
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockListRoleGrants {
+	if mmListRoleGrants.mock.funcListRoleGrants != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Set")
+	}
+
+	if mmListRoleGrants.defaultExpectation == nil {
+		mmListRoleGrants.defaultExpectation = &RoleGrantRepositoryMockListRoleGrantsExpectation{}
+	}
+
+	if mmListRoleGrants.defaultExpectation.params != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Expect")
+	}
+
+	if mmListRoleGrants.defaultExpectation.paramPtrs == nil {
+		mmListRoleGrants.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockListRoleGrantsParamPtrs{}
+	}
+	mmListRoleGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoleGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoleGrants
+}
+
+// ExpectFilterParam2 sets up expected param filter for RoleGrantRepository.ListRoleGrants
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) ExpectFilterParam2(filter *model.RoleGrantFilter) *mRoleGrantRepositoryMockListRoleGrants {
+	if mmListRoleGrants.mock.funcListRoleGrants != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Set")
+	}
+
+	if mmListRoleGrants.defaultExpectation == nil {
+		mmListRoleGrants.defaultExpectation = &RoleGrantRepositoryMockListRoleGrantsExpectation{}
+	}
+
+	if mmListRoleGrants.defaultExpectation.params != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Expect")
+	}
+
+	if mmListRoleGrants.defaultExpectation.paramPtrs == nil {
+		mmListRoleGrants.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockListRoleGrantsParamPtrs{}
+	}
+	mmListRoleGrants.defaultExpectation.paramPtrs.filter = &filter
+	mmListRoleGrants.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListRoleGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.ListRoleGrants
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Inspect(f func(ctx context.Context, filter *model.RoleGrantFilter)) *mRoleGrantRepositoryMockListRoleGrants {
+	if mmListRoleGrants.mock.inspectFuncListRoleGrants != nil {
+		mmListRoleGrants.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.ListRoleGrants")
+	}
+
+	mmListRoleGrants.mock.inspectFuncListRoleGrants = f
+
+	return mmListRoleGrants
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.ListRoleGrants
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Return(rpa1 []*model.RoleGrant, err error) *RoleGrantRepositoryMock {
+	if mmListRoleGrants.mock.funcListRoleGrants != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Set")
+	}
+
+	if mmListRoleGrants.defaultExpectation == nil {
+		mmListRoleGrants.defaultExpectation = &RoleGrantRepositoryMockListRoleGrantsExpectation{mock: mmListRoleGrants.mock}
+	}
+	mmListRoleGrants.defaultExpectation.results = &RoleGrantRepositoryMockListRoleGrantsResults{rpa1, err}
+	mmListRoleGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoleGrants.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.ListRoleGrants method
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Set(f func(ctx context.Context, filter *model.RoleGrantFilter) (rpa1 []*model.RoleGrant, err error)) *RoleGrantRepositoryMock {
+	if mmListRoleGrants.defaultExpectation != nil {
+		mmListRoleGrants.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.ListRoleGrants method")
+	}
+
+	if len(mmListRoleGrants.expectations) > 0 {
+		mmListRoleGrants.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.ListRoleGrants method")
+	}
+
+	mmListRoleGrants.mock.funcListRoleGrants = f
+	mmListRoleGrants.mock.funcListRoleGrantsOrigin = minimock.CallerInfo(1)
+	return mmListRoleGrants.mock
+}
+
+// When sets expectation for the RoleGrantRepository.ListRoleGrants which will trigger the result defined by the following
+// Then helper
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) When(ctx context.Context, filter *model.RoleGrantFilter) *RoleGrantRepositoryMockListRoleGrantsExpectation {
+	if mmListRoleGrants.mock.funcListRoleGrants != nil {
+		mmListRoleGrants.mock.t.Fatalf("RoleGrantRepositoryMock.ListRoleGrants mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockListRoleGrantsExpectation{
+		mock:               mmListRoleGrants.mock,
+		params:             &RoleGrantRepositoryMockListRoleGrantsParams{ctx, filter},
+		expectationOrigins: RoleGrantRepositoryMockListRoleGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoleGrants.expectations = append(mmListRoleGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.ListRoleGrants return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockListRoleGrantsExpectation) Then(rpa1 []*model.RoleGrant, err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockListRoleGrantsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.ListRoleGrants should be invoked
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Times(n uint64) *mRoleGrantRepositoryMockListRoleGrants {
+	if n == 0 {
+		mmListRoleGrants.mock.t.Fatalf("Times of RoleGrantRepositoryMock.ListRoleGrants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoleGrants.expectedInvocations, n)
+	mmListRoleGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoleGrants
+}
+
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) invocationsDone() bool {
+	if len(mmListRoleGrants.expectations) == 0 && mmListRoleGrants.defaultExpectation == nil && mmListRoleGrants.mock.funcListRoleGrants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoleGrants.mock.afterListRoleGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoleGrants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoleGrants implements mm_repository.RoleGrantRepository
+func (mmListRoleGrants *RoleGrantRepositoryMock) ListRoleGrants(ctx context.Context, filter *model.RoleGrantFilter) (rpa1 []*model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmListRoleGrants.beforeListRoleGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoleGrants.afterListRoleGrantsCounter, 1)
+
+	mmListRoleGrants.t.Helper()
+
+	if mmListRoleGrants.inspectFuncListRoleGrants != nil {
+		mmListRoleGrants.inspectFuncListRoleGrants(ctx, filter)
+	}
+
+	mm_params := RoleGrantRepositoryMockListRoleGrantsParams{ctx, filter}
+
+	// Record call args
+	mmListRoleGrants.ListRoleGrantsMock.mutex.Lock()
+	mmListRoleGrants.ListRoleGrantsMock.callArgs = append(mmListRoleGrants.ListRoleGrantsMock.callArgs, &mm_params)
+	mmListRoleGrants.ListRoleGrantsMock.mutex.Unlock()
+
+	for _, e := range mmListRoleGrants.ListRoleGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListRoleGrants.ListRoleGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockListRoleGrantsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoleGrants.t.Errorf("RoleGrantRepositoryMock.ListRoleGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListRoleGrants.t.Errorf("RoleGrantRepositoryMock.ListRoleGrants got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoleGrants.t.Errorf("RoleGrantRepositoryMock.ListRoleGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoleGrants.ListRoleGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoleGrants.t.Fatal("No results are set for the RoleGrantRepositoryMock.ListRoleGrants")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListRoleGrants.funcListRoleGrants != nil {
+		return mmListRoleGrants.funcListRoleGrants(ctx, filter)
+	}
+	mmListRoleGrants.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.ListRoleGrants. %v %v", ctx, filter)
+	return
+}
+
+// ListRoleGrantsAfterCounter returns a count of finished RoleGrantRepositoryMock.ListRoleGrants invocations
+func (mmListRoleGrants *RoleGrantRepositoryMock) ListRoleGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoleGrants.afterListRoleGrantsCounter)
+}
+
+// ListRoleGrantsBeforeCounter returns a count of RoleGrantRepositoryMock.ListRoleGrants invocations
+func (mmListRoleGrants *RoleGrantRepositoryMock) ListRoleGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoleGrants.beforeListRoleGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.ListRoleGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoleGrants *mRoleGrantRepositoryMockListRoleGrants) Calls() []*RoleGrantRepositoryMockListRoleGrantsParams {
+	mmListRoleGrants.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockListRoleGrantsParams, len(mmListRoleGrants.callArgs))
+	copy(argCopy, mmListRoleGrants.callArgs)
+
+	mmListRoleGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRoleGrantsDone returns true if the count of the ListRoleGrants invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockListRoleGrantsDone() bool {
+	if m.ListRoleGrantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRoleGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRoleGrantsMock.invocationsDone()
+}
+
+// MinimockListRoleGrantsInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockListRoleGrantsInspect() {
+	for _, e := range m.ListRoleGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.ListRoleGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRoleGrantsCounter := mm_atomic.LoadUint64(&m.afterListRoleGrantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRoleGrantsMock.defaultExpectation != nil && afterListRoleGrantsCounter < 1 {
+		if m.ListRoleGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.ListRoleGrants at\n%s", m.ListRoleGrantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.ListRoleGrants at\n%s with params: %#v", m.ListRoleGrantsMock.defaultExpectation.expectationOrigins.origin, *m.ListRoleGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoleGrants != nil && afterListRoleGrantsCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.ListRoleGrants at\n%s", m.funcListRoleGrantsOrigin)
+	}
+
+	if !m.ListRoleGrantsMock.invocationsDone() && afterListRoleGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.ListRoleGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRoleGrantsMock.expectedInvocations), m.ListRoleGrantsMock.expectedInvocationsOrigin, afterListRoleGrantsCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockPseudonymizeReviewer struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockPseudonymizeReviewerExpectation
+	expectations       []*RoleGrantRepositoryMockPseudonymizeReviewerExpectation
+
+	callArgs []*RoleGrantRepositoryMockPseudonymizeReviewerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockPseudonymizeReviewerExpectation specifies expectation struct of the RoleGrantRepository.PseudonymizeReviewer
+type RoleGrantRepositoryMockPseudonymizeReviewerExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockPseudonymizeReviewerParams
+	paramPtrs          *RoleGrantRepositoryMockPseudonymizeReviewerParamPtrs
+	expectationOrigins RoleGrantRepositoryMockPseudonymizeReviewerExpectationOrigins
+	results            *RoleGrantRepositoryMockPseudonymizeReviewerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockPseudonymizeReviewerParams contains parameters of the RoleGrantRepository.PseudonymizeReviewer
+type RoleGrantRepositoryMockPseudonymizeReviewerParams struct {
+	ctx       context.Context
+	username  string
+	pseudonym string
+}
+
+// RoleGrantRepositoryMockPseudonymizeReviewerParamPtrs contains pointers to parameters of the RoleGrantRepository.PseudonymizeReviewer
+type RoleGrantRepositoryMockPseudonymizeReviewerParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	pseudonym *string
+}
+
+// RoleGrantRepositoryMockPseudonymizeReviewerResults contains results of the RoleGrantRepository.PseudonymizeReviewer
+type RoleGrantRepositoryMockPseudonymizeReviewerResults struct {
+	err error
+}
+
+// RoleGrantRepositoryMockPseudonymizeReviewerOrigins contains origins of expectations of the RoleGrantRepository.PseudonymizeReviewer
+type RoleGrantRepositoryMockPseudonymizeReviewerExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originPseudonym string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Optional() *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	mmPseudonymizeReviewer.optional = true
+	return mmPseudonymizeReviewer
+}
+
+// Expect sets up expected params for RoleGrantRepository.PseudonymizeReviewer
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Expect(ctx context.Context, username string, pseudonym string) *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	if mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Set")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation == nil {
+		mmPseudonymizeReviewer.defaultExpectation = &RoleGrantRepositoryMockPseudonymizeReviewerExpectation{}
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.paramPtrs != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by ExpectParams functions")
+	}
+
+	mmPseudonymizeReviewer.defaultExpectation.params = &RoleGrantRepositoryMockPseudonymizeReviewerParams{ctx, username, pseudonym}
+	mmPseudonymizeReviewer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPseudonymizeReviewer.expectations {
+		if minimock.Equal(e.params, mmPseudonymizeReviewer.defaultExpectation.params) {
+			mmPseudonymizeReviewer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPseudonymizeReviewer.defaultExpectation.params)
+		}
+	}
+
+	return mmPseudonymizeReviewer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.PseudonymizeReviewer
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	if mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Set")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation == nil {
+		mmPseudonymizeReviewer.defaultExpectation = &RoleGrantRepositoryMockPseudonymizeReviewerExpectation{}
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.params != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Expect")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeReviewer.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockPseudonymizeReviewerParamPtrs{}
+	}
+	mmPseudonymizeReviewer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPseudonymizeReviewer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPseudonymizeReviewer
+}
+
+// ExpectUsernameParam2 sets up expected param username for RoleGrantRepository.PseudonymizeReviewer
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) ExpectUsernameParam2(username string) *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	if mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Set")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation == nil {
+		mmPseudonymizeReviewer.defaultExpectation = &RoleGrantRepositoryMockPseudonymizeReviewerExpectation{}
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.params != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Expect")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeReviewer.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockPseudonymizeReviewerParamPtrs{}
+	}
+	mmPseudonymizeReviewer.defaultExpectation.paramPtrs.username = &username
+	mmPseudonymizeReviewer.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmPseudonymizeReviewer
+}
+
+// ExpectPseudonymParam3 sets up expected param pseudonym for RoleGrantRepository.PseudonymizeReviewer
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) ExpectPseudonymParam3(pseudonym string) *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	if mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Set")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation == nil {
+		mmPseudonymizeReviewer.defaultExpectation = &RoleGrantRepositoryMockPseudonymizeReviewerExpectation{}
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.params != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Expect")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation.paramPtrs == nil {
+		mmPseudonymizeReviewer.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockPseudonymizeReviewerParamPtrs{}
+	}
+	mmPseudonymizeReviewer.defaultExpectation.paramPtrs.pseudonym = &pseudonym
+	mmPseudonymizeReviewer.defaultExpectation.expectationOrigins.originPseudonym = minimock.CallerInfo(1)
+
+	return mmPseudonymizeReviewer
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.PseudonymizeReviewer
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Inspect(f func(ctx context.Context, username string, pseudonym string)) *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	if mmPseudonymizeReviewer.mock.inspectFuncPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.PseudonymizeReviewer")
+	}
+
+	mmPseudonymizeReviewer.mock.inspectFuncPseudonymizeReviewer = f
+
+	return mmPseudonymizeReviewer
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.PseudonymizeReviewer
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Return(err error) *RoleGrantRepositoryMock {
+	if mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Set")
+	}
+
+	if mmPseudonymizeReviewer.defaultExpectation == nil {
+		mmPseudonymizeReviewer.defaultExpectation = &RoleGrantRepositoryMockPseudonymizeReviewerExpectation{mock: mmPseudonymizeReviewer.mock}
+	}
+	mmPseudonymizeReviewer.defaultExpectation.results = &RoleGrantRepositoryMockPseudonymizeReviewerResults{err}
+	mmPseudonymizeReviewer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeReviewer.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.PseudonymizeReviewer method
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Set(f func(ctx context.Context, username string, pseudonym string) (err error)) *RoleGrantRepositoryMock {
+	if mmPseudonymizeReviewer.defaultExpectation != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.PseudonymizeReviewer method")
+	}
+
+	if len(mmPseudonymizeReviewer.expectations) > 0 {
+		mmPseudonymizeReviewer.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.PseudonymizeReviewer method")
+	}
+
+	mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer = f
+	mmPseudonymizeReviewer.mock.funcPseudonymizeReviewerOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeReviewer.mock
+}
+
+// When sets expectation for the RoleGrantRepository.PseudonymizeReviewer which will trigger the result defined by the following
+// Then helper
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) When(ctx context.Context, username string, pseudonym string) *RoleGrantRepositoryMockPseudonymizeReviewerExpectation {
+	if mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.mock.t.Fatalf("RoleGrantRepositoryMock.PseudonymizeReviewer mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockPseudonymizeReviewerExpectation{
+		mock:               mmPseudonymizeReviewer.mock,
+		params:             &RoleGrantRepositoryMockPseudonymizeReviewerParams{ctx, username, pseudonym},
+		expectationOrigins: RoleGrantRepositoryMockPseudonymizeReviewerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPseudonymizeReviewer.expectations = append(mmPseudonymizeReviewer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.PseudonymizeReviewer return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockPseudonymizeReviewerExpectation) Then(err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockPseudonymizeReviewerResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.PseudonymizeReviewer should be invoked
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Times(n uint64) *mRoleGrantRepositoryMockPseudonymizeReviewer {
+	if n == 0 {
+		mmPseudonymizeReviewer.mock.t.Fatalf("Times of RoleGrantRepositoryMock.PseudonymizeReviewer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPseudonymizeReviewer.expectedInvocations, n)
+	mmPseudonymizeReviewer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPseudonymizeReviewer
+}
+
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) invocationsDone() bool {
+	if len(mmPseudonymizeReviewer.expectations) == 0 && mmPseudonymizeReviewer.defaultExpectation == nil && mmPseudonymizeReviewer.mock.funcPseudonymizeReviewer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPseudonymizeReviewer.mock.afterPseudonymizeReviewerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPseudonymizeReviewer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PseudonymizeReviewer implements mm_repository.RoleGrantRepository
+func (mmPseudonymizeReviewer *RoleGrantRepositoryMock) PseudonymizeReviewer(ctx context.Context, username string, pseudonym string) (err error) {
+	mm_atomic.AddUint64(&mmPseudonymizeReviewer.beforePseudonymizeReviewerCounter, 1)
+	defer mm_atomic.AddUint64(&mmPseudonymizeReviewer.afterPseudonymizeReviewerCounter, 1)
+
+	mmPseudonymizeReviewer.t.Helper()
+
+	if mmPseudonymizeReviewer.inspectFuncPseudonymizeReviewer != nil {
+		mmPseudonymizeReviewer.inspectFuncPseudonymizeReviewer(ctx, username, pseudonym)
+	}
+
+	mm_params := RoleGrantRepositoryMockPseudonymizeReviewerParams{ctx, username, pseudonym}
+
+	// Record call args
+	mmPseudonymizeReviewer.PseudonymizeReviewerMock.mutex.Lock()
+	mmPseudonymizeReviewer.PseudonymizeReviewerMock.callArgs = append(mmPseudonymizeReviewer.PseudonymizeReviewerMock.callArgs, &mm_params)
+	mmPseudonymizeReviewer.PseudonymizeReviewerMock.mutex.Unlock()
+
+	for _, e := range mmPseudonymizeReviewer.PseudonymizeReviewerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.Counter, 1)
+		mm_want := mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.params
+		mm_want_ptrs := mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockPseudonymizeReviewerParams{ctx, username, pseudonym}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPseudonymizeReviewer.t.Errorf("RoleGrantRepositoryMock.PseudonymizeReviewer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmPseudonymizeReviewer.t.Errorf("RoleGrantRepositoryMock.PseudonymizeReviewer got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.pseudonym != nil && !minimock.Equal(*mm_want_ptrs.pseudonym, mm_got.pseudonym) {
+				mmPseudonymizeReviewer.t.Errorf("RoleGrantRepositoryMock.PseudonymizeReviewer got unexpected parameter pseudonym, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.expectationOrigins.originPseudonym, *mm_want_ptrs.pseudonym, mm_got.pseudonym, minimock.Diff(*mm_want_ptrs.pseudonym, mm_got.pseudonym))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPseudonymizeReviewer.t.Errorf("RoleGrantRepositoryMock.PseudonymizeReviewer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPseudonymizeReviewer.PseudonymizeReviewerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPseudonymizeReviewer.t.Fatal("No results are set for the RoleGrantRepositoryMock.PseudonymizeReviewer")
+		}
+		return (*mm_results).err
+	}
+	if mmPseudonymizeReviewer.funcPseudonymizeReviewer != nil {
+		return mmPseudonymizeReviewer.funcPseudonymizeReviewer(ctx, username, pseudonym)
+	}
+	mmPseudonymizeReviewer.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.PseudonymizeReviewer. %v %v %v", ctx, username, pseudonym)
+	return
+}
+
+// PseudonymizeReviewerAfterCounter returns a count of finished RoleGrantRepositoryMock.PseudonymizeReviewer invocations
+func (mmPseudonymizeReviewer *RoleGrantRepositoryMock) PseudonymizeReviewerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeReviewer.afterPseudonymizeReviewerCounter)
+}
+
+// PseudonymizeReviewerBeforeCounter returns a count of RoleGrantRepositoryMock.PseudonymizeReviewer invocations
+func (mmPseudonymizeReviewer *RoleGrantRepositoryMock) PseudonymizeReviewerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPseudonymizeReviewer.beforePseudonymizeReviewerCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.PseudonymizeReviewer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPseudonymizeReviewer *mRoleGrantRepositoryMockPseudonymizeReviewer) Calls() []*RoleGrantRepositoryMockPseudonymizeReviewerParams {
+	mmPseudonymizeReviewer.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockPseudonymizeReviewerParams, len(mmPseudonymizeReviewer.callArgs))
+	copy(argCopy, mmPseudonymizeReviewer.callArgs)
+
+	mmPseudonymizeReviewer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPseudonymizeReviewerDone returns true if the count of the PseudonymizeReviewer invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockPseudonymizeReviewerDone() bool {
+	if m.PseudonymizeReviewerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PseudonymizeReviewerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PseudonymizeReviewerMock.invocationsDone()
+}
+
+// MinimockPseudonymizeReviewerInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockPseudonymizeReviewerInspect() {
+	for _, e := range m.PseudonymizeReviewerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.PseudonymizeReviewer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPseudonymizeReviewerCounter := mm_atomic.LoadUint64(&m.afterPseudonymizeReviewerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PseudonymizeReviewerMock.defaultExpectation != nil && afterPseudonymizeReviewerCounter < 1 {
+		if m.PseudonymizeReviewerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.PseudonymizeReviewer at\n%s", m.PseudonymizeReviewerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.PseudonymizeReviewer at\n%s with params: %#v", m.PseudonymizeReviewerMock.defaultExpectation.expectationOrigins.origin, *m.PseudonymizeReviewerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPseudonymizeReviewer != nil && afterPseudonymizeReviewerCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.PseudonymizeReviewer at\n%s", m.funcPseudonymizeReviewerOrigin)
+	}
+
+	if !m.PseudonymizeReviewerMock.invocationsDone() && afterPseudonymizeReviewerCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.PseudonymizeReviewer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PseudonymizeReviewerMock.expectedInvocations), m.PseudonymizeReviewerMock.expectedInvocationsOrigin, afterPseudonymizeReviewerCounter)
+	}
+}
+
+type mRoleGrantRepositoryMockUpdateRoleGrantStatus struct {
+	optional           bool
+	mock               *RoleGrantRepositoryMock
+	defaultExpectation *RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation
+	expectations       []*RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation
+
+	callArgs []*RoleGrantRepositoryMockUpdateRoleGrantStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation specifies expectation struct of the RoleGrantRepository.UpdateRoleGrantStatus
+type RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation struct {
+	mock               *RoleGrantRepositoryMock
+	params             *RoleGrantRepositoryMockUpdateRoleGrantStatusParams
+	paramPtrs          *RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs
+	expectationOrigins RoleGrantRepositoryMockUpdateRoleGrantStatusExpectationOrigins
+	results            *RoleGrantRepositoryMockUpdateRoleGrantStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleGrantRepositoryMockUpdateRoleGrantStatusParams contains parameters of the RoleGrantRepository.UpdateRoleGrantStatus
+type RoleGrantRepositoryMockUpdateRoleGrantStatusParams struct {
+	ctx        context.Context
+	id         int64
+	status     string
+	reviewedBy string
+}
+
+// RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs contains pointers to parameters of the RoleGrantRepository.UpdateRoleGrantStatus
+type RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs struct {
+	ctx        *context.Context
+	id         *int64
+	status     *string
+	reviewedBy *string
+}
+
+// RoleGrantRepositoryMockUpdateRoleGrantStatusResults contains results of the RoleGrantRepository.UpdateRoleGrantStatus
+type RoleGrantRepositoryMockUpdateRoleGrantStatusResults struct {
+	err error
+}
+
+// RoleGrantRepositoryMockUpdateRoleGrantStatusOrigins contains origins of expectations of the RoleGrantRepository.UpdateRoleGrantStatus
+type RoleGrantRepositoryMockUpdateRoleGrantStatusExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originId         string
+	originStatus     string
+	originReviewedBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Optional() *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	mmUpdateRoleGrantStatus.optional = true
+	return mmUpdateRoleGrantStatus
+}
+
+// Expect sets up expected params for RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Expect(ctx context.Context, id int64, status string, reviewedBy string) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation = &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{}
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRoleGrantStatus.defaultExpectation.params = &RoleGrantRepositoryMockUpdateRoleGrantStatusParams{ctx, id, status, reviewedBy}
+	mmUpdateRoleGrantStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateRoleGrantStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateRoleGrantStatus.defaultExpectation.params) {
+			mmUpdateRoleGrantStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRoleGrantStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRoleGrantStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) ExpectCtxParam1(ctx context.Context) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation = &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{}
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.params != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Expect")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs{}
+	}
+	mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateRoleGrantStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateRoleGrantStatus
+}
+
+// ExpectIdParam2 sets up expected param id for RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) ExpectIdParam2(id int64) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation = &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{}
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.params != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Expect")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs{}
+	}
+	mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs.id = &id
+	mmUpdateRoleGrantStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateRoleGrantStatus
+}
+
+// ExpectStatusParam3 sets up expected param status for RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) ExpectStatusParam3(status string) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation = &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{}
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.params != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Expect")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs{}
+	}
+	mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs.status = &status
+	mmUpdateRoleGrantStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmUpdateRoleGrantStatus
+}
+
+// ExpectReviewedByParam4 sets up expected param reviewedBy for RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) ExpectReviewedByParam4(reviewedBy string) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation = &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{}
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.params != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Expect")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs = &RoleGrantRepositoryMockUpdateRoleGrantStatusParamPtrs{}
+	}
+	mmUpdateRoleGrantStatus.defaultExpectation.paramPtrs.reviewedBy = &reviewedBy
+	mmUpdateRoleGrantStatus.defaultExpectation.expectationOrigins.originReviewedBy = minimock.CallerInfo(1)
+
+	return mmUpdateRoleGrantStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Inspect(f func(ctx context.Context, id int64, status string, reviewedBy string)) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if mmUpdateRoleGrantStatus.mock.inspectFuncUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("Inspect function is already set for RoleGrantRepositoryMock.UpdateRoleGrantStatus")
+	}
+
+	mmUpdateRoleGrantStatus.mock.inspectFuncUpdateRoleGrantStatus = f
+
+	return mmUpdateRoleGrantStatus
+}
+
+// Return sets up results that will be returned by RoleGrantRepository.UpdateRoleGrantStatus
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Return(err error) *RoleGrantRepositoryMock {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	if mmUpdateRoleGrantStatus.defaultExpectation == nil {
+		mmUpdateRoleGrantStatus.defaultExpectation = &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{mock: mmUpdateRoleGrantStatus.mock}
+	}
+	mmUpdateRoleGrantStatus.defaultExpectation.results = &RoleGrantRepositoryMockUpdateRoleGrantStatusResults{err}
+	mmUpdateRoleGrantStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateRoleGrantStatus.mock
+}
+
+// Set uses given function f to mock the RoleGrantRepository.UpdateRoleGrantStatus method
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Set(f func(ctx context.Context, id int64, status string, reviewedBy string) (err error)) *RoleGrantRepositoryMock {
+	if mmUpdateRoleGrantStatus.defaultExpectation != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("Default expectation is already set for the RoleGrantRepository.UpdateRoleGrantStatus method")
+	}
+
+	if len(mmUpdateRoleGrantStatus.expectations) > 0 {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("Some expectations are already set for the RoleGrantRepository.UpdateRoleGrantStatus method")
+	}
+
+	mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus = f
+	mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatusOrigin = minimock.CallerInfo(1)
+	return mmUpdateRoleGrantStatus.mock
+}
+
+// When sets expectation for the RoleGrantRepository.UpdateRoleGrantStatus which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) When(ctx context.Context, id int64, status string, reviewedBy string) *RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation {
+	if mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("RoleGrantRepositoryMock.UpdateRoleGrantStatus mock is already set by Set")
+	}
+
+	expectation := &RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation{
+		mock:               mmUpdateRoleGrantStatus.mock,
+		params:             &RoleGrantRepositoryMockUpdateRoleGrantStatusParams{ctx, id, status, reviewedBy},
+		expectationOrigins: RoleGrantRepositoryMockUpdateRoleGrantStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateRoleGrantStatus.expectations = append(mmUpdateRoleGrantStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleGrantRepository.UpdateRoleGrantStatus return parameters for the expectation previously defined by the When method
+func (e *RoleGrantRepositoryMockUpdateRoleGrantStatusExpectation) Then(err error) *RoleGrantRepositoryMock {
+	e.results = &RoleGrantRepositoryMockUpdateRoleGrantStatusResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleGrantRepository.UpdateRoleGrantStatus should be invoked
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Times(n uint64) *mRoleGrantRepositoryMockUpdateRoleGrantStatus {
+	if n == 0 {
+		mmUpdateRoleGrantStatus.mock.t.Fatalf("Times of RoleGrantRepositoryMock.UpdateRoleGrantStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRoleGrantStatus.expectedInvocations, n)
+	mmUpdateRoleGrantStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateRoleGrantStatus
+}
+
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) invocationsDone() bool {
+	if len(mmUpdateRoleGrantStatus.expectations) == 0 && mmUpdateRoleGrantStatus.defaultExpectation == nil && mmUpdateRoleGrantStatus.mock.funcUpdateRoleGrantStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRoleGrantStatus.mock.afterUpdateRoleGrantStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRoleGrantStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRoleGrantStatus implements mm_repository.RoleGrantRepository
+func (mmUpdateRoleGrantStatus *RoleGrantRepositoryMock) UpdateRoleGrantStatus(ctx context.Context, id int64, status string, reviewedBy string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRoleGrantStatus.beforeUpdateRoleGrantStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRoleGrantStatus.afterUpdateRoleGrantStatusCounter, 1)
+
+	mmUpdateRoleGrantStatus.t.Helper()
+
+	if mmUpdateRoleGrantStatus.inspectFuncUpdateRoleGrantStatus != nil {
+		mmUpdateRoleGrantStatus.inspectFuncUpdateRoleGrantStatus(ctx, id, status, reviewedBy)
+	}
+
+	mm_params := RoleGrantRepositoryMockUpdateRoleGrantStatusParams{ctx, id, status, reviewedBy}
+
+	// Record call args
+	mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.mutex.Lock()
+	mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.callArgs = append(mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.callArgs, &mm_params)
+	mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleGrantRepositoryMockUpdateRoleGrantStatusParams{ctx, id, status, reviewedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRoleGrantStatus.t.Errorf("RoleGrantRepositoryMock.UpdateRoleGrantStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateRoleGrantStatus.t.Errorf("RoleGrantRepositoryMock.UpdateRoleGrantStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmUpdateRoleGrantStatus.t.Errorf("RoleGrantRepositoryMock.UpdateRoleGrantStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.reviewedBy != nil && !minimock.Equal(*mm_want_ptrs.reviewedBy, mm_got.reviewedBy) {
+				mmUpdateRoleGrantStatus.t.Errorf("RoleGrantRepositoryMock.UpdateRoleGrantStatus got unexpected parameter reviewedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.expectationOrigins.originReviewedBy, *mm_want_ptrs.reviewedBy, mm_got.reviewedBy, minimock.Diff(*mm_want_ptrs.reviewedBy, mm_got.reviewedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRoleGrantStatus.t.Errorf("RoleGrantRepositoryMock.UpdateRoleGrantStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRoleGrantStatus.UpdateRoleGrantStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRoleGrantStatus.t.Fatal("No results are set for the RoleGrantRepositoryMock.UpdateRoleGrantStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRoleGrantStatus.funcUpdateRoleGrantStatus != nil {
+		return mmUpdateRoleGrantStatus.funcUpdateRoleGrantStatus(ctx, id, status, reviewedBy)
+	}
+	mmUpdateRoleGrantStatus.t.Fatalf("Unexpected call to RoleGrantRepositoryMock.UpdateRoleGrantStatus. %v %v %v %v", ctx, id, status, reviewedBy)
+	return
+}
+
+// UpdateRoleGrantStatusAfterCounter returns a count of finished RoleGrantRepositoryMock.UpdateRoleGrantStatus invocations
+func (mmUpdateRoleGrantStatus *RoleGrantRepositoryMock) UpdateRoleGrantStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRoleGrantStatus.afterUpdateRoleGrantStatusCounter)
+}
+
+// UpdateRoleGrantStatusBeforeCounter returns a count of RoleGrantRepositoryMock.UpdateRoleGrantStatus invocations
+func (mmUpdateRoleGrantStatus *RoleGrantRepositoryMock) UpdateRoleGrantStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRoleGrantStatus.beforeUpdateRoleGrantStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleGrantRepositoryMock.UpdateRoleGrantStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRoleGrantStatus *mRoleGrantRepositoryMockUpdateRoleGrantStatus) Calls() []*RoleGrantRepositoryMockUpdateRoleGrantStatusParams {
+	mmUpdateRoleGrantStatus.mutex.RLock()
+
+	argCopy := make([]*RoleGrantRepositoryMockUpdateRoleGrantStatusParams, len(mmUpdateRoleGrantStatus.callArgs))
+	copy(argCopy, mmUpdateRoleGrantStatus.callArgs)
+
+	mmUpdateRoleGrantStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRoleGrantStatusDone returns true if the count of the UpdateRoleGrantStatus invocations corresponds
+// the number of defined expectations
+func (m *RoleGrantRepositoryMock) MinimockUpdateRoleGrantStatusDone() bool {
+	if m.UpdateRoleGrantStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRoleGrantStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRoleGrantStatusMock.invocationsDone()
+}
+
+// MinimockUpdateRoleGrantStatusInspect logs each unmet expectation
+func (m *RoleGrantRepositoryMock) MinimockUpdateRoleGrantStatusInspect() {
+	for _, e := range m.UpdateRoleGrantStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.UpdateRoleGrantStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateRoleGrantStatusCounter := mm_atomic.LoadUint64(&m.afterUpdateRoleGrantStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRoleGrantStatusMock.defaultExpectation != nil && afterUpdateRoleGrantStatusCounter < 1 {
+		if m.UpdateRoleGrantStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.UpdateRoleGrantStatus at\n%s", m.UpdateRoleGrantStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleGrantRepositoryMock.UpdateRoleGrantStatus at\n%s with params: %#v", m.UpdateRoleGrantStatusMock.defaultExpectation.expectationOrigins.origin, *m.UpdateRoleGrantStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRoleGrantStatus != nil && afterUpdateRoleGrantStatusCounter < 1 {
+		m.t.Errorf("Expected call to RoleGrantRepositoryMock.UpdateRoleGrantStatus at\n%s", m.funcUpdateRoleGrantStatusOrigin)
+	}
+
+	if !m.UpdateRoleGrantStatusMock.invocationsDone() && afterUpdateRoleGrantStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleGrantRepositoryMock.UpdateRoleGrantStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRoleGrantStatusMock.expectedInvocations), m.UpdateRoleGrantStatusMock.expectedInvocationsOrigin, afterUpdateRoleGrantStatusCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleGrantRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateRoleGrantInspect()
+
+			m.MinimockDeleteRoleGrantsByUserIDInspect()
+
+			m.MinimockExpireRoleGrantsInspect()
+
+			m.MinimockGetActiveRoleGrantInspect()
+
+			m.MinimockGetRoleGrantInspect()
+
+			m.MinimockListRoleGrantsInspect()
+
+			m.MinimockPseudonymizeReviewerInspect()
+
+			m.MinimockUpdateRoleGrantStatusInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleGrantRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleGrantRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateRoleGrantDone() &&
+		m.MinimockDeleteRoleGrantsByUserIDDone() &&
+		m.MinimockExpireRoleGrantsDone() &&
+		m.MinimockGetActiveRoleGrantDone() &&
+		m.MinimockGetRoleGrantDone() &&
+		m.MinimockListRoleGrantsDone() &&
+		m.MinimockPseudonymizeReviewerDone() &&
+		m.MinimockUpdateRoleGrantStatusDone()
+}
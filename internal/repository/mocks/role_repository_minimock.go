@@ -0,0 +1,2582 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.RoleRepository -o role_repository_minimock.go -n RoleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// RoleRepositoryMock implements mm_repository.RoleRepository
+type RoleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddRoleParent          func(ctx context.Context, role string, parent string) (err error)
+	funcAddRoleParentOrigin    string
+	inspectFuncAddRoleParent   func(ctx context.Context, role string, parent string)
+	afterAddRoleParentCounter  uint64
+	beforeAddRoleParentCounter uint64
+	AddRoleParentMock          mRoleRepositoryMockAddRoleParent
+
+	funcCreateRole          func(ctx context.Context, role *model.Role) (i1 int64, err error)
+	funcCreateRoleOrigin    string
+	inspectFuncCreateRole   func(ctx context.Context, role *model.Role)
+	afterCreateRoleCounter  uint64
+	beforeCreateRoleCounter uint64
+	CreateRoleMock          mRoleRepositoryMockCreateRole
+
+	funcDeleteRole          func(ctx context.Context, name string) (err error)
+	funcDeleteRoleOrigin    string
+	inspectFuncDeleteRole   func(ctx context.Context, name string)
+	afterDeleteRoleCounter  uint64
+	beforeDeleteRoleCounter uint64
+	DeleteRoleMock          mRoleRepositoryMockDeleteRole
+
+	funcDeleteRoleParent          func(ctx context.Context, role string, parent string) (err error)
+	funcDeleteRoleParentOrigin    string
+	inspectFuncDeleteRoleParent   func(ctx context.Context, role string, parent string)
+	afterDeleteRoleParentCounter  uint64
+	beforeDeleteRoleParentCounter uint64
+	DeleteRoleParentMock          mRoleRepositoryMockDeleteRoleParent
+
+	funcListAncestorRoles          func(ctx context.Context, role string) (sa1 []string, err error)
+	funcListAncestorRolesOrigin    string
+	inspectFuncListAncestorRoles   func(ctx context.Context, role string)
+	afterListAncestorRolesCounter  uint64
+	beforeListAncestorRolesCounter uint64
+	ListAncestorRolesMock          mRoleRepositoryMockListAncestorRoles
+
+	funcListRoleParents          func(ctx context.Context, role string) (sa1 []string, err error)
+	funcListRoleParentsOrigin    string
+	inspectFuncListRoleParents   func(ctx context.Context, role string)
+	afterListRoleParentsCounter  uint64
+	beforeListRoleParentsCounter uint64
+	ListRoleParentsMock          mRoleRepositoryMockListRoleParents
+
+	funcListRoles          func(ctx context.Context) (rpa1 []*model.Role, err error)
+	funcListRolesOrigin    string
+	inspectFuncListRoles   func(ctx context.Context)
+	afterListRolesCounter  uint64
+	beforeListRolesCounter uint64
+	ListRolesMock          mRoleRepositoryMockListRoles
+}
+
+// NewRoleRepositoryMock returns a mock for mm_repository.RoleRepository
+func NewRoleRepositoryMock(t minimock.Tester) *RoleRepositoryMock {
+	m := &RoleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddRoleParentMock = mRoleRepositoryMockAddRoleParent{mock: m}
+	m.AddRoleParentMock.callArgs = []*RoleRepositoryMockAddRoleParentParams{}
+
+	m.CreateRoleMock = mRoleRepositoryMockCreateRole{mock: m}
+	m.CreateRoleMock.callArgs = []*RoleRepositoryMockCreateRoleParams{}
+
+	m.DeleteRoleMock = mRoleRepositoryMockDeleteRole{mock: m}
+	m.DeleteRoleMock.callArgs = []*RoleRepositoryMockDeleteRoleParams{}
+
+	m.DeleteRoleParentMock = mRoleRepositoryMockDeleteRoleParent{mock: m}
+	m.DeleteRoleParentMock.callArgs = []*RoleRepositoryMockDeleteRoleParentParams{}
+
+	m.ListAncestorRolesMock = mRoleRepositoryMockListAncestorRoles{mock: m}
+	m.ListAncestorRolesMock.callArgs = []*RoleRepositoryMockListAncestorRolesParams{}
+
+	m.ListRoleParentsMock = mRoleRepositoryMockListRoleParents{mock: m}
+	m.ListRoleParentsMock.callArgs = []*RoleRepositoryMockListRoleParentsParams{}
+
+	m.ListRolesMock = mRoleRepositoryMockListRoles{mock: m}
+	m.ListRolesMock.callArgs = []*RoleRepositoryMockListRolesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleRepositoryMockAddRoleParent struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockAddRoleParentExpectation
+	expectations       []*RoleRepositoryMockAddRoleParentExpectation
+
+	callArgs []*RoleRepositoryMockAddRoleParentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockAddRoleParentExpectation specifies expectation struct of the RoleRepository.AddRoleParent
+type RoleRepositoryMockAddRoleParentExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockAddRoleParentParams
+	paramPtrs          *RoleRepositoryMockAddRoleParentParamPtrs
+	expectationOrigins RoleRepositoryMockAddRoleParentExpectationOrigins
+	results            *RoleRepositoryMockAddRoleParentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockAddRoleParentParams contains parameters of the RoleRepository.AddRoleParent
+type RoleRepositoryMockAddRoleParentParams struct {
+	ctx    context.Context
+	role   string
+	parent string
+}
+
+// RoleRepositoryMockAddRoleParentParamPtrs contains pointers to parameters of the RoleRepository.AddRoleParent
+type RoleRepositoryMockAddRoleParentParamPtrs struct {
+	ctx    *context.Context
+	role   *string
+	parent *string
+}
+
+// RoleRepositoryMockAddRoleParentResults contains results of the RoleRepository.AddRoleParent
+type RoleRepositoryMockAddRoleParentResults struct {
+	err error
+}
+
+// RoleRepositoryMockAddRoleParentOrigins contains origins of expectations of the RoleRepository.AddRoleParent
+type RoleRepositoryMockAddRoleParentExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRole   string
+	originParent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Optional() *mRoleRepositoryMockAddRoleParent {
+	mmAddRoleParent.optional = true
+	return mmAddRoleParent
+}
+
+// Expect sets up expected params for RoleRepository.AddRoleParent
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Expect(ctx context.Context, role string, parent string) *mRoleRepositoryMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &RoleRepositoryMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by ExpectParams functions")
+	}
+
+	mmAddRoleParent.defaultExpectation.params = &RoleRepositoryMockAddRoleParentParams{ctx, role, parent}
+	mmAddRoleParent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddRoleParent.expectations {
+		if minimock.Equal(e.params, mmAddRoleParent.defaultExpectation.params) {
+			mmAddRoleParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddRoleParent.defaultExpectation.params)
+		}
+	}
+
+	return mmAddRoleParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.AddRoleParent
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &RoleRepositoryMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.params != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Expect")
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs == nil {
+		mmAddRoleParent.defaultExpectation.paramPtrs = &RoleRepositoryMockAddRoleParentParamPtrs{}
+	}
+	mmAddRoleParent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddRoleParent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddRoleParent
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.AddRoleParent
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) ExpectRoleParam2(role string) *mRoleRepositoryMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &RoleRepositoryMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.params != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Expect")
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs == nil {
+		mmAddRoleParent.defaultExpectation.paramPtrs = &RoleRepositoryMockAddRoleParentParamPtrs{}
+	}
+	mmAddRoleParent.defaultExpectation.paramPtrs.role = &role
+	mmAddRoleParent.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmAddRoleParent
+}
+
+// ExpectParentParam3 sets up expected param parent for RoleRepository.AddRoleParent
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) ExpectParentParam3(parent string) *mRoleRepositoryMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &RoleRepositoryMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.params != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Expect")
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs == nil {
+		mmAddRoleParent.defaultExpectation.paramPtrs = &RoleRepositoryMockAddRoleParentParamPtrs{}
+	}
+	mmAddRoleParent.defaultExpectation.paramPtrs.parent = &parent
+	mmAddRoleParent.defaultExpectation.expectationOrigins.originParent = minimock.CallerInfo(1)
+
+	return mmAddRoleParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.AddRoleParent
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Inspect(f func(ctx context.Context, role string, parent string)) *mRoleRepositoryMockAddRoleParent {
+	if mmAddRoleParent.mock.inspectFuncAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.AddRoleParent")
+	}
+
+	mmAddRoleParent.mock.inspectFuncAddRoleParent = f
+
+	return mmAddRoleParent
+}
+
+// Return sets up results that will be returned by RoleRepository.AddRoleParent
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Return(err error) *RoleRepositoryMock {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &RoleRepositoryMockAddRoleParentExpectation{mock: mmAddRoleParent.mock}
+	}
+	mmAddRoleParent.defaultExpectation.results = &RoleRepositoryMockAddRoleParentResults{err}
+	mmAddRoleParent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddRoleParent.mock
+}
+
+// Set uses given function f to mock the RoleRepository.AddRoleParent method
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Set(f func(ctx context.Context, role string, parent string) (err error)) *RoleRepositoryMock {
+	if mmAddRoleParent.defaultExpectation != nil {
+		mmAddRoleParent.mock.t.Fatalf("Default expectation is already set for the RoleRepository.AddRoleParent method")
+	}
+
+	if len(mmAddRoleParent.expectations) > 0 {
+		mmAddRoleParent.mock.t.Fatalf("Some expectations are already set for the RoleRepository.AddRoleParent method")
+	}
+
+	mmAddRoleParent.mock.funcAddRoleParent = f
+	mmAddRoleParent.mock.funcAddRoleParentOrigin = minimock.CallerInfo(1)
+	return mmAddRoleParent.mock
+}
+
+// When sets expectation for the RoleRepository.AddRoleParent which will trigger the result defined by the following
+// Then helper
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) When(ctx context.Context, role string, parent string) *RoleRepositoryMockAddRoleParentExpectation {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("RoleRepositoryMock.AddRoleParent mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockAddRoleParentExpectation{
+		mock:               mmAddRoleParent.mock,
+		params:             &RoleRepositoryMockAddRoleParentParams{ctx, role, parent},
+		expectationOrigins: RoleRepositoryMockAddRoleParentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddRoleParent.expectations = append(mmAddRoleParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.AddRoleParent return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockAddRoleParentExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockAddRoleParentResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.AddRoleParent should be invoked
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Times(n uint64) *mRoleRepositoryMockAddRoleParent {
+	if n == 0 {
+		mmAddRoleParent.mock.t.Fatalf("Times of RoleRepositoryMock.AddRoleParent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddRoleParent.expectedInvocations, n)
+	mmAddRoleParent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddRoleParent
+}
+
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) invocationsDone() bool {
+	if len(mmAddRoleParent.expectations) == 0 && mmAddRoleParent.defaultExpectation == nil && mmAddRoleParent.mock.funcAddRoleParent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddRoleParent.mock.afterAddRoleParentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddRoleParent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddRoleParent implements mm_repository.RoleRepository
+func (mmAddRoleParent *RoleRepositoryMock) AddRoleParent(ctx context.Context, role string, parent string) (err error) {
+	mm_atomic.AddUint64(&mmAddRoleParent.beforeAddRoleParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRoleParent.afterAddRoleParentCounter, 1)
+
+	mmAddRoleParent.t.Helper()
+
+	if mmAddRoleParent.inspectFuncAddRoleParent != nil {
+		mmAddRoleParent.inspectFuncAddRoleParent(ctx, role, parent)
+	}
+
+	mm_params := RoleRepositoryMockAddRoleParentParams{ctx, role, parent}
+
+	// Record call args
+	mmAddRoleParent.AddRoleParentMock.mutex.Lock()
+	mmAddRoleParent.AddRoleParentMock.callArgs = append(mmAddRoleParent.AddRoleParentMock.callArgs, &mm_params)
+	mmAddRoleParent.AddRoleParentMock.mutex.Unlock()
+
+	for _, e := range mmAddRoleParent.AddRoleParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddRoleParent.AddRoleParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRoleParent.AddRoleParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRoleParent.AddRoleParentMock.defaultExpectation.params
+		mm_want_ptrs := mmAddRoleParent.AddRoleParentMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockAddRoleParentParams{ctx, role, parent}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddRoleParent.t.Errorf("RoleRepositoryMock.AddRoleParent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmAddRoleParent.t.Errorf("RoleRepositoryMock.AddRoleParent got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+			if mm_want_ptrs.parent != nil && !minimock.Equal(*mm_want_ptrs.parent, mm_got.parent) {
+				mmAddRoleParent.t.Errorf("RoleRepositoryMock.AddRoleParent got unexpected parameter parent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.originParent, *mm_want_ptrs.parent, mm_got.parent, minimock.Diff(*mm_want_ptrs.parent, mm_got.parent))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddRoleParent.t.Errorf("RoleRepositoryMock.AddRoleParent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddRoleParent.AddRoleParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddRoleParent.t.Fatal("No results are set for the RoleRepositoryMock.AddRoleParent")
+		}
+		return (*mm_results).err
+	}
+	if mmAddRoleParent.funcAddRoleParent != nil {
+		return mmAddRoleParent.funcAddRoleParent(ctx, role, parent)
+	}
+	mmAddRoleParent.t.Fatalf("Unexpected call to RoleRepositoryMock.AddRoleParent. %v %v %v", ctx, role, parent)
+	return
+}
+
+// AddRoleParentAfterCounter returns a count of finished RoleRepositoryMock.AddRoleParent invocations
+func (mmAddRoleParent *RoleRepositoryMock) AddRoleParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRoleParent.afterAddRoleParentCounter)
+}
+
+// AddRoleParentBeforeCounter returns a count of RoleRepositoryMock.AddRoleParent invocations
+func (mmAddRoleParent *RoleRepositoryMock) AddRoleParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRoleParent.beforeAddRoleParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.AddRoleParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddRoleParent *mRoleRepositoryMockAddRoleParent) Calls() []*RoleRepositoryMockAddRoleParentParams {
+	mmAddRoleParent.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockAddRoleParentParams, len(mmAddRoleParent.callArgs))
+	copy(argCopy, mmAddRoleParent.callArgs)
+
+	mmAddRoleParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddRoleParentDone returns true if the count of the AddRoleParent invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockAddRoleParentDone() bool {
+	if m.AddRoleParentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddRoleParentMock.invocationsDone()
+}
+
+// MinimockAddRoleParentInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockAddRoleParentInspect() {
+	for _, e := range m.AddRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AddRoleParent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddRoleParentCounter := mm_atomic.LoadUint64(&m.afterAddRoleParentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddRoleParentMock.defaultExpectation != nil && afterAddRoleParentCounter < 1 {
+		if m.AddRoleParentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AddRoleParent at\n%s", m.AddRoleParentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AddRoleParent at\n%s with params: %#v", m.AddRoleParentMock.defaultExpectation.expectationOrigins.origin, *m.AddRoleParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddRoleParent != nil && afterAddRoleParentCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.AddRoleParent at\n%s", m.funcAddRoleParentOrigin)
+	}
+
+	if !m.AddRoleParentMock.invocationsDone() && afterAddRoleParentCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.AddRoleParent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRoleParentMock.expectedInvocations), m.AddRoleParentMock.expectedInvocationsOrigin, afterAddRoleParentCounter)
+	}
+}
+
+type mRoleRepositoryMockCreateRole struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockCreateRoleExpectation
+	expectations       []*RoleRepositoryMockCreateRoleExpectation
+
+	callArgs []*RoleRepositoryMockCreateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockCreateRoleExpectation specifies expectation struct of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockCreateRoleParams
+	paramPtrs          *RoleRepositoryMockCreateRoleParamPtrs
+	expectationOrigins RoleRepositoryMockCreateRoleExpectationOrigins
+	results            *RoleRepositoryMockCreateRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockCreateRoleParams contains parameters of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleParams struct {
+	ctx  context.Context
+	role *model.Role
+}
+
+// RoleRepositoryMockCreateRoleParamPtrs contains pointers to parameters of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleParamPtrs struct {
+	ctx  *context.Context
+	role **model.Role
+}
+
+// RoleRepositoryMockCreateRoleResults contains results of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleResults struct {
+	i1  int64
+	err error
+}
+
+// RoleRepositoryMockCreateRoleOrigins contains origins of expectations of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Optional() *mRoleRepositoryMockCreateRole {
+	mmCreateRole.optional = true
+	return mmCreateRole
+}
+
+// Expect sets up expected params for RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Expect(ctx context.Context, role *model.Role) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRole.defaultExpectation.params = &RoleRepositoryMockCreateRoleParams{ctx, role}
+	mmCreateRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRole.expectations {
+		if minimock.Equal(e.params, mmCreateRole.defaultExpectation.params) {
+			mmCreateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRole
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) ExpectRoleParam2(role *model.Role) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.role = &role
+	mmCreateRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmCreateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Inspect(f func(ctx context.Context, role *model.Role)) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.inspectFuncCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.CreateRole")
+	}
+
+	mmCreateRole.mock.inspectFuncCreateRole = f
+
+	return mmCreateRole
+}
+
+// Return sets up results that will be returned by RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Return(i1 int64, err error) *RoleRepositoryMock {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{mock: mmCreateRole.mock}
+	}
+	mmCreateRole.defaultExpectation.results = &RoleRepositoryMockCreateRoleResults{i1, err}
+	mmCreateRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRole.mock
+}
+
+// Set uses given function f to mock the RoleRepository.CreateRole method
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Set(f func(ctx context.Context, role *model.Role) (i1 int64, err error)) *RoleRepositoryMock {
+	if mmCreateRole.defaultExpectation != nil {
+		mmCreateRole.mock.t.Fatalf("Default expectation is already set for the RoleRepository.CreateRole method")
+	}
+
+	if len(mmCreateRole.expectations) > 0 {
+		mmCreateRole.mock.t.Fatalf("Some expectations are already set for the RoleRepository.CreateRole method")
+	}
+
+	mmCreateRole.mock.funcCreateRole = f
+	mmCreateRole.mock.funcCreateRoleOrigin = minimock.CallerInfo(1)
+	return mmCreateRole.mock
+}
+
+// When sets expectation for the RoleRepository.CreateRole which will trigger the result defined by the following
+// Then helper
+func (mmCreateRole *mRoleRepositoryMockCreateRole) When(ctx context.Context, role *model.Role) *RoleRepositoryMockCreateRoleExpectation {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockCreateRoleExpectation{
+		mock:               mmCreateRole.mock,
+		params:             &RoleRepositoryMockCreateRoleParams{ctx, role},
+		expectationOrigins: RoleRepositoryMockCreateRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRole.expectations = append(mmCreateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.CreateRole return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockCreateRoleExpectation) Then(i1 int64, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockCreateRoleResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.CreateRole should be invoked
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Times(n uint64) *mRoleRepositoryMockCreateRole {
+	if n == 0 {
+		mmCreateRole.mock.t.Fatalf("Times of RoleRepositoryMock.CreateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRole.expectedInvocations, n)
+	mmCreateRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRole
+}
+
+func (mmCreateRole *mRoleRepositoryMockCreateRole) invocationsDone() bool {
+	if len(mmCreateRole.expectations) == 0 && mmCreateRole.defaultExpectation == nil && mmCreateRole.mock.funcCreateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRole.mock.afterCreateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRole implements mm_repository.RoleRepository
+func (mmCreateRole *RoleRepositoryMock) CreateRole(ctx context.Context, role *model.Role) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRole.beforeCreateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRole.afterCreateRoleCounter, 1)
+
+	mmCreateRole.t.Helper()
+
+	if mmCreateRole.inspectFuncCreateRole != nil {
+		mmCreateRole.inspectFuncCreateRole(ctx, role)
+	}
+
+	mm_params := RoleRepositoryMockCreateRoleParams{ctx, role}
+
+	// Record call args
+	mmCreateRole.CreateRoleMock.mutex.Lock()
+	mmCreateRole.CreateRoleMock.callArgs = append(mmCreateRole.CreateRoleMock.callArgs, &mm_params)
+	mmCreateRole.CreateRoleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRole.CreateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRole.CreateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRole.CreateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRole.CreateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRole.CreateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockCreateRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRole.t.Errorf("RoleRepositoryMock.CreateRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCreateRole.t.Errorf("RoleRepositoryMock.CreateRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRole.t.Errorf("RoleRepositoryMock.CreateRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRole.CreateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRole.t.Fatal("No results are set for the RoleRepositoryMock.CreateRole")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRole.funcCreateRole != nil {
+		return mmCreateRole.funcCreateRole(ctx, role)
+	}
+	mmCreateRole.t.Fatalf("Unexpected call to RoleRepositoryMock.CreateRole. %v %v", ctx, role)
+	return
+}
+
+// CreateRoleAfterCounter returns a count of finished RoleRepositoryMock.CreateRole invocations
+func (mmCreateRole *RoleRepositoryMock) CreateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.afterCreateRoleCounter)
+}
+
+// CreateRoleBeforeCounter returns a count of RoleRepositoryMock.CreateRole invocations
+func (mmCreateRole *RoleRepositoryMock) CreateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.beforeCreateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.CreateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Calls() []*RoleRepositoryMockCreateRoleParams {
+	mmCreateRole.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockCreateRoleParams, len(mmCreateRole.callArgs))
+	copy(argCopy, mmCreateRole.callArgs)
+
+	mmCreateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRoleDone returns true if the count of the CreateRole invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockCreateRoleDone() bool {
+	if m.CreateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRoleMock.invocationsDone()
+}
+
+// MinimockCreateRoleInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockCreateRoleInspect() {
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRoleCounter := mm_atomic.LoadUint64(&m.afterCreateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRoleMock.defaultExpectation != nil && afterCreateRoleCounter < 1 {
+		if m.CreateRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s", m.CreateRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s with params: %#v", m.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *m.CreateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRole != nil && afterCreateRoleCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s", m.funcCreateRoleOrigin)
+	}
+
+	if !m.CreateRoleMock.invocationsDone() && afterCreateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.CreateRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRoleMock.expectedInvocations), m.CreateRoleMock.expectedInvocationsOrigin, afterCreateRoleCounter)
+	}
+}
+
+type mRoleRepositoryMockDeleteRole struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockDeleteRoleExpectation
+	expectations       []*RoleRepositoryMockDeleteRoleExpectation
+
+	callArgs []*RoleRepositoryMockDeleteRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockDeleteRoleExpectation specifies expectation struct of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockDeleteRoleParams
+	paramPtrs          *RoleRepositoryMockDeleteRoleParamPtrs
+	expectationOrigins RoleRepositoryMockDeleteRoleExpectationOrigins
+	results            *RoleRepositoryMockDeleteRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockDeleteRoleParams contains parameters of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleRepositoryMockDeleteRoleParamPtrs contains pointers to parameters of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleRepositoryMockDeleteRoleResults contains results of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleResults struct {
+	err error
+}
+
+// RoleRepositoryMockDeleteRoleOrigins contains origins of expectations of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Optional() *mRoleRepositoryMockDeleteRole {
+	mmDeleteRole.optional = true
+	return mmDeleteRole
+}
+
+// Expect sets up expected params for RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Expect(ctx context.Context, name string) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRole.defaultExpectation.params = &RoleRepositoryMockDeleteRoleParams{ctx, name}
+	mmDeleteRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRole.expectations {
+		if minimock.Equal(e.params, mmDeleteRole.defaultExpectation.params) {
+			mmDeleteRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// ExpectNameParam2 sets up expected param name for RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) ExpectNameParam2(name string) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.name = &name
+	mmDeleteRole.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Inspect(f func(ctx context.Context, name string)) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.inspectFuncDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.DeleteRole")
+	}
+
+	mmDeleteRole.mock.inspectFuncDeleteRole = f
+
+	return mmDeleteRole
+}
+
+// Return sets up results that will be returned by RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Return(err error) *RoleRepositoryMock {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{mock: mmDeleteRole.mock}
+	}
+	mmDeleteRole.defaultExpectation.results = &RoleRepositoryMockDeleteRoleResults{err}
+	mmDeleteRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole.mock
+}
+
+// Set uses given function f to mock the RoleRepository.DeleteRole method
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Set(f func(ctx context.Context, name string) (err error)) *RoleRepositoryMock {
+	if mmDeleteRole.defaultExpectation != nil {
+		mmDeleteRole.mock.t.Fatalf("Default expectation is already set for the RoleRepository.DeleteRole method")
+	}
+
+	if len(mmDeleteRole.expectations) > 0 {
+		mmDeleteRole.mock.t.Fatalf("Some expectations are already set for the RoleRepository.DeleteRole method")
+	}
+
+	mmDeleteRole.mock.funcDeleteRole = f
+	mmDeleteRole.mock.funcDeleteRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole.mock
+}
+
+// When sets expectation for the RoleRepository.DeleteRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) When(ctx context.Context, name string) *RoleRepositoryMockDeleteRoleExpectation {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockDeleteRoleExpectation{
+		mock:               mmDeleteRole.mock,
+		params:             &RoleRepositoryMockDeleteRoleParams{ctx, name},
+		expectationOrigins: RoleRepositoryMockDeleteRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRole.expectations = append(mmDeleteRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.DeleteRole return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockDeleteRoleExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockDeleteRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.DeleteRole should be invoked
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Times(n uint64) *mRoleRepositoryMockDeleteRole {
+	if n == 0 {
+		mmDeleteRole.mock.t.Fatalf("Times of RoleRepositoryMock.DeleteRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRole.expectedInvocations, n)
+	mmDeleteRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole
+}
+
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) invocationsDone() bool {
+	if len(mmDeleteRole.expectations) == 0 && mmDeleteRole.defaultExpectation == nil && mmDeleteRole.mock.funcDeleteRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRole.mock.afterDeleteRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRole implements mm_repository.RoleRepository
+func (mmDeleteRole *RoleRepositoryMock) DeleteRole(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRole.beforeDeleteRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRole.afterDeleteRoleCounter, 1)
+
+	mmDeleteRole.t.Helper()
+
+	if mmDeleteRole.inspectFuncDeleteRole != nil {
+		mmDeleteRole.inspectFuncDeleteRole(ctx, name)
+	}
+
+	mm_params := RoleRepositoryMockDeleteRoleParams{ctx, name}
+
+	// Record call args
+	mmDeleteRole.DeleteRoleMock.mutex.Lock()
+	mmDeleteRole.DeleteRoleMock.callArgs = append(mmDeleteRole.DeleteRoleMock.callArgs, &mm_params)
+	mmDeleteRole.DeleteRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRole.DeleteRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRole.DeleteRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRole.DeleteRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRole.DeleteRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRole.DeleteRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockDeleteRoleParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRole.t.Errorf("RoleRepositoryMock.DeleteRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteRole.t.Errorf("RoleRepositoryMock.DeleteRole got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRole.t.Errorf("RoleRepositoryMock.DeleteRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRole.DeleteRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRole.t.Fatal("No results are set for the RoleRepositoryMock.DeleteRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRole.funcDeleteRole != nil {
+		return mmDeleteRole.funcDeleteRole(ctx, name)
+	}
+	mmDeleteRole.t.Fatalf("Unexpected call to RoleRepositoryMock.DeleteRole. %v %v", ctx, name)
+	return
+}
+
+// DeleteRoleAfterCounter returns a count of finished RoleRepositoryMock.DeleteRole invocations
+func (mmDeleteRole *RoleRepositoryMock) DeleteRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.afterDeleteRoleCounter)
+}
+
+// DeleteRoleBeforeCounter returns a count of RoleRepositoryMock.DeleteRole invocations
+func (mmDeleteRole *RoleRepositoryMock) DeleteRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.beforeDeleteRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.DeleteRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Calls() []*RoleRepositoryMockDeleteRoleParams {
+	mmDeleteRole.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockDeleteRoleParams, len(mmDeleteRole.callArgs))
+	copy(argCopy, mmDeleteRole.callArgs)
+
+	mmDeleteRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleDone returns true if the count of the DeleteRole invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockDeleteRoleDone() bool {
+	if m.DeleteRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleMock.invocationsDone()
+}
+
+// MinimockDeleteRoleInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockDeleteRoleInspect() {
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleMock.defaultExpectation != nil && afterDeleteRoleCounter < 1 {
+		if m.DeleteRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s", m.DeleteRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s with params: %#v", m.DeleteRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRole != nil && afterDeleteRoleCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s", m.funcDeleteRoleOrigin)
+	}
+
+	if !m.DeleteRoleMock.invocationsDone() && afterDeleteRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.DeleteRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleMock.expectedInvocations), m.DeleteRoleMock.expectedInvocationsOrigin, afterDeleteRoleCounter)
+	}
+}
+
+type mRoleRepositoryMockDeleteRoleParent struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockDeleteRoleParentExpectation
+	expectations       []*RoleRepositoryMockDeleteRoleParentExpectation
+
+	callArgs []*RoleRepositoryMockDeleteRoleParentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockDeleteRoleParentExpectation specifies expectation struct of the RoleRepository.DeleteRoleParent
+type RoleRepositoryMockDeleteRoleParentExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockDeleteRoleParentParams
+	paramPtrs          *RoleRepositoryMockDeleteRoleParentParamPtrs
+	expectationOrigins RoleRepositoryMockDeleteRoleParentExpectationOrigins
+	results            *RoleRepositoryMockDeleteRoleParentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockDeleteRoleParentParams contains parameters of the RoleRepository.DeleteRoleParent
+type RoleRepositoryMockDeleteRoleParentParams struct {
+	ctx    context.Context
+	role   string
+	parent string
+}
+
+// RoleRepositoryMockDeleteRoleParentParamPtrs contains pointers to parameters of the RoleRepository.DeleteRoleParent
+type RoleRepositoryMockDeleteRoleParentParamPtrs struct {
+	ctx    *context.Context
+	role   *string
+	parent *string
+}
+
+// RoleRepositoryMockDeleteRoleParentResults contains results of the RoleRepository.DeleteRoleParent
+type RoleRepositoryMockDeleteRoleParentResults struct {
+	err error
+}
+
+// RoleRepositoryMockDeleteRoleParentOrigins contains origins of expectations of the RoleRepository.DeleteRoleParent
+type RoleRepositoryMockDeleteRoleParentExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRole   string
+	originParent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Optional() *mRoleRepositoryMockDeleteRoleParent {
+	mmDeleteRoleParent.optional = true
+	return mmDeleteRoleParent
+}
+
+// Expect sets up expected params for RoleRepository.DeleteRoleParent
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Expect(ctx context.Context, role string, parent string) *mRoleRepositoryMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &RoleRepositoryMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRoleParent.defaultExpectation.params = &RoleRepositoryMockDeleteRoleParentParams{ctx, role, parent}
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRoleParent.expectations {
+		if minimock.Equal(e.params, mmDeleteRoleParent.defaultExpectation.params) {
+			mmDeleteRoleParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRoleParent.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRoleParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.DeleteRoleParent
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &RoleRepositoryMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.params != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Expect")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleParent.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParentParamPtrs{}
+	}
+	mmDeleteRoleParent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRoleParent
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.DeleteRoleParent
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) ExpectRoleParam2(role string) *mRoleRepositoryMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &RoleRepositoryMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.params != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Expect")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleParent.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParentParamPtrs{}
+	}
+	mmDeleteRoleParent.defaultExpectation.paramPtrs.role = &role
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmDeleteRoleParent
+}
+
+// ExpectParentParam3 sets up expected param parent for RoleRepository.DeleteRoleParent
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) ExpectParentParam3(parent string) *mRoleRepositoryMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &RoleRepositoryMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.params != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Expect")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleParent.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParentParamPtrs{}
+	}
+	mmDeleteRoleParent.defaultExpectation.paramPtrs.parent = &parent
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.originParent = minimock.CallerInfo(1)
+
+	return mmDeleteRoleParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.DeleteRoleParent
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Inspect(f func(ctx context.Context, role string, parent string)) *mRoleRepositoryMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.inspectFuncDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.DeleteRoleParent")
+	}
+
+	mmDeleteRoleParent.mock.inspectFuncDeleteRoleParent = f
+
+	return mmDeleteRoleParent
+}
+
+// Return sets up results that will be returned by RoleRepository.DeleteRoleParent
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Return(err error) *RoleRepositoryMock {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &RoleRepositoryMockDeleteRoleParentExpectation{mock: mmDeleteRoleParent.mock}
+	}
+	mmDeleteRoleParent.defaultExpectation.results = &RoleRepositoryMockDeleteRoleParentResults{err}
+	mmDeleteRoleParent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleParent.mock
+}
+
+// Set uses given function f to mock the RoleRepository.DeleteRoleParent method
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Set(f func(ctx context.Context, role string, parent string) (err error)) *RoleRepositoryMock {
+	if mmDeleteRoleParent.defaultExpectation != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("Default expectation is already set for the RoleRepository.DeleteRoleParent method")
+	}
+
+	if len(mmDeleteRoleParent.expectations) > 0 {
+		mmDeleteRoleParent.mock.t.Fatalf("Some expectations are already set for the RoleRepository.DeleteRoleParent method")
+	}
+
+	mmDeleteRoleParent.mock.funcDeleteRoleParent = f
+	mmDeleteRoleParent.mock.funcDeleteRoleParentOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleParent.mock
+}
+
+// When sets expectation for the RoleRepository.DeleteRoleParent which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) When(ctx context.Context, role string, parent string) *RoleRepositoryMockDeleteRoleParentExpectation {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("RoleRepositoryMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockDeleteRoleParentExpectation{
+		mock:               mmDeleteRoleParent.mock,
+		params:             &RoleRepositoryMockDeleteRoleParentParams{ctx, role, parent},
+		expectationOrigins: RoleRepositoryMockDeleteRoleParentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRoleParent.expectations = append(mmDeleteRoleParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.DeleteRoleParent return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockDeleteRoleParentExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockDeleteRoleParentResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.DeleteRoleParent should be invoked
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Times(n uint64) *mRoleRepositoryMockDeleteRoleParent {
+	if n == 0 {
+		mmDeleteRoleParent.mock.t.Fatalf("Times of RoleRepositoryMock.DeleteRoleParent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRoleParent.expectedInvocations, n)
+	mmDeleteRoleParent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleParent
+}
+
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) invocationsDone() bool {
+	if len(mmDeleteRoleParent.expectations) == 0 && mmDeleteRoleParent.defaultExpectation == nil && mmDeleteRoleParent.mock.funcDeleteRoleParent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRoleParent.mock.afterDeleteRoleParentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRoleParent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRoleParent implements mm_repository.RoleRepository
+func (mmDeleteRoleParent *RoleRepositoryMock) DeleteRoleParent(ctx context.Context, role string, parent string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRoleParent.beforeDeleteRoleParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRoleParent.afterDeleteRoleParentCounter, 1)
+
+	mmDeleteRoleParent.t.Helper()
+
+	if mmDeleteRoleParent.inspectFuncDeleteRoleParent != nil {
+		mmDeleteRoleParent.inspectFuncDeleteRoleParent(ctx, role, parent)
+	}
+
+	mm_params := RoleRepositoryMockDeleteRoleParentParams{ctx, role, parent}
+
+	// Record call args
+	mmDeleteRoleParent.DeleteRoleParentMock.mutex.Lock()
+	mmDeleteRoleParent.DeleteRoleParentMock.callArgs = append(mmDeleteRoleParent.DeleteRoleParentMock.callArgs, &mm_params)
+	mmDeleteRoleParent.DeleteRoleParentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRoleParent.DeleteRoleParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockDeleteRoleParentParams{ctx, role, parent}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRoleParent.t.Errorf("RoleRepositoryMock.DeleteRoleParent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmDeleteRoleParent.t.Errorf("RoleRepositoryMock.DeleteRoleParent got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+			if mm_want_ptrs.parent != nil && !minimock.Equal(*mm_want_ptrs.parent, mm_got.parent) {
+				mmDeleteRoleParent.t.Errorf("RoleRepositoryMock.DeleteRoleParent got unexpected parameter parent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.originParent, *mm_want_ptrs.parent, mm_got.parent, minimock.Diff(*mm_want_ptrs.parent, mm_got.parent))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRoleParent.t.Errorf("RoleRepositoryMock.DeleteRoleParent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRoleParent.t.Fatal("No results are set for the RoleRepositoryMock.DeleteRoleParent")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRoleParent.funcDeleteRoleParent != nil {
+		return mmDeleteRoleParent.funcDeleteRoleParent(ctx, role, parent)
+	}
+	mmDeleteRoleParent.t.Fatalf("Unexpected call to RoleRepositoryMock.DeleteRoleParent. %v %v %v", ctx, role, parent)
+	return
+}
+
+// DeleteRoleParentAfterCounter returns a count of finished RoleRepositoryMock.DeleteRoleParent invocations
+func (mmDeleteRoleParent *RoleRepositoryMock) DeleteRoleParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRoleParent.afterDeleteRoleParentCounter)
+}
+
+// DeleteRoleParentBeforeCounter returns a count of RoleRepositoryMock.DeleteRoleParent invocations
+func (mmDeleteRoleParent *RoleRepositoryMock) DeleteRoleParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRoleParent.beforeDeleteRoleParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.DeleteRoleParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRoleParent *mRoleRepositoryMockDeleteRoleParent) Calls() []*RoleRepositoryMockDeleteRoleParentParams {
+	mmDeleteRoleParent.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockDeleteRoleParentParams, len(mmDeleteRoleParent.callArgs))
+	copy(argCopy, mmDeleteRoleParent.callArgs)
+
+	mmDeleteRoleParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleParentDone returns true if the count of the DeleteRoleParent invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockDeleteRoleParentDone() bool {
+	if m.DeleteRoleParentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleParentMock.invocationsDone()
+}
+
+// MinimockDeleteRoleParentInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockDeleteRoleParentInspect() {
+	for _, e := range m.DeleteRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRoleParent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRoleParentCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleParentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleParentMock.defaultExpectation != nil && afterDeleteRoleParentCounter < 1 {
+		if m.DeleteRoleParentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRoleParent at\n%s", m.DeleteRoleParentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRoleParent at\n%s with params: %#v", m.DeleteRoleParentMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRoleParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRoleParent != nil && afterDeleteRoleParentCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRoleParent at\n%s", m.funcDeleteRoleParentOrigin)
+	}
+
+	if !m.DeleteRoleParentMock.invocationsDone() && afterDeleteRoleParentCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.DeleteRoleParent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleParentMock.expectedInvocations), m.DeleteRoleParentMock.expectedInvocationsOrigin, afterDeleteRoleParentCounter)
+	}
+}
+
+type mRoleRepositoryMockListAncestorRoles struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListAncestorRolesExpectation
+	expectations       []*RoleRepositoryMockListAncestorRolesExpectation
+
+	callArgs []*RoleRepositoryMockListAncestorRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockListAncestorRolesExpectation specifies expectation struct of the RoleRepository.ListAncestorRoles
+type RoleRepositoryMockListAncestorRolesExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockListAncestorRolesParams
+	paramPtrs          *RoleRepositoryMockListAncestorRolesParamPtrs
+	expectationOrigins RoleRepositoryMockListAncestorRolesExpectationOrigins
+	results            *RoleRepositoryMockListAncestorRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockListAncestorRolesParams contains parameters of the RoleRepository.ListAncestorRoles
+type RoleRepositoryMockListAncestorRolesParams struct {
+	ctx  context.Context
+	role string
+}
+
+// RoleRepositoryMockListAncestorRolesParamPtrs contains pointers to parameters of the RoleRepository.ListAncestorRoles
+type RoleRepositoryMockListAncestorRolesParamPtrs struct {
+	ctx  *context.Context
+	role *string
+}
+
+// RoleRepositoryMockListAncestorRolesResults contains results of the RoleRepository.ListAncestorRoles
+type RoleRepositoryMockListAncestorRolesResults struct {
+	sa1 []string
+	err error
+}
+
+// RoleRepositoryMockListAncestorRolesOrigins contains origins of expectations of the RoleRepository.ListAncestorRoles
+type RoleRepositoryMockListAncestorRolesExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Optional() *mRoleRepositoryMockListAncestorRoles {
+	mmListAncestorRoles.optional = true
+	return mmListAncestorRoles
+}
+
+// Expect sets up expected params for RoleRepository.ListAncestorRoles
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Expect(ctx context.Context, role string) *mRoleRepositoryMockListAncestorRoles {
+	if mmListAncestorRoles.mock.funcListAncestorRoles != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Set")
+	}
+
+	if mmListAncestorRoles.defaultExpectation == nil {
+		mmListAncestorRoles.defaultExpectation = &RoleRepositoryMockListAncestorRolesExpectation{}
+	}
+
+	if mmListAncestorRoles.defaultExpectation.paramPtrs != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListAncestorRoles.defaultExpectation.params = &RoleRepositoryMockListAncestorRolesParams{ctx, role}
+	mmListAncestorRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAncestorRoles.expectations {
+		if minimock.Equal(e.params, mmListAncestorRoles.defaultExpectation.params) {
+			mmListAncestorRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAncestorRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListAncestorRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListAncestorRoles
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListAncestorRoles {
+	if mmListAncestorRoles.mock.funcListAncestorRoles != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Set")
+	}
+
+	if mmListAncestorRoles.defaultExpectation == nil {
+		mmListAncestorRoles.defaultExpectation = &RoleRepositoryMockListAncestorRolesExpectation{}
+	}
+
+	if mmListAncestorRoles.defaultExpectation.params != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Expect")
+	}
+
+	if mmListAncestorRoles.defaultExpectation.paramPtrs == nil {
+		mmListAncestorRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListAncestorRolesParamPtrs{}
+	}
+	mmListAncestorRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAncestorRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAncestorRoles
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.ListAncestorRoles
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) ExpectRoleParam2(role string) *mRoleRepositoryMockListAncestorRoles {
+	if mmListAncestorRoles.mock.funcListAncestorRoles != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Set")
+	}
+
+	if mmListAncestorRoles.defaultExpectation == nil {
+		mmListAncestorRoles.defaultExpectation = &RoleRepositoryMockListAncestorRolesExpectation{}
+	}
+
+	if mmListAncestorRoles.defaultExpectation.params != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Expect")
+	}
+
+	if mmListAncestorRoles.defaultExpectation.paramPtrs == nil {
+		mmListAncestorRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListAncestorRolesParamPtrs{}
+	}
+	mmListAncestorRoles.defaultExpectation.paramPtrs.role = &role
+	mmListAncestorRoles.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmListAncestorRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListAncestorRoles
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Inspect(f func(ctx context.Context, role string)) *mRoleRepositoryMockListAncestorRoles {
+	if mmListAncestorRoles.mock.inspectFuncListAncestorRoles != nil {
+		mmListAncestorRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListAncestorRoles")
+	}
+
+	mmListAncestorRoles.mock.inspectFuncListAncestorRoles = f
+
+	return mmListAncestorRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.ListAncestorRoles
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Return(sa1 []string, err error) *RoleRepositoryMock {
+	if mmListAncestorRoles.mock.funcListAncestorRoles != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Set")
+	}
+
+	if mmListAncestorRoles.defaultExpectation == nil {
+		mmListAncestorRoles.defaultExpectation = &RoleRepositoryMockListAncestorRolesExpectation{mock: mmListAncestorRoles.mock}
+	}
+	mmListAncestorRoles.defaultExpectation.results = &RoleRepositoryMockListAncestorRolesResults{sa1, err}
+	mmListAncestorRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAncestorRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListAncestorRoles method
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Set(f func(ctx context.Context, role string) (sa1 []string, err error)) *RoleRepositoryMock {
+	if mmListAncestorRoles.defaultExpectation != nil {
+		mmListAncestorRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListAncestorRoles method")
+	}
+
+	if len(mmListAncestorRoles.expectations) > 0 {
+		mmListAncestorRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListAncestorRoles method")
+	}
+
+	mmListAncestorRoles.mock.funcListAncestorRoles = f
+	mmListAncestorRoles.mock.funcListAncestorRolesOrigin = minimock.CallerInfo(1)
+	return mmListAncestorRoles.mock
+}
+
+// When sets expectation for the RoleRepository.ListAncestorRoles which will trigger the result defined by the following
+// Then helper
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) When(ctx context.Context, role string) *RoleRepositoryMockListAncestorRolesExpectation {
+	if mmListAncestorRoles.mock.funcListAncestorRoles != nil {
+		mmListAncestorRoles.mock.t.Fatalf("RoleRepositoryMock.ListAncestorRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListAncestorRolesExpectation{
+		mock:               mmListAncestorRoles.mock,
+		params:             &RoleRepositoryMockListAncestorRolesParams{ctx, role},
+		expectationOrigins: RoleRepositoryMockListAncestorRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAncestorRoles.expectations = append(mmListAncestorRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListAncestorRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListAncestorRolesExpectation) Then(sa1 []string, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListAncestorRolesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.ListAncestorRoles should be invoked
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Times(n uint64) *mRoleRepositoryMockListAncestorRoles {
+	if n == 0 {
+		mmListAncestorRoles.mock.t.Fatalf("Times of RoleRepositoryMock.ListAncestorRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAncestorRoles.expectedInvocations, n)
+	mmListAncestorRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAncestorRoles
+}
+
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) invocationsDone() bool {
+	if len(mmListAncestorRoles.expectations) == 0 && mmListAncestorRoles.defaultExpectation == nil && mmListAncestorRoles.mock.funcListAncestorRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAncestorRoles.mock.afterListAncestorRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAncestorRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAncestorRoles implements mm_repository.RoleRepository
+func (mmListAncestorRoles *RoleRepositoryMock) ListAncestorRoles(ctx context.Context, role string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListAncestorRoles.beforeListAncestorRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAncestorRoles.afterListAncestorRolesCounter, 1)
+
+	mmListAncestorRoles.t.Helper()
+
+	if mmListAncestorRoles.inspectFuncListAncestorRoles != nil {
+		mmListAncestorRoles.inspectFuncListAncestorRoles(ctx, role)
+	}
+
+	mm_params := RoleRepositoryMockListAncestorRolesParams{ctx, role}
+
+	// Record call args
+	mmListAncestorRoles.ListAncestorRolesMock.mutex.Lock()
+	mmListAncestorRoles.ListAncestorRolesMock.callArgs = append(mmListAncestorRoles.ListAncestorRolesMock.callArgs, &mm_params)
+	mmListAncestorRoles.ListAncestorRolesMock.mutex.Unlock()
+
+	for _, e := range mmListAncestorRoles.ListAncestorRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListAncestorRolesParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAncestorRoles.t.Errorf("RoleRepositoryMock.ListAncestorRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmListAncestorRoles.t.Errorf("RoleRepositoryMock.ListAncestorRoles got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAncestorRoles.t.Errorf("RoleRepositoryMock.ListAncestorRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAncestorRoles.ListAncestorRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAncestorRoles.t.Fatal("No results are set for the RoleRepositoryMock.ListAncestorRoles")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListAncestorRoles.funcListAncestorRoles != nil {
+		return mmListAncestorRoles.funcListAncestorRoles(ctx, role)
+	}
+	mmListAncestorRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.ListAncestorRoles. %v %v", ctx, role)
+	return
+}
+
+// ListAncestorRolesAfterCounter returns a count of finished RoleRepositoryMock.ListAncestorRoles invocations
+func (mmListAncestorRoles *RoleRepositoryMock) ListAncestorRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAncestorRoles.afterListAncestorRolesCounter)
+}
+
+// ListAncestorRolesBeforeCounter returns a count of RoleRepositoryMock.ListAncestorRoles invocations
+func (mmListAncestorRoles *RoleRepositoryMock) ListAncestorRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAncestorRoles.beforeListAncestorRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListAncestorRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAncestorRoles *mRoleRepositoryMockListAncestorRoles) Calls() []*RoleRepositoryMockListAncestorRolesParams {
+	mmListAncestorRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListAncestorRolesParams, len(mmListAncestorRoles.callArgs))
+	copy(argCopy, mmListAncestorRoles.callArgs)
+
+	mmListAncestorRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAncestorRolesDone returns true if the count of the ListAncestorRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListAncestorRolesDone() bool {
+	if m.ListAncestorRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAncestorRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAncestorRolesMock.invocationsDone()
+}
+
+// MinimockListAncestorRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListAncestorRolesInspect() {
+	for _, e := range m.ListAncestorRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListAncestorRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAncestorRolesCounter := mm_atomic.LoadUint64(&m.afterListAncestorRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAncestorRolesMock.defaultExpectation != nil && afterListAncestorRolesCounter < 1 {
+		if m.ListAncestorRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListAncestorRoles at\n%s", m.ListAncestorRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListAncestorRoles at\n%s with params: %#v", m.ListAncestorRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListAncestorRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAncestorRoles != nil && afterListAncestorRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.ListAncestorRoles at\n%s", m.funcListAncestorRolesOrigin)
+	}
+
+	if !m.ListAncestorRolesMock.invocationsDone() && afterListAncestorRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.ListAncestorRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAncestorRolesMock.expectedInvocations), m.ListAncestorRolesMock.expectedInvocationsOrigin, afterListAncestorRolesCounter)
+	}
+}
+
+type mRoleRepositoryMockListRoleParents struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListRoleParentsExpectation
+	expectations       []*RoleRepositoryMockListRoleParentsExpectation
+
+	callArgs []*RoleRepositoryMockListRoleParentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockListRoleParentsExpectation specifies expectation struct of the RoleRepository.ListRoleParents
+type RoleRepositoryMockListRoleParentsExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockListRoleParentsParams
+	paramPtrs          *RoleRepositoryMockListRoleParentsParamPtrs
+	expectationOrigins RoleRepositoryMockListRoleParentsExpectationOrigins
+	results            *RoleRepositoryMockListRoleParentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockListRoleParentsParams contains parameters of the RoleRepository.ListRoleParents
+type RoleRepositoryMockListRoleParentsParams struct {
+	ctx  context.Context
+	role string
+}
+
+// RoleRepositoryMockListRoleParentsParamPtrs contains pointers to parameters of the RoleRepository.ListRoleParents
+type RoleRepositoryMockListRoleParentsParamPtrs struct {
+	ctx  *context.Context
+	role *string
+}
+
+// RoleRepositoryMockListRoleParentsResults contains results of the RoleRepository.ListRoleParents
+type RoleRepositoryMockListRoleParentsResults struct {
+	sa1 []string
+	err error
+}
+
+// RoleRepositoryMockListRoleParentsOrigins contains origins of expectations of the RoleRepository.ListRoleParents
+type RoleRepositoryMockListRoleParentsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Optional() *mRoleRepositoryMockListRoleParents {
+	mmListRoleParents.optional = true
+	return mmListRoleParents
+}
+
+// Expect sets up expected params for RoleRepository.ListRoleParents
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Expect(ctx context.Context, role string) *mRoleRepositoryMockListRoleParents {
+	if mmListRoleParents.mock.funcListRoleParents != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Set")
+	}
+
+	if mmListRoleParents.defaultExpectation == nil {
+		mmListRoleParents.defaultExpectation = &RoleRepositoryMockListRoleParentsExpectation{}
+	}
+
+	if mmListRoleParents.defaultExpectation.paramPtrs != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by ExpectParams functions")
+	}
+
+	mmListRoleParents.defaultExpectation.params = &RoleRepositoryMockListRoleParentsParams{ctx, role}
+	mmListRoleParents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoleParents.expectations {
+		if minimock.Equal(e.params, mmListRoleParents.defaultExpectation.params) {
+			mmListRoleParents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoleParents.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoleParents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListRoleParents
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListRoleParents {
+	if mmListRoleParents.mock.funcListRoleParents != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Set")
+	}
+
+	if mmListRoleParents.defaultExpectation == nil {
+		mmListRoleParents.defaultExpectation = &RoleRepositoryMockListRoleParentsExpectation{}
+	}
+
+	if mmListRoleParents.defaultExpectation.params != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Expect")
+	}
+
+	if mmListRoleParents.defaultExpectation.paramPtrs == nil {
+		mmListRoleParents.defaultExpectation.paramPtrs = &RoleRepositoryMockListRoleParentsParamPtrs{}
+	}
+	mmListRoleParents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoleParents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoleParents
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.ListRoleParents
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) ExpectRoleParam2(role string) *mRoleRepositoryMockListRoleParents {
+	if mmListRoleParents.mock.funcListRoleParents != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Set")
+	}
+
+	if mmListRoleParents.defaultExpectation == nil {
+		mmListRoleParents.defaultExpectation = &RoleRepositoryMockListRoleParentsExpectation{}
+	}
+
+	if mmListRoleParents.defaultExpectation.params != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Expect")
+	}
+
+	if mmListRoleParents.defaultExpectation.paramPtrs == nil {
+		mmListRoleParents.defaultExpectation.paramPtrs = &RoleRepositoryMockListRoleParentsParamPtrs{}
+	}
+	mmListRoleParents.defaultExpectation.paramPtrs.role = &role
+	mmListRoleParents.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmListRoleParents
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListRoleParents
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Inspect(f func(ctx context.Context, role string)) *mRoleRepositoryMockListRoleParents {
+	if mmListRoleParents.mock.inspectFuncListRoleParents != nil {
+		mmListRoleParents.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListRoleParents")
+	}
+
+	mmListRoleParents.mock.inspectFuncListRoleParents = f
+
+	return mmListRoleParents
+}
+
+// Return sets up results that will be returned by RoleRepository.ListRoleParents
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Return(sa1 []string, err error) *RoleRepositoryMock {
+	if mmListRoleParents.mock.funcListRoleParents != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Set")
+	}
+
+	if mmListRoleParents.defaultExpectation == nil {
+		mmListRoleParents.defaultExpectation = &RoleRepositoryMockListRoleParentsExpectation{mock: mmListRoleParents.mock}
+	}
+	mmListRoleParents.defaultExpectation.results = &RoleRepositoryMockListRoleParentsResults{sa1, err}
+	mmListRoleParents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoleParents.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListRoleParents method
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Set(f func(ctx context.Context, role string) (sa1 []string, err error)) *RoleRepositoryMock {
+	if mmListRoleParents.defaultExpectation != nil {
+		mmListRoleParents.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListRoleParents method")
+	}
+
+	if len(mmListRoleParents.expectations) > 0 {
+		mmListRoleParents.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListRoleParents method")
+	}
+
+	mmListRoleParents.mock.funcListRoleParents = f
+	mmListRoleParents.mock.funcListRoleParentsOrigin = minimock.CallerInfo(1)
+	return mmListRoleParents.mock
+}
+
+// When sets expectation for the RoleRepository.ListRoleParents which will trigger the result defined by the following
+// Then helper
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) When(ctx context.Context, role string) *RoleRepositoryMockListRoleParentsExpectation {
+	if mmListRoleParents.mock.funcListRoleParents != nil {
+		mmListRoleParents.mock.t.Fatalf("RoleRepositoryMock.ListRoleParents mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListRoleParentsExpectation{
+		mock:               mmListRoleParents.mock,
+		params:             &RoleRepositoryMockListRoleParentsParams{ctx, role},
+		expectationOrigins: RoleRepositoryMockListRoleParentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoleParents.expectations = append(mmListRoleParents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListRoleParents return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListRoleParentsExpectation) Then(sa1 []string, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListRoleParentsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.ListRoleParents should be invoked
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Times(n uint64) *mRoleRepositoryMockListRoleParents {
+	if n == 0 {
+		mmListRoleParents.mock.t.Fatalf("Times of RoleRepositoryMock.ListRoleParents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoleParents.expectedInvocations, n)
+	mmListRoleParents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoleParents
+}
+
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) invocationsDone() bool {
+	if len(mmListRoleParents.expectations) == 0 && mmListRoleParents.defaultExpectation == nil && mmListRoleParents.mock.funcListRoleParents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoleParents.mock.afterListRoleParentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoleParents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoleParents implements mm_repository.RoleRepository
+func (mmListRoleParents *RoleRepositoryMock) ListRoleParents(ctx context.Context, role string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListRoleParents.beforeListRoleParentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoleParents.afterListRoleParentsCounter, 1)
+
+	mmListRoleParents.t.Helper()
+
+	if mmListRoleParents.inspectFuncListRoleParents != nil {
+		mmListRoleParents.inspectFuncListRoleParents(ctx, role)
+	}
+
+	mm_params := RoleRepositoryMockListRoleParentsParams{ctx, role}
+
+	// Record call args
+	mmListRoleParents.ListRoleParentsMock.mutex.Lock()
+	mmListRoleParents.ListRoleParentsMock.callArgs = append(mmListRoleParents.ListRoleParentsMock.callArgs, &mm_params)
+	mmListRoleParents.ListRoleParentsMock.mutex.Unlock()
+
+	for _, e := range mmListRoleParents.ListRoleParentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListRoleParents.ListRoleParentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoleParents.ListRoleParentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoleParents.ListRoleParentsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoleParents.ListRoleParentsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListRoleParentsParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoleParents.t.Errorf("RoleRepositoryMock.ListRoleParents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoleParents.ListRoleParentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmListRoleParents.t.Errorf("RoleRepositoryMock.ListRoleParents got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoleParents.ListRoleParentsMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoleParents.t.Errorf("RoleRepositoryMock.ListRoleParents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoleParents.ListRoleParentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoleParents.ListRoleParentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoleParents.t.Fatal("No results are set for the RoleRepositoryMock.ListRoleParents")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListRoleParents.funcListRoleParents != nil {
+		return mmListRoleParents.funcListRoleParents(ctx, role)
+	}
+	mmListRoleParents.t.Fatalf("Unexpected call to RoleRepositoryMock.ListRoleParents. %v %v", ctx, role)
+	return
+}
+
+// ListRoleParentsAfterCounter returns a count of finished RoleRepositoryMock.ListRoleParents invocations
+func (mmListRoleParents *RoleRepositoryMock) ListRoleParentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoleParents.afterListRoleParentsCounter)
+}
+
+// ListRoleParentsBeforeCounter returns a count of RoleRepositoryMock.ListRoleParents invocations
+func (mmListRoleParents *RoleRepositoryMock) ListRoleParentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoleParents.beforeListRoleParentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListRoleParents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoleParents *mRoleRepositoryMockListRoleParents) Calls() []*RoleRepositoryMockListRoleParentsParams {
+	mmListRoleParents.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListRoleParentsParams, len(mmListRoleParents.callArgs))
+	copy(argCopy, mmListRoleParents.callArgs)
+
+	mmListRoleParents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRoleParentsDone returns true if the count of the ListRoleParents invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListRoleParentsDone() bool {
+	if m.ListRoleParentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRoleParentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRoleParentsMock.invocationsDone()
+}
+
+// MinimockListRoleParentsInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListRoleParentsInspect() {
+	for _, e := range m.ListRoleParentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoleParents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRoleParentsCounter := mm_atomic.LoadUint64(&m.afterListRoleParentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRoleParentsMock.defaultExpectation != nil && afterListRoleParentsCounter < 1 {
+		if m.ListRoleParentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoleParents at\n%s", m.ListRoleParentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoleParents at\n%s with params: %#v", m.ListRoleParentsMock.defaultExpectation.expectationOrigins.origin, *m.ListRoleParentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoleParents != nil && afterListRoleParentsCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.ListRoleParents at\n%s", m.funcListRoleParentsOrigin)
+	}
+
+	if !m.ListRoleParentsMock.invocationsDone() && afterListRoleParentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.ListRoleParents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRoleParentsMock.expectedInvocations), m.ListRoleParentsMock.expectedInvocationsOrigin, afterListRoleParentsCounter)
+	}
+}
+
+type mRoleRepositoryMockListRoles struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListRolesExpectation
+	expectations       []*RoleRepositoryMockListRolesExpectation
+
+	callArgs []*RoleRepositoryMockListRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockListRolesExpectation specifies expectation struct of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockListRolesParams
+	paramPtrs          *RoleRepositoryMockListRolesParamPtrs
+	expectationOrigins RoleRepositoryMockListRolesExpectationOrigins
+	results            *RoleRepositoryMockListRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockListRolesParams contains parameters of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesParams struct {
+	ctx context.Context
+}
+
+// RoleRepositoryMockListRolesParamPtrs contains pointers to parameters of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRepositoryMockListRolesResults contains results of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesResults struct {
+	rpa1 []*model.Role
+	err  error
+}
+
+// RoleRepositoryMockListRolesOrigins contains origins of expectations of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoles *mRoleRepositoryMockListRoles) Optional() *mRoleRepositoryMockListRoles {
+	mmListRoles.optional = true
+	return mmListRoles
+}
+
+// Expect sets up expected params for RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) Expect(ctx context.Context) *mRoleRepositoryMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleRepositoryMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListRoles.defaultExpectation.params = &RoleRepositoryMockListRolesParams{ctx}
+	mmListRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoles.expectations {
+		if minimock.Equal(e.params, mmListRoles.defaultExpectation.params) {
+			mmListRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleRepositoryMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.params != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Expect")
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs == nil {
+		mmListRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListRolesParamPtrs{}
+	}
+	mmListRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) Inspect(f func(ctx context.Context)) *mRoleRepositoryMockListRoles {
+	if mmListRoles.mock.inspectFuncListRoles != nil {
+		mmListRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListRoles")
+	}
+
+	mmListRoles.mock.inspectFuncListRoles = f
+
+	return mmListRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) Return(rpa1 []*model.Role, err error) *RoleRepositoryMock {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleRepositoryMockListRolesExpectation{mock: mmListRoles.mock}
+	}
+	mmListRoles.defaultExpectation.results = &RoleRepositoryMockListRolesResults{rpa1, err}
+	mmListRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListRoles method
+func (mmListRoles *mRoleRepositoryMockListRoles) Set(f func(ctx context.Context) (rpa1 []*model.Role, err error)) *RoleRepositoryMock {
+	if mmListRoles.defaultExpectation != nil {
+		mmListRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListRoles method")
+	}
+
+	if len(mmListRoles.expectations) > 0 {
+		mmListRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListRoles method")
+	}
+
+	mmListRoles.mock.funcListRoles = f
+	mmListRoles.mock.funcListRolesOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// When sets expectation for the RoleRepository.ListRoles which will trigger the result defined by the following
+// Then helper
+func (mmListRoles *mRoleRepositoryMockListRoles) When(ctx context.Context) *RoleRepositoryMockListRolesExpectation {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListRolesExpectation{
+		mock:               mmListRoles.mock,
+		params:             &RoleRepositoryMockListRolesParams{ctx},
+		expectationOrigins: RoleRepositoryMockListRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoles.expectations = append(mmListRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListRolesExpectation) Then(rpa1 []*model.Role, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListRolesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.ListRoles should be invoked
+func (mmListRoles *mRoleRepositoryMockListRoles) Times(n uint64) *mRoleRepositoryMockListRoles {
+	if n == 0 {
+		mmListRoles.mock.t.Fatalf("Times of RoleRepositoryMock.ListRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoles.expectedInvocations, n)
+	mmListRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoles
+}
+
+func (mmListRoles *mRoleRepositoryMockListRoles) invocationsDone() bool {
+	if len(mmListRoles.expectations) == 0 && mmListRoles.defaultExpectation == nil && mmListRoles.mock.funcListRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoles.mock.afterListRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoles implements mm_repository.RoleRepository
+func (mmListRoles *RoleRepositoryMock) ListRoles(ctx context.Context) (rpa1 []*model.Role, err error) {
+	mm_atomic.AddUint64(&mmListRoles.beforeListRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoles.afterListRolesCounter, 1)
+
+	mmListRoles.t.Helper()
+
+	if mmListRoles.inspectFuncListRoles != nil {
+		mmListRoles.inspectFuncListRoles(ctx)
+	}
+
+	mm_params := RoleRepositoryMockListRolesParams{ctx}
+
+	// Record call args
+	mmListRoles.ListRolesMock.mutex.Lock()
+	mmListRoles.ListRolesMock.callArgs = append(mmListRoles.ListRolesMock.callArgs, &mm_params)
+	mmListRoles.ListRolesMock.mutex.Unlock()
+
+	for _, e := range mmListRoles.ListRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListRoles.ListRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoles.ListRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoles.ListRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoles.ListRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListRolesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoles.t.Errorf("RoleRepositoryMock.ListRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoles.t.Errorf("RoleRepositoryMock.ListRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoles.ListRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoles.t.Fatal("No results are set for the RoleRepositoryMock.ListRoles")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListRoles.funcListRoles != nil {
+		return mmListRoles.funcListRoles(ctx)
+	}
+	mmListRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.ListRoles. %v", ctx)
+	return
+}
+
+// ListRolesAfterCounter returns a count of finished RoleRepositoryMock.ListRoles invocations
+func (mmListRoles *RoleRepositoryMock) ListRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.afterListRolesCounter)
+}
+
+// ListRolesBeforeCounter returns a count of RoleRepositoryMock.ListRoles invocations
+func (mmListRoles *RoleRepositoryMock) ListRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.beforeListRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoles *mRoleRepositoryMockListRoles) Calls() []*RoleRepositoryMockListRolesParams {
+	mmListRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListRolesParams, len(mmListRoles.callArgs))
+	copy(argCopy, mmListRoles.callArgs)
+
+	mmListRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRolesDone returns true if the count of the ListRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListRolesDone() bool {
+	if m.ListRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRolesMock.invocationsDone()
+}
+
+// MinimockListRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListRolesInspect() {
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRolesCounter := mm_atomic.LoadUint64(&m.afterListRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRolesMock.defaultExpectation != nil && afterListRolesCounter < 1 {
+		if m.ListRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s", m.ListRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s with params: %#v", m.ListRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoles != nil && afterListRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s", m.funcListRolesOrigin)
+	}
+
+	if !m.ListRolesMock.invocationsDone() && afterListRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.ListRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRolesMock.expectedInvocations), m.ListRolesMock.expectedInvocationsOrigin, afterListRolesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddRoleParentInspect()
+
+			m.MinimockCreateRoleInspect()
+
+			m.MinimockDeleteRoleInspect()
+
+			m.MinimockDeleteRoleParentInspect()
+
+			m.MinimockListAncestorRolesInspect()
+
+			m.MinimockListRoleParentsInspect()
+
+			m.MinimockListRolesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddRoleParentDone() &&
+		m.MinimockCreateRoleDone() &&
+		m.MinimockDeleteRoleDone() &&
+		m.MinimockDeleteRoleParentDone() &&
+		m.MinimockListAncestorRolesDone() &&
+		m.MinimockListRoleParentsDone() &&
+		m.MinimockListRolesDone()
+}
@@ -0,0 +1,1840 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.ServiceAccountRepository -o service_account_repository_minimock.go -n ServiceAccountRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ServiceAccountRepositoryMock implements mm_repository.ServiceAccountRepository
+type ServiceAccountRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateServiceAccount          func(ctx context.Context, account *model.ServiceAccount) (i1 int64, err error)
+	funcCreateServiceAccountOrigin    string
+	inspectFuncCreateServiceAccount   func(ctx context.Context, account *model.ServiceAccount)
+	afterCreateServiceAccountCounter  uint64
+	beforeCreateServiceAccountCounter uint64
+	CreateServiceAccountMock          mServiceAccountRepositoryMockCreateServiceAccount
+
+	funcDisableServiceAccount          func(ctx context.Context, clientID string) (err error)
+	funcDisableServiceAccountOrigin    string
+	inspectFuncDisableServiceAccount   func(ctx context.Context, clientID string)
+	afterDisableServiceAccountCounter  uint64
+	beforeDisableServiceAccountCounter uint64
+	DisableServiceAccountMock          mServiceAccountRepositoryMockDisableServiceAccount
+
+	funcGetServiceAccount          func(ctx context.Context, clientID string) (sp1 *model.ServiceAccount, err error)
+	funcGetServiceAccountOrigin    string
+	inspectFuncGetServiceAccount   func(ctx context.Context, clientID string)
+	afterGetServiceAccountCounter  uint64
+	beforeGetServiceAccountCounter uint64
+	GetServiceAccountMock          mServiceAccountRepositoryMockGetServiceAccount
+
+	funcListServiceAccounts          func(ctx context.Context) (spa1 []*model.ServiceAccount, err error)
+	funcListServiceAccountsOrigin    string
+	inspectFuncListServiceAccounts   func(ctx context.Context)
+	afterListServiceAccountsCounter  uint64
+	beforeListServiceAccountsCounter uint64
+	ListServiceAccountsMock          mServiceAccountRepositoryMockListServiceAccounts
+
+	funcUpdateServiceAccountSecret          func(ctx context.Context, clientID string, secretHash string) (err error)
+	funcUpdateServiceAccountSecretOrigin    string
+	inspectFuncUpdateServiceAccountSecret   func(ctx context.Context, clientID string, secretHash string)
+	afterUpdateServiceAccountSecretCounter  uint64
+	beforeUpdateServiceAccountSecretCounter uint64
+	UpdateServiceAccountSecretMock          mServiceAccountRepositoryMockUpdateServiceAccountSecret
+}
+
+// NewServiceAccountRepositoryMock returns a mock for mm_repository.ServiceAccountRepository
+func NewServiceAccountRepositoryMock(t minimock.Tester) *ServiceAccountRepositoryMock {
+	m := &ServiceAccountRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateServiceAccountMock = mServiceAccountRepositoryMockCreateServiceAccount{mock: m}
+	m.CreateServiceAccountMock.callArgs = []*ServiceAccountRepositoryMockCreateServiceAccountParams{}
+
+	m.DisableServiceAccountMock = mServiceAccountRepositoryMockDisableServiceAccount{mock: m}
+	m.DisableServiceAccountMock.callArgs = []*ServiceAccountRepositoryMockDisableServiceAccountParams{}
+
+	m.GetServiceAccountMock = mServiceAccountRepositoryMockGetServiceAccount{mock: m}
+	m.GetServiceAccountMock.callArgs = []*ServiceAccountRepositoryMockGetServiceAccountParams{}
+
+	m.ListServiceAccountsMock = mServiceAccountRepositoryMockListServiceAccounts{mock: m}
+	m.ListServiceAccountsMock.callArgs = []*ServiceAccountRepositoryMockListServiceAccountsParams{}
+
+	m.UpdateServiceAccountSecretMock = mServiceAccountRepositoryMockUpdateServiceAccountSecret{mock: m}
+	m.UpdateServiceAccountSecretMock.callArgs = []*ServiceAccountRepositoryMockUpdateServiceAccountSecretParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceAccountRepositoryMockCreateServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockCreateServiceAccountExpectation
+	expectations       []*ServiceAccountRepositoryMockCreateServiceAccountExpectation
+
+	callArgs []*ServiceAccountRepositoryMockCreateServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountExpectation specifies expectation struct of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockCreateServiceAccountParams
+	paramPtrs          *ServiceAccountRepositoryMockCreateServiceAccountParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockCreateServiceAccountExpectationOrigins
+	results            *ServiceAccountRepositoryMockCreateServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountParams contains parameters of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountParams struct {
+	ctx     context.Context
+	account *model.ServiceAccount
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountParamPtrs struct {
+	ctx     *context.Context
+	account **model.ServiceAccount
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountResults contains results of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountResults struct {
+	i1  int64
+	err error
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountOrigins contains origins of expectations of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAccount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Optional() *mServiceAccountRepositoryMockCreateServiceAccount {
+	mmCreateServiceAccount.optional = true
+	return mmCreateServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Expect(ctx context.Context, account *model.ServiceAccount) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmCreateServiceAccount.defaultExpectation.params = &ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account}
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateServiceAccount.expectations {
+		if minimock.Equal(e.params, mmCreateServiceAccount.defaultExpectation.params) {
+			mmCreateServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectAccountParam2 sets up expected param account for ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) ExpectAccountParam2(account *model.ServiceAccount) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.account = &account
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originAccount = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Inspect(f func(ctx context.Context, account *model.ServiceAccount)) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.CreateServiceAccount")
+	}
+
+	mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount = f
+
+	return mmCreateServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Return(i1 int64, err error) *ServiceAccountRepositoryMock {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{mock: mmCreateServiceAccount.mock}
+	}
+	mmCreateServiceAccount.defaultExpectation.results = &ServiceAccountRepositoryMockCreateServiceAccountResults{i1, err}
+	mmCreateServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.CreateServiceAccount method
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Set(f func(ctx context.Context, account *model.ServiceAccount) (i1 int64, err error)) *ServiceAccountRepositoryMock {
+	if mmCreateServiceAccount.defaultExpectation != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.CreateServiceAccount method")
+	}
+
+	if len(mmCreateServiceAccount.expectations) > 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.CreateServiceAccount method")
+	}
+
+	mmCreateServiceAccount.mock.funcCreateServiceAccount = f
+	mmCreateServiceAccount.mock.funcCreateServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.CreateServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) When(ctx context.Context, account *model.ServiceAccount) *ServiceAccountRepositoryMockCreateServiceAccountExpectation {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockCreateServiceAccountExpectation{
+		mock:               mmCreateServiceAccount.mock,
+		params:             &ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account},
+		expectationOrigins: ServiceAccountRepositoryMockCreateServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateServiceAccount.expectations = append(mmCreateServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.CreateServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockCreateServiceAccountExpectation) Then(i1 int64, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockCreateServiceAccountResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.CreateServiceAccount should be invoked
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Times(n uint64) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if n == 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.CreateServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateServiceAccount.expectedInvocations, n)
+	mmCreateServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount
+}
+
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) invocationsDone() bool {
+	if len(mmCreateServiceAccount.expectations) == 0 && mmCreateServiceAccount.defaultExpectation == nil && mmCreateServiceAccount.mock.funcCreateServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.mock.afterCreateServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateServiceAccount implements mm_repository.ServiceAccountRepository
+func (mmCreateServiceAccount *ServiceAccountRepositoryMock) CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter, 1)
+
+	mmCreateServiceAccount.t.Helper()
+
+	if mmCreateServiceAccount.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.inspectFuncCreateServiceAccount(ctx, account)
+	}
+
+	mm_params := ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account}
+
+	// Record call args
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Lock()
+	mmCreateServiceAccount.CreateServiceAccountMock.callArgs = append(mmCreateServiceAccount.CreateServiceAccountMock.callArgs, &mm_params)
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmCreateServiceAccount.CreateServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountRepositoryMock.CreateServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.account != nil && !minimock.Equal(*mm_want_ptrs.account, mm_got.account) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountRepositoryMock.CreateServiceAccount got unexpected parameter account, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originAccount, *mm_want_ptrs.account, mm_got.account, minimock.Diff(*mm_want_ptrs.account, mm_got.account))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateServiceAccount.t.Errorf("ServiceAccountRepositoryMock.CreateServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateServiceAccount.t.Fatal("No results are set for the ServiceAccountRepositoryMock.CreateServiceAccount")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateServiceAccount.funcCreateServiceAccount != nil {
+		return mmCreateServiceAccount.funcCreateServiceAccount(ctx, account)
+	}
+	mmCreateServiceAccount.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.CreateServiceAccount. %v %v", ctx, account)
+	return
+}
+
+// CreateServiceAccountAfterCounter returns a count of finished ServiceAccountRepositoryMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountRepositoryMock) CreateServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter)
+}
+
+// CreateServiceAccountBeforeCounter returns a count of ServiceAccountRepositoryMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountRepositoryMock) CreateServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.CreateServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Calls() []*ServiceAccountRepositoryMockCreateServiceAccountParams {
+	mmCreateServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockCreateServiceAccountParams, len(mmCreateServiceAccount.callArgs))
+	copy(argCopy, mmCreateServiceAccount.callArgs)
+
+	mmCreateServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateServiceAccountDone returns true if the count of the CreateServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockCreateServiceAccountDone() bool {
+	if m.CreateServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateServiceAccountMock.invocationsDone()
+}
+
+// MinimockCreateServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockCreateServiceAccountInspect() {
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateServiceAccountCounter := mm_atomic.LoadUint64(&m.afterCreateServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateServiceAccountMock.defaultExpectation != nil && afterCreateServiceAccountCounter < 1 {
+		if m.CreateServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s", m.CreateServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s with params: %#v", m.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.CreateServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateServiceAccount != nil && afterCreateServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s", m.funcCreateServiceAccountOrigin)
+	}
+
+	if !m.CreateServiceAccountMock.invocationsDone() && afterCreateServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateServiceAccountMock.expectedInvocations), m.CreateServiceAccountMock.expectedInvocationsOrigin, afterCreateServiceAccountCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockDisableServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockDisableServiceAccountExpectation
+	expectations       []*ServiceAccountRepositoryMockDisableServiceAccountExpectation
+
+	callArgs []*ServiceAccountRepositoryMockDisableServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockDisableServiceAccountExpectation specifies expectation struct of the ServiceAccountRepository.DisableServiceAccount
+type ServiceAccountRepositoryMockDisableServiceAccountExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockDisableServiceAccountParams
+	paramPtrs          *ServiceAccountRepositoryMockDisableServiceAccountParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockDisableServiceAccountExpectationOrigins
+	results            *ServiceAccountRepositoryMockDisableServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockDisableServiceAccountParams contains parameters of the ServiceAccountRepository.DisableServiceAccount
+type ServiceAccountRepositoryMockDisableServiceAccountParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// ServiceAccountRepositoryMockDisableServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountRepository.DisableServiceAccount
+type ServiceAccountRepositoryMockDisableServiceAccountParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// ServiceAccountRepositoryMockDisableServiceAccountResults contains results of the ServiceAccountRepository.DisableServiceAccount
+type ServiceAccountRepositoryMockDisableServiceAccountResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockDisableServiceAccountOrigins contains origins of expectations of the ServiceAccountRepository.DisableServiceAccount
+type ServiceAccountRepositoryMockDisableServiceAccountExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Optional() *mServiceAccountRepositoryMockDisableServiceAccount {
+	mmDisableServiceAccount.optional = true
+	return mmDisableServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountRepository.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Expect(ctx context.Context, clientID string) *mServiceAccountRepositoryMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmDisableServiceAccount.defaultExpectation.params = &ServiceAccountRepositoryMockDisableServiceAccountParams{ctx, clientID}
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDisableServiceAccount.expectations {
+		if minimock.Equal(e.params, mmDisableServiceAccount.defaultExpectation.params) {
+			mmDisableServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDisableServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmDisableServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.params != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Expect")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDisableServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDisableServiceAccountParamPtrs{}
+	}
+	mmDisableServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDisableServiceAccount
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for ServiceAccountRepository.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) ExpectClientIDParam2(clientID string) *mServiceAccountRepositoryMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.params != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Expect")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDisableServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDisableServiceAccountParamPtrs{}
+	}
+	mmDisableServiceAccount.defaultExpectation.paramPtrs.clientID = &clientID
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmDisableServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Inspect(f func(ctx context.Context, clientID string)) *mServiceAccountRepositoryMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.inspectFuncDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.DisableServiceAccount")
+	}
+
+	mmDisableServiceAccount.mock.inspectFuncDisableServiceAccount = f
+
+	return mmDisableServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Return(err error) *ServiceAccountRepositoryMock {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDisableServiceAccountExpectation{mock: mmDisableServiceAccount.mock}
+	}
+	mmDisableServiceAccount.defaultExpectation.results = &ServiceAccountRepositoryMockDisableServiceAccountResults{err}
+	mmDisableServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDisableServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.DisableServiceAccount method
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Set(f func(ctx context.Context, clientID string) (err error)) *ServiceAccountRepositoryMock {
+	if mmDisableServiceAccount.defaultExpectation != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.DisableServiceAccount method")
+	}
+
+	if len(mmDisableServiceAccount.expectations) > 0 {
+		mmDisableServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.DisableServiceAccount method")
+	}
+
+	mmDisableServiceAccount.mock.funcDisableServiceAccount = f
+	mmDisableServiceAccount.mock.funcDisableServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmDisableServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.DisableServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) When(ctx context.Context, clientID string) *ServiceAccountRepositoryMockDisableServiceAccountExpectation {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockDisableServiceAccountExpectation{
+		mock:               mmDisableServiceAccount.mock,
+		params:             &ServiceAccountRepositoryMockDisableServiceAccountParams{ctx, clientID},
+		expectationOrigins: ServiceAccountRepositoryMockDisableServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDisableServiceAccount.expectations = append(mmDisableServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.DisableServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockDisableServiceAccountExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockDisableServiceAccountResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.DisableServiceAccount should be invoked
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Times(n uint64) *mServiceAccountRepositoryMockDisableServiceAccount {
+	if n == 0 {
+		mmDisableServiceAccount.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.DisableServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDisableServiceAccount.expectedInvocations, n)
+	mmDisableServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDisableServiceAccount
+}
+
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) invocationsDone() bool {
+	if len(mmDisableServiceAccount.expectations) == 0 && mmDisableServiceAccount.defaultExpectation == nil && mmDisableServiceAccount.mock.funcDisableServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDisableServiceAccount.mock.afterDisableServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDisableServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DisableServiceAccount implements mm_repository.ServiceAccountRepository
+func (mmDisableServiceAccount *ServiceAccountRepositoryMock) DisableServiceAccount(ctx context.Context, clientID string) (err error) {
+	mm_atomic.AddUint64(&mmDisableServiceAccount.beforeDisableServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmDisableServiceAccount.afterDisableServiceAccountCounter, 1)
+
+	mmDisableServiceAccount.t.Helper()
+
+	if mmDisableServiceAccount.inspectFuncDisableServiceAccount != nil {
+		mmDisableServiceAccount.inspectFuncDisableServiceAccount(ctx, clientID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockDisableServiceAccountParams{ctx, clientID}
+
+	// Record call args
+	mmDisableServiceAccount.DisableServiceAccountMock.mutex.Lock()
+	mmDisableServiceAccount.DisableServiceAccountMock.callArgs = append(mmDisableServiceAccount.DisableServiceAccountMock.callArgs, &mm_params)
+	mmDisableServiceAccount.DisableServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmDisableServiceAccount.DisableServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockDisableServiceAccountParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDisableServiceAccount.t.Errorf("ServiceAccountRepositoryMock.DisableServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmDisableServiceAccount.t.Errorf("ServiceAccountRepositoryMock.DisableServiceAccount got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDisableServiceAccount.t.Errorf("ServiceAccountRepositoryMock.DisableServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDisableServiceAccount.t.Fatal("No results are set for the ServiceAccountRepositoryMock.DisableServiceAccount")
+		}
+		return (*mm_results).err
+	}
+	if mmDisableServiceAccount.funcDisableServiceAccount != nil {
+		return mmDisableServiceAccount.funcDisableServiceAccount(ctx, clientID)
+	}
+	mmDisableServiceAccount.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.DisableServiceAccount. %v %v", ctx, clientID)
+	return
+}
+
+// DisableServiceAccountAfterCounter returns a count of finished ServiceAccountRepositoryMock.DisableServiceAccount invocations
+func (mmDisableServiceAccount *ServiceAccountRepositoryMock) DisableServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableServiceAccount.afterDisableServiceAccountCounter)
+}
+
+// DisableServiceAccountBeforeCounter returns a count of ServiceAccountRepositoryMock.DisableServiceAccount invocations
+func (mmDisableServiceAccount *ServiceAccountRepositoryMock) DisableServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableServiceAccount.beforeDisableServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.DisableServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDisableServiceAccount *mServiceAccountRepositoryMockDisableServiceAccount) Calls() []*ServiceAccountRepositoryMockDisableServiceAccountParams {
+	mmDisableServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockDisableServiceAccountParams, len(mmDisableServiceAccount.callArgs))
+	copy(argCopy, mmDisableServiceAccount.callArgs)
+
+	mmDisableServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDisableServiceAccountDone returns true if the count of the DisableServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockDisableServiceAccountDone() bool {
+	if m.DisableServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DisableServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DisableServiceAccountMock.invocationsDone()
+}
+
+// MinimockDisableServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockDisableServiceAccountInspect() {
+	for _, e := range m.DisableServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DisableServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDisableServiceAccountCounter := mm_atomic.LoadUint64(&m.afterDisableServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DisableServiceAccountMock.defaultExpectation != nil && afterDisableServiceAccountCounter < 1 {
+		if m.DisableServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DisableServiceAccount at\n%s", m.DisableServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DisableServiceAccount at\n%s with params: %#v", m.DisableServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.DisableServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDisableServiceAccount != nil && afterDisableServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DisableServiceAccount at\n%s", m.funcDisableServiceAccountOrigin)
+	}
+
+	if !m.DisableServiceAccountMock.invocationsDone() && afterDisableServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.DisableServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DisableServiceAccountMock.expectedInvocations), m.DisableServiceAccountMock.expectedInvocationsOrigin, afterDisableServiceAccountCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockGetServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockGetServiceAccountExpectation
+	expectations       []*ServiceAccountRepositoryMockGetServiceAccountExpectation
+
+	callArgs []*ServiceAccountRepositoryMockGetServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockGetServiceAccountExpectation specifies expectation struct of the ServiceAccountRepository.GetServiceAccount
+type ServiceAccountRepositoryMockGetServiceAccountExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockGetServiceAccountParams
+	paramPtrs          *ServiceAccountRepositoryMockGetServiceAccountParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockGetServiceAccountExpectationOrigins
+	results            *ServiceAccountRepositoryMockGetServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockGetServiceAccountParams contains parameters of the ServiceAccountRepository.GetServiceAccount
+type ServiceAccountRepositoryMockGetServiceAccountParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// ServiceAccountRepositoryMockGetServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountRepository.GetServiceAccount
+type ServiceAccountRepositoryMockGetServiceAccountParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// ServiceAccountRepositoryMockGetServiceAccountResults contains results of the ServiceAccountRepository.GetServiceAccount
+type ServiceAccountRepositoryMockGetServiceAccountResults struct {
+	sp1 *model.ServiceAccount
+	err error
+}
+
+// ServiceAccountRepositoryMockGetServiceAccountOrigins contains origins of expectations of the ServiceAccountRepository.GetServiceAccount
+type ServiceAccountRepositoryMockGetServiceAccountExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Optional() *mServiceAccountRepositoryMockGetServiceAccount {
+	mmGetServiceAccount.optional = true
+	return mmGetServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountRepository.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Expect(ctx context.Context, clientID string) *mServiceAccountRepositoryMockGetServiceAccount {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockGetServiceAccountExpectation{}
+	}
+
+	if mmGetServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmGetServiceAccount.defaultExpectation.params = &ServiceAccountRepositoryMockGetServiceAccountParams{ctx, clientID}
+	mmGetServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetServiceAccount.expectations {
+		if minimock.Equal(e.params, mmGetServiceAccount.defaultExpectation.params) {
+			mmGetServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmGetServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockGetServiceAccount {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockGetServiceAccountExpectation{}
+	}
+
+	if mmGetServiceAccount.defaultExpectation.params != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Expect")
+	}
+
+	if mmGetServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmGetServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockGetServiceAccountParamPtrs{}
+	}
+	mmGetServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetServiceAccount
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for ServiceAccountRepository.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) ExpectClientIDParam2(clientID string) *mServiceAccountRepositoryMockGetServiceAccount {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockGetServiceAccountExpectation{}
+	}
+
+	if mmGetServiceAccount.defaultExpectation.params != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Expect")
+	}
+
+	if mmGetServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmGetServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockGetServiceAccountParamPtrs{}
+	}
+	mmGetServiceAccount.defaultExpectation.paramPtrs.clientID = &clientID
+	mmGetServiceAccount.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmGetServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Inspect(f func(ctx context.Context, clientID string)) *mServiceAccountRepositoryMockGetServiceAccount {
+	if mmGetServiceAccount.mock.inspectFuncGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.GetServiceAccount")
+	}
+
+	mmGetServiceAccount.mock.inspectFuncGetServiceAccount = f
+
+	return mmGetServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Return(sp1 *model.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockGetServiceAccountExpectation{mock: mmGetServiceAccount.mock}
+	}
+	mmGetServiceAccount.defaultExpectation.results = &ServiceAccountRepositoryMockGetServiceAccountResults{sp1, err}
+	mmGetServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.GetServiceAccount method
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Set(f func(ctx context.Context, clientID string) (sp1 *model.ServiceAccount, err error)) *ServiceAccountRepositoryMock {
+	if mmGetServiceAccount.defaultExpectation != nil {
+		mmGetServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.GetServiceAccount method")
+	}
+
+	if len(mmGetServiceAccount.expectations) > 0 {
+		mmGetServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.GetServiceAccount method")
+	}
+
+	mmGetServiceAccount.mock.funcGetServiceAccount = f
+	mmGetServiceAccount.mock.funcGetServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmGetServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.GetServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) When(ctx context.Context, clientID string) *ServiceAccountRepositoryMockGetServiceAccountExpectation {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.GetServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockGetServiceAccountExpectation{
+		mock:               mmGetServiceAccount.mock,
+		params:             &ServiceAccountRepositoryMockGetServiceAccountParams{ctx, clientID},
+		expectationOrigins: ServiceAccountRepositoryMockGetServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetServiceAccount.expectations = append(mmGetServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.GetServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockGetServiceAccountExpectation) Then(sp1 *model.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockGetServiceAccountResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.GetServiceAccount should be invoked
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Times(n uint64) *mServiceAccountRepositoryMockGetServiceAccount {
+	if n == 0 {
+		mmGetServiceAccount.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.GetServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetServiceAccount.expectedInvocations, n)
+	mmGetServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetServiceAccount
+}
+
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) invocationsDone() bool {
+	if len(mmGetServiceAccount.expectations) == 0 && mmGetServiceAccount.defaultExpectation == nil && mmGetServiceAccount.mock.funcGetServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetServiceAccount.mock.afterGetServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetServiceAccount implements mm_repository.ServiceAccountRepository
+func (mmGetServiceAccount *ServiceAccountRepositoryMock) GetServiceAccount(ctx context.Context, clientID string) (sp1 *model.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmGetServiceAccount.beforeGetServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetServiceAccount.afterGetServiceAccountCounter, 1)
+
+	mmGetServiceAccount.t.Helper()
+
+	if mmGetServiceAccount.inspectFuncGetServiceAccount != nil {
+		mmGetServiceAccount.inspectFuncGetServiceAccount(ctx, clientID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockGetServiceAccountParams{ctx, clientID}
+
+	// Record call args
+	mmGetServiceAccount.GetServiceAccountMock.mutex.Lock()
+	mmGetServiceAccount.GetServiceAccountMock.callArgs = append(mmGetServiceAccount.GetServiceAccountMock.callArgs, &mm_params)
+	mmGetServiceAccount.GetServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmGetServiceAccount.GetServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetServiceAccount.GetServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockGetServiceAccountParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetServiceAccount.t.Errorf("ServiceAccountRepositoryMock.GetServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmGetServiceAccount.t.Errorf("ServiceAccountRepositoryMock.GetServiceAccount got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetServiceAccount.t.Errorf("ServiceAccountRepositoryMock.GetServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetServiceAccount.t.Fatal("No results are set for the ServiceAccountRepositoryMock.GetServiceAccount")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetServiceAccount.funcGetServiceAccount != nil {
+		return mmGetServiceAccount.funcGetServiceAccount(ctx, clientID)
+	}
+	mmGetServiceAccount.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.GetServiceAccount. %v %v", ctx, clientID)
+	return
+}
+
+// GetServiceAccountAfterCounter returns a count of finished ServiceAccountRepositoryMock.GetServiceAccount invocations
+func (mmGetServiceAccount *ServiceAccountRepositoryMock) GetServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetServiceAccount.afterGetServiceAccountCounter)
+}
+
+// GetServiceAccountBeforeCounter returns a count of ServiceAccountRepositoryMock.GetServiceAccount invocations
+func (mmGetServiceAccount *ServiceAccountRepositoryMock) GetServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetServiceAccount.beforeGetServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.GetServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetServiceAccount *mServiceAccountRepositoryMockGetServiceAccount) Calls() []*ServiceAccountRepositoryMockGetServiceAccountParams {
+	mmGetServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockGetServiceAccountParams, len(mmGetServiceAccount.callArgs))
+	copy(argCopy, mmGetServiceAccount.callArgs)
+
+	mmGetServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetServiceAccountDone returns true if the count of the GetServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockGetServiceAccountDone() bool {
+	if m.GetServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetServiceAccountMock.invocationsDone()
+}
+
+// MinimockGetServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockGetServiceAccountInspect() {
+	for _, e := range m.GetServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.GetServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetServiceAccountCounter := mm_atomic.LoadUint64(&m.afterGetServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetServiceAccountMock.defaultExpectation != nil && afterGetServiceAccountCounter < 1 {
+		if m.GetServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.GetServiceAccount at\n%s", m.GetServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.GetServiceAccount at\n%s with params: %#v", m.GetServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.GetServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetServiceAccount != nil && afterGetServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.GetServiceAccount at\n%s", m.funcGetServiceAccountOrigin)
+	}
+
+	if !m.GetServiceAccountMock.invocationsDone() && afterGetServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.GetServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetServiceAccountMock.expectedInvocations), m.GetServiceAccountMock.expectedInvocationsOrigin, afterGetServiceAccountCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockListServiceAccounts struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockListServiceAccountsExpectation
+	expectations       []*ServiceAccountRepositoryMockListServiceAccountsExpectation
+
+	callArgs []*ServiceAccountRepositoryMockListServiceAccountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsExpectation specifies expectation struct of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockListServiceAccountsParams
+	paramPtrs          *ServiceAccountRepositoryMockListServiceAccountsParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockListServiceAccountsExpectationOrigins
+	results            *ServiceAccountRepositoryMockListServiceAccountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsParams contains parameters of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsParams struct {
+	ctx context.Context
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsParamPtrs contains pointers to parameters of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsResults contains results of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsResults struct {
+	spa1 []*model.ServiceAccount
+	err  error
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsOrigins contains origins of expectations of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Optional() *mServiceAccountRepositoryMockListServiceAccounts {
+	mmListServiceAccounts.optional = true
+	return mmListServiceAccounts
+}
+
+// Expect sets up expected params for ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Expect(ctx context.Context) *mServiceAccountRepositoryMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountRepositoryMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by ExpectParams functions")
+	}
+
+	mmListServiceAccounts.defaultExpectation.params = &ServiceAccountRepositoryMockListServiceAccountsParams{ctx}
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListServiceAccounts.expectations {
+		if minimock.Equal(e.params, mmListServiceAccounts.defaultExpectation.params) {
+			mmListServiceAccounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListServiceAccounts.defaultExpectation.params)
+		}
+	}
+
+	return mmListServiceAccounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountRepositoryMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.params != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Expect")
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs == nil {
+		mmListServiceAccounts.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockListServiceAccountsParamPtrs{}
+	}
+	mmListServiceAccounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListServiceAccounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Inspect(f func(ctx context.Context)) *mServiceAccountRepositoryMockListServiceAccounts {
+	if mmListServiceAccounts.mock.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.ListServiceAccounts")
+	}
+
+	mmListServiceAccounts.mock.inspectFuncListServiceAccounts = f
+
+	return mmListServiceAccounts
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Return(spa1 []*model.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountRepositoryMockListServiceAccountsExpectation{mock: mmListServiceAccounts.mock}
+	}
+	mmListServiceAccounts.defaultExpectation.results = &ServiceAccountRepositoryMockListServiceAccountsResults{spa1, err}
+	mmListServiceAccounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.ListServiceAccounts method
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Set(f func(ctx context.Context) (spa1 []*model.ServiceAccount, err error)) *ServiceAccountRepositoryMock {
+	if mmListServiceAccounts.defaultExpectation != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.ListServiceAccounts method")
+	}
+
+	if len(mmListServiceAccounts.expectations) > 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.ListServiceAccounts method")
+	}
+
+	mmListServiceAccounts.mock.funcListServiceAccounts = f
+	mmListServiceAccounts.mock.funcListServiceAccountsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.ListServiceAccounts which will trigger the result defined by the following
+// Then helper
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) When(ctx context.Context) *ServiceAccountRepositoryMockListServiceAccountsExpectation {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockListServiceAccountsExpectation{
+		mock:               mmListServiceAccounts.mock,
+		params:             &ServiceAccountRepositoryMockListServiceAccountsParams{ctx},
+		expectationOrigins: ServiceAccountRepositoryMockListServiceAccountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListServiceAccounts.expectations = append(mmListServiceAccounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.ListServiceAccounts return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockListServiceAccountsExpectation) Then(spa1 []*model.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockListServiceAccountsResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.ListServiceAccounts should be invoked
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Times(n uint64) *mServiceAccountRepositoryMockListServiceAccounts {
+	if n == 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.ListServiceAccounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListServiceAccounts.expectedInvocations, n)
+	mmListServiceAccounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts
+}
+
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) invocationsDone() bool {
+	if len(mmListServiceAccounts.expectations) == 0 && mmListServiceAccounts.defaultExpectation == nil && mmListServiceAccounts.mock.funcListServiceAccounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.mock.afterListServiceAccountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListServiceAccounts implements mm_repository.ServiceAccountRepository
+func (mmListServiceAccounts *ServiceAccountRepositoryMock) ListServiceAccounts(ctx context.Context) (spa1 []*model.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListServiceAccounts.afterListServiceAccountsCounter, 1)
+
+	mmListServiceAccounts.t.Helper()
+
+	if mmListServiceAccounts.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.inspectFuncListServiceAccounts(ctx)
+	}
+
+	mm_params := ServiceAccountRepositoryMockListServiceAccountsParams{ctx}
+
+	// Record call args
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Lock()
+	mmListServiceAccounts.ListServiceAccountsMock.callArgs = append(mmListServiceAccounts.ListServiceAccountsMock.callArgs, &mm_params)
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Unlock()
+
+	for _, e := range mmListServiceAccounts.ListServiceAccountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.params
+		mm_want_ptrs := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockListServiceAccountsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListServiceAccounts.t.Errorf("ServiceAccountRepositoryMock.ListServiceAccounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListServiceAccounts.t.Errorf("ServiceAccountRepositoryMock.ListServiceAccounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListServiceAccounts.t.Fatal("No results are set for the ServiceAccountRepositoryMock.ListServiceAccounts")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListServiceAccounts.funcListServiceAccounts != nil {
+		return mmListServiceAccounts.funcListServiceAccounts(ctx)
+	}
+	mmListServiceAccounts.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.ListServiceAccounts. %v", ctx)
+	return
+}
+
+// ListServiceAccountsAfterCounter returns a count of finished ServiceAccountRepositoryMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountRepositoryMock) ListServiceAccountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.afterListServiceAccountsCounter)
+}
+
+// ListServiceAccountsBeforeCounter returns a count of ServiceAccountRepositoryMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountRepositoryMock) ListServiceAccountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.ListServiceAccounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Calls() []*ServiceAccountRepositoryMockListServiceAccountsParams {
+	mmListServiceAccounts.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockListServiceAccountsParams, len(mmListServiceAccounts.callArgs))
+	copy(argCopy, mmListServiceAccounts.callArgs)
+
+	mmListServiceAccounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListServiceAccountsDone returns true if the count of the ListServiceAccounts invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockListServiceAccountsDone() bool {
+	if m.ListServiceAccountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListServiceAccountsMock.invocationsDone()
+}
+
+// MinimockListServiceAccountsInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockListServiceAccountsInspect() {
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListServiceAccountsCounter := mm_atomic.LoadUint64(&m.afterListServiceAccountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListServiceAccountsMock.defaultExpectation != nil && afterListServiceAccountsCounter < 1 {
+		if m.ListServiceAccountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s", m.ListServiceAccountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s with params: %#v", m.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *m.ListServiceAccountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListServiceAccounts != nil && afterListServiceAccountsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s", m.funcListServiceAccountsOrigin)
+	}
+
+	if !m.ListServiceAccountsMock.invocationsDone() && afterListServiceAccountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListServiceAccountsMock.expectedInvocations), m.ListServiceAccountsMock.expectedInvocationsOrigin, afterListServiceAccountsCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockUpdateServiceAccountSecret struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation
+	expectations       []*ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation
+
+	callArgs []*ServiceAccountRepositoryMockUpdateServiceAccountSecretParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation specifies expectation struct of the ServiceAccountRepository.UpdateServiceAccountSecret
+type ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockUpdateServiceAccountSecretParams
+	paramPtrs          *ServiceAccountRepositoryMockUpdateServiceAccountSecretParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectationOrigins
+	results            *ServiceAccountRepositoryMockUpdateServiceAccountSecretResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockUpdateServiceAccountSecretParams contains parameters of the ServiceAccountRepository.UpdateServiceAccountSecret
+type ServiceAccountRepositoryMockUpdateServiceAccountSecretParams struct {
+	ctx        context.Context
+	clientID   string
+	secretHash string
+}
+
+// ServiceAccountRepositoryMockUpdateServiceAccountSecretParamPtrs contains pointers to parameters of the ServiceAccountRepository.UpdateServiceAccountSecret
+type ServiceAccountRepositoryMockUpdateServiceAccountSecretParamPtrs struct {
+	ctx        *context.Context
+	clientID   *string
+	secretHash *string
+}
+
+// ServiceAccountRepositoryMockUpdateServiceAccountSecretResults contains results of the ServiceAccountRepository.UpdateServiceAccountSecret
+type ServiceAccountRepositoryMockUpdateServiceAccountSecretResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockUpdateServiceAccountSecretOrigins contains origins of expectations of the ServiceAccountRepository.UpdateServiceAccountSecret
+type ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originClientID   string
+	originSecretHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Optional() *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	mmUpdateServiceAccountSecret.optional = true
+	return mmUpdateServiceAccountSecret
+}
+
+// Expect sets up expected params for ServiceAccountRepository.UpdateServiceAccountSecret
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Expect(ctx context.Context, clientID string, secretHash string) *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	if mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation = &ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation{}
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateServiceAccountSecret.defaultExpectation.params = &ServiceAccountRepositoryMockUpdateServiceAccountSecretParams{ctx, clientID, secretHash}
+	mmUpdateServiceAccountSecret.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateServiceAccountSecret.expectations {
+		if minimock.Equal(e.params, mmUpdateServiceAccountSecret.defaultExpectation.params) {
+			mmUpdateServiceAccountSecret.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateServiceAccountSecret.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateServiceAccountSecret
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.UpdateServiceAccountSecret
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	if mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation = &ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation{}
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.params != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Expect")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockUpdateServiceAccountSecretParamPtrs{}
+	}
+	mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateServiceAccountSecret.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateServiceAccountSecret
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for ServiceAccountRepository.UpdateServiceAccountSecret
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) ExpectClientIDParam2(clientID string) *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	if mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation = &ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation{}
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.params != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Expect")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockUpdateServiceAccountSecretParamPtrs{}
+	}
+	mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs.clientID = &clientID
+	mmUpdateServiceAccountSecret.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmUpdateServiceAccountSecret
+}
+
+// ExpectSecretHashParam3 sets up expected param secretHash for ServiceAccountRepository.UpdateServiceAccountSecret
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) ExpectSecretHashParam3(secretHash string) *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	if mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation = &ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation{}
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.params != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Expect")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockUpdateServiceAccountSecretParamPtrs{}
+	}
+	mmUpdateServiceAccountSecret.defaultExpectation.paramPtrs.secretHash = &secretHash
+	mmUpdateServiceAccountSecret.defaultExpectation.expectationOrigins.originSecretHash = minimock.CallerInfo(1)
+
+	return mmUpdateServiceAccountSecret
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.UpdateServiceAccountSecret
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Inspect(f func(ctx context.Context, clientID string, secretHash string)) *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	if mmUpdateServiceAccountSecret.mock.inspectFuncUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.UpdateServiceAccountSecret")
+	}
+
+	mmUpdateServiceAccountSecret.mock.inspectFuncUpdateServiceAccountSecret = f
+
+	return mmUpdateServiceAccountSecret
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.UpdateServiceAccountSecret
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Return(err error) *ServiceAccountRepositoryMock {
+	if mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmUpdateServiceAccountSecret.defaultExpectation == nil {
+		mmUpdateServiceAccountSecret.defaultExpectation = &ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation{mock: mmUpdateServiceAccountSecret.mock}
+	}
+	mmUpdateServiceAccountSecret.defaultExpectation.results = &ServiceAccountRepositoryMockUpdateServiceAccountSecretResults{err}
+	mmUpdateServiceAccountSecret.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateServiceAccountSecret.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.UpdateServiceAccountSecret method
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Set(f func(ctx context.Context, clientID string, secretHash string) (err error)) *ServiceAccountRepositoryMock {
+	if mmUpdateServiceAccountSecret.defaultExpectation != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.UpdateServiceAccountSecret method")
+	}
+
+	if len(mmUpdateServiceAccountSecret.expectations) > 0 {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.UpdateServiceAccountSecret method")
+	}
+
+	mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret = f
+	mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecretOrigin = minimock.CallerInfo(1)
+	return mmUpdateServiceAccountSecret.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.UpdateServiceAccountSecret which will trigger the result defined by the following
+// Then helper
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) When(ctx context.Context, clientID string, secretHash string) *ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation {
+	if mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation{
+		mock:               mmUpdateServiceAccountSecret.mock,
+		params:             &ServiceAccountRepositoryMockUpdateServiceAccountSecretParams{ctx, clientID, secretHash},
+		expectationOrigins: ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateServiceAccountSecret.expectations = append(mmUpdateServiceAccountSecret.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.UpdateServiceAccountSecret return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockUpdateServiceAccountSecretExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockUpdateServiceAccountSecretResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.UpdateServiceAccountSecret should be invoked
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Times(n uint64) *mServiceAccountRepositoryMockUpdateServiceAccountSecret {
+	if n == 0 {
+		mmUpdateServiceAccountSecret.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.UpdateServiceAccountSecret mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateServiceAccountSecret.expectedInvocations, n)
+	mmUpdateServiceAccountSecret.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateServiceAccountSecret
+}
+
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) invocationsDone() bool {
+	if len(mmUpdateServiceAccountSecret.expectations) == 0 && mmUpdateServiceAccountSecret.defaultExpectation == nil && mmUpdateServiceAccountSecret.mock.funcUpdateServiceAccountSecret == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateServiceAccountSecret.mock.afterUpdateServiceAccountSecretCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateServiceAccountSecret.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateServiceAccountSecret implements mm_repository.ServiceAccountRepository
+func (mmUpdateServiceAccountSecret *ServiceAccountRepositoryMock) UpdateServiceAccountSecret(ctx context.Context, clientID string, secretHash string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateServiceAccountSecret.beforeUpdateServiceAccountSecretCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateServiceAccountSecret.afterUpdateServiceAccountSecretCounter, 1)
+
+	mmUpdateServiceAccountSecret.t.Helper()
+
+	if mmUpdateServiceAccountSecret.inspectFuncUpdateServiceAccountSecret != nil {
+		mmUpdateServiceAccountSecret.inspectFuncUpdateServiceAccountSecret(ctx, clientID, secretHash)
+	}
+
+	mm_params := ServiceAccountRepositoryMockUpdateServiceAccountSecretParams{ctx, clientID, secretHash}
+
+	// Record call args
+	mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.mutex.Lock()
+	mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.callArgs = append(mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.callArgs, &mm_params)
+	mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.mutex.Unlock()
+
+	for _, e := range mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockUpdateServiceAccountSecretParams{ctx, clientID, secretHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateServiceAccountSecret.t.Errorf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmUpdateServiceAccountSecret.t.Errorf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.secretHash != nil && !minimock.Equal(*mm_want_ptrs.secretHash, mm_got.secretHash) {
+				mmUpdateServiceAccountSecret.t.Errorf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret got unexpected parameter secretHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.expectationOrigins.originSecretHash, *mm_want_ptrs.secretHash, mm_got.secretHash, minimock.Diff(*mm_want_ptrs.secretHash, mm_got.secretHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateServiceAccountSecret.t.Errorf("ServiceAccountRepositoryMock.UpdateServiceAccountSecret got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateServiceAccountSecret.UpdateServiceAccountSecretMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateServiceAccountSecret.t.Fatal("No results are set for the ServiceAccountRepositoryMock.UpdateServiceAccountSecret")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateServiceAccountSecret.funcUpdateServiceAccountSecret != nil {
+		return mmUpdateServiceAccountSecret.funcUpdateServiceAccountSecret(ctx, clientID, secretHash)
+	}
+	mmUpdateServiceAccountSecret.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.UpdateServiceAccountSecret. %v %v %v", ctx, clientID, secretHash)
+	return
+}
+
+// UpdateServiceAccountSecretAfterCounter returns a count of finished ServiceAccountRepositoryMock.UpdateServiceAccountSecret invocations
+func (mmUpdateServiceAccountSecret *ServiceAccountRepositoryMock) UpdateServiceAccountSecretAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateServiceAccountSecret.afterUpdateServiceAccountSecretCounter)
+}
+
+// UpdateServiceAccountSecretBeforeCounter returns a count of ServiceAccountRepositoryMock.UpdateServiceAccountSecret invocations
+func (mmUpdateServiceAccountSecret *ServiceAccountRepositoryMock) UpdateServiceAccountSecretBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateServiceAccountSecret.beforeUpdateServiceAccountSecretCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.UpdateServiceAccountSecret.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateServiceAccountSecret *mServiceAccountRepositoryMockUpdateServiceAccountSecret) Calls() []*ServiceAccountRepositoryMockUpdateServiceAccountSecretParams {
+	mmUpdateServiceAccountSecret.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockUpdateServiceAccountSecretParams, len(mmUpdateServiceAccountSecret.callArgs))
+	copy(argCopy, mmUpdateServiceAccountSecret.callArgs)
+
+	mmUpdateServiceAccountSecret.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateServiceAccountSecretDone returns true if the count of the UpdateServiceAccountSecret invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockUpdateServiceAccountSecretDone() bool {
+	if m.UpdateServiceAccountSecretMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateServiceAccountSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateServiceAccountSecretMock.invocationsDone()
+}
+
+// MinimockUpdateServiceAccountSecretInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockUpdateServiceAccountSecretInspect() {
+	for _, e := range m.UpdateServiceAccountSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.UpdateServiceAccountSecret at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateServiceAccountSecretCounter := mm_atomic.LoadUint64(&m.afterUpdateServiceAccountSecretCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateServiceAccountSecretMock.defaultExpectation != nil && afterUpdateServiceAccountSecretCounter < 1 {
+		if m.UpdateServiceAccountSecretMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.UpdateServiceAccountSecret at\n%s", m.UpdateServiceAccountSecretMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.UpdateServiceAccountSecret at\n%s with params: %#v", m.UpdateServiceAccountSecretMock.defaultExpectation.expectationOrigins.origin, *m.UpdateServiceAccountSecretMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateServiceAccountSecret != nil && afterUpdateServiceAccountSecretCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.UpdateServiceAccountSecret at\n%s", m.funcUpdateServiceAccountSecretOrigin)
+	}
+
+	if !m.UpdateServiceAccountSecretMock.invocationsDone() && afterUpdateServiceAccountSecretCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.UpdateServiceAccountSecret at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateServiceAccountSecretMock.expectedInvocations), m.UpdateServiceAccountSecretMock.expectedInvocationsOrigin, afterUpdateServiceAccountSecretCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceAccountRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateServiceAccountInspect()
+
+			m.MinimockDisableServiceAccountInspect()
+
+			m.MinimockGetServiceAccountInspect()
+
+			m.MinimockListServiceAccountsInspect()
+
+			m.MinimockUpdateServiceAccountSecretInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceAccountRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceAccountRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateServiceAccountDone() &&
+		m.MinimockDisableServiceAccountDone() &&
+		m.MinimockGetServiceAccountDone() &&
+		m.MinimockListServiceAccountsDone() &&
+		m.MinimockUpdateServiceAccountSecretDone()
+}
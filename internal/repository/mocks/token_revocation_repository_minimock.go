@@ -0,0 +1,804 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/repository.TokenRevocationRepository -o token_revocation_repository_minimock.go -n TokenRevocationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TokenRevocationRepositoryMock implements mm_repository.TokenRevocationRepository
+type TokenRevocationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetTokensRevokedAt          func(ctx context.Context, subject string) (t1 time.Time, err error)
+	funcGetTokensRevokedAtOrigin    string
+	inspectFuncGetTokensRevokedAt   func(ctx context.Context, subject string)
+	afterGetTokensRevokedAtCounter  uint64
+	beforeGetTokensRevokedAtCounter uint64
+	GetTokensRevokedAtMock          mTokenRevocationRepositoryMockGetTokensRevokedAt
+
+	funcRevokeUserTokens          func(ctx context.Context, subject string, revokedAt time.Time) (err error)
+	funcRevokeUserTokensOrigin    string
+	inspectFuncRevokeUserTokens   func(ctx context.Context, subject string, revokedAt time.Time)
+	afterRevokeUserTokensCounter  uint64
+	beforeRevokeUserTokensCounter uint64
+	RevokeUserTokensMock          mTokenRevocationRepositoryMockRevokeUserTokens
+}
+
+// NewTokenRevocationRepositoryMock returns a mock for mm_repository.TokenRevocationRepository
+func NewTokenRevocationRepositoryMock(t minimock.Tester) *TokenRevocationRepositoryMock {
+	m := &TokenRevocationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetTokensRevokedAtMock = mTokenRevocationRepositoryMockGetTokensRevokedAt{mock: m}
+	m.GetTokensRevokedAtMock.callArgs = []*TokenRevocationRepositoryMockGetTokensRevokedAtParams{}
+
+	m.RevokeUserTokensMock = mTokenRevocationRepositoryMockRevokeUserTokens{mock: m}
+	m.RevokeUserTokensMock.callArgs = []*TokenRevocationRepositoryMockRevokeUserTokensParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTokenRevocationRepositoryMockGetTokensRevokedAt struct {
+	optional           bool
+	mock               *TokenRevocationRepositoryMock
+	defaultExpectation *TokenRevocationRepositoryMockGetTokensRevokedAtExpectation
+	expectations       []*TokenRevocationRepositoryMockGetTokensRevokedAtExpectation
+
+	callArgs []*TokenRevocationRepositoryMockGetTokensRevokedAtParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenRevocationRepositoryMockGetTokensRevokedAtExpectation specifies expectation struct of the TokenRevocationRepository.GetTokensRevokedAt
+type TokenRevocationRepositoryMockGetTokensRevokedAtExpectation struct {
+	mock               *TokenRevocationRepositoryMock
+	params             *TokenRevocationRepositoryMockGetTokensRevokedAtParams
+	paramPtrs          *TokenRevocationRepositoryMockGetTokensRevokedAtParamPtrs
+	expectationOrigins TokenRevocationRepositoryMockGetTokensRevokedAtExpectationOrigins
+	results            *TokenRevocationRepositoryMockGetTokensRevokedAtResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenRevocationRepositoryMockGetTokensRevokedAtParams contains parameters of the TokenRevocationRepository.GetTokensRevokedAt
+type TokenRevocationRepositoryMockGetTokensRevokedAtParams struct {
+	ctx     context.Context
+	subject string
+}
+
+// TokenRevocationRepositoryMockGetTokensRevokedAtParamPtrs contains pointers to parameters of the TokenRevocationRepository.GetTokensRevokedAt
+type TokenRevocationRepositoryMockGetTokensRevokedAtParamPtrs struct {
+	ctx     *context.Context
+	subject *string
+}
+
+// TokenRevocationRepositoryMockGetTokensRevokedAtResults contains results of the TokenRevocationRepository.GetTokensRevokedAt
+type TokenRevocationRepositoryMockGetTokensRevokedAtResults struct {
+	t1  time.Time
+	err error
+}
+
+// TokenRevocationRepositoryMockGetTokensRevokedAtOrigins contains origins of expectations of the TokenRevocationRepository.GetTokensRevokedAt
+type TokenRevocationRepositoryMockGetTokensRevokedAtExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSubject string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Optional() *mTokenRevocationRepositoryMockGetTokensRevokedAt {
+	mmGetTokensRevokedAt.optional = true
+	return mmGetTokensRevokedAt
+}
+
+// Expect sets up expected params for TokenRevocationRepository.GetTokensRevokedAt
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Expect(ctx context.Context, subject string) *mTokenRevocationRepositoryMockGetTokensRevokedAt {
+	if mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Set")
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation == nil {
+		mmGetTokensRevokedAt.defaultExpectation = &TokenRevocationRepositoryMockGetTokensRevokedAtExpectation{}
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation.paramPtrs != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by ExpectParams functions")
+	}
+
+	mmGetTokensRevokedAt.defaultExpectation.params = &TokenRevocationRepositoryMockGetTokensRevokedAtParams{ctx, subject}
+	mmGetTokensRevokedAt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTokensRevokedAt.expectations {
+		if minimock.Equal(e.params, mmGetTokensRevokedAt.defaultExpectation.params) {
+			mmGetTokensRevokedAt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTokensRevokedAt.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTokensRevokedAt
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenRevocationRepository.GetTokensRevokedAt
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) ExpectCtxParam1(ctx context.Context) *mTokenRevocationRepositoryMockGetTokensRevokedAt {
+	if mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Set")
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation == nil {
+		mmGetTokensRevokedAt.defaultExpectation = &TokenRevocationRepositoryMockGetTokensRevokedAtExpectation{}
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation.params != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Expect")
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation.paramPtrs == nil {
+		mmGetTokensRevokedAt.defaultExpectation.paramPtrs = &TokenRevocationRepositoryMockGetTokensRevokedAtParamPtrs{}
+	}
+	mmGetTokensRevokedAt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTokensRevokedAt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTokensRevokedAt
+}
+
+// ExpectSubjectParam2 sets up expected param subject for TokenRevocationRepository.GetTokensRevokedAt
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) ExpectSubjectParam2(subject string) *mTokenRevocationRepositoryMockGetTokensRevokedAt {
+	if mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Set")
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation == nil {
+		mmGetTokensRevokedAt.defaultExpectation = &TokenRevocationRepositoryMockGetTokensRevokedAtExpectation{}
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation.params != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Expect")
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation.paramPtrs == nil {
+		mmGetTokensRevokedAt.defaultExpectation.paramPtrs = &TokenRevocationRepositoryMockGetTokensRevokedAtParamPtrs{}
+	}
+	mmGetTokensRevokedAt.defaultExpectation.paramPtrs.subject = &subject
+	mmGetTokensRevokedAt.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmGetTokensRevokedAt
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenRevocationRepository.GetTokensRevokedAt
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Inspect(f func(ctx context.Context, subject string)) *mTokenRevocationRepositoryMockGetTokensRevokedAt {
+	if mmGetTokensRevokedAt.mock.inspectFuncGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("Inspect function is already set for TokenRevocationRepositoryMock.GetTokensRevokedAt")
+	}
+
+	mmGetTokensRevokedAt.mock.inspectFuncGetTokensRevokedAt = f
+
+	return mmGetTokensRevokedAt
+}
+
+// Return sets up results that will be returned by TokenRevocationRepository.GetTokensRevokedAt
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Return(t1 time.Time, err error) *TokenRevocationRepositoryMock {
+	if mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Set")
+	}
+
+	if mmGetTokensRevokedAt.defaultExpectation == nil {
+		mmGetTokensRevokedAt.defaultExpectation = &TokenRevocationRepositoryMockGetTokensRevokedAtExpectation{mock: mmGetTokensRevokedAt.mock}
+	}
+	mmGetTokensRevokedAt.defaultExpectation.results = &TokenRevocationRepositoryMockGetTokensRevokedAtResults{t1, err}
+	mmGetTokensRevokedAt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTokensRevokedAt.mock
+}
+
+// Set uses given function f to mock the TokenRevocationRepository.GetTokensRevokedAt method
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Set(f func(ctx context.Context, subject string) (t1 time.Time, err error)) *TokenRevocationRepositoryMock {
+	if mmGetTokensRevokedAt.defaultExpectation != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("Default expectation is already set for the TokenRevocationRepository.GetTokensRevokedAt method")
+	}
+
+	if len(mmGetTokensRevokedAt.expectations) > 0 {
+		mmGetTokensRevokedAt.mock.t.Fatalf("Some expectations are already set for the TokenRevocationRepository.GetTokensRevokedAt method")
+	}
+
+	mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt = f
+	mmGetTokensRevokedAt.mock.funcGetTokensRevokedAtOrigin = minimock.CallerInfo(1)
+	return mmGetTokensRevokedAt.mock
+}
+
+// When sets expectation for the TokenRevocationRepository.GetTokensRevokedAt which will trigger the result defined by the following
+// Then helper
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) When(ctx context.Context, subject string) *TokenRevocationRepositoryMockGetTokensRevokedAtExpectation {
+	if mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.mock.t.Fatalf("TokenRevocationRepositoryMock.GetTokensRevokedAt mock is already set by Set")
+	}
+
+	expectation := &TokenRevocationRepositoryMockGetTokensRevokedAtExpectation{
+		mock:               mmGetTokensRevokedAt.mock,
+		params:             &TokenRevocationRepositoryMockGetTokensRevokedAtParams{ctx, subject},
+		expectationOrigins: TokenRevocationRepositoryMockGetTokensRevokedAtExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTokensRevokedAt.expectations = append(mmGetTokensRevokedAt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenRevocationRepository.GetTokensRevokedAt return parameters for the expectation previously defined by the When method
+func (e *TokenRevocationRepositoryMockGetTokensRevokedAtExpectation) Then(t1 time.Time, err error) *TokenRevocationRepositoryMock {
+	e.results = &TokenRevocationRepositoryMockGetTokensRevokedAtResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenRevocationRepository.GetTokensRevokedAt should be invoked
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Times(n uint64) *mTokenRevocationRepositoryMockGetTokensRevokedAt {
+	if n == 0 {
+		mmGetTokensRevokedAt.mock.t.Fatalf("Times of TokenRevocationRepositoryMock.GetTokensRevokedAt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTokensRevokedAt.expectedInvocations, n)
+	mmGetTokensRevokedAt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTokensRevokedAt
+}
+
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) invocationsDone() bool {
+	if len(mmGetTokensRevokedAt.expectations) == 0 && mmGetTokensRevokedAt.defaultExpectation == nil && mmGetTokensRevokedAt.mock.funcGetTokensRevokedAt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTokensRevokedAt.mock.afterGetTokensRevokedAtCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTokensRevokedAt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTokensRevokedAt implements mm_repository.TokenRevocationRepository
+func (mmGetTokensRevokedAt *TokenRevocationRepositoryMock) GetTokensRevokedAt(ctx context.Context, subject string) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmGetTokensRevokedAt.beforeGetTokensRevokedAtCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTokensRevokedAt.afterGetTokensRevokedAtCounter, 1)
+
+	mmGetTokensRevokedAt.t.Helper()
+
+	if mmGetTokensRevokedAt.inspectFuncGetTokensRevokedAt != nil {
+		mmGetTokensRevokedAt.inspectFuncGetTokensRevokedAt(ctx, subject)
+	}
+
+	mm_params := TokenRevocationRepositoryMockGetTokensRevokedAtParams{ctx, subject}
+
+	// Record call args
+	mmGetTokensRevokedAt.GetTokensRevokedAtMock.mutex.Lock()
+	mmGetTokensRevokedAt.GetTokensRevokedAtMock.callArgs = append(mmGetTokensRevokedAt.GetTokensRevokedAtMock.callArgs, &mm_params)
+	mmGetTokensRevokedAt.GetTokensRevokedAtMock.mutex.Unlock()
+
+	for _, e := range mmGetTokensRevokedAt.GetTokensRevokedAtMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenRevocationRepositoryMockGetTokensRevokedAtParams{ctx, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTokensRevokedAt.t.Errorf("TokenRevocationRepositoryMock.GetTokensRevokedAt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGetTokensRevokedAt.t.Errorf("TokenRevocationRepositoryMock.GetTokensRevokedAt got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTokensRevokedAt.t.Errorf("TokenRevocationRepositoryMock.GetTokensRevokedAt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTokensRevokedAt.GetTokensRevokedAtMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTokensRevokedAt.t.Fatal("No results are set for the TokenRevocationRepositoryMock.GetTokensRevokedAt")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTokensRevokedAt.funcGetTokensRevokedAt != nil {
+		return mmGetTokensRevokedAt.funcGetTokensRevokedAt(ctx, subject)
+	}
+	mmGetTokensRevokedAt.t.Fatalf("Unexpected call to TokenRevocationRepositoryMock.GetTokensRevokedAt. %v %v", ctx, subject)
+	return
+}
+
+// GetTokensRevokedAtAfterCounter returns a count of finished TokenRevocationRepositoryMock.GetTokensRevokedAt invocations
+func (mmGetTokensRevokedAt *TokenRevocationRepositoryMock) GetTokensRevokedAtAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTokensRevokedAt.afterGetTokensRevokedAtCounter)
+}
+
+// GetTokensRevokedAtBeforeCounter returns a count of TokenRevocationRepositoryMock.GetTokensRevokedAt invocations
+func (mmGetTokensRevokedAt *TokenRevocationRepositoryMock) GetTokensRevokedAtBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTokensRevokedAt.beforeGetTokensRevokedAtCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenRevocationRepositoryMock.GetTokensRevokedAt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTokensRevokedAt *mTokenRevocationRepositoryMockGetTokensRevokedAt) Calls() []*TokenRevocationRepositoryMockGetTokensRevokedAtParams {
+	mmGetTokensRevokedAt.mutex.RLock()
+
+	argCopy := make([]*TokenRevocationRepositoryMockGetTokensRevokedAtParams, len(mmGetTokensRevokedAt.callArgs))
+	copy(argCopy, mmGetTokensRevokedAt.callArgs)
+
+	mmGetTokensRevokedAt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTokensRevokedAtDone returns true if the count of the GetTokensRevokedAt invocations corresponds
+// the number of defined expectations
+func (m *TokenRevocationRepositoryMock) MinimockGetTokensRevokedAtDone() bool {
+	if m.GetTokensRevokedAtMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTokensRevokedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTokensRevokedAtMock.invocationsDone()
+}
+
+// MinimockGetTokensRevokedAtInspect logs each unmet expectation
+func (m *TokenRevocationRepositoryMock) MinimockGetTokensRevokedAtInspect() {
+	for _, e := range m.GetTokensRevokedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenRevocationRepositoryMock.GetTokensRevokedAt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTokensRevokedAtCounter := mm_atomic.LoadUint64(&m.afterGetTokensRevokedAtCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTokensRevokedAtMock.defaultExpectation != nil && afterGetTokensRevokedAtCounter < 1 {
+		if m.GetTokensRevokedAtMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenRevocationRepositoryMock.GetTokensRevokedAt at\n%s", m.GetTokensRevokedAtMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenRevocationRepositoryMock.GetTokensRevokedAt at\n%s with params: %#v", m.GetTokensRevokedAtMock.defaultExpectation.expectationOrigins.origin, *m.GetTokensRevokedAtMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTokensRevokedAt != nil && afterGetTokensRevokedAtCounter < 1 {
+		m.t.Errorf("Expected call to TokenRevocationRepositoryMock.GetTokensRevokedAt at\n%s", m.funcGetTokensRevokedAtOrigin)
+	}
+
+	if !m.GetTokensRevokedAtMock.invocationsDone() && afterGetTokensRevokedAtCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenRevocationRepositoryMock.GetTokensRevokedAt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTokensRevokedAtMock.expectedInvocations), m.GetTokensRevokedAtMock.expectedInvocationsOrigin, afterGetTokensRevokedAtCounter)
+	}
+}
+
+type mTokenRevocationRepositoryMockRevokeUserTokens struct {
+	optional           bool
+	mock               *TokenRevocationRepositoryMock
+	defaultExpectation *TokenRevocationRepositoryMockRevokeUserTokensExpectation
+	expectations       []*TokenRevocationRepositoryMockRevokeUserTokensExpectation
+
+	callArgs []*TokenRevocationRepositoryMockRevokeUserTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenRevocationRepositoryMockRevokeUserTokensExpectation specifies expectation struct of the TokenRevocationRepository.RevokeUserTokens
+type TokenRevocationRepositoryMockRevokeUserTokensExpectation struct {
+	mock               *TokenRevocationRepositoryMock
+	params             *TokenRevocationRepositoryMockRevokeUserTokensParams
+	paramPtrs          *TokenRevocationRepositoryMockRevokeUserTokensParamPtrs
+	expectationOrigins TokenRevocationRepositoryMockRevokeUserTokensExpectationOrigins
+	results            *TokenRevocationRepositoryMockRevokeUserTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenRevocationRepositoryMockRevokeUserTokensParams contains parameters of the TokenRevocationRepository.RevokeUserTokens
+type TokenRevocationRepositoryMockRevokeUserTokensParams struct {
+	ctx       context.Context
+	subject   string
+	revokedAt time.Time
+}
+
+// TokenRevocationRepositoryMockRevokeUserTokensParamPtrs contains pointers to parameters of the TokenRevocationRepository.RevokeUserTokens
+type TokenRevocationRepositoryMockRevokeUserTokensParamPtrs struct {
+	ctx       *context.Context
+	subject   *string
+	revokedAt *time.Time
+}
+
+// TokenRevocationRepositoryMockRevokeUserTokensResults contains results of the TokenRevocationRepository.RevokeUserTokens
+type TokenRevocationRepositoryMockRevokeUserTokensResults struct {
+	err error
+}
+
+// TokenRevocationRepositoryMockRevokeUserTokensOrigins contains origins of expectations of the TokenRevocationRepository.RevokeUserTokens
+type TokenRevocationRepositoryMockRevokeUserTokensExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSubject   string
+	originRevokedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Optional() *mTokenRevocationRepositoryMockRevokeUserTokens {
+	mmRevokeUserTokens.optional = true
+	return mmRevokeUserTokens
+}
+
+// Expect sets up expected params for TokenRevocationRepository.RevokeUserTokens
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Expect(ctx context.Context, subject string, revokedAt time.Time) *mTokenRevocationRepositoryMockRevokeUserTokens {
+	if mmRevokeUserTokens.mock.funcRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation == nil {
+		mmRevokeUserTokens.defaultExpectation = &TokenRevocationRepositoryMockRevokeUserTokensExpectation{}
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.paramPtrs != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeUserTokens.defaultExpectation.params = &TokenRevocationRepositoryMockRevokeUserTokensParams{ctx, subject, revokedAt}
+	mmRevokeUserTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeUserTokens.expectations {
+		if minimock.Equal(e.params, mmRevokeUserTokens.defaultExpectation.params) {
+			mmRevokeUserTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeUserTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeUserTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenRevocationRepository.RevokeUserTokens
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) ExpectCtxParam1(ctx context.Context) *mTokenRevocationRepositoryMockRevokeUserTokens {
+	if mmRevokeUserTokens.mock.funcRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation == nil {
+		mmRevokeUserTokens.defaultExpectation = &TokenRevocationRepositoryMockRevokeUserTokensExpectation{}
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.params != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Expect")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserTokens.defaultExpectation.paramPtrs = &TokenRevocationRepositoryMockRevokeUserTokensParamPtrs{}
+	}
+	mmRevokeUserTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeUserTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeUserTokens
+}
+
+// ExpectSubjectParam2 sets up expected param subject for TokenRevocationRepository.RevokeUserTokens
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) ExpectSubjectParam2(subject string) *mTokenRevocationRepositoryMockRevokeUserTokens {
+	if mmRevokeUserTokens.mock.funcRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation == nil {
+		mmRevokeUserTokens.defaultExpectation = &TokenRevocationRepositoryMockRevokeUserTokensExpectation{}
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.params != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Expect")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserTokens.defaultExpectation.paramPtrs = &TokenRevocationRepositoryMockRevokeUserTokensParamPtrs{}
+	}
+	mmRevokeUserTokens.defaultExpectation.paramPtrs.subject = &subject
+	mmRevokeUserTokens.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmRevokeUserTokens
+}
+
+// ExpectRevokedAtParam3 sets up expected param revokedAt for TokenRevocationRepository.RevokeUserTokens
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) ExpectRevokedAtParam3(revokedAt time.Time) *mTokenRevocationRepositoryMockRevokeUserTokens {
+	if mmRevokeUserTokens.mock.funcRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation == nil {
+		mmRevokeUserTokens.defaultExpectation = &TokenRevocationRepositoryMockRevokeUserTokensExpectation{}
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.params != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Expect")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserTokens.defaultExpectation.paramPtrs = &TokenRevocationRepositoryMockRevokeUserTokensParamPtrs{}
+	}
+	mmRevokeUserTokens.defaultExpectation.paramPtrs.revokedAt = &revokedAt
+	mmRevokeUserTokens.defaultExpectation.expectationOrigins.originRevokedAt = minimock.CallerInfo(1)
+
+	return mmRevokeUserTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenRevocationRepository.RevokeUserTokens
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Inspect(f func(ctx context.Context, subject string, revokedAt time.Time)) *mTokenRevocationRepositoryMockRevokeUserTokens {
+	if mmRevokeUserTokens.mock.inspectFuncRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("Inspect function is already set for TokenRevocationRepositoryMock.RevokeUserTokens")
+	}
+
+	mmRevokeUserTokens.mock.inspectFuncRevokeUserTokens = f
+
+	return mmRevokeUserTokens
+}
+
+// Return sets up results that will be returned by TokenRevocationRepository.RevokeUserTokens
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Return(err error) *TokenRevocationRepositoryMock {
+	if mmRevokeUserTokens.mock.funcRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserTokens.defaultExpectation == nil {
+		mmRevokeUserTokens.defaultExpectation = &TokenRevocationRepositoryMockRevokeUserTokensExpectation{mock: mmRevokeUserTokens.mock}
+	}
+	mmRevokeUserTokens.defaultExpectation.results = &TokenRevocationRepositoryMockRevokeUserTokensResults{err}
+	mmRevokeUserTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeUserTokens.mock
+}
+
+// Set uses given function f to mock the TokenRevocationRepository.RevokeUserTokens method
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Set(f func(ctx context.Context, subject string, revokedAt time.Time) (err error)) *TokenRevocationRepositoryMock {
+	if mmRevokeUserTokens.defaultExpectation != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("Default expectation is already set for the TokenRevocationRepository.RevokeUserTokens method")
+	}
+
+	if len(mmRevokeUserTokens.expectations) > 0 {
+		mmRevokeUserTokens.mock.t.Fatalf("Some expectations are already set for the TokenRevocationRepository.RevokeUserTokens method")
+	}
+
+	mmRevokeUserTokens.mock.funcRevokeUserTokens = f
+	mmRevokeUserTokens.mock.funcRevokeUserTokensOrigin = minimock.CallerInfo(1)
+	return mmRevokeUserTokens.mock
+}
+
+// When sets expectation for the TokenRevocationRepository.RevokeUserTokens which will trigger the result defined by the following
+// Then helper
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) When(ctx context.Context, subject string, revokedAt time.Time) *TokenRevocationRepositoryMockRevokeUserTokensExpectation {
+	if mmRevokeUserTokens.mock.funcRevokeUserTokens != nil {
+		mmRevokeUserTokens.mock.t.Fatalf("TokenRevocationRepositoryMock.RevokeUserTokens mock is already set by Set")
+	}
+
+	expectation := &TokenRevocationRepositoryMockRevokeUserTokensExpectation{
+		mock:               mmRevokeUserTokens.mock,
+		params:             &TokenRevocationRepositoryMockRevokeUserTokensParams{ctx, subject, revokedAt},
+		expectationOrigins: TokenRevocationRepositoryMockRevokeUserTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeUserTokens.expectations = append(mmRevokeUserTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenRevocationRepository.RevokeUserTokens return parameters for the expectation previously defined by the When method
+func (e *TokenRevocationRepositoryMockRevokeUserTokensExpectation) Then(err error) *TokenRevocationRepositoryMock {
+	e.results = &TokenRevocationRepositoryMockRevokeUserTokensResults{err}
+	return e.mock
+}
+
+// Times sets number of times TokenRevocationRepository.RevokeUserTokens should be invoked
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Times(n uint64) *mTokenRevocationRepositoryMockRevokeUserTokens {
+	if n == 0 {
+		mmRevokeUserTokens.mock.t.Fatalf("Times of TokenRevocationRepositoryMock.RevokeUserTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeUserTokens.expectedInvocations, n)
+	mmRevokeUserTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeUserTokens
+}
+
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) invocationsDone() bool {
+	if len(mmRevokeUserTokens.expectations) == 0 && mmRevokeUserTokens.defaultExpectation == nil && mmRevokeUserTokens.mock.funcRevokeUserTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeUserTokens.mock.afterRevokeUserTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeUserTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeUserTokens implements mm_repository.TokenRevocationRepository
+func (mmRevokeUserTokens *TokenRevocationRepositoryMock) RevokeUserTokens(ctx context.Context, subject string, revokedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmRevokeUserTokens.beforeRevokeUserTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeUserTokens.afterRevokeUserTokensCounter, 1)
+
+	mmRevokeUserTokens.t.Helper()
+
+	if mmRevokeUserTokens.inspectFuncRevokeUserTokens != nil {
+		mmRevokeUserTokens.inspectFuncRevokeUserTokens(ctx, subject, revokedAt)
+	}
+
+	mm_params := TokenRevocationRepositoryMockRevokeUserTokensParams{ctx, subject, revokedAt}
+
+	// Record call args
+	mmRevokeUserTokens.RevokeUserTokensMock.mutex.Lock()
+	mmRevokeUserTokens.RevokeUserTokensMock.callArgs = append(mmRevokeUserTokens.RevokeUserTokensMock.callArgs, &mm_params)
+	mmRevokeUserTokens.RevokeUserTokensMock.mutex.Unlock()
+
+	for _, e := range mmRevokeUserTokens.RevokeUserTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenRevocationRepositoryMockRevokeUserTokensParams{ctx, subject, revokedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeUserTokens.t.Errorf("TokenRevocationRepositoryMock.RevokeUserTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmRevokeUserTokens.t.Errorf("TokenRevocationRepositoryMock.RevokeUserTokens got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+			if mm_want_ptrs.revokedAt != nil && !minimock.Equal(*mm_want_ptrs.revokedAt, mm_got.revokedAt) {
+				mmRevokeUserTokens.t.Errorf("TokenRevocationRepositoryMock.RevokeUserTokens got unexpected parameter revokedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.expectationOrigins.originRevokedAt, *mm_want_ptrs.revokedAt, mm_got.revokedAt, minimock.Diff(*mm_want_ptrs.revokedAt, mm_got.revokedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeUserTokens.t.Errorf("TokenRevocationRepositoryMock.RevokeUserTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeUserTokens.RevokeUserTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeUserTokens.t.Fatal("No results are set for the TokenRevocationRepositoryMock.RevokeUserTokens")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeUserTokens.funcRevokeUserTokens != nil {
+		return mmRevokeUserTokens.funcRevokeUserTokens(ctx, subject, revokedAt)
+	}
+	mmRevokeUserTokens.t.Fatalf("Unexpected call to TokenRevocationRepositoryMock.RevokeUserTokens. %v %v %v", ctx, subject, revokedAt)
+	return
+}
+
+// RevokeUserTokensAfterCounter returns a count of finished TokenRevocationRepositoryMock.RevokeUserTokens invocations
+func (mmRevokeUserTokens *TokenRevocationRepositoryMock) RevokeUserTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeUserTokens.afterRevokeUserTokensCounter)
+}
+
+// RevokeUserTokensBeforeCounter returns a count of TokenRevocationRepositoryMock.RevokeUserTokens invocations
+func (mmRevokeUserTokens *TokenRevocationRepositoryMock) RevokeUserTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeUserTokens.beforeRevokeUserTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenRevocationRepositoryMock.RevokeUserTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeUserTokens *mTokenRevocationRepositoryMockRevokeUserTokens) Calls() []*TokenRevocationRepositoryMockRevokeUserTokensParams {
+	mmRevokeUserTokens.mutex.RLock()
+
+	argCopy := make([]*TokenRevocationRepositoryMockRevokeUserTokensParams, len(mmRevokeUserTokens.callArgs))
+	copy(argCopy, mmRevokeUserTokens.callArgs)
+
+	mmRevokeUserTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeUserTokensDone returns true if the count of the RevokeUserTokens invocations corresponds
+// the number of defined expectations
+func (m *TokenRevocationRepositoryMock) MinimockRevokeUserTokensDone() bool {
+	if m.RevokeUserTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeUserTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeUserTokensMock.invocationsDone()
+}
+
+// MinimockRevokeUserTokensInspect logs each unmet expectation
+func (m *TokenRevocationRepositoryMock) MinimockRevokeUserTokensInspect() {
+	for _, e := range m.RevokeUserTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenRevocationRepositoryMock.RevokeUserTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeUserTokensCounter := mm_atomic.LoadUint64(&m.afterRevokeUserTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeUserTokensMock.defaultExpectation != nil && afterRevokeUserTokensCounter < 1 {
+		if m.RevokeUserTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenRevocationRepositoryMock.RevokeUserTokens at\n%s", m.RevokeUserTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenRevocationRepositoryMock.RevokeUserTokens at\n%s with params: %#v", m.RevokeUserTokensMock.defaultExpectation.expectationOrigins.origin, *m.RevokeUserTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeUserTokens != nil && afterRevokeUserTokensCounter < 1 {
+		m.t.Errorf("Expected call to TokenRevocationRepositoryMock.RevokeUserTokens at\n%s", m.funcRevokeUserTokensOrigin)
+	}
+
+	if !m.RevokeUserTokensMock.invocationsDone() && afterRevokeUserTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenRevocationRepositoryMock.RevokeUserTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeUserTokensMock.expectedInvocations), m.RevokeUserTokensMock.expectedInvocationsOrigin, afterRevokeUserTokensCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TokenRevocationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetTokensRevokedAtInspect()
+
+			m.MinimockRevokeUserTokensInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TokenRevocationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TokenRevocationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetTokensRevokedAtDone() &&
+		m.MinimockRevokeUserTokensDone()
+}
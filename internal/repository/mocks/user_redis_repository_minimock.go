@@ -40,6 +40,13 @@ type UserRedisRepositoryMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserRedisRepositoryMockGetUser
 
+	funcGetUsers          func(ctx context.Context, ids []int64) (m1 map[int64]*model.User, err error)
+	funcGetUsersOrigin    string
+	inspectFuncGetUsers   func(ctx context.Context, ids []int64)
+	afterGetUsersCounter  uint64
+	beforeGetUsersCounter uint64
+	GetUsersMock          mUserRedisRepositoryMockGetUsers
+
 	funcSetExpireUser          func(ctx context.Context, id int64) (err error)
 	funcSetExpireUserOrigin    string
 	inspectFuncSetExpireUser   func(ctx context.Context, id int64)
@@ -65,6 +72,9 @@ func NewUserRedisRepositoryMock(t minimock.Tester) *UserRedisRepositoryMock {
 	m.GetUserMock = mUserRedisRepositoryMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserRedisRepositoryMockGetUserParams{}
 
+	m.GetUsersMock = mUserRedisRepositoryMockGetUsers{mock: m}
+	m.GetUsersMock.callArgs = []*UserRedisRepositoryMockGetUsersParams{}
+
 	m.SetExpireUserMock = mUserRedisRepositoryMockSetExpireUser{mock: m}
 	m.SetExpireUserMock.callArgs = []*UserRedisRepositoryMockSetExpireUserParams{}
 
@@ -1100,6 +1110,349 @@ func (m *UserRedisRepositoryMock) MinimockGetUserInspect() {
 	}
 }
 
+type mUserRedisRepositoryMockGetUsers struct {
+	optional           bool
+	mock               *UserRedisRepositoryMock
+	defaultExpectation *UserRedisRepositoryMockGetUsersExpectation
+	expectations       []*UserRedisRepositoryMockGetUsersExpectation
+
+	callArgs []*UserRedisRepositoryMockGetUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRedisRepositoryMockGetUsersExpectation specifies expectation struct of the UserRedisRepository.GetUsers
+type UserRedisRepositoryMockGetUsersExpectation struct {
+	mock               *UserRedisRepositoryMock
+	params             *UserRedisRepositoryMockGetUsersParams
+	paramPtrs          *UserRedisRepositoryMockGetUsersParamPtrs
+	expectationOrigins UserRedisRepositoryMockGetUsersExpectationOrigins
+	results            *UserRedisRepositoryMockGetUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRedisRepositoryMockGetUsersParams contains parameters of the UserRedisRepository.GetUsers
+type UserRedisRepositoryMockGetUsersParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// UserRedisRepositoryMockGetUsersParamPtrs contains pointers to parameters of the UserRedisRepository.GetUsers
+type UserRedisRepositoryMockGetUsersParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// UserRedisRepositoryMockGetUsersResults contains results of the UserRedisRepository.GetUsers
+type UserRedisRepositoryMockGetUsersResults struct {
+	m1  map[int64]*model.User
+	err error
+}
+
+// UserRedisRepositoryMockGetUsersOrigins contains origins of expectations of the UserRedisRepository.GetUsers
+type UserRedisRepositoryMockGetUsersExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Optional() *mUserRedisRepositoryMockGetUsers {
+	mmGetUsers.optional = true
+	return mmGetUsers
+}
+
+// Expect sets up expected params for UserRedisRepository.GetUsers
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Expect(ctx context.Context, ids []int64) *mUserRedisRepositoryMockGetUsers {
+	if mmGetUsers.mock.funcGetUsers != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Set")
+	}
+
+	if mmGetUsers.defaultExpectation == nil {
+		mmGetUsers.defaultExpectation = &UserRedisRepositoryMockGetUsersExpectation{}
+	}
+
+	if mmGetUsers.defaultExpectation.paramPtrs != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by ExpectParams functions")
+	}
+
+	mmGetUsers.defaultExpectation.params = &UserRedisRepositoryMockGetUsersParams{ctx, ids}
+	mmGetUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUsers.expectations {
+		if minimock.Equal(e.params, mmGetUsers.defaultExpectation.params) {
+			mmGetUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRedisRepository.GetUsers
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) ExpectCtxParam1(ctx context.Context) *mUserRedisRepositoryMockGetUsers {
+	if mmGetUsers.mock.funcGetUsers != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Set")
+	}
+
+	if mmGetUsers.defaultExpectation == nil {
+		mmGetUsers.defaultExpectation = &UserRedisRepositoryMockGetUsersExpectation{}
+	}
+
+	if mmGetUsers.defaultExpectation.params != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Expect")
+	}
+
+	if mmGetUsers.defaultExpectation.paramPtrs == nil {
+		mmGetUsers.defaultExpectation.paramPtrs = &UserRedisRepositoryMockGetUsersParamPtrs{}
+	}
+	mmGetUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUsers
+}
+
+// ExpectIdsParam2 sets up expected param ids for UserRedisRepository.GetUsers
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) ExpectIdsParam2(ids []int64) *mUserRedisRepositoryMockGetUsers {
+	if mmGetUsers.mock.funcGetUsers != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Set")
+	}
+
+	if mmGetUsers.defaultExpectation == nil {
+		mmGetUsers.defaultExpectation = &UserRedisRepositoryMockGetUsersExpectation{}
+	}
+
+	if mmGetUsers.defaultExpectation.params != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Expect")
+	}
+
+	if mmGetUsers.defaultExpectation.paramPtrs == nil {
+		mmGetUsers.defaultExpectation.paramPtrs = &UserRedisRepositoryMockGetUsersParamPtrs{}
+	}
+	mmGetUsers.defaultExpectation.paramPtrs.ids = &ids
+	mmGetUsers.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmGetUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRedisRepository.GetUsers
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Inspect(f func(ctx context.Context, ids []int64)) *mUserRedisRepositoryMockGetUsers {
+	if mmGetUsers.mock.inspectFuncGetUsers != nil {
+		mmGetUsers.mock.t.Fatalf("Inspect function is already set for UserRedisRepositoryMock.GetUsers")
+	}
+
+	mmGetUsers.mock.inspectFuncGetUsers = f
+
+	return mmGetUsers
+}
+
+// Return sets up results that will be returned by UserRedisRepository.GetUsers
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Return(m1 map[int64]*model.User, err error) *UserRedisRepositoryMock {
+	if mmGetUsers.mock.funcGetUsers != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Set")
+	}
+
+	if mmGetUsers.defaultExpectation == nil {
+		mmGetUsers.defaultExpectation = &UserRedisRepositoryMockGetUsersExpectation{mock: mmGetUsers.mock}
+	}
+	mmGetUsers.defaultExpectation.results = &UserRedisRepositoryMockGetUsersResults{m1, err}
+	mmGetUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUsers.mock
+}
+
+// Set uses given function f to mock the UserRedisRepository.GetUsers method
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Set(f func(ctx context.Context, ids []int64) (m1 map[int64]*model.User, err error)) *UserRedisRepositoryMock {
+	if mmGetUsers.defaultExpectation != nil {
+		mmGetUsers.mock.t.Fatalf("Default expectation is already set for the UserRedisRepository.GetUsers method")
+	}
+
+	if len(mmGetUsers.expectations) > 0 {
+		mmGetUsers.mock.t.Fatalf("Some expectations are already set for the UserRedisRepository.GetUsers method")
+	}
+
+	mmGetUsers.mock.funcGetUsers = f
+	mmGetUsers.mock.funcGetUsersOrigin = minimock.CallerInfo(1)
+	return mmGetUsers.mock
+}
+
+// When sets expectation for the UserRedisRepository.GetUsers which will trigger the result defined by the following
+// Then helper
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) When(ctx context.Context, ids []int64) *UserRedisRepositoryMockGetUsersExpectation {
+	if mmGetUsers.mock.funcGetUsers != nil {
+		mmGetUsers.mock.t.Fatalf("UserRedisRepositoryMock.GetUsers mock is already set by Set")
+	}
+
+	expectation := &UserRedisRepositoryMockGetUsersExpectation{
+		mock:               mmGetUsers.mock,
+		params:             &UserRedisRepositoryMockGetUsersParams{ctx, ids},
+		expectationOrigins: UserRedisRepositoryMockGetUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUsers.expectations = append(mmGetUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRedisRepository.GetUsers return parameters for the expectation previously defined by the When method
+func (e *UserRedisRepositoryMockGetUsersExpectation) Then(m1 map[int64]*model.User, err error) *UserRedisRepositoryMock {
+	e.results = &UserRedisRepositoryMockGetUsersResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRedisRepository.GetUsers should be invoked
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Times(n uint64) *mUserRedisRepositoryMockGetUsers {
+	if n == 0 {
+		mmGetUsers.mock.t.Fatalf("Times of UserRedisRepositoryMock.GetUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUsers.expectedInvocations, n)
+	mmGetUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUsers
+}
+
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) invocationsDone() bool {
+	if len(mmGetUsers.expectations) == 0 && mmGetUsers.defaultExpectation == nil && mmGetUsers.mock.funcGetUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUsers.mock.afterGetUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUsers implements mm_repository.UserRedisRepository
+func (mmGetUsers *UserRedisRepositoryMock) GetUsers(ctx context.Context, ids []int64) (m1 map[int64]*model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUsers.beforeGetUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUsers.afterGetUsersCounter, 1)
+
+	mmGetUsers.t.Helper()
+
+	if mmGetUsers.inspectFuncGetUsers != nil {
+		mmGetUsers.inspectFuncGetUsers(ctx, ids)
+	}
+
+	mm_params := UserRedisRepositoryMockGetUsersParams{ctx, ids}
+
+	// Record call args
+	mmGetUsers.GetUsersMock.mutex.Lock()
+	mmGetUsers.GetUsersMock.callArgs = append(mmGetUsers.GetUsersMock.callArgs, &mm_params)
+	mmGetUsers.GetUsersMock.mutex.Unlock()
+
+	for _, e := range mmGetUsers.GetUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetUsers.GetUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUsers.GetUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUsers.GetUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUsers.GetUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRedisRepositoryMockGetUsersParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUsers.t.Errorf("UserRedisRepositoryMock.GetUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUsers.GetUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmGetUsers.t.Errorf("UserRedisRepositoryMock.GetUsers got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUsers.GetUsersMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUsers.t.Errorf("UserRedisRepositoryMock.GetUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUsers.GetUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUsers.GetUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUsers.t.Fatal("No results are set for the UserRedisRepositoryMock.GetUsers")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetUsers.funcGetUsers != nil {
+		return mmGetUsers.funcGetUsers(ctx, ids)
+	}
+	mmGetUsers.t.Fatalf("Unexpected call to UserRedisRepositoryMock.GetUsers. %v %v", ctx, ids)
+	return
+}
+
+// GetUsersAfterCounter returns a count of finished UserRedisRepositoryMock.GetUsers invocations
+func (mmGetUsers *UserRedisRepositoryMock) GetUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsers.afterGetUsersCounter)
+}
+
+// GetUsersBeforeCounter returns a count of UserRedisRepositoryMock.GetUsers invocations
+func (mmGetUsers *UserRedisRepositoryMock) GetUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsers.beforeGetUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRedisRepositoryMock.GetUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUsers *mUserRedisRepositoryMockGetUsers) Calls() []*UserRedisRepositoryMockGetUsersParams {
+	mmGetUsers.mutex.RLock()
+
+	argCopy := make([]*UserRedisRepositoryMockGetUsersParams, len(mmGetUsers.callArgs))
+	copy(argCopy, mmGetUsers.callArgs)
+
+	mmGetUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUsersDone returns true if the count of the GetUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRedisRepositoryMock) MinimockGetUsersDone() bool {
+	if m.GetUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUsersMock.invocationsDone()
+}
+
+// MinimockGetUsersInspect logs each unmet expectation
+func (m *UserRedisRepositoryMock) MinimockGetUsersInspect() {
+	for _, e := range m.GetUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRedisRepositoryMock.GetUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUsersCounter := mm_atomic.LoadUint64(&m.afterGetUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUsersMock.defaultExpectation != nil && afterGetUsersCounter < 1 {
+		if m.GetUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRedisRepositoryMock.GetUsers at\n%s", m.GetUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRedisRepositoryMock.GetUsers at\n%s with params: %#v", m.GetUsersMock.defaultExpectation.expectationOrigins.origin, *m.GetUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUsers != nil && afterGetUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserRedisRepositoryMock.GetUsers at\n%s", m.funcGetUsersOrigin)
+	}
+
+	if !m.GetUsersMock.invocationsDone() && afterGetUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRedisRepositoryMock.GetUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUsersMock.expectedInvocations), m.GetUsersMock.expectedInvocationsOrigin, afterGetUsersCounter)
+	}
+}
+
 type mUserRedisRepositoryMockSetExpireUser struct {
 	optional           bool
 	mock               *UserRedisRepositoryMock
@@ -1452,6 +1805,8 @@ func (m *UserRedisRepositoryMock) MinimockFinish() {
 
 			m.MinimockGetUserInspect()
 
+			m.MinimockGetUsersInspect()
+
 			m.MinimockSetExpireUserInspect()
 		}
 	})
@@ -1479,5 +1834,6 @@ func (m *UserRedisRepositoryMock) minimockDone() bool {
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockGetUsersDone() &&
 		m.MinimockSetExpireUserDone()
 }
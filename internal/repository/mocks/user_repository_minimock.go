@@ -19,6 +19,20 @@ type UserRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcActivateUser          func(ctx context.Context, id int64, passwordHash string) (err error)
+	funcActivateUserOrigin    string
+	inspectFuncActivateUser   func(ctx context.Context, id int64, passwordHash string)
+	afterActivateUserCounter  uint64
+	beforeActivateUserCounter uint64
+	ActivateUserMock          mUserRepositoryMockActivateUser
+
+	funcCopyUsers          func(ctx context.Context, users []*model.ImportUser) (i1 int64, err error)
+	funcCopyUsersOrigin    string
+	inspectFuncCopyUsers   func(ctx context.Context, users []*model.ImportUser)
+	afterCopyUsersCounter  uint64
+	beforeCopyUsersCounter uint64
+	CopyUsersMock          mUserRepositoryMockCopyUsers
+
 	funcCreateUser          func(ctx context.Context, newUser *model.NewUser) (i1 int64, err error)
 	funcCreateUserOrigin    string
 	inspectFuncCreateUser   func(ctx context.Context, newUser *model.NewUser)
@@ -33,6 +47,13 @@ type UserRepositoryMock struct {
 	beforeDeleteUserCounter uint64
 	DeleteUserMock          mUserRepositoryMockDeleteUser
 
+	funcFindUserNames          func(ctx context.Context, names []string) (sa1 []string, err error)
+	funcFindUserNamesOrigin    string
+	inspectFuncFindUserNames   func(ctx context.Context, names []string)
+	afterFindUserNamesCounter  uint64
+	beforeFindUserNamesCounter uint64
+	FindUserNamesMock          mUserRepositoryMockFindUserNames
+
 	funcGetUser          func(ctx context.Context, id int64) (up1 *model.User, err error)
 	funcGetUserOrigin    string
 	inspectFuncGetUser   func(ctx context.Context, id int64)
@@ -40,6 +61,13 @@ type UserRepositoryMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserRepositoryMockGetUser
 
+	funcGetUserByEmail          func(ctx context.Context, email string) (up1 *model.User, err error)
+	funcGetUserByEmailOrigin    string
+	inspectFuncGetUserByEmail   func(ctx context.Context, email string)
+	afterGetUserByEmailCounter  uint64
+	beforeGetUserByEmailCounter uint64
+	GetUserByEmailMock          mUserRepositoryMockGetUserByEmail
+
 	funcGetUserByName          func(ctx context.Context, username string) (up1 *model.User, err error)
 	funcGetUserByNameOrigin    string
 	inspectFuncGetUserByName   func(ctx context.Context, username string)
@@ -47,6 +75,20 @@ type UserRepositoryMock struct {
 	beforeGetUserByNameCounter uint64
 	GetUserByNameMock          mUserRepositoryMockGetUserByName
 
+	funcGetUsersByIDs          func(ctx context.Context, ids []int64) (upa1 []*model.User, err error)
+	funcGetUsersByIDsOrigin    string
+	inspectFuncGetUsersByIDs   func(ctx context.Context, ids []int64)
+	afterGetUsersByIDsCounter  uint64
+	beforeGetUsersByIDsCounter uint64
+	GetUsersByIDsMock          mUserRepositoryMockGetUsersByIDs
+
+	funcListUsers          func(ctx context.Context, afterID int64, limit uint64) (upa1 []*model.User, err error)
+	funcListUsersOrigin    string
+	inspectFuncListUsers   func(ctx context.Context, afterID int64, limit uint64)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserRepositoryMockListUsers
+
 	funcUpdateUser          func(ctx context.Context, updateUserInfo *model.UpdateUserInfo) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, updateUserInfo *model.UpdateUserInfo)
@@ -63,18 +105,36 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ActivateUserMock = mUserRepositoryMockActivateUser{mock: m}
+	m.ActivateUserMock.callArgs = []*UserRepositoryMockActivateUserParams{}
+
+	m.CopyUsersMock = mUserRepositoryMockCopyUsers{mock: m}
+	m.CopyUsersMock.callArgs = []*UserRepositoryMockCopyUsersParams{}
+
 	m.CreateUserMock = mUserRepositoryMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*UserRepositoryMockCreateUserParams{}
 
 	m.DeleteUserMock = mUserRepositoryMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*UserRepositoryMockDeleteUserParams{}
 
+	m.FindUserNamesMock = mUserRepositoryMockFindUserNames{mock: m}
+	m.FindUserNamesMock.callArgs = []*UserRepositoryMockFindUserNamesParams{}
+
 	m.GetUserMock = mUserRepositoryMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserRepositoryMockGetUserParams{}
 
+	m.GetUserByEmailMock = mUserRepositoryMockGetUserByEmail{mock: m}
+	m.GetUserByEmailMock.callArgs = []*UserRepositoryMockGetUserByEmailParams{}
+
 	m.GetUserByNameMock = mUserRepositoryMockGetUserByName{mock: m}
 	m.GetUserByNameMock.callArgs = []*UserRepositoryMockGetUserByNameParams{}
 
+	m.GetUsersByIDsMock = mUserRepositoryMockGetUsersByIDs{mock: m}
+	m.GetUsersByIDsMock.callArgs = []*UserRepositoryMockGetUsersByIDsParams{}
+
+	m.ListUsersMock = mUserRepositoryMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserRepositoryMockListUsersParams{}
+
 	m.UpdateUserMock = mUserRepositoryMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserRepositoryMockUpdateUserParams{}
 
@@ -83,53 +143,55 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	return m
 }
 
-type mUserRepositoryMockCreateUser struct {
+type mUserRepositoryMockActivateUser struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockCreateUserExpectation
-	expectations       []*UserRepositoryMockCreateUserExpectation
+	defaultExpectation *UserRepositoryMockActivateUserExpectation
+	expectations       []*UserRepositoryMockActivateUserExpectation
 
-	callArgs []*UserRepositoryMockCreateUserParams
+	callArgs []*UserRepositoryMockActivateUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockCreateUserExpectation specifies expectation struct of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserExpectation struct {
+// UserRepositoryMockActivateUserExpectation specifies expectation struct of the UserRepository.ActivateUser
+type UserRepositoryMockActivateUserExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockCreateUserParams
-	paramPtrs          *UserRepositoryMockCreateUserParamPtrs
-	expectationOrigins UserRepositoryMockCreateUserExpectationOrigins
-	results            *UserRepositoryMockCreateUserResults
+	params             *UserRepositoryMockActivateUserParams
+	paramPtrs          *UserRepositoryMockActivateUserParamPtrs
+	expectationOrigins UserRepositoryMockActivateUserExpectationOrigins
+	results            *UserRepositoryMockActivateUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockCreateUserParams contains parameters of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserParams struct {
-	ctx     context.Context
-	newUser *model.NewUser
+// UserRepositoryMockActivateUserParams contains parameters of the UserRepository.ActivateUser
+type UserRepositoryMockActivateUserParams struct {
+	ctx          context.Context
+	id           int64
+	passwordHash string
 }
 
-// UserRepositoryMockCreateUserParamPtrs contains pointers to parameters of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserParamPtrs struct {
-	ctx     *context.Context
-	newUser **model.NewUser
+// UserRepositoryMockActivateUserParamPtrs contains pointers to parameters of the UserRepository.ActivateUser
+type UserRepositoryMockActivateUserParamPtrs struct {
+	ctx          *context.Context
+	id           *int64
+	passwordHash *string
 }
 
-// UserRepositoryMockCreateUserResults contains results of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserResults struct {
-	i1  int64
+// UserRepositoryMockActivateUserResults contains results of the UserRepository.ActivateUser
+type UserRepositoryMockActivateUserResults struct {
 	err error
 }
 
-// UserRepositoryMockCreateUserOrigins contains origins of expectations of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originNewUser string
+// UserRepositoryMockActivateUserOrigins contains origins of expectations of the UserRepository.ActivateUser
+type UserRepositoryMockActivateUserExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originId           string
+	originPasswordHash string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -137,341 +199,370 @@ type UserRepositoryMockCreateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateUser *mUserRepositoryMockCreateUser) Optional() *mUserRepositoryMockCreateUser {
-	mmCreateUser.optional = true
-	return mmCreateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) Optional() *mUserRepositoryMockActivateUser {
+	mmActivateUser.optional = true
+	return mmActivateUser
 }
 
-// Expect sets up expected params for UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) Expect(ctx context.Context, newUser *model.NewUser) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.ActivateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) Expect(ctx context.Context, id int64, passwordHash string) *mUserRepositoryMockActivateUser {
+	if mmActivateUser.mock.funcActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	if mmActivateUser.defaultExpectation == nil {
+		mmActivateUser.defaultExpectation = &UserRepositoryMockActivateUserExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by ExpectParams functions")
+	if mmActivateUser.defaultExpectation.paramPtrs != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by ExpectParams functions")
 	}
 
-	mmCreateUser.defaultExpectation.params = &UserRepositoryMockCreateUserParams{ctx, newUser}
-	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateUser.expectations {
-		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
-			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+	mmActivateUser.defaultExpectation.params = &UserRepositoryMockActivateUserParams{ctx, id, passwordHash}
+	mmActivateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmActivateUser.expectations {
+		if minimock.Equal(e.params, mmActivateUser.defaultExpectation.params) {
+			mmActivateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmActivateUser.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateUser
+	return mmActivateUser
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ActivateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockActivateUser {
+	if mmActivateUser.mock.funcActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	if mmActivateUser.defaultExpectation == nil {
+		mmActivateUser.defaultExpectation = &UserRepositoryMockActivateUserExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
+	if mmActivateUser.defaultExpectation.params != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
+	if mmActivateUser.defaultExpectation.paramPtrs == nil {
+		mmActivateUser.defaultExpectation.paramPtrs = &UserRepositoryMockActivateUserParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmActivateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmActivateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmActivateUser
 }
 
-// ExpectNewUserParam2 sets up expected param newUser for UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectNewUserParam2(newUser *model.NewUser) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for UserRepository.ActivateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) ExpectIdParam2(id int64) *mUserRepositoryMockActivateUser {
+	if mmActivateUser.mock.funcActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	if mmActivateUser.defaultExpectation == nil {
+		mmActivateUser.defaultExpectation = &UserRepositoryMockActivateUserExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
+	if mmActivateUser.defaultExpectation.params != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
+	if mmActivateUser.defaultExpectation.paramPtrs == nil {
+		mmActivateUser.defaultExpectation.paramPtrs = &UserRepositoryMockActivateUserParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.newUser = &newUser
-	mmCreateUser.defaultExpectation.expectationOrigins.originNewUser = minimock.CallerInfo(1)
+	mmActivateUser.defaultExpectation.paramPtrs.id = &id
+	mmActivateUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmActivateUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) Inspect(f func(ctx context.Context, newUser *model.NewUser)) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.inspectFuncCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateUser")
+// ExpectPasswordHashParam3 sets up expected param passwordHash for UserRepository.ActivateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) ExpectPasswordHashParam3(passwordHash string) *mUserRepositoryMockActivateUser {
+	if mmActivateUser.mock.funcActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Set")
 	}
 
-	mmCreateUser.mock.inspectFuncCreateUser = f
+	if mmActivateUser.defaultExpectation == nil {
+		mmActivateUser.defaultExpectation = &UserRepositoryMockActivateUserExpectation{}
+	}
 
-	return mmCreateUser
+	if mmActivateUser.defaultExpectation.params != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Expect")
+	}
+
+	if mmActivateUser.defaultExpectation.paramPtrs == nil {
+		mmActivateUser.defaultExpectation.paramPtrs = &UserRepositoryMockActivateUserParamPtrs{}
+	}
+	mmActivateUser.defaultExpectation.paramPtrs.passwordHash = &passwordHash
+	mmActivateUser.defaultExpectation.expectationOrigins.originPasswordHash = minimock.CallerInfo(1)
+
+	return mmActivateUser
 }
 
-// Return sets up results that will be returned by UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) Return(i1 int64, err error) *UserRepositoryMock {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ActivateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) Inspect(f func(ctx context.Context, id int64, passwordHash string)) *mUserRepositoryMockActivateUser {
+	if mmActivateUser.mock.inspectFuncActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ActivateUser")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	mmActivateUser.mock.inspectFuncActivateUser = f
+
+	return mmActivateUser
+}
+
+// Return sets up results that will be returned by UserRepository.ActivateUser
+func (mmActivateUser *mUserRepositoryMockActivateUser) Return(err error) *UserRepositoryMock {
+	if mmActivateUser.mock.funcActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Set")
 	}
-	mmCreateUser.defaultExpectation.results = &UserRepositoryMockCreateUserResults{i1, err}
-	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+
+	if mmActivateUser.defaultExpectation == nil {
+		mmActivateUser.defaultExpectation = &UserRepositoryMockActivateUserExpectation{mock: mmActivateUser.mock}
+	}
+	mmActivateUser.defaultExpectation.results = &UserRepositoryMockActivateUserResults{err}
+	mmActivateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmActivateUser.mock
 }
 
-// Set uses given function f to mock the UserRepository.CreateUser method
-func (mmCreateUser *mUserRepositoryMockCreateUser) Set(f func(ctx context.Context, newUser *model.NewUser) (i1 int64, err error)) *UserRepositoryMock {
-	if mmCreateUser.defaultExpectation != nil {
-		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateUser method")
+// Set uses given function f to mock the UserRepository.ActivateUser method
+func (mmActivateUser *mUserRepositoryMockActivateUser) Set(f func(ctx context.Context, id int64, passwordHash string) (err error)) *UserRepositoryMock {
+	if mmActivateUser.defaultExpectation != nil {
+		mmActivateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.ActivateUser method")
 	}
 
-	if len(mmCreateUser.expectations) > 0 {
-		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateUser method")
+	if len(mmActivateUser.expectations) > 0 {
+		mmActivateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.ActivateUser method")
 	}
 
-	mmCreateUser.mock.funcCreateUser = f
-	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmActivateUser.mock.funcActivateUser = f
+	mmActivateUser.mock.funcActivateUserOrigin = minimock.CallerInfo(1)
+	return mmActivateUser.mock
 }
 
-// When sets expectation for the UserRepository.CreateUser which will trigger the result defined by the following
+// When sets expectation for the UserRepository.ActivateUser which will trigger the result defined by the following
 // Then helper
-func (mmCreateUser *mUserRepositoryMockCreateUser) When(ctx context.Context, newUser *model.NewUser) *UserRepositoryMockCreateUserExpectation {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+func (mmActivateUser *mUserRepositoryMockActivateUser) When(ctx context.Context, id int64, passwordHash string) *UserRepositoryMockActivateUserExpectation {
+	if mmActivateUser.mock.funcActivateUser != nil {
+		mmActivateUser.mock.t.Fatalf("UserRepositoryMock.ActivateUser mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockCreateUserExpectation{
-		mock:               mmCreateUser.mock,
-		params:             &UserRepositoryMockCreateUserParams{ctx, newUser},
-		expectationOrigins: UserRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockActivateUserExpectation{
+		mock:               mmActivateUser.mock,
+		params:             &UserRepositoryMockActivateUserParams{ctx, id, passwordHash},
+		expectationOrigins: UserRepositoryMockActivateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	mmActivateUser.expectations = append(mmActivateUser.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.CreateUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockCreateUserExpectation) Then(i1 int64, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockCreateUserResults{i1, err}
+// Then sets up UserRepository.ActivateUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockActivateUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockActivateUserResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.CreateUser should be invoked
-func (mmCreateUser *mUserRepositoryMockCreateUser) Times(n uint64) *mUserRepositoryMockCreateUser {
+// Times sets number of times UserRepository.ActivateUser should be invoked
+func (mmActivateUser *mUserRepositoryMockActivateUser) Times(n uint64) *mUserRepositoryMockActivateUser {
 	if n == 0 {
-		mmCreateUser.mock.t.Fatalf("Times of UserRepositoryMock.CreateUser mock can not be zero")
+		mmActivateUser.mock.t.Fatalf("Times of UserRepositoryMock.ActivateUser mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
-	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateUser
+	mm_atomic.StoreUint64(&mmActivateUser.expectedInvocations, n)
+	mmActivateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmActivateUser
 }
 
-func (mmCreateUser *mUserRepositoryMockCreateUser) invocationsDone() bool {
-	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+func (mmActivateUser *mUserRepositoryMockActivateUser) invocationsDone() bool {
+	if len(mmActivateUser.expectations) == 0 && mmActivateUser.defaultExpectation == nil && mmActivateUser.mock.funcActivateUser == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmActivateUser.mock.afterActivateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmActivateUser.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateUser implements mm_repository.UserRepository
-func (mmCreateUser *UserRepositoryMock) CreateUser(ctx context.Context, newUser *model.NewUser) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+// ActivateUser implements mm_repository.UserRepository
+func (mmActivateUser *UserRepositoryMock) ActivateUser(ctx context.Context, id int64, passwordHash string) (err error) {
+	mm_atomic.AddUint64(&mmActivateUser.beforeActivateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmActivateUser.afterActivateUserCounter, 1)
 
-	mmCreateUser.t.Helper()
+	mmActivateUser.t.Helper()
 
-	if mmCreateUser.inspectFuncCreateUser != nil {
-		mmCreateUser.inspectFuncCreateUser(ctx, newUser)
+	if mmActivateUser.inspectFuncActivateUser != nil {
+		mmActivateUser.inspectFuncActivateUser(ctx, id, passwordHash)
 	}
 
-	mm_params := UserRepositoryMockCreateUserParams{ctx, newUser}
+	mm_params := UserRepositoryMockActivateUserParams{ctx, id, passwordHash}
 
 	// Record call args
-	mmCreateUser.CreateUserMock.mutex.Lock()
-	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
-	mmCreateUser.CreateUserMock.mutex.Unlock()
+	mmActivateUser.ActivateUserMock.mutex.Lock()
+	mmActivateUser.ActivateUserMock.callArgs = append(mmActivateUser.ActivateUserMock.callArgs, &mm_params)
+	mmActivateUser.ActivateUserMock.mutex.Unlock()
 
-	for _, e := range mmCreateUser.CreateUserMock.expectations {
+	for _, e := range mmActivateUser.ActivateUserMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+	if mmActivateUser.ActivateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmActivateUser.ActivateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmActivateUser.ActivateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmActivateUser.ActivateUserMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockCreateUserParams{ctx, newUser}
+		mm_got := UserRepositoryMockActivateUserParams{ctx, id, passwordHash}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmActivateUser.t.Errorf("UserRepositoryMock.ActivateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmActivateUser.ActivateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.newUser != nil && !minimock.Equal(*mm_want_ptrs.newUser, mm_got.newUser) {
-				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter newUser, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originNewUser, *mm_want_ptrs.newUser, mm_got.newUser, minimock.Diff(*mm_want_ptrs.newUser, mm_got.newUser))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmActivateUser.t.Errorf("UserRepositoryMock.ActivateUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmActivateUser.ActivateUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.passwordHash != nil && !minimock.Equal(*mm_want_ptrs.passwordHash, mm_got.passwordHash) {
+				mmActivateUser.t.Errorf("UserRepositoryMock.ActivateUser got unexpected parameter passwordHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmActivateUser.ActivateUserMock.defaultExpectation.expectationOrigins.originPasswordHash, *mm_want_ptrs.passwordHash, mm_got.passwordHash, minimock.Diff(*mm_want_ptrs.passwordHash, mm_got.passwordHash))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmActivateUser.t.Errorf("UserRepositoryMock.ActivateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmActivateUser.ActivateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		mm_results := mmActivateUser.ActivateUserMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateUser.t.Fatal("No results are set for the UserRepositoryMock.CreateUser")
+			mmActivateUser.t.Fatal("No results are set for the UserRepositoryMock.ActivateUser")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateUser.funcCreateUser != nil {
-		return mmCreateUser.funcCreateUser(ctx, newUser)
+	if mmActivateUser.funcActivateUser != nil {
+		return mmActivateUser.funcActivateUser(ctx, id, passwordHash)
 	}
-	mmCreateUser.t.Fatalf("Unexpected call to UserRepositoryMock.CreateUser. %v %v", ctx, newUser)
+	mmActivateUser.t.Fatalf("Unexpected call to UserRepositoryMock.ActivateUser. %v %v %v", ctx, id, passwordHash)
 	return
 }
 
-// CreateUserAfterCounter returns a count of finished UserRepositoryMock.CreateUser invocations
-func (mmCreateUser *UserRepositoryMock) CreateUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+// ActivateUserAfterCounter returns a count of finished UserRepositoryMock.ActivateUser invocations
+func (mmActivateUser *UserRepositoryMock) ActivateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmActivateUser.afterActivateUserCounter)
 }
 
-// CreateUserBeforeCounter returns a count of UserRepositoryMock.CreateUser invocations
-func (mmCreateUser *UserRepositoryMock) CreateUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+// ActivateUserBeforeCounter returns a count of UserRepositoryMock.ActivateUser invocations
+func (mmActivateUser *UserRepositoryMock) ActivateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmActivateUser.beforeActivateUserCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ActivateUser.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateUser *mUserRepositoryMockCreateUser) Calls() []*UserRepositoryMockCreateUserParams {
-	mmCreateUser.mutex.RLock()
+func (mmActivateUser *mUserRepositoryMockActivateUser) Calls() []*UserRepositoryMockActivateUserParams {
+	mmActivateUser.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
-	copy(argCopy, mmCreateUser.callArgs)
+	argCopy := make([]*UserRepositoryMockActivateUserParams, len(mmActivateUser.callArgs))
+	copy(argCopy, mmActivateUser.callArgs)
 
-	mmCreateUser.mutex.RUnlock()
+	mmActivateUser.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// MinimockActivateUserDone returns true if the count of the ActivateUser invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockCreateUserDone() bool {
-	if m.CreateUserMock.optional {
+func (m *UserRepositoryMock) MinimockActivateUserDone() bool {
+	if m.ActivateUserMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateUserMock.expectations {
+	for _, e := range m.ActivateUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateUserMock.invocationsDone()
+	return m.ActivateUserMock.invocationsDone()
 }
 
-// MinimockCreateUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockCreateUserInspect() {
-	for _, e := range m.CreateUserMock.expectations {
+// MinimockActivateUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockActivateUserInspect() {
+	for _, e := range m.ActivateUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.ActivateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	afterActivateUserCounter := mm_atomic.LoadUint64(&m.afterActivateUserCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
-		if m.CreateUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+	if m.ActivateUserMock.defaultExpectation != nil && afterActivateUserCounter < 1 {
+		if m.ActivateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.ActivateUser at\n%s", m.ActivateUserMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.ActivateUser at\n%s with params: %#v", m.ActivateUserMock.defaultExpectation.expectationOrigins.origin, *m.ActivateUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	if m.funcActivateUser != nil && afterActivateUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.ActivateUser at\n%s", m.funcActivateUserOrigin)
 	}
 
-	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	if !m.ActivateUserMock.invocationsDone() && afterActivateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ActivateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ActivateUserMock.expectedInvocations), m.ActivateUserMock.expectedInvocationsOrigin, afterActivateUserCounter)
 	}
 }
 
-type mUserRepositoryMockDeleteUser struct {
+type mUserRepositoryMockCopyUsers struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockDeleteUserExpectation
-	expectations       []*UserRepositoryMockDeleteUserExpectation
+	defaultExpectation *UserRepositoryMockCopyUsersExpectation
+	expectations       []*UserRepositoryMockCopyUsersExpectation
 
-	callArgs []*UserRepositoryMockDeleteUserParams
+	callArgs []*UserRepositoryMockCopyUsersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockDeleteUserExpectation specifies expectation struct of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserExpectation struct {
+// UserRepositoryMockCopyUsersExpectation specifies expectation struct of the UserRepository.CopyUsers
+type UserRepositoryMockCopyUsersExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockDeleteUserParams
-	paramPtrs          *UserRepositoryMockDeleteUserParamPtrs
-	expectationOrigins UserRepositoryMockDeleteUserExpectationOrigins
-	results            *UserRepositoryMockDeleteUserResults
+	params             *UserRepositoryMockCopyUsersParams
+	paramPtrs          *UserRepositoryMockCopyUsersParamPtrs
+	expectationOrigins UserRepositoryMockCopyUsersExpectationOrigins
+	results            *UserRepositoryMockCopyUsersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockDeleteUserParams contains parameters of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserParams struct {
-	ctx context.Context
-	id  int64
+// UserRepositoryMockCopyUsersParams contains parameters of the UserRepository.CopyUsers
+type UserRepositoryMockCopyUsersParams struct {
+	ctx   context.Context
+	users []*model.ImportUser
 }
 
-// UserRepositoryMockDeleteUserParamPtrs contains pointers to parameters of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// UserRepositoryMockCopyUsersParamPtrs contains pointers to parameters of the UserRepository.CopyUsers
+type UserRepositoryMockCopyUsersParamPtrs struct {
+	ctx   *context.Context
+	users *[]*model.ImportUser
 }
 
-// UserRepositoryMockDeleteUserResults contains results of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserResults struct {
+// UserRepositoryMockCopyUsersResults contains results of the UserRepository.CopyUsers
+type UserRepositoryMockCopyUsersResults struct {
+	i1  int64
 	err error
 }
 
-// UserRepositoryMockDeleteUserOrigins contains origins of expectations of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// UserRepositoryMockCopyUsersOrigins contains origins of expectations of the UserRepository.CopyUsers
+type UserRepositoryMockCopyUsersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originUsers string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -479,342 +570,2399 @@ type UserRepositoryMockDeleteUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Optional() *mUserRepositoryMockDeleteUser {
-	mmDeleteUser.optional = true
-	return mmDeleteUser
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Optional() *mUserRepositoryMockCopyUsers {
+	mmCopyUsers.optional = true
+	return mmCopyUsers
 }
 
-// Expect sets up expected params for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.CopyUsers
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Expect(ctx context.Context, users []*model.ImportUser) *mUserRepositoryMockCopyUsers {
+	if mmCopyUsers.mock.funcCopyUsers != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmCopyUsers.defaultExpectation == nil {
+		mmCopyUsers.defaultExpectation = &UserRepositoryMockCopyUsersExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by ExpectParams functions")
+	if mmCopyUsers.defaultExpectation.paramPtrs != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUser.defaultExpectation.params = &UserRepositoryMockDeleteUserParams{ctx, id}
-	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUser.expectations {
-		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
-			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+	mmCopyUsers.defaultExpectation.params = &UserRepositoryMockCopyUsersParams{ctx, users}
+	mmCopyUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCopyUsers.expectations {
+		if minimock.Equal(e.params, mmCopyUsers.defaultExpectation.params) {
+			mmCopyUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCopyUsers.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUser
+	return mmCopyUsers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CopyUsers
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCopyUsers {
+	if mmCopyUsers.mock.funcCopyUsers != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmCopyUsers.defaultExpectation == nil {
+		mmCopyUsers.defaultExpectation = &UserRepositoryMockCopyUsersExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	if mmCopyUsers.defaultExpectation.params != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	if mmCopyUsers.defaultExpectation.paramPtrs == nil {
+		mmCopyUsers.defaultExpectation.paramPtrs = &UserRepositoryMockCopyUsersParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCopyUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCopyUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmCopyUsers
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectIdParam2(id int64) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectUsersParam2 sets up expected param users for UserRepository.CopyUsers
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) ExpectUsersParam2(users []*model.ImportUser) *mUserRepositoryMockCopyUsers {
+	if mmCopyUsers.mock.funcCopyUsers != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmCopyUsers.defaultExpectation == nil {
+		mmCopyUsers.defaultExpectation = &UserRepositoryMockCopyUsersExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	if mmCopyUsers.defaultExpectation.params != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	if mmCopyUsers.defaultExpectation.paramPtrs == nil {
+		mmCopyUsers.defaultExpectation.paramPtrs = &UserRepositoryMockCopyUsersParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
-	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCopyUsers.defaultExpectation.paramPtrs.users = &users
+	mmCopyUsers.defaultExpectation.expectationOrigins.originUsers = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmCopyUsers
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CopyUsers
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Inspect(f func(ctx context.Context, users []*model.ImportUser)) *mUserRepositoryMockCopyUsers {
+	if mmCopyUsers.mock.inspectFuncCopyUsers != nil {
+		mmCopyUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CopyUsers")
 	}
 
-	mmDeleteUser.mock.inspectFuncDeleteUser = f
+	mmCopyUsers.mock.inspectFuncCopyUsers = f
 
-	return mmDeleteUser
+	return mmCopyUsers
 }
 
-// Return sets up results that will be returned by UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Return(err error) *UserRepositoryMock {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.CopyUsers
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmCopyUsers.mock.funcCopyUsers != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	if mmCopyUsers.defaultExpectation == nil {
+		mmCopyUsers.defaultExpectation = &UserRepositoryMockCopyUsersExpectation{mock: mmCopyUsers.mock}
 	}
-	mmDeleteUser.defaultExpectation.results = &UserRepositoryMockDeleteUserResults{err}
-	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmCopyUsers.defaultExpectation.results = &UserRepositoryMockCopyUsersResults{i1, err}
+	mmCopyUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCopyUsers.mock
 }
 
-// Set uses given function f to mock the UserRepository.DeleteUser method
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
-	if mmDeleteUser.defaultExpectation != nil {
-		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUser method")
+// Set uses given function f to mock the UserRepository.CopyUsers method
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Set(f func(ctx context.Context, users []*model.ImportUser) (i1 int64, err error)) *UserRepositoryMock {
+	if mmCopyUsers.defaultExpectation != nil {
+		mmCopyUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.CopyUsers method")
 	}
 
-	if len(mmDeleteUser.expectations) > 0 {
-		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUser method")
+	if len(mmCopyUsers.expectations) > 0 {
+		mmCopyUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.CopyUsers method")
 	}
 
-	mmDeleteUser.mock.funcDeleteUser = f
-	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmCopyUsers.mock.funcCopyUsers = f
+	mmCopyUsers.mock.funcCopyUsersOrigin = minimock.CallerInfo(1)
+	return mmCopyUsers.mock
 }
 
-// When sets expectation for the UserRepository.DeleteUser which will trigger the result defined by the following
+// When sets expectation for the UserRepository.CopyUsers which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) When(ctx context.Context, id int64) *UserRepositoryMockDeleteUserExpectation {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) When(ctx context.Context, users []*model.ImportUser) *UserRepositoryMockCopyUsersExpectation {
+	if mmCopyUsers.mock.funcCopyUsers != nil {
+		mmCopyUsers.mock.t.Fatalf("UserRepositoryMock.CopyUsers mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockDeleteUserExpectation{
-		mock:               mmDeleteUser.mock,
-		params:             &UserRepositoryMockDeleteUserParams{ctx, id},
-		expectationOrigins: UserRepositoryMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockCopyUsersExpectation{
+		mock:               mmCopyUsers.mock,
+		params:             &UserRepositoryMockCopyUsersParams{ctx, users},
+		expectationOrigins: UserRepositoryMockCopyUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	mmCopyUsers.expectations = append(mmCopyUsers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.DeleteUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockDeleteUserExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockDeleteUserResults{err}
+// Then sets up UserRepository.CopyUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCopyUsersExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCopyUsersResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.DeleteUser should be invoked
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Times(n uint64) *mUserRepositoryMockDeleteUser {
+// Times sets number of times UserRepository.CopyUsers should be invoked
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Times(n uint64) *mUserRepositoryMockCopyUsers {
 	if n == 0 {
-		mmDeleteUser.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUser mock can not be zero")
+		mmCopyUsers.mock.t.Fatalf("Times of UserRepositoryMock.CopyUsers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
-	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser
+	mm_atomic.StoreUint64(&mmCopyUsers.expectedInvocations, n)
+	mmCopyUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCopyUsers
+}
+
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) invocationsDone() bool {
+	if len(mmCopyUsers.expectations) == 0 && mmCopyUsers.defaultExpectation == nil && mmCopyUsers.mock.funcCopyUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCopyUsers.mock.afterCopyUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCopyUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CopyUsers implements mm_repository.UserRepository
+func (mmCopyUsers *UserRepositoryMock) CopyUsers(ctx context.Context, users []*model.ImportUser) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCopyUsers.beforeCopyUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCopyUsers.afterCopyUsersCounter, 1)
+
+	mmCopyUsers.t.Helper()
+
+	if mmCopyUsers.inspectFuncCopyUsers != nil {
+		mmCopyUsers.inspectFuncCopyUsers(ctx, users)
+	}
+
+	mm_params := UserRepositoryMockCopyUsersParams{ctx, users}
+
+	// Record call args
+	mmCopyUsers.CopyUsersMock.mutex.Lock()
+	mmCopyUsers.CopyUsersMock.callArgs = append(mmCopyUsers.CopyUsersMock.callArgs, &mm_params)
+	mmCopyUsers.CopyUsersMock.mutex.Unlock()
+
+	for _, e := range mmCopyUsers.CopyUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCopyUsers.CopyUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCopyUsers.CopyUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCopyUsers.CopyUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmCopyUsers.CopyUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCopyUsersParams{ctx, users}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCopyUsers.t.Errorf("UserRepositoryMock.CopyUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopyUsers.CopyUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.users != nil && !minimock.Equal(*mm_want_ptrs.users, mm_got.users) {
+				mmCopyUsers.t.Errorf("UserRepositoryMock.CopyUsers got unexpected parameter users, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopyUsers.CopyUsersMock.defaultExpectation.expectationOrigins.originUsers, *mm_want_ptrs.users, mm_got.users, minimock.Diff(*mm_want_ptrs.users, mm_got.users))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCopyUsers.t.Errorf("UserRepositoryMock.CopyUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCopyUsers.CopyUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCopyUsers.CopyUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCopyUsers.t.Fatal("No results are set for the UserRepositoryMock.CopyUsers")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCopyUsers.funcCopyUsers != nil {
+		return mmCopyUsers.funcCopyUsers(ctx, users)
+	}
+	mmCopyUsers.t.Fatalf("Unexpected call to UserRepositoryMock.CopyUsers. %v %v", ctx, users)
+	return
+}
+
+// CopyUsersAfterCounter returns a count of finished UserRepositoryMock.CopyUsers invocations
+func (mmCopyUsers *UserRepositoryMock) CopyUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopyUsers.afterCopyUsersCounter)
+}
+
+// CopyUsersBeforeCounter returns a count of UserRepositoryMock.CopyUsers invocations
+func (mmCopyUsers *UserRepositoryMock) CopyUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopyUsers.beforeCopyUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CopyUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCopyUsers *mUserRepositoryMockCopyUsers) Calls() []*UserRepositoryMockCopyUsersParams {
+	mmCopyUsers.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCopyUsersParams, len(mmCopyUsers.callArgs))
+	copy(argCopy, mmCopyUsers.callArgs)
+
+	mmCopyUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCopyUsersDone returns true if the count of the CopyUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCopyUsersDone() bool {
+	if m.CopyUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CopyUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CopyUsersMock.invocationsDone()
+}
+
+// MinimockCopyUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCopyUsersInspect() {
+	for _, e := range m.CopyUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.CopyUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCopyUsersCounter := mm_atomic.LoadUint64(&m.afterCopyUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CopyUsersMock.defaultExpectation != nil && afterCopyUsersCounter < 1 {
+		if m.CopyUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CopyUsers at\n%s", m.CopyUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.CopyUsers at\n%s with params: %#v", m.CopyUsersMock.defaultExpectation.expectationOrigins.origin, *m.CopyUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCopyUsers != nil && afterCopyUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CopyUsers at\n%s", m.funcCopyUsersOrigin)
+	}
+
+	if !m.CopyUsersMock.invocationsDone() && afterCopyUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CopyUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CopyUsersMock.expectedInvocations), m.CopyUsersMock.expectedInvocationsOrigin, afterCopyUsersCounter)
+	}
+}
+
+type mUserRepositoryMockCreateUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockCreateUserExpectation
+	expectations       []*UserRepositoryMockCreateUserExpectation
+
+	callArgs []*UserRepositoryMockCreateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockCreateUserExpectation specifies expectation struct of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockCreateUserParams
+	paramPtrs          *UserRepositoryMockCreateUserParamPtrs
+	expectationOrigins UserRepositoryMockCreateUserExpectationOrigins
+	results            *UserRepositoryMockCreateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockCreateUserParams contains parameters of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserParams struct {
+	ctx     context.Context
+	newUser *model.NewUser
+}
+
+// UserRepositoryMockCreateUserParamPtrs contains pointers to parameters of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserParamPtrs struct {
+	ctx     *context.Context
+	newUser **model.NewUser
+}
+
+// UserRepositoryMockCreateUserResults contains results of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserResults struct {
+	i1  int64
+	err error
+}
+
+// UserRepositoryMockCreateUserOrigins contains origins of expectations of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originNewUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUser *mUserRepositoryMockCreateUser) Optional() *mUserRepositoryMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
+}
+
+// Expect sets up expected params for UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Expect(ctx context.Context, newUser *model.NewUser) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUser.defaultExpectation.params = &UserRepositoryMockCreateUserParams{ctx, newUser}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectNewUserParam2 sets up expected param newUser for UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectNewUserParam2(newUser *model.NewUser) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.newUser = &newUser
+	mmCreateUser.defaultExpectation.expectationOrigins.originNewUser = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Inspect(f func(ctx context.Context, newUser *model.NewUser)) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateUser")
+	}
+
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &UserRepositoryMockCreateUserResults{i1, err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.CreateUser method
+func (mmCreateUser *mUserRepositoryMockCreateUser) Set(f func(ctx context.Context, newUser *model.NewUser) (i1 int64, err error)) *UserRepositoryMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the UserRepository.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mUserRepositoryMockCreateUser) When(ctx context.Context, newUser *model.NewUser) *UserRepositoryMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &UserRepositoryMockCreateUserParams{ctx, newUser},
+		expectationOrigins: UserRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.CreateUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreateUserExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreateUserResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.CreateUser should be invoked
+func (mmCreateUser *mUserRepositoryMockCreateUser) Times(n uint64) *mUserRepositoryMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of UserRepositoryMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mUserRepositoryMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements mm_repository.UserRepository
+func (mmCreateUser *UserRepositoryMock) CreateUser(ctx context.Context, newUser *model.NewUser) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, newUser)
+	}
+
+	mm_params := UserRepositoryMockCreateUserParams{ctx, newUser}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCreateUserParams{ctx, newUser}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.newUser != nil && !minimock.Equal(*mm_want_ptrs.newUser, mm_got.newUser) {
+				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter newUser, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originNewUser, *mm_want_ptrs.newUser, mm_got.newUser, minimock.Diff(*mm_want_ptrs.newUser, mm_got.newUser))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the UserRepositoryMock.CreateUser")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, newUser)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to UserRepositoryMock.CreateUser. %v %v", ctx, newUser)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished UserRepositoryMock.CreateUser invocations
+func (mmCreateUser *UserRepositoryMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of UserRepositoryMock.CreateUser invocations
+func (mmCreateUser *UserRepositoryMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mUserRepositoryMockCreateUser) Calls() []*UserRepositoryMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+type mUserRepositoryMockDeleteUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeleteUserExpectation
+	expectations       []*UserRepositoryMockDeleteUserExpectation
+
+	callArgs []*UserRepositoryMockDeleteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeleteUserExpectation specifies expectation struct of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeleteUserParams
+	paramPtrs          *UserRepositoryMockDeleteUserParamPtrs
+	expectationOrigins UserRepositoryMockDeleteUserExpectationOrigins
+	results            *UserRepositoryMockDeleteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeleteUserParams contains parameters of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockDeleteUserParamPtrs contains pointers to parameters of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockDeleteUserResults contains results of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserResults struct {
+	err error
+}
+
+// UserRepositoryMockDeleteUserOrigins contains origins of expectations of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Optional() *mUserRepositoryMockDeleteUser {
+	mmDeleteUser.optional = true
+	return mmDeleteUser
+}
+
+// Expect sets up expected params for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUser.defaultExpectation.params = &UserRepositoryMockDeleteUserParams{ctx, id}
+	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUser.expectations {
+		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
+			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectIdParam2(id int64) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
+	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUser")
+	}
+
+	mmDeleteUser.mock.inspectFuncDeleteUser = f
+
+	return mmDeleteUser
+}
+
+// Return sets up results that will be returned by UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Return(err error) *UserRepositoryMock {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	}
+	mmDeleteUser.defaultExpectation.results = &UserRepositoryMockDeleteUserResults{err}
+	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.DeleteUser method
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmDeleteUser.defaultExpectation != nil {
+		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUser method")
+	}
+
+	if len(mmDeleteUser.expectations) > 0 {
+		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUser method")
+	}
+
+	mmDeleteUser.mock.funcDeleteUser = f
+	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// When sets expectation for the UserRepository.DeleteUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) When(ctx context.Context, id int64) *UserRepositoryMockDeleteUserExpectation {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeleteUserExpectation{
+		mock:               mmDeleteUser.mock,
+		params:             &UserRepositoryMockDeleteUserParams{ctx, id},
+		expectationOrigins: UserRepositoryMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DeleteUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeleteUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeleteUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DeleteUser should be invoked
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Times(n uint64) *mUserRepositoryMockDeleteUser {
+	if n == 0 {
+		mmDeleteUser.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
+	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser
+}
+
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) invocationsDone() bool {
+	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUser implements mm_repository.UserRepository
+func (mmDeleteUser *UserRepositoryMock) DeleteUser(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+
+	mmDeleteUser.t.Helper()
+
+	if mmDeleteUser.inspectFuncDeleteUser != nil {
+		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockDeleteUserParams{ctx, id}
+
+	// Record call args
+	mmDeleteUser.DeleteUserMock.mutex.Lock()
+	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
+	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeleteUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUser.t.Fatal("No results are set for the UserRepositoryMock.DeleteUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUser.funcDeleteUser != nil {
+		return mmDeleteUser.funcDeleteUser(ctx, id)
+	}
+	mmDeleteUser.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUser. %v %v", ctx, id)
+	return
+}
+
+// DeleteUserAfterCounter returns a count of finished UserRepositoryMock.DeleteUser invocations
+func (mmDeleteUser *UserRepositoryMock) DeleteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+}
+
+// DeleteUserBeforeCounter returns a count of UserRepositoryMock.DeleteUser invocations
+func (mmDeleteUser *UserRepositoryMock) DeleteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Calls() []*UserRepositoryMockDeleteUserParams {
+	mmDeleteUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeleteUserParams, len(mmDeleteUser.callArgs))
+	copy(argCopy, mmDeleteUser.callArgs)
+
+	mmDeleteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeleteUserDone() bool {
+	if m.DeleteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserMock.invocationsDone()
+}
+
+// MinimockDeleteUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeleteUserInspect() {
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
+		if m.DeleteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	}
+
+	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	}
+}
+
+type mUserRepositoryMockFindUserNames struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockFindUserNamesExpectation
+	expectations       []*UserRepositoryMockFindUserNamesExpectation
+
+	callArgs []*UserRepositoryMockFindUserNamesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockFindUserNamesExpectation specifies expectation struct of the UserRepository.FindUserNames
+type UserRepositoryMockFindUserNamesExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockFindUserNamesParams
+	paramPtrs          *UserRepositoryMockFindUserNamesParamPtrs
+	expectationOrigins UserRepositoryMockFindUserNamesExpectationOrigins
+	results            *UserRepositoryMockFindUserNamesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockFindUserNamesParams contains parameters of the UserRepository.FindUserNames
+type UserRepositoryMockFindUserNamesParams struct {
+	ctx   context.Context
+	names []string
+}
+
+// UserRepositoryMockFindUserNamesParamPtrs contains pointers to parameters of the UserRepository.FindUserNames
+type UserRepositoryMockFindUserNamesParamPtrs struct {
+	ctx   *context.Context
+	names *[]string
+}
+
+// UserRepositoryMockFindUserNamesResults contains results of the UserRepository.FindUserNames
+type UserRepositoryMockFindUserNamesResults struct {
+	sa1 []string
+	err error
+}
+
+// UserRepositoryMockFindUserNamesOrigins contains origins of expectations of the UserRepository.FindUserNames
+type UserRepositoryMockFindUserNamesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originNames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Optional() *mUserRepositoryMockFindUserNames {
+	mmFindUserNames.optional = true
+	return mmFindUserNames
+}
+
+// Expect sets up expected params for UserRepository.FindUserNames
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Expect(ctx context.Context, names []string) *mUserRepositoryMockFindUserNames {
+	if mmFindUserNames.mock.funcFindUserNames != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Set")
+	}
+
+	if mmFindUserNames.defaultExpectation == nil {
+		mmFindUserNames.defaultExpectation = &UserRepositoryMockFindUserNamesExpectation{}
+	}
+
+	if mmFindUserNames.defaultExpectation.paramPtrs != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by ExpectParams functions")
+	}
+
+	mmFindUserNames.defaultExpectation.params = &UserRepositoryMockFindUserNamesParams{ctx, names}
+	mmFindUserNames.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindUserNames.expectations {
+		if minimock.Equal(e.params, mmFindUserNames.defaultExpectation.params) {
+			mmFindUserNames.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindUserNames.defaultExpectation.params)
+		}
+	}
+
+	return mmFindUserNames
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindUserNames
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindUserNames {
+	if mmFindUserNames.mock.funcFindUserNames != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Set")
+	}
+
+	if mmFindUserNames.defaultExpectation == nil {
+		mmFindUserNames.defaultExpectation = &UserRepositoryMockFindUserNamesExpectation{}
+	}
+
+	if mmFindUserNames.defaultExpectation.params != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Expect")
+	}
+
+	if mmFindUserNames.defaultExpectation.paramPtrs == nil {
+		mmFindUserNames.defaultExpectation.paramPtrs = &UserRepositoryMockFindUserNamesParamPtrs{}
+	}
+	mmFindUserNames.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindUserNames.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindUserNames
+}
+
+// ExpectNamesParam2 sets up expected param names for UserRepository.FindUserNames
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) ExpectNamesParam2(names []string) *mUserRepositoryMockFindUserNames {
+	if mmFindUserNames.mock.funcFindUserNames != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Set")
+	}
+
+	if mmFindUserNames.defaultExpectation == nil {
+		mmFindUserNames.defaultExpectation = &UserRepositoryMockFindUserNamesExpectation{}
+	}
+
+	if mmFindUserNames.defaultExpectation.params != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Expect")
+	}
+
+	if mmFindUserNames.defaultExpectation.paramPtrs == nil {
+		mmFindUserNames.defaultExpectation.paramPtrs = &UserRepositoryMockFindUserNamesParamPtrs{}
+	}
+	mmFindUserNames.defaultExpectation.paramPtrs.names = &names
+	mmFindUserNames.defaultExpectation.expectationOrigins.originNames = minimock.CallerInfo(1)
+
+	return mmFindUserNames
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.FindUserNames
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Inspect(f func(ctx context.Context, names []string)) *mUserRepositoryMockFindUserNames {
+	if mmFindUserNames.mock.inspectFuncFindUserNames != nil {
+		mmFindUserNames.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindUserNames")
+	}
+
+	mmFindUserNames.mock.inspectFuncFindUserNames = f
+
+	return mmFindUserNames
+}
+
+// Return sets up results that will be returned by UserRepository.FindUserNames
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Return(sa1 []string, err error) *UserRepositoryMock {
+	if mmFindUserNames.mock.funcFindUserNames != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Set")
+	}
+
+	if mmFindUserNames.defaultExpectation == nil {
+		mmFindUserNames.defaultExpectation = &UserRepositoryMockFindUserNamesExpectation{mock: mmFindUserNames.mock}
+	}
+	mmFindUserNames.defaultExpectation.results = &UserRepositoryMockFindUserNamesResults{sa1, err}
+	mmFindUserNames.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindUserNames.mock
+}
+
+// Set uses given function f to mock the UserRepository.FindUserNames method
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Set(f func(ctx context.Context, names []string) (sa1 []string, err error)) *UserRepositoryMock {
+	if mmFindUserNames.defaultExpectation != nil {
+		mmFindUserNames.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindUserNames method")
+	}
+
+	if len(mmFindUserNames.expectations) > 0 {
+		mmFindUserNames.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindUserNames method")
+	}
+
+	mmFindUserNames.mock.funcFindUserNames = f
+	mmFindUserNames.mock.funcFindUserNamesOrigin = minimock.CallerInfo(1)
+	return mmFindUserNames.mock
+}
+
+// When sets expectation for the UserRepository.FindUserNames which will trigger the result defined by the following
+// Then helper
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) When(ctx context.Context, names []string) *UserRepositoryMockFindUserNamesExpectation {
+	if mmFindUserNames.mock.funcFindUserNames != nil {
+		mmFindUserNames.mock.t.Fatalf("UserRepositoryMock.FindUserNames mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockFindUserNamesExpectation{
+		mock:               mmFindUserNames.mock,
+		params:             &UserRepositoryMockFindUserNamesParams{ctx, names},
+		expectationOrigins: UserRepositoryMockFindUserNamesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindUserNames.expectations = append(mmFindUserNames.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.FindUserNames return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockFindUserNamesExpectation) Then(sa1 []string, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockFindUserNamesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.FindUserNames should be invoked
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Times(n uint64) *mUserRepositoryMockFindUserNames {
+	if n == 0 {
+		mmFindUserNames.mock.t.Fatalf("Times of UserRepositoryMock.FindUserNames mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindUserNames.expectedInvocations, n)
+	mmFindUserNames.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindUserNames
+}
+
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) invocationsDone() bool {
+	if len(mmFindUserNames.expectations) == 0 && mmFindUserNames.defaultExpectation == nil && mmFindUserNames.mock.funcFindUserNames == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindUserNames.mock.afterFindUserNamesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindUserNames.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindUserNames implements mm_repository.UserRepository
+func (mmFindUserNames *UserRepositoryMock) FindUserNames(ctx context.Context, names []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmFindUserNames.beforeFindUserNamesCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindUserNames.afterFindUserNamesCounter, 1)
+
+	mmFindUserNames.t.Helper()
+
+	if mmFindUserNames.inspectFuncFindUserNames != nil {
+		mmFindUserNames.inspectFuncFindUserNames(ctx, names)
+	}
+
+	mm_params := UserRepositoryMockFindUserNamesParams{ctx, names}
+
+	// Record call args
+	mmFindUserNames.FindUserNamesMock.mutex.Lock()
+	mmFindUserNames.FindUserNamesMock.callArgs = append(mmFindUserNames.FindUserNamesMock.callArgs, &mm_params)
+	mmFindUserNames.FindUserNamesMock.mutex.Unlock()
+
+	for _, e := range mmFindUserNames.FindUserNamesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmFindUserNames.FindUserNamesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindUserNames.FindUserNamesMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindUserNames.FindUserNamesMock.defaultExpectation.params
+		mm_want_ptrs := mmFindUserNames.FindUserNamesMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockFindUserNamesParams{ctx, names}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindUserNames.t.Errorf("UserRepositoryMock.FindUserNames got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindUserNames.FindUserNamesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.names != nil && !minimock.Equal(*mm_want_ptrs.names, mm_got.names) {
+				mmFindUserNames.t.Errorf("UserRepositoryMock.FindUserNames got unexpected parameter names, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindUserNames.FindUserNamesMock.defaultExpectation.expectationOrigins.originNames, *mm_want_ptrs.names, mm_got.names, minimock.Diff(*mm_want_ptrs.names, mm_got.names))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindUserNames.t.Errorf("UserRepositoryMock.FindUserNames got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindUserNames.FindUserNamesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindUserNames.FindUserNamesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindUserNames.t.Fatal("No results are set for the UserRepositoryMock.FindUserNames")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmFindUserNames.funcFindUserNames != nil {
+		return mmFindUserNames.funcFindUserNames(ctx, names)
+	}
+	mmFindUserNames.t.Fatalf("Unexpected call to UserRepositoryMock.FindUserNames. %v %v", ctx, names)
+	return
+}
+
+// FindUserNamesAfterCounter returns a count of finished UserRepositoryMock.FindUserNames invocations
+func (mmFindUserNames *UserRepositoryMock) FindUserNamesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindUserNames.afterFindUserNamesCounter)
+}
+
+// FindUserNamesBeforeCounter returns a count of UserRepositoryMock.FindUserNames invocations
+func (mmFindUserNames *UserRepositoryMock) FindUserNamesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindUserNames.beforeFindUserNamesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.FindUserNames.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindUserNames *mUserRepositoryMockFindUserNames) Calls() []*UserRepositoryMockFindUserNamesParams {
+	mmFindUserNames.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockFindUserNamesParams, len(mmFindUserNames.callArgs))
+	copy(argCopy, mmFindUserNames.callArgs)
+
+	mmFindUserNames.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindUserNamesDone returns true if the count of the FindUserNames invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockFindUserNamesDone() bool {
+	if m.FindUserNamesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindUserNamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindUserNamesMock.invocationsDone()
+}
+
+// MinimockFindUserNamesInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockFindUserNamesInspect() {
+	for _, e := range m.FindUserNamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindUserNames at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindUserNamesCounter := mm_atomic.LoadUint64(&m.afterFindUserNamesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindUserNamesMock.defaultExpectation != nil && afterFindUserNamesCounter < 1 {
+		if m.FindUserNamesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindUserNames at\n%s", m.FindUserNamesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindUserNames at\n%s with params: %#v", m.FindUserNamesMock.defaultExpectation.expectationOrigins.origin, *m.FindUserNamesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindUserNames != nil && afterFindUserNamesCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.FindUserNames at\n%s", m.funcFindUserNamesOrigin)
+	}
+
+	if !m.FindUserNamesMock.invocationsDone() && afterFindUserNamesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindUserNames at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindUserNamesMock.expectedInvocations), m.FindUserNamesMock.expectedInvocationsOrigin, afterFindUserNamesCounter)
+	}
+}
+
+type mUserRepositoryMockGetUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserExpectation
+	expectations       []*UserRepositoryMockGetUserExpectation
+
+	callArgs []*UserRepositoryMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetUserExpectation specifies expectation struct of the UserRepository.GetUser
+type UserRepositoryMockGetUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetUserParams
+	paramPtrs          *UserRepositoryMockGetUserParamPtrs
+	expectationOrigins UserRepositoryMockGetUserExpectationOrigins
+	results            *UserRepositoryMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetUserParams contains parameters of the UserRepository.GetUser
+type UserRepositoryMockGetUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockGetUserParamPtrs contains pointers to parameters of the UserRepository.GetUser
+type UserRepositoryMockGetUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockGetUserResults contains results of the UserRepository.GetUser
+type UserRepositoryMockGetUserResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockGetUserOrigins contains origins of expectations of the UserRepository.GetUser
+type UserRepositoryMockGetUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mUserRepositoryMockGetUser) Optional() *mUserRepositoryMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &UserRepositoryMockGetUserParams{ctx, id}
+	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) ExpectIdParam2(id int64) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.id = &id
+	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &UserRepositoryMockGetUserResults{up1, err}
+	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUser method
+func (mmGetUser *mUserRepositoryMockGetUser) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// When sets expectation for the UserRepository.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mUserRepositoryMockGetUser) When(ctx context.Context, id int64) *UserRepositoryMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserExpectation{
+		mock:               mmGetUser.mock,
+		params:             &UserRepositoryMockGetUserParams{ctx, id},
+		expectationOrigins: UserRepositoryMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUser should be invoked
+func (mmGetUser *mUserRepositoryMockGetUser) Times(n uint64) *mUserRepositoryMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of UserRepositoryMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUser
+}
+
+func (mmGetUser *mUserRepositoryMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements mm_repository.UserRepository
+func (mmGetUser *UserRepositoryMock) GetUser(ctx context.Context, id int64) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	mmGetUser.t.Helper()
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockGetUserParams{ctx, id}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the UserRepositoryMock.GetUser")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, id)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to UserRepositoryMock.GetUser. %v %v", ctx, id)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished UserRepositoryMock.GetUser invocations
+func (mmGetUser *UserRepositoryMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of UserRepositoryMock.GetUser invocations
+func (mmGetUser *UserRepositoryMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mUserRepositoryMockGetUser) Calls() []*UserRepositoryMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.funcGetUserOrigin)
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	}
+}
+
+type mUserRepositoryMockGetUserByEmail struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserByEmailExpectation
+	expectations       []*UserRepositoryMockGetUserByEmailExpectation
+
+	callArgs []*UserRepositoryMockGetUserByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetUserByEmailExpectation specifies expectation struct of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetUserByEmailParams
+	paramPtrs          *UserRepositoryMockGetUserByEmailParamPtrs
+	expectationOrigins UserRepositoryMockGetUserByEmailExpectationOrigins
+	results            *UserRepositoryMockGetUserByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetUserByEmailParams contains parameters of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserRepositoryMockGetUserByEmailParamPtrs contains pointers to parameters of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserRepositoryMockGetUserByEmailResults contains results of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockGetUserByEmailOrigins contains origins of expectations of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Optional() *mUserRepositoryMockGetUserByEmail {
+	mmGetUserByEmail.optional = true
+	return mmGetUserByEmail
+}
+
+// Expect sets up expected params for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByEmail.defaultExpectation.params = &UserRepositoryMockGetUserByEmailParams{ctx, email}
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByEmail.expectations {
+		if minimock.Equal(e.params, mmGetUserByEmail.defaultExpectation.params) {
+			mmGetUserByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.email = &email
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUserByEmail")
+	}
+
+	mmGetUserByEmail.mock.inspectFuncGetUserByEmail = f
+
+	return mmGetUserByEmail
+}
+
+// Return sets up results that will be returned by UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{mock: mmGetUserByEmail.mock}
+	}
+	mmGetUserByEmail.defaultExpectation.results = &UserRepositoryMockGetUserByEmailResults{up1, err}
+	mmGetUserByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUserByEmail method
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Set(f func(ctx context.Context, email string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetUserByEmail.defaultExpectation != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUserByEmail method")
+	}
+
+	if len(mmGetUserByEmail.expectations) > 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUserByEmail method")
+	}
+
+	mmGetUserByEmail.mock.funcGetUserByEmail = f
+	mmGetUserByEmail.mock.funcGetUserByEmailOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// When sets expectation for the UserRepository.GetUserByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) When(ctx context.Context, email string) *UserRepositoryMockGetUserByEmailExpectation {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserByEmailExpectation{
+		mock:               mmGetUserByEmail.mock,
+		params:             &UserRepositoryMockGetUserByEmailParams{ctx, email},
+		expectationOrigins: UserRepositoryMockGetUserByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByEmail.expectations = append(mmGetUserByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUserByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserByEmailExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUserByEmail should be invoked
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Times(n uint64) *mUserRepositoryMockGetUserByEmail {
+	if n == 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Times of UserRepositoryMock.GetUserByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByEmail.expectedInvocations, n)
+	mmGetUserByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail
+}
+
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) invocationsDone() bool {
+	if len(mmGetUserByEmail.expectations) == 0 && mmGetUserByEmail.defaultExpectation == nil && mmGetUserByEmail.mock.funcGetUserByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.mock.afterGetUserByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByEmail implements mm_repository.UserRepository
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmail(ctx context.Context, email string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByEmail.afterGetUserByEmailCounter, 1)
+
+	mmGetUserByEmail.t.Helper()
+
+	if mmGetUserByEmail.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.inspectFuncGetUserByEmail(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockGetUserByEmailParams{ctx, email}
+
+	// Record call args
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Lock()
+	mmGetUserByEmail.GetUserByEmailMock.callArgs = append(mmGetUserByEmail.GetUserByEmailMock.callArgs, &mm_params)
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByEmail.GetUserByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserByEmail.GetUserByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByEmail.t.Fatal("No results are set for the UserRepositoryMock.GetUserByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserByEmail.funcGetUserByEmail != nil {
+		return mmGetUserByEmail.funcGetUserByEmail(ctx, email)
+	}
+	mmGetUserByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.GetUserByEmail. %v %v", ctx, email)
+	return
+}
+
+// GetUserByEmailAfterCounter returns a count of finished UserRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.afterGetUserByEmailCounter)
+}
+
+// GetUserByEmailBeforeCounter returns a count of UserRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUserByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Calls() []*UserRepositoryMockGetUserByEmailParams {
+	mmGetUserByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserByEmailParams, len(mmGetUserByEmail.callArgs))
+	copy(argCopy, mmGetUserByEmail.callArgs)
+
+	mmGetUserByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByEmailDone returns true if the count of the GetUserByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserByEmailDone() bool {
+	if m.GetUserByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByEmailMock.invocationsDone()
+}
+
+// MinimockGetUserByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserByEmailInspect() {
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByEmailMock.defaultExpectation != nil && afterGetUserByEmailCounter < 1 {
+		if m.GetUserByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s", m.GetUserByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s with params: %#v", m.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByEmail != nil && afterGetUserByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s", m.funcGetUserByEmailOrigin)
+	}
+
+	if !m.GetUserByEmailMock.invocationsDone() && afterGetUserByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUserByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByEmailMock.expectedInvocations), m.GetUserByEmailMock.expectedInvocationsOrigin, afterGetUserByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockGetUserByName struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserByNameExpectation
+	expectations       []*UserRepositoryMockGetUserByNameExpectation
+
+	callArgs []*UserRepositoryMockGetUserByNameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetUserByNameExpectation specifies expectation struct of the UserRepository.GetUserByName
+type UserRepositoryMockGetUserByNameExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetUserByNameParams
+	paramPtrs          *UserRepositoryMockGetUserByNameParamPtrs
+	expectationOrigins UserRepositoryMockGetUserByNameExpectationOrigins
+	results            *UserRepositoryMockGetUserByNameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetUserByNameParams contains parameters of the UserRepository.GetUserByName
+type UserRepositoryMockGetUserByNameParams struct {
+	ctx      context.Context
+	username string
+}
+
+// UserRepositoryMockGetUserByNameParamPtrs contains pointers to parameters of the UserRepository.GetUserByName
+type UserRepositoryMockGetUserByNameParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// UserRepositoryMockGetUserByNameResults contains results of the UserRepository.GetUserByName
+type UserRepositoryMockGetUserByNameResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockGetUserByNameOrigins contains origins of expectations of the UserRepository.GetUserByName
+type UserRepositoryMockGetUserByNameExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Optional() *mUserRepositoryMockGetUserByName {
+	mmGetUserByName.optional = true
+	return mmGetUserByName
+}
+
+// Expect sets up expected params for UserRepository.GetUserByName
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Expect(ctx context.Context, username string) *mUserRepositoryMockGetUserByName {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{}
+	}
+
+	if mmGetUserByName.defaultExpectation.paramPtrs != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByName.defaultExpectation.params = &UserRepositoryMockGetUserByNameParams{ctx, username}
+	mmGetUserByName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByName.expectations {
+		if minimock.Equal(e.params, mmGetUserByName.defaultExpectation.params) {
+			mmGetUserByName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByName.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByName
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUserByName
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUserByName {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{}
+	}
+
+	if mmGetUserByName.defaultExpectation.params != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Expect")
+	}
+
+	if mmGetUserByName.defaultExpectation.paramPtrs == nil {
+		mmGetUserByName.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByNameParamPtrs{}
+	}
+	mmGetUserByName.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByName
+}
+
+// ExpectUsernameParam2 sets up expected param username for UserRepository.GetUserByName
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) ExpectUsernameParam2(username string) *mUserRepositoryMockGetUserByName {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{}
+	}
+
+	if mmGetUserByName.defaultExpectation.params != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Expect")
+	}
+
+	if mmGetUserByName.defaultExpectation.paramPtrs == nil {
+		mmGetUserByName.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByNameParamPtrs{}
+	}
+	mmGetUserByName.defaultExpectation.paramPtrs.username = &username
+	mmGetUserByName.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserByName
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUserByName
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Inspect(f func(ctx context.Context, username string)) *mUserRepositoryMockGetUserByName {
+	if mmGetUserByName.mock.inspectFuncGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUserByName")
+	}
+
+	mmGetUserByName.mock.inspectFuncGetUserByName = f
+
+	return mmGetUserByName
+}
+
+// Return sets up results that will be returned by UserRepository.GetUserByName
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{mock: mmGetUserByName.mock}
+	}
+	mmGetUserByName.defaultExpectation.results = &UserRepositoryMockGetUserByNameResults{up1, err}
+	mmGetUserByName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByName.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUserByName method
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Set(f func(ctx context.Context, username string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetUserByName.defaultExpectation != nil {
+		mmGetUserByName.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUserByName method")
+	}
+
+	if len(mmGetUserByName.expectations) > 0 {
+		mmGetUserByName.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUserByName method")
+	}
+
+	mmGetUserByName.mock.funcGetUserByName = f
+	mmGetUserByName.mock.funcGetUserByNameOrigin = minimock.CallerInfo(1)
+	return mmGetUserByName.mock
+}
+
+// When sets expectation for the UserRepository.GetUserByName which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) When(ctx context.Context, username string) *UserRepositoryMockGetUserByNameExpectation {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserByNameExpectation{
+		mock:               mmGetUserByName.mock,
+		params:             &UserRepositoryMockGetUserByNameParams{ctx, username},
+		expectationOrigins: UserRepositoryMockGetUserByNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByName.expectations = append(mmGetUserByName.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUserByName return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserByNameExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserByNameResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUserByName should be invoked
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Times(n uint64) *mUserRepositoryMockGetUserByName {
+	if n == 0 {
+		mmGetUserByName.mock.t.Fatalf("Times of UserRepositoryMock.GetUserByName mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByName.expectedInvocations, n)
+	mmGetUserByName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByName
 }
 
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) invocationsDone() bool {
-	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) invocationsDone() bool {
+	if len(mmGetUserByName.expectations) == 0 && mmGetUserByName.defaultExpectation == nil && mmGetUserByName.mock.funcGetUserByName == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByName.mock.afterGetUserByNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByName.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUser implements mm_repository.UserRepository
-func (mmDeleteUser *UserRepositoryMock) DeleteUser(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+// GetUserByName implements mm_repository.UserRepository
+func (mmGetUserByName *UserRepositoryMock) GetUserByName(ctx context.Context, username string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByName.beforeGetUserByNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByName.afterGetUserByNameCounter, 1)
 
-	mmDeleteUser.t.Helper()
+	mmGetUserByName.t.Helper()
 
-	if mmDeleteUser.inspectFuncDeleteUser != nil {
-		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	if mmGetUserByName.inspectFuncGetUserByName != nil {
+		mmGetUserByName.inspectFuncGetUserByName(ctx, username)
 	}
 
-	mm_params := UserRepositoryMockDeleteUserParams{ctx, id}
+	mm_params := UserRepositoryMockGetUserByNameParams{ctx, username}
 
 	// Record call args
-	mmDeleteUser.DeleteUserMock.mutex.Lock()
-	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
-	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+	mmGetUserByName.GetUserByNameMock.mutex.Lock()
+	mmGetUserByName.GetUserByNameMock.callArgs = append(mmGetUserByName.GetUserByNameMock.callArgs, &mm_params)
+	mmGetUserByName.GetUserByNameMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+	for _, e := range mmGetUserByName.GetUserByNameMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.up1, e.results.err
 		}
 	}
 
-	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+	if mmGetUserByName.GetUserByNameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByName.GetUserByNameMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByName.GetUserByNameMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByName.GetUserByNameMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockDeleteUserParams{ctx, id}
+		mm_got := UserRepositoryMockGetUserByNameParams{ctx, username}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetUserByName.t.Errorf("UserRepositoryMock.GetUserByName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserByName.t.Errorf("UserRepositoryMock.GetUserByName got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetUserByName.t.Errorf("UserRepositoryMock.GetUserByName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		mm_results := mmGetUserByName.GetUserByNameMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUser.t.Fatal("No results are set for the UserRepositoryMock.DeleteUser")
+			mmGetUserByName.t.Fatal("No results are set for the UserRepositoryMock.GetUserByName")
 		}
-		return (*mm_results).err
+		return (*mm_results).up1, (*mm_results).err
 	}
-	if mmDeleteUser.funcDeleteUser != nil {
-		return mmDeleteUser.funcDeleteUser(ctx, id)
+	if mmGetUserByName.funcGetUserByName != nil {
+		return mmGetUserByName.funcGetUserByName(ctx, username)
 	}
-	mmDeleteUser.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUser. %v %v", ctx, id)
+	mmGetUserByName.t.Fatalf("Unexpected call to UserRepositoryMock.GetUserByName. %v %v", ctx, username)
 	return
 }
 
-// DeleteUserAfterCounter returns a count of finished UserRepositoryMock.DeleteUser invocations
-func (mmDeleteUser *UserRepositoryMock) DeleteUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+// GetUserByNameAfterCounter returns a count of finished UserRepositoryMock.GetUserByName invocations
+func (mmGetUserByName *UserRepositoryMock) GetUserByNameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByName.afterGetUserByNameCounter)
 }
 
-// DeleteUserBeforeCounter returns a count of UserRepositoryMock.DeleteUser invocations
-func (mmDeleteUser *UserRepositoryMock) DeleteUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+// GetUserByNameBeforeCounter returns a count of UserRepositoryMock.GetUserByName invocations
+func (mmGetUserByName *UserRepositoryMock) GetUserByNameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByName.beforeGetUserByNameCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUserByName.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Calls() []*UserRepositoryMockDeleteUserParams {
-	mmDeleteUser.mutex.RLock()
+func (mmGetUserByName *mUserRepositoryMockGetUserByName) Calls() []*UserRepositoryMockGetUserByNameParams {
+	mmGetUserByName.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockDeleteUserParams, len(mmDeleteUser.callArgs))
-	copy(argCopy, mmDeleteUser.callArgs)
+	argCopy := make([]*UserRepositoryMockGetUserByNameParams, len(mmGetUserByName.callArgs))
+	copy(argCopy, mmGetUserByName.callArgs)
 
-	mmDeleteUser.mutex.RUnlock()
+	mmGetUserByName.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// MinimockGetUserByNameDone returns true if the count of the GetUserByName invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockDeleteUserDone() bool {
-	if m.DeleteUserMock.optional {
+func (m *UserRepositoryMock) MinimockGetUserByNameDone() bool {
+	if m.GetUserByNameMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserMock.expectations {
+	for _, e := range m.GetUserByNameMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserMock.invocationsDone()
+	return m.GetUserByNameMock.invocationsDone()
 }
 
-// MinimockDeleteUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockDeleteUserInspect() {
-	for _, e := range m.DeleteUserMock.expectations {
+// MinimockGetUserByNameInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserByNameInspect() {
+	for _, e := range m.GetUserByNameMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	afterGetUserByNameCounter := mm_atomic.LoadUint64(&m.afterGetUserByNameCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
-		if m.DeleteUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+	if m.GetUserByNameMock.defaultExpectation != nil && afterGetUserByNameCounter < 1 {
+		if m.GetUserByNameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s", m.GetUserByNameMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s with params: %#v", m.GetUserByNameMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByNameMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	if m.funcGetUserByName != nil && afterGetUserByNameCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s", m.funcGetUserByNameOrigin)
 	}
 
-	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	if !m.GetUserByNameMock.invocationsDone() && afterGetUserByNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUserByName at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByNameMock.expectedInvocations), m.GetUserByNameMock.expectedInvocationsOrigin, afterGetUserByNameCounter)
 	}
 }
 
-type mUserRepositoryMockGetUser struct {
+type mUserRepositoryMockGetUsersByIDs struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockGetUserExpectation
-	expectations       []*UserRepositoryMockGetUserExpectation
+	defaultExpectation *UserRepositoryMockGetUsersByIDsExpectation
+	expectations       []*UserRepositoryMockGetUsersByIDsExpectation
 
-	callArgs []*UserRepositoryMockGetUserParams
+	callArgs []*UserRepositoryMockGetUsersByIDsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockGetUserExpectation specifies expectation struct of the UserRepository.GetUser
-type UserRepositoryMockGetUserExpectation struct {
+// UserRepositoryMockGetUsersByIDsExpectation specifies expectation struct of the UserRepository.GetUsersByIDs
+type UserRepositoryMockGetUsersByIDsExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockGetUserParams
-	paramPtrs          *UserRepositoryMockGetUserParamPtrs
-	expectationOrigins UserRepositoryMockGetUserExpectationOrigins
-	results            *UserRepositoryMockGetUserResults
+	params             *UserRepositoryMockGetUsersByIDsParams
+	paramPtrs          *UserRepositoryMockGetUsersByIDsParamPtrs
+	expectationOrigins UserRepositoryMockGetUsersByIDsExpectationOrigins
+	results            *UserRepositoryMockGetUsersByIDsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockGetUserParams contains parameters of the UserRepository.GetUser
-type UserRepositoryMockGetUserParams struct {
+// UserRepositoryMockGetUsersByIDsParams contains parameters of the UserRepository.GetUsersByIDs
+type UserRepositoryMockGetUsersByIDsParams struct {
 	ctx context.Context
-	id  int64
+	ids []int64
 }
 
-// UserRepositoryMockGetUserParamPtrs contains pointers to parameters of the UserRepository.GetUser
-type UserRepositoryMockGetUserParamPtrs struct {
+// UserRepositoryMockGetUsersByIDsParamPtrs contains pointers to parameters of the UserRepository.GetUsersByIDs
+type UserRepositoryMockGetUsersByIDsParamPtrs struct {
 	ctx *context.Context
-	id  *int64
+	ids *[]int64
 }
 
-// UserRepositoryMockGetUserResults contains results of the UserRepository.GetUser
-type UserRepositoryMockGetUserResults struct {
-	up1 *model.User
-	err error
+// UserRepositoryMockGetUsersByIDsResults contains results of the UserRepository.GetUsersByIDs
+type UserRepositoryMockGetUsersByIDsResults struct {
+	upa1 []*model.User
+	err  error
 }
 
-// UserRepositoryMockGetUserOrigins contains origins of expectations of the UserRepository.GetUser
-type UserRepositoryMockGetUserExpectationOrigins struct {
+// UserRepositoryMockGetUsersByIDsOrigins contains origins of expectations of the UserRepository.GetUsersByIDs
+type UserRepositoryMockGetUsersByIDsExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originId  string
+	originIds string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -822,342 +2970,345 @@ type UserRepositoryMockGetUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUser *mUserRepositoryMockGetUser) Optional() *mUserRepositoryMockGetUser {
-	mmGetUser.optional = true
-	return mmGetUser
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Optional() *mUserRepositoryMockGetUsersByIDs {
+	mmGetUsersByIDs.optional = true
+	return mmGetUsersByIDs
 }
 
-// Expect sets up expected params for UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.GetUsersByIDs
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Expect(ctx context.Context, ids []int64) *mUserRepositoryMockGetUsersByIDs {
+	if mmGetUsersByIDs.mock.funcGetUsersByIDs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	if mmGetUsersByIDs.defaultExpectation == nil {
+		mmGetUsersByIDs.defaultExpectation = &UserRepositoryMockGetUsersByIDsExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by ExpectParams functions")
+	if mmGetUsersByIDs.defaultExpectation.paramPtrs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by ExpectParams functions")
 	}
 
-	mmGetUser.defaultExpectation.params = &UserRepositoryMockGetUserParams{ctx, id}
-	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUser.expectations {
-		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
-			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+	mmGetUsersByIDs.defaultExpectation.params = &UserRepositoryMockGetUsersByIDsParams{ctx, ids}
+	mmGetUsersByIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUsersByIDs.expectations {
+		if minimock.Equal(e.params, mmGetUsersByIDs.defaultExpectation.params) {
+			mmGetUsersByIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUsersByIDs.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUser
+	return mmGetUsersByIDs
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUsersByIDs
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUsersByIDs {
+	if mmGetUsersByIDs.mock.funcGetUsersByIDs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	if mmGetUsersByIDs.defaultExpectation == nil {
+		mmGetUsersByIDs.defaultExpectation = &UserRepositoryMockGetUsersByIDsExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	if mmGetUsersByIDs.defaultExpectation.params != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	if mmGetUsersByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetUsersByIDs.defaultExpectation.paramPtrs = &UserRepositoryMockGetUsersByIDsParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetUsersByIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUsersByIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmGetUsersByIDs
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) ExpectIdParam2(id int64) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// ExpectIdsParam2 sets up expected param ids for UserRepository.GetUsersByIDs
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) ExpectIdsParam2(ids []int64) *mUserRepositoryMockGetUsersByIDs {
+	if mmGetUsersByIDs.mock.funcGetUsersByIDs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	if mmGetUsersByIDs.defaultExpectation == nil {
+		mmGetUsersByIDs.defaultExpectation = &UserRepositoryMockGetUsersByIDsExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	if mmGetUsersByIDs.defaultExpectation.params != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	if mmGetUsersByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetUsersByIDs.defaultExpectation.paramPtrs = &UserRepositoryMockGetUsersByIDsParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.id = &id
-	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmGetUsersByIDs.defaultExpectation.paramPtrs.ids = &ids
+	mmGetUsersByIDs.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmGetUsersByIDs
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.inspectFuncGetUser != nil {
-		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUsersByIDs
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Inspect(f func(ctx context.Context, ids []int64)) *mUserRepositoryMockGetUsersByIDs {
+	if mmGetUsersByIDs.mock.inspectFuncGetUsersByIDs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUsersByIDs")
 	}
 
-	mmGetUser.mock.inspectFuncGetUser = f
+	mmGetUsersByIDs.mock.inspectFuncGetUsersByIDs = f
 
-	return mmGetUser
+	return mmGetUsersByIDs
 }
 
-// Return sets up results that will be returned by UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) Return(up1 *model.User, err error) *UserRepositoryMock {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.GetUsersByIDs
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Return(upa1 []*model.User, err error) *UserRepositoryMock {
+	if mmGetUsersByIDs.mock.funcGetUsersByIDs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{mock: mmGetUser.mock}
+	if mmGetUsersByIDs.defaultExpectation == nil {
+		mmGetUsersByIDs.defaultExpectation = &UserRepositoryMockGetUsersByIDsExpectation{mock: mmGetUsersByIDs.mock}
 	}
-	mmGetUser.defaultExpectation.results = &UserRepositoryMockGetUserResults{up1, err}
-	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	mmGetUsersByIDs.defaultExpectation.results = &UserRepositoryMockGetUsersByIDsResults{upa1, err}
+	mmGetUsersByIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUsersByIDs.mock
 }
 
-// Set uses given function f to mock the UserRepository.GetUser method
-func (mmGetUser *mUserRepositoryMockGetUser) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserRepositoryMock {
-	if mmGetUser.defaultExpectation != nil {
-		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUser method")
+// Set uses given function f to mock the UserRepository.GetUsersByIDs method
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Set(f func(ctx context.Context, ids []int64) (upa1 []*model.User, err error)) *UserRepositoryMock {
+	if mmGetUsersByIDs.defaultExpectation != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUsersByIDs method")
 	}
 
-	if len(mmGetUser.expectations) > 0 {
-		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUser method")
+	if len(mmGetUsersByIDs.expectations) > 0 {
+		mmGetUsersByIDs.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUsersByIDs method")
 	}
 
-	mmGetUser.mock.funcGetUser = f
-	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	mmGetUsersByIDs.mock.funcGetUsersByIDs = f
+	mmGetUsersByIDs.mock.funcGetUsersByIDsOrigin = minimock.CallerInfo(1)
+	return mmGetUsersByIDs.mock
 }
 
-// When sets expectation for the UserRepository.GetUser which will trigger the result defined by the following
+// When sets expectation for the UserRepository.GetUsersByIDs which will trigger the result defined by the following
 // Then helper
-func (mmGetUser *mUserRepositoryMockGetUser) When(ctx context.Context, id int64) *UserRepositoryMockGetUserExpectation {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) When(ctx context.Context, ids []int64) *UserRepositoryMockGetUsersByIDsExpectation {
+	if mmGetUsersByIDs.mock.funcGetUsersByIDs != nil {
+		mmGetUsersByIDs.mock.t.Fatalf("UserRepositoryMock.GetUsersByIDs mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockGetUserExpectation{
-		mock:               mmGetUser.mock,
-		params:             &UserRepositoryMockGetUserParams{ctx, id},
-		expectationOrigins: UserRepositoryMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockGetUsersByIDsExpectation{
+		mock:               mmGetUsersByIDs.mock,
+		params:             &UserRepositoryMockGetUsersByIDsParams{ctx, ids},
+		expectationOrigins: UserRepositoryMockGetUsersByIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	mmGetUsersByIDs.expectations = append(mmGetUsersByIDs.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.GetUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockGetUserExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockGetUserResults{up1, err}
+// Then sets up UserRepository.GetUsersByIDs return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUsersByIDsExpectation) Then(upa1 []*model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUsersByIDsResults{upa1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.GetUser should be invoked
-func (mmGetUser *mUserRepositoryMockGetUser) Times(n uint64) *mUserRepositoryMockGetUser {
+// Times sets number of times UserRepository.GetUsersByIDs should be invoked
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Times(n uint64) *mUserRepositoryMockGetUsersByIDs {
 	if n == 0 {
-		mmGetUser.mock.t.Fatalf("Times of UserRepositoryMock.GetUser mock can not be zero")
+		mmGetUsersByIDs.mock.t.Fatalf("Times of UserRepositoryMock.GetUsersByIDs mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
-	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUser
+	mm_atomic.StoreUint64(&mmGetUsersByIDs.expectedInvocations, n)
+	mmGetUsersByIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUsersByIDs
 }
 
-func (mmGetUser *mUserRepositoryMockGetUser) invocationsDone() bool {
-	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) invocationsDone() bool {
+	if len(mmGetUsersByIDs.expectations) == 0 && mmGetUsersByIDs.defaultExpectation == nil && mmGetUsersByIDs.mock.funcGetUsersByIDs == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUsersByIDs.mock.afterGetUsersByIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUsersByIDs.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUser implements mm_repository.UserRepository
-func (mmGetUser *UserRepositoryMock) GetUser(ctx context.Context, id int64) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+// GetUsersByIDs implements mm_repository.UserRepository
+func (mmGetUsersByIDs *UserRepositoryMock) GetUsersByIDs(ctx context.Context, ids []int64) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUsersByIDs.beforeGetUsersByIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUsersByIDs.afterGetUsersByIDsCounter, 1)
 
-	mmGetUser.t.Helper()
-
-	if mmGetUser.inspectFuncGetUser != nil {
-		mmGetUser.inspectFuncGetUser(ctx, id)
+	mmGetUsersByIDs.t.Helper()
+
+	if mmGetUsersByIDs.inspectFuncGetUsersByIDs != nil {
+		mmGetUsersByIDs.inspectFuncGetUsersByIDs(ctx, ids)
 	}
 
-	mm_params := UserRepositoryMockGetUserParams{ctx, id}
+	mm_params := UserRepositoryMockGetUsersByIDsParams{ctx, ids}
 
 	// Record call args
-	mmGetUser.GetUserMock.mutex.Lock()
-	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
-	mmGetUser.GetUserMock.mutex.Unlock()
+	mmGetUsersByIDs.GetUsersByIDsMock.mutex.Lock()
+	mmGetUsersByIDs.GetUsersByIDsMock.callArgs = append(mmGetUsersByIDs.GetUsersByIDsMock.callArgs, &mm_params)
+	mmGetUsersByIDs.GetUsersByIDsMock.mutex.Unlock()
 
-	for _, e := range mmGetUser.GetUserMock.expectations {
+	for _, e := range mmGetUsersByIDs.GetUsersByIDsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.upa1, e.results.err
 		}
 	}
 
-	if mmGetUser.GetUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+	if mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockGetUserParams{ctx, id}
+		mm_got := UserRepositoryMockGetUsersByIDsParams{ctx, ids}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetUsersByIDs.t.Errorf("UserRepositoryMock.GetUsersByIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmGetUsersByIDs.t.Errorf("UserRepositoryMock.GetUsersByIDs got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetUsersByIDs.t.Errorf("UserRepositoryMock.GetUsersByIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		mm_results := mmGetUsersByIDs.GetUsersByIDsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUser.t.Fatal("No results are set for the UserRepositoryMock.GetUser")
+			mmGetUsersByIDs.t.Fatal("No results are set for the UserRepositoryMock.GetUsersByIDs")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).upa1, (*mm_results).err
 	}
-	if mmGetUser.funcGetUser != nil {
-		return mmGetUser.funcGetUser(ctx, id)
+	if mmGetUsersByIDs.funcGetUsersByIDs != nil {
+		return mmGetUsersByIDs.funcGetUsersByIDs(ctx, ids)
 	}
-	mmGetUser.t.Fatalf("Unexpected call to UserRepositoryMock.GetUser. %v %v", ctx, id)
+	mmGetUsersByIDs.t.Fatalf("Unexpected call to UserRepositoryMock.GetUsersByIDs. %v %v", ctx, ids)
 	return
 }
 
-// GetUserAfterCounter returns a count of finished UserRepositoryMock.GetUser invocations
-func (mmGetUser *UserRepositoryMock) GetUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+// GetUsersByIDsAfterCounter returns a count of finished UserRepositoryMock.GetUsersByIDs invocations
+func (mmGetUsersByIDs *UserRepositoryMock) GetUsersByIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsersByIDs.afterGetUsersByIDsCounter)
 }
 
-// GetUserBeforeCounter returns a count of UserRepositoryMock.GetUser invocations
-func (mmGetUser *UserRepositoryMock) GetUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+// GetUsersByIDsBeforeCounter returns a count of UserRepositoryMock.GetUsersByIDs invocations
+func (mmGetUsersByIDs *UserRepositoryMock) GetUsersByIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsersByIDs.beforeGetUsersByIDsCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUsersByIDs.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUser *mUserRepositoryMockGetUser) Calls() []*UserRepositoryMockGetUserParams {
-	mmGetUser.mutex.RLock()
+func (mmGetUsersByIDs *mUserRepositoryMockGetUsersByIDs) Calls() []*UserRepositoryMockGetUsersByIDsParams {
+	mmGetUsersByIDs.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockGetUserParams, len(mmGetUser.callArgs))
-	copy(argCopy, mmGetUser.callArgs)
+	argCopy := make([]*UserRepositoryMockGetUsersByIDsParams, len(mmGetUsersByIDs.callArgs))
+	copy(argCopy, mmGetUsersByIDs.callArgs)
 
-	mmGetUser.mutex.RUnlock()
+	mmGetUsersByIDs.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// MinimockGetUsersByIDsDone returns true if the count of the GetUsersByIDs invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockGetUserDone() bool {
-	if m.GetUserMock.optional {
+func (m *UserRepositoryMock) MinimockGetUsersByIDsDone() bool {
+	if m.GetUsersByIDsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserMock.expectations {
+	for _, e := range m.GetUsersByIDsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserMock.invocationsDone()
+	return m.GetUsersByIDsMock.invocationsDone()
 }
 
-// MinimockGetUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockGetUserInspect() {
-	for _, e := range m.GetUserMock.expectations {
+// MinimockGetUsersByIDsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUsersByIDsInspect() {
+	for _, e := range m.GetUsersByIDsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUsersByIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	afterGetUsersByIDsCounter := mm_atomic.LoadUint64(&m.afterGetUsersByIDsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
-		if m.GetUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+	if m.GetUsersByIDsMock.defaultExpectation != nil && afterGetUsersByIDsCounter < 1 {
+		if m.GetUsersByIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUsersByIDs at\n%s", m.GetUsersByIDsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUsersByIDs at\n%s with params: %#v", m.GetUsersByIDsMock.defaultExpectation.expectationOrigins.origin, *m.GetUsersByIDsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUser != nil && afterGetUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.funcGetUserOrigin)
+	if m.funcGetUsersByIDs != nil && afterGetUsersByIDsCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUsersByIDs at\n%s", m.funcGetUsersByIDsOrigin)
 	}
 
-	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	if !m.GetUsersByIDsMock.invocationsDone() && afterGetUsersByIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUsersByIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUsersByIDsMock.expectedInvocations), m.GetUsersByIDsMock.expectedInvocationsOrigin, afterGetUsersByIDsCounter)
 	}
 }
 
-type mUserRepositoryMockGetUserByName struct {
+type mUserRepositoryMockListUsers struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockGetUserByNameExpectation
-	expectations       []*UserRepositoryMockGetUserByNameExpectation
+	defaultExpectation *UserRepositoryMockListUsersExpectation
+	expectations       []*UserRepositoryMockListUsersExpectation
 
-	callArgs []*UserRepositoryMockGetUserByNameParams
+	callArgs []*UserRepositoryMockListUsersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockGetUserByNameExpectation specifies expectation struct of the UserRepository.GetUserByName
-type UserRepositoryMockGetUserByNameExpectation struct {
+// UserRepositoryMockListUsersExpectation specifies expectation struct of the UserRepository.ListUsers
+type UserRepositoryMockListUsersExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockGetUserByNameParams
-	paramPtrs          *UserRepositoryMockGetUserByNameParamPtrs
-	expectationOrigins UserRepositoryMockGetUserByNameExpectationOrigins
-	results            *UserRepositoryMockGetUserByNameResults
+	params             *UserRepositoryMockListUsersParams
+	paramPtrs          *UserRepositoryMockListUsersParamPtrs
+	expectationOrigins UserRepositoryMockListUsersExpectationOrigins
+	results            *UserRepositoryMockListUsersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockGetUserByNameParams contains parameters of the UserRepository.GetUserByName
-type UserRepositoryMockGetUserByNameParams struct {
-	ctx      context.Context
-	username string
+// UserRepositoryMockListUsersParams contains parameters of the UserRepository.ListUsers
+type UserRepositoryMockListUsersParams struct {
+	ctx     context.Context
+	afterID int64
+	limit   uint64
 }
 
-// UserRepositoryMockGetUserByNameParamPtrs contains pointers to parameters of the UserRepository.GetUserByName
-type UserRepositoryMockGetUserByNameParamPtrs struct {
-	ctx      *context.Context
-	username *string
+// UserRepositoryMockListUsersParamPtrs contains pointers to parameters of the UserRepository.ListUsers
+type UserRepositoryMockListUsersParamPtrs struct {
+	ctx     *context.Context
+	afterID *int64
+	limit   *uint64
 }
 
-// UserRepositoryMockGetUserByNameResults contains results of the UserRepository.GetUserByName
-type UserRepositoryMockGetUserByNameResults struct {
-	up1 *model.User
-	err error
+// UserRepositoryMockListUsersResults contains results of the UserRepository.ListUsers
+type UserRepositoryMockListUsersResults struct {
+	upa1 []*model.User
+	err  error
 }
 
-// UserRepositoryMockGetUserByNameOrigins contains origins of expectations of the UserRepository.GetUserByName
-type UserRepositoryMockGetUserByNameExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
+// UserRepositoryMockListUsersOrigins contains origins of expectations of the UserRepository.ListUsers
+type UserRepositoryMockListUsersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAfterID string
+	originLimit   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1165,292 +3316,320 @@ type UserRepositoryMockGetUserByNameExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Optional() *mUserRepositoryMockGetUserByName {
-	mmGetUserByName.optional = true
-	return mmGetUserByName
+func (mmListUsers *mUserRepositoryMockListUsers) Optional() *mUserRepositoryMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
 }
 
-// Expect sets up expected params for UserRepository.GetUserByName
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Expect(ctx context.Context, username string) *mUserRepositoryMockGetUserByName {
-	if mmGetUserByName.mock.funcGetUserByName != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+// Expect sets up expected params for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Expect(ctx context.Context, afterID int64, limit uint64) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
 	}
 
-	if mmGetUserByName.defaultExpectation == nil {
-		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
 	}
 
-	if mmGetUserByName.defaultExpectation.paramPtrs != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by ExpectParams functions")
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by ExpectParams functions")
 	}
 
-	mmGetUserByName.defaultExpectation.params = &UserRepositoryMockGetUserByNameParams{ctx, username}
-	mmGetUserByName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUserByName.expectations {
-		if minimock.Equal(e.params, mmGetUserByName.defaultExpectation.params) {
-			mmGetUserByName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByName.defaultExpectation.params)
+	mmListUsers.defaultExpectation.params = &UserRepositoryMockListUsersParams{ctx, afterID, limit}
+	mmListUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUserByName
+	return mmListUsers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUserByName
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUserByName {
-	if mmGetUserByName.mock.funcGetUserByName != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
 	}
 
-	if mmGetUserByName.defaultExpectation == nil {
-		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
 	}
 
-	if mmGetUserByName.defaultExpectation.params != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Expect")
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
 	}
 
-	if mmGetUserByName.defaultExpectation.paramPtrs == nil {
-		mmGetUserByName.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByNameParamPtrs{}
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
 	}
-	mmGetUserByName.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUserByName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUserByName
+	return mmListUsers
 }
 
-// ExpectUsernameParam2 sets up expected param username for UserRepository.GetUserByName
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) ExpectUsernameParam2(username string) *mUserRepositoryMockGetUserByName {
-	if mmGetUserByName.mock.funcGetUserByName != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+// ExpectAfterIDParam2 sets up expected param afterID for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectAfterIDParam2(afterID int64) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
 	}
 
-	if mmGetUserByName.defaultExpectation == nil {
-		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
 	}
 
-	if mmGetUserByName.defaultExpectation.params != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Expect")
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
 	}
 
-	if mmGetUserByName.defaultExpectation.paramPtrs == nil {
-		mmGetUserByName.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByNameParamPtrs{}
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
 	}
-	mmGetUserByName.defaultExpectation.paramPtrs.username = &username
-	mmGetUserByName.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmListUsers.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListUsers.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
 
-	return mmGetUserByName
+	return mmListUsers
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUserByName
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Inspect(f func(ctx context.Context, username string)) *mUserRepositoryMockGetUserByName {
-	if mmGetUserByName.mock.inspectFuncGetUserByName != nil {
-		mmGetUserByName.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUserByName")
+// ExpectLimitParam3 sets up expected param limit for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectLimitParam3(limit uint64) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
 	}
 
-	mmGetUserByName.mock.inspectFuncGetUserByName = f
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
 
-	return mmGetUserByName
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.limit = &limit
+	mmListUsers.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListUsers
 }
 
-// Return sets up results that will be returned by UserRepository.GetUserByName
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Return(up1 *model.User, err error) *UserRepositoryMock {
-	if mmGetUserByName.mock.funcGetUserByName != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Inspect(f func(ctx context.Context, afterID int64, limit uint64)) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ListUsers")
 	}
 
-	if mmGetUserByName.defaultExpectation == nil {
-		mmGetUserByName.defaultExpectation = &UserRepositoryMockGetUserByNameExpectation{mock: mmGetUserByName.mock}
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Return(upa1 []*model.User, err error) *UserRepositoryMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
 	}
-	mmGetUserByName.defaultExpectation.results = &UserRepositoryMockGetUserByNameResults{up1, err}
-	mmGetUserByName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUserByName.mock
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserRepositoryMockListUsersResults{upa1, err}
+	mmListUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
 }
 
-// Set uses given function f to mock the UserRepository.GetUserByName method
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Set(f func(ctx context.Context, username string) (up1 *model.User, err error)) *UserRepositoryMock {
-	if mmGetUserByName.defaultExpectation != nil {
-		mmGetUserByName.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUserByName method")
+// Set uses given function f to mock the UserRepository.ListUsers method
+func (mmListUsers *mUserRepositoryMockListUsers) Set(f func(ctx context.Context, afterID int64, limit uint64) (upa1 []*model.User, err error)) *UserRepositoryMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.ListUsers method")
 	}
 
-	if len(mmGetUserByName.expectations) > 0 {
-		mmGetUserByName.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUserByName method")
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.ListUsers method")
 	}
 
-	mmGetUserByName.mock.funcGetUserByName = f
-	mmGetUserByName.mock.funcGetUserByNameOrigin = minimock.CallerInfo(1)
-	return mmGetUserByName.mock
+	mmListUsers.mock.funcListUsers = f
+	mmListUsers.mock.funcListUsersOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
 }
 
-// When sets expectation for the UserRepository.GetUserByName which will trigger the result defined by the following
+// When sets expectation for the UserRepository.ListUsers which will trigger the result defined by the following
 // Then helper
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) When(ctx context.Context, username string) *UserRepositoryMockGetUserByNameExpectation {
-	if mmGetUserByName.mock.funcGetUserByName != nil {
-		mmGetUserByName.mock.t.Fatalf("UserRepositoryMock.GetUserByName mock is already set by Set")
+func (mmListUsers *mUserRepositoryMockListUsers) When(ctx context.Context, afterID int64, limit uint64) *UserRepositoryMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockGetUserByNameExpectation{
-		mock:               mmGetUserByName.mock,
-		params:             &UserRepositoryMockGetUserByNameParams{ctx, username},
-		expectationOrigins: UserRepositoryMockGetUserByNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockListUsersExpectation{
+		mock:               mmListUsers.mock,
+		params:             &UserRepositoryMockListUsersParams{ctx, afterID, limit},
+		expectationOrigins: UserRepositoryMockListUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUserByName.expectations = append(mmGetUserByName.expectations, expectation)
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.GetUserByName return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockGetUserByNameExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockGetUserByNameResults{up1, err}
+// Then sets up UserRepository.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListUsersExpectation) Then(upa1 []*model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListUsersResults{upa1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.GetUserByName should be invoked
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Times(n uint64) *mUserRepositoryMockGetUserByName {
+// Times sets number of times UserRepository.ListUsers should be invoked
+func (mmListUsers *mUserRepositoryMockListUsers) Times(n uint64) *mUserRepositoryMockListUsers {
 	if n == 0 {
-		mmGetUserByName.mock.t.Fatalf("Times of UserRepositoryMock.GetUserByName mock can not be zero")
+		mmListUsers.mock.t.Fatalf("Times of UserRepositoryMock.ListUsers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUserByName.expectedInvocations, n)
-	mmGetUserByName.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUserByName
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	mmListUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUsers
 }
 
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) invocationsDone() bool {
-	if len(mmGetUserByName.expectations) == 0 && mmGetUserByName.defaultExpectation == nil && mmGetUserByName.mock.funcGetUserByName == nil {
+func (mmListUsers *mUserRepositoryMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByName.mock.afterGetUserByNameCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByName.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserByName implements mm_repository.UserRepository
-func (mmGetUserByName *UserRepositoryMock) GetUserByName(ctx context.Context, username string) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGetUserByName.beforeGetUserByNameCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserByName.afterGetUserByNameCounter, 1)
+// ListUsers implements mm_repository.UserRepository
+func (mmListUsers *UserRepositoryMock) ListUsers(ctx context.Context, afterID int64, limit uint64) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
 
-	mmGetUserByName.t.Helper()
+	mmListUsers.t.Helper()
 
-	if mmGetUserByName.inspectFuncGetUserByName != nil {
-		mmGetUserByName.inspectFuncGetUserByName(ctx, username)
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, afterID, limit)
 	}
 
-	mm_params := UserRepositoryMockGetUserByNameParams{ctx, username}
+	mm_params := UserRepositoryMockListUsersParams{ctx, afterID, limit}
 
 	// Record call args
-	mmGetUserByName.GetUserByNameMock.mutex.Lock()
-	mmGetUserByName.GetUserByNameMock.callArgs = append(mmGetUserByName.GetUserByNameMock.callArgs, &mm_params)
-	mmGetUserByName.GetUserByNameMock.mutex.Unlock()
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
 
-	for _, e := range mmGetUserByName.GetUserByNameMock.expectations {
+	for _, e := range mmListUsers.ListUsersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.upa1, e.results.err
 		}
 	}
 
-	if mmGetUserByName.GetUserByNameMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserByName.GetUserByNameMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserByName.GetUserByNameMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserByName.GetUserByNameMock.defaultExpectation.paramPtrs
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockGetUserByNameParams{ctx, username}
+		mm_got := UserRepositoryMockListUsersParams{ctx, afterID, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserByName.t.Errorf("UserRepositoryMock.GetUserByName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetUserByName.t.Errorf("UserRepositoryMock.GetUserByName got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserByName.t.Errorf("UserRepositoryMock.GetUserByName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserByName.GetUserByNameMock.defaultExpectation.results
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserByName.t.Fatal("No results are set for the UserRepositoryMock.GetUserByName")
+			mmListUsers.t.Fatal("No results are set for the UserRepositoryMock.ListUsers")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).upa1, (*mm_results).err
 	}
-	if mmGetUserByName.funcGetUserByName != nil {
-		return mmGetUserByName.funcGetUserByName(ctx, username)
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, afterID, limit)
 	}
-	mmGetUserByName.t.Fatalf("Unexpected call to UserRepositoryMock.GetUserByName. %v %v", ctx, username)
+	mmListUsers.t.Fatalf("Unexpected call to UserRepositoryMock.ListUsers. %v %v %v", ctx, afterID, limit)
 	return
 }
 
-// GetUserByNameAfterCounter returns a count of finished UserRepositoryMock.GetUserByName invocations
-func (mmGetUserByName *UserRepositoryMock) GetUserByNameAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserByName.afterGetUserByNameCounter)
+// ListUsersAfterCounter returns a count of finished UserRepositoryMock.ListUsers invocations
+func (mmListUsers *UserRepositoryMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
 }
 
-// GetUserByNameBeforeCounter returns a count of UserRepositoryMock.GetUserByName invocations
-func (mmGetUserByName *UserRepositoryMock) GetUserByNameBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserByName.beforeGetUserByNameCounter)
+// ListUsersBeforeCounter returns a count of UserRepositoryMock.ListUsers invocations
+func (mmListUsers *UserRepositoryMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUserByName.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ListUsers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserByName *mUserRepositoryMockGetUserByName) Calls() []*UserRepositoryMockGetUserByNameParams {
-	mmGetUserByName.mutex.RLock()
+func (mmListUsers *mUserRepositoryMockListUsers) Calls() []*UserRepositoryMockListUsersParams {
+	mmListUsers.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockGetUserByNameParams, len(mmGetUserByName.callArgs))
-	copy(argCopy, mmGetUserByName.callArgs)
+	argCopy := make([]*UserRepositoryMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
 
-	mmGetUserByName.mutex.RUnlock()
+	mmListUsers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserByNameDone returns true if the count of the GetUserByName invocations corresponds
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockGetUserByNameDone() bool {
-	if m.GetUserByNameMock.optional {
+func (m *UserRepositoryMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserByNameMock.expectations {
+	for _, e := range m.ListUsersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserByNameMock.invocationsDone()
+	return m.ListUsersMock.invocationsDone()
 }
 
-// MinimockGetUserByNameInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockGetUserByNameInspect() {
-	for _, e := range m.GetUserByNameMock.expectations {
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserByNameCounter := mm_atomic.LoadUint64(&m.afterGetUserByNameCounter)
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserByNameMock.defaultExpectation != nil && afterGetUserByNameCounter < 1 {
-		if m.GetUserByNameMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s", m.GetUserByNameMock.defaultExpectation.returnOrigin)
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s", m.ListUsersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s with params: %#v", m.GetUserByNameMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByNameMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s with params: %#v", m.ListUsersMock.defaultExpectation.expectationOrigins.origin, *m.ListUsersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserByName != nil && afterGetUserByNameCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.GetUserByName at\n%s", m.funcGetUserByNameOrigin)
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s", m.funcListUsersOrigin)
 	}
 
-	if !m.GetUserByNameMock.invocationsDone() && afterGetUserByNameCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUserByName at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserByNameMock.expectedInvocations), m.GetUserByNameMock.expectedInvocationsOrigin, afterGetUserByNameCounter)
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ListUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), m.ListUsersMock.expectedInvocationsOrigin, afterListUsersCounter)
 	}
 }
 
@@ -1800,14 +3979,26 @@ func (m *UserRepositoryMock) MinimockUpdateUserInspect() {
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockActivateUserInspect()
+
+			m.MinimockCopyUsersInspect()
+
 			m.MinimockCreateUserInspect()
 
 			m.MinimockDeleteUserInspect()
 
+			m.MinimockFindUserNamesInspect()
+
 			m.MinimockGetUserInspect()
 
+			m.MinimockGetUserByEmailInspect()
+
 			m.MinimockGetUserByNameInspect()
 
+			m.MinimockGetUsersByIDsInspect()
+
+			m.MinimockListUsersInspect()
+
 			m.MinimockUpdateUserInspect()
 		}
 	})
@@ -1832,9 +4023,15 @@ func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockActivateUserDone() &&
+		m.MinimockCopyUsersDone() &&
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
+		m.MinimockFindUserNamesDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockGetUserByEmailDone() &&
 		m.MinimockGetUserByNameDone() &&
+		m.MinimockGetUsersByIDsDone() &&
+		m.MinimockListUsersDone() &&
 		m.MinimockUpdateUserDone()
 }
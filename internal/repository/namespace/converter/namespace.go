@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/namespace/model"
+)
+
+// ToNamespaceConfigFromService returns relation rewrites of namespace as JSON
+func ToNamespaceConfigFromService(namespace *model.RelationNamespace) ([]byte, error) {
+	return json.Marshal(namespace.Relations)
+}
+
+// ToNamespacesFromRepo converts namespaces from repository model to service model
+func ToNamespacesFromRepo(namespaces []*modelRepo.Namespace) ([]*model.RelationNamespace, error) {
+	res := make([]*model.RelationNamespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		ns := &model.RelationNamespace{Name: namespace.Name}
+		if err := json.Unmarshal(namespace.Config, &ns.Relations); err != nil {
+			return nil, err
+		}
+		res = append(res, ns)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Namespace contains relation namespace, relation rewrites are stored as JSON
+type Namespace struct {
+	ID        int64        `db:"id"`
+	TenantID  int64        `db:"tenant_id"`
+	Name      string       `db:"name"`
+	Config    []byte       `db:"config"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
@@ -0,0 +1,100 @@
+package namespace
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/namespace/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/namespace/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "relation_namespaces"
+
+	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
+	nameColumn      = "name"
+	configColumn    = "config"
+	createdAtColumn = "created_at"
+	updatedAtColumn = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new relation namespaces repository
+func NewRepository(db db.Client) repository.RelationNamespaceRepository {
+	return &repo{db: db}
+}
+
+// SaveNamespace creates namespace or replaces configuration of existing one
+func (r *repo) SaveNamespace(ctx context.Context, namespace *model.RelationNamespace) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	config, err := converter.ToNamespaceConfigFromService(namespace)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, nameColumn, configColumn).
+		Values(tenantID, namespace.Name, config).
+		Suffix("ON CONFLICT (tenant_id, name) DO UPDATE SET config = EXCLUDED.config")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "namespace_repository.SaveNamespace",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// ListNamespaces returns namespaces of tenant
+func (r *repo) ListNamespaces(ctx context.Context) ([]*model.RelationNamespace, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, nameColumn, configColumn,
+		createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(nameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "namespace_repository.ListNamespaces",
+		QueryRaw: query,
+	}
+
+	var namespaces []*modelRepo.Namespace
+	err = r.db.DB().ScanAllContext(ctx, &namespaces, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToNamespacesFromRepo(namespaces)
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/oauthclient/model"
+)
+
+// ToOAuthClientFromRepo converts OAuth client from repository model to service model
+func ToOAuthClientFromRepo(client *modelRepo.OAuthClient) *model.OAuthClient {
+	if client == nil {
+		return &model.OAuthClient{}
+	}
+
+	return &model.OAuthClient{
+		ID:           client.ID,
+		TenantID:     client.TenantID,
+		ClientID:     client.ClientID,
+		Name:         client.Name,
+		RedirectURIs: client.RedirectURIs,
+		CreatedAt:    client.CreatedAt,
+	}
+}
+
+// ToOAuthClientsFromRepo converts OAuth clients from repository model to service model
+func ToOAuthClientsFromRepo(clients []*modelRepo.OAuthClient) []*model.OAuthClient {
+	res := make([]*model.OAuthClient, 0, len(clients))
+	for _, client := range clients {
+		res = append(res, ToOAuthClientFromRepo(client))
+	}
+
+	return res
+}
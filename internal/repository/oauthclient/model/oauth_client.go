@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// OAuthClient contains OAuth client info
+type OAuthClient struct {
+	ID           int64     `db:"id"`
+	TenantID     int64     `db:"tenant_id"`
+	ClientID     string    `db:"client_id"`
+	Name         string    `db:"name"`
+	RedirectURIs []string  `db:"redirect_uris"`
+	CreatedAt    time.Time `db:"created_at"`
+}
@@ -0,0 +1,166 @@
+package oauthclient
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/oauthclient/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/oauthclient/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "oauth_clients"
+
+	idColumn           = "id"
+	tenantIDColumn     = "tenant_id"
+	clientIDColumn     = "client_id"
+	nameColumn         = "name"
+	redirectURIsColumn = "redirect_uris"
+	createdAtColumn    = "created_at"
+)
+
+var selectColumns = []string{
+	idColumn, tenantIDColumn, clientIDColumn, nameColumn, redirectURIsColumn, createdAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new OAuth clients repository
+func NewRepository(db db.Client) repository.OAuthClientRepository {
+	return &repo{db: db}
+}
+
+// CreateOAuthClient registers new OAuth client in tenant
+func (r *repo) CreateOAuthClient(ctx context.Context, client *model.OAuthClient) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, clientIDColumn, nameColumn, redirectURIsColumn).
+		Values(tenantID, client.ClientID, client.Name, client.RedirectURIs).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.CreateOAuthClient",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetOAuthClient returns OAuth client of any tenant, client ID determines tenant
+// of authorization requests
+func (r *repo) GetOAuthClient(ctx context.Context, clientID string) (*model.OAuthClient, error) {
+	builderSelectOne := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{clientIDColumn: clientID}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.GetOAuthClient",
+		QueryRaw: query,
+	}
+
+	var client modelRepo.OAuthClient
+	err = r.db.DB().ScanOneContext(ctx, &client, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorOAuthClientNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToOAuthClientFromRepo(&client), nil
+}
+
+// ListOAuthClients returns OAuth clients of tenant
+func (r *repo) ListOAuthClients(ctx context.Context) ([]*model.OAuthClient, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.ListOAuthClients",
+		QueryRaw: query,
+	}
+
+	var clients []*modelRepo.OAuthClient
+	err = r.db.DB().ScanAllContext(ctx, &clients, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToOAuthClientsFromRepo(clients), nil
+}
+
+// DeleteOAuthClient deletes OAuth client of tenant with its authorization codes
+func (r *repo) DeleteOAuthClient(ctx context.Context, clientID string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, clientIDColumn: clientID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "oauth_client_repository.DeleteOAuthClient",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorOAuthClientNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/oauthcode/model"
+)
+
+// ToAuthorizationCodeFromRepo converts authorization code from repository model
+// to service model
+func ToAuthorizationCodeFromRepo(code *modelRepo.AuthorizationCode,
+) *model.OAuthAuthorizationCode {
+	if code == nil {
+		return &model.OAuthAuthorizationCode{}
+	}
+
+	return &model.OAuthAuthorizationCode{
+		CodeHash:      code.CodeHash,
+		TenantID:      code.TenantID,
+		ClientID:      code.ClientID,
+		UserID:        code.UserID,
+		RedirectURI:   code.RedirectURI,
+		Scope:         code.Scope,
+		CodeChallenge: code.CodeChallenge,
+		Nonce:         code.Nonce,
+		AuthTime:      code.AuthTime,
+		AuthMethod:    code.AuthMethod,
+		ExpiresAt:     code.ExpiresAt,
+		CreatedAt:     code.CreatedAt,
+	}
+}
+
+// ToAuthorizationCodesFromRepo converts authorization codes from repository model
+// to service model
+func ToAuthorizationCodesFromRepo(codes []*modelRepo.AuthorizationCode,
+) []*model.OAuthAuthorizationCode {
+	res := make([]*model.OAuthAuthorizationCode, 0, len(codes))
+	for _, code := range codes {
+		res = append(res, ToAuthorizationCodeFromRepo(code))
+	}
+
+	return res
+}
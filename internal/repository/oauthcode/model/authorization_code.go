@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// AuthorizationCode contains issued OAuth authorization code info
+type AuthorizationCode struct {
+	CodeHash      string    `db:"code_hash"`
+	TenantID      int64     `db:"tenant_id"`
+	ClientID      string    `db:"client_id"`
+	UserID        int64     `db:"user_id"`
+	RedirectURI   string    `db:"redirect_uri"`
+	Scope         string    `db:"scope"`
+	CodeChallenge string    `db:"code_challenge"`
+	Nonce         string    `db:"nonce"`
+	AuthTime      time.Time `db:"auth_time"`
+	AuthMethod    string    `db:"auth_method"`
+	ExpiresAt     time.Time `db:"expires_at"`
+	CreatedAt     time.Time `db:"created_at"`
+}
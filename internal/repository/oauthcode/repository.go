@@ -0,0 +1,209 @@
+package oauthcode
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/oauthcode/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/oauthcode/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "oauth_authorization_codes"
+
+	codeHashColumn      = "code_hash"
+	tenantIDColumn      = "tenant_id"
+	clientIDColumn      = "client_id"
+	userIDColumn        = "user_id"
+	redirectURIColumn   = "redirect_uri"
+	scopeColumn         = "scope"
+	codeChallengeColumn = "code_challenge"
+	nonceColumn         = "nonce"
+	authTimeColumn      = "auth_time"
+	authMethodColumn    = "auth_method"
+	expiresAtColumn     = "expires_at"
+	createdAtColumn     = "created_at"
+)
+
+var selectColumns = []string{
+	codeHashColumn, tenantIDColumn, clientIDColumn, userIDColumn, redirectURIColumn,
+	scopeColumn, codeChallengeColumn, nonceColumn, authTimeColumn, authMethodColumn,
+	expiresAtColumn, createdAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new OAuth authorization codes repository
+func NewRepository(db db.Client) repository.OAuthAuthorizationCodeRepository {
+	return &repo{db: db}
+}
+
+// CreateAuthorizationCode saves authorization code issued in tenant
+func (r *repo) CreateAuthorizationCode(ctx context.Context,
+	code *model.OAuthAuthorizationCode,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(codeHashColumn, tenantIDColumn, clientIDColumn, userIDColumn, redirectURIColumn,
+			scopeColumn, codeChallengeColumn, nonceColumn, authTimeColumn, authMethodColumn,
+			expiresAtColumn).
+		Values(code.CodeHash, tenantID, code.ClientID, code.UserID, code.RedirectURI,
+			code.Scope, code.CodeChallenge, code.Nonce, code.AuthTime, code.AuthMethod,
+			code.ExpiresAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "oauth_code_repository.CreateAuthorizationCode",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// ConsumeAuthorizationCode deletes authorization code of tenant and returns it,
+// so every code is exchanged at most once
+func (r *repo) ConsumeAuthorizationCode(ctx context.Context, codeHash string) (
+	*model.OAuthAuthorizationCode, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, codeHashColumn: codeHash}).
+		Suffix("RETURNING " + strings.Join(selectColumns, ", "))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "oauth_code_repository.ConsumeAuthorizationCode",
+		QueryRaw: query,
+	}
+
+	var code modelRepo.AuthorizationCode
+	err = r.db.DB().ScanOneContext(ctx, &code, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorOAuthInvalidGrant
+		}
+		return nil, err
+	}
+
+	return converter.ToAuthorizationCodeFromRepo(&code), nil
+}
+
+// DeleteExpiredAuthorizationCodes deletes authorization codes of tenant which expired
+// not later than given time
+func (r *repo) DeleteExpiredAuthorizationCodes(ctx context.Context, at time.Time) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		Where(sq.LtOrEq{expiresAtColumn: at})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "oauth_code_repository.DeleteExpiredAuthorizationCodes",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// ListAuthorizationCodesByUserID returns authorization codes issued to clients of tenant
+// on behalf of user
+func (r *repo) ListAuthorizationCodesByUserID(ctx context.Context, userID int64) (
+	[]*model.OAuthAuthorizationCode, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID}).
+		OrderBy(createdAtColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "oauth_code_repository.ListAuthorizationCodesByUserID",
+		QueryRaw: query,
+	}
+
+	var codes []*modelRepo.AuthorizationCode
+	err = r.db.DB().ScanAllContext(ctx, &codes, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAuthorizationCodesFromRepo(codes), nil
+}
+
+// DeleteAuthorizationCodesByUserID deletes all authorization codes issued on behalf of user
+func (r *repo) DeleteAuthorizationCodesByUserID(ctx context.Context, userID int64) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "oauth_code_repository.DeleteAuthorizationCodesByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
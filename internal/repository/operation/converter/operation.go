@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/operation/model"
+)
+
+// ToOperationFromRepo converts operation from repository model to service model
+func ToOperationFromRepo(operation *modelRepo.Operation) (*model.Operation, error) {
+	res := &model.Operation{
+		ID:        operation.ID,
+		TenantID:  operation.TenantID,
+		Kind:      operation.Kind,
+		Done:      operation.Done,
+		Error:     operation.Error,
+		CreatedAt: operation.CreatedAt,
+		UpdatedAt: operation.UpdatedAt,
+	}
+
+	if len(operation.Metadata) > 0 {
+		if err := json.Unmarshal(operation.Metadata, &res.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(operation.Result) > 0 {
+		res.Result = &model.ImportUsersResult{}
+		if err := json.Unmarshal(operation.Result, res.Result); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// ToOperationJSONFromService returns metadata and result of operation as JSON
+func ToOperationJSONFromService(operation *model.Operation) ([]byte, []byte, error) {
+	metadata, err := json.Marshal(operation.Metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if operation.Result == nil {
+		return metadata, nil, nil
+	}
+
+	result, err := json.Marshal(operation.Result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metadata, result, nil
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Operation contains long-running operation info,
+// metadata and result are stored as JSON
+type Operation struct {
+	ID        int64        `db:"id"`
+	TenantID  int64        `db:"tenant_id"`
+	Kind      string       `db:"kind"`
+	Done      bool         `db:"done"`
+	Metadata  []byte       `db:"metadata"`
+	Result    []byte       `db:"result"`
+	Error     string       `db:"error"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
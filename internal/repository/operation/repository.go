@@ -0,0 +1,152 @@
+package operation
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/operation/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/operation/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "operations"
+
+	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
+	kindColumn      = "kind"
+	doneColumn      = "done"
+	metadataColumn  = "metadata"
+	resultColumn    = "result"
+	errorColumn     = "error"
+	createdAtColumn = "created_at"
+	updatedAtColumn = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new operation repository
+func NewRepository(db db.Client) repository.OperationRepository {
+	return &repo{db: db}
+}
+
+// CreateOperation creates new operation in tenant
+func (r *repo) CreateOperation(ctx context.Context, operation *model.Operation) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	metadata, result, err := converter.ToOperationJSONFromService(operation)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, kindColumn, doneColumn, metadataColumn, resultColumn, errorColumn).
+		Values(tenantID, operation.Kind, operation.Done, metadata, result, operation.Error).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "operation_repository.CreateOperation",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetOperation returns operation by id
+func (r *repo) GetOperation(ctx context.Context, id int64) (*model.Operation, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, kindColumn, doneColumn,
+		metadataColumn, resultColumn, errorColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "operation_repository.GetOperation",
+		QueryRaw: query,
+	}
+
+	var operation modelRepo.Operation
+	err = r.db.DB().ScanOneContext(ctx, &operation, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorOperationNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToOperationFromRepo(&operation)
+}
+
+// UpdateOperation saves operation progress and result
+func (r *repo) UpdateOperation(ctx context.Context, operation *model.Operation) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	metadata, result, err := converter.ToOperationJSONFromService(operation)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(doneColumn, operation.Done).
+		Set(metadataColumn, metadata).
+		Set(resultColumn, result).
+		Set(errorColumn, operation.Error).
+		Where(sq.Eq{idColumn: operation.ID, tenantIDColumn: tenantID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "operation_repository.UpdateOperation",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorOperationNotFound
+	}
+
+	return nil
+}
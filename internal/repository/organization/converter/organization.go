@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/organization/model"
+)
+
+// ToOrganizationFromRepo converts organization from repository model to service model
+func ToOrganizationFromRepo(org *modelRepo.Organization) *model.Organization {
+	if org == nil {
+		return &model.Organization{}
+	}
+
+	return &model.Organization{
+		ID:        org.ID,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
+
+// ToOrganizationsFromRepo converts organizations from repository model to service model
+func ToOrganizationsFromRepo(orgs []*modelRepo.Organization) []*model.Organization {
+	res := make([]*model.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		res = append(res, ToOrganizationFromRepo(org))
+	}
+
+	return res
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Organization contains organization settings
+type Organization struct {
+	ID        int64        `db:"id"`
+	Name      string       `db:"name"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
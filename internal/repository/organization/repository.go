@@ -0,0 +1,142 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/organization/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/organization/model"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "organizations"
+
+	idColumn        = "id"
+	nameColumn      = "name"
+	createdAtColumn = "created_at"
+	updatedAtColumn = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new organization repository
+func NewRepository(db db.Client) repository.OrganizationRepository {
+	return &repo{db: db}
+}
+
+// CreateOrganization creates new organization
+func (r *repo) CreateOrganization(ctx context.Context, name string) (int64, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn).
+		Values(name).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.CreateOrganization",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetOrganization returns organization by id
+func (r *repo) GetOrganization(ctx context.Context, id int64) (*model.Organization, error) {
+	builderSelectOne := sq.Select(idColumn, nameColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.GetOrganization",
+		QueryRaw: query,
+	}
+
+	var org modelRepo.Organization
+	err = r.db.DB().ScanOneContext(ctx, &org, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorOrganizationNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToOrganizationFromRepo(&org), nil
+}
+
+// ListOrganizations returns all organizations
+func (r *repo) ListOrganizations(ctx context.Context) ([]*model.Organization, error) {
+	builderSelect := sq.Select(idColumn, nameColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy(idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.ListOrganizations",
+		QueryRaw: query,
+	}
+
+	var orgs []*modelRepo.Organization
+	err = r.db.DB().ScanAllContext(ctx, &orgs, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToOrganizationsFromRepo(orgs), nil
+}
+
+// DeleteOrganization removes organization
+func (r *repo) DeleteOrganization(ctx context.Context, id int64) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.DeleteOrganization",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorOrganizationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/permission/model"
+)
+
+// ToPermissionsFromRepo converts permissions from repository model to service model
+func ToPermissionsFromRepo(permissions []*modelRepo.Permission) []*model.Permission {
+	res := make([]*model.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		res = append(res, &model.Permission{
+			ID:          permission.ID,
+			TenantID:    permission.TenantID,
+			Name:        permission.Name,
+			Description: permission.Description,
+			CreatedAt:   permission.CreatedAt,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Permission contains permission settings
+type Permission struct {
+	ID          int64     `db:"id"`
+	TenantID    int64     `db:"tenant_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+}
@@ -0,0 +1,264 @@
+package permission
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/permission/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/permission/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName       = "permissions"
+	grantsTableName = "role_permissions"
+
+	idColumn          = "id"
+	tenantIDColumn    = "tenant_id"
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	createdAtColumn   = "created_at"
+	roleColumn        = "role"
+	permissionColumn  = "permission"
+)
+
+// queryEffectivePermissions selects permissions of role and all roles it inherits,
+// union drops repeated roles so cycles of inheritance do not loop
+const queryEffectivePermissions = `
+with recursive ancestors (name) as (
+    select $2::varchar
+    union
+    select p.parent
+    from role_parents p
+             join ancestors a on a.name = p.role
+    where p.tenant_id = $1
+)
+select distinct rp.permission
+from role_permissions rp
+where rp.tenant_id = $1
+  and rp.role in (select name from ancestors)
+order by rp.permission`
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new permission repository
+func NewRepository(db db.Client) repository.PermissionRepository {
+	return &repo{db: db}
+}
+
+// CreatePermission creates new permission in tenant
+func (r *repo) CreatePermission(ctx context.Context, permission *model.Permission) (
+	int64, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, nameColumn, descriptionColumn).
+		Values(tenantID, permission.Name, permission.Description).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.CreatePermission",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListPermissions returns permissions of tenant
+func (r *repo) ListPermissions(ctx context.Context) ([]*model.Permission, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, nameColumn, descriptionColumn,
+		createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(nameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.ListPermissions",
+		QueryRaw: query,
+	}
+
+	var permissions []*modelRepo.Permission
+	err = r.db.DB().ScanAllContext(ctx, &permissions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPermissionsFromRepo(permissions), nil
+}
+
+// DeletePermission removes permission from tenant with all its grants and access rules
+func (r *repo) DeletePermission(ctx context.Context, name string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name, tenantIDColumn: tenantID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.DeletePermission",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorPermissionNotFound
+	}
+
+	return nil
+}
+
+// GrantPermission grants permission to role, granting already granted permission is no-op
+func (r *repo) GrantPermission(ctx context.Context, role, permission string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(grantsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, roleColumn, permissionColumn).
+		Values(tenantID, role, permission).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.GrantPermission",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// RevokePermission revokes permission from role
+func (r *repo) RevokePermission(ctx context.Context, role, permission string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(grantsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, roleColumn: role, permissionColumn: permission})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.RevokePermission",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorPermissionNotFound
+	}
+
+	return nil
+}
+
+// ListRolePermissions returns permissions granted to role directly
+func (r *repo) ListRolePermissions(ctx context.Context, role string) ([]string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(permissionColumn).
+		From(grantsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, roleColumn: role}).
+		OrderBy(permissionColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.ListRolePermissions",
+		QueryRaw: query,
+	}
+
+	var permissions []string
+	err = r.db.DB().ScanAllContext(ctx, &permissions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// ListEffectivePermissions returns permissions of role including inherited ones
+func (r *repo) ListEffectivePermissions(ctx context.Context, role string) ([]string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.ListEffectivePermissions",
+		QueryRaw: queryEffectivePermissions,
+	}
+
+	var permissions []string
+	err = r.db.DB().ScanAllContext(ctx, &permissions, q, tenantID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,20 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+type policyChangeDetails struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// ToPolicyChangeDetailsFromService returns state of policy before and after change as JSON
+func ToPolicyChangeDetailsFromService(change *model.PolicyChange) ([]byte, error) {
+	return json.Marshal(policyChangeDetails{
+		Before: change.Before,
+		After:  change.After,
+	})
+}
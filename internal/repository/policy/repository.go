@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/policy/converter"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "policy_changes"
+
+	tenantIDColumn = "tenant_id"
+	actorColumn    = "actor"
+	actionColumn   = "action"
+	detailsColumn  = "details"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new policy changes repository
+func NewRepository(db db.Client) repository.PolicyChangeRepository {
+	return &repo{db: db}
+}
+
+// CreatePolicyChange writes audit record of access policy change
+func (r *repo) CreatePolicyChange(ctx context.Context, change *model.PolicyChange) (
+	int64, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	details, err := converter.ToPolicyChangeDetailsFromService(change)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, actorColumn, actionColumn, detailsColumn).
+		Values(tenantID, change.Actor, change.Action, details).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "policy_repository.CreatePolicyChange",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// PseudonymizeActor replaces name of actor in audit records of tenant with pseudonym
+func (r *repo) PseudonymizeActor(ctx context.Context, username, pseudonym string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(actorColumn, pseudonym).
+		Where(sq.Eq{tenantIDColumn: tenantID, actorColumn: username})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "policy_repository.PseudonymizeActor",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -16,6 +16,7 @@ func ToRedisRepoFromUser(user *model.User) *modelRepo.UserRedis {
 
 	return &modelRepo.UserRedis{
 		ID:          user.ID,
+		TenantID:    user.TenantID,
 		Name:        user.Name,
 		Email:       user.Email,
 		Role:        user.Role,
@@ -32,6 +33,7 @@ func ToUserFromRedisRepo(userRedis *modelRepo.UserRedis) *model.User {
 
 	return &model.User{
 		ID:        userRedis.ID,
+		TenantID:  userRedis.TenantID,
 		Name:      userRedis.Name,
 		Email:     userRedis.Email,
 		Role:      userRedis.Role,
@@ -3,6 +3,7 @@ package model
 // UserRedis is a struct for redis user
 type UserRedis struct {
 	ID          int64  `redis:"id"`
+	TenantID    int64  `redis:"tenant_id"`
 	Name        string `redis:"name"`
 	Email       string `redis:"email"`
 	Role        string `redis:"role"`
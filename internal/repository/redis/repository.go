@@ -2,7 +2,7 @@ package pg
 
 import (
 	"context"
-	"strconv"
+	"fmt"
 
 	redigo "github.com/gomodule/redigo/redis"
 	"github.com/pkg/errors"
@@ -11,31 +11,43 @@ import (
 	"github.com/valek177/auth/internal/repository"
 	"github.com/valek177/auth/internal/repository/redis/converter"
 	modelRepo "github.com/valek177/auth/internal/repository/redis/model"
+	"github.com/valek177/auth/internal/tenant"
 	"github.com/valek177/platform-common/pkg/client/cache"
 	"github.com/valek177/platform-common/pkg/client/cache/config"
 )
 
 type repo struct {
 	cl     cache.RedisClient
+	pool   *redigo.Pool
 	config config.RedisConfig
 }
 
-// NewUserRedisRepository returns new user redis repository
-func NewUserRedisRepository(cl cache.RedisClient, config config.RedisConfig,
+// NewUserRedisRepository returns new user redis repository, pool is used for
+// pipelined batch reads
+func NewUserRedisRepository(cl cache.RedisClient, pool *redigo.Pool, config config.RedisConfig,
 ) repository.UserRedisRepository {
-	return &repo{cl: cl, config: config}
+	return &repo{cl: cl, pool: pool, config: config}
 }
 
 // CreateUser creates user record in Redis
 func (r *repo) CreateUser(ctx context.Context, user *model.User) error {
 	userRedis := converter.ToRedisRepoFromUser(user)
 
-	idStr := strconv.FormatInt(userRedis.ID, 10)
-	err := r.cl.Ping(ctx)
+	key, err := userKey(ctx, userRedis.ID)
+	if err != nil {
+		return err
+	}
+
+	tenantID, _ := tenant.FromContext(ctx)
+	if userRedis.TenantID != 0 && userRedis.TenantID != tenantID {
+		return errors.New("unable to cache user of another tenant")
+	}
+
+	err = r.cl.Ping(ctx)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	err = r.cl.HashSet(ctx, idStr, userRedis)
+	err = r.cl.HashSet(ctx, key, userRedis)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -45,8 +57,12 @@ func (r *repo) CreateUser(ctx context.Context, user *model.User) error {
 
 // GetUser returns user from redis
 func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
-	idStr := strconv.FormatInt(id, 10)
-	values, err := r.cl.HGetAll(ctx, idStr)
+	key, err := userKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := r.cl.HGetAll(ctx, key)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -64,11 +80,66 @@ func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
 	return converter.ToUserFromRedisRepo(&userRedis), nil
 }
 
+// GetUsers returns cached users by ids in one round-trip, not cached users are absent
+// in result
+func (r *repo) GetUsers(ctx context.Context, ids []int64) (map[int64]*model.User, error) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		key, err := userKey(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	connCtx, cancel := context.WithTimeout(ctx, r.config.ConnectionTimeout())
+	defer cancel()
+
+	conn, err := r.pool.GetContext(connCtx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	for _, key := range keys {
+		if err = conn.Send("HGETALL", key); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if err = conn.Flush(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	users := make(map[int64]*model.User, len(ids))
+	for range keys {
+		values, errReceive := redigo.Values(conn.Receive())
+		if errReceive != nil {
+			return nil, errors.WithStack(errReceive)
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		var userRedis modelRepo.UserRedis
+		if err = redigo.ScanStruct(values, &userRedis); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		users[userRedis.ID] = converter.ToUserFromRedisRepo(&userRedis)
+	}
+
+	return users, nil
+}
+
 // DeleteUser deletes user in redis
 func (r *repo) DeleteUser(ctx context.Context, id int64) error {
-	idStr := strconv.FormatInt(id, 10)
+	key, err := userKey(ctx, id)
+	if err != nil {
+		return err
+	}
 
-	err := r.cl.Delete(ctx, idStr)
+	err = r.cl.Delete(ctx, key)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -78,12 +149,25 @@ func (r *repo) DeleteUser(ctx context.Context, id int64) error {
 
 // SetExpireUser sets expire time of user
 func (r *repo) SetExpireUser(ctx context.Context, id int64) error {
-	idStr := strconv.FormatInt(id, 10)
+	key, err := userKey(ctx, id)
+	if err != nil {
+		return err
+	}
 
-	err := r.cl.Expire(ctx, idStr, r.config.ElementTTL())
+	err = r.cl.Expire(ctx, key, r.config.ElementTTL())
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
 	return nil
 }
+
+// userKey returns key of user hash, users of different tenants never share keys
+func userKey(ctx context.Context, id int64) (string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d:%d", tenantID, id), nil
+}
@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/cache"
+)
+
+type revocationRepo struct {
+	cl  cache.RedisClient
+	ttl time.Duration
+}
+
+// NewTokenRevocationRepository returns new revoked tokens redis repository,
+// ttl must be not less than lifetime of longest token
+func NewTokenRevocationRepository(cl cache.RedisClient, ttl time.Duration,
+) repository.TokenRevocationRepository {
+	return &revocationRepo{cl: cl, ttl: ttl}
+}
+
+// RevokeUserTokens revokes all tokens of subject issued not later than revokedAt,
+// time is stored with nanoseconds
+func (r *revocationRepo) RevokeUserTokens(ctx context.Context, subject string,
+	revokedAt time.Time,
+) error {
+	key, err := revocationKey(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	err = r.cl.Set(ctx, key, revokedAt.UnixNano())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = r.cl.Expire(ctx, key, r.ttl)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetTokensRevokedAt returns time of last revocation of tokens of subject,
+// zero time is returned if tokens were never revoked
+func (r *revocationRepo) GetTokensRevokedAt(ctx context.Context, subject string) (
+	time.Time, error,
+) {
+	key, err := revocationKey(ctx, subject)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	value, err := r.cl.Get(ctx, key)
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+	if value == nil {
+		return time.Time{}, nil
+	}
+
+	revokedAt, err := redigo.Int64(value, nil)
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+
+	return time.Unix(0, revokedAt), nil
+}
+
+func revocationKey(ctx context.Context, subject string) (string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d:revoked:%s", tenantID, subject), nil
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/relation/model"
+)
+
+// ToRelationTuplesFromRepo converts relation tuples from repository model to service model
+func ToRelationTuplesFromRepo(tuples []*modelRepo.RelationTuple) []*model.RelationTuple {
+	res := make([]*model.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		res = append(res, &model.RelationTuple{
+			Object: model.RelationObject{
+				Namespace: tuple.Namespace,
+				ID:        tuple.ObjectID,
+			},
+			Relation: tuple.Relation,
+			Subject: model.RelationSubject{
+				Namespace: tuple.SubjectNamespace,
+				ID:        tuple.SubjectObjectID,
+				Relation:  tuple.SubjectRelation,
+			},
+		})
+	}
+
+	return res
+}
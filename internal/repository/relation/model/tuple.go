@@ -0,0 +1,11 @@
+package model
+
+// RelationTuple contains relation tuple, subject relation is empty for direct subjects
+type RelationTuple struct {
+	Namespace        string `db:"namespace"`
+	ObjectID         string `db:"object_id"`
+	Relation         string `db:"relation"`
+	SubjectNamespace string `db:"subject_namespace"`
+	SubjectObjectID  string `db:"subject_object_id"`
+	SubjectRelation  string `db:"subject_relation"`
+}
@@ -0,0 +1,268 @@
+package relation
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/relation/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/relation/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName          = "relation_tuples"
+	revisionsTableName = "relation_revisions"
+
+	tenantIDColumn         = "tenant_id"
+	namespaceColumn        = "namespace"
+	objectIDColumn         = "object_id"
+	relationColumn         = "relation"
+	subjectNamespaceColumn = "subject_namespace"
+	subjectObjectIDColumn  = "subject_object_id"
+	subjectRelationColumn  = "subject_relation"
+	createdRevisionColumn  = "created_revision"
+	deletedRevisionColumn  = "deleted_revision"
+	revisionColumn         = "revision"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new relation tuples repository
+func NewRepository(db db.Client) repository.RelationTupleRepository {
+	return &repo{db: db}
+}
+
+// IncrementRevision allocates next revision of tenant, row of tenant stays locked
+// until transaction ends, so writes of tenant are serialized
+func (r *repo) IncrementRevision(ctx context.Context) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(revisionsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, revisionColumn).
+		Values(tenantID, 1).
+		Suffix("ON CONFLICT (tenant_id) DO UPDATE SET revision = relation_revisions.revision + 1 " +
+			"RETURNING revision")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.IncrementRevision",
+		QueryRaw: query,
+	}
+
+	var revision int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&revision); err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+// GetRevision returns latest committed revision of tenant
+func (r *repo) GetRevision(ctx context.Context) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderSelect := sq.Select(revisionColumn).
+		From(revisionsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.GetRevision",
+		QueryRaw: query,
+	}
+
+	var revision int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+// CreateTuple creates tuple at revision, creating existing tuple is no-op
+func (r *repo) CreateTuple(ctx context.Context, tuple *model.RelationTuple,
+	revision int64,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, namespaceColumn, objectIDColumn, relationColumn,
+			subjectNamespaceColumn, subjectObjectIDColumn, subjectRelationColumn,
+			createdRevisionColumn).
+		Values(tenantID, tuple.Object.Namespace, tuple.Object.ID, tuple.Relation,
+			tuple.Subject.Namespace, tuple.Subject.ID, tuple.Subject.Relation, revision).
+		Suffix("ON CONFLICT (tenant_id, namespace, object_id, relation, subject_namespace, " +
+			"subject_object_id, subject_relation) WHERE deleted_revision IS NULL DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.CreateTuple",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// DeleteTuple marks tuple deleted at revision, deleting missing tuple is no-op,
+// deleted tuple stays visible to snapshots of earlier revisions
+func (r *repo) DeleteTuple(ctx context.Context, tuple *model.RelationTuple,
+	revision int64,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(deletedRevisionColumn, revision).
+		Where(sq.Eq{
+			tenantIDColumn:         tenantID,
+			namespaceColumn:        tuple.Object.Namespace,
+			objectIDColumn:         tuple.Object.ID,
+			relationColumn:         tuple.Relation,
+			subjectNamespaceColumn: tuple.Subject.Namespace,
+			subjectObjectIDColumn:  tuple.Subject.ID,
+			subjectRelationColumn:  tuple.Subject.Relation,
+			deletedRevisionColumn:  nil,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.DeleteTuple",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// ListTuples returns tuples of object relation visible at revision
+func (r *repo) ListTuples(ctx context.Context, object model.RelationObject, relation string,
+	revision int64,
+) ([]*model.RelationTuple, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(namespaceColumn, objectIDColumn, relationColumn,
+		subjectNamespaceColumn, subjectObjectIDColumn, subjectRelationColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			tenantIDColumn:  tenantID,
+			namespaceColumn: object.Namespace,
+			objectIDColumn:  object.ID,
+			relationColumn:  relation,
+		}).
+		Where(visibleAt(revision)).
+		OrderBy(subjectNamespaceColumn, subjectObjectIDColumn, subjectRelationColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.ListTuples",
+		QueryRaw: query,
+	}
+
+	var tuples []*modelRepo.RelationTuple
+	err = r.db.DB().ScanAllContext(ctx, &tuples, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRelationTuplesFromRepo(tuples), nil
+}
+
+// ListObjectIDs returns IDs of objects of namespace having tuples visible at revision
+func (r *repo) ListObjectIDs(ctx context.Context, namespace string, revision int64) (
+	[]string, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(objectIDColumn).
+		Distinct().
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, namespaceColumn: namespace}).
+		Where(visibleAt(revision)).
+		OrderBy(objectIDColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.ListObjectIDs",
+		QueryRaw: query,
+	}
+
+	var ids []string
+	err = r.db.DB().ScanAllContext(ctx, &ids, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// visibleAt selects tuples created at or before revision and not deleted by it
+func visibleAt(revision int64) sq.Sqlizer {
+	return sq.And{
+		sq.LtOrEq{createdRevisionColumn: revision},
+		sq.Or{
+			sq.Eq{deletedRevisionColumn: nil},
+			sq.Gt{deletedRevisionColumn: revision},
+		},
+	}
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/valek177/auth/internal/matcher"
 	"github.com/valek177/auth/internal/model"
 )
 
@@ -11,8 +13,52 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, newUser *model.NewUser) (int64, error)
 	GetUser(ctx context.Context, id int64) (*model.User, error)
 	GetUserByName(ctx context.Context, username string) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUsersByIDs(ctx context.Context, ids []int64) ([]*model.User, error)
 	UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserInfo) error
+	ActivateUser(ctx context.Context, id int64, passwordHash string) error
 	DeleteUser(ctx context.Context, id int64) error
+	CopyUsers(ctx context.Context, users []*model.ImportUser) (int64, error)
+	ListUsers(ctx context.Context, afterID int64, limit uint64) ([]*model.User, error)
+	FindUserNames(ctx context.Context, names []string) ([]string, error)
+}
+
+// OperationRepository is interface for long-running operations repository
+type OperationRepository interface {
+	CreateOperation(ctx context.Context, operation *model.Operation) (int64, error)
+	GetOperation(ctx context.Context, id int64) (*model.Operation, error)
+	UpdateOperation(ctx context.Context, operation *model.Operation) error
+}
+
+// InvitationRepository is interface for invitations repository
+type InvitationRepository interface {
+	CreateInvitation(ctx context.Context, invitation *model.Invitation) (int64, error)
+	GetInvitation(ctx context.Context, id int64) (*model.Invitation, error)
+	GetInvitationByTokenID(ctx context.Context, tokenID string) (*model.Invitation, error)
+	UpdateInvitationToken(ctx context.Context, id int64, tokenID string, expiresAt time.Time) error
+	UpdateInvitationStatus(ctx context.Context, id int64, status string) error
+	ListInvitationsByUserID(ctx context.Context, userID int64) ([]*model.Invitation, error)
+	DeleteInvitationsByUserID(ctx context.Context, userID int64) error
+}
+
+// ErasureRepository is interface for user erasure requests repository
+type ErasureRepository interface {
+	CreateErasureRequest(ctx context.Context, request *model.ErasureRequest) (int64, error)
+	GetErasureRequest(ctx context.Context, id int64) (*model.ErasureRequest, error)
+	UpdateErasureRequestStatus(ctx context.Context, id int64, status, reviewedBy string) error
+	PseudonymizeErasureRequests(ctx context.Context, username, pseudonym string) error
+}
+
+// RoleGrantRepository is interface for time-bound role grants repository
+type RoleGrantRepository interface {
+	CreateRoleGrant(ctx context.Context, grant *model.RoleGrant) (int64, error)
+	GetRoleGrant(ctx context.Context, id int64) (*model.RoleGrant, error)
+	GetActiveRoleGrant(ctx context.Context, userID int64, at time.Time) (*model.RoleGrant, error)
+	ListRoleGrants(ctx context.Context, filter *model.RoleGrantFilter) ([]*model.RoleGrant, error)
+	UpdateRoleGrantStatus(ctx context.Context, id int64, status, reviewedBy string) error
+	ExpireRoleGrants(ctx context.Context, at time.Time) (int64, error)
+	DeleteRoleGrantsByUserID(ctx context.Context, userID int64) error
+	PseudonymizeReviewer(ctx context.Context, username, pseudonym string) error
 }
 
 // AccessRepository is interface for access repository
@@ -20,17 +66,147 @@ type AccessRepository interface {
 	GetAccessRuleByEndpoint(ctx context.Context, endpoint string) (
 		*model.EndpointAccessRule, error,
 	)
+	GetAccessMatcher(ctx context.Context) (*matcher.Matcher, error)
+	CreateRule(ctx context.Context, rule *model.AccessRule) (int64, error)
+	GetRule(ctx context.Context, id int64) (*model.AccessRule, error)
+	ListRules(ctx context.Context, filter *model.AccessRuleFilter) ([]*model.AccessRule, error)
+	UpdateRule(ctx context.Context, update *model.UpdateAccessRule) error
+	DeleteRule(ctx context.Context, id int64) error
+}
+
+// PolicySnapshotRepository is interface for access policies of all tenants
+type PolicySnapshotRepository interface {
+	GetPolicyVersion(ctx context.Context) (int64, error)
+	GetPolicySnapshot(ctx context.Context) (*model.PolicySnapshot, error)
+}
+
+// PolicyChangeRepository is interface for audit log of access policy changes
+type PolicyChangeRepository interface {
+	CreatePolicyChange(ctx context.Context, change *model.PolicyChange) (int64, error)
+	PseudonymizeActor(ctx context.Context, username, pseudonym string) error
+}
+
+// PolicyBundleRepository is interface for applied policy bundles stored as versions
+// of tenant policy
+type PolicyBundleRepository interface {
+	CreatePolicyBundle(ctx context.Context, version *model.PolicyBundleVersion) (int64, error)
+	CreateInitialPolicyBundle(ctx context.Context, version *model.PolicyBundleVersion) error
+	GetPolicyBundle(ctx context.Context, version int64) (*model.PolicyBundleVersion, error)
+	ListPolicyBundles(ctx context.Context) ([]*model.PolicyBundleVersion, error)
+}
+
+// OAuthClientRepository is interface for OAuth clients registered in tenants
+type OAuthClientRepository interface {
+	CreateOAuthClient(ctx context.Context, client *model.OAuthClient) (int64, error)
+	GetOAuthClient(ctx context.Context, clientID string) (*model.OAuthClient, error)
+	ListOAuthClients(ctx context.Context) ([]*model.OAuthClient, error)
+	DeleteOAuthClient(ctx context.Context, clientID string) error
+}
+
+// OAuthAuthorizationCodeRepository is interface for issued OAuth authorization codes
+type OAuthAuthorizationCodeRepository interface {
+	CreateAuthorizationCode(ctx context.Context, code *model.OAuthAuthorizationCode) error
+	ConsumeAuthorizationCode(ctx context.Context, codeHash string) (
+		*model.OAuthAuthorizationCode, error)
+	DeleteExpiredAuthorizationCodes(ctx context.Context, at time.Time) error
+	ListAuthorizationCodesByUserID(ctx context.Context, userID int64) (
+		[]*model.OAuthAuthorizationCode, error)
+	DeleteAuthorizationCodesByUserID(ctx context.Context, userID int64) error
+}
+
+// ServiceAccountRepository is interface for service accounts of tenants
+type ServiceAccountRepository interface {
+	CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) (int64, error)
+	GetServiceAccount(ctx context.Context, clientID string) (*model.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]*model.ServiceAccount, error)
+	UpdateServiceAccountSecret(ctx context.Context, clientID string, secretHash string) error
+	DisableServiceAccount(ctx context.Context, clientID string) error
+}
+
+// FederatedIdentityRepository is interface for links of upstream provider accounts to users
+type FederatedIdentityRepository interface {
+	CreateFederatedIdentity(ctx context.Context, identity *model.FederatedIdentity) (int64, error)
+	GetFederatedIdentity(ctx context.Context, provider, subject string) (
+		*model.FederatedIdentity, error)
+	ListFederatedIdentitiesByUserID(ctx context.Context, userID int64) (
+		[]*model.FederatedIdentity, error)
+	DeleteFederatedIdentitiesByUserID(ctx context.Context, userID int64) error
+}
+
+// FederationStateRepository is interface for federated logins in progress
+type FederationStateRepository interface {
+	CreateLoginState(ctx context.Context, state *model.FederationLoginState) error
+	ConsumeLoginState(ctx context.Context, stateHash string) (*model.FederationLoginState, error)
+	DeleteExpiredLoginStates(ctx context.Context, at time.Time) error
+}
+
+// OrganizationRepository is interface for organization repository
+type OrganizationRepository interface {
+	CreateOrganization(ctx context.Context, name string) (int64, error)
+	GetOrganization(ctx context.Context, id int64) (*model.Organization, error)
+	ListOrganizations(ctx context.Context) ([]*model.Organization, error)
+	DeleteOrganization(ctx context.Context, id int64) error
+}
+
+// RoleRepository is interface for roles of tenant
+type RoleRepository interface {
+	CreateRole(ctx context.Context, role *model.Role) (int64, error)
+	ListRoles(ctx context.Context) ([]*model.Role, error)
+	DeleteRole(ctx context.Context, name string) error
+	AddRoleParent(ctx context.Context, role, parent string) error
+	DeleteRoleParent(ctx context.Context, role, parent string) error
+	ListRoleParents(ctx context.Context, role string) ([]string, error)
+	ListAncestorRoles(ctx context.Context, role string) ([]string, error)
+}
+
+// PermissionRepository is interface for permissions of tenant and their grants to roles
+type PermissionRepository interface {
+	CreatePermission(ctx context.Context, permission *model.Permission) (int64, error)
+	ListPermissions(ctx context.Context) ([]*model.Permission, error)
+	DeletePermission(ctx context.Context, name string) error
+	GrantPermission(ctx context.Context, role, permission string) error
+	RevokePermission(ctx context.Context, role, permission string) error
+	ListRolePermissions(ctx context.Context, role string) ([]string, error)
+	ListEffectivePermissions(ctx context.Context, role string) ([]string, error)
 }
 
 // LogRepository is interface for logging user actions
 type LogRepository interface {
 	CreateRecord(ctx context.Context, record *model.Record) (int64, error)
+	ListRecords(ctx context.Context, userID int64) ([]*model.Record, error)
+	PseudonymizeRecords(ctx context.Context, userID int64, pseudonym string) error
 }
 
 // UserRedisRepository is interface for redis repository
 type UserRedisRepository interface {
 	CreateUser(ctx context.Context, user *model.User) error
 	GetUser(ctx context.Context, id int64) (*model.User, error)
+	GetUsers(ctx context.Context, ids []int64) (map[int64]*model.User, error)
 	DeleteUser(ctx context.Context, id int64) error
 	SetExpireUser(ctx context.Context, id int64) error
 }
+
+// TokenRevocationRepository is interface for revoked tokens repository,
+// tokens are revoked by subject, see model.UserClaims.TokenSubject
+type TokenRevocationRepository interface {
+	RevokeUserTokens(ctx context.Context, subject string, revokedAt time.Time) error
+	GetTokensRevokedAt(ctx context.Context, subject string) (time.Time, error)
+}
+
+// RelationTupleRepository is interface for relation tuples repository,
+// tuples are versioned by revisions of tenant and read at snapshot of revision
+type RelationTupleRepository interface {
+	IncrementRevision(ctx context.Context) (int64, error)
+	GetRevision(ctx context.Context) (int64, error)
+	CreateTuple(ctx context.Context, tuple *model.RelationTuple, revision int64) error
+	DeleteTuple(ctx context.Context, tuple *model.RelationTuple, revision int64) error
+	ListTuples(ctx context.Context, object model.RelationObject, relation string,
+		revision int64) ([]*model.RelationTuple, error)
+	ListObjectIDs(ctx context.Context, namespace string, revision int64) ([]string, error)
+}
+
+// RelationNamespaceRepository is interface for relation namespaces configuration repository
+type RelationNamespaceRepository interface {
+	SaveNamespace(ctx context.Context, namespace *model.RelationNamespace) error
+	ListNamespaces(ctx context.Context) ([]*model.RelationNamespace, error)
+}
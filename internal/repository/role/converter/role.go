@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/role/model"
+)
+
+// ToRolesFromRepo converts roles from repository model to service model
+func ToRolesFromRepo(roles []*modelRepo.Role) []*model.Role {
+	res := make([]*model.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, &model.Role{
+			ID:          role.ID,
+			TenantID:    role.TenantID,
+			Name:        role.Name,
+			Description: role.Description,
+			CreatedAt:   role.CreatedAt,
+		})
+	}
+
+	return res
+}
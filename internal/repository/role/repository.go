@@ -0,0 +1,263 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/role/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/role/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName        = "roles"
+	parentsTableName = "role_parents"
+
+	idColumn          = "id"
+	tenantIDColumn    = "tenant_id"
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	createdAtColumn   = "created_at"
+	roleColumn        = "role"
+	parentColumn      = "parent"
+)
+
+// queryAncestorRoles selects all roles inherited by role directly or through other roles
+const queryAncestorRoles = `
+with recursive ancestors (name) as (
+    select parent
+    from role_parents
+    where tenant_id = $1
+      and role = $2
+    union
+    select p.parent
+    from role_parents p
+             join ancestors a on a.name = p.role
+    where p.tenant_id = $1
+)
+select name
+from ancestors
+order by name`
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new role repository
+func NewRepository(db db.Client) repository.RoleRepository {
+	return &repo{db: db}
+}
+
+// CreateRole creates new role in tenant
+func (r *repo) CreateRole(ctx context.Context, role *model.Role) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, nameColumn, descriptionColumn).
+		Values(tenantID, role.Name, role.Description).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.CreateRole",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListRoles returns roles of tenant
+func (r *repo) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, nameColumn, descriptionColumn,
+		createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(nameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.ListRoles",
+		QueryRaw: query,
+	}
+
+	var roles []*modelRepo.Role
+	err = r.db.DB().ScanAllContext(ctx, &roles, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRolesFromRepo(roles), nil
+}
+
+// DeleteRole removes role from tenant
+func (r *repo) DeleteRole(ctx context.Context, name string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name, tenantIDColumn: tenantID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.DeleteRole",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("role not found")
+	}
+
+	return nil
+}
+
+// AddRoleParent makes role inherit all permissions of parent role
+func (r *repo) AddRoleParent(ctx context.Context, role, parent string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(parentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, roleColumn, parentColumn).
+		Values(tenantID, role, parent).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.AddRoleParent",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// DeleteRoleParent removes inheritance of parent role
+func (r *repo) DeleteRoleParent(ctx context.Context, role, parent string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(parentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, roleColumn: role, parentColumn: parent})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.DeleteRoleParent",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorRoleParentNotFound
+	}
+
+	return nil
+}
+
+// ListRoleParents returns roles inherited by role directly
+func (r *repo) ListRoleParents(ctx context.Context, role string) ([]string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(parentColumn).
+		From(parentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, roleColumn: role}).
+		OrderBy(parentColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.ListRoleParents",
+		QueryRaw: query,
+	}
+
+	var parents []string
+	err = r.db.DB().ScanAllContext(ctx, &parents, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return parents, nil
+}
+
+// ListAncestorRoles returns all roles inherited by role directly or transitively
+func (r *repo) ListAncestorRoles(ctx context.Context, role string) ([]string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.ListAncestorRoles",
+		QueryRaw: queryAncestorRoles,
+	}
+
+	var ancestors []string
+	err = r.db.DB().ScanAllContext(ctx, &ancestors, q, tenantID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return ancestors, nil
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/rolegrant/model"
+)
+
+// ToRoleGrantFromRepo converts role grant from repository model to service model
+func ToRoleGrantFromRepo(grant *modelRepo.RoleGrant) *model.RoleGrant {
+	if grant == nil {
+		return &model.RoleGrant{}
+	}
+
+	return &model.RoleGrant{
+		ID:         grant.ID,
+		TenantID:   grant.TenantID,
+		UserID:     grant.UserID,
+		Role:       grant.Role,
+		Reason:     grant.Reason,
+		Status:     grant.Status,
+		ReviewedBy: grant.ReviewedBy,
+		StartsAt:   grant.StartsAt,
+		ExpiresAt:  grant.ExpiresAt,
+		CreatedAt:  grant.CreatedAt,
+		UpdatedAt:  grant.UpdatedAt,
+	}
+}
+
+// ToRoleGrantsFromRepo converts role grants from repository model to service model
+func ToRoleGrantsFromRepo(grants []*modelRepo.RoleGrant) []*model.RoleGrant {
+	res := make([]*model.RoleGrant, 0, len(grants))
+	for _, grant := range grants {
+		res = append(res, ToRoleGrantFromRepo(grant))
+	}
+
+	return res
+}
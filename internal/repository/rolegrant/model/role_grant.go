@@ -0,0 +1,21 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RoleGrant contains time-bound role grant info
+type RoleGrant struct {
+	ID         int64          `db:"id"`
+	TenantID   int64          `db:"tenant_id"`
+	UserID     int64          `db:"user_id"`
+	Role       string         `db:"role"`
+	Reason     string         `db:"reason"`
+	Status     string         `db:"status"`
+	ReviewedBy sql.NullString `db:"reviewed_by"`
+	StartsAt   time.Time      `db:"starts_at"`
+	ExpiresAt  time.Time      `db:"expires_at"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  sql.NullTime   `db:"updated_at"`
+}
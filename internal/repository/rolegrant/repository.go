@@ -0,0 +1,302 @@
+package rolegrant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/rolegrant/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/rolegrant/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "role_grants"
+
+	idColumn         = "id"
+	tenantIDColumn   = "tenant_id"
+	userIDColumn     = "user_id"
+	roleColumn       = "role"
+	reasonColumn     = "reason"
+	statusColumn     = "status"
+	reviewedByColumn = "reviewed_by"
+	startsAtColumn   = "starts_at"
+	expiresAtColumn  = "expires_at"
+	createdAtColumn  = "created_at"
+	updatedAtColumn  = "updated_at"
+)
+
+var selectColumns = []string{
+	idColumn, tenantIDColumn, userIDColumn, roleColumn, reasonColumn, statusColumn,
+	reviewedByColumn, startsAtColumn, expiresAtColumn, createdAtColumn, updatedAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new role grants repository
+func NewRepository(db db.Client) repository.RoleGrantRepository {
+	return &repo{db: db}
+}
+
+// CreateRoleGrant creates new role grant in tenant
+func (r *repo) CreateRoleGrant(ctx context.Context, grant *model.RoleGrant) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, userIDColumn, roleColumn, reasonColumn, statusColumn,
+			startsAtColumn, expiresAtColumn).
+		Values(tenantID, grant.UserID, grant.Role, grant.Reason, grant.Status, grant.StartsAt,
+			grant.ExpiresAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "role_grant_repository.CreateRoleGrant",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetRoleGrant returns role grant by id
+func (r *repo) GetRoleGrant(ctx context.Context, id int64) (*model.RoleGrant, error) {
+	return r.getRoleGrant(ctx, "role_grant_repository.GetRoleGrant", sq.Eq{idColumn: id})
+}
+
+// GetActiveRoleGrant returns approved grant of user which is active at given time,
+// the latest expiring one if several grants overlap
+func (r *repo) GetActiveRoleGrant(ctx context.Context, userID int64, at time.Time) (
+	*model.RoleGrant, error,
+) {
+	return r.getRoleGrant(ctx, "role_grant_repository.GetActiveRoleGrant", sq.And{
+		sq.Eq{userIDColumn: userID, statusColumn: model.RoleGrantStatusApproved},
+		sq.LtOrEq{startsAtColumn: at},
+		sq.Gt{expiresAtColumn: at},
+	})
+}
+
+func (r *repo) getRoleGrant(ctx context.Context, name string, cond sq.Sqlizer) (
+	*model.RoleGrant, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(cond).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(expiresAtColumn + " DESC").
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	var grant modelRepo.RoleGrant
+	err = r.db.DB().ScanOneContext(ctx, &grant, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorRoleGrantNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToRoleGrantFromRepo(&grant), nil
+}
+
+// ListRoleGrants returns role grants of tenant matching filter, newest first
+func (r *repo) ListRoleGrants(ctx context.Context, filter *model.RoleGrantFilter) (
+	[]*model.RoleGrant, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := sq.Eq{tenantIDColumn: tenantID}
+	if filter != nil && filter.UserID != 0 {
+		cond[userIDColumn] = filter.UserID
+	}
+	if filter != nil && filter.Status != "" {
+		cond[statusColumn] = filter.Status
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(cond).
+		OrderBy(idColumn + " DESC")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_grant_repository.ListRoleGrants",
+		QueryRaw: query,
+	}
+
+	var grants []*modelRepo.RoleGrant
+	err = r.db.DB().ScanAllContext(ctx, &grants, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRoleGrantsFromRepo(grants), nil
+}
+
+// UpdateRoleGrantStatus sets status of pending not expired role grant and its reviewer,
+// status is checked by the update itself so only one of concurrent reviews succeeds
+func (r *repo) UpdateRoleGrantStatus(ctx context.Context, id int64,
+	status, reviewedBy string,
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, status).
+		Set(reviewedByColumn, reviewedBy).
+		Where(sq.Eq{
+			idColumn:       id,
+			tenantIDColumn: tenantID,
+			statusColumn:   model.RoleGrantStatusPending,
+		}).
+		Where(sq.Gt{expiresAtColumn: time.Now()})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_grant_repository.UpdateRoleGrantStatus",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorRoleGrantNotFound
+	}
+
+	return nil
+}
+
+// ExpireRoleGrants marks pending and approved grants of all tenants which expired
+// not later than given time as expired and returns their count
+func (r *repo) ExpireRoleGrants(ctx context.Context, at time.Time) (int64, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, model.RoleGrantStatusExpired).
+		Where(sq.Eq{statusColumn: []string{
+			model.RoleGrantStatusPending,
+			model.RoleGrantStatusApproved,
+		}}).
+		Where(sq.LtOrEq{expiresAtColumn: at})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "role_grant_repository.ExpireRoleGrants",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// DeleteRoleGrantsByUserID deletes all role grants of user
+func (r *repo) DeleteRoleGrantsByUserID(ctx context.Context, userID int64) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_grant_repository.DeleteRoleGrantsByUserID",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// PseudonymizeReviewer replaces name of reviewer in role grants of tenant with pseudonym
+func (r *repo) PseudonymizeReviewer(ctx context.Context, username, pseudonym string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(reviewedByColumn, pseudonym).
+		Where(sq.Eq{tenantIDColumn: tenantID, reviewedByColumn: username})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_grant_repository.PseudonymizeReviewer",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	modelRepo "github.com/valek177/auth/internal/repository/serviceaccount/model"
+)
+
+// ToServiceAccountFromRepo converts service account from repository model to service model
+func ToServiceAccountFromRepo(account *modelRepo.ServiceAccount) *model.ServiceAccount {
+	if account == nil {
+		return &model.ServiceAccount{}
+	}
+
+	return &model.ServiceAccount{
+		ID:              account.ID,
+		TenantID:        account.TenantID,
+		ClientID:        account.ClientID,
+		Name:            account.Name,
+		Role:            account.Role,
+		Scopes:          account.Scopes,
+		SecretHash:      account.SecretHash,
+		Disabled:        account.Disabled,
+		SecretRotatedAt: account.SecretRotatedAt,
+		CreatedAt:       account.CreatedAt,
+		UpdatedAt:       account.UpdatedAt,
+	}
+}
+
+// ToServiceAccountsFromRepo converts service accounts from repository model to service model
+func ToServiceAccountsFromRepo(accounts []*modelRepo.ServiceAccount) []*model.ServiceAccount {
+	res := make([]*model.ServiceAccount, 0, len(accounts))
+	for _, account := range accounts {
+		res = append(res, ToServiceAccountFromRepo(account))
+	}
+
+	return res
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ServiceAccount contains service account info
+type ServiceAccount struct {
+	ID              int64        `db:"id"`
+	TenantID        int64        `db:"tenant_id"`
+	ClientID        string       `db:"client_id"`
+	Name            string       `db:"name"`
+	Role            string       `db:"role"`
+	Scopes          []string     `db:"scopes"`
+	SecretHash      string       `db:"secret_hash"`
+	Disabled        bool         `db:"disabled"`
+	SecretRotatedAt time.Time    `db:"secret_rotated_at"`
+	CreatedAt       time.Time    `db:"created_at"`
+	UpdatedAt       sql.NullTime `db:"updated_at"`
+}
@@ -0,0 +1,197 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/repository/serviceaccount/converter"
+	modelRepo "github.com/valek177/auth/internal/repository/serviceaccount/model"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	tableName = "service_accounts"
+
+	idColumn              = "id"
+	tenantIDColumn        = "tenant_id"
+	clientIDColumn        = "client_id"
+	nameColumn            = "name"
+	roleColumn            = "role"
+	scopesColumn          = "scopes"
+	secretHashColumn      = "secret_hash"
+	disabledColumn        = "disabled"
+	secretRotatedAtColumn = "secret_rotated_at"
+	createdAtColumn       = "created_at"
+	updatedAtColumn       = "updated_at"
+)
+
+var selectColumns = []string{
+	idColumn, tenantIDColumn, clientIDColumn, nameColumn, roleColumn, scopesColumn,
+	secretHashColumn, disabledColumn, secretRotatedAtColumn, createdAtColumn, updatedAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new service accounts repository
+func NewRepository(db db.Client) repository.ServiceAccountRepository {
+	return &repo{db: db}
+}
+
+// CreateServiceAccount creates new service account in tenant
+func (r *repo) CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) (
+	int64, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tenantIDColumn, clientIDColumn, nameColumn, roleColumn, scopesColumn,
+			secretHashColumn).
+		Values(tenantID, account.ClientID, account.Name, account.Role, account.Scopes,
+			account.SecretHash).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "service_account_repository.CreateServiceAccount",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetServiceAccount returns service account of any tenant, client ID determines tenant
+// of client credentials grant
+func (r *repo) GetServiceAccount(ctx context.Context, clientID string) (
+	*model.ServiceAccount, error,
+) {
+	builderSelectOne := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{clientIDColumn: clientID}).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "service_account_repository.GetServiceAccount",
+		QueryRaw: query,
+	}
+
+	var account modelRepo.ServiceAccount
+	err = r.db.DB().ScanOneContext(ctx, &account, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorServiceAccountNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToServiceAccountFromRepo(&account), nil
+}
+
+// ListServiceAccounts returns service accounts of tenant
+func (r *repo) ListServiceAccounts(ctx context.Context) ([]*model.ServiceAccount, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		OrderBy(idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "service_account_repository.ListServiceAccounts",
+		QueryRaw: query,
+	}
+
+	var accounts []*modelRepo.ServiceAccount
+	err = r.db.DB().ScanAllContext(ctx, &accounts, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToServiceAccountsFromRepo(accounts), nil
+}
+
+// UpdateServiceAccountSecret replaces secret hash of service account of tenant
+func (r *repo) UpdateServiceAccountSecret(ctx context.Context, clientID string,
+	secretHash string,
+) error {
+	return r.update(ctx, "service_account_repository.UpdateServiceAccountSecret", clientID,
+		map[string]interface{}{
+			secretHashColumn:      secretHash,
+			secretRotatedAtColumn: sq.Expr("now()"),
+		})
+}
+
+// DisableServiceAccount disables service account of tenant
+func (r *repo) DisableServiceAccount(ctx context.Context, clientID string) error {
+	return r.update(ctx, "service_account_repository.DisableServiceAccount", clientID,
+		map[string]interface{}{disabledColumn: true})
+}
+
+func (r *repo) update(ctx context.Context, name string, clientID string,
+	values map[string]interface{},
+) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		SetMap(values).
+		Where(sq.Eq{tenantIDColumn: tenantID, clientIDColumn: clientID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrorServiceAccountNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"github.com/valek177/auth/internal/model"
+	repoModel "github.com/valek177/auth/internal/repository/snapshot/model"
+)
+
+// ToRoleParentsFromRepo converts role inheritance from repository model to service model
+func ToRoleParentsFromRepo(parents []*repoModel.RoleParent) []*model.RoleParent {
+	res := make([]*model.RoleParent, 0, len(parents))
+	for _, parent := range parents {
+		res = append(res, &model.RoleParent{
+			TenantID: parent.TenantID,
+			Role:     parent.Role,
+			Parent:   parent.Parent,
+		})
+	}
+
+	return res
+}
+
+// ToRolePermissionsFromRepo converts permission grants from repository model to service model
+func ToRolePermissionsFromRepo(grants []*repoModel.RolePermission) []*model.RolePermission {
+	res := make([]*model.RolePermission, 0, len(grants))
+	for _, grant := range grants {
+		res = append(res, &model.RolePermission{
+			TenantID:   grant.TenantID,
+			Role:       grant.Role,
+			Permission: grant.Permission,
+		})
+	}
+
+	return res
+}
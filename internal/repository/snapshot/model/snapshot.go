@@ -0,0 +1,15 @@
+package model
+
+// RoleParent is a struct for role inheritance record
+type RoleParent struct {
+	TenantID int64  `db:"tenant_id"`
+	Role     string `db:"role"`
+	Parent   string `db:"parent"`
+}
+
+// RolePermission is a struct for permission grant record
+type RolePermission struct {
+	TenantID   int64  `db:"tenant_id"`
+	Role       string `db:"role"`
+	Permission string `db:"permission"`
+}
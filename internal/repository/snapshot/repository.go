@@ -0,0 +1,167 @@
+package snapshot
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	accessConverter "github.com/valek177/auth/internal/repository/access/converter"
+	accessModel "github.com/valek177/auth/internal/repository/access/model"
+	"github.com/valek177/auth/internal/repository/snapshot/converter"
+	repoModel "github.com/valek177/auth/internal/repository/snapshot/model"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	versionTableName    = "policy_versions"
+	rulesTableName      = "access_list"
+	parentsTableName    = "role_parents"
+	permissionTableName = "role_permissions"
+
+	idColumn         = "id"
+	tenantIDColumn   = "tenant_id"
+	versionColumn    = "version"
+	effectColumn     = "effect"
+	permissionColumn = "permission"
+	roleColumn       = "role"
+	parentColumn     = "parent"
+	userIDColumn     = "user_id"
+	endpointColumn   = "endpoint"
+	conditionColumn  = "condition"
+	createdAtColumn  = "created_at"
+	updatedAtColumn  = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new repository of access policies of all tenants
+func NewRepository(db db.Client) repository.PolicySnapshotRepository {
+	return &repo{db: db}
+}
+
+// GetPolicyVersion returns current version of access policies
+func (r *repo) GetPolicyVersion(ctx context.Context) (int64, error) {
+	query, args, err := sq.Select(versionColumn).
+		From(versionTableName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "snapshot_repository.GetPolicyVersion",
+		QueryRaw: query,
+	}
+
+	var version int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// GetPolicySnapshot returns access rules and role grants of all tenants,
+// version is read first so snapshot is never older than its version
+func (r *repo) GetPolicySnapshot(ctx context.Context) (*model.PolicySnapshot, error) {
+	version, err := r.GetPolicyVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := r.listRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parents, err := r.listRoleParents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := r.listRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.PolicySnapshot{
+		Version:         version,
+		Rules:           rules,
+		RoleParents:     parents,
+		RolePermissions: grants,
+	}, nil
+}
+
+func (r *repo) listRules(ctx context.Context) ([]*model.AccessRule, error) {
+	query, args, err := sq.Select(idColumn, tenantIDColumn, effectColumn, permissionColumn,
+		roleColumn, userIDColumn, endpointColumn, conditionColumn, createdAtColumn,
+		updatedAtColumn).
+		From(rulesTableName).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy(idColumn).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "snapshot_repository.listRules",
+		QueryRaw: query,
+	}
+
+	var rules []*accessModel.Rule
+	if err = r.db.DB().ScanAllContext(ctx, &rules, q, args...); err != nil {
+		return nil, err
+	}
+
+	return accessConverter.ToAccessRulesFromRepo(rules), nil
+}
+
+func (r *repo) listRoleParents(ctx context.Context) ([]*model.RoleParent, error) {
+	query, args, err := sq.Select(tenantIDColumn, roleColumn, parentColumn).
+		From(parentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "snapshot_repository.listRoleParents",
+		QueryRaw: query,
+	}
+
+	var parents []*repoModel.RoleParent
+	if err = r.db.DB().ScanAllContext(ctx, &parents, q, args...); err != nil {
+		return nil, err
+	}
+
+	return converter.ToRoleParentsFromRepo(parents), nil
+}
+
+func (r *repo) listRolePermissions(ctx context.Context) ([]*model.RolePermission, error) {
+	query, args, err := sq.Select(tenantIDColumn, roleColumn, permissionColumn).
+		From(permissionTableName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "snapshot_repository.listRolePermissions",
+		QueryRaw: query,
+	}
+
+	var grants []*repoModel.RolePermission
+	if err = r.db.DB().ScanAllContext(ctx, &grants, q, args...); err != nil {
+		return nil, err
+	}
+
+	return converter.ToRolePermissionsFromRepo(grants), nil
+}
@@ -0,0 +1,350 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+)
+
+// notifyChannel is channel notified by database triggers with new policy version
+const notifyChannel = "policy_changed"
+
+// snapshot is immutable compiled access policies of all tenants
+type snapshot struct {
+	version int64
+	// syncedAt is time when snapshot was last known to be current
+	syncedAt time.Time
+	tenants  map[int64]*tenantPolicy
+}
+
+// tenantPolicy is compiled access policies of tenant
+type tenantPolicy struct {
+	matcher *matcher.Matcher
+	// ancestors and permissions are resolved for every role having parents or grants
+	ancestors   map[string][]string
+	permissions map[string][]string
+}
+
+// Store keeps snapshot of access policies of all tenants in memory and replaces it atomically
+// on change, changes are notified by database triggers and missed notifications are caught
+// by periodic resync. Snapshot which is not synced for staleAfter is not used.
+type Store struct {
+	repo           repository.PolicySnapshotRepository
+	dsn            string
+	resyncInterval time.Duration
+	staleAfter     time.Duration
+
+	current atomic.Pointer[snapshot]
+	// reloadMu serializes reloads so older snapshot never replaces newer one
+	reloadMu sync.Mutex
+}
+
+// NewStore creates store which is empty until Reload or Run
+func NewStore(repo repository.PolicySnapshotRepository, dsn string,
+	resyncInterval time.Duration,
+) *Store {
+	return &Store{
+		repo:           repo,
+		dsn:            dsn,
+		resyncInterval: resyncInterval,
+		staleAfter:     3 * resyncInterval,
+	}
+}
+
+// Version returns version of current snapshot, zero if it is not loaded
+func (s *Store) Version() int64 {
+	snap := s.current.Load()
+	if snap == nil {
+		return 0
+	}
+
+	return snap.version
+}
+
+// SyncedAt returns time when current snapshot was last known to be current
+func (s *Store) SyncedAt() time.Time {
+	snap := s.current.Load()
+	if snap == nil {
+		return time.Time{}
+	}
+
+	return snap.syncedAt
+}
+
+// tenant returns policies of tenant from current snapshot,
+// ok is false if snapshot is not loaded or stale
+func (s *Store) tenant(tenantID int64) (*tenantPolicy, bool) {
+	snap := s.current.Load()
+	if snap == nil || time.Since(snap.syncedAt) > s.staleAfter {
+		return nil, false
+	}
+
+	policy, ok := snap.tenants[tenantID]
+	if !ok {
+		return emptyPolicy, true
+	}
+
+	return policy, true
+}
+
+// Reload loads and compiles policies of all tenants and replaces current snapshot
+func (s *Store) Reload(ctx context.Context) error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	return s.reload(ctx)
+}
+
+func (s *Store) reload(ctx context.Context) error {
+	policies, err := s.repo.GetPolicySnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	snap, err := compile(policies)
+	if err != nil {
+		return err
+	}
+
+	if current := s.current.Load(); current != nil && current.version > snap.version {
+		return nil
+	}
+	s.current.Store(snap)
+
+	return nil
+}
+
+// Sync reloads snapshot if policy version changed, otherwise marks snapshot as current
+func (s *Store) Sync(ctx context.Context) error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	current := s.current.Load()
+	if current == nil {
+		return s.reload(ctx)
+	}
+
+	version, err := s.repo.GetPolicyVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version != current.version {
+		return s.reload(ctx)
+	}
+
+	synced := *current
+	synced.syncedAt = time.Now()
+	s.current.Store(&synced)
+
+	return nil
+}
+
+// Run keeps snapshot current until context is done: it listens for notifications of
+// policy changes and resyncs periodically, while listener is down snapshot is polled
+func (s *Store) Run(ctx context.Context) {
+	for {
+		err := s.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Error("policy snapshot listener failed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.resyncInterval):
+		}
+
+		if err = s.Sync(ctx); err != nil {
+			logger.Error("failed to sync policy snapshot", zap.Error(err))
+		}
+	}
+}
+
+func (s *Store) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, s.dsn)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(context.Background())
+	}()
+
+	if _, err = conn.Exec(ctx, "listen "+notifyChannel); err != nil {
+		return err
+	}
+
+	// changes made before listening started are caught by sync
+	if err = s.Sync(ctx); err != nil {
+		return err
+	}
+
+	for {
+		waitCtx, cancel := context.WithTimeout(ctx, s.resyncInterval)
+		notification, errWait := conn.WaitForNotification(waitCtx)
+		cancel()
+
+		switch {
+		case errWait == nil:
+			version, errParse := strconv.ParseInt(notification.Payload, 10, 64)
+			if errParse == nil && version <= s.Version() {
+				continue
+			}
+			err = s.Reload(ctx)
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(errWait, context.DeadlineExceeded):
+			err = s.Sync(ctx)
+		default:
+			return errWait
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// emptyPolicy is policy of tenant without rules and grants
+var emptyPolicy = func() *tenantPolicy {
+	m, _ := matcher.New(nil)
+
+	return &tenantPolicy{matcher: m}
+}()
+
+func compile(policies *model.PolicySnapshot) (*snapshot, error) {
+	rules := make(map[int64][]*model.AccessRule)
+	for _, rule := range policies.Rules {
+		rules[rule.TenantID] = append(rules[rule.TenantID], rule)
+	}
+	parents := make(map[int64]map[string][]string)
+	for _, parent := range policies.RoleParents {
+		if parents[parent.TenantID] == nil {
+			parents[parent.TenantID] = make(map[string][]string)
+		}
+		parents[parent.TenantID][parent.Role] = append(parents[parent.TenantID][parent.Role],
+			parent.Parent)
+	}
+	grants := make(map[int64]map[string][]string)
+	for _, grant := range policies.RolePermissions {
+		if grants[grant.TenantID] == nil {
+			grants[grant.TenantID] = make(map[string][]string)
+		}
+		grants[grant.TenantID][grant.Role] = append(grants[grant.TenantID][grant.Role],
+			grant.Permission)
+	}
+
+	tenants := make(map[int64]bool)
+	for tenantID := range rules {
+		tenants[tenantID] = true
+	}
+	for tenantID := range parents {
+		tenants[tenantID] = true
+	}
+	for tenantID := range grants {
+		tenants[tenantID] = true
+	}
+
+	snap := &snapshot{
+		version:  policies.Version,
+		syncedAt: time.Now(),
+		tenants:  make(map[int64]*tenantPolicy, len(tenants)),
+	}
+	for tenantID := range tenants {
+		m, err := matcher.New(rules[tenantID])
+		if err != nil {
+			return nil, err
+		}
+
+		snap.tenants[tenantID] = compileRoles(m, parents[tenantID], grants[tenantID])
+	}
+
+	return snap, nil
+}
+
+// compileRoles resolves ancestors and effective permissions of every role
+// having parents or grants
+func compileRoles(m *matcher.Matcher, parents, grants map[string][]string) *tenantPolicy {
+	policy := &tenantPolicy{
+		matcher:     m,
+		ancestors:   make(map[string][]string),
+		permissions: make(map[string][]string),
+	}
+
+	roles := make(map[string]bool)
+	for role := range parents {
+		roles[role] = true
+	}
+	for role := range grants {
+		roles[role] = true
+	}
+
+	for role := range roles {
+		// role itself is its ancestor only if inheritance has cycle through it
+		visited := make(map[string]bool)
+		queue := append([]string(nil), parents[role]...)
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+			queue = append(queue, parents[name]...)
+		}
+
+		ancestors := make([]string, 0, len(visited))
+		for name := range visited {
+			ancestors = append(ancestors, name)
+		}
+		sort.Strings(ancestors)
+		policy.ancestors[role] = ancestors
+
+		permissions := make(map[string]bool)
+		for _, permission := range grants[role] {
+			permissions[permission] = true
+		}
+		for _, name := range ancestors {
+			for _, permission := range grants[name] {
+				permissions[permission] = true
+			}
+		}
+		list := make([]string, 0, len(permissions))
+		for permission := range permissions {
+			list = append(list, permission)
+		}
+		sort.Strings(list)
+		policy.permissions[role] = list
+	}
+
+	return policy
+}
+
+// roleAncestors returns roles inherited by role
+func (p *tenantPolicy) roleAncestors(role string) []string {
+	if ancestors, ok := p.ancestors[role]; ok {
+		return ancestors
+	}
+
+	return []string{}
+}
+
+// rolePermissions returns effective permissions of role
+func (p *tenantPolicy) rolePermissions(role string) []string {
+	if permissions, ok := p.permissions[role]; ok {
+		return permissions
+	}
+
+	return []string{}
+}
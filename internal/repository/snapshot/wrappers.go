@@ -0,0 +1,112 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+type accessRepo struct {
+	repository.AccessRepository
+
+	store *Store
+}
+
+// NewAccessRepository wraps access repository to match endpoints against rules of snapshot,
+// rules are read from wrapped repository while snapshot is not loaded or stale
+func NewAccessRepository(repo repository.AccessRepository, store *Store,
+) repository.AccessRepository {
+	return &accessRepo{AccessRepository: repo, store: store}
+}
+
+// GetAccessRuleByEndpoint matches endpoint against rules of tenant
+func (r *accessRepo) GetAccessRuleByEndpoint(ctx context.Context, endpoint string) (
+	*model.EndpointAccessRule, error,
+) {
+	m, err := r.GetAccessMatcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Match(endpoint), nil
+}
+
+// GetAccessMatcher returns compiled rules of tenant
+func (r *accessRepo) GetAccessMatcher(ctx context.Context) (*matcher.Matcher, error) {
+	policy, ok, err := r.store.policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.AccessRepository.GetAccessMatcher(ctx)
+	}
+
+	return policy.matcher, nil
+}
+
+type roleRepo struct {
+	repository.RoleRepository
+
+	store *Store
+}
+
+// NewRoleRepository wraps role repository to resolve inherited roles by snapshot
+func NewRoleRepository(repo repository.RoleRepository, store *Store) repository.RoleRepository {
+	return &roleRepo{RoleRepository: repo, store: store}
+}
+
+// ListAncestorRoles returns roles inherited by role
+func (r *roleRepo) ListAncestorRoles(ctx context.Context, role string) ([]string, error) {
+	policy, ok, err := r.store.policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.RoleRepository.ListAncestorRoles(ctx, role)
+	}
+
+	return policy.roleAncestors(role), nil
+}
+
+type permissionRepo struct {
+	repository.PermissionRepository
+
+	store *Store
+}
+
+// NewPermissionRepository wraps permission repository to resolve effective permissions
+// by snapshot
+func NewPermissionRepository(repo repository.PermissionRepository, store *Store,
+) repository.PermissionRepository {
+	return &permissionRepo{PermissionRepository: repo, store: store}
+}
+
+// ListEffectivePermissions returns permissions granted to role and roles it inherits
+func (r *permissionRepo) ListEffectivePermissions(ctx context.Context, role string) (
+	[]string, error,
+) {
+	policy, ok, err := r.store.policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.PermissionRepository.ListEffectivePermissions(ctx, role)
+	}
+
+	return policy.rolePermissions(role), nil
+}
+
+// policy returns policies of context tenant from current snapshot
+func (s *Store) policy(ctx context.Context) (*tenantPolicy, bool, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	policy, ok := s.tenant(tenantID)
+
+	return policy, ok, nil
+}
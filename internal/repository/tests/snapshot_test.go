@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/repository/snapshot"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+func TestPolicySnapshot(t *testing.T) {
+	t.Parallel()
+	mc := minimock.NewController(t)
+
+	ctx := tenant.NewContext(context.Background(), tenantID)
+	otherCtx := tenant.NewContext(context.Background(), tenantID+1)
+
+	policies := &model.PolicySnapshot{
+		Version: 5,
+		Rules: []*model.AccessRule{
+			{ID: 1, TenantID: tenantID, Effect: model.AccessEffectAllow, Role: "USER",
+				Endpoint: "/user_v1.UserV1/Get"},
+		},
+		RoleParents: []*model.RoleParent{
+			{TenantID: tenantID, Role: "SUPPORT", Parent: "USER"},
+			{TenantID: tenantID, Role: "USER", Parent: "GUEST"},
+		},
+		RolePermissions: []*model.RolePermission{
+			{TenantID: tenantID, Role: "GUEST", Permission: "user:read"},
+			{TenantID: tenantID, Role: "SUPPORT", Permission: "user:update"},
+		},
+	}
+
+	snapshotMock := repoMocks.NewPolicySnapshotRepositoryMock(mc)
+	snapshotMock.GetPolicySnapshotMock.Times(1).Return(policies, nil)
+	snapshotMock.GetPolicyVersionMock.Times(1).Return(5, nil)
+
+	store := snapshot.NewStore(snapshotMock, "", time.Minute)
+	require.NoError(t, store.Sync(ctx))
+	// version is unchanged so snapshot is not reloaded
+	require.NoError(t, store.Sync(ctx))
+	assert.Equal(t, int64(5), store.Version())
+
+	accessRepo := snapshot.NewAccessRepository(repoMocks.NewAccessRepositoryMock(mc), store)
+	roleRepo := snapshot.NewRoleRepository(repoMocks.NewRoleRepositoryMock(mc), store)
+	permissionRepo := snapshot.NewPermissionRepository(repoMocks.NewPermissionRepositoryMock(mc),
+		store)
+
+	rules, err := accessRepo.GetAccessRuleByEndpoint(ctx, "/user_v1.UserV1/Get")
+	require.NoError(t, err)
+	require.NotNil(t, rules)
+	assert.Equal(t, int64(1), rules.Allow[0].ID)
+
+	rules, err = accessRepo.GetAccessRuleByEndpoint(otherCtx, "/user_v1.UserV1/Get")
+	require.NoError(t, err)
+	assert.Nil(t, rules)
+
+	ancestors, err := roleRepo.ListAncestorRoles(ctx, "SUPPORT")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GUEST", "USER"}, ancestors)
+
+	permissions, err := permissionRepo.ListEffectivePermissions(ctx, "SUPPORT")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user:read", "user:update"}, permissions)
+
+	permissions, err = permissionRepo.ListEffectivePermissions(ctx, "AUDITOR")
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+
+	_, err = roleRepo.ListAncestorRoles(context.Background(), "SUPPORT")
+	assert.ErrorIs(t, err, model.ErrorTenantNotSpecified)
+}
+
+func TestPolicySnapshotFallback(t *testing.T) {
+	t.Parallel()
+	mc := minimock.NewController(t)
+
+	ctx := tenant.NewContext(context.Background(), tenantID)
+
+	snapshotMock := repoMocks.NewPolicySnapshotRepositoryMock(mc)
+	snapshotMock.GetPolicySnapshotMock.Times(1).Return(&model.PolicySnapshot{Version: 1}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Times(2).Return([]string{"USER"}, nil)
+
+	// snapshot is stale as soon as it is loaded
+	store := snapshot.NewStore(snapshotMock, "", time.Nanosecond)
+	roleRepo := snapshot.NewRoleRepository(roleMock, store)
+
+	ancestors, err := roleRepo.ListAncestorRoles(ctx, "SUPPORT")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USER"}, ancestors)
+
+	require.NoError(t, store.Reload(ctx))
+	time.Sleep(time.Millisecond)
+
+	ancestors, err = roleRepo.ListAncestorRoles(ctx, "SUPPORT")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USER"}, ancestors)
+}
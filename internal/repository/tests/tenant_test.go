@@ -0,0 +1,387 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/model"
+	accessRepo "github.com/valek177/auth/internal/repository/access"
+	federatedIdentityRepo "github.com/valek177/auth/internal/repository/federatedidentity"
+	federationStateRepo "github.com/valek177/auth/internal/repository/federationstate"
+	logRepo "github.com/valek177/auth/internal/repository/log"
+	operationRepo "github.com/valek177/auth/internal/repository/operation"
+	redisRepo "github.com/valek177/auth/internal/repository/redis"
+	roleRepo "github.com/valek177/auth/internal/repository/role"
+	userRepo "github.com/valek177/auth/internal/repository/user"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const tenantID = int64(42)
+
+// query is a query executed by repository
+type query struct {
+	sql  string
+	args []interface{}
+}
+
+// fakeDB records queries instead of executing them
+type fakeDB struct {
+	mu      sync.Mutex
+	queries []query
+}
+
+func (f *fakeDB) Close() {}
+
+// fakeClient is db client over fakeDB
+type fakeClient struct {
+	db *fakeDB
+}
+
+func (c fakeClient) DB() db.DB    { return c.db }
+func (c fakeClient) Close() error { return nil }
+
+func (f *fakeDB) record(q db.Query, args []interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queries = append(f.queries, query{sql: q.QueryRaw, args: args})
+}
+
+func (f *fakeDB) last() query {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.queries[len(f.queries)-1]
+}
+
+func (f *fakeDB) ScanOneContext(_ context.Context, _ interface{}, q db.Query, args ...interface{}) error {
+	f.record(q, args)
+	return pgx.ErrNoRows
+}
+
+func (f *fakeDB) ScanAllContext(_ context.Context, _ interface{}, q db.Query, args ...interface{}) error {
+	f.record(q, args)
+	return nil
+}
+
+func (f *fakeDB) ExecContext(_ context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
+	f.record(q, args)
+	return pgconn.CommandTag("DELETE 1"), nil
+}
+
+func (f *fakeDB) QueryContext(_ context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	f.record(q, args)
+	return nil, pgx.ErrNoRows
+}
+
+func (f *fakeDB) QueryRowContext(_ context.Context, q db.Query, args ...interface{}) pgx.Row {
+	f.record(q, args)
+	return fakeRow{}
+}
+
+func (f *fakeDB) BeginTx(_ context.Context, _ pgx.TxOptions) (pgx.Tx, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (f *fakeDB) Ping(_ context.Context) error { return nil }
+
+type fakeRow struct{}
+
+func (fakeRow) Scan(dest ...interface{}) error {
+	if id, ok := dest[0].(*int64); ok {
+		*id = 1
+	}
+	return nil
+}
+
+// assertTenantScoped checks that query filters or inserts by tenant of context
+func assertTenantScoped(t *testing.T, q query) {
+	t.Helper()
+
+	idx := strings.Index(q.sql, "tenant_id")
+	require.NotEqual(t, -1, idx, "query is not scoped by tenant: %s", q.sql)
+
+	found := false
+	for _, arg := range q.args {
+		if arg == tenantID {
+			found = true
+		}
+	}
+	assert.True(t, found, "query has no tenant argument: %s %v", q.sql, q.args)
+}
+
+func TestRepositoriesAreTenantScoped(t *testing.T) {
+	t.Parallel()
+
+	ctx := tenant.NewContext(context.Background(), tenantID)
+	name := "user"
+	role := "USER"
+
+	cases := []struct {
+		name string
+		call func(dbc *fakeDB) error
+	}{
+		{
+			name: "user: create",
+			call: func(dbc *fakeDB) error {
+				_, err := userRepo.NewRepository(fakeClient{db: dbc}).CreateUser(ctx, &model.NewUser{Name: name})
+				return err
+			},
+		},
+		{
+			name: "user: get",
+			call: func(dbc *fakeDB) error {
+				_, _ = userRepo.NewRepository(fakeClient{db: dbc}).GetUser(ctx, 1)
+				return nil
+			},
+		},
+		{
+			name: "user: get by name",
+			call: func(dbc *fakeDB) error {
+				_, _ = userRepo.NewRepository(fakeClient{db: dbc}).GetUserByName(ctx, name)
+				return nil
+			},
+		},
+		{
+			name: "user: update",
+			call: func(dbc *fakeDB) error {
+				return userRepo.NewRepository(fakeClient{db: dbc}).UpdateUser(ctx,
+					&model.UpdateUserInfo{ID: 1, Name: &name, Role: &role})
+			},
+		},
+		{
+			name: "user: delete",
+			call: func(dbc *fakeDB) error {
+				return userRepo.NewRepository(fakeClient{db: dbc}).DeleteUser(ctx, 1)
+			},
+		},
+		{
+			name: "user: list",
+			call: func(dbc *fakeDB) error {
+				_, err := userRepo.NewRepository(fakeClient{db: dbc}).ListUsers(ctx, 0, 10)
+				return err
+			},
+		},
+		{
+			name: "user: find names",
+			call: func(dbc *fakeDB) error {
+				_, err := userRepo.NewRepository(fakeClient{db: dbc}).FindUserNames(ctx, []string{name})
+				return err
+			},
+		},
+		{
+			name: "operation: create",
+			call: func(dbc *fakeDB) error {
+				_, err := operationRepo.NewRepository(fakeClient{db: dbc}).CreateOperation(ctx,
+					&model.Operation{Kind: model.OperationKindImportUsers})
+				return err
+			},
+		},
+		{
+			name: "operation: get",
+			call: func(dbc *fakeDB) error {
+				_, _ = operationRepo.NewRepository(fakeClient{db: dbc}).GetOperation(ctx, 1)
+				return nil
+			},
+		},
+		{
+			name: "operation: update",
+			call: func(dbc *fakeDB) error {
+				return operationRepo.NewRepository(fakeClient{db: dbc}).UpdateOperation(ctx,
+					&model.Operation{ID: 1})
+			},
+		},
+		{
+			name: "log: create record",
+			call: func(dbc *fakeDB) error {
+				_, err := logRepo.NewRepository(fakeClient{db: dbc}).CreateRecord(ctx, &model.Record{UserID: 1})
+				return err
+			},
+		},
+		{
+			name: "access: get rule by endpoint",
+			call: func(dbc *fakeDB) error {
+				_, err := accessRepo.NewRepository(fakeClient{db: dbc}).GetAccessRuleByEndpoint(ctx, "/e")
+				return err
+			},
+		},
+		{
+			name: "role: create",
+			call: func(dbc *fakeDB) error {
+				_, err := roleRepo.NewRepository(fakeClient{db: dbc}).CreateRole(ctx, &model.Role{Name: role})
+				return err
+			},
+		},
+		{
+			name: "role: list",
+			call: func(dbc *fakeDB) error {
+				_, err := roleRepo.NewRepository(fakeClient{db: dbc}).ListRoles(ctx)
+				return err
+			},
+		},
+		{
+			name: "role: delete",
+			call: func(dbc *fakeDB) error {
+				return roleRepo.NewRepository(fakeClient{db: dbc}).DeleteRole(ctx, role)
+			},
+		},
+		{
+			name: "federated identity: create",
+			call: func(dbc *fakeDB) error {
+				_, err := federatedIdentityRepo.NewRepository(fakeClient{db: dbc}).
+					CreateFederatedIdentity(ctx, &model.FederatedIdentity{
+						Provider: "corporate",
+						Subject:  "subject",
+						UserID:   1,
+					})
+				return err
+			},
+		},
+		{
+			name: "federated identity: get",
+			call: func(dbc *fakeDB) error {
+				_, _ = federatedIdentityRepo.NewRepository(fakeClient{db: dbc}).
+					GetFederatedIdentity(ctx, "corporate", "subject")
+				return nil
+			},
+		},
+		{
+			name: "federation state: create",
+			call: func(dbc *fakeDB) error {
+				return federationStateRepo.NewRepository(fakeClient{db: dbc}).
+					CreateLoginState(ctx, &model.FederationLoginState{
+						Provider: "corporate",
+						Request:  &model.OAuthAuthorizationRequest{ClientID: "client"},
+					})
+			},
+		},
+		{
+			name: "federation state: consume",
+			call: func(dbc *fakeDB) error {
+				_, _ = federationStateRepo.NewRepository(fakeClient{db: dbc}).
+					ConsumeLoginState(ctx, "hash")
+				return nil
+			},
+		},
+		{
+			name: "federation state: delete expired",
+			call: func(dbc *fakeDB) error {
+				return federationStateRepo.NewRepository(fakeClient{db: dbc}).
+					DeleteExpiredLoginStates(ctx, time.Now())
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbc := &fakeDB{}
+			require.NoError(t, tt.call(dbc))
+			assertTenantScoped(t, dbc.last())
+		})
+	}
+}
+
+func TestRepositoriesRequireTenant(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dbc := &fakeDB{}
+
+	_, err := userRepo.NewRepository(fakeClient{db: dbc}).GetUser(ctx, 1)
+	assert.ErrorIs(t, err, model.ErrorTenantNotSpecified)
+
+	_, err = userRepo.NewRepository(fakeClient{db: dbc}).GetUserByName(ctx, "user")
+	assert.ErrorIs(t, err, model.ErrorTenantNotSpecified)
+
+	_, err = accessRepo.NewRepository(fakeClient{db: dbc}).GetAccessRuleByEndpoint(ctx, "/e")
+	assert.ErrorIs(t, err, model.ErrorTenantNotSpecified)
+
+	_, err = roleRepo.NewRepository(fakeClient{db: dbc}).ListRoles(ctx)
+	assert.ErrorIs(t, err, model.ErrorTenantNotSpecified)
+
+	assert.Empty(t, dbc.queries)
+}
+
+// fakeRedis is in-memory redis client
+type fakeRedis struct {
+	mu     sync.Mutex
+	hashes map[string][]interface{}
+}
+
+func (f *fakeRedis) HashSet(_ context.Context, key string, values interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var flat []interface{}
+	for _, v := range (redigo.Args{}).AddFlat(values) {
+		flat = append(flat, []byte(fmt.Sprint(v)))
+	}
+	f.hashes[key] = flat
+
+	return nil
+}
+
+func (f *fakeRedis) HGetAll(_ context.Context, key string) ([]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.hashes[key], nil
+}
+
+func (f *fakeRedis) Set(_ context.Context, _ string, _ interface{}) error { return nil }
+
+func (f *fakeRedis) Get(_ context.Context, _ string) (interface{}, error) { return nil, nil }
+
+func (f *fakeRedis) Expire(_ context.Context, _ string, _ time.Duration) error { return nil }
+
+func (f *fakeRedis) Ping(_ context.Context) error { return nil }
+
+func (f *fakeRedis) Delete(_ context.Context, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.hashes, key)
+	return nil
+}
+
+type redisConfig struct{}
+
+func (redisConfig) Address() string                  { return "" }
+func (redisConfig) ConnectionTimeout() time.Duration { return 0 }
+func (redisConfig) MaxIdle() int                     { return 0 }
+func (redisConfig) IdleTimeout() time.Duration       { return 0 }
+func (redisConfig) ElementTTL() time.Duration        { return time.Minute }
+
+func TestRedisRepositoryCrossTenantRead(t *testing.T) {
+	t.Parallel()
+
+	cl := &fakeRedis{hashes: map[string][]interface{}{}}
+	repo := redisRepo.NewUserRedisRepository(cl, nil, redisConfig{})
+
+	ctxA := tenant.NewContext(context.Background(), tenantID)
+	ctxB := tenant.NewContext(context.Background(), tenantID+1)
+
+	user := &model.User{ID: 7, TenantID: tenantID, Name: "user", Role: "USER"}
+	require.NoError(t, repo.CreateUser(ctxA, user))
+
+	got, err := repo.GetUser(ctxA, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.Name, got.Name)
+	assert.Equal(t, tenantID, got.TenantID)
+
+	_, err = repo.GetUser(ctxB, user.ID)
+	assert.ErrorIs(t, err, model.ErrorUserNotFound)
+
+	err = repo.CreateUser(ctxB, user)
+	assert.Error(t, err)
+}
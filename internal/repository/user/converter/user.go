@@ -12,12 +12,25 @@ func ToUserFromRepo(user *modelRepo.User) *model.User {
 	}
 
 	return &model.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Role:      user.Role,
-		Password:  user.Password,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:              user.ID,
+		TenantID:        user.TenantID,
+		Name:            user.Name,
+		Email:           user.Email,
+		Role:            user.Role,
+		Password:        user.Password,
+		Status:          user.Status,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
+		EmailVerifiedAt: user.EmailVerifiedAt,
 	}
 }
+
+// ToUsersFromRepo converts users from repository model to service model
+func ToUsersFromRepo(users []*modelRepo.User) []*model.User {
+	res := make([]*model.User, 0, len(users))
+	for _, user := range users {
+		res = append(res, ToUserFromRepo(user))
+	}
+
+	return res
+}
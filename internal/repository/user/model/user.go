@@ -7,11 +7,14 @@ import (
 
 // User contains user settings
 type User struct {
-	ID        int64        `db:"id"`
-	Name      string       `db:"name"`
-	Email     string       `db:"email"`
-	Role      string       `db:"role"`
-	Password  string       `db:"password"`
-	CreatedAt time.Time    `db:"created_at"`
-	UpdatedAt sql.NullTime `db:"updated_at"`
+	ID              int64        `db:"id"`
+	TenantID        int64        `db:"tenant_id"`
+	Name            string       `db:"name"`
+	Email           string       `db:"email"`
+	Role            string       `db:"role"`
+	Password        string       `db:"password"`
+	Status          string       `db:"status"`
+	CreatedAt       time.Time    `db:"created_at"`
+	UpdatedAt       sql.NullTime `db:"updated_at"`
+	EmailVerifiedAt sql.NullTime `db:"email_verified_at"`
 }
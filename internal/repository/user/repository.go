@@ -5,24 +5,31 @@ import (
 	"errors"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 
 	"github.com/valek177/auth/internal/model"
 	"github.com/valek177/auth/internal/repository"
 	"github.com/valek177/auth/internal/repository/user/converter"
 	modelRepo "github.com/valek177/auth/internal/repository/user/model"
+	"github.com/valek177/auth/internal/tenant"
 	"github.com/valek177/platform-common/pkg/client/db"
+	"github.com/valek177/platform-common/pkg/client/db/pg"
 )
 
 const (
 	tableName = "users"
 
 	idColumn        = "id"
+	tenantIDColumn  = "tenant_id"
 	nameColumn      = "name"
 	emailColumn     = "email"
 	roleColumn      = "role"
 	passwordColumn  = "password"
+	statusColumn    = "status"
 	createdAtColumn = "created_at"
 	updatedAtColumn = "updated_at"
+
+	emailVerifiedAtColumn = "email_verified_at"
 )
 
 type repo struct {
@@ -36,10 +43,27 @@ func NewRepository(db db.Client) repository.UserRepository {
 
 // CreateUser creates new user with specified parameters
 func (r *repo) CreateUser(ctx context.Context, newUser *model.NewUser) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	status := newUser.Status
+	if status == "" {
+		status = model.UserStatusActive
+	}
+
+	var emailVerifiedAt interface{}
+	if newUser.EmailVerified {
+		emailVerifiedAt = sq.Expr("now()")
+	}
+
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(nameColumn, emailColumn, passwordColumn, roleColumn).
-		Values(newUser.Name, newUser.Email, newUser.Password, newUser.Role).
+		Columns(tenantIDColumn, nameColumn, emailColumn, passwordColumn, roleColumn, statusColumn,
+			emailVerifiedAtColumn).
+		Values(tenantID, newUser.Name, newUser.Email, newUser.Password, newUser.Role, status,
+			emailVerifiedAt).
 		Suffix("RETURNING id")
 
 	query, args, err := builderInsert.ToSql()
@@ -60,13 +84,108 @@ func (r *repo) CreateUser(ctx context.Context, newUser *model.NewUser) (int64, e
 	return userID, nil
 }
 
+// CopyUsers inserts users with COPY protocol, it must be called in transaction
+func (r *repo) CopyUsers(ctx context.Context, users []*model.ImportUser) (int64, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
+	if !ok {
+		return 0, errors.New("unable to copy users: transaction is required")
+	}
+
+	rows := make([][]interface{}, 0, len(users))
+	for _, user := range users {
+		rows = append(rows, []interface{}{
+			tenantID, user.Name, user.Email, user.PasswordHash, user.Role, model.UserStatusActive,
+		})
+	}
+
+	return tx.CopyFrom(ctx, pgx.Identifier{tableName},
+		[]string{tenantIDColumn, nameColumn, emailColumn, passwordColumn, roleColumn, statusColumn},
+		pgx.CopyFromRows(rows))
+}
+
+// ListUsers returns users with id greater than afterID ordered by id
+func (r *repo) ListUsers(ctx context.Context, afterID int64, limit uint64) ([]*model.User, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, nameColumn, emailColumn, roleColumn,
+		statusColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID}).
+		Where(sq.Gt{idColumn: afterID}).
+		OrderBy(idColumn).
+		Limit(limit)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.ListUsers",
+		QueryRaw: query,
+	}
+
+	var users []*modelRepo.User
+	err = r.db.DB().ScanAllContext(ctx, &users, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUsersFromRepo(users), nil
+}
+
+// FindUserNames returns names from the list which are already taken
+func (r *repo) FindUserNames(ctx context.Context, names []string) ([]string, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(nameColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tenantIDColumn: tenantID, nameColumn: names})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.FindUserNames",
+		QueryRaw: query,
+	}
+
+	var existing []string
+	err = r.db.DB().ScanAllContext(ctx, &existing, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
 // GetUser returns info about user
 func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
-	builderSelectOne := sq.Select(idColumn, nameColumn, emailColumn, roleColumn,
-		createdAtColumn, updatedAtColumn).
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, nameColumn, emailColumn, roleColumn,
+		statusColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{idColumn: id}).
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID}).
 		Limit(1)
 
 	query, args, err := builderSelectOne.ToSql()
@@ -82,6 +201,9 @@ func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
 	var user modelRepo.User
 	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorUserNotFound
+		}
 		return nil, err
 	}
 
@@ -90,11 +212,16 @@ func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
 
 // GetUserByName returns info about user by name
 func (r *repo) GetUserByName(ctx context.Context, username string) (*model.User, error) {
-	builderSelectOne := sq.Select(idColumn, nameColumn, emailColumn, roleColumn,
-		passwordColumn, createdAtColumn, updatedAtColumn).
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, nameColumn, emailColumn, roleColumn,
+		passwordColumn, statusColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{nameColumn: username}).
+		Where(sq.Eq{nameColumn: username, tenantIDColumn: tenantID}).
 		Limit(1)
 
 	query, args, err := builderSelectOne.ToSql()
@@ -110,17 +237,94 @@ func (r *repo) GetUserByName(ctx context.Context, username string) (*model.User,
 	var user modelRepo.User
 	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorUserNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToUserFromRepo(&user), nil
+}
+
+// GetUserByEmail returns user by e-mail
+func (r *repo) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelectOne := sq.Select(idColumn, tenantIDColumn, nameColumn, emailColumn, roleColumn,
+		statusColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{emailColumn: email, tenantIDColumn: tenantID}).
+		OrderBy(idColumn).
+		Limit(1)
+
+	query, args, err := builderSelectOne.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetUserByEmail",
+		QueryRaw: query,
+	}
+
+	var user modelRepo.User
+	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorUserNotFound
+		}
 		return nil, err
 	}
 
 	return converter.ToUserFromRepo(&user), nil
 }
 
+// GetUsersByIDs returns existing users with specified ids in one query
+func (r *repo) GetUsersByIDs(ctx context.Context, ids []int64) ([]*model.User, error) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, tenantIDColumn, nameColumn, emailColumn, roleColumn,
+		statusColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: ids, tenantIDColumn: tenantID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetUsersByIDs",
+		QueryRaw: query,
+	}
+
+	var users []*modelRepo.User
+	err = r.db.DB().ScanAllContext(ctx, &users, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUsersFromRepo(users), nil
+}
+
 // UpdateUser updates user info by id
 func (r *repo) UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserInfo) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	builderUpdate := sq.Update(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{idColumn: updateUserInfo.ID})
+		Where(sq.Eq{idColumn: updateUserInfo.ID, tenantIDColumn: tenantID})
 
 	isUpdated := false
 
@@ -132,6 +336,14 @@ func (r *repo) UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserI
 		builderUpdate = builderUpdate.Set(roleColumn, *updateUserInfo.Role)
 		isUpdated = true
 	}
+	if updateUserInfo.Email != nil {
+		builderUpdate = builderUpdate.Set(emailColumn, *updateUserInfo.Email)
+		isUpdated = true
+	}
+	if updateUserInfo.Status != nil {
+		builderUpdate = builderUpdate.Set(statusColumn, *updateUserInfo.Status)
+		isUpdated = true
+	}
 
 	if !isUpdated {
 		return errors.New("unable to update users: empty request")
@@ -154,11 +366,56 @@ func (r *repo) UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserI
 	return nil
 }
 
+// ActivateUser sets password of pending user and activates it, email of user is verified
+// by invitation delivered to it
+func (r *repo) ActivateUser(ctx context.Context, id int64, passwordHash string) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(passwordColumn, passwordHash).
+		Set(statusColumn, model.UserStatusActive).
+		Set(emailVerifiedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{
+			idColumn:       id,
+			tenantIDColumn: tenantID,
+			statusColumn:   model.UserStatusPending,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.ActivateUser",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("unable to activate user: pending user not found")
+	}
+
+	return nil
+}
+
 // DeleteUser removes user
 func (r *repo) DeleteUser(ctx context.Context, id int64) error {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	builderDelete := sq.Delete(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{idColumn: id})
+		Where(sq.Eq{idColumn: id, tenantIDColumn: tenantID})
 
 	query, args, err := builderDelete.ToSql()
 	if err != nil {
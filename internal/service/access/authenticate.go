@@ -0,0 +1,44 @@
+package access
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// Authenticate verifies access token and returns its claims, invalid or revoked token
+// is ErrorUnauthenticated
+func (s *serv) Authenticate(ctx context.Context, accessToken string) (
+	*model.UserClaims, error,
+) {
+	_, claims, err := s.authenticate(ctx, accessToken)
+
+	return claims, err
+}
+
+// authenticate verifies access token and returns its claims with context of token tenant,
+// rules are always taken from the tenant the token was issued for.
+// Invalid or revoked token is ErrorUnauthenticated.
+func (s *serv) authenticate(ctx context.Context, accessToken string) (
+	context.Context, *model.UserClaims, error,
+) {
+	claims, err := s.tokenAccess.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return ctx, nil, errors.Wrap(model.ErrorUnauthenticated, err.Error())
+	}
+
+	ctx = tenant.NewContext(ctx, claims.TenantID)
+
+	revokedAt, err := s.revocationRepository.GetTokensRevokedAt(ctx, claims.TokenSubject())
+	if err != nil {
+		return ctx, nil, err
+	}
+	if claims.IsRevoked(revokedAt) {
+		return ctx, nil, errors.Wrap(model.ErrorUnauthenticated, "access token is revoked")
+	}
+
+	return ctx, claims, nil
+}
@@ -0,0 +1,40 @@
+package access
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+)
+
+// Authorize decides access of request proxied to endpoint and returns identity of its user,
+// which is forwarded to upstream when access is allowed
+func (s *serv) Authorize(ctx context.Context, accessToken string, endpoint string,
+	attributes map[string]string,
+) (*model.AccessAuthorization, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authorize access (service)")
+	defer span.Finish()
+
+	ctx, claims, err := s.authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	accessRule, err := s.accessRepository.GetAccessRuleByEndpoint(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	decided, err := s.decider.Decide(ctx, decision.NewSubject(claims), accessRule, attributes,
+		false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AccessAuthorization{
+		Decision: decided,
+		Claims:   claims,
+	}, nil
+}
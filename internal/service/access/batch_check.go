@@ -0,0 +1,42 @@
+package access
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+)
+
+// BatchCheck decides user access to every endpoint of batch with single token verification
+// and rules fetch, decisions are returned in order of checks
+func (s *serv) BatchCheck(ctx context.Context, accessToken string, checks []*model.AccessCheck) (
+	[]*model.AccessDecision, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "batch check access (service)")
+	defer span.Finish()
+
+	ctx, claims, err := s.authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.accessRepository.GetAccessMatcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := decision.NewSubject(claims)
+	res := make([]*model.AccessDecision, 0, len(checks))
+	for _, check := range checks {
+		decided, errDecide := s.decider.Decide(ctx, subject, m.Match(check.Endpoint),
+			check.Attributes, false)
+		if errDecide != nil {
+			return nil, errDecide
+		}
+		res = append(res, decided)
+	}
+
+	return res, nil
+}
@@ -2,36 +2,30 @@ package access
 
 import (
 	"context"
-	"errors"
-	"slices"
 
 	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
 )
 
-// Check checks user access permissions to resource
-func (s *serv) Check(ctx context.Context, accessToken string, endpoint string) (bool, error) {
+// Check decides user access to endpoint, attributes are used by conditions of access rules,
+// explained decision contains evaluation of every rule matching endpoint
+func (s *serv) Check(ctx context.Context, accessToken string, endpoint string,
+	attributes map[string]string, explain bool,
+) (*model.AccessDecision, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "check access (service)")
 	defer span.Finish()
 
-	claims, err := s.tokenAccess.VerifyToken(ctx, accessToken)
+	ctx, claims, err := s.authenticate(ctx, accessToken)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	accessRule, err := s.accessRepository.GetAccessRuleByEndpoint(ctx, endpoint)
 	if err != nil {
-		return false, err
-	}
-	if accessRule == nil {
-		return false, errors.New("unable to find access rule")
-	}
-	if len(accessRule.Roles) == 0 {
-		return false, errors.New("no roles in access rule")
-	}
-
-	if slices.Contains(accessRule.Roles, claims.Role) {
-		return true, nil
+		return nil, err
 	}
 
-	return false, nil
+	return s.decider.Decide(ctx, decision.NewSubject(claims), accessRule, attributes, explain)
 }
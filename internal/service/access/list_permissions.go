@@ -0,0 +1,59 @@
+package access
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ListPermissions returns roles, permissions and endpoint patterns allowed to user,
+// attributes are used by conditions of access rules, patterns whose allowing rules
+// do not hold with them are omitted
+func (s *serv) ListPermissions(ctx context.Context, accessToken string,
+	attributes map[string]string,
+) (*model.AccessPermissions, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list permissions (service)")
+	defer span.Finish()
+
+	ctx, claims, err := s.authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.accessRepository.GetAccessMatcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := decision.NewSubject(claims)
+	if err = s.decider.LoadSubject(ctx, subject); err != nil {
+		return nil, err
+	}
+
+	res := &model.AccessPermissions{
+		Roles:       subject.Roles(),
+		Permissions: subject.Permissions(),
+	}
+	// pattern matched as endpoint is denied by deny rules of patterns covering it entirely
+	for _, pattern := range m.Patterns() {
+		decided, errDecide := s.decider.Decide(ctx, subject, m.Match(pattern), attributes,
+			false)
+		if errDecide != nil {
+			return nil, errDecide
+		}
+		if !decided.Allowed {
+			continue
+		}
+
+		res.Endpoints = append(res.Endpoints, &model.AccessEndpoint{
+			Endpoint:    pattern,
+			RuleID:      decided.Rule.ID,
+			Conditional: decided.Rule.Condition != "",
+		})
+	}
+
+	return res, nil
+}
@@ -1,23 +1,30 @@
 package access
 
 import (
+	"github.com/valek177/auth/internal/decision"
 	"github.com/valek177/auth/internal/repository"
 	"github.com/valek177/auth/internal/service"
 	"github.com/valek177/auth/internal/utils"
 )
 
 type serv struct {
-	accessRepository repository.AccessRepository
-	tokenAccess      utils.Token
+	accessRepository     repository.AccessRepository
+	revocationRepository repository.TokenRevocationRepository
+	tokenAccess          utils.Token
+	decider              *decision.Decider
 }
 
 // NewService creates new service with settings
 func NewService(
 	accessRepository repository.AccessRepository,
+	revocationRepository repository.TokenRevocationRepository,
 	tokenAccess utils.Token,
+	decider *decision.Decider,
 ) service.AccessService {
 	return &serv{
-		accessRepository: accessRepository,
-		tokenAccess:      tokenAccess,
+		accessRepository:     accessRepository,
+		revocationRepository: revocationRepository,
+		tokenAccess:          tokenAccess,
+		decider:              decider,
 	}
 }
@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/utils"
+)
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		endpoint = "GET /user/v1/7"
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       7,
+				TenantID: 4,
+				Name:     "alice",
+				Role:     "SUPPORT",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+
+		rule = &model.EndpointAccessRule{
+			Endpoint: "GET /user/v1/{id}",
+			Allow: []*model.AccessRule{
+				{ID: 1, Effect: model.AccessEffectAllow, Role: "USER",
+					Condition: `request.user_id == string(claims.user_id)`},
+			},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		conditions, _ = condition.NewEvaluator(1000)
+	)
+
+	authorizeFunc := func(revokedAt time.Time, ruleErr error, resolve bool) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(revokedAt, nil)
+
+			accessMock := repoMocks.NewAccessRepositoryMock(mc)
+			if revokedAt.IsZero() {
+				accessMock.GetAccessRuleByEndpointMock.Return(rule, ruleErr)
+			}
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			if resolve {
+				roleMock.ListAncestorRolesMock.Return([]string{"USER"}, nil)
+			}
+
+			return mocks{
+				accessRepository:     accessMock,
+				permissionRepository: repoMocks.NewPermissionRepositoryMock(mc),
+				roleRepository:       roleMock,
+				revocationRepository: revocationMock,
+			}
+		}
+	}
+
+	testsSuccessful := []struct {
+		name       string
+		attributes map[string]string
+		want       bool
+		mocks      mocksFunc
+	}{
+		{
+			name:       "success case: allowed with identity of user",
+			attributes: map[string]string{"user_id": "7"},
+			want:       true,
+			mocks:      authorizeFunc(time.Time{}, nil, true),
+		},
+		{
+			name:       "success case: denied",
+			attributes: map[string]string{"user_id": "8"},
+			want:       false,
+			mocks:      authorizeFunc(time.Time{}, nil, true),
+		},
+	}
+	testsErrors := []struct {
+		name        string
+		accessToken string
+		err         error
+		mocks       mocksFunc
+	}{
+		{
+			name:        "error: invalid token",
+			accessToken: "invalid",
+			err:         model.ErrorUnauthenticated,
+			mocks: func(mc *minimock.Controller) mocks {
+				return mocks{
+					accessRepository:     repoMocks.NewAccessRepositoryMock(mc),
+					permissionRepository: repoMocks.NewPermissionRepositoryMock(mc),
+					roleRepository:       repoMocks.NewRoleRepositoryMock(mc),
+					revocationRepository: repoMocks.NewTokenRevocationRepositoryMock(mc),
+				}
+			},
+		},
+		{
+			name:        "error: revoked token",
+			accessToken: token,
+			err:         model.ErrorUnauthenticated,
+			mocks:       authorizeFunc(time.Now().Add(time.Minute), nil, false),
+		},
+		{
+			name:        "repo error: get access rule",
+			accessToken: token,
+			err:         repoErr,
+			mocks:       authorizeFunc(time.Time{}, repoErr, false),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := access.NewService(m.accessRepository, m.revocationRepository, tokenAccess,
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			res, err := service.Authorize(ctx, token, endpoint, tt.attributes)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, res.Decision.Allowed)
+			assert.Equal(t, int64(7), res.Claims.UserID)
+			assert.Equal(t, "SUPPORT", res.Claims.Role)
+			assert.Equal(t, int64(4), res.Claims.TenantID)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := access.NewService(m.accessRepository, m.revocationRepository, tokenAccess,
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			_, err := service.Authorize(ctx, tt.accessToken, endpoint, nil)
+
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/utils"
+)
+
+func TestBatchCheck(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       7,
+				TenantID: 4,
+				Name:     "alice",
+				Role:     "SUPPORT",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+
+		rules = []*model.AccessRule{
+			{ID: 1, Effect: model.AccessEffectAllow, Permission: "user:read",
+				Endpoint: "/user_v1.UserV1/Get"},
+			{ID: 2, Effect: model.AccessEffectAllow, Permission: "user:admin",
+				Endpoint: "/user_v1.UserV1/*"},
+			{ID: 3, Effect: model.AccessEffectDeny, Role: "USER",
+				Endpoint: "/user_v1.UserV1/Delete"},
+			{ID: 4, Effect: model.AccessEffectAllow, Permission: "user:read",
+				Endpoint: "/user_v1.UserV1/Delete"},
+		}
+		checks = []*model.AccessCheck{
+			{Endpoint: "/user_v1.UserV1/Get"},
+			{Endpoint: "/user_v1.UserV1/Delete"},
+			{Endpoint: "/user_v1.UserV1/Update"},
+			{Endpoint: "/chat_v1.ChatV1/Send"},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		conditions, _ = condition.NewEvaluator(1000)
+	)
+
+	// batchFunc expects rules to be fetched and subject to be resolved once for all checks
+	batchFunc := func(matcherErr, resolveErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+			m, err := matcher.New(rules)
+			require.NoError(t, err)
+			accessMock := repoMocks.NewAccessRepositoryMock(mc)
+			accessMock.GetAccessMatcherMock.Times(1).Return(m, matcherErr)
+
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			if matcherErr == nil {
+				permissionMock.ListEffectivePermissionsMock.Times(1).
+					Return([]string{"user:read"}, resolveErr)
+			}
+			if matcherErr == nil && resolveErr == nil {
+				roleMock.ListAncestorRolesMock.Times(1).Return([]string{"USER"}, nil)
+			}
+
+			return mocks{
+				accessRepository:     accessMock,
+				permissionRepository: permissionMock,
+				roleRepository:       roleMock,
+				revocationRepository: revocationMock,
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		allowed []bool
+		codes   []string
+		err     error
+		mocks   mocksFunc
+	}{
+		{
+			name:    "success case: results in order of checks",
+			allowed: []bool{true, false, false, false},
+			codes: []string{
+				model.AccessReasonAllowed,
+				model.AccessReasonExplicitDeny,
+				model.AccessReasonNoAllowingRule,
+				model.AccessReasonNoMatchingRule,
+			},
+			mocks: batchFunc(nil, nil),
+		},
+		{
+			name:  "repo error: get access matcher",
+			err:   repoErr,
+			mocks: batchFunc(repoErr, nil),
+		},
+		{
+			name:  "repo error: list effective permissions",
+			err:   repoErr,
+			mocks: batchFunc(nil, repoErr),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := access.NewService(m.accessRepository, m.revocationRepository, tokenAccess,
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			res, err := service.BatchCheck(ctx, token, checks)
+
+			if tt.err != nil {
+				assert.ErrorContains(t, err, tt.err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			require.Len(t, res, len(checks))
+			for i, decided := range res {
+				assert.Equal(t, tt.allowed[i], decided.Allowed, checks[i].Endpoint)
+				assert.Equal(t, tt.codes[i], decided.Code, checks[i].Endpoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type mocks struct {
+	accessRepository     repository.AccessRepository
+	permissionRepository repository.PermissionRepository
+	roleRepository       repository.RoleRepository
+	revocationRepository repository.TokenRevocationRepository
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID = int64(4)
+		endpoint = "/user_v1.UserV1/Get"
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       7,
+				TenantID: tenantID,
+				Name:     "alice",
+				Role:     role,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+		serviceAccountToken = func(scopes ...string) string {
+			str, err := tokenAccess.GenerateServiceAccountToken(ctx, &model.ServiceAccount{
+				TenantID: tenantID,
+				ClientID: "ci",
+				Role:     "SUPPORT",
+			}, scopes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		conditions, _ = condition.NewEvaluator(1000)
+	)
+
+	// checkFunc expects effective permissions or ancestor roles of token role
+	// to be resolved only when they are not nil
+	checkFunc := func(rule *model.EndpointAccessRule, effective, ancestors []string,
+		resolveErr error,
+	) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+			accessMock := repoMocks.NewAccessRepositoryMock(mc)
+			accessMock.GetAccessRuleByEndpointMock.Return(rule, nil)
+
+			resolve := func(ctx context.Context, role string, res []string) ([]string, error) {
+				if ctxTenantID, err := tenant.FromContext(ctx); err != nil ||
+					ctxTenantID != tenantID {
+					return nil, fmt.Errorf("subject resolved in wrong tenant")
+				}
+				if role != "SUPPORT" {
+					return nil, fmt.Errorf("subject resolved for wrong role")
+				}
+				return res, resolveErr
+			}
+
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			if effective != nil {
+				permissionMock.ListEffectivePermissionsMock.Set(func(ctx context.Context,
+					role string,
+				) ([]string, error) {
+					return resolve(ctx, role, effective)
+				})
+			}
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			if ancestors != nil {
+				roleMock.ListAncestorRolesMock.Set(func(ctx context.Context,
+					role string,
+				) ([]string, error) {
+					return resolve(ctx, role, ancestors)
+				})
+			}
+
+			return mocks{
+				accessRepository:     accessMock,
+				permissionRepository: permissionMock,
+				roleRepository:       roleMock,
+				revocationRepository: revocationMock,
+			}
+		}
+	}
+	allow := []*model.AccessRule{
+		{ID: 1, Permission: "user:read", Condition: `request.user_id == string(claims.user_id)`},
+		{ID: 2, Permission: "user:admin"},
+	}
+	rule := &model.EndpointAccessRule{
+		Endpoint: endpoint,
+		Allow:    allow,
+	}
+	denyUser := &model.AccessRule{ID: 20, UserID: 7, Effect: model.AccessEffectDeny}
+	ruleDeny := func(deny ...*model.AccessRule) *model.EndpointAccessRule {
+		for _, d := range deny {
+			d.Effect = model.AccessEffectDeny
+		}
+		return &model.EndpointAccessRule{
+			Endpoint: endpoint,
+			Allow:    allow,
+			Deny:     deny,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		attributes map[string]string
+		explain    bool
+		want       bool
+		ruleID     int64
+		reason     string
+		explained  *model.AccessExplanation
+		err        error
+		mocks      mocksFunc
+	}{
+		{
+			name:   "success case: permission is inherited by role",
+			want:   true,
+			ruleID: 2,
+			reason: "allowed by rule 2",
+			mocks:  checkFunc(rule, []string{"invitation:create", "user:admin"}, nil, nil),
+		},
+		{
+			name:   "success case: role has no required permission",
+			want:   false,
+			reason: "no rule allows access",
+			mocks:  checkFunc(rule, []string{"invitation:create"}, nil, nil),
+		},
+		{
+			name:   "success case: service account permission is in token scope",
+			token:  serviceAccountToken("user:admin"),
+			want:   true,
+			ruleID: 2,
+			reason: "allowed by rule 2",
+			mocks:  checkFunc(rule, []string{"user:read", "user:admin"}, nil, nil),
+		},
+		{
+			name:   "success case: service account permission is out of token scope",
+			token:  serviceAccountToken("user:read"),
+			want:   false,
+			reason: "no rule allows access",
+			mocks:  checkFunc(rule, []string{"user:admin"}, nil, nil),
+		},
+		{
+			name:       "success case: condition holds",
+			attributes: map[string]string{"user_id": "7"},
+			want:       true,
+			ruleID:     1,
+			reason:     "allowed by rule 1",
+			mocks:      checkFunc(rule, []string{"user:read"}, nil, nil),
+		},
+		{
+			name:       "success case: condition does not hold",
+			attributes: map[string]string{"user_id": "8"},
+			want:       false,
+			reason:     "no rule allows access",
+			mocks:      checkFunc(rule, []string{"user:read"}, nil, nil),
+		},
+		{
+			name:   "success case: condition attribute is missing",
+			want:   false,
+			reason: "no rule allows access",
+			mocks:  checkFunc(rule, []string{"user:read"}, nil, nil),
+		},
+		{
+			name:   "success case: no access rule for endpoint",
+			want:   false,
+			reason: "no access rule matches endpoint",
+			mocks:  checkFunc(nil, nil, nil, nil),
+		},
+		{
+			name:   "success case: deny of user overrides allow",
+			want:   false,
+			ruleID: 20,
+			reason: "explicit deny by rule 20",
+			mocks:  checkFunc(ruleDeny(&model.AccessRule{ID: 20, UserID: 7}), nil, nil, nil),
+		},
+		{
+			name:   "success case: deny of inherited role",
+			want:   false,
+			ruleID: 21,
+			reason: "explicit deny by rule 21",
+			mocks: checkFunc(ruleDeny(&model.AccessRule{ID: 21, Role: "USER"}), nil,
+				[]string{"USER"}, nil),
+		},
+		{
+			name:   "success case: deny of other user and role does not apply",
+			want:   true,
+			ruleID: 2,
+			reason: "allowed by rule 2",
+			mocks: checkFunc(ruleDeny(
+				&model.AccessRule{ID: 20, UserID: 8},
+				&model.AccessRule{ID: 21, Role: "ADMIN"},
+			), []string{"user:admin"}, []string{"USER"}, nil),
+		},
+		{
+			name:   "success case: deny condition which can not be evaluated denies",
+			want:   false,
+			ruleID: 22,
+			reason: "explicit deny by rule 22",
+			mocks: checkFunc(ruleDeny(&model.AccessRule{ID: 22, Permission: "user:admin",
+				Condition: `request.ip == "10.0.0.1"`}), []string{"user:admin"}, nil, nil),
+		},
+		{
+			name:    "success case: explained deny evaluates every rule",
+			explain: true,
+			want:    false,
+			ruleID:  20,
+			reason:  "explicit deny by rule 20",
+			explained: &model.AccessExplanation{
+				UserID:      7,
+				Username:    "alice",
+				Role:        "SUPPORT",
+				Roles:       []string{"SUPPORT", "USER"},
+				Permissions: []string{"user:admin"},
+				Rules: []*model.AccessRuleEvaluation{
+					{Rule: denyUser, SubjectMatched: true, ConditionMatched: true},
+					{Rule: allow[0], SubjectMatched: false},
+					{Rule: allow[1], SubjectMatched: true, ConditionMatched: true},
+				},
+			},
+			mocks: checkFunc(ruleDeny(denyUser), []string{"user:admin"}, []string{"USER"}, nil),
+		},
+		{
+			name:    "success case: explained condition error",
+			explain: true,
+			want:    false,
+			reason:  "no rule allows access",
+			explained: &model.AccessExplanation{
+				UserID:      7,
+				Username:    "alice",
+				Role:        "SUPPORT",
+				Roles:       []string{"SUPPORT"},
+				Permissions: []string{"user:read"},
+				Rules: []*model.AccessRuleEvaluation{
+					{Rule: allow[0], SubjectMatched: true,
+						ConditionError: "unable to evaluate condition: no such key: user_id"},
+					{Rule: allow[1], SubjectMatched: false},
+				},
+			},
+			mocks: checkFunc(rule, []string{"user:read"}, []string{}, nil),
+		},
+		{
+			name:  "repo error: list effective permissions",
+			err:   repoErr,
+			mocks: checkFunc(rule, []string{}, nil, repoErr),
+		},
+		{
+			name: "repo error: list ancestor roles",
+			err:  repoErr,
+			mocks: checkFunc(ruleDeny(&model.AccessRule{ID: 21, Role: "USER"}), nil,
+				[]string{}, repoErr),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := access.NewService(m.accessRepository, m.revocationRepository, tokenAccess,
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			accessToken := tt.token
+			if accessToken == "" {
+				accessToken = token("SUPPORT")
+			}
+
+			res, err := service.Check(ctx, accessToken, endpoint, tt.attributes, tt.explain)
+
+			if tt.err != nil {
+				assert.ErrorContains(t, err, tt.err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, res.Allowed)
+			assert.Equal(t, tt.reason, res.Reason)
+			if tt.explain {
+				assert.Equal(t, tt.explained, res.Explanation)
+			} else {
+				assert.Nil(t, res.Explanation)
+			}
+			if tt.ruleID == 0 {
+				assert.Nil(t, res.Rule)
+				return
+			}
+			assert.Equal(t, tt.ruleID, res.Rule.ID)
+		})
+	}
+}
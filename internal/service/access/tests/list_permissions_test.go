@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/utils"
+)
+
+func TestListPermissions(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       7,
+				TenantID: 4,
+				Name:     "alice",
+				Role:     "SUPPORT",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+
+		rules = []*model.AccessRule{
+			{ID: 1, Effect: model.AccessEffectAllow, Permission: "user:read",
+				Endpoint: "/user_v1.UserV1/Get"},
+			{ID: 2, Effect: model.AccessEffectAllow, Permission: "user:admin",
+				Endpoint: "/user_v1.UserV1/*"},
+			{ID: 3, Effect: model.AccessEffectAllow, Role: "USER", Endpoint: "GET /user/v1/{id}",
+				Condition: `request.user_id == string(claims.user_id)`},
+			{ID: 4, Effect: model.AccessEffectAllow, Permission: "user:read",
+				Endpoint: "/chat_v1.ChatV1/Send"},
+			{ID: 5, Effect: model.AccessEffectDeny, UserID: 7, Endpoint: "/chat_v1.ChatV1"},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		conditions, _ = condition.NewEvaluator(1000)
+	)
+
+	listFunc := func(resolveErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+			m, err := matcher.New(rules)
+			require.NoError(t, err)
+			accessMock := repoMocks.NewAccessRepositoryMock(mc)
+			accessMock.GetAccessMatcherMock.Return(m, nil)
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListAncestorRolesMock.Times(1).Return([]string{"USER"}, resolveErr)
+
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			if resolveErr == nil {
+				permissionMock.ListEffectivePermissionsMock.Times(1).
+					Return([]string{"user:read"}, nil)
+			}
+
+			return mocks{
+				accessRepository:     accessMock,
+				permissionRepository: permissionMock,
+				roleRepository:       roleMock,
+				revocationRepository: revocationMock,
+			}
+		}
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		want       *model.AccessPermissions
+		err        error
+		mocks      mocksFunc
+	}{
+		{
+			name:       "success case: denied and conditional patterns",
+			attributes: map[string]string{"user_id": "7"},
+			want: &model.AccessPermissions{
+				Roles:       []string{"SUPPORT", "USER"},
+				Permissions: []string{"user:read"},
+				Endpoints: []*model.AccessEndpoint{
+					{Endpoint: "/user_v1.UserV1/Get", RuleID: 1},
+					{Endpoint: "GET /user/v1/{id}", RuleID: 3, Conditional: true},
+				},
+			},
+			mocks: listFunc(nil),
+		},
+		{
+			name: "success case: condition does not hold without attributes",
+			want: &model.AccessPermissions{
+				Roles:       []string{"SUPPORT", "USER"},
+				Permissions: []string{"user:read"},
+				Endpoints: []*model.AccessEndpoint{
+					{Endpoint: "/user_v1.UserV1/Get", RuleID: 1},
+				},
+			},
+			mocks: listFunc(nil),
+		},
+		{
+			name:  "repo error: list ancestor roles",
+			err:   repoErr,
+			mocks: listFunc(repoErr),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := access.NewService(m.accessRepository, m.revocationRepository, tokenAccess,
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			res, err := service.ListPermissions(ctx, token, tt.attributes)
+
+			if tt.err != nil {
+				assert.ErrorContains(t, err, tt.err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
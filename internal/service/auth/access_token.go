@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// generateAccessToken returns access token of user, while user has active role grant
+// token carries granted role and expires not later than grant
+func (s *serv) generateAccessToken(ctx context.Context, user *model.User) (string, error) {
+	grant, err := s.roleGrantRepository.GetActiveRoleGrant(ctx, user.ID, time.Now())
+	if errors.Is(err, model.ErrorRoleGrantNotFound) {
+		return s.tokenAccess.GenerateToken(ctx, user)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get role grant")
+	}
+
+	elevated := *user
+	elevated.Role = grant.Role
+
+	return s.tokenAccess.GenerateTokenUntil(ctx, &elevated, grant.ExpiresAt)
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// AuthorizeOAuth authenticates user of client tenant with username & password and
+// returns authorization code bound to client, redirect URI and PKCE code challenge
+func (s *serv) AuthorizeOAuth(ctx context.Context, req *model.OAuthAuthorizationRequest,
+	username, password string,
+) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authorize oauth (service)")
+	defer span.Finish()
+
+	err := validateAuthorizationRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := s.oauthClient(ctx, req.ClientID, req.RedirectURI)
+	if err != nil {
+		return "", err
+	}
+
+	ctx = tenant.NewContext(ctx, client.TenantID)
+
+	user, err := s.checkCredentials(ctx, username, password)
+	if err != nil {
+		return "", err
+	}
+
+	return s.issueAuthorizationCode(ctx, req, user, model.OIDCAuthMethodPassword)
+}
+
+// AuthorizeOAuthUser returns authorization code for user of client tenant who
+// authenticated with given method elsewhere, e.g. at upstream identity provider
+func (s *serv) AuthorizeOAuthUser(ctx context.Context, req *model.OAuthAuthorizationRequest,
+	userID int64, authMethod string,
+) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authorize oauth user (service)")
+	defer span.Finish()
+
+	err := validateAuthorizationRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := s.oauthClient(ctx, req.ClientID, req.RedirectURI)
+	if err != nil {
+		return "", err
+	}
+
+	ctx = tenant.NewContext(ctx, client.TenantID)
+
+	user, err := s.userRepository.GetUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.Status != model.UserStatusActive {
+		return "", errors.Errorf("unable to login: user %s is not active", user.Name)
+	}
+
+	return s.issueAuthorizationCode(ctx, req, user, authMethod)
+}
+
+// issueAuthorizationCode saves authorization code of authenticated user and returns it
+func (s *serv) issueAuthorizationCode(ctx context.Context, req *model.OAuthAuthorizationRequest,
+	user *model.User, authMethod string,
+) (string, error) {
+	code, err := newAuthorizationCode()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if err = s.oauthCodeRepository.DeleteExpiredAuthorizationCodes(ctx, now); err != nil {
+		return "", err
+	}
+
+	err = s.oauthCodeRepository.CreateAuthorizationCode(ctx, &model.OAuthAuthorizationCode{
+		CodeHash:      hashAuthorizationCode(code),
+		ClientID:      req.ClientID,
+		UserID:        user.ID,
+		RedirectURI:   req.RedirectURI,
+		Scope:         req.Scope,
+		CodeChallenge: req.CodeChallenge,
+		Nonce:         req.Nonce,
+		AuthTime:      now,
+		AuthMethod:    authMethod,
+		ExpiresAt:     now.Add(model.OAuthAuthorizationCodeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.recordLogin(ctx, user)
+
+	return code, nil
+}
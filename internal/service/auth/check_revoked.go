@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// checkRevoked returns error if token was revoked, e.g. after user erasure
+func (s *serv) checkRevoked(ctx context.Context, claims *model.UserClaims) error {
+	revokedAt, err := s.revocationRepository.GetTokensRevokedAt(ctx, claims.TokenSubject())
+	if err != nil {
+		return errors.Wrap(err, "unable to check token revocation")
+	}
+	if claims.IsRevoked(revokedAt) {
+		return errors.New("token is revoked")
+	}
+
+	return nil
+}
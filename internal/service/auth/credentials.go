@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+)
+
+// checkCredentials returns active user of tenant with username & password, authenticators
+// are tried in order until one of them knows user and password. Failed authenticator,
+// e.g. unreachable directory, is logged and skipped.
+func (s *serv) checkCredentials(ctx context.Context, username, password string) (
+	*model.User, error,
+) {
+	for i, authenticator := range s.authenticators {
+		user, err := authenticator.Authenticate(ctx, username, password)
+		if errors.Is(err, model.ErrorInvalidCredentials) {
+			continue
+		}
+		if err != nil {
+			logger.ErrorWithMsg("unable to authenticate user", err,
+				zap.Int("authenticator", i))
+			continue
+		}
+
+		if user.Status != model.UserStatusActive {
+			return nil, fmt.Errorf("unable to login: user %s is not active", username)
+		}
+
+		return user, nil
+	}
+
+	return nil, fmt.Errorf("unable to login: incorrect username or password")
+}
+
+// recordLogin writes login record of user, failure does not fail login
+func (s *serv) recordLogin(ctx context.Context, user *model.User) {
+	_, err := s.logRepository.CreateRecord(ctx, converter.ToRecordRepoFromService(user.ID,
+		model.ActionLogin))
+	if err != nil {
+		logger.ErrorWithMsg("unable to save login record", err, zap.Int64("user_id", user.ID))
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// ExchangeOAuthCode returns tokens for authorization code and its PKCE code verifier,
+// ID token is issued for openid scope, code is consumed even if exchange fails
+func (s *serv) ExchangeOAuthCode(ctx context.Context, exchange *model.OAuthCodeExchange) (
+	*model.OAuthToken, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "exchange oauth code (service)")
+	defer span.Finish()
+
+	client, err := s.oauthClientRepository.GetOAuthClient(ctx, exchange.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !codeVerifierRegexp.MatchString(exchange.CodeVerifier) {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "invalid code verifier")
+	}
+
+	ctx = tenant.NewContext(ctx, client.TenantID)
+
+	code, err := s.oauthCodeRepository.ConsumeAuthorizationCode(ctx,
+		hashAuthorizationCode(exchange.Code))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case code.ClientID != client.ClientID:
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "code was issued to another client")
+	case !time.Now().Before(code.ExpiresAt):
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "code is expired")
+	case code.RedirectURI != exchange.RedirectURI:
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "redirect uri does not match")
+	case !verifyCodeChallenge(code.CodeChallenge, exchange.CodeVerifier):
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "code verifier does not match")
+	}
+
+	user, err := s.userRepository.GetUser(ctx, code.UserID)
+	if err != nil {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "user is not found")
+	}
+	if user.Status != model.UserStatusActive {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "user is not active")
+	}
+
+	token, err := s.oauthToken(ctx, user, code.ClientID, code.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if model.HasScope(code.Scope, model.OIDCScopeOpenID) {
+		token.IDToken, err = s.idToken.GenerateIDToken(ctx, user, &model.IDTokenParams{
+			ClientID:   code.ClientID,
+			Nonce:      code.Nonce,
+			AuthTime:   code.AuthTime,
+			AuthMethod: code.AuthMethod,
+			Scope:      code.Scope,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate id token")
+		}
+	}
+
+	return token, nil
+}
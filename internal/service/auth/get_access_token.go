@@ -6,10 +6,10 @@ import (
 
 	"github.com/opentracing/opentracing-go"
 
-	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
 )
 
-// GetAccessToken returns access token by refresh token
+// GetAccessToken returns access token of active user by refresh token
 func (s *serv) GetAccessToken(ctx context.Context, refreshToken string) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "get access token (service)")
 	defer span.Finish()
@@ -18,11 +18,21 @@ func (s *serv) GetAccessToken(ctx context.Context, refreshToken string) (string,
 	if err != nil {
 		return "", fmt.Errorf("invalid refresh token: %v", err)
 	}
+	if claims.ClientID != "" {
+		return "", fmt.Errorf("invalid refresh token: token was issued to oauth client")
+	}
+
+	ctx = tenant.NewContext(ctx, claims.TenantID)
+	if err = s.checkRevoked(ctx, claims); err != nil {
+		return "", fmt.Errorf("invalid refresh token: %v", err)
+	}
+
+	user, err := s.refreshedUser(ctx, claims)
+	if err != nil {
+		return "", fmt.Errorf("invalid refresh token: %v", err)
+	}
 
-	accessToken, err := s.tokenAccess.GenerateToken(ctx, &model.User{
-		Name: claims.Username,
-		Role: claims.Role,
-	})
+	accessToken, err := s.generateAccessToken(ctx, user)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate access token: %v", err)
 	}
@@ -6,10 +6,10 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 
-	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
 )
 
-// GetRefreshToken returns new refresh token by old refresh token
+// GetRefreshToken returns new refresh token of active user by old refresh token
 func (s *serv) GetRefreshToken(ctx context.Context, oldRefreshToken string) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "get refresh token (service)")
 	defer span.Finish()
@@ -18,11 +18,21 @@ func (s *serv) GetRefreshToken(ctx context.Context, oldRefreshToken string) (str
 	if err != nil {
 		return "", errors.New("invalid refresh token")
 	}
+	if claims.ClientID != "" {
+		return "", errors.New("invalid refresh token: token was issued to oauth client")
+	}
+
+	ctx = tenant.NewContext(ctx, claims.TenantID)
+	if err = s.checkRevoked(ctx, claims); err != nil {
+		return "", errors.Wrap(err, "invalid refresh token")
+	}
+
+	user, err := s.refreshedUser(ctx, claims)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid refresh token")
+	}
 
-	refreshToken, err := s.tokenRefresh.GenerateToken(ctx, &model.User{
-		Name: claims.Username,
-		Role: claims.Role,
-	})
+	refreshToken, err := s.tokenRefresh.GenerateToken(ctx, user)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// GetUserInfo returns active user of access token for userinfo endpoint,
+// tokens of service accounts have no user and are ErrorUnauthenticated
+func (s *serv) GetUserInfo(ctx context.Context, accessToken string) (*model.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get user info (service)")
+	defer span.Finish()
+
+	claims, err := s.tokenAccess.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, errors.Wrap(model.ErrorUnauthenticated, err.Error())
+	}
+	if claims.IsServiceAccount() {
+		return nil, errors.Wrap(model.ErrorUnauthenticated, "token is not issued to user")
+	}
+
+	ctx = tenant.NewContext(ctx, claims.TenantID)
+	if err = s.checkRevoked(ctx, claims); err != nil {
+		return nil, errors.Wrap(model.ErrorUnauthenticated, err.Error())
+	}
+
+	user, err := s.userRepository.GetUser(ctx, claims.UserID)
+	if errors.Is(err, model.ErrorUserNotFound) {
+		return nil, errors.Wrap(model.ErrorUnauthenticated, "user is not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != model.UserStatusActive {
+		return nil, errors.Wrap(model.ErrorUnauthenticated, "user is not active")
+	}
+
+	return user, nil
+}
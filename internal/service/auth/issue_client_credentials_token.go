@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	passwordLib "github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// IssueClientCredentialsToken authenticates service account with client ID and secret
+// and returns access token of its tenant with requested scopes
+func (s *serv) IssueClientCredentialsToken(ctx context.Context,
+	credentials *model.OAuthClientCredentials,
+) (*model.OAuthToken, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue client credentials token (service)")
+	defer span.Finish()
+
+	account, err := s.serviceAccountRepository.GetServiceAccount(ctx, credentials.ClientID)
+	if errors.Is(err, model.ErrorServiceAccountNotFound) {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidClient, "unknown service account")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !passwordLib.CheckPasswordHash(credentials.ClientSecret, account.SecretHash) {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidClient, "incorrect client secret")
+	}
+	if account.Disabled {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidClient, "service account is disabled")
+	}
+
+	scopes, err := grantedScopes(account, credentials.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, account.TenantID)
+
+	accessToken, err := s.tokenAccess.GenerateServiceAccountToken(ctx, account, scopes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate access token")
+	}
+
+	claims, err := s.tokenAccess.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OAuthToken{
+		AccessToken: accessToken,
+		ExpiresAt:   time.Unix(claims.ExpiresAt, 0),
+		Scope:       claims.Scope,
+	}, nil
+}
+
+// grantedScopes returns requested scopes, each of them must be scope of service account,
+// empty request is granted every scope of account
+func grantedScopes(account *model.ServiceAccount, scope string) ([]string, error) {
+	requested := strings.Fields(scope)
+	if len(requested) == 0 {
+		return account.Scopes, nil
+	}
+
+	granted := make([]string, 0, len(requested))
+	for _, s := range requested {
+		if !slices.Contains(account.Scopes, s) {
+			return nil, errors.Wrapf(model.ErrorOAuthInvalidScope,
+				"scope %q is not granted to service account", s)
+		}
+		if !slices.Contains(granted, s) {
+			granted = append(granted, s)
+		}
+	}
+
+	return granted, nil
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/opentracing/opentracing-go"
-
-	passwordLib "github.com/valek177/auth/internal/password"
 )
 
 // Login returns refresh and access tokens for username & password
@@ -14,14 +12,9 @@ func (s *serv) Login(ctx context.Context, username, password string) (string, st
 	span, ctx := opentracing.StartSpanFromContext(ctx, "login (service)")
 	defer span.Finish()
 
-	user, err := s.userRepository.GetUserByName(ctx, username)
+	user, err := s.checkCredentials(ctx, username, password)
 	if err != nil {
-		return "", "", fmt.Errorf("unable to get user %s", username)
-	}
-
-	isPasswordsEqual := passwordLib.CheckPasswordHash(password, user.Password)
-	if !isPasswordsEqual {
-		return "", "", fmt.Errorf("unable to login: incorrect password")
+		return "", "", err
 	}
 
 	refreshToken, err := s.tokenRefresh.GenerateToken(ctx, user)
@@ -29,10 +22,12 @@ func (s *serv) Login(ctx context.Context, username, password string) (string, st
 		return "", "", fmt.Errorf("failed to generate refresh token")
 	}
 
-	accessToken, err := s.tokenAccess.GenerateToken(ctx, user)
+	accessToken, err := s.generateAccessToken(ctx, user)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to generate access token")
 	}
 
+	s.recordLogin(ctx, user)
+
 	return refreshToken, accessToken, nil
 }
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// GetOAuthClient returns OAuth client with registered redirect URI
+func (s *serv) GetOAuthClient(ctx context.Context, clientID, redirectURI string) (
+	*model.OAuthClient, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get oauth client (service)")
+	defer span.Finish()
+
+	return s.oauthClient(ctx, clientID, redirectURI)
+}
+
+func (s *serv) oauthClient(ctx context.Context, clientID, redirectURI string) (
+	*model.OAuthClient, error,
+) {
+	client, err := s.oauthClientRepository.GetOAuthClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	// redirect URI is compared exactly, RFC 9700 section 4.1.3
+	if !slices.Contains(client.RedirectURIs, redirectURI) {
+		return nil, errors.Errorf("redirect uri %q is not registered for client %s",
+			redirectURI, clientID)
+	}
+
+	return client, nil
+}
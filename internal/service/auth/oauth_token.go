@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// oauthToken returns access token and refresh token issued to client of user for token endpoint
+func (s *serv) oauthToken(ctx context.Context, user *model.User, clientID, scope string) (
+	*model.OAuthToken, error,
+) {
+	refreshToken, err := s.tokenRefresh.GenerateClientToken(ctx, user, clientID, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate refresh token")
+	}
+
+	accessToken, err := s.generateAccessToken(ctx, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate access token")
+	}
+
+	claims, err := s.tokenAccess.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OAuthToken{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Unix(claims.ExpiresAt, 0),
+		Scope:        scope,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+)
+
+const authorizationCodeSize = 32
+
+var (
+	// codeVerifierRegexp matches PKCE code verifier, RFC 7636 section 4.1
+	codeVerifierRegexp = regexp.MustCompile(`^[A-Za-z0-9._~-]{43,128}$`)
+	// codeChallengeRegexp matches base64url encoded SHA-256 of code verifier
+	codeChallengeRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+)
+
+// verifyCodeChallenge reports whether S256 challenge is made of code verifier
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(expected)) == 1
+}
+
+// newAuthorizationCode returns random authorization code
+func newAuthorizationCode() (string, error) {
+	code := make([]byte, authorizationCodeSize)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(code), nil
+}
+
+// hashAuthorizationCode returns hash of authorization code stored instead of code
+func hashAuthorizationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+
+	return hex.EncodeToString(sum[:])
+}
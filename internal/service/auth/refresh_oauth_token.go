@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// RefreshOAuthToken returns new tokens for refresh token issued to client,
+// tokens carry current role of active user and scope granted to client
+func (s *serv) RefreshOAuthToken(ctx context.Context, clientID, refreshToken string) (
+	*model.OAuthToken, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refresh oauth token (service)")
+	defer span.Finish()
+
+	client, err := s.oauthClientRepository.GetOAuthClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := s.tokenRefresh.VerifyToken(ctx, refreshToken)
+	if err != nil {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, "invalid refresh token")
+	}
+	if claims.ClientID != client.ClientID {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant,
+			"refresh token was issued to another client")
+	}
+	if claims.TenantID != client.TenantID {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant,
+			"refresh token was issued in another organization")
+	}
+
+	ctx = tenant.NewContext(ctx, claims.TenantID)
+	if err = s.checkRevoked(ctx, claims); err != nil {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, err.Error())
+	}
+
+	user, err := s.refreshedUser(ctx, claims)
+	if err != nil {
+		return nil, errors.Wrap(model.ErrorOAuthInvalidGrant, err.Error())
+	}
+
+	return s.oauthToken(ctx, user, client.ClientID, claims.Scope)
+}
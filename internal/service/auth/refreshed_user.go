@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// refreshedUser returns current user of refresh token, user must be active,
+// so tokens carry its current role and disabled user can't refresh them
+func (s *serv) refreshedUser(ctx context.Context, claims *model.UserClaims) (
+	*model.User, error,
+) {
+	user, err := s.userRepository.GetUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, errors.New("user is not found")
+	}
+	if user.Status != model.UserStatusActive {
+		return nil, errors.New("user is not active")
+	}
+
+	return user, nil
+}
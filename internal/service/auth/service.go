@@ -7,20 +7,44 @@ import (
 )
 
 type serv struct {
-	userRepository repository.UserRepository
-	tokenRefresh   utils.Token
-	tokenAccess    utils.Token
+	userRepository           repository.UserRepository
+	logRepository            repository.LogRepository
+	revocationRepository     repository.TokenRevocationRepository
+	roleGrantRepository      repository.RoleGrantRepository
+	oauthClientRepository    repository.OAuthClientRepository
+	oauthCodeRepository      repository.OAuthAuthorizationCodeRepository
+	serviceAccountRepository repository.ServiceAccountRepository
+	tokenRefresh             utils.Token
+	tokenAccess              utils.Token
+	idToken                  utils.IDToken
+	authenticators           []service.Authenticator
 }
 
 // NewService creates new service with settings
 func NewService(
 	userRepository repository.UserRepository,
+	logRepository repository.LogRepository,
+	revocationRepository repository.TokenRevocationRepository,
+	roleGrantRepository repository.RoleGrantRepository,
+	oauthClientRepository repository.OAuthClientRepository,
+	oauthCodeRepository repository.OAuthAuthorizationCodeRepository,
+	serviceAccountRepository repository.ServiceAccountRepository,
 	tokenRefresh utils.Token,
 	tokenAccess utils.Token,
+	idToken utils.IDToken,
+	authenticators []service.Authenticator,
 ) service.AuthService {
 	return &serv{
-		userRepository: userRepository,
-		tokenRefresh:   tokenRefresh,
-		tokenAccess:    tokenAccess,
+		userRepository:           userRepository,
+		logRepository:            logRepository,
+		revocationRepository:     revocationRepository,
+		roleGrantRepository:      roleGrantRepository,
+		oauthClientRepository:    oauthClientRepository,
+		oauthCodeRepository:      oauthCodeRepository,
+		serviceAccountRepository: serviceAccountRepository,
+		tokenRefresh:             tokenRefresh,
+		tokenAccess:              tokenAccess,
+		idToken:                  idToken,
+		authenticators:           authenticators,
 	}
 }
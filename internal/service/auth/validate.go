@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	oauthScopeMaxLength = 1024
+	oauthStateMaxLength = 1024
+	oauthNonceMaxLength = 1024
+)
+
+func validateAuthorizationRequest(req *model.OAuthAuthorizationRequest) error {
+	if req == nil {
+		return errors.New("unable to authorize: empty request")
+	}
+	if req.ResponseType != model.OAuthResponseTypeCode {
+		return errors.Errorf("unable to authorize: unsupported response type %q",
+			req.ResponseType)
+	}
+	if req.CodeChallengeMethod != model.OAuthCodeChallengeMethodS256 {
+		return errors.New("unable to authorize: code challenge method S256 is required")
+	}
+	if !codeChallengeRegexp.MatchString(req.CodeChallenge) {
+		return errors.New("unable to authorize: invalid code challenge")
+	}
+	if len(req.Scope) > oauthScopeMaxLength {
+		return errors.New("unable to authorize: scope is too long")
+	}
+	if len(req.State) > oauthStateMaxLength {
+		return errors.New("unable to authorize: state is too long")
+	}
+	if len(req.Nonce) > oauthNonceMaxLength {
+		return errors.New("unable to authorize: nonce is too long")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package authenticator
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/valek177/platform-common/pkg/client/db"
+
+	ldapClient "github.com/valek177/auth/internal/client/ldap"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+type ldap struct {
+	client                      ldapClient.Client
+	userRepository              repository.UserRepository
+	redisRepository             repository.UserRedisRepository
+	logRepository               repository.LogRepository
+	federatedIdentityRepository repository.FederatedIdentityRepository
+	txManager                   db.TxManager
+}
+
+// NewLDAPAuthenticator returns authenticator binding to LDAP directory as user
+func NewLDAPAuthenticator(
+	client ldapClient.Client,
+	userRepository repository.UserRepository,
+	redisRepository repository.UserRedisRepository,
+	logRepository repository.LogRepository,
+	federatedIdentityRepository repository.FederatedIdentityRepository,
+	txManager db.TxManager,
+) service.Authenticator {
+	return &ldap{
+		client:                      client,
+		userRepository:              userRepository,
+		redisRepository:             redisRepository,
+		logRepository:               logRepository,
+		federatedIdentityRepository: federatedIdentityRepository,
+		txManager:                   txManager,
+	}
+}
+
+// Authenticate returns user of directory tenant who binds to directory with password.
+// Synced directory creates user on first login and updates role mapped from groups
+// on every login, other directory authenticates existing user linked to entry.
+func (a *ldap) Authenticate(ctx context.Context, username, password string) (
+	*model.User, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authenticate ldap (service)")
+	defer span.Finish()
+
+	directory := a.client.Directory()
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tenantID != directory.TenantID {
+		return nil, model.ErrorInvalidCredentials
+	}
+
+	entry, err := a.client.Authenticate(ctx, username, password)
+	if errors.Is(err, model.ErrorInvalidCredentials) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "ldap directory %s", directory.Name)
+	}
+	if entry.Username == "" {
+		entry.Username = username
+	}
+
+	if !directory.Sync {
+		return a.provisionedUser(ctx, directory, entry)
+	}
+
+	return a.syncedUser(ctx, directory, entry)
+}
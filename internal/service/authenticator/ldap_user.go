@@ -0,0 +1,173 @@
+package authenticator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+)
+
+// syncedUser returns user linked to directory entry with role mapped from groups of
+// entry. Unlinked entry gets new user, existing users are never linked automatically
+// to prevent account takeover.
+func (a *ldap) syncedUser(ctx context.Context, directory *model.LDAPDirectory,
+	entry *model.LDAPEntry,
+) (*model.User, error) {
+	role := directory.MapRole(entry.Groups)
+	if role == "" {
+		return nil, errors.Errorf("unable to login: no role is mapped to groups of user %s",
+			entry.Username)
+	}
+
+	// DN is case insensitive
+	subject := strings.ToLower(entry.DN)
+	identity, err := a.federatedIdentityRepository.GetFederatedIdentity(ctx,
+		directory.IdentityProvider(), subject)
+	switch {
+	case err == nil:
+		return a.updateRole(ctx, identity.UserID, role)
+	case !errors.Is(err, model.ErrorFederatedIdentityNotFound):
+		return nil, err
+	}
+
+	if model.IsServiceAccountPrincipal(entry.Username) {
+		return nil, errors.Errorf("unable to login: %s is not valid user name", entry.Username)
+	}
+
+	var userID int64
+	err = a.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		names, errTx := a.userRepository.FindUserNames(ctx, []string{entry.Username})
+		if errTx != nil {
+			return errTx
+		}
+		if len(names) > 0 {
+			return errors.Errorf("unable to login: user name %s is already taken",
+				entry.Username)
+		}
+
+		userID, errTx = a.userRepository.CreateUser(ctx, &model.NewUser{
+			Name:   entry.Username,
+			Email:  entry.Email,
+			Role:   role,
+			Status: model.UserStatusActive,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = a.federatedIdentityRepository.CreateFederatedIdentity(ctx,
+			&model.FederatedIdentity{
+				Provider: directory.IdentityProvider(),
+				Subject:  subject,
+				UserID:   userID,
+			})
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = a.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(userID, "create"))
+
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a.userRepository.GetUser(ctx, userID)
+}
+
+// provisionedUser returns existing user linked to directory entry. Unlinked entry is
+// linked to user with same name only if verified email of user is email of entry,
+// so that directory account can't take over local user by name collision.
+func (a *ldap) provisionedUser(ctx context.Context, directory *model.LDAPDirectory,
+	entry *model.LDAPEntry,
+) (*model.User, error) {
+	// DN is case insensitive
+	subject := strings.ToLower(entry.DN)
+	identity, err := a.federatedIdentityRepository.GetFederatedIdentity(ctx,
+		directory.IdentityProvider(), subject)
+	switch {
+	case err == nil:
+		return a.userRepository.GetUser(ctx, identity.UserID)
+	case !errors.Is(err, model.ErrorFederatedIdentityNotFound):
+		return nil, err
+	}
+
+	user, err := a.userRepository.GetUserByName(ctx, entry.Username)
+	if errors.Is(err, model.ErrorUserNotFound) {
+		return nil, errors.Errorf("unable to login: user %s is not provisioned", entry.Username)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if entry.Email == "" || !user.EmailVerifiedAt.Valid ||
+		!strings.EqualFold(user.Email, entry.Email) {
+		return nil, errors.Errorf("unable to login: user %s is not linked to directory account",
+			entry.Username)
+	}
+
+	identities, err := a.federatedIdentityRepository.ListFederatedIdentitiesByUserID(ctx,
+		user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, linked := range identities {
+		if linked.Provider == directory.IdentityProvider() {
+			return nil, errors.Errorf("unable to login: user %s is linked to another "+
+				"directory account", entry.Username)
+		}
+	}
+
+	_, err = a.federatedIdentityRepository.CreateFederatedIdentity(ctx,
+		&model.FederatedIdentity{
+			Provider: directory.IdentityProvider(),
+			Subject:  subject,
+			UserID:   user.ID,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// updateRole returns linked user whose role is changed to role mapped from groups
+func (a *ldap) updateRole(ctx context.Context, userID int64, role string) (*model.User, error) {
+	user, err := a.userRepository.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+	err = a.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := a.userRepository.UpdateUser(ctx, &model.UpdateUserInfo{
+			ID:   user.ID,
+			Role: &role,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = a.redisRepository.DeleteUser(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = a.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(user.ID, "update"))
+
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+	user.Role = role
+
+	return user, nil
+}
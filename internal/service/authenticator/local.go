@@ -0,0 +1,47 @@
+package authenticator
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	passwordLib "github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+)
+
+type local struct {
+	userRepository repository.UserRepository
+}
+
+// NewLocalAuthenticator returns authenticator checking password hashes of users table
+func NewLocalAuthenticator(userRepository repository.UserRepository) service.Authenticator {
+	return &local{
+		userRepository: userRepository,
+	}
+}
+
+// Authenticate returns user of tenant whose password hash matches password,
+// users without local password, e.g. provisioned by directory, never match
+func (a *local) Authenticate(ctx context.Context, username, password string) (
+	*model.User, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authenticate local (service)")
+	defer span.Finish()
+
+	user, err := a.userRepository.GetUserByName(ctx, username)
+	if errors.Is(err, model.ErrorUserNotFound) {
+		return nil, model.ErrorInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Password == "" || !passwordLib.CheckPasswordHash(password, user.Password) {
+		return nil, model.ErrorInvalidCredentials
+	}
+
+	return user, nil
+}
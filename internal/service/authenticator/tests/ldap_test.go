@@ -0,0 +1,467 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+
+	ldapClient "github.com/valek177/auth/internal/client/ldap"
+	"github.com/valek177/auth/internal/client/ldap/ldaptest"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/authenticator"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+const (
+	bindDN       = "cn=search,dc=example,dc=org"
+	bindPassword = "search secret"
+	adminsDN     = "cn=admins,ou=groups,dc=example,dc=org"
+	davePassword = "dave password"
+	daveDN       = "uid=dave,ou=people,dc=example,dc=org"
+)
+
+type mocks struct {
+	userRepository     repository.UserRepository
+	redisRepository    repository.UserRedisRepository
+	logRepository      repository.LogRepository
+	identityRepository repository.FederatedIdentityRepository
+	txManager          db.TxManager
+}
+
+func person(dn, uid, password string, groups ...string) *ldaptest.Entry {
+	return &ldaptest.Entry{
+		DN:       dn,
+		Password: password,
+		Attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {uid},
+			"mail":        {uid + "@example.org"},
+			"memberOf":    groups,
+		},
+	}
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		tenantID = int64(3)
+		ctx      = tenant.NewContext(context.Background(), tenantID)
+		mc       = minimock.NewController(t)
+
+		dave = &model.User{ID: 10, TenantID: tenantID, Name: "dave", Role: model.RoleAdmin,
+			Status: model.UserStatusActive}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	server, err := ldaptest.NewServer(bindDN, bindPassword,
+		person(daveDN, "dave", davePassword, adminsDN),
+		person("uid=erin,ou=people,dc=example,dc=org", "erin", "erin password"),
+		person("uid=twin,ou=people,dc=example,dc=org", "twin", "twin password"),
+		person("uid=twin,ou=contractors,ou=people,dc=example,dc=org", "twin", "twin password"))
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	directory := func(sync bool, defaultRole string) *model.LDAPDirectory {
+		return &model.LDAPDirectory{
+			Name:              "corp",
+			TenantID:          tenantID,
+			URL:               server.URL,
+			BindDN:            bindDN,
+			BindPassword:      bindPassword,
+			BaseDN:            "ou=people,dc=example,dc=org",
+			UserFilter:        "(objectClass=person)",
+			UsernameAttribute: "uid",
+			EmailAttribute:    "mail",
+			GroupAttribute:    "memberOf",
+			Sync:              sync,
+			DefaultRole:       defaultRole,
+			RoleMappings: []model.LDAPRoleMapping{
+				// DNs are compared ignoring case and spaces
+				{GroupDN: "CN=Admins, OU=Groups, DC=example, DC=org", Role: model.RoleAdmin},
+			},
+		}
+	}
+	synced := directory(true, model.RoleUser)
+
+	emptyMocks := func(mc *minimock.Controller) mocks {
+		return mocks{
+			userRepository:     repoMocks.NewUserRepositoryMock(mc),
+			redisRepository:    repoMocks.NewUserRedisRepositoryMock(mc),
+			logRepository:      repoMocks.NewLogRepositoryMock(mc),
+			identityRepository: repoMocks.NewFederatedIdentityRepositoryMock(mc),
+			txManager:          dbMocks.NewTxManagerMock(mc),
+		}
+	}
+	txManager := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+		return mock
+	}
+	linkedFunc := func(user *model.User) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := emptyMocks(mc)
+			identityMock := repoMocks.NewFederatedIdentityRepositoryMock(mc)
+			identityMock.GetFederatedIdentityMock.Expect(minimock.AnyContext, "ldap:corp", daveDN).
+				Return(&model.FederatedIdentity{UserID: user.ID}, nil)
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			userMock.GetUserMock.Expect(minimock.AnyContext, user.ID).Return(user, nil)
+			m.identityRepository = identityMock
+			m.userRepository = userMock
+			return m
+		}
+	}
+	roleChangedFunc := func(mc *minimock.Controller) mocks {
+		m := linkedFunc(&model.User{ID: dave.ID, TenantID: tenantID, Name: "dave",
+			Role: model.RoleUser, Status: model.UserStatusActive})(mc)
+		userMock := m.userRepository.(*repoMocks.UserRepositoryMock)
+		role := model.RoleAdmin
+		userMock.UpdateUserMock.Expect(minimock.AnyContext, &model.UpdateUserInfo{
+			ID:   dave.ID,
+			Role: &role,
+		}).Return(nil)
+		redisMock := repoMocks.NewUserRedisRepositoryMock(mc)
+		redisMock.DeleteUserMock.Expect(minimock.AnyContext, dave.ID).Return(nil)
+		logMock := repoMocks.NewLogRepositoryMock(mc)
+		logMock.CreateRecordMock.Return(1, nil)
+		m.redisRepository = redisMock
+		m.logRepository = logMock
+		m.txManager = txManager(mc)
+		return m
+	}
+	unlinkedFunc := func(err error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := emptyMocks(mc)
+			identityMock := repoMocks.NewFederatedIdentityRepositoryMock(mc)
+			identityMock.GetFederatedIdentityMock.Return(nil, err)
+			m.identityRepository = identityMock
+			return m
+		}
+	}
+	provisionFunc := func(names []string, user *model.User) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := unlinkedFunc(model.ErrorFederatedIdentityNotFound)(mc)
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			userMock.FindUserNamesMock.Expect(minimock.AnyContext, []string{user.Name}).
+				Return(names, nil)
+			m.userRepository = userMock
+			m.txManager = txManager(mc)
+			if len(names) > 0 {
+				return m
+			}
+
+			userMock.CreateUserMock.Expect(minimock.AnyContext, &model.NewUser{
+				Name:   user.Name,
+				Email:  user.Name + "@example.org",
+				Role:   user.Role,
+				Status: model.UserStatusActive,
+			}).Return(user.ID, nil)
+			userMock.GetUserMock.Expect(minimock.AnyContext, user.ID).Return(user, nil)
+			identityMock := m.identityRepository.(*repoMocks.FederatedIdentityRepositoryMock)
+			identityMock.CreateFederatedIdentityMock.Expect(minimock.AnyContext,
+				&model.FederatedIdentity{
+					Provider: "ldap:corp",
+					Subject:  "uid=" + user.Name + ",ou=people,dc=example,dc=org",
+					UserID:   user.ID,
+				}).Return(1, nil)
+			logMock := repoMocks.NewLogRepositoryMock(mc)
+			logMock.CreateRecordMock.Return(1, nil)
+			m.logRepository = logMock
+			return m
+		}
+	}
+	byNameFunc := func(user *model.User, err error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := unlinkedFunc(model.ErrorFederatedIdentityNotFound)(mc)
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			userMock.GetUserByNameMock.Expect(minimock.AnyContext, "dave").Return(user, err)
+			m.userRepository = userMock
+			return m
+		}
+	}
+	// linkFunc mocks existing user of same name linked to identities,
+	// user is linked to entry unless it is linked to another account of directory
+	linkFunc := func(user *model.User, identities []*model.FederatedIdentity) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := byNameFunc(user, nil)(mc)
+			identityMock := m.identityRepository.(*repoMocks.FederatedIdentityRepositoryMock)
+			identityMock.ListFederatedIdentitiesByUserIDMock.
+				Expect(minimock.AnyContext, user.ID).Return(identities, nil)
+			for _, identity := range identities {
+				if identity.Provider == "ldap:corp" {
+					return m
+				}
+			}
+
+			identityMock.CreateFederatedIdentityMock.Expect(minimock.AnyContext,
+				&model.FederatedIdentity{
+					Provider: "ldap:corp",
+					Subject:  daveDN,
+					UserID:   user.ID,
+				}).Return(1, nil)
+			return m
+		}
+	}
+	verifiedDave := &model.User{ID: dave.ID, TenantID: tenantID, Name: "dave",
+		Email: "Dave@example.org", Role: model.RoleAdmin, Status: model.UserStatusActive,
+		EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+	unverifiedDave := &model.User{ID: dave.ID, TenantID: tenantID, Name: "dave",
+		Email: "dave@example.org", Role: model.RoleAdmin, Status: model.UserStatusActive}
+
+	erin := &model.User{ID: 11, TenantID: tenantID, Name: "erin", Role: model.RoleUser}
+
+	testsSuccessful := []struct {
+		name      string
+		directory *model.LDAPDirectory
+		username  string
+		password  string
+		want      *model.User
+		mocks     mocksFunc
+	}{
+		{
+			name:      "success case: linked user",
+			directory: synced,
+			username:  "dave",
+			password:  davePassword,
+			want:      dave,
+			mocks:     linkedFunc(dave),
+		},
+		{
+			name:      "success case: role of linked user follows groups",
+			directory: synced,
+			username:  "dave",
+			password:  davePassword,
+			want:      dave,
+			mocks:     roleChangedFunc,
+		},
+		{
+			name:      "success case: user is provisioned with mapped role",
+			directory: synced,
+			username:  "dave",
+			password:  davePassword,
+			want:      dave,
+			mocks:     provisionFunc(nil, dave),
+		},
+		{
+			name:      "success case: user in no mapped group gets default role",
+			directory: synced,
+			username:  "erin",
+			password:  "erin password",
+			want:      erin,
+			mocks:     provisionFunc(nil, erin),
+		},
+		{
+			name:      "success case: directory checks password of linked existing user",
+			directory: directory(false, ""),
+			username:  "dave",
+			password:  davePassword,
+			want:      dave,
+			mocks:     linkedFunc(dave),
+		},
+		{
+			name:      "success case: existing user with verified email of entry is linked",
+			directory: directory(false, ""),
+			username:  "DAVE",
+			password:  davePassword,
+			want:      verifiedDave,
+			mocks: linkFunc(verifiedDave, []*model.FederatedIdentity{
+				{Provider: "google", Subject: "dave", UserID: dave.ID},
+			}),
+		},
+	}
+	testsErrors := []struct {
+		name      string
+		directory *model.LDAPDirectory
+		username  string
+		password  string
+		err       error
+		mocks     mocksFunc
+	}{
+		{
+			name: "error: directory of other tenant",
+			directory: func() *model.LDAPDirectory {
+				d := directory(true, model.RoleUser)
+				d.TenantID = tenantID + 1
+				return d
+			}(),
+			username: "dave",
+			password: davePassword,
+			err:      model.ErrorInvalidCredentials,
+			mocks:    emptyMocks,
+		},
+		{
+			name:      "error: unknown user",
+			directory: synced,
+			username:  "nobody",
+			password:  davePassword,
+			err:       model.ErrorInvalidCredentials,
+			mocks:     emptyMocks,
+		},
+		{
+			name:      "error: wrong password",
+			directory: synced,
+			username:  "dave",
+			password:  "wrong",
+			err:       model.ErrorInvalidCredentials,
+			mocks:     emptyMocks,
+		},
+		{
+			// server accepts unauthenticated bind with empty password
+			name:      "error: empty password",
+			directory: synced,
+			username:  "dave",
+			password:  "",
+			err:       model.ErrorInvalidCredentials,
+			mocks:     emptyMocks,
+		},
+		{
+			name:      "error: filter injection",
+			directory: synced,
+			username:  "*",
+			password:  davePassword,
+			err:       model.ErrorInvalidCredentials,
+			mocks:     emptyMocks,
+		},
+		{
+			name:      "error: no role is mapped",
+			directory: directory(true, ""),
+			username:  "erin",
+			password:  "erin password",
+			err:       fmt.Errorf("unable to login: no role is mapped to groups of user erin"),
+			mocks:     emptyMocks,
+		},
+		{
+			name:      "error: local user with same name is not taken over",
+			directory: synced,
+			username:  "dave",
+			password:  davePassword,
+			err:       fmt.Errorf("unable to login: user name dave is already taken"),
+			mocks:     provisionFunc([]string{"dave"}, dave),
+		},
+		{
+			name:      "error: user is not provisioned",
+			directory: directory(false, ""),
+			username:  "dave",
+			password:  davePassword,
+			err:       fmt.Errorf("unable to login: user dave is not provisioned"),
+			mocks:     byNameFunc(nil, model.ErrorUserNotFound),
+		},
+		{
+			name:      "error: local user with same name and unverified email is not taken over",
+			directory: directory(false, ""),
+			username:  "dave",
+			password:  davePassword,
+			err:       fmt.Errorf("unable to login: user dave is not linked to directory account"),
+			mocks:     byNameFunc(unverifiedDave, nil),
+		},
+		{
+			name:      "error: local user with same name and other email is not taken over",
+			directory: directory(false, ""),
+			username:  "dave",
+			password:  davePassword,
+			err:       fmt.Errorf("unable to login: user dave is not linked to directory account"),
+			mocks: byNameFunc(&model.User{ID: dave.ID, Name: "dave", Email: "dave@evil.org",
+				EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil),
+		},
+		{
+			name:      "error: user is linked to another directory account",
+			directory: directory(false, ""),
+			username:  "dave",
+			password:  davePassword,
+			err: fmt.Errorf("unable to login: user dave is linked to another " +
+				"directory account"),
+			mocks: linkFunc(verifiedDave, []*model.FederatedIdentity{
+				{Provider: "ldap:corp", Subject: "uid=dave,ou=old,dc=example,dc=org",
+					UserID: dave.ID},
+			}),
+		},
+		{
+			name:      "error: ambiguous user",
+			directory: synced,
+			username:  "twin",
+			password:  "twin password",
+			err:       fmt.Errorf("directory has several users \"twin\""),
+			mocks:     emptyMocks,
+		},
+		{
+			name: "error: wrong password of search account",
+			directory: func() *model.LDAPDirectory {
+				d := directory(true, model.RoleUser)
+				d.BindPassword = "wrong"
+				return d
+			}(),
+			username: "dave",
+			password: davePassword,
+			err:      fmt.Errorf("unable to bind search account"),
+			mocks:    emptyMocks,
+		},
+		{
+			name: "error: directory is unreachable",
+			directory: func() *model.LDAPDirectory {
+				d := directory(true, model.RoleUser)
+				d.URL = "ldap://127.0.0.1:1"
+				return d
+			}(),
+			username: "dave",
+			password: davePassword,
+			err:      fmt.Errorf("unable to connect to directory"),
+			mocks:    emptyMocks,
+		},
+		{
+			name:      "repo error",
+			directory: synced,
+			username:  "dave",
+			password:  davePassword,
+			err:       repoErr,
+			mocks:     unlinkedFunc(repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			ldap := authenticator.NewLDAPAuthenticator(
+				ldapClient.NewClient(tt.directory, time.Second), m.userRepository,
+				m.redisRepository, m.logRepository, m.identityRepository, m.txManager)
+
+			user, err := ldap.Authenticate(ctx, tt.username, tt.password)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, user)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			ldap := authenticator.NewLDAPAuthenticator(
+				ldapClient.NewClient(tt.directory, time.Second), m.userRepository,
+				m.redisRepository, m.logRepository, m.identityRepository, m.txManager)
+
+			_, err := ldap.Authenticate(ctx, tt.username, tt.password)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/model"
+	passwordLib "github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/authenticator"
+)
+
+func TestLocalAuthenticate(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		password = "correct horse"
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	hash, err := passwordLib.HashPassword(password)
+	require.NoError(t, err)
+
+	userFunc := func(user *model.User, err error) userRepositoryMockFunc {
+		return func(mc *minimock.Controller) repository.UserRepository {
+			mock := repoMocks.NewUserRepositoryMock(mc)
+			mock.GetUserByNameMock.Expect(minimock.AnyContext, "alice").Return(user, err)
+			return mock
+		}
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		want     *model.User
+		err      error
+		mock     userRepositoryMockFunc
+	}{
+		{
+			name:     "success case",
+			password: password,
+			want:     &model.User{ID: 1, Name: "alice", Password: hash},
+			mock:     userFunc(&model.User{ID: 1, Name: "alice", Password: hash}, nil),
+		},
+		{
+			name:     "error: wrong password",
+			password: "wrong",
+			err:      model.ErrorInvalidCredentials,
+			mock:     userFunc(&model.User{ID: 1, Name: "alice", Password: hash}, nil),
+		},
+		{
+			name:     "error: user has no local password",
+			password: "",
+			err:      model.ErrorInvalidCredentials,
+			mock:     userFunc(&model.User{ID: 1, Name: "alice"}, nil),
+		},
+		{
+			name:     "error: unknown user",
+			password: password,
+			err:      model.ErrorInvalidCredentials,
+			mock:     userFunc(nil, model.ErrorUserNotFound),
+		},
+		{
+			name:     "repo error",
+			password: password,
+			err:      repoErr,
+			mock:     userFunc(nil, repoErr),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			local := authenticator.NewLocalAuthenticator(tt.mock(mc))
+
+			user, err := local.Authenticate(ctx, "alice", tt.password)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, user)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Package caller authenticates callers of services by access tokens and authorizes them
+// by access rules of their organizations
+package caller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// Authenticate verifies access token of caller and returns its claims
+// with context of token tenant
+func Authenticate(ctx context.Context, accessService service.AccessService, accessToken string) (
+	context.Context, *model.UserClaims, error,
+) {
+	claims, err := accessService.Authenticate(ctx, accessToken)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	return tenant.NewContext(ctx, claims.TenantID), claims, nil
+}
+
+// Authorize verifies access token of caller and decides its access to endpoint
+// by access rules of token tenant, e.g. /access_admin_v1.AccessAdminV1/CreateRule.
+// It returns claims of allowed caller with context of token tenant,
+// caller without allowing rule is ErrorPermissionDenied.
+func Authorize(ctx context.Context, accessService service.AccessService, accessToken string,
+	endpoint string, attributes map[string]string,
+) (context.Context, *model.UserClaims, error) {
+	authorization, err := accessService.Authorize(ctx, accessToken, endpoint, attributes)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, authorization.Claims.TenantID)
+	if !authorization.Decision.Allowed {
+		return ctx, nil, errors.Wrap(model.ErrorPermissionDenied, authorization.Decision.Reason)
+	}
+
+	return ctx, authorization.Claims, nil
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/service/caller"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		endpoint = "/relation_v1.RelationV1/WriteTuples"
+		tenantID = int64(4)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       7,
+				TenantID: tenantID,
+				Name:     "alice",
+				Role:     role,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+	)
+
+	revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+	revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Return(&model.EndpointAccessRule{
+		Endpoint: "/relation_v1.RelationV1",
+		Allow: []*model.AccessRule{
+			{ID: 1, Effect: model.AccessEffectAllow, Role: model.RoleAdmin},
+		},
+	}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Return([]string{model.RoleUser}, nil)
+
+	accessService := access.NewService(accessMock, revocationMock, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, nil))
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{
+			name:  "success case: admin",
+			token: token(model.RoleAdmin),
+		},
+		{
+			name:  "error: role without allowing rule",
+			token: token(model.RoleUser),
+			err:   model.ErrorPermissionDenied,
+		},
+		{
+			name:  "error: invalid token",
+			token: "invalid",
+			err:   model.ErrorUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			callerCtx, claims, err := caller.Authorize(ctx, accessService, tt.token, endpoint, nil)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, claims)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "alice", claims.Username)
+			id, err := tenant.FromContext(callerCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tenantID, id)
+		})
+	}
+}
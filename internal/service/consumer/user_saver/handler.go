@@ -9,6 +9,7 @@ import (
 
 	"github.com/valek177/auth/internal/logger"
 	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
 )
 
 // UserSaveHandler executes kafka creation user logic
@@ -19,7 +20,7 @@ func (s *service) UserSaveHandler(ctx context.Context, msg *sarama.ConsumerMessa
 		return err
 	}
 
-	id, err := s.userRepository.CreateUser(ctx, user)
+	id, err := s.userRepository.CreateUser(tenant.NewContext(ctx, model.DefaultTenantID), user)
 	if err != nil {
 		return err
 	}
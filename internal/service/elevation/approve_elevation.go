@@ -0,0 +1,54 @@
+package elevation
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// ApproveElevation approves pending elevation request of another user, tokens minted
+// between start and expiry of grant carry granted role
+func (s *serv) ApproveElevation(ctx context.Context, accessToken string, id int64) (
+	*model.RoleGrant, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "approve elevation (service)")
+	defer span.Finish()
+
+	ctx, claims, approverRole, err := s.approver(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := s.roleGrantRepository.GetRoleGrant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if grant.Status != model.RoleGrantStatusPending {
+		return nil, errors.Errorf("elevation request is already %s", grant.Status)
+	}
+	if grant.UserID == claims.UserID {
+		return nil, errors.New("elevation request must be approved by another approver")
+	}
+	if !time.Now().Before(grant.ExpiresAt) {
+		return nil, errors.New("elevation request has expired")
+	}
+	if err = s.authorizeRole(ctx, approverRole, grant.Role); err != nil {
+		return nil, err
+	}
+
+	// request may be reviewed or expire after it was read, update approves pending one only
+	err = s.roleGrantRepository.UpdateRoleGrantStatus(ctx, id, model.RoleGrantStatusApproved,
+		claims.Username)
+	if errors.Is(err, model.ErrorRoleGrantNotFound) {
+		return nil, errors.Wrap(err, "elevation request is no longer pending")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roleGrantRepository.GetRoleGrant(ctx, id)
+}
@@ -0,0 +1,81 @@
+package elevation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// approver verifies access token of designated approver and returns its claims
+// and own role with context of token tenant
+func (s *serv) approver(ctx context.Context, accessToken string) (
+	context.Context, *model.UserClaims, string, error,
+) {
+	ctx, claims, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return ctx, nil, "", err
+	}
+
+	role, err := s.approverRole(ctx, claims.UserID)
+	if err != nil {
+		return ctx, nil, "", err
+	}
+	if role == "" {
+		return ctx, nil, "", errors.New("permission denied: elevation approver required")
+	}
+
+	return ctx, claims, role, nil
+}
+
+// isApprover reports whether own role of user grants approval of elevation requests,
+// roles granted by elevation are not taken into account
+func (s *serv) isApprover(ctx context.Context, userID int64) (bool, error) {
+	role, err := s.approverRole(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return role != "", nil
+}
+
+// approverRole returns own role of user if it grants approval of elevation requests
+// and empty role otherwise
+func (s *serv) approverRole(ctx context.Context, userID int64) (string, error) {
+	user, err := s.userRepository.GetUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	permissions, err := s.permissionRepository.ListEffectivePermissions(ctx, user.Role)
+	if err != nil {
+		return "", err
+	}
+	if !slices.Contains(permissions, model.PermissionElevationApprove) {
+		return "", nil
+	}
+
+	return user.Role, nil
+}
+
+// authorizeRole returns error unless approver of own role may grant role: its own role
+// or role it inherits, e.g. USER is granted by ADMIN but not vice versa
+func (s *serv) authorizeRole(ctx context.Context, approverRole, role string) error {
+	if approverRole == role {
+		return nil
+	}
+
+	ancestors, err := s.roleRepository.ListAncestorRoles(ctx, approverRole)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(ancestors, role) {
+		return errors.Wrapf(model.ErrorPermissionDenied, "role %s can't be granted by %s",
+			role, approverRole)
+	}
+
+	return nil
+}
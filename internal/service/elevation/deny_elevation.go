@@ -0,0 +1,30 @@
+package elevation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// DenyElevation denies pending elevation request
+func (s *serv) DenyElevation(ctx context.Context, accessToken string, id int64) (
+	*model.RoleGrant, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "deny elevation (service)")
+	defer span.Finish()
+
+	ctx, claims, _, err := s.approver(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.roleGrantRepository.UpdateRoleGrantStatus(ctx, id, model.RoleGrantStatusDenied,
+		claims.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roleGrantRepository.GetRoleGrant(ctx, id)
+}
@@ -0,0 +1,17 @@
+package elevation
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// ExpireElevations marks role grants and requests of all tenants past their expiry
+// as expired and returns their count
+func (s *serv) ExpireElevations(ctx context.Context) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "expire elevations (service)")
+	defer span.Finish()
+
+	return s.roleGrantRepository.ExpireRoleGrants(ctx, time.Now())
+}
@@ -0,0 +1,36 @@
+package elevation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListElevations returns role grants with given status, empty status matches any,
+// approvers see grants of all users and other users see own grants only
+func (s *serv) ListElevations(ctx context.Context, accessToken string, status string) (
+	[]*model.RoleGrant, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list elevations (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &model.RoleGrantFilter{Status: status}
+
+	ok, err := s.isApprover(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		filter.UserID = claims.UserID
+	}
+
+	return s.roleGrantRepository.ListRoleGrants(ctx, filter)
+}
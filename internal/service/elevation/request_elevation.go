@@ -0,0 +1,58 @@
+package elevation
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// RequestElevation creates pending request of caller for time-bound grant of role,
+// grant starts not earlier than now
+func (s *serv) RequestElevation(ctx context.Context, accessToken string,
+	elevation *model.NewElevation,
+) (*model.RoleGrant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "request elevation (service)")
+	defer span.Finish()
+
+	if err := validateRequestElevation(elevation); err != nil {
+		return nil, err
+	}
+
+	ctx, claims, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == elevation.Role {
+		return nil, errors.Errorf("unable to request elevation: user already has role %s",
+			elevation.Role)
+	}
+
+	startsAt := elevation.StartsAt
+	if now := time.Now(); startsAt.Before(now) {
+		startsAt = now
+	}
+
+	id, err := s.roleGrantRepository.CreateRoleGrant(ctx, &model.RoleGrant{
+		UserID:    user.ID,
+		Role:      elevation.Role,
+		Reason:    elevation.Reason,
+		Status:    model.RoleGrantStatusPending,
+		StartsAt:  startsAt,
+		ExpiresAt: startsAt.Add(elevation.Duration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roleGrantRepository.GetRoleGrant(ctx, id)
+}
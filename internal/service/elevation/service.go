@@ -0,0 +1,31 @@
+package elevation
+
+import (
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+)
+
+type serv struct {
+	userRepository       repository.UserRepository
+	roleGrantRepository  repository.RoleGrantRepository
+	permissionRepository repository.PermissionRepository
+	roleRepository       repository.RoleRepository
+	accessService        service.AccessService
+}
+
+// NewService creates new service with settings
+func NewService(
+	userRepository repository.UserRepository,
+	roleGrantRepository repository.RoleGrantRepository,
+	permissionRepository repository.PermissionRepository,
+	roleRepository repository.RoleRepository,
+	accessService service.AccessService,
+) service.ElevationService {
+	return &serv{
+		userRepository:       userRepository,
+		roleGrantRepository:  roleGrantRepository,
+		permissionRepository: permissionRepository,
+		roleRepository:       roleRepository,
+		accessService:        accessService,
+	}
+}
@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/service/elevation"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type mocks struct {
+	userRepository       repository.UserRepository
+	roleGrantRepository  repository.RoleGrantRepository
+	permissionRepository repository.PermissionRepository
+	roleRepository       repository.RoleRepository
+	revocationRepository repository.TokenRevocationRepository
+}
+
+// newAccessService returns access service allowing admins only to any endpoint
+func newAccessService(mc *minimock.Controller, tokenAccess utils.Token,
+	revocationRepository repository.TokenRevocationRepository,
+) service.AccessService {
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Return(&model.EndpointAccessRule{
+		Allow: []*model.AccessRule{{Effect: model.AccessEffectAllow, Role: model.RoleAdmin}},
+	}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Return(nil, nil)
+
+	return access.NewService(accessMock, revocationRepository, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, nil))
+}
+
+func TestApproveElevation(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID   = int64(7)
+		grantID    = int64(5)
+		userID     = int64(11)
+		approverID = int64(12)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(id int64, name string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       id,
+				TenantID: tenantID,
+				Name:     name,
+				Role:     model.RoleAdmin,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		grant = func(status string, expiresAt time.Time) *model.RoleGrant {
+			return &model.RoleGrant{
+				ID:        grantID,
+				TenantID:  tenantID,
+				UserID:    userID,
+				Role:      model.RoleAdmin,
+				Status:    status,
+				StartsAt:  expiresAt.Add(-time.Hour),
+				ExpiresAt: expiresAt,
+			}
+		}
+		later = time.Now().Add(time.Hour)
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	// approverFunc returns mocks of caller whose own role has given permissions
+	approverFunc := func(permissions []string) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Set(func(ctx context.Context, _ string) (
+				time.Time, error,
+			) {
+				if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+					return time.Time{}, fmt.Errorf("revocation checked in wrong tenant")
+				}
+				return time.Time{}, nil
+			})
+
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			userMock.GetUserMock.Set(func(_ context.Context, id int64) (*model.User, error) {
+				// own role of caller is checked, not role of token
+				return &model.User{ID: id, TenantID: tenantID, Role: "SRE"}, nil
+			})
+
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			permissionMock.ListEffectivePermissionsMock.Expect(minimock.AnyContext, "SRE").
+				Return(permissions, nil)
+
+			return mocks{
+				userRepository:       userMock,
+				roleGrantRepository:  repoMocks.NewRoleGrantRepositoryMock(mc),
+				permissionRepository: permissionMock,
+				revocationRepository: revocationMock,
+			}
+		}
+	}
+	approver := approverFunc([]string{"user:read", model.PermissionElevationApprove})
+	getGrantFunc := func(g *model.RoleGrant) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := approver(mc)
+			grantMock := repoMocks.NewRoleGrantRepositoryMock(mc)
+			grantMock.GetRoleGrantMock.Return(g, nil)
+			m.roleGrantRepository = grantMock
+			return m
+		}
+	}
+	// ancestorsFunc returns mocks of pending grant and approver inheriting roles of ancestors
+	ancestorsFunc := func(ancestors []string) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := getGrantFunc(grant(model.RoleGrantStatusPending, later))(mc)
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListAncestorRolesMock.Expect(minimock.AnyContext, "SRE").
+				Return(ancestors, nil)
+			m.roleRepository = roleMock
+			return m
+		}
+	}
+	approveFunc := func(updateErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := approver(mc)
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListAncestorRolesMock.Expect(minimock.AnyContext, "SRE").
+				Return([]string{model.RoleAdmin, model.RoleUser}, nil)
+			m.roleRepository = roleMock
+
+			approved := false
+			grantMock := repoMocks.NewRoleGrantRepositoryMock(mc)
+			grantMock.GetRoleGrantMock.Set(func(_ context.Context, _ int64) (
+				*model.RoleGrant, error,
+			) {
+				if approved {
+					g := grant(model.RoleGrantStatusApproved, later)
+					g.ReviewedBy.String, g.ReviewedBy.Valid = "boss", true
+					return g, nil
+				}
+				return grant(model.RoleGrantStatusPending, later), nil
+			})
+			grantMock.UpdateRoleGrantStatusMock.Set(func(_ context.Context, id int64,
+				status, reviewedBy string,
+			) error {
+				if id != grantID || status != model.RoleGrantStatusApproved || reviewedBy != "boss" {
+					return fmt.Errorf("wrong review")
+				}
+				approved = updateErr == nil
+				return updateErr
+			})
+			m.roleGrantRepository = grantMock
+			return m
+		}
+	}
+
+	testsSuccessful := []struct {
+		name  string
+		token string
+		want  string
+		mocks mocksFunc
+	}{
+		{
+			name:  "success case: grant is approved",
+			token: token(approverID, "boss"),
+			want:  model.RoleGrantStatusApproved,
+			mocks: approveFunc(nil),
+		},
+	}
+	testsErrors := []struct {
+		name  string
+		token string
+		err   error
+		mocks mocksFunc
+	}{
+		{
+			name:  "error: caller is not approver",
+			token: token(approverID, "boss"),
+			err:   fmt.Errorf("permission denied: elevation approver required"),
+			mocks: approverFunc([]string{"user:read"}),
+		},
+		{
+			name:  "error: own request",
+			token: token(userID, "alice"),
+			err:   fmt.Errorf("elevation request must be approved by another approver"),
+			mocks: getGrantFunc(grant(model.RoleGrantStatusPending, later)),
+		},
+		{
+			name:  "error: request is already denied",
+			token: token(approverID, "boss"),
+			err:   fmt.Errorf("elevation request is already denied"),
+			mocks: getGrantFunc(grant(model.RoleGrantStatusDenied, later)),
+		},
+		{
+			name:  "error: request has expired",
+			token: token(approverID, "boss"),
+			err:   fmt.Errorf("elevation request has expired"),
+			mocks: getGrantFunc(grant(model.RoleGrantStatusPending, time.Now().Add(-time.Minute))),
+		},
+		{
+			name:  "error: role above own role of approver",
+			token: token(approverID, "boss"),
+			err:   fmt.Errorf("role ADMIN can't be granted by SRE"),
+			mocks: ancestorsFunc([]string{model.RoleUser}),
+		},
+		{
+			name:  "error: request is reviewed concurrently",
+			token: token(approverID, "boss"),
+			err:   fmt.Errorf("elevation request is no longer pending"),
+			mocks: approveFunc(model.ErrorRoleGrantNotFound),
+		},
+		{
+			name:  "repo error: update status",
+			token: token(approverID, "boss"),
+			err:   repoErr,
+			mocks: approveFunc(repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := elevation.NewService(m.userRepository, m.roleGrantRepository,
+				m.permissionRepository, m.roleRepository,
+				newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			res, err := service.ApproveElevation(ctx, tt.token, grantID)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, res.Status)
+			assert.Equal(t, "boss", res.ReviewedBy.String)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := elevation.NewService(m.userRepository, m.roleGrantRepository,
+				m.permissionRepository, m.roleRepository,
+				newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			_, err := service.ApproveElevation(ctx, tt.token, grantID)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
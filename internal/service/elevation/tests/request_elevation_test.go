@@ -0,0 +1,207 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/elevation"
+	"github.com/valek177/auth/internal/utils"
+)
+
+func TestRequestElevation(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID = int64(7)
+		userID   = int64(11)
+		grantID  = int64(5)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				ID:       userID,
+				TenantID: tenantID,
+				Name:     "alice",
+				Role:     model.RoleUser,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+
+		startsAt = time.Now().Add(time.Hour)
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	callerFunc := func(mc *minimock.Controller) mocks {
+		revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+		revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+		userMock := repoMocks.NewUserRepositoryMock(mc)
+		userMock.GetUserMock.Expect(minimock.AnyContext, userID).
+			Return(&model.User{ID: userID, TenantID: tenantID, Role: model.RoleUser}, nil)
+
+		return mocks{
+			userRepository:       userMock,
+			roleGrantRepository:  repoMocks.NewRoleGrantRepositoryMock(mc),
+			permissionRepository: repoMocks.NewPermissionRepositoryMock(mc),
+			revocationRepository: revocationMock,
+		}
+	}
+	createFunc := func(check func(*model.RoleGrant) error, createErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := callerFunc(mc)
+
+			var created *model.RoleGrant
+			grantMock := repoMocks.NewRoleGrantRepositoryMock(mc)
+			grantMock.CreateRoleGrantMock.Set(func(_ context.Context, grant *model.RoleGrant) (
+				int64, error,
+			) {
+				if err := check(grant); err != nil {
+					return 0, err
+				}
+				created = grant
+				return grantID, createErr
+			})
+			if createErr == nil {
+				grantMock.GetRoleGrantMock.Set(func(_ context.Context, _ int64) (
+					*model.RoleGrant, error,
+				) {
+					return created, nil
+				})
+			}
+			m.roleGrantRepository = grantMock
+			return m
+		}
+	}
+	noMocks := func(mc *minimock.Controller) mocks {
+		return mocks{
+			userRepository:       repoMocks.NewUserRepositoryMock(mc),
+			roleGrantRepository:  repoMocks.NewRoleGrantRepositoryMock(mc),
+			permissionRepository: repoMocks.NewPermissionRepositoryMock(mc),
+			revocationRepository: repoMocks.NewTokenRevocationRepositoryMock(mc),
+		}
+	}
+
+	testsSuccessful := []struct {
+		name      string
+		elevation *model.NewElevation
+		mocks     mocksFunc
+	}{
+		{
+			name: "success case: pending grant starts at requested time",
+			elevation: &model.NewElevation{
+				Role:     model.RoleAdmin,
+				Reason:   "incident 42",
+				StartsAt: startsAt,
+				Duration: time.Hour,
+			},
+			mocks: createFunc(func(grant *model.RoleGrant) error {
+				if grant.UserID != userID || grant.Status != model.RoleGrantStatusPending {
+					return fmt.Errorf("wrong grant")
+				}
+				if !grant.StartsAt.Equal(startsAt) || !grant.ExpiresAt.Equal(startsAt.Add(time.Hour)) {
+					return fmt.Errorf("wrong grant time")
+				}
+				return nil
+			}, nil),
+		},
+		{
+			name: "success case: grant in past starts now",
+			elevation: &model.NewElevation{
+				Role:     model.RoleAdmin,
+				Reason:   "incident 42",
+				StartsAt: time.Now().Add(-time.Hour),
+				Duration: time.Hour,
+			},
+			mocks: createFunc(func(grant *model.RoleGrant) error {
+				if time.Since(grant.StartsAt) > time.Minute || grant.StartsAt.After(time.Now()) {
+					return fmt.Errorf("grant does not start now")
+				}
+				return nil
+			}, nil),
+		},
+	}
+	testsErrors := []struct {
+		name      string
+		elevation *model.NewElevation
+		err       error
+		mocks     mocksFunc
+	}{
+		{
+			name:      "error: reason is required",
+			elevation: &model.NewElevation{Role: model.RoleAdmin, Reason: " ", Duration: time.Hour},
+			err:       fmt.Errorf("unable to request elevation: reason is required"),
+			mocks:     noMocks,
+		},
+		{
+			name: "error: duration is too long",
+			elevation: &model.NewElevation{Role: model.RoleAdmin, Reason: "incident",
+				Duration: model.MaxElevationDuration + time.Minute},
+			err:   fmt.Errorf("unable to request elevation: duration must be positive"),
+			mocks: noMocks,
+		},
+		{
+			name:      "error: role is already held",
+			elevation: &model.NewElevation{Role: model.RoleUser, Reason: "incident", Duration: time.Hour},
+			err:       fmt.Errorf("unable to request elevation: user already has role USER"),
+			mocks:     callerFunc,
+		},
+		{
+			name:      "repo error: create grant",
+			elevation: &model.NewElevation{Role: model.RoleAdmin, Reason: "incident", Duration: time.Hour},
+			err:       repoErr,
+			mocks: createFunc(func(_ *model.RoleGrant) error {
+				return nil
+			}, repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := elevation.NewService(m.userRepository, m.roleGrantRepository,
+				m.permissionRepository, m.roleRepository,
+				newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			res, err := service.RequestElevation(ctx, token, tt.elevation)
+
+			assert.Nil(t, err)
+			assert.Equal(t, model.RoleGrantStatusPending, res.Status)
+			assert.Equal(t, tt.elevation.Role, res.Role)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := elevation.NewService(m.userRepository, m.roleGrantRepository,
+				m.permissionRepository, m.roleRepository,
+				newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			_, err := service.RequestElevation(ctx, token, tt.elevation)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package elevation
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+func validateRequestElevation(elevation *model.NewElevation) error {
+	if elevation == nil {
+		return errors.New("unable to request elevation: empty request")
+	}
+	if elevation.Role == "" {
+		return errors.New("unable to request elevation: role is required")
+	}
+	if strings.TrimSpace(elevation.Reason) == "" {
+		return errors.New("unable to request elevation: reason is required")
+	}
+	if elevation.Duration <= 0 || elevation.Duration > model.MaxElevationDuration {
+		return errors.Errorf("unable to request elevation: duration must be positive "+
+			"and not longer than %s", model.MaxElevationDuration)
+	}
+
+	return nil
+}
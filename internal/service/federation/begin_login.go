@@ -0,0 +1,67 @@
+package federation
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// BeginLogin starts login of OAuth client user at upstream provider, authorization
+// request is resumed on callback with returned state
+func (s *serv) BeginLogin(ctx context.Context, provider string,
+	req *model.OAuthAuthorizationRequest,
+) (*model.FederationRedirect, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "begin federated login (service)")
+	defer span.Finish()
+
+	client, err := s.providerClient(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, client.Provider().TenantID)
+
+	state, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := client.AuthCodeURL(ctx, state, nonce, codeChallenge(codeVerifier))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err = s.federationStateRepository.DeleteExpiredLoginStates(ctx, now); err != nil {
+		return nil, err
+	}
+
+	err = s.federationStateRepository.CreateLoginState(ctx, &model.FederationLoginState{
+		StateHash:     hashState(state),
+		Provider:      provider,
+		Request:       req,
+		UpstreamNonce: nonce,
+		CodeVerifier:  codeVerifier,
+		ExpiresAt:     now.Add(model.FederationLoginStateTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FederationRedirect{
+		URL:   url,
+		State: state,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package federation
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// CompleteLogin completes login at upstream provider and returns user linked to
+// upstream account, user is provisioned on first login when provider allows it
+func (s *serv) CompleteLogin(ctx context.Context, provider string,
+	callback *model.FederationCallback,
+) (*model.FederatedLogin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "complete federated login (service)")
+	defer span.Finish()
+
+	client, ok := s.clients[provider]
+	if !ok {
+		return nil, model.ErrorFederationProviderNotFound
+	}
+
+	ctx = tenant.NewContext(ctx, client.Provider().TenantID)
+
+	if callback.State == "" {
+		return nil, model.ErrorFederationInvalidState
+	}
+
+	state, err := s.federationStateRepository.ConsumeLoginState(ctx, hashState(callback.State))
+	if err != nil {
+		return nil, err
+	}
+	if state.Provider != provider || !time.Now().Before(state.ExpiresAt) {
+		return nil, model.ErrorFederationInvalidState
+	}
+
+	login := &model.FederatedLogin{Request: state.Request}
+
+	if callback.Error != "" {
+		login.DeniedReason = "login at identity provider failed: " + callback.Error
+		return login, nil
+	}
+
+	claims, err := client.Exchange(ctx, callback.Code, state.CodeVerifier, state.UpstreamNonce)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to complete login at provider %s", provider)
+	}
+
+	login.UserID, login.DeniedReason, err = s.linkedUser(ctx, client.Provider(), claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return login, nil
+}
@@ -0,0 +1,92 @@
+package federation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+)
+
+// linkedUser returns ID of active user linked to upstream account or reason why
+// account may not sign in. Unlinked account gets new user when provider provisions
+// users, existing users are never linked automatically to prevent account takeover.
+func (s *serv) linkedUser(ctx context.Context, provider *model.FederationProvider,
+	claims *model.FederatedClaims,
+) (int64, string, error) {
+	identity, err := s.federatedIdentityRepository.GetFederatedIdentity(ctx, provider.Name,
+		claims.Subject)
+	switch {
+	case err == nil:
+		user, errUser := s.userRepository.GetUser(ctx, identity.UserID)
+		if errUser != nil {
+			return 0, "", errUser
+		}
+		if user.Status != model.UserStatusActive {
+			return 0, "user is not active", nil
+		}
+		return user.ID, "", nil
+	case !errors.Is(err, model.ErrorFederatedIdentityNotFound):
+		return 0, "", err
+	}
+
+	if !provider.Provisioning {
+		return 0, "account is not linked to user", nil
+	}
+
+	role := provider.MapRole(claims.Claims)
+	if role == "" {
+		return 0, "no role is mapped to account", nil
+	}
+
+	name := provider.Username(claims)
+	if name == "" || model.IsServiceAccountPrincipal(name) {
+		return 0, "account has no valid user name", nil
+	}
+
+	var (
+		userID int64
+		reason string
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		names, errTx := s.userRepository.FindUserNames(ctx, []string{name})
+		if errTx != nil {
+			return errTx
+		}
+		if len(names) > 0 {
+			reason = "user name is already taken"
+			return nil
+		}
+
+		userID, errTx = s.userRepository.CreateUser(ctx, &model.NewUser{
+			Name:          name,
+			Email:         claims.Email,
+			Role:          role,
+			Status:        model.UserStatusActive,
+			EmailVerified: claims.Email != "" && claims.EmailVerified,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.federatedIdentityRepository.CreateFederatedIdentity(ctx,
+			&model.FederatedIdentity{
+				Provider: provider.Name,
+				Subject:  claims.Subject,
+				UserID:   userID,
+			})
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(userID, "create"))
+
+		return errTx
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	return userID, reason, nil
+}
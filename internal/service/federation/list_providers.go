@@ -0,0 +1,24 @@
+package federation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// ListProviders returns upstream providers of OAuth client tenant ordered by name
+func (s *serv) ListProviders(ctx context.Context, clientID string) (
+	[]*model.FederationProvider, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list federation providers (service)")
+	defer span.Finish()
+
+	client, err := s.oauthClientRepository.GetOAuthClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tenantProviders(client.TenantID), nil
+}
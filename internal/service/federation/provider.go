@@ -0,0 +1,53 @@
+package federation
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/client/oidc"
+	"github.com/valek177/auth/internal/model"
+)
+
+// tenantProviders returns upstream providers of tenant ordered by name
+func (s *serv) tenantProviders(tenantID int64) []*model.FederationProvider {
+	providers := make([]*model.FederationProvider, 0, len(s.clients))
+	for _, client := range s.clients {
+		if client.Provider().TenantID == tenantID {
+			providers = append(providers, client.Provider())
+		}
+	}
+	slices.SortFunc(providers, func(a, b *model.FederationProvider) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return providers
+}
+
+// providerClient returns client of upstream provider users of OAuth client tenant
+// sign in with, client must have redirect URI registered
+func (s *serv) providerClient(ctx context.Context, name string,
+	req *model.OAuthAuthorizationRequest,
+) (oidc.Client, error) {
+	client, ok := s.clients[name]
+	if !ok {
+		return nil, model.ErrorFederationProviderNotFound
+	}
+
+	oauthClient, err := s.oauthClientRepository.GetOAuthClient(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	// redirect URI is compared exactly, RFC 9700 section 4.1.3
+	if !slices.Contains(oauthClient.RedirectURIs, req.RedirectURI) {
+		return nil, errors.Errorf("redirect uri %q is not registered for client %s",
+			req.RedirectURI, req.ClientID)
+	}
+	if oauthClient.TenantID != client.Provider().TenantID {
+		return nil, model.ErrorFederationProviderNotFound
+	}
+
+	return client, nil
+}
@@ -0,0 +1,45 @@
+package federation
+
+import (
+	"github.com/valek177/auth/internal/client/oidc"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+type serv struct {
+	oauthClientRepository       repository.OAuthClientRepository
+	userRepository              repository.UserRepository
+	logRepository               repository.LogRepository
+	federatedIdentityRepository repository.FederatedIdentityRepository
+	federationStateRepository   repository.FederationStateRepository
+	txManager                   db.TxManager
+	clients                     map[string]oidc.Client
+}
+
+// NewService creates new service with settings, clients are upstream providers
+// users sign in with
+func NewService(
+	oauthClientRepository repository.OAuthClientRepository,
+	userRepository repository.UserRepository,
+	logRepository repository.LogRepository,
+	federatedIdentityRepository repository.FederatedIdentityRepository,
+	federationStateRepository repository.FederationStateRepository,
+	txManager db.TxManager,
+	clients []oidc.Client,
+) service.FederationService {
+	clientsByName := make(map[string]oidc.Client, len(clients))
+	for _, client := range clients {
+		clientsByName[client.Provider().Name] = client
+	}
+
+	return &serv{
+		oauthClientRepository:       oauthClientRepository,
+		userRepository:              userRepository,
+		logRepository:               logRepository,
+		federatedIdentityRepository: federatedIdentityRepository,
+		federationStateRepository:   federationStateRepository,
+		txManager:                   txManager,
+		clients:                     clientsByName,
+	}
+}
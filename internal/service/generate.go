@@ -3,4 +3,14 @@ package service
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserService -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i AuthService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i Authenticator -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i AccessService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OrganizationService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i InvitationService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PrivacyService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ElevationService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PolicyService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OAuthClientService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ServiceAccountService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i FederationService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RelationService -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,94 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+	passwordLib "github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// AcceptInvitation sets password of invited user, activates it
+// and returns refresh and access tokens
+func (s *serv) AcceptInvitation(ctx context.Context, acceptInvitation *model.AcceptInvitation) (
+	string, string, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "accept invitation (service)")
+	defer span.Finish()
+
+	err := validateAcceptInvitation(acceptInvitation)
+	if err != nil {
+		return "", "", err
+	}
+
+	claims, err := s.invitationToken.VerifyToken(ctx, acceptInvitation.Token)
+	if err != nil {
+		return "", "", err
+	}
+	ctx = tenant.NewContext(ctx, claims.TenantID)
+
+	invitation, err := s.invitationRepository.GetInvitationByTokenID(ctx, claims.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrorInvitationNotFound) {
+			return "", "", errors.New("invitation link is no longer valid")
+		}
+		return "", "", err
+	}
+	if err = validatePendingInvitation(invitation); err != nil {
+		return "", "", err
+	}
+	if err = validateNotExpired(invitation); err != nil {
+		return "", "", err
+	}
+
+	passwordHash, err := passwordLib.HashPassword(acceptInvitation.Password)
+	if err != nil {
+		return "", "", errors.Wrap(err, "unable to hash password")
+	}
+
+	userID := invitation.UserID.Int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userRepository.ActivateUser(ctx, userID, passwordHash)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.invitationRepository.UpdateInvitationStatus(ctx, invitation.ID,
+			model.InvitationStatusAccepted)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(userID, "accept invitation"))
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	user, err := s.userRepository.GetUser(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err := s.tokenRefresh.GenerateToken(ctx, user)
+	if err != nil {
+		return "", "", errors.New("failed to generate refresh token")
+	}
+
+	accessToken, err := s.tokenAccess.GenerateToken(ctx, user)
+	if err != nil {
+		return "", "", errors.New("failed to generate access token")
+	}
+
+	return refreshToken, accessToken, nil
+}
@@ -0,0 +1,34 @@
+package invitation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+)
+
+// authorizeRole returns error unless inviter may grant role: its own role
+// or role it inherits, e.g. USER is granted by ADMIN but not vice versa
+func (s *serv) authorizeRole(ctx context.Context, role string) error {
+	claims, ok := identity.FromContext(ctx)
+	if !ok {
+		return model.ErrorUnauthenticated
+	}
+	if claims.Role == role {
+		return nil
+	}
+
+	ancestors, err := s.roleRepository.ListAncestorRoles(ctx, claims.Role)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(ancestors, role) {
+		return errors.Wrapf(model.ErrorPermissionDenied, "role %s can't be granted by %s",
+			role, claims.Role)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package invitation
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// InviteUser creates pending user with invitation and sends invitation link,
+// invited user can't get role higher than role of inviter
+func (s *serv) InviteUser(ctx context.Context, newInvitation *model.NewInvitation) (
+	*model.Invitation, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "invite user (service)")
+	defer span.Finish()
+
+	err := validateInviteUser(newInvitation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizeRole(ctx, newInvitation.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, err := s.invitationToken.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := &model.Invitation{
+		TenantID:  tenantID,
+		Email:     newInvitation.Email,
+		TokenID:   tokenID,
+		Status:    model.InvitationStatusPending,
+		ExpiresAt: s.invitationToken.ExpiresAt(),
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		userID, errTx := s.userRepository.CreateUser(ctx, &model.NewUser{
+			Name:   newInvitation.Name,
+			Email:  newInvitation.Email,
+			Role:   newInvitation.Role,
+			Status: model.UserStatusPending,
+		})
+		if errTx != nil {
+			return errTx
+		}
+		invitation.UserID = sql.NullInt64{Int64: userID, Valid: true}
+
+		invitation.ID, errTx = s.invitationRepository.CreateInvitation(ctx, invitation)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(userID, "invite"))
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sendInvitation(ctx, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
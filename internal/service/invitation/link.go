@@ -0,0 +1,35 @@
+package invitation
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+const tokenParamName = "token"
+
+// sendInvitation generates invitation link with current token and sends it to user
+func (s *serv) sendInvitation(ctx context.Context, invitation *model.Invitation) error {
+	token, err := s.invitationToken.GenerateToken(ctx, invitation)
+	if err != nil {
+		return errors.Wrap(err, "unable to generate invitation token")
+	}
+
+	link, err := url.Parse(s.invitationURL)
+	if err != nil {
+		return errors.Wrap(err, "unable to build invitation link")
+	}
+	query := link.Query()
+	query.Set(tokenParamName, token)
+	link.RawQuery = query.Encode()
+
+	err = s.notifier.SendInvitation(ctx, invitation.Email, link.String())
+	if err != nil {
+		return errors.Wrap(err, "unable to send invitation")
+	}
+
+	return nil
+}
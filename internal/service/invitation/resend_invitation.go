@@ -0,0 +1,50 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/converter"
+)
+
+// ResendInvitation sends invitation with new token, previous links become invalid
+func (s *serv) ResendInvitation(ctx context.Context, id int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "resend invitation (service)")
+	defer span.Finish()
+
+	invitation, err := s.invitationRepository.GetInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = validatePendingInvitation(invitation); err != nil {
+		return err
+	}
+
+	invitation.TokenID, err = s.invitationToken.NewTokenID()
+	if err != nil {
+		return err
+	}
+	invitation.ExpiresAt = s.invitationToken.ExpiresAt()
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.invitationRepository.UpdateInvitationToken(ctx, invitation.ID,
+			invitation.TokenID, invitation.ExpiresAt)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(invitation.UserID.Int64, "resend invitation"))
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sendInvitation(ctx, invitation)
+}
@@ -0,0 +1,46 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+)
+
+// RevokeInvitation revokes invitation and removes pending user
+func (s *serv) RevokeInvitation(ctx context.Context, id int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "revoke invitation (service)")
+	defer span.Finish()
+
+	invitation, err := s.invitationRepository.GetInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = validatePendingInvitation(invitation); err != nil {
+		return err
+	}
+
+	userID := invitation.UserID.Int64
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.invitationRepository.UpdateInvitationStatus(ctx, invitation.ID,
+			model.InvitationStatusRevoked)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.userRepository.DeleteUser(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.logRepository.CreateRecord(ctx,
+			converter.ToRecordRepoFromService(userID, "revoke invitation"))
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,49 @@
+package invitation
+
+import (
+	"github.com/valek177/auth/internal/client/notifier"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+type serv struct {
+	invitationRepository repository.InvitationRepository
+	userRepository       repository.UserRepository
+	roleRepository       repository.RoleRepository
+	logRepository        repository.LogRepository
+	txManager            db.TxManager
+	invitationToken      utils.InvitationToken
+	tokenRefresh         utils.Token
+	tokenAccess          utils.Token
+	notifier             notifier.Notifier
+	invitationURL        string
+}
+
+// NewService creates new service with settings
+func NewService(
+	invitationRepository repository.InvitationRepository,
+	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
+	logRepository repository.LogRepository,
+	txManager db.TxManager,
+	invitationToken utils.InvitationToken,
+	tokenRefresh utils.Token,
+	tokenAccess utils.Token,
+	notifier notifier.Notifier,
+	invitationURL string,
+) service.InvitationService {
+	return &serv{
+		invitationRepository: invitationRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		logRepository:        logRepository,
+		txManager:            txManager,
+		invitationToken:      invitationToken,
+		tokenRefresh:         tokenRefresh,
+		tokenAccess:          tokenAccess,
+		notifier:             notifier,
+		invitationURL:        invitationURL,
+	}
+}
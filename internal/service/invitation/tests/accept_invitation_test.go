@@ -0,0 +1,297 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/client/notifier"
+	notifierMocks "github.com/valek177/auth/internal/client/notifier/mocks"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/invitation"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+func (tokenConfig) URL() string            { return "http://localhost/invitation/accept" }
+
+func TestAcceptInvitation(t *testing.T) {
+	t.Parallel()
+	type invitationRepositoryMockFunc func(mc *minimock.Controller) repository.InvitationRepository
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type logRepositoryMockFunc func(mc *minimock.Controller) repository.LogRepository
+	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+
+	type args struct {
+		ctx    context.Context
+		accept *model.AcceptInvitation
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID     = int64(7)
+		invitationID = int64(3)
+		userID       = int64(11)
+		password     = "password"
+		tokenID      = "current"
+
+		invitationToken = utils.NewInvitationToken(tokenConfig{})
+
+		pendingInvitation = func(expiresAt time.Time) *model.Invitation {
+			return &model.Invitation{
+				ID:        invitationID,
+				TenantID:  tenantID,
+				UserID:    sql.NullInt64{Int64: userID, Valid: true},
+				TokenID:   tokenID,
+				Status:    model.InvitationStatusPending,
+				ExpiresAt: expiresAt,
+			}
+		}
+		token = func(tokenID string) string {
+			inv := pendingInvitation(time.Now().Add(time.Hour))
+			inv.TokenID = tokenID
+			str, err := invitationToken.GenerateToken(ctx, inv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	getInvitationFunc := func(invitation *model.Invitation, accepted bool) invitationRepositoryMockFunc {
+		return func(mc *minimock.Controller) repository.InvitationRepository {
+			mock := repoMocks.NewInvitationRepositoryMock(mc)
+			mock.GetInvitationByTokenIDMock.Set(func(ctx context.Context, id string) (
+				*model.Invitation, error,
+			) {
+				if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+					return nil, fmt.Errorf("invitation requested in wrong tenant")
+				}
+				if id != invitation.TokenID {
+					return nil, model.ErrorInvitationNotFound
+				}
+				return invitation, nil
+			})
+			if accepted {
+				mock.UpdateInvitationStatusMock.Return(nil)
+			}
+			return mock
+		}
+	}
+	noUserRepositoryFunc := func(mc *minimock.Controller) repository.UserRepository {
+		return repoMocks.NewUserRepositoryMock(mc)
+	}
+	logRepositoryFunc := func(mc *minimock.Controller) repository.LogRepository {
+		mock := repoMocks.NewLogRepositoryMock(mc)
+		mock.CreateRecordMock.Return(1, nil)
+		return mock
+	}
+	noLogRepositoryFunc := func(mc *minimock.Controller) repository.LogRepository {
+		return repoMocks.NewLogRepositoryMock(mc)
+	}
+	txManagerFunc := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.
+			Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+		return mock
+	}
+	noTxManagerFunc := func(mc *minimock.Controller) db.TxManager {
+		return dbMocks.NewTxManagerMock(mc)
+	}
+	notifierFunc := func(mc *minimock.Controller) notifier.Notifier {
+		return notifierMocks.NewNotifierMock(mc)
+	}
+
+	testsSuccessful := []struct {
+		name                     string
+		args                     args
+		invitationRepositoryMock invitationRepositoryMockFunc
+		userRepositoryMock       userRepositoryMockFunc
+		logRepositoryMock        logRepositoryMockFunc
+		txManagerMock            txManagerMockFunc
+	}{
+		{
+			name: "success case: pending user is activated",
+			args: args{
+				ctx: ctx,
+				accept: &model.AcceptInvitation{
+					Token:           token(tokenID),
+					Password:        password,
+					PasswordConfirm: password,
+				},
+			},
+			invitationRepositoryMock: getInvitationFunc(pendingInvitation(time.Now().Add(time.Hour)), true),
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.ActivateUserMock.Set(func(_ context.Context, id int64, _ string) error {
+					if id != userID {
+						return fmt.Errorf("wrong user activated")
+					}
+					return nil
+				})
+				mock.GetUserMock.Return(&model.User{
+					ID:       userID,
+					TenantID: tenantID,
+					Name:     "name",
+					Role:     "USER",
+					Status:   model.UserStatusActive,
+				}, nil)
+				return mock
+			},
+			logRepositoryMock: logRepositoryFunc,
+			txManagerMock:     txManagerFunc,
+		},
+	}
+	testsErrors := []struct {
+		name                     string
+		args                     args
+		err                      error
+		invitationRepositoryMock invitationRepositoryMockFunc
+		userRepositoryMock       userRepositoryMockFunc
+		logRepositoryMock        logRepositoryMockFunc
+		txManagerMock            txManagerMockFunc
+	}{
+		{
+			name: "error: passwords do not match",
+			args: args{
+				ctx: ctx,
+				accept: &model.AcceptInvitation{
+					Token:           token(tokenID),
+					Password:        password,
+					PasswordConfirm: "other",
+				},
+			},
+			err: fmt.Errorf("unable to accept invitation: the passwords do not match"),
+			invitationRepositoryMock: func(mc *minimock.Controller) repository.InvitationRepository {
+				return repoMocks.NewInvitationRepositoryMock(mc)
+			},
+			userRepositoryMock: noUserRepositoryFunc,
+			logRepositoryMock:  noLogRepositoryFunc,
+			txManagerMock:      noTxManagerFunc,
+		},
+		{
+			name: "error: invalid token",
+			args: args{
+				ctx: ctx,
+				accept: &model.AcceptInvitation{
+					Token:           "token",
+					Password:        password,
+					PasswordConfirm: password,
+				},
+			},
+			err: fmt.Errorf("invalid token"),
+			invitationRepositoryMock: func(mc *minimock.Controller) repository.InvitationRepository {
+				return repoMocks.NewInvitationRepositoryMock(mc)
+			},
+			userRepositoryMock: noUserRepositoryFunc,
+			logRepositoryMock:  noLogRepositoryFunc,
+			txManagerMock:      noTxManagerFunc,
+		},
+		{
+			name: "error: link was replaced by resend",
+			args: args{
+				ctx: ctx,
+				accept: &model.AcceptInvitation{
+					Token:           token("previous"),
+					Password:        password,
+					PasswordConfirm: password,
+				},
+			},
+			err:                      fmt.Errorf("invitation link is no longer valid"),
+			invitationRepositoryMock: getInvitationFunc(pendingInvitation(time.Now().Add(time.Hour)), false),
+			userRepositoryMock:       noUserRepositoryFunc,
+			logRepositoryMock:        noLogRepositoryFunc,
+			txManagerMock:            noTxManagerFunc,
+		},
+		{
+			name: "error: invitation is expired",
+			args: args{
+				ctx: ctx,
+				accept: &model.AcceptInvitation{
+					Token:           token(tokenID),
+					Password:        password,
+					PasswordConfirm: password,
+				},
+			},
+			err:                      fmt.Errorf("invitation is expired"),
+			invitationRepositoryMock: getInvitationFunc(pendingInvitation(time.Now().Add(-time.Hour)), false),
+			userRepositoryMock:       noUserRepositoryFunc,
+			logRepositoryMock:        noLogRepositoryFunc,
+			txManagerMock:            noTxManagerFunc,
+		},
+		{
+			name: "repo error: activate user",
+			args: args{
+				ctx: ctx,
+				accept: &model.AcceptInvitation{
+					Token:           token(tokenID),
+					Password:        password,
+					PasswordConfirm: password,
+				},
+			},
+			err:                      repoErr,
+			invitationRepositoryMock: getInvitationFunc(pendingInvitation(time.Now().Add(time.Hour)), false),
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.ActivateUserMock.Return(repoErr)
+				return mock
+			},
+			logRepositoryMock: noLogRepositoryFunc,
+			txManagerMock:     txManagerFunc,
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := invitation.NewService(tt.invitationRepositoryMock(mc),
+				tt.userRepositoryMock(mc), repoMocks.NewRoleRepositoryMock(mc),
+				tt.logRepositoryMock(mc), tt.txManagerMock(mc),
+				invitationToken, utils.NewToken(tokenConfig{}), utils.NewToken(tokenConfig{}),
+				notifierFunc(mc), tokenConfig{}.URL())
+
+			refreshToken, accessToken, err := service.AcceptInvitation(tt.args.ctx, tt.args.accept)
+
+			assert.Nil(t, err)
+			assert.NotEmpty(t, refreshToken)
+			assert.NotEmpty(t, accessToken)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := invitation.NewService(tt.invitationRepositoryMock(mc),
+				tt.userRepositoryMock(mc), repoMocks.NewRoleRepositoryMock(mc),
+				tt.logRepositoryMock(mc), tt.txManagerMock(mc),
+				invitationToken, utils.NewToken(tokenConfig{}), utils.NewToken(tokenConfig{}),
+				notifierFunc(mc), tokenConfig{}.URL())
+
+			_, _, err := service.AcceptInvitation(tt.args.ctx, tt.args.accept)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/client/notifier"
+	notifierMocks "github.com/valek177/auth/internal/client/notifier/mocks"
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/invitation"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestInviteUser(t *testing.T) {
+	t.Parallel()
+	type mocks struct {
+		invitationRepository repository.InvitationRepository
+		userRepository       repository.UserRepository
+		roleRepository       repository.RoleRepository
+		logRepository        repository.LogRepository
+		txManager            db.TxManager
+		notifier             notifier.Notifier
+	}
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		mc = minimock.NewController(t)
+
+		tenantID     = int64(7)
+		userID       = int64(11)
+		invitationID = int64(3)
+
+		callerCtx = func(role string) context.Context {
+			ctx := tenant.NewContext(context.Background(), tenantID)
+			return identity.NewContext(ctx, &model.UserClaims{
+				UserID:   1,
+				Username: "alice",
+				Role:     role,
+				TenantID: tenantID,
+			})
+		}
+		newInvitation = func(role string) *model.NewInvitation {
+			return &model.NewInvitation{Name: "bob", Email: "bob@example.com", Role: role}
+		}
+
+		ancestors = map[string][]string{
+			model.RoleAdmin: {model.RoleUser},
+			model.RoleUser:  {},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	rolesFunc := func(mc *minimock.Controller) repository.RoleRepository {
+		mock := repoMocks.NewRoleRepositoryMock(mc)
+		mock.ListAncestorRolesMock.Set(func(_ context.Context, role string) ([]string, error) {
+			return ancestors[role], nil
+		})
+		return mock
+	}
+	invitedFunc := func(roles func(mc *minimock.Controller) repository.RoleRepository) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			userMock.CreateUserMock.Return(userID, nil)
+
+			invitationMock := repoMocks.NewInvitationRepositoryMock(mc)
+			invitationMock.CreateInvitationMock.Return(invitationID, nil)
+
+			logMock := repoMocks.NewLogRepositoryMock(mc)
+			logMock.CreateRecordMock.Return(1, nil)
+
+			txMock := dbMocks.NewTxManagerMock(mc)
+			txMock.ReadCommittedMock.
+				Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+
+			notifierMock := notifierMocks.NewNotifierMock(mc)
+			notifierMock.SendInvitationMock.Return(nil)
+
+			return mocks{
+				invitationRepository: invitationMock,
+				userRepository:       userMock,
+				roleRepository:       roles(mc),
+				logRepository:        logMock,
+				txManager:            txMock,
+				notifier:             notifierMock,
+			}
+		}
+	}
+	deniedFunc := func(roles func(mc *minimock.Controller) repository.RoleRepository) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			return mocks{
+				invitationRepository: repoMocks.NewInvitationRepositoryMock(mc),
+				userRepository:       repoMocks.NewUserRepositoryMock(mc),
+				roleRepository:       roles(mc),
+				logRepository:        repoMocks.NewLogRepositoryMock(mc),
+				txManager:            dbMocks.NewTxManagerMock(mc),
+				notifier:             notifierMocks.NewNotifierMock(mc),
+			}
+		}
+	}
+	noRolesFunc := func(mc *minimock.Controller) repository.RoleRepository {
+		return repoMocks.NewRoleRepositoryMock(mc)
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		invitation *model.NewInvitation
+		err        error
+		mocks      mocksFunc
+	}{
+		{
+			name:       "success case: admin invites admin",
+			ctx:        callerCtx(model.RoleAdmin),
+			invitation: newInvitation(model.RoleAdmin),
+			mocks:      invitedFunc(noRolesFunc),
+		},
+		{
+			name:       "success case: admin invites user of inherited role",
+			ctx:        callerCtx(model.RoleAdmin),
+			invitation: newInvitation(model.RoleUser),
+			mocks:      invitedFunc(rolesFunc),
+		},
+		{
+			name:       "error: user invites admin",
+			ctx:        callerCtx(model.RoleUser),
+			invitation: newInvitation(model.RoleAdmin),
+			err:        model.ErrorPermissionDenied,
+			mocks:      deniedFunc(rolesFunc),
+		},
+		{
+			name:       "error: anonymous caller",
+			ctx:        tenant.NewContext(context.Background(), tenantID),
+			invitation: newInvitation(model.RoleUser),
+			err:        model.ErrorUnauthenticated,
+			mocks:      deniedFunc(noRolesFunc),
+		},
+		{
+			name:       "repo error: list ancestor roles",
+			ctx:        callerCtx(model.RoleUser),
+			invitation: newInvitation(model.RoleAdmin),
+			err:        repoErr,
+			mocks: deniedFunc(func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.ListAncestorRolesMock.Return(nil, repoErr)
+				return mock
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := invitation.NewService(m.invitationRepository, m.userRepository,
+				m.roleRepository, m.logRepository, m.txManager,
+				utils.NewInvitationToken(tokenConfig{}), utils.NewToken(tokenConfig{}),
+				utils.NewToken(tokenConfig{}), m.notifier, tokenConfig{}.URL())
+
+			res, err := service.InviteUser(tt.ctx, tt.invitation)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, invitationID, res.ID)
+			assert.Equal(t, userID, res.UserID.Int64)
+		})
+	}
+}
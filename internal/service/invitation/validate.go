@@ -0,0 +1,62 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+func validateInviteUser(newInvitation *model.NewInvitation) error {
+	if newInvitation == nil {
+		return errors.New("unable to invite user: empty model")
+	}
+	if newInvitation.Name == "" {
+		return errors.New("unable to invite user: name is required")
+	}
+	if model.IsServiceAccountPrincipal(newInvitation.Name) {
+		return errors.New("unable to invite user: name is reserved for service accounts")
+	}
+	if newInvitation.Email == "" {
+		return errors.New("unable to invite user: email is required")
+	}
+
+	return nil
+}
+
+func validateAcceptInvitation(acceptInvitation *model.AcceptInvitation) error {
+	if acceptInvitation == nil {
+		return errors.New("unable to accept invitation: empty model")
+	}
+	if acceptInvitation.Token == "" {
+		return errors.New("unable to accept invitation: token is required")
+	}
+	if acceptInvitation.Password == "" {
+		return errors.New("unable to accept invitation: password is required")
+	}
+	if acceptInvitation.Password != acceptInvitation.PasswordConfirm {
+		return errors.New("unable to accept invitation: the passwords do not match")
+	}
+
+	return nil
+}
+
+func validatePendingInvitation(invitation *model.Invitation) error {
+	if invitation.Status != model.InvitationStatusPending {
+		return errors.Errorf("invitation is %s", invitation.Status)
+	}
+	if !invitation.UserID.Valid {
+		return errors.New("invitation has no pending user")
+	}
+
+	return nil
+}
+
+func validateNotExpired(invitation *model.Invitation) error {
+	if time.Now().After(invitation.ExpiresAt) {
+		return errors.New("invitation is expired")
+	}
+
+	return nil
+}
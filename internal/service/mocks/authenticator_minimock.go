@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.Authenticator -o authenticator_minimock.go -n AuthenticatorMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// AuthenticatorMock implements mm_service.Authenticator
+type AuthenticatorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, username string, password string) (up1 *model.User, err error)
+	funcAuthenticateOrigin    string
+	inspectFuncAuthenticate   func(ctx context.Context, username string, password string)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mAuthenticatorMockAuthenticate
+}
+
+// NewAuthenticatorMock returns a mock for mm_service.Authenticator
+func NewAuthenticatorMock(t minimock.Tester) *AuthenticatorMock {
+	m := &AuthenticatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mAuthenticatorMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*AuthenticatorMockAuthenticateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthenticatorMockAuthenticate struct {
+	optional           bool
+	mock               *AuthenticatorMock
+	defaultExpectation *AuthenticatorMockAuthenticateExpectation
+	expectations       []*AuthenticatorMockAuthenticateExpectation
+
+	callArgs []*AuthenticatorMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthenticatorMockAuthenticateExpectation specifies expectation struct of the Authenticator.Authenticate
+type AuthenticatorMockAuthenticateExpectation struct {
+	mock               *AuthenticatorMock
+	params             *AuthenticatorMockAuthenticateParams
+	paramPtrs          *AuthenticatorMockAuthenticateParamPtrs
+	expectationOrigins AuthenticatorMockAuthenticateExpectationOrigins
+	results            *AuthenticatorMockAuthenticateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthenticatorMockAuthenticateParams contains parameters of the Authenticator.Authenticate
+type AuthenticatorMockAuthenticateParams struct {
+	ctx      context.Context
+	username string
+	password string
+}
+
+// AuthenticatorMockAuthenticateParamPtrs contains pointers to parameters of the Authenticator.Authenticate
+type AuthenticatorMockAuthenticateParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	password *string
+}
+
+// AuthenticatorMockAuthenticateResults contains results of the Authenticator.Authenticate
+type AuthenticatorMockAuthenticateResults struct {
+	up1 *model.User
+	err error
+}
+
+// AuthenticatorMockAuthenticateOrigins contains origins of expectations of the Authenticator.Authenticate
+type AuthenticatorMockAuthenticateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Optional() *mAuthenticatorMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for Authenticator.Authenticate
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Expect(ctx context.Context, username string, password string) *mAuthenticatorMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthenticatorMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &AuthenticatorMockAuthenticateParams{ctx, username, password}
+	mmAuthenticate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Authenticator.Authenticate
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mAuthenticatorMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthenticatorMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &AuthenticatorMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectUsernameParam2 sets up expected param username for Authenticator.Authenticate
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) ExpectUsernameParam2(username string) *mAuthenticatorMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthenticatorMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &AuthenticatorMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.username = &username
+	mmAuthenticate.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectPasswordParam3 sets up expected param password for Authenticator.Authenticate
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) ExpectPasswordParam3(password string) *mAuthenticatorMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthenticatorMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &AuthenticatorMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.password = &password
+	mmAuthenticate.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Authenticator.Authenticate
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Inspect(f func(ctx context.Context, username string, password string)) *mAuthenticatorMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for AuthenticatorMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by Authenticator.Authenticate
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Return(up1 *model.User, err error) *AuthenticatorMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthenticatorMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &AuthenticatorMockAuthenticateResults{up1, err}
+	mmAuthenticate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the Authenticator.Authenticate method
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Set(f func(ctx context.Context, username string, password string) (up1 *model.User, err error)) *AuthenticatorMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the Authenticator.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the Authenticator.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	mmAuthenticate.mock.funcAuthenticateOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the Authenticator.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) When(ctx context.Context, username string, password string) *AuthenticatorMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthenticatorMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &AuthenticatorMockAuthenticateExpectation{
+		mock:               mmAuthenticate.mock,
+		params:             &AuthenticatorMockAuthenticateParams{ctx, username, password},
+		expectationOrigins: AuthenticatorMockAuthenticateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Authenticator.Authenticate return parameters for the expectation previously defined by the When method
+func (e *AuthenticatorMockAuthenticateExpectation) Then(up1 *model.User, err error) *AuthenticatorMock {
+	e.results = &AuthenticatorMockAuthenticateResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Authenticator.Authenticate should be invoked
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Times(n uint64) *mAuthenticatorMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of AuthenticatorMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	mmAuthenticate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements mm_service.Authenticator
+func (mmAuthenticate *AuthenticatorMock) Authenticate(ctx context.Context, username string, password string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	mmAuthenticate.t.Helper()
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, username, password)
+	}
+
+	mm_params := AuthenticatorMockAuthenticateParams{ctx, username, password}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthenticatorMockAuthenticateParams{ctx, username, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("AuthenticatorMock.Authenticate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAuthenticate.t.Errorf("AuthenticatorMock.Authenticate got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmAuthenticate.t.Errorf("AuthenticatorMock.Authenticate got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("AuthenticatorMock.Authenticate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the AuthenticatorMock.Authenticate")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, username, password)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to AuthenticatorMock.Authenticate. %v %v %v", ctx, username, password)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished AuthenticatorMock.Authenticate invocations
+func (mmAuthenticate *AuthenticatorMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of AuthenticatorMock.Authenticate invocations
+func (mmAuthenticate *AuthenticatorMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthenticatorMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mAuthenticatorMockAuthenticate) Calls() []*AuthenticatorMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*AuthenticatorMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *AuthenticatorMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *AuthenticatorMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthenticatorMock.Authenticate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthenticatorMock.Authenticate at\n%s", m.AuthenticateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthenticatorMock.Authenticate at\n%s with params: %#v", m.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Errorf("Expected call to AuthenticatorMock.Authenticate at\n%s", m.funcAuthenticateOrigin)
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthenticatorMock.Authenticate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), m.AuthenticateMock.expectedInvocationsOrigin, afterAuthenticateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthenticatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthenticatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthenticatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone()
+}
@@ -0,0 +1,1935 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.ElevationService -o elevation_service_minimock.go -n ElevationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ElevationServiceMock implements mm_service.ElevationService
+type ElevationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcApproveElevation          func(ctx context.Context, accessToken string, id int64) (rp1 *model.RoleGrant, err error)
+	funcApproveElevationOrigin    string
+	inspectFuncApproveElevation   func(ctx context.Context, accessToken string, id int64)
+	afterApproveElevationCounter  uint64
+	beforeApproveElevationCounter uint64
+	ApproveElevationMock          mElevationServiceMockApproveElevation
+
+	funcDenyElevation          func(ctx context.Context, accessToken string, id int64) (rp1 *model.RoleGrant, err error)
+	funcDenyElevationOrigin    string
+	inspectFuncDenyElevation   func(ctx context.Context, accessToken string, id int64)
+	afterDenyElevationCounter  uint64
+	beforeDenyElevationCounter uint64
+	DenyElevationMock          mElevationServiceMockDenyElevation
+
+	funcExpireElevations          func(ctx context.Context) (i1 int64, err error)
+	funcExpireElevationsOrigin    string
+	inspectFuncExpireElevations   func(ctx context.Context)
+	afterExpireElevationsCounter  uint64
+	beforeExpireElevationsCounter uint64
+	ExpireElevationsMock          mElevationServiceMockExpireElevations
+
+	funcListElevations          func(ctx context.Context, accessToken string, status string) (rpa1 []*model.RoleGrant, err error)
+	funcListElevationsOrigin    string
+	inspectFuncListElevations   func(ctx context.Context, accessToken string, status string)
+	afterListElevationsCounter  uint64
+	beforeListElevationsCounter uint64
+	ListElevationsMock          mElevationServiceMockListElevations
+
+	funcRequestElevation          func(ctx context.Context, accessToken string, elevation *model.NewElevation) (rp1 *model.RoleGrant, err error)
+	funcRequestElevationOrigin    string
+	inspectFuncRequestElevation   func(ctx context.Context, accessToken string, elevation *model.NewElevation)
+	afterRequestElevationCounter  uint64
+	beforeRequestElevationCounter uint64
+	RequestElevationMock          mElevationServiceMockRequestElevation
+}
+
+// NewElevationServiceMock returns a mock for mm_service.ElevationService
+func NewElevationServiceMock(t minimock.Tester) *ElevationServiceMock {
+	m := &ElevationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ApproveElevationMock = mElevationServiceMockApproveElevation{mock: m}
+	m.ApproveElevationMock.callArgs = []*ElevationServiceMockApproveElevationParams{}
+
+	m.DenyElevationMock = mElevationServiceMockDenyElevation{mock: m}
+	m.DenyElevationMock.callArgs = []*ElevationServiceMockDenyElevationParams{}
+
+	m.ExpireElevationsMock = mElevationServiceMockExpireElevations{mock: m}
+	m.ExpireElevationsMock.callArgs = []*ElevationServiceMockExpireElevationsParams{}
+
+	m.ListElevationsMock = mElevationServiceMockListElevations{mock: m}
+	m.ListElevationsMock.callArgs = []*ElevationServiceMockListElevationsParams{}
+
+	m.RequestElevationMock = mElevationServiceMockRequestElevation{mock: m}
+	m.RequestElevationMock.callArgs = []*ElevationServiceMockRequestElevationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mElevationServiceMockApproveElevation struct {
+	optional           bool
+	mock               *ElevationServiceMock
+	defaultExpectation *ElevationServiceMockApproveElevationExpectation
+	expectations       []*ElevationServiceMockApproveElevationExpectation
+
+	callArgs []*ElevationServiceMockApproveElevationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ElevationServiceMockApproveElevationExpectation specifies expectation struct of the ElevationService.ApproveElevation
+type ElevationServiceMockApproveElevationExpectation struct {
+	mock               *ElevationServiceMock
+	params             *ElevationServiceMockApproveElevationParams
+	paramPtrs          *ElevationServiceMockApproveElevationParamPtrs
+	expectationOrigins ElevationServiceMockApproveElevationExpectationOrigins
+	results            *ElevationServiceMockApproveElevationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ElevationServiceMockApproveElevationParams contains parameters of the ElevationService.ApproveElevation
+type ElevationServiceMockApproveElevationParams struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+// ElevationServiceMockApproveElevationParamPtrs contains pointers to parameters of the ElevationService.ApproveElevation
+type ElevationServiceMockApproveElevationParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	id          *int64
+}
+
+// ElevationServiceMockApproveElevationResults contains results of the ElevationService.ApproveElevation
+type ElevationServiceMockApproveElevationResults struct {
+	rp1 *model.RoleGrant
+	err error
+}
+
+// ElevationServiceMockApproveElevationOrigins contains origins of expectations of the ElevationService.ApproveElevation
+type ElevationServiceMockApproveElevationExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originId          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Optional() *mElevationServiceMockApproveElevation {
+	mmApproveElevation.optional = true
+	return mmApproveElevation
+}
+
+// Expect sets up expected params for ElevationService.ApproveElevation
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Expect(ctx context.Context, accessToken string, id int64) *mElevationServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &ElevationServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by ExpectParams functions")
+	}
+
+	mmApproveElevation.defaultExpectation.params = &ElevationServiceMockApproveElevationParams{ctx, accessToken, id}
+	mmApproveElevation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApproveElevation.expectations {
+		if minimock.Equal(e.params, mmApproveElevation.defaultExpectation.params) {
+			mmApproveElevation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApproveElevation.defaultExpectation.params)
+		}
+	}
+
+	return mmApproveElevation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationService.ApproveElevation
+func (mmApproveElevation *mElevationServiceMockApproveElevation) ExpectCtxParam1(ctx context.Context) *mElevationServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &ElevationServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.params != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Expect")
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs == nil {
+		mmApproveElevation.defaultExpectation.paramPtrs = &ElevationServiceMockApproveElevationParamPtrs{}
+	}
+	mmApproveElevation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApproveElevation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApproveElevation
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ElevationService.ApproveElevation
+func (mmApproveElevation *mElevationServiceMockApproveElevation) ExpectAccessTokenParam2(accessToken string) *mElevationServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &ElevationServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.params != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Expect")
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs == nil {
+		mmApproveElevation.defaultExpectation.paramPtrs = &ElevationServiceMockApproveElevationParamPtrs{}
+	}
+	mmApproveElevation.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmApproveElevation.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmApproveElevation
+}
+
+// ExpectIdParam3 sets up expected param id for ElevationService.ApproveElevation
+func (mmApproveElevation *mElevationServiceMockApproveElevation) ExpectIdParam3(id int64) *mElevationServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &ElevationServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.params != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Expect")
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs == nil {
+		mmApproveElevation.defaultExpectation.paramPtrs = &ElevationServiceMockApproveElevationParamPtrs{}
+	}
+	mmApproveElevation.defaultExpectation.paramPtrs.id = &id
+	mmApproveElevation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmApproveElevation
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationService.ApproveElevation
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Inspect(f func(ctx context.Context, accessToken string, id int64)) *mElevationServiceMockApproveElevation {
+	if mmApproveElevation.mock.inspectFuncApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("Inspect function is already set for ElevationServiceMock.ApproveElevation")
+	}
+
+	mmApproveElevation.mock.inspectFuncApproveElevation = f
+
+	return mmApproveElevation
+}
+
+// Return sets up results that will be returned by ElevationService.ApproveElevation
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Return(rp1 *model.RoleGrant, err error) *ElevationServiceMock {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &ElevationServiceMockApproveElevationExpectation{mock: mmApproveElevation.mock}
+	}
+	mmApproveElevation.defaultExpectation.results = &ElevationServiceMockApproveElevationResults{rp1, err}
+	mmApproveElevation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApproveElevation.mock
+}
+
+// Set uses given function f to mock the ElevationService.ApproveElevation method
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Set(f func(ctx context.Context, accessToken string, id int64) (rp1 *model.RoleGrant, err error)) *ElevationServiceMock {
+	if mmApproveElevation.defaultExpectation != nil {
+		mmApproveElevation.mock.t.Fatalf("Default expectation is already set for the ElevationService.ApproveElevation method")
+	}
+
+	if len(mmApproveElevation.expectations) > 0 {
+		mmApproveElevation.mock.t.Fatalf("Some expectations are already set for the ElevationService.ApproveElevation method")
+	}
+
+	mmApproveElevation.mock.funcApproveElevation = f
+	mmApproveElevation.mock.funcApproveElevationOrigin = minimock.CallerInfo(1)
+	return mmApproveElevation.mock
+}
+
+// When sets expectation for the ElevationService.ApproveElevation which will trigger the result defined by the following
+// Then helper
+func (mmApproveElevation *mElevationServiceMockApproveElevation) When(ctx context.Context, accessToken string, id int64) *ElevationServiceMockApproveElevationExpectation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("ElevationServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	expectation := &ElevationServiceMockApproveElevationExpectation{
+		mock:               mmApproveElevation.mock,
+		params:             &ElevationServiceMockApproveElevationParams{ctx, accessToken, id},
+		expectationOrigins: ElevationServiceMockApproveElevationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApproveElevation.expectations = append(mmApproveElevation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationService.ApproveElevation return parameters for the expectation previously defined by the When method
+func (e *ElevationServiceMockApproveElevationExpectation) Then(rp1 *model.RoleGrant, err error) *ElevationServiceMock {
+	e.results = &ElevationServiceMockApproveElevationResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationService.ApproveElevation should be invoked
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Times(n uint64) *mElevationServiceMockApproveElevation {
+	if n == 0 {
+		mmApproveElevation.mock.t.Fatalf("Times of ElevationServiceMock.ApproveElevation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApproveElevation.expectedInvocations, n)
+	mmApproveElevation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApproveElevation
+}
+
+func (mmApproveElevation *mElevationServiceMockApproveElevation) invocationsDone() bool {
+	if len(mmApproveElevation.expectations) == 0 && mmApproveElevation.defaultExpectation == nil && mmApproveElevation.mock.funcApproveElevation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApproveElevation.mock.afterApproveElevationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApproveElevation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApproveElevation implements mm_service.ElevationService
+func (mmApproveElevation *ElevationServiceMock) ApproveElevation(ctx context.Context, accessToken string, id int64) (rp1 *model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmApproveElevation.beforeApproveElevationCounter, 1)
+	defer mm_atomic.AddUint64(&mmApproveElevation.afterApproveElevationCounter, 1)
+
+	mmApproveElevation.t.Helper()
+
+	if mmApproveElevation.inspectFuncApproveElevation != nil {
+		mmApproveElevation.inspectFuncApproveElevation(ctx, accessToken, id)
+	}
+
+	mm_params := ElevationServiceMockApproveElevationParams{ctx, accessToken, id}
+
+	// Record call args
+	mmApproveElevation.ApproveElevationMock.mutex.Lock()
+	mmApproveElevation.ApproveElevationMock.callArgs = append(mmApproveElevation.ApproveElevationMock.callArgs, &mm_params)
+	mmApproveElevation.ApproveElevationMock.mutex.Unlock()
+
+	for _, e := range mmApproveElevation.ApproveElevationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmApproveElevation.ApproveElevationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApproveElevation.ApproveElevationMock.defaultExpectation.Counter, 1)
+		mm_want := mmApproveElevation.ApproveElevationMock.defaultExpectation.params
+		mm_want_ptrs := mmApproveElevation.ApproveElevationMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationServiceMockApproveElevationParams{ctx, accessToken, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApproveElevation.t.Errorf("ElevationServiceMock.ApproveElevation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveElevation.ApproveElevationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmApproveElevation.t.Errorf("ElevationServiceMock.ApproveElevation got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveElevation.ApproveElevationMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApproveElevation.t.Errorf("ElevationServiceMock.ApproveElevation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveElevation.ApproveElevationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApproveElevation.t.Errorf("ElevationServiceMock.ApproveElevation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApproveElevation.ApproveElevationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApproveElevation.ApproveElevationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApproveElevation.t.Fatal("No results are set for the ElevationServiceMock.ApproveElevation")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmApproveElevation.funcApproveElevation != nil {
+		return mmApproveElevation.funcApproveElevation(ctx, accessToken, id)
+	}
+	mmApproveElevation.t.Fatalf("Unexpected call to ElevationServiceMock.ApproveElevation. %v %v %v", ctx, accessToken, id)
+	return
+}
+
+// ApproveElevationAfterCounter returns a count of finished ElevationServiceMock.ApproveElevation invocations
+func (mmApproveElevation *ElevationServiceMock) ApproveElevationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveElevation.afterApproveElevationCounter)
+}
+
+// ApproveElevationBeforeCounter returns a count of ElevationServiceMock.ApproveElevation invocations
+func (mmApproveElevation *ElevationServiceMock) ApproveElevationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveElevation.beforeApproveElevationCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationServiceMock.ApproveElevation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApproveElevation *mElevationServiceMockApproveElevation) Calls() []*ElevationServiceMockApproveElevationParams {
+	mmApproveElevation.mutex.RLock()
+
+	argCopy := make([]*ElevationServiceMockApproveElevationParams, len(mmApproveElevation.callArgs))
+	copy(argCopy, mmApproveElevation.callArgs)
+
+	mmApproveElevation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveElevationDone returns true if the count of the ApproveElevation invocations corresponds
+// the number of defined expectations
+func (m *ElevationServiceMock) MinimockApproveElevationDone() bool {
+	if m.ApproveElevationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApproveElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApproveElevationMock.invocationsDone()
+}
+
+// MinimockApproveElevationInspect logs each unmet expectation
+func (m *ElevationServiceMock) MinimockApproveElevationInspect() {
+	for _, e := range m.ApproveElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationServiceMock.ApproveElevation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApproveElevationCounter := mm_atomic.LoadUint64(&m.afterApproveElevationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveElevationMock.defaultExpectation != nil && afterApproveElevationCounter < 1 {
+		if m.ApproveElevationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ElevationServiceMock.ApproveElevation at\n%s", m.ApproveElevationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ElevationServiceMock.ApproveElevation at\n%s with params: %#v", m.ApproveElevationMock.defaultExpectation.expectationOrigins.origin, *m.ApproveElevationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApproveElevation != nil && afterApproveElevationCounter < 1 {
+		m.t.Errorf("Expected call to ElevationServiceMock.ApproveElevation at\n%s", m.funcApproveElevationOrigin)
+	}
+
+	if !m.ApproveElevationMock.invocationsDone() && afterApproveElevationCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationServiceMock.ApproveElevation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApproveElevationMock.expectedInvocations), m.ApproveElevationMock.expectedInvocationsOrigin, afterApproveElevationCounter)
+	}
+}
+
+type mElevationServiceMockDenyElevation struct {
+	optional           bool
+	mock               *ElevationServiceMock
+	defaultExpectation *ElevationServiceMockDenyElevationExpectation
+	expectations       []*ElevationServiceMockDenyElevationExpectation
+
+	callArgs []*ElevationServiceMockDenyElevationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ElevationServiceMockDenyElevationExpectation specifies expectation struct of the ElevationService.DenyElevation
+type ElevationServiceMockDenyElevationExpectation struct {
+	mock               *ElevationServiceMock
+	params             *ElevationServiceMockDenyElevationParams
+	paramPtrs          *ElevationServiceMockDenyElevationParamPtrs
+	expectationOrigins ElevationServiceMockDenyElevationExpectationOrigins
+	results            *ElevationServiceMockDenyElevationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ElevationServiceMockDenyElevationParams contains parameters of the ElevationService.DenyElevation
+type ElevationServiceMockDenyElevationParams struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+// ElevationServiceMockDenyElevationParamPtrs contains pointers to parameters of the ElevationService.DenyElevation
+type ElevationServiceMockDenyElevationParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	id          *int64
+}
+
+// ElevationServiceMockDenyElevationResults contains results of the ElevationService.DenyElevation
+type ElevationServiceMockDenyElevationResults struct {
+	rp1 *model.RoleGrant
+	err error
+}
+
+// ElevationServiceMockDenyElevationOrigins contains origins of expectations of the ElevationService.DenyElevation
+type ElevationServiceMockDenyElevationExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originId          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Optional() *mElevationServiceMockDenyElevation {
+	mmDenyElevation.optional = true
+	return mmDenyElevation
+}
+
+// Expect sets up expected params for ElevationService.DenyElevation
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Expect(ctx context.Context, accessToken string, id int64) *mElevationServiceMockDenyElevation {
+	if mmDenyElevation.mock.funcDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Set")
+	}
+
+	if mmDenyElevation.defaultExpectation == nil {
+		mmDenyElevation.defaultExpectation = &ElevationServiceMockDenyElevationExpectation{}
+	}
+
+	if mmDenyElevation.defaultExpectation.paramPtrs != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by ExpectParams functions")
+	}
+
+	mmDenyElevation.defaultExpectation.params = &ElevationServiceMockDenyElevationParams{ctx, accessToken, id}
+	mmDenyElevation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDenyElevation.expectations {
+		if minimock.Equal(e.params, mmDenyElevation.defaultExpectation.params) {
+			mmDenyElevation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDenyElevation.defaultExpectation.params)
+		}
+	}
+
+	return mmDenyElevation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationService.DenyElevation
+func (mmDenyElevation *mElevationServiceMockDenyElevation) ExpectCtxParam1(ctx context.Context) *mElevationServiceMockDenyElevation {
+	if mmDenyElevation.mock.funcDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Set")
+	}
+
+	if mmDenyElevation.defaultExpectation == nil {
+		mmDenyElevation.defaultExpectation = &ElevationServiceMockDenyElevationExpectation{}
+	}
+
+	if mmDenyElevation.defaultExpectation.params != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Expect")
+	}
+
+	if mmDenyElevation.defaultExpectation.paramPtrs == nil {
+		mmDenyElevation.defaultExpectation.paramPtrs = &ElevationServiceMockDenyElevationParamPtrs{}
+	}
+	mmDenyElevation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDenyElevation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDenyElevation
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ElevationService.DenyElevation
+func (mmDenyElevation *mElevationServiceMockDenyElevation) ExpectAccessTokenParam2(accessToken string) *mElevationServiceMockDenyElevation {
+	if mmDenyElevation.mock.funcDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Set")
+	}
+
+	if mmDenyElevation.defaultExpectation == nil {
+		mmDenyElevation.defaultExpectation = &ElevationServiceMockDenyElevationExpectation{}
+	}
+
+	if mmDenyElevation.defaultExpectation.params != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Expect")
+	}
+
+	if mmDenyElevation.defaultExpectation.paramPtrs == nil {
+		mmDenyElevation.defaultExpectation.paramPtrs = &ElevationServiceMockDenyElevationParamPtrs{}
+	}
+	mmDenyElevation.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmDenyElevation.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmDenyElevation
+}
+
+// ExpectIdParam3 sets up expected param id for ElevationService.DenyElevation
+func (mmDenyElevation *mElevationServiceMockDenyElevation) ExpectIdParam3(id int64) *mElevationServiceMockDenyElevation {
+	if mmDenyElevation.mock.funcDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Set")
+	}
+
+	if mmDenyElevation.defaultExpectation == nil {
+		mmDenyElevation.defaultExpectation = &ElevationServiceMockDenyElevationExpectation{}
+	}
+
+	if mmDenyElevation.defaultExpectation.params != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Expect")
+	}
+
+	if mmDenyElevation.defaultExpectation.paramPtrs == nil {
+		mmDenyElevation.defaultExpectation.paramPtrs = &ElevationServiceMockDenyElevationParamPtrs{}
+	}
+	mmDenyElevation.defaultExpectation.paramPtrs.id = &id
+	mmDenyElevation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDenyElevation
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationService.DenyElevation
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Inspect(f func(ctx context.Context, accessToken string, id int64)) *mElevationServiceMockDenyElevation {
+	if mmDenyElevation.mock.inspectFuncDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("Inspect function is already set for ElevationServiceMock.DenyElevation")
+	}
+
+	mmDenyElevation.mock.inspectFuncDenyElevation = f
+
+	return mmDenyElevation
+}
+
+// Return sets up results that will be returned by ElevationService.DenyElevation
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Return(rp1 *model.RoleGrant, err error) *ElevationServiceMock {
+	if mmDenyElevation.mock.funcDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Set")
+	}
+
+	if mmDenyElevation.defaultExpectation == nil {
+		mmDenyElevation.defaultExpectation = &ElevationServiceMockDenyElevationExpectation{mock: mmDenyElevation.mock}
+	}
+	mmDenyElevation.defaultExpectation.results = &ElevationServiceMockDenyElevationResults{rp1, err}
+	mmDenyElevation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDenyElevation.mock
+}
+
+// Set uses given function f to mock the ElevationService.DenyElevation method
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Set(f func(ctx context.Context, accessToken string, id int64) (rp1 *model.RoleGrant, err error)) *ElevationServiceMock {
+	if mmDenyElevation.defaultExpectation != nil {
+		mmDenyElevation.mock.t.Fatalf("Default expectation is already set for the ElevationService.DenyElevation method")
+	}
+
+	if len(mmDenyElevation.expectations) > 0 {
+		mmDenyElevation.mock.t.Fatalf("Some expectations are already set for the ElevationService.DenyElevation method")
+	}
+
+	mmDenyElevation.mock.funcDenyElevation = f
+	mmDenyElevation.mock.funcDenyElevationOrigin = minimock.CallerInfo(1)
+	return mmDenyElevation.mock
+}
+
+// When sets expectation for the ElevationService.DenyElevation which will trigger the result defined by the following
+// Then helper
+func (mmDenyElevation *mElevationServiceMockDenyElevation) When(ctx context.Context, accessToken string, id int64) *ElevationServiceMockDenyElevationExpectation {
+	if mmDenyElevation.mock.funcDenyElevation != nil {
+		mmDenyElevation.mock.t.Fatalf("ElevationServiceMock.DenyElevation mock is already set by Set")
+	}
+
+	expectation := &ElevationServiceMockDenyElevationExpectation{
+		mock:               mmDenyElevation.mock,
+		params:             &ElevationServiceMockDenyElevationParams{ctx, accessToken, id},
+		expectationOrigins: ElevationServiceMockDenyElevationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDenyElevation.expectations = append(mmDenyElevation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationService.DenyElevation return parameters for the expectation previously defined by the When method
+func (e *ElevationServiceMockDenyElevationExpectation) Then(rp1 *model.RoleGrant, err error) *ElevationServiceMock {
+	e.results = &ElevationServiceMockDenyElevationResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationService.DenyElevation should be invoked
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Times(n uint64) *mElevationServiceMockDenyElevation {
+	if n == 0 {
+		mmDenyElevation.mock.t.Fatalf("Times of ElevationServiceMock.DenyElevation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDenyElevation.expectedInvocations, n)
+	mmDenyElevation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDenyElevation
+}
+
+func (mmDenyElevation *mElevationServiceMockDenyElevation) invocationsDone() bool {
+	if len(mmDenyElevation.expectations) == 0 && mmDenyElevation.defaultExpectation == nil && mmDenyElevation.mock.funcDenyElevation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDenyElevation.mock.afterDenyElevationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDenyElevation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DenyElevation implements mm_service.ElevationService
+func (mmDenyElevation *ElevationServiceMock) DenyElevation(ctx context.Context, accessToken string, id int64) (rp1 *model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmDenyElevation.beforeDenyElevationCounter, 1)
+	defer mm_atomic.AddUint64(&mmDenyElevation.afterDenyElevationCounter, 1)
+
+	mmDenyElevation.t.Helper()
+
+	if mmDenyElevation.inspectFuncDenyElevation != nil {
+		mmDenyElevation.inspectFuncDenyElevation(ctx, accessToken, id)
+	}
+
+	mm_params := ElevationServiceMockDenyElevationParams{ctx, accessToken, id}
+
+	// Record call args
+	mmDenyElevation.DenyElevationMock.mutex.Lock()
+	mmDenyElevation.DenyElevationMock.callArgs = append(mmDenyElevation.DenyElevationMock.callArgs, &mm_params)
+	mmDenyElevation.DenyElevationMock.mutex.Unlock()
+
+	for _, e := range mmDenyElevation.DenyElevationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmDenyElevation.DenyElevationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDenyElevation.DenyElevationMock.defaultExpectation.Counter, 1)
+		mm_want := mmDenyElevation.DenyElevationMock.defaultExpectation.params
+		mm_want_ptrs := mmDenyElevation.DenyElevationMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationServiceMockDenyElevationParams{ctx, accessToken, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDenyElevation.t.Errorf("ElevationServiceMock.DenyElevation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDenyElevation.DenyElevationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmDenyElevation.t.Errorf("ElevationServiceMock.DenyElevation got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDenyElevation.DenyElevationMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDenyElevation.t.Errorf("ElevationServiceMock.DenyElevation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDenyElevation.DenyElevationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDenyElevation.t.Errorf("ElevationServiceMock.DenyElevation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDenyElevation.DenyElevationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDenyElevation.DenyElevationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDenyElevation.t.Fatal("No results are set for the ElevationServiceMock.DenyElevation")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmDenyElevation.funcDenyElevation != nil {
+		return mmDenyElevation.funcDenyElevation(ctx, accessToken, id)
+	}
+	mmDenyElevation.t.Fatalf("Unexpected call to ElevationServiceMock.DenyElevation. %v %v %v", ctx, accessToken, id)
+	return
+}
+
+// DenyElevationAfterCounter returns a count of finished ElevationServiceMock.DenyElevation invocations
+func (mmDenyElevation *ElevationServiceMock) DenyElevationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDenyElevation.afterDenyElevationCounter)
+}
+
+// DenyElevationBeforeCounter returns a count of ElevationServiceMock.DenyElevation invocations
+func (mmDenyElevation *ElevationServiceMock) DenyElevationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDenyElevation.beforeDenyElevationCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationServiceMock.DenyElevation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDenyElevation *mElevationServiceMockDenyElevation) Calls() []*ElevationServiceMockDenyElevationParams {
+	mmDenyElevation.mutex.RLock()
+
+	argCopy := make([]*ElevationServiceMockDenyElevationParams, len(mmDenyElevation.callArgs))
+	copy(argCopy, mmDenyElevation.callArgs)
+
+	mmDenyElevation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDenyElevationDone returns true if the count of the DenyElevation invocations corresponds
+// the number of defined expectations
+func (m *ElevationServiceMock) MinimockDenyElevationDone() bool {
+	if m.DenyElevationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DenyElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DenyElevationMock.invocationsDone()
+}
+
+// MinimockDenyElevationInspect logs each unmet expectation
+func (m *ElevationServiceMock) MinimockDenyElevationInspect() {
+	for _, e := range m.DenyElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationServiceMock.DenyElevation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDenyElevationCounter := mm_atomic.LoadUint64(&m.afterDenyElevationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DenyElevationMock.defaultExpectation != nil && afterDenyElevationCounter < 1 {
+		if m.DenyElevationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ElevationServiceMock.DenyElevation at\n%s", m.DenyElevationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ElevationServiceMock.DenyElevation at\n%s with params: %#v", m.DenyElevationMock.defaultExpectation.expectationOrigins.origin, *m.DenyElevationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDenyElevation != nil && afterDenyElevationCounter < 1 {
+		m.t.Errorf("Expected call to ElevationServiceMock.DenyElevation at\n%s", m.funcDenyElevationOrigin)
+	}
+
+	if !m.DenyElevationMock.invocationsDone() && afterDenyElevationCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationServiceMock.DenyElevation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DenyElevationMock.expectedInvocations), m.DenyElevationMock.expectedInvocationsOrigin, afterDenyElevationCounter)
+	}
+}
+
+type mElevationServiceMockExpireElevations struct {
+	optional           bool
+	mock               *ElevationServiceMock
+	defaultExpectation *ElevationServiceMockExpireElevationsExpectation
+	expectations       []*ElevationServiceMockExpireElevationsExpectation
+
+	callArgs []*ElevationServiceMockExpireElevationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ElevationServiceMockExpireElevationsExpectation specifies expectation struct of the ElevationService.ExpireElevations
+type ElevationServiceMockExpireElevationsExpectation struct {
+	mock               *ElevationServiceMock
+	params             *ElevationServiceMockExpireElevationsParams
+	paramPtrs          *ElevationServiceMockExpireElevationsParamPtrs
+	expectationOrigins ElevationServiceMockExpireElevationsExpectationOrigins
+	results            *ElevationServiceMockExpireElevationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ElevationServiceMockExpireElevationsParams contains parameters of the ElevationService.ExpireElevations
+type ElevationServiceMockExpireElevationsParams struct {
+	ctx context.Context
+}
+
+// ElevationServiceMockExpireElevationsParamPtrs contains pointers to parameters of the ElevationService.ExpireElevations
+type ElevationServiceMockExpireElevationsParamPtrs struct {
+	ctx *context.Context
+}
+
+// ElevationServiceMockExpireElevationsResults contains results of the ElevationService.ExpireElevations
+type ElevationServiceMockExpireElevationsResults struct {
+	i1  int64
+	err error
+}
+
+// ElevationServiceMockExpireElevationsOrigins contains origins of expectations of the ElevationService.ExpireElevations
+type ElevationServiceMockExpireElevationsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Optional() *mElevationServiceMockExpireElevations {
+	mmExpireElevations.optional = true
+	return mmExpireElevations
+}
+
+// Expect sets up expected params for ElevationService.ExpireElevations
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Expect(ctx context.Context) *mElevationServiceMockExpireElevations {
+	if mmExpireElevations.mock.funcExpireElevations != nil {
+		mmExpireElevations.mock.t.Fatalf("ElevationServiceMock.ExpireElevations mock is already set by Set")
+	}
+
+	if mmExpireElevations.defaultExpectation == nil {
+		mmExpireElevations.defaultExpectation = &ElevationServiceMockExpireElevationsExpectation{}
+	}
+
+	if mmExpireElevations.defaultExpectation.paramPtrs != nil {
+		mmExpireElevations.mock.t.Fatalf("ElevationServiceMock.ExpireElevations mock is already set by ExpectParams functions")
+	}
+
+	mmExpireElevations.defaultExpectation.params = &ElevationServiceMockExpireElevationsParams{ctx}
+	mmExpireElevations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireElevations.expectations {
+		if minimock.Equal(e.params, mmExpireElevations.defaultExpectation.params) {
+			mmExpireElevations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireElevations.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireElevations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationService.ExpireElevations
+func (mmExpireElevations *mElevationServiceMockExpireElevations) ExpectCtxParam1(ctx context.Context) *mElevationServiceMockExpireElevations {
+	if mmExpireElevations.mock.funcExpireElevations != nil {
+		mmExpireElevations.mock.t.Fatalf("ElevationServiceMock.ExpireElevations mock is already set by Set")
+	}
+
+	if mmExpireElevations.defaultExpectation == nil {
+		mmExpireElevations.defaultExpectation = &ElevationServiceMockExpireElevationsExpectation{}
+	}
+
+	if mmExpireElevations.defaultExpectation.params != nil {
+		mmExpireElevations.mock.t.Fatalf("ElevationServiceMock.ExpireElevations mock is already set by Expect")
+	}
+
+	if mmExpireElevations.defaultExpectation.paramPtrs == nil {
+		mmExpireElevations.defaultExpectation.paramPtrs = &ElevationServiceMockExpireElevationsParamPtrs{}
+	}
+	mmExpireElevations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireElevations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpireElevations
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationService.ExpireElevations
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Inspect(f func(ctx context.Context)) *mElevationServiceMockExpireElevations {
+	if mmExpireElevations.mock.inspectFuncExpireElevations != nil {
+		mmExpireElevations.mock.t.Fatalf("Inspect function is already set for ElevationServiceMock.ExpireElevations")
+	}
+
+	mmExpireElevations.mock.inspectFuncExpireElevations = f
+
+	return mmExpireElevations
+}
+
+// Return sets up results that will be returned by ElevationService.ExpireElevations
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Return(i1 int64, err error) *ElevationServiceMock {
+	if mmExpireElevations.mock.funcExpireElevations != nil {
+		mmExpireElevations.mock.t.Fatalf("ElevationServiceMock.ExpireElevations mock is already set by Set")
+	}
+
+	if mmExpireElevations.defaultExpectation == nil {
+		mmExpireElevations.defaultExpectation = &ElevationServiceMockExpireElevationsExpectation{mock: mmExpireElevations.mock}
+	}
+	mmExpireElevations.defaultExpectation.results = &ElevationServiceMockExpireElevationsResults{i1, err}
+	mmExpireElevations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireElevations.mock
+}
+
+// Set uses given function f to mock the ElevationService.ExpireElevations method
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Set(f func(ctx context.Context) (i1 int64, err error)) *ElevationServiceMock {
+	if mmExpireElevations.defaultExpectation != nil {
+		mmExpireElevations.mock.t.Fatalf("Default expectation is already set for the ElevationService.ExpireElevations method")
+	}
+
+	if len(mmExpireElevations.expectations) > 0 {
+		mmExpireElevations.mock.t.Fatalf("Some expectations are already set for the ElevationService.ExpireElevations method")
+	}
+
+	mmExpireElevations.mock.funcExpireElevations = f
+	mmExpireElevations.mock.funcExpireElevationsOrigin = minimock.CallerInfo(1)
+	return mmExpireElevations.mock
+}
+
+// When sets expectation for the ElevationService.ExpireElevations which will trigger the result defined by the following
+// Then helper
+func (mmExpireElevations *mElevationServiceMockExpireElevations) When(ctx context.Context) *ElevationServiceMockExpireElevationsExpectation {
+	if mmExpireElevations.mock.funcExpireElevations != nil {
+		mmExpireElevations.mock.t.Fatalf("ElevationServiceMock.ExpireElevations mock is already set by Set")
+	}
+
+	expectation := &ElevationServiceMockExpireElevationsExpectation{
+		mock:               mmExpireElevations.mock,
+		params:             &ElevationServiceMockExpireElevationsParams{ctx},
+		expectationOrigins: ElevationServiceMockExpireElevationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireElevations.expectations = append(mmExpireElevations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationService.ExpireElevations return parameters for the expectation previously defined by the When method
+func (e *ElevationServiceMockExpireElevationsExpectation) Then(i1 int64, err error) *ElevationServiceMock {
+	e.results = &ElevationServiceMockExpireElevationsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationService.ExpireElevations should be invoked
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Times(n uint64) *mElevationServiceMockExpireElevations {
+	if n == 0 {
+		mmExpireElevations.mock.t.Fatalf("Times of ElevationServiceMock.ExpireElevations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireElevations.expectedInvocations, n)
+	mmExpireElevations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireElevations
+}
+
+func (mmExpireElevations *mElevationServiceMockExpireElevations) invocationsDone() bool {
+	if len(mmExpireElevations.expectations) == 0 && mmExpireElevations.defaultExpectation == nil && mmExpireElevations.mock.funcExpireElevations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireElevations.mock.afterExpireElevationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireElevations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireElevations implements mm_service.ElevationService
+func (mmExpireElevations *ElevationServiceMock) ExpireElevations(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmExpireElevations.beforeExpireElevationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireElevations.afterExpireElevationsCounter, 1)
+
+	mmExpireElevations.t.Helper()
+
+	if mmExpireElevations.inspectFuncExpireElevations != nil {
+		mmExpireElevations.inspectFuncExpireElevations(ctx)
+	}
+
+	mm_params := ElevationServiceMockExpireElevationsParams{ctx}
+
+	// Record call args
+	mmExpireElevations.ExpireElevationsMock.mutex.Lock()
+	mmExpireElevations.ExpireElevationsMock.callArgs = append(mmExpireElevations.ExpireElevationsMock.callArgs, &mm_params)
+	mmExpireElevations.ExpireElevationsMock.mutex.Unlock()
+
+	for _, e := range mmExpireElevations.ExpireElevationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmExpireElevations.ExpireElevationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireElevations.ExpireElevationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireElevations.ExpireElevationsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireElevations.ExpireElevationsMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationServiceMockExpireElevationsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireElevations.t.Errorf("ElevationServiceMock.ExpireElevations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireElevations.ExpireElevationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireElevations.t.Errorf("ElevationServiceMock.ExpireElevations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireElevations.ExpireElevationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireElevations.ExpireElevationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireElevations.t.Fatal("No results are set for the ElevationServiceMock.ExpireElevations")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmExpireElevations.funcExpireElevations != nil {
+		return mmExpireElevations.funcExpireElevations(ctx)
+	}
+	mmExpireElevations.t.Fatalf("Unexpected call to ElevationServiceMock.ExpireElevations. %v", ctx)
+	return
+}
+
+// ExpireElevationsAfterCounter returns a count of finished ElevationServiceMock.ExpireElevations invocations
+func (mmExpireElevations *ElevationServiceMock) ExpireElevationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireElevations.afterExpireElevationsCounter)
+}
+
+// ExpireElevationsBeforeCounter returns a count of ElevationServiceMock.ExpireElevations invocations
+func (mmExpireElevations *ElevationServiceMock) ExpireElevationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireElevations.beforeExpireElevationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationServiceMock.ExpireElevations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireElevations *mElevationServiceMockExpireElevations) Calls() []*ElevationServiceMockExpireElevationsParams {
+	mmExpireElevations.mutex.RLock()
+
+	argCopy := make([]*ElevationServiceMockExpireElevationsParams, len(mmExpireElevations.callArgs))
+	copy(argCopy, mmExpireElevations.callArgs)
+
+	mmExpireElevations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireElevationsDone returns true if the count of the ExpireElevations invocations corresponds
+// the number of defined expectations
+func (m *ElevationServiceMock) MinimockExpireElevationsDone() bool {
+	if m.ExpireElevationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireElevationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireElevationsMock.invocationsDone()
+}
+
+// MinimockExpireElevationsInspect logs each unmet expectation
+func (m *ElevationServiceMock) MinimockExpireElevationsInspect() {
+	for _, e := range m.ExpireElevationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationServiceMock.ExpireElevations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireElevationsCounter := mm_atomic.LoadUint64(&m.afterExpireElevationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireElevationsMock.defaultExpectation != nil && afterExpireElevationsCounter < 1 {
+		if m.ExpireElevationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ElevationServiceMock.ExpireElevations at\n%s", m.ExpireElevationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ElevationServiceMock.ExpireElevations at\n%s with params: %#v", m.ExpireElevationsMock.defaultExpectation.expectationOrigins.origin, *m.ExpireElevationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireElevations != nil && afterExpireElevationsCounter < 1 {
+		m.t.Errorf("Expected call to ElevationServiceMock.ExpireElevations at\n%s", m.funcExpireElevationsOrigin)
+	}
+
+	if !m.ExpireElevationsMock.invocationsDone() && afterExpireElevationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationServiceMock.ExpireElevations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireElevationsMock.expectedInvocations), m.ExpireElevationsMock.expectedInvocationsOrigin, afterExpireElevationsCounter)
+	}
+}
+
+type mElevationServiceMockListElevations struct {
+	optional           bool
+	mock               *ElevationServiceMock
+	defaultExpectation *ElevationServiceMockListElevationsExpectation
+	expectations       []*ElevationServiceMockListElevationsExpectation
+
+	callArgs []*ElevationServiceMockListElevationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ElevationServiceMockListElevationsExpectation specifies expectation struct of the ElevationService.ListElevations
+type ElevationServiceMockListElevationsExpectation struct {
+	mock               *ElevationServiceMock
+	params             *ElevationServiceMockListElevationsParams
+	paramPtrs          *ElevationServiceMockListElevationsParamPtrs
+	expectationOrigins ElevationServiceMockListElevationsExpectationOrigins
+	results            *ElevationServiceMockListElevationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ElevationServiceMockListElevationsParams contains parameters of the ElevationService.ListElevations
+type ElevationServiceMockListElevationsParams struct {
+	ctx         context.Context
+	accessToken string
+	status      string
+}
+
+// ElevationServiceMockListElevationsParamPtrs contains pointers to parameters of the ElevationService.ListElevations
+type ElevationServiceMockListElevationsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	status      *string
+}
+
+// ElevationServiceMockListElevationsResults contains results of the ElevationService.ListElevations
+type ElevationServiceMockListElevationsResults struct {
+	rpa1 []*model.RoleGrant
+	err  error
+}
+
+// ElevationServiceMockListElevationsOrigins contains origins of expectations of the ElevationService.ListElevations
+type ElevationServiceMockListElevationsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originStatus      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListElevations *mElevationServiceMockListElevations) Optional() *mElevationServiceMockListElevations {
+	mmListElevations.optional = true
+	return mmListElevations
+}
+
+// Expect sets up expected params for ElevationService.ListElevations
+func (mmListElevations *mElevationServiceMockListElevations) Expect(ctx context.Context, accessToken string, status string) *mElevationServiceMockListElevations {
+	if mmListElevations.mock.funcListElevations != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Set")
+	}
+
+	if mmListElevations.defaultExpectation == nil {
+		mmListElevations.defaultExpectation = &ElevationServiceMockListElevationsExpectation{}
+	}
+
+	if mmListElevations.defaultExpectation.paramPtrs != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by ExpectParams functions")
+	}
+
+	mmListElevations.defaultExpectation.params = &ElevationServiceMockListElevationsParams{ctx, accessToken, status}
+	mmListElevations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListElevations.expectations {
+		if minimock.Equal(e.params, mmListElevations.defaultExpectation.params) {
+			mmListElevations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListElevations.defaultExpectation.params)
+		}
+	}
+
+	return mmListElevations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationService.ListElevations
+func (mmListElevations *mElevationServiceMockListElevations) ExpectCtxParam1(ctx context.Context) *mElevationServiceMockListElevations {
+	if mmListElevations.mock.funcListElevations != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Set")
+	}
+
+	if mmListElevations.defaultExpectation == nil {
+		mmListElevations.defaultExpectation = &ElevationServiceMockListElevationsExpectation{}
+	}
+
+	if mmListElevations.defaultExpectation.params != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Expect")
+	}
+
+	if mmListElevations.defaultExpectation.paramPtrs == nil {
+		mmListElevations.defaultExpectation.paramPtrs = &ElevationServiceMockListElevationsParamPtrs{}
+	}
+	mmListElevations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListElevations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListElevations
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ElevationService.ListElevations
+func (mmListElevations *mElevationServiceMockListElevations) ExpectAccessTokenParam2(accessToken string) *mElevationServiceMockListElevations {
+	if mmListElevations.mock.funcListElevations != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Set")
+	}
+
+	if mmListElevations.defaultExpectation == nil {
+		mmListElevations.defaultExpectation = &ElevationServiceMockListElevationsExpectation{}
+	}
+
+	if mmListElevations.defaultExpectation.params != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Expect")
+	}
+
+	if mmListElevations.defaultExpectation.paramPtrs == nil {
+		mmListElevations.defaultExpectation.paramPtrs = &ElevationServiceMockListElevationsParamPtrs{}
+	}
+	mmListElevations.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListElevations.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListElevations
+}
+
+// ExpectStatusParam3 sets up expected param status for ElevationService.ListElevations
+func (mmListElevations *mElevationServiceMockListElevations) ExpectStatusParam3(status string) *mElevationServiceMockListElevations {
+	if mmListElevations.mock.funcListElevations != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Set")
+	}
+
+	if mmListElevations.defaultExpectation == nil {
+		mmListElevations.defaultExpectation = &ElevationServiceMockListElevationsExpectation{}
+	}
+
+	if mmListElevations.defaultExpectation.params != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Expect")
+	}
+
+	if mmListElevations.defaultExpectation.paramPtrs == nil {
+		mmListElevations.defaultExpectation.paramPtrs = &ElevationServiceMockListElevationsParamPtrs{}
+	}
+	mmListElevations.defaultExpectation.paramPtrs.status = &status
+	mmListElevations.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmListElevations
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationService.ListElevations
+func (mmListElevations *mElevationServiceMockListElevations) Inspect(f func(ctx context.Context, accessToken string, status string)) *mElevationServiceMockListElevations {
+	if mmListElevations.mock.inspectFuncListElevations != nil {
+		mmListElevations.mock.t.Fatalf("Inspect function is already set for ElevationServiceMock.ListElevations")
+	}
+
+	mmListElevations.mock.inspectFuncListElevations = f
+
+	return mmListElevations
+}
+
+// Return sets up results that will be returned by ElevationService.ListElevations
+func (mmListElevations *mElevationServiceMockListElevations) Return(rpa1 []*model.RoleGrant, err error) *ElevationServiceMock {
+	if mmListElevations.mock.funcListElevations != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Set")
+	}
+
+	if mmListElevations.defaultExpectation == nil {
+		mmListElevations.defaultExpectation = &ElevationServiceMockListElevationsExpectation{mock: mmListElevations.mock}
+	}
+	mmListElevations.defaultExpectation.results = &ElevationServiceMockListElevationsResults{rpa1, err}
+	mmListElevations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListElevations.mock
+}
+
+// Set uses given function f to mock the ElevationService.ListElevations method
+func (mmListElevations *mElevationServiceMockListElevations) Set(f func(ctx context.Context, accessToken string, status string) (rpa1 []*model.RoleGrant, err error)) *ElevationServiceMock {
+	if mmListElevations.defaultExpectation != nil {
+		mmListElevations.mock.t.Fatalf("Default expectation is already set for the ElevationService.ListElevations method")
+	}
+
+	if len(mmListElevations.expectations) > 0 {
+		mmListElevations.mock.t.Fatalf("Some expectations are already set for the ElevationService.ListElevations method")
+	}
+
+	mmListElevations.mock.funcListElevations = f
+	mmListElevations.mock.funcListElevationsOrigin = minimock.CallerInfo(1)
+	return mmListElevations.mock
+}
+
+// When sets expectation for the ElevationService.ListElevations which will trigger the result defined by the following
+// Then helper
+func (mmListElevations *mElevationServiceMockListElevations) When(ctx context.Context, accessToken string, status string) *ElevationServiceMockListElevationsExpectation {
+	if mmListElevations.mock.funcListElevations != nil {
+		mmListElevations.mock.t.Fatalf("ElevationServiceMock.ListElevations mock is already set by Set")
+	}
+
+	expectation := &ElevationServiceMockListElevationsExpectation{
+		mock:               mmListElevations.mock,
+		params:             &ElevationServiceMockListElevationsParams{ctx, accessToken, status},
+		expectationOrigins: ElevationServiceMockListElevationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListElevations.expectations = append(mmListElevations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationService.ListElevations return parameters for the expectation previously defined by the When method
+func (e *ElevationServiceMockListElevationsExpectation) Then(rpa1 []*model.RoleGrant, err error) *ElevationServiceMock {
+	e.results = &ElevationServiceMockListElevationsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationService.ListElevations should be invoked
+func (mmListElevations *mElevationServiceMockListElevations) Times(n uint64) *mElevationServiceMockListElevations {
+	if n == 0 {
+		mmListElevations.mock.t.Fatalf("Times of ElevationServiceMock.ListElevations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListElevations.expectedInvocations, n)
+	mmListElevations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListElevations
+}
+
+func (mmListElevations *mElevationServiceMockListElevations) invocationsDone() bool {
+	if len(mmListElevations.expectations) == 0 && mmListElevations.defaultExpectation == nil && mmListElevations.mock.funcListElevations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListElevations.mock.afterListElevationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListElevations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListElevations implements mm_service.ElevationService
+func (mmListElevations *ElevationServiceMock) ListElevations(ctx context.Context, accessToken string, status string) (rpa1 []*model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmListElevations.beforeListElevationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListElevations.afterListElevationsCounter, 1)
+
+	mmListElevations.t.Helper()
+
+	if mmListElevations.inspectFuncListElevations != nil {
+		mmListElevations.inspectFuncListElevations(ctx, accessToken, status)
+	}
+
+	mm_params := ElevationServiceMockListElevationsParams{ctx, accessToken, status}
+
+	// Record call args
+	mmListElevations.ListElevationsMock.mutex.Lock()
+	mmListElevations.ListElevationsMock.callArgs = append(mmListElevations.ListElevationsMock.callArgs, &mm_params)
+	mmListElevations.ListElevationsMock.mutex.Unlock()
+
+	for _, e := range mmListElevations.ListElevationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListElevations.ListElevationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListElevations.ListElevationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListElevations.ListElevationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListElevations.ListElevationsMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationServiceMockListElevationsParams{ctx, accessToken, status}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListElevations.t.Errorf("ElevationServiceMock.ListElevations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListElevations.ListElevationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListElevations.t.Errorf("ElevationServiceMock.ListElevations got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListElevations.ListElevationsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmListElevations.t.Errorf("ElevationServiceMock.ListElevations got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListElevations.ListElevationsMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListElevations.t.Errorf("ElevationServiceMock.ListElevations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListElevations.ListElevationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListElevations.ListElevationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListElevations.t.Fatal("No results are set for the ElevationServiceMock.ListElevations")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListElevations.funcListElevations != nil {
+		return mmListElevations.funcListElevations(ctx, accessToken, status)
+	}
+	mmListElevations.t.Fatalf("Unexpected call to ElevationServiceMock.ListElevations. %v %v %v", ctx, accessToken, status)
+	return
+}
+
+// ListElevationsAfterCounter returns a count of finished ElevationServiceMock.ListElevations invocations
+func (mmListElevations *ElevationServiceMock) ListElevationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListElevations.afterListElevationsCounter)
+}
+
+// ListElevationsBeforeCounter returns a count of ElevationServiceMock.ListElevations invocations
+func (mmListElevations *ElevationServiceMock) ListElevationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListElevations.beforeListElevationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationServiceMock.ListElevations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListElevations *mElevationServiceMockListElevations) Calls() []*ElevationServiceMockListElevationsParams {
+	mmListElevations.mutex.RLock()
+
+	argCopy := make([]*ElevationServiceMockListElevationsParams, len(mmListElevations.callArgs))
+	copy(argCopy, mmListElevations.callArgs)
+
+	mmListElevations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListElevationsDone returns true if the count of the ListElevations invocations corresponds
+// the number of defined expectations
+func (m *ElevationServiceMock) MinimockListElevationsDone() bool {
+	if m.ListElevationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListElevationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListElevationsMock.invocationsDone()
+}
+
+// MinimockListElevationsInspect logs each unmet expectation
+func (m *ElevationServiceMock) MinimockListElevationsInspect() {
+	for _, e := range m.ListElevationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationServiceMock.ListElevations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListElevationsCounter := mm_atomic.LoadUint64(&m.afterListElevationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListElevationsMock.defaultExpectation != nil && afterListElevationsCounter < 1 {
+		if m.ListElevationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ElevationServiceMock.ListElevations at\n%s", m.ListElevationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ElevationServiceMock.ListElevations at\n%s with params: %#v", m.ListElevationsMock.defaultExpectation.expectationOrigins.origin, *m.ListElevationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListElevations != nil && afterListElevationsCounter < 1 {
+		m.t.Errorf("Expected call to ElevationServiceMock.ListElevations at\n%s", m.funcListElevationsOrigin)
+	}
+
+	if !m.ListElevationsMock.invocationsDone() && afterListElevationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationServiceMock.ListElevations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListElevationsMock.expectedInvocations), m.ListElevationsMock.expectedInvocationsOrigin, afterListElevationsCounter)
+	}
+}
+
+type mElevationServiceMockRequestElevation struct {
+	optional           bool
+	mock               *ElevationServiceMock
+	defaultExpectation *ElevationServiceMockRequestElevationExpectation
+	expectations       []*ElevationServiceMockRequestElevationExpectation
+
+	callArgs []*ElevationServiceMockRequestElevationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ElevationServiceMockRequestElevationExpectation specifies expectation struct of the ElevationService.RequestElevation
+type ElevationServiceMockRequestElevationExpectation struct {
+	mock               *ElevationServiceMock
+	params             *ElevationServiceMockRequestElevationParams
+	paramPtrs          *ElevationServiceMockRequestElevationParamPtrs
+	expectationOrigins ElevationServiceMockRequestElevationExpectationOrigins
+	results            *ElevationServiceMockRequestElevationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ElevationServiceMockRequestElevationParams contains parameters of the ElevationService.RequestElevation
+type ElevationServiceMockRequestElevationParams struct {
+	ctx         context.Context
+	accessToken string
+	elevation   *model.NewElevation
+}
+
+// ElevationServiceMockRequestElevationParamPtrs contains pointers to parameters of the ElevationService.RequestElevation
+type ElevationServiceMockRequestElevationParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	elevation   **model.NewElevation
+}
+
+// ElevationServiceMockRequestElevationResults contains results of the ElevationService.RequestElevation
+type ElevationServiceMockRequestElevationResults struct {
+	rp1 *model.RoleGrant
+	err error
+}
+
+// ElevationServiceMockRequestElevationOrigins contains origins of expectations of the ElevationService.RequestElevation
+type ElevationServiceMockRequestElevationExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originElevation   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Optional() *mElevationServiceMockRequestElevation {
+	mmRequestElevation.optional = true
+	return mmRequestElevation
+}
+
+// Expect sets up expected params for ElevationService.RequestElevation
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Expect(ctx context.Context, accessToken string, elevation *model.NewElevation) *mElevationServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &ElevationServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by ExpectParams functions")
+	}
+
+	mmRequestElevation.defaultExpectation.params = &ElevationServiceMockRequestElevationParams{ctx, accessToken, elevation}
+	mmRequestElevation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestElevation.expectations {
+		if minimock.Equal(e.params, mmRequestElevation.defaultExpectation.params) {
+			mmRequestElevation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestElevation.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestElevation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationService.RequestElevation
+func (mmRequestElevation *mElevationServiceMockRequestElevation) ExpectCtxParam1(ctx context.Context) *mElevationServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &ElevationServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.params != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Expect")
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs == nil {
+		mmRequestElevation.defaultExpectation.paramPtrs = &ElevationServiceMockRequestElevationParamPtrs{}
+	}
+	mmRequestElevation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestElevation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestElevation
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ElevationService.RequestElevation
+func (mmRequestElevation *mElevationServiceMockRequestElevation) ExpectAccessTokenParam2(accessToken string) *mElevationServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &ElevationServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.params != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Expect")
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs == nil {
+		mmRequestElevation.defaultExpectation.paramPtrs = &ElevationServiceMockRequestElevationParamPtrs{}
+	}
+	mmRequestElevation.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmRequestElevation.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmRequestElevation
+}
+
+// ExpectElevationParam3 sets up expected param elevation for ElevationService.RequestElevation
+func (mmRequestElevation *mElevationServiceMockRequestElevation) ExpectElevationParam3(elevation *model.NewElevation) *mElevationServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &ElevationServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.params != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Expect")
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs == nil {
+		mmRequestElevation.defaultExpectation.paramPtrs = &ElevationServiceMockRequestElevationParamPtrs{}
+	}
+	mmRequestElevation.defaultExpectation.paramPtrs.elevation = &elevation
+	mmRequestElevation.defaultExpectation.expectationOrigins.originElevation = minimock.CallerInfo(1)
+
+	return mmRequestElevation
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationService.RequestElevation
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Inspect(f func(ctx context.Context, accessToken string, elevation *model.NewElevation)) *mElevationServiceMockRequestElevation {
+	if mmRequestElevation.mock.inspectFuncRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("Inspect function is already set for ElevationServiceMock.RequestElevation")
+	}
+
+	mmRequestElevation.mock.inspectFuncRequestElevation = f
+
+	return mmRequestElevation
+}
+
+// Return sets up results that will be returned by ElevationService.RequestElevation
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Return(rp1 *model.RoleGrant, err error) *ElevationServiceMock {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &ElevationServiceMockRequestElevationExpectation{mock: mmRequestElevation.mock}
+	}
+	mmRequestElevation.defaultExpectation.results = &ElevationServiceMockRequestElevationResults{rp1, err}
+	mmRequestElevation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestElevation.mock
+}
+
+// Set uses given function f to mock the ElevationService.RequestElevation method
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Set(f func(ctx context.Context, accessToken string, elevation *model.NewElevation) (rp1 *model.RoleGrant, err error)) *ElevationServiceMock {
+	if mmRequestElevation.defaultExpectation != nil {
+		mmRequestElevation.mock.t.Fatalf("Default expectation is already set for the ElevationService.RequestElevation method")
+	}
+
+	if len(mmRequestElevation.expectations) > 0 {
+		mmRequestElevation.mock.t.Fatalf("Some expectations are already set for the ElevationService.RequestElevation method")
+	}
+
+	mmRequestElevation.mock.funcRequestElevation = f
+	mmRequestElevation.mock.funcRequestElevationOrigin = minimock.CallerInfo(1)
+	return mmRequestElevation.mock
+}
+
+// When sets expectation for the ElevationService.RequestElevation which will trigger the result defined by the following
+// Then helper
+func (mmRequestElevation *mElevationServiceMockRequestElevation) When(ctx context.Context, accessToken string, elevation *model.NewElevation) *ElevationServiceMockRequestElevationExpectation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("ElevationServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	expectation := &ElevationServiceMockRequestElevationExpectation{
+		mock:               mmRequestElevation.mock,
+		params:             &ElevationServiceMockRequestElevationParams{ctx, accessToken, elevation},
+		expectationOrigins: ElevationServiceMockRequestElevationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequestElevation.expectations = append(mmRequestElevation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationService.RequestElevation return parameters for the expectation previously defined by the When method
+func (e *ElevationServiceMockRequestElevationExpectation) Then(rp1 *model.RoleGrant, err error) *ElevationServiceMock {
+	e.results = &ElevationServiceMockRequestElevationResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationService.RequestElevation should be invoked
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Times(n uint64) *mElevationServiceMockRequestElevation {
+	if n == 0 {
+		mmRequestElevation.mock.t.Fatalf("Times of ElevationServiceMock.RequestElevation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestElevation.expectedInvocations, n)
+	mmRequestElevation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestElevation
+}
+
+func (mmRequestElevation *mElevationServiceMockRequestElevation) invocationsDone() bool {
+	if len(mmRequestElevation.expectations) == 0 && mmRequestElevation.defaultExpectation == nil && mmRequestElevation.mock.funcRequestElevation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestElevation.mock.afterRequestElevationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestElevation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestElevation implements mm_service.ElevationService
+func (mmRequestElevation *ElevationServiceMock) RequestElevation(ctx context.Context, accessToken string, elevation *model.NewElevation) (rp1 *model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmRequestElevation.beforeRequestElevationCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestElevation.afterRequestElevationCounter, 1)
+
+	mmRequestElevation.t.Helper()
+
+	if mmRequestElevation.inspectFuncRequestElevation != nil {
+		mmRequestElevation.inspectFuncRequestElevation(ctx, accessToken, elevation)
+	}
+
+	mm_params := ElevationServiceMockRequestElevationParams{ctx, accessToken, elevation}
+
+	// Record call args
+	mmRequestElevation.RequestElevationMock.mutex.Lock()
+	mmRequestElevation.RequestElevationMock.callArgs = append(mmRequestElevation.RequestElevationMock.callArgs, &mm_params)
+	mmRequestElevation.RequestElevationMock.mutex.Unlock()
+
+	for _, e := range mmRequestElevation.RequestElevationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmRequestElevation.RequestElevationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestElevation.RequestElevationMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestElevation.RequestElevationMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestElevation.RequestElevationMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationServiceMockRequestElevationParams{ctx, accessToken, elevation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestElevation.t.Errorf("ElevationServiceMock.RequestElevation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestElevation.RequestElevationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmRequestElevation.t.Errorf("ElevationServiceMock.RequestElevation got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestElevation.RequestElevationMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.elevation != nil && !minimock.Equal(*mm_want_ptrs.elevation, mm_got.elevation) {
+				mmRequestElevation.t.Errorf("ElevationServiceMock.RequestElevation got unexpected parameter elevation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestElevation.RequestElevationMock.defaultExpectation.expectationOrigins.originElevation, *mm_want_ptrs.elevation, mm_got.elevation, minimock.Diff(*mm_want_ptrs.elevation, mm_got.elevation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestElevation.t.Errorf("ElevationServiceMock.RequestElevation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestElevation.RequestElevationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestElevation.RequestElevationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestElevation.t.Fatal("No results are set for the ElevationServiceMock.RequestElevation")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmRequestElevation.funcRequestElevation != nil {
+		return mmRequestElevation.funcRequestElevation(ctx, accessToken, elevation)
+	}
+	mmRequestElevation.t.Fatalf("Unexpected call to ElevationServiceMock.RequestElevation. %v %v %v", ctx, accessToken, elevation)
+	return
+}
+
+// RequestElevationAfterCounter returns a count of finished ElevationServiceMock.RequestElevation invocations
+func (mmRequestElevation *ElevationServiceMock) RequestElevationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestElevation.afterRequestElevationCounter)
+}
+
+// RequestElevationBeforeCounter returns a count of ElevationServiceMock.RequestElevation invocations
+func (mmRequestElevation *ElevationServiceMock) RequestElevationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestElevation.beforeRequestElevationCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationServiceMock.RequestElevation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestElevation *mElevationServiceMockRequestElevation) Calls() []*ElevationServiceMockRequestElevationParams {
+	mmRequestElevation.mutex.RLock()
+
+	argCopy := make([]*ElevationServiceMockRequestElevationParams, len(mmRequestElevation.callArgs))
+	copy(argCopy, mmRequestElevation.callArgs)
+
+	mmRequestElevation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestElevationDone returns true if the count of the RequestElevation invocations corresponds
+// the number of defined expectations
+func (m *ElevationServiceMock) MinimockRequestElevationDone() bool {
+	if m.RequestElevationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestElevationMock.invocationsDone()
+}
+
+// MinimockRequestElevationInspect logs each unmet expectation
+func (m *ElevationServiceMock) MinimockRequestElevationInspect() {
+	for _, e := range m.RequestElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationServiceMock.RequestElevation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestElevationCounter := mm_atomic.LoadUint64(&m.afterRequestElevationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestElevationMock.defaultExpectation != nil && afterRequestElevationCounter < 1 {
+		if m.RequestElevationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ElevationServiceMock.RequestElevation at\n%s", m.RequestElevationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ElevationServiceMock.RequestElevation at\n%s with params: %#v", m.RequestElevationMock.defaultExpectation.expectationOrigins.origin, *m.RequestElevationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestElevation != nil && afterRequestElevationCounter < 1 {
+		m.t.Errorf("Expected call to ElevationServiceMock.RequestElevation at\n%s", m.funcRequestElevationOrigin)
+	}
+
+	if !m.RequestElevationMock.invocationsDone() && afterRequestElevationCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationServiceMock.RequestElevation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestElevationMock.expectedInvocations), m.RequestElevationMock.expectedInvocationsOrigin, afterRequestElevationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ElevationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockApproveElevationInspect()
+
+			m.MinimockDenyElevationInspect()
+
+			m.MinimockExpireElevationsInspect()
+
+			m.MinimockListElevationsInspect()
+
+			m.MinimockRequestElevationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ElevationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ElevationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockApproveElevationDone() &&
+		m.MinimockDenyElevationDone() &&
+		m.MinimockExpireElevationsDone() &&
+		m.MinimockListElevationsDone() &&
+		m.MinimockRequestElevationDone()
+}
@@ -0,0 +1,1192 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.FederationService -o federation_service_minimock.go -n FederationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// FederationServiceMock implements mm_service.FederationService
+type FederationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcBeginLogin          func(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest) (fp1 *model.FederationRedirect, err error)
+	funcBeginLoginOrigin    string
+	inspectFuncBeginLogin   func(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest)
+	afterBeginLoginCounter  uint64
+	beforeBeginLoginCounter uint64
+	BeginLoginMock          mFederationServiceMockBeginLogin
+
+	funcCompleteLogin          func(ctx context.Context, provider string, callback *model.FederationCallback) (fp1 *model.FederatedLogin, err error)
+	funcCompleteLoginOrigin    string
+	inspectFuncCompleteLogin   func(ctx context.Context, provider string, callback *model.FederationCallback)
+	afterCompleteLoginCounter  uint64
+	beforeCompleteLoginCounter uint64
+	CompleteLoginMock          mFederationServiceMockCompleteLogin
+
+	funcListProviders          func(ctx context.Context, clientID string) (fpa1 []*model.FederationProvider, err error)
+	funcListProvidersOrigin    string
+	inspectFuncListProviders   func(ctx context.Context, clientID string)
+	afterListProvidersCounter  uint64
+	beforeListProvidersCounter uint64
+	ListProvidersMock          mFederationServiceMockListProviders
+}
+
+// NewFederationServiceMock returns a mock for mm_service.FederationService
+func NewFederationServiceMock(t minimock.Tester) *FederationServiceMock {
+	m := &FederationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BeginLoginMock = mFederationServiceMockBeginLogin{mock: m}
+	m.BeginLoginMock.callArgs = []*FederationServiceMockBeginLoginParams{}
+
+	m.CompleteLoginMock = mFederationServiceMockCompleteLogin{mock: m}
+	m.CompleteLoginMock.callArgs = []*FederationServiceMockCompleteLoginParams{}
+
+	m.ListProvidersMock = mFederationServiceMockListProviders{mock: m}
+	m.ListProvidersMock.callArgs = []*FederationServiceMockListProvidersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFederationServiceMockBeginLogin struct {
+	optional           bool
+	mock               *FederationServiceMock
+	defaultExpectation *FederationServiceMockBeginLoginExpectation
+	expectations       []*FederationServiceMockBeginLoginExpectation
+
+	callArgs []*FederationServiceMockBeginLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederationServiceMockBeginLoginExpectation specifies expectation struct of the FederationService.BeginLogin
+type FederationServiceMockBeginLoginExpectation struct {
+	mock               *FederationServiceMock
+	params             *FederationServiceMockBeginLoginParams
+	paramPtrs          *FederationServiceMockBeginLoginParamPtrs
+	expectationOrigins FederationServiceMockBeginLoginExpectationOrigins
+	results            *FederationServiceMockBeginLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederationServiceMockBeginLoginParams contains parameters of the FederationService.BeginLogin
+type FederationServiceMockBeginLoginParams struct {
+	ctx      context.Context
+	provider string
+	req      *model.OAuthAuthorizationRequest
+}
+
+// FederationServiceMockBeginLoginParamPtrs contains pointers to parameters of the FederationService.BeginLogin
+type FederationServiceMockBeginLoginParamPtrs struct {
+	ctx      *context.Context
+	provider *string
+	req      **model.OAuthAuthorizationRequest
+}
+
+// FederationServiceMockBeginLoginResults contains results of the FederationService.BeginLogin
+type FederationServiceMockBeginLoginResults struct {
+	fp1 *model.FederationRedirect
+	err error
+}
+
+// FederationServiceMockBeginLoginOrigins contains origins of expectations of the FederationService.BeginLogin
+type FederationServiceMockBeginLoginExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originProvider string
+	originReq      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Optional() *mFederationServiceMockBeginLogin {
+	mmBeginLogin.optional = true
+	return mmBeginLogin
+}
+
+// Expect sets up expected params for FederationService.BeginLogin
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Expect(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest) *mFederationServiceMockBeginLogin {
+	if mmBeginLogin.mock.funcBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Set")
+	}
+
+	if mmBeginLogin.defaultExpectation == nil {
+		mmBeginLogin.defaultExpectation = &FederationServiceMockBeginLoginExpectation{}
+	}
+
+	if mmBeginLogin.defaultExpectation.paramPtrs != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by ExpectParams functions")
+	}
+
+	mmBeginLogin.defaultExpectation.params = &FederationServiceMockBeginLoginParams{ctx, provider, req}
+	mmBeginLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBeginLogin.expectations {
+		if minimock.Equal(e.params, mmBeginLogin.defaultExpectation.params) {
+			mmBeginLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBeginLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmBeginLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederationService.BeginLogin
+func (mmBeginLogin *mFederationServiceMockBeginLogin) ExpectCtxParam1(ctx context.Context) *mFederationServiceMockBeginLogin {
+	if mmBeginLogin.mock.funcBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Set")
+	}
+
+	if mmBeginLogin.defaultExpectation == nil {
+		mmBeginLogin.defaultExpectation = &FederationServiceMockBeginLoginExpectation{}
+	}
+
+	if mmBeginLogin.defaultExpectation.params != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Expect")
+	}
+
+	if mmBeginLogin.defaultExpectation.paramPtrs == nil {
+		mmBeginLogin.defaultExpectation.paramPtrs = &FederationServiceMockBeginLoginParamPtrs{}
+	}
+	mmBeginLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBeginLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBeginLogin
+}
+
+// ExpectProviderParam2 sets up expected param provider for FederationService.BeginLogin
+func (mmBeginLogin *mFederationServiceMockBeginLogin) ExpectProviderParam2(provider string) *mFederationServiceMockBeginLogin {
+	if mmBeginLogin.mock.funcBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Set")
+	}
+
+	if mmBeginLogin.defaultExpectation == nil {
+		mmBeginLogin.defaultExpectation = &FederationServiceMockBeginLoginExpectation{}
+	}
+
+	if mmBeginLogin.defaultExpectation.params != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Expect")
+	}
+
+	if mmBeginLogin.defaultExpectation.paramPtrs == nil {
+		mmBeginLogin.defaultExpectation.paramPtrs = &FederationServiceMockBeginLoginParamPtrs{}
+	}
+	mmBeginLogin.defaultExpectation.paramPtrs.provider = &provider
+	mmBeginLogin.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmBeginLogin
+}
+
+// ExpectReqParam3 sets up expected param req for FederationService.BeginLogin
+func (mmBeginLogin *mFederationServiceMockBeginLogin) ExpectReqParam3(req *model.OAuthAuthorizationRequest) *mFederationServiceMockBeginLogin {
+	if mmBeginLogin.mock.funcBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Set")
+	}
+
+	if mmBeginLogin.defaultExpectation == nil {
+		mmBeginLogin.defaultExpectation = &FederationServiceMockBeginLoginExpectation{}
+	}
+
+	if mmBeginLogin.defaultExpectation.params != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Expect")
+	}
+
+	if mmBeginLogin.defaultExpectation.paramPtrs == nil {
+		mmBeginLogin.defaultExpectation.paramPtrs = &FederationServiceMockBeginLoginParamPtrs{}
+	}
+	mmBeginLogin.defaultExpectation.paramPtrs.req = &req
+	mmBeginLogin.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmBeginLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederationService.BeginLogin
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Inspect(f func(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest)) *mFederationServiceMockBeginLogin {
+	if mmBeginLogin.mock.inspectFuncBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("Inspect function is already set for FederationServiceMock.BeginLogin")
+	}
+
+	mmBeginLogin.mock.inspectFuncBeginLogin = f
+
+	return mmBeginLogin
+}
+
+// Return sets up results that will be returned by FederationService.BeginLogin
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Return(fp1 *model.FederationRedirect, err error) *FederationServiceMock {
+	if mmBeginLogin.mock.funcBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Set")
+	}
+
+	if mmBeginLogin.defaultExpectation == nil {
+		mmBeginLogin.defaultExpectation = &FederationServiceMockBeginLoginExpectation{mock: mmBeginLogin.mock}
+	}
+	mmBeginLogin.defaultExpectation.results = &FederationServiceMockBeginLoginResults{fp1, err}
+	mmBeginLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBeginLogin.mock
+}
+
+// Set uses given function f to mock the FederationService.BeginLogin method
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Set(f func(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest) (fp1 *model.FederationRedirect, err error)) *FederationServiceMock {
+	if mmBeginLogin.defaultExpectation != nil {
+		mmBeginLogin.mock.t.Fatalf("Default expectation is already set for the FederationService.BeginLogin method")
+	}
+
+	if len(mmBeginLogin.expectations) > 0 {
+		mmBeginLogin.mock.t.Fatalf("Some expectations are already set for the FederationService.BeginLogin method")
+	}
+
+	mmBeginLogin.mock.funcBeginLogin = f
+	mmBeginLogin.mock.funcBeginLoginOrigin = minimock.CallerInfo(1)
+	return mmBeginLogin.mock
+}
+
+// When sets expectation for the FederationService.BeginLogin which will trigger the result defined by the following
+// Then helper
+func (mmBeginLogin *mFederationServiceMockBeginLogin) When(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest) *FederationServiceMockBeginLoginExpectation {
+	if mmBeginLogin.mock.funcBeginLogin != nil {
+		mmBeginLogin.mock.t.Fatalf("FederationServiceMock.BeginLogin mock is already set by Set")
+	}
+
+	expectation := &FederationServiceMockBeginLoginExpectation{
+		mock:               mmBeginLogin.mock,
+		params:             &FederationServiceMockBeginLoginParams{ctx, provider, req},
+		expectationOrigins: FederationServiceMockBeginLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBeginLogin.expectations = append(mmBeginLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederationService.BeginLogin return parameters for the expectation previously defined by the When method
+func (e *FederationServiceMockBeginLoginExpectation) Then(fp1 *model.FederationRedirect, err error) *FederationServiceMock {
+	e.results = &FederationServiceMockBeginLoginResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times FederationService.BeginLogin should be invoked
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Times(n uint64) *mFederationServiceMockBeginLogin {
+	if n == 0 {
+		mmBeginLogin.mock.t.Fatalf("Times of FederationServiceMock.BeginLogin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBeginLogin.expectedInvocations, n)
+	mmBeginLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBeginLogin
+}
+
+func (mmBeginLogin *mFederationServiceMockBeginLogin) invocationsDone() bool {
+	if len(mmBeginLogin.expectations) == 0 && mmBeginLogin.defaultExpectation == nil && mmBeginLogin.mock.funcBeginLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBeginLogin.mock.afterBeginLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBeginLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BeginLogin implements mm_service.FederationService
+func (mmBeginLogin *FederationServiceMock) BeginLogin(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest) (fp1 *model.FederationRedirect, err error) {
+	mm_atomic.AddUint64(&mmBeginLogin.beforeBeginLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmBeginLogin.afterBeginLoginCounter, 1)
+
+	mmBeginLogin.t.Helper()
+
+	if mmBeginLogin.inspectFuncBeginLogin != nil {
+		mmBeginLogin.inspectFuncBeginLogin(ctx, provider, req)
+	}
+
+	mm_params := FederationServiceMockBeginLoginParams{ctx, provider, req}
+
+	// Record call args
+	mmBeginLogin.BeginLoginMock.mutex.Lock()
+	mmBeginLogin.BeginLoginMock.callArgs = append(mmBeginLogin.BeginLoginMock.callArgs, &mm_params)
+	mmBeginLogin.BeginLoginMock.mutex.Unlock()
+
+	for _, e := range mmBeginLogin.BeginLoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmBeginLogin.BeginLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBeginLogin.BeginLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmBeginLogin.BeginLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmBeginLogin.BeginLoginMock.defaultExpectation.paramPtrs
+
+		mm_got := FederationServiceMockBeginLoginParams{ctx, provider, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBeginLogin.t.Errorf("FederationServiceMock.BeginLogin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginLogin.BeginLoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmBeginLogin.t.Errorf("FederationServiceMock.BeginLogin got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginLogin.BeginLoginMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmBeginLogin.t.Errorf("FederationServiceMock.BeginLogin got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginLogin.BeginLoginMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBeginLogin.t.Errorf("FederationServiceMock.BeginLogin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBeginLogin.BeginLoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBeginLogin.BeginLoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBeginLogin.t.Fatal("No results are set for the FederationServiceMock.BeginLogin")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmBeginLogin.funcBeginLogin != nil {
+		return mmBeginLogin.funcBeginLogin(ctx, provider, req)
+	}
+	mmBeginLogin.t.Fatalf("Unexpected call to FederationServiceMock.BeginLogin. %v %v %v", ctx, provider, req)
+	return
+}
+
+// BeginLoginAfterCounter returns a count of finished FederationServiceMock.BeginLogin invocations
+func (mmBeginLogin *FederationServiceMock) BeginLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginLogin.afterBeginLoginCounter)
+}
+
+// BeginLoginBeforeCounter returns a count of FederationServiceMock.BeginLogin invocations
+func (mmBeginLogin *FederationServiceMock) BeginLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginLogin.beforeBeginLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederationServiceMock.BeginLogin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBeginLogin *mFederationServiceMockBeginLogin) Calls() []*FederationServiceMockBeginLoginParams {
+	mmBeginLogin.mutex.RLock()
+
+	argCopy := make([]*FederationServiceMockBeginLoginParams, len(mmBeginLogin.callArgs))
+	copy(argCopy, mmBeginLogin.callArgs)
+
+	mmBeginLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBeginLoginDone returns true if the count of the BeginLogin invocations corresponds
+// the number of defined expectations
+func (m *FederationServiceMock) MinimockBeginLoginDone() bool {
+	if m.BeginLoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BeginLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BeginLoginMock.invocationsDone()
+}
+
+// MinimockBeginLoginInspect logs each unmet expectation
+func (m *FederationServiceMock) MinimockBeginLoginInspect() {
+	for _, e := range m.BeginLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederationServiceMock.BeginLogin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBeginLoginCounter := mm_atomic.LoadUint64(&m.afterBeginLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BeginLoginMock.defaultExpectation != nil && afterBeginLoginCounter < 1 {
+		if m.BeginLoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederationServiceMock.BeginLogin at\n%s", m.BeginLoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederationServiceMock.BeginLogin at\n%s with params: %#v", m.BeginLoginMock.defaultExpectation.expectationOrigins.origin, *m.BeginLoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBeginLogin != nil && afterBeginLoginCounter < 1 {
+		m.t.Errorf("Expected call to FederationServiceMock.BeginLogin at\n%s", m.funcBeginLoginOrigin)
+	}
+
+	if !m.BeginLoginMock.invocationsDone() && afterBeginLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederationServiceMock.BeginLogin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BeginLoginMock.expectedInvocations), m.BeginLoginMock.expectedInvocationsOrigin, afterBeginLoginCounter)
+	}
+}
+
+type mFederationServiceMockCompleteLogin struct {
+	optional           bool
+	mock               *FederationServiceMock
+	defaultExpectation *FederationServiceMockCompleteLoginExpectation
+	expectations       []*FederationServiceMockCompleteLoginExpectation
+
+	callArgs []*FederationServiceMockCompleteLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederationServiceMockCompleteLoginExpectation specifies expectation struct of the FederationService.CompleteLogin
+type FederationServiceMockCompleteLoginExpectation struct {
+	mock               *FederationServiceMock
+	params             *FederationServiceMockCompleteLoginParams
+	paramPtrs          *FederationServiceMockCompleteLoginParamPtrs
+	expectationOrigins FederationServiceMockCompleteLoginExpectationOrigins
+	results            *FederationServiceMockCompleteLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederationServiceMockCompleteLoginParams contains parameters of the FederationService.CompleteLogin
+type FederationServiceMockCompleteLoginParams struct {
+	ctx      context.Context
+	provider string
+	callback *model.FederationCallback
+}
+
+// FederationServiceMockCompleteLoginParamPtrs contains pointers to parameters of the FederationService.CompleteLogin
+type FederationServiceMockCompleteLoginParamPtrs struct {
+	ctx      *context.Context
+	provider *string
+	callback **model.FederationCallback
+}
+
+// FederationServiceMockCompleteLoginResults contains results of the FederationService.CompleteLogin
+type FederationServiceMockCompleteLoginResults struct {
+	fp1 *model.FederatedLogin
+	err error
+}
+
+// FederationServiceMockCompleteLoginOrigins contains origins of expectations of the FederationService.CompleteLogin
+type FederationServiceMockCompleteLoginExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originProvider string
+	originCallback string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Optional() *mFederationServiceMockCompleteLogin {
+	mmCompleteLogin.optional = true
+	return mmCompleteLogin
+}
+
+// Expect sets up expected params for FederationService.CompleteLogin
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Expect(ctx context.Context, provider string, callback *model.FederationCallback) *mFederationServiceMockCompleteLogin {
+	if mmCompleteLogin.mock.funcCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Set")
+	}
+
+	if mmCompleteLogin.defaultExpectation == nil {
+		mmCompleteLogin.defaultExpectation = &FederationServiceMockCompleteLoginExpectation{}
+	}
+
+	if mmCompleteLogin.defaultExpectation.paramPtrs != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by ExpectParams functions")
+	}
+
+	mmCompleteLogin.defaultExpectation.params = &FederationServiceMockCompleteLoginParams{ctx, provider, callback}
+	mmCompleteLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCompleteLogin.expectations {
+		if minimock.Equal(e.params, mmCompleteLogin.defaultExpectation.params) {
+			mmCompleteLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmCompleteLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederationService.CompleteLogin
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) ExpectCtxParam1(ctx context.Context) *mFederationServiceMockCompleteLogin {
+	if mmCompleteLogin.mock.funcCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Set")
+	}
+
+	if mmCompleteLogin.defaultExpectation == nil {
+		mmCompleteLogin.defaultExpectation = &FederationServiceMockCompleteLoginExpectation{}
+	}
+
+	if mmCompleteLogin.defaultExpectation.params != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Expect")
+	}
+
+	if mmCompleteLogin.defaultExpectation.paramPtrs == nil {
+		mmCompleteLogin.defaultExpectation.paramPtrs = &FederationServiceMockCompleteLoginParamPtrs{}
+	}
+	mmCompleteLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCompleteLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCompleteLogin
+}
+
+// ExpectProviderParam2 sets up expected param provider for FederationService.CompleteLogin
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) ExpectProviderParam2(provider string) *mFederationServiceMockCompleteLogin {
+	if mmCompleteLogin.mock.funcCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Set")
+	}
+
+	if mmCompleteLogin.defaultExpectation == nil {
+		mmCompleteLogin.defaultExpectation = &FederationServiceMockCompleteLoginExpectation{}
+	}
+
+	if mmCompleteLogin.defaultExpectation.params != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Expect")
+	}
+
+	if mmCompleteLogin.defaultExpectation.paramPtrs == nil {
+		mmCompleteLogin.defaultExpectation.paramPtrs = &FederationServiceMockCompleteLoginParamPtrs{}
+	}
+	mmCompleteLogin.defaultExpectation.paramPtrs.provider = &provider
+	mmCompleteLogin.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmCompleteLogin
+}
+
+// ExpectCallbackParam3 sets up expected param callback for FederationService.CompleteLogin
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) ExpectCallbackParam3(callback *model.FederationCallback) *mFederationServiceMockCompleteLogin {
+	if mmCompleteLogin.mock.funcCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Set")
+	}
+
+	if mmCompleteLogin.defaultExpectation == nil {
+		mmCompleteLogin.defaultExpectation = &FederationServiceMockCompleteLoginExpectation{}
+	}
+
+	if mmCompleteLogin.defaultExpectation.params != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Expect")
+	}
+
+	if mmCompleteLogin.defaultExpectation.paramPtrs == nil {
+		mmCompleteLogin.defaultExpectation.paramPtrs = &FederationServiceMockCompleteLoginParamPtrs{}
+	}
+	mmCompleteLogin.defaultExpectation.paramPtrs.callback = &callback
+	mmCompleteLogin.defaultExpectation.expectationOrigins.originCallback = minimock.CallerInfo(1)
+
+	return mmCompleteLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederationService.CompleteLogin
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Inspect(f func(ctx context.Context, provider string, callback *model.FederationCallback)) *mFederationServiceMockCompleteLogin {
+	if mmCompleteLogin.mock.inspectFuncCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("Inspect function is already set for FederationServiceMock.CompleteLogin")
+	}
+
+	mmCompleteLogin.mock.inspectFuncCompleteLogin = f
+
+	return mmCompleteLogin
+}
+
+// Return sets up results that will be returned by FederationService.CompleteLogin
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Return(fp1 *model.FederatedLogin, err error) *FederationServiceMock {
+	if mmCompleteLogin.mock.funcCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Set")
+	}
+
+	if mmCompleteLogin.defaultExpectation == nil {
+		mmCompleteLogin.defaultExpectation = &FederationServiceMockCompleteLoginExpectation{mock: mmCompleteLogin.mock}
+	}
+	mmCompleteLogin.defaultExpectation.results = &FederationServiceMockCompleteLoginResults{fp1, err}
+	mmCompleteLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCompleteLogin.mock
+}
+
+// Set uses given function f to mock the FederationService.CompleteLogin method
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Set(f func(ctx context.Context, provider string, callback *model.FederationCallback) (fp1 *model.FederatedLogin, err error)) *FederationServiceMock {
+	if mmCompleteLogin.defaultExpectation != nil {
+		mmCompleteLogin.mock.t.Fatalf("Default expectation is already set for the FederationService.CompleteLogin method")
+	}
+
+	if len(mmCompleteLogin.expectations) > 0 {
+		mmCompleteLogin.mock.t.Fatalf("Some expectations are already set for the FederationService.CompleteLogin method")
+	}
+
+	mmCompleteLogin.mock.funcCompleteLogin = f
+	mmCompleteLogin.mock.funcCompleteLoginOrigin = minimock.CallerInfo(1)
+	return mmCompleteLogin.mock
+}
+
+// When sets expectation for the FederationService.CompleteLogin which will trigger the result defined by the following
+// Then helper
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) When(ctx context.Context, provider string, callback *model.FederationCallback) *FederationServiceMockCompleteLoginExpectation {
+	if mmCompleteLogin.mock.funcCompleteLogin != nil {
+		mmCompleteLogin.mock.t.Fatalf("FederationServiceMock.CompleteLogin mock is already set by Set")
+	}
+
+	expectation := &FederationServiceMockCompleteLoginExpectation{
+		mock:               mmCompleteLogin.mock,
+		params:             &FederationServiceMockCompleteLoginParams{ctx, provider, callback},
+		expectationOrigins: FederationServiceMockCompleteLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCompleteLogin.expectations = append(mmCompleteLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederationService.CompleteLogin return parameters for the expectation previously defined by the When method
+func (e *FederationServiceMockCompleteLoginExpectation) Then(fp1 *model.FederatedLogin, err error) *FederationServiceMock {
+	e.results = &FederationServiceMockCompleteLoginResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times FederationService.CompleteLogin should be invoked
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Times(n uint64) *mFederationServiceMockCompleteLogin {
+	if n == 0 {
+		mmCompleteLogin.mock.t.Fatalf("Times of FederationServiceMock.CompleteLogin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCompleteLogin.expectedInvocations, n)
+	mmCompleteLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCompleteLogin
+}
+
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) invocationsDone() bool {
+	if len(mmCompleteLogin.expectations) == 0 && mmCompleteLogin.defaultExpectation == nil && mmCompleteLogin.mock.funcCompleteLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteLogin.mock.afterCompleteLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CompleteLogin implements mm_service.FederationService
+func (mmCompleteLogin *FederationServiceMock) CompleteLogin(ctx context.Context, provider string, callback *model.FederationCallback) (fp1 *model.FederatedLogin, err error) {
+	mm_atomic.AddUint64(&mmCompleteLogin.beforeCompleteLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteLogin.afterCompleteLoginCounter, 1)
+
+	mmCompleteLogin.t.Helper()
+
+	if mmCompleteLogin.inspectFuncCompleteLogin != nil {
+		mmCompleteLogin.inspectFuncCompleteLogin(ctx, provider, callback)
+	}
+
+	mm_params := FederationServiceMockCompleteLoginParams{ctx, provider, callback}
+
+	// Record call args
+	mmCompleteLogin.CompleteLoginMock.mutex.Lock()
+	mmCompleteLogin.CompleteLoginMock.callArgs = append(mmCompleteLogin.CompleteLoginMock.callArgs, &mm_params)
+	mmCompleteLogin.CompleteLoginMock.mutex.Unlock()
+
+	for _, e := range mmCompleteLogin.CompleteLoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmCompleteLogin.CompleteLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteLogin.CompleteLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteLogin.CompleteLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteLogin.CompleteLoginMock.defaultExpectation.paramPtrs
+
+		mm_got := FederationServiceMockCompleteLoginParams{ctx, provider, callback}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCompleteLogin.t.Errorf("FederationServiceMock.CompleteLogin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteLogin.CompleteLoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmCompleteLogin.t.Errorf("FederationServiceMock.CompleteLogin got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteLogin.CompleteLoginMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.callback != nil && !minimock.Equal(*mm_want_ptrs.callback, mm_got.callback) {
+				mmCompleteLogin.t.Errorf("FederationServiceMock.CompleteLogin got unexpected parameter callback, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteLogin.CompleteLoginMock.defaultExpectation.expectationOrigins.originCallback, *mm_want_ptrs.callback, mm_got.callback, minimock.Diff(*mm_want_ptrs.callback, mm_got.callback))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCompleteLogin.t.Errorf("FederationServiceMock.CompleteLogin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCompleteLogin.CompleteLoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCompleteLogin.CompleteLoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCompleteLogin.t.Fatal("No results are set for the FederationServiceMock.CompleteLogin")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmCompleteLogin.funcCompleteLogin != nil {
+		return mmCompleteLogin.funcCompleteLogin(ctx, provider, callback)
+	}
+	mmCompleteLogin.t.Fatalf("Unexpected call to FederationServiceMock.CompleteLogin. %v %v %v", ctx, provider, callback)
+	return
+}
+
+// CompleteLoginAfterCounter returns a count of finished FederationServiceMock.CompleteLogin invocations
+func (mmCompleteLogin *FederationServiceMock) CompleteLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteLogin.afterCompleteLoginCounter)
+}
+
+// CompleteLoginBeforeCounter returns a count of FederationServiceMock.CompleteLogin invocations
+func (mmCompleteLogin *FederationServiceMock) CompleteLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteLogin.beforeCompleteLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederationServiceMock.CompleteLogin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCompleteLogin *mFederationServiceMockCompleteLogin) Calls() []*FederationServiceMockCompleteLoginParams {
+	mmCompleteLogin.mutex.RLock()
+
+	argCopy := make([]*FederationServiceMockCompleteLoginParams, len(mmCompleteLogin.callArgs))
+	copy(argCopy, mmCompleteLogin.callArgs)
+
+	mmCompleteLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteLoginDone returns true if the count of the CompleteLogin invocations corresponds
+// the number of defined expectations
+func (m *FederationServiceMock) MinimockCompleteLoginDone() bool {
+	if m.CompleteLoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteLoginMock.invocationsDone()
+}
+
+// MinimockCompleteLoginInspect logs each unmet expectation
+func (m *FederationServiceMock) MinimockCompleteLoginInspect() {
+	for _, e := range m.CompleteLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederationServiceMock.CompleteLogin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteLoginCounter := mm_atomic.LoadUint64(&m.afterCompleteLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteLoginMock.defaultExpectation != nil && afterCompleteLoginCounter < 1 {
+		if m.CompleteLoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederationServiceMock.CompleteLogin at\n%s", m.CompleteLoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederationServiceMock.CompleteLogin at\n%s with params: %#v", m.CompleteLoginMock.defaultExpectation.expectationOrigins.origin, *m.CompleteLoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCompleteLogin != nil && afterCompleteLoginCounter < 1 {
+		m.t.Errorf("Expected call to FederationServiceMock.CompleteLogin at\n%s", m.funcCompleteLoginOrigin)
+	}
+
+	if !m.CompleteLoginMock.invocationsDone() && afterCompleteLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederationServiceMock.CompleteLogin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteLoginMock.expectedInvocations), m.CompleteLoginMock.expectedInvocationsOrigin, afterCompleteLoginCounter)
+	}
+}
+
+type mFederationServiceMockListProviders struct {
+	optional           bool
+	mock               *FederationServiceMock
+	defaultExpectation *FederationServiceMockListProvidersExpectation
+	expectations       []*FederationServiceMockListProvidersExpectation
+
+	callArgs []*FederationServiceMockListProvidersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FederationServiceMockListProvidersExpectation specifies expectation struct of the FederationService.ListProviders
+type FederationServiceMockListProvidersExpectation struct {
+	mock               *FederationServiceMock
+	params             *FederationServiceMockListProvidersParams
+	paramPtrs          *FederationServiceMockListProvidersParamPtrs
+	expectationOrigins FederationServiceMockListProvidersExpectationOrigins
+	results            *FederationServiceMockListProvidersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FederationServiceMockListProvidersParams contains parameters of the FederationService.ListProviders
+type FederationServiceMockListProvidersParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// FederationServiceMockListProvidersParamPtrs contains pointers to parameters of the FederationService.ListProviders
+type FederationServiceMockListProvidersParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// FederationServiceMockListProvidersResults contains results of the FederationService.ListProviders
+type FederationServiceMockListProvidersResults struct {
+	fpa1 []*model.FederationProvider
+	err  error
+}
+
+// FederationServiceMockListProvidersOrigins contains origins of expectations of the FederationService.ListProviders
+type FederationServiceMockListProvidersExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListProviders *mFederationServiceMockListProviders) Optional() *mFederationServiceMockListProviders {
+	mmListProviders.optional = true
+	return mmListProviders
+}
+
+// Expect sets up expected params for FederationService.ListProviders
+func (mmListProviders *mFederationServiceMockListProviders) Expect(ctx context.Context, clientID string) *mFederationServiceMockListProviders {
+	if mmListProviders.mock.funcListProviders != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Set")
+	}
+
+	if mmListProviders.defaultExpectation == nil {
+		mmListProviders.defaultExpectation = &FederationServiceMockListProvidersExpectation{}
+	}
+
+	if mmListProviders.defaultExpectation.paramPtrs != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by ExpectParams functions")
+	}
+
+	mmListProviders.defaultExpectation.params = &FederationServiceMockListProvidersParams{ctx, clientID}
+	mmListProviders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListProviders.expectations {
+		if minimock.Equal(e.params, mmListProviders.defaultExpectation.params) {
+			mmListProviders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListProviders.defaultExpectation.params)
+		}
+	}
+
+	return mmListProviders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederationService.ListProviders
+func (mmListProviders *mFederationServiceMockListProviders) ExpectCtxParam1(ctx context.Context) *mFederationServiceMockListProviders {
+	if mmListProviders.mock.funcListProviders != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Set")
+	}
+
+	if mmListProviders.defaultExpectation == nil {
+		mmListProviders.defaultExpectation = &FederationServiceMockListProvidersExpectation{}
+	}
+
+	if mmListProviders.defaultExpectation.params != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Expect")
+	}
+
+	if mmListProviders.defaultExpectation.paramPtrs == nil {
+		mmListProviders.defaultExpectation.paramPtrs = &FederationServiceMockListProvidersParamPtrs{}
+	}
+	mmListProviders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListProviders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListProviders
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for FederationService.ListProviders
+func (mmListProviders *mFederationServiceMockListProviders) ExpectClientIDParam2(clientID string) *mFederationServiceMockListProviders {
+	if mmListProviders.mock.funcListProviders != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Set")
+	}
+
+	if mmListProviders.defaultExpectation == nil {
+		mmListProviders.defaultExpectation = &FederationServiceMockListProvidersExpectation{}
+	}
+
+	if mmListProviders.defaultExpectation.params != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Expect")
+	}
+
+	if mmListProviders.defaultExpectation.paramPtrs == nil {
+		mmListProviders.defaultExpectation.paramPtrs = &FederationServiceMockListProvidersParamPtrs{}
+	}
+	mmListProviders.defaultExpectation.paramPtrs.clientID = &clientID
+	mmListProviders.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmListProviders
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederationService.ListProviders
+func (mmListProviders *mFederationServiceMockListProviders) Inspect(f func(ctx context.Context, clientID string)) *mFederationServiceMockListProviders {
+	if mmListProviders.mock.inspectFuncListProviders != nil {
+		mmListProviders.mock.t.Fatalf("Inspect function is already set for FederationServiceMock.ListProviders")
+	}
+
+	mmListProviders.mock.inspectFuncListProviders = f
+
+	return mmListProviders
+}
+
+// Return sets up results that will be returned by FederationService.ListProviders
+func (mmListProviders *mFederationServiceMockListProviders) Return(fpa1 []*model.FederationProvider, err error) *FederationServiceMock {
+	if mmListProviders.mock.funcListProviders != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Set")
+	}
+
+	if mmListProviders.defaultExpectation == nil {
+		mmListProviders.defaultExpectation = &FederationServiceMockListProvidersExpectation{mock: mmListProviders.mock}
+	}
+	mmListProviders.defaultExpectation.results = &FederationServiceMockListProvidersResults{fpa1, err}
+	mmListProviders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListProviders.mock
+}
+
+// Set uses given function f to mock the FederationService.ListProviders method
+func (mmListProviders *mFederationServiceMockListProviders) Set(f func(ctx context.Context, clientID string) (fpa1 []*model.FederationProvider, err error)) *FederationServiceMock {
+	if mmListProviders.defaultExpectation != nil {
+		mmListProviders.mock.t.Fatalf("Default expectation is already set for the FederationService.ListProviders method")
+	}
+
+	if len(mmListProviders.expectations) > 0 {
+		mmListProviders.mock.t.Fatalf("Some expectations are already set for the FederationService.ListProviders method")
+	}
+
+	mmListProviders.mock.funcListProviders = f
+	mmListProviders.mock.funcListProvidersOrigin = minimock.CallerInfo(1)
+	return mmListProviders.mock
+}
+
+// When sets expectation for the FederationService.ListProviders which will trigger the result defined by the following
+// Then helper
+func (mmListProviders *mFederationServiceMockListProviders) When(ctx context.Context, clientID string) *FederationServiceMockListProvidersExpectation {
+	if mmListProviders.mock.funcListProviders != nil {
+		mmListProviders.mock.t.Fatalf("FederationServiceMock.ListProviders mock is already set by Set")
+	}
+
+	expectation := &FederationServiceMockListProvidersExpectation{
+		mock:               mmListProviders.mock,
+		params:             &FederationServiceMockListProvidersParams{ctx, clientID},
+		expectationOrigins: FederationServiceMockListProvidersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListProviders.expectations = append(mmListProviders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederationService.ListProviders return parameters for the expectation previously defined by the When method
+func (e *FederationServiceMockListProvidersExpectation) Then(fpa1 []*model.FederationProvider, err error) *FederationServiceMock {
+	e.results = &FederationServiceMockListProvidersResults{fpa1, err}
+	return e.mock
+}
+
+// Times sets number of times FederationService.ListProviders should be invoked
+func (mmListProviders *mFederationServiceMockListProviders) Times(n uint64) *mFederationServiceMockListProviders {
+	if n == 0 {
+		mmListProviders.mock.t.Fatalf("Times of FederationServiceMock.ListProviders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListProviders.expectedInvocations, n)
+	mmListProviders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListProviders
+}
+
+func (mmListProviders *mFederationServiceMockListProviders) invocationsDone() bool {
+	if len(mmListProviders.expectations) == 0 && mmListProviders.defaultExpectation == nil && mmListProviders.mock.funcListProviders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListProviders.mock.afterListProvidersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListProviders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListProviders implements mm_service.FederationService
+func (mmListProviders *FederationServiceMock) ListProviders(ctx context.Context, clientID string) (fpa1 []*model.FederationProvider, err error) {
+	mm_atomic.AddUint64(&mmListProviders.beforeListProvidersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListProviders.afterListProvidersCounter, 1)
+
+	mmListProviders.t.Helper()
+
+	if mmListProviders.inspectFuncListProviders != nil {
+		mmListProviders.inspectFuncListProviders(ctx, clientID)
+	}
+
+	mm_params := FederationServiceMockListProvidersParams{ctx, clientID}
+
+	// Record call args
+	mmListProviders.ListProvidersMock.mutex.Lock()
+	mmListProviders.ListProvidersMock.callArgs = append(mmListProviders.ListProvidersMock.callArgs, &mm_params)
+	mmListProviders.ListProvidersMock.mutex.Unlock()
+
+	for _, e := range mmListProviders.ListProvidersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fpa1, e.results.err
+		}
+	}
+
+	if mmListProviders.ListProvidersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListProviders.ListProvidersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListProviders.ListProvidersMock.defaultExpectation.params
+		mm_want_ptrs := mmListProviders.ListProvidersMock.defaultExpectation.paramPtrs
+
+		mm_got := FederationServiceMockListProvidersParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListProviders.t.Errorf("FederationServiceMock.ListProviders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListProviders.ListProvidersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmListProviders.t.Errorf("FederationServiceMock.ListProviders got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListProviders.ListProvidersMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListProviders.t.Errorf("FederationServiceMock.ListProviders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListProviders.ListProvidersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListProviders.ListProvidersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListProviders.t.Fatal("No results are set for the FederationServiceMock.ListProviders")
+		}
+		return (*mm_results).fpa1, (*mm_results).err
+	}
+	if mmListProviders.funcListProviders != nil {
+		return mmListProviders.funcListProviders(ctx, clientID)
+	}
+	mmListProviders.t.Fatalf("Unexpected call to FederationServiceMock.ListProviders. %v %v", ctx, clientID)
+	return
+}
+
+// ListProvidersAfterCounter returns a count of finished FederationServiceMock.ListProviders invocations
+func (mmListProviders *FederationServiceMock) ListProvidersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListProviders.afterListProvidersCounter)
+}
+
+// ListProvidersBeforeCounter returns a count of FederationServiceMock.ListProviders invocations
+func (mmListProviders *FederationServiceMock) ListProvidersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListProviders.beforeListProvidersCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederationServiceMock.ListProviders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListProviders *mFederationServiceMockListProviders) Calls() []*FederationServiceMockListProvidersParams {
+	mmListProviders.mutex.RLock()
+
+	argCopy := make([]*FederationServiceMockListProvidersParams, len(mmListProviders.callArgs))
+	copy(argCopy, mmListProviders.callArgs)
+
+	mmListProviders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListProvidersDone returns true if the count of the ListProviders invocations corresponds
+// the number of defined expectations
+func (m *FederationServiceMock) MinimockListProvidersDone() bool {
+	if m.ListProvidersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListProvidersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListProvidersMock.invocationsDone()
+}
+
+// MinimockListProvidersInspect logs each unmet expectation
+func (m *FederationServiceMock) MinimockListProvidersInspect() {
+	for _, e := range m.ListProvidersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederationServiceMock.ListProviders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListProvidersCounter := mm_atomic.LoadUint64(&m.afterListProvidersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListProvidersMock.defaultExpectation != nil && afterListProvidersCounter < 1 {
+		if m.ListProvidersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FederationServiceMock.ListProviders at\n%s", m.ListProvidersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FederationServiceMock.ListProviders at\n%s with params: %#v", m.ListProvidersMock.defaultExpectation.expectationOrigins.origin, *m.ListProvidersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListProviders != nil && afterListProvidersCounter < 1 {
+		m.t.Errorf("Expected call to FederationServiceMock.ListProviders at\n%s", m.funcListProvidersOrigin)
+	}
+
+	if !m.ListProvidersMock.invocationsDone() && afterListProvidersCounter > 0 {
+		m.t.Errorf("Expected %d calls to FederationServiceMock.ListProviders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListProvidersMock.expectedInvocations), m.ListProvidersMock.expectedInvocationsOrigin, afterListProvidersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FederationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockBeginLoginInspect()
+
+			m.MinimockCompleteLoginInspect()
+
+			m.MinimockListProvidersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FederationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FederationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBeginLoginDone() &&
+		m.MinimockCompleteLoginDone() &&
+		m.MinimockListProvidersDone()
+}
@@ -0,0 +1,1485 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.InvitationService -o invitation_service_minimock.go -n InvitationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// InvitationServiceMock implements mm_service.InvitationService
+type InvitationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAcceptInvitation          func(ctx context.Context, acceptInvitation *model.AcceptInvitation) (s1 string, s2 string, err error)
+	funcAcceptInvitationOrigin    string
+	inspectFuncAcceptInvitation   func(ctx context.Context, acceptInvitation *model.AcceptInvitation)
+	afterAcceptInvitationCounter  uint64
+	beforeAcceptInvitationCounter uint64
+	AcceptInvitationMock          mInvitationServiceMockAcceptInvitation
+
+	funcInviteUser          func(ctx context.Context, newInvitation *model.NewInvitation) (ip1 *model.Invitation, err error)
+	funcInviteUserOrigin    string
+	inspectFuncInviteUser   func(ctx context.Context, newInvitation *model.NewInvitation)
+	afterInviteUserCounter  uint64
+	beforeInviteUserCounter uint64
+	InviteUserMock          mInvitationServiceMockInviteUser
+
+	funcResendInvitation          func(ctx context.Context, id int64) (err error)
+	funcResendInvitationOrigin    string
+	inspectFuncResendInvitation   func(ctx context.Context, id int64)
+	afterResendInvitationCounter  uint64
+	beforeResendInvitationCounter uint64
+	ResendInvitationMock          mInvitationServiceMockResendInvitation
+
+	funcRevokeInvitation          func(ctx context.Context, id int64) (err error)
+	funcRevokeInvitationOrigin    string
+	inspectFuncRevokeInvitation   func(ctx context.Context, id int64)
+	afterRevokeInvitationCounter  uint64
+	beforeRevokeInvitationCounter uint64
+	RevokeInvitationMock          mInvitationServiceMockRevokeInvitation
+}
+
+// NewInvitationServiceMock returns a mock for mm_service.InvitationService
+func NewInvitationServiceMock(t minimock.Tester) *InvitationServiceMock {
+	m := &InvitationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcceptInvitationMock = mInvitationServiceMockAcceptInvitation{mock: m}
+	m.AcceptInvitationMock.callArgs = []*InvitationServiceMockAcceptInvitationParams{}
+
+	m.InviteUserMock = mInvitationServiceMockInviteUser{mock: m}
+	m.InviteUserMock.callArgs = []*InvitationServiceMockInviteUserParams{}
+
+	m.ResendInvitationMock = mInvitationServiceMockResendInvitation{mock: m}
+	m.ResendInvitationMock.callArgs = []*InvitationServiceMockResendInvitationParams{}
+
+	m.RevokeInvitationMock = mInvitationServiceMockRevokeInvitation{mock: m}
+	m.RevokeInvitationMock.callArgs = []*InvitationServiceMockRevokeInvitationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mInvitationServiceMockAcceptInvitation struct {
+	optional           bool
+	mock               *InvitationServiceMock
+	defaultExpectation *InvitationServiceMockAcceptInvitationExpectation
+	expectations       []*InvitationServiceMockAcceptInvitationExpectation
+
+	callArgs []*InvitationServiceMockAcceptInvitationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationServiceMockAcceptInvitationExpectation specifies expectation struct of the InvitationService.AcceptInvitation
+type InvitationServiceMockAcceptInvitationExpectation struct {
+	mock               *InvitationServiceMock
+	params             *InvitationServiceMockAcceptInvitationParams
+	paramPtrs          *InvitationServiceMockAcceptInvitationParamPtrs
+	expectationOrigins InvitationServiceMockAcceptInvitationExpectationOrigins
+	results            *InvitationServiceMockAcceptInvitationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationServiceMockAcceptInvitationParams contains parameters of the InvitationService.AcceptInvitation
+type InvitationServiceMockAcceptInvitationParams struct {
+	ctx              context.Context
+	acceptInvitation *model.AcceptInvitation
+}
+
+// InvitationServiceMockAcceptInvitationParamPtrs contains pointers to parameters of the InvitationService.AcceptInvitation
+type InvitationServiceMockAcceptInvitationParamPtrs struct {
+	ctx              *context.Context
+	acceptInvitation **model.AcceptInvitation
+}
+
+// InvitationServiceMockAcceptInvitationResults contains results of the InvitationService.AcceptInvitation
+type InvitationServiceMockAcceptInvitationResults struct {
+	s1  string
+	s2  string
+	err error
+}
+
+// InvitationServiceMockAcceptInvitationOrigins contains origins of expectations of the InvitationService.AcceptInvitation
+type InvitationServiceMockAcceptInvitationExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originAcceptInvitation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Optional() *mInvitationServiceMockAcceptInvitation {
+	mmAcceptInvitation.optional = true
+	return mmAcceptInvitation
+}
+
+// Expect sets up expected params for InvitationService.AcceptInvitation
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Expect(ctx context.Context, acceptInvitation *model.AcceptInvitation) *mInvitationServiceMockAcceptInvitation {
+	if mmAcceptInvitation.mock.funcAcceptInvitation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Set")
+	}
+
+	if mmAcceptInvitation.defaultExpectation == nil {
+		mmAcceptInvitation.defaultExpectation = &InvitationServiceMockAcceptInvitationExpectation{}
+	}
+
+	if mmAcceptInvitation.defaultExpectation.paramPtrs != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by ExpectParams functions")
+	}
+
+	mmAcceptInvitation.defaultExpectation.params = &InvitationServiceMockAcceptInvitationParams{ctx, acceptInvitation}
+	mmAcceptInvitation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAcceptInvitation.expectations {
+		if minimock.Equal(e.params, mmAcceptInvitation.defaultExpectation.params) {
+			mmAcceptInvitation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAcceptInvitation.defaultExpectation.params)
+		}
+	}
+
+	return mmAcceptInvitation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationService.AcceptInvitation
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) ExpectCtxParam1(ctx context.Context) *mInvitationServiceMockAcceptInvitation {
+	if mmAcceptInvitation.mock.funcAcceptInvitation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Set")
+	}
+
+	if mmAcceptInvitation.defaultExpectation == nil {
+		mmAcceptInvitation.defaultExpectation = &InvitationServiceMockAcceptInvitationExpectation{}
+	}
+
+	if mmAcceptInvitation.defaultExpectation.params != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Expect")
+	}
+
+	if mmAcceptInvitation.defaultExpectation.paramPtrs == nil {
+		mmAcceptInvitation.defaultExpectation.paramPtrs = &InvitationServiceMockAcceptInvitationParamPtrs{}
+	}
+	mmAcceptInvitation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAcceptInvitation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAcceptInvitation
+}
+
+// ExpectAcceptInvitationParam2 sets up expected param acceptInvitation for InvitationService.AcceptInvitation
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) ExpectAcceptInvitationParam2(acceptInvitation *model.AcceptInvitation) *mInvitationServiceMockAcceptInvitation {
+	if mmAcceptInvitation.mock.funcAcceptInvitation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Set")
+	}
+
+	if mmAcceptInvitation.defaultExpectation == nil {
+		mmAcceptInvitation.defaultExpectation = &InvitationServiceMockAcceptInvitationExpectation{}
+	}
+
+	if mmAcceptInvitation.defaultExpectation.params != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Expect")
+	}
+
+	if mmAcceptInvitation.defaultExpectation.paramPtrs == nil {
+		mmAcceptInvitation.defaultExpectation.paramPtrs = &InvitationServiceMockAcceptInvitationParamPtrs{}
+	}
+	mmAcceptInvitation.defaultExpectation.paramPtrs.acceptInvitation = &acceptInvitation
+	mmAcceptInvitation.defaultExpectation.expectationOrigins.originAcceptInvitation = minimock.CallerInfo(1)
+
+	return mmAcceptInvitation
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationService.AcceptInvitation
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Inspect(f func(ctx context.Context, acceptInvitation *model.AcceptInvitation)) *mInvitationServiceMockAcceptInvitation {
+	if mmAcceptInvitation.mock.inspectFuncAcceptInvitation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("Inspect function is already set for InvitationServiceMock.AcceptInvitation")
+	}
+
+	mmAcceptInvitation.mock.inspectFuncAcceptInvitation = f
+
+	return mmAcceptInvitation
+}
+
+// Return sets up results that will be returned by InvitationService.AcceptInvitation
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Return(s1 string, s2 string, err error) *InvitationServiceMock {
+	if mmAcceptInvitation.mock.funcAcceptInvitation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Set")
+	}
+
+	if mmAcceptInvitation.defaultExpectation == nil {
+		mmAcceptInvitation.defaultExpectation = &InvitationServiceMockAcceptInvitationExpectation{mock: mmAcceptInvitation.mock}
+	}
+	mmAcceptInvitation.defaultExpectation.results = &InvitationServiceMockAcceptInvitationResults{s1, s2, err}
+	mmAcceptInvitation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAcceptInvitation.mock
+}
+
+// Set uses given function f to mock the InvitationService.AcceptInvitation method
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Set(f func(ctx context.Context, acceptInvitation *model.AcceptInvitation) (s1 string, s2 string, err error)) *InvitationServiceMock {
+	if mmAcceptInvitation.defaultExpectation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("Default expectation is already set for the InvitationService.AcceptInvitation method")
+	}
+
+	if len(mmAcceptInvitation.expectations) > 0 {
+		mmAcceptInvitation.mock.t.Fatalf("Some expectations are already set for the InvitationService.AcceptInvitation method")
+	}
+
+	mmAcceptInvitation.mock.funcAcceptInvitation = f
+	mmAcceptInvitation.mock.funcAcceptInvitationOrigin = minimock.CallerInfo(1)
+	return mmAcceptInvitation.mock
+}
+
+// When sets expectation for the InvitationService.AcceptInvitation which will trigger the result defined by the following
+// Then helper
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) When(ctx context.Context, acceptInvitation *model.AcceptInvitation) *InvitationServiceMockAcceptInvitationExpectation {
+	if mmAcceptInvitation.mock.funcAcceptInvitation != nil {
+		mmAcceptInvitation.mock.t.Fatalf("InvitationServiceMock.AcceptInvitation mock is already set by Set")
+	}
+
+	expectation := &InvitationServiceMockAcceptInvitationExpectation{
+		mock:               mmAcceptInvitation.mock,
+		params:             &InvitationServiceMockAcceptInvitationParams{ctx, acceptInvitation},
+		expectationOrigins: InvitationServiceMockAcceptInvitationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAcceptInvitation.expectations = append(mmAcceptInvitation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationService.AcceptInvitation return parameters for the expectation previously defined by the When method
+func (e *InvitationServiceMockAcceptInvitationExpectation) Then(s1 string, s2 string, err error) *InvitationServiceMock {
+	e.results = &InvitationServiceMockAcceptInvitationResults{s1, s2, err}
+	return e.mock
+}
+
+// Times sets number of times InvitationService.AcceptInvitation should be invoked
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Times(n uint64) *mInvitationServiceMockAcceptInvitation {
+	if n == 0 {
+		mmAcceptInvitation.mock.t.Fatalf("Times of InvitationServiceMock.AcceptInvitation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAcceptInvitation.expectedInvocations, n)
+	mmAcceptInvitation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAcceptInvitation
+}
+
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) invocationsDone() bool {
+	if len(mmAcceptInvitation.expectations) == 0 && mmAcceptInvitation.defaultExpectation == nil && mmAcceptInvitation.mock.funcAcceptInvitation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAcceptInvitation.mock.afterAcceptInvitationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAcceptInvitation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AcceptInvitation implements mm_service.InvitationService
+func (mmAcceptInvitation *InvitationServiceMock) AcceptInvitation(ctx context.Context, acceptInvitation *model.AcceptInvitation) (s1 string, s2 string, err error) {
+	mm_atomic.AddUint64(&mmAcceptInvitation.beforeAcceptInvitationCounter, 1)
+	defer mm_atomic.AddUint64(&mmAcceptInvitation.afterAcceptInvitationCounter, 1)
+
+	mmAcceptInvitation.t.Helper()
+
+	if mmAcceptInvitation.inspectFuncAcceptInvitation != nil {
+		mmAcceptInvitation.inspectFuncAcceptInvitation(ctx, acceptInvitation)
+	}
+
+	mm_params := InvitationServiceMockAcceptInvitationParams{ctx, acceptInvitation}
+
+	// Record call args
+	mmAcceptInvitation.AcceptInvitationMock.mutex.Lock()
+	mmAcceptInvitation.AcceptInvitationMock.callArgs = append(mmAcceptInvitation.AcceptInvitationMock.callArgs, &mm_params)
+	mmAcceptInvitation.AcceptInvitationMock.mutex.Unlock()
+
+	for _, e := range mmAcceptInvitation.AcceptInvitationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.s2, e.results.err
+		}
+	}
+
+	if mmAcceptInvitation.AcceptInvitationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.Counter, 1)
+		mm_want := mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.params
+		mm_want_ptrs := mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationServiceMockAcceptInvitationParams{ctx, acceptInvitation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAcceptInvitation.t.Errorf("InvitationServiceMock.AcceptInvitation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.acceptInvitation != nil && !minimock.Equal(*mm_want_ptrs.acceptInvitation, mm_got.acceptInvitation) {
+				mmAcceptInvitation.t.Errorf("InvitationServiceMock.AcceptInvitation got unexpected parameter acceptInvitation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.expectationOrigins.originAcceptInvitation, *mm_want_ptrs.acceptInvitation, mm_got.acceptInvitation, minimock.Diff(*mm_want_ptrs.acceptInvitation, mm_got.acceptInvitation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAcceptInvitation.t.Errorf("InvitationServiceMock.AcceptInvitation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAcceptInvitation.AcceptInvitationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAcceptInvitation.t.Fatal("No results are set for the InvitationServiceMock.AcceptInvitation")
+		}
+		return (*mm_results).s1, (*mm_results).s2, (*mm_results).err
+	}
+	if mmAcceptInvitation.funcAcceptInvitation != nil {
+		return mmAcceptInvitation.funcAcceptInvitation(ctx, acceptInvitation)
+	}
+	mmAcceptInvitation.t.Fatalf("Unexpected call to InvitationServiceMock.AcceptInvitation. %v %v", ctx, acceptInvitation)
+	return
+}
+
+// AcceptInvitationAfterCounter returns a count of finished InvitationServiceMock.AcceptInvitation invocations
+func (mmAcceptInvitation *InvitationServiceMock) AcceptInvitationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAcceptInvitation.afterAcceptInvitationCounter)
+}
+
+// AcceptInvitationBeforeCounter returns a count of InvitationServiceMock.AcceptInvitation invocations
+func (mmAcceptInvitation *InvitationServiceMock) AcceptInvitationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAcceptInvitation.beforeAcceptInvitationCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationServiceMock.AcceptInvitation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAcceptInvitation *mInvitationServiceMockAcceptInvitation) Calls() []*InvitationServiceMockAcceptInvitationParams {
+	mmAcceptInvitation.mutex.RLock()
+
+	argCopy := make([]*InvitationServiceMockAcceptInvitationParams, len(mmAcceptInvitation.callArgs))
+	copy(argCopy, mmAcceptInvitation.callArgs)
+
+	mmAcceptInvitation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcceptInvitationDone returns true if the count of the AcceptInvitation invocations corresponds
+// the number of defined expectations
+func (m *InvitationServiceMock) MinimockAcceptInvitationDone() bool {
+	if m.AcceptInvitationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcceptInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcceptInvitationMock.invocationsDone()
+}
+
+// MinimockAcceptInvitationInspect logs each unmet expectation
+func (m *InvitationServiceMock) MinimockAcceptInvitationInspect() {
+	for _, e := range m.AcceptInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationServiceMock.AcceptInvitation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAcceptInvitationCounter := mm_atomic.LoadUint64(&m.afterAcceptInvitationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcceptInvitationMock.defaultExpectation != nil && afterAcceptInvitationCounter < 1 {
+		if m.AcceptInvitationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationServiceMock.AcceptInvitation at\n%s", m.AcceptInvitationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationServiceMock.AcceptInvitation at\n%s with params: %#v", m.AcceptInvitationMock.defaultExpectation.expectationOrigins.origin, *m.AcceptInvitationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAcceptInvitation != nil && afterAcceptInvitationCounter < 1 {
+		m.t.Errorf("Expected call to InvitationServiceMock.AcceptInvitation at\n%s", m.funcAcceptInvitationOrigin)
+	}
+
+	if !m.AcceptInvitationMock.invocationsDone() && afterAcceptInvitationCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationServiceMock.AcceptInvitation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AcceptInvitationMock.expectedInvocations), m.AcceptInvitationMock.expectedInvocationsOrigin, afterAcceptInvitationCounter)
+	}
+}
+
+type mInvitationServiceMockInviteUser struct {
+	optional           bool
+	mock               *InvitationServiceMock
+	defaultExpectation *InvitationServiceMockInviteUserExpectation
+	expectations       []*InvitationServiceMockInviteUserExpectation
+
+	callArgs []*InvitationServiceMockInviteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationServiceMockInviteUserExpectation specifies expectation struct of the InvitationService.InviteUser
+type InvitationServiceMockInviteUserExpectation struct {
+	mock               *InvitationServiceMock
+	params             *InvitationServiceMockInviteUserParams
+	paramPtrs          *InvitationServiceMockInviteUserParamPtrs
+	expectationOrigins InvitationServiceMockInviteUserExpectationOrigins
+	results            *InvitationServiceMockInviteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationServiceMockInviteUserParams contains parameters of the InvitationService.InviteUser
+type InvitationServiceMockInviteUserParams struct {
+	ctx           context.Context
+	newInvitation *model.NewInvitation
+}
+
+// InvitationServiceMockInviteUserParamPtrs contains pointers to parameters of the InvitationService.InviteUser
+type InvitationServiceMockInviteUserParamPtrs struct {
+	ctx           *context.Context
+	newInvitation **model.NewInvitation
+}
+
+// InvitationServiceMockInviteUserResults contains results of the InvitationService.InviteUser
+type InvitationServiceMockInviteUserResults struct {
+	ip1 *model.Invitation
+	err error
+}
+
+// InvitationServiceMockInviteUserOrigins contains origins of expectations of the InvitationService.InviteUser
+type InvitationServiceMockInviteUserExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originNewInvitation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInviteUser *mInvitationServiceMockInviteUser) Optional() *mInvitationServiceMockInviteUser {
+	mmInviteUser.optional = true
+	return mmInviteUser
+}
+
+// Expect sets up expected params for InvitationService.InviteUser
+func (mmInviteUser *mInvitationServiceMockInviteUser) Expect(ctx context.Context, newInvitation *model.NewInvitation) *mInvitationServiceMockInviteUser {
+	if mmInviteUser.mock.funcInviteUser != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Set")
+	}
+
+	if mmInviteUser.defaultExpectation == nil {
+		mmInviteUser.defaultExpectation = &InvitationServiceMockInviteUserExpectation{}
+	}
+
+	if mmInviteUser.defaultExpectation.paramPtrs != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by ExpectParams functions")
+	}
+
+	mmInviteUser.defaultExpectation.params = &InvitationServiceMockInviteUserParams{ctx, newInvitation}
+	mmInviteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInviteUser.expectations {
+		if minimock.Equal(e.params, mmInviteUser.defaultExpectation.params) {
+			mmInviteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInviteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmInviteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationService.InviteUser
+func (mmInviteUser *mInvitationServiceMockInviteUser) ExpectCtxParam1(ctx context.Context) *mInvitationServiceMockInviteUser {
+	if mmInviteUser.mock.funcInviteUser != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Set")
+	}
+
+	if mmInviteUser.defaultExpectation == nil {
+		mmInviteUser.defaultExpectation = &InvitationServiceMockInviteUserExpectation{}
+	}
+
+	if mmInviteUser.defaultExpectation.params != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Expect")
+	}
+
+	if mmInviteUser.defaultExpectation.paramPtrs == nil {
+		mmInviteUser.defaultExpectation.paramPtrs = &InvitationServiceMockInviteUserParamPtrs{}
+	}
+	mmInviteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmInviteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmInviteUser
+}
+
+// ExpectNewInvitationParam2 sets up expected param newInvitation for InvitationService.InviteUser
+func (mmInviteUser *mInvitationServiceMockInviteUser) ExpectNewInvitationParam2(newInvitation *model.NewInvitation) *mInvitationServiceMockInviteUser {
+	if mmInviteUser.mock.funcInviteUser != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Set")
+	}
+
+	if mmInviteUser.defaultExpectation == nil {
+		mmInviteUser.defaultExpectation = &InvitationServiceMockInviteUserExpectation{}
+	}
+
+	if mmInviteUser.defaultExpectation.params != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Expect")
+	}
+
+	if mmInviteUser.defaultExpectation.paramPtrs == nil {
+		mmInviteUser.defaultExpectation.paramPtrs = &InvitationServiceMockInviteUserParamPtrs{}
+	}
+	mmInviteUser.defaultExpectation.paramPtrs.newInvitation = &newInvitation
+	mmInviteUser.defaultExpectation.expectationOrigins.originNewInvitation = minimock.CallerInfo(1)
+
+	return mmInviteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationService.InviteUser
+func (mmInviteUser *mInvitationServiceMockInviteUser) Inspect(f func(ctx context.Context, newInvitation *model.NewInvitation)) *mInvitationServiceMockInviteUser {
+	if mmInviteUser.mock.inspectFuncInviteUser != nil {
+		mmInviteUser.mock.t.Fatalf("Inspect function is already set for InvitationServiceMock.InviteUser")
+	}
+
+	mmInviteUser.mock.inspectFuncInviteUser = f
+
+	return mmInviteUser
+}
+
+// Return sets up results that will be returned by InvitationService.InviteUser
+func (mmInviteUser *mInvitationServiceMockInviteUser) Return(ip1 *model.Invitation, err error) *InvitationServiceMock {
+	if mmInviteUser.mock.funcInviteUser != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Set")
+	}
+
+	if mmInviteUser.defaultExpectation == nil {
+		mmInviteUser.defaultExpectation = &InvitationServiceMockInviteUserExpectation{mock: mmInviteUser.mock}
+	}
+	mmInviteUser.defaultExpectation.results = &InvitationServiceMockInviteUserResults{ip1, err}
+	mmInviteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInviteUser.mock
+}
+
+// Set uses given function f to mock the InvitationService.InviteUser method
+func (mmInviteUser *mInvitationServiceMockInviteUser) Set(f func(ctx context.Context, newInvitation *model.NewInvitation) (ip1 *model.Invitation, err error)) *InvitationServiceMock {
+	if mmInviteUser.defaultExpectation != nil {
+		mmInviteUser.mock.t.Fatalf("Default expectation is already set for the InvitationService.InviteUser method")
+	}
+
+	if len(mmInviteUser.expectations) > 0 {
+		mmInviteUser.mock.t.Fatalf("Some expectations are already set for the InvitationService.InviteUser method")
+	}
+
+	mmInviteUser.mock.funcInviteUser = f
+	mmInviteUser.mock.funcInviteUserOrigin = minimock.CallerInfo(1)
+	return mmInviteUser.mock
+}
+
+// When sets expectation for the InvitationService.InviteUser which will trigger the result defined by the following
+// Then helper
+func (mmInviteUser *mInvitationServiceMockInviteUser) When(ctx context.Context, newInvitation *model.NewInvitation) *InvitationServiceMockInviteUserExpectation {
+	if mmInviteUser.mock.funcInviteUser != nil {
+		mmInviteUser.mock.t.Fatalf("InvitationServiceMock.InviteUser mock is already set by Set")
+	}
+
+	expectation := &InvitationServiceMockInviteUserExpectation{
+		mock:               mmInviteUser.mock,
+		params:             &InvitationServiceMockInviteUserParams{ctx, newInvitation},
+		expectationOrigins: InvitationServiceMockInviteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmInviteUser.expectations = append(mmInviteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationService.InviteUser return parameters for the expectation previously defined by the When method
+func (e *InvitationServiceMockInviteUserExpectation) Then(ip1 *model.Invitation, err error) *InvitationServiceMock {
+	e.results = &InvitationServiceMockInviteUserResults{ip1, err}
+	return e.mock
+}
+
+// Times sets number of times InvitationService.InviteUser should be invoked
+func (mmInviteUser *mInvitationServiceMockInviteUser) Times(n uint64) *mInvitationServiceMockInviteUser {
+	if n == 0 {
+		mmInviteUser.mock.t.Fatalf("Times of InvitationServiceMock.InviteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInviteUser.expectedInvocations, n)
+	mmInviteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInviteUser
+}
+
+func (mmInviteUser *mInvitationServiceMockInviteUser) invocationsDone() bool {
+	if len(mmInviteUser.expectations) == 0 && mmInviteUser.defaultExpectation == nil && mmInviteUser.mock.funcInviteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInviteUser.mock.afterInviteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInviteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// InviteUser implements mm_service.InvitationService
+func (mmInviteUser *InvitationServiceMock) InviteUser(ctx context.Context, newInvitation *model.NewInvitation) (ip1 *model.Invitation, err error) {
+	mm_atomic.AddUint64(&mmInviteUser.beforeInviteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmInviteUser.afterInviteUserCounter, 1)
+
+	mmInviteUser.t.Helper()
+
+	if mmInviteUser.inspectFuncInviteUser != nil {
+		mmInviteUser.inspectFuncInviteUser(ctx, newInvitation)
+	}
+
+	mm_params := InvitationServiceMockInviteUserParams{ctx, newInvitation}
+
+	// Record call args
+	mmInviteUser.InviteUserMock.mutex.Lock()
+	mmInviteUser.InviteUserMock.callArgs = append(mmInviteUser.InviteUserMock.callArgs, &mm_params)
+	mmInviteUser.InviteUserMock.mutex.Unlock()
+
+	for _, e := range mmInviteUser.InviteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.err
+		}
+	}
+
+	if mmInviteUser.InviteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInviteUser.InviteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmInviteUser.InviteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmInviteUser.InviteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationServiceMockInviteUserParams{ctx, newInvitation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInviteUser.t.Errorf("InvitationServiceMock.InviteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInviteUser.InviteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.newInvitation != nil && !minimock.Equal(*mm_want_ptrs.newInvitation, mm_got.newInvitation) {
+				mmInviteUser.t.Errorf("InvitationServiceMock.InviteUser got unexpected parameter newInvitation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInviteUser.InviteUserMock.defaultExpectation.expectationOrigins.originNewInvitation, *mm_want_ptrs.newInvitation, mm_got.newInvitation, minimock.Diff(*mm_want_ptrs.newInvitation, mm_got.newInvitation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInviteUser.t.Errorf("InvitationServiceMock.InviteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInviteUser.InviteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInviteUser.InviteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInviteUser.t.Fatal("No results are set for the InvitationServiceMock.InviteUser")
+		}
+		return (*mm_results).ip1, (*mm_results).err
+	}
+	if mmInviteUser.funcInviteUser != nil {
+		return mmInviteUser.funcInviteUser(ctx, newInvitation)
+	}
+	mmInviteUser.t.Fatalf("Unexpected call to InvitationServiceMock.InviteUser. %v %v", ctx, newInvitation)
+	return
+}
+
+// InviteUserAfterCounter returns a count of finished InvitationServiceMock.InviteUser invocations
+func (mmInviteUser *InvitationServiceMock) InviteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInviteUser.afterInviteUserCounter)
+}
+
+// InviteUserBeforeCounter returns a count of InvitationServiceMock.InviteUser invocations
+func (mmInviteUser *InvitationServiceMock) InviteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInviteUser.beforeInviteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationServiceMock.InviteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInviteUser *mInvitationServiceMockInviteUser) Calls() []*InvitationServiceMockInviteUserParams {
+	mmInviteUser.mutex.RLock()
+
+	argCopy := make([]*InvitationServiceMockInviteUserParams, len(mmInviteUser.callArgs))
+	copy(argCopy, mmInviteUser.callArgs)
+
+	mmInviteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInviteUserDone returns true if the count of the InviteUser invocations corresponds
+// the number of defined expectations
+func (m *InvitationServiceMock) MinimockInviteUserDone() bool {
+	if m.InviteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InviteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InviteUserMock.invocationsDone()
+}
+
+// MinimockInviteUserInspect logs each unmet expectation
+func (m *InvitationServiceMock) MinimockInviteUserInspect() {
+	for _, e := range m.InviteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationServiceMock.InviteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterInviteUserCounter := mm_atomic.LoadUint64(&m.afterInviteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InviteUserMock.defaultExpectation != nil && afterInviteUserCounter < 1 {
+		if m.InviteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationServiceMock.InviteUser at\n%s", m.InviteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationServiceMock.InviteUser at\n%s with params: %#v", m.InviteUserMock.defaultExpectation.expectationOrigins.origin, *m.InviteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInviteUser != nil && afterInviteUserCounter < 1 {
+		m.t.Errorf("Expected call to InvitationServiceMock.InviteUser at\n%s", m.funcInviteUserOrigin)
+	}
+
+	if !m.InviteUserMock.invocationsDone() && afterInviteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationServiceMock.InviteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InviteUserMock.expectedInvocations), m.InviteUserMock.expectedInvocationsOrigin, afterInviteUserCounter)
+	}
+}
+
+type mInvitationServiceMockResendInvitation struct {
+	optional           bool
+	mock               *InvitationServiceMock
+	defaultExpectation *InvitationServiceMockResendInvitationExpectation
+	expectations       []*InvitationServiceMockResendInvitationExpectation
+
+	callArgs []*InvitationServiceMockResendInvitationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationServiceMockResendInvitationExpectation specifies expectation struct of the InvitationService.ResendInvitation
+type InvitationServiceMockResendInvitationExpectation struct {
+	mock               *InvitationServiceMock
+	params             *InvitationServiceMockResendInvitationParams
+	paramPtrs          *InvitationServiceMockResendInvitationParamPtrs
+	expectationOrigins InvitationServiceMockResendInvitationExpectationOrigins
+	results            *InvitationServiceMockResendInvitationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationServiceMockResendInvitationParams contains parameters of the InvitationService.ResendInvitation
+type InvitationServiceMockResendInvitationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// InvitationServiceMockResendInvitationParamPtrs contains pointers to parameters of the InvitationService.ResendInvitation
+type InvitationServiceMockResendInvitationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// InvitationServiceMockResendInvitationResults contains results of the InvitationService.ResendInvitation
+type InvitationServiceMockResendInvitationResults struct {
+	err error
+}
+
+// InvitationServiceMockResendInvitationOrigins contains origins of expectations of the InvitationService.ResendInvitation
+type InvitationServiceMockResendInvitationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Optional() *mInvitationServiceMockResendInvitation {
+	mmResendInvitation.optional = true
+	return mmResendInvitation
+}
+
+// Expect sets up expected params for InvitationService.ResendInvitation
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Expect(ctx context.Context, id int64) *mInvitationServiceMockResendInvitation {
+	if mmResendInvitation.mock.funcResendInvitation != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Set")
+	}
+
+	if mmResendInvitation.defaultExpectation == nil {
+		mmResendInvitation.defaultExpectation = &InvitationServiceMockResendInvitationExpectation{}
+	}
+
+	if mmResendInvitation.defaultExpectation.paramPtrs != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by ExpectParams functions")
+	}
+
+	mmResendInvitation.defaultExpectation.params = &InvitationServiceMockResendInvitationParams{ctx, id}
+	mmResendInvitation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResendInvitation.expectations {
+		if minimock.Equal(e.params, mmResendInvitation.defaultExpectation.params) {
+			mmResendInvitation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResendInvitation.defaultExpectation.params)
+		}
+	}
+
+	return mmResendInvitation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationService.ResendInvitation
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) ExpectCtxParam1(ctx context.Context) *mInvitationServiceMockResendInvitation {
+	if mmResendInvitation.mock.funcResendInvitation != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Set")
+	}
+
+	if mmResendInvitation.defaultExpectation == nil {
+		mmResendInvitation.defaultExpectation = &InvitationServiceMockResendInvitationExpectation{}
+	}
+
+	if mmResendInvitation.defaultExpectation.params != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Expect")
+	}
+
+	if mmResendInvitation.defaultExpectation.paramPtrs == nil {
+		mmResendInvitation.defaultExpectation.paramPtrs = &InvitationServiceMockResendInvitationParamPtrs{}
+	}
+	mmResendInvitation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResendInvitation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmResendInvitation
+}
+
+// ExpectIdParam2 sets up expected param id for InvitationService.ResendInvitation
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) ExpectIdParam2(id int64) *mInvitationServiceMockResendInvitation {
+	if mmResendInvitation.mock.funcResendInvitation != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Set")
+	}
+
+	if mmResendInvitation.defaultExpectation == nil {
+		mmResendInvitation.defaultExpectation = &InvitationServiceMockResendInvitationExpectation{}
+	}
+
+	if mmResendInvitation.defaultExpectation.params != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Expect")
+	}
+
+	if mmResendInvitation.defaultExpectation.paramPtrs == nil {
+		mmResendInvitation.defaultExpectation.paramPtrs = &InvitationServiceMockResendInvitationParamPtrs{}
+	}
+	mmResendInvitation.defaultExpectation.paramPtrs.id = &id
+	mmResendInvitation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmResendInvitation
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationService.ResendInvitation
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Inspect(f func(ctx context.Context, id int64)) *mInvitationServiceMockResendInvitation {
+	if mmResendInvitation.mock.inspectFuncResendInvitation != nil {
+		mmResendInvitation.mock.t.Fatalf("Inspect function is already set for InvitationServiceMock.ResendInvitation")
+	}
+
+	mmResendInvitation.mock.inspectFuncResendInvitation = f
+
+	return mmResendInvitation
+}
+
+// Return sets up results that will be returned by InvitationService.ResendInvitation
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Return(err error) *InvitationServiceMock {
+	if mmResendInvitation.mock.funcResendInvitation != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Set")
+	}
+
+	if mmResendInvitation.defaultExpectation == nil {
+		mmResendInvitation.defaultExpectation = &InvitationServiceMockResendInvitationExpectation{mock: mmResendInvitation.mock}
+	}
+	mmResendInvitation.defaultExpectation.results = &InvitationServiceMockResendInvitationResults{err}
+	mmResendInvitation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResendInvitation.mock
+}
+
+// Set uses given function f to mock the InvitationService.ResendInvitation method
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Set(f func(ctx context.Context, id int64) (err error)) *InvitationServiceMock {
+	if mmResendInvitation.defaultExpectation != nil {
+		mmResendInvitation.mock.t.Fatalf("Default expectation is already set for the InvitationService.ResendInvitation method")
+	}
+
+	if len(mmResendInvitation.expectations) > 0 {
+		mmResendInvitation.mock.t.Fatalf("Some expectations are already set for the InvitationService.ResendInvitation method")
+	}
+
+	mmResendInvitation.mock.funcResendInvitation = f
+	mmResendInvitation.mock.funcResendInvitationOrigin = minimock.CallerInfo(1)
+	return mmResendInvitation.mock
+}
+
+// When sets expectation for the InvitationService.ResendInvitation which will trigger the result defined by the following
+// Then helper
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) When(ctx context.Context, id int64) *InvitationServiceMockResendInvitationExpectation {
+	if mmResendInvitation.mock.funcResendInvitation != nil {
+		mmResendInvitation.mock.t.Fatalf("InvitationServiceMock.ResendInvitation mock is already set by Set")
+	}
+
+	expectation := &InvitationServiceMockResendInvitationExpectation{
+		mock:               mmResendInvitation.mock,
+		params:             &InvitationServiceMockResendInvitationParams{ctx, id},
+		expectationOrigins: InvitationServiceMockResendInvitationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmResendInvitation.expectations = append(mmResendInvitation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationService.ResendInvitation return parameters for the expectation previously defined by the When method
+func (e *InvitationServiceMockResendInvitationExpectation) Then(err error) *InvitationServiceMock {
+	e.results = &InvitationServiceMockResendInvitationResults{err}
+	return e.mock
+}
+
+// Times sets number of times InvitationService.ResendInvitation should be invoked
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Times(n uint64) *mInvitationServiceMockResendInvitation {
+	if n == 0 {
+		mmResendInvitation.mock.t.Fatalf("Times of InvitationServiceMock.ResendInvitation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResendInvitation.expectedInvocations, n)
+	mmResendInvitation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResendInvitation
+}
+
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) invocationsDone() bool {
+	if len(mmResendInvitation.expectations) == 0 && mmResendInvitation.defaultExpectation == nil && mmResendInvitation.mock.funcResendInvitation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResendInvitation.mock.afterResendInvitationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResendInvitation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResendInvitation implements mm_service.InvitationService
+func (mmResendInvitation *InvitationServiceMock) ResendInvitation(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmResendInvitation.beforeResendInvitationCounter, 1)
+	defer mm_atomic.AddUint64(&mmResendInvitation.afterResendInvitationCounter, 1)
+
+	mmResendInvitation.t.Helper()
+
+	if mmResendInvitation.inspectFuncResendInvitation != nil {
+		mmResendInvitation.inspectFuncResendInvitation(ctx, id)
+	}
+
+	mm_params := InvitationServiceMockResendInvitationParams{ctx, id}
+
+	// Record call args
+	mmResendInvitation.ResendInvitationMock.mutex.Lock()
+	mmResendInvitation.ResendInvitationMock.callArgs = append(mmResendInvitation.ResendInvitationMock.callArgs, &mm_params)
+	mmResendInvitation.ResendInvitationMock.mutex.Unlock()
+
+	for _, e := range mmResendInvitation.ResendInvitationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResendInvitation.ResendInvitationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResendInvitation.ResendInvitationMock.defaultExpectation.Counter, 1)
+		mm_want := mmResendInvitation.ResendInvitationMock.defaultExpectation.params
+		mm_want_ptrs := mmResendInvitation.ResendInvitationMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationServiceMockResendInvitationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResendInvitation.t.Errorf("InvitationServiceMock.ResendInvitation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResendInvitation.ResendInvitationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmResendInvitation.t.Errorf("InvitationServiceMock.ResendInvitation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResendInvitation.ResendInvitationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResendInvitation.t.Errorf("InvitationServiceMock.ResendInvitation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResendInvitation.ResendInvitationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResendInvitation.ResendInvitationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResendInvitation.t.Fatal("No results are set for the InvitationServiceMock.ResendInvitation")
+		}
+		return (*mm_results).err
+	}
+	if mmResendInvitation.funcResendInvitation != nil {
+		return mmResendInvitation.funcResendInvitation(ctx, id)
+	}
+	mmResendInvitation.t.Fatalf("Unexpected call to InvitationServiceMock.ResendInvitation. %v %v", ctx, id)
+	return
+}
+
+// ResendInvitationAfterCounter returns a count of finished InvitationServiceMock.ResendInvitation invocations
+func (mmResendInvitation *InvitationServiceMock) ResendInvitationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResendInvitation.afterResendInvitationCounter)
+}
+
+// ResendInvitationBeforeCounter returns a count of InvitationServiceMock.ResendInvitation invocations
+func (mmResendInvitation *InvitationServiceMock) ResendInvitationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResendInvitation.beforeResendInvitationCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationServiceMock.ResendInvitation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResendInvitation *mInvitationServiceMockResendInvitation) Calls() []*InvitationServiceMockResendInvitationParams {
+	mmResendInvitation.mutex.RLock()
+
+	argCopy := make([]*InvitationServiceMockResendInvitationParams, len(mmResendInvitation.callArgs))
+	copy(argCopy, mmResendInvitation.callArgs)
+
+	mmResendInvitation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResendInvitationDone returns true if the count of the ResendInvitation invocations corresponds
+// the number of defined expectations
+func (m *InvitationServiceMock) MinimockResendInvitationDone() bool {
+	if m.ResendInvitationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResendInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResendInvitationMock.invocationsDone()
+}
+
+// MinimockResendInvitationInspect logs each unmet expectation
+func (m *InvitationServiceMock) MinimockResendInvitationInspect() {
+	for _, e := range m.ResendInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationServiceMock.ResendInvitation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResendInvitationCounter := mm_atomic.LoadUint64(&m.afterResendInvitationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResendInvitationMock.defaultExpectation != nil && afterResendInvitationCounter < 1 {
+		if m.ResendInvitationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationServiceMock.ResendInvitation at\n%s", m.ResendInvitationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationServiceMock.ResendInvitation at\n%s with params: %#v", m.ResendInvitationMock.defaultExpectation.expectationOrigins.origin, *m.ResendInvitationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResendInvitation != nil && afterResendInvitationCounter < 1 {
+		m.t.Errorf("Expected call to InvitationServiceMock.ResendInvitation at\n%s", m.funcResendInvitationOrigin)
+	}
+
+	if !m.ResendInvitationMock.invocationsDone() && afterResendInvitationCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationServiceMock.ResendInvitation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResendInvitationMock.expectedInvocations), m.ResendInvitationMock.expectedInvocationsOrigin, afterResendInvitationCounter)
+	}
+}
+
+type mInvitationServiceMockRevokeInvitation struct {
+	optional           bool
+	mock               *InvitationServiceMock
+	defaultExpectation *InvitationServiceMockRevokeInvitationExpectation
+	expectations       []*InvitationServiceMockRevokeInvitationExpectation
+
+	callArgs []*InvitationServiceMockRevokeInvitationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// InvitationServiceMockRevokeInvitationExpectation specifies expectation struct of the InvitationService.RevokeInvitation
+type InvitationServiceMockRevokeInvitationExpectation struct {
+	mock               *InvitationServiceMock
+	params             *InvitationServiceMockRevokeInvitationParams
+	paramPtrs          *InvitationServiceMockRevokeInvitationParamPtrs
+	expectationOrigins InvitationServiceMockRevokeInvitationExpectationOrigins
+	results            *InvitationServiceMockRevokeInvitationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// InvitationServiceMockRevokeInvitationParams contains parameters of the InvitationService.RevokeInvitation
+type InvitationServiceMockRevokeInvitationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// InvitationServiceMockRevokeInvitationParamPtrs contains pointers to parameters of the InvitationService.RevokeInvitation
+type InvitationServiceMockRevokeInvitationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// InvitationServiceMockRevokeInvitationResults contains results of the InvitationService.RevokeInvitation
+type InvitationServiceMockRevokeInvitationResults struct {
+	err error
+}
+
+// InvitationServiceMockRevokeInvitationOrigins contains origins of expectations of the InvitationService.RevokeInvitation
+type InvitationServiceMockRevokeInvitationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Optional() *mInvitationServiceMockRevokeInvitation {
+	mmRevokeInvitation.optional = true
+	return mmRevokeInvitation
+}
+
+// Expect sets up expected params for InvitationService.RevokeInvitation
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Expect(ctx context.Context, id int64) *mInvitationServiceMockRevokeInvitation {
+	if mmRevokeInvitation.mock.funcRevokeInvitation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Set")
+	}
+
+	if mmRevokeInvitation.defaultExpectation == nil {
+		mmRevokeInvitation.defaultExpectation = &InvitationServiceMockRevokeInvitationExpectation{}
+	}
+
+	if mmRevokeInvitation.defaultExpectation.paramPtrs != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeInvitation.defaultExpectation.params = &InvitationServiceMockRevokeInvitationParams{ctx, id}
+	mmRevokeInvitation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeInvitation.expectations {
+		if minimock.Equal(e.params, mmRevokeInvitation.defaultExpectation.params) {
+			mmRevokeInvitation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeInvitation.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeInvitation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for InvitationService.RevokeInvitation
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) ExpectCtxParam1(ctx context.Context) *mInvitationServiceMockRevokeInvitation {
+	if mmRevokeInvitation.mock.funcRevokeInvitation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Set")
+	}
+
+	if mmRevokeInvitation.defaultExpectation == nil {
+		mmRevokeInvitation.defaultExpectation = &InvitationServiceMockRevokeInvitationExpectation{}
+	}
+
+	if mmRevokeInvitation.defaultExpectation.params != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Expect")
+	}
+
+	if mmRevokeInvitation.defaultExpectation.paramPtrs == nil {
+		mmRevokeInvitation.defaultExpectation.paramPtrs = &InvitationServiceMockRevokeInvitationParamPtrs{}
+	}
+	mmRevokeInvitation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeInvitation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeInvitation
+}
+
+// ExpectIdParam2 sets up expected param id for InvitationService.RevokeInvitation
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) ExpectIdParam2(id int64) *mInvitationServiceMockRevokeInvitation {
+	if mmRevokeInvitation.mock.funcRevokeInvitation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Set")
+	}
+
+	if mmRevokeInvitation.defaultExpectation == nil {
+		mmRevokeInvitation.defaultExpectation = &InvitationServiceMockRevokeInvitationExpectation{}
+	}
+
+	if mmRevokeInvitation.defaultExpectation.params != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Expect")
+	}
+
+	if mmRevokeInvitation.defaultExpectation.paramPtrs == nil {
+		mmRevokeInvitation.defaultExpectation.paramPtrs = &InvitationServiceMockRevokeInvitationParamPtrs{}
+	}
+	mmRevokeInvitation.defaultExpectation.paramPtrs.id = &id
+	mmRevokeInvitation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRevokeInvitation
+}
+
+// Inspect accepts an inspector function that has same arguments as the InvitationService.RevokeInvitation
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Inspect(f func(ctx context.Context, id int64)) *mInvitationServiceMockRevokeInvitation {
+	if mmRevokeInvitation.mock.inspectFuncRevokeInvitation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("Inspect function is already set for InvitationServiceMock.RevokeInvitation")
+	}
+
+	mmRevokeInvitation.mock.inspectFuncRevokeInvitation = f
+
+	return mmRevokeInvitation
+}
+
+// Return sets up results that will be returned by InvitationService.RevokeInvitation
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Return(err error) *InvitationServiceMock {
+	if mmRevokeInvitation.mock.funcRevokeInvitation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Set")
+	}
+
+	if mmRevokeInvitation.defaultExpectation == nil {
+		mmRevokeInvitation.defaultExpectation = &InvitationServiceMockRevokeInvitationExpectation{mock: mmRevokeInvitation.mock}
+	}
+	mmRevokeInvitation.defaultExpectation.results = &InvitationServiceMockRevokeInvitationResults{err}
+	mmRevokeInvitation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeInvitation.mock
+}
+
+// Set uses given function f to mock the InvitationService.RevokeInvitation method
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Set(f func(ctx context.Context, id int64) (err error)) *InvitationServiceMock {
+	if mmRevokeInvitation.defaultExpectation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("Default expectation is already set for the InvitationService.RevokeInvitation method")
+	}
+
+	if len(mmRevokeInvitation.expectations) > 0 {
+		mmRevokeInvitation.mock.t.Fatalf("Some expectations are already set for the InvitationService.RevokeInvitation method")
+	}
+
+	mmRevokeInvitation.mock.funcRevokeInvitation = f
+	mmRevokeInvitation.mock.funcRevokeInvitationOrigin = minimock.CallerInfo(1)
+	return mmRevokeInvitation.mock
+}
+
+// When sets expectation for the InvitationService.RevokeInvitation which will trigger the result defined by the following
+// Then helper
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) When(ctx context.Context, id int64) *InvitationServiceMockRevokeInvitationExpectation {
+	if mmRevokeInvitation.mock.funcRevokeInvitation != nil {
+		mmRevokeInvitation.mock.t.Fatalf("InvitationServiceMock.RevokeInvitation mock is already set by Set")
+	}
+
+	expectation := &InvitationServiceMockRevokeInvitationExpectation{
+		mock:               mmRevokeInvitation.mock,
+		params:             &InvitationServiceMockRevokeInvitationParams{ctx, id},
+		expectationOrigins: InvitationServiceMockRevokeInvitationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeInvitation.expectations = append(mmRevokeInvitation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up InvitationService.RevokeInvitation return parameters for the expectation previously defined by the When method
+func (e *InvitationServiceMockRevokeInvitationExpectation) Then(err error) *InvitationServiceMock {
+	e.results = &InvitationServiceMockRevokeInvitationResults{err}
+	return e.mock
+}
+
+// Times sets number of times InvitationService.RevokeInvitation should be invoked
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Times(n uint64) *mInvitationServiceMockRevokeInvitation {
+	if n == 0 {
+		mmRevokeInvitation.mock.t.Fatalf("Times of InvitationServiceMock.RevokeInvitation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeInvitation.expectedInvocations, n)
+	mmRevokeInvitation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeInvitation
+}
+
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) invocationsDone() bool {
+	if len(mmRevokeInvitation.expectations) == 0 && mmRevokeInvitation.defaultExpectation == nil && mmRevokeInvitation.mock.funcRevokeInvitation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeInvitation.mock.afterRevokeInvitationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeInvitation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeInvitation implements mm_service.InvitationService
+func (mmRevokeInvitation *InvitationServiceMock) RevokeInvitation(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeInvitation.beforeRevokeInvitationCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeInvitation.afterRevokeInvitationCounter, 1)
+
+	mmRevokeInvitation.t.Helper()
+
+	if mmRevokeInvitation.inspectFuncRevokeInvitation != nil {
+		mmRevokeInvitation.inspectFuncRevokeInvitation(ctx, id)
+	}
+
+	mm_params := InvitationServiceMockRevokeInvitationParams{ctx, id}
+
+	// Record call args
+	mmRevokeInvitation.RevokeInvitationMock.mutex.Lock()
+	mmRevokeInvitation.RevokeInvitationMock.callArgs = append(mmRevokeInvitation.RevokeInvitationMock.callArgs, &mm_params)
+	mmRevokeInvitation.RevokeInvitationMock.mutex.Unlock()
+
+	for _, e := range mmRevokeInvitation.RevokeInvitationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeInvitation.RevokeInvitationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.paramPtrs
+
+		mm_got := InvitationServiceMockRevokeInvitationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeInvitation.t.Errorf("InvitationServiceMock.RevokeInvitation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeInvitation.t.Errorf("InvitationServiceMock.RevokeInvitation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeInvitation.t.Errorf("InvitationServiceMock.RevokeInvitation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeInvitation.RevokeInvitationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeInvitation.t.Fatal("No results are set for the InvitationServiceMock.RevokeInvitation")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeInvitation.funcRevokeInvitation != nil {
+		return mmRevokeInvitation.funcRevokeInvitation(ctx, id)
+	}
+	mmRevokeInvitation.t.Fatalf("Unexpected call to InvitationServiceMock.RevokeInvitation. %v %v", ctx, id)
+	return
+}
+
+// RevokeInvitationAfterCounter returns a count of finished InvitationServiceMock.RevokeInvitation invocations
+func (mmRevokeInvitation *InvitationServiceMock) RevokeInvitationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeInvitation.afterRevokeInvitationCounter)
+}
+
+// RevokeInvitationBeforeCounter returns a count of InvitationServiceMock.RevokeInvitation invocations
+func (mmRevokeInvitation *InvitationServiceMock) RevokeInvitationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeInvitation.beforeRevokeInvitationCounter)
+}
+
+// Calls returns a list of arguments used in each call to InvitationServiceMock.RevokeInvitation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeInvitation *mInvitationServiceMockRevokeInvitation) Calls() []*InvitationServiceMockRevokeInvitationParams {
+	mmRevokeInvitation.mutex.RLock()
+
+	argCopy := make([]*InvitationServiceMockRevokeInvitationParams, len(mmRevokeInvitation.callArgs))
+	copy(argCopy, mmRevokeInvitation.callArgs)
+
+	mmRevokeInvitation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeInvitationDone returns true if the count of the RevokeInvitation invocations corresponds
+// the number of defined expectations
+func (m *InvitationServiceMock) MinimockRevokeInvitationDone() bool {
+	if m.RevokeInvitationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeInvitationMock.invocationsDone()
+}
+
+// MinimockRevokeInvitationInspect logs each unmet expectation
+func (m *InvitationServiceMock) MinimockRevokeInvitationInspect() {
+	for _, e := range m.RevokeInvitationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InvitationServiceMock.RevokeInvitation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeInvitationCounter := mm_atomic.LoadUint64(&m.afterRevokeInvitationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeInvitationMock.defaultExpectation != nil && afterRevokeInvitationCounter < 1 {
+		if m.RevokeInvitationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to InvitationServiceMock.RevokeInvitation at\n%s", m.RevokeInvitationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to InvitationServiceMock.RevokeInvitation at\n%s with params: %#v", m.RevokeInvitationMock.defaultExpectation.expectationOrigins.origin, *m.RevokeInvitationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeInvitation != nil && afterRevokeInvitationCounter < 1 {
+		m.t.Errorf("Expected call to InvitationServiceMock.RevokeInvitation at\n%s", m.funcRevokeInvitationOrigin)
+	}
+
+	if !m.RevokeInvitationMock.invocationsDone() && afterRevokeInvitationCounter > 0 {
+		m.t.Errorf("Expected %d calls to InvitationServiceMock.RevokeInvitation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeInvitationMock.expectedInvocations), m.RevokeInvitationMock.expectedInvocationsOrigin, afterRevokeInvitationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *InvitationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcceptInvitationInspect()
+
+			m.MinimockInviteUserInspect()
+
+			m.MinimockResendInvitationInspect()
+
+			m.MinimockRevokeInvitationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *InvitationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *InvitationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcceptInvitationDone() &&
+		m.MinimockInviteUserDone() &&
+		m.MinimockResendInvitationDone() &&
+		m.MinimockRevokeInvitationDone()
+}
@@ -0,0 +1,1191 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.OAuthClientService -o o_auth_client_service_minimock.go -n OAuthClientServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// OAuthClientServiceMock implements mm_service.OAuthClientService
+type OAuthClientServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateClient          func(ctx context.Context, accessToken string, client *model.OAuthClient) (op1 *model.OAuthClient, err error)
+	funcCreateClientOrigin    string
+	inspectFuncCreateClient   func(ctx context.Context, accessToken string, client *model.OAuthClient)
+	afterCreateClientCounter  uint64
+	beforeCreateClientCounter uint64
+	CreateClientMock          mOAuthClientServiceMockCreateClient
+
+	funcDeleteClient          func(ctx context.Context, accessToken string, clientID string) (err error)
+	funcDeleteClientOrigin    string
+	inspectFuncDeleteClient   func(ctx context.Context, accessToken string, clientID string)
+	afterDeleteClientCounter  uint64
+	beforeDeleteClientCounter uint64
+	DeleteClientMock          mOAuthClientServiceMockDeleteClient
+
+	funcListClients          func(ctx context.Context, accessToken string) (opa1 []*model.OAuthClient, err error)
+	funcListClientsOrigin    string
+	inspectFuncListClients   func(ctx context.Context, accessToken string)
+	afterListClientsCounter  uint64
+	beforeListClientsCounter uint64
+	ListClientsMock          mOAuthClientServiceMockListClients
+}
+
+// NewOAuthClientServiceMock returns a mock for mm_service.OAuthClientService
+func NewOAuthClientServiceMock(t minimock.Tester) *OAuthClientServiceMock {
+	m := &OAuthClientServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateClientMock = mOAuthClientServiceMockCreateClient{mock: m}
+	m.CreateClientMock.callArgs = []*OAuthClientServiceMockCreateClientParams{}
+
+	m.DeleteClientMock = mOAuthClientServiceMockDeleteClient{mock: m}
+	m.DeleteClientMock.callArgs = []*OAuthClientServiceMockDeleteClientParams{}
+
+	m.ListClientsMock = mOAuthClientServiceMockListClients{mock: m}
+	m.ListClientsMock.callArgs = []*OAuthClientServiceMockListClientsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOAuthClientServiceMockCreateClient struct {
+	optional           bool
+	mock               *OAuthClientServiceMock
+	defaultExpectation *OAuthClientServiceMockCreateClientExpectation
+	expectations       []*OAuthClientServiceMockCreateClientExpectation
+
+	callArgs []*OAuthClientServiceMockCreateClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientServiceMockCreateClientExpectation specifies expectation struct of the OAuthClientService.CreateClient
+type OAuthClientServiceMockCreateClientExpectation struct {
+	mock               *OAuthClientServiceMock
+	params             *OAuthClientServiceMockCreateClientParams
+	paramPtrs          *OAuthClientServiceMockCreateClientParamPtrs
+	expectationOrigins OAuthClientServiceMockCreateClientExpectationOrigins
+	results            *OAuthClientServiceMockCreateClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientServiceMockCreateClientParams contains parameters of the OAuthClientService.CreateClient
+type OAuthClientServiceMockCreateClientParams struct {
+	ctx         context.Context
+	accessToken string
+	client      *model.OAuthClient
+}
+
+// OAuthClientServiceMockCreateClientParamPtrs contains pointers to parameters of the OAuthClientService.CreateClient
+type OAuthClientServiceMockCreateClientParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	client      **model.OAuthClient
+}
+
+// OAuthClientServiceMockCreateClientResults contains results of the OAuthClientService.CreateClient
+type OAuthClientServiceMockCreateClientResults struct {
+	op1 *model.OAuthClient
+	err error
+}
+
+// OAuthClientServiceMockCreateClientOrigins contains origins of expectations of the OAuthClientService.CreateClient
+type OAuthClientServiceMockCreateClientExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originClient      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Optional() *mOAuthClientServiceMockCreateClient {
+	mmCreateClient.optional = true
+	return mmCreateClient
+}
+
+// Expect sets up expected params for OAuthClientService.CreateClient
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Expect(ctx context.Context, accessToken string, client *model.OAuthClient) *mOAuthClientServiceMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthClientServiceMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by ExpectParams functions")
+	}
+
+	mmCreateClient.defaultExpectation.params = &OAuthClientServiceMockCreateClientParams{ctx, accessToken, client}
+	mmCreateClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateClient.expectations {
+		if minimock.Equal(e.params, mmCreateClient.defaultExpectation.params) {
+			mmCreateClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateClient.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientService.CreateClient
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) ExpectCtxParam1(ctx context.Context) *mOAuthClientServiceMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthClientServiceMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.params != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Expect")
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs == nil {
+		mmCreateClient.defaultExpectation.paramPtrs = &OAuthClientServiceMockCreateClientParamPtrs{}
+	}
+	mmCreateClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateClient
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for OAuthClientService.CreateClient
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) ExpectAccessTokenParam2(accessToken string) *mOAuthClientServiceMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthClientServiceMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.params != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Expect")
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs == nil {
+		mmCreateClient.defaultExpectation.paramPtrs = &OAuthClientServiceMockCreateClientParamPtrs{}
+	}
+	mmCreateClient.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmCreateClient.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmCreateClient
+}
+
+// ExpectClientParam3 sets up expected param client for OAuthClientService.CreateClient
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) ExpectClientParam3(client *model.OAuthClient) *mOAuthClientServiceMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthClientServiceMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.params != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Expect")
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs == nil {
+		mmCreateClient.defaultExpectation.paramPtrs = &OAuthClientServiceMockCreateClientParamPtrs{}
+	}
+	mmCreateClient.defaultExpectation.paramPtrs.client = &client
+	mmCreateClient.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmCreateClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientService.CreateClient
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Inspect(f func(ctx context.Context, accessToken string, client *model.OAuthClient)) *mOAuthClientServiceMockCreateClient {
+	if mmCreateClient.mock.inspectFuncCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("Inspect function is already set for OAuthClientServiceMock.CreateClient")
+	}
+
+	mmCreateClient.mock.inspectFuncCreateClient = f
+
+	return mmCreateClient
+}
+
+// Return sets up results that will be returned by OAuthClientService.CreateClient
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Return(op1 *model.OAuthClient, err error) *OAuthClientServiceMock {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthClientServiceMockCreateClientExpectation{mock: mmCreateClient.mock}
+	}
+	mmCreateClient.defaultExpectation.results = &OAuthClientServiceMockCreateClientResults{op1, err}
+	mmCreateClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateClient.mock
+}
+
+// Set uses given function f to mock the OAuthClientService.CreateClient method
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Set(f func(ctx context.Context, accessToken string, client *model.OAuthClient) (op1 *model.OAuthClient, err error)) *OAuthClientServiceMock {
+	if mmCreateClient.defaultExpectation != nil {
+		mmCreateClient.mock.t.Fatalf("Default expectation is already set for the OAuthClientService.CreateClient method")
+	}
+
+	if len(mmCreateClient.expectations) > 0 {
+		mmCreateClient.mock.t.Fatalf("Some expectations are already set for the OAuthClientService.CreateClient method")
+	}
+
+	mmCreateClient.mock.funcCreateClient = f
+	mmCreateClient.mock.funcCreateClientOrigin = minimock.CallerInfo(1)
+	return mmCreateClient.mock
+}
+
+// When sets expectation for the OAuthClientService.CreateClient which will trigger the result defined by the following
+// Then helper
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) When(ctx context.Context, accessToken string, client *model.OAuthClient) *OAuthClientServiceMockCreateClientExpectation {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthClientServiceMock.CreateClient mock is already set by Set")
+	}
+
+	expectation := &OAuthClientServiceMockCreateClientExpectation{
+		mock:               mmCreateClient.mock,
+		params:             &OAuthClientServiceMockCreateClientParams{ctx, accessToken, client},
+		expectationOrigins: OAuthClientServiceMockCreateClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateClient.expectations = append(mmCreateClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientService.CreateClient return parameters for the expectation previously defined by the When method
+func (e *OAuthClientServiceMockCreateClientExpectation) Then(op1 *model.OAuthClient, err error) *OAuthClientServiceMock {
+	e.results = &OAuthClientServiceMockCreateClientResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientService.CreateClient should be invoked
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Times(n uint64) *mOAuthClientServiceMockCreateClient {
+	if n == 0 {
+		mmCreateClient.mock.t.Fatalf("Times of OAuthClientServiceMock.CreateClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateClient.expectedInvocations, n)
+	mmCreateClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateClient
+}
+
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) invocationsDone() bool {
+	if len(mmCreateClient.expectations) == 0 && mmCreateClient.defaultExpectation == nil && mmCreateClient.mock.funcCreateClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateClient.mock.afterCreateClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateClient implements mm_service.OAuthClientService
+func (mmCreateClient *OAuthClientServiceMock) CreateClient(ctx context.Context, accessToken string, client *model.OAuthClient) (op1 *model.OAuthClient, err error) {
+	mm_atomic.AddUint64(&mmCreateClient.beforeCreateClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateClient.afterCreateClientCounter, 1)
+
+	mmCreateClient.t.Helper()
+
+	if mmCreateClient.inspectFuncCreateClient != nil {
+		mmCreateClient.inspectFuncCreateClient(ctx, accessToken, client)
+	}
+
+	mm_params := OAuthClientServiceMockCreateClientParams{ctx, accessToken, client}
+
+	// Record call args
+	mmCreateClient.CreateClientMock.mutex.Lock()
+	mmCreateClient.CreateClientMock.callArgs = append(mmCreateClient.CreateClientMock.callArgs, &mm_params)
+	mmCreateClient.CreateClientMock.mutex.Unlock()
+
+	for _, e := range mmCreateClient.CreateClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmCreateClient.CreateClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateClient.CreateClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateClient.CreateClientMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateClient.CreateClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientServiceMockCreateClientParams{ctx, accessToken, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateClient.t.Errorf("OAuthClientServiceMock.CreateClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCreateClient.t.Errorf("OAuthClientServiceMock.CreateClient got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmCreateClient.t.Errorf("OAuthClientServiceMock.CreateClient got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateClient.t.Errorf("OAuthClientServiceMock.CreateClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateClient.CreateClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateClient.t.Fatal("No results are set for the OAuthClientServiceMock.CreateClient")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmCreateClient.funcCreateClient != nil {
+		return mmCreateClient.funcCreateClient(ctx, accessToken, client)
+	}
+	mmCreateClient.t.Fatalf("Unexpected call to OAuthClientServiceMock.CreateClient. %v %v %v", ctx, accessToken, client)
+	return
+}
+
+// CreateClientAfterCounter returns a count of finished OAuthClientServiceMock.CreateClient invocations
+func (mmCreateClient *OAuthClientServiceMock) CreateClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateClient.afterCreateClientCounter)
+}
+
+// CreateClientBeforeCounter returns a count of OAuthClientServiceMock.CreateClient invocations
+func (mmCreateClient *OAuthClientServiceMock) CreateClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateClient.beforeCreateClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientServiceMock.CreateClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateClient *mOAuthClientServiceMockCreateClient) Calls() []*OAuthClientServiceMockCreateClientParams {
+	mmCreateClient.mutex.RLock()
+
+	argCopy := make([]*OAuthClientServiceMockCreateClientParams, len(mmCreateClient.callArgs))
+	copy(argCopy, mmCreateClient.callArgs)
+
+	mmCreateClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateClientDone returns true if the count of the CreateClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientServiceMock) MinimockCreateClientDone() bool {
+	if m.CreateClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateClientMock.invocationsDone()
+}
+
+// MinimockCreateClientInspect logs each unmet expectation
+func (m *OAuthClientServiceMock) MinimockCreateClientInspect() {
+	for _, e := range m.CreateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.CreateClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateClientCounter := mm_atomic.LoadUint64(&m.afterCreateClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateClientMock.defaultExpectation != nil && afterCreateClientCounter < 1 {
+		if m.CreateClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.CreateClient at\n%s", m.CreateClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.CreateClient at\n%s with params: %#v", m.CreateClientMock.defaultExpectation.expectationOrigins.origin, *m.CreateClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateClient != nil && afterCreateClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientServiceMock.CreateClient at\n%s", m.funcCreateClientOrigin)
+	}
+
+	if !m.CreateClientMock.invocationsDone() && afterCreateClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientServiceMock.CreateClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateClientMock.expectedInvocations), m.CreateClientMock.expectedInvocationsOrigin, afterCreateClientCounter)
+	}
+}
+
+type mOAuthClientServiceMockDeleteClient struct {
+	optional           bool
+	mock               *OAuthClientServiceMock
+	defaultExpectation *OAuthClientServiceMockDeleteClientExpectation
+	expectations       []*OAuthClientServiceMockDeleteClientExpectation
+
+	callArgs []*OAuthClientServiceMockDeleteClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientServiceMockDeleteClientExpectation specifies expectation struct of the OAuthClientService.DeleteClient
+type OAuthClientServiceMockDeleteClientExpectation struct {
+	mock               *OAuthClientServiceMock
+	params             *OAuthClientServiceMockDeleteClientParams
+	paramPtrs          *OAuthClientServiceMockDeleteClientParamPtrs
+	expectationOrigins OAuthClientServiceMockDeleteClientExpectationOrigins
+	results            *OAuthClientServiceMockDeleteClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientServiceMockDeleteClientParams contains parameters of the OAuthClientService.DeleteClient
+type OAuthClientServiceMockDeleteClientParams struct {
+	ctx         context.Context
+	accessToken string
+	clientID    string
+}
+
+// OAuthClientServiceMockDeleteClientParamPtrs contains pointers to parameters of the OAuthClientService.DeleteClient
+type OAuthClientServiceMockDeleteClientParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	clientID    *string
+}
+
+// OAuthClientServiceMockDeleteClientResults contains results of the OAuthClientService.DeleteClient
+type OAuthClientServiceMockDeleteClientResults struct {
+	err error
+}
+
+// OAuthClientServiceMockDeleteClientOrigins contains origins of expectations of the OAuthClientService.DeleteClient
+type OAuthClientServiceMockDeleteClientExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originClientID    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Optional() *mOAuthClientServiceMockDeleteClient {
+	mmDeleteClient.optional = true
+	return mmDeleteClient
+}
+
+// Expect sets up expected params for OAuthClientService.DeleteClient
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Expect(ctx context.Context, accessToken string, clientID string) *mOAuthClientServiceMockDeleteClient {
+	if mmDeleteClient.mock.funcDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Set")
+	}
+
+	if mmDeleteClient.defaultExpectation == nil {
+		mmDeleteClient.defaultExpectation = &OAuthClientServiceMockDeleteClientExpectation{}
+	}
+
+	if mmDeleteClient.defaultExpectation.paramPtrs != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteClient.defaultExpectation.params = &OAuthClientServiceMockDeleteClientParams{ctx, accessToken, clientID}
+	mmDeleteClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteClient.expectations {
+		if minimock.Equal(e.params, mmDeleteClient.defaultExpectation.params) {
+			mmDeleteClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteClient.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientService.DeleteClient
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) ExpectCtxParam1(ctx context.Context) *mOAuthClientServiceMockDeleteClient {
+	if mmDeleteClient.mock.funcDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Set")
+	}
+
+	if mmDeleteClient.defaultExpectation == nil {
+		mmDeleteClient.defaultExpectation = &OAuthClientServiceMockDeleteClientExpectation{}
+	}
+
+	if mmDeleteClient.defaultExpectation.params != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Expect")
+	}
+
+	if mmDeleteClient.defaultExpectation.paramPtrs == nil {
+		mmDeleteClient.defaultExpectation.paramPtrs = &OAuthClientServiceMockDeleteClientParamPtrs{}
+	}
+	mmDeleteClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteClient
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for OAuthClientService.DeleteClient
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) ExpectAccessTokenParam2(accessToken string) *mOAuthClientServiceMockDeleteClient {
+	if mmDeleteClient.mock.funcDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Set")
+	}
+
+	if mmDeleteClient.defaultExpectation == nil {
+		mmDeleteClient.defaultExpectation = &OAuthClientServiceMockDeleteClientExpectation{}
+	}
+
+	if mmDeleteClient.defaultExpectation.params != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Expect")
+	}
+
+	if mmDeleteClient.defaultExpectation.paramPtrs == nil {
+		mmDeleteClient.defaultExpectation.paramPtrs = &OAuthClientServiceMockDeleteClientParamPtrs{}
+	}
+	mmDeleteClient.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmDeleteClient.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmDeleteClient
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for OAuthClientService.DeleteClient
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) ExpectClientIDParam3(clientID string) *mOAuthClientServiceMockDeleteClient {
+	if mmDeleteClient.mock.funcDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Set")
+	}
+
+	if mmDeleteClient.defaultExpectation == nil {
+		mmDeleteClient.defaultExpectation = &OAuthClientServiceMockDeleteClientExpectation{}
+	}
+
+	if mmDeleteClient.defaultExpectation.params != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Expect")
+	}
+
+	if mmDeleteClient.defaultExpectation.paramPtrs == nil {
+		mmDeleteClient.defaultExpectation.paramPtrs = &OAuthClientServiceMockDeleteClientParamPtrs{}
+	}
+	mmDeleteClient.defaultExpectation.paramPtrs.clientID = &clientID
+	mmDeleteClient.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmDeleteClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientService.DeleteClient
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Inspect(f func(ctx context.Context, accessToken string, clientID string)) *mOAuthClientServiceMockDeleteClient {
+	if mmDeleteClient.mock.inspectFuncDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("Inspect function is already set for OAuthClientServiceMock.DeleteClient")
+	}
+
+	mmDeleteClient.mock.inspectFuncDeleteClient = f
+
+	return mmDeleteClient
+}
+
+// Return sets up results that will be returned by OAuthClientService.DeleteClient
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Return(err error) *OAuthClientServiceMock {
+	if mmDeleteClient.mock.funcDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Set")
+	}
+
+	if mmDeleteClient.defaultExpectation == nil {
+		mmDeleteClient.defaultExpectation = &OAuthClientServiceMockDeleteClientExpectation{mock: mmDeleteClient.mock}
+	}
+	mmDeleteClient.defaultExpectation.results = &OAuthClientServiceMockDeleteClientResults{err}
+	mmDeleteClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteClient.mock
+}
+
+// Set uses given function f to mock the OAuthClientService.DeleteClient method
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Set(f func(ctx context.Context, accessToken string, clientID string) (err error)) *OAuthClientServiceMock {
+	if mmDeleteClient.defaultExpectation != nil {
+		mmDeleteClient.mock.t.Fatalf("Default expectation is already set for the OAuthClientService.DeleteClient method")
+	}
+
+	if len(mmDeleteClient.expectations) > 0 {
+		mmDeleteClient.mock.t.Fatalf("Some expectations are already set for the OAuthClientService.DeleteClient method")
+	}
+
+	mmDeleteClient.mock.funcDeleteClient = f
+	mmDeleteClient.mock.funcDeleteClientOrigin = minimock.CallerInfo(1)
+	return mmDeleteClient.mock
+}
+
+// When sets expectation for the OAuthClientService.DeleteClient which will trigger the result defined by the following
+// Then helper
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) When(ctx context.Context, accessToken string, clientID string) *OAuthClientServiceMockDeleteClientExpectation {
+	if mmDeleteClient.mock.funcDeleteClient != nil {
+		mmDeleteClient.mock.t.Fatalf("OAuthClientServiceMock.DeleteClient mock is already set by Set")
+	}
+
+	expectation := &OAuthClientServiceMockDeleteClientExpectation{
+		mock:               mmDeleteClient.mock,
+		params:             &OAuthClientServiceMockDeleteClientParams{ctx, accessToken, clientID},
+		expectationOrigins: OAuthClientServiceMockDeleteClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteClient.expectations = append(mmDeleteClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientService.DeleteClient return parameters for the expectation previously defined by the When method
+func (e *OAuthClientServiceMockDeleteClientExpectation) Then(err error) *OAuthClientServiceMock {
+	e.results = &OAuthClientServiceMockDeleteClientResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientService.DeleteClient should be invoked
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Times(n uint64) *mOAuthClientServiceMockDeleteClient {
+	if n == 0 {
+		mmDeleteClient.mock.t.Fatalf("Times of OAuthClientServiceMock.DeleteClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteClient.expectedInvocations, n)
+	mmDeleteClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteClient
+}
+
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) invocationsDone() bool {
+	if len(mmDeleteClient.expectations) == 0 && mmDeleteClient.defaultExpectation == nil && mmDeleteClient.mock.funcDeleteClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteClient.mock.afterDeleteClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteClient implements mm_service.OAuthClientService
+func (mmDeleteClient *OAuthClientServiceMock) DeleteClient(ctx context.Context, accessToken string, clientID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteClient.beforeDeleteClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteClient.afterDeleteClientCounter, 1)
+
+	mmDeleteClient.t.Helper()
+
+	if mmDeleteClient.inspectFuncDeleteClient != nil {
+		mmDeleteClient.inspectFuncDeleteClient(ctx, accessToken, clientID)
+	}
+
+	mm_params := OAuthClientServiceMockDeleteClientParams{ctx, accessToken, clientID}
+
+	// Record call args
+	mmDeleteClient.DeleteClientMock.mutex.Lock()
+	mmDeleteClient.DeleteClientMock.callArgs = append(mmDeleteClient.DeleteClientMock.callArgs, &mm_params)
+	mmDeleteClient.DeleteClientMock.mutex.Unlock()
+
+	for _, e := range mmDeleteClient.DeleteClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteClient.DeleteClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteClient.DeleteClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteClient.DeleteClientMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteClient.DeleteClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientServiceMockDeleteClientParams{ctx, accessToken, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteClient.t.Errorf("OAuthClientServiceMock.DeleteClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteClient.DeleteClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmDeleteClient.t.Errorf("OAuthClientServiceMock.DeleteClient got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteClient.DeleteClientMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmDeleteClient.t.Errorf("OAuthClientServiceMock.DeleteClient got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteClient.DeleteClientMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteClient.t.Errorf("OAuthClientServiceMock.DeleteClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteClient.DeleteClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteClient.DeleteClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteClient.t.Fatal("No results are set for the OAuthClientServiceMock.DeleteClient")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteClient.funcDeleteClient != nil {
+		return mmDeleteClient.funcDeleteClient(ctx, accessToken, clientID)
+	}
+	mmDeleteClient.t.Fatalf("Unexpected call to OAuthClientServiceMock.DeleteClient. %v %v %v", ctx, accessToken, clientID)
+	return
+}
+
+// DeleteClientAfterCounter returns a count of finished OAuthClientServiceMock.DeleteClient invocations
+func (mmDeleteClient *OAuthClientServiceMock) DeleteClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteClient.afterDeleteClientCounter)
+}
+
+// DeleteClientBeforeCounter returns a count of OAuthClientServiceMock.DeleteClient invocations
+func (mmDeleteClient *OAuthClientServiceMock) DeleteClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteClient.beforeDeleteClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientServiceMock.DeleteClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteClient *mOAuthClientServiceMockDeleteClient) Calls() []*OAuthClientServiceMockDeleteClientParams {
+	mmDeleteClient.mutex.RLock()
+
+	argCopy := make([]*OAuthClientServiceMockDeleteClientParams, len(mmDeleteClient.callArgs))
+	copy(argCopy, mmDeleteClient.callArgs)
+
+	mmDeleteClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteClientDone returns true if the count of the DeleteClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientServiceMock) MinimockDeleteClientDone() bool {
+	if m.DeleteClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteClientMock.invocationsDone()
+}
+
+// MinimockDeleteClientInspect logs each unmet expectation
+func (m *OAuthClientServiceMock) MinimockDeleteClientInspect() {
+	for _, e := range m.DeleteClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.DeleteClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteClientCounter := mm_atomic.LoadUint64(&m.afterDeleteClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteClientMock.defaultExpectation != nil && afterDeleteClientCounter < 1 {
+		if m.DeleteClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.DeleteClient at\n%s", m.DeleteClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.DeleteClient at\n%s with params: %#v", m.DeleteClientMock.defaultExpectation.expectationOrigins.origin, *m.DeleteClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteClient != nil && afterDeleteClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientServiceMock.DeleteClient at\n%s", m.funcDeleteClientOrigin)
+	}
+
+	if !m.DeleteClientMock.invocationsDone() && afterDeleteClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientServiceMock.DeleteClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteClientMock.expectedInvocations), m.DeleteClientMock.expectedInvocationsOrigin, afterDeleteClientCounter)
+	}
+}
+
+type mOAuthClientServiceMockListClients struct {
+	optional           bool
+	mock               *OAuthClientServiceMock
+	defaultExpectation *OAuthClientServiceMockListClientsExpectation
+	expectations       []*OAuthClientServiceMockListClientsExpectation
+
+	callArgs []*OAuthClientServiceMockListClientsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthClientServiceMockListClientsExpectation specifies expectation struct of the OAuthClientService.ListClients
+type OAuthClientServiceMockListClientsExpectation struct {
+	mock               *OAuthClientServiceMock
+	params             *OAuthClientServiceMockListClientsParams
+	paramPtrs          *OAuthClientServiceMockListClientsParamPtrs
+	expectationOrigins OAuthClientServiceMockListClientsExpectationOrigins
+	results            *OAuthClientServiceMockListClientsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthClientServiceMockListClientsParams contains parameters of the OAuthClientService.ListClients
+type OAuthClientServiceMockListClientsParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// OAuthClientServiceMockListClientsParamPtrs contains pointers to parameters of the OAuthClientService.ListClients
+type OAuthClientServiceMockListClientsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// OAuthClientServiceMockListClientsResults contains results of the OAuthClientService.ListClients
+type OAuthClientServiceMockListClientsResults struct {
+	opa1 []*model.OAuthClient
+	err  error
+}
+
+// OAuthClientServiceMockListClientsOrigins contains origins of expectations of the OAuthClientService.ListClients
+type OAuthClientServiceMockListClientsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListClients *mOAuthClientServiceMockListClients) Optional() *mOAuthClientServiceMockListClients {
+	mmListClients.optional = true
+	return mmListClients
+}
+
+// Expect sets up expected params for OAuthClientService.ListClients
+func (mmListClients *mOAuthClientServiceMockListClients) Expect(ctx context.Context, accessToken string) *mOAuthClientServiceMockListClients {
+	if mmListClients.mock.funcListClients != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Set")
+	}
+
+	if mmListClients.defaultExpectation == nil {
+		mmListClients.defaultExpectation = &OAuthClientServiceMockListClientsExpectation{}
+	}
+
+	if mmListClients.defaultExpectation.paramPtrs != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by ExpectParams functions")
+	}
+
+	mmListClients.defaultExpectation.params = &OAuthClientServiceMockListClientsParams{ctx, accessToken}
+	mmListClients.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListClients.expectations {
+		if minimock.Equal(e.params, mmListClients.defaultExpectation.params) {
+			mmListClients.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListClients.defaultExpectation.params)
+		}
+	}
+
+	return mmListClients
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthClientService.ListClients
+func (mmListClients *mOAuthClientServiceMockListClients) ExpectCtxParam1(ctx context.Context) *mOAuthClientServiceMockListClients {
+	if mmListClients.mock.funcListClients != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Set")
+	}
+
+	if mmListClients.defaultExpectation == nil {
+		mmListClients.defaultExpectation = &OAuthClientServiceMockListClientsExpectation{}
+	}
+
+	if mmListClients.defaultExpectation.params != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Expect")
+	}
+
+	if mmListClients.defaultExpectation.paramPtrs == nil {
+		mmListClients.defaultExpectation.paramPtrs = &OAuthClientServiceMockListClientsParamPtrs{}
+	}
+	mmListClients.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListClients.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListClients
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for OAuthClientService.ListClients
+func (mmListClients *mOAuthClientServiceMockListClients) ExpectAccessTokenParam2(accessToken string) *mOAuthClientServiceMockListClients {
+	if mmListClients.mock.funcListClients != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Set")
+	}
+
+	if mmListClients.defaultExpectation == nil {
+		mmListClients.defaultExpectation = &OAuthClientServiceMockListClientsExpectation{}
+	}
+
+	if mmListClients.defaultExpectation.params != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Expect")
+	}
+
+	if mmListClients.defaultExpectation.paramPtrs == nil {
+		mmListClients.defaultExpectation.paramPtrs = &OAuthClientServiceMockListClientsParamPtrs{}
+	}
+	mmListClients.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListClients.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListClients
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthClientService.ListClients
+func (mmListClients *mOAuthClientServiceMockListClients) Inspect(f func(ctx context.Context, accessToken string)) *mOAuthClientServiceMockListClients {
+	if mmListClients.mock.inspectFuncListClients != nil {
+		mmListClients.mock.t.Fatalf("Inspect function is already set for OAuthClientServiceMock.ListClients")
+	}
+
+	mmListClients.mock.inspectFuncListClients = f
+
+	return mmListClients
+}
+
+// Return sets up results that will be returned by OAuthClientService.ListClients
+func (mmListClients *mOAuthClientServiceMockListClients) Return(opa1 []*model.OAuthClient, err error) *OAuthClientServiceMock {
+	if mmListClients.mock.funcListClients != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Set")
+	}
+
+	if mmListClients.defaultExpectation == nil {
+		mmListClients.defaultExpectation = &OAuthClientServiceMockListClientsExpectation{mock: mmListClients.mock}
+	}
+	mmListClients.defaultExpectation.results = &OAuthClientServiceMockListClientsResults{opa1, err}
+	mmListClients.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListClients.mock
+}
+
+// Set uses given function f to mock the OAuthClientService.ListClients method
+func (mmListClients *mOAuthClientServiceMockListClients) Set(f func(ctx context.Context, accessToken string) (opa1 []*model.OAuthClient, err error)) *OAuthClientServiceMock {
+	if mmListClients.defaultExpectation != nil {
+		mmListClients.mock.t.Fatalf("Default expectation is already set for the OAuthClientService.ListClients method")
+	}
+
+	if len(mmListClients.expectations) > 0 {
+		mmListClients.mock.t.Fatalf("Some expectations are already set for the OAuthClientService.ListClients method")
+	}
+
+	mmListClients.mock.funcListClients = f
+	mmListClients.mock.funcListClientsOrigin = minimock.CallerInfo(1)
+	return mmListClients.mock
+}
+
+// When sets expectation for the OAuthClientService.ListClients which will trigger the result defined by the following
+// Then helper
+func (mmListClients *mOAuthClientServiceMockListClients) When(ctx context.Context, accessToken string) *OAuthClientServiceMockListClientsExpectation {
+	if mmListClients.mock.funcListClients != nil {
+		mmListClients.mock.t.Fatalf("OAuthClientServiceMock.ListClients mock is already set by Set")
+	}
+
+	expectation := &OAuthClientServiceMockListClientsExpectation{
+		mock:               mmListClients.mock,
+		params:             &OAuthClientServiceMockListClientsParams{ctx, accessToken},
+		expectationOrigins: OAuthClientServiceMockListClientsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListClients.expectations = append(mmListClients.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthClientService.ListClients return parameters for the expectation previously defined by the When method
+func (e *OAuthClientServiceMockListClientsExpectation) Then(opa1 []*model.OAuthClient, err error) *OAuthClientServiceMock {
+	e.results = &OAuthClientServiceMockListClientsResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthClientService.ListClients should be invoked
+func (mmListClients *mOAuthClientServiceMockListClients) Times(n uint64) *mOAuthClientServiceMockListClients {
+	if n == 0 {
+		mmListClients.mock.t.Fatalf("Times of OAuthClientServiceMock.ListClients mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListClients.expectedInvocations, n)
+	mmListClients.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListClients
+}
+
+func (mmListClients *mOAuthClientServiceMockListClients) invocationsDone() bool {
+	if len(mmListClients.expectations) == 0 && mmListClients.defaultExpectation == nil && mmListClients.mock.funcListClients == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListClients.mock.afterListClientsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListClients.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListClients implements mm_service.OAuthClientService
+func (mmListClients *OAuthClientServiceMock) ListClients(ctx context.Context, accessToken string) (opa1 []*model.OAuthClient, err error) {
+	mm_atomic.AddUint64(&mmListClients.beforeListClientsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListClients.afterListClientsCounter, 1)
+
+	mmListClients.t.Helper()
+
+	if mmListClients.inspectFuncListClients != nil {
+		mmListClients.inspectFuncListClients(ctx, accessToken)
+	}
+
+	mm_params := OAuthClientServiceMockListClientsParams{ctx, accessToken}
+
+	// Record call args
+	mmListClients.ListClientsMock.mutex.Lock()
+	mmListClients.ListClientsMock.callArgs = append(mmListClients.ListClientsMock.callArgs, &mm_params)
+	mmListClients.ListClientsMock.mutex.Unlock()
+
+	for _, e := range mmListClients.ListClientsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmListClients.ListClientsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListClients.ListClientsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListClients.ListClientsMock.defaultExpectation.params
+		mm_want_ptrs := mmListClients.ListClientsMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthClientServiceMockListClientsParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListClients.t.Errorf("OAuthClientServiceMock.ListClients got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListClients.ListClientsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListClients.t.Errorf("OAuthClientServiceMock.ListClients got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListClients.ListClientsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListClients.t.Errorf("OAuthClientServiceMock.ListClients got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListClients.ListClientsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListClients.ListClientsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListClients.t.Fatal("No results are set for the OAuthClientServiceMock.ListClients")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmListClients.funcListClients != nil {
+		return mmListClients.funcListClients(ctx, accessToken)
+	}
+	mmListClients.t.Fatalf("Unexpected call to OAuthClientServiceMock.ListClients. %v %v", ctx, accessToken)
+	return
+}
+
+// ListClientsAfterCounter returns a count of finished OAuthClientServiceMock.ListClients invocations
+func (mmListClients *OAuthClientServiceMock) ListClientsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListClients.afterListClientsCounter)
+}
+
+// ListClientsBeforeCounter returns a count of OAuthClientServiceMock.ListClients invocations
+func (mmListClients *OAuthClientServiceMock) ListClientsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListClients.beforeListClientsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthClientServiceMock.ListClients.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListClients *mOAuthClientServiceMockListClients) Calls() []*OAuthClientServiceMockListClientsParams {
+	mmListClients.mutex.RLock()
+
+	argCopy := make([]*OAuthClientServiceMockListClientsParams, len(mmListClients.callArgs))
+	copy(argCopy, mmListClients.callArgs)
+
+	mmListClients.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListClientsDone returns true if the count of the ListClients invocations corresponds
+// the number of defined expectations
+func (m *OAuthClientServiceMock) MinimockListClientsDone() bool {
+	if m.ListClientsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListClientsMock.invocationsDone()
+}
+
+// MinimockListClientsInspect logs each unmet expectation
+func (m *OAuthClientServiceMock) MinimockListClientsInspect() {
+	for _, e := range m.ListClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.ListClients at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListClientsCounter := mm_atomic.LoadUint64(&m.afterListClientsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListClientsMock.defaultExpectation != nil && afterListClientsCounter < 1 {
+		if m.ListClientsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.ListClients at\n%s", m.ListClientsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthClientServiceMock.ListClients at\n%s with params: %#v", m.ListClientsMock.defaultExpectation.expectationOrigins.origin, *m.ListClientsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListClients != nil && afterListClientsCounter < 1 {
+		m.t.Errorf("Expected call to OAuthClientServiceMock.ListClients at\n%s", m.funcListClientsOrigin)
+	}
+
+	if !m.ListClientsMock.invocationsDone() && afterListClientsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthClientServiceMock.ListClients at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListClientsMock.expectedInvocations), m.ListClientsMock.expectedInvocationsOrigin, afterListClientsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OAuthClientServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateClientInspect()
+
+			m.MinimockDeleteClientInspect()
+
+			m.MinimockListClientsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OAuthClientServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OAuthClientServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateClientDone() &&
+		m.MinimockDeleteClientDone() &&
+		m.MinimockListClientsDone()
+}
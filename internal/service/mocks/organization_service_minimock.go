@@ -0,0 +1,2552 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.OrganizationService -o organization_service_minimock.go -n OrganizationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// OrganizationServiceMock implements mm_service.OrganizationService
+type OrganizationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateOrganization          func(ctx context.Context, name string) (i1 int64, err error)
+	funcCreateOrganizationOrigin    string
+	inspectFuncCreateOrganization   func(ctx context.Context, name string)
+	afterCreateOrganizationCounter  uint64
+	beforeCreateOrganizationCounter uint64
+	CreateOrganizationMock          mOrganizationServiceMockCreateOrganization
+
+	funcCreateRole          func(ctx context.Context, role *model.Role) (i1 int64, err error)
+	funcCreateRoleOrigin    string
+	inspectFuncCreateRole   func(ctx context.Context, role *model.Role)
+	afterCreateRoleCounter  uint64
+	beforeCreateRoleCounter uint64
+	CreateRoleMock          mOrganizationServiceMockCreateRole
+
+	funcDeleteOrganization          func(ctx context.Context, id int64) (err error)
+	funcDeleteOrganizationOrigin    string
+	inspectFuncDeleteOrganization   func(ctx context.Context, id int64)
+	afterDeleteOrganizationCounter  uint64
+	beforeDeleteOrganizationCounter uint64
+	DeleteOrganizationMock          mOrganizationServiceMockDeleteOrganization
+
+	funcDeleteRole          func(ctx context.Context, organizationID int64, name string) (err error)
+	funcDeleteRoleOrigin    string
+	inspectFuncDeleteRole   func(ctx context.Context, organizationID int64, name string)
+	afterDeleteRoleCounter  uint64
+	beforeDeleteRoleCounter uint64
+	DeleteRoleMock          mOrganizationServiceMockDeleteRole
+
+	funcGetOrganization          func(ctx context.Context, id int64) (op1 *model.Organization, err error)
+	funcGetOrganizationOrigin    string
+	inspectFuncGetOrganization   func(ctx context.Context, id int64)
+	afterGetOrganizationCounter  uint64
+	beforeGetOrganizationCounter uint64
+	GetOrganizationMock          mOrganizationServiceMockGetOrganization
+
+	funcListOrganizations          func(ctx context.Context) (opa1 []*model.Organization, err error)
+	funcListOrganizationsOrigin    string
+	inspectFuncListOrganizations   func(ctx context.Context)
+	afterListOrganizationsCounter  uint64
+	beforeListOrganizationsCounter uint64
+	ListOrganizationsMock          mOrganizationServiceMockListOrganizations
+
+	funcListRoles          func(ctx context.Context, organizationID int64) (rpa1 []*model.Role, err error)
+	funcListRolesOrigin    string
+	inspectFuncListRoles   func(ctx context.Context, organizationID int64)
+	afterListRolesCounter  uint64
+	beforeListRolesCounter uint64
+	ListRolesMock          mOrganizationServiceMockListRoles
+}
+
+// NewOrganizationServiceMock returns a mock for mm_service.OrganizationService
+func NewOrganizationServiceMock(t minimock.Tester) *OrganizationServiceMock {
+	m := &OrganizationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateOrganizationMock = mOrganizationServiceMockCreateOrganization{mock: m}
+	m.CreateOrganizationMock.callArgs = []*OrganizationServiceMockCreateOrganizationParams{}
+
+	m.CreateRoleMock = mOrganizationServiceMockCreateRole{mock: m}
+	m.CreateRoleMock.callArgs = []*OrganizationServiceMockCreateRoleParams{}
+
+	m.DeleteOrganizationMock = mOrganizationServiceMockDeleteOrganization{mock: m}
+	m.DeleteOrganizationMock.callArgs = []*OrganizationServiceMockDeleteOrganizationParams{}
+
+	m.DeleteRoleMock = mOrganizationServiceMockDeleteRole{mock: m}
+	m.DeleteRoleMock.callArgs = []*OrganizationServiceMockDeleteRoleParams{}
+
+	m.GetOrganizationMock = mOrganizationServiceMockGetOrganization{mock: m}
+	m.GetOrganizationMock.callArgs = []*OrganizationServiceMockGetOrganizationParams{}
+
+	m.ListOrganizationsMock = mOrganizationServiceMockListOrganizations{mock: m}
+	m.ListOrganizationsMock.callArgs = []*OrganizationServiceMockListOrganizationsParams{}
+
+	m.ListRolesMock = mOrganizationServiceMockListRoles{mock: m}
+	m.ListRolesMock.callArgs = []*OrganizationServiceMockListRolesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOrganizationServiceMockCreateOrganization struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockCreateOrganizationExpectation
+	expectations       []*OrganizationServiceMockCreateOrganizationExpectation
+
+	callArgs []*OrganizationServiceMockCreateOrganizationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockCreateOrganizationExpectation specifies expectation struct of the OrganizationService.CreateOrganization
+type OrganizationServiceMockCreateOrganizationExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockCreateOrganizationParams
+	paramPtrs          *OrganizationServiceMockCreateOrganizationParamPtrs
+	expectationOrigins OrganizationServiceMockCreateOrganizationExpectationOrigins
+	results            *OrganizationServiceMockCreateOrganizationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockCreateOrganizationParams contains parameters of the OrganizationService.CreateOrganization
+type OrganizationServiceMockCreateOrganizationParams struct {
+	ctx  context.Context
+	name string
+}
+
+// OrganizationServiceMockCreateOrganizationParamPtrs contains pointers to parameters of the OrganizationService.CreateOrganization
+type OrganizationServiceMockCreateOrganizationParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// OrganizationServiceMockCreateOrganizationResults contains results of the OrganizationService.CreateOrganization
+type OrganizationServiceMockCreateOrganizationResults struct {
+	i1  int64
+	err error
+}
+
+// OrganizationServiceMockCreateOrganizationOrigins contains origins of expectations of the OrganizationService.CreateOrganization
+type OrganizationServiceMockCreateOrganizationExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Optional() *mOrganizationServiceMockCreateOrganization {
+	mmCreateOrganization.optional = true
+	return mmCreateOrganization
+}
+
+// Expect sets up expected params for OrganizationService.CreateOrganization
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Expect(ctx context.Context, name string) *mOrganizationServiceMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationServiceMockCreateOrganizationExpectation{}
+	}
+
+	if mmCreateOrganization.defaultExpectation.paramPtrs != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOrganization.defaultExpectation.params = &OrganizationServiceMockCreateOrganizationParams{ctx, name}
+	mmCreateOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOrganization.expectations {
+		if minimock.Equal(e.params, mmCreateOrganization.defaultExpectation.params) {
+			mmCreateOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrganization.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrganization
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.CreateOrganization
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationServiceMockCreateOrganizationExpectation{}
+	}
+
+	if mmCreateOrganization.defaultExpectation.params != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Expect")
+	}
+
+	if mmCreateOrganization.defaultExpectation.paramPtrs == nil {
+		mmCreateOrganization.defaultExpectation.paramPtrs = &OrganizationServiceMockCreateOrganizationParamPtrs{}
+	}
+	mmCreateOrganization.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOrganization.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOrganization
+}
+
+// ExpectNameParam2 sets up expected param name for OrganizationService.CreateOrganization
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) ExpectNameParam2(name string) *mOrganizationServiceMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationServiceMockCreateOrganizationExpectation{}
+	}
+
+	if mmCreateOrganization.defaultExpectation.params != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Expect")
+	}
+
+	if mmCreateOrganization.defaultExpectation.paramPtrs == nil {
+		mmCreateOrganization.defaultExpectation.paramPtrs = &OrganizationServiceMockCreateOrganizationParamPtrs{}
+	}
+	mmCreateOrganization.defaultExpectation.paramPtrs.name = &name
+	mmCreateOrganization.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreateOrganization
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.CreateOrganization
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Inspect(f func(ctx context.Context, name string)) *mOrganizationServiceMockCreateOrganization {
+	if mmCreateOrganization.mock.inspectFuncCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.CreateOrganization")
+	}
+
+	mmCreateOrganization.mock.inspectFuncCreateOrganization = f
+
+	return mmCreateOrganization
+}
+
+// Return sets up results that will be returned by OrganizationService.CreateOrganization
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Return(i1 int64, err error) *OrganizationServiceMock {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Set")
+	}
+
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &OrganizationServiceMockCreateOrganizationExpectation{mock: mmCreateOrganization.mock}
+	}
+	mmCreateOrganization.defaultExpectation.results = &OrganizationServiceMockCreateOrganizationResults{i1, err}
+	mmCreateOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization.mock
+}
+
+// Set uses given function f to mock the OrganizationService.CreateOrganization method
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Set(f func(ctx context.Context, name string) (i1 int64, err error)) *OrganizationServiceMock {
+	if mmCreateOrganization.defaultExpectation != nil {
+		mmCreateOrganization.mock.t.Fatalf("Default expectation is already set for the OrganizationService.CreateOrganization method")
+	}
+
+	if len(mmCreateOrganization.expectations) > 0 {
+		mmCreateOrganization.mock.t.Fatalf("Some expectations are already set for the OrganizationService.CreateOrganization method")
+	}
+
+	mmCreateOrganization.mock.funcCreateOrganization = f
+	mmCreateOrganization.mock.funcCreateOrganizationOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization.mock
+}
+
+// When sets expectation for the OrganizationService.CreateOrganization which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) When(ctx context.Context, name string) *OrganizationServiceMockCreateOrganizationExpectation {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("OrganizationServiceMock.CreateOrganization mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockCreateOrganizationExpectation{
+		mock:               mmCreateOrganization.mock,
+		params:             &OrganizationServiceMockCreateOrganizationParams{ctx, name},
+		expectationOrigins: OrganizationServiceMockCreateOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOrganization.expectations = append(mmCreateOrganization.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.CreateOrganization return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockCreateOrganizationExpectation) Then(i1 int64, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockCreateOrganizationResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.CreateOrganization should be invoked
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Times(n uint64) *mOrganizationServiceMockCreateOrganization {
+	if n == 0 {
+		mmCreateOrganization.mock.t.Fatalf("Times of OrganizationServiceMock.CreateOrganization mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOrganization.expectedInvocations, n)
+	mmCreateOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization
+}
+
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) invocationsDone() bool {
+	if len(mmCreateOrganization.expectations) == 0 && mmCreateOrganization.defaultExpectation == nil && mmCreateOrganization.mock.funcCreateOrganization == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOrganization.mock.afterCreateOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOrganization.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOrganization implements mm_service.OrganizationService
+func (mmCreateOrganization *OrganizationServiceMock) CreateOrganization(ctx context.Context, name string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateOrganization.beforeCreateOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrganization.afterCreateOrganizationCounter, 1)
+
+	mmCreateOrganization.t.Helper()
+
+	if mmCreateOrganization.inspectFuncCreateOrganization != nil {
+		mmCreateOrganization.inspectFuncCreateOrganization(ctx, name)
+	}
+
+	mm_params := OrganizationServiceMockCreateOrganizationParams{ctx, name}
+
+	// Record call args
+	mmCreateOrganization.CreateOrganizationMock.mutex.Lock()
+	mmCreateOrganization.CreateOrganizationMock.callArgs = append(mmCreateOrganization.CreateOrganizationMock.callArgs, &mm_params)
+	mmCreateOrganization.CreateOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrganization.CreateOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateOrganization.CreateOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrganization.CreateOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockCreateOrganizationParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOrganization.t.Errorf("OrganizationServiceMock.CreateOrganization got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreateOrganization.t.Errorf("OrganizationServiceMock.CreateOrganization got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrganization.t.Errorf("OrganizationServiceMock.CreateOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrganization.t.Fatal("No results are set for the OrganizationServiceMock.CreateOrganization")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateOrganization.funcCreateOrganization != nil {
+		return mmCreateOrganization.funcCreateOrganization(ctx, name)
+	}
+	mmCreateOrganization.t.Fatalf("Unexpected call to OrganizationServiceMock.CreateOrganization. %v %v", ctx, name)
+	return
+}
+
+// CreateOrganizationAfterCounter returns a count of finished OrganizationServiceMock.CreateOrganization invocations
+func (mmCreateOrganization *OrganizationServiceMock) CreateOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrganization.afterCreateOrganizationCounter)
+}
+
+// CreateOrganizationBeforeCounter returns a count of OrganizationServiceMock.CreateOrganization invocations
+func (mmCreateOrganization *OrganizationServiceMock) CreateOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrganization.beforeCreateOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.CreateOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrganization *mOrganizationServiceMockCreateOrganization) Calls() []*OrganizationServiceMockCreateOrganizationParams {
+	mmCreateOrganization.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockCreateOrganizationParams, len(mmCreateOrganization.callArgs))
+	copy(argCopy, mmCreateOrganization.callArgs)
+
+	mmCreateOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrganizationDone returns true if the count of the CreateOrganization invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockCreateOrganizationDone() bool {
+	if m.CreateOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOrganizationMock.invocationsDone()
+}
+
+// MinimockCreateOrganizationInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockCreateOrganizationInspect() {
+	for _, e := range m.CreateOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.CreateOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOrganizationCounter := mm_atomic.LoadUint64(&m.afterCreateOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrganizationMock.defaultExpectation != nil && afterCreateOrganizationCounter < 1 {
+		if m.CreateOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.CreateOrganization at\n%s", m.CreateOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.CreateOrganization at\n%s with params: %#v", m.CreateOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.CreateOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrganization != nil && afterCreateOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.CreateOrganization at\n%s", m.funcCreateOrganizationOrigin)
+	}
+
+	if !m.CreateOrganizationMock.invocationsDone() && afterCreateOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.CreateOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOrganizationMock.expectedInvocations), m.CreateOrganizationMock.expectedInvocationsOrigin, afterCreateOrganizationCounter)
+	}
+}
+
+type mOrganizationServiceMockCreateRole struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockCreateRoleExpectation
+	expectations       []*OrganizationServiceMockCreateRoleExpectation
+
+	callArgs []*OrganizationServiceMockCreateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockCreateRoleExpectation specifies expectation struct of the OrganizationService.CreateRole
+type OrganizationServiceMockCreateRoleExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockCreateRoleParams
+	paramPtrs          *OrganizationServiceMockCreateRoleParamPtrs
+	expectationOrigins OrganizationServiceMockCreateRoleExpectationOrigins
+	results            *OrganizationServiceMockCreateRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockCreateRoleParams contains parameters of the OrganizationService.CreateRole
+type OrganizationServiceMockCreateRoleParams struct {
+	ctx  context.Context
+	role *model.Role
+}
+
+// OrganizationServiceMockCreateRoleParamPtrs contains pointers to parameters of the OrganizationService.CreateRole
+type OrganizationServiceMockCreateRoleParamPtrs struct {
+	ctx  *context.Context
+	role **model.Role
+}
+
+// OrganizationServiceMockCreateRoleResults contains results of the OrganizationService.CreateRole
+type OrganizationServiceMockCreateRoleResults struct {
+	i1  int64
+	err error
+}
+
+// OrganizationServiceMockCreateRoleOrigins contains origins of expectations of the OrganizationService.CreateRole
+type OrganizationServiceMockCreateRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Optional() *mOrganizationServiceMockCreateRole {
+	mmCreateRole.optional = true
+	return mmCreateRole
+}
+
+// Expect sets up expected params for OrganizationService.CreateRole
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Expect(ctx context.Context, role *model.Role) *mOrganizationServiceMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &OrganizationServiceMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRole.defaultExpectation.params = &OrganizationServiceMockCreateRoleParams{ctx, role}
+	mmCreateRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRole.expectations {
+		if minimock.Equal(e.params, mmCreateRole.defaultExpectation.params) {
+			mmCreateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.CreateRole
+func (mmCreateRole *mOrganizationServiceMockCreateRole) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &OrganizationServiceMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &OrganizationServiceMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRole
+}
+
+// ExpectRoleParam2 sets up expected param role for OrganizationService.CreateRole
+func (mmCreateRole *mOrganizationServiceMockCreateRole) ExpectRoleParam2(role *model.Role) *mOrganizationServiceMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &OrganizationServiceMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &OrganizationServiceMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.role = &role
+	mmCreateRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmCreateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.CreateRole
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Inspect(f func(ctx context.Context, role *model.Role)) *mOrganizationServiceMockCreateRole {
+	if mmCreateRole.mock.inspectFuncCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.CreateRole")
+	}
+
+	mmCreateRole.mock.inspectFuncCreateRole = f
+
+	return mmCreateRole
+}
+
+// Return sets up results that will be returned by OrganizationService.CreateRole
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Return(i1 int64, err error) *OrganizationServiceMock {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &OrganizationServiceMockCreateRoleExpectation{mock: mmCreateRole.mock}
+	}
+	mmCreateRole.defaultExpectation.results = &OrganizationServiceMockCreateRoleResults{i1, err}
+	mmCreateRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRole.mock
+}
+
+// Set uses given function f to mock the OrganizationService.CreateRole method
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Set(f func(ctx context.Context, role *model.Role) (i1 int64, err error)) *OrganizationServiceMock {
+	if mmCreateRole.defaultExpectation != nil {
+		mmCreateRole.mock.t.Fatalf("Default expectation is already set for the OrganizationService.CreateRole method")
+	}
+
+	if len(mmCreateRole.expectations) > 0 {
+		mmCreateRole.mock.t.Fatalf("Some expectations are already set for the OrganizationService.CreateRole method")
+	}
+
+	mmCreateRole.mock.funcCreateRole = f
+	mmCreateRole.mock.funcCreateRoleOrigin = minimock.CallerInfo(1)
+	return mmCreateRole.mock
+}
+
+// When sets expectation for the OrganizationService.CreateRole which will trigger the result defined by the following
+// Then helper
+func (mmCreateRole *mOrganizationServiceMockCreateRole) When(ctx context.Context, role *model.Role) *OrganizationServiceMockCreateRoleExpectation {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("OrganizationServiceMock.CreateRole mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockCreateRoleExpectation{
+		mock:               mmCreateRole.mock,
+		params:             &OrganizationServiceMockCreateRoleParams{ctx, role},
+		expectationOrigins: OrganizationServiceMockCreateRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRole.expectations = append(mmCreateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.CreateRole return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockCreateRoleExpectation) Then(i1 int64, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockCreateRoleResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.CreateRole should be invoked
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Times(n uint64) *mOrganizationServiceMockCreateRole {
+	if n == 0 {
+		mmCreateRole.mock.t.Fatalf("Times of OrganizationServiceMock.CreateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRole.expectedInvocations, n)
+	mmCreateRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRole
+}
+
+func (mmCreateRole *mOrganizationServiceMockCreateRole) invocationsDone() bool {
+	if len(mmCreateRole.expectations) == 0 && mmCreateRole.defaultExpectation == nil && mmCreateRole.mock.funcCreateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRole.mock.afterCreateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRole implements mm_service.OrganizationService
+func (mmCreateRole *OrganizationServiceMock) CreateRole(ctx context.Context, role *model.Role) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRole.beforeCreateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRole.afterCreateRoleCounter, 1)
+
+	mmCreateRole.t.Helper()
+
+	if mmCreateRole.inspectFuncCreateRole != nil {
+		mmCreateRole.inspectFuncCreateRole(ctx, role)
+	}
+
+	mm_params := OrganizationServiceMockCreateRoleParams{ctx, role}
+
+	// Record call args
+	mmCreateRole.CreateRoleMock.mutex.Lock()
+	mmCreateRole.CreateRoleMock.callArgs = append(mmCreateRole.CreateRoleMock.callArgs, &mm_params)
+	mmCreateRole.CreateRoleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRole.CreateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRole.CreateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRole.CreateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRole.CreateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRole.CreateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockCreateRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRole.t.Errorf("OrganizationServiceMock.CreateRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCreateRole.t.Errorf("OrganizationServiceMock.CreateRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRole.t.Errorf("OrganizationServiceMock.CreateRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRole.CreateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRole.t.Fatal("No results are set for the OrganizationServiceMock.CreateRole")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRole.funcCreateRole != nil {
+		return mmCreateRole.funcCreateRole(ctx, role)
+	}
+	mmCreateRole.t.Fatalf("Unexpected call to OrganizationServiceMock.CreateRole. %v %v", ctx, role)
+	return
+}
+
+// CreateRoleAfterCounter returns a count of finished OrganizationServiceMock.CreateRole invocations
+func (mmCreateRole *OrganizationServiceMock) CreateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.afterCreateRoleCounter)
+}
+
+// CreateRoleBeforeCounter returns a count of OrganizationServiceMock.CreateRole invocations
+func (mmCreateRole *OrganizationServiceMock) CreateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.beforeCreateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.CreateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRole *mOrganizationServiceMockCreateRole) Calls() []*OrganizationServiceMockCreateRoleParams {
+	mmCreateRole.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockCreateRoleParams, len(mmCreateRole.callArgs))
+	copy(argCopy, mmCreateRole.callArgs)
+
+	mmCreateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRoleDone returns true if the count of the CreateRole invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockCreateRoleDone() bool {
+	if m.CreateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRoleMock.invocationsDone()
+}
+
+// MinimockCreateRoleInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockCreateRoleInspect() {
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.CreateRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRoleCounter := mm_atomic.LoadUint64(&m.afterCreateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRoleMock.defaultExpectation != nil && afterCreateRoleCounter < 1 {
+		if m.CreateRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.CreateRole at\n%s", m.CreateRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.CreateRole at\n%s with params: %#v", m.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *m.CreateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRole != nil && afterCreateRoleCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.CreateRole at\n%s", m.funcCreateRoleOrigin)
+	}
+
+	if !m.CreateRoleMock.invocationsDone() && afterCreateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.CreateRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRoleMock.expectedInvocations), m.CreateRoleMock.expectedInvocationsOrigin, afterCreateRoleCounter)
+	}
+}
+
+type mOrganizationServiceMockDeleteOrganization struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockDeleteOrganizationExpectation
+	expectations       []*OrganizationServiceMockDeleteOrganizationExpectation
+
+	callArgs []*OrganizationServiceMockDeleteOrganizationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockDeleteOrganizationExpectation specifies expectation struct of the OrganizationService.DeleteOrganization
+type OrganizationServiceMockDeleteOrganizationExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockDeleteOrganizationParams
+	paramPtrs          *OrganizationServiceMockDeleteOrganizationParamPtrs
+	expectationOrigins OrganizationServiceMockDeleteOrganizationExpectationOrigins
+	results            *OrganizationServiceMockDeleteOrganizationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockDeleteOrganizationParams contains parameters of the OrganizationService.DeleteOrganization
+type OrganizationServiceMockDeleteOrganizationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OrganizationServiceMockDeleteOrganizationParamPtrs contains pointers to parameters of the OrganizationService.DeleteOrganization
+type OrganizationServiceMockDeleteOrganizationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// OrganizationServiceMockDeleteOrganizationResults contains results of the OrganizationService.DeleteOrganization
+type OrganizationServiceMockDeleteOrganizationResults struct {
+	err error
+}
+
+// OrganizationServiceMockDeleteOrganizationOrigins contains origins of expectations of the OrganizationService.DeleteOrganization
+type OrganizationServiceMockDeleteOrganizationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Optional() *mOrganizationServiceMockDeleteOrganization {
+	mmDeleteOrganization.optional = true
+	return mmDeleteOrganization
+}
+
+// Expect sets up expected params for OrganizationService.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Expect(ctx context.Context, id int64) *mOrganizationServiceMockDeleteOrganization {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationServiceMockDeleteOrganizationExpectation{}
+	}
+
+	if mmDeleteOrganization.defaultExpectation.paramPtrs != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOrganization.defaultExpectation.params = &OrganizationServiceMockDeleteOrganizationParams{ctx, id}
+	mmDeleteOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOrganization.expectations {
+		if minimock.Equal(e.params, mmDeleteOrganization.defaultExpectation.params) {
+			mmDeleteOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOrganization.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOrganization
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockDeleteOrganization {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationServiceMockDeleteOrganizationExpectation{}
+	}
+
+	if mmDeleteOrganization.defaultExpectation.params != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Expect")
+	}
+
+	if mmDeleteOrganization.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrganization.defaultExpectation.paramPtrs = &OrganizationServiceMockDeleteOrganizationParamPtrs{}
+	}
+	mmDeleteOrganization.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOrganization.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOrganization
+}
+
+// ExpectIdParam2 sets up expected param id for OrganizationService.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) ExpectIdParam2(id int64) *mOrganizationServiceMockDeleteOrganization {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationServiceMockDeleteOrganizationExpectation{}
+	}
+
+	if mmDeleteOrganization.defaultExpectation.params != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Expect")
+	}
+
+	if mmDeleteOrganization.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrganization.defaultExpectation.paramPtrs = &OrganizationServiceMockDeleteOrganizationParamPtrs{}
+	}
+	mmDeleteOrganization.defaultExpectation.paramPtrs.id = &id
+	mmDeleteOrganization.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteOrganization
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Inspect(f func(ctx context.Context, id int64)) *mOrganizationServiceMockDeleteOrganization {
+	if mmDeleteOrganization.mock.inspectFuncDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.DeleteOrganization")
+	}
+
+	mmDeleteOrganization.mock.inspectFuncDeleteOrganization = f
+
+	return mmDeleteOrganization
+}
+
+// Return sets up results that will be returned by OrganizationService.DeleteOrganization
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Return(err error) *OrganizationServiceMock {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Set")
+	}
+
+	if mmDeleteOrganization.defaultExpectation == nil {
+		mmDeleteOrganization.defaultExpectation = &OrganizationServiceMockDeleteOrganizationExpectation{mock: mmDeleteOrganization.mock}
+	}
+	mmDeleteOrganization.defaultExpectation.results = &OrganizationServiceMockDeleteOrganizationResults{err}
+	mmDeleteOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganization.mock
+}
+
+// Set uses given function f to mock the OrganizationService.DeleteOrganization method
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Set(f func(ctx context.Context, id int64) (err error)) *OrganizationServiceMock {
+	if mmDeleteOrganization.defaultExpectation != nil {
+		mmDeleteOrganization.mock.t.Fatalf("Default expectation is already set for the OrganizationService.DeleteOrganization method")
+	}
+
+	if len(mmDeleteOrganization.expectations) > 0 {
+		mmDeleteOrganization.mock.t.Fatalf("Some expectations are already set for the OrganizationService.DeleteOrganization method")
+	}
+
+	mmDeleteOrganization.mock.funcDeleteOrganization = f
+	mmDeleteOrganization.mock.funcDeleteOrganizationOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganization.mock
+}
+
+// When sets expectation for the OrganizationService.DeleteOrganization which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) When(ctx context.Context, id int64) *OrganizationServiceMockDeleteOrganizationExpectation {
+	if mmDeleteOrganization.mock.funcDeleteOrganization != nil {
+		mmDeleteOrganization.mock.t.Fatalf("OrganizationServiceMock.DeleteOrganization mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockDeleteOrganizationExpectation{
+		mock:               mmDeleteOrganization.mock,
+		params:             &OrganizationServiceMockDeleteOrganizationParams{ctx, id},
+		expectationOrigins: OrganizationServiceMockDeleteOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOrganization.expectations = append(mmDeleteOrganization.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.DeleteOrganization return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockDeleteOrganizationExpectation) Then(err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockDeleteOrganizationResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.DeleteOrganization should be invoked
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Times(n uint64) *mOrganizationServiceMockDeleteOrganization {
+	if n == 0 {
+		mmDeleteOrganization.mock.t.Fatalf("Times of OrganizationServiceMock.DeleteOrganization mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOrganization.expectedInvocations, n)
+	mmDeleteOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganization
+}
+
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) invocationsDone() bool {
+	if len(mmDeleteOrganization.expectations) == 0 && mmDeleteOrganization.defaultExpectation == nil && mmDeleteOrganization.mock.funcDeleteOrganization == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOrganization.mock.afterDeleteOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOrganization.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOrganization implements mm_service.OrganizationService
+func (mmDeleteOrganization *OrganizationServiceMock) DeleteOrganization(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOrganization.beforeDeleteOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOrganization.afterDeleteOrganizationCounter, 1)
+
+	mmDeleteOrganization.t.Helper()
+
+	if mmDeleteOrganization.inspectFuncDeleteOrganization != nil {
+		mmDeleteOrganization.inspectFuncDeleteOrganization(ctx, id)
+	}
+
+	mm_params := OrganizationServiceMockDeleteOrganizationParams{ctx, id}
+
+	// Record call args
+	mmDeleteOrganization.DeleteOrganizationMock.mutex.Lock()
+	mmDeleteOrganization.DeleteOrganizationMock.callArgs = append(mmDeleteOrganization.DeleteOrganizationMock.callArgs, &mm_params)
+	mmDeleteOrganization.DeleteOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOrganization.DeleteOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockDeleteOrganizationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOrganization.t.Errorf("OrganizationServiceMock.DeleteOrganization got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteOrganization.t.Errorf("OrganizationServiceMock.DeleteOrganization got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOrganization.t.Errorf("OrganizationServiceMock.DeleteOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOrganization.DeleteOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOrganization.t.Fatal("No results are set for the OrganizationServiceMock.DeleteOrganization")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOrganization.funcDeleteOrganization != nil {
+		return mmDeleteOrganization.funcDeleteOrganization(ctx, id)
+	}
+	mmDeleteOrganization.t.Fatalf("Unexpected call to OrganizationServiceMock.DeleteOrganization. %v %v", ctx, id)
+	return
+}
+
+// DeleteOrganizationAfterCounter returns a count of finished OrganizationServiceMock.DeleteOrganization invocations
+func (mmDeleteOrganization *OrganizationServiceMock) DeleteOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrganization.afterDeleteOrganizationCounter)
+}
+
+// DeleteOrganizationBeforeCounter returns a count of OrganizationServiceMock.DeleteOrganization invocations
+func (mmDeleteOrganization *OrganizationServiceMock) DeleteOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrganization.beforeDeleteOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.DeleteOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOrganization *mOrganizationServiceMockDeleteOrganization) Calls() []*OrganizationServiceMockDeleteOrganizationParams {
+	mmDeleteOrganization.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockDeleteOrganizationParams, len(mmDeleteOrganization.callArgs))
+	copy(argCopy, mmDeleteOrganization.callArgs)
+
+	mmDeleteOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOrganizationDone returns true if the count of the DeleteOrganization invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockDeleteOrganizationDone() bool {
+	if m.DeleteOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOrganizationMock.invocationsDone()
+}
+
+// MinimockDeleteOrganizationInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockDeleteOrganizationInspect() {
+	for _, e := range m.DeleteOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.DeleteOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOrganizationCounter := mm_atomic.LoadUint64(&m.afterDeleteOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOrganizationMock.defaultExpectation != nil && afterDeleteOrganizationCounter < 1 {
+		if m.DeleteOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.DeleteOrganization at\n%s", m.DeleteOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.DeleteOrganization at\n%s with params: %#v", m.DeleteOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOrganization != nil && afterDeleteOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.DeleteOrganization at\n%s", m.funcDeleteOrganizationOrigin)
+	}
+
+	if !m.DeleteOrganizationMock.invocationsDone() && afterDeleteOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.DeleteOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOrganizationMock.expectedInvocations), m.DeleteOrganizationMock.expectedInvocationsOrigin, afterDeleteOrganizationCounter)
+	}
+}
+
+type mOrganizationServiceMockDeleteRole struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockDeleteRoleExpectation
+	expectations       []*OrganizationServiceMockDeleteRoleExpectation
+
+	callArgs []*OrganizationServiceMockDeleteRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockDeleteRoleExpectation specifies expectation struct of the OrganizationService.DeleteRole
+type OrganizationServiceMockDeleteRoleExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockDeleteRoleParams
+	paramPtrs          *OrganizationServiceMockDeleteRoleParamPtrs
+	expectationOrigins OrganizationServiceMockDeleteRoleExpectationOrigins
+	results            *OrganizationServiceMockDeleteRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockDeleteRoleParams contains parameters of the OrganizationService.DeleteRole
+type OrganizationServiceMockDeleteRoleParams struct {
+	ctx            context.Context
+	organizationID int64
+	name           string
+}
+
+// OrganizationServiceMockDeleteRoleParamPtrs contains pointers to parameters of the OrganizationService.DeleteRole
+type OrganizationServiceMockDeleteRoleParamPtrs struct {
+	ctx            *context.Context
+	organizationID *int64
+	name           *string
+}
+
+// OrganizationServiceMockDeleteRoleResults contains results of the OrganizationService.DeleteRole
+type OrganizationServiceMockDeleteRoleResults struct {
+	err error
+}
+
+// OrganizationServiceMockDeleteRoleOrigins contains origins of expectations of the OrganizationService.DeleteRole
+type OrganizationServiceMockDeleteRoleExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originOrganizationID string
+	originName           string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Optional() *mOrganizationServiceMockDeleteRole {
+	mmDeleteRole.optional = true
+	return mmDeleteRole
+}
+
+// Expect sets up expected params for OrganizationService.DeleteRole
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Expect(ctx context.Context, organizationID int64, name string) *mOrganizationServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &OrganizationServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRole.defaultExpectation.params = &OrganizationServiceMockDeleteRoleParams{ctx, organizationID, name}
+	mmDeleteRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRole.expectations {
+		if minimock.Equal(e.params, mmDeleteRole.defaultExpectation.params) {
+			mmDeleteRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.DeleteRole
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &OrganizationServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &OrganizationServiceMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// ExpectOrganizationIDParam2 sets up expected param organizationID for OrganizationService.DeleteRole
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) ExpectOrganizationIDParam2(organizationID int64) *mOrganizationServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &OrganizationServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &OrganizationServiceMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.organizationID = &organizationID
+	mmDeleteRole.defaultExpectation.expectationOrigins.originOrganizationID = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// ExpectNameParam3 sets up expected param name for OrganizationService.DeleteRole
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) ExpectNameParam3(name string) *mOrganizationServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &OrganizationServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &OrganizationServiceMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.name = &name
+	mmDeleteRole.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.DeleteRole
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Inspect(f func(ctx context.Context, organizationID int64, name string)) *mOrganizationServiceMockDeleteRole {
+	if mmDeleteRole.mock.inspectFuncDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.DeleteRole")
+	}
+
+	mmDeleteRole.mock.inspectFuncDeleteRole = f
+
+	return mmDeleteRole
+}
+
+// Return sets up results that will be returned by OrganizationService.DeleteRole
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Return(err error) *OrganizationServiceMock {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &OrganizationServiceMockDeleteRoleExpectation{mock: mmDeleteRole.mock}
+	}
+	mmDeleteRole.defaultExpectation.results = &OrganizationServiceMockDeleteRoleResults{err}
+	mmDeleteRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole.mock
+}
+
+// Set uses given function f to mock the OrganizationService.DeleteRole method
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Set(f func(ctx context.Context, organizationID int64, name string) (err error)) *OrganizationServiceMock {
+	if mmDeleteRole.defaultExpectation != nil {
+		mmDeleteRole.mock.t.Fatalf("Default expectation is already set for the OrganizationService.DeleteRole method")
+	}
+
+	if len(mmDeleteRole.expectations) > 0 {
+		mmDeleteRole.mock.t.Fatalf("Some expectations are already set for the OrganizationService.DeleteRole method")
+	}
+
+	mmDeleteRole.mock.funcDeleteRole = f
+	mmDeleteRole.mock.funcDeleteRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole.mock
+}
+
+// When sets expectation for the OrganizationService.DeleteRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) When(ctx context.Context, organizationID int64, name string) *OrganizationServiceMockDeleteRoleExpectation {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("OrganizationServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockDeleteRoleExpectation{
+		mock:               mmDeleteRole.mock,
+		params:             &OrganizationServiceMockDeleteRoleParams{ctx, organizationID, name},
+		expectationOrigins: OrganizationServiceMockDeleteRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRole.expectations = append(mmDeleteRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.DeleteRole return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockDeleteRoleExpectation) Then(err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockDeleteRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.DeleteRole should be invoked
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Times(n uint64) *mOrganizationServiceMockDeleteRole {
+	if n == 0 {
+		mmDeleteRole.mock.t.Fatalf("Times of OrganizationServiceMock.DeleteRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRole.expectedInvocations, n)
+	mmDeleteRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole
+}
+
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) invocationsDone() bool {
+	if len(mmDeleteRole.expectations) == 0 && mmDeleteRole.defaultExpectation == nil && mmDeleteRole.mock.funcDeleteRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRole.mock.afterDeleteRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRole implements mm_service.OrganizationService
+func (mmDeleteRole *OrganizationServiceMock) DeleteRole(ctx context.Context, organizationID int64, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRole.beforeDeleteRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRole.afterDeleteRoleCounter, 1)
+
+	mmDeleteRole.t.Helper()
+
+	if mmDeleteRole.inspectFuncDeleteRole != nil {
+		mmDeleteRole.inspectFuncDeleteRole(ctx, organizationID, name)
+	}
+
+	mm_params := OrganizationServiceMockDeleteRoleParams{ctx, organizationID, name}
+
+	// Record call args
+	mmDeleteRole.DeleteRoleMock.mutex.Lock()
+	mmDeleteRole.DeleteRoleMock.callArgs = append(mmDeleteRole.DeleteRoleMock.callArgs, &mm_params)
+	mmDeleteRole.DeleteRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRole.DeleteRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRole.DeleteRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRole.DeleteRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRole.DeleteRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRole.DeleteRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockDeleteRoleParams{ctx, organizationID, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRole.t.Errorf("OrganizationServiceMock.DeleteRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.organizationID != nil && !minimock.Equal(*mm_want_ptrs.organizationID, mm_got.organizationID) {
+				mmDeleteRole.t.Errorf("OrganizationServiceMock.DeleteRole got unexpected parameter organizationID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originOrganizationID, *mm_want_ptrs.organizationID, mm_got.organizationID, minimock.Diff(*mm_want_ptrs.organizationID, mm_got.organizationID))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteRole.t.Errorf("OrganizationServiceMock.DeleteRole got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRole.t.Errorf("OrganizationServiceMock.DeleteRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRole.DeleteRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRole.t.Fatal("No results are set for the OrganizationServiceMock.DeleteRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRole.funcDeleteRole != nil {
+		return mmDeleteRole.funcDeleteRole(ctx, organizationID, name)
+	}
+	mmDeleteRole.t.Fatalf("Unexpected call to OrganizationServiceMock.DeleteRole. %v %v %v", ctx, organizationID, name)
+	return
+}
+
+// DeleteRoleAfterCounter returns a count of finished OrganizationServiceMock.DeleteRole invocations
+func (mmDeleteRole *OrganizationServiceMock) DeleteRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.afterDeleteRoleCounter)
+}
+
+// DeleteRoleBeforeCounter returns a count of OrganizationServiceMock.DeleteRole invocations
+func (mmDeleteRole *OrganizationServiceMock) DeleteRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.beforeDeleteRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.DeleteRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRole *mOrganizationServiceMockDeleteRole) Calls() []*OrganizationServiceMockDeleteRoleParams {
+	mmDeleteRole.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockDeleteRoleParams, len(mmDeleteRole.callArgs))
+	copy(argCopy, mmDeleteRole.callArgs)
+
+	mmDeleteRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleDone returns true if the count of the DeleteRole invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockDeleteRoleDone() bool {
+	if m.DeleteRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleMock.invocationsDone()
+}
+
+// MinimockDeleteRoleInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockDeleteRoleInspect() {
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.DeleteRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleMock.defaultExpectation != nil && afterDeleteRoleCounter < 1 {
+		if m.DeleteRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.DeleteRole at\n%s", m.DeleteRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.DeleteRole at\n%s with params: %#v", m.DeleteRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRole != nil && afterDeleteRoleCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.DeleteRole at\n%s", m.funcDeleteRoleOrigin)
+	}
+
+	if !m.DeleteRoleMock.invocationsDone() && afterDeleteRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.DeleteRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleMock.expectedInvocations), m.DeleteRoleMock.expectedInvocationsOrigin, afterDeleteRoleCounter)
+	}
+}
+
+type mOrganizationServiceMockGetOrganization struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockGetOrganizationExpectation
+	expectations       []*OrganizationServiceMockGetOrganizationExpectation
+
+	callArgs []*OrganizationServiceMockGetOrganizationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockGetOrganizationExpectation specifies expectation struct of the OrganizationService.GetOrganization
+type OrganizationServiceMockGetOrganizationExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockGetOrganizationParams
+	paramPtrs          *OrganizationServiceMockGetOrganizationParamPtrs
+	expectationOrigins OrganizationServiceMockGetOrganizationExpectationOrigins
+	results            *OrganizationServiceMockGetOrganizationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockGetOrganizationParams contains parameters of the OrganizationService.GetOrganization
+type OrganizationServiceMockGetOrganizationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OrganizationServiceMockGetOrganizationParamPtrs contains pointers to parameters of the OrganizationService.GetOrganization
+type OrganizationServiceMockGetOrganizationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// OrganizationServiceMockGetOrganizationResults contains results of the OrganizationService.GetOrganization
+type OrganizationServiceMockGetOrganizationResults struct {
+	op1 *model.Organization
+	err error
+}
+
+// OrganizationServiceMockGetOrganizationOrigins contains origins of expectations of the OrganizationService.GetOrganization
+type OrganizationServiceMockGetOrganizationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Optional() *mOrganizationServiceMockGetOrganization {
+	mmGetOrganization.optional = true
+	return mmGetOrganization
+}
+
+// Expect sets up expected params for OrganizationService.GetOrganization
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Expect(ctx context.Context, id int64) *mOrganizationServiceMockGetOrganization {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationServiceMockGetOrganizationExpectation{}
+	}
+
+	if mmGetOrganization.defaultExpectation.paramPtrs != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrganization.defaultExpectation.params = &OrganizationServiceMockGetOrganizationParams{ctx, id}
+	mmGetOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrganization.expectations {
+		if minimock.Equal(e.params, mmGetOrganization.defaultExpectation.params) {
+			mmGetOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrganization.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrganization
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.GetOrganization
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockGetOrganization {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationServiceMockGetOrganizationExpectation{}
+	}
+
+	if mmGetOrganization.defaultExpectation.params != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Expect")
+	}
+
+	if mmGetOrganization.defaultExpectation.paramPtrs == nil {
+		mmGetOrganization.defaultExpectation.paramPtrs = &OrganizationServiceMockGetOrganizationParamPtrs{}
+	}
+	mmGetOrganization.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrganization.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrganization
+}
+
+// ExpectIdParam2 sets up expected param id for OrganizationService.GetOrganization
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) ExpectIdParam2(id int64) *mOrganizationServiceMockGetOrganization {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationServiceMockGetOrganizationExpectation{}
+	}
+
+	if mmGetOrganization.defaultExpectation.params != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Expect")
+	}
+
+	if mmGetOrganization.defaultExpectation.paramPtrs == nil {
+		mmGetOrganization.defaultExpectation.paramPtrs = &OrganizationServiceMockGetOrganizationParamPtrs{}
+	}
+	mmGetOrganization.defaultExpectation.paramPtrs.id = &id
+	mmGetOrganization.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetOrganization
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.GetOrganization
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Inspect(f func(ctx context.Context, id int64)) *mOrganizationServiceMockGetOrganization {
+	if mmGetOrganization.mock.inspectFuncGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.GetOrganization")
+	}
+
+	mmGetOrganization.mock.inspectFuncGetOrganization = f
+
+	return mmGetOrganization
+}
+
+// Return sets up results that will be returned by OrganizationService.GetOrganization
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Return(op1 *model.Organization, err error) *OrganizationServiceMock {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Set")
+	}
+
+	if mmGetOrganization.defaultExpectation == nil {
+		mmGetOrganization.defaultExpectation = &OrganizationServiceMockGetOrganizationExpectation{mock: mmGetOrganization.mock}
+	}
+	mmGetOrganization.defaultExpectation.results = &OrganizationServiceMockGetOrganizationResults{op1, err}
+	mmGetOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrganization.mock
+}
+
+// Set uses given function f to mock the OrganizationService.GetOrganization method
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Set(f func(ctx context.Context, id int64) (op1 *model.Organization, err error)) *OrganizationServiceMock {
+	if mmGetOrganization.defaultExpectation != nil {
+		mmGetOrganization.mock.t.Fatalf("Default expectation is already set for the OrganizationService.GetOrganization method")
+	}
+
+	if len(mmGetOrganization.expectations) > 0 {
+		mmGetOrganization.mock.t.Fatalf("Some expectations are already set for the OrganizationService.GetOrganization method")
+	}
+
+	mmGetOrganization.mock.funcGetOrganization = f
+	mmGetOrganization.mock.funcGetOrganizationOrigin = minimock.CallerInfo(1)
+	return mmGetOrganization.mock
+}
+
+// When sets expectation for the OrganizationService.GetOrganization which will trigger the result defined by the following
+// Then helper
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) When(ctx context.Context, id int64) *OrganizationServiceMockGetOrganizationExpectation {
+	if mmGetOrganization.mock.funcGetOrganization != nil {
+		mmGetOrganization.mock.t.Fatalf("OrganizationServiceMock.GetOrganization mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockGetOrganizationExpectation{
+		mock:               mmGetOrganization.mock,
+		params:             &OrganizationServiceMockGetOrganizationParams{ctx, id},
+		expectationOrigins: OrganizationServiceMockGetOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrganization.expectations = append(mmGetOrganization.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.GetOrganization return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockGetOrganizationExpectation) Then(op1 *model.Organization, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockGetOrganizationResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.GetOrganization should be invoked
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Times(n uint64) *mOrganizationServiceMockGetOrganization {
+	if n == 0 {
+		mmGetOrganization.mock.t.Fatalf("Times of OrganizationServiceMock.GetOrganization mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrganization.expectedInvocations, n)
+	mmGetOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrganization
+}
+
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) invocationsDone() bool {
+	if len(mmGetOrganization.expectations) == 0 && mmGetOrganization.defaultExpectation == nil && mmGetOrganization.mock.funcGetOrganization == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrganization.mock.afterGetOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrganization.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrganization implements mm_service.OrganizationService
+func (mmGetOrganization *OrganizationServiceMock) GetOrganization(ctx context.Context, id int64) (op1 *model.Organization, err error) {
+	mm_atomic.AddUint64(&mmGetOrganization.beforeGetOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrganization.afterGetOrganizationCounter, 1)
+
+	mmGetOrganization.t.Helper()
+
+	if mmGetOrganization.inspectFuncGetOrganization != nil {
+		mmGetOrganization.inspectFuncGetOrganization(ctx, id)
+	}
+
+	mm_params := OrganizationServiceMockGetOrganizationParams{ctx, id}
+
+	// Record call args
+	mmGetOrganization.GetOrganizationMock.mutex.Lock()
+	mmGetOrganization.GetOrganizationMock.callArgs = append(mmGetOrganization.GetOrganizationMock.callArgs, &mm_params)
+	mmGetOrganization.GetOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmGetOrganization.GetOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOrganization.GetOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrganization.GetOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrganization.GetOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrganization.GetOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockGetOrganizationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrganization.t.Errorf("OrganizationServiceMock.GetOrganization got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrganization.GetOrganizationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetOrganization.t.Errorf("OrganizationServiceMock.GetOrganization got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrganization.GetOrganizationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrganization.t.Errorf("OrganizationServiceMock.GetOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrganization.GetOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrganization.GetOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrganization.t.Fatal("No results are set for the OrganizationServiceMock.GetOrganization")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOrganization.funcGetOrganization != nil {
+		return mmGetOrganization.funcGetOrganization(ctx, id)
+	}
+	mmGetOrganization.t.Fatalf("Unexpected call to OrganizationServiceMock.GetOrganization. %v %v", ctx, id)
+	return
+}
+
+// GetOrganizationAfterCounter returns a count of finished OrganizationServiceMock.GetOrganization invocations
+func (mmGetOrganization *OrganizationServiceMock) GetOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganization.afterGetOrganizationCounter)
+}
+
+// GetOrganizationBeforeCounter returns a count of OrganizationServiceMock.GetOrganization invocations
+func (mmGetOrganization *OrganizationServiceMock) GetOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganization.beforeGetOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.GetOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrganization *mOrganizationServiceMockGetOrganization) Calls() []*OrganizationServiceMockGetOrganizationParams {
+	mmGetOrganization.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockGetOrganizationParams, len(mmGetOrganization.callArgs))
+	copy(argCopy, mmGetOrganization.callArgs)
+
+	mmGetOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrganizationDone returns true if the count of the GetOrganization invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockGetOrganizationDone() bool {
+	if m.GetOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrganizationMock.invocationsDone()
+}
+
+// MinimockGetOrganizationInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockGetOrganizationInspect() {
+	for _, e := range m.GetOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.GetOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrganizationCounter := mm_atomic.LoadUint64(&m.afterGetOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrganizationMock.defaultExpectation != nil && afterGetOrganizationCounter < 1 {
+		if m.GetOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.GetOrganization at\n%s", m.GetOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.GetOrganization at\n%s with params: %#v", m.GetOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.GetOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrganization != nil && afterGetOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.GetOrganization at\n%s", m.funcGetOrganizationOrigin)
+	}
+
+	if !m.GetOrganizationMock.invocationsDone() && afterGetOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.GetOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrganizationMock.expectedInvocations), m.GetOrganizationMock.expectedInvocationsOrigin, afterGetOrganizationCounter)
+	}
+}
+
+type mOrganizationServiceMockListOrganizations struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockListOrganizationsExpectation
+	expectations       []*OrganizationServiceMockListOrganizationsExpectation
+
+	callArgs []*OrganizationServiceMockListOrganizationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockListOrganizationsExpectation specifies expectation struct of the OrganizationService.ListOrganizations
+type OrganizationServiceMockListOrganizationsExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockListOrganizationsParams
+	paramPtrs          *OrganizationServiceMockListOrganizationsParamPtrs
+	expectationOrigins OrganizationServiceMockListOrganizationsExpectationOrigins
+	results            *OrganizationServiceMockListOrganizationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockListOrganizationsParams contains parameters of the OrganizationService.ListOrganizations
+type OrganizationServiceMockListOrganizationsParams struct {
+	ctx context.Context
+}
+
+// OrganizationServiceMockListOrganizationsParamPtrs contains pointers to parameters of the OrganizationService.ListOrganizations
+type OrganizationServiceMockListOrganizationsParamPtrs struct {
+	ctx *context.Context
+}
+
+// OrganizationServiceMockListOrganizationsResults contains results of the OrganizationService.ListOrganizations
+type OrganizationServiceMockListOrganizationsResults struct {
+	opa1 []*model.Organization
+	err  error
+}
+
+// OrganizationServiceMockListOrganizationsOrigins contains origins of expectations of the OrganizationService.ListOrganizations
+type OrganizationServiceMockListOrganizationsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Optional() *mOrganizationServiceMockListOrganizations {
+	mmListOrganizations.optional = true
+	return mmListOrganizations
+}
+
+// Expect sets up expected params for OrganizationService.ListOrganizations
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Expect(ctx context.Context) *mOrganizationServiceMockListOrganizations {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationServiceMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &OrganizationServiceMockListOrganizationsExpectation{}
+	}
+
+	if mmListOrganizations.defaultExpectation.paramPtrs != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationServiceMock.ListOrganizations mock is already set by ExpectParams functions")
+	}
+
+	mmListOrganizations.defaultExpectation.params = &OrganizationServiceMockListOrganizationsParams{ctx}
+	mmListOrganizations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOrganizations.expectations {
+		if minimock.Equal(e.params, mmListOrganizations.defaultExpectation.params) {
+			mmListOrganizations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrganizations.defaultExpectation.params)
+		}
+	}
+
+	return mmListOrganizations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.ListOrganizations
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockListOrganizations {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationServiceMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &OrganizationServiceMockListOrganizationsExpectation{}
+	}
+
+	if mmListOrganizations.defaultExpectation.params != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationServiceMock.ListOrganizations mock is already set by Expect")
+	}
+
+	if mmListOrganizations.defaultExpectation.paramPtrs == nil {
+		mmListOrganizations.defaultExpectation.paramPtrs = &OrganizationServiceMockListOrganizationsParamPtrs{}
+	}
+	mmListOrganizations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOrganizations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOrganizations
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.ListOrganizations
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Inspect(f func(ctx context.Context)) *mOrganizationServiceMockListOrganizations {
+	if mmListOrganizations.mock.inspectFuncListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.ListOrganizations")
+	}
+
+	mmListOrganizations.mock.inspectFuncListOrganizations = f
+
+	return mmListOrganizations
+}
+
+// Return sets up results that will be returned by OrganizationService.ListOrganizations
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Return(opa1 []*model.Organization, err error) *OrganizationServiceMock {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationServiceMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &OrganizationServiceMockListOrganizationsExpectation{mock: mmListOrganizations.mock}
+	}
+	mmListOrganizations.defaultExpectation.results = &OrganizationServiceMockListOrganizationsResults{opa1, err}
+	mmListOrganizations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations.mock
+}
+
+// Set uses given function f to mock the OrganizationService.ListOrganizations method
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Set(f func(ctx context.Context) (opa1 []*model.Organization, err error)) *OrganizationServiceMock {
+	if mmListOrganizations.defaultExpectation != nil {
+		mmListOrganizations.mock.t.Fatalf("Default expectation is already set for the OrganizationService.ListOrganizations method")
+	}
+
+	if len(mmListOrganizations.expectations) > 0 {
+		mmListOrganizations.mock.t.Fatalf("Some expectations are already set for the OrganizationService.ListOrganizations method")
+	}
+
+	mmListOrganizations.mock.funcListOrganizations = f
+	mmListOrganizations.mock.funcListOrganizationsOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations.mock
+}
+
+// When sets expectation for the OrganizationService.ListOrganizations which will trigger the result defined by the following
+// Then helper
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) When(ctx context.Context) *OrganizationServiceMockListOrganizationsExpectation {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("OrganizationServiceMock.ListOrganizations mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockListOrganizationsExpectation{
+		mock:               mmListOrganizations.mock,
+		params:             &OrganizationServiceMockListOrganizationsParams{ctx},
+		expectationOrigins: OrganizationServiceMockListOrganizationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOrganizations.expectations = append(mmListOrganizations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.ListOrganizations return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockListOrganizationsExpectation) Then(opa1 []*model.Organization, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockListOrganizationsResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.ListOrganizations should be invoked
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Times(n uint64) *mOrganizationServiceMockListOrganizations {
+	if n == 0 {
+		mmListOrganizations.mock.t.Fatalf("Times of OrganizationServiceMock.ListOrganizations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOrganizations.expectedInvocations, n)
+	mmListOrganizations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations
+}
+
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) invocationsDone() bool {
+	if len(mmListOrganizations.expectations) == 0 && mmListOrganizations.defaultExpectation == nil && mmListOrganizations.mock.funcListOrganizations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOrganizations.mock.afterListOrganizationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOrganizations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOrganizations implements mm_service.OrganizationService
+func (mmListOrganizations *OrganizationServiceMock) ListOrganizations(ctx context.Context) (opa1 []*model.Organization, err error) {
+	mm_atomic.AddUint64(&mmListOrganizations.beforeListOrganizationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOrganizations.afterListOrganizationsCounter, 1)
+
+	mmListOrganizations.t.Helper()
+
+	if mmListOrganizations.inspectFuncListOrganizations != nil {
+		mmListOrganizations.inspectFuncListOrganizations(ctx)
+	}
+
+	mm_params := OrganizationServiceMockListOrganizationsParams{ctx}
+
+	// Record call args
+	mmListOrganizations.ListOrganizationsMock.mutex.Lock()
+	mmListOrganizations.ListOrganizationsMock.callArgs = append(mmListOrganizations.ListOrganizationsMock.callArgs, &mm_params)
+	mmListOrganizations.ListOrganizationsMock.mutex.Unlock()
+
+	for _, e := range mmListOrganizations.ListOrganizationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmListOrganizations.ListOrganizationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOrganizations.ListOrganizationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOrganizations.ListOrganizationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListOrganizations.ListOrganizationsMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockListOrganizationsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOrganizations.t.Errorf("OrganizationServiceMock.ListOrganizations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrganizations.ListOrganizationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOrganizations.t.Errorf("OrganizationServiceMock.ListOrganizations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOrganizations.ListOrganizationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOrganizations.ListOrganizationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOrganizations.t.Fatal("No results are set for the OrganizationServiceMock.ListOrganizations")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmListOrganizations.funcListOrganizations != nil {
+		return mmListOrganizations.funcListOrganizations(ctx)
+	}
+	mmListOrganizations.t.Fatalf("Unexpected call to OrganizationServiceMock.ListOrganizations. %v", ctx)
+	return
+}
+
+// ListOrganizationsAfterCounter returns a count of finished OrganizationServiceMock.ListOrganizations invocations
+func (mmListOrganizations *OrganizationServiceMock) ListOrganizationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrganizations.afterListOrganizationsCounter)
+}
+
+// ListOrganizationsBeforeCounter returns a count of OrganizationServiceMock.ListOrganizations invocations
+func (mmListOrganizations *OrganizationServiceMock) ListOrganizationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrganizations.beforeListOrganizationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.ListOrganizations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOrganizations *mOrganizationServiceMockListOrganizations) Calls() []*OrganizationServiceMockListOrganizationsParams {
+	mmListOrganizations.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockListOrganizationsParams, len(mmListOrganizations.callArgs))
+	copy(argCopy, mmListOrganizations.callArgs)
+
+	mmListOrganizations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOrganizationsDone returns true if the count of the ListOrganizations invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockListOrganizationsDone() bool {
+	if m.ListOrganizationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOrganizationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOrganizationsMock.invocationsDone()
+}
+
+// MinimockListOrganizationsInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockListOrganizationsInspect() {
+	for _, e := range m.ListOrganizationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.ListOrganizations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOrganizationsCounter := mm_atomic.LoadUint64(&m.afterListOrganizationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOrganizationsMock.defaultExpectation != nil && afterListOrganizationsCounter < 1 {
+		if m.ListOrganizationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.ListOrganizations at\n%s", m.ListOrganizationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.ListOrganizations at\n%s with params: %#v", m.ListOrganizationsMock.defaultExpectation.expectationOrigins.origin, *m.ListOrganizationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOrganizations != nil && afterListOrganizationsCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.ListOrganizations at\n%s", m.funcListOrganizationsOrigin)
+	}
+
+	if !m.ListOrganizationsMock.invocationsDone() && afterListOrganizationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.ListOrganizations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOrganizationsMock.expectedInvocations), m.ListOrganizationsMock.expectedInvocationsOrigin, afterListOrganizationsCounter)
+	}
+}
+
+type mOrganizationServiceMockListRoles struct {
+	optional           bool
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockListRolesExpectation
+	expectations       []*OrganizationServiceMockListRolesExpectation
+
+	callArgs []*OrganizationServiceMockListRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrganizationServiceMockListRolesExpectation specifies expectation struct of the OrganizationService.ListRoles
+type OrganizationServiceMockListRolesExpectation struct {
+	mock               *OrganizationServiceMock
+	params             *OrganizationServiceMockListRolesParams
+	paramPtrs          *OrganizationServiceMockListRolesParamPtrs
+	expectationOrigins OrganizationServiceMockListRolesExpectationOrigins
+	results            *OrganizationServiceMockListRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrganizationServiceMockListRolesParams contains parameters of the OrganizationService.ListRoles
+type OrganizationServiceMockListRolesParams struct {
+	ctx            context.Context
+	organizationID int64
+}
+
+// OrganizationServiceMockListRolesParamPtrs contains pointers to parameters of the OrganizationService.ListRoles
+type OrganizationServiceMockListRolesParamPtrs struct {
+	ctx            *context.Context
+	organizationID *int64
+}
+
+// OrganizationServiceMockListRolesResults contains results of the OrganizationService.ListRoles
+type OrganizationServiceMockListRolesResults struct {
+	rpa1 []*model.Role
+	err  error
+}
+
+// OrganizationServiceMockListRolesOrigins contains origins of expectations of the OrganizationService.ListRoles
+type OrganizationServiceMockListRolesExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originOrganizationID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoles *mOrganizationServiceMockListRoles) Optional() *mOrganizationServiceMockListRoles {
+	mmListRoles.optional = true
+	return mmListRoles
+}
+
+// Expect sets up expected params for OrganizationService.ListRoles
+func (mmListRoles *mOrganizationServiceMockListRoles) Expect(ctx context.Context, organizationID int64) *mOrganizationServiceMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &OrganizationServiceMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListRoles.defaultExpectation.params = &OrganizationServiceMockListRolesParams{ctx, organizationID}
+	mmListRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoles.expectations {
+		if minimock.Equal(e.params, mmListRoles.defaultExpectation.params) {
+			mmListRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.ListRoles
+func (mmListRoles *mOrganizationServiceMockListRoles) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &OrganizationServiceMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.params != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Expect")
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs == nil {
+		mmListRoles.defaultExpectation.paramPtrs = &OrganizationServiceMockListRolesParamPtrs{}
+	}
+	mmListRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoles
+}
+
+// ExpectOrganizationIDParam2 sets up expected param organizationID for OrganizationService.ListRoles
+func (mmListRoles *mOrganizationServiceMockListRoles) ExpectOrganizationIDParam2(organizationID int64) *mOrganizationServiceMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &OrganizationServiceMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.params != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Expect")
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs == nil {
+		mmListRoles.defaultExpectation.paramPtrs = &OrganizationServiceMockListRolesParamPtrs{}
+	}
+	mmListRoles.defaultExpectation.paramPtrs.organizationID = &organizationID
+	mmListRoles.defaultExpectation.expectationOrigins.originOrganizationID = minimock.CallerInfo(1)
+
+	return mmListRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.ListRoles
+func (mmListRoles *mOrganizationServiceMockListRoles) Inspect(f func(ctx context.Context, organizationID int64)) *mOrganizationServiceMockListRoles {
+	if mmListRoles.mock.inspectFuncListRoles != nil {
+		mmListRoles.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.ListRoles")
+	}
+
+	mmListRoles.mock.inspectFuncListRoles = f
+
+	return mmListRoles
+}
+
+// Return sets up results that will be returned by OrganizationService.ListRoles
+func (mmListRoles *mOrganizationServiceMockListRoles) Return(rpa1 []*model.Role, err error) *OrganizationServiceMock {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &OrganizationServiceMockListRolesExpectation{mock: mmListRoles.mock}
+	}
+	mmListRoles.defaultExpectation.results = &OrganizationServiceMockListRolesResults{rpa1, err}
+	mmListRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// Set uses given function f to mock the OrganizationService.ListRoles method
+func (mmListRoles *mOrganizationServiceMockListRoles) Set(f func(ctx context.Context, organizationID int64) (rpa1 []*model.Role, err error)) *OrganizationServiceMock {
+	if mmListRoles.defaultExpectation != nil {
+		mmListRoles.mock.t.Fatalf("Default expectation is already set for the OrganizationService.ListRoles method")
+	}
+
+	if len(mmListRoles.expectations) > 0 {
+		mmListRoles.mock.t.Fatalf("Some expectations are already set for the OrganizationService.ListRoles method")
+	}
+
+	mmListRoles.mock.funcListRoles = f
+	mmListRoles.mock.funcListRolesOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// When sets expectation for the OrganizationService.ListRoles which will trigger the result defined by the following
+// Then helper
+func (mmListRoles *mOrganizationServiceMockListRoles) When(ctx context.Context, organizationID int64) *OrganizationServiceMockListRolesExpectation {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("OrganizationServiceMock.ListRoles mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockListRolesExpectation{
+		mock:               mmListRoles.mock,
+		params:             &OrganizationServiceMockListRolesParams{ctx, organizationID},
+		expectationOrigins: OrganizationServiceMockListRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoles.expectations = append(mmListRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.ListRoles return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockListRolesExpectation) Then(rpa1 []*model.Role, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockListRolesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrganizationService.ListRoles should be invoked
+func (mmListRoles *mOrganizationServiceMockListRoles) Times(n uint64) *mOrganizationServiceMockListRoles {
+	if n == 0 {
+		mmListRoles.mock.t.Fatalf("Times of OrganizationServiceMock.ListRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoles.expectedInvocations, n)
+	mmListRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoles
+}
+
+func (mmListRoles *mOrganizationServiceMockListRoles) invocationsDone() bool {
+	if len(mmListRoles.expectations) == 0 && mmListRoles.defaultExpectation == nil && mmListRoles.mock.funcListRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoles.mock.afterListRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoles implements mm_service.OrganizationService
+func (mmListRoles *OrganizationServiceMock) ListRoles(ctx context.Context, organizationID int64) (rpa1 []*model.Role, err error) {
+	mm_atomic.AddUint64(&mmListRoles.beforeListRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoles.afterListRolesCounter, 1)
+
+	mmListRoles.t.Helper()
+
+	if mmListRoles.inspectFuncListRoles != nil {
+		mmListRoles.inspectFuncListRoles(ctx, organizationID)
+	}
+
+	mm_params := OrganizationServiceMockListRolesParams{ctx, organizationID}
+
+	// Record call args
+	mmListRoles.ListRolesMock.mutex.Lock()
+	mmListRoles.ListRolesMock.callArgs = append(mmListRoles.ListRolesMock.callArgs, &mm_params)
+	mmListRoles.ListRolesMock.mutex.Unlock()
+
+	for _, e := range mmListRoles.ListRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListRoles.ListRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoles.ListRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoles.ListRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoles.ListRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockListRolesParams{ctx, organizationID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoles.t.Errorf("OrganizationServiceMock.ListRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.organizationID != nil && !minimock.Equal(*mm_want_ptrs.organizationID, mm_got.organizationID) {
+				mmListRoles.t.Errorf("OrganizationServiceMock.ListRoles got unexpected parameter organizationID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.originOrganizationID, *mm_want_ptrs.organizationID, mm_got.organizationID, minimock.Diff(*mm_want_ptrs.organizationID, mm_got.organizationID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoles.t.Errorf("OrganizationServiceMock.ListRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoles.ListRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoles.t.Fatal("No results are set for the OrganizationServiceMock.ListRoles")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListRoles.funcListRoles != nil {
+		return mmListRoles.funcListRoles(ctx, organizationID)
+	}
+	mmListRoles.t.Fatalf("Unexpected call to OrganizationServiceMock.ListRoles. %v %v", ctx, organizationID)
+	return
+}
+
+// ListRolesAfterCounter returns a count of finished OrganizationServiceMock.ListRoles invocations
+func (mmListRoles *OrganizationServiceMock) ListRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.afterListRolesCounter)
+}
+
+// ListRolesBeforeCounter returns a count of OrganizationServiceMock.ListRoles invocations
+func (mmListRoles *OrganizationServiceMock) ListRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.beforeListRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.ListRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoles *mOrganizationServiceMockListRoles) Calls() []*OrganizationServiceMockListRolesParams {
+	mmListRoles.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockListRolesParams, len(mmListRoles.callArgs))
+	copy(argCopy, mmListRoles.callArgs)
+
+	mmListRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRolesDone returns true if the count of the ListRoles invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockListRolesDone() bool {
+	if m.ListRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRolesMock.invocationsDone()
+}
+
+// MinimockListRolesInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockListRolesInspect() {
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.ListRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRolesCounter := mm_atomic.LoadUint64(&m.afterListRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRolesMock.defaultExpectation != nil && afterListRolesCounter < 1 {
+		if m.ListRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrganizationServiceMock.ListRoles at\n%s", m.ListRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.ListRoles at\n%s with params: %#v", m.ListRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoles != nil && afterListRolesCounter < 1 {
+		m.t.Errorf("Expected call to OrganizationServiceMock.ListRoles at\n%s", m.funcListRolesOrigin)
+	}
+
+	if !m.ListRolesMock.invocationsDone() && afterListRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrganizationServiceMock.ListRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRolesMock.expectedInvocations), m.ListRolesMock.expectedInvocationsOrigin, afterListRolesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrganizationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateOrganizationInspect()
+
+			m.MinimockCreateRoleInspect()
+
+			m.MinimockDeleteOrganizationInspect()
+
+			m.MinimockDeleteRoleInspect()
+
+			m.MinimockGetOrganizationInspect()
+
+			m.MinimockListOrganizationsInspect()
+
+			m.MinimockListRolesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OrganizationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OrganizationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateOrganizationDone() &&
+		m.MinimockCreateRoleDone() &&
+		m.MinimockDeleteOrganizationDone() &&
+		m.MinimockDeleteRoleDone() &&
+		m.MinimockGetOrganizationDone() &&
+		m.MinimockListOrganizationsDone() &&
+		m.MinimockListRolesDone()
+}
@@ -0,0 +1,6603 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.PolicyService -o policy_service_minimock.go -n PolicyServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// PolicyServiceMock implements mm_service.PolicyService
+type PolicyServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddRoleParent          func(ctx context.Context, accessToken string, parent *model.RoleParent) (err error)
+	funcAddRoleParentOrigin    string
+	inspectFuncAddRoleParent   func(ctx context.Context, accessToken string, parent *model.RoleParent)
+	afterAddRoleParentCounter  uint64
+	beforeAddRoleParentCounter uint64
+	AddRoleParentMock          mPolicyServiceMockAddRoleParent
+
+	funcApplyPolicy          func(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool) (pp1 *model.PolicyApplyResult, err error)
+	funcApplyPolicyOrigin    string
+	inspectFuncApplyPolicy   func(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool)
+	afterApplyPolicyCounter  uint64
+	beforeApplyPolicyCounter uint64
+	ApplyPolicyMock          mPolicyServiceMockApplyPolicy
+
+	funcCreatePermission          func(ctx context.Context, accessToken string, permission *model.Permission) (i1 int64, err error)
+	funcCreatePermissionOrigin    string
+	inspectFuncCreatePermission   func(ctx context.Context, accessToken string, permission *model.Permission)
+	afterCreatePermissionCounter  uint64
+	beforeCreatePermissionCounter uint64
+	CreatePermissionMock          mPolicyServiceMockCreatePermission
+
+	funcCreateRule          func(ctx context.Context, accessToken string, rule *model.AccessRule) (i1 int64, err error)
+	funcCreateRuleOrigin    string
+	inspectFuncCreateRule   func(ctx context.Context, accessToken string, rule *model.AccessRule)
+	afterCreateRuleCounter  uint64
+	beforeCreateRuleCounter uint64
+	CreateRuleMock          mPolicyServiceMockCreateRule
+
+	funcDeletePermission          func(ctx context.Context, accessToken string, name string) (err error)
+	funcDeletePermissionOrigin    string
+	inspectFuncDeletePermission   func(ctx context.Context, accessToken string, name string)
+	afterDeletePermissionCounter  uint64
+	beforeDeletePermissionCounter uint64
+	DeletePermissionMock          mPolicyServiceMockDeletePermission
+
+	funcDeleteRoleParent          func(ctx context.Context, accessToken string, parent *model.RoleParent) (err error)
+	funcDeleteRoleParentOrigin    string
+	inspectFuncDeleteRoleParent   func(ctx context.Context, accessToken string, parent *model.RoleParent)
+	afterDeleteRoleParentCounter  uint64
+	beforeDeleteRoleParentCounter uint64
+	DeleteRoleParentMock          mPolicyServiceMockDeleteRoleParent
+
+	funcDeleteRule          func(ctx context.Context, accessToken string, id int64) (err error)
+	funcDeleteRuleOrigin    string
+	inspectFuncDeleteRule   func(ctx context.Context, accessToken string, id int64)
+	afterDeleteRuleCounter  uint64
+	beforeDeleteRuleCounter uint64
+	DeleteRuleMock          mPolicyServiceMockDeleteRule
+
+	funcExportPolicy          func(ctx context.Context, accessToken string) (pp1 *model.PolicyBundle, err error)
+	funcExportPolicyOrigin    string
+	inspectFuncExportPolicy   func(ctx context.Context, accessToken string)
+	afterExportPolicyCounter  uint64
+	beforeExportPolicyCounter uint64
+	ExportPolicyMock          mPolicyServiceMockExportPolicy
+
+	funcGetRolePermissions          func(ctx context.Context, accessToken string, role string) (rp1 *model.RolePermissions, err error)
+	funcGetRolePermissionsOrigin    string
+	inspectFuncGetRolePermissions   func(ctx context.Context, accessToken string, role string)
+	afterGetRolePermissionsCounter  uint64
+	beforeGetRolePermissionsCounter uint64
+	GetRolePermissionsMock          mPolicyServiceMockGetRolePermissions
+
+	funcGrantPermission          func(ctx context.Context, accessToken string, grant *model.RolePermission) (err error)
+	funcGrantPermissionOrigin    string
+	inspectFuncGrantPermission   func(ctx context.Context, accessToken string, grant *model.RolePermission)
+	afterGrantPermissionCounter  uint64
+	beforeGrantPermissionCounter uint64
+	GrantPermissionMock          mPolicyServiceMockGrantPermission
+
+	funcListPermissions          func(ctx context.Context, accessToken string) (ppa1 []*model.Permission, err error)
+	funcListPermissionsOrigin    string
+	inspectFuncListPermissions   func(ctx context.Context, accessToken string)
+	afterListPermissionsCounter  uint64
+	beforeListPermissionsCounter uint64
+	ListPermissionsMock          mPolicyServiceMockListPermissions
+
+	funcListPolicyVersions          func(ctx context.Context, accessToken string) (ppa1 []*model.PolicyBundleVersion, err error)
+	funcListPolicyVersionsOrigin    string
+	inspectFuncListPolicyVersions   func(ctx context.Context, accessToken string)
+	afterListPolicyVersionsCounter  uint64
+	beforeListPolicyVersionsCounter uint64
+	ListPolicyVersionsMock          mPolicyServiceMockListPolicyVersions
+
+	funcListRules          func(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) (apa1 []*model.AccessRule, err error)
+	funcListRulesOrigin    string
+	inspectFuncListRules   func(ctx context.Context, accessToken string, filter *model.AccessRuleFilter)
+	afterListRulesCounter  uint64
+	beforeListRulesCounter uint64
+	ListRulesMock          mPolicyServiceMockListRules
+
+	funcRevokePermission          func(ctx context.Context, accessToken string, grant *model.RolePermission) (err error)
+	funcRevokePermissionOrigin    string
+	inspectFuncRevokePermission   func(ctx context.Context, accessToken string, grant *model.RolePermission)
+	afterRevokePermissionCounter  uint64
+	beforeRevokePermissionCounter uint64
+	RevokePermissionMock          mPolicyServiceMockRevokePermission
+
+	funcRollbackPolicy          func(ctx context.Context, accessToken string, version int64, dryRun bool) (pp1 *model.PolicyApplyResult, err error)
+	funcRollbackPolicyOrigin    string
+	inspectFuncRollbackPolicy   func(ctx context.Context, accessToken string, version int64, dryRun bool)
+	afterRollbackPolicyCounter  uint64
+	beforeRollbackPolicyCounter uint64
+	RollbackPolicyMock          mPolicyServiceMockRollbackPolicy
+
+	funcSimulateCheck          func(ctx context.Context, accessToken string, simulation *model.AccessSimulation) (ap1 *model.AccessDecision, err error)
+	funcSimulateCheckOrigin    string
+	inspectFuncSimulateCheck   func(ctx context.Context, accessToken string, simulation *model.AccessSimulation)
+	afterSimulateCheckCounter  uint64
+	beforeSimulateCheckCounter uint64
+	SimulateCheckMock          mPolicyServiceMockSimulateCheck
+
+	funcUpdateRule          func(ctx context.Context, accessToken string, update *model.UpdateAccessRule) (err error)
+	funcUpdateRuleOrigin    string
+	inspectFuncUpdateRule   func(ctx context.Context, accessToken string, update *model.UpdateAccessRule)
+	afterUpdateRuleCounter  uint64
+	beforeUpdateRuleCounter uint64
+	UpdateRuleMock          mPolicyServiceMockUpdateRule
+}
+
+// NewPolicyServiceMock returns a mock for mm_service.PolicyService
+func NewPolicyServiceMock(t minimock.Tester) *PolicyServiceMock {
+	m := &PolicyServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddRoleParentMock = mPolicyServiceMockAddRoleParent{mock: m}
+	m.AddRoleParentMock.callArgs = []*PolicyServiceMockAddRoleParentParams{}
+
+	m.ApplyPolicyMock = mPolicyServiceMockApplyPolicy{mock: m}
+	m.ApplyPolicyMock.callArgs = []*PolicyServiceMockApplyPolicyParams{}
+
+	m.CreatePermissionMock = mPolicyServiceMockCreatePermission{mock: m}
+	m.CreatePermissionMock.callArgs = []*PolicyServiceMockCreatePermissionParams{}
+
+	m.CreateRuleMock = mPolicyServiceMockCreateRule{mock: m}
+	m.CreateRuleMock.callArgs = []*PolicyServiceMockCreateRuleParams{}
+
+	m.DeletePermissionMock = mPolicyServiceMockDeletePermission{mock: m}
+	m.DeletePermissionMock.callArgs = []*PolicyServiceMockDeletePermissionParams{}
+
+	m.DeleteRoleParentMock = mPolicyServiceMockDeleteRoleParent{mock: m}
+	m.DeleteRoleParentMock.callArgs = []*PolicyServiceMockDeleteRoleParentParams{}
+
+	m.DeleteRuleMock = mPolicyServiceMockDeleteRule{mock: m}
+	m.DeleteRuleMock.callArgs = []*PolicyServiceMockDeleteRuleParams{}
+
+	m.ExportPolicyMock = mPolicyServiceMockExportPolicy{mock: m}
+	m.ExportPolicyMock.callArgs = []*PolicyServiceMockExportPolicyParams{}
+
+	m.GetRolePermissionsMock = mPolicyServiceMockGetRolePermissions{mock: m}
+	m.GetRolePermissionsMock.callArgs = []*PolicyServiceMockGetRolePermissionsParams{}
+
+	m.GrantPermissionMock = mPolicyServiceMockGrantPermission{mock: m}
+	m.GrantPermissionMock.callArgs = []*PolicyServiceMockGrantPermissionParams{}
+
+	m.ListPermissionsMock = mPolicyServiceMockListPermissions{mock: m}
+	m.ListPermissionsMock.callArgs = []*PolicyServiceMockListPermissionsParams{}
+
+	m.ListPolicyVersionsMock = mPolicyServiceMockListPolicyVersions{mock: m}
+	m.ListPolicyVersionsMock.callArgs = []*PolicyServiceMockListPolicyVersionsParams{}
+
+	m.ListRulesMock = mPolicyServiceMockListRules{mock: m}
+	m.ListRulesMock.callArgs = []*PolicyServiceMockListRulesParams{}
+
+	m.RevokePermissionMock = mPolicyServiceMockRevokePermission{mock: m}
+	m.RevokePermissionMock.callArgs = []*PolicyServiceMockRevokePermissionParams{}
+
+	m.RollbackPolicyMock = mPolicyServiceMockRollbackPolicy{mock: m}
+	m.RollbackPolicyMock.callArgs = []*PolicyServiceMockRollbackPolicyParams{}
+
+	m.SimulateCheckMock = mPolicyServiceMockSimulateCheck{mock: m}
+	m.SimulateCheckMock.callArgs = []*PolicyServiceMockSimulateCheckParams{}
+
+	m.UpdateRuleMock = mPolicyServiceMockUpdateRule{mock: m}
+	m.UpdateRuleMock.callArgs = []*PolicyServiceMockUpdateRuleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPolicyServiceMockAddRoleParent struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockAddRoleParentExpectation
+	expectations       []*PolicyServiceMockAddRoleParentExpectation
+
+	callArgs []*PolicyServiceMockAddRoleParentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockAddRoleParentExpectation specifies expectation struct of the PolicyService.AddRoleParent
+type PolicyServiceMockAddRoleParentExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockAddRoleParentParams
+	paramPtrs          *PolicyServiceMockAddRoleParentParamPtrs
+	expectationOrigins PolicyServiceMockAddRoleParentExpectationOrigins
+	results            *PolicyServiceMockAddRoleParentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockAddRoleParentParams contains parameters of the PolicyService.AddRoleParent
+type PolicyServiceMockAddRoleParentParams struct {
+	ctx         context.Context
+	accessToken string
+	parent      *model.RoleParent
+}
+
+// PolicyServiceMockAddRoleParentParamPtrs contains pointers to parameters of the PolicyService.AddRoleParent
+type PolicyServiceMockAddRoleParentParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	parent      **model.RoleParent
+}
+
+// PolicyServiceMockAddRoleParentResults contains results of the PolicyService.AddRoleParent
+type PolicyServiceMockAddRoleParentResults struct {
+	err error
+}
+
+// PolicyServiceMockAddRoleParentOrigins contains origins of expectations of the PolicyService.AddRoleParent
+type PolicyServiceMockAddRoleParentExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originParent      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Optional() *mPolicyServiceMockAddRoleParent {
+	mmAddRoleParent.optional = true
+	return mmAddRoleParent
+}
+
+// Expect sets up expected params for PolicyService.AddRoleParent
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Expect(ctx context.Context, accessToken string, parent *model.RoleParent) *mPolicyServiceMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &PolicyServiceMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by ExpectParams functions")
+	}
+
+	mmAddRoleParent.defaultExpectation.params = &PolicyServiceMockAddRoleParentParams{ctx, accessToken, parent}
+	mmAddRoleParent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddRoleParent.expectations {
+		if minimock.Equal(e.params, mmAddRoleParent.defaultExpectation.params) {
+			mmAddRoleParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddRoleParent.defaultExpectation.params)
+		}
+	}
+
+	return mmAddRoleParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.AddRoleParent
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &PolicyServiceMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.params != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Expect")
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs == nil {
+		mmAddRoleParent.defaultExpectation.paramPtrs = &PolicyServiceMockAddRoleParentParamPtrs{}
+	}
+	mmAddRoleParent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddRoleParent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddRoleParent
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.AddRoleParent
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &PolicyServiceMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.params != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Expect")
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs == nil {
+		mmAddRoleParent.defaultExpectation.paramPtrs = &PolicyServiceMockAddRoleParentParamPtrs{}
+	}
+	mmAddRoleParent.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmAddRoleParent.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmAddRoleParent
+}
+
+// ExpectParentParam3 sets up expected param parent for PolicyService.AddRoleParent
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) ExpectParentParam3(parent *model.RoleParent) *mPolicyServiceMockAddRoleParent {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &PolicyServiceMockAddRoleParentExpectation{}
+	}
+
+	if mmAddRoleParent.defaultExpectation.params != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Expect")
+	}
+
+	if mmAddRoleParent.defaultExpectation.paramPtrs == nil {
+		mmAddRoleParent.defaultExpectation.paramPtrs = &PolicyServiceMockAddRoleParentParamPtrs{}
+	}
+	mmAddRoleParent.defaultExpectation.paramPtrs.parent = &parent
+	mmAddRoleParent.defaultExpectation.expectationOrigins.originParent = minimock.CallerInfo(1)
+
+	return mmAddRoleParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.AddRoleParent
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Inspect(f func(ctx context.Context, accessToken string, parent *model.RoleParent)) *mPolicyServiceMockAddRoleParent {
+	if mmAddRoleParent.mock.inspectFuncAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.AddRoleParent")
+	}
+
+	mmAddRoleParent.mock.inspectFuncAddRoleParent = f
+
+	return mmAddRoleParent
+}
+
+// Return sets up results that will be returned by PolicyService.AddRoleParent
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Return(err error) *PolicyServiceMock {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Set")
+	}
+
+	if mmAddRoleParent.defaultExpectation == nil {
+		mmAddRoleParent.defaultExpectation = &PolicyServiceMockAddRoleParentExpectation{mock: mmAddRoleParent.mock}
+	}
+	mmAddRoleParent.defaultExpectation.results = &PolicyServiceMockAddRoleParentResults{err}
+	mmAddRoleParent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddRoleParent.mock
+}
+
+// Set uses given function f to mock the PolicyService.AddRoleParent method
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Set(f func(ctx context.Context, accessToken string, parent *model.RoleParent) (err error)) *PolicyServiceMock {
+	if mmAddRoleParent.defaultExpectation != nil {
+		mmAddRoleParent.mock.t.Fatalf("Default expectation is already set for the PolicyService.AddRoleParent method")
+	}
+
+	if len(mmAddRoleParent.expectations) > 0 {
+		mmAddRoleParent.mock.t.Fatalf("Some expectations are already set for the PolicyService.AddRoleParent method")
+	}
+
+	mmAddRoleParent.mock.funcAddRoleParent = f
+	mmAddRoleParent.mock.funcAddRoleParentOrigin = minimock.CallerInfo(1)
+	return mmAddRoleParent.mock
+}
+
+// When sets expectation for the PolicyService.AddRoleParent which will trigger the result defined by the following
+// Then helper
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) When(ctx context.Context, accessToken string, parent *model.RoleParent) *PolicyServiceMockAddRoleParentExpectation {
+	if mmAddRoleParent.mock.funcAddRoleParent != nil {
+		mmAddRoleParent.mock.t.Fatalf("PolicyServiceMock.AddRoleParent mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockAddRoleParentExpectation{
+		mock:               mmAddRoleParent.mock,
+		params:             &PolicyServiceMockAddRoleParentParams{ctx, accessToken, parent},
+		expectationOrigins: PolicyServiceMockAddRoleParentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddRoleParent.expectations = append(mmAddRoleParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.AddRoleParent return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockAddRoleParentExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockAddRoleParentResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.AddRoleParent should be invoked
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Times(n uint64) *mPolicyServiceMockAddRoleParent {
+	if n == 0 {
+		mmAddRoleParent.mock.t.Fatalf("Times of PolicyServiceMock.AddRoleParent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddRoleParent.expectedInvocations, n)
+	mmAddRoleParent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddRoleParent
+}
+
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) invocationsDone() bool {
+	if len(mmAddRoleParent.expectations) == 0 && mmAddRoleParent.defaultExpectation == nil && mmAddRoleParent.mock.funcAddRoleParent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddRoleParent.mock.afterAddRoleParentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddRoleParent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddRoleParent implements mm_service.PolicyService
+func (mmAddRoleParent *PolicyServiceMock) AddRoleParent(ctx context.Context, accessToken string, parent *model.RoleParent) (err error) {
+	mm_atomic.AddUint64(&mmAddRoleParent.beforeAddRoleParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRoleParent.afterAddRoleParentCounter, 1)
+
+	mmAddRoleParent.t.Helper()
+
+	if mmAddRoleParent.inspectFuncAddRoleParent != nil {
+		mmAddRoleParent.inspectFuncAddRoleParent(ctx, accessToken, parent)
+	}
+
+	mm_params := PolicyServiceMockAddRoleParentParams{ctx, accessToken, parent}
+
+	// Record call args
+	mmAddRoleParent.AddRoleParentMock.mutex.Lock()
+	mmAddRoleParent.AddRoleParentMock.callArgs = append(mmAddRoleParent.AddRoleParentMock.callArgs, &mm_params)
+	mmAddRoleParent.AddRoleParentMock.mutex.Unlock()
+
+	for _, e := range mmAddRoleParent.AddRoleParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddRoleParent.AddRoleParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRoleParent.AddRoleParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRoleParent.AddRoleParentMock.defaultExpectation.params
+		mm_want_ptrs := mmAddRoleParent.AddRoleParentMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockAddRoleParentParams{ctx, accessToken, parent}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddRoleParent.t.Errorf("PolicyServiceMock.AddRoleParent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmAddRoleParent.t.Errorf("PolicyServiceMock.AddRoleParent got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.parent != nil && !minimock.Equal(*mm_want_ptrs.parent, mm_got.parent) {
+				mmAddRoleParent.t.Errorf("PolicyServiceMock.AddRoleParent got unexpected parameter parent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.originParent, *mm_want_ptrs.parent, mm_got.parent, minimock.Diff(*mm_want_ptrs.parent, mm_got.parent))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddRoleParent.t.Errorf("PolicyServiceMock.AddRoleParent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddRoleParent.AddRoleParentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddRoleParent.AddRoleParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddRoleParent.t.Fatal("No results are set for the PolicyServiceMock.AddRoleParent")
+		}
+		return (*mm_results).err
+	}
+	if mmAddRoleParent.funcAddRoleParent != nil {
+		return mmAddRoleParent.funcAddRoleParent(ctx, accessToken, parent)
+	}
+	mmAddRoleParent.t.Fatalf("Unexpected call to PolicyServiceMock.AddRoleParent. %v %v %v", ctx, accessToken, parent)
+	return
+}
+
+// AddRoleParentAfterCounter returns a count of finished PolicyServiceMock.AddRoleParent invocations
+func (mmAddRoleParent *PolicyServiceMock) AddRoleParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRoleParent.afterAddRoleParentCounter)
+}
+
+// AddRoleParentBeforeCounter returns a count of PolicyServiceMock.AddRoleParent invocations
+func (mmAddRoleParent *PolicyServiceMock) AddRoleParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRoleParent.beforeAddRoleParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.AddRoleParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddRoleParent *mPolicyServiceMockAddRoleParent) Calls() []*PolicyServiceMockAddRoleParentParams {
+	mmAddRoleParent.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockAddRoleParentParams, len(mmAddRoleParent.callArgs))
+	copy(argCopy, mmAddRoleParent.callArgs)
+
+	mmAddRoleParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddRoleParentDone returns true if the count of the AddRoleParent invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockAddRoleParentDone() bool {
+	if m.AddRoleParentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddRoleParentMock.invocationsDone()
+}
+
+// MinimockAddRoleParentInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockAddRoleParentInspect() {
+	for _, e := range m.AddRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.AddRoleParent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddRoleParentCounter := mm_atomic.LoadUint64(&m.afterAddRoleParentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddRoleParentMock.defaultExpectation != nil && afterAddRoleParentCounter < 1 {
+		if m.AddRoleParentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.AddRoleParent at\n%s", m.AddRoleParentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.AddRoleParent at\n%s with params: %#v", m.AddRoleParentMock.defaultExpectation.expectationOrigins.origin, *m.AddRoleParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddRoleParent != nil && afterAddRoleParentCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.AddRoleParent at\n%s", m.funcAddRoleParentOrigin)
+	}
+
+	if !m.AddRoleParentMock.invocationsDone() && afterAddRoleParentCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.AddRoleParent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRoleParentMock.expectedInvocations), m.AddRoleParentMock.expectedInvocationsOrigin, afterAddRoleParentCounter)
+	}
+}
+
+type mPolicyServiceMockApplyPolicy struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockApplyPolicyExpectation
+	expectations       []*PolicyServiceMockApplyPolicyExpectation
+
+	callArgs []*PolicyServiceMockApplyPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockApplyPolicyExpectation specifies expectation struct of the PolicyService.ApplyPolicy
+type PolicyServiceMockApplyPolicyExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockApplyPolicyParams
+	paramPtrs          *PolicyServiceMockApplyPolicyParamPtrs
+	expectationOrigins PolicyServiceMockApplyPolicyExpectationOrigins
+	results            *PolicyServiceMockApplyPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockApplyPolicyParams contains parameters of the PolicyService.ApplyPolicy
+type PolicyServiceMockApplyPolicyParams struct {
+	ctx         context.Context
+	accessToken string
+	bundle      *model.PolicyBundle
+	dryRun      bool
+}
+
+// PolicyServiceMockApplyPolicyParamPtrs contains pointers to parameters of the PolicyService.ApplyPolicy
+type PolicyServiceMockApplyPolicyParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	bundle      **model.PolicyBundle
+	dryRun      *bool
+}
+
+// PolicyServiceMockApplyPolicyResults contains results of the PolicyService.ApplyPolicy
+type PolicyServiceMockApplyPolicyResults struct {
+	pp1 *model.PolicyApplyResult
+	err error
+}
+
+// PolicyServiceMockApplyPolicyOrigins contains origins of expectations of the PolicyService.ApplyPolicy
+type PolicyServiceMockApplyPolicyExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originBundle      string
+	originDryRun      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Optional() *mPolicyServiceMockApplyPolicy {
+	mmApplyPolicy.optional = true
+	return mmApplyPolicy
+}
+
+// Expect sets up expected params for PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Expect(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool) *mPolicyServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &PolicyServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmApplyPolicy.defaultExpectation.params = &PolicyServiceMockApplyPolicyParams{ctx, accessToken, bundle, dryRun}
+	mmApplyPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPolicy.expectations {
+		if minimock.Equal(e.params, mmApplyPolicy.defaultExpectation.params) {
+			mmApplyPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &PolicyServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyPolicy
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &PolicyServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmApplyPolicy.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmApplyPolicy
+}
+
+// ExpectBundleParam3 sets up expected param bundle for PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) ExpectBundleParam3(bundle *model.PolicyBundle) *mPolicyServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &PolicyServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.bundle = &bundle
+	mmApplyPolicy.defaultExpectation.expectationOrigins.originBundle = minimock.CallerInfo(1)
+
+	return mmApplyPolicy
+}
+
+// ExpectDryRunParam4 sets up expected param dryRun for PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) ExpectDryRunParam4(dryRun bool) *mPolicyServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &PolicyServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.dryRun = &dryRun
+	mmApplyPolicy.defaultExpectation.expectationOrigins.originDryRun = minimock.CallerInfo(1)
+
+	return mmApplyPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Inspect(f func(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool)) *mPolicyServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.inspectFuncApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.ApplyPolicy")
+	}
+
+	mmApplyPolicy.mock.inspectFuncApplyPolicy = f
+
+	return mmApplyPolicy
+}
+
+// Return sets up results that will be returned by PolicyService.ApplyPolicy
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Return(pp1 *model.PolicyApplyResult, err error) *PolicyServiceMock {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &PolicyServiceMockApplyPolicyExpectation{mock: mmApplyPolicy.mock}
+	}
+	mmApplyPolicy.defaultExpectation.results = &PolicyServiceMockApplyPolicyResults{pp1, err}
+	mmApplyPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPolicy.mock
+}
+
+// Set uses given function f to mock the PolicyService.ApplyPolicy method
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Set(f func(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool) (pp1 *model.PolicyApplyResult, err error)) *PolicyServiceMock {
+	if mmApplyPolicy.defaultExpectation != nil {
+		mmApplyPolicy.mock.t.Fatalf("Default expectation is already set for the PolicyService.ApplyPolicy method")
+	}
+
+	if len(mmApplyPolicy.expectations) > 0 {
+		mmApplyPolicy.mock.t.Fatalf("Some expectations are already set for the PolicyService.ApplyPolicy method")
+	}
+
+	mmApplyPolicy.mock.funcApplyPolicy = f
+	mmApplyPolicy.mock.funcApplyPolicyOrigin = minimock.CallerInfo(1)
+	return mmApplyPolicy.mock
+}
+
+// When sets expectation for the PolicyService.ApplyPolicy which will trigger the result defined by the following
+// Then helper
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) When(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool) *PolicyServiceMockApplyPolicyExpectation {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("PolicyServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockApplyPolicyExpectation{
+		mock:               mmApplyPolicy.mock,
+		params:             &PolicyServiceMockApplyPolicyParams{ctx, accessToken, bundle, dryRun},
+		expectationOrigins: PolicyServiceMockApplyPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApplyPolicy.expectations = append(mmApplyPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.ApplyPolicy return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockApplyPolicyExpectation) Then(pp1 *model.PolicyApplyResult, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockApplyPolicyResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.ApplyPolicy should be invoked
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Times(n uint64) *mPolicyServiceMockApplyPolicy {
+	if n == 0 {
+		mmApplyPolicy.mock.t.Fatalf("Times of PolicyServiceMock.ApplyPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyPolicy.expectedInvocations, n)
+	mmApplyPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPolicy
+}
+
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) invocationsDone() bool {
+	if len(mmApplyPolicy.expectations) == 0 && mmApplyPolicy.defaultExpectation == nil && mmApplyPolicy.mock.funcApplyPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPolicy.mock.afterApplyPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyPolicy implements mm_service.PolicyService
+func (mmApplyPolicy *PolicyServiceMock) ApplyPolicy(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool) (pp1 *model.PolicyApplyResult, err error) {
+	mm_atomic.AddUint64(&mmApplyPolicy.beforeApplyPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPolicy.afterApplyPolicyCounter, 1)
+
+	mmApplyPolicy.t.Helper()
+
+	if mmApplyPolicy.inspectFuncApplyPolicy != nil {
+		mmApplyPolicy.inspectFuncApplyPolicy(ctx, accessToken, bundle, dryRun)
+	}
+
+	mm_params := PolicyServiceMockApplyPolicyParams{ctx, accessToken, bundle, dryRun}
+
+	// Record call args
+	mmApplyPolicy.ApplyPolicyMock.mutex.Lock()
+	mmApplyPolicy.ApplyPolicyMock.callArgs = append(mmApplyPolicy.ApplyPolicyMock.callArgs, &mm_params)
+	mmApplyPolicy.ApplyPolicyMock.mutex.Unlock()
+
+	for _, e := range mmApplyPolicy.ApplyPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmApplyPolicy.ApplyPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPolicy.ApplyPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPolicy.ApplyPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPolicy.ApplyPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockApplyPolicyParams{ctx, accessToken, bundle, dryRun}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyPolicy.t.Errorf("PolicyServiceMock.ApplyPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPolicy.ApplyPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmApplyPolicy.t.Errorf("PolicyServiceMock.ApplyPolicy got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPolicy.ApplyPolicyMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.bundle != nil && !minimock.Equal(*mm_want_ptrs.bundle, mm_got.bundle) {
+				mmApplyPolicy.t.Errorf("PolicyServiceMock.ApplyPolicy got unexpected parameter bundle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPolicy.ApplyPolicyMock.defaultExpectation.expectationOrigins.originBundle, *mm_want_ptrs.bundle, mm_got.bundle, minimock.Diff(*mm_want_ptrs.bundle, mm_got.bundle))
+			}
+
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmApplyPolicy.t.Errorf("PolicyServiceMock.ApplyPolicy got unexpected parameter dryRun, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPolicy.ApplyPolicyMock.defaultExpectation.expectationOrigins.originDryRun, *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyPolicy.t.Errorf("PolicyServiceMock.ApplyPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPolicy.ApplyPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyPolicy.ApplyPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyPolicy.t.Fatal("No results are set for the PolicyServiceMock.ApplyPolicy")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmApplyPolicy.funcApplyPolicy != nil {
+		return mmApplyPolicy.funcApplyPolicy(ctx, accessToken, bundle, dryRun)
+	}
+	mmApplyPolicy.t.Fatalf("Unexpected call to PolicyServiceMock.ApplyPolicy. %v %v %v %v", ctx, accessToken, bundle, dryRun)
+	return
+}
+
+// ApplyPolicyAfterCounter returns a count of finished PolicyServiceMock.ApplyPolicy invocations
+func (mmApplyPolicy *PolicyServiceMock) ApplyPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPolicy.afterApplyPolicyCounter)
+}
+
+// ApplyPolicyBeforeCounter returns a count of PolicyServiceMock.ApplyPolicy invocations
+func (mmApplyPolicy *PolicyServiceMock) ApplyPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPolicy.beforeApplyPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.ApplyPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyPolicy *mPolicyServiceMockApplyPolicy) Calls() []*PolicyServiceMockApplyPolicyParams {
+	mmApplyPolicy.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockApplyPolicyParams, len(mmApplyPolicy.callArgs))
+	copy(argCopy, mmApplyPolicy.callArgs)
+
+	mmApplyPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyPolicyDone returns true if the count of the ApplyPolicy invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockApplyPolicyDone() bool {
+	if m.ApplyPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyPolicyMock.invocationsDone()
+}
+
+// MinimockApplyPolicyInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockApplyPolicyInspect() {
+	for _, e := range m.ApplyPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.ApplyPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyPolicyCounter := mm_atomic.LoadUint64(&m.afterApplyPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyPolicyMock.defaultExpectation != nil && afterApplyPolicyCounter < 1 {
+		if m.ApplyPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.ApplyPolicy at\n%s", m.ApplyPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.ApplyPolicy at\n%s with params: %#v", m.ApplyPolicyMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyPolicy != nil && afterApplyPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.ApplyPolicy at\n%s", m.funcApplyPolicyOrigin)
+	}
+
+	if !m.ApplyPolicyMock.invocationsDone() && afterApplyPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.ApplyPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPolicyMock.expectedInvocations), m.ApplyPolicyMock.expectedInvocationsOrigin, afterApplyPolicyCounter)
+	}
+}
+
+type mPolicyServiceMockCreatePermission struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockCreatePermissionExpectation
+	expectations       []*PolicyServiceMockCreatePermissionExpectation
+
+	callArgs []*PolicyServiceMockCreatePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockCreatePermissionExpectation specifies expectation struct of the PolicyService.CreatePermission
+type PolicyServiceMockCreatePermissionExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockCreatePermissionParams
+	paramPtrs          *PolicyServiceMockCreatePermissionParamPtrs
+	expectationOrigins PolicyServiceMockCreatePermissionExpectationOrigins
+	results            *PolicyServiceMockCreatePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockCreatePermissionParams contains parameters of the PolicyService.CreatePermission
+type PolicyServiceMockCreatePermissionParams struct {
+	ctx         context.Context
+	accessToken string
+	permission  *model.Permission
+}
+
+// PolicyServiceMockCreatePermissionParamPtrs contains pointers to parameters of the PolicyService.CreatePermission
+type PolicyServiceMockCreatePermissionParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	permission  **model.Permission
+}
+
+// PolicyServiceMockCreatePermissionResults contains results of the PolicyService.CreatePermission
+type PolicyServiceMockCreatePermissionResults struct {
+	i1  int64
+	err error
+}
+
+// PolicyServiceMockCreatePermissionOrigins contains origins of expectations of the PolicyService.CreatePermission
+type PolicyServiceMockCreatePermissionExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originPermission  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Optional() *mPolicyServiceMockCreatePermission {
+	mmCreatePermission.optional = true
+	return mmCreatePermission
+}
+
+// Expect sets up expected params for PolicyService.CreatePermission
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Expect(ctx context.Context, accessToken string, permission *model.Permission) *mPolicyServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PolicyServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePermission.defaultExpectation.params = &PolicyServiceMockCreatePermissionParams{ctx, accessToken, permission}
+	mmCreatePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePermission.expectations {
+		if minimock.Equal(e.params, mmCreatePermission.defaultExpectation.params) {
+			mmCreatePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.CreatePermission
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PolicyServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &PolicyServiceMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePermission
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.CreatePermission
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PolicyServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &PolicyServiceMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmCreatePermission.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmCreatePermission
+}
+
+// ExpectPermissionParam3 sets up expected param permission for PolicyService.CreatePermission
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) ExpectPermissionParam3(permission *model.Permission) *mPolicyServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PolicyServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &PolicyServiceMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.permission = &permission
+	mmCreatePermission.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmCreatePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.CreatePermission
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Inspect(f func(ctx context.Context, accessToken string, permission *model.Permission)) *mPolicyServiceMockCreatePermission {
+	if mmCreatePermission.mock.inspectFuncCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.CreatePermission")
+	}
+
+	mmCreatePermission.mock.inspectFuncCreatePermission = f
+
+	return mmCreatePermission
+}
+
+// Return sets up results that will be returned by PolicyService.CreatePermission
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Return(i1 int64, err error) *PolicyServiceMock {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &PolicyServiceMockCreatePermissionExpectation{mock: mmCreatePermission.mock}
+	}
+	mmCreatePermission.defaultExpectation.results = &PolicyServiceMockCreatePermissionResults{i1, err}
+	mmCreatePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePermission.mock
+}
+
+// Set uses given function f to mock the PolicyService.CreatePermission method
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Set(f func(ctx context.Context, accessToken string, permission *model.Permission) (i1 int64, err error)) *PolicyServiceMock {
+	if mmCreatePermission.defaultExpectation != nil {
+		mmCreatePermission.mock.t.Fatalf("Default expectation is already set for the PolicyService.CreatePermission method")
+	}
+
+	if len(mmCreatePermission.expectations) > 0 {
+		mmCreatePermission.mock.t.Fatalf("Some expectations are already set for the PolicyService.CreatePermission method")
+	}
+
+	mmCreatePermission.mock.funcCreatePermission = f
+	mmCreatePermission.mock.funcCreatePermissionOrigin = minimock.CallerInfo(1)
+	return mmCreatePermission.mock
+}
+
+// When sets expectation for the PolicyService.CreatePermission which will trigger the result defined by the following
+// Then helper
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) When(ctx context.Context, accessToken string, permission *model.Permission) *PolicyServiceMockCreatePermissionExpectation {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("PolicyServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockCreatePermissionExpectation{
+		mock:               mmCreatePermission.mock,
+		params:             &PolicyServiceMockCreatePermissionParams{ctx, accessToken, permission},
+		expectationOrigins: PolicyServiceMockCreatePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePermission.expectations = append(mmCreatePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.CreatePermission return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockCreatePermissionExpectation) Then(i1 int64, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockCreatePermissionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.CreatePermission should be invoked
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Times(n uint64) *mPolicyServiceMockCreatePermission {
+	if n == 0 {
+		mmCreatePermission.mock.t.Fatalf("Times of PolicyServiceMock.CreatePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePermission.expectedInvocations, n)
+	mmCreatePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePermission
+}
+
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) invocationsDone() bool {
+	if len(mmCreatePermission.expectations) == 0 && mmCreatePermission.defaultExpectation == nil && mmCreatePermission.mock.funcCreatePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePermission.mock.afterCreatePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePermission implements mm_service.PolicyService
+func (mmCreatePermission *PolicyServiceMock) CreatePermission(ctx context.Context, accessToken string, permission *model.Permission) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreatePermission.beforeCreatePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePermission.afterCreatePermissionCounter, 1)
+
+	mmCreatePermission.t.Helper()
+
+	if mmCreatePermission.inspectFuncCreatePermission != nil {
+		mmCreatePermission.inspectFuncCreatePermission(ctx, accessToken, permission)
+	}
+
+	mm_params := PolicyServiceMockCreatePermissionParams{ctx, accessToken, permission}
+
+	// Record call args
+	mmCreatePermission.CreatePermissionMock.mutex.Lock()
+	mmCreatePermission.CreatePermissionMock.callArgs = append(mmCreatePermission.CreatePermissionMock.callArgs, &mm_params)
+	mmCreatePermission.CreatePermissionMock.mutex.Unlock()
+
+	for _, e := range mmCreatePermission.CreatePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreatePermission.CreatePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePermission.CreatePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePermission.CreatePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePermission.CreatePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockCreatePermissionParams{ctx, accessToken, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePermission.t.Errorf("PolicyServiceMock.CreatePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCreatePermission.t.Errorf("PolicyServiceMock.CreatePermission got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCreatePermission.t.Errorf("PolicyServiceMock.CreatePermission got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePermission.t.Errorf("PolicyServiceMock.CreatePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePermission.CreatePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePermission.CreatePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePermission.t.Fatal("No results are set for the PolicyServiceMock.CreatePermission")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreatePermission.funcCreatePermission != nil {
+		return mmCreatePermission.funcCreatePermission(ctx, accessToken, permission)
+	}
+	mmCreatePermission.t.Fatalf("Unexpected call to PolicyServiceMock.CreatePermission. %v %v %v", ctx, accessToken, permission)
+	return
+}
+
+// CreatePermissionAfterCounter returns a count of finished PolicyServiceMock.CreatePermission invocations
+func (mmCreatePermission *PolicyServiceMock) CreatePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePermission.afterCreatePermissionCounter)
+}
+
+// CreatePermissionBeforeCounter returns a count of PolicyServiceMock.CreatePermission invocations
+func (mmCreatePermission *PolicyServiceMock) CreatePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePermission.beforeCreatePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.CreatePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePermission *mPolicyServiceMockCreatePermission) Calls() []*PolicyServiceMockCreatePermissionParams {
+	mmCreatePermission.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockCreatePermissionParams, len(mmCreatePermission.callArgs))
+	copy(argCopy, mmCreatePermission.callArgs)
+
+	mmCreatePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePermissionDone returns true if the count of the CreatePermission invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockCreatePermissionDone() bool {
+	if m.CreatePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePermissionMock.invocationsDone()
+}
+
+// MinimockCreatePermissionInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockCreatePermissionInspect() {
+	for _, e := range m.CreatePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.CreatePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePermissionCounter := mm_atomic.LoadUint64(&m.afterCreatePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePermissionMock.defaultExpectation != nil && afterCreatePermissionCounter < 1 {
+		if m.CreatePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.CreatePermission at\n%s", m.CreatePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.CreatePermission at\n%s with params: %#v", m.CreatePermissionMock.defaultExpectation.expectationOrigins.origin, *m.CreatePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePermission != nil && afterCreatePermissionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.CreatePermission at\n%s", m.funcCreatePermissionOrigin)
+	}
+
+	if !m.CreatePermissionMock.invocationsDone() && afterCreatePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.CreatePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePermissionMock.expectedInvocations), m.CreatePermissionMock.expectedInvocationsOrigin, afterCreatePermissionCounter)
+	}
+}
+
+type mPolicyServiceMockCreateRule struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockCreateRuleExpectation
+	expectations       []*PolicyServiceMockCreateRuleExpectation
+
+	callArgs []*PolicyServiceMockCreateRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockCreateRuleExpectation specifies expectation struct of the PolicyService.CreateRule
+type PolicyServiceMockCreateRuleExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockCreateRuleParams
+	paramPtrs          *PolicyServiceMockCreateRuleParamPtrs
+	expectationOrigins PolicyServiceMockCreateRuleExpectationOrigins
+	results            *PolicyServiceMockCreateRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockCreateRuleParams contains parameters of the PolicyService.CreateRule
+type PolicyServiceMockCreateRuleParams struct {
+	ctx         context.Context
+	accessToken string
+	rule        *model.AccessRule
+}
+
+// PolicyServiceMockCreateRuleParamPtrs contains pointers to parameters of the PolicyService.CreateRule
+type PolicyServiceMockCreateRuleParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	rule        **model.AccessRule
+}
+
+// PolicyServiceMockCreateRuleResults contains results of the PolicyService.CreateRule
+type PolicyServiceMockCreateRuleResults struct {
+	i1  int64
+	err error
+}
+
+// PolicyServiceMockCreateRuleOrigins contains origins of expectations of the PolicyService.CreateRule
+type PolicyServiceMockCreateRuleExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originRule        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRule *mPolicyServiceMockCreateRule) Optional() *mPolicyServiceMockCreateRule {
+	mmCreateRule.optional = true
+	return mmCreateRule
+}
+
+// Expect sets up expected params for PolicyService.CreateRule
+func (mmCreateRule *mPolicyServiceMockCreateRule) Expect(ctx context.Context, accessToken string, rule *model.AccessRule) *mPolicyServiceMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &PolicyServiceMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRule.defaultExpectation.params = &PolicyServiceMockCreateRuleParams{ctx, accessToken, rule}
+	mmCreateRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRule.expectations {
+		if minimock.Equal(e.params, mmCreateRule.defaultExpectation.params) {
+			mmCreateRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRule.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.CreateRule
+func (mmCreateRule *mPolicyServiceMockCreateRule) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &PolicyServiceMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.params != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Expect")
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRule.defaultExpectation.paramPtrs = &PolicyServiceMockCreateRuleParamPtrs{}
+	}
+	mmCreateRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRule
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.CreateRule
+func (mmCreateRule *mPolicyServiceMockCreateRule) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &PolicyServiceMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.params != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Expect")
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRule.defaultExpectation.paramPtrs = &PolicyServiceMockCreateRuleParamPtrs{}
+	}
+	mmCreateRule.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmCreateRule.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmCreateRule
+}
+
+// ExpectRuleParam3 sets up expected param rule for PolicyService.CreateRule
+func (mmCreateRule *mPolicyServiceMockCreateRule) ExpectRuleParam3(rule *model.AccessRule) *mPolicyServiceMockCreateRule {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &PolicyServiceMockCreateRuleExpectation{}
+	}
+
+	if mmCreateRule.defaultExpectation.params != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Expect")
+	}
+
+	if mmCreateRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRule.defaultExpectation.paramPtrs = &PolicyServiceMockCreateRuleParamPtrs{}
+	}
+	mmCreateRule.defaultExpectation.paramPtrs.rule = &rule
+	mmCreateRule.defaultExpectation.expectationOrigins.originRule = minimock.CallerInfo(1)
+
+	return mmCreateRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.CreateRule
+func (mmCreateRule *mPolicyServiceMockCreateRule) Inspect(f func(ctx context.Context, accessToken string, rule *model.AccessRule)) *mPolicyServiceMockCreateRule {
+	if mmCreateRule.mock.inspectFuncCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.CreateRule")
+	}
+
+	mmCreateRule.mock.inspectFuncCreateRule = f
+
+	return mmCreateRule
+}
+
+// Return sets up results that will be returned by PolicyService.CreateRule
+func (mmCreateRule *mPolicyServiceMockCreateRule) Return(i1 int64, err error) *PolicyServiceMock {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Set")
+	}
+
+	if mmCreateRule.defaultExpectation == nil {
+		mmCreateRule.defaultExpectation = &PolicyServiceMockCreateRuleExpectation{mock: mmCreateRule.mock}
+	}
+	mmCreateRule.defaultExpectation.results = &PolicyServiceMockCreateRuleResults{i1, err}
+	mmCreateRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRule.mock
+}
+
+// Set uses given function f to mock the PolicyService.CreateRule method
+func (mmCreateRule *mPolicyServiceMockCreateRule) Set(f func(ctx context.Context, accessToken string, rule *model.AccessRule) (i1 int64, err error)) *PolicyServiceMock {
+	if mmCreateRule.defaultExpectation != nil {
+		mmCreateRule.mock.t.Fatalf("Default expectation is already set for the PolicyService.CreateRule method")
+	}
+
+	if len(mmCreateRule.expectations) > 0 {
+		mmCreateRule.mock.t.Fatalf("Some expectations are already set for the PolicyService.CreateRule method")
+	}
+
+	mmCreateRule.mock.funcCreateRule = f
+	mmCreateRule.mock.funcCreateRuleOrigin = minimock.CallerInfo(1)
+	return mmCreateRule.mock
+}
+
+// When sets expectation for the PolicyService.CreateRule which will trigger the result defined by the following
+// Then helper
+func (mmCreateRule *mPolicyServiceMockCreateRule) When(ctx context.Context, accessToken string, rule *model.AccessRule) *PolicyServiceMockCreateRuleExpectation {
+	if mmCreateRule.mock.funcCreateRule != nil {
+		mmCreateRule.mock.t.Fatalf("PolicyServiceMock.CreateRule mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockCreateRuleExpectation{
+		mock:               mmCreateRule.mock,
+		params:             &PolicyServiceMockCreateRuleParams{ctx, accessToken, rule},
+		expectationOrigins: PolicyServiceMockCreateRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRule.expectations = append(mmCreateRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.CreateRule return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockCreateRuleExpectation) Then(i1 int64, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockCreateRuleResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.CreateRule should be invoked
+func (mmCreateRule *mPolicyServiceMockCreateRule) Times(n uint64) *mPolicyServiceMockCreateRule {
+	if n == 0 {
+		mmCreateRule.mock.t.Fatalf("Times of PolicyServiceMock.CreateRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRule.expectedInvocations, n)
+	mmCreateRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRule
+}
+
+func (mmCreateRule *mPolicyServiceMockCreateRule) invocationsDone() bool {
+	if len(mmCreateRule.expectations) == 0 && mmCreateRule.defaultExpectation == nil && mmCreateRule.mock.funcCreateRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRule.mock.afterCreateRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRule implements mm_service.PolicyService
+func (mmCreateRule *PolicyServiceMock) CreateRule(ctx context.Context, accessToken string, rule *model.AccessRule) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRule.beforeCreateRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRule.afterCreateRuleCounter, 1)
+
+	mmCreateRule.t.Helper()
+
+	if mmCreateRule.inspectFuncCreateRule != nil {
+		mmCreateRule.inspectFuncCreateRule(ctx, accessToken, rule)
+	}
+
+	mm_params := PolicyServiceMockCreateRuleParams{ctx, accessToken, rule}
+
+	// Record call args
+	mmCreateRule.CreateRuleMock.mutex.Lock()
+	mmCreateRule.CreateRuleMock.callArgs = append(mmCreateRule.CreateRuleMock.callArgs, &mm_params)
+	mmCreateRule.CreateRuleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRule.CreateRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRule.CreateRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRule.CreateRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRule.CreateRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRule.CreateRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockCreateRuleParams{ctx, accessToken, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRule.t.Errorf("PolicyServiceMock.CreateRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCreateRule.t.Errorf("PolicyServiceMock.CreateRule got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmCreateRule.t.Errorf("PolicyServiceMock.CreateRule got unexpected parameter rule, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.originRule, *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRule.t.Errorf("PolicyServiceMock.CreateRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRule.CreateRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRule.CreateRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRule.t.Fatal("No results are set for the PolicyServiceMock.CreateRule")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRule.funcCreateRule != nil {
+		return mmCreateRule.funcCreateRule(ctx, accessToken, rule)
+	}
+	mmCreateRule.t.Fatalf("Unexpected call to PolicyServiceMock.CreateRule. %v %v %v", ctx, accessToken, rule)
+	return
+}
+
+// CreateRuleAfterCounter returns a count of finished PolicyServiceMock.CreateRule invocations
+func (mmCreateRule *PolicyServiceMock) CreateRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRule.afterCreateRuleCounter)
+}
+
+// CreateRuleBeforeCounter returns a count of PolicyServiceMock.CreateRule invocations
+func (mmCreateRule *PolicyServiceMock) CreateRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRule.beforeCreateRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.CreateRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRule *mPolicyServiceMockCreateRule) Calls() []*PolicyServiceMockCreateRuleParams {
+	mmCreateRule.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockCreateRuleParams, len(mmCreateRule.callArgs))
+	copy(argCopy, mmCreateRule.callArgs)
+
+	mmCreateRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRuleDone returns true if the count of the CreateRule invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockCreateRuleDone() bool {
+	if m.CreateRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRuleMock.invocationsDone()
+}
+
+// MinimockCreateRuleInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockCreateRuleInspect() {
+	for _, e := range m.CreateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.CreateRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRuleCounter := mm_atomic.LoadUint64(&m.afterCreateRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRuleMock.defaultExpectation != nil && afterCreateRuleCounter < 1 {
+		if m.CreateRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.CreateRule at\n%s", m.CreateRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.CreateRule at\n%s with params: %#v", m.CreateRuleMock.defaultExpectation.expectationOrigins.origin, *m.CreateRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRule != nil && afterCreateRuleCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.CreateRule at\n%s", m.funcCreateRuleOrigin)
+	}
+
+	if !m.CreateRuleMock.invocationsDone() && afterCreateRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.CreateRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRuleMock.expectedInvocations), m.CreateRuleMock.expectedInvocationsOrigin, afterCreateRuleCounter)
+	}
+}
+
+type mPolicyServiceMockDeletePermission struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockDeletePermissionExpectation
+	expectations       []*PolicyServiceMockDeletePermissionExpectation
+
+	callArgs []*PolicyServiceMockDeletePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockDeletePermissionExpectation specifies expectation struct of the PolicyService.DeletePermission
+type PolicyServiceMockDeletePermissionExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockDeletePermissionParams
+	paramPtrs          *PolicyServiceMockDeletePermissionParamPtrs
+	expectationOrigins PolicyServiceMockDeletePermissionExpectationOrigins
+	results            *PolicyServiceMockDeletePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockDeletePermissionParams contains parameters of the PolicyService.DeletePermission
+type PolicyServiceMockDeletePermissionParams struct {
+	ctx         context.Context
+	accessToken string
+	name        string
+}
+
+// PolicyServiceMockDeletePermissionParamPtrs contains pointers to parameters of the PolicyService.DeletePermission
+type PolicyServiceMockDeletePermissionParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	name        *string
+}
+
+// PolicyServiceMockDeletePermissionResults contains results of the PolicyService.DeletePermission
+type PolicyServiceMockDeletePermissionResults struct {
+	err error
+}
+
+// PolicyServiceMockDeletePermissionOrigins contains origins of expectations of the PolicyService.DeletePermission
+type PolicyServiceMockDeletePermissionExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originName        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Optional() *mPolicyServiceMockDeletePermission {
+	mmDeletePermission.optional = true
+	return mmDeletePermission
+}
+
+// Expect sets up expected params for PolicyService.DeletePermission
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Expect(ctx context.Context, accessToken string, name string) *mPolicyServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PolicyServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePermission.defaultExpectation.params = &PolicyServiceMockDeletePermissionParams{ctx, accessToken, name}
+	mmDeletePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePermission.expectations {
+		if minimock.Equal(e.params, mmDeletePermission.defaultExpectation.params) {
+			mmDeletePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.DeletePermission
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PolicyServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &PolicyServiceMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePermission
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.DeletePermission
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PolicyServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &PolicyServiceMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmDeletePermission.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmDeletePermission
+}
+
+// ExpectNameParam3 sets up expected param name for PolicyService.DeletePermission
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) ExpectNameParam3(name string) *mPolicyServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PolicyServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &PolicyServiceMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.name = &name
+	mmDeletePermission.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeletePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.DeletePermission
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Inspect(f func(ctx context.Context, accessToken string, name string)) *mPolicyServiceMockDeletePermission {
+	if mmDeletePermission.mock.inspectFuncDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.DeletePermission")
+	}
+
+	mmDeletePermission.mock.inspectFuncDeletePermission = f
+
+	return mmDeletePermission
+}
+
+// Return sets up results that will be returned by PolicyService.DeletePermission
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Return(err error) *PolicyServiceMock {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &PolicyServiceMockDeletePermissionExpectation{mock: mmDeletePermission.mock}
+	}
+	mmDeletePermission.defaultExpectation.results = &PolicyServiceMockDeletePermissionResults{err}
+	mmDeletePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePermission.mock
+}
+
+// Set uses given function f to mock the PolicyService.DeletePermission method
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Set(f func(ctx context.Context, accessToken string, name string) (err error)) *PolicyServiceMock {
+	if mmDeletePermission.defaultExpectation != nil {
+		mmDeletePermission.mock.t.Fatalf("Default expectation is already set for the PolicyService.DeletePermission method")
+	}
+
+	if len(mmDeletePermission.expectations) > 0 {
+		mmDeletePermission.mock.t.Fatalf("Some expectations are already set for the PolicyService.DeletePermission method")
+	}
+
+	mmDeletePermission.mock.funcDeletePermission = f
+	mmDeletePermission.mock.funcDeletePermissionOrigin = minimock.CallerInfo(1)
+	return mmDeletePermission.mock
+}
+
+// When sets expectation for the PolicyService.DeletePermission which will trigger the result defined by the following
+// Then helper
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) When(ctx context.Context, accessToken string, name string) *PolicyServiceMockDeletePermissionExpectation {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("PolicyServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockDeletePermissionExpectation{
+		mock:               mmDeletePermission.mock,
+		params:             &PolicyServiceMockDeletePermissionParams{ctx, accessToken, name},
+		expectationOrigins: PolicyServiceMockDeletePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePermission.expectations = append(mmDeletePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.DeletePermission return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockDeletePermissionExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockDeletePermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.DeletePermission should be invoked
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Times(n uint64) *mPolicyServiceMockDeletePermission {
+	if n == 0 {
+		mmDeletePermission.mock.t.Fatalf("Times of PolicyServiceMock.DeletePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePermission.expectedInvocations, n)
+	mmDeletePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePermission
+}
+
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) invocationsDone() bool {
+	if len(mmDeletePermission.expectations) == 0 && mmDeletePermission.defaultExpectation == nil && mmDeletePermission.mock.funcDeletePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePermission.mock.afterDeletePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePermission implements mm_service.PolicyService
+func (mmDeletePermission *PolicyServiceMock) DeletePermission(ctx context.Context, accessToken string, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeletePermission.beforeDeletePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePermission.afterDeletePermissionCounter, 1)
+
+	mmDeletePermission.t.Helper()
+
+	if mmDeletePermission.inspectFuncDeletePermission != nil {
+		mmDeletePermission.inspectFuncDeletePermission(ctx, accessToken, name)
+	}
+
+	mm_params := PolicyServiceMockDeletePermissionParams{ctx, accessToken, name}
+
+	// Record call args
+	mmDeletePermission.DeletePermissionMock.mutex.Lock()
+	mmDeletePermission.DeletePermissionMock.callArgs = append(mmDeletePermission.DeletePermissionMock.callArgs, &mm_params)
+	mmDeletePermission.DeletePermissionMock.mutex.Unlock()
+
+	for _, e := range mmDeletePermission.DeletePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePermission.DeletePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePermission.DeletePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePermission.DeletePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePermission.DeletePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockDeletePermissionParams{ctx, accessToken, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePermission.t.Errorf("PolicyServiceMock.DeletePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmDeletePermission.t.Errorf("PolicyServiceMock.DeletePermission got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeletePermission.t.Errorf("PolicyServiceMock.DeletePermission got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePermission.t.Errorf("PolicyServiceMock.DeletePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePermission.DeletePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePermission.DeletePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePermission.t.Fatal("No results are set for the PolicyServiceMock.DeletePermission")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePermission.funcDeletePermission != nil {
+		return mmDeletePermission.funcDeletePermission(ctx, accessToken, name)
+	}
+	mmDeletePermission.t.Fatalf("Unexpected call to PolicyServiceMock.DeletePermission. %v %v %v", ctx, accessToken, name)
+	return
+}
+
+// DeletePermissionAfterCounter returns a count of finished PolicyServiceMock.DeletePermission invocations
+func (mmDeletePermission *PolicyServiceMock) DeletePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePermission.afterDeletePermissionCounter)
+}
+
+// DeletePermissionBeforeCounter returns a count of PolicyServiceMock.DeletePermission invocations
+func (mmDeletePermission *PolicyServiceMock) DeletePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePermission.beforeDeletePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.DeletePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePermission *mPolicyServiceMockDeletePermission) Calls() []*PolicyServiceMockDeletePermissionParams {
+	mmDeletePermission.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockDeletePermissionParams, len(mmDeletePermission.callArgs))
+	copy(argCopy, mmDeletePermission.callArgs)
+
+	mmDeletePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePermissionDone returns true if the count of the DeletePermission invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockDeletePermissionDone() bool {
+	if m.DeletePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePermissionMock.invocationsDone()
+}
+
+// MinimockDeletePermissionInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockDeletePermissionInspect() {
+	for _, e := range m.DeletePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeletePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePermissionCounter := mm_atomic.LoadUint64(&m.afterDeletePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePermissionMock.defaultExpectation != nil && afterDeletePermissionCounter < 1 {
+		if m.DeletePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeletePermission at\n%s", m.DeletePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeletePermission at\n%s with params: %#v", m.DeletePermissionMock.defaultExpectation.expectationOrigins.origin, *m.DeletePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePermission != nil && afterDeletePermissionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.DeletePermission at\n%s", m.funcDeletePermissionOrigin)
+	}
+
+	if !m.DeletePermissionMock.invocationsDone() && afterDeletePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.DeletePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePermissionMock.expectedInvocations), m.DeletePermissionMock.expectedInvocationsOrigin, afterDeletePermissionCounter)
+	}
+}
+
+type mPolicyServiceMockDeleteRoleParent struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockDeleteRoleParentExpectation
+	expectations       []*PolicyServiceMockDeleteRoleParentExpectation
+
+	callArgs []*PolicyServiceMockDeleteRoleParentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockDeleteRoleParentExpectation specifies expectation struct of the PolicyService.DeleteRoleParent
+type PolicyServiceMockDeleteRoleParentExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockDeleteRoleParentParams
+	paramPtrs          *PolicyServiceMockDeleteRoleParentParamPtrs
+	expectationOrigins PolicyServiceMockDeleteRoleParentExpectationOrigins
+	results            *PolicyServiceMockDeleteRoleParentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockDeleteRoleParentParams contains parameters of the PolicyService.DeleteRoleParent
+type PolicyServiceMockDeleteRoleParentParams struct {
+	ctx         context.Context
+	accessToken string
+	parent      *model.RoleParent
+}
+
+// PolicyServiceMockDeleteRoleParentParamPtrs contains pointers to parameters of the PolicyService.DeleteRoleParent
+type PolicyServiceMockDeleteRoleParentParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	parent      **model.RoleParent
+}
+
+// PolicyServiceMockDeleteRoleParentResults contains results of the PolicyService.DeleteRoleParent
+type PolicyServiceMockDeleteRoleParentResults struct {
+	err error
+}
+
+// PolicyServiceMockDeleteRoleParentOrigins contains origins of expectations of the PolicyService.DeleteRoleParent
+type PolicyServiceMockDeleteRoleParentExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originParent      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Optional() *mPolicyServiceMockDeleteRoleParent {
+	mmDeleteRoleParent.optional = true
+	return mmDeleteRoleParent
+}
+
+// Expect sets up expected params for PolicyService.DeleteRoleParent
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Expect(ctx context.Context, accessToken string, parent *model.RoleParent) *mPolicyServiceMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &PolicyServiceMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRoleParent.defaultExpectation.params = &PolicyServiceMockDeleteRoleParentParams{ctx, accessToken, parent}
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRoleParent.expectations {
+		if minimock.Equal(e.params, mmDeleteRoleParent.defaultExpectation.params) {
+			mmDeleteRoleParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRoleParent.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRoleParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.DeleteRoleParent
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &PolicyServiceMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.params != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Expect")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleParent.defaultExpectation.paramPtrs = &PolicyServiceMockDeleteRoleParentParamPtrs{}
+	}
+	mmDeleteRoleParent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRoleParent
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.DeleteRoleParent
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &PolicyServiceMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.params != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Expect")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleParent.defaultExpectation.paramPtrs = &PolicyServiceMockDeleteRoleParentParamPtrs{}
+	}
+	mmDeleteRoleParent.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmDeleteRoleParent
+}
+
+// ExpectParentParam3 sets up expected param parent for PolicyService.DeleteRoleParent
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) ExpectParentParam3(parent *model.RoleParent) *mPolicyServiceMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &PolicyServiceMockDeleteRoleParentExpectation{}
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.params != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Expect")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation.paramPtrs == nil {
+		mmDeleteRoleParent.defaultExpectation.paramPtrs = &PolicyServiceMockDeleteRoleParentParamPtrs{}
+	}
+	mmDeleteRoleParent.defaultExpectation.paramPtrs.parent = &parent
+	mmDeleteRoleParent.defaultExpectation.expectationOrigins.originParent = minimock.CallerInfo(1)
+
+	return mmDeleteRoleParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.DeleteRoleParent
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Inspect(f func(ctx context.Context, accessToken string, parent *model.RoleParent)) *mPolicyServiceMockDeleteRoleParent {
+	if mmDeleteRoleParent.mock.inspectFuncDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.DeleteRoleParent")
+	}
+
+	mmDeleteRoleParent.mock.inspectFuncDeleteRoleParent = f
+
+	return mmDeleteRoleParent
+}
+
+// Return sets up results that will be returned by PolicyService.DeleteRoleParent
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Return(err error) *PolicyServiceMock {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	if mmDeleteRoleParent.defaultExpectation == nil {
+		mmDeleteRoleParent.defaultExpectation = &PolicyServiceMockDeleteRoleParentExpectation{mock: mmDeleteRoleParent.mock}
+	}
+	mmDeleteRoleParent.defaultExpectation.results = &PolicyServiceMockDeleteRoleParentResults{err}
+	mmDeleteRoleParent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleParent.mock
+}
+
+// Set uses given function f to mock the PolicyService.DeleteRoleParent method
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Set(f func(ctx context.Context, accessToken string, parent *model.RoleParent) (err error)) *PolicyServiceMock {
+	if mmDeleteRoleParent.defaultExpectation != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("Default expectation is already set for the PolicyService.DeleteRoleParent method")
+	}
+
+	if len(mmDeleteRoleParent.expectations) > 0 {
+		mmDeleteRoleParent.mock.t.Fatalf("Some expectations are already set for the PolicyService.DeleteRoleParent method")
+	}
+
+	mmDeleteRoleParent.mock.funcDeleteRoleParent = f
+	mmDeleteRoleParent.mock.funcDeleteRoleParentOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleParent.mock
+}
+
+// When sets expectation for the PolicyService.DeleteRoleParent which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) When(ctx context.Context, accessToken string, parent *model.RoleParent) *PolicyServiceMockDeleteRoleParentExpectation {
+	if mmDeleteRoleParent.mock.funcDeleteRoleParent != nil {
+		mmDeleteRoleParent.mock.t.Fatalf("PolicyServiceMock.DeleteRoleParent mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockDeleteRoleParentExpectation{
+		mock:               mmDeleteRoleParent.mock,
+		params:             &PolicyServiceMockDeleteRoleParentParams{ctx, accessToken, parent},
+		expectationOrigins: PolicyServiceMockDeleteRoleParentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRoleParent.expectations = append(mmDeleteRoleParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.DeleteRoleParent return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockDeleteRoleParentExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockDeleteRoleParentResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.DeleteRoleParent should be invoked
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Times(n uint64) *mPolicyServiceMockDeleteRoleParent {
+	if n == 0 {
+		mmDeleteRoleParent.mock.t.Fatalf("Times of PolicyServiceMock.DeleteRoleParent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRoleParent.expectedInvocations, n)
+	mmDeleteRoleParent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRoleParent
+}
+
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) invocationsDone() bool {
+	if len(mmDeleteRoleParent.expectations) == 0 && mmDeleteRoleParent.defaultExpectation == nil && mmDeleteRoleParent.mock.funcDeleteRoleParent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRoleParent.mock.afterDeleteRoleParentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRoleParent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRoleParent implements mm_service.PolicyService
+func (mmDeleteRoleParent *PolicyServiceMock) DeleteRoleParent(ctx context.Context, accessToken string, parent *model.RoleParent) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRoleParent.beforeDeleteRoleParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRoleParent.afterDeleteRoleParentCounter, 1)
+
+	mmDeleteRoleParent.t.Helper()
+
+	if mmDeleteRoleParent.inspectFuncDeleteRoleParent != nil {
+		mmDeleteRoleParent.inspectFuncDeleteRoleParent(ctx, accessToken, parent)
+	}
+
+	mm_params := PolicyServiceMockDeleteRoleParentParams{ctx, accessToken, parent}
+
+	// Record call args
+	mmDeleteRoleParent.DeleteRoleParentMock.mutex.Lock()
+	mmDeleteRoleParent.DeleteRoleParentMock.callArgs = append(mmDeleteRoleParent.DeleteRoleParentMock.callArgs, &mm_params)
+	mmDeleteRoleParent.DeleteRoleParentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRoleParent.DeleteRoleParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockDeleteRoleParentParams{ctx, accessToken, parent}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRoleParent.t.Errorf("PolicyServiceMock.DeleteRoleParent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmDeleteRoleParent.t.Errorf("PolicyServiceMock.DeleteRoleParent got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.parent != nil && !minimock.Equal(*mm_want_ptrs.parent, mm_got.parent) {
+				mmDeleteRoleParent.t.Errorf("PolicyServiceMock.DeleteRoleParent got unexpected parameter parent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.originParent, *mm_want_ptrs.parent, mm_got.parent, minimock.Diff(*mm_want_ptrs.parent, mm_got.parent))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRoleParent.t.Errorf("PolicyServiceMock.DeleteRoleParent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRoleParent.DeleteRoleParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRoleParent.t.Fatal("No results are set for the PolicyServiceMock.DeleteRoleParent")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRoleParent.funcDeleteRoleParent != nil {
+		return mmDeleteRoleParent.funcDeleteRoleParent(ctx, accessToken, parent)
+	}
+	mmDeleteRoleParent.t.Fatalf("Unexpected call to PolicyServiceMock.DeleteRoleParent. %v %v %v", ctx, accessToken, parent)
+	return
+}
+
+// DeleteRoleParentAfterCounter returns a count of finished PolicyServiceMock.DeleteRoleParent invocations
+func (mmDeleteRoleParent *PolicyServiceMock) DeleteRoleParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRoleParent.afterDeleteRoleParentCounter)
+}
+
+// DeleteRoleParentBeforeCounter returns a count of PolicyServiceMock.DeleteRoleParent invocations
+func (mmDeleteRoleParent *PolicyServiceMock) DeleteRoleParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRoleParent.beforeDeleteRoleParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.DeleteRoleParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRoleParent *mPolicyServiceMockDeleteRoleParent) Calls() []*PolicyServiceMockDeleteRoleParentParams {
+	mmDeleteRoleParent.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockDeleteRoleParentParams, len(mmDeleteRoleParent.callArgs))
+	copy(argCopy, mmDeleteRoleParent.callArgs)
+
+	mmDeleteRoleParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleParentDone returns true if the count of the DeleteRoleParent invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockDeleteRoleParentDone() bool {
+	if m.DeleteRoleParentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleParentMock.invocationsDone()
+}
+
+// MinimockDeleteRoleParentInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockDeleteRoleParentInspect() {
+	for _, e := range m.DeleteRoleParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeleteRoleParent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRoleParentCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleParentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleParentMock.defaultExpectation != nil && afterDeleteRoleParentCounter < 1 {
+		if m.DeleteRoleParentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeleteRoleParent at\n%s", m.DeleteRoleParentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeleteRoleParent at\n%s with params: %#v", m.DeleteRoleParentMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRoleParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRoleParent != nil && afterDeleteRoleParentCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.DeleteRoleParent at\n%s", m.funcDeleteRoleParentOrigin)
+	}
+
+	if !m.DeleteRoleParentMock.invocationsDone() && afterDeleteRoleParentCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.DeleteRoleParent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleParentMock.expectedInvocations), m.DeleteRoleParentMock.expectedInvocationsOrigin, afterDeleteRoleParentCounter)
+	}
+}
+
+type mPolicyServiceMockDeleteRule struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockDeleteRuleExpectation
+	expectations       []*PolicyServiceMockDeleteRuleExpectation
+
+	callArgs []*PolicyServiceMockDeleteRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockDeleteRuleExpectation specifies expectation struct of the PolicyService.DeleteRule
+type PolicyServiceMockDeleteRuleExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockDeleteRuleParams
+	paramPtrs          *PolicyServiceMockDeleteRuleParamPtrs
+	expectationOrigins PolicyServiceMockDeleteRuleExpectationOrigins
+	results            *PolicyServiceMockDeleteRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockDeleteRuleParams contains parameters of the PolicyService.DeleteRule
+type PolicyServiceMockDeleteRuleParams struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+// PolicyServiceMockDeleteRuleParamPtrs contains pointers to parameters of the PolicyService.DeleteRule
+type PolicyServiceMockDeleteRuleParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	id          *int64
+}
+
+// PolicyServiceMockDeleteRuleResults contains results of the PolicyService.DeleteRule
+type PolicyServiceMockDeleteRuleResults struct {
+	err error
+}
+
+// PolicyServiceMockDeleteRuleOrigins contains origins of expectations of the PolicyService.DeleteRule
+type PolicyServiceMockDeleteRuleExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originId          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Optional() *mPolicyServiceMockDeleteRule {
+	mmDeleteRule.optional = true
+	return mmDeleteRule
+}
+
+// Expect sets up expected params for PolicyService.DeleteRule
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Expect(ctx context.Context, accessToken string, id int64) *mPolicyServiceMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &PolicyServiceMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRule.defaultExpectation.params = &PolicyServiceMockDeleteRuleParams{ctx, accessToken, id}
+	mmDeleteRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRule.expectations {
+		if minimock.Equal(e.params, mmDeleteRule.defaultExpectation.params) {
+			mmDeleteRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRule.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.DeleteRule
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &PolicyServiceMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.params != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRule.defaultExpectation.paramPtrs = &PolicyServiceMockDeleteRuleParamPtrs{}
+	}
+	mmDeleteRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRule
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.DeleteRule
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &PolicyServiceMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.params != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRule.defaultExpectation.paramPtrs = &PolicyServiceMockDeleteRuleParamPtrs{}
+	}
+	mmDeleteRule.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmDeleteRule.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmDeleteRule
+}
+
+// ExpectIdParam3 sets up expected param id for PolicyService.DeleteRule
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) ExpectIdParam3(id int64) *mPolicyServiceMockDeleteRule {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &PolicyServiceMockDeleteRuleExpectation{}
+	}
+
+	if mmDeleteRule.defaultExpectation.params != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRule.defaultExpectation.paramPtrs = &PolicyServiceMockDeleteRuleParamPtrs{}
+	}
+	mmDeleteRule.defaultExpectation.paramPtrs.id = &id
+	mmDeleteRule.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.DeleteRule
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Inspect(f func(ctx context.Context, accessToken string, id int64)) *mPolicyServiceMockDeleteRule {
+	if mmDeleteRule.mock.inspectFuncDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.DeleteRule")
+	}
+
+	mmDeleteRule.mock.inspectFuncDeleteRule = f
+
+	return mmDeleteRule
+}
+
+// Return sets up results that will be returned by PolicyService.DeleteRule
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Return(err error) *PolicyServiceMock {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Set")
+	}
+
+	if mmDeleteRule.defaultExpectation == nil {
+		mmDeleteRule.defaultExpectation = &PolicyServiceMockDeleteRuleExpectation{mock: mmDeleteRule.mock}
+	}
+	mmDeleteRule.defaultExpectation.results = &PolicyServiceMockDeleteRuleResults{err}
+	mmDeleteRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRule.mock
+}
+
+// Set uses given function f to mock the PolicyService.DeleteRule method
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Set(f func(ctx context.Context, accessToken string, id int64) (err error)) *PolicyServiceMock {
+	if mmDeleteRule.defaultExpectation != nil {
+		mmDeleteRule.mock.t.Fatalf("Default expectation is already set for the PolicyService.DeleteRule method")
+	}
+
+	if len(mmDeleteRule.expectations) > 0 {
+		mmDeleteRule.mock.t.Fatalf("Some expectations are already set for the PolicyService.DeleteRule method")
+	}
+
+	mmDeleteRule.mock.funcDeleteRule = f
+	mmDeleteRule.mock.funcDeleteRuleOrigin = minimock.CallerInfo(1)
+	return mmDeleteRule.mock
+}
+
+// When sets expectation for the PolicyService.DeleteRule which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) When(ctx context.Context, accessToken string, id int64) *PolicyServiceMockDeleteRuleExpectation {
+	if mmDeleteRule.mock.funcDeleteRule != nil {
+		mmDeleteRule.mock.t.Fatalf("PolicyServiceMock.DeleteRule mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockDeleteRuleExpectation{
+		mock:               mmDeleteRule.mock,
+		params:             &PolicyServiceMockDeleteRuleParams{ctx, accessToken, id},
+		expectationOrigins: PolicyServiceMockDeleteRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRule.expectations = append(mmDeleteRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.DeleteRule return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockDeleteRuleExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockDeleteRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.DeleteRule should be invoked
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Times(n uint64) *mPolicyServiceMockDeleteRule {
+	if n == 0 {
+		mmDeleteRule.mock.t.Fatalf("Times of PolicyServiceMock.DeleteRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRule.expectedInvocations, n)
+	mmDeleteRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRule
+}
+
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) invocationsDone() bool {
+	if len(mmDeleteRule.expectations) == 0 && mmDeleteRule.defaultExpectation == nil && mmDeleteRule.mock.funcDeleteRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRule.mock.afterDeleteRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRule implements mm_service.PolicyService
+func (mmDeleteRule *PolicyServiceMock) DeleteRule(ctx context.Context, accessToken string, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRule.beforeDeleteRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRule.afterDeleteRuleCounter, 1)
+
+	mmDeleteRule.t.Helper()
+
+	if mmDeleteRule.inspectFuncDeleteRule != nil {
+		mmDeleteRule.inspectFuncDeleteRule(ctx, accessToken, id)
+	}
+
+	mm_params := PolicyServiceMockDeleteRuleParams{ctx, accessToken, id}
+
+	// Record call args
+	mmDeleteRule.DeleteRuleMock.mutex.Lock()
+	mmDeleteRule.DeleteRuleMock.callArgs = append(mmDeleteRule.DeleteRuleMock.callArgs, &mm_params)
+	mmDeleteRule.DeleteRuleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRule.DeleteRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRule.DeleteRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRule.DeleteRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRule.DeleteRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRule.DeleteRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockDeleteRuleParams{ctx, accessToken, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRule.t.Errorf("PolicyServiceMock.DeleteRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmDeleteRule.t.Errorf("PolicyServiceMock.DeleteRule got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteRule.t.Errorf("PolicyServiceMock.DeleteRule got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRule.t.Errorf("PolicyServiceMock.DeleteRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRule.DeleteRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRule.DeleteRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRule.t.Fatal("No results are set for the PolicyServiceMock.DeleteRule")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRule.funcDeleteRule != nil {
+		return mmDeleteRule.funcDeleteRule(ctx, accessToken, id)
+	}
+	mmDeleteRule.t.Fatalf("Unexpected call to PolicyServiceMock.DeleteRule. %v %v %v", ctx, accessToken, id)
+	return
+}
+
+// DeleteRuleAfterCounter returns a count of finished PolicyServiceMock.DeleteRule invocations
+func (mmDeleteRule *PolicyServiceMock) DeleteRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRule.afterDeleteRuleCounter)
+}
+
+// DeleteRuleBeforeCounter returns a count of PolicyServiceMock.DeleteRule invocations
+func (mmDeleteRule *PolicyServiceMock) DeleteRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRule.beforeDeleteRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.DeleteRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRule *mPolicyServiceMockDeleteRule) Calls() []*PolicyServiceMockDeleteRuleParams {
+	mmDeleteRule.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockDeleteRuleParams, len(mmDeleteRule.callArgs))
+	copy(argCopy, mmDeleteRule.callArgs)
+
+	mmDeleteRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRuleDone returns true if the count of the DeleteRule invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockDeleteRuleDone() bool {
+	if m.DeleteRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRuleMock.invocationsDone()
+}
+
+// MinimockDeleteRuleInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockDeleteRuleInspect() {
+	for _, e := range m.DeleteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeleteRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRuleCounter := mm_atomic.LoadUint64(&m.afterDeleteRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRuleMock.defaultExpectation != nil && afterDeleteRuleCounter < 1 {
+		if m.DeleteRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeleteRule at\n%s", m.DeleteRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.DeleteRule at\n%s with params: %#v", m.DeleteRuleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRule != nil && afterDeleteRuleCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.DeleteRule at\n%s", m.funcDeleteRuleOrigin)
+	}
+
+	if !m.DeleteRuleMock.invocationsDone() && afterDeleteRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.DeleteRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRuleMock.expectedInvocations), m.DeleteRuleMock.expectedInvocationsOrigin, afterDeleteRuleCounter)
+	}
+}
+
+type mPolicyServiceMockExportPolicy struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockExportPolicyExpectation
+	expectations       []*PolicyServiceMockExportPolicyExpectation
+
+	callArgs []*PolicyServiceMockExportPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockExportPolicyExpectation specifies expectation struct of the PolicyService.ExportPolicy
+type PolicyServiceMockExportPolicyExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockExportPolicyParams
+	paramPtrs          *PolicyServiceMockExportPolicyParamPtrs
+	expectationOrigins PolicyServiceMockExportPolicyExpectationOrigins
+	results            *PolicyServiceMockExportPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockExportPolicyParams contains parameters of the PolicyService.ExportPolicy
+type PolicyServiceMockExportPolicyParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// PolicyServiceMockExportPolicyParamPtrs contains pointers to parameters of the PolicyService.ExportPolicy
+type PolicyServiceMockExportPolicyParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// PolicyServiceMockExportPolicyResults contains results of the PolicyService.ExportPolicy
+type PolicyServiceMockExportPolicyResults struct {
+	pp1 *model.PolicyBundle
+	err error
+}
+
+// PolicyServiceMockExportPolicyOrigins contains origins of expectations of the PolicyService.ExportPolicy
+type PolicyServiceMockExportPolicyExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Optional() *mPolicyServiceMockExportPolicy {
+	mmExportPolicy.optional = true
+	return mmExportPolicy
+}
+
+// Expect sets up expected params for PolicyService.ExportPolicy
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Expect(ctx context.Context, accessToken string) *mPolicyServiceMockExportPolicy {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &PolicyServiceMockExportPolicyExpectation{}
+	}
+
+	if mmExportPolicy.defaultExpectation.paramPtrs != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmExportPolicy.defaultExpectation.params = &PolicyServiceMockExportPolicyParams{ctx, accessToken}
+	mmExportPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportPolicy.expectations {
+		if minimock.Equal(e.params, mmExportPolicy.defaultExpectation.params) {
+			mmExportPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmExportPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.ExportPolicy
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockExportPolicy {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &PolicyServiceMockExportPolicyExpectation{}
+	}
+
+	if mmExportPolicy.defaultExpectation.params != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Expect")
+	}
+
+	if mmExportPolicy.defaultExpectation.paramPtrs == nil {
+		mmExportPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockExportPolicyParamPtrs{}
+	}
+	mmExportPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportPolicy
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.ExportPolicy
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockExportPolicy {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &PolicyServiceMockExportPolicyExpectation{}
+	}
+
+	if mmExportPolicy.defaultExpectation.params != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Expect")
+	}
+
+	if mmExportPolicy.defaultExpectation.paramPtrs == nil {
+		mmExportPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockExportPolicyParamPtrs{}
+	}
+	mmExportPolicy.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmExportPolicy.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmExportPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.ExportPolicy
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Inspect(f func(ctx context.Context, accessToken string)) *mPolicyServiceMockExportPolicy {
+	if mmExportPolicy.mock.inspectFuncExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.ExportPolicy")
+	}
+
+	mmExportPolicy.mock.inspectFuncExportPolicy = f
+
+	return mmExportPolicy
+}
+
+// Return sets up results that will be returned by PolicyService.ExportPolicy
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Return(pp1 *model.PolicyBundle, err error) *PolicyServiceMock {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &PolicyServiceMockExportPolicyExpectation{mock: mmExportPolicy.mock}
+	}
+	mmExportPolicy.defaultExpectation.results = &PolicyServiceMockExportPolicyResults{pp1, err}
+	mmExportPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportPolicy.mock
+}
+
+// Set uses given function f to mock the PolicyService.ExportPolicy method
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Set(f func(ctx context.Context, accessToken string) (pp1 *model.PolicyBundle, err error)) *PolicyServiceMock {
+	if mmExportPolicy.defaultExpectation != nil {
+		mmExportPolicy.mock.t.Fatalf("Default expectation is already set for the PolicyService.ExportPolicy method")
+	}
+
+	if len(mmExportPolicy.expectations) > 0 {
+		mmExportPolicy.mock.t.Fatalf("Some expectations are already set for the PolicyService.ExportPolicy method")
+	}
+
+	mmExportPolicy.mock.funcExportPolicy = f
+	mmExportPolicy.mock.funcExportPolicyOrigin = minimock.CallerInfo(1)
+	return mmExportPolicy.mock
+}
+
+// When sets expectation for the PolicyService.ExportPolicy which will trigger the result defined by the following
+// Then helper
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) When(ctx context.Context, accessToken string) *PolicyServiceMockExportPolicyExpectation {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("PolicyServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockExportPolicyExpectation{
+		mock:               mmExportPolicy.mock,
+		params:             &PolicyServiceMockExportPolicyParams{ctx, accessToken},
+		expectationOrigins: PolicyServiceMockExportPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportPolicy.expectations = append(mmExportPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.ExportPolicy return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockExportPolicyExpectation) Then(pp1 *model.PolicyBundle, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockExportPolicyResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.ExportPolicy should be invoked
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Times(n uint64) *mPolicyServiceMockExportPolicy {
+	if n == 0 {
+		mmExportPolicy.mock.t.Fatalf("Times of PolicyServiceMock.ExportPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportPolicy.expectedInvocations, n)
+	mmExportPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportPolicy
+}
+
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) invocationsDone() bool {
+	if len(mmExportPolicy.expectations) == 0 && mmExportPolicy.defaultExpectation == nil && mmExportPolicy.mock.funcExportPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportPolicy.mock.afterExportPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportPolicy implements mm_service.PolicyService
+func (mmExportPolicy *PolicyServiceMock) ExportPolicy(ctx context.Context, accessToken string) (pp1 *model.PolicyBundle, err error) {
+	mm_atomic.AddUint64(&mmExportPolicy.beforeExportPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportPolicy.afterExportPolicyCounter, 1)
+
+	mmExportPolicy.t.Helper()
+
+	if mmExportPolicy.inspectFuncExportPolicy != nil {
+		mmExportPolicy.inspectFuncExportPolicy(ctx, accessToken)
+	}
+
+	mm_params := PolicyServiceMockExportPolicyParams{ctx, accessToken}
+
+	// Record call args
+	mmExportPolicy.ExportPolicyMock.mutex.Lock()
+	mmExportPolicy.ExportPolicyMock.callArgs = append(mmExportPolicy.ExportPolicyMock.callArgs, &mm_params)
+	mmExportPolicy.ExportPolicyMock.mutex.Unlock()
+
+	for _, e := range mmExportPolicy.ExportPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmExportPolicy.ExportPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportPolicy.ExportPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportPolicy.ExportPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmExportPolicy.ExportPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockExportPolicyParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportPolicy.t.Errorf("PolicyServiceMock.ExportPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportPolicy.ExportPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmExportPolicy.t.Errorf("PolicyServiceMock.ExportPolicy got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportPolicy.ExportPolicyMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportPolicy.t.Errorf("PolicyServiceMock.ExportPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportPolicy.ExportPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportPolicy.ExportPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportPolicy.t.Fatal("No results are set for the PolicyServiceMock.ExportPolicy")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmExportPolicy.funcExportPolicy != nil {
+		return mmExportPolicy.funcExportPolicy(ctx, accessToken)
+	}
+	mmExportPolicy.t.Fatalf("Unexpected call to PolicyServiceMock.ExportPolicy. %v %v", ctx, accessToken)
+	return
+}
+
+// ExportPolicyAfterCounter returns a count of finished PolicyServiceMock.ExportPolicy invocations
+func (mmExportPolicy *PolicyServiceMock) ExportPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportPolicy.afterExportPolicyCounter)
+}
+
+// ExportPolicyBeforeCounter returns a count of PolicyServiceMock.ExportPolicy invocations
+func (mmExportPolicy *PolicyServiceMock) ExportPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportPolicy.beforeExportPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.ExportPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportPolicy *mPolicyServiceMockExportPolicy) Calls() []*PolicyServiceMockExportPolicyParams {
+	mmExportPolicy.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockExportPolicyParams, len(mmExportPolicy.callArgs))
+	copy(argCopy, mmExportPolicy.callArgs)
+
+	mmExportPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportPolicyDone returns true if the count of the ExportPolicy invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockExportPolicyDone() bool {
+	if m.ExportPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportPolicyMock.invocationsDone()
+}
+
+// MinimockExportPolicyInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockExportPolicyInspect() {
+	for _, e := range m.ExportPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.ExportPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportPolicyCounter := mm_atomic.LoadUint64(&m.afterExportPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportPolicyMock.defaultExpectation != nil && afterExportPolicyCounter < 1 {
+		if m.ExportPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.ExportPolicy at\n%s", m.ExportPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.ExportPolicy at\n%s with params: %#v", m.ExportPolicyMock.defaultExpectation.expectationOrigins.origin, *m.ExportPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportPolicy != nil && afterExportPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.ExportPolicy at\n%s", m.funcExportPolicyOrigin)
+	}
+
+	if !m.ExportPolicyMock.invocationsDone() && afterExportPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.ExportPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportPolicyMock.expectedInvocations), m.ExportPolicyMock.expectedInvocationsOrigin, afterExportPolicyCounter)
+	}
+}
+
+type mPolicyServiceMockGetRolePermissions struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockGetRolePermissionsExpectation
+	expectations       []*PolicyServiceMockGetRolePermissionsExpectation
+
+	callArgs []*PolicyServiceMockGetRolePermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockGetRolePermissionsExpectation specifies expectation struct of the PolicyService.GetRolePermissions
+type PolicyServiceMockGetRolePermissionsExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockGetRolePermissionsParams
+	paramPtrs          *PolicyServiceMockGetRolePermissionsParamPtrs
+	expectationOrigins PolicyServiceMockGetRolePermissionsExpectationOrigins
+	results            *PolicyServiceMockGetRolePermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockGetRolePermissionsParams contains parameters of the PolicyService.GetRolePermissions
+type PolicyServiceMockGetRolePermissionsParams struct {
+	ctx         context.Context
+	accessToken string
+	role        string
+}
+
+// PolicyServiceMockGetRolePermissionsParamPtrs contains pointers to parameters of the PolicyService.GetRolePermissions
+type PolicyServiceMockGetRolePermissionsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	role        *string
+}
+
+// PolicyServiceMockGetRolePermissionsResults contains results of the PolicyService.GetRolePermissions
+type PolicyServiceMockGetRolePermissionsResults struct {
+	rp1 *model.RolePermissions
+	err error
+}
+
+// PolicyServiceMockGetRolePermissionsOrigins contains origins of expectations of the PolicyService.GetRolePermissions
+type PolicyServiceMockGetRolePermissionsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originRole        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Optional() *mPolicyServiceMockGetRolePermissions {
+	mmGetRolePermissions.optional = true
+	return mmGetRolePermissions
+}
+
+// Expect sets up expected params for PolicyService.GetRolePermissions
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Expect(ctx context.Context, accessToken string, role string) *mPolicyServiceMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &PolicyServiceMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by ExpectParams functions")
+	}
+
+	mmGetRolePermissions.defaultExpectation.params = &PolicyServiceMockGetRolePermissionsParams{ctx, accessToken, role}
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRolePermissions.expectations {
+		if minimock.Equal(e.params, mmGetRolePermissions.defaultExpectation.params) {
+			mmGetRolePermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRolePermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRolePermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.GetRolePermissions
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &PolicyServiceMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.params != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Expect")
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmGetRolePermissions.defaultExpectation.paramPtrs = &PolicyServiceMockGetRolePermissionsParamPtrs{}
+	}
+	mmGetRolePermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRolePermissions
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.GetRolePermissions
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &PolicyServiceMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.params != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Expect")
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmGetRolePermissions.defaultExpectation.paramPtrs = &PolicyServiceMockGetRolePermissionsParamPtrs{}
+	}
+	mmGetRolePermissions.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmGetRolePermissions
+}
+
+// ExpectRoleParam3 sets up expected param role for PolicyService.GetRolePermissions
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) ExpectRoleParam3(role string) *mPolicyServiceMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &PolicyServiceMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.params != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Expect")
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmGetRolePermissions.defaultExpectation.paramPtrs = &PolicyServiceMockGetRolePermissionsParamPtrs{}
+	}
+	mmGetRolePermissions.defaultExpectation.paramPtrs.role = &role
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmGetRolePermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.GetRolePermissions
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Inspect(f func(ctx context.Context, accessToken string, role string)) *mPolicyServiceMockGetRolePermissions {
+	if mmGetRolePermissions.mock.inspectFuncGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.GetRolePermissions")
+	}
+
+	mmGetRolePermissions.mock.inspectFuncGetRolePermissions = f
+
+	return mmGetRolePermissions
+}
+
+// Return sets up results that will be returned by PolicyService.GetRolePermissions
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Return(rp1 *model.RolePermissions, err error) *PolicyServiceMock {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &PolicyServiceMockGetRolePermissionsExpectation{mock: mmGetRolePermissions.mock}
+	}
+	mmGetRolePermissions.defaultExpectation.results = &PolicyServiceMockGetRolePermissionsResults{rp1, err}
+	mmGetRolePermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRolePermissions.mock
+}
+
+// Set uses given function f to mock the PolicyService.GetRolePermissions method
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Set(f func(ctx context.Context, accessToken string, role string) (rp1 *model.RolePermissions, err error)) *PolicyServiceMock {
+	if mmGetRolePermissions.defaultExpectation != nil {
+		mmGetRolePermissions.mock.t.Fatalf("Default expectation is already set for the PolicyService.GetRolePermissions method")
+	}
+
+	if len(mmGetRolePermissions.expectations) > 0 {
+		mmGetRolePermissions.mock.t.Fatalf("Some expectations are already set for the PolicyService.GetRolePermissions method")
+	}
+
+	mmGetRolePermissions.mock.funcGetRolePermissions = f
+	mmGetRolePermissions.mock.funcGetRolePermissionsOrigin = minimock.CallerInfo(1)
+	return mmGetRolePermissions.mock
+}
+
+// When sets expectation for the PolicyService.GetRolePermissions which will trigger the result defined by the following
+// Then helper
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) When(ctx context.Context, accessToken string, role string) *PolicyServiceMockGetRolePermissionsExpectation {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("PolicyServiceMock.GetRolePermissions mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockGetRolePermissionsExpectation{
+		mock:               mmGetRolePermissions.mock,
+		params:             &PolicyServiceMockGetRolePermissionsParams{ctx, accessToken, role},
+		expectationOrigins: PolicyServiceMockGetRolePermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRolePermissions.expectations = append(mmGetRolePermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.GetRolePermissions return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockGetRolePermissionsExpectation) Then(rp1 *model.RolePermissions, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockGetRolePermissionsResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.GetRolePermissions should be invoked
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Times(n uint64) *mPolicyServiceMockGetRolePermissions {
+	if n == 0 {
+		mmGetRolePermissions.mock.t.Fatalf("Times of PolicyServiceMock.GetRolePermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRolePermissions.expectedInvocations, n)
+	mmGetRolePermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRolePermissions
+}
+
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) invocationsDone() bool {
+	if len(mmGetRolePermissions.expectations) == 0 && mmGetRolePermissions.defaultExpectation == nil && mmGetRolePermissions.mock.funcGetRolePermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRolePermissions.mock.afterGetRolePermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRolePermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRolePermissions implements mm_service.PolicyService
+func (mmGetRolePermissions *PolicyServiceMock) GetRolePermissions(ctx context.Context, accessToken string, role string) (rp1 *model.RolePermissions, err error) {
+	mm_atomic.AddUint64(&mmGetRolePermissions.beforeGetRolePermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRolePermissions.afterGetRolePermissionsCounter, 1)
+
+	mmGetRolePermissions.t.Helper()
+
+	if mmGetRolePermissions.inspectFuncGetRolePermissions != nil {
+		mmGetRolePermissions.inspectFuncGetRolePermissions(ctx, accessToken, role)
+	}
+
+	mm_params := PolicyServiceMockGetRolePermissionsParams{ctx, accessToken, role}
+
+	// Record call args
+	mmGetRolePermissions.GetRolePermissionsMock.mutex.Lock()
+	mmGetRolePermissions.GetRolePermissionsMock.callArgs = append(mmGetRolePermissions.GetRolePermissionsMock.callArgs, &mm_params)
+	mmGetRolePermissions.GetRolePermissionsMock.mutex.Unlock()
+
+	for _, e := range mmGetRolePermissions.GetRolePermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockGetRolePermissionsParams{ctx, accessToken, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRolePermissions.t.Errorf("PolicyServiceMock.GetRolePermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmGetRolePermissions.t.Errorf("PolicyServiceMock.GetRolePermissions got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmGetRolePermissions.t.Errorf("PolicyServiceMock.GetRolePermissions got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRolePermissions.t.Errorf("PolicyServiceMock.GetRolePermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRolePermissions.t.Fatal("No results are set for the PolicyServiceMock.GetRolePermissions")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetRolePermissions.funcGetRolePermissions != nil {
+		return mmGetRolePermissions.funcGetRolePermissions(ctx, accessToken, role)
+	}
+	mmGetRolePermissions.t.Fatalf("Unexpected call to PolicyServiceMock.GetRolePermissions. %v %v %v", ctx, accessToken, role)
+	return
+}
+
+// GetRolePermissionsAfterCounter returns a count of finished PolicyServiceMock.GetRolePermissions invocations
+func (mmGetRolePermissions *PolicyServiceMock) GetRolePermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRolePermissions.afterGetRolePermissionsCounter)
+}
+
+// GetRolePermissionsBeforeCounter returns a count of PolicyServiceMock.GetRolePermissions invocations
+func (mmGetRolePermissions *PolicyServiceMock) GetRolePermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRolePermissions.beforeGetRolePermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.GetRolePermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRolePermissions *mPolicyServiceMockGetRolePermissions) Calls() []*PolicyServiceMockGetRolePermissionsParams {
+	mmGetRolePermissions.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockGetRolePermissionsParams, len(mmGetRolePermissions.callArgs))
+	copy(argCopy, mmGetRolePermissions.callArgs)
+
+	mmGetRolePermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRolePermissionsDone returns true if the count of the GetRolePermissions invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockGetRolePermissionsDone() bool {
+	if m.GetRolePermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRolePermissionsMock.invocationsDone()
+}
+
+// MinimockGetRolePermissionsInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockGetRolePermissionsInspect() {
+	for _, e := range m.GetRolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.GetRolePermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRolePermissionsCounter := mm_atomic.LoadUint64(&m.afterGetRolePermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRolePermissionsMock.defaultExpectation != nil && afterGetRolePermissionsCounter < 1 {
+		if m.GetRolePermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.GetRolePermissions at\n%s", m.GetRolePermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.GetRolePermissions at\n%s with params: %#v", m.GetRolePermissionsMock.defaultExpectation.expectationOrigins.origin, *m.GetRolePermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRolePermissions != nil && afterGetRolePermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.GetRolePermissions at\n%s", m.funcGetRolePermissionsOrigin)
+	}
+
+	if !m.GetRolePermissionsMock.invocationsDone() && afterGetRolePermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.GetRolePermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRolePermissionsMock.expectedInvocations), m.GetRolePermissionsMock.expectedInvocationsOrigin, afterGetRolePermissionsCounter)
+	}
+}
+
+type mPolicyServiceMockGrantPermission struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockGrantPermissionExpectation
+	expectations       []*PolicyServiceMockGrantPermissionExpectation
+
+	callArgs []*PolicyServiceMockGrantPermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockGrantPermissionExpectation specifies expectation struct of the PolicyService.GrantPermission
+type PolicyServiceMockGrantPermissionExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockGrantPermissionParams
+	paramPtrs          *PolicyServiceMockGrantPermissionParamPtrs
+	expectationOrigins PolicyServiceMockGrantPermissionExpectationOrigins
+	results            *PolicyServiceMockGrantPermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockGrantPermissionParams contains parameters of the PolicyService.GrantPermission
+type PolicyServiceMockGrantPermissionParams struct {
+	ctx         context.Context
+	accessToken string
+	grant       *model.RolePermission
+}
+
+// PolicyServiceMockGrantPermissionParamPtrs contains pointers to parameters of the PolicyService.GrantPermission
+type PolicyServiceMockGrantPermissionParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	grant       **model.RolePermission
+}
+
+// PolicyServiceMockGrantPermissionResults contains results of the PolicyService.GrantPermission
+type PolicyServiceMockGrantPermissionResults struct {
+	err error
+}
+
+// PolicyServiceMockGrantPermissionOrigins contains origins of expectations of the PolicyService.GrantPermission
+type PolicyServiceMockGrantPermissionExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originGrant       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Optional() *mPolicyServiceMockGrantPermission {
+	mmGrantPermission.optional = true
+	return mmGrantPermission
+}
+
+// Expect sets up expected params for PolicyService.GrantPermission
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Expect(ctx context.Context, accessToken string, grant *model.RolePermission) *mPolicyServiceMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PolicyServiceMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by ExpectParams functions")
+	}
+
+	mmGrantPermission.defaultExpectation.params = &PolicyServiceMockGrantPermissionParams{ctx, accessToken, grant}
+	mmGrantPermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGrantPermission.expectations {
+		if minimock.Equal(e.params, mmGrantPermission.defaultExpectation.params) {
+			mmGrantPermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGrantPermission.defaultExpectation.params)
+		}
+	}
+
+	return mmGrantPermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.GrantPermission
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PolicyServiceMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.params != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Expect")
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs == nil {
+		mmGrantPermission.defaultExpectation.paramPtrs = &PolicyServiceMockGrantPermissionParamPtrs{}
+	}
+	mmGrantPermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGrantPermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGrantPermission
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.GrantPermission
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PolicyServiceMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.params != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Expect")
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs == nil {
+		mmGrantPermission.defaultExpectation.paramPtrs = &PolicyServiceMockGrantPermissionParamPtrs{}
+	}
+	mmGrantPermission.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmGrantPermission.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmGrantPermission
+}
+
+// ExpectGrantParam3 sets up expected param grant for PolicyService.GrantPermission
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) ExpectGrantParam3(grant *model.RolePermission) *mPolicyServiceMockGrantPermission {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PolicyServiceMockGrantPermissionExpectation{}
+	}
+
+	if mmGrantPermission.defaultExpectation.params != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Expect")
+	}
+
+	if mmGrantPermission.defaultExpectation.paramPtrs == nil {
+		mmGrantPermission.defaultExpectation.paramPtrs = &PolicyServiceMockGrantPermissionParamPtrs{}
+	}
+	mmGrantPermission.defaultExpectation.paramPtrs.grant = &grant
+	mmGrantPermission.defaultExpectation.expectationOrigins.originGrant = minimock.CallerInfo(1)
+
+	return mmGrantPermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.GrantPermission
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Inspect(f func(ctx context.Context, accessToken string, grant *model.RolePermission)) *mPolicyServiceMockGrantPermission {
+	if mmGrantPermission.mock.inspectFuncGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.GrantPermission")
+	}
+
+	mmGrantPermission.mock.inspectFuncGrantPermission = f
+
+	return mmGrantPermission
+}
+
+// Return sets up results that will be returned by PolicyService.GrantPermission
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Return(err error) *PolicyServiceMock {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Set")
+	}
+
+	if mmGrantPermission.defaultExpectation == nil {
+		mmGrantPermission.defaultExpectation = &PolicyServiceMockGrantPermissionExpectation{mock: mmGrantPermission.mock}
+	}
+	mmGrantPermission.defaultExpectation.results = &PolicyServiceMockGrantPermissionResults{err}
+	mmGrantPermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGrantPermission.mock
+}
+
+// Set uses given function f to mock the PolicyService.GrantPermission method
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Set(f func(ctx context.Context, accessToken string, grant *model.RolePermission) (err error)) *PolicyServiceMock {
+	if mmGrantPermission.defaultExpectation != nil {
+		mmGrantPermission.mock.t.Fatalf("Default expectation is already set for the PolicyService.GrantPermission method")
+	}
+
+	if len(mmGrantPermission.expectations) > 0 {
+		mmGrantPermission.mock.t.Fatalf("Some expectations are already set for the PolicyService.GrantPermission method")
+	}
+
+	mmGrantPermission.mock.funcGrantPermission = f
+	mmGrantPermission.mock.funcGrantPermissionOrigin = minimock.CallerInfo(1)
+	return mmGrantPermission.mock
+}
+
+// When sets expectation for the PolicyService.GrantPermission which will trigger the result defined by the following
+// Then helper
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) When(ctx context.Context, accessToken string, grant *model.RolePermission) *PolicyServiceMockGrantPermissionExpectation {
+	if mmGrantPermission.mock.funcGrantPermission != nil {
+		mmGrantPermission.mock.t.Fatalf("PolicyServiceMock.GrantPermission mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockGrantPermissionExpectation{
+		mock:               mmGrantPermission.mock,
+		params:             &PolicyServiceMockGrantPermissionParams{ctx, accessToken, grant},
+		expectationOrigins: PolicyServiceMockGrantPermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGrantPermission.expectations = append(mmGrantPermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.GrantPermission return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockGrantPermissionExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockGrantPermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.GrantPermission should be invoked
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Times(n uint64) *mPolicyServiceMockGrantPermission {
+	if n == 0 {
+		mmGrantPermission.mock.t.Fatalf("Times of PolicyServiceMock.GrantPermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGrantPermission.expectedInvocations, n)
+	mmGrantPermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGrantPermission
+}
+
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) invocationsDone() bool {
+	if len(mmGrantPermission.expectations) == 0 && mmGrantPermission.defaultExpectation == nil && mmGrantPermission.mock.funcGrantPermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGrantPermission.mock.afterGrantPermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGrantPermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GrantPermission implements mm_service.PolicyService
+func (mmGrantPermission *PolicyServiceMock) GrantPermission(ctx context.Context, accessToken string, grant *model.RolePermission) (err error) {
+	mm_atomic.AddUint64(&mmGrantPermission.beforeGrantPermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGrantPermission.afterGrantPermissionCounter, 1)
+
+	mmGrantPermission.t.Helper()
+
+	if mmGrantPermission.inspectFuncGrantPermission != nil {
+		mmGrantPermission.inspectFuncGrantPermission(ctx, accessToken, grant)
+	}
+
+	mm_params := PolicyServiceMockGrantPermissionParams{ctx, accessToken, grant}
+
+	// Record call args
+	mmGrantPermission.GrantPermissionMock.mutex.Lock()
+	mmGrantPermission.GrantPermissionMock.callArgs = append(mmGrantPermission.GrantPermissionMock.callArgs, &mm_params)
+	mmGrantPermission.GrantPermissionMock.mutex.Unlock()
+
+	for _, e := range mmGrantPermission.GrantPermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmGrantPermission.GrantPermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGrantPermission.GrantPermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGrantPermission.GrantPermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmGrantPermission.GrantPermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockGrantPermissionParams{ctx, accessToken, grant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGrantPermission.t.Errorf("PolicyServiceMock.GrantPermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmGrantPermission.t.Errorf("PolicyServiceMock.GrantPermission got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.grant != nil && !minimock.Equal(*mm_want_ptrs.grant, mm_got.grant) {
+				mmGrantPermission.t.Errorf("PolicyServiceMock.GrantPermission got unexpected parameter grant, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.originGrant, *mm_want_ptrs.grant, mm_got.grant, minimock.Diff(*mm_want_ptrs.grant, mm_got.grant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGrantPermission.t.Errorf("PolicyServiceMock.GrantPermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGrantPermission.GrantPermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGrantPermission.GrantPermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGrantPermission.t.Fatal("No results are set for the PolicyServiceMock.GrantPermission")
+		}
+		return (*mm_results).err
+	}
+	if mmGrantPermission.funcGrantPermission != nil {
+		return mmGrantPermission.funcGrantPermission(ctx, accessToken, grant)
+	}
+	mmGrantPermission.t.Fatalf("Unexpected call to PolicyServiceMock.GrantPermission. %v %v %v", ctx, accessToken, grant)
+	return
+}
+
+// GrantPermissionAfterCounter returns a count of finished PolicyServiceMock.GrantPermission invocations
+func (mmGrantPermission *PolicyServiceMock) GrantPermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrantPermission.afterGrantPermissionCounter)
+}
+
+// GrantPermissionBeforeCounter returns a count of PolicyServiceMock.GrantPermission invocations
+func (mmGrantPermission *PolicyServiceMock) GrantPermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrantPermission.beforeGrantPermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.GrantPermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGrantPermission *mPolicyServiceMockGrantPermission) Calls() []*PolicyServiceMockGrantPermissionParams {
+	mmGrantPermission.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockGrantPermissionParams, len(mmGrantPermission.callArgs))
+	copy(argCopy, mmGrantPermission.callArgs)
+
+	mmGrantPermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGrantPermissionDone returns true if the count of the GrantPermission invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockGrantPermissionDone() bool {
+	if m.GrantPermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GrantPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GrantPermissionMock.invocationsDone()
+}
+
+// MinimockGrantPermissionInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockGrantPermissionInspect() {
+	for _, e := range m.GrantPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.GrantPermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGrantPermissionCounter := mm_atomic.LoadUint64(&m.afterGrantPermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GrantPermissionMock.defaultExpectation != nil && afterGrantPermissionCounter < 1 {
+		if m.GrantPermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.GrantPermission at\n%s", m.GrantPermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.GrantPermission at\n%s with params: %#v", m.GrantPermissionMock.defaultExpectation.expectationOrigins.origin, *m.GrantPermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGrantPermission != nil && afterGrantPermissionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.GrantPermission at\n%s", m.funcGrantPermissionOrigin)
+	}
+
+	if !m.GrantPermissionMock.invocationsDone() && afterGrantPermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.GrantPermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GrantPermissionMock.expectedInvocations), m.GrantPermissionMock.expectedInvocationsOrigin, afterGrantPermissionCounter)
+	}
+}
+
+type mPolicyServiceMockListPermissions struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockListPermissionsExpectation
+	expectations       []*PolicyServiceMockListPermissionsExpectation
+
+	callArgs []*PolicyServiceMockListPermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockListPermissionsExpectation specifies expectation struct of the PolicyService.ListPermissions
+type PolicyServiceMockListPermissionsExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockListPermissionsParams
+	paramPtrs          *PolicyServiceMockListPermissionsParamPtrs
+	expectationOrigins PolicyServiceMockListPermissionsExpectationOrigins
+	results            *PolicyServiceMockListPermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockListPermissionsParams contains parameters of the PolicyService.ListPermissions
+type PolicyServiceMockListPermissionsParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// PolicyServiceMockListPermissionsParamPtrs contains pointers to parameters of the PolicyService.ListPermissions
+type PolicyServiceMockListPermissionsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// PolicyServiceMockListPermissionsResults contains results of the PolicyService.ListPermissions
+type PolicyServiceMockListPermissionsResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// PolicyServiceMockListPermissionsOrigins contains origins of expectations of the PolicyService.ListPermissions
+type PolicyServiceMockListPermissionsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPermissions *mPolicyServiceMockListPermissions) Optional() *mPolicyServiceMockListPermissions {
+	mmListPermissions.optional = true
+	return mmListPermissions
+}
+
+// Expect sets up expected params for PolicyService.ListPermissions
+func (mmListPermissions *mPolicyServiceMockListPermissions) Expect(ctx context.Context, accessToken string) *mPolicyServiceMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PolicyServiceMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by ExpectParams functions")
+	}
+
+	mmListPermissions.defaultExpectation.params = &PolicyServiceMockListPermissionsParams{ctx, accessToken}
+	mmListPermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPermissions.expectations {
+		if minimock.Equal(e.params, mmListPermissions.defaultExpectation.params) {
+			mmListPermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmListPermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.ListPermissions
+func (mmListPermissions *mPolicyServiceMockListPermissions) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PolicyServiceMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.params != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Expect")
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs == nil {
+		mmListPermissions.defaultExpectation.paramPtrs = &PolicyServiceMockListPermissionsParamPtrs{}
+	}
+	mmListPermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPermissions
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.ListPermissions
+func (mmListPermissions *mPolicyServiceMockListPermissions) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PolicyServiceMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.params != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Expect")
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs == nil {
+		mmListPermissions.defaultExpectation.paramPtrs = &PolicyServiceMockListPermissionsParamPtrs{}
+	}
+	mmListPermissions.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListPermissions.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListPermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.ListPermissions
+func (mmListPermissions *mPolicyServiceMockListPermissions) Inspect(f func(ctx context.Context, accessToken string)) *mPolicyServiceMockListPermissions {
+	if mmListPermissions.mock.inspectFuncListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.ListPermissions")
+	}
+
+	mmListPermissions.mock.inspectFuncListPermissions = f
+
+	return mmListPermissions
+}
+
+// Return sets up results that will be returned by PolicyService.ListPermissions
+func (mmListPermissions *mPolicyServiceMockListPermissions) Return(ppa1 []*model.Permission, err error) *PolicyServiceMock {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &PolicyServiceMockListPermissionsExpectation{mock: mmListPermissions.mock}
+	}
+	mmListPermissions.defaultExpectation.results = &PolicyServiceMockListPermissionsResults{ppa1, err}
+	mmListPermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPermissions.mock
+}
+
+// Set uses given function f to mock the PolicyService.ListPermissions method
+func (mmListPermissions *mPolicyServiceMockListPermissions) Set(f func(ctx context.Context, accessToken string) (ppa1 []*model.Permission, err error)) *PolicyServiceMock {
+	if mmListPermissions.defaultExpectation != nil {
+		mmListPermissions.mock.t.Fatalf("Default expectation is already set for the PolicyService.ListPermissions method")
+	}
+
+	if len(mmListPermissions.expectations) > 0 {
+		mmListPermissions.mock.t.Fatalf("Some expectations are already set for the PolicyService.ListPermissions method")
+	}
+
+	mmListPermissions.mock.funcListPermissions = f
+	mmListPermissions.mock.funcListPermissionsOrigin = minimock.CallerInfo(1)
+	return mmListPermissions.mock
+}
+
+// When sets expectation for the PolicyService.ListPermissions which will trigger the result defined by the following
+// Then helper
+func (mmListPermissions *mPolicyServiceMockListPermissions) When(ctx context.Context, accessToken string) *PolicyServiceMockListPermissionsExpectation {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("PolicyServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockListPermissionsExpectation{
+		mock:               mmListPermissions.mock,
+		params:             &PolicyServiceMockListPermissionsParams{ctx, accessToken},
+		expectationOrigins: PolicyServiceMockListPermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPermissions.expectations = append(mmListPermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.ListPermissions return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockListPermissionsExpectation) Then(ppa1 []*model.Permission, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockListPermissionsResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.ListPermissions should be invoked
+func (mmListPermissions *mPolicyServiceMockListPermissions) Times(n uint64) *mPolicyServiceMockListPermissions {
+	if n == 0 {
+		mmListPermissions.mock.t.Fatalf("Times of PolicyServiceMock.ListPermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPermissions.expectedInvocations, n)
+	mmListPermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPermissions
+}
+
+func (mmListPermissions *mPolicyServiceMockListPermissions) invocationsDone() bool {
+	if len(mmListPermissions.expectations) == 0 && mmListPermissions.defaultExpectation == nil && mmListPermissions.mock.funcListPermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPermissions.mock.afterListPermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPermissions implements mm_service.PolicyService
+func (mmListPermissions *PolicyServiceMock) ListPermissions(ctx context.Context, accessToken string) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmListPermissions.beforeListPermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPermissions.afterListPermissionsCounter, 1)
+
+	mmListPermissions.t.Helper()
+
+	if mmListPermissions.inspectFuncListPermissions != nil {
+		mmListPermissions.inspectFuncListPermissions(ctx, accessToken)
+	}
+
+	mm_params := PolicyServiceMockListPermissionsParams{ctx, accessToken}
+
+	// Record call args
+	mmListPermissions.ListPermissionsMock.mutex.Lock()
+	mmListPermissions.ListPermissionsMock.callArgs = append(mmListPermissions.ListPermissionsMock.callArgs, &mm_params)
+	mmListPermissions.ListPermissionsMock.mutex.Unlock()
+
+	for _, e := range mmListPermissions.ListPermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPermissions.ListPermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPermissions.ListPermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPermissions.ListPermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPermissions.ListPermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockListPermissionsParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPermissions.t.Errorf("PolicyServiceMock.ListPermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPermissions.ListPermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListPermissions.t.Errorf("PolicyServiceMock.ListPermissions got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPermissions.ListPermissionsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPermissions.t.Errorf("PolicyServiceMock.ListPermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPermissions.ListPermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPermissions.ListPermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPermissions.t.Fatal("No results are set for the PolicyServiceMock.ListPermissions")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPermissions.funcListPermissions != nil {
+		return mmListPermissions.funcListPermissions(ctx, accessToken)
+	}
+	mmListPermissions.t.Fatalf("Unexpected call to PolicyServiceMock.ListPermissions. %v %v", ctx, accessToken)
+	return
+}
+
+// ListPermissionsAfterCounter returns a count of finished PolicyServiceMock.ListPermissions invocations
+func (mmListPermissions *PolicyServiceMock) ListPermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPermissions.afterListPermissionsCounter)
+}
+
+// ListPermissionsBeforeCounter returns a count of PolicyServiceMock.ListPermissions invocations
+func (mmListPermissions *PolicyServiceMock) ListPermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPermissions.beforeListPermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.ListPermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPermissions *mPolicyServiceMockListPermissions) Calls() []*PolicyServiceMockListPermissionsParams {
+	mmListPermissions.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockListPermissionsParams, len(mmListPermissions.callArgs))
+	copy(argCopy, mmListPermissions.callArgs)
+
+	mmListPermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPermissionsDone returns true if the count of the ListPermissions invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockListPermissionsDone() bool {
+	if m.ListPermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPermissionsMock.invocationsDone()
+}
+
+// MinimockListPermissionsInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockListPermissionsInspect() {
+	for _, e := range m.ListPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListPermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPermissionsCounter := mm_atomic.LoadUint64(&m.afterListPermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPermissionsMock.defaultExpectation != nil && afterListPermissionsCounter < 1 {
+		if m.ListPermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListPermissions at\n%s", m.ListPermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListPermissions at\n%s with params: %#v", m.ListPermissionsMock.defaultExpectation.expectationOrigins.origin, *m.ListPermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPermissions != nil && afterListPermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.ListPermissions at\n%s", m.funcListPermissionsOrigin)
+	}
+
+	if !m.ListPermissionsMock.invocationsDone() && afterListPermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.ListPermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPermissionsMock.expectedInvocations), m.ListPermissionsMock.expectedInvocationsOrigin, afterListPermissionsCounter)
+	}
+}
+
+type mPolicyServiceMockListPolicyVersions struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockListPolicyVersionsExpectation
+	expectations       []*PolicyServiceMockListPolicyVersionsExpectation
+
+	callArgs []*PolicyServiceMockListPolicyVersionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockListPolicyVersionsExpectation specifies expectation struct of the PolicyService.ListPolicyVersions
+type PolicyServiceMockListPolicyVersionsExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockListPolicyVersionsParams
+	paramPtrs          *PolicyServiceMockListPolicyVersionsParamPtrs
+	expectationOrigins PolicyServiceMockListPolicyVersionsExpectationOrigins
+	results            *PolicyServiceMockListPolicyVersionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockListPolicyVersionsParams contains parameters of the PolicyService.ListPolicyVersions
+type PolicyServiceMockListPolicyVersionsParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// PolicyServiceMockListPolicyVersionsParamPtrs contains pointers to parameters of the PolicyService.ListPolicyVersions
+type PolicyServiceMockListPolicyVersionsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// PolicyServiceMockListPolicyVersionsResults contains results of the PolicyService.ListPolicyVersions
+type PolicyServiceMockListPolicyVersionsResults struct {
+	ppa1 []*model.PolicyBundleVersion
+	err  error
+}
+
+// PolicyServiceMockListPolicyVersionsOrigins contains origins of expectations of the PolicyService.ListPolicyVersions
+type PolicyServiceMockListPolicyVersionsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Optional() *mPolicyServiceMockListPolicyVersions {
+	mmListPolicyVersions.optional = true
+	return mmListPolicyVersions
+}
+
+// Expect sets up expected params for PolicyService.ListPolicyVersions
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Expect(ctx context.Context, accessToken string) *mPolicyServiceMockListPolicyVersions {
+	if mmListPolicyVersions.mock.funcListPolicyVersions != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Set")
+	}
+
+	if mmListPolicyVersions.defaultExpectation == nil {
+		mmListPolicyVersions.defaultExpectation = &PolicyServiceMockListPolicyVersionsExpectation{}
+	}
+
+	if mmListPolicyVersions.defaultExpectation.paramPtrs != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by ExpectParams functions")
+	}
+
+	mmListPolicyVersions.defaultExpectation.params = &PolicyServiceMockListPolicyVersionsParams{ctx, accessToken}
+	mmListPolicyVersions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPolicyVersions.expectations {
+		if minimock.Equal(e.params, mmListPolicyVersions.defaultExpectation.params) {
+			mmListPolicyVersions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPolicyVersions.defaultExpectation.params)
+		}
+	}
+
+	return mmListPolicyVersions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.ListPolicyVersions
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockListPolicyVersions {
+	if mmListPolicyVersions.mock.funcListPolicyVersions != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Set")
+	}
+
+	if mmListPolicyVersions.defaultExpectation == nil {
+		mmListPolicyVersions.defaultExpectation = &PolicyServiceMockListPolicyVersionsExpectation{}
+	}
+
+	if mmListPolicyVersions.defaultExpectation.params != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Expect")
+	}
+
+	if mmListPolicyVersions.defaultExpectation.paramPtrs == nil {
+		mmListPolicyVersions.defaultExpectation.paramPtrs = &PolicyServiceMockListPolicyVersionsParamPtrs{}
+	}
+	mmListPolicyVersions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPolicyVersions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPolicyVersions
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.ListPolicyVersions
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockListPolicyVersions {
+	if mmListPolicyVersions.mock.funcListPolicyVersions != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Set")
+	}
+
+	if mmListPolicyVersions.defaultExpectation == nil {
+		mmListPolicyVersions.defaultExpectation = &PolicyServiceMockListPolicyVersionsExpectation{}
+	}
+
+	if mmListPolicyVersions.defaultExpectation.params != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Expect")
+	}
+
+	if mmListPolicyVersions.defaultExpectation.paramPtrs == nil {
+		mmListPolicyVersions.defaultExpectation.paramPtrs = &PolicyServiceMockListPolicyVersionsParamPtrs{}
+	}
+	mmListPolicyVersions.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListPolicyVersions.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListPolicyVersions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.ListPolicyVersions
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Inspect(f func(ctx context.Context, accessToken string)) *mPolicyServiceMockListPolicyVersions {
+	if mmListPolicyVersions.mock.inspectFuncListPolicyVersions != nil {
+		mmListPolicyVersions.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.ListPolicyVersions")
+	}
+
+	mmListPolicyVersions.mock.inspectFuncListPolicyVersions = f
+
+	return mmListPolicyVersions
+}
+
+// Return sets up results that will be returned by PolicyService.ListPolicyVersions
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Return(ppa1 []*model.PolicyBundleVersion, err error) *PolicyServiceMock {
+	if mmListPolicyVersions.mock.funcListPolicyVersions != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Set")
+	}
+
+	if mmListPolicyVersions.defaultExpectation == nil {
+		mmListPolicyVersions.defaultExpectation = &PolicyServiceMockListPolicyVersionsExpectation{mock: mmListPolicyVersions.mock}
+	}
+	mmListPolicyVersions.defaultExpectation.results = &PolicyServiceMockListPolicyVersionsResults{ppa1, err}
+	mmListPolicyVersions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPolicyVersions.mock
+}
+
+// Set uses given function f to mock the PolicyService.ListPolicyVersions method
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Set(f func(ctx context.Context, accessToken string) (ppa1 []*model.PolicyBundleVersion, err error)) *PolicyServiceMock {
+	if mmListPolicyVersions.defaultExpectation != nil {
+		mmListPolicyVersions.mock.t.Fatalf("Default expectation is already set for the PolicyService.ListPolicyVersions method")
+	}
+
+	if len(mmListPolicyVersions.expectations) > 0 {
+		mmListPolicyVersions.mock.t.Fatalf("Some expectations are already set for the PolicyService.ListPolicyVersions method")
+	}
+
+	mmListPolicyVersions.mock.funcListPolicyVersions = f
+	mmListPolicyVersions.mock.funcListPolicyVersionsOrigin = minimock.CallerInfo(1)
+	return mmListPolicyVersions.mock
+}
+
+// When sets expectation for the PolicyService.ListPolicyVersions which will trigger the result defined by the following
+// Then helper
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) When(ctx context.Context, accessToken string) *PolicyServiceMockListPolicyVersionsExpectation {
+	if mmListPolicyVersions.mock.funcListPolicyVersions != nil {
+		mmListPolicyVersions.mock.t.Fatalf("PolicyServiceMock.ListPolicyVersions mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockListPolicyVersionsExpectation{
+		mock:               mmListPolicyVersions.mock,
+		params:             &PolicyServiceMockListPolicyVersionsParams{ctx, accessToken},
+		expectationOrigins: PolicyServiceMockListPolicyVersionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPolicyVersions.expectations = append(mmListPolicyVersions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.ListPolicyVersions return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockListPolicyVersionsExpectation) Then(ppa1 []*model.PolicyBundleVersion, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockListPolicyVersionsResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.ListPolicyVersions should be invoked
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Times(n uint64) *mPolicyServiceMockListPolicyVersions {
+	if n == 0 {
+		mmListPolicyVersions.mock.t.Fatalf("Times of PolicyServiceMock.ListPolicyVersions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPolicyVersions.expectedInvocations, n)
+	mmListPolicyVersions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPolicyVersions
+}
+
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) invocationsDone() bool {
+	if len(mmListPolicyVersions.expectations) == 0 && mmListPolicyVersions.defaultExpectation == nil && mmListPolicyVersions.mock.funcListPolicyVersions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPolicyVersions.mock.afterListPolicyVersionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPolicyVersions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPolicyVersions implements mm_service.PolicyService
+func (mmListPolicyVersions *PolicyServiceMock) ListPolicyVersions(ctx context.Context, accessToken string) (ppa1 []*model.PolicyBundleVersion, err error) {
+	mm_atomic.AddUint64(&mmListPolicyVersions.beforeListPolicyVersionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPolicyVersions.afterListPolicyVersionsCounter, 1)
+
+	mmListPolicyVersions.t.Helper()
+
+	if mmListPolicyVersions.inspectFuncListPolicyVersions != nil {
+		mmListPolicyVersions.inspectFuncListPolicyVersions(ctx, accessToken)
+	}
+
+	mm_params := PolicyServiceMockListPolicyVersionsParams{ctx, accessToken}
+
+	// Record call args
+	mmListPolicyVersions.ListPolicyVersionsMock.mutex.Lock()
+	mmListPolicyVersions.ListPolicyVersionsMock.callArgs = append(mmListPolicyVersions.ListPolicyVersionsMock.callArgs, &mm_params)
+	mmListPolicyVersions.ListPolicyVersionsMock.mutex.Unlock()
+
+	for _, e := range mmListPolicyVersions.ListPolicyVersionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockListPolicyVersionsParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPolicyVersions.t.Errorf("PolicyServiceMock.ListPolicyVersions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListPolicyVersions.t.Errorf("PolicyServiceMock.ListPolicyVersions got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPolicyVersions.t.Errorf("PolicyServiceMock.ListPolicyVersions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPolicyVersions.ListPolicyVersionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPolicyVersions.t.Fatal("No results are set for the PolicyServiceMock.ListPolicyVersions")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPolicyVersions.funcListPolicyVersions != nil {
+		return mmListPolicyVersions.funcListPolicyVersions(ctx, accessToken)
+	}
+	mmListPolicyVersions.t.Fatalf("Unexpected call to PolicyServiceMock.ListPolicyVersions. %v %v", ctx, accessToken)
+	return
+}
+
+// ListPolicyVersionsAfterCounter returns a count of finished PolicyServiceMock.ListPolicyVersions invocations
+func (mmListPolicyVersions *PolicyServiceMock) ListPolicyVersionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPolicyVersions.afterListPolicyVersionsCounter)
+}
+
+// ListPolicyVersionsBeforeCounter returns a count of PolicyServiceMock.ListPolicyVersions invocations
+func (mmListPolicyVersions *PolicyServiceMock) ListPolicyVersionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPolicyVersions.beforeListPolicyVersionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.ListPolicyVersions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPolicyVersions *mPolicyServiceMockListPolicyVersions) Calls() []*PolicyServiceMockListPolicyVersionsParams {
+	mmListPolicyVersions.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockListPolicyVersionsParams, len(mmListPolicyVersions.callArgs))
+	copy(argCopy, mmListPolicyVersions.callArgs)
+
+	mmListPolicyVersions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPolicyVersionsDone returns true if the count of the ListPolicyVersions invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockListPolicyVersionsDone() bool {
+	if m.ListPolicyVersionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPolicyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPolicyVersionsMock.invocationsDone()
+}
+
+// MinimockListPolicyVersionsInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockListPolicyVersionsInspect() {
+	for _, e := range m.ListPolicyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListPolicyVersions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPolicyVersionsCounter := mm_atomic.LoadUint64(&m.afterListPolicyVersionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPolicyVersionsMock.defaultExpectation != nil && afterListPolicyVersionsCounter < 1 {
+		if m.ListPolicyVersionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListPolicyVersions at\n%s", m.ListPolicyVersionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListPolicyVersions at\n%s with params: %#v", m.ListPolicyVersionsMock.defaultExpectation.expectationOrigins.origin, *m.ListPolicyVersionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPolicyVersions != nil && afterListPolicyVersionsCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.ListPolicyVersions at\n%s", m.funcListPolicyVersionsOrigin)
+	}
+
+	if !m.ListPolicyVersionsMock.invocationsDone() && afterListPolicyVersionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.ListPolicyVersions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPolicyVersionsMock.expectedInvocations), m.ListPolicyVersionsMock.expectedInvocationsOrigin, afterListPolicyVersionsCounter)
+	}
+}
+
+type mPolicyServiceMockListRules struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockListRulesExpectation
+	expectations       []*PolicyServiceMockListRulesExpectation
+
+	callArgs []*PolicyServiceMockListRulesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockListRulesExpectation specifies expectation struct of the PolicyService.ListRules
+type PolicyServiceMockListRulesExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockListRulesParams
+	paramPtrs          *PolicyServiceMockListRulesParamPtrs
+	expectationOrigins PolicyServiceMockListRulesExpectationOrigins
+	results            *PolicyServiceMockListRulesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockListRulesParams contains parameters of the PolicyService.ListRules
+type PolicyServiceMockListRulesParams struct {
+	ctx         context.Context
+	accessToken string
+	filter      *model.AccessRuleFilter
+}
+
+// PolicyServiceMockListRulesParamPtrs contains pointers to parameters of the PolicyService.ListRules
+type PolicyServiceMockListRulesParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	filter      **model.AccessRuleFilter
+}
+
+// PolicyServiceMockListRulesResults contains results of the PolicyService.ListRules
+type PolicyServiceMockListRulesResults struct {
+	apa1 []*model.AccessRule
+	err  error
+}
+
+// PolicyServiceMockListRulesOrigins contains origins of expectations of the PolicyService.ListRules
+type PolicyServiceMockListRulesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originFilter      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRules *mPolicyServiceMockListRules) Optional() *mPolicyServiceMockListRules {
+	mmListRules.optional = true
+	return mmListRules
+}
+
+// Expect sets up expected params for PolicyService.ListRules
+func (mmListRules *mPolicyServiceMockListRules) Expect(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) *mPolicyServiceMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &PolicyServiceMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by ExpectParams functions")
+	}
+
+	mmListRules.defaultExpectation.params = &PolicyServiceMockListRulesParams{ctx, accessToken, filter}
+	mmListRules.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRules.expectations {
+		if minimock.Equal(e.params, mmListRules.defaultExpectation.params) {
+			mmListRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRules.defaultExpectation.params)
+		}
+	}
+
+	return mmListRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.ListRules
+func (mmListRules *mPolicyServiceMockListRules) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &PolicyServiceMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.params != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Expect")
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs == nil {
+		mmListRules.defaultExpectation.paramPtrs = &PolicyServiceMockListRulesParamPtrs{}
+	}
+	mmListRules.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRules.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRules
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.ListRules
+func (mmListRules *mPolicyServiceMockListRules) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &PolicyServiceMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.params != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Expect")
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs == nil {
+		mmListRules.defaultExpectation.paramPtrs = &PolicyServiceMockListRulesParamPtrs{}
+	}
+	mmListRules.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListRules.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListRules
+}
+
+// ExpectFilterParam3 sets up expected param filter for PolicyService.ListRules
+func (mmListRules *mPolicyServiceMockListRules) ExpectFilterParam3(filter *model.AccessRuleFilter) *mPolicyServiceMockListRules {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &PolicyServiceMockListRulesExpectation{}
+	}
+
+	if mmListRules.defaultExpectation.params != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Expect")
+	}
+
+	if mmListRules.defaultExpectation.paramPtrs == nil {
+		mmListRules.defaultExpectation.paramPtrs = &PolicyServiceMockListRulesParamPtrs{}
+	}
+	mmListRules.defaultExpectation.paramPtrs.filter = &filter
+	mmListRules.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.ListRules
+func (mmListRules *mPolicyServiceMockListRules) Inspect(f func(ctx context.Context, accessToken string, filter *model.AccessRuleFilter)) *mPolicyServiceMockListRules {
+	if mmListRules.mock.inspectFuncListRules != nil {
+		mmListRules.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.ListRules")
+	}
+
+	mmListRules.mock.inspectFuncListRules = f
+
+	return mmListRules
+}
+
+// Return sets up results that will be returned by PolicyService.ListRules
+func (mmListRules *mPolicyServiceMockListRules) Return(apa1 []*model.AccessRule, err error) *PolicyServiceMock {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Set")
+	}
+
+	if mmListRules.defaultExpectation == nil {
+		mmListRules.defaultExpectation = &PolicyServiceMockListRulesExpectation{mock: mmListRules.mock}
+	}
+	mmListRules.defaultExpectation.results = &PolicyServiceMockListRulesResults{apa1, err}
+	mmListRules.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRules.mock
+}
+
+// Set uses given function f to mock the PolicyService.ListRules method
+func (mmListRules *mPolicyServiceMockListRules) Set(f func(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) (apa1 []*model.AccessRule, err error)) *PolicyServiceMock {
+	if mmListRules.defaultExpectation != nil {
+		mmListRules.mock.t.Fatalf("Default expectation is already set for the PolicyService.ListRules method")
+	}
+
+	if len(mmListRules.expectations) > 0 {
+		mmListRules.mock.t.Fatalf("Some expectations are already set for the PolicyService.ListRules method")
+	}
+
+	mmListRules.mock.funcListRules = f
+	mmListRules.mock.funcListRulesOrigin = minimock.CallerInfo(1)
+	return mmListRules.mock
+}
+
+// When sets expectation for the PolicyService.ListRules which will trigger the result defined by the following
+// Then helper
+func (mmListRules *mPolicyServiceMockListRules) When(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) *PolicyServiceMockListRulesExpectation {
+	if mmListRules.mock.funcListRules != nil {
+		mmListRules.mock.t.Fatalf("PolicyServiceMock.ListRules mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockListRulesExpectation{
+		mock:               mmListRules.mock,
+		params:             &PolicyServiceMockListRulesParams{ctx, accessToken, filter},
+		expectationOrigins: PolicyServiceMockListRulesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRules.expectations = append(mmListRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.ListRules return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockListRulesExpectation) Then(apa1 []*model.AccessRule, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockListRulesResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.ListRules should be invoked
+func (mmListRules *mPolicyServiceMockListRules) Times(n uint64) *mPolicyServiceMockListRules {
+	if n == 0 {
+		mmListRules.mock.t.Fatalf("Times of PolicyServiceMock.ListRules mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRules.expectedInvocations, n)
+	mmListRules.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRules
+}
+
+func (mmListRules *mPolicyServiceMockListRules) invocationsDone() bool {
+	if len(mmListRules.expectations) == 0 && mmListRules.defaultExpectation == nil && mmListRules.mock.funcListRules == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRules.mock.afterListRulesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRules.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRules implements mm_service.PolicyService
+func (mmListRules *PolicyServiceMock) ListRules(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) (apa1 []*model.AccessRule, err error) {
+	mm_atomic.AddUint64(&mmListRules.beforeListRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRules.afterListRulesCounter, 1)
+
+	mmListRules.t.Helper()
+
+	if mmListRules.inspectFuncListRules != nil {
+		mmListRules.inspectFuncListRules(ctx, accessToken, filter)
+	}
+
+	mm_params := PolicyServiceMockListRulesParams{ctx, accessToken, filter}
+
+	// Record call args
+	mmListRules.ListRulesMock.mutex.Lock()
+	mmListRules.ListRulesMock.callArgs = append(mmListRules.ListRulesMock.callArgs, &mm_params)
+	mmListRules.ListRulesMock.mutex.Unlock()
+
+	for _, e := range mmListRules.ListRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmListRules.ListRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRules.ListRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRules.ListRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRules.ListRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockListRulesParams{ctx, accessToken, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRules.t.Errorf("PolicyServiceMock.ListRules got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListRules.t.Errorf("PolicyServiceMock.ListRules got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListRules.t.Errorf("PolicyServiceMock.ListRules got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRules.t.Errorf("PolicyServiceMock.ListRules got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRules.ListRulesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRules.ListRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRules.t.Fatal("No results are set for the PolicyServiceMock.ListRules")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmListRules.funcListRules != nil {
+		return mmListRules.funcListRules(ctx, accessToken, filter)
+	}
+	mmListRules.t.Fatalf("Unexpected call to PolicyServiceMock.ListRules. %v %v %v", ctx, accessToken, filter)
+	return
+}
+
+// ListRulesAfterCounter returns a count of finished PolicyServiceMock.ListRules invocations
+func (mmListRules *PolicyServiceMock) ListRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRules.afterListRulesCounter)
+}
+
+// ListRulesBeforeCounter returns a count of PolicyServiceMock.ListRules invocations
+func (mmListRules *PolicyServiceMock) ListRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRules.beforeListRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.ListRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRules *mPolicyServiceMockListRules) Calls() []*PolicyServiceMockListRulesParams {
+	mmListRules.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockListRulesParams, len(mmListRules.callArgs))
+	copy(argCopy, mmListRules.callArgs)
+
+	mmListRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRulesDone returns true if the count of the ListRules invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockListRulesDone() bool {
+	if m.ListRulesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRulesMock.invocationsDone()
+}
+
+// MinimockListRulesInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockListRulesInspect() {
+	for _, e := range m.ListRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListRules at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRulesCounter := mm_atomic.LoadUint64(&m.afterListRulesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRulesMock.defaultExpectation != nil && afterListRulesCounter < 1 {
+		if m.ListRulesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListRules at\n%s", m.ListRulesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.ListRules at\n%s with params: %#v", m.ListRulesMock.defaultExpectation.expectationOrigins.origin, *m.ListRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRules != nil && afterListRulesCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.ListRules at\n%s", m.funcListRulesOrigin)
+	}
+
+	if !m.ListRulesMock.invocationsDone() && afterListRulesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.ListRules at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRulesMock.expectedInvocations), m.ListRulesMock.expectedInvocationsOrigin, afterListRulesCounter)
+	}
+}
+
+type mPolicyServiceMockRevokePermission struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockRevokePermissionExpectation
+	expectations       []*PolicyServiceMockRevokePermissionExpectation
+
+	callArgs []*PolicyServiceMockRevokePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockRevokePermissionExpectation specifies expectation struct of the PolicyService.RevokePermission
+type PolicyServiceMockRevokePermissionExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockRevokePermissionParams
+	paramPtrs          *PolicyServiceMockRevokePermissionParamPtrs
+	expectationOrigins PolicyServiceMockRevokePermissionExpectationOrigins
+	results            *PolicyServiceMockRevokePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockRevokePermissionParams contains parameters of the PolicyService.RevokePermission
+type PolicyServiceMockRevokePermissionParams struct {
+	ctx         context.Context
+	accessToken string
+	grant       *model.RolePermission
+}
+
+// PolicyServiceMockRevokePermissionParamPtrs contains pointers to parameters of the PolicyService.RevokePermission
+type PolicyServiceMockRevokePermissionParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	grant       **model.RolePermission
+}
+
+// PolicyServiceMockRevokePermissionResults contains results of the PolicyService.RevokePermission
+type PolicyServiceMockRevokePermissionResults struct {
+	err error
+}
+
+// PolicyServiceMockRevokePermissionOrigins contains origins of expectations of the PolicyService.RevokePermission
+type PolicyServiceMockRevokePermissionExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originGrant       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Optional() *mPolicyServiceMockRevokePermission {
+	mmRevokePermission.optional = true
+	return mmRevokePermission
+}
+
+// Expect sets up expected params for PolicyService.RevokePermission
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Expect(ctx context.Context, accessToken string, grant *model.RolePermission) *mPolicyServiceMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PolicyServiceMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by ExpectParams functions")
+	}
+
+	mmRevokePermission.defaultExpectation.params = &PolicyServiceMockRevokePermissionParams{ctx, accessToken, grant}
+	mmRevokePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokePermission.expectations {
+		if minimock.Equal(e.params, mmRevokePermission.defaultExpectation.params) {
+			mmRevokePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.RevokePermission
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PolicyServiceMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.params != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Expect")
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs == nil {
+		mmRevokePermission.defaultExpectation.paramPtrs = &PolicyServiceMockRevokePermissionParamPtrs{}
+	}
+	mmRevokePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokePermission
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.RevokePermission
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PolicyServiceMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.params != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Expect")
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs == nil {
+		mmRevokePermission.defaultExpectation.paramPtrs = &PolicyServiceMockRevokePermissionParamPtrs{}
+	}
+	mmRevokePermission.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmRevokePermission.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmRevokePermission
+}
+
+// ExpectGrantParam3 sets up expected param grant for PolicyService.RevokePermission
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) ExpectGrantParam3(grant *model.RolePermission) *mPolicyServiceMockRevokePermission {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PolicyServiceMockRevokePermissionExpectation{}
+	}
+
+	if mmRevokePermission.defaultExpectation.params != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Expect")
+	}
+
+	if mmRevokePermission.defaultExpectation.paramPtrs == nil {
+		mmRevokePermission.defaultExpectation.paramPtrs = &PolicyServiceMockRevokePermissionParamPtrs{}
+	}
+	mmRevokePermission.defaultExpectation.paramPtrs.grant = &grant
+	mmRevokePermission.defaultExpectation.expectationOrigins.originGrant = minimock.CallerInfo(1)
+
+	return mmRevokePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.RevokePermission
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Inspect(f func(ctx context.Context, accessToken string, grant *model.RolePermission)) *mPolicyServiceMockRevokePermission {
+	if mmRevokePermission.mock.inspectFuncRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.RevokePermission")
+	}
+
+	mmRevokePermission.mock.inspectFuncRevokePermission = f
+
+	return mmRevokePermission
+}
+
+// Return sets up results that will be returned by PolicyService.RevokePermission
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Return(err error) *PolicyServiceMock {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Set")
+	}
+
+	if mmRevokePermission.defaultExpectation == nil {
+		mmRevokePermission.defaultExpectation = &PolicyServiceMockRevokePermissionExpectation{mock: mmRevokePermission.mock}
+	}
+	mmRevokePermission.defaultExpectation.results = &PolicyServiceMockRevokePermissionResults{err}
+	mmRevokePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokePermission.mock
+}
+
+// Set uses given function f to mock the PolicyService.RevokePermission method
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Set(f func(ctx context.Context, accessToken string, grant *model.RolePermission) (err error)) *PolicyServiceMock {
+	if mmRevokePermission.defaultExpectation != nil {
+		mmRevokePermission.mock.t.Fatalf("Default expectation is already set for the PolicyService.RevokePermission method")
+	}
+
+	if len(mmRevokePermission.expectations) > 0 {
+		mmRevokePermission.mock.t.Fatalf("Some expectations are already set for the PolicyService.RevokePermission method")
+	}
+
+	mmRevokePermission.mock.funcRevokePermission = f
+	mmRevokePermission.mock.funcRevokePermissionOrigin = minimock.CallerInfo(1)
+	return mmRevokePermission.mock
+}
+
+// When sets expectation for the PolicyService.RevokePermission which will trigger the result defined by the following
+// Then helper
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) When(ctx context.Context, accessToken string, grant *model.RolePermission) *PolicyServiceMockRevokePermissionExpectation {
+	if mmRevokePermission.mock.funcRevokePermission != nil {
+		mmRevokePermission.mock.t.Fatalf("PolicyServiceMock.RevokePermission mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockRevokePermissionExpectation{
+		mock:               mmRevokePermission.mock,
+		params:             &PolicyServiceMockRevokePermissionParams{ctx, accessToken, grant},
+		expectationOrigins: PolicyServiceMockRevokePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokePermission.expectations = append(mmRevokePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.RevokePermission return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockRevokePermissionExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockRevokePermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.RevokePermission should be invoked
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Times(n uint64) *mPolicyServiceMockRevokePermission {
+	if n == 0 {
+		mmRevokePermission.mock.t.Fatalf("Times of PolicyServiceMock.RevokePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokePermission.expectedInvocations, n)
+	mmRevokePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokePermission
+}
+
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) invocationsDone() bool {
+	if len(mmRevokePermission.expectations) == 0 && mmRevokePermission.defaultExpectation == nil && mmRevokePermission.mock.funcRevokePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokePermission.mock.afterRevokePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokePermission implements mm_service.PolicyService
+func (mmRevokePermission *PolicyServiceMock) RevokePermission(ctx context.Context, accessToken string, grant *model.RolePermission) (err error) {
+	mm_atomic.AddUint64(&mmRevokePermission.beforeRevokePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokePermission.afterRevokePermissionCounter, 1)
+
+	mmRevokePermission.t.Helper()
+
+	if mmRevokePermission.inspectFuncRevokePermission != nil {
+		mmRevokePermission.inspectFuncRevokePermission(ctx, accessToken, grant)
+	}
+
+	mm_params := PolicyServiceMockRevokePermissionParams{ctx, accessToken, grant}
+
+	// Record call args
+	mmRevokePermission.RevokePermissionMock.mutex.Lock()
+	mmRevokePermission.RevokePermissionMock.callArgs = append(mmRevokePermission.RevokePermissionMock.callArgs, &mm_params)
+	mmRevokePermission.RevokePermissionMock.mutex.Unlock()
+
+	for _, e := range mmRevokePermission.RevokePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokePermission.RevokePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokePermission.RevokePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokePermission.RevokePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokePermission.RevokePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockRevokePermissionParams{ctx, accessToken, grant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokePermission.t.Errorf("PolicyServiceMock.RevokePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmRevokePermission.t.Errorf("PolicyServiceMock.RevokePermission got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.grant != nil && !minimock.Equal(*mm_want_ptrs.grant, mm_got.grant) {
+				mmRevokePermission.t.Errorf("PolicyServiceMock.RevokePermission got unexpected parameter grant, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.originGrant, *mm_want_ptrs.grant, mm_got.grant, minimock.Diff(*mm_want_ptrs.grant, mm_got.grant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokePermission.t.Errorf("PolicyServiceMock.RevokePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokePermission.RevokePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokePermission.RevokePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokePermission.t.Fatal("No results are set for the PolicyServiceMock.RevokePermission")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokePermission.funcRevokePermission != nil {
+		return mmRevokePermission.funcRevokePermission(ctx, accessToken, grant)
+	}
+	mmRevokePermission.t.Fatalf("Unexpected call to PolicyServiceMock.RevokePermission. %v %v %v", ctx, accessToken, grant)
+	return
+}
+
+// RevokePermissionAfterCounter returns a count of finished PolicyServiceMock.RevokePermission invocations
+func (mmRevokePermission *PolicyServiceMock) RevokePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokePermission.afterRevokePermissionCounter)
+}
+
+// RevokePermissionBeforeCounter returns a count of PolicyServiceMock.RevokePermission invocations
+func (mmRevokePermission *PolicyServiceMock) RevokePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokePermission.beforeRevokePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.RevokePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokePermission *mPolicyServiceMockRevokePermission) Calls() []*PolicyServiceMockRevokePermissionParams {
+	mmRevokePermission.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockRevokePermissionParams, len(mmRevokePermission.callArgs))
+	copy(argCopy, mmRevokePermission.callArgs)
+
+	mmRevokePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokePermissionDone returns true if the count of the RevokePermission invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockRevokePermissionDone() bool {
+	if m.RevokePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokePermissionMock.invocationsDone()
+}
+
+// MinimockRevokePermissionInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockRevokePermissionInspect() {
+	for _, e := range m.RevokePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.RevokePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokePermissionCounter := mm_atomic.LoadUint64(&m.afterRevokePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokePermissionMock.defaultExpectation != nil && afterRevokePermissionCounter < 1 {
+		if m.RevokePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.RevokePermission at\n%s", m.RevokePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.RevokePermission at\n%s with params: %#v", m.RevokePermissionMock.defaultExpectation.expectationOrigins.origin, *m.RevokePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokePermission != nil && afterRevokePermissionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.RevokePermission at\n%s", m.funcRevokePermissionOrigin)
+	}
+
+	if !m.RevokePermissionMock.invocationsDone() && afterRevokePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.RevokePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokePermissionMock.expectedInvocations), m.RevokePermissionMock.expectedInvocationsOrigin, afterRevokePermissionCounter)
+	}
+}
+
+type mPolicyServiceMockRollbackPolicy struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockRollbackPolicyExpectation
+	expectations       []*PolicyServiceMockRollbackPolicyExpectation
+
+	callArgs []*PolicyServiceMockRollbackPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockRollbackPolicyExpectation specifies expectation struct of the PolicyService.RollbackPolicy
+type PolicyServiceMockRollbackPolicyExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockRollbackPolicyParams
+	paramPtrs          *PolicyServiceMockRollbackPolicyParamPtrs
+	expectationOrigins PolicyServiceMockRollbackPolicyExpectationOrigins
+	results            *PolicyServiceMockRollbackPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockRollbackPolicyParams contains parameters of the PolicyService.RollbackPolicy
+type PolicyServiceMockRollbackPolicyParams struct {
+	ctx         context.Context
+	accessToken string
+	version     int64
+	dryRun      bool
+}
+
+// PolicyServiceMockRollbackPolicyParamPtrs contains pointers to parameters of the PolicyService.RollbackPolicy
+type PolicyServiceMockRollbackPolicyParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	version     *int64
+	dryRun      *bool
+}
+
+// PolicyServiceMockRollbackPolicyResults contains results of the PolicyService.RollbackPolicy
+type PolicyServiceMockRollbackPolicyResults struct {
+	pp1 *model.PolicyApplyResult
+	err error
+}
+
+// PolicyServiceMockRollbackPolicyOrigins contains origins of expectations of the PolicyService.RollbackPolicy
+type PolicyServiceMockRollbackPolicyExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originVersion     string
+	originDryRun      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Optional() *mPolicyServiceMockRollbackPolicy {
+	mmRollbackPolicy.optional = true
+	return mmRollbackPolicy
+}
+
+// Expect sets up expected params for PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Expect(ctx context.Context, accessToken string, version int64, dryRun bool) *mPolicyServiceMockRollbackPolicy {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	if mmRollbackPolicy.defaultExpectation == nil {
+		mmRollbackPolicy.defaultExpectation = &PolicyServiceMockRollbackPolicyExpectation{}
+	}
+
+	if mmRollbackPolicy.defaultExpectation.paramPtrs != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmRollbackPolicy.defaultExpectation.params = &PolicyServiceMockRollbackPolicyParams{ctx, accessToken, version, dryRun}
+	mmRollbackPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRollbackPolicy.expectations {
+		if minimock.Equal(e.params, mmRollbackPolicy.defaultExpectation.params) {
+			mmRollbackPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRollbackPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmRollbackPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockRollbackPolicy {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	if mmRollbackPolicy.defaultExpectation == nil {
+		mmRollbackPolicy.defaultExpectation = &PolicyServiceMockRollbackPolicyExpectation{}
+	}
+
+	if mmRollbackPolicy.defaultExpectation.params != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Expect")
+	}
+
+	if mmRollbackPolicy.defaultExpectation.paramPtrs == nil {
+		mmRollbackPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockRollbackPolicyParamPtrs{}
+	}
+	mmRollbackPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRollbackPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRollbackPolicy
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockRollbackPolicy {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	if mmRollbackPolicy.defaultExpectation == nil {
+		mmRollbackPolicy.defaultExpectation = &PolicyServiceMockRollbackPolicyExpectation{}
+	}
+
+	if mmRollbackPolicy.defaultExpectation.params != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Expect")
+	}
+
+	if mmRollbackPolicy.defaultExpectation.paramPtrs == nil {
+		mmRollbackPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockRollbackPolicyParamPtrs{}
+	}
+	mmRollbackPolicy.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmRollbackPolicy.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmRollbackPolicy
+}
+
+// ExpectVersionParam3 sets up expected param version for PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) ExpectVersionParam3(version int64) *mPolicyServiceMockRollbackPolicy {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	if mmRollbackPolicy.defaultExpectation == nil {
+		mmRollbackPolicy.defaultExpectation = &PolicyServiceMockRollbackPolicyExpectation{}
+	}
+
+	if mmRollbackPolicy.defaultExpectation.params != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Expect")
+	}
+
+	if mmRollbackPolicy.defaultExpectation.paramPtrs == nil {
+		mmRollbackPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockRollbackPolicyParamPtrs{}
+	}
+	mmRollbackPolicy.defaultExpectation.paramPtrs.version = &version
+	mmRollbackPolicy.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmRollbackPolicy
+}
+
+// ExpectDryRunParam4 sets up expected param dryRun for PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) ExpectDryRunParam4(dryRun bool) *mPolicyServiceMockRollbackPolicy {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	if mmRollbackPolicy.defaultExpectation == nil {
+		mmRollbackPolicy.defaultExpectation = &PolicyServiceMockRollbackPolicyExpectation{}
+	}
+
+	if mmRollbackPolicy.defaultExpectation.params != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Expect")
+	}
+
+	if mmRollbackPolicy.defaultExpectation.paramPtrs == nil {
+		mmRollbackPolicy.defaultExpectation.paramPtrs = &PolicyServiceMockRollbackPolicyParamPtrs{}
+	}
+	mmRollbackPolicy.defaultExpectation.paramPtrs.dryRun = &dryRun
+	mmRollbackPolicy.defaultExpectation.expectationOrigins.originDryRun = minimock.CallerInfo(1)
+
+	return mmRollbackPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Inspect(f func(ctx context.Context, accessToken string, version int64, dryRun bool)) *mPolicyServiceMockRollbackPolicy {
+	if mmRollbackPolicy.mock.inspectFuncRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.RollbackPolicy")
+	}
+
+	mmRollbackPolicy.mock.inspectFuncRollbackPolicy = f
+
+	return mmRollbackPolicy
+}
+
+// Return sets up results that will be returned by PolicyService.RollbackPolicy
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Return(pp1 *model.PolicyApplyResult, err error) *PolicyServiceMock {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	if mmRollbackPolicy.defaultExpectation == nil {
+		mmRollbackPolicy.defaultExpectation = &PolicyServiceMockRollbackPolicyExpectation{mock: mmRollbackPolicy.mock}
+	}
+	mmRollbackPolicy.defaultExpectation.results = &PolicyServiceMockRollbackPolicyResults{pp1, err}
+	mmRollbackPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRollbackPolicy.mock
+}
+
+// Set uses given function f to mock the PolicyService.RollbackPolicy method
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Set(f func(ctx context.Context, accessToken string, version int64, dryRun bool) (pp1 *model.PolicyApplyResult, err error)) *PolicyServiceMock {
+	if mmRollbackPolicy.defaultExpectation != nil {
+		mmRollbackPolicy.mock.t.Fatalf("Default expectation is already set for the PolicyService.RollbackPolicy method")
+	}
+
+	if len(mmRollbackPolicy.expectations) > 0 {
+		mmRollbackPolicy.mock.t.Fatalf("Some expectations are already set for the PolicyService.RollbackPolicy method")
+	}
+
+	mmRollbackPolicy.mock.funcRollbackPolicy = f
+	mmRollbackPolicy.mock.funcRollbackPolicyOrigin = minimock.CallerInfo(1)
+	return mmRollbackPolicy.mock
+}
+
+// When sets expectation for the PolicyService.RollbackPolicy which will trigger the result defined by the following
+// Then helper
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) When(ctx context.Context, accessToken string, version int64, dryRun bool) *PolicyServiceMockRollbackPolicyExpectation {
+	if mmRollbackPolicy.mock.funcRollbackPolicy != nil {
+		mmRollbackPolicy.mock.t.Fatalf("PolicyServiceMock.RollbackPolicy mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockRollbackPolicyExpectation{
+		mock:               mmRollbackPolicy.mock,
+		params:             &PolicyServiceMockRollbackPolicyParams{ctx, accessToken, version, dryRun},
+		expectationOrigins: PolicyServiceMockRollbackPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRollbackPolicy.expectations = append(mmRollbackPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.RollbackPolicy return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockRollbackPolicyExpectation) Then(pp1 *model.PolicyApplyResult, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockRollbackPolicyResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.RollbackPolicy should be invoked
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Times(n uint64) *mPolicyServiceMockRollbackPolicy {
+	if n == 0 {
+		mmRollbackPolicy.mock.t.Fatalf("Times of PolicyServiceMock.RollbackPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRollbackPolicy.expectedInvocations, n)
+	mmRollbackPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRollbackPolicy
+}
+
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) invocationsDone() bool {
+	if len(mmRollbackPolicy.expectations) == 0 && mmRollbackPolicy.defaultExpectation == nil && mmRollbackPolicy.mock.funcRollbackPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRollbackPolicy.mock.afterRollbackPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRollbackPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RollbackPolicy implements mm_service.PolicyService
+func (mmRollbackPolicy *PolicyServiceMock) RollbackPolicy(ctx context.Context, accessToken string, version int64, dryRun bool) (pp1 *model.PolicyApplyResult, err error) {
+	mm_atomic.AddUint64(&mmRollbackPolicy.beforeRollbackPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRollbackPolicy.afterRollbackPolicyCounter, 1)
+
+	mmRollbackPolicy.t.Helper()
+
+	if mmRollbackPolicy.inspectFuncRollbackPolicy != nil {
+		mmRollbackPolicy.inspectFuncRollbackPolicy(ctx, accessToken, version, dryRun)
+	}
+
+	mm_params := PolicyServiceMockRollbackPolicyParams{ctx, accessToken, version, dryRun}
+
+	// Record call args
+	mmRollbackPolicy.RollbackPolicyMock.mutex.Lock()
+	mmRollbackPolicy.RollbackPolicyMock.callArgs = append(mmRollbackPolicy.RollbackPolicyMock.callArgs, &mm_params)
+	mmRollbackPolicy.RollbackPolicyMock.mutex.Unlock()
+
+	for _, e := range mmRollbackPolicy.RollbackPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmRollbackPolicy.RollbackPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockRollbackPolicyParams{ctx, accessToken, version, dryRun}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRollbackPolicy.t.Errorf("PolicyServiceMock.RollbackPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmRollbackPolicy.t.Errorf("PolicyServiceMock.RollbackPolicy got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmRollbackPolicy.t.Errorf("PolicyServiceMock.RollbackPolicy got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmRollbackPolicy.t.Errorf("PolicyServiceMock.RollbackPolicy got unexpected parameter dryRun, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.expectationOrigins.originDryRun, *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRollbackPolicy.t.Errorf("PolicyServiceMock.RollbackPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRollbackPolicy.RollbackPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRollbackPolicy.t.Fatal("No results are set for the PolicyServiceMock.RollbackPolicy")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmRollbackPolicy.funcRollbackPolicy != nil {
+		return mmRollbackPolicy.funcRollbackPolicy(ctx, accessToken, version, dryRun)
+	}
+	mmRollbackPolicy.t.Fatalf("Unexpected call to PolicyServiceMock.RollbackPolicy. %v %v %v %v", ctx, accessToken, version, dryRun)
+	return
+}
+
+// RollbackPolicyAfterCounter returns a count of finished PolicyServiceMock.RollbackPolicy invocations
+func (mmRollbackPolicy *PolicyServiceMock) RollbackPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRollbackPolicy.afterRollbackPolicyCounter)
+}
+
+// RollbackPolicyBeforeCounter returns a count of PolicyServiceMock.RollbackPolicy invocations
+func (mmRollbackPolicy *PolicyServiceMock) RollbackPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRollbackPolicy.beforeRollbackPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.RollbackPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRollbackPolicy *mPolicyServiceMockRollbackPolicy) Calls() []*PolicyServiceMockRollbackPolicyParams {
+	mmRollbackPolicy.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockRollbackPolicyParams, len(mmRollbackPolicy.callArgs))
+	copy(argCopy, mmRollbackPolicy.callArgs)
+
+	mmRollbackPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRollbackPolicyDone returns true if the count of the RollbackPolicy invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockRollbackPolicyDone() bool {
+	if m.RollbackPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RollbackPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RollbackPolicyMock.invocationsDone()
+}
+
+// MinimockRollbackPolicyInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockRollbackPolicyInspect() {
+	for _, e := range m.RollbackPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.RollbackPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRollbackPolicyCounter := mm_atomic.LoadUint64(&m.afterRollbackPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RollbackPolicyMock.defaultExpectation != nil && afterRollbackPolicyCounter < 1 {
+		if m.RollbackPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.RollbackPolicy at\n%s", m.RollbackPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.RollbackPolicy at\n%s with params: %#v", m.RollbackPolicyMock.defaultExpectation.expectationOrigins.origin, *m.RollbackPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRollbackPolicy != nil && afterRollbackPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.RollbackPolicy at\n%s", m.funcRollbackPolicyOrigin)
+	}
+
+	if !m.RollbackPolicyMock.invocationsDone() && afterRollbackPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.RollbackPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RollbackPolicyMock.expectedInvocations), m.RollbackPolicyMock.expectedInvocationsOrigin, afterRollbackPolicyCounter)
+	}
+}
+
+type mPolicyServiceMockSimulateCheck struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockSimulateCheckExpectation
+	expectations       []*PolicyServiceMockSimulateCheckExpectation
+
+	callArgs []*PolicyServiceMockSimulateCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockSimulateCheckExpectation specifies expectation struct of the PolicyService.SimulateCheck
+type PolicyServiceMockSimulateCheckExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockSimulateCheckParams
+	paramPtrs          *PolicyServiceMockSimulateCheckParamPtrs
+	expectationOrigins PolicyServiceMockSimulateCheckExpectationOrigins
+	results            *PolicyServiceMockSimulateCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockSimulateCheckParams contains parameters of the PolicyService.SimulateCheck
+type PolicyServiceMockSimulateCheckParams struct {
+	ctx         context.Context
+	accessToken string
+	simulation  *model.AccessSimulation
+}
+
+// PolicyServiceMockSimulateCheckParamPtrs contains pointers to parameters of the PolicyService.SimulateCheck
+type PolicyServiceMockSimulateCheckParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	simulation  **model.AccessSimulation
+}
+
+// PolicyServiceMockSimulateCheckResults contains results of the PolicyService.SimulateCheck
+type PolicyServiceMockSimulateCheckResults struct {
+	ap1 *model.AccessDecision
+	err error
+}
+
+// PolicyServiceMockSimulateCheckOrigins contains origins of expectations of the PolicyService.SimulateCheck
+type PolicyServiceMockSimulateCheckExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originSimulation  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Optional() *mPolicyServiceMockSimulateCheck {
+	mmSimulateCheck.optional = true
+	return mmSimulateCheck
+}
+
+// Expect sets up expected params for PolicyService.SimulateCheck
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Expect(ctx context.Context, accessToken string, simulation *model.AccessSimulation) *mPolicyServiceMockSimulateCheck {
+	if mmSimulateCheck.mock.funcSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Set")
+	}
+
+	if mmSimulateCheck.defaultExpectation == nil {
+		mmSimulateCheck.defaultExpectation = &PolicyServiceMockSimulateCheckExpectation{}
+	}
+
+	if mmSimulateCheck.defaultExpectation.paramPtrs != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by ExpectParams functions")
+	}
+
+	mmSimulateCheck.defaultExpectation.params = &PolicyServiceMockSimulateCheckParams{ctx, accessToken, simulation}
+	mmSimulateCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSimulateCheck.expectations {
+		if minimock.Equal(e.params, mmSimulateCheck.defaultExpectation.params) {
+			mmSimulateCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSimulateCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmSimulateCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.SimulateCheck
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockSimulateCheck {
+	if mmSimulateCheck.mock.funcSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Set")
+	}
+
+	if mmSimulateCheck.defaultExpectation == nil {
+		mmSimulateCheck.defaultExpectation = &PolicyServiceMockSimulateCheckExpectation{}
+	}
+
+	if mmSimulateCheck.defaultExpectation.params != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Expect")
+	}
+
+	if mmSimulateCheck.defaultExpectation.paramPtrs == nil {
+		mmSimulateCheck.defaultExpectation.paramPtrs = &PolicyServiceMockSimulateCheckParamPtrs{}
+	}
+	mmSimulateCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSimulateCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSimulateCheck
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.SimulateCheck
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockSimulateCheck {
+	if mmSimulateCheck.mock.funcSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Set")
+	}
+
+	if mmSimulateCheck.defaultExpectation == nil {
+		mmSimulateCheck.defaultExpectation = &PolicyServiceMockSimulateCheckExpectation{}
+	}
+
+	if mmSimulateCheck.defaultExpectation.params != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Expect")
+	}
+
+	if mmSimulateCheck.defaultExpectation.paramPtrs == nil {
+		mmSimulateCheck.defaultExpectation.paramPtrs = &PolicyServiceMockSimulateCheckParamPtrs{}
+	}
+	mmSimulateCheck.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmSimulateCheck.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmSimulateCheck
+}
+
+// ExpectSimulationParam3 sets up expected param simulation for PolicyService.SimulateCheck
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) ExpectSimulationParam3(simulation *model.AccessSimulation) *mPolicyServiceMockSimulateCheck {
+	if mmSimulateCheck.mock.funcSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Set")
+	}
+
+	if mmSimulateCheck.defaultExpectation == nil {
+		mmSimulateCheck.defaultExpectation = &PolicyServiceMockSimulateCheckExpectation{}
+	}
+
+	if mmSimulateCheck.defaultExpectation.params != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Expect")
+	}
+
+	if mmSimulateCheck.defaultExpectation.paramPtrs == nil {
+		mmSimulateCheck.defaultExpectation.paramPtrs = &PolicyServiceMockSimulateCheckParamPtrs{}
+	}
+	mmSimulateCheck.defaultExpectation.paramPtrs.simulation = &simulation
+	mmSimulateCheck.defaultExpectation.expectationOrigins.originSimulation = minimock.CallerInfo(1)
+
+	return mmSimulateCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.SimulateCheck
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Inspect(f func(ctx context.Context, accessToken string, simulation *model.AccessSimulation)) *mPolicyServiceMockSimulateCheck {
+	if mmSimulateCheck.mock.inspectFuncSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.SimulateCheck")
+	}
+
+	mmSimulateCheck.mock.inspectFuncSimulateCheck = f
+
+	return mmSimulateCheck
+}
+
+// Return sets up results that will be returned by PolicyService.SimulateCheck
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Return(ap1 *model.AccessDecision, err error) *PolicyServiceMock {
+	if mmSimulateCheck.mock.funcSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Set")
+	}
+
+	if mmSimulateCheck.defaultExpectation == nil {
+		mmSimulateCheck.defaultExpectation = &PolicyServiceMockSimulateCheckExpectation{mock: mmSimulateCheck.mock}
+	}
+	mmSimulateCheck.defaultExpectation.results = &PolicyServiceMockSimulateCheckResults{ap1, err}
+	mmSimulateCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSimulateCheck.mock
+}
+
+// Set uses given function f to mock the PolicyService.SimulateCheck method
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Set(f func(ctx context.Context, accessToken string, simulation *model.AccessSimulation) (ap1 *model.AccessDecision, err error)) *PolicyServiceMock {
+	if mmSimulateCheck.defaultExpectation != nil {
+		mmSimulateCheck.mock.t.Fatalf("Default expectation is already set for the PolicyService.SimulateCheck method")
+	}
+
+	if len(mmSimulateCheck.expectations) > 0 {
+		mmSimulateCheck.mock.t.Fatalf("Some expectations are already set for the PolicyService.SimulateCheck method")
+	}
+
+	mmSimulateCheck.mock.funcSimulateCheck = f
+	mmSimulateCheck.mock.funcSimulateCheckOrigin = minimock.CallerInfo(1)
+	return mmSimulateCheck.mock
+}
+
+// When sets expectation for the PolicyService.SimulateCheck which will trigger the result defined by the following
+// Then helper
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) When(ctx context.Context, accessToken string, simulation *model.AccessSimulation) *PolicyServiceMockSimulateCheckExpectation {
+	if mmSimulateCheck.mock.funcSimulateCheck != nil {
+		mmSimulateCheck.mock.t.Fatalf("PolicyServiceMock.SimulateCheck mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockSimulateCheckExpectation{
+		mock:               mmSimulateCheck.mock,
+		params:             &PolicyServiceMockSimulateCheckParams{ctx, accessToken, simulation},
+		expectationOrigins: PolicyServiceMockSimulateCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSimulateCheck.expectations = append(mmSimulateCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.SimulateCheck return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockSimulateCheckExpectation) Then(ap1 *model.AccessDecision, err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockSimulateCheckResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.SimulateCheck should be invoked
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Times(n uint64) *mPolicyServiceMockSimulateCheck {
+	if n == 0 {
+		mmSimulateCheck.mock.t.Fatalf("Times of PolicyServiceMock.SimulateCheck mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSimulateCheck.expectedInvocations, n)
+	mmSimulateCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSimulateCheck
+}
+
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) invocationsDone() bool {
+	if len(mmSimulateCheck.expectations) == 0 && mmSimulateCheck.defaultExpectation == nil && mmSimulateCheck.mock.funcSimulateCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSimulateCheck.mock.afterSimulateCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSimulateCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SimulateCheck implements mm_service.PolicyService
+func (mmSimulateCheck *PolicyServiceMock) SimulateCheck(ctx context.Context, accessToken string, simulation *model.AccessSimulation) (ap1 *model.AccessDecision, err error) {
+	mm_atomic.AddUint64(&mmSimulateCheck.beforeSimulateCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmSimulateCheck.afterSimulateCheckCounter, 1)
+
+	mmSimulateCheck.t.Helper()
+
+	if mmSimulateCheck.inspectFuncSimulateCheck != nil {
+		mmSimulateCheck.inspectFuncSimulateCheck(ctx, accessToken, simulation)
+	}
+
+	mm_params := PolicyServiceMockSimulateCheckParams{ctx, accessToken, simulation}
+
+	// Record call args
+	mmSimulateCheck.SimulateCheckMock.mutex.Lock()
+	mmSimulateCheck.SimulateCheckMock.callArgs = append(mmSimulateCheck.SimulateCheckMock.callArgs, &mm_params)
+	mmSimulateCheck.SimulateCheckMock.mutex.Unlock()
+
+	for _, e := range mmSimulateCheck.SimulateCheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmSimulateCheck.SimulateCheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSimulateCheck.SimulateCheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmSimulateCheck.SimulateCheckMock.defaultExpectation.params
+		mm_want_ptrs := mmSimulateCheck.SimulateCheckMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockSimulateCheckParams{ctx, accessToken, simulation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSimulateCheck.t.Errorf("PolicyServiceMock.SimulateCheck got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSimulateCheck.SimulateCheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmSimulateCheck.t.Errorf("PolicyServiceMock.SimulateCheck got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSimulateCheck.SimulateCheckMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.simulation != nil && !minimock.Equal(*mm_want_ptrs.simulation, mm_got.simulation) {
+				mmSimulateCheck.t.Errorf("PolicyServiceMock.SimulateCheck got unexpected parameter simulation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSimulateCheck.SimulateCheckMock.defaultExpectation.expectationOrigins.originSimulation, *mm_want_ptrs.simulation, mm_got.simulation, minimock.Diff(*mm_want_ptrs.simulation, mm_got.simulation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSimulateCheck.t.Errorf("PolicyServiceMock.SimulateCheck got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSimulateCheck.SimulateCheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSimulateCheck.SimulateCheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSimulateCheck.t.Fatal("No results are set for the PolicyServiceMock.SimulateCheck")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmSimulateCheck.funcSimulateCheck != nil {
+		return mmSimulateCheck.funcSimulateCheck(ctx, accessToken, simulation)
+	}
+	mmSimulateCheck.t.Fatalf("Unexpected call to PolicyServiceMock.SimulateCheck. %v %v %v", ctx, accessToken, simulation)
+	return
+}
+
+// SimulateCheckAfterCounter returns a count of finished PolicyServiceMock.SimulateCheck invocations
+func (mmSimulateCheck *PolicyServiceMock) SimulateCheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSimulateCheck.afterSimulateCheckCounter)
+}
+
+// SimulateCheckBeforeCounter returns a count of PolicyServiceMock.SimulateCheck invocations
+func (mmSimulateCheck *PolicyServiceMock) SimulateCheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSimulateCheck.beforeSimulateCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.SimulateCheck.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSimulateCheck *mPolicyServiceMockSimulateCheck) Calls() []*PolicyServiceMockSimulateCheckParams {
+	mmSimulateCheck.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockSimulateCheckParams, len(mmSimulateCheck.callArgs))
+	copy(argCopy, mmSimulateCheck.callArgs)
+
+	mmSimulateCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSimulateCheckDone returns true if the count of the SimulateCheck invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockSimulateCheckDone() bool {
+	if m.SimulateCheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SimulateCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SimulateCheckMock.invocationsDone()
+}
+
+// MinimockSimulateCheckInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockSimulateCheckInspect() {
+	for _, e := range m.SimulateCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.SimulateCheck at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSimulateCheckCounter := mm_atomic.LoadUint64(&m.afterSimulateCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SimulateCheckMock.defaultExpectation != nil && afterSimulateCheckCounter < 1 {
+		if m.SimulateCheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.SimulateCheck at\n%s", m.SimulateCheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.SimulateCheck at\n%s with params: %#v", m.SimulateCheckMock.defaultExpectation.expectationOrigins.origin, *m.SimulateCheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSimulateCheck != nil && afterSimulateCheckCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.SimulateCheck at\n%s", m.funcSimulateCheckOrigin)
+	}
+
+	if !m.SimulateCheckMock.invocationsDone() && afterSimulateCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.SimulateCheck at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SimulateCheckMock.expectedInvocations), m.SimulateCheckMock.expectedInvocationsOrigin, afterSimulateCheckCounter)
+	}
+}
+
+type mPolicyServiceMockUpdateRule struct {
+	optional           bool
+	mock               *PolicyServiceMock
+	defaultExpectation *PolicyServiceMockUpdateRuleExpectation
+	expectations       []*PolicyServiceMockUpdateRuleExpectation
+
+	callArgs []*PolicyServiceMockUpdateRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyServiceMockUpdateRuleExpectation specifies expectation struct of the PolicyService.UpdateRule
+type PolicyServiceMockUpdateRuleExpectation struct {
+	mock               *PolicyServiceMock
+	params             *PolicyServiceMockUpdateRuleParams
+	paramPtrs          *PolicyServiceMockUpdateRuleParamPtrs
+	expectationOrigins PolicyServiceMockUpdateRuleExpectationOrigins
+	results            *PolicyServiceMockUpdateRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyServiceMockUpdateRuleParams contains parameters of the PolicyService.UpdateRule
+type PolicyServiceMockUpdateRuleParams struct {
+	ctx         context.Context
+	accessToken string
+	update      *model.UpdateAccessRule
+}
+
+// PolicyServiceMockUpdateRuleParamPtrs contains pointers to parameters of the PolicyService.UpdateRule
+type PolicyServiceMockUpdateRuleParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	update      **model.UpdateAccessRule
+}
+
+// PolicyServiceMockUpdateRuleResults contains results of the PolicyService.UpdateRule
+type PolicyServiceMockUpdateRuleResults struct {
+	err error
+}
+
+// PolicyServiceMockUpdateRuleOrigins contains origins of expectations of the PolicyService.UpdateRule
+type PolicyServiceMockUpdateRuleExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originUpdate      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Optional() *mPolicyServiceMockUpdateRule {
+	mmUpdateRule.optional = true
+	return mmUpdateRule
+}
+
+// Expect sets up expected params for PolicyService.UpdateRule
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Expect(ctx context.Context, accessToken string, update *model.UpdateAccessRule) *mPolicyServiceMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &PolicyServiceMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRule.defaultExpectation.params = &PolicyServiceMockUpdateRuleParams{ctx, accessToken, update}
+	mmUpdateRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateRule.expectations {
+		if minimock.Equal(e.params, mmUpdateRule.defaultExpectation.params) {
+			mmUpdateRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRule.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyService.UpdateRule
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) ExpectCtxParam1(ctx context.Context) *mPolicyServiceMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &PolicyServiceMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.params != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Expect")
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRule.defaultExpectation.paramPtrs = &PolicyServiceMockUpdateRuleParamPtrs{}
+	}
+	mmUpdateRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateRule
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PolicyService.UpdateRule
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) ExpectAccessTokenParam2(accessToken string) *mPolicyServiceMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &PolicyServiceMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.params != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Expect")
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRule.defaultExpectation.paramPtrs = &PolicyServiceMockUpdateRuleParamPtrs{}
+	}
+	mmUpdateRule.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmUpdateRule.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmUpdateRule
+}
+
+// ExpectUpdateParam3 sets up expected param update for PolicyService.UpdateRule
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) ExpectUpdateParam3(update *model.UpdateAccessRule) *mPolicyServiceMockUpdateRule {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &PolicyServiceMockUpdateRuleExpectation{}
+	}
+
+	if mmUpdateRule.defaultExpectation.params != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Expect")
+	}
+
+	if mmUpdateRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRule.defaultExpectation.paramPtrs = &PolicyServiceMockUpdateRuleParamPtrs{}
+	}
+	mmUpdateRule.defaultExpectation.paramPtrs.update = &update
+	mmUpdateRule.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyService.UpdateRule
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Inspect(f func(ctx context.Context, accessToken string, update *model.UpdateAccessRule)) *mPolicyServiceMockUpdateRule {
+	if mmUpdateRule.mock.inspectFuncUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("Inspect function is already set for PolicyServiceMock.UpdateRule")
+	}
+
+	mmUpdateRule.mock.inspectFuncUpdateRule = f
+
+	return mmUpdateRule
+}
+
+// Return sets up results that will be returned by PolicyService.UpdateRule
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Return(err error) *PolicyServiceMock {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Set")
+	}
+
+	if mmUpdateRule.defaultExpectation == nil {
+		mmUpdateRule.defaultExpectation = &PolicyServiceMockUpdateRuleExpectation{mock: mmUpdateRule.mock}
+	}
+	mmUpdateRule.defaultExpectation.results = &PolicyServiceMockUpdateRuleResults{err}
+	mmUpdateRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateRule.mock
+}
+
+// Set uses given function f to mock the PolicyService.UpdateRule method
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Set(f func(ctx context.Context, accessToken string, update *model.UpdateAccessRule) (err error)) *PolicyServiceMock {
+	if mmUpdateRule.defaultExpectation != nil {
+		mmUpdateRule.mock.t.Fatalf("Default expectation is already set for the PolicyService.UpdateRule method")
+	}
+
+	if len(mmUpdateRule.expectations) > 0 {
+		mmUpdateRule.mock.t.Fatalf("Some expectations are already set for the PolicyService.UpdateRule method")
+	}
+
+	mmUpdateRule.mock.funcUpdateRule = f
+	mmUpdateRule.mock.funcUpdateRuleOrigin = minimock.CallerInfo(1)
+	return mmUpdateRule.mock
+}
+
+// When sets expectation for the PolicyService.UpdateRule which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) When(ctx context.Context, accessToken string, update *model.UpdateAccessRule) *PolicyServiceMockUpdateRuleExpectation {
+	if mmUpdateRule.mock.funcUpdateRule != nil {
+		mmUpdateRule.mock.t.Fatalf("PolicyServiceMock.UpdateRule mock is already set by Set")
+	}
+
+	expectation := &PolicyServiceMockUpdateRuleExpectation{
+		mock:               mmUpdateRule.mock,
+		params:             &PolicyServiceMockUpdateRuleParams{ctx, accessToken, update},
+		expectationOrigins: PolicyServiceMockUpdateRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateRule.expectations = append(mmUpdateRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyService.UpdateRule return parameters for the expectation previously defined by the When method
+func (e *PolicyServiceMockUpdateRuleExpectation) Then(err error) *PolicyServiceMock {
+	e.results = &PolicyServiceMockUpdateRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyService.UpdateRule should be invoked
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Times(n uint64) *mPolicyServiceMockUpdateRule {
+	if n == 0 {
+		mmUpdateRule.mock.t.Fatalf("Times of PolicyServiceMock.UpdateRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRule.expectedInvocations, n)
+	mmUpdateRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateRule
+}
+
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) invocationsDone() bool {
+	if len(mmUpdateRule.expectations) == 0 && mmUpdateRule.defaultExpectation == nil && mmUpdateRule.mock.funcUpdateRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRule.mock.afterUpdateRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRule implements mm_service.PolicyService
+func (mmUpdateRule *PolicyServiceMock) UpdateRule(ctx context.Context, accessToken string, update *model.UpdateAccessRule) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRule.beforeUpdateRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRule.afterUpdateRuleCounter, 1)
+
+	mmUpdateRule.t.Helper()
+
+	if mmUpdateRule.inspectFuncUpdateRule != nil {
+		mmUpdateRule.inspectFuncUpdateRule(ctx, accessToken, update)
+	}
+
+	mm_params := PolicyServiceMockUpdateRuleParams{ctx, accessToken, update}
+
+	// Record call args
+	mmUpdateRule.UpdateRuleMock.mutex.Lock()
+	mmUpdateRule.UpdateRuleMock.callArgs = append(mmUpdateRule.UpdateRuleMock.callArgs, &mm_params)
+	mmUpdateRule.UpdateRuleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRule.UpdateRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRule.UpdateRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRule.UpdateRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRule.UpdateRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRule.UpdateRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyServiceMockUpdateRuleParams{ctx, accessToken, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRule.t.Errorf("PolicyServiceMock.UpdateRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmUpdateRule.t.Errorf("PolicyServiceMock.UpdateRule got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateRule.t.Errorf("PolicyServiceMock.UpdateRule got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRule.t.Errorf("PolicyServiceMock.UpdateRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateRule.UpdateRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRule.UpdateRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRule.t.Fatal("No results are set for the PolicyServiceMock.UpdateRule")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRule.funcUpdateRule != nil {
+		return mmUpdateRule.funcUpdateRule(ctx, accessToken, update)
+	}
+	mmUpdateRule.t.Fatalf("Unexpected call to PolicyServiceMock.UpdateRule. %v %v %v", ctx, accessToken, update)
+	return
+}
+
+// UpdateRuleAfterCounter returns a count of finished PolicyServiceMock.UpdateRule invocations
+func (mmUpdateRule *PolicyServiceMock) UpdateRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRule.afterUpdateRuleCounter)
+}
+
+// UpdateRuleBeforeCounter returns a count of PolicyServiceMock.UpdateRule invocations
+func (mmUpdateRule *PolicyServiceMock) UpdateRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRule.beforeUpdateRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyServiceMock.UpdateRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRule *mPolicyServiceMockUpdateRule) Calls() []*PolicyServiceMockUpdateRuleParams {
+	mmUpdateRule.mutex.RLock()
+
+	argCopy := make([]*PolicyServiceMockUpdateRuleParams, len(mmUpdateRule.callArgs))
+	copy(argCopy, mmUpdateRule.callArgs)
+
+	mmUpdateRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRuleDone returns true if the count of the UpdateRule invocations corresponds
+// the number of defined expectations
+func (m *PolicyServiceMock) MinimockUpdateRuleDone() bool {
+	if m.UpdateRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRuleMock.invocationsDone()
+}
+
+// MinimockUpdateRuleInspect logs each unmet expectation
+func (m *PolicyServiceMock) MinimockUpdateRuleInspect() {
+	for _, e := range m.UpdateRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyServiceMock.UpdateRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateRuleCounter := mm_atomic.LoadUint64(&m.afterUpdateRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRuleMock.defaultExpectation != nil && afterUpdateRuleCounter < 1 {
+		if m.UpdateRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyServiceMock.UpdateRule at\n%s", m.UpdateRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyServiceMock.UpdateRule at\n%s with params: %#v", m.UpdateRuleMock.defaultExpectation.expectationOrigins.origin, *m.UpdateRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRule != nil && afterUpdateRuleCounter < 1 {
+		m.t.Errorf("Expected call to PolicyServiceMock.UpdateRule at\n%s", m.funcUpdateRuleOrigin)
+	}
+
+	if !m.UpdateRuleMock.invocationsDone() && afterUpdateRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyServiceMock.UpdateRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRuleMock.expectedInvocations), m.UpdateRuleMock.expectedInvocationsOrigin, afterUpdateRuleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PolicyServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddRoleParentInspect()
+
+			m.MinimockApplyPolicyInspect()
+
+			m.MinimockCreatePermissionInspect()
+
+			m.MinimockCreateRuleInspect()
+
+			m.MinimockDeletePermissionInspect()
+
+			m.MinimockDeleteRoleParentInspect()
+
+			m.MinimockDeleteRuleInspect()
+
+			m.MinimockExportPolicyInspect()
+
+			m.MinimockGetRolePermissionsInspect()
+
+			m.MinimockGrantPermissionInspect()
+
+			m.MinimockListPermissionsInspect()
+
+			m.MinimockListPolicyVersionsInspect()
+
+			m.MinimockListRulesInspect()
+
+			m.MinimockRevokePermissionInspect()
+
+			m.MinimockRollbackPolicyInspect()
+
+			m.MinimockSimulateCheckInspect()
+
+			m.MinimockUpdateRuleInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PolicyServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PolicyServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddRoleParentDone() &&
+		m.MinimockApplyPolicyDone() &&
+		m.MinimockCreatePermissionDone() &&
+		m.MinimockCreateRuleDone() &&
+		m.MinimockDeletePermissionDone() &&
+		m.MinimockDeleteRoleParentDone() &&
+		m.MinimockDeleteRuleDone() &&
+		m.MinimockExportPolicyDone() &&
+		m.MinimockGetRolePermissionsDone() &&
+		m.MinimockGrantPermissionDone() &&
+		m.MinimockListPermissionsDone() &&
+		m.MinimockListPolicyVersionsDone() &&
+		m.MinimockListRulesDone() &&
+		m.MinimockRevokePermissionDone() &&
+		m.MinimockRollbackPolicyDone() &&
+		m.MinimockSimulateCheckDone() &&
+		m.MinimockUpdateRuleDone()
+}
@@ -0,0 +1,1997 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.PrivacyService -o privacy_service_minimock.go -n PrivacyServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// PrivacyServiceMock implements mm_service.PrivacyService
+type PrivacyServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcApproveErasure          func(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error)
+	funcApproveErasureOrigin    string
+	inspectFuncApproveErasure   func(ctx context.Context, accessToken string, id int64)
+	afterApproveErasureCounter  uint64
+	beforeApproveErasureCounter uint64
+	ApproveErasureMock          mPrivacyServiceMockApproveErasure
+
+	funcEraseUser          func(ctx context.Context, accessToken string, userID int64, reason string) (ep1 *model.ErasureRequest, err error)
+	funcEraseUserOrigin    string
+	inspectFuncEraseUser   func(ctx context.Context, accessToken string, userID int64, reason string)
+	afterEraseUserCounter  uint64
+	beforeEraseUserCounter uint64
+	EraseUserMock          mPrivacyServiceMockEraseUser
+
+	funcExportMyData          func(ctx context.Context, accessToken string) (dp1 *model.DataBundle, err error)
+	funcExportMyDataOrigin    string
+	inspectFuncExportMyData   func(ctx context.Context, accessToken string)
+	afterExportMyDataCounter  uint64
+	beforeExportMyDataCounter uint64
+	ExportMyDataMock          mPrivacyServiceMockExportMyData
+
+	funcGetErasureRequest          func(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error)
+	funcGetErasureRequestOrigin    string
+	inspectFuncGetErasureRequest   func(ctx context.Context, accessToken string, id int64)
+	afterGetErasureRequestCounter  uint64
+	beforeGetErasureRequestCounter uint64
+	GetErasureRequestMock          mPrivacyServiceMockGetErasureRequest
+
+	funcRejectErasure          func(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error)
+	funcRejectErasureOrigin    string
+	inspectFuncRejectErasure   func(ctx context.Context, accessToken string, id int64)
+	afterRejectErasureCounter  uint64
+	beforeRejectErasureCounter uint64
+	RejectErasureMock          mPrivacyServiceMockRejectErasure
+}
+
+// NewPrivacyServiceMock returns a mock for mm_service.PrivacyService
+func NewPrivacyServiceMock(t minimock.Tester) *PrivacyServiceMock {
+	m := &PrivacyServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ApproveErasureMock = mPrivacyServiceMockApproveErasure{mock: m}
+	m.ApproveErasureMock.callArgs = []*PrivacyServiceMockApproveErasureParams{}
+
+	m.EraseUserMock = mPrivacyServiceMockEraseUser{mock: m}
+	m.EraseUserMock.callArgs = []*PrivacyServiceMockEraseUserParams{}
+
+	m.ExportMyDataMock = mPrivacyServiceMockExportMyData{mock: m}
+	m.ExportMyDataMock.callArgs = []*PrivacyServiceMockExportMyDataParams{}
+
+	m.GetErasureRequestMock = mPrivacyServiceMockGetErasureRequest{mock: m}
+	m.GetErasureRequestMock.callArgs = []*PrivacyServiceMockGetErasureRequestParams{}
+
+	m.RejectErasureMock = mPrivacyServiceMockRejectErasure{mock: m}
+	m.RejectErasureMock.callArgs = []*PrivacyServiceMockRejectErasureParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPrivacyServiceMockApproveErasure struct {
+	optional           bool
+	mock               *PrivacyServiceMock
+	defaultExpectation *PrivacyServiceMockApproveErasureExpectation
+	expectations       []*PrivacyServiceMockApproveErasureExpectation
+
+	callArgs []*PrivacyServiceMockApproveErasureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PrivacyServiceMockApproveErasureExpectation specifies expectation struct of the PrivacyService.ApproveErasure
+type PrivacyServiceMockApproveErasureExpectation struct {
+	mock               *PrivacyServiceMock
+	params             *PrivacyServiceMockApproveErasureParams
+	paramPtrs          *PrivacyServiceMockApproveErasureParamPtrs
+	expectationOrigins PrivacyServiceMockApproveErasureExpectationOrigins
+	results            *PrivacyServiceMockApproveErasureResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PrivacyServiceMockApproveErasureParams contains parameters of the PrivacyService.ApproveErasure
+type PrivacyServiceMockApproveErasureParams struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+// PrivacyServiceMockApproveErasureParamPtrs contains pointers to parameters of the PrivacyService.ApproveErasure
+type PrivacyServiceMockApproveErasureParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	id          *int64
+}
+
+// PrivacyServiceMockApproveErasureResults contains results of the PrivacyService.ApproveErasure
+type PrivacyServiceMockApproveErasureResults struct {
+	ep1 *model.ErasureRequest
+	err error
+}
+
+// PrivacyServiceMockApproveErasureOrigins contains origins of expectations of the PrivacyService.ApproveErasure
+type PrivacyServiceMockApproveErasureExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originId          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Optional() *mPrivacyServiceMockApproveErasure {
+	mmApproveErasure.optional = true
+	return mmApproveErasure
+}
+
+// Expect sets up expected params for PrivacyService.ApproveErasure
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Expect(ctx context.Context, accessToken string, id int64) *mPrivacyServiceMockApproveErasure {
+	if mmApproveErasure.mock.funcApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Set")
+	}
+
+	if mmApproveErasure.defaultExpectation == nil {
+		mmApproveErasure.defaultExpectation = &PrivacyServiceMockApproveErasureExpectation{}
+	}
+
+	if mmApproveErasure.defaultExpectation.paramPtrs != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by ExpectParams functions")
+	}
+
+	mmApproveErasure.defaultExpectation.params = &PrivacyServiceMockApproveErasureParams{ctx, accessToken, id}
+	mmApproveErasure.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApproveErasure.expectations {
+		if minimock.Equal(e.params, mmApproveErasure.defaultExpectation.params) {
+			mmApproveErasure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApproveErasure.defaultExpectation.params)
+		}
+	}
+
+	return mmApproveErasure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PrivacyService.ApproveErasure
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) ExpectCtxParam1(ctx context.Context) *mPrivacyServiceMockApproveErasure {
+	if mmApproveErasure.mock.funcApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Set")
+	}
+
+	if mmApproveErasure.defaultExpectation == nil {
+		mmApproveErasure.defaultExpectation = &PrivacyServiceMockApproveErasureExpectation{}
+	}
+
+	if mmApproveErasure.defaultExpectation.params != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Expect")
+	}
+
+	if mmApproveErasure.defaultExpectation.paramPtrs == nil {
+		mmApproveErasure.defaultExpectation.paramPtrs = &PrivacyServiceMockApproveErasureParamPtrs{}
+	}
+	mmApproveErasure.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApproveErasure.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApproveErasure
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PrivacyService.ApproveErasure
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) ExpectAccessTokenParam2(accessToken string) *mPrivacyServiceMockApproveErasure {
+	if mmApproveErasure.mock.funcApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Set")
+	}
+
+	if mmApproveErasure.defaultExpectation == nil {
+		mmApproveErasure.defaultExpectation = &PrivacyServiceMockApproveErasureExpectation{}
+	}
+
+	if mmApproveErasure.defaultExpectation.params != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Expect")
+	}
+
+	if mmApproveErasure.defaultExpectation.paramPtrs == nil {
+		mmApproveErasure.defaultExpectation.paramPtrs = &PrivacyServiceMockApproveErasureParamPtrs{}
+	}
+	mmApproveErasure.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmApproveErasure.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmApproveErasure
+}
+
+// ExpectIdParam3 sets up expected param id for PrivacyService.ApproveErasure
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) ExpectIdParam3(id int64) *mPrivacyServiceMockApproveErasure {
+	if mmApproveErasure.mock.funcApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Set")
+	}
+
+	if mmApproveErasure.defaultExpectation == nil {
+		mmApproveErasure.defaultExpectation = &PrivacyServiceMockApproveErasureExpectation{}
+	}
+
+	if mmApproveErasure.defaultExpectation.params != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Expect")
+	}
+
+	if mmApproveErasure.defaultExpectation.paramPtrs == nil {
+		mmApproveErasure.defaultExpectation.paramPtrs = &PrivacyServiceMockApproveErasureParamPtrs{}
+	}
+	mmApproveErasure.defaultExpectation.paramPtrs.id = &id
+	mmApproveErasure.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmApproveErasure
+}
+
+// Inspect accepts an inspector function that has same arguments as the PrivacyService.ApproveErasure
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Inspect(f func(ctx context.Context, accessToken string, id int64)) *mPrivacyServiceMockApproveErasure {
+	if mmApproveErasure.mock.inspectFuncApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("Inspect function is already set for PrivacyServiceMock.ApproveErasure")
+	}
+
+	mmApproveErasure.mock.inspectFuncApproveErasure = f
+
+	return mmApproveErasure
+}
+
+// Return sets up results that will be returned by PrivacyService.ApproveErasure
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Return(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	if mmApproveErasure.mock.funcApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Set")
+	}
+
+	if mmApproveErasure.defaultExpectation == nil {
+		mmApproveErasure.defaultExpectation = &PrivacyServiceMockApproveErasureExpectation{mock: mmApproveErasure.mock}
+	}
+	mmApproveErasure.defaultExpectation.results = &PrivacyServiceMockApproveErasureResults{ep1, err}
+	mmApproveErasure.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApproveErasure.mock
+}
+
+// Set uses given function f to mock the PrivacyService.ApproveErasure method
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Set(f func(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error)) *PrivacyServiceMock {
+	if mmApproveErasure.defaultExpectation != nil {
+		mmApproveErasure.mock.t.Fatalf("Default expectation is already set for the PrivacyService.ApproveErasure method")
+	}
+
+	if len(mmApproveErasure.expectations) > 0 {
+		mmApproveErasure.mock.t.Fatalf("Some expectations are already set for the PrivacyService.ApproveErasure method")
+	}
+
+	mmApproveErasure.mock.funcApproveErasure = f
+	mmApproveErasure.mock.funcApproveErasureOrigin = minimock.CallerInfo(1)
+	return mmApproveErasure.mock
+}
+
+// When sets expectation for the PrivacyService.ApproveErasure which will trigger the result defined by the following
+// Then helper
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) When(ctx context.Context, accessToken string, id int64) *PrivacyServiceMockApproveErasureExpectation {
+	if mmApproveErasure.mock.funcApproveErasure != nil {
+		mmApproveErasure.mock.t.Fatalf("PrivacyServiceMock.ApproveErasure mock is already set by Set")
+	}
+
+	expectation := &PrivacyServiceMockApproveErasureExpectation{
+		mock:               mmApproveErasure.mock,
+		params:             &PrivacyServiceMockApproveErasureParams{ctx, accessToken, id},
+		expectationOrigins: PrivacyServiceMockApproveErasureExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApproveErasure.expectations = append(mmApproveErasure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PrivacyService.ApproveErasure return parameters for the expectation previously defined by the When method
+func (e *PrivacyServiceMockApproveErasureExpectation) Then(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	e.results = &PrivacyServiceMockApproveErasureResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times PrivacyService.ApproveErasure should be invoked
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Times(n uint64) *mPrivacyServiceMockApproveErasure {
+	if n == 0 {
+		mmApproveErasure.mock.t.Fatalf("Times of PrivacyServiceMock.ApproveErasure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApproveErasure.expectedInvocations, n)
+	mmApproveErasure.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApproveErasure
+}
+
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) invocationsDone() bool {
+	if len(mmApproveErasure.expectations) == 0 && mmApproveErasure.defaultExpectation == nil && mmApproveErasure.mock.funcApproveErasure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApproveErasure.mock.afterApproveErasureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApproveErasure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApproveErasure implements mm_service.PrivacyService
+func (mmApproveErasure *PrivacyServiceMock) ApproveErasure(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error) {
+	mm_atomic.AddUint64(&mmApproveErasure.beforeApproveErasureCounter, 1)
+	defer mm_atomic.AddUint64(&mmApproveErasure.afterApproveErasureCounter, 1)
+
+	mmApproveErasure.t.Helper()
+
+	if mmApproveErasure.inspectFuncApproveErasure != nil {
+		mmApproveErasure.inspectFuncApproveErasure(ctx, accessToken, id)
+	}
+
+	mm_params := PrivacyServiceMockApproveErasureParams{ctx, accessToken, id}
+
+	// Record call args
+	mmApproveErasure.ApproveErasureMock.mutex.Lock()
+	mmApproveErasure.ApproveErasureMock.callArgs = append(mmApproveErasure.ApproveErasureMock.callArgs, &mm_params)
+	mmApproveErasure.ApproveErasureMock.mutex.Unlock()
+
+	for _, e := range mmApproveErasure.ApproveErasureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmApproveErasure.ApproveErasureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApproveErasure.ApproveErasureMock.defaultExpectation.Counter, 1)
+		mm_want := mmApproveErasure.ApproveErasureMock.defaultExpectation.params
+		mm_want_ptrs := mmApproveErasure.ApproveErasureMock.defaultExpectation.paramPtrs
+
+		mm_got := PrivacyServiceMockApproveErasureParams{ctx, accessToken, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApproveErasure.t.Errorf("PrivacyServiceMock.ApproveErasure got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveErasure.ApproveErasureMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmApproveErasure.t.Errorf("PrivacyServiceMock.ApproveErasure got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveErasure.ApproveErasureMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApproveErasure.t.Errorf("PrivacyServiceMock.ApproveErasure got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveErasure.ApproveErasureMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApproveErasure.t.Errorf("PrivacyServiceMock.ApproveErasure got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApproveErasure.ApproveErasureMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApproveErasure.ApproveErasureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApproveErasure.t.Fatal("No results are set for the PrivacyServiceMock.ApproveErasure")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmApproveErasure.funcApproveErasure != nil {
+		return mmApproveErasure.funcApproveErasure(ctx, accessToken, id)
+	}
+	mmApproveErasure.t.Fatalf("Unexpected call to PrivacyServiceMock.ApproveErasure. %v %v %v", ctx, accessToken, id)
+	return
+}
+
+// ApproveErasureAfterCounter returns a count of finished PrivacyServiceMock.ApproveErasure invocations
+func (mmApproveErasure *PrivacyServiceMock) ApproveErasureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveErasure.afterApproveErasureCounter)
+}
+
+// ApproveErasureBeforeCounter returns a count of PrivacyServiceMock.ApproveErasure invocations
+func (mmApproveErasure *PrivacyServiceMock) ApproveErasureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveErasure.beforeApproveErasureCounter)
+}
+
+// Calls returns a list of arguments used in each call to PrivacyServiceMock.ApproveErasure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApproveErasure *mPrivacyServiceMockApproveErasure) Calls() []*PrivacyServiceMockApproveErasureParams {
+	mmApproveErasure.mutex.RLock()
+
+	argCopy := make([]*PrivacyServiceMockApproveErasureParams, len(mmApproveErasure.callArgs))
+	copy(argCopy, mmApproveErasure.callArgs)
+
+	mmApproveErasure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveErasureDone returns true if the count of the ApproveErasure invocations corresponds
+// the number of defined expectations
+func (m *PrivacyServiceMock) MinimockApproveErasureDone() bool {
+	if m.ApproveErasureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApproveErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApproveErasureMock.invocationsDone()
+}
+
+// MinimockApproveErasureInspect logs each unmet expectation
+func (m *PrivacyServiceMock) MinimockApproveErasureInspect() {
+	for _, e := range m.ApproveErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PrivacyServiceMock.ApproveErasure at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApproveErasureCounter := mm_atomic.LoadUint64(&m.afterApproveErasureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveErasureMock.defaultExpectation != nil && afterApproveErasureCounter < 1 {
+		if m.ApproveErasureMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PrivacyServiceMock.ApproveErasure at\n%s", m.ApproveErasureMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PrivacyServiceMock.ApproveErasure at\n%s with params: %#v", m.ApproveErasureMock.defaultExpectation.expectationOrigins.origin, *m.ApproveErasureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApproveErasure != nil && afterApproveErasureCounter < 1 {
+		m.t.Errorf("Expected call to PrivacyServiceMock.ApproveErasure at\n%s", m.funcApproveErasureOrigin)
+	}
+
+	if !m.ApproveErasureMock.invocationsDone() && afterApproveErasureCounter > 0 {
+		m.t.Errorf("Expected %d calls to PrivacyServiceMock.ApproveErasure at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApproveErasureMock.expectedInvocations), m.ApproveErasureMock.expectedInvocationsOrigin, afterApproveErasureCounter)
+	}
+}
+
+type mPrivacyServiceMockEraseUser struct {
+	optional           bool
+	mock               *PrivacyServiceMock
+	defaultExpectation *PrivacyServiceMockEraseUserExpectation
+	expectations       []*PrivacyServiceMockEraseUserExpectation
+
+	callArgs []*PrivacyServiceMockEraseUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PrivacyServiceMockEraseUserExpectation specifies expectation struct of the PrivacyService.EraseUser
+type PrivacyServiceMockEraseUserExpectation struct {
+	mock               *PrivacyServiceMock
+	params             *PrivacyServiceMockEraseUserParams
+	paramPtrs          *PrivacyServiceMockEraseUserParamPtrs
+	expectationOrigins PrivacyServiceMockEraseUserExpectationOrigins
+	results            *PrivacyServiceMockEraseUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PrivacyServiceMockEraseUserParams contains parameters of the PrivacyService.EraseUser
+type PrivacyServiceMockEraseUserParams struct {
+	ctx         context.Context
+	accessToken string
+	userID      int64
+	reason      string
+}
+
+// PrivacyServiceMockEraseUserParamPtrs contains pointers to parameters of the PrivacyService.EraseUser
+type PrivacyServiceMockEraseUserParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	userID      *int64
+	reason      *string
+}
+
+// PrivacyServiceMockEraseUserResults contains results of the PrivacyService.EraseUser
+type PrivacyServiceMockEraseUserResults struct {
+	ep1 *model.ErasureRequest
+	err error
+}
+
+// PrivacyServiceMockEraseUserOrigins contains origins of expectations of the PrivacyService.EraseUser
+type PrivacyServiceMockEraseUserExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originUserID      string
+	originReason      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Optional() *mPrivacyServiceMockEraseUser {
+	mmEraseUser.optional = true
+	return mmEraseUser
+}
+
+// Expect sets up expected params for PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Expect(ctx context.Context, accessToken string, userID int64, reason string) *mPrivacyServiceMockEraseUser {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &PrivacyServiceMockEraseUserExpectation{}
+	}
+
+	if mmEraseUser.defaultExpectation.paramPtrs != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by ExpectParams functions")
+	}
+
+	mmEraseUser.defaultExpectation.params = &PrivacyServiceMockEraseUserParams{ctx, accessToken, userID, reason}
+	mmEraseUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEraseUser.expectations {
+		if minimock.Equal(e.params, mmEraseUser.defaultExpectation.params) {
+			mmEraseUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEraseUser.defaultExpectation.params)
+		}
+	}
+
+	return mmEraseUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) ExpectCtxParam1(ctx context.Context) *mPrivacyServiceMockEraseUser {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &PrivacyServiceMockEraseUserExpectation{}
+	}
+
+	if mmEraseUser.defaultExpectation.params != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Expect")
+	}
+
+	if mmEraseUser.defaultExpectation.paramPtrs == nil {
+		mmEraseUser.defaultExpectation.paramPtrs = &PrivacyServiceMockEraseUserParamPtrs{}
+	}
+	mmEraseUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEraseUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEraseUser
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) ExpectAccessTokenParam2(accessToken string) *mPrivacyServiceMockEraseUser {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &PrivacyServiceMockEraseUserExpectation{}
+	}
+
+	if mmEraseUser.defaultExpectation.params != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Expect")
+	}
+
+	if mmEraseUser.defaultExpectation.paramPtrs == nil {
+		mmEraseUser.defaultExpectation.paramPtrs = &PrivacyServiceMockEraseUserParamPtrs{}
+	}
+	mmEraseUser.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmEraseUser.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmEraseUser
+}
+
+// ExpectUserIDParam3 sets up expected param userID for PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) ExpectUserIDParam3(userID int64) *mPrivacyServiceMockEraseUser {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &PrivacyServiceMockEraseUserExpectation{}
+	}
+
+	if mmEraseUser.defaultExpectation.params != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Expect")
+	}
+
+	if mmEraseUser.defaultExpectation.paramPtrs == nil {
+		mmEraseUser.defaultExpectation.paramPtrs = &PrivacyServiceMockEraseUserParamPtrs{}
+	}
+	mmEraseUser.defaultExpectation.paramPtrs.userID = &userID
+	mmEraseUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmEraseUser
+}
+
+// ExpectReasonParam4 sets up expected param reason for PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) ExpectReasonParam4(reason string) *mPrivacyServiceMockEraseUser {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &PrivacyServiceMockEraseUserExpectation{}
+	}
+
+	if mmEraseUser.defaultExpectation.params != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Expect")
+	}
+
+	if mmEraseUser.defaultExpectation.paramPtrs == nil {
+		mmEraseUser.defaultExpectation.paramPtrs = &PrivacyServiceMockEraseUserParamPtrs{}
+	}
+	mmEraseUser.defaultExpectation.paramPtrs.reason = &reason
+	mmEraseUser.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmEraseUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Inspect(f func(ctx context.Context, accessToken string, userID int64, reason string)) *mPrivacyServiceMockEraseUser {
+	if mmEraseUser.mock.inspectFuncEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("Inspect function is already set for PrivacyServiceMock.EraseUser")
+	}
+
+	mmEraseUser.mock.inspectFuncEraseUser = f
+
+	return mmEraseUser
+}
+
+// Return sets up results that will be returned by PrivacyService.EraseUser
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Return(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &PrivacyServiceMockEraseUserExpectation{mock: mmEraseUser.mock}
+	}
+	mmEraseUser.defaultExpectation.results = &PrivacyServiceMockEraseUserResults{ep1, err}
+	mmEraseUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEraseUser.mock
+}
+
+// Set uses given function f to mock the PrivacyService.EraseUser method
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Set(f func(ctx context.Context, accessToken string, userID int64, reason string) (ep1 *model.ErasureRequest, err error)) *PrivacyServiceMock {
+	if mmEraseUser.defaultExpectation != nil {
+		mmEraseUser.mock.t.Fatalf("Default expectation is already set for the PrivacyService.EraseUser method")
+	}
+
+	if len(mmEraseUser.expectations) > 0 {
+		mmEraseUser.mock.t.Fatalf("Some expectations are already set for the PrivacyService.EraseUser method")
+	}
+
+	mmEraseUser.mock.funcEraseUser = f
+	mmEraseUser.mock.funcEraseUserOrigin = minimock.CallerInfo(1)
+	return mmEraseUser.mock
+}
+
+// When sets expectation for the PrivacyService.EraseUser which will trigger the result defined by the following
+// Then helper
+func (mmEraseUser *mPrivacyServiceMockEraseUser) When(ctx context.Context, accessToken string, userID int64, reason string) *PrivacyServiceMockEraseUserExpectation {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("PrivacyServiceMock.EraseUser mock is already set by Set")
+	}
+
+	expectation := &PrivacyServiceMockEraseUserExpectation{
+		mock:               mmEraseUser.mock,
+		params:             &PrivacyServiceMockEraseUserParams{ctx, accessToken, userID, reason},
+		expectationOrigins: PrivacyServiceMockEraseUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEraseUser.expectations = append(mmEraseUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PrivacyService.EraseUser return parameters for the expectation previously defined by the When method
+func (e *PrivacyServiceMockEraseUserExpectation) Then(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	e.results = &PrivacyServiceMockEraseUserResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times PrivacyService.EraseUser should be invoked
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Times(n uint64) *mPrivacyServiceMockEraseUser {
+	if n == 0 {
+		mmEraseUser.mock.t.Fatalf("Times of PrivacyServiceMock.EraseUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEraseUser.expectedInvocations, n)
+	mmEraseUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEraseUser
+}
+
+func (mmEraseUser *mPrivacyServiceMockEraseUser) invocationsDone() bool {
+	if len(mmEraseUser.expectations) == 0 && mmEraseUser.defaultExpectation == nil && mmEraseUser.mock.funcEraseUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEraseUser.mock.afterEraseUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEraseUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EraseUser implements mm_service.PrivacyService
+func (mmEraseUser *PrivacyServiceMock) EraseUser(ctx context.Context, accessToken string, userID int64, reason string) (ep1 *model.ErasureRequest, err error) {
+	mm_atomic.AddUint64(&mmEraseUser.beforeEraseUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmEraseUser.afterEraseUserCounter, 1)
+
+	mmEraseUser.t.Helper()
+
+	if mmEraseUser.inspectFuncEraseUser != nil {
+		mmEraseUser.inspectFuncEraseUser(ctx, accessToken, userID, reason)
+	}
+
+	mm_params := PrivacyServiceMockEraseUserParams{ctx, accessToken, userID, reason}
+
+	// Record call args
+	mmEraseUser.EraseUserMock.mutex.Lock()
+	mmEraseUser.EraseUserMock.callArgs = append(mmEraseUser.EraseUserMock.callArgs, &mm_params)
+	mmEraseUser.EraseUserMock.mutex.Unlock()
+
+	for _, e := range mmEraseUser.EraseUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmEraseUser.EraseUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEraseUser.EraseUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmEraseUser.EraseUserMock.defaultExpectation.params
+		mm_want_ptrs := mmEraseUser.EraseUserMock.defaultExpectation.paramPtrs
+
+		mm_got := PrivacyServiceMockEraseUserParams{ctx, accessToken, userID, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEraseUser.t.Errorf("PrivacyServiceMock.EraseUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEraseUser.EraseUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmEraseUser.t.Errorf("PrivacyServiceMock.EraseUser got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEraseUser.EraseUserMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmEraseUser.t.Errorf("PrivacyServiceMock.EraseUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEraseUser.EraseUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmEraseUser.t.Errorf("PrivacyServiceMock.EraseUser got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEraseUser.EraseUserMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEraseUser.t.Errorf("PrivacyServiceMock.EraseUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEraseUser.EraseUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEraseUser.EraseUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEraseUser.t.Fatal("No results are set for the PrivacyServiceMock.EraseUser")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmEraseUser.funcEraseUser != nil {
+		return mmEraseUser.funcEraseUser(ctx, accessToken, userID, reason)
+	}
+	mmEraseUser.t.Fatalf("Unexpected call to PrivacyServiceMock.EraseUser. %v %v %v %v", ctx, accessToken, userID, reason)
+	return
+}
+
+// EraseUserAfterCounter returns a count of finished PrivacyServiceMock.EraseUser invocations
+func (mmEraseUser *PrivacyServiceMock) EraseUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEraseUser.afterEraseUserCounter)
+}
+
+// EraseUserBeforeCounter returns a count of PrivacyServiceMock.EraseUser invocations
+func (mmEraseUser *PrivacyServiceMock) EraseUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEraseUser.beforeEraseUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to PrivacyServiceMock.EraseUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEraseUser *mPrivacyServiceMockEraseUser) Calls() []*PrivacyServiceMockEraseUserParams {
+	mmEraseUser.mutex.RLock()
+
+	argCopy := make([]*PrivacyServiceMockEraseUserParams, len(mmEraseUser.callArgs))
+	copy(argCopy, mmEraseUser.callArgs)
+
+	mmEraseUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEraseUserDone returns true if the count of the EraseUser invocations corresponds
+// the number of defined expectations
+func (m *PrivacyServiceMock) MinimockEraseUserDone() bool {
+	if m.EraseUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EraseUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EraseUserMock.invocationsDone()
+}
+
+// MinimockEraseUserInspect logs each unmet expectation
+func (m *PrivacyServiceMock) MinimockEraseUserInspect() {
+	for _, e := range m.EraseUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PrivacyServiceMock.EraseUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEraseUserCounter := mm_atomic.LoadUint64(&m.afterEraseUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EraseUserMock.defaultExpectation != nil && afterEraseUserCounter < 1 {
+		if m.EraseUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PrivacyServiceMock.EraseUser at\n%s", m.EraseUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PrivacyServiceMock.EraseUser at\n%s with params: %#v", m.EraseUserMock.defaultExpectation.expectationOrigins.origin, *m.EraseUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEraseUser != nil && afterEraseUserCounter < 1 {
+		m.t.Errorf("Expected call to PrivacyServiceMock.EraseUser at\n%s", m.funcEraseUserOrigin)
+	}
+
+	if !m.EraseUserMock.invocationsDone() && afterEraseUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to PrivacyServiceMock.EraseUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EraseUserMock.expectedInvocations), m.EraseUserMock.expectedInvocationsOrigin, afterEraseUserCounter)
+	}
+}
+
+type mPrivacyServiceMockExportMyData struct {
+	optional           bool
+	mock               *PrivacyServiceMock
+	defaultExpectation *PrivacyServiceMockExportMyDataExpectation
+	expectations       []*PrivacyServiceMockExportMyDataExpectation
+
+	callArgs []*PrivacyServiceMockExportMyDataParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PrivacyServiceMockExportMyDataExpectation specifies expectation struct of the PrivacyService.ExportMyData
+type PrivacyServiceMockExportMyDataExpectation struct {
+	mock               *PrivacyServiceMock
+	params             *PrivacyServiceMockExportMyDataParams
+	paramPtrs          *PrivacyServiceMockExportMyDataParamPtrs
+	expectationOrigins PrivacyServiceMockExportMyDataExpectationOrigins
+	results            *PrivacyServiceMockExportMyDataResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PrivacyServiceMockExportMyDataParams contains parameters of the PrivacyService.ExportMyData
+type PrivacyServiceMockExportMyDataParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// PrivacyServiceMockExportMyDataParamPtrs contains pointers to parameters of the PrivacyService.ExportMyData
+type PrivacyServiceMockExportMyDataParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// PrivacyServiceMockExportMyDataResults contains results of the PrivacyService.ExportMyData
+type PrivacyServiceMockExportMyDataResults struct {
+	dp1 *model.DataBundle
+	err error
+}
+
+// PrivacyServiceMockExportMyDataOrigins contains origins of expectations of the PrivacyService.ExportMyData
+type PrivacyServiceMockExportMyDataExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Optional() *mPrivacyServiceMockExportMyData {
+	mmExportMyData.optional = true
+	return mmExportMyData
+}
+
+// Expect sets up expected params for PrivacyService.ExportMyData
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Expect(ctx context.Context, accessToken string) *mPrivacyServiceMockExportMyData {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	if mmExportMyData.defaultExpectation == nil {
+		mmExportMyData.defaultExpectation = &PrivacyServiceMockExportMyDataExpectation{}
+	}
+
+	if mmExportMyData.defaultExpectation.paramPtrs != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by ExpectParams functions")
+	}
+
+	mmExportMyData.defaultExpectation.params = &PrivacyServiceMockExportMyDataParams{ctx, accessToken}
+	mmExportMyData.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportMyData.expectations {
+		if minimock.Equal(e.params, mmExportMyData.defaultExpectation.params) {
+			mmExportMyData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportMyData.defaultExpectation.params)
+		}
+	}
+
+	return mmExportMyData
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PrivacyService.ExportMyData
+func (mmExportMyData *mPrivacyServiceMockExportMyData) ExpectCtxParam1(ctx context.Context) *mPrivacyServiceMockExportMyData {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	if mmExportMyData.defaultExpectation == nil {
+		mmExportMyData.defaultExpectation = &PrivacyServiceMockExportMyDataExpectation{}
+	}
+
+	if mmExportMyData.defaultExpectation.params != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Expect")
+	}
+
+	if mmExportMyData.defaultExpectation.paramPtrs == nil {
+		mmExportMyData.defaultExpectation.paramPtrs = &PrivacyServiceMockExportMyDataParamPtrs{}
+	}
+	mmExportMyData.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportMyData.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportMyData
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PrivacyService.ExportMyData
+func (mmExportMyData *mPrivacyServiceMockExportMyData) ExpectAccessTokenParam2(accessToken string) *mPrivacyServiceMockExportMyData {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	if mmExportMyData.defaultExpectation == nil {
+		mmExportMyData.defaultExpectation = &PrivacyServiceMockExportMyDataExpectation{}
+	}
+
+	if mmExportMyData.defaultExpectation.params != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Expect")
+	}
+
+	if mmExportMyData.defaultExpectation.paramPtrs == nil {
+		mmExportMyData.defaultExpectation.paramPtrs = &PrivacyServiceMockExportMyDataParamPtrs{}
+	}
+	mmExportMyData.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmExportMyData.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmExportMyData
+}
+
+// Inspect accepts an inspector function that has same arguments as the PrivacyService.ExportMyData
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Inspect(f func(ctx context.Context, accessToken string)) *mPrivacyServiceMockExportMyData {
+	if mmExportMyData.mock.inspectFuncExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("Inspect function is already set for PrivacyServiceMock.ExportMyData")
+	}
+
+	mmExportMyData.mock.inspectFuncExportMyData = f
+
+	return mmExportMyData
+}
+
+// Return sets up results that will be returned by PrivacyService.ExportMyData
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Return(dp1 *model.DataBundle, err error) *PrivacyServiceMock {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	if mmExportMyData.defaultExpectation == nil {
+		mmExportMyData.defaultExpectation = &PrivacyServiceMockExportMyDataExpectation{mock: mmExportMyData.mock}
+	}
+	mmExportMyData.defaultExpectation.results = &PrivacyServiceMockExportMyDataResults{dp1, err}
+	mmExportMyData.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportMyData.mock
+}
+
+// Set uses given function f to mock the PrivacyService.ExportMyData method
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Set(f func(ctx context.Context, accessToken string) (dp1 *model.DataBundle, err error)) *PrivacyServiceMock {
+	if mmExportMyData.defaultExpectation != nil {
+		mmExportMyData.mock.t.Fatalf("Default expectation is already set for the PrivacyService.ExportMyData method")
+	}
+
+	if len(mmExportMyData.expectations) > 0 {
+		mmExportMyData.mock.t.Fatalf("Some expectations are already set for the PrivacyService.ExportMyData method")
+	}
+
+	mmExportMyData.mock.funcExportMyData = f
+	mmExportMyData.mock.funcExportMyDataOrigin = minimock.CallerInfo(1)
+	return mmExportMyData.mock
+}
+
+// When sets expectation for the PrivacyService.ExportMyData which will trigger the result defined by the following
+// Then helper
+func (mmExportMyData *mPrivacyServiceMockExportMyData) When(ctx context.Context, accessToken string) *PrivacyServiceMockExportMyDataExpectation {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("PrivacyServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	expectation := &PrivacyServiceMockExportMyDataExpectation{
+		mock:               mmExportMyData.mock,
+		params:             &PrivacyServiceMockExportMyDataParams{ctx, accessToken},
+		expectationOrigins: PrivacyServiceMockExportMyDataExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportMyData.expectations = append(mmExportMyData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PrivacyService.ExportMyData return parameters for the expectation previously defined by the When method
+func (e *PrivacyServiceMockExportMyDataExpectation) Then(dp1 *model.DataBundle, err error) *PrivacyServiceMock {
+	e.results = &PrivacyServiceMockExportMyDataResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times PrivacyService.ExportMyData should be invoked
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Times(n uint64) *mPrivacyServiceMockExportMyData {
+	if n == 0 {
+		mmExportMyData.mock.t.Fatalf("Times of PrivacyServiceMock.ExportMyData mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportMyData.expectedInvocations, n)
+	mmExportMyData.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportMyData
+}
+
+func (mmExportMyData *mPrivacyServiceMockExportMyData) invocationsDone() bool {
+	if len(mmExportMyData.expectations) == 0 && mmExportMyData.defaultExpectation == nil && mmExportMyData.mock.funcExportMyData == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportMyData.mock.afterExportMyDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportMyData.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportMyData implements mm_service.PrivacyService
+func (mmExportMyData *PrivacyServiceMock) ExportMyData(ctx context.Context, accessToken string) (dp1 *model.DataBundle, err error) {
+	mm_atomic.AddUint64(&mmExportMyData.beforeExportMyDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportMyData.afterExportMyDataCounter, 1)
+
+	mmExportMyData.t.Helper()
+
+	if mmExportMyData.inspectFuncExportMyData != nil {
+		mmExportMyData.inspectFuncExportMyData(ctx, accessToken)
+	}
+
+	mm_params := PrivacyServiceMockExportMyDataParams{ctx, accessToken}
+
+	// Record call args
+	mmExportMyData.ExportMyDataMock.mutex.Lock()
+	mmExportMyData.ExportMyDataMock.callArgs = append(mmExportMyData.ExportMyDataMock.callArgs, &mm_params)
+	mmExportMyData.ExportMyDataMock.mutex.Unlock()
+
+	for _, e := range mmExportMyData.ExportMyDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmExportMyData.ExportMyDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportMyData.ExportMyDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportMyData.ExportMyDataMock.defaultExpectation.params
+		mm_want_ptrs := mmExportMyData.ExportMyDataMock.defaultExpectation.paramPtrs
+
+		mm_got := PrivacyServiceMockExportMyDataParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportMyData.t.Errorf("PrivacyServiceMock.ExportMyData got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportMyData.ExportMyDataMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmExportMyData.t.Errorf("PrivacyServiceMock.ExportMyData got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportMyData.ExportMyDataMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportMyData.t.Errorf("PrivacyServiceMock.ExportMyData got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportMyData.ExportMyDataMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportMyData.ExportMyDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportMyData.t.Fatal("No results are set for the PrivacyServiceMock.ExportMyData")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmExportMyData.funcExportMyData != nil {
+		return mmExportMyData.funcExportMyData(ctx, accessToken)
+	}
+	mmExportMyData.t.Fatalf("Unexpected call to PrivacyServiceMock.ExportMyData. %v %v", ctx, accessToken)
+	return
+}
+
+// ExportMyDataAfterCounter returns a count of finished PrivacyServiceMock.ExportMyData invocations
+func (mmExportMyData *PrivacyServiceMock) ExportMyDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportMyData.afterExportMyDataCounter)
+}
+
+// ExportMyDataBeforeCounter returns a count of PrivacyServiceMock.ExportMyData invocations
+func (mmExportMyData *PrivacyServiceMock) ExportMyDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportMyData.beforeExportMyDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to PrivacyServiceMock.ExportMyData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportMyData *mPrivacyServiceMockExportMyData) Calls() []*PrivacyServiceMockExportMyDataParams {
+	mmExportMyData.mutex.RLock()
+
+	argCopy := make([]*PrivacyServiceMockExportMyDataParams, len(mmExportMyData.callArgs))
+	copy(argCopy, mmExportMyData.callArgs)
+
+	mmExportMyData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportMyDataDone returns true if the count of the ExportMyData invocations corresponds
+// the number of defined expectations
+func (m *PrivacyServiceMock) MinimockExportMyDataDone() bool {
+	if m.ExportMyDataMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMyDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMyDataMock.invocationsDone()
+}
+
+// MinimockExportMyDataInspect logs each unmet expectation
+func (m *PrivacyServiceMock) MinimockExportMyDataInspect() {
+	for _, e := range m.ExportMyDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PrivacyServiceMock.ExportMyData at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportMyDataCounter := mm_atomic.LoadUint64(&m.afterExportMyDataCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMyDataMock.defaultExpectation != nil && afterExportMyDataCounter < 1 {
+		if m.ExportMyDataMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PrivacyServiceMock.ExportMyData at\n%s", m.ExportMyDataMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PrivacyServiceMock.ExportMyData at\n%s with params: %#v", m.ExportMyDataMock.defaultExpectation.expectationOrigins.origin, *m.ExportMyDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportMyData != nil && afterExportMyDataCounter < 1 {
+		m.t.Errorf("Expected call to PrivacyServiceMock.ExportMyData at\n%s", m.funcExportMyDataOrigin)
+	}
+
+	if !m.ExportMyDataMock.invocationsDone() && afterExportMyDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to PrivacyServiceMock.ExportMyData at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMyDataMock.expectedInvocations), m.ExportMyDataMock.expectedInvocationsOrigin, afterExportMyDataCounter)
+	}
+}
+
+type mPrivacyServiceMockGetErasureRequest struct {
+	optional           bool
+	mock               *PrivacyServiceMock
+	defaultExpectation *PrivacyServiceMockGetErasureRequestExpectation
+	expectations       []*PrivacyServiceMockGetErasureRequestExpectation
+
+	callArgs []*PrivacyServiceMockGetErasureRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PrivacyServiceMockGetErasureRequestExpectation specifies expectation struct of the PrivacyService.GetErasureRequest
+type PrivacyServiceMockGetErasureRequestExpectation struct {
+	mock               *PrivacyServiceMock
+	params             *PrivacyServiceMockGetErasureRequestParams
+	paramPtrs          *PrivacyServiceMockGetErasureRequestParamPtrs
+	expectationOrigins PrivacyServiceMockGetErasureRequestExpectationOrigins
+	results            *PrivacyServiceMockGetErasureRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PrivacyServiceMockGetErasureRequestParams contains parameters of the PrivacyService.GetErasureRequest
+type PrivacyServiceMockGetErasureRequestParams struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+// PrivacyServiceMockGetErasureRequestParamPtrs contains pointers to parameters of the PrivacyService.GetErasureRequest
+type PrivacyServiceMockGetErasureRequestParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	id          *int64
+}
+
+// PrivacyServiceMockGetErasureRequestResults contains results of the PrivacyService.GetErasureRequest
+type PrivacyServiceMockGetErasureRequestResults struct {
+	ep1 *model.ErasureRequest
+	err error
+}
+
+// PrivacyServiceMockGetErasureRequestOrigins contains origins of expectations of the PrivacyService.GetErasureRequest
+type PrivacyServiceMockGetErasureRequestExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originId          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Optional() *mPrivacyServiceMockGetErasureRequest {
+	mmGetErasureRequest.optional = true
+	return mmGetErasureRequest
+}
+
+// Expect sets up expected params for PrivacyService.GetErasureRequest
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Expect(ctx context.Context, accessToken string, id int64) *mPrivacyServiceMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &PrivacyServiceMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by ExpectParams functions")
+	}
+
+	mmGetErasureRequest.defaultExpectation.params = &PrivacyServiceMockGetErasureRequestParams{ctx, accessToken, id}
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetErasureRequest.expectations {
+		if minimock.Equal(e.params, mmGetErasureRequest.defaultExpectation.params) {
+			mmGetErasureRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetErasureRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmGetErasureRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PrivacyService.GetErasureRequest
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) ExpectCtxParam1(ctx context.Context) *mPrivacyServiceMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &PrivacyServiceMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.params != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Expect")
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmGetErasureRequest.defaultExpectation.paramPtrs = &PrivacyServiceMockGetErasureRequestParamPtrs{}
+	}
+	mmGetErasureRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetErasureRequest
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PrivacyService.GetErasureRequest
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) ExpectAccessTokenParam2(accessToken string) *mPrivacyServiceMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &PrivacyServiceMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.params != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Expect")
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmGetErasureRequest.defaultExpectation.paramPtrs = &PrivacyServiceMockGetErasureRequestParamPtrs{}
+	}
+	mmGetErasureRequest.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmGetErasureRequest
+}
+
+// ExpectIdParam3 sets up expected param id for PrivacyService.GetErasureRequest
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) ExpectIdParam3(id int64) *mPrivacyServiceMockGetErasureRequest {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &PrivacyServiceMockGetErasureRequestExpectation{}
+	}
+
+	if mmGetErasureRequest.defaultExpectation.params != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Expect")
+	}
+
+	if mmGetErasureRequest.defaultExpectation.paramPtrs == nil {
+		mmGetErasureRequest.defaultExpectation.paramPtrs = &PrivacyServiceMockGetErasureRequestParamPtrs{}
+	}
+	mmGetErasureRequest.defaultExpectation.paramPtrs.id = &id
+	mmGetErasureRequest.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetErasureRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the PrivacyService.GetErasureRequest
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Inspect(f func(ctx context.Context, accessToken string, id int64)) *mPrivacyServiceMockGetErasureRequest {
+	if mmGetErasureRequest.mock.inspectFuncGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("Inspect function is already set for PrivacyServiceMock.GetErasureRequest")
+	}
+
+	mmGetErasureRequest.mock.inspectFuncGetErasureRequest = f
+
+	return mmGetErasureRequest
+}
+
+// Return sets up results that will be returned by PrivacyService.GetErasureRequest
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Return(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Set")
+	}
+
+	if mmGetErasureRequest.defaultExpectation == nil {
+		mmGetErasureRequest.defaultExpectation = &PrivacyServiceMockGetErasureRequestExpectation{mock: mmGetErasureRequest.mock}
+	}
+	mmGetErasureRequest.defaultExpectation.results = &PrivacyServiceMockGetErasureRequestResults{ep1, err}
+	mmGetErasureRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetErasureRequest.mock
+}
+
+// Set uses given function f to mock the PrivacyService.GetErasureRequest method
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Set(f func(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error)) *PrivacyServiceMock {
+	if mmGetErasureRequest.defaultExpectation != nil {
+		mmGetErasureRequest.mock.t.Fatalf("Default expectation is already set for the PrivacyService.GetErasureRequest method")
+	}
+
+	if len(mmGetErasureRequest.expectations) > 0 {
+		mmGetErasureRequest.mock.t.Fatalf("Some expectations are already set for the PrivacyService.GetErasureRequest method")
+	}
+
+	mmGetErasureRequest.mock.funcGetErasureRequest = f
+	mmGetErasureRequest.mock.funcGetErasureRequestOrigin = minimock.CallerInfo(1)
+	return mmGetErasureRequest.mock
+}
+
+// When sets expectation for the PrivacyService.GetErasureRequest which will trigger the result defined by the following
+// Then helper
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) When(ctx context.Context, accessToken string, id int64) *PrivacyServiceMockGetErasureRequestExpectation {
+	if mmGetErasureRequest.mock.funcGetErasureRequest != nil {
+		mmGetErasureRequest.mock.t.Fatalf("PrivacyServiceMock.GetErasureRequest mock is already set by Set")
+	}
+
+	expectation := &PrivacyServiceMockGetErasureRequestExpectation{
+		mock:               mmGetErasureRequest.mock,
+		params:             &PrivacyServiceMockGetErasureRequestParams{ctx, accessToken, id},
+		expectationOrigins: PrivacyServiceMockGetErasureRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetErasureRequest.expectations = append(mmGetErasureRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PrivacyService.GetErasureRequest return parameters for the expectation previously defined by the When method
+func (e *PrivacyServiceMockGetErasureRequestExpectation) Then(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	e.results = &PrivacyServiceMockGetErasureRequestResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times PrivacyService.GetErasureRequest should be invoked
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Times(n uint64) *mPrivacyServiceMockGetErasureRequest {
+	if n == 0 {
+		mmGetErasureRequest.mock.t.Fatalf("Times of PrivacyServiceMock.GetErasureRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetErasureRequest.expectedInvocations, n)
+	mmGetErasureRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetErasureRequest
+}
+
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) invocationsDone() bool {
+	if len(mmGetErasureRequest.expectations) == 0 && mmGetErasureRequest.defaultExpectation == nil && mmGetErasureRequest.mock.funcGetErasureRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetErasureRequest.mock.afterGetErasureRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetErasureRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetErasureRequest implements mm_service.PrivacyService
+func (mmGetErasureRequest *PrivacyServiceMock) GetErasureRequest(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error) {
+	mm_atomic.AddUint64(&mmGetErasureRequest.beforeGetErasureRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetErasureRequest.afterGetErasureRequestCounter, 1)
+
+	mmGetErasureRequest.t.Helper()
+
+	if mmGetErasureRequest.inspectFuncGetErasureRequest != nil {
+		mmGetErasureRequest.inspectFuncGetErasureRequest(ctx, accessToken, id)
+	}
+
+	mm_params := PrivacyServiceMockGetErasureRequestParams{ctx, accessToken, id}
+
+	// Record call args
+	mmGetErasureRequest.GetErasureRequestMock.mutex.Lock()
+	mmGetErasureRequest.GetErasureRequestMock.callArgs = append(mmGetErasureRequest.GetErasureRequestMock.callArgs, &mm_params)
+	mmGetErasureRequest.GetErasureRequestMock.mutex.Unlock()
+
+	for _, e := range mmGetErasureRequest.GetErasureRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmGetErasureRequest.GetErasureRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := PrivacyServiceMockGetErasureRequestParams{ctx, accessToken, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetErasureRequest.t.Errorf("PrivacyServiceMock.GetErasureRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmGetErasureRequest.t.Errorf("PrivacyServiceMock.GetErasureRequest got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetErasureRequest.t.Errorf("PrivacyServiceMock.GetErasureRequest got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetErasureRequest.t.Errorf("PrivacyServiceMock.GetErasureRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetErasureRequest.GetErasureRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetErasureRequest.t.Fatal("No results are set for the PrivacyServiceMock.GetErasureRequest")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmGetErasureRequest.funcGetErasureRequest != nil {
+		return mmGetErasureRequest.funcGetErasureRequest(ctx, accessToken, id)
+	}
+	mmGetErasureRequest.t.Fatalf("Unexpected call to PrivacyServiceMock.GetErasureRequest. %v %v %v", ctx, accessToken, id)
+	return
+}
+
+// GetErasureRequestAfterCounter returns a count of finished PrivacyServiceMock.GetErasureRequest invocations
+func (mmGetErasureRequest *PrivacyServiceMock) GetErasureRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetErasureRequest.afterGetErasureRequestCounter)
+}
+
+// GetErasureRequestBeforeCounter returns a count of PrivacyServiceMock.GetErasureRequest invocations
+func (mmGetErasureRequest *PrivacyServiceMock) GetErasureRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetErasureRequest.beforeGetErasureRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to PrivacyServiceMock.GetErasureRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetErasureRequest *mPrivacyServiceMockGetErasureRequest) Calls() []*PrivacyServiceMockGetErasureRequestParams {
+	mmGetErasureRequest.mutex.RLock()
+
+	argCopy := make([]*PrivacyServiceMockGetErasureRequestParams, len(mmGetErasureRequest.callArgs))
+	copy(argCopy, mmGetErasureRequest.callArgs)
+
+	mmGetErasureRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetErasureRequestDone returns true if the count of the GetErasureRequest invocations corresponds
+// the number of defined expectations
+func (m *PrivacyServiceMock) MinimockGetErasureRequestDone() bool {
+	if m.GetErasureRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetErasureRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetErasureRequestMock.invocationsDone()
+}
+
+// MinimockGetErasureRequestInspect logs each unmet expectation
+func (m *PrivacyServiceMock) MinimockGetErasureRequestInspect() {
+	for _, e := range m.GetErasureRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PrivacyServiceMock.GetErasureRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetErasureRequestCounter := mm_atomic.LoadUint64(&m.afterGetErasureRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetErasureRequestMock.defaultExpectation != nil && afterGetErasureRequestCounter < 1 {
+		if m.GetErasureRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PrivacyServiceMock.GetErasureRequest at\n%s", m.GetErasureRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PrivacyServiceMock.GetErasureRequest at\n%s with params: %#v", m.GetErasureRequestMock.defaultExpectation.expectationOrigins.origin, *m.GetErasureRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetErasureRequest != nil && afterGetErasureRequestCounter < 1 {
+		m.t.Errorf("Expected call to PrivacyServiceMock.GetErasureRequest at\n%s", m.funcGetErasureRequestOrigin)
+	}
+
+	if !m.GetErasureRequestMock.invocationsDone() && afterGetErasureRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to PrivacyServiceMock.GetErasureRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetErasureRequestMock.expectedInvocations), m.GetErasureRequestMock.expectedInvocationsOrigin, afterGetErasureRequestCounter)
+	}
+}
+
+type mPrivacyServiceMockRejectErasure struct {
+	optional           bool
+	mock               *PrivacyServiceMock
+	defaultExpectation *PrivacyServiceMockRejectErasureExpectation
+	expectations       []*PrivacyServiceMockRejectErasureExpectation
+
+	callArgs []*PrivacyServiceMockRejectErasureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PrivacyServiceMockRejectErasureExpectation specifies expectation struct of the PrivacyService.RejectErasure
+type PrivacyServiceMockRejectErasureExpectation struct {
+	mock               *PrivacyServiceMock
+	params             *PrivacyServiceMockRejectErasureParams
+	paramPtrs          *PrivacyServiceMockRejectErasureParamPtrs
+	expectationOrigins PrivacyServiceMockRejectErasureExpectationOrigins
+	results            *PrivacyServiceMockRejectErasureResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PrivacyServiceMockRejectErasureParams contains parameters of the PrivacyService.RejectErasure
+type PrivacyServiceMockRejectErasureParams struct {
+	ctx         context.Context
+	accessToken string
+	id          int64
+}
+
+// PrivacyServiceMockRejectErasureParamPtrs contains pointers to parameters of the PrivacyService.RejectErasure
+type PrivacyServiceMockRejectErasureParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	id          *int64
+}
+
+// PrivacyServiceMockRejectErasureResults contains results of the PrivacyService.RejectErasure
+type PrivacyServiceMockRejectErasureResults struct {
+	ep1 *model.ErasureRequest
+	err error
+}
+
+// PrivacyServiceMockRejectErasureOrigins contains origins of expectations of the PrivacyService.RejectErasure
+type PrivacyServiceMockRejectErasureExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originId          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Optional() *mPrivacyServiceMockRejectErasure {
+	mmRejectErasure.optional = true
+	return mmRejectErasure
+}
+
+// Expect sets up expected params for PrivacyService.RejectErasure
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Expect(ctx context.Context, accessToken string, id int64) *mPrivacyServiceMockRejectErasure {
+	if mmRejectErasure.mock.funcRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Set")
+	}
+
+	if mmRejectErasure.defaultExpectation == nil {
+		mmRejectErasure.defaultExpectation = &PrivacyServiceMockRejectErasureExpectation{}
+	}
+
+	if mmRejectErasure.defaultExpectation.paramPtrs != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by ExpectParams functions")
+	}
+
+	mmRejectErasure.defaultExpectation.params = &PrivacyServiceMockRejectErasureParams{ctx, accessToken, id}
+	mmRejectErasure.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRejectErasure.expectations {
+		if minimock.Equal(e.params, mmRejectErasure.defaultExpectation.params) {
+			mmRejectErasure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRejectErasure.defaultExpectation.params)
+		}
+	}
+
+	return mmRejectErasure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PrivacyService.RejectErasure
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) ExpectCtxParam1(ctx context.Context) *mPrivacyServiceMockRejectErasure {
+	if mmRejectErasure.mock.funcRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Set")
+	}
+
+	if mmRejectErasure.defaultExpectation == nil {
+		mmRejectErasure.defaultExpectation = &PrivacyServiceMockRejectErasureExpectation{}
+	}
+
+	if mmRejectErasure.defaultExpectation.params != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Expect")
+	}
+
+	if mmRejectErasure.defaultExpectation.paramPtrs == nil {
+		mmRejectErasure.defaultExpectation.paramPtrs = &PrivacyServiceMockRejectErasureParamPtrs{}
+	}
+	mmRejectErasure.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRejectErasure.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRejectErasure
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for PrivacyService.RejectErasure
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) ExpectAccessTokenParam2(accessToken string) *mPrivacyServiceMockRejectErasure {
+	if mmRejectErasure.mock.funcRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Set")
+	}
+
+	if mmRejectErasure.defaultExpectation == nil {
+		mmRejectErasure.defaultExpectation = &PrivacyServiceMockRejectErasureExpectation{}
+	}
+
+	if mmRejectErasure.defaultExpectation.params != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Expect")
+	}
+
+	if mmRejectErasure.defaultExpectation.paramPtrs == nil {
+		mmRejectErasure.defaultExpectation.paramPtrs = &PrivacyServiceMockRejectErasureParamPtrs{}
+	}
+	mmRejectErasure.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmRejectErasure.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmRejectErasure
+}
+
+// ExpectIdParam3 sets up expected param id for PrivacyService.RejectErasure
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) ExpectIdParam3(id int64) *mPrivacyServiceMockRejectErasure {
+	if mmRejectErasure.mock.funcRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Set")
+	}
+
+	if mmRejectErasure.defaultExpectation == nil {
+		mmRejectErasure.defaultExpectation = &PrivacyServiceMockRejectErasureExpectation{}
+	}
+
+	if mmRejectErasure.defaultExpectation.params != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Expect")
+	}
+
+	if mmRejectErasure.defaultExpectation.paramPtrs == nil {
+		mmRejectErasure.defaultExpectation.paramPtrs = &PrivacyServiceMockRejectErasureParamPtrs{}
+	}
+	mmRejectErasure.defaultExpectation.paramPtrs.id = &id
+	mmRejectErasure.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRejectErasure
+}
+
+// Inspect accepts an inspector function that has same arguments as the PrivacyService.RejectErasure
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Inspect(f func(ctx context.Context, accessToken string, id int64)) *mPrivacyServiceMockRejectErasure {
+	if mmRejectErasure.mock.inspectFuncRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("Inspect function is already set for PrivacyServiceMock.RejectErasure")
+	}
+
+	mmRejectErasure.mock.inspectFuncRejectErasure = f
+
+	return mmRejectErasure
+}
+
+// Return sets up results that will be returned by PrivacyService.RejectErasure
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Return(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	if mmRejectErasure.mock.funcRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Set")
+	}
+
+	if mmRejectErasure.defaultExpectation == nil {
+		mmRejectErasure.defaultExpectation = &PrivacyServiceMockRejectErasureExpectation{mock: mmRejectErasure.mock}
+	}
+	mmRejectErasure.defaultExpectation.results = &PrivacyServiceMockRejectErasureResults{ep1, err}
+	mmRejectErasure.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRejectErasure.mock
+}
+
+// Set uses given function f to mock the PrivacyService.RejectErasure method
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Set(f func(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error)) *PrivacyServiceMock {
+	if mmRejectErasure.defaultExpectation != nil {
+		mmRejectErasure.mock.t.Fatalf("Default expectation is already set for the PrivacyService.RejectErasure method")
+	}
+
+	if len(mmRejectErasure.expectations) > 0 {
+		mmRejectErasure.mock.t.Fatalf("Some expectations are already set for the PrivacyService.RejectErasure method")
+	}
+
+	mmRejectErasure.mock.funcRejectErasure = f
+	mmRejectErasure.mock.funcRejectErasureOrigin = minimock.CallerInfo(1)
+	return mmRejectErasure.mock
+}
+
+// When sets expectation for the PrivacyService.RejectErasure which will trigger the result defined by the following
+// Then helper
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) When(ctx context.Context, accessToken string, id int64) *PrivacyServiceMockRejectErasureExpectation {
+	if mmRejectErasure.mock.funcRejectErasure != nil {
+		mmRejectErasure.mock.t.Fatalf("PrivacyServiceMock.RejectErasure mock is already set by Set")
+	}
+
+	expectation := &PrivacyServiceMockRejectErasureExpectation{
+		mock:               mmRejectErasure.mock,
+		params:             &PrivacyServiceMockRejectErasureParams{ctx, accessToken, id},
+		expectationOrigins: PrivacyServiceMockRejectErasureExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRejectErasure.expectations = append(mmRejectErasure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PrivacyService.RejectErasure return parameters for the expectation previously defined by the When method
+func (e *PrivacyServiceMockRejectErasureExpectation) Then(ep1 *model.ErasureRequest, err error) *PrivacyServiceMock {
+	e.results = &PrivacyServiceMockRejectErasureResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times PrivacyService.RejectErasure should be invoked
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Times(n uint64) *mPrivacyServiceMockRejectErasure {
+	if n == 0 {
+		mmRejectErasure.mock.t.Fatalf("Times of PrivacyServiceMock.RejectErasure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRejectErasure.expectedInvocations, n)
+	mmRejectErasure.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRejectErasure
+}
+
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) invocationsDone() bool {
+	if len(mmRejectErasure.expectations) == 0 && mmRejectErasure.defaultExpectation == nil && mmRejectErasure.mock.funcRejectErasure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRejectErasure.mock.afterRejectErasureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRejectErasure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RejectErasure implements mm_service.PrivacyService
+func (mmRejectErasure *PrivacyServiceMock) RejectErasure(ctx context.Context, accessToken string, id int64) (ep1 *model.ErasureRequest, err error) {
+	mm_atomic.AddUint64(&mmRejectErasure.beforeRejectErasureCounter, 1)
+	defer mm_atomic.AddUint64(&mmRejectErasure.afterRejectErasureCounter, 1)
+
+	mmRejectErasure.t.Helper()
+
+	if mmRejectErasure.inspectFuncRejectErasure != nil {
+		mmRejectErasure.inspectFuncRejectErasure(ctx, accessToken, id)
+	}
+
+	mm_params := PrivacyServiceMockRejectErasureParams{ctx, accessToken, id}
+
+	// Record call args
+	mmRejectErasure.RejectErasureMock.mutex.Lock()
+	mmRejectErasure.RejectErasureMock.callArgs = append(mmRejectErasure.RejectErasureMock.callArgs, &mm_params)
+	mmRejectErasure.RejectErasureMock.mutex.Unlock()
+
+	for _, e := range mmRejectErasure.RejectErasureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmRejectErasure.RejectErasureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRejectErasure.RejectErasureMock.defaultExpectation.Counter, 1)
+		mm_want := mmRejectErasure.RejectErasureMock.defaultExpectation.params
+		mm_want_ptrs := mmRejectErasure.RejectErasureMock.defaultExpectation.paramPtrs
+
+		mm_got := PrivacyServiceMockRejectErasureParams{ctx, accessToken, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRejectErasure.t.Errorf("PrivacyServiceMock.RejectErasure got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRejectErasure.RejectErasureMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmRejectErasure.t.Errorf("PrivacyServiceMock.RejectErasure got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRejectErasure.RejectErasureMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRejectErasure.t.Errorf("PrivacyServiceMock.RejectErasure got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRejectErasure.RejectErasureMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRejectErasure.t.Errorf("PrivacyServiceMock.RejectErasure got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRejectErasure.RejectErasureMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRejectErasure.RejectErasureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRejectErasure.t.Fatal("No results are set for the PrivacyServiceMock.RejectErasure")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmRejectErasure.funcRejectErasure != nil {
+		return mmRejectErasure.funcRejectErasure(ctx, accessToken, id)
+	}
+	mmRejectErasure.t.Fatalf("Unexpected call to PrivacyServiceMock.RejectErasure. %v %v %v", ctx, accessToken, id)
+	return
+}
+
+// RejectErasureAfterCounter returns a count of finished PrivacyServiceMock.RejectErasure invocations
+func (mmRejectErasure *PrivacyServiceMock) RejectErasureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRejectErasure.afterRejectErasureCounter)
+}
+
+// RejectErasureBeforeCounter returns a count of PrivacyServiceMock.RejectErasure invocations
+func (mmRejectErasure *PrivacyServiceMock) RejectErasureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRejectErasure.beforeRejectErasureCounter)
+}
+
+// Calls returns a list of arguments used in each call to PrivacyServiceMock.RejectErasure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRejectErasure *mPrivacyServiceMockRejectErasure) Calls() []*PrivacyServiceMockRejectErasureParams {
+	mmRejectErasure.mutex.RLock()
+
+	argCopy := make([]*PrivacyServiceMockRejectErasureParams, len(mmRejectErasure.callArgs))
+	copy(argCopy, mmRejectErasure.callArgs)
+
+	mmRejectErasure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRejectErasureDone returns true if the count of the RejectErasure invocations corresponds
+// the number of defined expectations
+func (m *PrivacyServiceMock) MinimockRejectErasureDone() bool {
+	if m.RejectErasureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RejectErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RejectErasureMock.invocationsDone()
+}
+
+// MinimockRejectErasureInspect logs each unmet expectation
+func (m *PrivacyServiceMock) MinimockRejectErasureInspect() {
+	for _, e := range m.RejectErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PrivacyServiceMock.RejectErasure at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRejectErasureCounter := mm_atomic.LoadUint64(&m.afterRejectErasureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RejectErasureMock.defaultExpectation != nil && afterRejectErasureCounter < 1 {
+		if m.RejectErasureMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PrivacyServiceMock.RejectErasure at\n%s", m.RejectErasureMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PrivacyServiceMock.RejectErasure at\n%s with params: %#v", m.RejectErasureMock.defaultExpectation.expectationOrigins.origin, *m.RejectErasureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRejectErasure != nil && afterRejectErasureCounter < 1 {
+		m.t.Errorf("Expected call to PrivacyServiceMock.RejectErasure at\n%s", m.funcRejectErasureOrigin)
+	}
+
+	if !m.RejectErasureMock.invocationsDone() && afterRejectErasureCounter > 0 {
+		m.t.Errorf("Expected %d calls to PrivacyServiceMock.RejectErasure at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RejectErasureMock.expectedInvocations), m.RejectErasureMock.expectedInvocationsOrigin, afterRejectErasureCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PrivacyServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockApproveErasureInspect()
+
+			m.MinimockEraseUserInspect()
+
+			m.MinimockExportMyDataInspect()
+
+			m.MinimockGetErasureRequestInspect()
+
+			m.MinimockRejectErasureInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PrivacyServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PrivacyServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockApproveErasureDone() &&
+		m.MinimockEraseUserDone() &&
+		m.MinimockExportMyDataDone() &&
+		m.MinimockGetErasureRequestDone() &&
+		m.MinimockRejectErasureDone()
+}
@@ -0,0 +1,2352 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.RelationService -o relation_service_minimock.go -n RelationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// RelationServiceMock implements mm_service.RelationService
+type RelationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckRelation          func(ctx context.Context, accessToken string, check *model.RelationCheck) (rp1 *model.RelationCheckResult, err error)
+	funcCheckRelationOrigin    string
+	inspectFuncCheckRelation   func(ctx context.Context, accessToken string, check *model.RelationCheck)
+	afterCheckRelationCounter  uint64
+	beforeCheckRelationCounter uint64
+	CheckRelationMock          mRelationServiceMockCheckRelation
+
+	funcExpand          func(ctx context.Context, accessToken string, expand *model.RelationExpand) (rp1 *model.RelationExpandResult, err error)
+	funcExpandOrigin    string
+	inspectFuncExpand   func(ctx context.Context, accessToken string, expand *model.RelationExpand)
+	afterExpandCounter  uint64
+	beforeExpandCounter uint64
+	ExpandMock          mRelationServiceMockExpand
+
+	funcListNamespaces          func(ctx context.Context, accessToken string) (rpa1 []*model.RelationNamespace, err error)
+	funcListNamespacesOrigin    string
+	inspectFuncListNamespaces   func(ctx context.Context, accessToken string)
+	afterListNamespacesCounter  uint64
+	beforeListNamespacesCounter uint64
+	ListNamespacesMock          mRelationServiceMockListNamespaces
+
+	funcListObjects          func(ctx context.Context, accessToken string, list *model.RelationListObjects) (rp1 *model.RelationListObjectsResult, err error)
+	funcListObjectsOrigin    string
+	inspectFuncListObjects   func(ctx context.Context, accessToken string, list *model.RelationListObjects)
+	afterListObjectsCounter  uint64
+	beforeListObjectsCounter uint64
+	ListObjectsMock          mRelationServiceMockListObjects
+
+	funcWriteNamespace          func(ctx context.Context, accessToken string, namespace *model.RelationNamespace) (err error)
+	funcWriteNamespaceOrigin    string
+	inspectFuncWriteNamespace   func(ctx context.Context, accessToken string, namespace *model.RelationNamespace)
+	afterWriteNamespaceCounter  uint64
+	beforeWriteNamespaceCounter uint64
+	WriteNamespaceMock          mRelationServiceMockWriteNamespace
+
+	funcWriteTuples          func(ctx context.Context, accessToken string, write *model.RelationTupleWrite) (s1 string, err error)
+	funcWriteTuplesOrigin    string
+	inspectFuncWriteTuples   func(ctx context.Context, accessToken string, write *model.RelationTupleWrite)
+	afterWriteTuplesCounter  uint64
+	beforeWriteTuplesCounter uint64
+	WriteTuplesMock          mRelationServiceMockWriteTuples
+}
+
+// NewRelationServiceMock returns a mock for mm_service.RelationService
+func NewRelationServiceMock(t minimock.Tester) *RelationServiceMock {
+	m := &RelationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckRelationMock = mRelationServiceMockCheckRelation{mock: m}
+	m.CheckRelationMock.callArgs = []*RelationServiceMockCheckRelationParams{}
+
+	m.ExpandMock = mRelationServiceMockExpand{mock: m}
+	m.ExpandMock.callArgs = []*RelationServiceMockExpandParams{}
+
+	m.ListNamespacesMock = mRelationServiceMockListNamespaces{mock: m}
+	m.ListNamespacesMock.callArgs = []*RelationServiceMockListNamespacesParams{}
+
+	m.ListObjectsMock = mRelationServiceMockListObjects{mock: m}
+	m.ListObjectsMock.callArgs = []*RelationServiceMockListObjectsParams{}
+
+	m.WriteNamespaceMock = mRelationServiceMockWriteNamespace{mock: m}
+	m.WriteNamespaceMock.callArgs = []*RelationServiceMockWriteNamespaceParams{}
+
+	m.WriteTuplesMock = mRelationServiceMockWriteTuples{mock: m}
+	m.WriteTuplesMock.callArgs = []*RelationServiceMockWriteTuplesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationServiceMockCheckRelation struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockCheckRelationExpectation
+	expectations       []*RelationServiceMockCheckRelationExpectation
+
+	callArgs []*RelationServiceMockCheckRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockCheckRelationExpectation specifies expectation struct of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockCheckRelationParams
+	paramPtrs          *RelationServiceMockCheckRelationParamPtrs
+	expectationOrigins RelationServiceMockCheckRelationExpectationOrigins
+	results            *RelationServiceMockCheckRelationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockCheckRelationParams contains parameters of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationParams struct {
+	ctx         context.Context
+	accessToken string
+	check       *model.RelationCheck
+}
+
+// RelationServiceMockCheckRelationParamPtrs contains pointers to parameters of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	check       **model.RelationCheck
+}
+
+// RelationServiceMockCheckRelationResults contains results of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationResults struct {
+	rp1 *model.RelationCheckResult
+	err error
+}
+
+// RelationServiceMockCheckRelationOrigins contains origins of expectations of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originCheck       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Optional() *mRelationServiceMockCheckRelation {
+	mmCheckRelation.optional = true
+	return mmCheckRelation
+}
+
+// Expect sets up expected params for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Expect(ctx context.Context, accessToken string, check *model.RelationCheck) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by ExpectParams functions")
+	}
+
+	mmCheckRelation.defaultExpectation.params = &RelationServiceMockCheckRelationParams{ctx, accessToken, check}
+	mmCheckRelation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckRelation.expectations {
+		if minimock.Equal(e.params, mmCheckRelation.defaultExpectation.params) {
+			mmCheckRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckRelation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &RelationServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckRelation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckRelation
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) ExpectAccessTokenParam2(accessToken string) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &RelationServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmCheckRelation.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmCheckRelation
+}
+
+// ExpectCheckParam3 sets up expected param check for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) ExpectCheckParam3(check *model.RelationCheck) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &RelationServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.check = &check
+	mmCheckRelation.defaultExpectation.expectationOrigins.originCheck = minimock.CallerInfo(1)
+
+	return mmCheckRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Inspect(f func(ctx context.Context, accessToken string, check *model.RelationCheck)) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.inspectFuncCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.CheckRelation")
+	}
+
+	mmCheckRelation.mock.inspectFuncCheckRelation = f
+
+	return mmCheckRelation
+}
+
+// Return sets up results that will be returned by RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Return(rp1 *model.RelationCheckResult, err error) *RelationServiceMock {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{mock: mmCheckRelation.mock}
+	}
+	mmCheckRelation.defaultExpectation.results = &RelationServiceMockCheckRelationResults{rp1, err}
+	mmCheckRelation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckRelation.mock
+}
+
+// Set uses given function f to mock the RelationService.CheckRelation method
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Set(f func(ctx context.Context, accessToken string, check *model.RelationCheck) (rp1 *model.RelationCheckResult, err error)) *RelationServiceMock {
+	if mmCheckRelation.defaultExpectation != nil {
+		mmCheckRelation.mock.t.Fatalf("Default expectation is already set for the RelationService.CheckRelation method")
+	}
+
+	if len(mmCheckRelation.expectations) > 0 {
+		mmCheckRelation.mock.t.Fatalf("Some expectations are already set for the RelationService.CheckRelation method")
+	}
+
+	mmCheckRelation.mock.funcCheckRelation = f
+	mmCheckRelation.mock.funcCheckRelationOrigin = minimock.CallerInfo(1)
+	return mmCheckRelation.mock
+}
+
+// When sets expectation for the RelationService.CheckRelation which will trigger the result defined by the following
+// Then helper
+func (mmCheckRelation *mRelationServiceMockCheckRelation) When(ctx context.Context, accessToken string, check *model.RelationCheck) *RelationServiceMockCheckRelationExpectation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockCheckRelationExpectation{
+		mock:               mmCheckRelation.mock,
+		params:             &RelationServiceMockCheckRelationParams{ctx, accessToken, check},
+		expectationOrigins: RelationServiceMockCheckRelationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckRelation.expectations = append(mmCheckRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.CheckRelation return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockCheckRelationExpectation) Then(rp1 *model.RelationCheckResult, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockCheckRelationResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.CheckRelation should be invoked
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Times(n uint64) *mRelationServiceMockCheckRelation {
+	if n == 0 {
+		mmCheckRelation.mock.t.Fatalf("Times of RelationServiceMock.CheckRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckRelation.expectedInvocations, n)
+	mmCheckRelation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckRelation
+}
+
+func (mmCheckRelation *mRelationServiceMockCheckRelation) invocationsDone() bool {
+	if len(mmCheckRelation.expectations) == 0 && mmCheckRelation.defaultExpectation == nil && mmCheckRelation.mock.funcCheckRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckRelation.mock.afterCheckRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckRelation implements mm_service.RelationService
+func (mmCheckRelation *RelationServiceMock) CheckRelation(ctx context.Context, accessToken string, check *model.RelationCheck) (rp1 *model.RelationCheckResult, err error) {
+	mm_atomic.AddUint64(&mmCheckRelation.beforeCheckRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckRelation.afterCheckRelationCounter, 1)
+
+	mmCheckRelation.t.Helper()
+
+	if mmCheckRelation.inspectFuncCheckRelation != nil {
+		mmCheckRelation.inspectFuncCheckRelation(ctx, accessToken, check)
+	}
+
+	mm_params := RelationServiceMockCheckRelationParams{ctx, accessToken, check}
+
+	// Record call args
+	mmCheckRelation.CheckRelationMock.mutex.Lock()
+	mmCheckRelation.CheckRelationMock.callArgs = append(mmCheckRelation.CheckRelationMock.callArgs, &mm_params)
+	mmCheckRelation.CheckRelationMock.mutex.Unlock()
+
+	for _, e := range mmCheckRelation.CheckRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmCheckRelation.CheckRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckRelation.CheckRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckRelation.CheckRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckRelation.CheckRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockCheckRelationParams{ctx, accessToken, check}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.check != nil && !minimock.Equal(*mm_want_ptrs.check, mm_got.check) {
+				mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameter check, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.originCheck, *mm_want_ptrs.check, mm_got.check, minimock.Diff(*mm_want_ptrs.check, mm_got.check))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckRelation.CheckRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckRelation.t.Fatal("No results are set for the RelationServiceMock.CheckRelation")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmCheckRelation.funcCheckRelation != nil {
+		return mmCheckRelation.funcCheckRelation(ctx, accessToken, check)
+	}
+	mmCheckRelation.t.Fatalf("Unexpected call to RelationServiceMock.CheckRelation. %v %v %v", ctx, accessToken, check)
+	return
+}
+
+// CheckRelationAfterCounter returns a count of finished RelationServiceMock.CheckRelation invocations
+func (mmCheckRelation *RelationServiceMock) CheckRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckRelation.afterCheckRelationCounter)
+}
+
+// CheckRelationBeforeCounter returns a count of RelationServiceMock.CheckRelation invocations
+func (mmCheckRelation *RelationServiceMock) CheckRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckRelation.beforeCheckRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.CheckRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Calls() []*RelationServiceMockCheckRelationParams {
+	mmCheckRelation.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockCheckRelationParams, len(mmCheckRelation.callArgs))
+	copy(argCopy, mmCheckRelation.callArgs)
+
+	mmCheckRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckRelationDone returns true if the count of the CheckRelation invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockCheckRelationDone() bool {
+	if m.CheckRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckRelationMock.invocationsDone()
+}
+
+// MinimockCheckRelationInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockCheckRelationInspect() {
+	for _, e := range m.CheckRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckRelationCounter := mm_atomic.LoadUint64(&m.afterCheckRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckRelationMock.defaultExpectation != nil && afterCheckRelationCounter < 1 {
+		if m.CheckRelationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s", m.CheckRelationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s with params: %#v", m.CheckRelationMock.defaultExpectation.expectationOrigins.origin, *m.CheckRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckRelation != nil && afterCheckRelationCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s", m.funcCheckRelationOrigin)
+	}
+
+	if !m.CheckRelationMock.invocationsDone() && afterCheckRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.CheckRelation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckRelationMock.expectedInvocations), m.CheckRelationMock.expectedInvocationsOrigin, afterCheckRelationCounter)
+	}
+}
+
+type mRelationServiceMockExpand struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockExpandExpectation
+	expectations       []*RelationServiceMockExpandExpectation
+
+	callArgs []*RelationServiceMockExpandParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockExpandExpectation specifies expectation struct of the RelationService.Expand
+type RelationServiceMockExpandExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockExpandParams
+	paramPtrs          *RelationServiceMockExpandParamPtrs
+	expectationOrigins RelationServiceMockExpandExpectationOrigins
+	results            *RelationServiceMockExpandResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockExpandParams contains parameters of the RelationService.Expand
+type RelationServiceMockExpandParams struct {
+	ctx         context.Context
+	accessToken string
+	expand      *model.RelationExpand
+}
+
+// RelationServiceMockExpandParamPtrs contains pointers to parameters of the RelationService.Expand
+type RelationServiceMockExpandParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	expand      **model.RelationExpand
+}
+
+// RelationServiceMockExpandResults contains results of the RelationService.Expand
+type RelationServiceMockExpandResults struct {
+	rp1 *model.RelationExpandResult
+	err error
+}
+
+// RelationServiceMockExpandOrigins contains origins of expectations of the RelationService.Expand
+type RelationServiceMockExpandExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originExpand      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpand *mRelationServiceMockExpand) Optional() *mRelationServiceMockExpand {
+	mmExpand.optional = true
+	return mmExpand
+}
+
+// Expect sets up expected params for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) Expect(ctx context.Context, accessToken string, expand *model.RelationExpand) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by ExpectParams functions")
+	}
+
+	mmExpand.defaultExpectation.params = &RelationServiceMockExpandParams{ctx, accessToken, expand}
+	mmExpand.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpand.expectations {
+		if minimock.Equal(e.params, mmExpand.defaultExpectation.params) {
+			mmExpand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpand.defaultExpectation.params)
+		}
+	}
+
+	return mmExpand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpand.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpand
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectAccessTokenParam2(accessToken string) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmExpand.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmExpand
+}
+
+// ExpectExpandParam3 sets up expected param expand for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectExpandParam3(expand *model.RelationExpand) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.expand = &expand
+	mmExpand.defaultExpectation.expectationOrigins.originExpand = minimock.CallerInfo(1)
+
+	return mmExpand
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) Inspect(f func(ctx context.Context, accessToken string, expand *model.RelationExpand)) *mRelationServiceMockExpand {
+	if mmExpand.mock.inspectFuncExpand != nil {
+		mmExpand.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.Expand")
+	}
+
+	mmExpand.mock.inspectFuncExpand = f
+
+	return mmExpand
+}
+
+// Return sets up results that will be returned by RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) Return(rp1 *model.RelationExpandResult, err error) *RelationServiceMock {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{mock: mmExpand.mock}
+	}
+	mmExpand.defaultExpectation.results = &RelationServiceMockExpandResults{rp1, err}
+	mmExpand.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpand.mock
+}
+
+// Set uses given function f to mock the RelationService.Expand method
+func (mmExpand *mRelationServiceMockExpand) Set(f func(ctx context.Context, accessToken string, expand *model.RelationExpand) (rp1 *model.RelationExpandResult, err error)) *RelationServiceMock {
+	if mmExpand.defaultExpectation != nil {
+		mmExpand.mock.t.Fatalf("Default expectation is already set for the RelationService.Expand method")
+	}
+
+	if len(mmExpand.expectations) > 0 {
+		mmExpand.mock.t.Fatalf("Some expectations are already set for the RelationService.Expand method")
+	}
+
+	mmExpand.mock.funcExpand = f
+	mmExpand.mock.funcExpandOrigin = minimock.CallerInfo(1)
+	return mmExpand.mock
+}
+
+// When sets expectation for the RelationService.Expand which will trigger the result defined by the following
+// Then helper
+func (mmExpand *mRelationServiceMockExpand) When(ctx context.Context, accessToken string, expand *model.RelationExpand) *RelationServiceMockExpandExpectation {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockExpandExpectation{
+		mock:               mmExpand.mock,
+		params:             &RelationServiceMockExpandParams{ctx, accessToken, expand},
+		expectationOrigins: RelationServiceMockExpandExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpand.expectations = append(mmExpand.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.Expand return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockExpandExpectation) Then(rp1 *model.RelationExpandResult, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockExpandResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.Expand should be invoked
+func (mmExpand *mRelationServiceMockExpand) Times(n uint64) *mRelationServiceMockExpand {
+	if n == 0 {
+		mmExpand.mock.t.Fatalf("Times of RelationServiceMock.Expand mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpand.expectedInvocations, n)
+	mmExpand.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpand
+}
+
+func (mmExpand *mRelationServiceMockExpand) invocationsDone() bool {
+	if len(mmExpand.expectations) == 0 && mmExpand.defaultExpectation == nil && mmExpand.mock.funcExpand == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpand.mock.afterExpandCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpand.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Expand implements mm_service.RelationService
+func (mmExpand *RelationServiceMock) Expand(ctx context.Context, accessToken string, expand *model.RelationExpand) (rp1 *model.RelationExpandResult, err error) {
+	mm_atomic.AddUint64(&mmExpand.beforeExpandCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpand.afterExpandCounter, 1)
+
+	mmExpand.t.Helper()
+
+	if mmExpand.inspectFuncExpand != nil {
+		mmExpand.inspectFuncExpand(ctx, accessToken, expand)
+	}
+
+	mm_params := RelationServiceMockExpandParams{ctx, accessToken, expand}
+
+	// Record call args
+	mmExpand.ExpandMock.mutex.Lock()
+	mmExpand.ExpandMock.callArgs = append(mmExpand.ExpandMock.callArgs, &mm_params)
+	mmExpand.ExpandMock.mutex.Unlock()
+
+	for _, e := range mmExpand.ExpandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmExpand.ExpandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpand.ExpandMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpand.ExpandMock.defaultExpectation.params
+		mm_want_ptrs := mmExpand.ExpandMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockExpandParams{ctx, accessToken, expand}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpand.ExpandMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpand.ExpandMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.expand != nil && !minimock.Equal(*mm_want_ptrs.expand, mm_got.expand) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter expand, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpand.ExpandMock.defaultExpectation.expectationOrigins.originExpand, *mm_want_ptrs.expand, mm_got.expand, minimock.Diff(*mm_want_ptrs.expand, mm_got.expand))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpand.ExpandMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpand.ExpandMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpand.t.Fatal("No results are set for the RelationServiceMock.Expand")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmExpand.funcExpand != nil {
+		return mmExpand.funcExpand(ctx, accessToken, expand)
+	}
+	mmExpand.t.Fatalf("Unexpected call to RelationServiceMock.Expand. %v %v %v", ctx, accessToken, expand)
+	return
+}
+
+// ExpandAfterCounter returns a count of finished RelationServiceMock.Expand invocations
+func (mmExpand *RelationServiceMock) ExpandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpand.afterExpandCounter)
+}
+
+// ExpandBeforeCounter returns a count of RelationServiceMock.Expand invocations
+func (mmExpand *RelationServiceMock) ExpandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpand.beforeExpandCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.Expand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpand *mRelationServiceMockExpand) Calls() []*RelationServiceMockExpandParams {
+	mmExpand.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockExpandParams, len(mmExpand.callArgs))
+	copy(argCopy, mmExpand.callArgs)
+
+	mmExpand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpandDone returns true if the count of the Expand invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockExpandDone() bool {
+	if m.ExpandMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpandMock.invocationsDone()
+}
+
+// MinimockExpandInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockExpandInspect() {
+	for _, e := range m.ExpandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.Expand at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpandCounter := mm_atomic.LoadUint64(&m.afterExpandCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpandMock.defaultExpectation != nil && afterExpandCounter < 1 {
+		if m.ExpandMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.Expand at\n%s", m.ExpandMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.Expand at\n%s with params: %#v", m.ExpandMock.defaultExpectation.expectationOrigins.origin, *m.ExpandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpand != nil && afterExpandCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.Expand at\n%s", m.funcExpandOrigin)
+	}
+
+	if !m.ExpandMock.invocationsDone() && afterExpandCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.Expand at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpandMock.expectedInvocations), m.ExpandMock.expectedInvocationsOrigin, afterExpandCounter)
+	}
+}
+
+type mRelationServiceMockListNamespaces struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockListNamespacesExpectation
+	expectations       []*RelationServiceMockListNamespacesExpectation
+
+	callArgs []*RelationServiceMockListNamespacesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockListNamespacesExpectation specifies expectation struct of the RelationService.ListNamespaces
+type RelationServiceMockListNamespacesExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockListNamespacesParams
+	paramPtrs          *RelationServiceMockListNamespacesParamPtrs
+	expectationOrigins RelationServiceMockListNamespacesExpectationOrigins
+	results            *RelationServiceMockListNamespacesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockListNamespacesParams contains parameters of the RelationService.ListNamespaces
+type RelationServiceMockListNamespacesParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// RelationServiceMockListNamespacesParamPtrs contains pointers to parameters of the RelationService.ListNamespaces
+type RelationServiceMockListNamespacesParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// RelationServiceMockListNamespacesResults contains results of the RelationService.ListNamespaces
+type RelationServiceMockListNamespacesResults struct {
+	rpa1 []*model.RelationNamespace
+	err  error
+}
+
+// RelationServiceMockListNamespacesOrigins contains origins of expectations of the RelationService.ListNamespaces
+type RelationServiceMockListNamespacesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Optional() *mRelationServiceMockListNamespaces {
+	mmListNamespaces.optional = true
+	return mmListNamespaces
+}
+
+// Expect sets up expected params for RelationService.ListNamespaces
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Expect(ctx context.Context, accessToken string) *mRelationServiceMockListNamespaces {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationServiceMockListNamespacesExpectation{}
+	}
+
+	if mmListNamespaces.defaultExpectation.paramPtrs != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by ExpectParams functions")
+	}
+
+	mmListNamespaces.defaultExpectation.params = &RelationServiceMockListNamespacesParams{ctx, accessToken}
+	mmListNamespaces.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListNamespaces.expectations {
+		if minimock.Equal(e.params, mmListNamespaces.defaultExpectation.params) {
+			mmListNamespaces.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespaces.defaultExpectation.params)
+		}
+	}
+
+	return mmListNamespaces
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.ListNamespaces
+func (mmListNamespaces *mRelationServiceMockListNamespaces) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockListNamespaces {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationServiceMockListNamespacesExpectation{}
+	}
+
+	if mmListNamespaces.defaultExpectation.params != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Expect")
+	}
+
+	if mmListNamespaces.defaultExpectation.paramPtrs == nil {
+		mmListNamespaces.defaultExpectation.paramPtrs = &RelationServiceMockListNamespacesParamPtrs{}
+	}
+	mmListNamespaces.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNamespaces.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListNamespaces
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for RelationService.ListNamespaces
+func (mmListNamespaces *mRelationServiceMockListNamespaces) ExpectAccessTokenParam2(accessToken string) *mRelationServiceMockListNamespaces {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationServiceMockListNamespacesExpectation{}
+	}
+
+	if mmListNamespaces.defaultExpectation.params != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Expect")
+	}
+
+	if mmListNamespaces.defaultExpectation.paramPtrs == nil {
+		mmListNamespaces.defaultExpectation.paramPtrs = &RelationServiceMockListNamespacesParamPtrs{}
+	}
+	mmListNamespaces.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListNamespaces.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListNamespaces
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.ListNamespaces
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Inspect(f func(ctx context.Context, accessToken string)) *mRelationServiceMockListNamespaces {
+	if mmListNamespaces.mock.inspectFuncListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.ListNamespaces")
+	}
+
+	mmListNamespaces.mock.inspectFuncListNamespaces = f
+
+	return mmListNamespaces
+}
+
+// Return sets up results that will be returned by RelationService.ListNamespaces
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Return(rpa1 []*model.RelationNamespace, err error) *RelationServiceMock {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Set")
+	}
+
+	if mmListNamespaces.defaultExpectation == nil {
+		mmListNamespaces.defaultExpectation = &RelationServiceMockListNamespacesExpectation{mock: mmListNamespaces.mock}
+	}
+	mmListNamespaces.defaultExpectation.results = &RelationServiceMockListNamespacesResults{rpa1, err}
+	mmListNamespaces.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListNamespaces.mock
+}
+
+// Set uses given function f to mock the RelationService.ListNamespaces method
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Set(f func(ctx context.Context, accessToken string) (rpa1 []*model.RelationNamespace, err error)) *RelationServiceMock {
+	if mmListNamespaces.defaultExpectation != nil {
+		mmListNamespaces.mock.t.Fatalf("Default expectation is already set for the RelationService.ListNamespaces method")
+	}
+
+	if len(mmListNamespaces.expectations) > 0 {
+		mmListNamespaces.mock.t.Fatalf("Some expectations are already set for the RelationService.ListNamespaces method")
+	}
+
+	mmListNamespaces.mock.funcListNamespaces = f
+	mmListNamespaces.mock.funcListNamespacesOrigin = minimock.CallerInfo(1)
+	return mmListNamespaces.mock
+}
+
+// When sets expectation for the RelationService.ListNamespaces which will trigger the result defined by the following
+// Then helper
+func (mmListNamespaces *mRelationServiceMockListNamespaces) When(ctx context.Context, accessToken string) *RelationServiceMockListNamespacesExpectation {
+	if mmListNamespaces.mock.funcListNamespaces != nil {
+		mmListNamespaces.mock.t.Fatalf("RelationServiceMock.ListNamespaces mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockListNamespacesExpectation{
+		mock:               mmListNamespaces.mock,
+		params:             &RelationServiceMockListNamespacesParams{ctx, accessToken},
+		expectationOrigins: RelationServiceMockListNamespacesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListNamespaces.expectations = append(mmListNamespaces.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.ListNamespaces return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockListNamespacesExpectation) Then(rpa1 []*model.RelationNamespace, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockListNamespacesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.ListNamespaces should be invoked
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Times(n uint64) *mRelationServiceMockListNamespaces {
+	if n == 0 {
+		mmListNamespaces.mock.t.Fatalf("Times of RelationServiceMock.ListNamespaces mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListNamespaces.expectedInvocations, n)
+	mmListNamespaces.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListNamespaces
+}
+
+func (mmListNamespaces *mRelationServiceMockListNamespaces) invocationsDone() bool {
+	if len(mmListNamespaces.expectations) == 0 && mmListNamespaces.defaultExpectation == nil && mmListNamespaces.mock.funcListNamespaces == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListNamespaces.mock.afterListNamespacesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespaces.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListNamespaces implements mm_service.RelationService
+func (mmListNamespaces *RelationServiceMock) ListNamespaces(ctx context.Context, accessToken string) (rpa1 []*model.RelationNamespace, err error) {
+	mm_atomic.AddUint64(&mmListNamespaces.beforeListNamespacesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNamespaces.afterListNamespacesCounter, 1)
+
+	mmListNamespaces.t.Helper()
+
+	if mmListNamespaces.inspectFuncListNamespaces != nil {
+		mmListNamespaces.inspectFuncListNamespaces(ctx, accessToken)
+	}
+
+	mm_params := RelationServiceMockListNamespacesParams{ctx, accessToken}
+
+	// Record call args
+	mmListNamespaces.ListNamespacesMock.mutex.Lock()
+	mmListNamespaces.ListNamespacesMock.callArgs = append(mmListNamespaces.ListNamespacesMock.callArgs, &mm_params)
+	mmListNamespaces.ListNamespacesMock.mutex.Unlock()
+
+	for _, e := range mmListNamespaces.ListNamespacesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListNamespaces.ListNamespacesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNamespaces.ListNamespacesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNamespaces.ListNamespacesMock.defaultExpectation.params
+		mm_want_ptrs := mmListNamespaces.ListNamespacesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockListNamespacesParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListNamespaces.t.Errorf("RelationServiceMock.ListNamespaces got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNamespaces.ListNamespacesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListNamespaces.t.Errorf("RelationServiceMock.ListNamespaces got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNamespaces.ListNamespacesMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListNamespaces.t.Errorf("RelationServiceMock.ListNamespaces got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListNamespaces.ListNamespacesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListNamespaces.ListNamespacesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListNamespaces.t.Fatal("No results are set for the RelationServiceMock.ListNamespaces")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListNamespaces.funcListNamespaces != nil {
+		return mmListNamespaces.funcListNamespaces(ctx, accessToken)
+	}
+	mmListNamespaces.t.Fatalf("Unexpected call to RelationServiceMock.ListNamespaces. %v %v", ctx, accessToken)
+	return
+}
+
+// ListNamespacesAfterCounter returns a count of finished RelationServiceMock.ListNamespaces invocations
+func (mmListNamespaces *RelationServiceMock) ListNamespacesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespaces.afterListNamespacesCounter)
+}
+
+// ListNamespacesBeforeCounter returns a count of RelationServiceMock.ListNamespaces invocations
+func (mmListNamespaces *RelationServiceMock) ListNamespacesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespaces.beforeListNamespacesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.ListNamespaces.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListNamespaces *mRelationServiceMockListNamespaces) Calls() []*RelationServiceMockListNamespacesParams {
+	mmListNamespaces.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockListNamespacesParams, len(mmListNamespaces.callArgs))
+	copy(argCopy, mmListNamespaces.callArgs)
+
+	mmListNamespaces.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListNamespacesDone returns true if the count of the ListNamespaces invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockListNamespacesDone() bool {
+	if m.ListNamespacesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListNamespacesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListNamespacesMock.invocationsDone()
+}
+
+// MinimockListNamespacesInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockListNamespacesInspect() {
+	for _, e := range m.ListNamespacesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.ListNamespaces at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListNamespacesCounter := mm_atomic.LoadUint64(&m.afterListNamespacesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListNamespacesMock.defaultExpectation != nil && afterListNamespacesCounter < 1 {
+		if m.ListNamespacesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.ListNamespaces at\n%s", m.ListNamespacesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.ListNamespaces at\n%s with params: %#v", m.ListNamespacesMock.defaultExpectation.expectationOrigins.origin, *m.ListNamespacesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListNamespaces != nil && afterListNamespacesCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.ListNamespaces at\n%s", m.funcListNamespacesOrigin)
+	}
+
+	if !m.ListNamespacesMock.invocationsDone() && afterListNamespacesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.ListNamespaces at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNamespacesMock.expectedInvocations), m.ListNamespacesMock.expectedInvocationsOrigin, afterListNamespacesCounter)
+	}
+}
+
+type mRelationServiceMockListObjects struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockListObjectsExpectation
+	expectations       []*RelationServiceMockListObjectsExpectation
+
+	callArgs []*RelationServiceMockListObjectsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockListObjectsExpectation specifies expectation struct of the RelationService.ListObjects
+type RelationServiceMockListObjectsExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockListObjectsParams
+	paramPtrs          *RelationServiceMockListObjectsParamPtrs
+	expectationOrigins RelationServiceMockListObjectsExpectationOrigins
+	results            *RelationServiceMockListObjectsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockListObjectsParams contains parameters of the RelationService.ListObjects
+type RelationServiceMockListObjectsParams struct {
+	ctx         context.Context
+	accessToken string
+	list        *model.RelationListObjects
+}
+
+// RelationServiceMockListObjectsParamPtrs contains pointers to parameters of the RelationService.ListObjects
+type RelationServiceMockListObjectsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	list        **model.RelationListObjects
+}
+
+// RelationServiceMockListObjectsResults contains results of the RelationService.ListObjects
+type RelationServiceMockListObjectsResults struct {
+	rp1 *model.RelationListObjectsResult
+	err error
+}
+
+// RelationServiceMockListObjectsOrigins contains origins of expectations of the RelationService.ListObjects
+type RelationServiceMockListObjectsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originList        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListObjects *mRelationServiceMockListObjects) Optional() *mRelationServiceMockListObjects {
+	mmListObjects.optional = true
+	return mmListObjects
+}
+
+// Expect sets up expected params for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) Expect(ctx context.Context, accessToken string, list *model.RelationListObjects) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by ExpectParams functions")
+	}
+
+	mmListObjects.defaultExpectation.params = &RelationServiceMockListObjectsParams{ctx, accessToken, list}
+	mmListObjects.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListObjects.expectations {
+		if minimock.Equal(e.params, mmListObjects.defaultExpectation.params) {
+			mmListObjects.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListObjects.defaultExpectation.params)
+		}
+	}
+
+	return mmListObjects
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.params != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Expect")
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs == nil {
+		mmListObjects.defaultExpectation.paramPtrs = &RelationServiceMockListObjectsParamPtrs{}
+	}
+	mmListObjects.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListObjects.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListObjects
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) ExpectAccessTokenParam2(accessToken string) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.params != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Expect")
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs == nil {
+		mmListObjects.defaultExpectation.paramPtrs = &RelationServiceMockListObjectsParamPtrs{}
+	}
+	mmListObjects.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListObjects.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListObjects
+}
+
+// ExpectListParam3 sets up expected param list for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) ExpectListParam3(list *model.RelationListObjects) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.params != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Expect")
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs == nil {
+		mmListObjects.defaultExpectation.paramPtrs = &RelationServiceMockListObjectsParamPtrs{}
+	}
+	mmListObjects.defaultExpectation.paramPtrs.list = &list
+	mmListObjects.defaultExpectation.expectationOrigins.originList = minimock.CallerInfo(1)
+
+	return mmListObjects
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) Inspect(f func(ctx context.Context, accessToken string, list *model.RelationListObjects)) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.inspectFuncListObjects != nil {
+		mmListObjects.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.ListObjects")
+	}
+
+	mmListObjects.mock.inspectFuncListObjects = f
+
+	return mmListObjects
+}
+
+// Return sets up results that will be returned by RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) Return(rp1 *model.RelationListObjectsResult, err error) *RelationServiceMock {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{mock: mmListObjects.mock}
+	}
+	mmListObjects.defaultExpectation.results = &RelationServiceMockListObjectsResults{rp1, err}
+	mmListObjects.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListObjects.mock
+}
+
+// Set uses given function f to mock the RelationService.ListObjects method
+func (mmListObjects *mRelationServiceMockListObjects) Set(f func(ctx context.Context, accessToken string, list *model.RelationListObjects) (rp1 *model.RelationListObjectsResult, err error)) *RelationServiceMock {
+	if mmListObjects.defaultExpectation != nil {
+		mmListObjects.mock.t.Fatalf("Default expectation is already set for the RelationService.ListObjects method")
+	}
+
+	if len(mmListObjects.expectations) > 0 {
+		mmListObjects.mock.t.Fatalf("Some expectations are already set for the RelationService.ListObjects method")
+	}
+
+	mmListObjects.mock.funcListObjects = f
+	mmListObjects.mock.funcListObjectsOrigin = minimock.CallerInfo(1)
+	return mmListObjects.mock
+}
+
+// When sets expectation for the RelationService.ListObjects which will trigger the result defined by the following
+// Then helper
+func (mmListObjects *mRelationServiceMockListObjects) When(ctx context.Context, accessToken string, list *model.RelationListObjects) *RelationServiceMockListObjectsExpectation {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockListObjectsExpectation{
+		mock:               mmListObjects.mock,
+		params:             &RelationServiceMockListObjectsParams{ctx, accessToken, list},
+		expectationOrigins: RelationServiceMockListObjectsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListObjects.expectations = append(mmListObjects.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.ListObjects return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockListObjectsExpectation) Then(rp1 *model.RelationListObjectsResult, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockListObjectsResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.ListObjects should be invoked
+func (mmListObjects *mRelationServiceMockListObjects) Times(n uint64) *mRelationServiceMockListObjects {
+	if n == 0 {
+		mmListObjects.mock.t.Fatalf("Times of RelationServiceMock.ListObjects mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListObjects.expectedInvocations, n)
+	mmListObjects.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListObjects
+}
+
+func (mmListObjects *mRelationServiceMockListObjects) invocationsDone() bool {
+	if len(mmListObjects.expectations) == 0 && mmListObjects.defaultExpectation == nil && mmListObjects.mock.funcListObjects == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListObjects.mock.afterListObjectsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListObjects.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListObjects implements mm_service.RelationService
+func (mmListObjects *RelationServiceMock) ListObjects(ctx context.Context, accessToken string, list *model.RelationListObjects) (rp1 *model.RelationListObjectsResult, err error) {
+	mm_atomic.AddUint64(&mmListObjects.beforeListObjectsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListObjects.afterListObjectsCounter, 1)
+
+	mmListObjects.t.Helper()
+
+	if mmListObjects.inspectFuncListObjects != nil {
+		mmListObjects.inspectFuncListObjects(ctx, accessToken, list)
+	}
+
+	mm_params := RelationServiceMockListObjectsParams{ctx, accessToken, list}
+
+	// Record call args
+	mmListObjects.ListObjectsMock.mutex.Lock()
+	mmListObjects.ListObjectsMock.callArgs = append(mmListObjects.ListObjectsMock.callArgs, &mm_params)
+	mmListObjects.ListObjectsMock.mutex.Unlock()
+
+	for _, e := range mmListObjects.ListObjectsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmListObjects.ListObjectsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListObjects.ListObjectsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListObjects.ListObjectsMock.defaultExpectation.params
+		mm_want_ptrs := mmListObjects.ListObjectsMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockListObjectsParams{ctx, accessToken, list}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameter list, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.originList, *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListObjects.ListObjectsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListObjects.t.Fatal("No results are set for the RelationServiceMock.ListObjects")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmListObjects.funcListObjects != nil {
+		return mmListObjects.funcListObjects(ctx, accessToken, list)
+	}
+	mmListObjects.t.Fatalf("Unexpected call to RelationServiceMock.ListObjects. %v %v %v", ctx, accessToken, list)
+	return
+}
+
+// ListObjectsAfterCounter returns a count of finished RelationServiceMock.ListObjects invocations
+func (mmListObjects *RelationServiceMock) ListObjectsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListObjects.afterListObjectsCounter)
+}
+
+// ListObjectsBeforeCounter returns a count of RelationServiceMock.ListObjects invocations
+func (mmListObjects *RelationServiceMock) ListObjectsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListObjects.beforeListObjectsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.ListObjects.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListObjects *mRelationServiceMockListObjects) Calls() []*RelationServiceMockListObjectsParams {
+	mmListObjects.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockListObjectsParams, len(mmListObjects.callArgs))
+	copy(argCopy, mmListObjects.callArgs)
+
+	mmListObjects.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListObjectsDone returns true if the count of the ListObjects invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockListObjectsDone() bool {
+	if m.ListObjectsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListObjectsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListObjectsMock.invocationsDone()
+}
+
+// MinimockListObjectsInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockListObjectsInspect() {
+	for _, e := range m.ListObjectsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListObjectsCounter := mm_atomic.LoadUint64(&m.afterListObjectsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListObjectsMock.defaultExpectation != nil && afterListObjectsCounter < 1 {
+		if m.ListObjectsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s", m.ListObjectsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s with params: %#v", m.ListObjectsMock.defaultExpectation.expectationOrigins.origin, *m.ListObjectsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListObjects != nil && afterListObjectsCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s", m.funcListObjectsOrigin)
+	}
+
+	if !m.ListObjectsMock.invocationsDone() && afterListObjectsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.ListObjects at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListObjectsMock.expectedInvocations), m.ListObjectsMock.expectedInvocationsOrigin, afterListObjectsCounter)
+	}
+}
+
+type mRelationServiceMockWriteNamespace struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockWriteNamespaceExpectation
+	expectations       []*RelationServiceMockWriteNamespaceExpectation
+
+	callArgs []*RelationServiceMockWriteNamespaceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockWriteNamespaceExpectation specifies expectation struct of the RelationService.WriteNamespace
+type RelationServiceMockWriteNamespaceExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockWriteNamespaceParams
+	paramPtrs          *RelationServiceMockWriteNamespaceParamPtrs
+	expectationOrigins RelationServiceMockWriteNamespaceExpectationOrigins
+	results            *RelationServiceMockWriteNamespaceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockWriteNamespaceParams contains parameters of the RelationService.WriteNamespace
+type RelationServiceMockWriteNamespaceParams struct {
+	ctx         context.Context
+	accessToken string
+	namespace   *model.RelationNamespace
+}
+
+// RelationServiceMockWriteNamespaceParamPtrs contains pointers to parameters of the RelationService.WriteNamespace
+type RelationServiceMockWriteNamespaceParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	namespace   **model.RelationNamespace
+}
+
+// RelationServiceMockWriteNamespaceResults contains results of the RelationService.WriteNamespace
+type RelationServiceMockWriteNamespaceResults struct {
+	err error
+}
+
+// RelationServiceMockWriteNamespaceOrigins contains origins of expectations of the RelationService.WriteNamespace
+type RelationServiceMockWriteNamespaceExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originNamespace   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Optional() *mRelationServiceMockWriteNamespace {
+	mmWriteNamespace.optional = true
+	return mmWriteNamespace
+}
+
+// Expect sets up expected params for RelationService.WriteNamespace
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Expect(ctx context.Context, accessToken string, namespace *model.RelationNamespace) *mRelationServiceMockWriteNamespace {
+	if mmWriteNamespace.mock.funcWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Set")
+	}
+
+	if mmWriteNamespace.defaultExpectation == nil {
+		mmWriteNamespace.defaultExpectation = &RelationServiceMockWriteNamespaceExpectation{}
+	}
+
+	if mmWriteNamespace.defaultExpectation.paramPtrs != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by ExpectParams functions")
+	}
+
+	mmWriteNamespace.defaultExpectation.params = &RelationServiceMockWriteNamespaceParams{ctx, accessToken, namespace}
+	mmWriteNamespace.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWriteNamespace.expectations {
+		if minimock.Equal(e.params, mmWriteNamespace.defaultExpectation.params) {
+			mmWriteNamespace.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWriteNamespace.defaultExpectation.params)
+		}
+	}
+
+	return mmWriteNamespace
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.WriteNamespace
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockWriteNamespace {
+	if mmWriteNamespace.mock.funcWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Set")
+	}
+
+	if mmWriteNamespace.defaultExpectation == nil {
+		mmWriteNamespace.defaultExpectation = &RelationServiceMockWriteNamespaceExpectation{}
+	}
+
+	if mmWriteNamespace.defaultExpectation.params != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Expect")
+	}
+
+	if mmWriteNamespace.defaultExpectation.paramPtrs == nil {
+		mmWriteNamespace.defaultExpectation.paramPtrs = &RelationServiceMockWriteNamespaceParamPtrs{}
+	}
+	mmWriteNamespace.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWriteNamespace.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWriteNamespace
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for RelationService.WriteNamespace
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) ExpectAccessTokenParam2(accessToken string) *mRelationServiceMockWriteNamespace {
+	if mmWriteNamespace.mock.funcWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Set")
+	}
+
+	if mmWriteNamespace.defaultExpectation == nil {
+		mmWriteNamespace.defaultExpectation = &RelationServiceMockWriteNamespaceExpectation{}
+	}
+
+	if mmWriteNamespace.defaultExpectation.params != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Expect")
+	}
+
+	if mmWriteNamespace.defaultExpectation.paramPtrs == nil {
+		mmWriteNamespace.defaultExpectation.paramPtrs = &RelationServiceMockWriteNamespaceParamPtrs{}
+	}
+	mmWriteNamespace.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmWriteNamespace.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmWriteNamespace
+}
+
+// ExpectNamespaceParam3 sets up expected param namespace for RelationService.WriteNamespace
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) ExpectNamespaceParam3(namespace *model.RelationNamespace) *mRelationServiceMockWriteNamespace {
+	if mmWriteNamespace.mock.funcWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Set")
+	}
+
+	if mmWriteNamespace.defaultExpectation == nil {
+		mmWriteNamespace.defaultExpectation = &RelationServiceMockWriteNamespaceExpectation{}
+	}
+
+	if mmWriteNamespace.defaultExpectation.params != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Expect")
+	}
+
+	if mmWriteNamespace.defaultExpectation.paramPtrs == nil {
+		mmWriteNamespace.defaultExpectation.paramPtrs = &RelationServiceMockWriteNamespaceParamPtrs{}
+	}
+	mmWriteNamespace.defaultExpectation.paramPtrs.namespace = &namespace
+	mmWriteNamespace.defaultExpectation.expectationOrigins.originNamespace = minimock.CallerInfo(1)
+
+	return mmWriteNamespace
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.WriteNamespace
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Inspect(f func(ctx context.Context, accessToken string, namespace *model.RelationNamespace)) *mRelationServiceMockWriteNamespace {
+	if mmWriteNamespace.mock.inspectFuncWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.WriteNamespace")
+	}
+
+	mmWriteNamespace.mock.inspectFuncWriteNamespace = f
+
+	return mmWriteNamespace
+}
+
+// Return sets up results that will be returned by RelationService.WriteNamespace
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Return(err error) *RelationServiceMock {
+	if mmWriteNamespace.mock.funcWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Set")
+	}
+
+	if mmWriteNamespace.defaultExpectation == nil {
+		mmWriteNamespace.defaultExpectation = &RelationServiceMockWriteNamespaceExpectation{mock: mmWriteNamespace.mock}
+	}
+	mmWriteNamespace.defaultExpectation.results = &RelationServiceMockWriteNamespaceResults{err}
+	mmWriteNamespace.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWriteNamespace.mock
+}
+
+// Set uses given function f to mock the RelationService.WriteNamespace method
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Set(f func(ctx context.Context, accessToken string, namespace *model.RelationNamespace) (err error)) *RelationServiceMock {
+	if mmWriteNamespace.defaultExpectation != nil {
+		mmWriteNamespace.mock.t.Fatalf("Default expectation is already set for the RelationService.WriteNamespace method")
+	}
+
+	if len(mmWriteNamespace.expectations) > 0 {
+		mmWriteNamespace.mock.t.Fatalf("Some expectations are already set for the RelationService.WriteNamespace method")
+	}
+
+	mmWriteNamespace.mock.funcWriteNamespace = f
+	mmWriteNamespace.mock.funcWriteNamespaceOrigin = minimock.CallerInfo(1)
+	return mmWriteNamespace.mock
+}
+
+// When sets expectation for the RelationService.WriteNamespace which will trigger the result defined by the following
+// Then helper
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) When(ctx context.Context, accessToken string, namespace *model.RelationNamespace) *RelationServiceMockWriteNamespaceExpectation {
+	if mmWriteNamespace.mock.funcWriteNamespace != nil {
+		mmWriteNamespace.mock.t.Fatalf("RelationServiceMock.WriteNamespace mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockWriteNamespaceExpectation{
+		mock:               mmWriteNamespace.mock,
+		params:             &RelationServiceMockWriteNamespaceParams{ctx, accessToken, namespace},
+		expectationOrigins: RelationServiceMockWriteNamespaceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWriteNamespace.expectations = append(mmWriteNamespace.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.WriteNamespace return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockWriteNamespaceExpectation) Then(err error) *RelationServiceMock {
+	e.results = &RelationServiceMockWriteNamespaceResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.WriteNamespace should be invoked
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Times(n uint64) *mRelationServiceMockWriteNamespace {
+	if n == 0 {
+		mmWriteNamespace.mock.t.Fatalf("Times of RelationServiceMock.WriteNamespace mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWriteNamespace.expectedInvocations, n)
+	mmWriteNamespace.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWriteNamespace
+}
+
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) invocationsDone() bool {
+	if len(mmWriteNamespace.expectations) == 0 && mmWriteNamespace.defaultExpectation == nil && mmWriteNamespace.mock.funcWriteNamespace == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWriteNamespace.mock.afterWriteNamespaceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWriteNamespace.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WriteNamespace implements mm_service.RelationService
+func (mmWriteNamespace *RelationServiceMock) WriteNamespace(ctx context.Context, accessToken string, namespace *model.RelationNamespace) (err error) {
+	mm_atomic.AddUint64(&mmWriteNamespace.beforeWriteNamespaceCounter, 1)
+	defer mm_atomic.AddUint64(&mmWriteNamespace.afterWriteNamespaceCounter, 1)
+
+	mmWriteNamespace.t.Helper()
+
+	if mmWriteNamespace.inspectFuncWriteNamespace != nil {
+		mmWriteNamespace.inspectFuncWriteNamespace(ctx, accessToken, namespace)
+	}
+
+	mm_params := RelationServiceMockWriteNamespaceParams{ctx, accessToken, namespace}
+
+	// Record call args
+	mmWriteNamespace.WriteNamespaceMock.mutex.Lock()
+	mmWriteNamespace.WriteNamespaceMock.callArgs = append(mmWriteNamespace.WriteNamespaceMock.callArgs, &mm_params)
+	mmWriteNamespace.WriteNamespaceMock.mutex.Unlock()
+
+	for _, e := range mmWriteNamespace.WriteNamespaceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWriteNamespace.WriteNamespaceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWriteNamespace.WriteNamespaceMock.defaultExpectation.Counter, 1)
+		mm_want := mmWriteNamespace.WriteNamespaceMock.defaultExpectation.params
+		mm_want_ptrs := mmWriteNamespace.WriteNamespaceMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockWriteNamespaceParams{ctx, accessToken, namespace}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWriteNamespace.t.Errorf("RelationServiceMock.WriteNamespace got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteNamespace.WriteNamespaceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmWriteNamespace.t.Errorf("RelationServiceMock.WriteNamespace got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteNamespace.WriteNamespaceMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.namespace != nil && !minimock.Equal(*mm_want_ptrs.namespace, mm_got.namespace) {
+				mmWriteNamespace.t.Errorf("RelationServiceMock.WriteNamespace got unexpected parameter namespace, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteNamespace.WriteNamespaceMock.defaultExpectation.expectationOrigins.originNamespace, *mm_want_ptrs.namespace, mm_got.namespace, minimock.Diff(*mm_want_ptrs.namespace, mm_got.namespace))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWriteNamespace.t.Errorf("RelationServiceMock.WriteNamespace got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWriteNamespace.WriteNamespaceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWriteNamespace.WriteNamespaceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWriteNamespace.t.Fatal("No results are set for the RelationServiceMock.WriteNamespace")
+		}
+		return (*mm_results).err
+	}
+	if mmWriteNamespace.funcWriteNamespace != nil {
+		return mmWriteNamespace.funcWriteNamespace(ctx, accessToken, namespace)
+	}
+	mmWriteNamespace.t.Fatalf("Unexpected call to RelationServiceMock.WriteNamespace. %v %v %v", ctx, accessToken, namespace)
+	return
+}
+
+// WriteNamespaceAfterCounter returns a count of finished RelationServiceMock.WriteNamespace invocations
+func (mmWriteNamespace *RelationServiceMock) WriteNamespaceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteNamespace.afterWriteNamespaceCounter)
+}
+
+// WriteNamespaceBeforeCounter returns a count of RelationServiceMock.WriteNamespace invocations
+func (mmWriteNamespace *RelationServiceMock) WriteNamespaceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteNamespace.beforeWriteNamespaceCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.WriteNamespace.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWriteNamespace *mRelationServiceMockWriteNamespace) Calls() []*RelationServiceMockWriteNamespaceParams {
+	mmWriteNamespace.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockWriteNamespaceParams, len(mmWriteNamespace.callArgs))
+	copy(argCopy, mmWriteNamespace.callArgs)
+
+	mmWriteNamespace.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteNamespaceDone returns true if the count of the WriteNamespace invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockWriteNamespaceDone() bool {
+	if m.WriteNamespaceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteNamespaceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteNamespaceMock.invocationsDone()
+}
+
+// MinimockWriteNamespaceInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockWriteNamespaceInspect() {
+	for _, e := range m.WriteNamespaceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteNamespace at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWriteNamespaceCounter := mm_atomic.LoadUint64(&m.afterWriteNamespaceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteNamespaceMock.defaultExpectation != nil && afterWriteNamespaceCounter < 1 {
+		if m.WriteNamespaceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteNamespace at\n%s", m.WriteNamespaceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteNamespace at\n%s with params: %#v", m.WriteNamespaceMock.defaultExpectation.expectationOrigins.origin, *m.WriteNamespaceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteNamespace != nil && afterWriteNamespaceCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.WriteNamespace at\n%s", m.funcWriteNamespaceOrigin)
+	}
+
+	if !m.WriteNamespaceMock.invocationsDone() && afterWriteNamespaceCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.WriteNamespace at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteNamespaceMock.expectedInvocations), m.WriteNamespaceMock.expectedInvocationsOrigin, afterWriteNamespaceCounter)
+	}
+}
+
+type mRelationServiceMockWriteTuples struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockWriteTuplesExpectation
+	expectations       []*RelationServiceMockWriteTuplesExpectation
+
+	callArgs []*RelationServiceMockWriteTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockWriteTuplesExpectation specifies expectation struct of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockWriteTuplesParams
+	paramPtrs          *RelationServiceMockWriteTuplesParamPtrs
+	expectationOrigins RelationServiceMockWriteTuplesExpectationOrigins
+	results            *RelationServiceMockWriteTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockWriteTuplesParams contains parameters of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesParams struct {
+	ctx         context.Context
+	accessToken string
+	write       *model.RelationTupleWrite
+}
+
+// RelationServiceMockWriteTuplesParamPtrs contains pointers to parameters of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	write       **model.RelationTupleWrite
+}
+
+// RelationServiceMockWriteTuplesResults contains results of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesResults struct {
+	s1  string
+	err error
+}
+
+// RelationServiceMockWriteTuplesOrigins contains origins of expectations of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originWrite       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Optional() *mRelationServiceMockWriteTuples {
+	mmWriteTuples.optional = true
+	return mmWriteTuples
+}
+
+// Expect sets up expected params for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Expect(ctx context.Context, accessToken string, write *model.RelationTupleWrite) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmWriteTuples.defaultExpectation.params = &RelationServiceMockWriteTuplesParams{ctx, accessToken, write}
+	mmWriteTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWriteTuples.expectations {
+		if minimock.Equal(e.params, mmWriteTuples.defaultExpectation.params) {
+			mmWriteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWriteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmWriteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWriteTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectAccessTokenParam2(accessToken string) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmWriteTuples.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// ExpectWriteParam3 sets up expected param write for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectWriteParam3(write *model.RelationTupleWrite) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.write = &write
+	mmWriteTuples.defaultExpectation.expectationOrigins.originWrite = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Inspect(f func(ctx context.Context, accessToken string, write *model.RelationTupleWrite)) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.inspectFuncWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.WriteTuples")
+	}
+
+	mmWriteTuples.mock.inspectFuncWriteTuples = f
+
+	return mmWriteTuples
+}
+
+// Return sets up results that will be returned by RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Return(s1 string, err error) *RelationServiceMock {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{mock: mmWriteTuples.mock}
+	}
+	mmWriteTuples.defaultExpectation.results = &RelationServiceMockWriteTuplesResults{s1, err}
+	mmWriteTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples.mock
+}
+
+// Set uses given function f to mock the RelationService.WriteTuples method
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Set(f func(ctx context.Context, accessToken string, write *model.RelationTupleWrite) (s1 string, err error)) *RelationServiceMock {
+	if mmWriteTuples.defaultExpectation != nil {
+		mmWriteTuples.mock.t.Fatalf("Default expectation is already set for the RelationService.WriteTuples method")
+	}
+
+	if len(mmWriteTuples.expectations) > 0 {
+		mmWriteTuples.mock.t.Fatalf("Some expectations are already set for the RelationService.WriteTuples method")
+	}
+
+	mmWriteTuples.mock.funcWriteTuples = f
+	mmWriteTuples.mock.funcWriteTuplesOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples.mock
+}
+
+// When sets expectation for the RelationService.WriteTuples which will trigger the result defined by the following
+// Then helper
+func (mmWriteTuples *mRelationServiceMockWriteTuples) When(ctx context.Context, accessToken string, write *model.RelationTupleWrite) *RelationServiceMockWriteTuplesExpectation {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockWriteTuplesExpectation{
+		mock:               mmWriteTuples.mock,
+		params:             &RelationServiceMockWriteTuplesParams{ctx, accessToken, write},
+		expectationOrigins: RelationServiceMockWriteTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWriteTuples.expectations = append(mmWriteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.WriteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockWriteTuplesExpectation) Then(s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockWriteTuplesResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.WriteTuples should be invoked
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Times(n uint64) *mRelationServiceMockWriteTuples {
+	if n == 0 {
+		mmWriteTuples.mock.t.Fatalf("Times of RelationServiceMock.WriteTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWriteTuples.expectedInvocations, n)
+	mmWriteTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples
+}
+
+func (mmWriteTuples *mRelationServiceMockWriteTuples) invocationsDone() bool {
+	if len(mmWriteTuples.expectations) == 0 && mmWriteTuples.defaultExpectation == nil && mmWriteTuples.mock.funcWriteTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWriteTuples.mock.afterWriteTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWriteTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WriteTuples implements mm_service.RelationService
+func (mmWriteTuples *RelationServiceMock) WriteTuples(ctx context.Context, accessToken string, write *model.RelationTupleWrite) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmWriteTuples.beforeWriteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmWriteTuples.afterWriteTuplesCounter, 1)
+
+	mmWriteTuples.t.Helper()
+
+	if mmWriteTuples.inspectFuncWriteTuples != nil {
+		mmWriteTuples.inspectFuncWriteTuples(ctx, accessToken, write)
+	}
+
+	mm_params := RelationServiceMockWriteTuplesParams{ctx, accessToken, write}
+
+	// Record call args
+	mmWriteTuples.WriteTuplesMock.mutex.Lock()
+	mmWriteTuples.WriteTuplesMock.callArgs = append(mmWriteTuples.WriteTuplesMock.callArgs, &mm_params)
+	mmWriteTuples.WriteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmWriteTuples.WriteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmWriteTuples.WriteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWriteTuples.WriteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmWriteTuples.WriteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmWriteTuples.WriteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockWriteTuplesParams{ctx, accessToken, write}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.write != nil && !minimock.Equal(*mm_want_ptrs.write, mm_got.write) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter write, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originWrite, *mm_want_ptrs.write, mm_got.write, minimock.Diff(*mm_want_ptrs.write, mm_got.write))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWriteTuples.WriteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWriteTuples.t.Fatal("No results are set for the RelationServiceMock.WriteTuples")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmWriteTuples.funcWriteTuples != nil {
+		return mmWriteTuples.funcWriteTuples(ctx, accessToken, write)
+	}
+	mmWriteTuples.t.Fatalf("Unexpected call to RelationServiceMock.WriteTuples. %v %v %v", ctx, accessToken, write)
+	return
+}
+
+// WriteTuplesAfterCounter returns a count of finished RelationServiceMock.WriteTuples invocations
+func (mmWriteTuples *RelationServiceMock) WriteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.afterWriteTuplesCounter)
+}
+
+// WriteTuplesBeforeCounter returns a count of RelationServiceMock.WriteTuples invocations
+func (mmWriteTuples *RelationServiceMock) WriteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.beforeWriteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.WriteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Calls() []*RelationServiceMockWriteTuplesParams {
+	mmWriteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockWriteTuplesParams, len(mmWriteTuples.callArgs))
+	copy(argCopy, mmWriteTuples.callArgs)
+
+	mmWriteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteTuplesDone returns true if the count of the WriteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockWriteTuplesDone() bool {
+	if m.WriteTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteTuplesMock.invocationsDone()
+}
+
+// MinimockWriteTuplesInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockWriteTuplesInspect() {
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWriteTuplesCounter := mm_atomic.LoadUint64(&m.afterWriteTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteTuplesMock.defaultExpectation != nil && afterWriteTuplesCounter < 1 {
+		if m.WriteTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s", m.WriteTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s with params: %#v", m.WriteTuplesMock.defaultExpectation.expectationOrigins.origin, *m.WriteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteTuples != nil && afterWriteTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s", m.funcWriteTuplesOrigin)
+	}
+
+	if !m.WriteTuplesMock.invocationsDone() && afterWriteTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.WriteTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteTuplesMock.expectedInvocations), m.WriteTuplesMock.expectedInvocationsOrigin, afterWriteTuplesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckRelationInspect()
+
+			m.MinimockExpandInspect()
+
+			m.MinimockListNamespacesInspect()
+
+			m.MinimockListObjectsInspect()
+
+			m.MinimockWriteNamespaceInspect()
+
+			m.MinimockWriteTuplesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckRelationDone() &&
+		m.MinimockExpandDone() &&
+		m.MinimockListNamespacesDone() &&
+		m.MinimockListObjectsDone() &&
+		m.MinimockWriteNamespaceDone() &&
+		m.MinimockWriteTuplesDone()
+}
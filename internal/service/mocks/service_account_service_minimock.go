@@ -0,0 +1,1578 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/valek177/auth/internal/service.ServiceAccountService -o service_account_service_minimock.go -n ServiceAccountServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/valek177/auth/internal/model"
+)
+
+// ServiceAccountServiceMock implements mm_service.ServiceAccountService
+type ServiceAccountServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateServiceAccount          func(ctx context.Context, accessToken string, account *model.ServiceAccount) (sp1 *model.ServiceAccountCredentials, err error)
+	funcCreateServiceAccountOrigin    string
+	inspectFuncCreateServiceAccount   func(ctx context.Context, accessToken string, account *model.ServiceAccount)
+	afterCreateServiceAccountCounter  uint64
+	beforeCreateServiceAccountCounter uint64
+	CreateServiceAccountMock          mServiceAccountServiceMockCreateServiceAccount
+
+	funcDisableServiceAccount          func(ctx context.Context, accessToken string, clientID string) (err error)
+	funcDisableServiceAccountOrigin    string
+	inspectFuncDisableServiceAccount   func(ctx context.Context, accessToken string, clientID string)
+	afterDisableServiceAccountCounter  uint64
+	beforeDisableServiceAccountCounter uint64
+	DisableServiceAccountMock          mServiceAccountServiceMockDisableServiceAccount
+
+	funcListServiceAccounts          func(ctx context.Context, accessToken string) (spa1 []*model.ServiceAccount, err error)
+	funcListServiceAccountsOrigin    string
+	inspectFuncListServiceAccounts   func(ctx context.Context, accessToken string)
+	afterListServiceAccountsCounter  uint64
+	beforeListServiceAccountsCounter uint64
+	ListServiceAccountsMock          mServiceAccountServiceMockListServiceAccounts
+
+	funcRotateServiceAccountSecret          func(ctx context.Context, accessToken string, clientID string) (sp1 *model.ServiceAccountCredentials, err error)
+	funcRotateServiceAccountSecretOrigin    string
+	inspectFuncRotateServiceAccountSecret   func(ctx context.Context, accessToken string, clientID string)
+	afterRotateServiceAccountSecretCounter  uint64
+	beforeRotateServiceAccountSecretCounter uint64
+	RotateServiceAccountSecretMock          mServiceAccountServiceMockRotateServiceAccountSecret
+}
+
+// NewServiceAccountServiceMock returns a mock for mm_service.ServiceAccountService
+func NewServiceAccountServiceMock(t minimock.Tester) *ServiceAccountServiceMock {
+	m := &ServiceAccountServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateServiceAccountMock = mServiceAccountServiceMockCreateServiceAccount{mock: m}
+	m.CreateServiceAccountMock.callArgs = []*ServiceAccountServiceMockCreateServiceAccountParams{}
+
+	m.DisableServiceAccountMock = mServiceAccountServiceMockDisableServiceAccount{mock: m}
+	m.DisableServiceAccountMock.callArgs = []*ServiceAccountServiceMockDisableServiceAccountParams{}
+
+	m.ListServiceAccountsMock = mServiceAccountServiceMockListServiceAccounts{mock: m}
+	m.ListServiceAccountsMock.callArgs = []*ServiceAccountServiceMockListServiceAccountsParams{}
+
+	m.RotateServiceAccountSecretMock = mServiceAccountServiceMockRotateServiceAccountSecret{mock: m}
+	m.RotateServiceAccountSecretMock.callArgs = []*ServiceAccountServiceMockRotateServiceAccountSecretParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceAccountServiceMockCreateServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockCreateServiceAccountExpectation
+	expectations       []*ServiceAccountServiceMockCreateServiceAccountExpectation
+
+	callArgs []*ServiceAccountServiceMockCreateServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockCreateServiceAccountExpectation specifies expectation struct of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockCreateServiceAccountParams
+	paramPtrs          *ServiceAccountServiceMockCreateServiceAccountParamPtrs
+	expectationOrigins ServiceAccountServiceMockCreateServiceAccountExpectationOrigins
+	results            *ServiceAccountServiceMockCreateServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockCreateServiceAccountParams contains parameters of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountParams struct {
+	ctx         context.Context
+	accessToken string
+	account     *model.ServiceAccount
+}
+
+// ServiceAccountServiceMockCreateServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	account     **model.ServiceAccount
+}
+
+// ServiceAccountServiceMockCreateServiceAccountResults contains results of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountResults struct {
+	sp1 *model.ServiceAccountCredentials
+	err error
+}
+
+// ServiceAccountServiceMockCreateServiceAccountOrigins contains origins of expectations of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originAccount     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Optional() *mServiceAccountServiceMockCreateServiceAccount {
+	mmCreateServiceAccount.optional = true
+	return mmCreateServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Expect(ctx context.Context, accessToken string, account *model.ServiceAccount) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmCreateServiceAccount.defaultExpectation.params = &ServiceAccountServiceMockCreateServiceAccountParams{ctx, accessToken, account}
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateServiceAccount.expectations {
+		if minimock.Equal(e.params, mmCreateServiceAccount.defaultExpectation.params) {
+			mmCreateServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectAccessTokenParam2(accessToken string) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectAccountParam3 sets up expected param account for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectAccountParam3(account *model.ServiceAccount) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.account = &account
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originAccount = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Inspect(f func(ctx context.Context, accessToken string, account *model.ServiceAccount)) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.CreateServiceAccount")
+	}
+
+	mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount = f
+
+	return mmCreateServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Return(sp1 *model.ServiceAccountCredentials, err error) *ServiceAccountServiceMock {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{mock: mmCreateServiceAccount.mock}
+	}
+	mmCreateServiceAccount.defaultExpectation.results = &ServiceAccountServiceMockCreateServiceAccountResults{sp1, err}
+	mmCreateServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.CreateServiceAccount method
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Set(f func(ctx context.Context, accessToken string, account *model.ServiceAccount) (sp1 *model.ServiceAccountCredentials, err error)) *ServiceAccountServiceMock {
+	if mmCreateServiceAccount.defaultExpectation != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.CreateServiceAccount method")
+	}
+
+	if len(mmCreateServiceAccount.expectations) > 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.CreateServiceAccount method")
+	}
+
+	mmCreateServiceAccount.mock.funcCreateServiceAccount = f
+	mmCreateServiceAccount.mock.funcCreateServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountService.CreateServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) When(ctx context.Context, accessToken string, account *model.ServiceAccount) *ServiceAccountServiceMockCreateServiceAccountExpectation {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockCreateServiceAccountExpectation{
+		mock:               mmCreateServiceAccount.mock,
+		params:             &ServiceAccountServiceMockCreateServiceAccountParams{ctx, accessToken, account},
+		expectationOrigins: ServiceAccountServiceMockCreateServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateServiceAccount.expectations = append(mmCreateServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.CreateServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockCreateServiceAccountExpectation) Then(sp1 *model.ServiceAccountCredentials, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockCreateServiceAccountResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.CreateServiceAccount should be invoked
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Times(n uint64) *mServiceAccountServiceMockCreateServiceAccount {
+	if n == 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Times of ServiceAccountServiceMock.CreateServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateServiceAccount.expectedInvocations, n)
+	mmCreateServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount
+}
+
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) invocationsDone() bool {
+	if len(mmCreateServiceAccount.expectations) == 0 && mmCreateServiceAccount.defaultExpectation == nil && mmCreateServiceAccount.mock.funcCreateServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.mock.afterCreateServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateServiceAccount implements mm_service.ServiceAccountService
+func (mmCreateServiceAccount *ServiceAccountServiceMock) CreateServiceAccount(ctx context.Context, accessToken string, account *model.ServiceAccount) (sp1 *model.ServiceAccountCredentials, err error) {
+	mm_atomic.AddUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter, 1)
+
+	mmCreateServiceAccount.t.Helper()
+
+	if mmCreateServiceAccount.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.inspectFuncCreateServiceAccount(ctx, accessToken, account)
+	}
+
+	mm_params := ServiceAccountServiceMockCreateServiceAccountParams{ctx, accessToken, account}
+
+	// Record call args
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Lock()
+	mmCreateServiceAccount.CreateServiceAccountMock.callArgs = append(mmCreateServiceAccount.CreateServiceAccountMock.callArgs, &mm_params)
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmCreateServiceAccount.CreateServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockCreateServiceAccountParams{ctx, accessToken, account}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.account != nil && !minimock.Equal(*mm_want_ptrs.account, mm_got.account) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter account, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originAccount, *mm_want_ptrs.account, mm_got.account, minimock.Diff(*mm_want_ptrs.account, mm_got.account))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateServiceAccount.t.Fatal("No results are set for the ServiceAccountServiceMock.CreateServiceAccount")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmCreateServiceAccount.funcCreateServiceAccount != nil {
+		return mmCreateServiceAccount.funcCreateServiceAccount(ctx, accessToken, account)
+	}
+	mmCreateServiceAccount.t.Fatalf("Unexpected call to ServiceAccountServiceMock.CreateServiceAccount. %v %v %v", ctx, accessToken, account)
+	return
+}
+
+// CreateServiceAccountAfterCounter returns a count of finished ServiceAccountServiceMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountServiceMock) CreateServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter)
+}
+
+// CreateServiceAccountBeforeCounter returns a count of ServiceAccountServiceMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountServiceMock) CreateServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.CreateServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Calls() []*ServiceAccountServiceMockCreateServiceAccountParams {
+	mmCreateServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockCreateServiceAccountParams, len(mmCreateServiceAccount.callArgs))
+	copy(argCopy, mmCreateServiceAccount.callArgs)
+
+	mmCreateServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateServiceAccountDone returns true if the count of the CreateServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockCreateServiceAccountDone() bool {
+	if m.CreateServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateServiceAccountMock.invocationsDone()
+}
+
+// MinimockCreateServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockCreateServiceAccountInspect() {
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateServiceAccountCounter := mm_atomic.LoadUint64(&m.afterCreateServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateServiceAccountMock.defaultExpectation != nil && afterCreateServiceAccountCounter < 1 {
+		if m.CreateServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s", m.CreateServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s with params: %#v", m.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.CreateServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateServiceAccount != nil && afterCreateServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s", m.funcCreateServiceAccountOrigin)
+	}
+
+	if !m.CreateServiceAccountMock.invocationsDone() && afterCreateServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.CreateServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateServiceAccountMock.expectedInvocations), m.CreateServiceAccountMock.expectedInvocationsOrigin, afterCreateServiceAccountCounter)
+	}
+}
+
+type mServiceAccountServiceMockDisableServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockDisableServiceAccountExpectation
+	expectations       []*ServiceAccountServiceMockDisableServiceAccountExpectation
+
+	callArgs []*ServiceAccountServiceMockDisableServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockDisableServiceAccountExpectation specifies expectation struct of the ServiceAccountService.DisableServiceAccount
+type ServiceAccountServiceMockDisableServiceAccountExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockDisableServiceAccountParams
+	paramPtrs          *ServiceAccountServiceMockDisableServiceAccountParamPtrs
+	expectationOrigins ServiceAccountServiceMockDisableServiceAccountExpectationOrigins
+	results            *ServiceAccountServiceMockDisableServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockDisableServiceAccountParams contains parameters of the ServiceAccountService.DisableServiceAccount
+type ServiceAccountServiceMockDisableServiceAccountParams struct {
+	ctx         context.Context
+	accessToken string
+	clientID    string
+}
+
+// ServiceAccountServiceMockDisableServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountService.DisableServiceAccount
+type ServiceAccountServiceMockDisableServiceAccountParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	clientID    *string
+}
+
+// ServiceAccountServiceMockDisableServiceAccountResults contains results of the ServiceAccountService.DisableServiceAccount
+type ServiceAccountServiceMockDisableServiceAccountResults struct {
+	err error
+}
+
+// ServiceAccountServiceMockDisableServiceAccountOrigins contains origins of expectations of the ServiceAccountService.DisableServiceAccount
+type ServiceAccountServiceMockDisableServiceAccountExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originClientID    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Optional() *mServiceAccountServiceMockDisableServiceAccount {
+	mmDisableServiceAccount.optional = true
+	return mmDisableServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountService.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Expect(ctx context.Context, accessToken string, clientID string) *mServiceAccountServiceMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountServiceMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmDisableServiceAccount.defaultExpectation.params = &ServiceAccountServiceMockDisableServiceAccountParams{ctx, accessToken, clientID}
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDisableServiceAccount.expectations {
+		if minimock.Equal(e.params, mmDisableServiceAccount.defaultExpectation.params) {
+			mmDisableServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDisableServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmDisableServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountServiceMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.params != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Expect")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDisableServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDisableServiceAccountParamPtrs{}
+	}
+	mmDisableServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDisableServiceAccount
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ServiceAccountService.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) ExpectAccessTokenParam2(accessToken string) *mServiceAccountServiceMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountServiceMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.params != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Expect")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDisableServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDisableServiceAccountParamPtrs{}
+	}
+	mmDisableServiceAccount.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmDisableServiceAccount
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for ServiceAccountService.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) ExpectClientIDParam3(clientID string) *mServiceAccountServiceMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountServiceMockDisableServiceAccountExpectation{}
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.params != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Expect")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDisableServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDisableServiceAccountParamPtrs{}
+	}
+	mmDisableServiceAccount.defaultExpectation.paramPtrs.clientID = &clientID
+	mmDisableServiceAccount.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmDisableServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Inspect(f func(ctx context.Context, accessToken string, clientID string)) *mServiceAccountServiceMockDisableServiceAccount {
+	if mmDisableServiceAccount.mock.inspectFuncDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.DisableServiceAccount")
+	}
+
+	mmDisableServiceAccount.mock.inspectFuncDisableServiceAccount = f
+
+	return mmDisableServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountService.DisableServiceAccount
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Return(err error) *ServiceAccountServiceMock {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	if mmDisableServiceAccount.defaultExpectation == nil {
+		mmDisableServiceAccount.defaultExpectation = &ServiceAccountServiceMockDisableServiceAccountExpectation{mock: mmDisableServiceAccount.mock}
+	}
+	mmDisableServiceAccount.defaultExpectation.results = &ServiceAccountServiceMockDisableServiceAccountResults{err}
+	mmDisableServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDisableServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.DisableServiceAccount method
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Set(f func(ctx context.Context, accessToken string, clientID string) (err error)) *ServiceAccountServiceMock {
+	if mmDisableServiceAccount.defaultExpectation != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.DisableServiceAccount method")
+	}
+
+	if len(mmDisableServiceAccount.expectations) > 0 {
+		mmDisableServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.DisableServiceAccount method")
+	}
+
+	mmDisableServiceAccount.mock.funcDisableServiceAccount = f
+	mmDisableServiceAccount.mock.funcDisableServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmDisableServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountService.DisableServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) When(ctx context.Context, accessToken string, clientID string) *ServiceAccountServiceMockDisableServiceAccountExpectation {
+	if mmDisableServiceAccount.mock.funcDisableServiceAccount != nil {
+		mmDisableServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DisableServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockDisableServiceAccountExpectation{
+		mock:               mmDisableServiceAccount.mock,
+		params:             &ServiceAccountServiceMockDisableServiceAccountParams{ctx, accessToken, clientID},
+		expectationOrigins: ServiceAccountServiceMockDisableServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDisableServiceAccount.expectations = append(mmDisableServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.DisableServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockDisableServiceAccountExpectation) Then(err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockDisableServiceAccountResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.DisableServiceAccount should be invoked
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Times(n uint64) *mServiceAccountServiceMockDisableServiceAccount {
+	if n == 0 {
+		mmDisableServiceAccount.mock.t.Fatalf("Times of ServiceAccountServiceMock.DisableServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDisableServiceAccount.expectedInvocations, n)
+	mmDisableServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDisableServiceAccount
+}
+
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) invocationsDone() bool {
+	if len(mmDisableServiceAccount.expectations) == 0 && mmDisableServiceAccount.defaultExpectation == nil && mmDisableServiceAccount.mock.funcDisableServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDisableServiceAccount.mock.afterDisableServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDisableServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DisableServiceAccount implements mm_service.ServiceAccountService
+func (mmDisableServiceAccount *ServiceAccountServiceMock) DisableServiceAccount(ctx context.Context, accessToken string, clientID string) (err error) {
+	mm_atomic.AddUint64(&mmDisableServiceAccount.beforeDisableServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmDisableServiceAccount.afterDisableServiceAccountCounter, 1)
+
+	mmDisableServiceAccount.t.Helper()
+
+	if mmDisableServiceAccount.inspectFuncDisableServiceAccount != nil {
+		mmDisableServiceAccount.inspectFuncDisableServiceAccount(ctx, accessToken, clientID)
+	}
+
+	mm_params := ServiceAccountServiceMockDisableServiceAccountParams{ctx, accessToken, clientID}
+
+	// Record call args
+	mmDisableServiceAccount.DisableServiceAccountMock.mutex.Lock()
+	mmDisableServiceAccount.DisableServiceAccountMock.callArgs = append(mmDisableServiceAccount.DisableServiceAccountMock.callArgs, &mm_params)
+	mmDisableServiceAccount.DisableServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmDisableServiceAccount.DisableServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockDisableServiceAccountParams{ctx, accessToken, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDisableServiceAccount.t.Errorf("ServiceAccountServiceMock.DisableServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmDisableServiceAccount.t.Errorf("ServiceAccountServiceMock.DisableServiceAccount got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmDisableServiceAccount.t.Errorf("ServiceAccountServiceMock.DisableServiceAccount got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDisableServiceAccount.t.Errorf("ServiceAccountServiceMock.DisableServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDisableServiceAccount.DisableServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDisableServiceAccount.t.Fatal("No results are set for the ServiceAccountServiceMock.DisableServiceAccount")
+		}
+		return (*mm_results).err
+	}
+	if mmDisableServiceAccount.funcDisableServiceAccount != nil {
+		return mmDisableServiceAccount.funcDisableServiceAccount(ctx, accessToken, clientID)
+	}
+	mmDisableServiceAccount.t.Fatalf("Unexpected call to ServiceAccountServiceMock.DisableServiceAccount. %v %v %v", ctx, accessToken, clientID)
+	return
+}
+
+// DisableServiceAccountAfterCounter returns a count of finished ServiceAccountServiceMock.DisableServiceAccount invocations
+func (mmDisableServiceAccount *ServiceAccountServiceMock) DisableServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableServiceAccount.afterDisableServiceAccountCounter)
+}
+
+// DisableServiceAccountBeforeCounter returns a count of ServiceAccountServiceMock.DisableServiceAccount invocations
+func (mmDisableServiceAccount *ServiceAccountServiceMock) DisableServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableServiceAccount.beforeDisableServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.DisableServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDisableServiceAccount *mServiceAccountServiceMockDisableServiceAccount) Calls() []*ServiceAccountServiceMockDisableServiceAccountParams {
+	mmDisableServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockDisableServiceAccountParams, len(mmDisableServiceAccount.callArgs))
+	copy(argCopy, mmDisableServiceAccount.callArgs)
+
+	mmDisableServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDisableServiceAccountDone returns true if the count of the DisableServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockDisableServiceAccountDone() bool {
+	if m.DisableServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DisableServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DisableServiceAccountMock.invocationsDone()
+}
+
+// MinimockDisableServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockDisableServiceAccountInspect() {
+	for _, e := range m.DisableServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DisableServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDisableServiceAccountCounter := mm_atomic.LoadUint64(&m.afterDisableServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DisableServiceAccountMock.defaultExpectation != nil && afterDisableServiceAccountCounter < 1 {
+		if m.DisableServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DisableServiceAccount at\n%s", m.DisableServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DisableServiceAccount at\n%s with params: %#v", m.DisableServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.DisableServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDisableServiceAccount != nil && afterDisableServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.DisableServiceAccount at\n%s", m.funcDisableServiceAccountOrigin)
+	}
+
+	if !m.DisableServiceAccountMock.invocationsDone() && afterDisableServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.DisableServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DisableServiceAccountMock.expectedInvocations), m.DisableServiceAccountMock.expectedInvocationsOrigin, afterDisableServiceAccountCounter)
+	}
+}
+
+type mServiceAccountServiceMockListServiceAccounts struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockListServiceAccountsExpectation
+	expectations       []*ServiceAccountServiceMockListServiceAccountsExpectation
+
+	callArgs []*ServiceAccountServiceMockListServiceAccountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockListServiceAccountsExpectation specifies expectation struct of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockListServiceAccountsParams
+	paramPtrs          *ServiceAccountServiceMockListServiceAccountsParamPtrs
+	expectationOrigins ServiceAccountServiceMockListServiceAccountsExpectationOrigins
+	results            *ServiceAccountServiceMockListServiceAccountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockListServiceAccountsParams contains parameters of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// ServiceAccountServiceMockListServiceAccountsParamPtrs contains pointers to parameters of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// ServiceAccountServiceMockListServiceAccountsResults contains results of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsResults struct {
+	spa1 []*model.ServiceAccount
+	err  error
+}
+
+// ServiceAccountServiceMockListServiceAccountsOrigins contains origins of expectations of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Optional() *mServiceAccountServiceMockListServiceAccounts {
+	mmListServiceAccounts.optional = true
+	return mmListServiceAccounts
+}
+
+// Expect sets up expected params for ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Expect(ctx context.Context, accessToken string) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by ExpectParams functions")
+	}
+
+	mmListServiceAccounts.defaultExpectation.params = &ServiceAccountServiceMockListServiceAccountsParams{ctx, accessToken}
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListServiceAccounts.expectations {
+		if minimock.Equal(e.params, mmListServiceAccounts.defaultExpectation.params) {
+			mmListServiceAccounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListServiceAccounts.defaultExpectation.params)
+		}
+	}
+
+	return mmListServiceAccounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.params != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Expect")
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs == nil {
+		mmListServiceAccounts.defaultExpectation.paramPtrs = &ServiceAccountServiceMockListServiceAccountsParamPtrs{}
+	}
+	mmListServiceAccounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListServiceAccounts
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) ExpectAccessTokenParam2(accessToken string) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.params != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Expect")
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs == nil {
+		mmListServiceAccounts.defaultExpectation.paramPtrs = &ServiceAccountServiceMockListServiceAccountsParamPtrs{}
+	}
+	mmListServiceAccounts.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmListServiceAccounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Inspect(f func(ctx context.Context, accessToken string)) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.ListServiceAccounts")
+	}
+
+	mmListServiceAccounts.mock.inspectFuncListServiceAccounts = f
+
+	return mmListServiceAccounts
+}
+
+// Return sets up results that will be returned by ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Return(spa1 []*model.ServiceAccount, err error) *ServiceAccountServiceMock {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{mock: mmListServiceAccounts.mock}
+	}
+	mmListServiceAccounts.defaultExpectation.results = &ServiceAccountServiceMockListServiceAccountsResults{spa1, err}
+	mmListServiceAccounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.ListServiceAccounts method
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Set(f func(ctx context.Context, accessToken string) (spa1 []*model.ServiceAccount, err error)) *ServiceAccountServiceMock {
+	if mmListServiceAccounts.defaultExpectation != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.ListServiceAccounts method")
+	}
+
+	if len(mmListServiceAccounts.expectations) > 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.ListServiceAccounts method")
+	}
+
+	mmListServiceAccounts.mock.funcListServiceAccounts = f
+	mmListServiceAccounts.mock.funcListServiceAccountsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// When sets expectation for the ServiceAccountService.ListServiceAccounts which will trigger the result defined by the following
+// Then helper
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) When(ctx context.Context, accessToken string) *ServiceAccountServiceMockListServiceAccountsExpectation {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockListServiceAccountsExpectation{
+		mock:               mmListServiceAccounts.mock,
+		params:             &ServiceAccountServiceMockListServiceAccountsParams{ctx, accessToken},
+		expectationOrigins: ServiceAccountServiceMockListServiceAccountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListServiceAccounts.expectations = append(mmListServiceAccounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.ListServiceAccounts return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockListServiceAccountsExpectation) Then(spa1 []*model.ServiceAccount, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockListServiceAccountsResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.ListServiceAccounts should be invoked
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Times(n uint64) *mServiceAccountServiceMockListServiceAccounts {
+	if n == 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Times of ServiceAccountServiceMock.ListServiceAccounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListServiceAccounts.expectedInvocations, n)
+	mmListServiceAccounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts
+}
+
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) invocationsDone() bool {
+	if len(mmListServiceAccounts.expectations) == 0 && mmListServiceAccounts.defaultExpectation == nil && mmListServiceAccounts.mock.funcListServiceAccounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.mock.afterListServiceAccountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListServiceAccounts implements mm_service.ServiceAccountService
+func (mmListServiceAccounts *ServiceAccountServiceMock) ListServiceAccounts(ctx context.Context, accessToken string) (spa1 []*model.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListServiceAccounts.afterListServiceAccountsCounter, 1)
+
+	mmListServiceAccounts.t.Helper()
+
+	if mmListServiceAccounts.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.inspectFuncListServiceAccounts(ctx, accessToken)
+	}
+
+	mm_params := ServiceAccountServiceMockListServiceAccountsParams{ctx, accessToken}
+
+	// Record call args
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Lock()
+	mmListServiceAccounts.ListServiceAccountsMock.callArgs = append(mmListServiceAccounts.ListServiceAccountsMock.callArgs, &mm_params)
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Unlock()
+
+	for _, e := range mmListServiceAccounts.ListServiceAccountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.params
+		mm_want_ptrs := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockListServiceAccountsParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListServiceAccounts.t.Errorf("ServiceAccountServiceMock.ListServiceAccounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListServiceAccounts.t.Errorf("ServiceAccountServiceMock.ListServiceAccounts got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListServiceAccounts.t.Errorf("ServiceAccountServiceMock.ListServiceAccounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListServiceAccounts.t.Fatal("No results are set for the ServiceAccountServiceMock.ListServiceAccounts")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListServiceAccounts.funcListServiceAccounts != nil {
+		return mmListServiceAccounts.funcListServiceAccounts(ctx, accessToken)
+	}
+	mmListServiceAccounts.t.Fatalf("Unexpected call to ServiceAccountServiceMock.ListServiceAccounts. %v %v", ctx, accessToken)
+	return
+}
+
+// ListServiceAccountsAfterCounter returns a count of finished ServiceAccountServiceMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountServiceMock) ListServiceAccountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.afterListServiceAccountsCounter)
+}
+
+// ListServiceAccountsBeforeCounter returns a count of ServiceAccountServiceMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountServiceMock) ListServiceAccountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.ListServiceAccounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Calls() []*ServiceAccountServiceMockListServiceAccountsParams {
+	mmListServiceAccounts.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockListServiceAccountsParams, len(mmListServiceAccounts.callArgs))
+	copy(argCopy, mmListServiceAccounts.callArgs)
+
+	mmListServiceAccounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListServiceAccountsDone returns true if the count of the ListServiceAccounts invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockListServiceAccountsDone() bool {
+	if m.ListServiceAccountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListServiceAccountsMock.invocationsDone()
+}
+
+// MinimockListServiceAccountsInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockListServiceAccountsInspect() {
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListServiceAccountsCounter := mm_atomic.LoadUint64(&m.afterListServiceAccountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListServiceAccountsMock.defaultExpectation != nil && afterListServiceAccountsCounter < 1 {
+		if m.ListServiceAccountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s", m.ListServiceAccountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s with params: %#v", m.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *m.ListServiceAccountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListServiceAccounts != nil && afterListServiceAccountsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s", m.funcListServiceAccountsOrigin)
+	}
+
+	if !m.ListServiceAccountsMock.invocationsDone() && afterListServiceAccountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.ListServiceAccounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListServiceAccountsMock.expectedInvocations), m.ListServiceAccountsMock.expectedInvocationsOrigin, afterListServiceAccountsCounter)
+	}
+}
+
+type mServiceAccountServiceMockRotateServiceAccountSecret struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockRotateServiceAccountSecretExpectation
+	expectations       []*ServiceAccountServiceMockRotateServiceAccountSecretExpectation
+
+	callArgs []*ServiceAccountServiceMockRotateServiceAccountSecretParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockRotateServiceAccountSecretExpectation specifies expectation struct of the ServiceAccountService.RotateServiceAccountSecret
+type ServiceAccountServiceMockRotateServiceAccountSecretExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockRotateServiceAccountSecretParams
+	paramPtrs          *ServiceAccountServiceMockRotateServiceAccountSecretParamPtrs
+	expectationOrigins ServiceAccountServiceMockRotateServiceAccountSecretExpectationOrigins
+	results            *ServiceAccountServiceMockRotateServiceAccountSecretResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockRotateServiceAccountSecretParams contains parameters of the ServiceAccountService.RotateServiceAccountSecret
+type ServiceAccountServiceMockRotateServiceAccountSecretParams struct {
+	ctx         context.Context
+	accessToken string
+	clientID    string
+}
+
+// ServiceAccountServiceMockRotateServiceAccountSecretParamPtrs contains pointers to parameters of the ServiceAccountService.RotateServiceAccountSecret
+type ServiceAccountServiceMockRotateServiceAccountSecretParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	clientID    *string
+}
+
+// ServiceAccountServiceMockRotateServiceAccountSecretResults contains results of the ServiceAccountService.RotateServiceAccountSecret
+type ServiceAccountServiceMockRotateServiceAccountSecretResults struct {
+	sp1 *model.ServiceAccountCredentials
+	err error
+}
+
+// ServiceAccountServiceMockRotateServiceAccountSecretOrigins contains origins of expectations of the ServiceAccountService.RotateServiceAccountSecret
+type ServiceAccountServiceMockRotateServiceAccountSecretExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originClientID    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Optional() *mServiceAccountServiceMockRotateServiceAccountSecret {
+	mmRotateServiceAccountSecret.optional = true
+	return mmRotateServiceAccountSecret
+}
+
+// Expect sets up expected params for ServiceAccountService.RotateServiceAccountSecret
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Expect(ctx context.Context, accessToken string, clientID string) *mServiceAccountServiceMockRotateServiceAccountSecret {
+	if mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation == nil {
+		mmRotateServiceAccountSecret.defaultExpectation = &ServiceAccountServiceMockRotateServiceAccountSecretExpectation{}
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.paramPtrs != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by ExpectParams functions")
+	}
+
+	mmRotateServiceAccountSecret.defaultExpectation.params = &ServiceAccountServiceMockRotateServiceAccountSecretParams{ctx, accessToken, clientID}
+	mmRotateServiceAccountSecret.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRotateServiceAccountSecret.expectations {
+		if minimock.Equal(e.params, mmRotateServiceAccountSecret.defaultExpectation.params) {
+			mmRotateServiceAccountSecret.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRotateServiceAccountSecret.defaultExpectation.params)
+		}
+	}
+
+	return mmRotateServiceAccountSecret
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.RotateServiceAccountSecret
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockRotateServiceAccountSecret {
+	if mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation == nil {
+		mmRotateServiceAccountSecret.defaultExpectation = &ServiceAccountServiceMockRotateServiceAccountSecretExpectation{}
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.params != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Expect")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.paramPtrs == nil {
+		mmRotateServiceAccountSecret.defaultExpectation.paramPtrs = &ServiceAccountServiceMockRotateServiceAccountSecretParamPtrs{}
+	}
+	mmRotateServiceAccountSecret.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRotateServiceAccountSecret.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRotateServiceAccountSecret
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for ServiceAccountService.RotateServiceAccountSecret
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) ExpectAccessTokenParam2(accessToken string) *mServiceAccountServiceMockRotateServiceAccountSecret {
+	if mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation == nil {
+		mmRotateServiceAccountSecret.defaultExpectation = &ServiceAccountServiceMockRotateServiceAccountSecretExpectation{}
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.params != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Expect")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.paramPtrs == nil {
+		mmRotateServiceAccountSecret.defaultExpectation.paramPtrs = &ServiceAccountServiceMockRotateServiceAccountSecretParamPtrs{}
+	}
+	mmRotateServiceAccountSecret.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmRotateServiceAccountSecret.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmRotateServiceAccountSecret
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for ServiceAccountService.RotateServiceAccountSecret
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) ExpectClientIDParam3(clientID string) *mServiceAccountServiceMockRotateServiceAccountSecret {
+	if mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation == nil {
+		mmRotateServiceAccountSecret.defaultExpectation = &ServiceAccountServiceMockRotateServiceAccountSecretExpectation{}
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.params != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Expect")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation.paramPtrs == nil {
+		mmRotateServiceAccountSecret.defaultExpectation.paramPtrs = &ServiceAccountServiceMockRotateServiceAccountSecretParamPtrs{}
+	}
+	mmRotateServiceAccountSecret.defaultExpectation.paramPtrs.clientID = &clientID
+	mmRotateServiceAccountSecret.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmRotateServiceAccountSecret
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.RotateServiceAccountSecret
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Inspect(f func(ctx context.Context, accessToken string, clientID string)) *mServiceAccountServiceMockRotateServiceAccountSecret {
+	if mmRotateServiceAccountSecret.mock.inspectFuncRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.RotateServiceAccountSecret")
+	}
+
+	mmRotateServiceAccountSecret.mock.inspectFuncRotateServiceAccountSecret = f
+
+	return mmRotateServiceAccountSecret
+}
+
+// Return sets up results that will be returned by ServiceAccountService.RotateServiceAccountSecret
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Return(sp1 *model.ServiceAccountCredentials, err error) *ServiceAccountServiceMock {
+	if mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Set")
+	}
+
+	if mmRotateServiceAccountSecret.defaultExpectation == nil {
+		mmRotateServiceAccountSecret.defaultExpectation = &ServiceAccountServiceMockRotateServiceAccountSecretExpectation{mock: mmRotateServiceAccountSecret.mock}
+	}
+	mmRotateServiceAccountSecret.defaultExpectation.results = &ServiceAccountServiceMockRotateServiceAccountSecretResults{sp1, err}
+	mmRotateServiceAccountSecret.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRotateServiceAccountSecret.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.RotateServiceAccountSecret method
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Set(f func(ctx context.Context, accessToken string, clientID string) (sp1 *model.ServiceAccountCredentials, err error)) *ServiceAccountServiceMock {
+	if mmRotateServiceAccountSecret.defaultExpectation != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.RotateServiceAccountSecret method")
+	}
+
+	if len(mmRotateServiceAccountSecret.expectations) > 0 {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.RotateServiceAccountSecret method")
+	}
+
+	mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret = f
+	mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecretOrigin = minimock.CallerInfo(1)
+	return mmRotateServiceAccountSecret.mock
+}
+
+// When sets expectation for the ServiceAccountService.RotateServiceAccountSecret which will trigger the result defined by the following
+// Then helper
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) When(ctx context.Context, accessToken string, clientID string) *ServiceAccountServiceMockRotateServiceAccountSecretExpectation {
+	if mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("ServiceAccountServiceMock.RotateServiceAccountSecret mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockRotateServiceAccountSecretExpectation{
+		mock:               mmRotateServiceAccountSecret.mock,
+		params:             &ServiceAccountServiceMockRotateServiceAccountSecretParams{ctx, accessToken, clientID},
+		expectationOrigins: ServiceAccountServiceMockRotateServiceAccountSecretExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRotateServiceAccountSecret.expectations = append(mmRotateServiceAccountSecret.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.RotateServiceAccountSecret return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockRotateServiceAccountSecretExpectation) Then(sp1 *model.ServiceAccountCredentials, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockRotateServiceAccountSecretResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.RotateServiceAccountSecret should be invoked
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Times(n uint64) *mServiceAccountServiceMockRotateServiceAccountSecret {
+	if n == 0 {
+		mmRotateServiceAccountSecret.mock.t.Fatalf("Times of ServiceAccountServiceMock.RotateServiceAccountSecret mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRotateServiceAccountSecret.expectedInvocations, n)
+	mmRotateServiceAccountSecret.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRotateServiceAccountSecret
+}
+
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) invocationsDone() bool {
+	if len(mmRotateServiceAccountSecret.expectations) == 0 && mmRotateServiceAccountSecret.defaultExpectation == nil && mmRotateServiceAccountSecret.mock.funcRotateServiceAccountSecret == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRotateServiceAccountSecret.mock.afterRotateServiceAccountSecretCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRotateServiceAccountSecret.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RotateServiceAccountSecret implements mm_service.ServiceAccountService
+func (mmRotateServiceAccountSecret *ServiceAccountServiceMock) RotateServiceAccountSecret(ctx context.Context, accessToken string, clientID string) (sp1 *model.ServiceAccountCredentials, err error) {
+	mm_atomic.AddUint64(&mmRotateServiceAccountSecret.beforeRotateServiceAccountSecretCounter, 1)
+	defer mm_atomic.AddUint64(&mmRotateServiceAccountSecret.afterRotateServiceAccountSecretCounter, 1)
+
+	mmRotateServiceAccountSecret.t.Helper()
+
+	if mmRotateServiceAccountSecret.inspectFuncRotateServiceAccountSecret != nil {
+		mmRotateServiceAccountSecret.inspectFuncRotateServiceAccountSecret(ctx, accessToken, clientID)
+	}
+
+	mm_params := ServiceAccountServiceMockRotateServiceAccountSecretParams{ctx, accessToken, clientID}
+
+	// Record call args
+	mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.mutex.Lock()
+	mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.callArgs = append(mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.callArgs, &mm_params)
+	mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.mutex.Unlock()
+
+	for _, e := range mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.Counter, 1)
+		mm_want := mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.params
+		mm_want_ptrs := mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockRotateServiceAccountSecretParams{ctx, accessToken, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRotateServiceAccountSecret.t.Errorf("ServiceAccountServiceMock.RotateServiceAccountSecret got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmRotateServiceAccountSecret.t.Errorf("ServiceAccountServiceMock.RotateServiceAccountSecret got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmRotateServiceAccountSecret.t.Errorf("ServiceAccountServiceMock.RotateServiceAccountSecret got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRotateServiceAccountSecret.t.Errorf("ServiceAccountServiceMock.RotateServiceAccountSecret got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRotateServiceAccountSecret.RotateServiceAccountSecretMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRotateServiceAccountSecret.t.Fatal("No results are set for the ServiceAccountServiceMock.RotateServiceAccountSecret")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmRotateServiceAccountSecret.funcRotateServiceAccountSecret != nil {
+		return mmRotateServiceAccountSecret.funcRotateServiceAccountSecret(ctx, accessToken, clientID)
+	}
+	mmRotateServiceAccountSecret.t.Fatalf("Unexpected call to ServiceAccountServiceMock.RotateServiceAccountSecret. %v %v %v", ctx, accessToken, clientID)
+	return
+}
+
+// RotateServiceAccountSecretAfterCounter returns a count of finished ServiceAccountServiceMock.RotateServiceAccountSecret invocations
+func (mmRotateServiceAccountSecret *ServiceAccountServiceMock) RotateServiceAccountSecretAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateServiceAccountSecret.afterRotateServiceAccountSecretCounter)
+}
+
+// RotateServiceAccountSecretBeforeCounter returns a count of ServiceAccountServiceMock.RotateServiceAccountSecret invocations
+func (mmRotateServiceAccountSecret *ServiceAccountServiceMock) RotateServiceAccountSecretBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateServiceAccountSecret.beforeRotateServiceAccountSecretCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.RotateServiceAccountSecret.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRotateServiceAccountSecret *mServiceAccountServiceMockRotateServiceAccountSecret) Calls() []*ServiceAccountServiceMockRotateServiceAccountSecretParams {
+	mmRotateServiceAccountSecret.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockRotateServiceAccountSecretParams, len(mmRotateServiceAccountSecret.callArgs))
+	copy(argCopy, mmRotateServiceAccountSecret.callArgs)
+
+	mmRotateServiceAccountSecret.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRotateServiceAccountSecretDone returns true if the count of the RotateServiceAccountSecret invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockRotateServiceAccountSecretDone() bool {
+	if m.RotateServiceAccountSecretMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RotateServiceAccountSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RotateServiceAccountSecretMock.invocationsDone()
+}
+
+// MinimockRotateServiceAccountSecretInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockRotateServiceAccountSecretInspect() {
+	for _, e := range m.RotateServiceAccountSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.RotateServiceAccountSecret at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRotateServiceAccountSecretCounter := mm_atomic.LoadUint64(&m.afterRotateServiceAccountSecretCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RotateServiceAccountSecretMock.defaultExpectation != nil && afterRotateServiceAccountSecretCounter < 1 {
+		if m.RotateServiceAccountSecretMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.RotateServiceAccountSecret at\n%s", m.RotateServiceAccountSecretMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.RotateServiceAccountSecret at\n%s with params: %#v", m.RotateServiceAccountSecretMock.defaultExpectation.expectationOrigins.origin, *m.RotateServiceAccountSecretMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRotateServiceAccountSecret != nil && afterRotateServiceAccountSecretCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.RotateServiceAccountSecret at\n%s", m.funcRotateServiceAccountSecretOrigin)
+	}
+
+	if !m.RotateServiceAccountSecretMock.invocationsDone() && afterRotateServiceAccountSecretCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.RotateServiceAccountSecret at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RotateServiceAccountSecretMock.expectedInvocations), m.RotateServiceAccountSecretMock.expectedInvocationsOrigin, afterRotateServiceAccountSecretCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceAccountServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateServiceAccountInspect()
+
+			m.MinimockDisableServiceAccountInspect()
+
+			m.MinimockListServiceAccountsInspect()
+
+			m.MinimockRotateServiceAccountSecretInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceAccountServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceAccountServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateServiceAccountDone() &&
+		m.MinimockDisableServiceAccountDone() &&
+		m.MinimockListServiceAccountsDone() &&
+		m.MinimockRotateServiceAccountSecretDone()
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
@@ -19,6 +20,13 @@ type UserServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcBatchGetUsers          func(ctx context.Context, ids []int64) (upa1 []*model.User, ia1 []int64, err error)
+	funcBatchGetUsersOrigin    string
+	inspectFuncBatchGetUsers   func(ctx context.Context, ids []int64)
+	afterBatchGetUsersCounter  uint64
+	beforeBatchGetUsersCounter uint64
+	BatchGetUsersMock          mUserServiceMockBatchGetUsers
+
 	funcCreateUser          func(ctx context.Context, newUser *model.NewUser) (i1 int64, err error)
 	funcCreateUserOrigin    string
 	inspectFuncCreateUser   func(ctx context.Context, newUser *model.NewUser)
@@ -33,6 +41,20 @@ type UserServiceMock struct {
 	beforeDeleteUserCounter uint64
 	DeleteUserMock          mUserServiceMockDeleteUser
 
+	funcExportUsers          func(ctx context.Context, format string, w io.Writer) (err error)
+	funcExportUsersOrigin    string
+	inspectFuncExportUsers   func(ctx context.Context, format string, w io.Writer)
+	afterExportUsersCounter  uint64
+	beforeExportUsersCounter uint64
+	ExportUsersMock          mUserServiceMockExportUsers
+
+	funcGetOperation          func(ctx context.Context, id int64) (op1 *model.Operation, err error)
+	funcGetOperationOrigin    string
+	inspectFuncGetOperation   func(ctx context.Context, id int64)
+	afterGetOperationCounter  uint64
+	beforeGetOperationCounter uint64
+	GetOperationMock          mUserServiceMockGetOperation
+
 	funcGetUser          func(ctx context.Context, id int64) (up1 *model.User, err error)
 	funcGetUserOrigin    string
 	inspectFuncGetUser   func(ctx context.Context, id int64)
@@ -40,6 +62,34 @@ type UserServiceMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserServiceMockGetUser
 
+	funcGetUserByEmail          func(ctx context.Context, email string) (up1 *model.User, err error)
+	funcGetUserByEmailOrigin    string
+	inspectFuncGetUserByEmail   func(ctx context.Context, email string)
+	afterGetUserByEmailCounter  uint64
+	beforeGetUserByEmailCounter uint64
+	GetUserByEmailMock          mUserServiceMockGetUserByEmail
+
+	funcGetUserByName          func(ctx context.Context, name string) (up1 *model.User, err error)
+	funcGetUserByNameOrigin    string
+	inspectFuncGetUserByName   func(ctx context.Context, name string)
+	afterGetUserByNameCounter  uint64
+	beforeGetUserByNameCounter uint64
+	GetUserByNameMock          mUserServiceMockGetUserByName
+
+	funcImportUsers          func(ctx context.Context, options *model.ImportUsersOptions, data io.Reader) (op1 *model.Operation, err error)
+	funcImportUsersOrigin    string
+	inspectFuncImportUsers   func(ctx context.Context, options *model.ImportUsersOptions, data io.Reader)
+	afterImportUsersCounter  uint64
+	beforeImportUsersCounter uint64
+	ImportUsersMock          mUserServiceMockImportUsers
+
+	funcListUsers          func(ctx context.Context, afterID int64, limit uint64) (upa1 []*model.User, err error)
+	funcListUsersOrigin    string
+	inspectFuncListUsers   func(ctx context.Context, afterID int64, limit uint64)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserServiceMockListUsers
+
 	funcUpdateUser          func(ctx context.Context, updateUserInfo *model.UpdateUserInfo) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, updateUserInfo *model.UpdateUserInfo)
@@ -56,15 +106,36 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.BatchGetUsersMock = mUserServiceMockBatchGetUsers{mock: m}
+	m.BatchGetUsersMock.callArgs = []*UserServiceMockBatchGetUsersParams{}
+
 	m.CreateUserMock = mUserServiceMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*UserServiceMockCreateUserParams{}
 
 	m.DeleteUserMock = mUserServiceMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*UserServiceMockDeleteUserParams{}
 
+	m.ExportUsersMock = mUserServiceMockExportUsers{mock: m}
+	m.ExportUsersMock.callArgs = []*UserServiceMockExportUsersParams{}
+
+	m.GetOperationMock = mUserServiceMockGetOperation{mock: m}
+	m.GetOperationMock.callArgs = []*UserServiceMockGetOperationParams{}
+
 	m.GetUserMock = mUserServiceMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserServiceMockGetUserParams{}
 
+	m.GetUserByEmailMock = mUserServiceMockGetUserByEmail{mock: m}
+	m.GetUserByEmailMock.callArgs = []*UserServiceMockGetUserByEmailParams{}
+
+	m.GetUserByNameMock = mUserServiceMockGetUserByName{mock: m}
+	m.GetUserByNameMock.callArgs = []*UserServiceMockGetUserByNameParams{}
+
+	m.ImportUsersMock = mUserServiceMockImportUsers{mock: m}
+	m.ImportUsersMock.callArgs = []*UserServiceMockImportUsersParams{}
+
+	m.ListUsersMock = mUserServiceMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserServiceMockListUsersParams{}
+
 	m.UpdateUserMock = mUserServiceMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserServiceMockUpdateUserParams{}
 
@@ -73,6 +144,350 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	return m
 }
 
+type mUserServiceMockBatchGetUsers struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockBatchGetUsersExpectation
+	expectations       []*UserServiceMockBatchGetUsersExpectation
+
+	callArgs []*UserServiceMockBatchGetUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockBatchGetUsersExpectation specifies expectation struct of the UserService.BatchGetUsers
+type UserServiceMockBatchGetUsersExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockBatchGetUsersParams
+	paramPtrs          *UserServiceMockBatchGetUsersParamPtrs
+	expectationOrigins UserServiceMockBatchGetUsersExpectationOrigins
+	results            *UserServiceMockBatchGetUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockBatchGetUsersParams contains parameters of the UserService.BatchGetUsers
+type UserServiceMockBatchGetUsersParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// UserServiceMockBatchGetUsersParamPtrs contains pointers to parameters of the UserService.BatchGetUsers
+type UserServiceMockBatchGetUsersParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// UserServiceMockBatchGetUsersResults contains results of the UserService.BatchGetUsers
+type UserServiceMockBatchGetUsersResults struct {
+	upa1 []*model.User
+	ia1  []int64
+	err  error
+}
+
+// UserServiceMockBatchGetUsersOrigins contains origins of expectations of the UserService.BatchGetUsers
+type UserServiceMockBatchGetUsersExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Optional() *mUserServiceMockBatchGetUsers {
+	mmBatchGetUsers.optional = true
+	return mmBatchGetUsers
+}
+
+// Expect sets up expected params for UserService.BatchGetUsers
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Expect(ctx context.Context, ids []int64) *mUserServiceMockBatchGetUsers {
+	if mmBatchGetUsers.mock.funcBatchGetUsers != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Set")
+	}
+
+	if mmBatchGetUsers.defaultExpectation == nil {
+		mmBatchGetUsers.defaultExpectation = &UserServiceMockBatchGetUsersExpectation{}
+	}
+
+	if mmBatchGetUsers.defaultExpectation.paramPtrs != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by ExpectParams functions")
+	}
+
+	mmBatchGetUsers.defaultExpectation.params = &UserServiceMockBatchGetUsersParams{ctx, ids}
+	mmBatchGetUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBatchGetUsers.expectations {
+		if minimock.Equal(e.params, mmBatchGetUsers.defaultExpectation.params) {
+			mmBatchGetUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchGetUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmBatchGetUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.BatchGetUsers
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockBatchGetUsers {
+	if mmBatchGetUsers.mock.funcBatchGetUsers != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Set")
+	}
+
+	if mmBatchGetUsers.defaultExpectation == nil {
+		mmBatchGetUsers.defaultExpectation = &UserServiceMockBatchGetUsersExpectation{}
+	}
+
+	if mmBatchGetUsers.defaultExpectation.params != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Expect")
+	}
+
+	if mmBatchGetUsers.defaultExpectation.paramPtrs == nil {
+		mmBatchGetUsers.defaultExpectation.paramPtrs = &UserServiceMockBatchGetUsersParamPtrs{}
+	}
+	mmBatchGetUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBatchGetUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBatchGetUsers
+}
+
+// ExpectIdsParam2 sets up expected param ids for UserService.BatchGetUsers
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) ExpectIdsParam2(ids []int64) *mUserServiceMockBatchGetUsers {
+	if mmBatchGetUsers.mock.funcBatchGetUsers != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Set")
+	}
+
+	if mmBatchGetUsers.defaultExpectation == nil {
+		mmBatchGetUsers.defaultExpectation = &UserServiceMockBatchGetUsersExpectation{}
+	}
+
+	if mmBatchGetUsers.defaultExpectation.params != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Expect")
+	}
+
+	if mmBatchGetUsers.defaultExpectation.paramPtrs == nil {
+		mmBatchGetUsers.defaultExpectation.paramPtrs = &UserServiceMockBatchGetUsersParamPtrs{}
+	}
+	mmBatchGetUsers.defaultExpectation.paramPtrs.ids = &ids
+	mmBatchGetUsers.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmBatchGetUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.BatchGetUsers
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Inspect(f func(ctx context.Context, ids []int64)) *mUserServiceMockBatchGetUsers {
+	if mmBatchGetUsers.mock.inspectFuncBatchGetUsers != nil {
+		mmBatchGetUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.BatchGetUsers")
+	}
+
+	mmBatchGetUsers.mock.inspectFuncBatchGetUsers = f
+
+	return mmBatchGetUsers
+}
+
+// Return sets up results that will be returned by UserService.BatchGetUsers
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Return(upa1 []*model.User, ia1 []int64, err error) *UserServiceMock {
+	if mmBatchGetUsers.mock.funcBatchGetUsers != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Set")
+	}
+
+	if mmBatchGetUsers.defaultExpectation == nil {
+		mmBatchGetUsers.defaultExpectation = &UserServiceMockBatchGetUsersExpectation{mock: mmBatchGetUsers.mock}
+	}
+	mmBatchGetUsers.defaultExpectation.results = &UserServiceMockBatchGetUsersResults{upa1, ia1, err}
+	mmBatchGetUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBatchGetUsers.mock
+}
+
+// Set uses given function f to mock the UserService.BatchGetUsers method
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Set(f func(ctx context.Context, ids []int64) (upa1 []*model.User, ia1 []int64, err error)) *UserServiceMock {
+	if mmBatchGetUsers.defaultExpectation != nil {
+		mmBatchGetUsers.mock.t.Fatalf("Default expectation is already set for the UserService.BatchGetUsers method")
+	}
+
+	if len(mmBatchGetUsers.expectations) > 0 {
+		mmBatchGetUsers.mock.t.Fatalf("Some expectations are already set for the UserService.BatchGetUsers method")
+	}
+
+	mmBatchGetUsers.mock.funcBatchGetUsers = f
+	mmBatchGetUsers.mock.funcBatchGetUsersOrigin = minimock.CallerInfo(1)
+	return mmBatchGetUsers.mock
+}
+
+// When sets expectation for the UserService.BatchGetUsers which will trigger the result defined by the following
+// Then helper
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) When(ctx context.Context, ids []int64) *UserServiceMockBatchGetUsersExpectation {
+	if mmBatchGetUsers.mock.funcBatchGetUsers != nil {
+		mmBatchGetUsers.mock.t.Fatalf("UserServiceMock.BatchGetUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockBatchGetUsersExpectation{
+		mock:               mmBatchGetUsers.mock,
+		params:             &UserServiceMockBatchGetUsersParams{ctx, ids},
+		expectationOrigins: UserServiceMockBatchGetUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBatchGetUsers.expectations = append(mmBatchGetUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.BatchGetUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockBatchGetUsersExpectation) Then(upa1 []*model.User, ia1 []int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockBatchGetUsersResults{upa1, ia1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.BatchGetUsers should be invoked
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Times(n uint64) *mUserServiceMockBatchGetUsers {
+	if n == 0 {
+		mmBatchGetUsers.mock.t.Fatalf("Times of UserServiceMock.BatchGetUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBatchGetUsers.expectedInvocations, n)
+	mmBatchGetUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBatchGetUsers
+}
+
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) invocationsDone() bool {
+	if len(mmBatchGetUsers.expectations) == 0 && mmBatchGetUsers.defaultExpectation == nil && mmBatchGetUsers.mock.funcBatchGetUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBatchGetUsers.mock.afterBatchGetUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBatchGetUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BatchGetUsers implements mm_service.UserService
+func (mmBatchGetUsers *UserServiceMock) BatchGetUsers(ctx context.Context, ids []int64) (upa1 []*model.User, ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmBatchGetUsers.beforeBatchGetUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchGetUsers.afterBatchGetUsersCounter, 1)
+
+	mmBatchGetUsers.t.Helper()
+
+	if mmBatchGetUsers.inspectFuncBatchGetUsers != nil {
+		mmBatchGetUsers.inspectFuncBatchGetUsers(ctx, ids)
+	}
+
+	mm_params := UserServiceMockBatchGetUsersParams{ctx, ids}
+
+	// Record call args
+	mmBatchGetUsers.BatchGetUsersMock.mutex.Lock()
+	mmBatchGetUsers.BatchGetUsersMock.callArgs = append(mmBatchGetUsers.BatchGetUsersMock.callArgs, &mm_params)
+	mmBatchGetUsers.BatchGetUsersMock.mutex.Unlock()
+
+	for _, e := range mmBatchGetUsers.BatchGetUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.ia1, e.results.err
+		}
+	}
+
+	if mmBatchGetUsers.BatchGetUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockBatchGetUsersParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBatchGetUsers.t.Errorf("UserServiceMock.BatchGetUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmBatchGetUsers.t.Errorf("UserServiceMock.BatchGetUsers got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBatchGetUsers.t.Errorf("UserServiceMock.BatchGetUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBatchGetUsers.BatchGetUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBatchGetUsers.t.Fatal("No results are set for the UserServiceMock.BatchGetUsers")
+		}
+		return (*mm_results).upa1, (*mm_results).ia1, (*mm_results).err
+	}
+	if mmBatchGetUsers.funcBatchGetUsers != nil {
+		return mmBatchGetUsers.funcBatchGetUsers(ctx, ids)
+	}
+	mmBatchGetUsers.t.Fatalf("Unexpected call to UserServiceMock.BatchGetUsers. %v %v", ctx, ids)
+	return
+}
+
+// BatchGetUsersAfterCounter returns a count of finished UserServiceMock.BatchGetUsers invocations
+func (mmBatchGetUsers *UserServiceMock) BatchGetUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchGetUsers.afterBatchGetUsersCounter)
+}
+
+// BatchGetUsersBeforeCounter returns a count of UserServiceMock.BatchGetUsers invocations
+func (mmBatchGetUsers *UserServiceMock) BatchGetUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchGetUsers.beforeBatchGetUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.BatchGetUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBatchGetUsers *mUserServiceMockBatchGetUsers) Calls() []*UserServiceMockBatchGetUsersParams {
+	mmBatchGetUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockBatchGetUsersParams, len(mmBatchGetUsers.callArgs))
+	copy(argCopy, mmBatchGetUsers.callArgs)
+
+	mmBatchGetUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBatchGetUsersDone returns true if the count of the BatchGetUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockBatchGetUsersDone() bool {
+	if m.BatchGetUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BatchGetUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BatchGetUsersMock.invocationsDone()
+}
+
+// MinimockBatchGetUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockBatchGetUsersInspect() {
+	for _, e := range m.BatchGetUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.BatchGetUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBatchGetUsersCounter := mm_atomic.LoadUint64(&m.afterBatchGetUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchGetUsersMock.defaultExpectation != nil && afterBatchGetUsersCounter < 1 {
+		if m.BatchGetUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.BatchGetUsers at\n%s", m.BatchGetUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.BatchGetUsers at\n%s with params: %#v", m.BatchGetUsersMock.defaultExpectation.expectationOrigins.origin, *m.BatchGetUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchGetUsers != nil && afterBatchGetUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.BatchGetUsers at\n%s", m.funcBatchGetUsersOrigin)
+	}
+
+	if !m.BatchGetUsersMock.invocationsDone() && afterBatchGetUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.BatchGetUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BatchGetUsersMock.expectedInvocations), m.BatchGetUsersMock.expectedInvocationsOrigin, afterBatchGetUsersCounter)
+	}
+}
+
 type mUserServiceMockCreateUser struct {
 	optional           bool
 	mock               *UserServiceMock
@@ -758,53 +1173,55 @@ func (m *UserServiceMock) MinimockDeleteUserInspect() {
 	}
 }
 
-type mUserServiceMockGetUser struct {
+type mUserServiceMockExportUsers struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockGetUserExpectation
-	expectations       []*UserServiceMockGetUserExpectation
+	defaultExpectation *UserServiceMockExportUsersExpectation
+	expectations       []*UserServiceMockExportUsersExpectation
 
-	callArgs []*UserServiceMockGetUserParams
+	callArgs []*UserServiceMockExportUsersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockGetUserExpectation specifies expectation struct of the UserService.GetUser
-type UserServiceMockGetUserExpectation struct {
+// UserServiceMockExportUsersExpectation specifies expectation struct of the UserService.ExportUsers
+type UserServiceMockExportUsersExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockGetUserParams
-	paramPtrs          *UserServiceMockGetUserParamPtrs
-	expectationOrigins UserServiceMockGetUserExpectationOrigins
-	results            *UserServiceMockGetUserResults
+	params             *UserServiceMockExportUsersParams
+	paramPtrs          *UserServiceMockExportUsersParamPtrs
+	expectationOrigins UserServiceMockExportUsersExpectationOrigins
+	results            *UserServiceMockExportUsersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockGetUserParams contains parameters of the UserService.GetUser
-type UserServiceMockGetUserParams struct {
-	ctx context.Context
-	id  int64
+// UserServiceMockExportUsersParams contains parameters of the UserService.ExportUsers
+type UserServiceMockExportUsersParams struct {
+	ctx    context.Context
+	format string
+	w      io.Writer
 }
 
-// UserServiceMockGetUserParamPtrs contains pointers to parameters of the UserService.GetUser
-type UserServiceMockGetUserParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// UserServiceMockExportUsersParamPtrs contains pointers to parameters of the UserService.ExportUsers
+type UserServiceMockExportUsersParamPtrs struct {
+	ctx    *context.Context
+	format *string
+	w      *io.Writer
 }
 
-// UserServiceMockGetUserResults contains results of the UserService.GetUser
-type UserServiceMockGetUserResults struct {
-	up1 *model.User
+// UserServiceMockExportUsersResults contains results of the UserService.ExportUsers
+type UserServiceMockExportUsersResults struct {
 	err error
 }
 
-// UserServiceMockGetUserOrigins contains origins of expectations of the UserService.GetUser
-type UserServiceMockGetUserExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// UserServiceMockExportUsersOrigins contains origins of expectations of the UserService.ExportUsers
+type UserServiceMockExportUsersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFormat string
+	originW      string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -812,292 +1229,2440 @@ type UserServiceMockGetUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUser *mUserServiceMockGetUser) Optional() *mUserServiceMockGetUser {
-	mmGetUser.optional = true
-	return mmGetUser
+func (mmExportUsers *mUserServiceMockExportUsers) Optional() *mUserServiceMockExportUsers {
+	mmExportUsers.optional = true
+	return mmExportUsers
 }
 
-// Expect sets up expected params for UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) Expect(ctx context.Context, id int64) *mUserServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// Expect sets up expected params for UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) Expect(ctx context.Context, format string, w io.Writer) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by ExpectParams functions")
+	if mmExportUsers.defaultExpectation.paramPtrs != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by ExpectParams functions")
 	}
 
-	mmGetUser.defaultExpectation.params = &UserServiceMockGetUserParams{ctx, id}
-	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUser.expectations {
-		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
-			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+	mmExportUsers.defaultExpectation.params = &UserServiceMockExportUsersParams{ctx, format, w}
+	mmExportUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportUsers.expectations {
+		if minimock.Equal(e.params, mmExportUsers.defaultExpectation.params) {
+			mmExportUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportUsers.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUser
+	return mmExportUsers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	if mmExportUsers.defaultExpectation.params != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	if mmExportUsers.defaultExpectation.paramPtrs == nil {
+		mmExportUsers.defaultExpectation.paramPtrs = &UserServiceMockExportUsersParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmExportUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmExportUsers
 }
 
-// ExpectIdParam2 sets up expected param id for UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) ExpectIdParam2(id int64) *mUserServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// ExpectFormatParam2 sets up expected param format for UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) ExpectFormatParam2(format string) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	if mmExportUsers.defaultExpectation.params != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	if mmExportUsers.defaultExpectation.paramPtrs == nil {
+		mmExportUsers.defaultExpectation.paramPtrs = &UserServiceMockExportUsersParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.id = &id
-	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmExportUsers.defaultExpectation.paramPtrs.format = &format
+	mmExportUsers.defaultExpectation.expectationOrigins.originFormat = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmExportUsers
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockGetUser {
-	if mmGetUser.mock.inspectFuncGetUser != nil {
-		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUser")
+// ExpectWParam3 sets up expected param w for UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) ExpectWParam3(w io.Writer) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
 	}
 
-	mmGetUser.mock.inspectFuncGetUser = f
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{}
+	}
 
-	return mmGetUser
+	if mmExportUsers.defaultExpectation.params != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Expect")
+	}
+
+	if mmExportUsers.defaultExpectation.paramPtrs == nil {
+		mmExportUsers.defaultExpectation.paramPtrs = &UserServiceMockExportUsersParamPtrs{}
+	}
+	mmExportUsers.defaultExpectation.paramPtrs.w = &w
+	mmExportUsers.defaultExpectation.expectationOrigins.originW = minimock.CallerInfo(1)
+
+	return mmExportUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) Inspect(f func(ctx context.Context, format string, w io.Writer)) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.inspectFuncExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ExportUsers")
+	}
+
+	mmExportUsers.mock.inspectFuncExportUsers = f
+
+	return mmExportUsers
+}
+
+// Return sets up results that will be returned by UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) Return(err error) *UserServiceMock {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
+	}
+
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{mock: mmExportUsers.mock}
+	}
+	mmExportUsers.defaultExpectation.results = &UserServiceMockExportUsersResults{err}
+	mmExportUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ExportUsers method
+func (mmExportUsers *mUserServiceMockExportUsers) Set(f func(ctx context.Context, format string, w io.Writer) (err error)) *UserServiceMock {
+	if mmExportUsers.defaultExpectation != nil {
+		mmExportUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ExportUsers method")
+	}
+
+	if len(mmExportUsers.expectations) > 0 {
+		mmExportUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ExportUsers method")
+	}
+
+	mmExportUsers.mock.funcExportUsers = f
+	mmExportUsers.mock.funcExportUsersOrigin = minimock.CallerInfo(1)
+	return mmExportUsers.mock
+}
+
+// When sets expectation for the UserService.ExportUsers which will trigger the result defined by the following
+// Then helper
+func (mmExportUsers *mUserServiceMockExportUsers) When(ctx context.Context, format string, w io.Writer) *UserServiceMockExportUsersExpectation {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockExportUsersExpectation{
+		mock:               mmExportUsers.mock,
+		params:             &UserServiceMockExportUsersParams{ctx, format, w},
+		expectationOrigins: UserServiceMockExportUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportUsers.expectations = append(mmExportUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ExportUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockExportUsersExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockExportUsersResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ExportUsers should be invoked
+func (mmExportUsers *mUserServiceMockExportUsers) Times(n uint64) *mUserServiceMockExportUsers {
+	if n == 0 {
+		mmExportUsers.mock.t.Fatalf("Times of UserServiceMock.ExportUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportUsers.expectedInvocations, n)
+	mmExportUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportUsers
+}
+
+func (mmExportUsers *mUserServiceMockExportUsers) invocationsDone() bool {
+	if len(mmExportUsers.expectations) == 0 && mmExportUsers.defaultExpectation == nil && mmExportUsers.mock.funcExportUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportUsers.mock.afterExportUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportUsers implements mm_service.UserService
+func (mmExportUsers *UserServiceMock) ExportUsers(ctx context.Context, format string, w io.Writer) (err error) {
+	mm_atomic.AddUint64(&mmExportUsers.beforeExportUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportUsers.afterExportUsersCounter, 1)
+
+	mmExportUsers.t.Helper()
+
+	if mmExportUsers.inspectFuncExportUsers != nil {
+		mmExportUsers.inspectFuncExportUsers(ctx, format, w)
+	}
+
+	mm_params := UserServiceMockExportUsersParams{ctx, format, w}
+
+	// Record call args
+	mmExportUsers.ExportUsersMock.mutex.Lock()
+	mmExportUsers.ExportUsersMock.callArgs = append(mmExportUsers.ExportUsersMock.callArgs, &mm_params)
+	mmExportUsers.ExportUsersMock.mutex.Unlock()
+
+	for _, e := range mmExportUsers.ExportUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExportUsers.ExportUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportUsers.ExportUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportUsers.ExportUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmExportUsers.ExportUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockExportUsersParams{ctx, format, w}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportUsers.t.Errorf("UserServiceMock.ExportUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUsers.ExportUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.format != nil && !minimock.Equal(*mm_want_ptrs.format, mm_got.format) {
+				mmExportUsers.t.Errorf("UserServiceMock.ExportUsers got unexpected parameter format, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUsers.ExportUsersMock.defaultExpectation.expectationOrigins.originFormat, *mm_want_ptrs.format, mm_got.format, minimock.Diff(*mm_want_ptrs.format, mm_got.format))
+			}
+
+			if mm_want_ptrs.w != nil && !minimock.Equal(*mm_want_ptrs.w, mm_got.w) {
+				mmExportUsers.t.Errorf("UserServiceMock.ExportUsers got unexpected parameter w, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUsers.ExportUsersMock.defaultExpectation.expectationOrigins.originW, *mm_want_ptrs.w, mm_got.w, minimock.Diff(*mm_want_ptrs.w, mm_got.w))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportUsers.t.Errorf("UserServiceMock.ExportUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportUsers.ExportUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportUsers.ExportUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportUsers.t.Fatal("No results are set for the UserServiceMock.ExportUsers")
+		}
+		return (*mm_results).err
+	}
+	if mmExportUsers.funcExportUsers != nil {
+		return mmExportUsers.funcExportUsers(ctx, format, w)
+	}
+	mmExportUsers.t.Fatalf("Unexpected call to UserServiceMock.ExportUsers. %v %v %v", ctx, format, w)
+	return
+}
+
+// ExportUsersAfterCounter returns a count of finished UserServiceMock.ExportUsers invocations
+func (mmExportUsers *UserServiceMock) ExportUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUsers.afterExportUsersCounter)
+}
+
+// ExportUsersBeforeCounter returns a count of UserServiceMock.ExportUsers invocations
+func (mmExportUsers *UserServiceMock) ExportUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUsers.beforeExportUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ExportUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportUsers *mUserServiceMockExportUsers) Calls() []*UserServiceMockExportUsersParams {
+	mmExportUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockExportUsersParams, len(mmExportUsers.callArgs))
+	copy(argCopy, mmExportUsers.callArgs)
+
+	mmExportUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportUsersDone returns true if the count of the ExportUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockExportUsersDone() bool {
+	if m.ExportUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportUsersMock.invocationsDone()
+}
+
+// MinimockExportUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockExportUsersInspect() {
+	for _, e := range m.ExportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ExportUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportUsersCounter := mm_atomic.LoadUint64(&m.afterExportUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportUsersMock.defaultExpectation != nil && afterExportUsersCounter < 1 {
+		if m.ExportUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ExportUsers at\n%s", m.ExportUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ExportUsers at\n%s with params: %#v", m.ExportUsersMock.defaultExpectation.expectationOrigins.origin, *m.ExportUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportUsers != nil && afterExportUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ExportUsers at\n%s", m.funcExportUsersOrigin)
+	}
+
+	if !m.ExportUsersMock.invocationsDone() && afterExportUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ExportUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportUsersMock.expectedInvocations), m.ExportUsersMock.expectedInvocationsOrigin, afterExportUsersCounter)
+	}
+}
+
+type mUserServiceMockGetOperation struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetOperationExpectation
+	expectations       []*UserServiceMockGetOperationExpectation
+
+	callArgs []*UserServiceMockGetOperationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockGetOperationExpectation specifies expectation struct of the UserService.GetOperation
+type UserServiceMockGetOperationExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockGetOperationParams
+	paramPtrs          *UserServiceMockGetOperationParamPtrs
+	expectationOrigins UserServiceMockGetOperationExpectationOrigins
+	results            *UserServiceMockGetOperationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockGetOperationParams contains parameters of the UserService.GetOperation
+type UserServiceMockGetOperationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserServiceMockGetOperationParamPtrs contains pointers to parameters of the UserService.GetOperation
+type UserServiceMockGetOperationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserServiceMockGetOperationResults contains results of the UserService.GetOperation
+type UserServiceMockGetOperationResults struct {
+	op1 *model.Operation
+	err error
+}
+
+// UserServiceMockGetOperationOrigins contains origins of expectations of the UserService.GetOperation
+type UserServiceMockGetOperationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOperation *mUserServiceMockGetOperation) Optional() *mUserServiceMockGetOperation {
+	mmGetOperation.optional = true
+	return mmGetOperation
+}
+
+// Expect sets up expected params for UserService.GetOperation
+func (mmGetOperation *mUserServiceMockGetOperation) Expect(ctx context.Context, id int64) *mUserServiceMockGetOperation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &UserServiceMockGetOperationExpectation{}
+	}
+
+	if mmGetOperation.defaultExpectation.paramPtrs != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by ExpectParams functions")
+	}
+
+	mmGetOperation.defaultExpectation.params = &UserServiceMockGetOperationParams{ctx, id}
+	mmGetOperation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOperation.expectations {
+		if minimock.Equal(e.params, mmGetOperation.defaultExpectation.params) {
+			mmGetOperation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOperation.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOperation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.GetOperation
+func (mmGetOperation *mUserServiceMockGetOperation) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetOperation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &UserServiceMockGetOperationExpectation{}
+	}
+
+	if mmGetOperation.defaultExpectation.params != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Expect")
+	}
+
+	if mmGetOperation.defaultExpectation.paramPtrs == nil {
+		mmGetOperation.defaultExpectation.paramPtrs = &UserServiceMockGetOperationParamPtrs{}
+	}
+	mmGetOperation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOperation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOperation
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.GetOperation
+func (mmGetOperation *mUserServiceMockGetOperation) ExpectIdParam2(id int64) *mUserServiceMockGetOperation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &UserServiceMockGetOperationExpectation{}
+	}
+
+	if mmGetOperation.defaultExpectation.params != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Expect")
+	}
+
+	if mmGetOperation.defaultExpectation.paramPtrs == nil {
+		mmGetOperation.defaultExpectation.paramPtrs = &UserServiceMockGetOperationParamPtrs{}
+	}
+	mmGetOperation.defaultExpectation.paramPtrs.id = &id
+	mmGetOperation.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetOperation
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.GetOperation
+func (mmGetOperation *mUserServiceMockGetOperation) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockGetOperation {
+	if mmGetOperation.mock.inspectFuncGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetOperation")
+	}
+
+	mmGetOperation.mock.inspectFuncGetOperation = f
+
+	return mmGetOperation
+}
+
+// Return sets up results that will be returned by UserService.GetOperation
+func (mmGetOperation *mUserServiceMockGetOperation) Return(op1 *model.Operation, err error) *UserServiceMock {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Set")
+	}
+
+	if mmGetOperation.defaultExpectation == nil {
+		mmGetOperation.defaultExpectation = &UserServiceMockGetOperationExpectation{mock: mmGetOperation.mock}
+	}
+	mmGetOperation.defaultExpectation.results = &UserServiceMockGetOperationResults{op1, err}
+	mmGetOperation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOperation.mock
+}
+
+// Set uses given function f to mock the UserService.GetOperation method
+func (mmGetOperation *mUserServiceMockGetOperation) Set(f func(ctx context.Context, id int64) (op1 *model.Operation, err error)) *UserServiceMock {
+	if mmGetOperation.defaultExpectation != nil {
+		mmGetOperation.mock.t.Fatalf("Default expectation is already set for the UserService.GetOperation method")
+	}
+
+	if len(mmGetOperation.expectations) > 0 {
+		mmGetOperation.mock.t.Fatalf("Some expectations are already set for the UserService.GetOperation method")
+	}
+
+	mmGetOperation.mock.funcGetOperation = f
+	mmGetOperation.mock.funcGetOperationOrigin = minimock.CallerInfo(1)
+	return mmGetOperation.mock
+}
+
+// When sets expectation for the UserService.GetOperation which will trigger the result defined by the following
+// Then helper
+func (mmGetOperation *mUserServiceMockGetOperation) When(ctx context.Context, id int64) *UserServiceMockGetOperationExpectation {
+	if mmGetOperation.mock.funcGetOperation != nil {
+		mmGetOperation.mock.t.Fatalf("UserServiceMock.GetOperation mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetOperationExpectation{
+		mock:               mmGetOperation.mock,
+		params:             &UserServiceMockGetOperationParams{ctx, id},
+		expectationOrigins: UserServiceMockGetOperationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOperation.expectations = append(mmGetOperation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.GetOperation return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetOperationExpectation) Then(op1 *model.Operation, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetOperationResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.GetOperation should be invoked
+func (mmGetOperation *mUserServiceMockGetOperation) Times(n uint64) *mUserServiceMockGetOperation {
+	if n == 0 {
+		mmGetOperation.mock.t.Fatalf("Times of UserServiceMock.GetOperation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOperation.expectedInvocations, n)
+	mmGetOperation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOperation
+}
+
+func (mmGetOperation *mUserServiceMockGetOperation) invocationsDone() bool {
+	if len(mmGetOperation.expectations) == 0 && mmGetOperation.defaultExpectation == nil && mmGetOperation.mock.funcGetOperation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOperation.mock.afterGetOperationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOperation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOperation implements mm_service.UserService
+func (mmGetOperation *UserServiceMock) GetOperation(ctx context.Context, id int64) (op1 *model.Operation, err error) {
+	mm_atomic.AddUint64(&mmGetOperation.beforeGetOperationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOperation.afterGetOperationCounter, 1)
+
+	mmGetOperation.t.Helper()
+
+	if mmGetOperation.inspectFuncGetOperation != nil {
+		mmGetOperation.inspectFuncGetOperation(ctx, id)
+	}
+
+	mm_params := UserServiceMockGetOperationParams{ctx, id}
+
+	// Record call args
+	mmGetOperation.GetOperationMock.mutex.Lock()
+	mmGetOperation.GetOperationMock.callArgs = append(mmGetOperation.GetOperationMock.callArgs, &mm_params)
+	mmGetOperation.GetOperationMock.mutex.Unlock()
+
+	for _, e := range mmGetOperation.GetOperationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOperation.GetOperationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOperation.GetOperationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOperation.GetOperationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOperation.GetOperationMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetOperationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOperation.t.Errorf("UserServiceMock.GetOperation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOperation.GetOperationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetOperation.t.Errorf("UserServiceMock.GetOperation got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOperation.GetOperationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOperation.t.Errorf("UserServiceMock.GetOperation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOperation.GetOperationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOperation.GetOperationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOperation.t.Fatal("No results are set for the UserServiceMock.GetOperation")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOperation.funcGetOperation != nil {
+		return mmGetOperation.funcGetOperation(ctx, id)
+	}
+	mmGetOperation.t.Fatalf("Unexpected call to UserServiceMock.GetOperation. %v %v", ctx, id)
+	return
+}
+
+// GetOperationAfterCounter returns a count of finished UserServiceMock.GetOperation invocations
+func (mmGetOperation *UserServiceMock) GetOperationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOperation.afterGetOperationCounter)
+}
+
+// GetOperationBeforeCounter returns a count of UserServiceMock.GetOperation invocations
+func (mmGetOperation *UserServiceMock) GetOperationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOperation.beforeGetOperationCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.GetOperation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOperation *mUserServiceMockGetOperation) Calls() []*UserServiceMockGetOperationParams {
+	mmGetOperation.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetOperationParams, len(mmGetOperation.callArgs))
+	copy(argCopy, mmGetOperation.callArgs)
+
+	mmGetOperation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOperationDone returns true if the count of the GetOperation invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetOperationDone() bool {
+	if m.GetOperationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOperationMock.invocationsDone()
+}
+
+// MinimockGetOperationInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetOperationInspect() {
+	for _, e := range m.GetOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.GetOperation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOperationCounter := mm_atomic.LoadUint64(&m.afterGetOperationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOperationMock.defaultExpectation != nil && afterGetOperationCounter < 1 {
+		if m.GetOperationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.GetOperation at\n%s", m.GetOperationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.GetOperation at\n%s with params: %#v", m.GetOperationMock.defaultExpectation.expectationOrigins.origin, *m.GetOperationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOperation != nil && afterGetOperationCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.GetOperation at\n%s", m.funcGetOperationOrigin)
+	}
+
+	if !m.GetOperationMock.invocationsDone() && afterGetOperationCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.GetOperation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOperationMock.expectedInvocations), m.GetOperationMock.expectedInvocationsOrigin, afterGetOperationCounter)
+	}
+}
+
+type mUserServiceMockGetUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetUserExpectation
+	expectations       []*UserServiceMockGetUserExpectation
+
+	callArgs []*UserServiceMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockGetUserExpectation specifies expectation struct of the UserService.GetUser
+type UserServiceMockGetUserExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockGetUserParams
+	paramPtrs          *UserServiceMockGetUserParamPtrs
+	expectationOrigins UserServiceMockGetUserExpectationOrigins
+	results            *UserServiceMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockGetUserParams contains parameters of the UserService.GetUser
+type UserServiceMockGetUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserServiceMockGetUserParamPtrs contains pointers to parameters of the UserService.GetUser
+type UserServiceMockGetUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserServiceMockGetUserResults contains results of the UserService.GetUser
+type UserServiceMockGetUserResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserServiceMockGetUserOrigins contains origins of expectations of the UserService.GetUser
+type UserServiceMockGetUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mUserServiceMockGetUser) Optional() *mUserServiceMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Expect(ctx context.Context, id int64) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &UserServiceMockGetUserParams{ctx, id}
+	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) ExpectIdParam2(id int64) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.id = &id
+	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Return(up1 *model.User, err error) *UserServiceMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &UserServiceMockGetUserResults{up1, err}
+	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the UserService.GetUser method
+func (mmGetUser *mUserServiceMockGetUser) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserServiceMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserService.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserService.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// When sets expectation for the UserService.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mUserServiceMockGetUser) When(ctx context.Context, id int64) *UserServiceMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetUserExpectation{
+		mock:               mmGetUser.mock,
+		params:             &UserServiceMockGetUserParams{ctx, id},
+		expectationOrigins: UserServiceMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.GetUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetUserExpectation) Then(up1 *model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetUserResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.GetUser should be invoked
+func (mmGetUser *mUserServiceMockGetUser) Times(n uint64) *mUserServiceMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of UserServiceMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUser
+}
+
+func (mmGetUser *mUserServiceMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements mm_service.UserService
+func (mmGetUser *UserServiceMock) GetUser(ctx context.Context, id int64) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	mmGetUser.t.Helper()
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, id)
+	}
+
+	mm_params := UserServiceMockGetUserParams{ctx, id}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the UserServiceMock.GetUser")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, id)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to UserServiceMock.GetUser. %v %v", ctx, id)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished UserServiceMock.GetUser invocations
+func (mmGetUser *UserServiceMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of UserServiceMock.GetUser invocations
+func (mmGetUser *UserServiceMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mUserServiceMockGetUser) Calls() []*UserServiceMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.funcGetUserOrigin)
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.GetUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	}
+}
+
+type mUserServiceMockGetUserByEmail struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetUserByEmailExpectation
+	expectations       []*UserServiceMockGetUserByEmailExpectation
+
+	callArgs []*UserServiceMockGetUserByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockGetUserByEmailExpectation specifies expectation struct of the UserService.GetUserByEmail
+type UserServiceMockGetUserByEmailExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockGetUserByEmailParams
+	paramPtrs          *UserServiceMockGetUserByEmailParamPtrs
+	expectationOrigins UserServiceMockGetUserByEmailExpectationOrigins
+	results            *UserServiceMockGetUserByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockGetUserByEmailParams contains parameters of the UserService.GetUserByEmail
+type UserServiceMockGetUserByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserServiceMockGetUserByEmailParamPtrs contains pointers to parameters of the UserService.GetUserByEmail
+type UserServiceMockGetUserByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserServiceMockGetUserByEmailResults contains results of the UserService.GetUserByEmail
+type UserServiceMockGetUserByEmailResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserServiceMockGetUserByEmailOrigins contains origins of expectations of the UserService.GetUserByEmail
+type UserServiceMockGetUserByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Optional() *mUserServiceMockGetUserByEmail {
+	mmGetUserByEmail.optional = true
+	return mmGetUserByEmail
+}
+
+// Expect sets up expected params for UserService.GetUserByEmail
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Expect(ctx context.Context, email string) *mUserServiceMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserServiceMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByEmail.defaultExpectation.params = &UserServiceMockGetUserByEmailParams{ctx, email}
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByEmail.expectations {
+		if minimock.Equal(e.params, mmGetUserByEmail.defaultExpectation.params) {
+			mmGetUserByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.GetUserByEmail
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserServiceMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserServiceMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserService.GetUserByEmail
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) ExpectEmailParam2(email string) *mUserServiceMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserServiceMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserServiceMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.email = &email
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.GetUserByEmail
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Inspect(f func(ctx context.Context, email string)) *mUserServiceMockGetUserByEmail {
+	if mmGetUserByEmail.mock.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUserByEmail")
+	}
+
+	mmGetUserByEmail.mock.inspectFuncGetUserByEmail = f
+
+	return mmGetUserByEmail
+}
+
+// Return sets up results that will be returned by UserService.GetUserByEmail
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Return(up1 *model.User, err error) *UserServiceMock {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserServiceMockGetUserByEmailExpectation{mock: mmGetUserByEmail.mock}
+	}
+	mmGetUserByEmail.defaultExpectation.results = &UserServiceMockGetUserByEmailResults{up1, err}
+	mmGetUserByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// Set uses given function f to mock the UserService.GetUserByEmail method
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Set(f func(ctx context.Context, email string) (up1 *model.User, err error)) *UserServiceMock {
+	if mmGetUserByEmail.defaultExpectation != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Default expectation is already set for the UserService.GetUserByEmail method")
+	}
+
+	if len(mmGetUserByEmail.expectations) > 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Some expectations are already set for the UserService.GetUserByEmail method")
+	}
+
+	mmGetUserByEmail.mock.funcGetUserByEmail = f
+	mmGetUserByEmail.mock.funcGetUserByEmailOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// When sets expectation for the UserService.GetUserByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) When(ctx context.Context, email string) *UserServiceMockGetUserByEmailExpectation {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserServiceMock.GetUserByEmail mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetUserByEmailExpectation{
+		mock:               mmGetUserByEmail.mock,
+		params:             &UserServiceMockGetUserByEmailParams{ctx, email},
+		expectationOrigins: UserServiceMockGetUserByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByEmail.expectations = append(mmGetUserByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.GetUserByEmail return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetUserByEmailExpectation) Then(up1 *model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetUserByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.GetUserByEmail should be invoked
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Times(n uint64) *mUserServiceMockGetUserByEmail {
+	if n == 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Times of UserServiceMock.GetUserByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByEmail.expectedInvocations, n)
+	mmGetUserByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail
+}
+
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) invocationsDone() bool {
+	if len(mmGetUserByEmail.expectations) == 0 && mmGetUserByEmail.defaultExpectation == nil && mmGetUserByEmail.mock.funcGetUserByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.mock.afterGetUserByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByEmail implements mm_service.UserService
+func (mmGetUserByEmail *UserServiceMock) GetUserByEmail(ctx context.Context, email string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByEmail.afterGetUserByEmailCounter, 1)
+
+	mmGetUserByEmail.t.Helper()
+
+	if mmGetUserByEmail.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.inspectFuncGetUserByEmail(ctx, email)
+	}
+
+	mm_params := UserServiceMockGetUserByEmailParams{ctx, email}
+
+	// Record call args
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Lock()
+	mmGetUserByEmail.GetUserByEmailMock.callArgs = append(mmGetUserByEmail.GetUserByEmailMock.callArgs, &mm_params)
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByEmail.GetUserByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserByEmail.GetUserByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetUserByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByEmail.t.Errorf("UserServiceMock.GetUserByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetUserByEmail.t.Errorf("UserServiceMock.GetUserByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByEmail.t.Errorf("UserServiceMock.GetUserByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByEmail.t.Fatal("No results are set for the UserServiceMock.GetUserByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserByEmail.funcGetUserByEmail != nil {
+		return mmGetUserByEmail.funcGetUserByEmail(ctx, email)
+	}
+	mmGetUserByEmail.t.Fatalf("Unexpected call to UserServiceMock.GetUserByEmail. %v %v", ctx, email)
+	return
+}
+
+// GetUserByEmailAfterCounter returns a count of finished UserServiceMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserServiceMock) GetUserByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.afterGetUserByEmailCounter)
+}
+
+// GetUserByEmailBeforeCounter returns a count of UserServiceMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserServiceMock) GetUserByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.GetUserByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByEmail *mUserServiceMockGetUserByEmail) Calls() []*UserServiceMockGetUserByEmailParams {
+	mmGetUserByEmail.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetUserByEmailParams, len(mmGetUserByEmail.callArgs))
+	copy(argCopy, mmGetUserByEmail.callArgs)
+
+	mmGetUserByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByEmailDone returns true if the count of the GetUserByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetUserByEmailDone() bool {
+	if m.GetUserByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByEmailMock.invocationsDone()
+}
+
+// MinimockGetUserByEmailInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetUserByEmailInspect() {
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.GetUserByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByEmailMock.defaultExpectation != nil && afterGetUserByEmailCounter < 1 {
+		if m.GetUserByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.GetUserByEmail at\n%s", m.GetUserByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.GetUserByEmail at\n%s with params: %#v", m.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByEmail != nil && afterGetUserByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.GetUserByEmail at\n%s", m.funcGetUserByEmailOrigin)
+	}
+
+	if !m.GetUserByEmailMock.invocationsDone() && afterGetUserByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.GetUserByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByEmailMock.expectedInvocations), m.GetUserByEmailMock.expectedInvocationsOrigin, afterGetUserByEmailCounter)
+	}
+}
+
+type mUserServiceMockGetUserByName struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetUserByNameExpectation
+	expectations       []*UserServiceMockGetUserByNameExpectation
+
+	callArgs []*UserServiceMockGetUserByNameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockGetUserByNameExpectation specifies expectation struct of the UserService.GetUserByName
+type UserServiceMockGetUserByNameExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockGetUserByNameParams
+	paramPtrs          *UserServiceMockGetUserByNameParamPtrs
+	expectationOrigins UserServiceMockGetUserByNameExpectationOrigins
+	results            *UserServiceMockGetUserByNameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockGetUserByNameParams contains parameters of the UserService.GetUserByName
+type UserServiceMockGetUserByNameParams struct {
+	ctx  context.Context
+	name string
+}
+
+// UserServiceMockGetUserByNameParamPtrs contains pointers to parameters of the UserService.GetUserByName
+type UserServiceMockGetUserByNameParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// UserServiceMockGetUserByNameResults contains results of the UserService.GetUserByName
+type UserServiceMockGetUserByNameResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserServiceMockGetUserByNameOrigins contains origins of expectations of the UserService.GetUserByName
+type UserServiceMockGetUserByNameExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByName *mUserServiceMockGetUserByName) Optional() *mUserServiceMockGetUserByName {
+	mmGetUserByName.optional = true
+	return mmGetUserByName
+}
+
+// Expect sets up expected params for UserService.GetUserByName
+func (mmGetUserByName *mUserServiceMockGetUserByName) Expect(ctx context.Context, name string) *mUserServiceMockGetUserByName {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserServiceMockGetUserByNameExpectation{}
+	}
+
+	if mmGetUserByName.defaultExpectation.paramPtrs != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByName.defaultExpectation.params = &UserServiceMockGetUserByNameParams{ctx, name}
+	mmGetUserByName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByName.expectations {
+		if minimock.Equal(e.params, mmGetUserByName.defaultExpectation.params) {
+			mmGetUserByName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByName.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByName
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.GetUserByName
+func (mmGetUserByName *mUserServiceMockGetUserByName) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUserByName {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserServiceMockGetUserByNameExpectation{}
+	}
+
+	if mmGetUserByName.defaultExpectation.params != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Expect")
+	}
+
+	if mmGetUserByName.defaultExpectation.paramPtrs == nil {
+		mmGetUserByName.defaultExpectation.paramPtrs = &UserServiceMockGetUserByNameParamPtrs{}
+	}
+	mmGetUserByName.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByName
+}
+
+// ExpectNameParam2 sets up expected param name for UserService.GetUserByName
+func (mmGetUserByName *mUserServiceMockGetUserByName) ExpectNameParam2(name string) *mUserServiceMockGetUserByName {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserServiceMockGetUserByNameExpectation{}
+	}
+
+	if mmGetUserByName.defaultExpectation.params != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Expect")
+	}
+
+	if mmGetUserByName.defaultExpectation.paramPtrs == nil {
+		mmGetUserByName.defaultExpectation.paramPtrs = &UserServiceMockGetUserByNameParamPtrs{}
+	}
+	mmGetUserByName.defaultExpectation.paramPtrs.name = &name
+	mmGetUserByName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmGetUserByName
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.GetUserByName
+func (mmGetUserByName *mUserServiceMockGetUserByName) Inspect(f func(ctx context.Context, name string)) *mUserServiceMockGetUserByName {
+	if mmGetUserByName.mock.inspectFuncGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUserByName")
+	}
+
+	mmGetUserByName.mock.inspectFuncGetUserByName = f
+
+	return mmGetUserByName
+}
+
+// Return sets up results that will be returned by UserService.GetUserByName
+func (mmGetUserByName *mUserServiceMockGetUserByName) Return(up1 *model.User, err error) *UserServiceMock {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Set")
+	}
+
+	if mmGetUserByName.defaultExpectation == nil {
+		mmGetUserByName.defaultExpectation = &UserServiceMockGetUserByNameExpectation{mock: mmGetUserByName.mock}
+	}
+	mmGetUserByName.defaultExpectation.results = &UserServiceMockGetUserByNameResults{up1, err}
+	mmGetUserByName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByName.mock
+}
+
+// Set uses given function f to mock the UserService.GetUserByName method
+func (mmGetUserByName *mUserServiceMockGetUserByName) Set(f func(ctx context.Context, name string) (up1 *model.User, err error)) *UserServiceMock {
+	if mmGetUserByName.defaultExpectation != nil {
+		mmGetUserByName.mock.t.Fatalf("Default expectation is already set for the UserService.GetUserByName method")
+	}
+
+	if len(mmGetUserByName.expectations) > 0 {
+		mmGetUserByName.mock.t.Fatalf("Some expectations are already set for the UserService.GetUserByName method")
+	}
+
+	mmGetUserByName.mock.funcGetUserByName = f
+	mmGetUserByName.mock.funcGetUserByNameOrigin = minimock.CallerInfo(1)
+	return mmGetUserByName.mock
+}
+
+// When sets expectation for the UserService.GetUserByName which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByName *mUserServiceMockGetUserByName) When(ctx context.Context, name string) *UserServiceMockGetUserByNameExpectation {
+	if mmGetUserByName.mock.funcGetUserByName != nil {
+		mmGetUserByName.mock.t.Fatalf("UserServiceMock.GetUserByName mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetUserByNameExpectation{
+		mock:               mmGetUserByName.mock,
+		params:             &UserServiceMockGetUserByNameParams{ctx, name},
+		expectationOrigins: UserServiceMockGetUserByNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByName.expectations = append(mmGetUserByName.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.GetUserByName return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetUserByNameExpectation) Then(up1 *model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetUserByNameResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.GetUserByName should be invoked
+func (mmGetUserByName *mUserServiceMockGetUserByName) Times(n uint64) *mUserServiceMockGetUserByName {
+	if n == 0 {
+		mmGetUserByName.mock.t.Fatalf("Times of UserServiceMock.GetUserByName mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByName.expectedInvocations, n)
+	mmGetUserByName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByName
+}
+
+func (mmGetUserByName *mUserServiceMockGetUserByName) invocationsDone() bool {
+	if len(mmGetUserByName.expectations) == 0 && mmGetUserByName.defaultExpectation == nil && mmGetUserByName.mock.funcGetUserByName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByName.mock.afterGetUserByNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByName implements mm_service.UserService
+func (mmGetUserByName *UserServiceMock) GetUserByName(ctx context.Context, name string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByName.beforeGetUserByNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByName.afterGetUserByNameCounter, 1)
+
+	mmGetUserByName.t.Helper()
+
+	if mmGetUserByName.inspectFuncGetUserByName != nil {
+		mmGetUserByName.inspectFuncGetUserByName(ctx, name)
+	}
+
+	mm_params := UserServiceMockGetUserByNameParams{ctx, name}
+
+	// Record call args
+	mmGetUserByName.GetUserByNameMock.mutex.Lock()
+	mmGetUserByName.GetUserByNameMock.callArgs = append(mmGetUserByName.GetUserByNameMock.callArgs, &mm_params)
+	mmGetUserByName.GetUserByNameMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByName.GetUserByNameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserByName.GetUserByNameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByName.GetUserByNameMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByName.GetUserByNameMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByName.GetUserByNameMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetUserByNameParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByName.t.Errorf("UserServiceMock.GetUserByName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmGetUserByName.t.Errorf("UserServiceMock.GetUserByName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByName.t.Errorf("UserServiceMock.GetUserByName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByName.GetUserByNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByName.GetUserByNameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByName.t.Fatal("No results are set for the UserServiceMock.GetUserByName")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserByName.funcGetUserByName != nil {
+		return mmGetUserByName.funcGetUserByName(ctx, name)
+	}
+	mmGetUserByName.t.Fatalf("Unexpected call to UserServiceMock.GetUserByName. %v %v", ctx, name)
+	return
+}
+
+// GetUserByNameAfterCounter returns a count of finished UserServiceMock.GetUserByName invocations
+func (mmGetUserByName *UserServiceMock) GetUserByNameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByName.afterGetUserByNameCounter)
+}
+
+// GetUserByNameBeforeCounter returns a count of UserServiceMock.GetUserByName invocations
+func (mmGetUserByName *UserServiceMock) GetUserByNameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByName.beforeGetUserByNameCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.GetUserByName.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByName *mUserServiceMockGetUserByName) Calls() []*UserServiceMockGetUserByNameParams {
+	mmGetUserByName.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetUserByNameParams, len(mmGetUserByName.callArgs))
+	copy(argCopy, mmGetUserByName.callArgs)
+
+	mmGetUserByName.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByNameDone returns true if the count of the GetUserByName invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetUserByNameDone() bool {
+	if m.GetUserByNameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByNameMock.invocationsDone()
+}
+
+// MinimockGetUserByNameInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetUserByNameInspect() {
+	for _, e := range m.GetUserByNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.GetUserByName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserByNameCounter := mm_atomic.LoadUint64(&m.afterGetUserByNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByNameMock.defaultExpectation != nil && afterGetUserByNameCounter < 1 {
+		if m.GetUserByNameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.GetUserByName at\n%s", m.GetUserByNameMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.GetUserByName at\n%s with params: %#v", m.GetUserByNameMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByNameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByName != nil && afterGetUserByNameCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.GetUserByName at\n%s", m.funcGetUserByNameOrigin)
+	}
+
+	if !m.GetUserByNameMock.invocationsDone() && afterGetUserByNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.GetUserByName at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByNameMock.expectedInvocations), m.GetUserByNameMock.expectedInvocationsOrigin, afterGetUserByNameCounter)
+	}
+}
+
+type mUserServiceMockImportUsers struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockImportUsersExpectation
+	expectations       []*UserServiceMockImportUsersExpectation
+
+	callArgs []*UserServiceMockImportUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockImportUsersExpectation specifies expectation struct of the UserService.ImportUsers
+type UserServiceMockImportUsersExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockImportUsersParams
+	paramPtrs          *UserServiceMockImportUsersParamPtrs
+	expectationOrigins UserServiceMockImportUsersExpectationOrigins
+	results            *UserServiceMockImportUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockImportUsersParams contains parameters of the UserService.ImportUsers
+type UserServiceMockImportUsersParams struct {
+	ctx     context.Context
+	options *model.ImportUsersOptions
+	data    io.Reader
+}
+
+// UserServiceMockImportUsersParamPtrs contains pointers to parameters of the UserService.ImportUsers
+type UserServiceMockImportUsersParamPtrs struct {
+	ctx     *context.Context
+	options **model.ImportUsersOptions
+	data    *io.Reader
+}
+
+// UserServiceMockImportUsersResults contains results of the UserService.ImportUsers
+type UserServiceMockImportUsersResults struct {
+	op1 *model.Operation
+	err error
+}
+
+// UserServiceMockImportUsersOrigins contains origins of expectations of the UserService.ImportUsers
+type UserServiceMockImportUsersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOptions string
+	originData    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImportUsers *mUserServiceMockImportUsers) Optional() *mUserServiceMockImportUsers {
+	mmImportUsers.optional = true
+	return mmImportUsers
+}
+
+// Expect sets up expected params for UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) Expect(ctx context.Context, options *model.ImportUsersOptions, data io.Reader) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{}
+	}
+
+	if mmImportUsers.defaultExpectation.paramPtrs != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by ExpectParams functions")
+	}
+
+	mmImportUsers.defaultExpectation.params = &UserServiceMockImportUsersParams{ctx, options, data}
+	mmImportUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImportUsers.expectations {
+		if minimock.Equal(e.params, mmImportUsers.defaultExpectation.params) {
+			mmImportUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmImportUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{}
+	}
+
+	if mmImportUsers.defaultExpectation.params != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Expect")
+	}
+
+	if mmImportUsers.defaultExpectation.paramPtrs == nil {
+		mmImportUsers.defaultExpectation.paramPtrs = &UserServiceMockImportUsersParamPtrs{}
+	}
+	mmImportUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImportUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImportUsers
+}
+
+// ExpectOptionsParam2 sets up expected param options for UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) ExpectOptionsParam2(options *model.ImportUsersOptions) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{}
+	}
+
+	if mmImportUsers.defaultExpectation.params != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Expect")
+	}
+
+	if mmImportUsers.defaultExpectation.paramPtrs == nil {
+		mmImportUsers.defaultExpectation.paramPtrs = &UserServiceMockImportUsersParamPtrs{}
+	}
+	mmImportUsers.defaultExpectation.paramPtrs.options = &options
+	mmImportUsers.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmImportUsers
+}
+
+// ExpectDataParam3 sets up expected param data for UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) ExpectDataParam3(data io.Reader) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{}
+	}
+
+	if mmImportUsers.defaultExpectation.params != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Expect")
+	}
+
+	if mmImportUsers.defaultExpectation.paramPtrs == nil {
+		mmImportUsers.defaultExpectation.paramPtrs = &UserServiceMockImportUsersParamPtrs{}
+	}
+	mmImportUsers.defaultExpectation.paramPtrs.data = &data
+	mmImportUsers.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmImportUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) Inspect(f func(ctx context.Context, options *model.ImportUsersOptions, data io.Reader)) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.inspectFuncImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ImportUsers")
+	}
+
+	mmImportUsers.mock.inspectFuncImportUsers = f
+
+	return mmImportUsers
+}
+
+// Return sets up results that will be returned by UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) Return(op1 *model.Operation, err error) *UserServiceMock {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{mock: mmImportUsers.mock}
+	}
+	mmImportUsers.defaultExpectation.results = &UserServiceMockImportUsersResults{op1, err}
+	mmImportUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImportUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ImportUsers method
+func (mmImportUsers *mUserServiceMockImportUsers) Set(f func(ctx context.Context, options *model.ImportUsersOptions, data io.Reader) (op1 *model.Operation, err error)) *UserServiceMock {
+	if mmImportUsers.defaultExpectation != nil {
+		mmImportUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ImportUsers method")
+	}
+
+	if len(mmImportUsers.expectations) > 0 {
+		mmImportUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ImportUsers method")
+	}
+
+	mmImportUsers.mock.funcImportUsers = f
+	mmImportUsers.mock.funcImportUsersOrigin = minimock.CallerInfo(1)
+	return mmImportUsers.mock
+}
+
+// When sets expectation for the UserService.ImportUsers which will trigger the result defined by the following
+// Then helper
+func (mmImportUsers *mUserServiceMockImportUsers) When(ctx context.Context, options *model.ImportUsersOptions, data io.Reader) *UserServiceMockImportUsersExpectation {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockImportUsersExpectation{
+		mock:               mmImportUsers.mock,
+		params:             &UserServiceMockImportUsersParams{ctx, options, data},
+		expectationOrigins: UserServiceMockImportUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImportUsers.expectations = append(mmImportUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ImportUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockImportUsersExpectation) Then(op1 *model.Operation, err error) *UserServiceMock {
+	e.results = &UserServiceMockImportUsersResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ImportUsers should be invoked
+func (mmImportUsers *mUserServiceMockImportUsers) Times(n uint64) *mUserServiceMockImportUsers {
+	if n == 0 {
+		mmImportUsers.mock.t.Fatalf("Times of UserServiceMock.ImportUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImportUsers.expectedInvocations, n)
+	mmImportUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImportUsers
+}
+
+func (mmImportUsers *mUserServiceMockImportUsers) invocationsDone() bool {
+	if len(mmImportUsers.expectations) == 0 && mmImportUsers.defaultExpectation == nil && mmImportUsers.mock.funcImportUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImportUsers.mock.afterImportUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImportUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ImportUsers implements mm_service.UserService
+func (mmImportUsers *UserServiceMock) ImportUsers(ctx context.Context, options *model.ImportUsersOptions, data io.Reader) (op1 *model.Operation, err error) {
+	mm_atomic.AddUint64(&mmImportUsers.beforeImportUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportUsers.afterImportUsersCounter, 1)
+
+	mmImportUsers.t.Helper()
+
+	if mmImportUsers.inspectFuncImportUsers != nil {
+		mmImportUsers.inspectFuncImportUsers(ctx, options, data)
+	}
+
+	mm_params := UserServiceMockImportUsersParams{ctx, options, data}
+
+	// Record call args
+	mmImportUsers.ImportUsersMock.mutex.Lock()
+	mmImportUsers.ImportUsersMock.callArgs = append(mmImportUsers.ImportUsersMock.callArgs, &mm_params)
+	mmImportUsers.ImportUsersMock.mutex.Unlock()
+
+	for _, e := range mmImportUsers.ImportUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmImportUsers.ImportUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportUsers.ImportUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportUsers.ImportUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmImportUsers.ImportUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockImportUsersParams{ctx, options, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImportUsers.t.Errorf("UserServiceMock.ImportUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportUsers.ImportUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmImportUsers.t.Errorf("UserServiceMock.ImportUsers got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportUsers.ImportUsersMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmImportUsers.t.Errorf("UserServiceMock.ImportUsers got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportUsers.ImportUsersMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportUsers.t.Errorf("UserServiceMock.ImportUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImportUsers.ImportUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportUsers.ImportUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportUsers.t.Fatal("No results are set for the UserServiceMock.ImportUsers")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmImportUsers.funcImportUsers != nil {
+		return mmImportUsers.funcImportUsers(ctx, options, data)
+	}
+	mmImportUsers.t.Fatalf("Unexpected call to UserServiceMock.ImportUsers. %v %v %v", ctx, options, data)
+	return
 }
 
-// Return sets up results that will be returned by UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) Return(up1 *model.User, err error) *UserServiceMock {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// ImportUsersAfterCounter returns a count of finished UserServiceMock.ImportUsers invocations
+func (mmImportUsers *UserServiceMock) ImportUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportUsers.afterImportUsersCounter)
+}
+
+// ImportUsersBeforeCounter returns a count of UserServiceMock.ImportUsers invocations
+func (mmImportUsers *UserServiceMock) ImportUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportUsers.beforeImportUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ImportUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportUsers *mUserServiceMockImportUsers) Calls() []*UserServiceMockImportUsersParams {
+	mmImportUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockImportUsersParams, len(mmImportUsers.callArgs))
+	copy(argCopy, mmImportUsers.callArgs)
+
+	mmImportUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportUsersDone returns true if the count of the ImportUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockImportUsersDone() bool {
+	if m.ImportUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	for _, e := range m.ImportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
-	mmGetUser.defaultExpectation.results = &UserServiceMockGetUserResults{up1, err}
-	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+
+	return m.ImportUsersMock.invocationsDone()
 }
 
-// Set uses given function f to mock the UserService.GetUser method
-func (mmGetUser *mUserServiceMockGetUser) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserServiceMock {
-	if mmGetUser.defaultExpectation != nil {
-		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserService.GetUser method")
+// MinimockImportUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockImportUsersInspect() {
+	for _, e := range m.ImportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ImportUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if len(mmGetUser.expectations) > 0 {
-		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserService.GetUser method")
+	afterImportUsersCounter := mm_atomic.LoadUint64(&m.afterImportUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportUsersMock.defaultExpectation != nil && afterImportUsersCounter < 1 {
+		if m.ImportUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ImportUsers at\n%s", m.ImportUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ImportUsers at\n%s with params: %#v", m.ImportUsersMock.defaultExpectation.expectationOrigins.origin, *m.ImportUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportUsers != nil && afterImportUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ImportUsers at\n%s", m.funcImportUsersOrigin)
 	}
 
-	mmGetUser.mock.funcGetUser = f
-	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	if !m.ImportUsersMock.invocationsDone() && afterImportUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ImportUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportUsersMock.expectedInvocations), m.ImportUsersMock.expectedInvocationsOrigin, afterImportUsersCounter)
+	}
 }
 
-// When sets expectation for the UserService.GetUser which will trigger the result defined by the following
+type mUserServiceMockListUsers struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockListUsersExpectation
+	expectations       []*UserServiceMockListUsersExpectation
+
+	callArgs []*UserServiceMockListUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockListUsersExpectation specifies expectation struct of the UserService.ListUsers
+type UserServiceMockListUsersExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockListUsersParams
+	paramPtrs          *UserServiceMockListUsersParamPtrs
+	expectationOrigins UserServiceMockListUsersExpectationOrigins
+	results            *UserServiceMockListUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockListUsersParams contains parameters of the UserService.ListUsers
+type UserServiceMockListUsersParams struct {
+	ctx     context.Context
+	afterID int64
+	limit   uint64
+}
+
+// UserServiceMockListUsersParamPtrs contains pointers to parameters of the UserService.ListUsers
+type UserServiceMockListUsersParamPtrs struct {
+	ctx     *context.Context
+	afterID *int64
+	limit   *uint64
+}
+
+// UserServiceMockListUsersResults contains results of the UserService.ListUsers
+type UserServiceMockListUsersResults struct {
+	upa1 []*model.User
+	err  error
+}
+
+// UserServiceMockListUsersOrigins contains origins of expectations of the UserService.ListUsers
+type UserServiceMockListUsersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUsers *mUserServiceMockListUsers) Optional() *mUserServiceMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
+}
+
+// Expect sets up expected params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Expect(ctx context.Context, afterID int64, limit uint64) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by ExpectParams functions")
+	}
+
+	mmListUsers.defaultExpectation.params = &UserServiceMockListUsersParams{ctx, afterID, limit}
+	mmListUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmListUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUsers
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectAfterIDParam2(afterID int64) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListUsers.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListUsers
+}
+
+// ExpectLimitParam3 sets up expected param limit for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectLimitParam3(limit uint64) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.limit = &limit
+	mmListUsers.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Inspect(f func(ctx context.Context, afterID int64, limit uint64)) *mUserServiceMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListUsers")
+	}
+
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Return(upa1 []*model.User, err error) *UserServiceMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserServiceMockListUsersResults{upa1, err}
+	mmListUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ListUsers method
+func (mmListUsers *mUserServiceMockListUsers) Set(f func(ctx context.Context, afterID int64, limit uint64) (upa1 []*model.User, err error)) *UserServiceMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	mmListUsers.mock.funcListUsersOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserService.ListUsers which will trigger the result defined by the following
 // Then helper
-func (mmGetUser *mUserServiceMockGetUser) When(ctx context.Context, id int64) *UserServiceMockGetUserExpectation {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+func (mmListUsers *mUserServiceMockListUsers) When(ctx context.Context, afterID int64, limit uint64) *UserServiceMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockGetUserExpectation{
-		mock:               mmGetUser.mock,
-		params:             &UserServiceMockGetUserParams{ctx, id},
-		expectationOrigins: UserServiceMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserServiceMockListUsersExpectation{
+		mock:               mmListUsers.mock,
+		params:             &UserServiceMockListUsersParams{ctx, afterID, limit},
+		expectationOrigins: UserServiceMockListUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.GetUser return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockGetUserExpectation) Then(up1 *model.User, err error) *UserServiceMock {
-	e.results = &UserServiceMockGetUserResults{up1, err}
+// Then sets up UserService.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListUsersExpectation) Then(upa1 []*model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockListUsersResults{upa1, err}
 	return e.mock
 }
 
-// Times sets number of times UserService.GetUser should be invoked
-func (mmGetUser *mUserServiceMockGetUser) Times(n uint64) *mUserServiceMockGetUser {
+// Times sets number of times UserService.ListUsers should be invoked
+func (mmListUsers *mUserServiceMockListUsers) Times(n uint64) *mUserServiceMockListUsers {
 	if n == 0 {
-		mmGetUser.mock.t.Fatalf("Times of UserServiceMock.GetUser mock can not be zero")
+		mmListUsers.mock.t.Fatalf("Times of UserServiceMock.ListUsers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
-	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUser
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	mmListUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUsers
 }
 
-func (mmGetUser *mUserServiceMockGetUser) invocationsDone() bool {
-	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+func (mmListUsers *mUserServiceMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUser implements mm_service.UserService
-func (mmGetUser *UserServiceMock) GetUser(ctx context.Context, id int64) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+// ListUsers implements mm_service.UserService
+func (mmListUsers *UserServiceMock) ListUsers(ctx context.Context, afterID int64, limit uint64) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
 
-	mmGetUser.t.Helper()
+	mmListUsers.t.Helper()
 
-	if mmGetUser.inspectFuncGetUser != nil {
-		mmGetUser.inspectFuncGetUser(ctx, id)
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, afterID, limit)
 	}
 
-	mm_params := UserServiceMockGetUserParams{ctx, id}
+	mm_params := UserServiceMockListUsersParams{ctx, afterID, limit}
 
 	// Record call args
-	mmGetUser.GetUserMock.mutex.Lock()
-	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
-	mmGetUser.GetUserMock.mutex.Unlock()
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
 
-	for _, e := range mmGetUser.GetUserMock.expectations {
+	for _, e := range mmListUsers.ListUsersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.upa1, e.results.err
 		}
 	}
 
-	if mmGetUser.GetUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockGetUserParams{ctx, id}
+		mm_got := UserServiceMockListUsersParams{ctx, afterID, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUser.t.Fatal("No results are set for the UserServiceMock.GetUser")
+			mmListUsers.t.Fatal("No results are set for the UserServiceMock.ListUsers")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).upa1, (*mm_results).err
 	}
-	if mmGetUser.funcGetUser != nil {
-		return mmGetUser.funcGetUser(ctx, id)
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, afterID, limit)
 	}
-	mmGetUser.t.Fatalf("Unexpected call to UserServiceMock.GetUser. %v %v", ctx, id)
+	mmListUsers.t.Fatalf("Unexpected call to UserServiceMock.ListUsers. %v %v %v", ctx, afterID, limit)
 	return
 }
 
-// GetUserAfterCounter returns a count of finished UserServiceMock.GetUser invocations
-func (mmGetUser *UserServiceMock) GetUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+// ListUsersAfterCounter returns a count of finished UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
 }
 
-// GetUserBeforeCounter returns a count of UserServiceMock.GetUser invocations
-func (mmGetUser *UserServiceMock) GetUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+// ListUsersBeforeCounter returns a count of UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.GetUser.
+// Calls returns a list of arguments used in each call to UserServiceMock.ListUsers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUser *mUserServiceMockGetUser) Calls() []*UserServiceMockGetUserParams {
-	mmGetUser.mutex.RLock()
+func (mmListUsers *mUserServiceMockListUsers) Calls() []*UserServiceMockListUsersParams {
+	mmListUsers.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockGetUserParams, len(mmGetUser.callArgs))
-	copy(argCopy, mmGetUser.callArgs)
+	argCopy := make([]*UserServiceMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
 
-	mmGetUser.mutex.RUnlock()
+	mmListUsers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockGetUserDone() bool {
-	if m.GetUserMock.optional {
+func (m *UserServiceMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserMock.expectations {
+	for _, e := range m.ListUsersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserMock.invocationsDone()
+	return m.ListUsersMock.invocationsDone()
 }
 
-// MinimockGetUserInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockGetUserInspect() {
-	for _, e := range m.GetUserMock.expectations {
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
-		if m.GetUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s", m.ListUsersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s with params: %#v", m.ListUsersMock.defaultExpectation.expectationOrigins.origin, *m.ListUsersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUser != nil && afterGetUserCounter < 1 {
-		m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.funcGetUserOrigin)
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s", m.funcListUsersOrigin)
 	}
 
-	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserServiceMock.GetUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ListUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), m.ListUsersMock.expectedInvocationsOrigin, afterListUsersCounter)
 	}
 }
 
@@ -1447,12 +4012,26 @@ func (m *UserServiceMock) MinimockUpdateUserInspect() {
 func (m *UserServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockBatchGetUsersInspect()
+
 			m.MinimockCreateUserInspect()
 
 			m.MinimockDeleteUserInspect()
 
+			m.MinimockExportUsersInspect()
+
+			m.MinimockGetOperationInspect()
+
 			m.MinimockGetUserInspect()
 
+			m.MinimockGetUserByEmailInspect()
+
+			m.MinimockGetUserByNameInspect()
+
+			m.MinimockImportUsersInspect()
+
+			m.MinimockListUsersInspect()
+
 			m.MinimockUpdateUserInspect()
 		}
 	})
@@ -1477,8 +4056,15 @@ func (m *UserServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockBatchGetUsersDone() &&
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
+		m.MinimockExportUsersDone() &&
+		m.MinimockGetOperationDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockGetUserByEmailDone() &&
+		m.MinimockGetUserByNameDone() &&
+		m.MinimockImportUsersDone() &&
+		m.MinimockListUsersDone() &&
 		m.MinimockUpdateUserDone()
 }
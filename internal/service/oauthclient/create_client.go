@@ -0,0 +1,48 @@
+package oauthclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+const clientIDSize = 16
+
+// CreateClient registers new OAuth client in admin organization
+func (s *serv) CreateClient(ctx context.Context, accessToken string, client *model.OAuthClient) (
+	*model.OAuthClient, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "create oauth client (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"CreateClient", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateCreateClient(client)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID := make([]byte, clientIDSize)
+	if _, err = rand.Read(clientID); err != nil {
+		return nil, err
+	}
+
+	created := *client
+	created.ClientID = hex.EncodeToString(clientID)
+
+	_, err = s.oauthClientRepository.CreateOAuthClient(ctx, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.oauthClientRepository.GetOAuthClient(ctx, created.ClientID)
+}
@@ -0,0 +1,23 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// DeleteClient deletes OAuth client of admin organization
+func (s *serv) DeleteClient(ctx context.Context, accessToken string, clientID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "delete oauth client (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"DeleteClient", nil)
+	if err != nil {
+		return err
+	}
+
+	return s.oauthClientRepository.DeleteOAuthClient(ctx, clientID)
+}
@@ -0,0 +1,24 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListClients returns OAuth clients of admin organization
+func (s *serv) ListClients(ctx context.Context, accessToken string) ([]*model.OAuthClient, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list oauth clients (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ListClients", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.oauthClientRepository.ListOAuthClients(ctx)
+}
@@ -0,0 +1,25 @@
+package oauthclient
+
+import (
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+)
+
+// endpointPrefix is prefix of endpoints of OAuth clients administration in access rules
+const endpointPrefix = "/oauth_v1.OAuthV1/"
+
+type serv struct {
+	oauthClientRepository repository.OAuthClientRepository
+	accessService         service.AccessService
+}
+
+// NewService creates new service with settings
+func NewService(
+	oauthClientRepository repository.OAuthClientRepository,
+	accessService service.AccessService,
+) service.OAuthClientService {
+	return &serv{
+		oauthClientRepository: oauthClientRepository,
+		accessService:         accessService,
+	}
+}
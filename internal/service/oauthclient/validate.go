@@ -0,0 +1,62 @@
+package oauthclient
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+func validateCreateClient(client *model.OAuthClient) error {
+	if client == nil {
+		return errors.New("unable to create oauth client: empty model")
+	}
+	if strings.TrimSpace(client.Name) == "" {
+		return errors.New("unable to create oauth client: name is required")
+	}
+	if len(client.RedirectURIs) == 0 {
+		return errors.New("unable to create oauth client: redirect uri is required")
+	}
+	for _, redirectURI := range client.RedirectURIs {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return errors.Wrap(err, "unable to create oauth client")
+		}
+	}
+
+	return nil
+}
+
+// validateRedirectURI accepts https URIs, http URIs of loopback hosts and private-use
+// scheme URIs of native apps, RFC 8252 section 7
+func validateRedirectURI(redirectURI string) error {
+	uri, err := url.Parse(redirectURI)
+	if err != nil {
+		return errors.Errorf("invalid redirect uri %q", redirectURI)
+	}
+	if uri.Fragment != "" || strings.Contains(redirectURI, "#") {
+		return errors.Errorf("redirect uri %q must not contain fragment", redirectURI)
+	}
+
+	switch uri.Scheme {
+	case "https":
+		if uri.Host == "" {
+			return errors.Errorf("redirect uri %q has no host", redirectURI)
+		}
+	case "http":
+		host := uri.Hostname()
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return errors.Errorf("redirect uri %q: http is allowed for loopback hosts only",
+				redirectURI)
+		}
+	default:
+		if !strings.Contains(uri.Scheme, ".") {
+			return errors.Errorf("redirect uri %q: private-use scheme must be reverse domain "+
+				"name", redirectURI)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+)
+
+// authorizePlatform returns error unless caller is platform operator, i.e. belongs
+// to default organization, only operators create, list and delete organizations
+func authorizePlatform(ctx context.Context) error {
+	claims, ok := identity.FromContext(ctx)
+	if !ok {
+		return model.ErrorUnauthenticated
+	}
+	if claims.TenantID != model.DefaultTenantID {
+		return errors.Wrap(model.ErrorPermissionDenied, "platform operator required")
+	}
+
+	return nil
+}
+
+// authorizeOrganization returns error unless caller belongs to organization
+// or is platform operator, access rules of caller tenant can't grant access
+// to other organizations
+func authorizeOrganization(ctx context.Context, organizationID int64) error {
+	claims, ok := identity.FromContext(ctx)
+	if !ok {
+		return model.ErrorUnauthenticated
+	}
+	if claims.TenantID != organizationID && claims.TenantID != model.DefaultTenantID {
+		return errors.Wrap(model.ErrorPermissionDenied, "organization of caller required")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// CreateOrganization creates organization with default roles and access rules
+// on behalf of platform operator,
+// ADMIN inherits USER and gets default permissions, they are created before access rules
+// referencing them
+func (s *serv) CreateOrganization(ctx context.Context, name string) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "create organization (service)")
+	defer span.Finish()
+
+	err := authorizePlatform(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		errTx = validateCreateOrganization(name)
+		if errTx != nil {
+			return errTx
+		}
+
+		id, errTx = s.organizationRepository.CreateOrganization(ctx, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		tenantCtx := tenant.NewContext(ctx, id)
+		for _, role := range model.DefaultRoles {
+			_, errTx = s.roleRepository.CreateRole(tenantCtx, &model.Role{Name: role})
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		errTx = s.roleRepository.AddRoleParent(tenantCtx, model.RoleAdmin, model.RoleUser)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, permission := range model.DefaultPermissions {
+			_, errTx = s.permissionRepository.CreatePermission(tenantCtx, &model.Permission{
+				Name:        permission.Name,
+				Description: permission.Description,
+			})
+			if errTx != nil {
+				return errTx
+			}
+
+			errTx = s.permissionRepository.GrantPermission(tenantCtx, model.RoleAdmin,
+				permission.Name)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		for _, rule := range model.DefaultAccessRules {
+			_, errTx = s.accessRepository.CreateRule(tenantCtx, rule)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
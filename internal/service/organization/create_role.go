@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// CreateRole creates role in organization
+func (s *serv) CreateRole(ctx context.Context, role *model.Role) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "create role (service)")
+	defer span.Finish()
+
+	err := validateCreateRole(role)
+	if err != nil {
+		return 0, err
+	}
+
+	err = authorizeOrganization(ctx, role.TenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = s.organizationRepository.GetOrganization(ctx, role.TenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.roleRepository.CreateRole(tenant.NewContext(ctx, role.TenantID), role)
+}
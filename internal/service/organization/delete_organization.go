@@ -0,0 +1,28 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// DeleteOrganization deletes organization on behalf of platform operator,
+// its users and rules are deleted by cascade
+func (s *serv) DeleteOrganization(ctx context.Context, id int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "delete organization (service)")
+	defer span.Finish()
+
+	err := authorizePlatform(ctx)
+	if err != nil {
+		return err
+	}
+
+	if id == model.DefaultTenantID {
+		return errors.New("unable to delete organization: default organization can't be deleted")
+	}
+
+	return s.organizationRepository.DeleteOrganization(ctx, id)
+}
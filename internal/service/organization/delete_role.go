@@ -0,0 +1,27 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// DeleteRole deletes role of organization, roles assigned to users can't be deleted
+func (s *serv) DeleteRole(ctx context.Context, organizationID int64, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "delete role (service)")
+	defer span.Finish()
+
+	err := authorizeOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.organizationRepository.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	return s.roleRepository.DeleteRole(tenant.NewContext(ctx, organizationID), name)
+}
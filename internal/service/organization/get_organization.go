@@ -0,0 +1,22 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// GetOrganization returns organization by id
+func (s *serv) GetOrganization(ctx context.Context, id int64) (*model.Organization, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get organization (service)")
+	defer span.Finish()
+
+	err := authorizeOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.organizationRepository.GetOrganization(ctx, id)
+}
@@ -0,0 +1,22 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// ListOrganizations returns all organizations to platform operator
+func (s *serv) ListOrganizations(ctx context.Context) ([]*model.Organization, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list organizations (service)")
+	defer span.Finish()
+
+	err := authorizePlatform(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.organizationRepository.ListOrganizations(ctx)
+}
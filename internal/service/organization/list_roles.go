@@ -0,0 +1,28 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// ListRoles returns roles of organization
+func (s *serv) ListRoles(ctx context.Context, organizationID int64) ([]*model.Role, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list roles (service)")
+	defer span.Finish()
+
+	err := authorizeOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.organizationRepository.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roleRepository.ListRoles(tenant.NewContext(ctx, organizationID))
+}
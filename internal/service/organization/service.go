@@ -0,0 +1,32 @@
+package organization
+
+import (
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+type serv struct {
+	organizationRepository repository.OrganizationRepository
+	roleRepository         repository.RoleRepository
+	accessRepository       repository.AccessRepository
+	permissionRepository   repository.PermissionRepository
+	txManager              db.TxManager
+}
+
+// NewService creates new service with settings
+func NewService(
+	organizationRepository repository.OrganizationRepository,
+	roleRepository repository.RoleRepository,
+	accessRepository repository.AccessRepository,
+	permissionRepository repository.PermissionRepository,
+	txManager db.TxManager,
+) service.OrganizationService {
+	return &serv{
+		organizationRepository: organizationRepository,
+		roleRepository:         roleRepository,
+		accessRepository:       accessRepository,
+		permissionRepository:   permissionRepository,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,329 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/organization"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	t.Parallel()
+	type organizationRepositoryMockFunc func(mc *minimock.Controller) repository.OrganizationRepository
+	type roleRepositoryMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type accessRepositoryMockFunc func(mc *minimock.Controller) repository.AccessRepository
+	type permissionRepositoryMockFunc func(mc *minimock.Controller) repository.PermissionRepository
+	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+
+	var (
+		ctx = identity.NewContext(context.Background(), &model.UserClaims{
+			UserID:   1,
+			Role:     model.RoleAdmin,
+			TenantID: model.DefaultTenantID,
+		})
+		tenantAdminCtx = identity.NewContext(context.Background(), &model.UserClaims{
+			UserID:   2,
+			Role:     model.RoleAdmin,
+			TenantID: 4,
+		})
+		mc = minimock.NewController(t)
+
+		id   = int64(5)
+		name = "acme"
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	noRulesFunc := func(mc *minimock.Controller) repository.AccessRepository {
+		return repoMocks.NewAccessRepositoryMock(mc)
+	}
+	noPermissionsFunc := func(mc *minimock.Controller) repository.PermissionRepository {
+		return repoMocks.NewPermissionRepositoryMock(mc)
+	}
+
+	noTxFunc := func(mc *minimock.Controller) db.TxManager {
+		return dbMocks.NewTxManagerMock(mc)
+	}
+	txManagerFunc := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.
+			Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+		return mock
+	}
+
+	testsSuccessful := []struct {
+		name                       string
+		args                       args
+		want                       int64
+		organizationRepositoryMock organizationRepositoryMockFunc
+		roleRepositoryMock         roleRepositoryMockFunc
+		accessRepositoryMock       accessRepositoryMockFunc
+		permissionRepositoryMock   permissionRepositoryMockFunc
+		txManagerMock              txManagerMockFunc
+	}{
+		{
+			name: "success case: default roles are created in new tenant",
+			args: args{
+				ctx:  ctx,
+				name: name,
+			},
+			want: id,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				mock := repoMocks.NewOrganizationRepositoryMock(mc)
+				mock.CreateOrganizationMock.Set(func(_ context.Context, _ string) (int64, error) {
+					return id, nil
+				})
+				return mock
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.CreateRoleMock.Set(func(ctx context.Context, _ *model.Role) (int64, error) {
+					tenantID, err := tenant.FromContext(ctx)
+					if err != nil || tenantID != id {
+						return 0, fmt.Errorf("role created in wrong tenant")
+					}
+					return 1, nil
+				})
+				mock.AddRoleParentMock.Set(func(ctx context.Context, role, parent string) error {
+					tenantID, err := tenant.FromContext(ctx)
+					if err != nil || tenantID != id {
+						return fmt.Errorf("role parent added in wrong tenant")
+					}
+					if role != model.RoleAdmin || parent != model.RoleUser {
+						return fmt.Errorf("wrong default role inheritance")
+					}
+					return nil
+				})
+				return mock
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repoMocks.NewAccessRepositoryMock(mc)
+				mock.CreateRuleMock.Times(uint64(len(model.DefaultAccessRules))).
+					Set(func(ctx context.Context, _ *model.AccessRule) (int64, error) {
+						tenantID, err := tenant.FromContext(ctx)
+						if err != nil || tenantID != id {
+							return 0, fmt.Errorf("access rule created in wrong tenant")
+						}
+						return 1, nil
+					})
+				return mock
+			},
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.CreatePermissionMock.Set(func(ctx context.Context, permission *model.Permission) (
+					int64, error,
+				) {
+					tenantID, err := tenant.FromContext(ctx)
+					if err != nil || tenantID != id {
+						return 0, fmt.Errorf("permission created in wrong tenant")
+					}
+					if permission.Name != model.PermissionElevationApprove &&
+						permission.Name != model.PermissionSCIMProvision {
+						return 0, fmt.Errorf("wrong default permission")
+					}
+					return 1, nil
+				})
+				mock.GrantPermissionMock.Times(uint64(len(model.DefaultPermissions))).
+					Set(func(_ context.Context, role, _ string) error {
+						if role != model.RoleAdmin {
+							return fmt.Errorf("default permission granted to wrong role")
+						}
+						return nil
+					})
+				return mock
+			},
+			txManagerMock: txManagerFunc,
+		},
+	}
+	testsErrors := []struct {
+		name                       string
+		args                       args
+		err                        error
+		organizationRepositoryMock organizationRepositoryMockFunc
+		roleRepositoryMock         roleRepositoryMockFunc
+		accessRepositoryMock       accessRepositoryMockFunc
+		permissionRepositoryMock   permissionRepositoryMockFunc
+		txManagerMock              txManagerMockFunc
+	}{
+		{
+			name: "error: caller is admin of other organization",
+			args: args{
+				ctx:  tenantAdminCtx,
+				name: name,
+			},
+			err: model.ErrorPermissionDenied,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				return repoMocks.NewOrganizationRepositoryMock(mc)
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+			accessRepositoryMock:     noRulesFunc,
+			permissionRepositoryMock: noPermissionsFunc,
+			txManagerMock:            noTxFunc,
+		},
+		{
+			name: "error: anonymous caller",
+			args: args{
+				ctx:  context.Background(),
+				name: name,
+			},
+			err: model.ErrorUnauthenticated,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				return repoMocks.NewOrganizationRepositoryMock(mc)
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+			accessRepositoryMock:     noRulesFunc,
+			permissionRepositoryMock: noPermissionsFunc,
+			txManagerMock:            noTxFunc,
+		},
+		{
+			name: "error: validation error (empty name)",
+			args: args{
+				ctx:  ctx,
+				name: "",
+			},
+			err: fmt.Errorf("unable to create organization: name is required"),
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				return repoMocks.NewOrganizationRepositoryMock(mc)
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+			accessRepositoryMock:     noRulesFunc,
+			permissionRepositoryMock: noPermissionsFunc,
+			txManagerMock:            txManagerFunc,
+		},
+		{
+			name: "repo error: create role",
+			args: args{
+				ctx:  ctx,
+				name: name,
+			},
+			err: repoErr,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				mock := repoMocks.NewOrganizationRepositoryMock(mc)
+				mock.CreateOrganizationMock.Set(func(_ context.Context, _ string) (int64, error) {
+					return id, nil
+				})
+				return mock
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.CreateRoleMock.Return(0, repoErr)
+				return mock
+			},
+			accessRepositoryMock:     noRulesFunc,
+			permissionRepositoryMock: noPermissionsFunc,
+			txManagerMock:            txManagerFunc,
+		},
+		{
+			name: "repo error: create access rule",
+			args: args{
+				ctx:  ctx,
+				name: name,
+			},
+			err: repoErr,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				mock := repoMocks.NewOrganizationRepositoryMock(mc)
+				mock.CreateOrganizationMock.Return(id, nil)
+				return mock
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.CreateRoleMock.Return(1, nil)
+				mock.AddRoleParentMock.Return(nil)
+				return mock
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repoMocks.NewAccessRepositoryMock(mc)
+				mock.CreateRuleMock.Return(0, repoErr)
+				return mock
+			},
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.CreatePermissionMock.Return(1, nil)
+				mock.GrantPermissionMock.Return(nil)
+				return mock
+			},
+			txManagerMock: txManagerFunc,
+		},
+		{
+			name: "repo error: grant default permission",
+			args: args{
+				ctx:  ctx,
+				name: name,
+			},
+			err: repoErr,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				mock := repoMocks.NewOrganizationRepositoryMock(mc)
+				mock.CreateOrganizationMock.Return(id, nil)
+				return mock
+			},
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.CreateRoleMock.Return(1, nil)
+				mock.AddRoleParentMock.Return(nil)
+				return mock
+			},
+			accessRepositoryMock: noRulesFunc,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.CreatePermissionMock.Return(1, nil)
+				mock.GrantPermissionMock.Return(repoErr)
+				return mock
+			},
+			txManagerMock: txManagerFunc,
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := organization.NewService(tt.organizationRepositoryMock(mc),
+				tt.roleRepositoryMock(mc), tt.accessRepositoryMock(mc),
+				tt.permissionRepositoryMock(mc), tt.txManagerMock(mc))
+
+			newID, err := service.CreateOrganization(tt.args.ctx, tt.args.name)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, newID)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := organization.NewService(tt.organizationRepositoryMock(mc),
+				tt.roleRepositoryMock(mc), tt.accessRepositoryMock(mc),
+				tt.permissionRepositoryMock(mc), tt.txManagerMock(mc))
+
+			_, err := service.CreateOrganization(tt.args.ctx, tt.args.name)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/organization"
+	"github.com/valek177/auth/internal/tenant"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestCreateRole(t *testing.T) {
+	t.Parallel()
+	type organizationRepositoryMockFunc func(mc *minimock.Controller) repository.OrganizationRepository
+	type roleRepositoryMockFunc func(mc *minimock.Controller) repository.RoleRepository
+
+	type args struct {
+		ctx  context.Context
+		role *model.Role
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		tenantID = int64(4)
+		roleID   = int64(9)
+
+		callerCtx = func(tenantID int64) context.Context {
+			return identity.NewContext(context.Background(), &model.UserClaims{
+				UserID:   2,
+				Role:     model.RoleAdmin,
+				TenantID: tenantID,
+			})
+		}
+		role = &model.Role{TenantID: tenantID, Name: "SUPPORT"}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	createdFunc := func(mc *minimock.Controller) repository.RoleRepository {
+		mock := repoMocks.NewRoleRepositoryMock(mc)
+		mock.CreateRoleMock.Set(func(ctx context.Context, _ *model.Role) (int64, error) {
+			id, err := tenant.FromContext(ctx)
+			if err != nil || id != tenantID {
+				return 0, fmt.Errorf("role created in wrong tenant")
+			}
+			return roleID, nil
+		})
+		return mock
+	}
+	organizationFunc := func(mc *minimock.Controller) repository.OrganizationRepository {
+		mock := repoMocks.NewOrganizationRepositoryMock(mc)
+		mock.GetOrganizationMock.Return(&model.Organization{ID: tenantID}, nil)
+		return mock
+	}
+	noOrganizationFunc := func(mc *minimock.Controller) repository.OrganizationRepository {
+		return repoMocks.NewOrganizationRepositoryMock(mc)
+	}
+	noRolesFunc := func(mc *minimock.Controller) repository.RoleRepository {
+		return repoMocks.NewRoleRepositoryMock(mc)
+	}
+
+	testsSuccessful := []struct {
+		name                       string
+		args                       args
+		organizationRepositoryMock organizationRepositoryMockFunc
+		roleRepositoryMock         roleRepositoryMockFunc
+	}{
+		{
+			name: "success case: admin of organization",
+			args: args{
+				ctx:  callerCtx(tenantID),
+				role: role,
+			},
+			organizationRepositoryMock: organizationFunc,
+			roleRepositoryMock:         createdFunc,
+		},
+		{
+			name: "success case: platform operator",
+			args: args{
+				ctx:  callerCtx(model.DefaultTenantID),
+				role: role,
+			},
+			organizationRepositoryMock: organizationFunc,
+			roleRepositoryMock:         createdFunc,
+		},
+	}
+	testsErrors := []struct {
+		name                       string
+		args                       args
+		err                        error
+		organizationRepositoryMock organizationRepositoryMockFunc
+		roleRepositoryMock         roleRepositoryMockFunc
+	}{
+		{
+			name: "error: admin of other organization",
+			args: args{
+				ctx:  callerCtx(5),
+				role: role,
+			},
+			err:                        model.ErrorPermissionDenied,
+			organizationRepositoryMock: noOrganizationFunc,
+			roleRepositoryMock:         noRolesFunc,
+		},
+		{
+			name: "error: anonymous caller",
+			args: args{
+				ctx:  context.Background(),
+				role: role,
+			},
+			err:                        model.ErrorUnauthenticated,
+			organizationRepositoryMock: noOrganizationFunc,
+			roleRepositoryMock:         noRolesFunc,
+		},
+		{
+			name: "repo error: organization not found",
+			args: args{
+				ctx:  callerCtx(model.DefaultTenantID),
+				role: role,
+			},
+			err: repoErr,
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				mock := repoMocks.NewOrganizationRepositoryMock(mc)
+				mock.GetOrganizationMock.Return(nil, repoErr)
+				return mock
+			},
+			roleRepositoryMock: noRolesFunc,
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := organization.NewService(tt.organizationRepositoryMock(mc),
+				tt.roleRepositoryMock(mc), repoMocks.NewAccessRepositoryMock(mc),
+				repoMocks.NewPermissionRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+			id, err := service.CreateRole(tt.args.ctx, tt.args.role)
+
+			assert.Nil(t, err)
+			assert.Equal(t, roleID, id)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := organization.NewService(tt.organizationRepositoryMock(mc),
+				tt.roleRepositoryMock(mc), repoMocks.NewAccessRepositoryMock(mc),
+				repoMocks.NewPermissionRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+			_, err := service.CreateRole(tt.args.ctx, tt.args.role)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/identity"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/organization"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestDeleteOrganization(t *testing.T) {
+	t.Parallel()
+	type organizationRepositoryMockFunc func(mc *minimock.Controller) repository.OrganizationRepository
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		id = int64(4)
+
+		callerCtx = func(tenantID int64) context.Context {
+			return identity.NewContext(context.Background(), &model.UserClaims{
+				UserID:   2,
+				Role:     model.RoleAdmin,
+				TenantID: tenantID,
+			})
+		}
+	)
+
+	noOrganizationFunc := func(mc *minimock.Controller) repository.OrganizationRepository {
+		return repoMocks.NewOrganizationRepositoryMock(mc)
+	}
+
+	tests := []struct {
+		name                       string
+		args                       args
+		err                        error
+		organizationRepositoryMock organizationRepositoryMockFunc
+	}{
+		{
+			name: "success case: platform operator",
+			args: args{
+				ctx: callerCtx(model.DefaultTenantID),
+				id:  id,
+			},
+			organizationRepositoryMock: func(mc *minimock.Controller) repository.OrganizationRepository {
+				mock := repoMocks.NewOrganizationRepositoryMock(mc)
+				mock.DeleteOrganizationMock.Expect(minimock.AnyContext, id).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "error: admin of other organization",
+			args: args{
+				ctx: callerCtx(5),
+				id:  id,
+			},
+			err:                        model.ErrorPermissionDenied,
+			organizationRepositoryMock: noOrganizationFunc,
+		},
+		{
+			name: "error: admin of deleted organization",
+			args: args{
+				ctx: callerCtx(id),
+				id:  id,
+			},
+			err:                        model.ErrorPermissionDenied,
+			organizationRepositoryMock: noOrganizationFunc,
+		},
+		{
+			name: "error: anonymous caller",
+			args: args{
+				ctx: context.Background(),
+				id:  id,
+			},
+			err:                        model.ErrorUnauthenticated,
+			organizationRepositoryMock: noOrganizationFunc,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := organization.NewService(tt.organizationRepositoryMock(mc),
+				repoMocks.NewRoleRepositoryMock(mc), repoMocks.NewAccessRepositoryMock(mc),
+				repoMocks.NewPermissionRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+			err := service.DeleteOrganization(tt.args.ctx, tt.args.id)
+
+			if tt.err == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
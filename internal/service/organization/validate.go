@@ -0,0 +1,29 @@
+package organization
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+func validateCreateOrganization(name string) error {
+	if name == "" {
+		return errors.New("unable to create organization: name is required")
+	}
+
+	return nil
+}
+
+func validateCreateRole(role *model.Role) error {
+	if role == nil {
+		return errors.New("unable to create role: empty model")
+	}
+	if role.TenantID == 0 {
+		return errors.New("unable to create role: organization is required")
+	}
+	if role.Name == "" {
+		return errors.New("unable to create role: name is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package policy
+
+import (
+	"context"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// AddRoleParent makes role inherit all permissions of parent role
+func (s *serv) AddRoleParent(ctx context.Context, accessToken string,
+	parent *model.RoleParent,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "add role parent (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"AddRoleParent", nil)
+	if err != nil {
+		return err
+	}
+
+	if parent.Role == parent.Parent {
+		return errors.New("unable to add role parent: role can not inherit itself")
+	}
+	if err = s.validateRoleExists(ctx, parent.Role); err != nil {
+		return err
+	}
+	if err = s.validateRoleExists(ctx, parent.Parent); err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		ancestors, errTx := s.roleRepository.ListAncestorRoles(ctx, parent.Parent)
+		if errTx != nil {
+			return errTx
+		}
+		if slices.Contains(ancestors, parent.Role) {
+			return errors.Errorf("unable to add role parent: %s already inherits %s",
+				parent.Parent, parent.Role)
+		}
+
+		errTx = s.roleRepository.AddRoleParent(ctx, parent.Role, parent.Parent)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "add role parent", nil, parent)
+	})
+}
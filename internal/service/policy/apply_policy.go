@@ -0,0 +1,185 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/policybundle"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ApplyPolicy replaces policy of admin organization with bundle and stores it
+// as new policy version, dry run only returns the diff
+func (s *serv) ApplyPolicy(ctx context.Context, accessToken string, bundle *model.PolicyBundle,
+	dryRun bool,
+) (*model.PolicyApplyResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "apply policy (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ApplyPolicy", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePolicyBundle(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	return s.applyPolicyBundle(ctx, claims, bundle, model.PolicyBundleActionApply, dryRun)
+}
+
+// applyPolicyBundle applies diff between current policy and validated bundle in one transaction
+func (s *serv) applyPolicyBundle(ctx context.Context, claims *model.UserClaims,
+	bundle *model.PolicyBundle, action string, dryRun bool,
+) (*model.PolicyApplyResult, error) {
+	res := &model.PolicyApplyResult{}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		state, errTx := s.policyState(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		res.Diff = policybundle.Diff(state, bundle)
+		if dryRun || res.Diff.IsEmpty() {
+			return nil
+		}
+
+		// policy before the first apply is kept as version 0 to roll back to
+		errTx = s.policyBundleRepository.CreateInitialPolicyBundle(ctx,
+			&model.PolicyBundleVersion{
+				Bundle: state.Bundle(),
+				Diff:   &model.PolicyDiff{},
+				Author: claims.Username,
+				Action: model.PolicyBundleActionInitial,
+			})
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.applyPolicyDiff(ctx, res.Diff); errTx != nil {
+			return errTx
+		}
+		if errTx = s.checkCallerKeepsAccess(ctx, claims); errTx != nil {
+			return errTx
+		}
+
+		res.Version, errTx = s.policyBundleRepository.CreatePolicyBundle(ctx,
+			&model.PolicyBundleVersion{
+				Bundle: bundle,
+				Diff:   res.Diff,
+				Author: claims.Username,
+				Action: action,
+			})
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, action+" policy", nil, res.Diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// checkCallerKeepsAccess rejects applied policy which does not allow caller to apply policy,
+// otherwise caller could not fix or roll back the policy
+func (s *serv) checkCallerKeepsAccess(ctx context.Context, claims *model.UserClaims) error {
+	rules, err := s.accessRepository.ListRules(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	m, err := matcher.New(rules)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.decider.Decide(ctx, decision.NewSubject(claims),
+		m.Match(endpointPrefix+"ApplyPolicy"), nil, false)
+	if err != nil {
+		return err
+	}
+	if !res.Allowed {
+		return errors.New("unable to apply policy: caller would lose access to apply policy")
+	}
+
+	return nil
+}
+
+// applyPolicyDiff writes diff so that every step references only existing roles and permissions
+func (s *serv) applyPolicyDiff(ctx context.Context, diff *model.PolicyDiff) error {
+	for _, role := range diff.AddedRoles {
+		_, err := s.roleRepository.CreateRole(ctx, &model.Role{
+			Name:        role.Name,
+			Description: role.Description,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, permission := range diff.AddedPermissions {
+		_, err := s.permissionRepository.CreatePermission(ctx, &model.Permission{
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range diff.RemovedRules {
+		if err := s.accessRepository.DeleteRule(ctx, rule.ID); err != nil {
+			return err
+		}
+	}
+	for _, grant := range diff.RevokedPermissions {
+		if err := s.permissionRepository.RevokePermission(ctx, grant.Role,
+			grant.Permission); err != nil {
+			return err
+		}
+	}
+	for _, parent := range diff.RemovedRoleParents {
+		if err := s.roleRepository.DeleteRoleParent(ctx, parent.Role, parent.Parent); err != nil {
+			return err
+		}
+	}
+
+	for _, parent := range diff.AddedRoleParents {
+		if err := s.roleRepository.AddRoleParent(ctx, parent.Role, parent.Parent); err != nil {
+			return err
+		}
+	}
+	for _, grant := range diff.GrantedPermissions {
+		if err := s.permissionRepository.GrantPermission(ctx, grant.Role,
+			grant.Permission); err != nil {
+			return err
+		}
+	}
+	for _, rule := range diff.AddedRules {
+		if _, err := s.accessRepository.CreateRule(ctx, rule); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range diff.RemovedPermissions {
+		if err := s.permissionRepository.DeletePermission(ctx, name); err != nil {
+			return err
+		}
+	}
+	for _, name := range diff.RemovedRoles {
+		if err := s.roleRepository.DeleteRole(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// recordChange writes audit record of policy change made by admin
+func (s *serv) recordChange(ctx context.Context, claims *model.UserClaims, action string,
+	before, after interface{},
+) error {
+	_, err := s.policyChangeRepository.CreatePolicyChange(ctx, &model.PolicyChange{
+		Actor:  claims.Username,
+		Action: action,
+		Before: before,
+		After:  after,
+	})
+
+	return err
+}
@@ -0,0 +1,47 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// CreatePermission creates new permission in admin organization
+func (s *serv) CreatePermission(ctx context.Context, accessToken string,
+	permission *model.Permission,
+) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "create permission (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"CreatePermission", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = validatePermission(permission); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.permissionRepository.CreatePermission(ctx, permission)
+		if errTx != nil {
+			return errTx
+		}
+
+		created := *permission
+		created.ID = id
+
+		return s.recordChange(ctx, claims, "create permission", nil, &created)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
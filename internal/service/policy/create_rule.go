@@ -0,0 +1,56 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// CreateRule creates rule allowing or denying endpoint to permission, role or user,
+// rule without effect allows access
+func (s *serv) CreateRule(ctx context.Context, accessToken string, rule *model.AccessRule) (
+	int64, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "create access rule (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"CreateRule", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if rule != nil && rule.Effect == "" {
+		rule.Effect = model.AccessEffectAllow
+	}
+	if err = validateAccessRule(rule); err != nil {
+		return 0, err
+	}
+	if err = s.validateRuleSubjectExists(ctx, rule); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.accessRepository.CreateRule(ctx, rule)
+		if errTx != nil {
+			return errTx
+		}
+
+		created, errTx := s.accessRepository.GetRule(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "create rule", nil, created)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,43 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// DeletePermission deletes permission with its grants and access rules
+func (s *serv) DeletePermission(ctx context.Context, accessToken string, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "delete permission (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"DeletePermission", nil)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// rules are removed here rather than by cascade to drop cached endpoint rules
+		rules, errTx := s.accessRepository.ListRules(ctx, &model.AccessRuleFilter{Permission: name})
+		if errTx != nil {
+			return errTx
+		}
+		for _, rule := range rules {
+			if errTx = s.accessRepository.DeleteRule(ctx, rule.ID); errTx != nil {
+				return errTx
+			}
+		}
+
+		errTx = s.permissionRepository.DeletePermission(ctx, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "delete permission",
+			&model.Permission{Name: name}, nil)
+	})
+}
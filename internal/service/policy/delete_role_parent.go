@@ -0,0 +1,33 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// DeleteRoleParent removes inheritance of parent role
+func (s *serv) DeleteRoleParent(ctx context.Context, accessToken string,
+	parent *model.RoleParent,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "delete role parent (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"DeleteRoleParent", nil)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.roleRepository.DeleteRoleParent(ctx, parent.Role, parent.Parent)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "delete role parent", parent, nil)
+	})
+}
@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// DeleteRule deletes access rule
+func (s *serv) DeleteRule(ctx context.Context, accessToken string, id int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "delete access rule (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"DeleteRule", nil)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, errTx := s.accessRepository.GetRule(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.accessRepository.DeleteRule(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "delete rule", before, nil)
+	})
+}
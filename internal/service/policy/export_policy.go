@@ -0,0 +1,29 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ExportPolicy returns current policy of admin organization as policy bundle
+func (s *serv) ExportPolicy(ctx context.Context, accessToken string) (*model.PolicyBundle, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "export policy (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ExportPolicy", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.policyState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Bundle(), nil
+}
@@ -0,0 +1,50 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// GetRolePermissions returns parents, direct and effective permissions of role
+func (s *serv) GetRolePermissions(ctx context.Context, accessToken string, role string) (
+	*model.RolePermissions, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get role permissions (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"GetRolePermissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateRoleExists(ctx, role); err != nil {
+		return nil, err
+	}
+
+	parents, err := s.roleRepository.ListRoleParents(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.permissionRepository.ListRolePermissions(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	effective, err := s.permissionRepository.ListEffectivePermissions(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RolePermissions{
+		Role:                 role,
+		Parents:              parents,
+		Permissions:          permissions,
+		EffectivePermissions: effective,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// GrantPermission grants permission to role
+func (s *serv) GrantPermission(ctx context.Context, accessToken string,
+	grant *model.RolePermission,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "grant permission (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"GrantPermission", nil)
+	if err != nil {
+		return err
+	}
+
+	if err = s.validateRoleExists(ctx, grant.Role); err != nil {
+		return err
+	}
+	if err = s.validatePermissionExists(ctx, grant.Permission); err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.permissionRepository.GrantPermission(ctx, grant.Role, grant.Permission)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "grant permission", nil, grant)
+	})
+}
@@ -0,0 +1,26 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListPermissions returns permissions of admin organization
+func (s *serv) ListPermissions(ctx context.Context, accessToken string) (
+	[]*model.Permission, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list permissions (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ListPermissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.permissionRepository.ListPermissions(ctx)
+}
@@ -0,0 +1,26 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListPolicyVersions returns applied policy versions of admin organization, newest first
+func (s *serv) ListPolicyVersions(ctx context.Context, accessToken string) (
+	[]*model.PolicyBundleVersion, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list policy versions (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ListPolicyVersions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.policyBundleRepository.ListPolicyBundles(ctx)
+}
@@ -0,0 +1,26 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListRules returns access rules of admin organization
+func (s *serv) ListRules(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) (
+	[]*model.AccessRule, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list access rules (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ListRules", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.accessRepository.ListRules(ctx, filter)
+}
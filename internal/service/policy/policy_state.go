@@ -0,0 +1,53 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/policybundle"
+)
+
+// policyState loads current roles, permissions and access rules of tenant
+func (s *serv) policyState(ctx context.Context) (*policybundle.State, error) {
+	roles, err := s.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &policybundle.State{}
+	for _, role := range roles {
+		parents, errList := s.roleRepository.ListRoleParents(ctx, role.Name)
+		if errList != nil {
+			return nil, errList
+		}
+		permissions, errList := s.permissionRepository.ListRolePermissions(ctx, role.Name)
+		if errList != nil {
+			return nil, errList
+		}
+
+		state.Roles = append(state.Roles, &model.PolicyBundleRole{
+			Name:        role.Name,
+			Description: role.Description,
+			Parents:     parents,
+			Permissions: permissions,
+		})
+	}
+
+	permissions, err := s.permissionRepository.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, permission := range permissions {
+		state.Permissions = append(state.Permissions, &model.PolicyBundlePermission{
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	state.Rules, err = s.accessRepository.ListRules(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
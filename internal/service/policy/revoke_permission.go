@@ -0,0 +1,33 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// RevokePermission revokes permission granted to role directly
+func (s *serv) RevokePermission(ctx context.Context, accessToken string,
+	grant *model.RolePermission,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "revoke permission (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"RevokePermission", nil)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.permissionRepository.RevokePermission(ctx, grant.Role, grant.Permission)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "revoke permission", grant, nil)
+	})
+}
@@ -0,0 +1,37 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// RollbackPolicy applies bundle of stored policy version as new policy version
+func (s *serv) RollbackPolicy(ctx context.Context, accessToken string, version int64,
+	dryRun bool,
+) (*model.PolicyApplyResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "rollback policy (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"RollbackPolicy", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.policyBundleRepository.GetPolicyBundle(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// users of user rules may have been deleted since the version was applied
+	if err = s.validatePolicyBundle(ctx, stored.Bundle); err != nil {
+		return nil, err
+	}
+
+	return s.applyPolicyBundle(ctx, claims, stored.Bundle, model.PolicyBundleActionRollback,
+		dryRun)
+}
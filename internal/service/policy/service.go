@@ -0,0 +1,48 @@
+package policy
+
+import (
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+// endpointPrefix is prefix of endpoints of policy administration methods in access rules
+const endpointPrefix = "/access_admin_v1.AccessAdminV1/"
+
+type serv struct {
+	userRepository         repository.UserRepository
+	accessRepository       repository.AccessRepository
+	roleRepository         repository.RoleRepository
+	permissionRepository   repository.PermissionRepository
+	policyChangeRepository repository.PolicyChangeRepository
+	policyBundleRepository repository.PolicyBundleRepository
+	txManager              db.TxManager
+	accessService          service.AccessService
+	decider                *decision.Decider
+}
+
+// NewService creates new service with settings
+func NewService(
+	userRepository repository.UserRepository,
+	accessRepository repository.AccessRepository,
+	roleRepository repository.RoleRepository,
+	permissionRepository repository.PermissionRepository,
+	policyChangeRepository repository.PolicyChangeRepository,
+	policyBundleRepository repository.PolicyBundleRepository,
+	txManager db.TxManager,
+	accessService service.AccessService,
+	decider *decision.Decider,
+) service.PolicyService {
+	return &serv{
+		userRepository:         userRepository,
+		accessRepository:       accessRepository,
+		roleRepository:         roleRepository,
+		permissionRepository:   permissionRepository,
+		policyChangeRepository: policyChangeRepository,
+		policyBundleRepository: policyBundleRepository,
+		txManager:              txManager,
+		accessService:          accessService,
+		decider:                decider,
+	}
+}
@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"context"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// SimulateCheck explains access of user or role to endpoint as if they had access token,
+// proposed change of rules is evaluated instead of current rules and is not saved
+func (s *serv) SimulateCheck(ctx context.Context, accessToken string,
+	simulation *model.AccessSimulation,
+) (*model.AccessDecision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "simulate access check (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"SimulateCheck", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateSimulation(simulation); err != nil {
+		return nil, err
+	}
+
+	subjectClaims, err := s.simulationClaims(ctx, claims.TenantID, simulation)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.simulationRules(ctx, simulation)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decider.Decide(ctx, decision.NewSubject(subjectClaims), rules, simulation.Attributes,
+		true)
+}
+
+func validateSimulation(simulation *model.AccessSimulation) error {
+	if simulation == nil {
+		return errors.New("unable to simulate access check: empty model")
+	}
+	if (simulation.UserID == 0) == (simulation.Role == "") {
+		return errors.New("unable to simulate access check: exactly one of user and role " +
+			"is required")
+	}
+
+	return nil
+}
+
+// simulationClaims returns claims of hypothetical subject in tenant
+func (s *serv) simulationClaims(ctx context.Context, tenantID int64,
+	simulation *model.AccessSimulation,
+) (*model.UserClaims, error) {
+	if simulation.Role != "" {
+		if err := s.validateRoleExists(ctx, simulation.Role); err != nil {
+			return nil, err
+		}
+
+		return &model.UserClaims{Role: simulation.Role, TenantID: tenantID}, nil
+	}
+
+	user, err := s.userRepository.GetUser(ctx, simulation.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UserClaims{
+		UserID:   user.ID,
+		Username: user.Name,
+		Role:     user.Role,
+		TenantID: tenantID,
+	}, nil
+}
+
+// simulationRules returns rules matching endpoint, current rules are used
+// unless change of rules is proposed
+func (s *serv) simulationRules(ctx context.Context, simulation *model.AccessSimulation) (
+	*model.EndpointAccessRule, error,
+) {
+	if len(simulation.AddRules) == 0 && len(simulation.RemoveRuleIDs) == 0 {
+		return s.accessRepository.GetAccessRuleByEndpoint(ctx, simulation.Endpoint)
+	}
+
+	current, err := s.accessRepository.ListRules(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*model.AccessRule, 0, len(current)+len(simulation.AddRules))
+	removed := 0
+	for _, rule := range current {
+		if slices.Contains(simulation.RemoveRuleIDs, rule.ID) {
+			removed++
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if removed != len(simulation.RemoveRuleIDs) {
+		return nil, errors.New("unable to simulate access check: rule proposed to delete " +
+			"does not exist")
+	}
+
+	for i, rule := range simulation.AddRules {
+		if rule != nil && rule.Effect == "" {
+			rule.Effect = model.AccessEffectAllow
+		}
+		if err = validateAccessRule(rule); err != nil {
+			return nil, errors.Wrapf(err, "proposed rule %d", i+1)
+		}
+		if err = s.validateRuleSubjectExists(ctx, rule); err != nil {
+			return nil, errors.Wrapf(err, "proposed rule %d", i+1)
+		}
+
+		rule.ID = -int64(i + 1)
+		rules = append(rules, rule)
+	}
+
+	m, err := matcher.New(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Match(simulation.Endpoint), nil
+}
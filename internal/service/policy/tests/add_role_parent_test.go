@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/policy"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestAddRoleParent(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		adminToken  = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: 3,
+				Name:     "boss",
+				Role:     model.RoleAdmin,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	parentFunc := func(ancestors []string, addErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleMock.ListRolesMock.Return([]*model.Role{
+				{Name: model.RoleAdmin}, {Name: model.RoleUser}, {Name: "SUPPORT"},
+			}, nil)
+			roleMock.ListAncestorRolesMock.Return(ancestors, nil)
+			if ancestors == nil {
+				roleMock.AddRoleParentMock.Return(addErr)
+			}
+
+			policyChangeMock := repoMocks.NewPolicyChangeRepositoryMock(mc)
+			if ancestors == nil && addErr == nil {
+				policyChangeMock.CreatePolicyChangeMock.Return(1, nil)
+			}
+
+			txMock := dbMocks.NewTxManagerMock(mc)
+			txMock.ReadCommittedMock.
+				Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+
+			return mocks{
+				accessRepository:       repoMocks.NewAccessRepositoryMock(mc),
+				roleRepository:         roleMock,
+				permissionRepository:   repoMocks.NewPermissionRepositoryMock(mc),
+				policyChangeRepository: policyChangeMock,
+				revocationRepository:   revocationMock,
+				txManager:              txMock,
+			}
+		}
+	}
+	emptyMocks := func(mc *minimock.Controller) mocks {
+		revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+		revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+		return mocks{
+			accessRepository:       repoMocks.NewAccessRepositoryMock(mc),
+			roleRepository:         repoMocks.NewRoleRepositoryMock(mc),
+			permissionRepository:   repoMocks.NewPermissionRepositoryMock(mc),
+			policyChangeRepository: repoMocks.NewPolicyChangeRepositoryMock(mc),
+			revocationRepository:   revocationMock,
+			txManager:              dbMocks.NewTxManagerMock(mc),
+		}
+	}
+
+	testsSuccessful := []struct {
+		name   string
+		parent *model.RoleParent
+		mocks  mocksFunc
+	}{
+		{
+			name:   "success case: role inherits parent",
+			parent: &model.RoleParent{Role: "SUPPORT", Parent: model.RoleUser},
+			mocks:  parentFunc(nil, nil),
+		},
+	}
+	testsErrors := []struct {
+		name   string
+		parent *model.RoleParent
+		err    error
+		mocks  mocksFunc
+	}{
+		{
+			name:   "error: role inherits itself",
+			parent: &model.RoleParent{Role: "SUPPORT", Parent: "SUPPORT"},
+			err:    fmt.Errorf("role can not inherit itself"),
+			mocks:  emptyMocks,
+		},
+		{
+			name:   "error: inheritance cycle",
+			parent: &model.RoleParent{Role: model.RoleUser, Parent: model.RoleAdmin},
+			err:    fmt.Errorf("ADMIN already inherits USER"),
+			mocks:  parentFunc([]string{model.RoleUser}, nil),
+		},
+		{
+			name:   "error: unknown parent role",
+			parent: &model.RoleParent{Role: "SUPPORT", Parent: "GUEST"},
+			err:    fmt.Errorf("role GUEST does not exist"),
+			mocks: func(mc *minimock.Controller) mocks {
+				m := emptyMocks(mc)
+				roleMock := repoMocks.NewRoleRepositoryMock(mc)
+				roleMock.ListRolesMock.Return([]*model.Role{{Name: "SUPPORT"}}, nil)
+				m.roleRepository = roleMock
+				return m
+			},
+		},
+		{
+			name:   "repo error: add role parent",
+			parent: &model.RoleParent{Role: "SUPPORT", Parent: model.RoleUser},
+			err:    repoErr,
+			mocks:  parentFunc(nil, repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository), nil)
+
+			err := service.AddRoleParent(ctx, adminToken, tt.parent)
+
+			assert.Nil(t, err)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository), nil)
+
+			err := service.AddRoleParent(ctx, adminToken, tt.parent)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/policy"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestApplyPolicy(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID  = int64(3)
+		version   = int64(4)
+		adminName = "boss"
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		adminToken  = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: tenantID,
+				Name:     adminName,
+				Role:     model.RoleAdmin,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+
+		adminRule = &model.AccessRule{
+			Effect:   model.AccessEffectAllow,
+			Role:     model.RoleAdmin,
+			Endpoint: "/user_v1.UserV1",
+		}
+		policyRule = &model.AccessRule{
+			Effect:   model.AccessEffectAllow,
+			Role:     model.RoleAdmin,
+			Endpoint: "/access_admin_v1.AccessAdminV1",
+		}
+		readRule = &model.AccessRule{
+			Effect:     model.AccessEffectAllow,
+			Permission: "user:read",
+			Endpoint:   "/user_v1.UserV1/GetUser",
+		}
+		bundle = &model.PolicyBundle{
+			Roles: []*model.PolicyBundleRole{
+				{Name: model.RoleAdmin, Parents: []string{model.RoleUser},
+					Permissions: []string{"user:read"}},
+				{Name: model.RoleUser},
+			},
+			Permissions: []*model.PolicyBundlePermission{{Name: "user:read"}},
+			Rules: []*model.PolicyBundleRule{
+				{Role: model.RoleAdmin, Endpoint: "/user_v1.UserV1"},
+				{Role: model.RoleAdmin, Endpoint: "/access_admin_v1.AccessAdminV1"},
+				{Permission: "user:read", Endpoint: "/user_v1.UserV1/GetUser"},
+			},
+		}
+		lockoutBundle = &model.PolicyBundle{
+			Roles: []*model.PolicyBundleRole{
+				{Name: model.RoleAdmin, Parents: []string{model.RoleUser}},
+				{Name: model.RoleUser},
+			},
+			Rules: []*model.PolicyBundleRule{
+				{Role: model.RoleAdmin, Endpoint: "/user_v1.UserV1"},
+			},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	emptyMocks := func(mc *minimock.Controller) mocks {
+		revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+		revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+		return mocks{
+			accessRepository:       repoMocks.NewAccessRepositoryMock(mc),
+			roleRepository:         repoMocks.NewRoleRepositoryMock(mc),
+			permissionRepository:   repoMocks.NewPermissionRepositoryMock(mc),
+			policyChangeRepository: repoMocks.NewPolicyChangeRepositoryMock(mc),
+			revocationRepository:   revocationMock,
+			policyBundleRepository: repoMocks.NewPolicyBundleRepositoryMock(mc),
+			txManager:              dbMocks.NewTxManagerMock(mc),
+		}
+	}
+	currentRules := []*model.AccessRule{
+		{ID: 1, Effect: adminRule.Effect, Role: adminRule.Role, Endpoint: adminRule.Endpoint},
+		{ID: 2, Effect: policyRule.Effect, Role: policyRule.Role, Endpoint: policyRule.Endpoint},
+	}
+	// rolesFunc mocks current policy with default roles and rules of access repository
+	rolesFunc := func(mc *minimock.Controller, accessMock *repoMocks.AccessRepositoryMock) mocks {
+		m := emptyMocks(mc)
+
+		txMock := dbMocks.NewTxManagerMock(mc)
+		txMock.ReadCommittedMock.
+			Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+		m.txManager = txMock
+
+		roleMock := repoMocks.NewRoleRepositoryMock(mc)
+		roleMock.ListRolesMock.Set(func(ctx context.Context) ([]*model.Role, error) {
+			if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+				return nil, fmt.Errorf("roles listed in wrong tenant")
+			}
+			return []*model.Role{{Name: model.RoleAdmin}, {Name: model.RoleUser}}, nil
+		})
+		roleMock.ListRoleParentsMock.Set(func(_ context.Context, role string) ([]string, error) {
+			if role == model.RoleAdmin {
+				return []string{model.RoleUser}, nil
+			}
+			return nil, nil
+		})
+		roleMock.ListAncestorRolesMock.Optional().Return([]string{model.RoleUser}, nil)
+		m.roleRepository = roleMock
+
+		permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+		permissionMock.ListRolePermissionsMock.Return(nil, nil)
+		permissionMock.ListPermissionsMock.Return(nil, nil)
+		m.permissionRepository = permissionMock
+
+		m.accessRepository = accessMock
+
+		return m
+	}
+	// stateFunc mocks current policy with default roles and admin rules
+	stateFunc := func(mc *minimock.Controller) mocks {
+		accessMock := repoMocks.NewAccessRepositoryMock(mc)
+		accessMock.ListRulesMock.Return(currentRules, nil)
+
+		return rolesFunc(mc, accessMock)
+	}
+	applyFunc := func(createErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := stateFunc(mc)
+
+			permissionMock := m.permissionRepository.(*repoMocks.PermissionRepositoryMock)
+			permissionMock.CreatePermissionMock.Set(func(_ context.Context,
+				permission *model.Permission,
+			) (int64, error) {
+				if permission.Name != "user:read" {
+					return 0, fmt.Errorf("unexpected permission %s", permission.Name)
+				}
+				return 1, nil
+			})
+			permissionMock.GrantPermissionMock.Expect(minimock.AnyContext, model.RoleAdmin,
+				"user:read").Return(nil)
+
+			accessMock := m.accessRepository.(*repoMocks.AccessRepositoryMock)
+			accessMock.CreateRuleMock.Set(func(_ context.Context, rule *model.AccessRule) (
+				int64, error,
+			) {
+				if *rule != *readRule {
+					return 0, fmt.Errorf("unexpected rule for %s", rule.Endpoint)
+				}
+				return 3, nil
+			})
+
+			bundleMock := repoMocks.NewPolicyBundleRepositoryMock(mc)
+			bundleMock.CreateInitialPolicyBundleMock.Set(func(_ context.Context,
+				initial *model.PolicyBundleVersion,
+			) error {
+				if initial.Action != model.PolicyBundleActionInitial ||
+					!initial.Diff.IsEmpty() || len(initial.Bundle.Rules) != 2 {
+					return fmt.Errorf("unexpected initial policy version")
+				}
+				return nil
+			})
+			bundleMock.CreatePolicyBundleMock.Set(func(_ context.Context,
+				created *model.PolicyBundleVersion,
+			) (int64, error) {
+				if created.Author != adminName || created.Action != model.PolicyBundleActionApply {
+					return 0, fmt.Errorf("unexpected policy version")
+				}
+				return version, createErr
+			})
+			m.policyBundleRepository = bundleMock
+
+			if createErr == nil {
+				policyChangeMock := repoMocks.NewPolicyChangeRepositoryMock(mc)
+				policyChangeMock.CreatePolicyChangeMock.Return(1, nil)
+				m.policyChangeRepository = policyChangeMock
+			}
+
+			return m
+		}
+	}
+	// lockoutFunc mocks apply of bundle removing rule which allows admin to apply policy
+	lockoutFunc := func(mc *minimock.Controller) mocks {
+		listed := 0
+		accessMock := repoMocks.NewAccessRepositoryMock(mc)
+		accessMock.ListRulesMock.Set(func(_ context.Context, _ *model.AccessRuleFilter) (
+			[]*model.AccessRule, error,
+		) {
+			listed++
+			if listed > 1 {
+				return currentRules[:1], nil
+			}
+			return currentRules, nil
+		})
+		accessMock.DeleteRuleMock.Expect(minimock.AnyContext, 2).Return(nil)
+		m := rolesFunc(mc, accessMock)
+
+		bundleMock := repoMocks.NewPolicyBundleRepositoryMock(mc)
+		bundleMock.CreateInitialPolicyBundleMock.Return(nil)
+		m.policyBundleRepository = bundleMock
+
+		return m
+	}
+
+	testsSuccessful := []struct {
+		name    string
+		bundle  *model.PolicyBundle
+		dryRun  bool
+		version int64
+		mocks   mocksFunc
+	}{
+		{
+			name:    "success case: diff is applied as new version",
+			bundle:  bundle,
+			version: version,
+			mocks:   applyFunc(nil),
+		},
+		{
+			name:   "success case: dry run changes nothing",
+			bundle: bundle,
+			dryRun: true,
+			mocks:  stateFunc,
+		},
+	}
+	testsErrors := []struct {
+		name   string
+		bundle *model.PolicyBundle
+		err    error
+		mocks  mocksFunc
+	}{
+		{
+			name: "error: default role is missing",
+			bundle: &model.PolicyBundle{
+				Roles: []*model.PolicyBundleRole{{Name: model.RoleAdmin}},
+			},
+			err:   fmt.Errorf("default role USER is required"),
+			mocks: emptyMocks,
+		},
+		{
+			name: "error: inheritance cycle",
+			bundle: &model.PolicyBundle{
+				Roles: []*model.PolicyBundleRole{
+					{Name: model.RoleAdmin, Parents: []string{model.RoleUser}},
+					{Name: model.RoleUser, Parents: []string{model.RoleAdmin}},
+				},
+			},
+			err:   fmt.Errorf("inherits itself"),
+			mocks: emptyMocks,
+		},
+		{
+			name: "error: rule of unknown permission",
+			bundle: &model.PolicyBundle{
+				Roles: []*model.PolicyBundleRole{{Name: model.RoleAdmin}, {Name: model.RoleUser}},
+				Rules: []*model.PolicyBundleRule{
+					{Permission: "user:read", Endpoint: "/user_v1.UserV1/GetUser"},
+				},
+			},
+			err:   fmt.Errorf("permission user:read of rule does not exist"),
+			mocks: emptyMocks,
+		},
+		{
+			name: "error: duplicate rule",
+			bundle: &model.PolicyBundle{
+				Roles: []*model.PolicyBundleRole{{Name: model.RoleAdmin}, {Name: model.RoleUser}},
+				Rules: []*model.PolicyBundleRule{
+					{Role: model.RoleAdmin, Endpoint: "/user_v1.UserV1"},
+					{Effect: model.AccessEffectAllow, Role: model.RoleAdmin,
+						Endpoint: "/user_v1.UserV1"},
+				},
+			},
+			err:   fmt.Errorf("duplicate rule"),
+			mocks: emptyMocks,
+		},
+		{
+			name:   "error: caller loses access to apply policy",
+			bundle: lockoutBundle,
+			err:    fmt.Errorf("caller would lose access to apply policy"),
+			mocks:  lockoutFunc,
+		},
+		{
+			name:   "repo error: create policy version",
+			bundle: bundle,
+			err:    repoErr,
+			mocks:  applyFunc(repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository),
+				decision.New(m.permissionRepository, m.roleRepository, nil))
+
+			res, err := service.ApplyPolicy(ctx, adminToken, tt.bundle, tt.dryRun)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.version, res.Version)
+			assert.Equal(t, []*model.PolicyBundlePermission{{Name: "user:read"}},
+				res.Diff.AddedPermissions)
+			assert.Equal(t, []*model.RolePermission{
+				{Role: model.RoleAdmin, Permission: "user:read"},
+			}, res.Diff.GrantedPermissions)
+			assert.Equal(t, []*model.AccessRule{readRule}, res.Diff.AddedRules)
+			assert.Empty(t, res.Diff.RemovedRules)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository),
+				decision.New(m.permissionRepository, m.roleRepository, nil))
+
+			_, err := service.ApplyPolicy(ctx, adminToken, tt.bundle, false)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/service/policy"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type mocks struct {
+	userRepository         repository.UserRepository
+	accessRepository       repository.AccessRepository
+	roleRepository         repository.RoleRepository
+	permissionRepository   repository.PermissionRepository
+	policyChangeRepository repository.PolicyChangeRepository
+	revocationRepository   repository.TokenRevocationRepository
+	policyBundleRepository repository.PolicyBundleRepository
+	txManager              db.TxManager
+}
+
+// newAccessService returns access service allowing admins only to any endpoint
+func newAccessService(mc *minimock.Controller, tokenAccess utils.Token,
+	revocationRepository repository.TokenRevocationRepository,
+) service.AccessService {
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Return(&model.EndpointAccessRule{
+		Allow: []*model.AccessRule{{Effect: model.AccessEffectAllow, Role: model.RoleAdmin}},
+	}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Return(nil, nil)
+
+	return access.NewService(accessMock, revocationRepository, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, nil))
+}
+
+func TestCreateRule(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID  = int64(3)
+		ruleID    = int64(9)
+		userID    = int64(12)
+		adminName = "boss"
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: tenantID,
+				Name:     adminName,
+				Role:     role,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		rule = &model.AccessRule{
+			Permission: "user:read",
+			Endpoint:   "/user_v1.UserV1/Get",
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	emptyMocks := func(mc *minimock.Controller) mocks {
+		revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+		revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+		return mocks{
+			accessRepository:       repoMocks.NewAccessRepositoryMock(mc),
+			roleRepository:         repoMocks.NewRoleRepositoryMock(mc),
+			permissionRepository:   repoMocks.NewPermissionRepositoryMock(mc),
+			policyChangeRepository: repoMocks.NewPolicyChangeRepositoryMock(mc),
+			revocationRepository:   revocationMock,
+			txManager:              dbMocks.NewTxManagerMock(mc),
+		}
+	}
+	permissionsFunc := func(mc *minimock.Controller) mocks {
+		m := emptyMocks(mc)
+		permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+		permissionMock.ListPermissionsMock.Set(func(ctx context.Context) (
+			[]*model.Permission, error,
+		) {
+			if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+				return nil, fmt.Errorf("permissions listed in wrong tenant")
+			}
+			return []*model.Permission{{Name: "user:read"}, {Name: "user:write"}}, nil
+		})
+		m.permissionRepository = permissionMock
+		return m
+	}
+	userFunc := func(mc *minimock.Controller) mocks {
+		m := emptyMocks(mc)
+		userMock := repoMocks.NewUserRepositoryMock(mc)
+		userMock.GetUserMock.Set(func(_ context.Context, id int64) (*model.User, error) {
+			if id != userID {
+				return nil, model.ErrorUserNotFound
+			}
+			return &model.User{ID: id, TenantID: tenantID}, nil
+		})
+		m.userRepository = userMock
+		return m
+	}
+	createFunc := func(createErr error, base mocksFunc) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := base(mc)
+
+			txMock := dbMocks.NewTxManagerMock(mc)
+			txMock.ReadCommittedMock.
+				Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+			m.txManager = txMock
+
+			accessMock := repoMocks.NewAccessRepositoryMock(mc)
+			accessMock.CreateRuleMock.Return(ruleID, createErr)
+			m.accessRepository = accessMock
+
+			if createErr != nil {
+				return m
+			}
+
+			accessMock.GetRuleMock.Set(func(_ context.Context, id int64) (*model.AccessRule, error) {
+				return &model.AccessRule{ID: id, Effect: model.AccessEffectAllow,
+					Permission: rule.Permission, Endpoint: rule.Endpoint}, nil
+			})
+
+			policyChangeMock := repoMocks.NewPolicyChangeRepositoryMock(mc)
+			policyChangeMock.CreatePolicyChangeMock.Set(func(_ context.Context,
+				change *model.PolicyChange,
+			) (int64, error) {
+				if change.Actor != adminName || change.Before != nil || change.After == nil {
+					return 0, fmt.Errorf("wrong policy change record")
+				}
+				return 1, nil
+			})
+			m.policyChangeRepository = policyChangeMock
+
+			return m
+		}
+	}
+
+	testsSuccessful := []struct {
+		name        string
+		accessToken string
+		rule        *model.AccessRule
+		mocks       mocksFunc
+	}{
+		{
+			name:        "success case: rule is created",
+			accessToken: token(model.RoleAdmin),
+			rule:        rule,
+			mocks:       createFunc(nil, permissionsFunc),
+		},
+		{
+			name:        "success case: deny rule of user is created",
+			accessToken: token(model.RoleAdmin),
+			rule: &model.AccessRule{Effect: model.AccessEffectDeny, UserID: userID,
+				Endpoint: "/user_v1.UserV1/*"},
+			mocks: createFunc(nil, userFunc),
+		},
+	}
+	testsErrors := []struct {
+		name        string
+		accessToken string
+		rule        *model.AccessRule
+		err         error
+		mocks       mocksFunc
+	}{
+		{
+			name:        "error: caller is not admin",
+			accessToken: token("USER"),
+			rule:        rule,
+			err:         model.ErrorPermissionDenied,
+			mocks:       emptyMocks,
+		},
+		{
+			name:        "error: empty endpoint",
+			accessToken: token(model.RoleAdmin),
+			rule:        &model.AccessRule{Permission: "user:read"},
+			err:         fmt.Errorf("endpoint is required"),
+			mocks:       emptyMocks,
+		},
+		{
+			name:        "error: unknown permission",
+			accessToken: token(model.RoleAdmin),
+			rule:        &model.AccessRule{Permission: "user:delete", Endpoint: rule.Endpoint},
+			err:         fmt.Errorf("permission user:delete does not exist"),
+			mocks:       permissionsFunc,
+		},
+		{
+			name:        "repo error: create rule",
+			accessToken: token(model.RoleAdmin),
+			rule:        rule,
+			err:         repoErr,
+			mocks:       createFunc(repoErr, permissionsFunc),
+		},
+		{
+			name:        "error: rule of permission and role",
+			accessToken: token(model.RoleAdmin),
+			rule: &model.AccessRule{Permission: "user:read", Role: model.RoleUser,
+				Endpoint: rule.Endpoint},
+			err:   fmt.Errorf("exactly one of permission, role and user is required"),
+			mocks: emptyMocks,
+		},
+		{
+			name:        "error: invalid effect",
+			accessToken: token(model.RoleAdmin),
+			rule: &model.AccessRule{Effect: "block", Permission: "user:read",
+				Endpoint: rule.Endpoint},
+			err:   fmt.Errorf("invalid effect"),
+			mocks: emptyMocks,
+		},
+		{
+			name:        "error: unknown user",
+			accessToken: token(model.RoleAdmin),
+			rule: &model.AccessRule{Effect: model.AccessEffectDeny, UserID: userID + 1,
+				Endpoint: rule.Endpoint},
+			err:   model.ErrorUserNotFound,
+			mocks: userFunc,
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository), nil)
+
+			id, err := service.CreateRule(ctx, tt.accessToken, tt.rule)
+
+			assert.Nil(t, err)
+			assert.Equal(t, ruleID, id)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository), nil)
+
+			_, err := service.CreateRule(ctx, tt.accessToken, tt.rule)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/policy"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestSimulateCheck(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+	type accessFunc func(mc *minimock.Controller) repository.AccessRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID = int64(3)
+		userID   = int64(12)
+		endpoint = "/user_v1.UserV1/Get"
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: tenantID,
+				Name:     "boss",
+				Role:     role,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		rules = []*model.AccessRule{
+			{ID: 1, Effect: model.AccessEffectAllow, Permission: "user:read", Endpoint: endpoint},
+			{ID: 2, Effect: model.AccessEffectAllow, Permission: "user:admin",
+				Endpoint: "/user_v1.UserV1/*"},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		conditions, _ = condition.NewEvaluator(1000)
+	)
+
+	// mocksWith expects user to be loaded, roles to be listed and subject to be resolved
+	// as SUPPORT role inheriting USER role and having user:admin permission
+	mocksWith := func(user, roles, resolve bool, accessMock accessFunc) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			if user {
+				userMock.GetUserMock.Set(func(_ context.Context, id int64) (*model.User, error) {
+					if id != userID {
+						return nil, model.ErrorUserNotFound
+					}
+					return &model.User{ID: id, TenantID: tenantID, Name: "alice",
+						Role: "SUPPORT"}, nil
+				})
+			}
+
+			roleMock := repoMocks.NewRoleRepositoryMock(mc)
+			permissionMock := repoMocks.NewPermissionRepositoryMock(mc)
+			if roles {
+				roleMock.ListRolesMock.Return([]*model.Role{{Name: "SUPPORT"}, {Name: "USER"}},
+					nil)
+			}
+			if resolve {
+				roleMock.ListAncestorRolesMock.Set(func(ctx context.Context, role string) (
+					[]string, error,
+				) {
+					if ctxTenantID, err := tenant.FromContext(ctx); err != nil ||
+						ctxTenantID != tenantID {
+						return nil, fmt.Errorf("roles listed in wrong tenant")
+					}
+					return []string{"USER"}, nil
+				})
+				permissionMock.ListEffectivePermissionsMock.Return([]string{"user:admin"}, nil)
+			}
+
+			return mocks{
+				userRepository:         userMock,
+				accessRepository:       accessMock(mc),
+				roleRepository:         roleMock,
+				permissionRepository:   permissionMock,
+				policyChangeRepository: repoMocks.NewPolicyChangeRepositoryMock(mc),
+				revocationRepository:   revocationMock,
+				txManager:              dbMocks.NewTxManagerMock(mc),
+			}
+		}
+	}
+	noRules := func(mc *minimock.Controller) repository.AccessRepository {
+		return repoMocks.NewAccessRepositoryMock(mc)
+	}
+	currentRules := func(mc *minimock.Controller) repository.AccessRepository {
+		accessMock := repoMocks.NewAccessRepositoryMock(mc)
+		accessMock.GetAccessRuleByEndpointMock.Return(&model.EndpointAccessRule{
+			Endpoint: endpoint,
+			Allow:    rules[:1],
+		}, nil)
+		return accessMock
+	}
+	allRules := func(listErr error) accessFunc {
+		return func(mc *minimock.Controller) repository.AccessRepository {
+			accessMock := repoMocks.NewAccessRepositoryMock(mc)
+			accessMock.ListRulesMock.Return(rules, listErr)
+			return accessMock
+		}
+	}
+
+	testsSuccessful := []struct {
+		name       string
+		simulation *model.AccessSimulation
+		want       bool
+		ruleID     int64
+		reason     string
+		evaluated  int
+		mocks      mocksFunc
+	}{
+		{
+			name:       "success case: user without permission of current rule",
+			simulation: &model.AccessSimulation{UserID: userID, Endpoint: endpoint},
+			want:       false,
+			reason:     "no rule allows access",
+			evaluated:  1,
+			mocks:      mocksWith(true, false, true, currentRules),
+		},
+		{
+			name: "success case: proposed rule removal",
+			simulation: &model.AccessSimulation{Role: "SUPPORT", Endpoint: endpoint,
+				RemoveRuleIDs: []int64{1}},
+			want:      true,
+			ruleID:    2,
+			reason:    "allowed by rule 2",
+			evaluated: 1,
+			mocks:     mocksWith(false, true, true, allRules(nil)),
+		},
+		{
+			name: "success case: proposed deny of inherited role",
+			simulation: &model.AccessSimulation{UserID: userID, Endpoint: endpoint,
+				RemoveRuleIDs: []int64{1},
+				AddRules: []*model.AccessRule{{Effect: model.AccessEffectDeny, Role: "USER",
+					Endpoint: "/user_v1.UserV1"}},
+			},
+			want:      false,
+			ruleID:    -1,
+			reason:    "explicit deny by rule -1",
+			evaluated: 2,
+			mocks:     mocksWith(true, true, true, allRules(nil)),
+		},
+	}
+	testsErrors := []struct {
+		name        string
+		accessToken string
+		simulation  *model.AccessSimulation
+		err         error
+		mocks       mocksFunc
+	}{
+		{
+			name:        "error: not admin",
+			accessToken: token(model.RoleUser),
+			simulation:  &model.AccessSimulation{UserID: userID, Endpoint: endpoint},
+			err:         model.ErrorPermissionDenied,
+			mocks:       mocksWith(false, false, false, noRules),
+		},
+		{
+			name:        "error: user and role",
+			accessToken: token(model.RoleAdmin),
+			simulation: &model.AccessSimulation{UserID: userID, Role: "SUPPORT",
+				Endpoint: endpoint},
+			err:   fmt.Errorf("exactly one of user and role is required"),
+			mocks: mocksWith(false, false, false, noRules),
+		},
+		{
+			name:        "error: unknown role",
+			accessToken: token(model.RoleAdmin),
+			simulation:  &model.AccessSimulation{Role: "AUDITOR", Endpoint: endpoint},
+			err:         fmt.Errorf("role AUDITOR does not exist"),
+			mocks:       mocksWith(false, true, false, noRules),
+		},
+		{
+			name:        "error: removed rule does not exist",
+			accessToken: token(model.RoleAdmin),
+			simulation: &model.AccessSimulation{Role: "SUPPORT", Endpoint: endpoint,
+				RemoveRuleIDs: []int64{5}},
+			err:   fmt.Errorf("rule proposed to delete does not exist"),
+			mocks: mocksWith(false, true, false, allRules(nil)),
+		},
+		{
+			name:        "error: invalid proposed rule",
+			accessToken: token(model.RoleAdmin),
+			simulation: &model.AccessSimulation{Role: "SUPPORT", Endpoint: endpoint,
+				AddRules: []*model.AccessRule{{Role: "USER", Endpoint: "user_v1"}}},
+			err:   fmt.Errorf("proposed rule 1"),
+			mocks: mocksWith(false, true, false, allRules(nil)),
+		},
+		{
+			name:        "repo error: list rules",
+			accessToken: token(model.RoleAdmin),
+			simulation: &model.AccessSimulation{Role: "SUPPORT", Endpoint: endpoint,
+				RemoveRuleIDs: []int64{1}},
+			err:   repoErr,
+			mocks: mocksWith(false, true, false, allRules(repoErr)),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository),
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			res, err := service.SimulateCheck(ctx, token(model.RoleAdmin), tt.simulation)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, res.Allowed)
+			assert.Equal(t, tt.reason, res.Reason)
+			assert.Equal(t, []string{"SUPPORT", "USER"}, res.Explanation.Roles)
+			assert.Len(t, res.Explanation.Rules, tt.evaluated)
+			if tt.ruleID == 0 {
+				assert.Nil(t, res.Rule)
+				return
+			}
+			assert.Equal(t, tt.ruleID, res.Rule.ID)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := policy.NewService(m.userRepository, m.accessRepository, m.roleRepository,
+				m.permissionRepository, m.policyChangeRepository, m.policyBundleRepository, m.txManager,
+				newAccessService(mc, tokenAccess, m.revocationRepository),
+				decision.New(m.permissionRepository, m.roleRepository, conditions))
+
+			_, err := service.SimulateCheck(ctx, tt.accessToken, tt.simulation)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// UpdateRule updates permission, endpoint or condition of access rule
+func (s *serv) UpdateRule(ctx context.Context, accessToken string,
+	update *model.UpdateAccessRule,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "update access rule (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"UpdateRule", nil)
+	if err != nil {
+		return err
+	}
+
+	if update == nil || (update.Permission == nil && update.Endpoint == nil &&
+		update.Condition == nil) {
+		return errors.New("unable to update access rule: nothing to update")
+	}
+	if update.Permission != nil {
+		if err = s.validatePermissionExists(ctx, *update.Permission); err != nil {
+			return err
+		}
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, errTx := s.accessRepository.GetRule(ctx, update.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		after := *before
+		if update.Permission != nil {
+			after.Permission = *update.Permission
+		}
+		if update.Endpoint != nil {
+			after.Endpoint = *update.Endpoint
+		}
+		if update.Condition != nil {
+			after.Condition = *update.Condition
+		}
+		if errTx = validateAccessRule(&after); errTx != nil {
+			return errTx
+		}
+
+		errTx = s.accessRepository.UpdateRule(ctx, update)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordChange(ctx, claims, "update rule", before, &after)
+	})
+}
@@ -0,0 +1,231 @@
+package policy
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/condition"
+	"github.com/valek177/auth/internal/matcher"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/policybundle"
+)
+
+var permissionNameRegexp = regexp.MustCompile(`^[0-9a-z_.-]+(:[0-9a-z_.-]+)+$`)
+
+func validateAccessRule(rule *model.AccessRule) error {
+	if rule == nil {
+		return errors.New("unable to save access rule: empty model")
+	}
+	if rule.Effect != model.AccessEffectAllow && rule.Effect != model.AccessEffectDeny {
+		return errors.Errorf("unable to save access rule: invalid effect %q", rule.Effect)
+	}
+
+	subjects := 0
+	if rule.Permission != "" {
+		subjects++
+	}
+	if rule.Role != "" {
+		subjects++
+	}
+	if rule.UserID != 0 {
+		subjects++
+	}
+	if subjects != 1 {
+		return errors.New("unable to save access rule: exactly one of permission, role " +
+			"and user is required")
+	}
+	if strings.TrimSpace(rule.Endpoint) == "" {
+		return errors.New("unable to save access rule: endpoint is required")
+	}
+	if err := matcher.ValidatePattern(rule.Endpoint); err != nil {
+		return errors.Wrap(err, "unable to save access rule")
+	}
+	if rule.Condition != "" {
+		if err := condition.Validate(rule.Condition); err != nil {
+			return errors.Wrap(err, "unable to save access rule")
+		}
+	}
+
+	return nil
+}
+
+func validatePermission(permission *model.Permission) error {
+	if permission == nil {
+		return errors.New("unable to create permission: empty model")
+	}
+	if !permissionNameRegexp.MatchString(permission.Name) {
+		return errors.Errorf("unable to create permission: invalid name %q, "+
+			"expected lowercase <resource>:<action>", permission.Name)
+	}
+
+	return nil
+}
+
+// validateRoleExists returns error if role is not defined in tenant
+func (s *serv) validateRoleExists(ctx context.Context, name string) error {
+	roles, err := s.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if role.Name == name {
+			return nil
+		}
+	}
+
+	return errors.Errorf("role %s does not exist", name)
+}
+
+// validateRuleSubjectExists returns error if permission, role or user of rule
+// is not defined in tenant
+func (s *serv) validateRuleSubjectExists(ctx context.Context, rule *model.AccessRule) error {
+	switch {
+	case rule.Permission != "":
+		return s.validatePermissionExists(ctx, rule.Permission)
+	case rule.Role != "":
+		return s.validateRoleExists(ctx, rule.Role)
+	default:
+		_, err := s.userRepository.GetUser(ctx, rule.UserID)
+		return err
+	}
+}
+
+// validatePermissionExists returns error if permission is not defined in tenant
+func (s *serv) validatePermissionExists(ctx context.Context, name string) error {
+	permissions, err := s.permissionRepository.ListPermissions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range permissions {
+		if permission.Name == name {
+			return nil
+		}
+	}
+
+	return errors.Errorf("permission %s does not exist", name)
+}
+
+// validatePolicyBundle returns error if bundle is not a consistent policy of tenant
+func (s *serv) validatePolicyBundle(ctx context.Context, bundle *model.PolicyBundle) error {
+	if bundle == nil {
+		return errors.New("invalid policy bundle: empty model")
+	}
+
+	permissions := make(map[string]bool, len(bundle.Permissions))
+	for _, permission := range bundle.Permissions {
+		if err := validatePermission(&model.Permission{Name: permission.Name}); err != nil {
+			return errors.Wrap(err, "invalid policy bundle")
+		}
+		if permissions[permission.Name] {
+			return errors.Errorf("invalid policy bundle: duplicate permission %s", permission.Name)
+		}
+		permissions[permission.Name] = true
+	}
+
+	parents := make(map[string][]string, len(bundle.Roles))
+	for _, role := range bundle.Roles {
+		if strings.TrimSpace(role.Name) == "" {
+			return errors.New("invalid policy bundle: role name is required")
+		}
+		if _, ok := parents[role.Name]; ok {
+			return errors.Errorf("invalid policy bundle: duplicate role %s", role.Name)
+		}
+		parents[role.Name] = role.Parents
+	}
+	for _, name := range model.DefaultRoles {
+		if _, ok := parents[name]; !ok {
+			return errors.Errorf("invalid policy bundle: default role %s is required", name)
+		}
+	}
+
+	for _, role := range bundle.Roles {
+		for _, parent := range role.Parents {
+			if _, ok := parents[parent]; !ok {
+				return errors.Errorf("invalid policy bundle: parent %s of role %s does not exist",
+					parent, role.Name)
+			}
+		}
+		for _, permission := range role.Permissions {
+			if !permissions[permission] {
+				return errors.Errorf("invalid policy bundle: permission %s of role %s "+
+					"does not exist", permission, role.Name)
+			}
+		}
+	}
+	if err := validateRoleHierarchy(parents); err != nil {
+		return err
+	}
+
+	rules := make(map[string]bool, len(bundle.Rules))
+	for _, bundleRule := range bundle.Rules {
+		rule := policybundle.ToAccessRule(bundleRule)
+		if err := validateAccessRule(rule); err != nil {
+			return errors.Wrap(err, "invalid policy bundle")
+		}
+
+		key := policybundle.RuleKey(rule)
+		if rules[key] {
+			return errors.Errorf("invalid policy bundle: duplicate rule for %s", rule.Endpoint)
+		}
+		rules[key] = true
+
+		switch {
+		case rule.Permission != "" && !permissions[rule.Permission]:
+			return errors.Errorf("invalid policy bundle: permission %s of rule does not exist",
+				rule.Permission)
+		case rule.Role != "":
+			if _, ok := parents[rule.Role]; !ok {
+				return errors.Errorf("invalid policy bundle: role %s of rule does not exist",
+					rule.Role)
+			}
+		case rule.UserID != 0:
+			if _, err := s.userRepository.GetUser(ctx, rule.UserID); err != nil {
+				return errors.Wrapf(err, "invalid policy bundle: user %d of rule", rule.UserID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRoleHierarchy returns error if role inherits itself directly or through other roles
+func validateRoleHierarchy(parents map[string][]string) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(parents))
+
+	var visit func(role string) error
+	visit = func(role string) error {
+		switch state[role] {
+		case visiting:
+			return errors.Errorf("invalid policy bundle: role %s inherits itself", role)
+		case visited:
+			return nil
+		}
+
+		state[role] = visiting
+		for _, parent := range parents[role] {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		state[role] = visited
+
+		return nil
+	}
+
+	for role := range parents {
+		if err := visit(role); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
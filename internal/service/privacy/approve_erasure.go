@@ -0,0 +1,176 @@
+package privacy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+const (
+	pseudonymPrefix = "erased-"
+	pseudonymLen    = 8
+)
+
+// ApproveErasure approves pending erasure request and erases user: audit log and name
+// of user in records of others are pseudonymized, personal data is deleted, tokens are
+// revoked and erasure event is sent
+func (s *serv) ApproveErasure(ctx context.Context, accessToken string, id int64) (
+	*model.ErasureRequest, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "approve erasure (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ApproveErasure", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.erasureRepository.GetErasureRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != model.ErasureStatusPending {
+		return nil, errors.Errorf("erasure request is already %s", request.Status)
+	}
+	if request.RequestedBy == claims.Username {
+		return nil, errors.New("erasure request must be approved by another admin")
+	}
+
+	pseudonym, err := newPseudonym()
+	if err != nil {
+		return nil, err
+	}
+
+	erasedAt := time.Now()
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.userRepository.GetUser(ctx, request.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.PseudonymizeRecords(ctx, user.ID, pseudonym)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.deleteUserData(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.erasureRepository.UpdateErasureRequestStatus(ctx, request.ID,
+			model.ErasureStatusCompleted, claims.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.pseudonymizeUsername(ctx, user.Name, pseudonym)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.logRepository.CreateRecord(ctx, &model.Record{
+			Action:    "erase",
+			Pseudonym: pseudonym,
+			CreatedAt: erasedAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.redisRepository.DeleteUser(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.revocationRepository.RevokeUserTokens(ctx, model.UserTokenSubject(user.ID),
+			erasedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.sendErasureEvent(ctx, &model.ErasureEvent{
+		TenantID:  request.TenantID,
+		UserID:    request.UserID,
+		Pseudonym: pseudonym,
+		ErasedAt:  erasedAt,
+	})
+
+	return s.erasureRepository.GetErasureRequest(ctx, request.ID)
+}
+
+// deleteUserData deletes user and all data linked to user
+func (s *serv) deleteUserData(ctx context.Context, userID int64) error {
+	err := s.invitationRepository.DeleteInvitationsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.roleGrantRepository.DeleteRoleGrantsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.oauthCodeRepository.DeleteAuthorizationCodesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.federatedIdentityRepository.DeleteFederatedIdentitiesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.userRepository.DeleteUser(ctx, userID)
+}
+
+// pseudonymizeUsername replaces name of user in records about other subjects:
+// erasure requests, role grants reviewed by user and policy changes made by user
+func (s *serv) pseudonymizeUsername(ctx context.Context, username, pseudonym string) error {
+	err := s.erasureRepository.PseudonymizeErasureRequests(ctx, username, pseudonym)
+	if err != nil {
+		return err
+	}
+
+	err = s.roleGrantRepository.PseudonymizeReviewer(ctx, username, pseudonym)
+	if err != nil {
+		return err
+	}
+
+	return s.policyChangeRepository.PseudonymizeActor(ctx, username, pseudonym)
+}
+
+// sendErasureEvent notifies other services about erasure, user is already erased
+// so failure is only logged
+func (s *serv) sendErasureEvent(ctx context.Context, event *model.ErasureEvent) {
+	data, err := json.Marshal(event)
+	if err == nil {
+		err = s.producer.Produce(ctx, erasureTopicName, strconv.FormatInt(event.UserID, 10), data)
+	}
+	if err != nil {
+		logger.ErrorWithMsg("unable to send erasure event", err,
+			zap.Int64("tenant_id", event.TenantID), zap.Int64("user_id", event.UserID))
+	}
+}
+
+func newPseudonym() (string, error) {
+	b := make([]byte, pseudonymLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "unable to generate pseudonym")
+	}
+
+	return pseudonymPrefix + hex.EncodeToString(b), nil
+}
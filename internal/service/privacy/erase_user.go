@@ -0,0 +1,50 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// EraseUser creates erasure request, user may request own erasure, admin may request
+// erasure of any user of tenant
+func (s *serv) EraseUser(ctx context.Context, accessToken string, userID int64,
+	reason string,
+) (*model.ErasureRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "erase user (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Name != claims.Username {
+		_, _, err = caller.Authorize(ctx, s.accessService, accessToken,
+			endpointPrefix+"EraseUser", nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to request erasure of another user")
+		}
+	}
+
+	id, err := s.erasureRepository.CreateErasureRequest(ctx, &model.ErasureRequest{
+		UserID:      user.ID,
+		RequestedBy: claims.Username,
+		Reason:      reason,
+		Status:      model.ErasureStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.erasureRepository.GetErasureRequest(ctx, id)
+}
@@ -0,0 +1,58 @@
+package privacy
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/converter"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ExportMyData returns all data stored about caller
+func (s *serv) ExportMyData(ctx context.Context, accessToken string) (*model.DataBundle, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "export my data (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUserByName(ctx, claims.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.logRepository.ListRecords(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.invitationRepository.ListInvitationsByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.roleGrantRepository.ListRoleGrants(ctx, &model.RoleGrantFilter{
+		UserID: user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := s.oauthCodeRepository.ListAuthorizationCodesByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.federatedIdentityRepository.ListFederatedIdentitiesByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDataBundle(time.Now(), user, records, invitations, grants, codes,
+		identities), nil
+}
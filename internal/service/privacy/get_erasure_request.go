@@ -0,0 +1,38 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// GetErasureRequest returns erasure request to admin or to its requester
+func (s *serv) GetErasureRequest(ctx context.Context, accessToken string, id int64) (
+	*model.ErasureRequest, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get erasure request (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.erasureRepository.GetErasureRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.RequestedBy != claims.Username {
+		_, _, err = caller.Authorize(ctx, s.accessService, accessToken,
+			endpointPrefix+"GetErasureRequest", nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return request, nil
+}
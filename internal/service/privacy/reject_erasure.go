@@ -0,0 +1,32 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// RejectErasure rejects pending erasure request
+func (s *serv) RejectErasure(ctx context.Context, accessToken string, id int64) (
+	*model.ErasureRequest, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "reject erasure (service)")
+	defer span.Finish()
+
+	ctx, claims, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"RejectErasure", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.erasureRepository.UpdateErasureRequestStatus(ctx, id, model.ErasureStatusRejected,
+		claims.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.erasureRepository.GetErasureRequest(ctx, id)
+}
@@ -0,0 +1,63 @@
+package privacy
+
+import (
+	"github.com/valek177/auth/internal/client/kafka"
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+const (
+	erasureTopicName = "user-erasure"
+	// endpointPrefix is prefix of endpoints of privacy in access rules
+	endpointPrefix = "/privacy_v1.PrivacyV1/"
+)
+
+type serv struct {
+	userRepository              repository.UserRepository
+	logRepository               repository.LogRepository
+	invitationRepository        repository.InvitationRepository
+	erasureRepository           repository.ErasureRepository
+	roleGrantRepository         repository.RoleGrantRepository
+	policyChangeRepository      repository.PolicyChangeRepository
+	oauthCodeRepository         repository.OAuthAuthorizationCodeRepository
+	federatedIdentityRepository repository.FederatedIdentityRepository
+	redisRepository             repository.UserRedisRepository
+	revocationRepository        repository.TokenRevocationRepository
+	txManager                   db.TxManager
+	accessService               service.AccessService
+	producer                    kafka.Producer
+}
+
+// NewService creates new service with settings
+func NewService(
+	userRepository repository.UserRepository,
+	logRepository repository.LogRepository,
+	invitationRepository repository.InvitationRepository,
+	erasureRepository repository.ErasureRepository,
+	roleGrantRepository repository.RoleGrantRepository,
+	policyChangeRepository repository.PolicyChangeRepository,
+	oauthCodeRepository repository.OAuthAuthorizationCodeRepository,
+	federatedIdentityRepository repository.FederatedIdentityRepository,
+	redisRepository repository.UserRedisRepository,
+	revocationRepository repository.TokenRevocationRepository,
+	txManager db.TxManager,
+	accessService service.AccessService,
+	producer kafka.Producer,
+) service.PrivacyService {
+	return &serv{
+		userRepository:              userRepository,
+		logRepository:               logRepository,
+		invitationRepository:        invitationRepository,
+		erasureRepository:           erasureRepository,
+		roleGrantRepository:         roleGrantRepository,
+		policyChangeRepository:      policyChangeRepository,
+		oauthCodeRepository:         oauthCodeRepository,
+		federatedIdentityRepository: federatedIdentityRepository,
+		redisRepository:             redisRepository,
+		revocationRepository:        revocationRepository,
+		txManager:                   txManager,
+		accessService:               accessService,
+		producer:                    producer,
+	}
+}
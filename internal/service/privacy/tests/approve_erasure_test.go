@@ -0,0 +1,348 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/client/kafka"
+	kafkaMocks "github.com/valek177/auth/internal/client/kafka/mocks"
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/service/privacy"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type mocks struct {
+	userRepository              repository.UserRepository
+	logRepository               repository.LogRepository
+	invitationRepository        repository.InvitationRepository
+	erasureRepository           repository.ErasureRepository
+	roleGrantRepository         repository.RoleGrantRepository
+	policyChangeRepository      repository.PolicyChangeRepository
+	oauthCodeRepository         repository.OAuthAuthorizationCodeRepository
+	federatedIdentityRepository repository.FederatedIdentityRepository
+	redisRepository             repository.UserRedisRepository
+	revocationRepository        repository.TokenRevocationRepository
+	txManager                   db.TxManager
+	producer                    kafka.Producer
+}
+
+// newPrivacyService returns privacy service with repositories of mocks
+func newPrivacyService(mc *minimock.Controller, tokenAccess utils.Token, m mocks,
+) service.PrivacyService {
+	return privacy.NewService(m.userRepository, m.logRepository, m.invitationRepository,
+		m.erasureRepository, m.roleGrantRepository, m.policyChangeRepository,
+		m.oauthCodeRepository, m.federatedIdentityRepository, m.redisRepository,
+		m.revocationRepository, m.txManager,
+		newAccessService(mc, tokenAccess, m.revocationRepository), m.producer)
+}
+
+// newAccessService returns access service allowing admins only to any endpoint
+func newAccessService(mc *minimock.Controller, tokenAccess utils.Token,
+	revocationRepository repository.TokenRevocationRepository,
+) service.AccessService {
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Return(&model.EndpointAccessRule{
+		Allow: []*model.AccessRule{{Effect: model.AccessEffectAllow, Role: model.RoleAdmin}},
+	}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Return(nil, nil)
+
+	return access.NewService(accessMock, revocationRepository, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, nil))
+}
+
+func TestApproveErasure(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID  = int64(7)
+		requestID = int64(5)
+		userID    = int64(11)
+		username  = "alice"
+		adminName = "boss"
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(name, role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: tenantID,
+				Name:     name,
+				Role:     role,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		request = func(status, requestedBy string) *model.ErasureRequest {
+			return &model.ErasureRequest{
+				ID:          requestID,
+				TenantID:    tenantID,
+				UserID:      userID,
+				RequestedBy: requestedBy,
+				Status:      status,
+			}
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	revokedMocks := func(revokedAt time.Time) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Set(func(ctx context.Context, _ string) (
+				time.Time, error,
+			) {
+				if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+					return time.Time{}, fmt.Errorf("revocation checked in wrong tenant")
+				}
+				return revokedAt, nil
+			})
+
+			return mocks{
+				userRepository:              repoMocks.NewUserRepositoryMock(mc),
+				logRepository:               repoMocks.NewLogRepositoryMock(mc),
+				invitationRepository:        repoMocks.NewInvitationRepositoryMock(mc),
+				erasureRepository:           repoMocks.NewErasureRepositoryMock(mc),
+				roleGrantRepository:         repoMocks.NewRoleGrantRepositoryMock(mc),
+				policyChangeRepository:      repoMocks.NewPolicyChangeRepositoryMock(mc),
+				oauthCodeRepository:         repoMocks.NewOAuthAuthorizationCodeRepositoryMock(mc),
+				federatedIdentityRepository: repoMocks.NewFederatedIdentityRepositoryMock(mc),
+				redisRepository:             repoMocks.NewUserRedisRepositoryMock(mc),
+				revocationRepository:        revocationMock,
+				txManager:                   dbMocks.NewTxManagerMock(mc),
+				producer:                    kafkaMocks.NewProducerMock(mc),
+			}
+		}
+	}
+	emptyMocks := revokedMocks(time.Time{})
+	getRequestFunc := func(req *model.ErasureRequest) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := emptyMocks(mc)
+			erasureMock := repoMocks.NewErasureRepositoryMock(mc)
+			erasureMock.GetErasureRequestMock.Return(req, nil)
+			m.erasureRepository = erasureMock
+			return m
+		}
+	}
+	erasureFunc := func(deleteUserErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := emptyMocks(mc)
+
+			completed := false
+			erasureMock := repoMocks.NewErasureRepositoryMock(mc)
+			erasureMock.GetErasureRequestMock.Set(func(_ context.Context, _ int64) (
+				*model.ErasureRequest, error,
+			) {
+				if completed {
+					return request(model.ErasureStatusCompleted, username), nil
+				}
+				return request(model.ErasureStatusPending, username), nil
+			})
+			if deleteUserErr == nil {
+				erasureMock.UpdateErasureRequestStatusMock.Set(func(_ context.Context, _ int64,
+					status, reviewedBy string,
+				) error {
+					if status != model.ErasureStatusCompleted || reviewedBy != adminName {
+						return fmt.Errorf("wrong erasure request review")
+					}
+					completed = true
+					return nil
+				})
+			}
+			m.erasureRepository = erasureMock
+
+			userMock := repoMocks.NewUserRepositoryMock(mc)
+			userMock.GetUserMock.Return(&model.User{ID: userID, TenantID: tenantID, Name: username}, nil)
+			userMock.DeleteUserMock.Return(deleteUserErr)
+			m.userRepository = userMock
+
+			pseudonym := ""
+			logMock := repoMocks.NewLogRepositoryMock(mc)
+			logMock.PseudonymizeRecordsMock.Set(func(_ context.Context, id int64, p string) error {
+				if id != userID || !strings.HasPrefix(p, "erased-") {
+					return fmt.Errorf("wrong pseudonymization")
+				}
+				pseudonym = p
+				return nil
+			})
+			m.logRepository = logMock
+
+			invitationMock := repoMocks.NewInvitationRepositoryMock(mc)
+			invitationMock.DeleteInvitationsByUserIDMock.Expect(minimock.AnyContext, userID).
+				Return(nil)
+			m.invitationRepository = invitationMock
+
+			roleGrantMock := repoMocks.NewRoleGrantRepositoryMock(mc)
+			roleGrantMock.DeleteRoleGrantsByUserIDMock.Expect(minimock.AnyContext, userID).
+				Return(nil)
+			m.roleGrantRepository = roleGrantMock
+
+			oauthCodeMock := repoMocks.NewOAuthAuthorizationCodeRepositoryMock(mc)
+			oauthCodeMock.DeleteAuthorizationCodesByUserIDMock.Expect(minimock.AnyContext, userID).
+				Return(nil)
+			m.oauthCodeRepository = oauthCodeMock
+
+			federatedIdentityMock := repoMocks.NewFederatedIdentityRepositoryMock(mc)
+			federatedIdentityMock.DeleteFederatedIdentitiesByUserIDMock.
+				Expect(minimock.AnyContext, userID).Return(nil)
+			m.federatedIdentityRepository = federatedIdentityMock
+
+			txMock := dbMocks.NewTxManagerMock(mc)
+			txMock.ReadCommittedMock.
+				Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+			m.txManager = txMock
+
+			if deleteUserErr != nil {
+				return m
+			}
+
+			pseudonymized := func(name, p string) error {
+				if name != username || p != pseudonym {
+					return fmt.Errorf("wrong pseudonymization of name")
+				}
+				return nil
+			}
+			erasureMock.PseudonymizeErasureRequestsMock.Set(
+				func(_ context.Context, name, p string) error { return pseudonymized(name, p) })
+			roleGrantMock.PseudonymizeReviewerMock.Set(
+				func(_ context.Context, name, p string) error { return pseudonymized(name, p) })
+			policyChangeMock := repoMocks.NewPolicyChangeRepositoryMock(mc)
+			policyChangeMock.PseudonymizeActorMock.Set(
+				func(_ context.Context, name, p string) error { return pseudonymized(name, p) })
+			m.policyChangeRepository = policyChangeMock
+
+			logMock.CreateRecordMock.Set(func(_ context.Context, record *model.Record) (
+				int64, error,
+			) {
+				if record.UserID != 0 || record.Pseudonym != pseudonym {
+					return 0, fmt.Errorf("erasure record is not pseudonymized")
+				}
+				return 1, nil
+			})
+
+			redisMock := repoMocks.NewUserRedisRepositoryMock(mc)
+			redisMock.DeleteUserMock.Return(nil)
+			m.redisRepository = redisMock
+
+			revocationMock := m.revocationRepository.(*repoMocks.TokenRevocationRepositoryMock)
+			revocationMock.RevokeUserTokensMock.Set(func(_ context.Context, subject string,
+				_ time.Time,
+			) error {
+				if subject != model.UserTokenSubject(userID) {
+					return fmt.Errorf("tokens of wrong user revoked")
+				}
+				return nil
+			})
+
+			producerMock := kafkaMocks.NewProducerMock(mc)
+			producerMock.ProduceMock.Return(nil)
+			m.producer = producerMock
+
+			return m
+		}
+	}
+
+	testsSuccessful := []struct {
+		name        string
+		accessToken string
+		mocks       mocksFunc
+	}{
+		{
+			name:        "success case: user is erased",
+			accessToken: token(adminName, model.RoleAdmin),
+			mocks:       erasureFunc(nil),
+		},
+	}
+	testsErrors := []struct {
+		name        string
+		accessToken string
+		err         error
+		mocks       mocksFunc
+	}{
+		{
+			name:        "error: caller is not admin",
+			accessToken: token(adminName, "USER"),
+			err:         model.ErrorPermissionDenied,
+			mocks:       emptyMocks,
+		},
+		{
+			name:        "error: approved by requester",
+			accessToken: token(adminName, model.RoleAdmin),
+			err:         fmt.Errorf("must be approved by another admin"),
+			mocks:       getRequestFunc(request(model.ErasureStatusPending, adminName)),
+		},
+		{
+			name:        "error: request is already rejected",
+			accessToken: token(adminName, model.RoleAdmin),
+			err:         fmt.Errorf("erasure request is already rejected"),
+			mocks:       getRequestFunc(request(model.ErasureStatusRejected, username)),
+		},
+		{
+			name:        "error: token is revoked",
+			accessToken: token(adminName, model.RoleAdmin),
+			err:         fmt.Errorf("access token is revoked"),
+			mocks:       revokedMocks(time.Now().Add(time.Minute)),
+		},
+		{
+			name:        "repo error: delete user",
+			accessToken: token(adminName, model.RoleAdmin),
+			err:         repoErr,
+			mocks:       erasureFunc(repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := newPrivacyService(mc, tokenAccess, m)
+
+			res, err := service.ApproveErasure(ctx, tt.accessToken, requestID)
+
+			assert.Nil(t, err)
+			assert.Equal(t, model.ErasureStatusCompleted, res.Status)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := newPrivacyService(mc, tokenAccess, m)
+
+			_, err := service.ApproveErasure(ctx, tt.accessToken, requestID)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
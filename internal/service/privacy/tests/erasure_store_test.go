@@ -0,0 +1,421 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kafkaMocks "github.com/valek177/auth/internal/client/kafka/mocks"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+// store keeps rows of every table with data about users in memory
+type store struct {
+	users              map[int64]*model.User
+	cachedUsers        map[int64]*model.User
+	records            []*model.Record
+	invitations        []*model.Invitation
+	erasureRequests    []*model.ErasureRequest
+	roleGrants         []*model.RoleGrant
+	policyChanges      []*model.PolicyChange
+	authorizationCodes []*model.OAuthAuthorizationCode
+	identities         []*model.FederatedIdentity
+}
+
+func newStore(user *model.User, other *model.User) *store {
+	now := time.Now()
+
+	return &store{
+		users:       map[int64]*model.User{user.ID: user, other.ID: other},
+		cachedUsers: map[int64]*model.User{user.ID: user},
+		records: []*model.Record{
+			{UserID: user.ID, Action: model.ActionLogin, CreatedAt: now},
+			{UserID: other.ID, Action: model.ActionLogin, CreatedAt: now},
+		},
+		invitations: []*model.Invitation{
+			{ID: 1, UserID: sql.NullInt64{Int64: user.ID, Valid: true}, Email: user.Email},
+		},
+		erasureRequests: []*model.ErasureRequest{
+			{ID: 5, UserID: user.ID, RequestedBy: user.Name, Status: model.ErasureStatusPending},
+			{ID: 6, UserID: 404, RequestedBy: other.Name, Status: model.ErasureStatusRejected,
+				ReviewedBy: sql.NullString{String: user.Name, Valid: true}},
+		},
+		roleGrants: []*model.RoleGrant{
+			{ID: 1, UserID: user.ID, Role: model.RoleAdmin, Reason: "incident of " + user.Name,
+				Status: model.RoleGrantStatusApproved, StartsAt: now, ExpiresAt: now.Add(time.Hour)},
+			{ID: 2, UserID: other.ID, Role: model.RoleAdmin, Reason: "incident",
+				Status:     model.RoleGrantStatusApproved,
+				ReviewedBy: sql.NullString{String: user.Name, Valid: true}},
+		},
+		policyChanges: []*model.PolicyChange{{Actor: user.Name, Action: "create_rule"}},
+		authorizationCodes: []*model.OAuthAuthorizationCode{
+			{CodeHash: "hash", ClientID: "app", UserID: user.ID, Scope: "openid",
+				RedirectURI: "https://app.example.com/callback", CreatedAt: now},
+		},
+		identities: []*model.FederatedIdentity{
+			{ID: 1, Provider: "google", Subject: user.Name + "-google", UserID: user.ID},
+		},
+	}
+}
+
+type userStore struct {
+	repository.UserRepository
+	s *store
+}
+
+func (u userStore) GetUser(_ context.Context, id int64) (*model.User, error) {
+	if user, ok := u.s.users[id]; ok {
+		return user, nil
+	}
+	return nil, model.ErrorUserNotFound
+}
+
+func (u userStore) GetUserByName(_ context.Context, name string) (*model.User, error) {
+	for _, user := range u.s.users {
+		if user.Name == name {
+			return user, nil
+		}
+	}
+	return nil, model.ErrorUserNotFound
+}
+
+func (u userStore) DeleteUser(_ context.Context, id int64) error {
+	delete(u.s.users, id)
+	return nil
+}
+
+type cacheStore struct {
+	repository.UserRedisRepository
+	s *store
+}
+
+func (c cacheStore) DeleteUser(_ context.Context, id int64) error {
+	delete(c.s.cachedUsers, id)
+	return nil
+}
+
+type logStore struct {
+	repository.LogRepository
+	s *store
+}
+
+func (l logStore) CreateRecord(_ context.Context, record *model.Record) (int64, error) {
+	l.s.records = append(l.s.records, record)
+	return int64(len(l.s.records)), nil
+}
+
+func (l logStore) ListRecords(_ context.Context, userID int64) ([]*model.Record, error) {
+	var records []*model.Record
+	for _, record := range l.s.records {
+		if record.UserID == userID {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (l logStore) PseudonymizeRecords(_ context.Context, userID int64, pseudonym string) error {
+	for _, record := range l.s.records {
+		if record.UserID == userID {
+			record.UserID = 0
+			record.Pseudonym = pseudonym
+		}
+	}
+	return nil
+}
+
+type invitationStore struct {
+	repository.InvitationRepository
+	s *store
+}
+
+func (i invitationStore) ListInvitationsByUserID(_ context.Context, userID int64) (
+	[]*model.Invitation, error,
+) {
+	var invitations []*model.Invitation
+	for _, invitation := range i.s.invitations {
+		if invitation.UserID.Int64 == userID {
+			invitations = append(invitations, invitation)
+		}
+	}
+	return invitations, nil
+}
+
+func (i invitationStore) DeleteInvitationsByUserID(_ context.Context, userID int64) error {
+	i.s.invitations = filter(i.s.invitations, func(invitation *model.Invitation) bool {
+		return invitation.UserID.Int64 == userID
+	})
+	return nil
+}
+
+type erasureStore struct {
+	repository.ErasureRepository
+	s *store
+}
+
+func (e erasureStore) GetErasureRequest(_ context.Context, id int64) (
+	*model.ErasureRequest, error,
+) {
+	for _, request := range e.s.erasureRequests {
+		if request.ID == id {
+			return request, nil
+		}
+	}
+	return nil, model.ErrorErasureRequestNotFound
+}
+
+func (e erasureStore) UpdateErasureRequestStatus(ctx context.Context, id int64,
+	status, reviewedBy string,
+) error {
+	request, err := e.GetErasureRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	request.Status = status
+	request.ReviewedBy = sql.NullString{String: reviewedBy, Valid: true}
+	return nil
+}
+
+func (e erasureStore) PseudonymizeErasureRequests(_ context.Context, username,
+	pseudonym string,
+) error {
+	for _, request := range e.s.erasureRequests {
+		if request.RequestedBy == username {
+			request.RequestedBy = pseudonym
+		}
+		if request.ReviewedBy.String == username {
+			request.ReviewedBy.String = pseudonym
+		}
+	}
+	return nil
+}
+
+type roleGrantStore struct {
+	repository.RoleGrantRepository
+	s *store
+}
+
+func (r roleGrantStore) ListRoleGrants(_ context.Context, f *model.RoleGrantFilter) (
+	[]*model.RoleGrant, error,
+) {
+	var grants []*model.RoleGrant
+	for _, grant := range r.s.roleGrants {
+		if grant.UserID == f.UserID {
+			grants = append(grants, grant)
+		}
+	}
+	return grants, nil
+}
+
+func (r roleGrantStore) DeleteRoleGrantsByUserID(_ context.Context, userID int64) error {
+	r.s.roleGrants = filter(r.s.roleGrants, func(grant *model.RoleGrant) bool {
+		return grant.UserID == userID
+	})
+	return nil
+}
+
+func (r roleGrantStore) PseudonymizeReviewer(_ context.Context, username, pseudonym string,
+) error {
+	for _, grant := range r.s.roleGrants {
+		if grant.ReviewedBy.String == username {
+			grant.ReviewedBy.String = pseudonym
+		}
+	}
+	return nil
+}
+
+type policyChangeStore struct {
+	repository.PolicyChangeRepository
+	s *store
+}
+
+func (p policyChangeStore) PseudonymizeActor(_ context.Context, username, pseudonym string,
+) error {
+	for _, change := range p.s.policyChanges {
+		if change.Actor == username {
+			change.Actor = pseudonym
+		}
+	}
+	return nil
+}
+
+type oauthCodeStore struct {
+	repository.OAuthAuthorizationCodeRepository
+	s *store
+}
+
+func (o oauthCodeStore) ListAuthorizationCodesByUserID(_ context.Context, userID int64) (
+	[]*model.OAuthAuthorizationCode, error,
+) {
+	var codes []*model.OAuthAuthorizationCode
+	for _, code := range o.s.authorizationCodes {
+		if code.UserID == userID {
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
+
+func (o oauthCodeStore) DeleteAuthorizationCodesByUserID(_ context.Context, userID int64,
+) error {
+	o.s.authorizationCodes = filter(o.s.authorizationCodes,
+		func(code *model.OAuthAuthorizationCode) bool { return code.UserID == userID })
+	return nil
+}
+
+type identityStore struct {
+	repository.FederatedIdentityRepository
+	s *store
+}
+
+func (i identityStore) ListFederatedIdentitiesByUserID(_ context.Context, userID int64) (
+	[]*model.FederatedIdentity, error,
+) {
+	var identities []*model.FederatedIdentity
+	for _, identity := range i.s.identities {
+		if identity.UserID == userID {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+func (i identityStore) DeleteFederatedIdentitiesByUserID(_ context.Context, userID int64,
+) error {
+	i.s.identities = filter(i.s.identities, func(identity *model.FederatedIdentity) bool {
+		return identity.UserID == userID
+	})
+	return nil
+}
+
+// filter returns rows except removed ones
+func filter[T any](rows []T, removed func(T) bool) []T {
+	res := make([]T, 0, len(rows))
+	for _, row := range rows {
+		if !removed(row) {
+			res = append(res, row)
+		}
+	}
+	return res
+}
+
+// storeMocks returns mocks keeping data in store, tokens are never revoked
+func storeMocks(mc *minimock.Controller, s *store) mocks {
+	revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+	revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+	revocationMock.RevokeUserTokensMock.Optional().Return(nil)
+
+	txMock := dbMocks.NewTxManagerMock(mc)
+	txMock.ReadCommittedMock.Optional().
+		Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+
+	producerMock := kafkaMocks.NewProducerMock(mc)
+	producerMock.ProduceMock.Optional().Return(nil)
+
+	return mocks{
+		userRepository:              userStore{s: s},
+		logRepository:               logStore{s: s},
+		invitationRepository:        invitationStore{s: s},
+		erasureRepository:           erasureStore{s: s},
+		roleGrantRepository:         roleGrantStore{s: s},
+		policyChangeRepository:      policyChangeStore{s: s},
+		oauthCodeRepository:         oauthCodeStore{s: s},
+		federatedIdentityRepository: identityStore{s: s},
+		redisRepository:             cacheStore{s: s},
+		revocationRepository:        revocationMock,
+		txManager:                   txMock,
+		producer:                    producerMock,
+	}
+}
+
+func TestEraseUserLeavesNoPersonalData(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		user  = &model.User{ID: 11, TenantID: 7, Name: "alice", Email: "alice@example.com"}
+		admin = &model.User{ID: 12, TenantID: 7, Name: "boss", Email: "boss@example.com",
+			Role: model.RoleAdmin}
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+	)
+
+	adminToken, err := tokenAccess.GenerateToken(ctx, admin)
+	require.NoError(t, err)
+
+	s := newStore(user, admin)
+	service := newPrivacyService(mc, tokenAccess, storeMocks(mc, s))
+
+	_, err = service.ApproveErasure(ctx, adminToken, 5)
+	require.NoError(t, err)
+
+	tables := map[string]interface{}{
+		"users":                     s.users,
+		"users cache":               s.cachedUsers,
+		"users_log":                 s.records,
+		"invitations":               s.invitations,
+		"erasure_requests":          s.erasureRequests,
+		"role_grants":               s.roleGrants,
+		"policy_changes":            s.policyChanges,
+		"oauth_authorization_codes": s.authorizationCodes,
+		"federated_identities":      s.identities,
+	}
+	for table, rows := range tables {
+		dump := fmt.Sprintf("%+v", rows)
+		for _, value := range []string{user.Name, user.Email} {
+			assert.NotContains(t, dump, value, "%s keeps personal data of erased user", table)
+		}
+	}
+
+	assert.Contains(t, s.users, admin.ID)
+	assert.Equal(t, admin.Name, s.erasureRequests[1].RequestedBy)
+	assert.Equal(t, admin.Name, s.erasureRequests[0].ReviewedBy.String)
+	assert.Equal(t, s.records[0].Pseudonym, s.erasureRequests[0].RequestedBy)
+}
+
+func TestExportMyData(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		user  = &model.User{ID: 11, TenantID: 7, Name: "alice", Email: "alice@example.com"}
+		other = &model.User{ID: 12, TenantID: 7, Name: "boss", Email: "boss@example.com"}
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+	)
+
+	token, err := tokenAccess.GenerateToken(ctx, user)
+	require.NoError(t, err)
+
+	service := newPrivacyService(mc, tokenAccess, storeMocks(mc, newStore(user, other)))
+
+	bundle, err := service.ExportMyData(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, user.Email, bundle.Profile.Email)
+	assert.Len(t, bundle.AuditLog, 1)
+	assert.Len(t, bundle.LoginHistory, 1)
+	assert.Len(t, bundle.Invitations, 1)
+	require.Len(t, bundle.RoleGrants, 1)
+	assert.Equal(t, model.RoleAdmin, bundle.RoleGrants[0].Role)
+	require.Len(t, bundle.AuthorizationCodes, 1)
+	assert.Equal(t, "app", bundle.AuthorizationCodes[0].ClientID)
+	assert.Equal(t, "openid", bundle.AuthorizationCodes[0].Scope)
+	require.Len(t, bundle.LinkedIdentities, 1)
+	assert.Equal(t, "google", bundle.LinkedIdentities[0].Provider)
+}
@@ -0,0 +1,49 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// CheckRelation reports whether subject has relation to object
+func (s *serv) CheckRelation(ctx context.Context, accessToken string,
+	check *model.RelationCheck,
+) (*model.RelationCheckResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "check relation (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, zookie, err := s.snapshotRevision(ctx, check.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.newGraph(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRelation(g.namespaces, check.Object.Namespace, check.Relation); err != nil {
+		return nil, err
+	}
+	if err = validateSubject(g.namespaces, check.Subject); err != nil {
+		return nil, err
+	}
+
+	allowed, err := g.check(ctx, check.Object, check.Relation, check.Subject, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RelationCheckResult{
+		Allowed: allowed,
+		Zookie:  zookie,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// Expand returns userset tree of object relation
+func (s *serv) Expand(ctx context.Context, accessToken string,
+	expand *model.RelationExpand,
+) (*model.RelationExpandResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "expand relation (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, zookie, err := s.snapshotRevision(ctx, expand.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.newGraph(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRelation(g.namespaces, expand.Object.Namespace, expand.Relation); err != nil {
+		return nil, err
+	}
+
+	tree, err := g.expand(ctx, expand.Object, expand.Relation, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RelationExpandResult{
+		Tree:   tree,
+		Zookie: zookie,
+	}, nil
+}
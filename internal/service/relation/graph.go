@@ -0,0 +1,270 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+)
+
+// maxDepth limits nesting of usersets followed by single check or expansion
+const maxDepth = 25
+
+const (
+	operationUnion           = "union"
+	operationThis            = "this"
+	operationComputedUserset = "computed_userset"
+	operationTupleToUserset  = "tuple_to_userset"
+)
+
+// graph evaluates userset rewrites of namespaces over tuples visible at revision,
+// tuples and positive check results are memoized for single request
+type graph struct {
+	tupleRepository repository.RelationTupleRepository
+	namespaces      map[string]*model.RelationNamespace
+	revision        int64
+
+	tuples   map[string][]*model.RelationTuple
+	allowed  map[string]bool
+	checking map[string]bool
+}
+
+// newGraph returns graph of tenant at revision
+func (s *serv) newGraph(ctx context.Context, revision int64) (*graph, error) {
+	namespaces, err := s.namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graph{
+		tupleRepository: s.tupleRepository,
+		namespaces:      namespaces,
+		revision:        revision,
+		tuples:          make(map[string][]*model.RelationTuple),
+		allowed:         make(map[string]bool),
+		checking:        make(map[string]bool),
+	}, nil
+}
+
+// namespaces returns namespaces of tenant by name
+func (s *serv) namespaces(ctx context.Context) (map[string]*model.RelationNamespace, error) {
+	list, err := s.namespaceRepository.ListNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := make(map[string]*model.RelationNamespace, len(list))
+	for _, ns := range list {
+		namespaces[ns.Name] = ns
+	}
+
+	return namespaces, nil
+}
+
+// rewriteRules returns rules of relation rewrite, relation without rules consists of its tuples
+func rewriteRules(rewrite *model.RelationRewrite) []*model.RelationRewriteRule {
+	if rewrite == nil || len(rewrite.Union) == 0 {
+		return []*model.RelationRewriteRule{{This: true}}
+	}
+
+	return rewrite.Union
+}
+
+// defined reports whether relation is defined in namespace
+func (g *graph) defined(namespace, relation string) bool {
+	ns, ok := g.namespaces[namespace]
+	if !ok {
+		return false
+	}
+	_, ok = ns.Relations[relation]
+
+	return ok
+}
+
+func (g *graph) rules(object model.RelationObject, relation string) []*model.RelationRewriteRule {
+	return rewriteRules(g.namespaces[object.Namespace].Relations[relation])
+}
+
+func (g *graph) listTuples(ctx context.Context, object model.RelationObject, relation string) (
+	[]*model.RelationTuple, error,
+) {
+	key := object.String() + "#" + relation
+	if tuples, ok := g.tuples[key]; ok {
+		return tuples, nil
+	}
+
+	tuples, err := g.tupleRepository.ListTuples(ctx, object, relation, g.revision)
+	if err != nil {
+		return nil, err
+	}
+	g.tuples[key] = tuples
+
+	return tuples, nil
+}
+
+// check reports whether subject is in userset of object relation,
+// userset reached again while being checked adds no members, so cycles end,
+// negative results depend on usersets being checked and are not memoized
+func (g *graph) check(ctx context.Context, object model.RelationObject, relation string,
+	subject model.RelationSubject, depth int,
+) (bool, error) {
+	if depth > maxDepth {
+		return false, errors.New("relation check exceeded maximum depth")
+	}
+	if !g.defined(object.Namespace, relation) {
+		return false, nil
+	}
+
+	key := object.String() + "#" + relation + "@" + subject.String()
+	if g.allowed[key] {
+		return true, nil
+	}
+	if g.checking[key] {
+		return false, nil
+	}
+	g.checking[key] = true
+	defer delete(g.checking, key)
+
+	for _, rule := range g.rules(object, relation) {
+		allowed, err := g.checkRule(ctx, object, relation, rule, subject, depth)
+		if err != nil {
+			return false, err
+		}
+		if allowed {
+			g.allowed[key] = true
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (g *graph) checkRule(ctx context.Context, object model.RelationObject, relation string,
+	rule *model.RelationRewriteRule, subject model.RelationSubject, depth int,
+) (bool, error) {
+	switch {
+	case rule.This:
+		tuples, err := g.listTuples(ctx, object, relation)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tuple := range tuples {
+			if tuple.Subject == subject {
+				return true, nil
+			}
+		}
+		for _, tuple := range tuples {
+			if tuple.Subject.Relation == "" {
+				continue
+			}
+			allowed, errCheck := g.check(ctx, tuple.Subject.Object(), tuple.Subject.Relation,
+				subject, depth+1)
+			if errCheck != nil || allowed {
+				return allowed, errCheck
+			}
+		}
+	case rule.ComputedUserset != "":
+		return g.check(ctx, object, rule.ComputedUserset, subject, depth+1)
+	case rule.TupleToUserset != nil:
+		tuples, err := g.listTuples(ctx, object, rule.TupleToUserset.Tupleset)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tuple := range tuples {
+			allowed, errCheck := g.check(ctx, tuple.Subject.Object(),
+				rule.TupleToUserset.ComputedUserset, subject, depth+1)
+			if errCheck != nil || allowed {
+				return allowed, errCheck
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// expand returns userset tree of object relation, subjects of tuples which are usersets
+// are returned as is and may be expanded by separate request
+func (g *graph) expand(ctx context.Context, object model.RelationObject, relation string,
+	depth int,
+) (*model.UsersetTree, error) {
+	if depth > maxDepth {
+		return nil, errors.New("relation expansion exceeded maximum depth")
+	}
+
+	tree := &model.UsersetTree{
+		Object:    object,
+		Relation:  relation,
+		Operation: operationUnion,
+	}
+
+	for _, rule := range g.rules(object, relation) {
+		child, err := g.expandRule(ctx, object, relation, rule, depth)
+		if err != nil {
+			return nil, err
+		}
+		tree.Children = append(tree.Children, child)
+	}
+
+	return tree, nil
+}
+
+func (g *graph) expandRule(ctx context.Context, object model.RelationObject, relation string,
+	rule *model.RelationRewriteRule, depth int,
+) (*model.UsersetTree, error) {
+	switch {
+	case rule.ComputedUserset != "":
+		child, err := g.expand(ctx, object, rule.ComputedUserset, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		child.Operation = operationComputedUserset
+
+		return child, nil
+	case rule.TupleToUserset != nil:
+		tuples, err := g.listTuples(ctx, object, rule.TupleToUserset.Tupleset)
+		if err != nil {
+			return nil, err
+		}
+
+		node := &model.UsersetTree{
+			Object:    object,
+			Relation:  rule.TupleToUserset.Tupleset,
+			Operation: operationTupleToUserset,
+		}
+		for _, tuple := range tuples {
+			target := tuple.Subject.Object()
+			if !g.defined(target.Namespace, rule.TupleToUserset.ComputedUserset) {
+				continue
+			}
+
+			child, errExpand := g.expand(ctx, target, rule.TupleToUserset.ComputedUserset,
+				depth+1)
+			if errExpand != nil {
+				return nil, errExpand
+			}
+			node.Children = append(node.Children, child)
+		}
+
+		return node, nil
+	default:
+		tuples, err := g.listTuples(ctx, object, relation)
+		if err != nil {
+			return nil, err
+		}
+
+		node := &model.UsersetTree{
+			Object:    object,
+			Relation:  relation,
+			Operation: operationThis,
+		}
+		for _, tuple := range tuples {
+			node.Subjects = append(node.Subjects, tuple.Subject)
+		}
+
+		return node, nil
+	}
+}
@@ -0,0 +1,25 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListNamespaces returns namespace configurations of user organization
+func (s *serv) ListNamespaces(ctx context.Context, accessToken string) (
+	[]*model.RelationNamespace, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list namespaces (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.namespaceRepository.ListNamespaces(ctx)
+}
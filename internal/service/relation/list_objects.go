@@ -0,0 +1,63 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListObjects returns IDs of objects of namespace subject has relation to,
+// every object having tuples in namespace is checked within single graph
+func (s *serv) ListObjects(ctx context.Context, accessToken string,
+	list *model.RelationListObjects,
+) (*model.RelationListObjectsResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list objects (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authenticate(ctx, s.accessService, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, zookie, err := s.snapshotRevision(ctx, list.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.newGraph(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRelation(g.namespaces, list.Namespace, list.Relation); err != nil {
+		return nil, err
+	}
+	if err = validateSubject(g.namespaces, list.Subject); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.tupleRepository.ListObjectIDs(ctx, list.Namespace, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &model.RelationListObjectsResult{
+		ObjectIDs: make([]string, 0),
+		Zookie:    zookie,
+	}
+	for _, id := range ids {
+		object := model.RelationObject{Namespace: list.Namespace, ID: id}
+
+		allowed, errCheck := g.check(ctx, object, list.Relation, list.Subject, 0)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+		if allowed {
+			res.ObjectIDs = append(res.ObjectIDs, id)
+		}
+	}
+
+	return res, nil
+}
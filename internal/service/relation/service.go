@@ -0,0 +1,32 @@
+package relation
+
+import (
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/platform-common/pkg/client/db"
+)
+
+// endpointPrefix is prefix of endpoints of relations in access rules
+const endpointPrefix = "/relation_v1.RelationV1/"
+
+type serv struct {
+	tupleRepository     repository.RelationTupleRepository
+	namespaceRepository repository.RelationNamespaceRepository
+	txManager           db.TxManager
+	accessService       service.AccessService
+}
+
+// NewService creates new service with settings
+func NewService(
+	tupleRepository repository.RelationTupleRepository,
+	namespaceRepository repository.RelationNamespaceRepository,
+	txManager db.TxManager,
+	accessService service.AccessService,
+) service.RelationService {
+	return &serv{
+		tupleRepository:     tupleRepository,
+		namespaceRepository: namespaceRepository,
+		txManager:           txManager,
+		accessService:       accessService,
+	}
+}
@@ -0,0 +1,340 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/service/relation"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/auth/internal/utils"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type mocks struct {
+	tupleRepository      repository.RelationTupleRepository
+	namespaceRepository  repository.RelationNamespaceRepository
+	revocationRepository repository.TokenRevocationRepository
+}
+
+// newAccessService returns access service allowing admins only to any endpoint
+func newAccessService(mc *minimock.Controller, tokenAccess utils.Token,
+	revocationRepository repository.TokenRevocationRepository,
+) service.AccessService {
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Return(&model.EndpointAccessRule{
+		Allow: []*model.AccessRule{{Effect: model.AccessEffectAllow, Role: model.RoleAdmin}},
+	}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Return(nil, nil)
+
+	return access.NewService(accessMock, revocationRepository, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, nil))
+}
+
+func TestCheckRelation(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantID       = int64(3)
+		latestRevision = int64(7)
+		// membership of bob in group eng is written at latest revision
+		memberRevision = int64(7)
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func() string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: tenantID,
+				Name:     "reader",
+				Role:     model.RoleUser,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}()
+		zookie = func(tenantID, revision int64) string {
+			return base64.RawURLEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%d.%d", tenantID, revision)))
+		}
+
+		namespaces = []*model.RelationNamespace{
+			{
+				Name:      "group",
+				Relations: map[string]*model.RelationRewrite{"member": {}},
+			},
+			{
+				Name:      "folder",
+				Relations: map[string]*model.RelationRewrite{"viewer": {}},
+			},
+			{
+				Name: "document",
+				Relations: map[string]*model.RelationRewrite{
+					"owner":  {},
+					"parent": {},
+					"editor": {Union: []*model.RelationRewriteRule{
+						{This: true},
+						{ComputedUserset: "owner"},
+					}},
+					"viewer": {Union: []*model.RelationRewriteRule{
+						{This: true},
+						{ComputedUserset: "editor"},
+						{TupleToUserset: &model.TupleToUserset{
+							Tupleset:        "parent",
+							ComputedUserset: "viewer",
+						}},
+					}},
+				},
+			},
+		}
+		tuples = map[string][]*model.RelationTuple{
+			"document:readme#owner": {{
+				Object:   model.RelationObject{Namespace: "document", ID: "readme"},
+				Relation: "owner",
+				Subject:  model.RelationSubject{Namespace: "user", ID: "alice"},
+			}},
+			"document:readme#parent": {{
+				Object:   model.RelationObject{Namespace: "document", ID: "readme"},
+				Relation: "parent",
+				Subject:  model.RelationSubject{Namespace: "folder", ID: "docs"},
+			}},
+			"folder:docs#viewer": {{
+				Object:   model.RelationObject{Namespace: "folder", ID: "docs"},
+				Relation: "viewer",
+				Subject:  model.RelationSubject{Namespace: "group", ID: "eng", Relation: "member"},
+			}},
+			"group:eng#member": {{
+				Object:   model.RelationObject{Namespace: "group", ID: "eng"},
+				Relation: "member",
+				Subject:  model.RelationSubject{Namespace: "user", ID: "bob"},
+			}},
+		}
+
+		readme = model.RelationObject{Namespace: "document", ID: "readme"}
+		alice  = model.RelationSubject{Namespace: "user", ID: "alice"}
+		bob    = model.RelationSubject{Namespace: "user", ID: "bob"}
+		carol  = model.RelationSubject{Namespace: "user", ID: "carol"}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	revisionFunc := func(mc *minimock.Controller) mocks {
+		revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+		revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+		tupleMock := repoMocks.NewRelationTupleRepositoryMock(mc)
+		tupleMock.GetRevisionMock.Return(latestRevision, nil)
+
+		return mocks{
+			tupleRepository:      tupleMock,
+			namespaceRepository:  repoMocks.NewRelationNamespaceRepositoryMock(mc),
+			revocationRepository: revocationMock,
+		}
+	}
+	namespacesFunc := func(mc *minimock.Controller) mocks {
+		m := revisionFunc(mc)
+
+		namespaceMock := repoMocks.NewRelationNamespaceRepositoryMock(mc)
+		namespaceMock.ListNamespacesMock.Set(func(ctx context.Context) (
+			[]*model.RelationNamespace, error,
+		) {
+			if ctxTenantID, err := tenant.FromContext(ctx); err != nil || ctxTenantID != tenantID {
+				return nil, fmt.Errorf("namespaces listed in wrong tenant")
+			}
+			return namespaces, nil
+		})
+		m.namespaceRepository = namespaceMock
+
+		return m
+	}
+	graphFunc := func(listErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			m := namespacesFunc(mc)
+
+			tupleMock := m.tupleRepository.(*repoMocks.RelationTupleRepositoryMock)
+			tupleMock.ListTuplesMock.Set(func(_ context.Context,
+				object model.RelationObject, relation string, revision int64,
+			) ([]*model.RelationTuple, error) {
+				if listErr != nil {
+					return nil, listErr
+				}
+				key := object.String() + "#" + relation
+				if key == "group:eng#member" && revision < memberRevision {
+					return nil, nil
+				}
+				return tuples[key], nil
+			})
+
+			return m
+		}
+	}
+
+	testsSuccessful := []struct {
+		name    string
+		check   *model.RelationCheck
+		allowed bool
+		zookie  string
+		mocks   mocksFunc
+	}{
+		{
+			name: "success case: owner is viewer by computed usersets",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer",
+				Subject: alice},
+			allowed: true,
+			zookie:  zookie(tenantID, latestRevision),
+			mocks:   graphFunc(nil),
+		},
+		{
+			name: "success case: group member is viewer by tuple to userset",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer",
+				Subject: bob},
+			allowed: true,
+			zookie:  zookie(tenantID, latestRevision),
+			mocks:   graphFunc(nil),
+		},
+		{
+			name: "success case: viewer is not editor",
+			check: &model.RelationCheck{Object: readme, Relation: "editor",
+				Subject: bob},
+			allowed: false,
+			zookie:  zookie(tenantID, latestRevision),
+			mocks:   graphFunc(nil),
+		},
+		{
+			name: "success case: unrelated user",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer",
+				Subject: carol},
+			allowed: false,
+			zookie:  zookie(tenantID, latestRevision),
+			mocks:   graphFunc(nil),
+		},
+		{
+			name: "success case: at least as fresh snapshot is latest",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer", Subject: bob,
+				Snapshot: model.RelationSnapshot{
+					Consistency: model.ConsistencyAtLeastAsFresh,
+					Zookie:      zookie(tenantID, 5),
+				}},
+			allowed: true,
+			zookie:  zookie(tenantID, latestRevision),
+			mocks:   graphFunc(nil),
+		},
+		{
+			name: "success case: exact snapshot before membership",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer", Subject: bob,
+				Snapshot: model.RelationSnapshot{
+					Consistency: model.ConsistencyAtExactSnapshot,
+					Zookie:      zookie(tenantID, 5),
+				}},
+			allowed: false,
+			zookie:  zookie(tenantID, 5),
+			mocks:   graphFunc(nil),
+		},
+	}
+	testsErrors := []struct {
+		name  string
+		check *model.RelationCheck
+		err   error
+		mocks mocksFunc
+	}{
+		{
+			name: "error: relation is not defined",
+			check: &model.RelationCheck{Object: readme, Relation: "commenter",
+				Subject: alice},
+			err:   fmt.Errorf("relation commenter is not defined in namespace document"),
+			mocks: namespacesFunc,
+		},
+		{
+			name: "error: namespace is not configured",
+			check: &model.RelationCheck{
+				Object:   model.RelationObject{Namespace: "room", ID: "general"},
+				Relation: "member", Subject: alice,
+			},
+			err:   model.ErrorRelationNamespaceNotFound,
+			mocks: namespacesFunc,
+		},
+		{
+			name: "error: zookie of another organization",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer", Subject: bob,
+				Snapshot: model.RelationSnapshot{
+					Consistency: model.ConsistencyAtExactSnapshot,
+					Zookie:      zookie(tenantID+1, 5),
+				}},
+			err:   fmt.Errorf("issued in another organization"),
+			mocks: revisionFunc,
+		},
+		{
+			name: "error: zookie of revision not written yet",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer", Subject: bob,
+				Snapshot: model.RelationSnapshot{
+					Consistency: model.ConsistencyAtLeastAsFresh,
+					Zookie:      zookie(tenantID, latestRevision+1),
+				}},
+			err:   fmt.Errorf("revision is not written yet"),
+			mocks: revisionFunc,
+		},
+		{
+			name: "repo error: list tuples",
+			check: &model.RelationCheck{Object: readme, Relation: "viewer",
+				Subject: alice},
+			err:   repoErr,
+			mocks: graphFunc(repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := relation.NewService(m.tupleRepository, m.namespaceRepository,
+				dbMocks.NewTxManagerMock(mc),
+				newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			res, err := service.CheckRelation(ctx, token, tt.check)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.allowed, res.Allowed)
+			assert.Equal(t, tt.zookie, res.Zookie)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := relation.NewService(m.tupleRepository, m.namespaceRepository,
+				dbMocks.NewTxManagerMock(mc),
+				newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			_, err := service.CheckRelation(ctx, token, tt.check)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
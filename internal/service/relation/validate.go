@@ -0,0 +1,147 @@
+package relation
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+func validateNamespace(namespace *model.RelationNamespace) error {
+	if namespace == nil {
+		return errors.New("unable to save namespace: empty model")
+	}
+	if !nameRegexp.MatchString(namespace.Name) {
+		return errors.Errorf("unable to save namespace: invalid name %q", namespace.Name)
+	}
+	if len(namespace.Relations) == 0 {
+		return errors.New("unable to save namespace: at least one relation is required")
+	}
+
+	for name, rewrite := range namespace.Relations {
+		if !nameRegexp.MatchString(name) {
+			return errors.Errorf("unable to save namespace: invalid relation name %q", name)
+		}
+		if rewrite == nil {
+			continue
+		}
+
+		for _, rule := range rewrite.Union {
+			if err := validateRewriteRule(namespace, rule); err != nil {
+				return errors.Wrapf(err, "unable to save namespace: relation %s", name)
+			}
+		}
+	}
+
+	for name := range namespace.Relations {
+		if computedCycle(namespace, name, map[string]bool{}) {
+			return errors.Errorf("unable to save namespace: relation %s is computed from itself",
+				name)
+		}
+	}
+
+	return nil
+}
+
+func validateRewriteRule(namespace *model.RelationNamespace, rule *model.RelationRewriteRule) error {
+	if rule == nil {
+		return errors.New("empty rewrite rule")
+	}
+
+	set := 0
+	if rule.This {
+		set++
+	}
+	if rule.ComputedUserset != "" {
+		set++
+		if _, ok := namespace.Relations[rule.ComputedUserset]; !ok {
+			return errors.Errorf("computed userset %s is not defined", rule.ComputedUserset)
+		}
+	}
+	if rule.TupleToUserset != nil {
+		set++
+		if _, ok := namespace.Relations[rule.TupleToUserset.Tupleset]; !ok {
+			return errors.Errorf("tupleset %s is not defined", rule.TupleToUserset.Tupleset)
+		}
+		if !nameRegexp.MatchString(rule.TupleToUserset.ComputedUserset) {
+			return errors.Errorf("invalid computed userset %q of tuple to userset",
+				rule.TupleToUserset.ComputedUserset)
+		}
+	}
+	if set != 1 {
+		return errors.New("rewrite rule must have exactly one of this, computed userset " +
+			"and tuple to userset")
+	}
+
+	return nil
+}
+
+// computedCycle reports whether relation reaches itself by computed usersets
+func computedCycle(namespace *model.RelationNamespace, relation string,
+	path map[string]bool,
+) bool {
+	if path[relation] {
+		return true
+	}
+	path[relation] = true
+	defer delete(path, relation)
+
+	for _, rule := range rewriteRules(namespace.Relations[relation]) {
+		if rule.ComputedUserset != "" && computedCycle(namespace, rule.ComputedUserset, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateRelation returns error if relation is not defined in namespace
+func validateRelation(namespaces map[string]*model.RelationNamespace,
+	namespace, relation string,
+) error {
+	ns, ok := namespaces[namespace]
+	if !ok {
+		return errors.Wrapf(model.ErrorRelationNamespaceNotFound, "namespace %s", namespace)
+	}
+	if _, ok = ns.Relations[relation]; !ok {
+		return errors.Errorf("relation %s is not defined in namespace %s", relation, namespace)
+	}
+
+	return nil
+}
+
+// validateSubject returns error if subject is userset of undefined relation
+func validateSubject(namespaces map[string]*model.RelationNamespace,
+	subject model.RelationSubject,
+) error {
+	if subject.Namespace == "" || subject.ID == "" {
+		return errors.New("subject namespace and object ID are required")
+	}
+	if subject.Relation == "" {
+		return nil
+	}
+
+	return validateRelation(namespaces, subject.Namespace, subject.Relation)
+}
+
+func validateTuple(namespaces map[string]*model.RelationNamespace,
+	tuple *model.RelationTuple,
+) error {
+	if tuple == nil {
+		return errors.New("empty tuple")
+	}
+	if tuple.Object.ID == "" {
+		return errors.New("object ID is required")
+	}
+	if err := validateRelation(namespaces, tuple.Object.Namespace, tuple.Relation); err != nil {
+		return errors.Wrapf(err, "tuple %s", tuple)
+	}
+	if err := validateSubject(namespaces, tuple.Subject); err != nil {
+		return errors.Wrapf(err, "tuple %s", tuple)
+	}
+
+	return nil
+}
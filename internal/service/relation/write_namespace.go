@@ -0,0 +1,30 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// WriteNamespace creates or replaces namespace configuration of admin organization
+func (s *serv) WriteNamespace(ctx context.Context, accessToken string,
+	namespace *model.RelationNamespace,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "write namespace (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"WriteNamespace", nil)
+	if err != nil {
+		return err
+	}
+
+	if err = validateNamespace(namespace); err != nil {
+		return err
+	}
+
+	return s.namespaceRepository.SaveNamespace(ctx, namespace)
+}
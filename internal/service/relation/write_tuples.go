@@ -0,0 +1,78 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// WriteTuples atomically deletes and creates tuples in admin organization
+// and returns zookie of revision including the change
+func (s *serv) WriteTuples(ctx context.Context, accessToken string,
+	write *model.RelationTupleWrite,
+) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "write tuples (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"WriteTuples", nil)
+	if err != nil {
+		return "", err
+	}
+
+	if write == nil || len(write.Writes)+len(write.Deletes) == 0 {
+		return "", errors.New("unable to write tuples: no tuples to write or delete")
+	}
+
+	namespaces, err := s.namespaces(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, tuple := range write.Writes {
+		if err = validateTuple(namespaces, tuple); err != nil {
+			return "", errors.Wrap(err, "unable to write tuples")
+		}
+	}
+	for _, tuple := range write.Deletes {
+		if tuple == nil {
+			return "", errors.New("unable to write tuples: empty tuple")
+		}
+	}
+
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var revision int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		revision, errTx = s.tupleRepository.IncrementRevision(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, tuple := range write.Deletes {
+			if errTx = s.tupleRepository.DeleteTuple(ctx, tuple, revision); errTx != nil {
+				return errTx
+			}
+		}
+		for _, tuple := range write.Writes {
+			if errTx = s.tupleRepository.CreateTuple(ctx, tuple, revision); errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return encodeZookie(tenantID, revision), nil
+}
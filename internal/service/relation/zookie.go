@@ -0,0 +1,80 @@
+package relation
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+// encodeZookie returns opaque consistency token of tenant revision
+func encodeZookie(tenantID, revision int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d.%d", tenantID, revision)))
+}
+
+// decodeZookie returns revision of consistency token issued in tenant
+func decodeZookie(zookie string, tenantID int64) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(zookie)
+	if err != nil {
+		return 0, errors.New("invalid zookie")
+	}
+
+	tenantPart, revisionPart, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return 0, errors.New("invalid zookie")
+	}
+
+	zookieTenantID, err := strconv.ParseInt(tenantPart, 10, 64)
+	if err != nil || zookieTenantID != tenantID {
+		return 0, errors.New("invalid zookie: issued in another organization")
+	}
+
+	revision, err := strconv.ParseInt(revisionPart, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, errors.New("invalid zookie")
+	}
+
+	return revision, nil
+}
+
+// snapshotRevision returns revision request is evaluated at and zookie of it
+func (s *serv) snapshotRevision(ctx context.Context, snapshot model.RelationSnapshot) (
+	int64, string, error,
+) {
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	latest, err := s.tupleRepository.GetRevision(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	revision := latest
+	if snapshot.Consistency != model.ConsistencyMinimizeLatency {
+		if snapshot.Zookie == "" {
+			return 0, "", errors.New("zookie is required for requested consistency")
+		}
+
+		zookieRevision, errDecode := decodeZookie(snapshot.Zookie, tenantID)
+		if errDecode != nil {
+			return 0, "", errDecode
+		}
+		if zookieRevision > latest {
+			return 0, "", errors.New("invalid zookie: revision is not written yet")
+		}
+
+		if snapshot.Consistency == model.ConsistencyAtExactSnapshot {
+			revision = zookieRevision
+		}
+	}
+
+	return revision, encodeZookie(tenantID, revision), nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/valek177/auth/internal/model"
 )
@@ -10,8 +11,16 @@ import (
 type UserService interface {
 	CreateUser(ctx context.Context, newUser *model.NewUser) (int64, error)
 	GetUser(ctx context.Context, id int64) (*model.User, error)
+	GetUserByName(ctx context.Context, name string) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	BatchGetUsers(ctx context.Context, ids []int64) ([]*model.User, []int64, error)
+	ListUsers(ctx context.Context, afterID int64, limit uint64) ([]*model.User, error)
 	UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserInfo) error
 	DeleteUser(ctx context.Context, id int64) error
+	ImportUsers(ctx context.Context, options *model.ImportUsersOptions, data io.Reader) (
+		*model.Operation, error)
+	GetOperation(ctx context.Context, id int64) (*model.Operation, error)
+	ExportUsers(ctx context.Context, format string, w io.Writer) error
 }
 
 // AuthService is interface for auth logic on service
@@ -19,14 +28,154 @@ type AuthService interface {
 	Login(ctx context.Context, username, password string) (string, string, error)
 	GetRefreshToken(ctx context.Context, oldRefreshToken string) (string, error)
 	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
+	GetOAuthClient(ctx context.Context, clientID, redirectURI string) (*model.OAuthClient, error)
+	AuthorizeOAuth(ctx context.Context, req *model.OAuthAuthorizationRequest,
+		username, password string) (string, error)
+	AuthorizeOAuthUser(ctx context.Context, req *model.OAuthAuthorizationRequest,
+		userID int64, authMethod string) (string, error)
+	ExchangeOAuthCode(ctx context.Context, exchange *model.OAuthCodeExchange) (
+		*model.OAuthToken, error)
+	RefreshOAuthToken(ctx context.Context, clientID, refreshToken string) (
+		*model.OAuthToken, error)
+	IssueClientCredentialsToken(ctx context.Context,
+		credentials *model.OAuthClientCredentials) (*model.OAuthToken, error)
+	GetUserInfo(ctx context.Context, accessToken string) (*model.User, error)
+}
+
+// Authenticator is interface for backend checking password of user, it returns
+// model.ErrorInvalidCredentials for unknown user or wrong password
+type Authenticator interface {
+	Authenticate(ctx context.Context, username, password string) (*model.User, error)
 }
 
 // AccessService is interface for access logic on service
 type AccessService interface {
-	Check(ctx context.Context, accessToken string, endpoint string) (bool, error)
+	Check(ctx context.Context, accessToken string, endpoint string,
+		attributes map[string]string, explain bool) (*model.AccessDecision, error)
+	BatchCheck(ctx context.Context, accessToken string, checks []*model.AccessCheck) (
+		[]*model.AccessDecision, error)
+	ListPermissions(ctx context.Context, accessToken string, attributes map[string]string) (
+		*model.AccessPermissions, error)
+	Authorize(ctx context.Context, accessToken string, endpoint string,
+		attributes map[string]string) (*model.AccessAuthorization, error)
+	Authenticate(ctx context.Context, accessToken string) (*model.UserClaims, error)
+}
+
+// PolicyService is interface for access policy administration logic on service
+type PolicyService interface {
+	CreateRule(ctx context.Context, accessToken string, rule *model.AccessRule) (int64, error)
+	ListRules(ctx context.Context, accessToken string, filter *model.AccessRuleFilter) (
+		[]*model.AccessRule, error)
+	UpdateRule(ctx context.Context, accessToken string, update *model.UpdateAccessRule) error
+	DeleteRule(ctx context.Context, accessToken string, id int64) error
+	CreatePermission(ctx context.Context, accessToken string, permission *model.Permission) (
+		int64, error)
+	ListPermissions(ctx context.Context, accessToken string) ([]*model.Permission, error)
+	DeletePermission(ctx context.Context, accessToken string, name string) error
+	GrantPermission(ctx context.Context, accessToken string, grant *model.RolePermission) error
+	RevokePermission(ctx context.Context, accessToken string, grant *model.RolePermission) error
+	AddRoleParent(ctx context.Context, accessToken string, parent *model.RoleParent) error
+	DeleteRoleParent(ctx context.Context, accessToken string, parent *model.RoleParent) error
+	GetRolePermissions(ctx context.Context, accessToken string, role string) (
+		*model.RolePermissions, error)
+	SimulateCheck(ctx context.Context, accessToken string, simulation *model.AccessSimulation) (
+		*model.AccessDecision, error)
+	ApplyPolicy(ctx context.Context, accessToken string, bundle *model.PolicyBundle, dryRun bool) (
+		*model.PolicyApplyResult, error)
+	ExportPolicy(ctx context.Context, accessToken string) (*model.PolicyBundle, error)
+	ListPolicyVersions(ctx context.Context, accessToken string) (
+		[]*model.PolicyBundleVersion, error)
+	RollbackPolicy(ctx context.Context, accessToken string, version int64, dryRun bool) (
+		*model.PolicyApplyResult, error)
+}
+
+// OAuthClientService is interface for registry of OAuth clients
+type OAuthClientService interface {
+	CreateClient(ctx context.Context, accessToken string, client *model.OAuthClient) (
+		*model.OAuthClient, error)
+	ListClients(ctx context.Context, accessToken string) ([]*model.OAuthClient, error)
+	DeleteClient(ctx context.Context, accessToken string, clientID string) error
+}
+
+// FederationService is interface for federated login through upstream identity providers
+type FederationService interface {
+	ListProviders(ctx context.Context, clientID string) ([]*model.FederationProvider, error)
+	BeginLogin(ctx context.Context, provider string, req *model.OAuthAuthorizationRequest) (
+		*model.FederationRedirect, error)
+	CompleteLogin(ctx context.Context, provider string, callback *model.FederationCallback) (
+		*model.FederatedLogin, error)
+}
+
+// ServiceAccountService is interface for service accounts of organization
+type ServiceAccountService interface {
+	CreateServiceAccount(ctx context.Context, accessToken string, account *model.ServiceAccount) (
+		*model.ServiceAccountCredentials, error)
+	ListServiceAccounts(ctx context.Context, accessToken string) ([]*model.ServiceAccount, error)
+	RotateServiceAccountSecret(ctx context.Context, accessToken string, clientID string) (
+		*model.ServiceAccountCredentials, error)
+	DisableServiceAccount(ctx context.Context, accessToken string, clientID string) error
+}
+
+// OrganizationService is interface for organization logic on service
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, name string) (int64, error)
+	GetOrganization(ctx context.Context, id int64) (*model.Organization, error)
+	ListOrganizations(ctx context.Context) ([]*model.Organization, error)
+	DeleteOrganization(ctx context.Context, id int64) error
+	CreateRole(ctx context.Context, role *model.Role) (int64, error)
+	ListRoles(ctx context.Context, organizationID int64) ([]*model.Role, error)
+	DeleteRole(ctx context.Context, organizationID int64, name string) error
+}
+
+// InvitationService is interface for invitation logic on service
+type InvitationService interface {
+	InviteUser(ctx context.Context, newInvitation *model.NewInvitation) (*model.Invitation, error)
+	AcceptInvitation(ctx context.Context, acceptInvitation *model.AcceptInvitation) (
+		string, string, error)
+	ResendInvitation(ctx context.Context, id int64) error
+	RevokeInvitation(ctx context.Context, id int64) error
+}
+
+// PrivacyService is interface for data subject requests logic on service
+type PrivacyService interface {
+	ExportMyData(ctx context.Context, accessToken string) (*model.DataBundle, error)
+	EraseUser(ctx context.Context, accessToken string, userID int64, reason string) (
+		*model.ErasureRequest, error)
+	GetErasureRequest(ctx context.Context, accessToken string, id int64) (
+		*model.ErasureRequest, error)
+	ApproveErasure(ctx context.Context, accessToken string, id int64) (
+		*model.ErasureRequest, error)
+	RejectErasure(ctx context.Context, accessToken string, id int64) (
+		*model.ErasureRequest, error)
+}
+
+// ElevationService is interface for just-in-time role elevation with approval
+type ElevationService interface {
+	RequestElevation(ctx context.Context, accessToken string, elevation *model.NewElevation) (
+		*model.RoleGrant, error)
+	ApproveElevation(ctx context.Context, accessToken string, id int64) (*model.RoleGrant, error)
+	DenyElevation(ctx context.Context, accessToken string, id int64) (*model.RoleGrant, error)
+	ListElevations(ctx context.Context, accessToken string, status string) (
+		[]*model.RoleGrant, error)
+	ExpireElevations(ctx context.Context) (int64, error)
 }
 
 // ConsumerService is interface for consumer logic
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+// RelationService is interface for relationship-based authorization over relation tuples
+type RelationService interface {
+	WriteNamespace(ctx context.Context, accessToken string,
+		namespace *model.RelationNamespace) error
+	ListNamespaces(ctx context.Context, accessToken string) ([]*model.RelationNamespace, error)
+	WriteTuples(ctx context.Context, accessToken string, write *model.RelationTupleWrite) (
+		string, error)
+	CheckRelation(ctx context.Context, accessToken string, check *model.RelationCheck) (
+		*model.RelationCheckResult, error)
+	Expand(ctx context.Context, accessToken string, expand *model.RelationExpand) (
+		*model.RelationExpandResult, error)
+	ListObjects(ctx context.Context, accessToken string, list *model.RelationListObjects) (
+		*model.RelationListObjectsResult, error)
+}
@@ -0,0 +1,61 @@
+package serviceaccount
+
+import (
+	"context"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// CreateServiceAccount creates service account in admin organization and returns it
+// with client secret, which is not stored and can not be read later
+func (s *serv) CreateServiceAccount(ctx context.Context, accessToken string,
+	account *model.ServiceAccount,
+) (*model.ServiceAccountCredentials, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "create service account (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"CreateServiceAccount", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateCreateServiceAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, err := newClientID()
+	if err != nil {
+		return nil, err
+	}
+
+	clientSecret, secretHash, err := newClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	created := *account
+	created.ClientID = clientID
+	created.SecretHash = secretHash
+	created.Scopes = slices.Compact(slices.Sorted(slices.Values(account.Scopes)))
+
+	_, err = s.serviceAccountRepository.CreateServiceAccount(ctx, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.serviceAccountRepository.GetServiceAccount(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ServiceAccountCredentials{
+		Account:      res,
+		ClientSecret: clientSecret,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package serviceaccount
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// DisableServiceAccount disables service account of admin organization and revokes its tokens
+func (s *serv) DisableServiceAccount(ctx context.Context, accessToken string,
+	clientID string,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "disable service account (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"DisableServiceAccount", nil)
+	if err != nil {
+		return err
+	}
+
+	err = s.serviceAccountRepository.DisableServiceAccount(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return s.revocationRepository.RevokeUserTokens(ctx, model.ServiceAccountPrincipal(clientID),
+		time.Now())
+}
@@ -0,0 +1,26 @@
+package serviceaccount
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// ListServiceAccounts returns service accounts of admin organization
+func (s *serv) ListServiceAccounts(ctx context.Context, accessToken string) (
+	[]*model.ServiceAccount, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list service accounts (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"ListServiceAccounts", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.serviceAccountRepository.ListServiceAccounts(ctx)
+}
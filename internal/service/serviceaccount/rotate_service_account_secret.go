@@ -0,0 +1,52 @@
+package serviceaccount
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/service/caller"
+)
+
+// RotateServiceAccountSecret replaces client secret of service account of admin organization,
+// previous secret and tokens issued with it stop working immediately
+func (s *serv) RotateServiceAccountSecret(ctx context.Context, accessToken string,
+	clientID string,
+) (*model.ServiceAccountCredentials, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "rotate service account secret (service)")
+	defer span.Finish()
+
+	ctx, _, err := caller.Authorize(ctx, s.accessService, accessToken,
+		endpointPrefix+"RotateServiceAccountSecret", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSecret, secretHash, err := newClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.serviceAccountRepository.UpdateServiceAccountSecret(ctx, clientID, secretHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.revocationRepository.RevokeUserTokens(ctx, model.ServiceAccountPrincipal(clientID),
+		time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.serviceAccountRepository.GetServiceAccount(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ServiceAccountCredentials{
+		Account:      account,
+		ClientSecret: clientSecret,
+	}, nil
+}
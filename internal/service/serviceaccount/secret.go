@@ -0,0 +1,40 @@
+package serviceaccount
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+
+	passwordLib "github.com/valek177/auth/internal/password"
+)
+
+const (
+	clientIDSize     = 16
+	clientSecretSize = 32
+)
+
+// newClientID returns random client ID of service account
+func newClientID() (string, error) {
+	clientID := make([]byte, clientIDSize)
+	if _, err := rand.Read(clientID); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(clientID), nil
+}
+
+// newClientSecret returns random client secret with its hash
+func newClientSecret() (string, string, error) {
+	secret := make([]byte, clientSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	clientSecret := base64.RawURLEncoding.EncodeToString(secret)
+
+	secretHash, err := passwordLib.HashPassword(clientSecret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return clientSecret, secretHash, nil
+}
@@ -0,0 +1,34 @@
+package serviceaccount
+
+import (
+	"github.com/valek177/auth/internal/repository"
+	"github.com/valek177/auth/internal/service"
+)
+
+// endpointPrefix is prefix of endpoints of service accounts administration in access rules
+const endpointPrefix = "/oauth_v1.OAuthV1/"
+
+type serv struct {
+	serviceAccountRepository repository.ServiceAccountRepository
+	roleRepository           repository.RoleRepository
+	permissionRepository     repository.PermissionRepository
+	revocationRepository     repository.TokenRevocationRepository
+	accessService            service.AccessService
+}
+
+// NewService creates new service with settings
+func NewService(
+	serviceAccountRepository repository.ServiceAccountRepository,
+	roleRepository repository.RoleRepository,
+	permissionRepository repository.PermissionRepository,
+	revocationRepository repository.TokenRevocationRepository,
+	accessService service.AccessService,
+) service.ServiceAccountService {
+	return &serv{
+		serviceAccountRepository: serviceAccountRepository,
+		roleRepository:           roleRepository,
+		permissionRepository:     permissionRepository,
+		revocationRepository:     revocationRepository,
+		accessService:            accessService,
+	}
+}
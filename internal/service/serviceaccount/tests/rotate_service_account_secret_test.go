@@ -0,0 +1,228 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/decision"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service"
+	"github.com/valek177/auth/internal/service/access"
+	"github.com/valek177/auth/internal/service/serviceaccount"
+	"github.com/valek177/auth/internal/utils"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Hour }
+func (tokenConfig) Secret() []byte         { return []byte("secret") }
+
+type mocks struct {
+	serviceAccountRepository repository.ServiceAccountRepository
+	revocationRepository     repository.TokenRevocationRepository
+}
+
+// newAccessService returns access service allowing admins only to any endpoint
+func newAccessService(mc *minimock.Controller, tokenAccess utils.Token,
+	revocationRepository repository.TokenRevocationRepository,
+) service.AccessService {
+	accessMock := repoMocks.NewAccessRepositoryMock(mc)
+	accessMock.GetAccessRuleByEndpointMock.Optional().Return(&model.EndpointAccessRule{
+		Allow: []*model.AccessRule{{Effect: model.AccessEffectAllow, Role: model.RoleAdmin}},
+	}, nil)
+
+	roleMock := repoMocks.NewRoleRepositoryMock(mc)
+	roleMock.ListAncestorRolesMock.Optional().Return(nil, nil)
+
+	return access.NewService(accessMock, revocationRepository, tokenAccess,
+		decision.New(repoMocks.NewPermissionRepositoryMock(mc), roleMock, nil))
+}
+
+func TestRotateServiceAccountSecret(t *testing.T) {
+	t.Parallel()
+	type mocksFunc func(mc *minimock.Controller) mocks
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		clientID = "robot"
+		account  = &model.ServiceAccount{ClientID: clientID, TenantID: 3, Role: model.RoleUser}
+
+		tokenAccess = utils.NewToken(tokenConfig{})
+		token       = func(role string) string {
+			str, err := tokenAccess.GenerateToken(ctx, &model.User{
+				TenantID: 3,
+				Name:     "boss",
+				Role:     role,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return str
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	rotateFunc := func(revokeErr error) mocksFunc {
+		return func(mc *minimock.Controller) mocks {
+			serviceAccountMock := repoMocks.NewServiceAccountRepositoryMock(mc)
+			serviceAccountMock.UpdateServiceAccountSecretMock.Return(nil)
+			if revokeErr == nil {
+				serviceAccountMock.GetServiceAccountMock.Return(account, nil)
+			}
+
+			revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+			revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+			revocationMock.RevokeUserTokensMock.
+				ExpectSubjectParam2(model.ServiceAccountPrincipal(clientID)).
+				Return(revokeErr)
+
+			return mocks{
+				serviceAccountRepository: serviceAccountMock,
+				revocationRepository:     revocationMock,
+			}
+		}
+	}
+
+	testsSuccessful := []struct {
+		name  string
+		mocks mocksFunc
+	}{
+		{
+			name:  "success case: secret replaced and issued tokens revoked",
+			mocks: rotateFunc(nil),
+		},
+	}
+	testsErrors := []struct {
+		name  string
+		token string
+		err   error
+		mocks mocksFunc
+	}{
+		{
+			name:  "error: caller is not admin",
+			token: token(model.RoleUser),
+			err:   model.ErrorPermissionDenied,
+			mocks: func(mc *minimock.Controller) mocks {
+				revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+				revocationMock.GetTokensRevokedAtMock.Return(time.Time{}, nil)
+
+				return mocks{
+					serviceAccountRepository: repoMocks.NewServiceAccountRepositoryMock(mc),
+					revocationRepository:     revocationMock,
+				}
+			},
+		},
+		{
+			name:  "repo error: revoke tokens",
+			token: token(model.RoleAdmin),
+			err:   repoErr,
+			mocks: rotateFunc(repoErr),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := serviceaccount.NewService(m.serviceAccountRepository,
+				repoMocks.NewRoleRepositoryMock(mc), repoMocks.NewPermissionRepositoryMock(mc),
+				m.revocationRepository, newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			res, err := service.RotateServiceAccountSecret(ctx, token(model.RoleAdmin), clientID)
+
+			require.NoError(t, err)
+			assert.Equal(t, account, res.Account)
+			assert.NotEmpty(t, res.ClientSecret)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := tt.mocks(mc)
+			service := serviceaccount.NewService(m.serviceAccountRepository,
+				repoMocks.NewRoleRepositoryMock(mc), repoMocks.NewPermissionRepositoryMock(mc),
+				m.revocationRepository, newAccessService(mc, tokenAccess, m.revocationRepository))
+
+			_, err := service.RotateServiceAccountSecret(ctx, tt.token, clientID)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRotateServiceAccountSecretThenIssueToken(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		account = &model.ServiceAccount{
+			ClientID: "robot",
+			TenantID: 3,
+			Role:     model.RoleUser,
+		}
+		tokenAccess = utils.NewToken(tokenConfig{})
+	)
+
+	var revokedAt time.Time
+	revocationMock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+	revocationMock.RevokeUserTokensMock.Set(func(_ context.Context, _ string, at time.Time) error {
+		revokedAt = at
+		return nil
+	})
+	revocationMock.GetTokensRevokedAtMock.Set(func(_ context.Context, subject string) (
+		time.Time, error,
+	) {
+		if subject != model.ServiceAccountPrincipal(account.ClientID) {
+			return time.Time{}, nil
+		}
+		return revokedAt, nil
+	})
+
+	serviceAccountMock := repoMocks.NewServiceAccountRepositoryMock(mc)
+	serviceAccountMock.UpdateServiceAccountSecretMock.Return(nil)
+	serviceAccountMock.GetServiceAccountMock.Return(account, nil)
+
+	accessService := newAccessService(mc, tokenAccess, revocationMock)
+	service := serviceaccount.NewService(serviceAccountMock,
+		repoMocks.NewRoleRepositoryMock(mc), repoMocks.NewPermissionRepositoryMock(mc),
+		revocationMock, accessService)
+
+	oldToken, err := tokenAccess.GenerateServiceAccountToken(ctx, account, nil)
+	require.NoError(t, err)
+	adminToken, err := tokenAccess.GenerateToken(ctx, &model.User{
+		TenantID: 3,
+		Name:     "boss",
+		Role:     model.RoleAdmin,
+	})
+	require.NoError(t, err)
+
+	_, err = service.RotateServiceAccountSecret(ctx, adminToken, account.ClientID)
+	require.NoError(t, err)
+
+	// token issued with new secret in the same second as rotation is valid
+	newToken, err := tokenAccess.GenerateServiceAccountToken(ctx, account, nil)
+	require.NoError(t, err)
+	_, err = accessService.Authenticate(ctx, newToken)
+	require.NoError(t, err)
+
+	_, err = accessService.Authenticate(ctx, oldToken)
+	assert.ErrorIs(t, err, model.ErrorUnauthenticated)
+}
@@ -0,0 +1,53 @@
+package serviceaccount
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// validateCreateServiceAccount checks that role and scopes of account exist in tenant,
+// scopes are names of permissions
+func (s *serv) validateCreateServiceAccount(ctx context.Context,
+	account *model.ServiceAccount,
+) error {
+	if account == nil {
+		return errors.New("unable to create service account: empty model")
+	}
+	if strings.TrimSpace(account.Name) == "" {
+		return errors.New("unable to create service account: name is required")
+	}
+
+	roles, err := s.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(roles, func(role *model.Role) bool {
+		return role.Name == account.Role
+	}) {
+		return errors.Errorf("unable to create service account: unknown role %q", account.Role)
+	}
+
+	if len(account.Scopes) == 0 {
+		return nil
+	}
+
+	permissions, err := s.permissionRepository.ListPermissions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, scope := range account.Scopes {
+		if !slices.ContainsFunc(permissions, func(permission *model.Permission) bool {
+			return permission.Name == scope
+		}) {
+			return errors.Errorf("unable to create service account: unknown permission %q of "+
+				"scope", scope)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// BatchGetUsers returns users in order of ids and ids of not existing users,
+// users are taken from redis first, cache misses are read from repo in one query
+// and written back to redis
+func (s *serv) BatchGetUsers(ctx context.Context, ids []int64) (
+	[]*model.User, []int64, error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "batch get users (service)")
+	defer span.Finish()
+
+	uniqueIDs := make([]int64, 0, len(ids))
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	found, err := s.redisRepository.GetUsers(ctx, uniqueIDs)
+	if err != nil {
+		found = make(map[int64]*model.User, len(uniqueIDs))
+	}
+
+	misses := make([]int64, 0, len(uniqueIDs))
+	for _, id := range uniqueIDs {
+		if _, ok := found[id]; !ok {
+			misses = append(misses, id)
+		}
+	}
+
+	if len(misses) > 0 {
+		users, errRepo := s.userRepository.GetUsersByIDs(ctx, misses)
+		if errRepo != nil {
+			return nil, nil, errors.WithStack(errRepo)
+		}
+		for _, user := range users {
+			found[user.ID] = user
+
+			_ = s.redisRepository.CreateUser(ctx, user)
+			_ = s.redisRepository.SetExpireUser(ctx, user.ID)
+		}
+	}
+
+	users := make([]*model.User, 0, len(ids))
+	for _, id := range ids {
+		if user, ok := found[id]; ok {
+			users = append(users, user)
+		}
+	}
+
+	var missingIDs []int64
+	for _, id := range misses {
+		if _, ok := found[id]; !ok {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return users, missingIDs, nil
+}
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+const exportBatchSize = 1000
+
+var exportCSVHeader = []string{"id", "name", "email", "role", "status", "created_at"}
+
+// ExportUsers writes all users of tenant to w, every batch of users is written with one Write call
+func (s *serv) ExportUsers(ctx context.Context, format string, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "export users (service)")
+	defer span.Finish()
+
+	if format != model.DataFormatCSV && format != model.DataFormatJSONL {
+		return errors.Errorf("unable to export users: unsupported format %q", format)
+	}
+
+	var buf bytes.Buffer
+	if format == model.DataFormatCSV {
+		if err := writeCSVUsers(&buf, exportCSVHeader, nil); err != nil {
+			return err
+		}
+	}
+
+	var afterID int64
+	for {
+		users, err := s.userRepository.ListUsers(ctx, afterID, exportBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			break
+		}
+		afterID = users[len(users)-1].ID
+
+		if format == model.DataFormatCSV {
+			err = writeCSVUsers(&buf, nil, users)
+		} else {
+			err = writeJSONLUsers(&buf, users)
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+		buf.Reset()
+
+		if len(users) < exportBatchSize {
+			break
+		}
+	}
+
+	if buf.Len() > 0 {
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+
+	return nil
+}
+
+func writeCSVUsers(buf *bytes.Buffer, header []string, users []*model.User) error {
+	writer := csv.NewWriter(buf)
+	if header != nil {
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+	for _, user := range users {
+		err := writer.Write([]string{
+			strconv.FormatInt(user.ID, 10),
+			user.Name,
+			user.Email,
+			user.Role,
+			user.Status,
+			user.CreatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func writeJSONLUsers(buf *bytes.Buffer, users []*model.User) error {
+	encoder := json.NewEncoder(buf)
+	for _, user := range users {
+		err := encoder.Encode(model.ExportUser{
+			ID:        user.ID,
+			Name:      user.Name,
+			Email:     user.Email,
+			Role:      user.Role,
+			Status:    user.Status,
+			CreatedAt: user.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
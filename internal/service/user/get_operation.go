@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// GetOperation returns state of long-running operation
+func (s *serv) GetOperation(ctx context.Context, id int64) (*model.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get operation (service)")
+	defer span.Finish()
+
+	return s.operationRepository.GetOperation(ctx, id)
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// GetUserByEmail returns user model by e-mail from repo
+func (s *serv) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get user by email (service)")
+	defer span.Finish()
+
+	user, err := s.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// GetUserByName returns user model by name from repo
+func (s *serv) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "get user by name (service)")
+	defer span.Finish()
+
+	user, err := s.userRepository.GetUserByName(ctx, name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	user.Password = ""
+
+	return user, nil
+}
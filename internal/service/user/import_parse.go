@@ -0,0 +1,125 @@
+package user
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+const (
+	csvColumnName         = "name"
+	csvColumnEmail        = "email"
+	csvColumnRole         = "role"
+	csvColumnPassword     = "password"
+	csvColumnPasswordHash = "password_hash"
+
+	maxJSONLineSize = 1 << 20
+)
+
+// parseImportUsers reads users file, malformed rows are returned as row errors,
+// error is returned only if file can not be read at all
+func parseImportUsers(format string, data io.Reader) ([]*model.ImportUser, []model.RowError, error) {
+	switch format {
+	case model.DataFormatCSV:
+		return parseCSVUsers(data)
+	case model.DataFormatJSONL:
+		return parseJSONLUsers(data)
+	default:
+		return nil, nil, errors.Errorf("unsupported format %q", format)
+	}
+}
+
+func parseCSVUsers(data io.Reader) ([]*model.ImportUser, []model.RowError, error) {
+	reader := csv.NewReader(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to read csv header")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{csvColumnName, csvColumnEmail, csvColumnRole} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, errors.Errorf("csv header has no %q column", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var (
+		users     []*model.ImportUser
+		rowErrors []model.RowError
+	)
+	for row := int64(1); ; row++ {
+		record, errRead := reader.Read()
+		if errors.Is(errRead, io.EOF) {
+			break
+		}
+		if errRead != nil {
+			var parseErr *csv.ParseError
+			if errors.As(errRead, &parseErr) {
+				rowErrors = append(rowErrors, model.RowError{Row: row, Error: parseErr.Err.Error()})
+				continue
+			}
+			return nil, nil, errors.Wrap(errRead, "unable to read csv")
+		}
+
+		users = append(users, &model.ImportUser{
+			Row:          row,
+			Name:         field(record, csvColumnName),
+			Email:        field(record, csvColumnEmail),
+			Role:         field(record, csvColumnRole),
+			Password:     field(record, csvColumnPassword),
+			PasswordHash: field(record, csvColumnPasswordHash),
+		})
+	}
+
+	return users, rowErrors, nil
+}
+
+func parseJSONLUsers(data io.Reader) ([]*model.ImportUser, []model.RowError, error) {
+	scanner := bufio.NewScanner(data)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxJSONLineSize)
+
+	var (
+		users     []*model.ImportUser
+		rowErrors []model.RowError
+	)
+	for row := int64(1); scanner.Scan(); row++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			row--
+			continue
+		}
+
+		user := &model.ImportUser{}
+		if err := json.Unmarshal([]byte(line), user); err != nil {
+			rowErrors = append(rowErrors, model.RowError{Row: row, Error: "invalid json: " + err.Error()})
+			continue
+		}
+		user.Row = row
+		users = append(users, user)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to read jsonl")
+	}
+
+	return users, rowErrors, nil
+}
@@ -0,0 +1,183 @@
+package user
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/valek177/auth/internal/logger"
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/tenant"
+)
+
+const importBatchSize = 1000
+
+// ImportUsers parses users file and starts import operation,
+// operation state is available with GetOperation
+func (s *serv) ImportUsers(ctx context.Context, options *model.ImportUsersOptions,
+	data io.Reader,
+) (*model.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "import users (service)")
+	defer span.Finish()
+
+	err := validateImportUsersOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, rowErrors, err := parseImportUsers(options.Format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := &model.Operation{
+		TenantID: tenantID,
+		Kind:     model.OperationKindImportUsers,
+		Metadata: model.ImportUsersMetadata{
+			TotalRows: int64(len(users) + len(rowErrors)),
+			DryRun:    options.DryRun,
+		},
+	}
+
+	operation.ID, err = s.operationRepository.CreateOperation(ctx, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	// import must not be canceled when upload request is finished
+	go s.runImport(tenant.NewContext(context.Background(), tenantID), operation, users, rowErrors)
+
+	return operation, nil
+}
+
+func (s *serv) runImport(ctx context.Context, operation *model.Operation,
+	users []*model.ImportUser, rowErrors []model.RowError,
+) {
+	result, err := s.importUsers(ctx, operation, users, rowErrors)
+	operation.Done = true
+	if err != nil {
+		operation.Error = err.Error()
+	} else {
+		operation.Result = result
+	}
+
+	err = s.operationRepository.UpdateOperation(ctx, operation)
+	if err != nil {
+		logger.ErrorWithMsg("unable to save import operation result", err,
+			zap.Int64("operation_id", operation.ID))
+	}
+}
+
+func (s *serv) importUsers(ctx context.Context, operation *model.Operation,
+	users []*model.ImportUser, rowErrors []model.RowError,
+) (*model.ImportUsersResult, error) {
+	roles, err := s.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get roles")
+	}
+	roleNames := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		roleNames[role.Name] = struct{}{}
+	}
+
+	operation.Metadata.ProcessedRows = int64(len(rowErrors))
+	seenNames := make(map[string]struct{}, len(users))
+	valid := make([]*model.ImportUser, 0, len(users))
+
+	for start := 0; start < len(users); start += importBatchSize {
+		batch := users[start:min(start+importBatchSize, len(users))]
+
+		names := make([]string, 0, len(batch))
+		for _, user := range batch {
+			names = append(names, user.Name)
+		}
+		existing, errFind := s.userRepository.FindUserNames(ctx, names)
+		if errFind != nil {
+			return nil, errors.Wrap(errFind, "unable to check user names")
+		}
+		taken := make(map[string]struct{}, len(existing))
+		for _, name := range existing {
+			taken[name] = struct{}{}
+		}
+
+		for _, user := range batch {
+			errRow := s.prepareImportUser(user, roleNames, seenNames, taken)
+			if errRow != nil {
+				rowErrors = append(rowErrors, model.RowError{Row: user.Row, Error: errRow.Error()})
+				continue
+			}
+			valid = append(valid, user)
+		}
+
+		operation.Metadata.ProcessedRows += int64(len(batch))
+		if errUpdate := s.operationRepository.UpdateOperation(ctx, operation); errUpdate != nil {
+			return nil, errUpdate
+		}
+	}
+
+	sort.Slice(rowErrors, func(i, j int) bool { return rowErrors[i].Row < rowErrors[j].Row })
+	result := &model.ImportUsersResult{
+		ImportedRows: int64(len(valid)),
+		FailedRows:   int64(len(rowErrors)),
+		RowErrors:    rowErrors,
+	}
+
+	if operation.Metadata.DryRun || len(valid) == 0 {
+		return result, nil
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for start := 0; start < len(valid); start += importBatchSize {
+			_, errTx := s.userRepository.CopyUsers(ctx,
+				valid[start:min(start+importBatchSize, len(valid))])
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to insert users")
+	}
+
+	return result, nil
+}
+
+// prepareImportUser validates row and hashes plain password
+func (s *serv) prepareImportUser(user *model.ImportUser, roles map[string]struct{},
+	seenNames map[string]struct{}, takenNames map[string]struct{},
+) error {
+	err := validateImportUser(user, roles)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := takenNames[user.Name]; ok {
+		return errors.Errorf("user %s already exists", user.Name)
+	}
+	if _, ok := seenNames[user.Name]; ok {
+		return errors.Errorf("duplicate user %s in file", user.Name)
+	}
+	seenNames[user.Name] = struct{}{}
+
+	if user.PasswordHash == "" {
+		user.PasswordHash, err = password.HashPassword(user.Password)
+		if err != nil {
+			return errors.New("unable to hash password")
+		}
+		user.Password = ""
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+// ListUsers returns page of users of tenant with id greater than afterID ordered by id
+func (s *serv) ListUsers(ctx context.Context, afterID int64, limit uint64) ([]*model.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "list users (service)")
+	defer span.Finish()
+
+	users, err := s.userRepository.ListUsers(ctx, afterID, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return users, nil
+}
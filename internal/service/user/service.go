@@ -7,10 +7,13 @@ import (
 )
 
 type serv struct {
-	userRepository  repository.UserRepository
-	logRepository   repository.LogRepository
-	redisRepository repository.UserRedisRepository
-	txManager       db.TxManager
+	userRepository       repository.UserRepository
+	logRepository        repository.LogRepository
+	redisRepository      repository.UserRedisRepository
+	revocationRepository repository.TokenRevocationRepository
+	roleRepository       repository.RoleRepository
+	operationRepository  repository.OperationRepository
+	txManager            db.TxManager
 }
 
 // NewService creates new service with settings
@@ -18,12 +21,18 @@ func NewService(
 	userRepository repository.UserRepository,
 	logRepository repository.LogRepository,
 	redisRepository repository.UserRedisRepository,
+	revocationRepository repository.TokenRevocationRepository,
+	roleRepository repository.RoleRepository,
+	operationRepository repository.OperationRepository,
 	txManager db.TxManager,
 ) service.UserService {
 	return &serv{
-		userRepository:  userRepository,
-		logRepository:   logRepository,
-		redisRepository: redisRepository,
-		txManager:       txManager,
+		userRepository:       userRepository,
+		logRepository:        logRepository,
+		redisRepository:      redisRepository,
+		revocationRepository: revocationRepository,
+		roleRepository:       roleRepository,
+		operationRepository:  operationRepository,
+		txManager:            txManager,
 	}
 }
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	descUser "github.com/valek177/auth/internal/service/user"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestBatchGetUsers(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type redisRepositoryMockFunc func(mc *minimock.Controller) repository.UserRedisRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		users = map[int64]*model.User{
+			1: {ID: 1, Name: "first"},
+			2: {ID: 2, Name: "second"},
+			3: {ID: 3, Name: "third"},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	// writeBack expects users read from database to be written back to redis
+	writeBack := func(mock *repoMocks.UserRedisRepositoryMock, written []int64) {
+		if len(written) == 0 {
+			return
+		}
+		mock.CreateUserMock.Set(func(_ context.Context, user *model.User) error {
+			assert.Contains(t, written, user.ID)
+			return nil
+		})
+		mock.SetExpireUserMock.Set(func(_ context.Context, id int64) error {
+			assert.Contains(t, written, id)
+			return nil
+		})
+		mock.CreateUserMock.Times(uint64(len(written)))
+		mock.SetExpireUserMock.Times(uint64(len(written)))
+	}
+	redisFunc := func(written []int64, cached ...int64) redisRepositoryMockFunc {
+		return func(mc *minimock.Controller) repository.UserRedisRepository {
+			mock := repoMocks.NewUserRedisRepositoryMock(mc)
+			mock.GetUsersMock.Set(func(_ context.Context, _ []int64) (
+				map[int64]*model.User, error,
+			) {
+				res := make(map[int64]*model.User, len(cached))
+				for _, id := range cached {
+					res[id] = users[id]
+				}
+				return res, nil
+			})
+			writeBack(mock, written)
+			return mock
+		}
+	}
+	userRepositoryFunc := func(wantIDs ...int64) userRepositoryMockFunc {
+		return func(mc *minimock.Controller) repository.UserRepository {
+			mock := repoMocks.NewUserRepositoryMock(mc)
+			mock.GetUsersByIDsMock.Set(func(_ context.Context, ids []int64) (
+				[]*model.User, error,
+			) {
+				if !assert.ElementsMatch(t, wantIDs, ids) {
+					return nil, fmt.Errorf("cached users requested from database")
+				}
+				var res []*model.User
+				for _, id := range ids {
+					if user, ok := users[id]; ok {
+						res = append(res, user)
+					}
+				}
+				return res, nil
+			})
+			return mock
+		}
+	}
+
+	testsSuccessful := []struct {
+		name                string
+		ids                 []int64
+		wantIDs             []int64
+		wantMissingIDs      []int64
+		userRepositoryMock  userRepositoryMockFunc
+		redisRepositoryMock redisRepositoryMockFunc
+	}{
+		{
+			name:                "success case: misses are read from database, order is preserved",
+			ids:                 []int64{3, 9, 1, 2},
+			wantIDs:             []int64{3, 1, 2},
+			wantMissingIDs:      []int64{9},
+			userRepositoryMock:  userRepositoryFunc(9, 2),
+			redisRepositoryMock: redisFunc([]int64{2}, 1, 3),
+		},
+		{
+			name:    "success case: all users are cached",
+			ids:     []int64{2, 1},
+			wantIDs: []int64{2, 1},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			redisRepositoryMock: redisFunc(nil, 1, 2),
+		},
+		{
+			name:               "success case: redis is unavailable",
+			ids:                []int64{1, 1, 7},
+			wantIDs:            []int64{1, 1},
+			wantMissingIDs:     []int64{7},
+			userRepositoryMock: userRepositoryFunc(1, 7),
+			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
+				mock := repoMocks.NewUserRedisRepositoryMock(mc)
+				mock.GetUsersMock.Return(nil, fmt.Errorf("redis error"))
+				writeBack(mock, []int64{1})
+				return mock
+			},
+		},
+	}
+	testsErrors := []struct {
+		name                string
+		ids                 []int64
+		err                 error
+		userRepositoryMock  userRepositoryMockFunc
+		redisRepositoryMock redisRepositoryMockFunc
+	}{
+		{
+			name: "repo error",
+			ids:  []int64{1, 2},
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUsersByIDsMock.Return(nil, repoErr)
+				return mock
+			},
+			redisRepositoryMock: redisFunc(nil),
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := descUser.NewService(tt.userRepositoryMock(mc),
+				repoMocks.NewLogRepositoryMock(mc), tt.redisRepositoryMock(mc), nil, nil, nil,
+				dbMocks.NewTxManagerMock(mc))
+
+			res, missingIDs, err := service.BatchGetUsers(ctx, tt.ids)
+
+			assert.Nil(t, err)
+			gotIDs := make([]int64, 0, len(res))
+			for _, user := range res {
+				gotIDs = append(gotIDs, user.ID)
+			}
+			assert.Equal(t, tt.wantIDs, gotIDs)
+			assert.Equal(t, tt.wantMissingIDs, missingIDs)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := descUser.NewService(tt.userRepositoryMock(mc),
+				repoMocks.NewLogRepositoryMock(mc), tt.redisRepositoryMock(mc), nil, nil, nil,
+				dbMocks.NewTxManagerMock(mc))
+
+			_, _, err := service.BatchGetUsers(ctx, tt.ids)
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -351,7 +351,7 @@ func TestCreateUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := user.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, nil, nil, nil, txManagerMock)
 
 			newID, err := service.CreateUser(tt.args.ctx, tt.args.newUser)
 
@@ -371,7 +371,7 @@ func TestCreateUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := user.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, nil, nil, nil, txManagerMock)
 
 			_, err := service.CreateUser(tt.args.ctx, tt.args.newUser)
 
@@ -215,7 +215,7 @@ func TestDeleteUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := user.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, nil, nil, nil, txManagerMock)
 
 			err := service.DeleteUser(tt.args.ctx, tt.args.req)
 
@@ -234,7 +234,7 @@ func TestDeleteUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := user.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, nil, nil, nil, txManagerMock)
 
 			err := service.DeleteUser(tt.args.ctx, tt.args.req)
 
@@ -199,7 +199,7 @@ func TestGetUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := descUser.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, nil, nil, nil, txManagerMock)
 
 			res, err := service.GetUser(tt.args.ctx, tt.args.id)
 
@@ -219,7 +219,7 @@ func TestGetUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := descUser.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, nil, nil, nil, txManagerMock)
 
 			_, err := service.GetUser(tt.args.ctx, tt.args.id)
 
@@ -0,0 +1,251 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valek177/auth/internal/model"
+	passLib "github.com/valek177/auth/internal/password"
+	"github.com/valek177/auth/internal/repository"
+	repoMocks "github.com/valek177/auth/internal/repository/mocks"
+	"github.com/valek177/auth/internal/service/user"
+	"github.com/valek177/auth/internal/tenant"
+	"github.com/valek177/platform-common/pkg/client/db"
+	dbMocks "github.com/valek177/platform-common/pkg/client/db/mocks"
+)
+
+func TestImportUsers(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+
+	type args struct {
+		ctx     context.Context
+		options *model.ImportUsersOptions
+		data    string
+	}
+
+	var (
+		ctx = tenant.NewContext(context.Background(), 1)
+		mc  = minimock.NewController(t)
+
+		operationID = int64(9)
+		hash, _     = passLib.HashPassword("secret")
+
+		csvData = strings.Join([]string{
+			"name,email,role,password,password_hash",
+			"alice,alice@example.com,USER,secret,",
+			"bob,bob@example.com,ADMIN,," + hash,
+			"carol,not-an-email,USER,secret,",
+			"dave,dave@example.com,OWNER,secret,",
+			"alice,alice2@example.com,USER,secret,",
+			"taken,taken@example.com,USER,secret,",
+			`"broken,broken@example.com,USER,secret,`,
+		}, "\n")
+		csvRowErrors = []int64{3, 4, 5, 6, 7}
+
+		jsonlData = strings.Join([]string{
+			`{"name":"alice","email":"alice@example.com","role":"USER","password":"secret"}`,
+			`{"name":"bob","email":"bob@example.com","role":"USER","password_hash":"plain"}`,
+			`{"name":`,
+		}, "\n")
+		jsonlRowErrors = []int64{2, 3}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	roleRepositoryMock := func(mc *minimock.Controller) repository.RoleRepository {
+		mock := repoMocks.NewRoleRepositoryMock(mc)
+		mock.ListRolesMock.Return([]*model.Role{{Name: "ADMIN"}, {Name: "USER"}}, nil)
+		return mock
+	}
+	operationRepositoryMock := func(mc *minimock.Controller, done chan<- *model.Operation) repository.OperationRepository {
+		mock := repoMocks.NewOperationRepositoryMock(mc)
+		mock.CreateOperationMock.Return(operationID, nil)
+		mock.UpdateOperationMock.Set(func(_ context.Context, operation *model.Operation) error {
+			if operation.Done {
+				done <- operation
+			}
+			return nil
+		})
+		return mock
+	}
+	findUserNames := func(_ context.Context, names []string) ([]string, error) {
+		for _, name := range names {
+			if name == "taken" {
+				return []string{name}, nil
+			}
+		}
+		return nil, nil
+	}
+	txManagerFunc := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.
+			Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
+		return mock
+	}
+	noTxManagerFunc := func(mc *minimock.Controller) db.TxManager {
+		return dbMocks.NewTxManagerMock(mc)
+	}
+
+	testsSuccessful := []struct {
+		name               string
+		args               args
+		wantImported       int64
+		wantRowErrors      []int64
+		wantError          string
+		userRepositoryMock userRepositoryMockFunc
+		txManagerMock      txManagerMockFunc
+	}{
+		{
+			name: "success case: csv dry run reports row errors and creates nothing",
+			args: args{
+				ctx:     ctx,
+				options: &model.ImportUsersOptions{Format: model.DataFormatCSV, DryRun: true},
+				data:    csvData,
+			},
+			wantImported:  2,
+			wantRowErrors: csvRowErrors,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.FindUserNamesMock.Set(findUserNames)
+				return mock
+			},
+			txManagerMock: noTxManagerFunc,
+		},
+		{
+			name: "success case: jsonl rows are copied with hashed passwords",
+			args: args{
+				ctx:     ctx,
+				options: &model.ImportUsersOptions{Format: model.DataFormatJSONL},
+				data:    jsonlData,
+			},
+			wantImported:  1,
+			wantRowErrors: jsonlRowErrors,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.FindUserNamesMock.Set(findUserNames)
+				mock.CopyUsersMock.Set(func(_ context.Context, users []*model.ImportUser) (int64, error) {
+					if len(users) != 1 || users[0].Password != "" ||
+						!passLib.CheckPasswordHash("secret", users[0].PasswordHash) {
+						return 0, fmt.Errorf("unexpected users %v", users)
+					}
+					return 1, nil
+				})
+				return mock
+			},
+			txManagerMock: txManagerFunc,
+		},
+		{
+			name: "operation failed: copy error",
+			args: args{
+				ctx:     ctx,
+				options: &model.ImportUsersOptions{Format: model.DataFormatJSONL},
+				data:    jsonlData,
+			},
+			wantError: repoErr.Error(),
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.FindUserNamesMock.Set(findUserNames)
+				mock.CopyUsersMock.Return(0, repoErr)
+				return mock
+			},
+			txManagerMock: txManagerFunc,
+		},
+	}
+	testsErrors := []struct {
+		name string
+		args args
+		err  error
+	}{
+		{
+			name: "error: unsupported format",
+			args: args{
+				ctx:     ctx,
+				options: &model.ImportUsersOptions{Format: "xml"},
+				data:    csvData,
+			},
+			err: fmt.Errorf("unsupported format"),
+		},
+		{
+			name: "error: csv header without required column",
+			args: args{
+				ctx:     ctx,
+				options: &model.ImportUsersOptions{Format: model.DataFormatCSV},
+				data:    "name,email\nalice,alice@example.com",
+			},
+			err: fmt.Errorf(`csv header has no "role" column`),
+		},
+		{
+			name: "error: tenant is not specified",
+			args: args{
+				ctx:     context.Background(),
+				options: &model.ImportUsersOptions{Format: model.DataFormatCSV},
+				data:    csvData,
+			},
+			err: model.ErrorTenantNotSpecified,
+		},
+	}
+
+	for _, tt := range testsSuccessful {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			done := make(chan *model.Operation, 1)
+			service := user.NewService(tt.userRepositoryMock(mc), repoMocks.NewLogRepositoryMock(mc),
+				repoMocks.NewUserRedisRepositoryMock(mc), nil, roleRepositoryMock(mc),
+				operationRepositoryMock(mc, done), tt.txManagerMock(mc))
+
+			operation, err := service.ImportUsers(tt.args.ctx, tt.args.options,
+				strings.NewReader(tt.args.data))
+			require.Nil(t, err)
+			assert.Equal(t, operationID, operation.ID)
+
+			var finished *model.Operation
+			select {
+			case finished = <-done:
+			case <-time.After(10 * time.Second):
+				t.Fatal("import operation is not finished")
+			}
+
+			assert.Equal(t, finished.Metadata.TotalRows, finished.Metadata.ProcessedRows)
+			if tt.wantError != "" {
+				assert.Contains(t, finished.Error, tt.wantError)
+				return
+			}
+			require.NotNil(t, finished.Result)
+			assert.Equal(t, tt.wantImported, finished.Result.ImportedRows)
+			rows := make([]int64, 0, len(finished.Result.RowErrors))
+			for _, rowError := range finished.Result.RowErrors {
+				rows = append(rows, rowError.Row)
+			}
+			assert.Equal(t, tt.wantRowErrors, rows)
+		})
+	}
+
+	for _, tt := range testsErrors {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := user.NewService(repoMocks.NewUserRepositoryMock(mc),
+				repoMocks.NewLogRepositoryMock(mc), repoMocks.NewUserRedisRepositoryMock(mc), nil,
+				repoMocks.NewRoleRepositoryMock(mc), repoMocks.NewOperationRepositoryMock(mc),
+				noTxManagerFunc(mc))
+
+			_, err := service.ImportUsers(tt.args.ctx, tt.args.options,
+				strings.NewReader(tt.args.data))
+
+			assert.NotNil(t, err)
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
@@ -26,6 +26,8 @@ func TestUpdateUser(t *testing.T) {
 	type logRepositoryMockFunc func(mc *minimock.Controller) repository.LogRepository
 	type redisRepositoryMockFunc func(mc *minimock.Controller) repository.UserRedisRepository
 	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+	type revocationRepositoryMockFunc func(mc *minimock.Controller,
+	) repository.TokenRevocationRepository
 
 	type args struct {
 		ctx context.Context
@@ -40,6 +42,9 @@ func TestUpdateUser(t *testing.T) {
 		name = gofakeit.Name()
 		role = user_v1.Role_USER.String()
 
+		pendingStatus  = model.UserStatusPending
+		disabledStatus = model.UserStatusDisabled
+
 		repoErr = errors.New("repo error")
 
 		updateUser = &model.UpdateUserInfo{
@@ -58,6 +63,40 @@ func TestUpdateUser(t *testing.T) {
 		return mock
 	}
 
+	noRevocationFunc := func(mc *minimock.Controller) repository.TokenRevocationRepository {
+		return repoMocks.NewTokenRevocationRepositoryMock(mc)
+	}
+
+	// statusChangeFunc returns user repository of user disabled by update
+	statusChangeFunc := func(mc *minimock.Controller) repository.UserRepository {
+		mock := repoMocks.NewUserRepositoryMock(mc)
+		status := model.UserStatusActive
+		mock.GetUserMock.Set(func(_ context.Context, _ int64) (*model.User, error) {
+			return &model.User{ID: id, Name: name, Status: status}, nil
+		})
+		mock.UpdateUserMock.Set(func(_ context.Context, info *model.UpdateUserInfo) error {
+			status = *info.Status
+			return nil
+		})
+		return mock
+	}
+	redisFunc := func(mc *minimock.Controller) repository.UserRedisRepository {
+		mock := repoMocks.NewUserRedisRepositoryMock(mc)
+		mock.DeleteUserMock.Return(nil)
+		mock.CreateUserMock.Return(nil)
+		mock.SetExpireUserMock.Return(nil)
+		return mock
+	}
+	logFunc := func(mc *minimock.Controller) repository.LogRepository {
+		mock := repoMocks.NewLogRepositoryMock(mc)
+		mock.CreateRecordMock.Return(0, nil)
+		return mock
+	}
+	disableUser := &model.UpdateUserInfo{
+		ID:     id,
+		Status: &disabledStatus,
+	}
+
 	testsSuccessful := []struct {
 		name                string
 		args                args
@@ -67,6 +106,7 @@ func TestUpdateUser(t *testing.T) {
 		logRepositoryMock   logRepositoryMockFunc
 		redisRepositoryMock redisRepositoryMockFunc
 		txManagerMock       txManagerMockFunc
+		revocationMock      revocationRepositoryMockFunc
 	}{
 		{
 			name: "success case",
@@ -104,6 +144,7 @@ func TestUpdateUser(t *testing.T) {
 					Set(func(ctx context.Context, f db.Handler) error { return f(ctx) })
 				return mock
 			},
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				mock.CreateUserMock.Set(func(_ context.Context, _ *model.User) (err error) {
@@ -119,6 +160,20 @@ func TestUpdateUser(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name:                "success case: tokens of disabled user are revoked",
+			args:                args{ctx: ctx, req: disableUser},
+			want:                res,
+			userRepositoryMock:  statusChangeFunc,
+			logRepositoryMock:   logFunc,
+			redisRepositoryMock: redisFunc,
+			txManagerMock:       txManagerFunc,
+			revocationMock: func(mc *minimock.Controller) repository.TokenRevocationRepository {
+				mock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+				mock.RevokeUserTokensMock.ExpectSubjectParam2(model.UserTokenSubject(id)).Return(nil)
+				return mock
+			},
+		},
 	}
 	testsErrors := []struct {
 		name                string
@@ -129,6 +184,7 @@ func TestUpdateUser(t *testing.T) {
 		logRepositoryMock   logRepositoryMockFunc
 		redisRepositoryMock redisRepositoryMockFunc
 		txManagerMock       txManagerMockFunc
+		revocationMock      revocationRepositoryMockFunc
 	}{
 		{
 			name: "repo error",
@@ -140,6 +196,7 @@ func TestUpdateUser(t *testing.T) {
 			err:  repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Return(&model.User{}, nil)
 				mock.UpdateUserMock.Set(func(_ context.Context, _ *model.UpdateUserInfo) (
 					err error,
 				) {
@@ -151,7 +208,8 @@ func TestUpdateUser(t *testing.T) {
 				mock := repoMocks.NewLogRepositoryMock(mc)
 				return mock
 			},
-			txManagerMock: txManagerFunc,
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				return mock
@@ -167,11 +225,6 @@ func TestUpdateUser(t *testing.T) {
 			err:  fmt.Errorf("auth update user error"),
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.UpdateUserMock.Set(func(_ context.Context, _ *model.UpdateUserInfo) (
-					err error,
-				) {
-					return nil
-				})
 				mock.GetUserMock.Set(func(_ context.Context, _ int64) (
 					up1 *model.User, err error,
 				) {
@@ -183,7 +236,8 @@ func TestUpdateUser(t *testing.T) {
 				mock := repoMocks.NewLogRepositoryMock(mc)
 				return mock
 			},
-			txManagerMock: txManagerFunc,
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				return mock
@@ -215,7 +269,8 @@ func TestUpdateUser(t *testing.T) {
 				mock := repoMocks.NewLogRepositoryMock(mc)
 				return mock
 			},
-			txManagerMock: txManagerFunc,
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				mock.DeleteUserMock.Set(func(_ context.Context, _ int64) (err error) {
@@ -240,7 +295,8 @@ func TestUpdateUser(t *testing.T) {
 				mock := repoMocks.NewLogRepositoryMock(mc)
 				return mock
 			},
-			txManagerMock: txManagerFunc,
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				return mock
@@ -266,7 +322,34 @@ func TestUpdateUser(t *testing.T) {
 				mock := repoMocks.NewLogRepositoryMock(mc)
 				return mock
 			},
-			txManagerMock: txManagerFunc,
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
+			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
+				mock := repoMocks.NewUserRedisRepositoryMock(mc)
+				return mock
+			},
+		},
+		{
+			name: "error: validation error (unknown status)",
+			args: args{
+				ctx: ctx,
+				req: &model.UpdateUserInfo{
+					ID:     id,
+					Status: &pendingStatus,
+				},
+			},
+			want: nil,
+			err:  fmt.Errorf("unable to update user: status must be active or disabled"),
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repoMocks.NewLogRepositoryMock(mc)
+				return mock
+			},
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				return mock
@@ -303,7 +386,8 @@ func TestUpdateUser(t *testing.T) {
 				})
 				return mock
 			},
-			txManagerMock: txManagerFunc,
+			txManagerMock:  txManagerFunc,
+			revocationMock: noRevocationFunc,
 			redisRepositoryMock: func(mc *minimock.Controller) repository.UserRedisRepository {
 				mock := repoMocks.NewUserRedisRepositoryMock(mc)
 				mock.DeleteUserMock.Set(func(_ context.Context, _ int64) (err error) {
@@ -318,6 +402,20 @@ func TestUpdateUser(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name:                "repo error: revoke tokens",
+			args:                args{ctx: ctx, req: disableUser},
+			err:                 repoErr,
+			userRepositoryMock:  statusChangeFunc,
+			logRepositoryMock:   logFunc,
+			redisRepositoryMock: redisFunc,
+			txManagerMock:       txManagerFunc,
+			revocationMock: func(mc *minimock.Controller) repository.TokenRevocationRepository {
+				mock := repoMocks.NewTokenRevocationRepositoryMock(mc)
+				mock.RevokeUserTokensMock.Return(repoErr)
+				return mock
+			},
+		},
 	}
 
 	for _, tt := range testsSuccessful {
@@ -331,7 +429,7 @@ func TestUpdateUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := descUser.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, tt.revocationMock(mc), nil, nil, txManagerMock)
 
 			err := service.UpdateUser(tt.args.ctx, tt.args.req)
 
@@ -351,7 +449,7 @@ func TestUpdateUser(t *testing.T) {
 			txManagerMock := tt.txManagerMock(mc)
 
 			service := descUser.NewService(userRepositoryMock, logRepositoryMock,
-				redisRepositoryMock, txManagerMock)
+				redisRepositoryMock, tt.revocationMock(mc), nil, nil, txManagerMock)
 
 			err := service.UpdateUser(tt.args.ctx, tt.args.req)
 
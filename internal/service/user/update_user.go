@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 
@@ -9,7 +10,7 @@ import (
 	"github.com/valek177/auth/internal/model"
 )
 
-// UpdateUser updates user in repo
+// UpdateUser updates user in repo, tokens of user are revoked when its status or role changes
 func (s *serv) UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserInfo) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "update user (service)")
 	defer span.Finish()
@@ -22,6 +23,11 @@ func (s *serv) UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserI
 			return errTx
 		}
 
+		previous, errTx := s.userRepository.GetUser(ctx, updateUserInfo.ID)
+		if errTx != nil {
+			return errTx
+		}
+
 		errTx = s.userRepository.UpdateUser(ctx, updateUserInfo)
 		if errTx != nil {
 			return errTx
@@ -45,6 +51,12 @@ func (s *serv) UpdateUser(ctx context.Context, updateUserInfo *model.UpdateUserI
 			return errTx
 		}
 
+		// disabled user and user with another role must not use tokens issued before
+		if user.Status != previous.Status || user.Role != previous.Role {
+			return s.revocationRepository.RevokeUserTokens(ctx, model.UserTokenSubject(user.ID),
+				time.Now())
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -1,6 +1,9 @@
 package user
 
 import (
+	"net/mail"
+	"regexp"
+
 	"github.com/pkg/errors"
 
 	"github.com/valek177/auth/internal/model"
@@ -14,6 +17,9 @@ func validateCreateUser(user *model.NewUser) error {
 	if user.Name == "" {
 		return errors.New("unable to create user: name is required")
 	}
+	if model.IsServiceAccountPrincipal(user.Name) {
+		return errors.New("unable to create user: name is reserved for service accounts")
+	}
 	if user.Password == "" {
 		return errors.New("unable to create user: password is required")
 	}
@@ -28,9 +34,50 @@ func validateUpdateUser(user *model.UpdateUserInfo) error {
 	if user == nil {
 		return errors.New("unable to update user: empty model")
 	}
-	if user.Name == nil && user.Role == nil {
+	if user.Name == nil && user.Role == nil && user.Email == nil && user.Status == nil {
 		return errors.New("unable to update user: nothing to update")
 	}
+	if user.Status != nil && *user.Status != model.UserStatusActive &&
+		*user.Status != model.UserStatusDisabled {
+		return errors.Errorf("unable to update user: status must be %s or %s",
+			model.UserStatusActive, model.UserStatusDisabled)
+	}
+	if user.Name != nil && model.IsServiceAccountPrincipal(*user.Name) {
+		return errors.New("unable to update user: name is reserved for service accounts")
+	}
+
+	return nil
+}
+
+var userNameRegexp = regexp.MustCompile(`^[0-9a-z:.-]{3,100}$`)
+
+func validateImportUsersOptions(options *model.ImportUsersOptions) error {
+	if options == nil {
+		return errors.New("unable to import users: empty options")
+	}
+	if options.Format != model.DataFormatCSV && options.Format != model.DataFormatJSONL {
+		return errors.Errorf("unable to import users: unsupported format %q", options.Format)
+	}
+
+	return nil
+}
+
+func validateImportUser(user *model.ImportUser, roles map[string]struct{}) error {
+	if !userNameRegexp.MatchString(user.Name) {
+		return errors.New("invalid name")
+	}
+	if _, err := mail.ParseAddress(user.Email); err != nil {
+		return errors.New("invalid email")
+	}
+	if _, ok := roles[user.Role]; !ok {
+		return errors.Errorf("unknown role %q", user.Role)
+	}
+	if (user.Password == "") == (user.PasswordHash == "") {
+		return errors.New("exactly one of password and password_hash is required")
+	}
+	if user.PasswordHash != "" && !password.IsSupportedHash(user.PasswordHash) {
+		return errors.New("password_hash must be bcrypt or argon2id hash")
+	}
 
 	return nil
 }
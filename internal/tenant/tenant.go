@@ -0,0 +1,26 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/valek177/auth/internal/model"
+)
+
+type key string
+
+const tenantKey key = "tenant"
+
+// NewContext returns context with tenant ID
+func NewContext(ctx context.Context, tenantID int64) context.Context {
+	return context.WithValue(ctx, tenantKey, tenantID)
+}
+
+// FromContext returns tenant ID from context
+func FromContext(ctx context.Context) (int64, error) {
+	tenantID, ok := ctx.Value(tenantKey).(int64)
+	if !ok || tenantID == 0 {
+		return 0, model.ErrorTenantNotSpecified
+	}
+
+	return tenantID, nil
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authHeaderName = "authorization"
+	authPrefix     = "Bearer "
+)
+
+// BearerToken returns access token of caller from authorization header
+func BearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("metadata is not provided")
+	}
+
+	authHeader := md.Get(authHeaderName)
+	if len(authHeader) == 0 {
+		return "", errors.New("authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], authPrefix) {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], authPrefix), nil
+}
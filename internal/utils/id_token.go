@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/valek177/auth/internal/config"
+	"github.com/valek177/auth/internal/model"
+)
+
+// IDToken is interface for OpenID Connect ID token
+type IDToken interface {
+	Issuer() string
+	GenerateIDToken(_ context.Context, user *model.User, params *model.IDTokenParams) (
+		string, error)
+	JWKS() *model.JSONWebKeySet
+}
+
+type idToken struct {
+	issuer  string
+	expTime time.Duration
+	key     *rsa.PrivateKey
+	keyID   string
+}
+
+// NewIDToken returns new ID token object signing tokens with RS256
+func NewIDToken(cfg config.OIDCConfig) *idToken {
+	return &idToken{
+		issuer:  cfg.Issuer(),
+		expTime: cfg.IDTokenExpTime(),
+		key:     cfg.SigningKey(),
+		keyID:   keyThumbprint(&cfg.SigningKey().PublicKey),
+	}
+}
+
+// Issuer returns issuer identifier of ID tokens
+func (t *idToken) Issuer() string {
+	return t.issuer
+}
+
+// GenerateIDToken returns ID token of user authenticated for client
+func (t *idToken) GenerateIDToken(_ context.Context, user *model.User,
+	params *model.IDTokenParams,
+) (string, error) {
+	now := time.Now()
+
+	claims := model.IDTokenClaims{
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    t.issuer,
+			Subject:   model.SubjectOf(user.ID),
+			Audience:  params.ClientID,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(t.expTime).Unix(),
+		},
+		Nonce:    params.Nonce,
+		AuthTime: params.AuthTime.Unix(),
+		AMR:      []string{params.AuthMethod},
+		UserInfo: model.NewUserInfo(user, params.Scope),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = t.keyID
+
+	return token.SignedString(t.key)
+}
+
+// JWKS returns key set with public key verifying ID tokens and access tokens
+func (t *idToken) JWKS() *model.JSONWebKeySet {
+	n, e := publicKeyMembers(&t.key.PublicKey)
+
+	return &model.JSONWebKeySet{
+		Keys: []model.JSONWebKey{
+			{
+				KeyType:   "RSA",
+				Use:       "sig",
+				Algorithm: jwt.SigningMethodRS256.Alg(),
+				KeyID:     t.keyID,
+				N:         n,
+				E:         e,
+			},
+		},
+	}
+}
+
+// publicKeyMembers returns base64url encoded modulus and exponent of public key, RFC 7518
+func publicKeyMembers(key *rsa.PublicKey) (string, string) {
+	return base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+}
+
+// keyThumbprint returns JWK thumbprint of public key used as key ID, RFC 7638
+func keyThumbprint(key *rsa.PublicKey) string {
+	n, e := publicKeyMembers(key)
+
+	// members are in lexicographic order required by RFC 7638
+	thumbprint, _ := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   e,
+		Kty: "RSA",
+		N:   n,
+	})
+	sum := sha256.Sum256(thumbprint)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
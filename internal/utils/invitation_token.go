@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/valek177/auth/internal/config"
+	"github.com/valek177/auth/internal/model"
+)
+
+const invitationTokenIDLen = 16
+
+// InvitationToken is interface for invitation token
+type InvitationToken interface {
+	NewTokenID() (string, error)
+	ExpiresAt() time.Time
+	GenerateToken(_ context.Context, invitation *model.Invitation) (string, error)
+	VerifyToken(_ context.Context, token string) (*model.InvitationClaims, error)
+}
+
+type invitationToken struct {
+	expTime time.Duration
+	secret  []byte
+}
+
+// NewInvitationToken returns new invitation token object
+func NewInvitationToken(cfg config.InvitationConfig) *invitationToken {
+	return &invitationToken{
+		expTime: cfg.ExpTime(),
+		secret:  cfg.Secret(),
+	}
+}
+
+// NewTokenID returns new random id for invitation token
+func (t *invitationToken) NewTokenID() (string, error) {
+	b := make([]byte, invitationTokenIDLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "unable to generate invitation token id")
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// ExpiresAt returns expiration time for invitation created now
+func (t *invitationToken) ExpiresAt() time.Time {
+	return time.Now().Add(t.expTime)
+}
+
+// GenerateToken returns new invitation token string
+func (t *invitationToken) GenerateToken(_ context.Context, invitation *model.Invitation) (
+	string, error,
+) {
+	claims := model.InvitationClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        invitation.TokenID,
+			ExpiresAt: invitation.ExpiresAt.Unix(),
+		},
+		TenantID: invitation.TenantID,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(t.secret)
+}
+
+// VerifyToken returns invitation claims by token
+func (t *invitationToken) VerifyToken(_ context.Context, token string) (
+	*model.InvitationClaims, error,
+) {
+	tokenParsed, err := jwt.ParseWithClaims(
+		token,
+		&model.InvitationClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			_, ok := token.Method.(*jwt.SigningMethodHMAC)
+			if !ok {
+				return nil, errors.New("unexpected token signing method")
+			}
+
+			return t.secret, nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Errorf("invalid token: %s", err.Error())
+	}
+
+	claims, ok := tokenParsed.Claims.(*model.InvitationClaims)
+	if !ok || claims.Id == "" {
+		return nil, errors.New("invalid token claims")
+	}
+
+	return claims, nil
+}
@@ -2,6 +2,8 @@ package utils
 
 import (
 	"context"
+	"crypto/rsa"
+	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -14,35 +16,113 @@ import (
 // Token is interface for token
 type Token interface {
 	GenerateToken(_ context.Context, user *model.User) (string, error)
+	GenerateTokenUntil(_ context.Context, user *model.User, notAfter time.Time) (string, error)
+	GenerateClientToken(_ context.Context, user *model.User, clientID, scope string) (
+		string, error)
+	GenerateServiceAccountToken(_ context.Context, account *model.ServiceAccount,
+		scopes []string) (string, error)
 	VerifyToken(_ context.Context, token string) (*model.UserClaims, error)
 }
 
 type token struct {
-	expTime time.Duration
-	secret  []byte
+	expTime   time.Duration
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	keyID     string
+	// tokenType is typ header distinguishing access tokens from ID tokens signed by same key
+	tokenType string
 }
 
-// NewToken returns new token object
+// NewToken returns new token object signing tokens with HS256 by secret
 func NewToken(cfg config.TokenConfig) *token {
 	return &token{
-		expTime: cfg.ExpTime(),
-		secret:  cfg.Secret(),
+		expTime:   cfg.ExpTime(),
+		method:    jwt.SigningMethodHS256,
+		signKey:   cfg.Secret(),
+		verifyKey: cfg.Secret(),
+	}
+}
+
+// NewAccessToken returns new token object signing access tokens with RS256 by key
+// published in JWKS, tokens are typed at+jwt (RFC 9068) unlike ID tokens
+func NewAccessToken(cfg config.TokenConfig, key *rsa.PrivateKey) *token {
+	return &token{
+		expTime:   cfg.ExpTime(),
+		method:    jwt.SigningMethodRS256,
+		signKey:   key,
+		verifyKey: &key.PublicKey,
+		keyID:     keyThumbprint(&key.PublicKey),
+		tokenType: model.AccessTokenType,
 	}
 }
 
 // GenerateToken returns new token string
-func (t *token) GenerateToken(_ context.Context, user *model.User) (string, error) {
+func (t *token) GenerateToken(ctx context.Context, user *model.User) (string, error) {
+	return t.GenerateTokenUntil(ctx, user, time.Time{})
+}
+
+// GenerateTokenUntil returns new token string expiring not later than notAfter,
+// zero notAfter does not cap token lifetime
+func (t *token) GenerateTokenUntil(_ context.Context, user *model.User, notAfter time.Time) (
+	string, error,
+) {
+	return t.generateUserToken(user, "", "", notAfter)
+}
+
+// GenerateClientToken returns new token string of user issued to oauth client with scope
+func (t *token) GenerateClientToken(_ context.Context, user *model.User, clientID,
+	scope string,
+) (string, error) {
+	return t.generateUserToken(user, clientID, scope, time.Time{})
+}
+
+func (t *token) generateUserToken(user *model.User, clientID, scope string,
+	notAfter time.Time,
+) (string, error) {
+	now := time.Now()
+	expiresAt := now.Add(t.expTime)
+	if !notAfter.IsZero() && notAfter.Before(expiresAt) {
+		expiresAt = notAfter
+	}
+
 	claims := model.UserClaims{
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(t.expTime).Unix(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
 		},
-		Username: user.Name,
-		Role:     user.Role,
+		UserID:       user.ID,
+		Username:     user.Name,
+		Role:         user.Role,
+		TenantID:     user.TenantID,
+		ClientID:     clientID,
+		Scope:        scope,
+		IssuedAtNano: now.UnixNano(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return t.sign(claims)
+}
 
-	return token.SignedString(t.secret)
+// GenerateServiceAccountToken returns new token string of service account with scopes
+func (t *token) GenerateServiceAccountToken(_ context.Context, account *model.ServiceAccount,
+	scopes []string,
+) (string, error) {
+	now := time.Now()
+
+	claims := model.UserClaims{
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(t.expTime).Unix(),
+		},
+		Username:     model.ServiceAccountPrincipal(account.ClientID),
+		Role:         account.Role,
+		TenantID:     account.TenantID,
+		ClientID:     account.ClientID,
+		Scope:        strings.Join(scopes, " "),
+		IssuedAtNano: now.UnixNano(),
+	}
+
+	return t.sign(claims)
 }
 
 // VerifyToken returns user claims by token
@@ -51,12 +131,14 @@ func (t *token) VerifyToken(_ context.Context, token string) (*model.UserClaims,
 		token,
 		&model.UserClaims{},
 		func(token *jwt.Token) (interface{}, error) {
-			_, ok := token.Method.(*jwt.SigningMethodHMAC)
-			if !ok {
+			if token.Method.Alg() != t.method.Alg() {
 				return nil, errors.New("unexpected token signing method")
 			}
+			if typ, _ := token.Header["typ"].(string); t.tokenType != "" && typ != t.tokenType {
+				return nil, errors.New("unexpected token type")
+			}
 
-			return t.secret, nil
+			return t.verifyKey, nil
 		},
 	)
 	if err != nil {
@@ -70,3 +152,15 @@ func (t *token) VerifyToken(_ context.Context, token string) (*model.UserClaims,
 
 	return claims, nil
 }
+
+func (t *token) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(t.method, claims)
+	if t.keyID != "" {
+		token.Header["kid"] = t.keyID
+	}
+	if t.tokenType != "" {
+		token.Header["typ"] = t.tokenType
+	}
+
+	return token.SignedString(t.signKey)
+}
@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+create table organizations
+(
+    id         serial primary key,
+    name       varchar   not null unique,
+    created_at timestamp not null default now(),
+    updated_at timestamp
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on organizations for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+insert into organizations (id, name) values (1, 'default');
+select setval('organizations_id_seq', (select max(id) from organizations));
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table roles
+(
+    id          serial primary key,
+    tenant_id   int       not null references organizations (id) on delete cascade,
+    name        varchar   not null,
+    description varchar   not null default '',
+    created_at  timestamp not null default now(),
+    unique (tenant_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+insert into roles (tenant_id, name) values (1, 'ADMIN'), (1, 'USER');
+insert into roles (tenant_id, name) select distinct 1, role from users on conflict do nothing;
+insert into roles (tenant_id, name) select distinct 1, role from access_list on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table users add column tenant_id int not null default 1 references organizations (id) on delete cascade;
+alter table users alter column tenant_id drop default;
+alter table users add foreign key (tenant_id, role) references roles (tenant_id, name);
+create index users_tenant_id_name_idx on users (tenant_id, name);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table users_log add column tenant_id int not null default 1 references organizations (id) on delete cascade;
+alter table users_log alter column tenant_id drop default;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table access_list add column tenant_id int not null default 1 references organizations (id) on delete cascade;
+alter table access_list alter column tenant_id drop default;
+alter table access_list add foreign key (tenant_id, role) references roles (tenant_id, name) on delete cascade;
+alter table access_list drop constraint access_list_role_endpoint_key;
+alter table access_list add unique (tenant_id, role, endpoint);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table access_list drop column tenant_id;
+alter table access_list add unique (role, endpoint);
+alter table users_log drop column tenant_id;
+alter table users drop column tenant_id;
+drop table roles;
+drop table organizations;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users add column status varchar not null default 'active';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table invitations
+(
+    id         serial primary key,
+    tenant_id  int       not null references organizations (id) on delete cascade,
+    user_id    int       references users (id) on delete set null,
+    email      varchar   not null,
+    token_id   varchar   not null unique,
+    status     varchar   not null,
+    expires_at timestamp not null,
+    created_at timestamp not null default now(),
+    updated_at timestamp
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on invitations for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table invitations;
+alter table users drop column status;
+-- +goose StatementEnd
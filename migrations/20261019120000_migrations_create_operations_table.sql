@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+create table operations
+(
+    id         serial primary key,
+    tenant_id  int       not null references organizations (id) on delete cascade,
+    kind       varchar   not null,
+    done       boolean   not null default false,
+    metadata   jsonb     not null default '{}',
+    result     jsonb,
+    error      varchar   not null default '',
+    created_at timestamp not null default now(),
+    updated_at timestamp
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on operations for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table operations;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users_log add column pseudonym varchar;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table erasure_requests
+(
+    id           serial primary key,
+    tenant_id    int       not null references organizations (id) on delete cascade,
+    user_id      int       not null,
+    requested_by varchar   not null,
+    reviewed_by  varchar,
+    reason       varchar   not null default '',
+    status       varchar   not null,
+    created_at   timestamp not null default now(),
+    updated_at   timestamp
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on erasure_requests for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table erasure_requests;
+alter table users_log drop column pseudonym;
+-- +goose StatementEnd
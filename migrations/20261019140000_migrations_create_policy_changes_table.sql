@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table access_list add column id serial primary key;
+alter table access_list add column created_at timestamp not null default now();
+alter table access_list add column updated_at timestamp;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on access_list for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table policy_changes
+(
+    id         serial primary key,
+    tenant_id  int       not null references organizations (id) on delete cascade,
+    actor      varchar   not null,
+    action     varchar   not null,
+    details    jsonb     not null,
+    created_at timestamp not null default now()
+);
+create index policy_changes_tenant_id_created_at_idx on policy_changes (tenant_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table policy_changes;
+drop trigger update_modified_time on access_list;
+alter table access_list drop column updated_at;
+alter table access_list drop column created_at;
+alter table access_list drop column id;
+-- +goose StatementEnd
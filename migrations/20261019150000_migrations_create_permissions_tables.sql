@@ -0,0 +1,94 @@
+-- +goose Up
+-- +goose StatementBegin
+create table permissions
+(
+    id          serial primary key,
+    tenant_id   int       not null references organizations (id) on delete cascade,
+    name        varchar   not null,
+    description varchar   not null default '',
+    created_at  timestamp not null default now(),
+    unique (tenant_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table role_permissions
+(
+    tenant_id  int     not null,
+    role       varchar not null,
+    permission varchar not null,
+    primary key (tenant_id, role, permission),
+    foreign key (tenant_id, role) references roles (tenant_id, name) on delete cascade,
+    foreign key (tenant_id, permission) references permissions (tenant_id, name) on delete cascade
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table role_parents
+(
+    tenant_id int     not null,
+    role      varchar not null,
+    parent    varchar not null,
+    primary key (tenant_id, role, parent),
+    check (role <> parent),
+    foreign key (tenant_id, role) references roles (tenant_id, name) on delete cascade,
+    foreign key (tenant_id, parent) references roles (tenant_id, name) on delete cascade
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- every endpoint of access_list gets its own permission "<service>:<method>",
+-- e.g. /user_v1.UserV1/Get becomes user_v1.userv1:get
+insert into permissions (tenant_id, name, description)
+select distinct tenant_id,
+                lower(regexp_replace(endpoint, '^/?([^/]+)/(.+)$', '\1:\2')),
+                'access to ' || endpoint
+from access_list;
+
+insert into role_permissions (tenant_id, role, permission)
+select distinct tenant_id, role, lower(regexp_replace(endpoint, '^/?([^/]+)/(.+)$', '\1:\2'))
+from access_list;
+
+insert into role_parents (tenant_id, role, parent)
+select a.tenant_id, a.name, u.name
+from roles a
+         join roles u on u.tenant_id = a.tenant_id and u.name = 'USER'
+where a.name = 'ADMIN';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table access_list add column permission varchar;
+update access_list set permission = lower(regexp_replace(endpoint, '^/?([^/]+)/(.+)$', '\1:\2'));
+delete from access_list a using access_list b
+where a.tenant_id = b.tenant_id and a.endpoint = b.endpoint and a.id > b.id;
+alter table access_list alter column permission set not null;
+alter table access_list drop column role;
+alter table access_list add unique (tenant_id, endpoint, permission);
+alter table access_list add foreign key (tenant_id, permission) references permissions (tenant_id, name) on delete cascade;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table access_list drop constraint access_list_tenant_id_endpoint_permission_key;
+alter table access_list add column role varchar;
+insert into access_list (tenant_id, role, endpoint, permission)
+with recursive effective (tenant_id, role, permission) as (
+    select tenant_id, role, permission
+    from role_permissions
+    union
+    select p.tenant_id, p.role, e.permission
+    from role_parents p
+             join effective e on e.tenant_id = p.tenant_id and e.role = p.parent
+)
+select distinct a.tenant_id, e.role, a.endpoint, a.permission
+from access_list a
+         join effective e on e.tenant_id = a.tenant_id and e.permission = a.permission;
+delete from access_list where role is null;
+alter table access_list drop column permission;
+alter table access_list alter column role set not null;
+alter table access_list add foreign key (tenant_id, role) references roles (tenant_id, name) on delete cascade;
+alter table access_list add unique (tenant_id, role, endpoint);
+drop table role_parents;
+drop table role_permissions;
+drop table permissions;
+-- +goose StatementEnd
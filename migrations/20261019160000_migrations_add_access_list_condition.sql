@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table access_list add column condition text not null default '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table access_list drop column condition;
+-- +goose StatementEnd
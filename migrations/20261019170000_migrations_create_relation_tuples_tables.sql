@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+create table relation_namespaces
+(
+    id         serial primary key,
+    tenant_id  int       not null references organizations (id) on delete cascade,
+    name       varchar   not null,
+    config     jsonb     not null,
+    created_at timestamp not null default now(),
+    updated_at timestamp,
+    unique (tenant_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on relation_namespaces for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table relation_revisions
+(
+    tenant_id int    primary key references organizations (id) on delete cascade,
+    revision  bigint not null
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table relation_tuples
+(
+    id                bigserial primary key,
+    tenant_id         int     not null references organizations (id) on delete cascade,
+    namespace         varchar not null,
+    object_id         varchar not null,
+    relation          varchar not null,
+    subject_namespace varchar not null,
+    subject_object_id varchar not null,
+    subject_relation  varchar not null default '',
+    created_revision  bigint  not null,
+    deleted_revision  bigint
+);
+create unique index relation_tuples_live_idx on relation_tuples
+    (tenant_id, namespace, object_id, relation, subject_namespace, subject_object_id, subject_relation)
+    where deleted_revision is null;
+create index relation_tuples_object_idx on relation_tuples (tenant_id, namespace, object_id, relation);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table relation_tuples;
+drop table relation_revisions;
+drop table relation_namespaces;
+-- +goose StatementEnd
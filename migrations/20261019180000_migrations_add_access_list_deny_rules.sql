@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+create unique index users_tenant_id_id_idx on users (tenant_id, id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table access_list add column effect varchar not null default 'allow' check (effect in ('allow', 'deny'));
+alter table access_list add column role varchar;
+alter table access_list add column user_id int;
+alter table access_list alter column permission drop not null;
+alter table access_list add foreign key (tenant_id, role) references roles (tenant_id, name) on delete cascade;
+alter table access_list add foreign key (tenant_id, user_id) references users (tenant_id, id) on delete cascade;
+alter table access_list add constraint access_list_subject_check check (num_nonnulls(permission, role, user_id) = 1);
+alter table access_list drop constraint access_list_tenant_id_endpoint_permission_key;
+create unique index access_list_rule_idx on access_list
+    (tenant_id, endpoint, effect, coalesce(permission, ''), coalesce(role, ''), coalesce(user_id, 0));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list where effect = 'deny' or permission is null;
+drop index access_list_rule_idx;
+alter table access_list add unique (tenant_id, endpoint, permission);
+alter table access_list drop constraint access_list_subject_check;
+alter table access_list alter column permission set not null;
+alter table access_list drop column user_id;
+alter table access_list drop column role;
+alter table access_list drop column effect;
+drop index users_tenant_id_id_idx;
+-- +goose StatementEnd
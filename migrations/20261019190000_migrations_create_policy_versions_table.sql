@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+create table policy_versions
+(
+    id      boolean primary key default true check (id),
+    version bigint  not null
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+insert into policy_versions (id, version) values (true, 0);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create or replace function notify_policy_change()
+returns trigger as $$
+declare
+    new_version bigint;
+begin
+update policy_versions set version = version + 1 returning version into new_version;
+perform pg_notify('policy_changed', new_version::text);
+return null;
+end;
+$$ language 'plpgsql';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger notify_policy_change after insert or update or delete or truncate on access_list for each statement execute procedure notify_policy_change();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger notify_policy_change after insert or update or delete or truncate on role_parents for each statement execute procedure notify_policy_change();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger notify_policy_change after insert or update or delete or truncate on role_permissions for each statement execute procedure notify_policy_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop trigger notify_policy_change on role_permissions;
+drop trigger notify_policy_change on role_parents;
+drop trigger notify_policy_change on access_list;
+drop function notify_policy_change();
+drop table policy_versions;
+-- +goose StatementEnd
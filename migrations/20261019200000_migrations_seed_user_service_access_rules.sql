@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- methods of user service are authorized by access rules: ADMIN manages users
+-- and USER reads own profile, new organizations get the same rules
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, '/user_v1.UserV1', ''
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, '/user_v1.UserV1/GetUser', 'request.id == string(claims.user_id)'
+from roles
+where name = 'USER'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list
+where (role = 'ADMIN' and endpoint = '/user_v1.UserV1')
+   or (role = 'USER' and endpoint = '/user_v1.UserV1/GetUser');
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+create table role_grants
+(
+    id          serial primary key,
+    tenant_id   int       not null references organizations (id) on delete cascade,
+    user_id     int       not null references users (id) on delete cascade,
+    role        varchar   not null,
+    reason      varchar   not null,
+    status      varchar   not null,
+    reviewed_by varchar,
+    starts_at   timestamp not null,
+    expires_at  timestamp not null,
+    created_at  timestamp not null default now(),
+    updated_at  timestamp,
+    check (starts_at < expires_at),
+    foreign key (tenant_id, role) references roles (tenant_id, name) on delete cascade
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create index role_grants_user_idx on role_grants (tenant_id, user_id, status);
+create index role_grants_expiry_idx on role_grants (status, expires_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on role_grants for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- ADMIN approves elevation requests, new organizations get the same grant
+insert into permissions (tenant_id, name, description)
+select tenant_id, 'elevation:approve', 'approval of elevation requests'
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+
+insert into role_permissions (tenant_id, role, permission)
+select tenant_id, name, 'elevation:approve'
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where name = 'elevation:approve';
+drop table role_grants;
+-- +goose StatementEnd
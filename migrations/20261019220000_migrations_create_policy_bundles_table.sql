@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table policy_bundles
+(
+    id         serial primary key,
+    tenant_id  int       not null references organizations (id) on delete cascade,
+    version    int       not null,
+    bundle     jsonb     not null,
+    diff       jsonb     not null,
+    author     varchar   not null,
+    action     varchar   not null,
+    created_at timestamp not null default now(),
+    unique (tenant_id, version)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table policy_bundles;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+create table oauth_clients
+(
+    id            serial primary key,
+    tenant_id     int       not null references organizations (id) on delete cascade,
+    client_id     varchar   not null unique,
+    name          varchar   not null,
+    redirect_uris text[]    not null,
+    created_at    timestamp not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table oauth_authorization_codes
+(
+    code_hash      varchar primary key,
+    tenant_id      int       not null references organizations (id) on delete cascade,
+    client_id      varchar   not null references oauth_clients (client_id) on delete cascade,
+    user_id        int       not null references users (id) on delete cascade,
+    redirect_uri   varchar   not null,
+    scope          varchar   not null,
+    code_challenge varchar   not null,
+    expires_at     timestamp not null,
+    created_at     timestamp not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create index oauth_authorization_codes_expiry_idx on oauth_authorization_codes (tenant_id, expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table oauth_authorization_codes;
+drop table oauth_clients;
+-- +goose StatementEnd
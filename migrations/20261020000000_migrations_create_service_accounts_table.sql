@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+create table service_accounts
+(
+    id                serial primary key,
+    tenant_id         int       not null references organizations (id) on delete cascade,
+    client_id         varchar   not null unique,
+    name              varchar   not null,
+    role              varchar   not null,
+    scopes            text[]    not null default '{}',
+    secret_hash       varchar   not null,
+    disabled          boolean   not null default false,
+    secret_rotated_at timestamp not null default now(),
+    created_at        timestamp not null default now(),
+    updated_at        timestamp,
+    unique (tenant_id, name),
+    foreign key (tenant_id, role) references roles (tenant_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create trigger update_modified_time before update on service_accounts for each row execute procedure update_modified_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table service_accounts;
+-- +goose StatementEnd
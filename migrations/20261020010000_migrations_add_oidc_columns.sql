@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users add column email_verified_at timestamp;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table oauth_authorization_codes
+    add column nonce     varchar   not null default '',
+    add column auth_time timestamp not null default now();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table oauth_authorization_codes drop column auth_time, drop column nonce;
+alter table users drop column email_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+create table federated_identities
+(
+    id         serial primary key,
+    tenant_id  int       not null references organizations (id) on delete cascade,
+    provider   varchar   not null,
+    subject    varchar   not null,
+    user_id    int       not null references users (id) on delete cascade,
+    created_at timestamp not null default now(),
+    unique (tenant_id, provider, subject)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create table federation_login_states
+(
+    state_hash     varchar primary key,
+    tenant_id      int       not null references organizations (id) on delete cascade,
+    provider       varchar   not null,
+    client_id      varchar   not null references oauth_clients (client_id) on delete cascade,
+    redirect_uri   varchar   not null,
+    scope          varchar   not null,
+    state          varchar   not null,
+    code_challenge varchar   not null,
+    nonce          varchar   not null,
+    upstream_nonce varchar   not null,
+    code_verifier  varchar   not null,
+    expires_at     timestamp not null,
+    created_at     timestamp not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create index federation_login_states_expiry_idx on federation_login_states (tenant_id, expires_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+alter table oauth_authorization_codes add column auth_method varchar not null default 'pwd';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table oauth_authorization_codes drop column auth_method;
+drop table federation_login_states;
+drop table federated_identities;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- SCIM clients provision users and groups with scim:provision granted to ADMIN,
+-- new organizations get the same permission and rule
+insert into permissions (tenant_id, name, description)
+select tenant_id, 'scim:provision', 'provisioning of users and groups over SCIM'
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+
+insert into role_permissions (tenant_id, role, permission)
+select tenant_id, name, 'scim:provision'
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+
+insert into access_list (tenant_id, effect, permission, endpoint, condition)
+select tenant_id, 'allow', 'scim:provision', '* /scim/v2/**', ''
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where name = 'scim:provision';
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- allowing rules of the most specific pattern apply, so rule of USER on GetUser
+-- shadowed service rule of ADMIN; access policy and OAuth clients are managed by ADMIN,
+-- new organizations get the same rules
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, endpoint, ''
+from roles
+cross join (values
+    ('/user_v1.UserV1/GetUser'),
+    ('/access_admin_v1.AccessAdminV1'),
+    ('/oauth_v1.OAuthV1')
+) as endpoints (endpoint)
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list
+where role = 'ADMIN' and condition = ''
+  and endpoint in ('/user_v1.UserV1/GetUser', '/access_admin_v1.AccessAdminV1', '/oauth_v1.OAuthV1');
+-- +goose StatementEnd
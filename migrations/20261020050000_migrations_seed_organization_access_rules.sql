@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- methods of organization service are authorized by access rules: ADMIN manages roles
+-- of own organization and ADMIN of default organization manages all organizations,
+-- new organizations get the same rule
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, '/organization_v1.OrganizationV1', ''
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list
+where role = 'ADMIN' and endpoint = '/organization_v1.OrganizationV1' and condition = '';
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- methods of invitation service except AcceptInvitation are authorized by access rules:
+-- ADMIN invites users, new organizations get the same rule
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, '/invitation_v1.InvitationV1', ''
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list
+where role = 'ADMIN' and endpoint = '/invitation_v1.InvitationV1' and condition = '';
+-- +goose StatementEnd
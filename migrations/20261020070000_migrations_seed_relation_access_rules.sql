@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- namespaces and tuples of relations are written by ADMIN,
+-- new organizations get the same rule
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, '/relation_v1.RelationV1', ''
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list
+where role = 'ADMIN' and condition = '' and endpoint = '/relation_v1.RelationV1';
+-- +goose StatementEnd
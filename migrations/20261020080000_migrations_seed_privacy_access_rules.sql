@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- erasures of other users are requested and handled by ADMIN,
+-- new organizations get the same rule
+insert into access_list (tenant_id, effect, role, endpoint, condition)
+select tenant_id, 'allow', name, '/privacy_v1.PrivacyV1', ''
+from roles
+where name = 'ADMIN'
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from access_list
+where role = 'ADMIN' and condition = '' and endpoint = '/privacy_v1.PrivacyV1';
+-- +goose StatementEnd
@@ -0,0 +1,89 @@
+package authclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CachingVerifier caches results of verifier by token and endpoint for ttl,
+// result is not cached longer than token is valid and errors other than
+// ErrorUnauthenticated and ErrorAccessDenied are not cached
+type CachingVerifier struct {
+	verifier   Verifier
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+type cacheKey struct {
+	token    [sha256.Size]byte
+	endpoint string
+}
+
+type cacheEntry struct {
+	claims    *Claims
+	err       error
+	expiresAt time.Time
+}
+
+// NewCachingVerifier creates verifier caching at most maxEntries results of verifier
+func NewCachingVerifier(verifier Verifier, ttl time.Duration, maxEntries int) *CachingVerifier {
+	return &CachingVerifier{
+		verifier:   verifier,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[cacheKey]cacheEntry),
+	}
+}
+
+// Verify returns cached result of verifier
+func (v *CachingVerifier) Verify(ctx context.Context, token string, endpoint string) (
+	*Claims, error,
+) {
+	key := cacheKey{token: sha256.Sum256([]byte(token)), endpoint: endpoint}
+	now := time.Now()
+
+	v.mu.Lock()
+	entry, ok := v.entries[key]
+	v.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.claims, entry.err
+	}
+
+	claims, err := v.verifier.Verify(ctx, token, endpoint)
+	if err != nil && !errors.Is(err, ErrorUnauthenticated) && !errors.Is(err, ErrorAccessDenied) {
+		return nil, err
+	}
+
+	entry = cacheEntry{claims: claims, err: err, expiresAt: now.Add(v.ttl)}
+	if claims != nil && claims.ExpiresAt.Before(entry.expiresAt) {
+		entry.expiresAt = claims.ExpiresAt
+	}
+
+	v.mu.Lock()
+	if len(v.entries) >= v.maxEntries {
+		v.evict(now)
+	}
+	v.entries[key] = entry
+	v.mu.Unlock()
+
+	return claims, err
+}
+
+// evict drops expired results, all results are dropped if cache is still full
+func (v *CachingVerifier) evict(now time.Time) {
+	for key, entry := range v.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(v.entries, key)
+		}
+	}
+	if len(v.entries) >= v.maxEntries {
+		v.entries = make(map[cacheKey]cacheEntry)
+	}
+}
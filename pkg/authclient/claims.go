@@ -0,0 +1,68 @@
+package authclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrorUnauthenticated is error for missing, invalid or expired access token
+	ErrorUnauthenticated = errors.New("unauthenticated")
+	// ErrorAccessDenied is error for caller which is not allowed to access endpoint
+	ErrorAccessDenied = errors.New("access denied")
+)
+
+// Claims are claims of access token issued by auth service
+type Claims struct {
+	UserID    int64
+	Username  string
+	Role      string
+	TenantID  int64
+	ExpiresAt time.Time
+}
+
+// tokenClaims is payload of access token
+type tokenClaims struct {
+	jwt.StandardClaims
+	UserID   int64  `json:"user_id,omitempty"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	TenantID int64  `json:"tenant_id"`
+}
+
+func (c *tokenClaims) toClaims() *Claims {
+	return &Claims{
+		UserID:    c.UserID,
+		Username:  c.Username,
+		Role:      c.Role,
+		TenantID:  c.TenantID,
+		ExpiresAt: time.Unix(c.ExpiresAt, 0),
+	}
+}
+
+// parseUnverified returns claims of token without verifying its signature
+func parseUnverified(token string) (*tokenClaims, error) {
+	claims := &tokenClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return nil, errors.Wrap(ErrorUnauthenticated, err.Error())
+	}
+
+	return claims, nil
+}
+
+type claimsKey struct{}
+
+// ContextWithClaims returns context with claims of verified caller
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns claims of caller verified by server interceptor or middleware
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+
+	return claims, ok && claims != nil
+}
@@ -0,0 +1,61 @@
+package authclient
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authHeaderName = "authorization"
+	authPrefix     = "Bearer "
+
+	// authServicePrefix is prefix of methods which issue tokens, calls of them are sent
+	// without token so token source may share connection with other clients
+	authServicePrefix = "/auth_v1.AuthV1/"
+)
+
+// UnaryClientInterceptor attaches access token of source to outgoing calls
+func UnaryClientInterceptor(source *TokenSource) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		ctx, err := withToken(ctx, source, method)
+		if err != nil {
+			return err
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor attaches access token of source to outgoing streams
+func StreamClientInterceptor(source *TokenSource) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		ctx, err := withToken(ctx, source, method)
+		if err != nil {
+			return nil, err
+		}
+
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func withToken(ctx context.Context, source *TokenSource, method string) (
+	context.Context, error,
+) {
+	if strings.HasPrefix(method, authServicePrefix) {
+		return ctx, nil
+	}
+
+	token, err := source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authHeaderName, authPrefix+token), nil
+}
@@ -0,0 +1,12 @@
+// Package authclient is client SDK of auth service.
+//
+// TokenSource logs in, caches access token and refreshes it before expiry,
+// UnaryClientInterceptor and StreamClientInterceptor attach its token to outgoing calls.
+//
+// Verifier checks tokens of incoming requests: JWKSVerifier verifies signature locally
+// by keys published by auth service and only authenticates caller, RemoteVerifier asks
+// AccessV1 whether caller may access endpoint and checks revocation, CachingVerifier
+// caches results of another verifier.
+// UnaryServerInterceptor, StreamServerInterceptor and Middleware put claims of verified
+// caller into context, see ClaimsFromContext.
+package authclient
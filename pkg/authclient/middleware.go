@@ -0,0 +1,38 @@
+package authclient
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Middleware verifies access token of caller of HTTP endpoint, e.g. GET /user/v1/5,
+// and puts its claims into context of request
+func Middleware(verifier Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get(authHeaderName)
+			if !strings.HasPrefix(token, authPrefix) {
+				http.Error(w, "access token is not provided", http.StatusUnauthorized)
+				return
+			}
+
+			claims, err := verifier.Verify(r.Context(), strings.TrimPrefix(token, authPrefix),
+				r.Method+" "+r.URL.Path)
+			switch {
+			case errors.Is(err, ErrorUnauthenticated):
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			case errors.Is(err, ErrorAccessDenied):
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			case err != nil:
+				http.Error(w, "unable to verify access token", http.StatusServiceUnavailable)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ContextWithClaims(r.Context(), claims)))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package authclient
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor verifies access token of caller of method
+// and puts its claims into context
+func UnaryServerInterceptor(verifier Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := verifyIncoming(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor verifies access token of caller of streaming method
+// and puts its claims into context of stream
+func StreamServerInterceptor(verifier Verifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := verifyIncoming(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func verifyIncoming(ctx context.Context, verifier Verifier, method string) (
+	context.Context, error,
+) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authHeaderName); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	if !strings.HasPrefix(token, authPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "access token is not provided")
+	}
+
+	claims, err := verifier.Verify(ctx, strings.TrimPrefix(token, authPrefix), method)
+	switch {
+	case errors.Is(err, ErrorUnauthenticated):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrorAccessDenied):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return ContextWithClaims(ctx, claims), nil
+}
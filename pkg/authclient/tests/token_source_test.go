@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/valek177/auth/grpc/pkg/auth_v1"
+	"github.com/valek177/auth/pkg/authclient"
+)
+
+var secret = []byte("secret")
+
+// signToken returns token of user signed with secret
+func signToken(t *testing.T, expiresIn time.Duration) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp":       time.Now().Add(expiresIn).Unix(),
+		"user_id":   7,
+		"username":  "alice",
+		"role":      "USER",
+		"tenant_id": 3,
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	return token
+}
+
+// fakeAuthClient issues tokens with given lifetimes and counts calls
+type fakeAuthClient struct {
+	t          *testing.T
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+
+	logins, refreshes, rotations atomic.Int32
+	tenant                       atomic.Value
+}
+
+func (c *fakeAuthClient) Login(ctx context.Context, _ *auth_v1.LoginRequest,
+	_ ...grpc.CallOption,
+) (*auth_v1.LoginResponse, error) {
+	c.logins.Add(1)
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get("x-tenant-id")) > 0 {
+		c.tenant.Store(md.Get("x-tenant-id")[0])
+	}
+
+	return &auth_v1.LoginResponse{
+		RefreshToken: signToken(c.t, c.refreshTTL),
+		AccessToken:  signToken(c.t, c.accessTTL),
+	}, nil
+}
+
+func (c *fakeAuthClient) GetRefreshToken(_ context.Context, _ *auth_v1.GetRefreshTokenRequest,
+	_ ...grpc.CallOption,
+) (*auth_v1.GetRefreshTokenResponse, error) {
+	c.rotations.Add(1)
+
+	return &auth_v1.GetRefreshTokenResponse{RefreshToken: signToken(c.t, c.refreshTTL)}, nil
+}
+
+func (c *fakeAuthClient) GetAccessToken(_ context.Context, _ *auth_v1.GetAccessTokenRequest,
+	_ ...grpc.CallOption,
+) (*auth_v1.GetAccessTokenResponse, error) {
+	c.refreshes.Add(1)
+
+	return &auth_v1.GetAccessTokenResponse{AccessToken: signToken(c.t, c.accessTTL)}, nil
+}
+
+func TestTokenSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		accessTTL  time.Duration
+		refreshTTL time.Duration
+		logins     int32
+		refreshes  int32
+		rotations  int32
+	}{
+		{
+			name:       "success case: cached access token",
+			accessTTL:  time.Hour,
+			refreshTTL: 2 * time.Hour,
+			logins:     1,
+		},
+		{
+			name:       "success case: expiring access token is refreshed",
+			accessTTL:  30 * time.Second,
+			refreshTTL: 2 * time.Hour,
+			logins:     1,
+			refreshes:  2,
+		},
+		{
+			name:       "success case: refresh token is rotated before expiry",
+			accessTTL:  30 * time.Second,
+			refreshTTL: 90 * time.Second,
+			logins:     1,
+			refreshes:  2,
+			rotations:  2,
+		},
+		{
+			name:       "success case: expiring refresh token leads to login",
+			accessTTL:  30 * time.Second,
+			refreshTTL: 30 * time.Second,
+			logins:     3,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeAuthClient{t: t, accessTTL: tt.accessTTL, refreshTTL: tt.refreshTTL}
+			source := authclient.NewTokenSource(client, authclient.Credentials{
+				Username: "alice",
+				Password: "password",
+				TenantID: 3,
+			}, time.Minute)
+
+			for i := 0; i < 3; i++ {
+				token, err := source.Token(context.Background())
+				require.NoError(t, err)
+				assert.NotEmpty(t, token)
+			}
+
+			assert.Equal(t, tt.logins, client.logins.Load())
+			assert.Equal(t, tt.refreshes, client.refreshes.Load())
+			assert.Equal(t, tt.rotations, client.rotations.Load())
+			assert.Equal(t, "3", client.tenant.Load())
+		})
+	}
+}
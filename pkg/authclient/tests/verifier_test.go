@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/valek177/auth/internal/model"
+	"github.com/valek177/auth/internal/utils"
+	"github.com/valek177/auth/pkg/authclient"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) ExpTime() time.Duration { return time.Minute }
+func (tokenConfig) Secret() []byte         { return secret }
+
+type oidcConfig struct {
+	key *rsa.PrivateKey
+}
+
+func (oidcConfig) Issuer() string                { return "https://auth.example.com" }
+func (c oidcConfig) SigningKey() *rsa.PrivateKey { return c.key }
+func (oidcConfig) IDTokenExpTime() time.Duration { return time.Minute }
+
+func TestJWKSVerifier(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idTokens := utils.NewIDToken(oidcConfig{key: key})
+	accessTokens := utils.NewAccessToken(tokenConfig{}, key)
+
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(idTokens.JWKS())
+	}))
+	defer server.Close()
+
+	user := &model.User{ID: 7, TenantID: 3, Name: "alice", Role: "USER"}
+	accessToken, err := accessTokens.GenerateToken(context.Background(), user)
+	require.NoError(t, err)
+
+	verifier := authclient.NewJWKSVerifier(server.URL, server.Client(), time.Hour)
+
+	for i := 0; i < 2; i++ {
+		claims, errVerify := verifier.Verify(context.Background(), accessToken, "")
+		require.NoError(t, errVerify)
+		assert.Equal(t, int64(7), claims.UserID)
+		assert.Equal(t, "alice", claims.Username)
+		assert.Equal(t, "USER", claims.Role)
+		assert.Equal(t, int64(3), claims.TenantID)
+	}
+
+	idToken, err := idTokens.GenerateIDToken(context.Background(), user, &model.IDTokenParams{
+		ClientID: "web-client",
+		AuthTime: time.Now(),
+		Scope:    model.OIDCScopeOpenID,
+	})
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKeyToken, err := utils.NewAccessToken(tokenConfig{}, otherKey).GenerateToken(
+		context.Background(), user)
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{
+		"ID token":               idToken,
+		"token of unknown key":   otherKeyToken,
+		"token signed by secret": signToken(t, time.Minute),
+		"expired token":          signAccessToken(t, key, idTokens.JWKS().Keys[0].KeyID),
+	} {
+		_, err = verifier.Verify(context.Background(), token, "")
+		assert.ErrorIs(t, err, authclient.ErrorUnauthenticated, name)
+	}
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+// signAccessToken returns expired access token signed by key
+func signAccessToken(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"exp":     time.Now().Add(-time.Minute).Unix(),
+		"user_id": 7,
+	})
+	token.Header["kid"] = kid
+	token.Header["typ"] = model.AccessTokenType
+	str, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return str
+}
+
+// fakeVerifier allows only /allowed endpoints and counts calls
+type fakeVerifier struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (v *fakeVerifier) Verify(_ context.Context, token string, endpoint string) (
+	*authclient.Claims, error,
+) {
+	v.calls.Add(1)
+	switch {
+	case v.err != nil:
+		return nil, v.err
+	case token != "valid":
+		return nil, errors.Wrap(authclient.ErrorUnauthenticated, "invalid token")
+	case endpoint != "/allowed" && endpoint != "GET /allowed":
+		return nil, errors.Wrap(authclient.ErrorAccessDenied, "no rule allows access")
+	}
+
+	return &authclient.Claims{UserID: 7, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func TestCachingVerifier(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeVerifier{}
+	verifier := authclient.NewCachingVerifier(fake, time.Minute, 100)
+
+	for i := 0; i < 3; i++ {
+		claims, err := verifier.Verify(context.Background(), "valid", "/allowed")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), claims.UserID)
+
+		_, err = verifier.Verify(context.Background(), "valid", "/denied")
+		assert.ErrorIs(t, err, authclient.ErrorAccessDenied)
+	}
+	assert.Equal(t, int32(2), fake.calls.Load())
+
+	// errors of verifier itself are not cached
+	failing := &fakeVerifier{err: errors.New("unavailable")}
+	verifier = authclient.NewCachingVerifier(failing, time.Minute, 100)
+	for i := 0; i < 2; i++ {
+		_, err := verifier.Verify(context.Background(), "valid", "/allowed")
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(2), failing.calls.Load())
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	handler := authclient.Middleware(&fakeVerifier{})(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := authclient.ClaimsFromContext(r.Context())
+			require.True(t, ok)
+			assert.Equal(t, int64(7), claims.UserID)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+	tests := []struct {
+		name   string
+		path   string
+		header string
+		want   int
+	}{
+		{name: "success case", path: "/allowed", header: "Bearer valid", want: http.StatusNoContent},
+		{name: "error: no token", path: "/allowed", want: http.StatusUnauthorized},
+		{name: "error: invalid token", path: "/allowed", header: "Bearer invalid",
+			want: http.StatusUnauthorized},
+		{name: "error: access denied", path: "/denied", header: "Bearer valid",
+			want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := authclient.UnaryServerInterceptor(&fakeVerifier{})
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		claims, ok := authclient.ClaimsFromContext(ctx)
+		require.True(t, ok)
+		return claims.UserID, nil
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		code   codes.Code
+	}{
+		{name: "success case", method: "/allowed", token: "valid", code: codes.OK},
+		{name: "error: invalid token", method: "/allowed", token: "invalid",
+			code: codes.Unauthenticated},
+		{name: "error: access denied", method: "/denied", token: "valid",
+			code: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("authorization", "Bearer "+tt.token))
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package authclient
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/valek177/auth/grpc/pkg/auth_v1"
+)
+
+const tenantHeaderName = "x-tenant-id"
+
+// Credentials are credentials of user tokens are issued for
+type Credentials struct {
+	Username string
+	Password string
+	// TenantID is organization of user, default organization is used when it is zero
+	TenantID int64
+}
+
+// TokenSource issues access tokens of user: it logs in once, then gets access tokens
+// by refresh token and rotates refresh token, tokens are renewed refreshBefore their expiry.
+// TokenSource is safe for concurrent use.
+type TokenSource struct {
+	client        auth_v1.AuthV1Client
+	credentials   Credentials
+	refreshBefore time.Duration
+
+	mu               sync.Mutex
+	accessToken      string
+	accessExpiresAt  time.Time
+	refreshToken     string
+	refreshExpiresAt time.Time
+}
+
+// NewTokenSource creates token source which logs in with credentials on first use
+func NewTokenSource(client auth_v1.AuthV1Client, credentials Credentials,
+	refreshBefore time.Duration,
+) *TokenSource {
+	return &TokenSource{
+		client:        client,
+		credentials:   credentials,
+		refreshBefore: refreshBefore,
+	}
+}
+
+// Token returns access token which is valid for at least refreshBefore
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.accessToken != "" && now.Add(s.refreshBefore).Before(s.accessExpiresAt) {
+		return s.accessToken, nil
+	}
+
+	if s.refreshToken != "" && now.Add(s.refreshBefore).Before(s.refreshExpiresAt) {
+		if err := s.refresh(ctx); err == nil {
+			return s.accessToken, nil
+		}
+	}
+
+	if err := s.login(ctx); err != nil {
+		return "", err
+	}
+
+	return s.accessToken, nil
+}
+
+// Invalidate drops cached access token, e.g. after it was rejected by server
+func (s *TokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accessToken = ""
+	s.accessExpiresAt = time.Time{}
+}
+
+func (s *TokenSource) login(ctx context.Context) error {
+	if s.credentials.TenantID != 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, tenantHeaderName,
+			strconv.FormatInt(s.credentials.TenantID, 10))
+	}
+
+	res, err := s.client.Login(ctx, &auth_v1.LoginRequest{
+		Username: s.credentials.Username,
+		Password: s.credentials.Password,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to log in")
+	}
+
+	if err = s.setRefreshToken(res.GetRefreshToken()); err != nil {
+		return err
+	}
+
+	return s.setAccessToken(res.GetAccessToken())
+}
+
+// refresh gets new access token, refresh token is rotated when it expires earlier
+// than new access token would
+func (s *TokenSource) refresh(ctx context.Context) error {
+	res, err := s.client.GetAccessToken(ctx, &auth_v1.GetAccessTokenRequest{
+		RefreshToken: s.refreshToken,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to refresh access token")
+	}
+	if err = s.setAccessToken(res.GetAccessToken()); err != nil {
+		return err
+	}
+
+	if s.refreshExpiresAt.After(s.accessExpiresAt.Add(s.refreshBefore)) {
+		return nil
+	}
+
+	rotated, err := s.client.GetRefreshToken(ctx, &auth_v1.GetRefreshTokenRequest{
+		OldRefreshToken: s.refreshToken,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to rotate refresh token")
+	}
+
+	return s.setRefreshToken(rotated.GetRefreshToken())
+}
+
+func (s *TokenSource) setAccessToken(token string) error {
+	claims, err := parseUnverified(token)
+	if err != nil {
+		return err
+	}
+
+	s.accessToken = token
+	s.accessExpiresAt = time.Unix(claims.ExpiresAt, 0)
+
+	return nil
+}
+
+func (s *TokenSource) setRefreshToken(token string) error {
+	claims, err := parseUnverified(token)
+	if err != nil {
+		return err
+	}
+
+	s.refreshToken = token
+	s.refreshExpiresAt = time.Unix(claims.ExpiresAt, 0)
+
+	return nil
+}
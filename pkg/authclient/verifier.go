@@ -0,0 +1,205 @@
+package authclient
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/valek177/auth/grpc/pkg/access_v1"
+)
+
+// Verifier verifies access token of caller of endpoint and returns its claims,
+// endpoint is full gRPC method, e.g. /user_v1.UserV1/Get, or HTTP method and path,
+// e.g. GET /user/v1/5. Invalid token is ErrorUnauthenticated, caller which may not
+// access endpoint is ErrorAccessDenied.
+type Verifier interface {
+	Verify(ctx context.Context, token string, endpoint string) (*Claims, error)
+}
+
+// accessTokenType is typ header of access tokens issued by auth service, RFC 9068
+const accessTokenType = "at+jwt"
+
+// JWKSVerifier verifies signature of access token by RSA keys published as JSON Web Key Set
+// of auth service, keys are fetched again when token is signed by unknown key but not more
+// often than minRefresh. ID tokens signed by the same keys are rejected by their type.
+// Any endpoint is allowed to caller with valid token and revoked tokens are accepted
+// until they expire, RemoteVerifier checks revocation.
+type JWKSVerifier struct {
+	url        string
+	client     *http.Client
+	minRefresh time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// NewJWKSVerifier creates verifier of tokens signed by keys published at url
+func NewJWKSVerifier(url string, client *http.Client, minRefresh time.Duration) *JWKSVerifier {
+	return &JWKSVerifier{
+		url:        url,
+		client:     client,
+		minRefresh: minRefresh,
+	}
+}
+
+// Verify verifies type, signature and expiration of token
+func (v *JWKSVerifier) Verify(ctx context.Context, token string, _ string) (*Claims, error) {
+	return verifySigned(token, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected token signing method")
+		}
+		if typ, _ := t.Header["typ"].(string); typ != accessTokenType {
+			return nil, errors.New("token is not access token")
+		}
+		kid, _ := t.Header["kid"].(string)
+
+		return v.key(ctx, kid)
+	})
+}
+
+func (v *JWKSVerifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(v.fetchedAt) < v.minRefresh {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	keys, err := v.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.keys = keys
+	v.fetchedAt = time.Now()
+
+	key, ok := v.keys[kid]
+	if !ok {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	return key, nil
+}
+
+// jwks is JSON Web Key Set
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+func (v *JWKSVerifier) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch signing keys")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to fetch signing keys: status %d", res.StatusCode)
+	}
+
+	var set jwks
+	if err = json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return nil, errors.Wrap(err, "unable to decode signing keys")
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" {
+			continue
+		}
+
+		n, errN := base64.RawURLEncoding.DecodeString(key.N)
+		e, errE := base64.RawURLEncoding.DecodeString(key.E)
+		if errN != nil || errE != nil {
+			return nil, errors.Errorf("invalid signing key %q", key.Kid)
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+func verifySigned(token string, keyFunc jwt.Keyfunc) (*Claims, error) {
+	claims := &tokenClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, keyFunc); err != nil {
+		return nil, errors.Wrap(ErrorUnauthenticated, err.Error())
+	}
+
+	return claims.toClaims(), nil
+}
+
+// RemoteVerifier asks auth service whether caller may access endpoint by AccessV1.BatchCheck,
+// which returns denied access as result rather than error,
+// so access rules of caller organization are enforced
+type RemoteVerifier struct {
+	client access_v1.AccessV1Client
+}
+
+// NewRemoteVerifier creates verifier calling AccessV1 of auth service
+func NewRemoteVerifier(client access_v1.AccessV1Client) *RemoteVerifier {
+	return &RemoteVerifier{client: client}
+}
+
+// Verify checks access of token owner to endpoint, claims are taken from token
+// after auth service accepted it
+func (v *RemoteVerifier) Verify(ctx context.Context, token string, endpoint string) (
+	*Claims, error,
+) {
+	claims, err := parseUnverified(token)
+	if err != nil {
+		return nil, err
+	}
+	if err = claims.Valid(); err != nil {
+		return nil, errors.Wrap(ErrorUnauthenticated, err.Error())
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, authHeaderName, authPrefix+token)
+	res, err := v.client.BatchCheck(ctx, &access_v1.BatchCheckRequest{
+		Checks: []*access_v1.BatchCheckItem{{EndpointAddress: endpoint}},
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, errors.Wrap(ErrorUnauthenticated, status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to check access")
+	}
+
+	results := res.GetResults()
+	if len(results) != 1 {
+		return nil, errors.New("unable to check access: unexpected response")
+	}
+	if !results[0].GetAllowed() {
+		return nil, errors.Wrap(ErrorAccessDenied, results[0].GetReason())
+	}
+
+	return claims.toClaims(), nil
+}